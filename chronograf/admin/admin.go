@@ -0,0 +1,7 @@
+// Package admin is the gRPC administrative API of Chronograf, managing its
+// users, organizations, sources and dashboards. It is served on the port of
+// --grpc-port to clients presenting a TLS client certificate mapped to a
+// SuperAdmin, such as infrastructure-as-code tooling.
+package admin
+
+//go:generate protoc --plugin ../../scripts/protoc-gen-gogo --gogo_out=plugins=grpc:. admin.proto
@@ -0,0 +1,2798 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: admin.proto
+
+package admin
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type Empty struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Empty) Reset()         { *m = Empty{} }
+func (m *Empty) String() string { return proto.CompactTextString(m) }
+func (*Empty) ProtoMessage()    {}
+func (*Empty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{0}
+}
+func (m *Empty) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Empty.Unmarshal(m, b)
+}
+func (m *Empty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Empty.Marshal(b, m, deterministic)
+}
+func (m *Empty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Empty.Merge(m, src)
+}
+func (m *Empty) XXX_Size() int {
+	return xxx_messageInfo_Empty.Size(m)
+}
+func (m *Empty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Empty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Empty proto.InternalMessageInfo
+
+type ListRequest struct {
+	Organization         string   `protobuf:"bytes,1,opt,name=Organization,proto3" json:"Organization,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRequest) Reset()         { *m = ListRequest{} }
+func (m *ListRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRequest) ProtoMessage()    {}
+func (*ListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{1}
+}
+func (m *ListRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRequest.Unmarshal(m, b)
+}
+func (m *ListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRequest.Marshal(b, m, deterministic)
+}
+func (m *ListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRequest.Merge(m, src)
+}
+func (m *ListRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRequest.Size(m)
+}
+func (m *ListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRequest proto.InternalMessageInfo
+
+func (m *ListRequest) GetOrganization() string {
+	if m != nil {
+		return m.Organization
+	}
+	return ""
+}
+
+type UserRequest struct {
+	ID                   uint64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserRequest) Reset()         { *m = UserRequest{} }
+func (m *UserRequest) String() string { return proto.CompactTextString(m) }
+func (*UserRequest) ProtoMessage()    {}
+func (*UserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{2}
+}
+func (m *UserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserRequest.Unmarshal(m, b)
+}
+func (m *UserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserRequest.Marshal(b, m, deterministic)
+}
+func (m *UserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserRequest.Merge(m, src)
+}
+func (m *UserRequest) XXX_Size() int {
+	return xxx_messageInfo_UserRequest.Size(m)
+}
+func (m *UserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserRequest proto.InternalMessageInfo
+
+func (m *UserRequest) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+type OrganizationRequest struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrganizationRequest) Reset()         { *m = OrganizationRequest{} }
+func (m *OrganizationRequest) String() string { return proto.CompactTextString(m) }
+func (*OrganizationRequest) ProtoMessage()    {}
+func (*OrganizationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{3}
+}
+func (m *OrganizationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrganizationRequest.Unmarshal(m, b)
+}
+func (m *OrganizationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrganizationRequest.Marshal(b, m, deterministic)
+}
+func (m *OrganizationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrganizationRequest.Merge(m, src)
+}
+func (m *OrganizationRequest) XXX_Size() int {
+	return xxx_messageInfo_OrganizationRequest.Size(m)
+}
+func (m *OrganizationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrganizationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrganizationRequest proto.InternalMessageInfo
+
+func (m *OrganizationRequest) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+type SourceRequest struct {
+	ID                   int64    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SourceRequest) Reset()         { *m = SourceRequest{} }
+func (m *SourceRequest) String() string { return proto.CompactTextString(m) }
+func (*SourceRequest) ProtoMessage()    {}
+func (*SourceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{4}
+}
+func (m *SourceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SourceRequest.Unmarshal(m, b)
+}
+func (m *SourceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SourceRequest.Marshal(b, m, deterministic)
+}
+func (m *SourceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceRequest.Merge(m, src)
+}
+func (m *SourceRequest) XXX_Size() int {
+	return xxx_messageInfo_SourceRequest.Size(m)
+}
+func (m *SourceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SourceRequest proto.InternalMessageInfo
+
+func (m *SourceRequest) GetID() int64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+type DashboardRequest struct {
+	ID                   int64    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DashboardRequest) Reset()         { *m = DashboardRequest{} }
+func (m *DashboardRequest) String() string { return proto.CompactTextString(m) }
+func (*DashboardRequest) ProtoMessage()    {}
+func (*DashboardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{5}
+}
+func (m *DashboardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardRequest.Unmarshal(m, b)
+}
+func (m *DashboardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardRequest.Marshal(b, m, deterministic)
+}
+func (m *DashboardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardRequest.Merge(m, src)
+}
+func (m *DashboardRequest) XXX_Size() int {
+	return xxx_messageInfo_DashboardRequest.Size(m)
+}
+func (m *DashboardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DashboardRequest proto.InternalMessageInfo
+
+func (m *DashboardRequest) GetID() int64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+type Users struct {
+	Users                []*User  `protobuf:"bytes,1,rep,name=Users,proto3" json:"Users,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Users) Reset()         { *m = Users{} }
+func (m *Users) String() string { return proto.CompactTextString(m) }
+func (*Users) ProtoMessage()    {}
+func (*Users) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{6}
+}
+func (m *Users) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Users.Unmarshal(m, b)
+}
+func (m *Users) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Users.Marshal(b, m, deterministic)
+}
+func (m *Users) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Users.Merge(m, src)
+}
+func (m *Users) XXX_Size() int {
+	return xxx_messageInfo_Users.Size(m)
+}
+func (m *Users) XXX_DiscardUnknown() {
+	xxx_messageInfo_Users.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Users proto.InternalMessageInfo
+
+func (m *Users) GetUsers() []*User {
+	if m != nil {
+		return m.Users
+	}
+	return nil
+}
+
+type Organizations struct {
+	Organizations        []*Organization `protobuf:"bytes,1,rep,name=Organizations,proto3" json:"Organizations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Organizations) Reset()         { *m = Organizations{} }
+func (m *Organizations) String() string { return proto.CompactTextString(m) }
+func (*Organizations) ProtoMessage()    {}
+func (*Organizations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{7}
+}
+func (m *Organizations) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Organizations.Unmarshal(m, b)
+}
+func (m *Organizations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Organizations.Marshal(b, m, deterministic)
+}
+func (m *Organizations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Organizations.Merge(m, src)
+}
+func (m *Organizations) XXX_Size() int {
+	return xxx_messageInfo_Organizations.Size(m)
+}
+func (m *Organizations) XXX_DiscardUnknown() {
+	xxx_messageInfo_Organizations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Organizations proto.InternalMessageInfo
+
+func (m *Organizations) GetOrganizations() []*Organization {
+	if m != nil {
+		return m.Organizations
+	}
+	return nil
+}
+
+type Sources struct {
+	Sources              []*Source `protobuf:"bytes,1,rep,name=Sources,proto3" json:"Sources,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *Sources) Reset()         { *m = Sources{} }
+func (m *Sources) String() string { return proto.CompactTextString(m) }
+func (*Sources) ProtoMessage()    {}
+func (*Sources) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{8}
+}
+func (m *Sources) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Sources.Unmarshal(m, b)
+}
+func (m *Sources) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Sources.Marshal(b, m, deterministic)
+}
+func (m *Sources) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Sources.Merge(m, src)
+}
+func (m *Sources) XXX_Size() int {
+	return xxx_messageInfo_Sources.Size(m)
+}
+func (m *Sources) XXX_DiscardUnknown() {
+	xxx_messageInfo_Sources.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Sources proto.InternalMessageInfo
+
+func (m *Sources) GetSources() []*Source {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
+type Dashboards struct {
+	Dashboards           []*Dashboard `protobuf:"bytes,1,rep,name=Dashboards,proto3" json:"Dashboards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *Dashboards) Reset()         { *m = Dashboards{} }
+func (m *Dashboards) String() string { return proto.CompactTextString(m) }
+func (*Dashboards) ProtoMessage()    {}
+func (*Dashboards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{9}
+}
+func (m *Dashboards) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Dashboards.Unmarshal(m, b)
+}
+func (m *Dashboards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Dashboards.Marshal(b, m, deterministic)
+}
+func (m *Dashboards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Dashboards.Merge(m, src)
+}
+func (m *Dashboards) XXX_Size() int {
+	return xxx_messageInfo_Dashboards.Size(m)
+}
+func (m *Dashboards) XXX_DiscardUnknown() {
+	xxx_messageInfo_Dashboards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Dashboards proto.InternalMessageInfo
+
+func (m *Dashboards) GetDashboards() []*Dashboard {
+	if m != nil {
+		return m.Dashboards
+	}
+	return nil
+}
+
+type User struct {
+	ID                   uint64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Provider             string   `protobuf:"bytes,3,opt,name=Provider,proto3" json:"Provider,omitempty"`
+	Scheme               string   `protobuf:"bytes,4,opt,name=Scheme,proto3" json:"Scheme,omitempty"`
+	Roles                []*Role  `protobuf:"bytes,5,rep,name=Roles,proto3" json:"Roles,omitempty"`
+	SuperAdmin           bool     `protobuf:"varint,6,opt,name=SuperAdmin,proto3" json:"SuperAdmin,omitempty"`
+	Status               string   `protobuf:"bytes,7,opt,name=Status,proto3" json:"Status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *User) Reset()         { *m = User{} }
+func (m *User) String() string { return proto.CompactTextString(m) }
+func (*User) ProtoMessage()    {}
+func (*User) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{10}
+}
+func (m *User) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_User.Unmarshal(m, b)
+}
+func (m *User) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_User.Marshal(b, m, deterministic)
+}
+func (m *User) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_User.Merge(m, src)
+}
+func (m *User) XXX_Size() int {
+	return xxx_messageInfo_User.Size(m)
+}
+func (m *User) XXX_DiscardUnknown() {
+	xxx_messageInfo_User.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_User proto.InternalMessageInfo
+
+func (m *User) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *User) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *User) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *User) GetScheme() string {
+	if m != nil {
+		return m.Scheme
+	}
+	return ""
+}
+
+func (m *User) GetRoles() []*Role {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *User) GetSuperAdmin() bool {
+	if m != nil {
+		return m.SuperAdmin
+	}
+	return false
+}
+
+func (m *User) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+type Role struct {
+	Organization         string   `protobuf:"bytes,1,opt,name=Organization,proto3" json:"Organization,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Role) Reset()         { *m = Role{} }
+func (m *Role) String() string { return proto.CompactTextString(m) }
+func (*Role) ProtoMessage()    {}
+func (*Role) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{11}
+}
+func (m *Role) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Role.Unmarshal(m, b)
+}
+func (m *Role) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Role.Marshal(b, m, deterministic)
+}
+func (m *Role) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Role.Merge(m, src)
+}
+func (m *Role) XXX_Size() int {
+	return xxx_messageInfo_Role.Size(m)
+}
+func (m *Role) XXX_DiscardUnknown() {
+	xxx_messageInfo_Role.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Role proto.InternalMessageInfo
+
+func (m *Role) GetOrganization() string {
+	if m != nil {
+		return m.Organization
+	}
+	return ""
+}
+
+func (m *Role) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type Organization struct {
+	ID                   string              `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name                 string              `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	DefaultRole          string              `protobuf:"bytes,3,opt,name=DefaultRole,proto3" json:"DefaultRole,omitempty"`
+	Quotas               *OrganizationQuotas `protobuf:"bytes,4,opt,name=Quotas,proto3" json:"Quotas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *Organization) Reset()         { *m = Organization{} }
+func (m *Organization) String() string { return proto.CompactTextString(m) }
+func (*Organization) ProtoMessage()    {}
+func (*Organization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{12}
+}
+func (m *Organization) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Organization.Unmarshal(m, b)
+}
+func (m *Organization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Organization.Marshal(b, m, deterministic)
+}
+func (m *Organization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Organization.Merge(m, src)
+}
+func (m *Organization) XXX_Size() int {
+	return xxx_messageInfo_Organization.Size(m)
+}
+func (m *Organization) XXX_DiscardUnknown() {
+	xxx_messageInfo_Organization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Organization proto.InternalMessageInfo
+
+func (m *Organization) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *Organization) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Organization) GetDefaultRole() string {
+	if m != nil {
+		return m.DefaultRole
+	}
+	return ""
+}
+
+func (m *Organization) GetQuotas() *OrganizationQuotas {
+	if m != nil {
+		return m.Quotas
+	}
+	return nil
+}
+
+type OrganizationQuotas struct {
+	MaxDashboards        int64    `protobuf:"varint,1,opt,name=MaxDashboards,proto3" json:"MaxDashboards,omitempty"`
+	MaxUsers             int64    `protobuf:"varint,2,opt,name=MaxUsers,proto3" json:"MaxUsers,omitempty"`
+	MaxKapacitorRules    int64    `protobuf:"varint,3,opt,name=MaxKapacitorRules,proto3" json:"MaxKapacitorRules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrganizationQuotas) Reset()         { *m = OrganizationQuotas{} }
+func (m *OrganizationQuotas) String() string { return proto.CompactTextString(m) }
+func (*OrganizationQuotas) ProtoMessage()    {}
+func (*OrganizationQuotas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{13}
+}
+func (m *OrganizationQuotas) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrganizationQuotas.Unmarshal(m, b)
+}
+func (m *OrganizationQuotas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrganizationQuotas.Marshal(b, m, deterministic)
+}
+func (m *OrganizationQuotas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrganizationQuotas.Merge(m, src)
+}
+func (m *OrganizationQuotas) XXX_Size() int {
+	return xxx_messageInfo_OrganizationQuotas.Size(m)
+}
+func (m *OrganizationQuotas) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrganizationQuotas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrganizationQuotas proto.InternalMessageInfo
+
+func (m *OrganizationQuotas) GetMaxDashboards() int64 {
+	if m != nil {
+		return m.MaxDashboards
+	}
+	return 0
+}
+
+func (m *OrganizationQuotas) GetMaxUsers() int64 {
+	if m != nil {
+		return m.MaxUsers
+	}
+	return 0
+}
+
+func (m *OrganizationQuotas) GetMaxKapacitorRules() int64 {
+	if m != nil {
+		return m.MaxKapacitorRules
+	}
+	return 0
+}
+
+type Source struct {
+	ID                   int64    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Type                 string   `protobuf:"bytes,3,opt,name=Type,proto3" json:"Type,omitempty"`
+	Username             string   `protobuf:"bytes,4,opt,name=Username,proto3" json:"Username,omitempty"`
+	Password             string   `protobuf:"bytes,5,opt,name=Password,proto3" json:"Password,omitempty"`
+	URL                  string   `protobuf:"bytes,6,opt,name=URL,proto3" json:"URL,omitempty"`
+	Default              bool     `protobuf:"varint,7,opt,name=Default,proto3" json:"Default,omitempty"`
+	Telegraf             string   `protobuf:"bytes,8,opt,name=Telegraf,proto3" json:"Telegraf,omitempty"`
+	InsecureSkipVerify   bool     `protobuf:"varint,9,opt,name=InsecureSkipVerify,proto3" json:"InsecureSkipVerify,omitempty"`
+	MetaURL              string   `protobuf:"bytes,10,opt,name=MetaURL,proto3" json:"MetaURL,omitempty"`
+	SharedSecret         string   `protobuf:"bytes,11,opt,name=SharedSecret,proto3" json:"SharedSecret,omitempty"`
+	Organization         string   `protobuf:"bytes,12,opt,name=Organization,proto3" json:"Organization,omitempty"`
+	Role                 string   `protobuf:"bytes,13,opt,name=Role,proto3" json:"Role,omitempty"`
+	DefaultRP            string   `protobuf:"bytes,14,opt,name=DefaultRP,proto3" json:"DefaultRP,omitempty"`
+	TLSCA                string   `protobuf:"bytes,15,opt,name=TLSCA,proto3" json:"TLSCA,omitempty"`
+	TLSCert              string   `protobuf:"bytes,16,opt,name=TLSCert,proto3" json:"TLSCert,omitempty"`
+	TLSKey               string   `protobuf:"bytes,17,opt,name=TLSKey,proto3" json:"TLSKey,omitempty"`
+	Discovered           bool     `protobuf:"varint,18,opt,name=Discovered,proto3" json:"Discovered,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Source) Reset()         { *m = Source{} }
+func (m *Source) String() string { return proto.CompactTextString(m) }
+func (*Source) ProtoMessage()    {}
+func (*Source) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{14}
+}
+func (m *Source) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Source.Unmarshal(m, b)
+}
+func (m *Source) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Source.Marshal(b, m, deterministic)
+}
+func (m *Source) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Source.Merge(m, src)
+}
+func (m *Source) XXX_Size() int {
+	return xxx_messageInfo_Source.Size(m)
+}
+func (m *Source) XXX_DiscardUnknown() {
+	xxx_messageInfo_Source.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Source proto.InternalMessageInfo
+
+func (m *Source) GetID() int64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *Source) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Source) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Source) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *Source) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *Source) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *Source) GetDefault() bool {
+	if m != nil {
+		return m.Default
+	}
+	return false
+}
+
+func (m *Source) GetTelegraf() string {
+	if m != nil {
+		return m.Telegraf
+	}
+	return ""
+}
+
+func (m *Source) GetInsecureSkipVerify() bool {
+	if m != nil {
+		return m.InsecureSkipVerify
+	}
+	return false
+}
+
+func (m *Source) GetMetaURL() string {
+	if m != nil {
+		return m.MetaURL
+	}
+	return ""
+}
+
+func (m *Source) GetSharedSecret() string {
+	if m != nil {
+		return m.SharedSecret
+	}
+	return ""
+}
+
+func (m *Source) GetOrganization() string {
+	if m != nil {
+		return m.Organization
+	}
+	return ""
+}
+
+func (m *Source) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *Source) GetDefaultRP() string {
+	if m != nil {
+		return m.DefaultRP
+	}
+	return ""
+}
+
+func (m *Source) GetTLSCA() string {
+	if m != nil {
+		return m.TLSCA
+	}
+	return ""
+}
+
+func (m *Source) GetTLSCert() string {
+	if m != nil {
+		return m.TLSCert
+	}
+	return ""
+}
+
+func (m *Source) GetTLSKey() string {
+	if m != nil {
+		return m.TLSKey
+	}
+	return ""
+}
+
+func (m *Source) GetDiscovered() bool {
+	if m != nil {
+		return m.Discovered
+	}
+	return false
+}
+
+type Dashboard struct {
+	ID                   int64            `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name                 string           `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Cells                []*DashboardCell `protobuf:"bytes,3,rep,name=cells,proto3" json:"cells,omitempty"`
+	Templates            []*Template      `protobuf:"bytes,4,rep,name=templates,proto3" json:"templates,omitempty"`
+	Organization         string           `protobuf:"bytes,5,opt,name=Organization,proto3" json:"Organization,omitempty"`
+	Folder               string           `protobuf:"bytes,6,opt,name=Folder,proto3" json:"Folder,omitempty"`
+	CacheTTL             string           `protobuf:"bytes,7,opt,name=CacheTTL,proto3" json:"CacheTTL,omitempty"`
+	Slug                 string           `protobuf:"bytes,8,opt,name=Slug,proto3" json:"Slug,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *Dashboard) Reset()         { *m = Dashboard{} }
+func (m *Dashboard) String() string { return proto.CompactTextString(m) }
+func (*Dashboard) ProtoMessage()    {}
+func (*Dashboard) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{15}
+}
+func (m *Dashboard) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Dashboard.Unmarshal(m, b)
+}
+func (m *Dashboard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Dashboard.Marshal(b, m, deterministic)
+}
+func (m *Dashboard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Dashboard.Merge(m, src)
+}
+func (m *Dashboard) XXX_Size() int {
+	return xxx_messageInfo_Dashboard.Size(m)
+}
+func (m *Dashboard) XXX_DiscardUnknown() {
+	xxx_messageInfo_Dashboard.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Dashboard proto.InternalMessageInfo
+
+func (m *Dashboard) GetID() int64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *Dashboard) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Dashboard) GetCells() []*DashboardCell {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+func (m *Dashboard) GetTemplates() []*Template {
+	if m != nil {
+		return m.Templates
+	}
+	return nil
+}
+
+func (m *Dashboard) GetOrganization() string {
+	if m != nil {
+		return m.Organization
+	}
+	return ""
+}
+
+func (m *Dashboard) GetFolder() string {
+	if m != nil {
+		return m.Folder
+	}
+	return ""
+}
+
+func (m *Dashboard) GetCacheTTL() string {
+	if m != nil {
+		return m.CacheTTL
+	}
+	return ""
+}
+
+func (m *Dashboard) GetSlug() string {
+	if m != nil {
+		return m.Slug
+	}
+	return ""
+}
+
+type DashboardCell struct {
+	X                    int32             `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y                    int32             `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	W                    int32             `protobuf:"varint,3,opt,name=w,proto3" json:"w,omitempty"`
+	H                    int32             `protobuf:"varint,4,opt,name=h,proto3" json:"h,omitempty"`
+	Queries              []*Query          `protobuf:"bytes,5,rep,name=queries,proto3" json:"queries,omitempty"`
+	Name                 string            `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 string            `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`
+	ID                   string            `protobuf:"bytes,8,opt,name=ID,proto3" json:"ID,omitempty"`
+	Axes                 map[string]*Axis  `protobuf:"bytes,9,rep,name=axes,proto3" json:"axes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Colors               []*Color          `protobuf:"bytes,10,rep,name=colors,proto3" json:"colors,omitempty"`
+	Legend               *Legend           `protobuf:"bytes,11,opt,name=legend,proto3" json:"legend,omitempty"`
+	TableOptions         *TableOptions     `protobuf:"bytes,12,opt,name=tableOptions,proto3" json:"tableOptions,omitempty"`
+	FieldOptions         []*RenamableField `protobuf:"bytes,13,rep,name=fieldOptions,proto3" json:"fieldOptions,omitempty"`
+	TimeFormat           string            `protobuf:"bytes,14,opt,name=timeFormat,proto3" json:"timeFormat,omitempty"`
+	DecimalPlaces        *DecimalPlaces    `protobuf:"bytes,15,opt,name=decimalPlaces,proto3" json:"decimalPlaces,omitempty"`
+	Prefix               string            `protobuf:"bytes,16,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Suffix               string            `protobuf:"bytes,17,opt,name=suffix,proto3" json:"suffix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DashboardCell) Reset()         { *m = DashboardCell{} }
+func (m *DashboardCell) String() string { return proto.CompactTextString(m) }
+func (*DashboardCell) ProtoMessage()    {}
+func (*DashboardCell) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{16}
+}
+func (m *DashboardCell) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardCell.Unmarshal(m, b)
+}
+func (m *DashboardCell) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardCell.Marshal(b, m, deterministic)
+}
+func (m *DashboardCell) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardCell.Merge(m, src)
+}
+func (m *DashboardCell) XXX_Size() int {
+	return xxx_messageInfo_DashboardCell.Size(m)
+}
+func (m *DashboardCell) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardCell.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DashboardCell proto.InternalMessageInfo
+
+func (m *DashboardCell) GetX() int32 {
+	if m != nil {
+		return m.X
+	}
+	return 0
+}
+
+func (m *DashboardCell) GetY() int32 {
+	if m != nil {
+		return m.Y
+	}
+	return 0
+}
+
+func (m *DashboardCell) GetW() int32 {
+	if m != nil {
+		return m.W
+	}
+	return 0
+}
+
+func (m *DashboardCell) GetH() int32 {
+	if m != nil {
+		return m.H
+	}
+	return 0
+}
+
+func (m *DashboardCell) GetQueries() []*Query {
+	if m != nil {
+		return m.Queries
+	}
+	return nil
+}
+
+func (m *DashboardCell) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DashboardCell) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *DashboardCell) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *DashboardCell) GetAxes() map[string]*Axis {
+	if m != nil {
+		return m.Axes
+	}
+	return nil
+}
+
+func (m *DashboardCell) GetColors() []*Color {
+	if m != nil {
+		return m.Colors
+	}
+	return nil
+}
+
+func (m *DashboardCell) GetLegend() *Legend {
+	if m != nil {
+		return m.Legend
+	}
+	return nil
+}
+
+func (m *DashboardCell) GetTableOptions() *TableOptions {
+	if m != nil {
+		return m.TableOptions
+	}
+	return nil
+}
+
+func (m *DashboardCell) GetFieldOptions() []*RenamableField {
+	if m != nil {
+		return m.FieldOptions
+	}
+	return nil
+}
+
+func (m *DashboardCell) GetTimeFormat() string {
+	if m != nil {
+		return m.TimeFormat
+	}
+	return ""
+}
+
+func (m *DashboardCell) GetDecimalPlaces() *DecimalPlaces {
+	if m != nil {
+		return m.DecimalPlaces
+	}
+	return nil
+}
+
+func (m *DashboardCell) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *DashboardCell) GetSuffix() string {
+	if m != nil {
+		return m.Suffix
+	}
+	return ""
+}
+
+type DecimalPlaces struct {
+	IsEnforced           bool     `protobuf:"varint,1,opt,name=isEnforced,proto3" json:"isEnforced,omitempty"`
+	Digits               int32    `protobuf:"varint,2,opt,name=digits,proto3" json:"digits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DecimalPlaces) Reset()         { *m = DecimalPlaces{} }
+func (m *DecimalPlaces) String() string { return proto.CompactTextString(m) }
+func (*DecimalPlaces) ProtoMessage()    {}
+func (*DecimalPlaces) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{17}
+}
+func (m *DecimalPlaces) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecimalPlaces.Unmarshal(m, b)
+}
+func (m *DecimalPlaces) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecimalPlaces.Marshal(b, m, deterministic)
+}
+func (m *DecimalPlaces) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecimalPlaces.Merge(m, src)
+}
+func (m *DecimalPlaces) XXX_Size() int {
+	return xxx_messageInfo_DecimalPlaces.Size(m)
+}
+func (m *DecimalPlaces) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecimalPlaces.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecimalPlaces proto.InternalMessageInfo
+
+func (m *DecimalPlaces) GetIsEnforced() bool {
+	if m != nil {
+		return m.IsEnforced
+	}
+	return false
+}
+
+func (m *DecimalPlaces) GetDigits() int32 {
+	if m != nil {
+		return m.Digits
+	}
+	return 0
+}
+
+type TableOptions struct {
+	VerticalTimeAxis     bool            `protobuf:"varint,2,opt,name=verticalTimeAxis,proto3" json:"verticalTimeAxis,omitempty"`
+	SortBy               *RenamableField `protobuf:"bytes,3,opt,name=sortBy,proto3" json:"sortBy,omitempty"`
+	Wrapping             string          `protobuf:"bytes,4,opt,name=wrapping,proto3" json:"wrapping,omitempty"`
+	FixFirstColumn       bool            `protobuf:"varint,6,opt,name=fixFirstColumn,proto3" json:"fixFirstColumn,omitempty"`
+	PageSize             int32           `protobuf:"varint,7,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *TableOptions) Reset()         { *m = TableOptions{} }
+func (m *TableOptions) String() string { return proto.CompactTextString(m) }
+func (*TableOptions) ProtoMessage()    {}
+func (*TableOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{18}
+}
+func (m *TableOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TableOptions.Unmarshal(m, b)
+}
+func (m *TableOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TableOptions.Marshal(b, m, deterministic)
+}
+func (m *TableOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TableOptions.Merge(m, src)
+}
+func (m *TableOptions) XXX_Size() int {
+	return xxx_messageInfo_TableOptions.Size(m)
+}
+func (m *TableOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_TableOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TableOptions proto.InternalMessageInfo
+
+func (m *TableOptions) GetVerticalTimeAxis() bool {
+	if m != nil {
+		return m.VerticalTimeAxis
+	}
+	return false
+}
+
+func (m *TableOptions) GetSortBy() *RenamableField {
+	if m != nil {
+		return m.SortBy
+	}
+	return nil
+}
+
+func (m *TableOptions) GetWrapping() string {
+	if m != nil {
+		return m.Wrapping
+	}
+	return ""
+}
+
+func (m *TableOptions) GetFixFirstColumn() bool {
+	if m != nil {
+		return m.FixFirstColumn
+	}
+	return false
+}
+
+func (m *TableOptions) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+type RenamableField struct {
+	InternalName         string   `protobuf:"bytes,1,opt,name=internalName,proto3" json:"internalName,omitempty"`
+	DisplayName          string   `protobuf:"bytes,2,opt,name=displayName,proto3" json:"displayName,omitempty"`
+	Visible              bool     `protobuf:"varint,3,opt,name=visible,proto3" json:"visible,omitempty"`
+	Format               string   `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+	Align                string   `protobuf:"bytes,5,opt,name=align,proto3" json:"align,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RenamableField) Reset()         { *m = RenamableField{} }
+func (m *RenamableField) String() string { return proto.CompactTextString(m) }
+func (*RenamableField) ProtoMessage()    {}
+func (*RenamableField) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{19}
+}
+func (m *RenamableField) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RenamableField.Unmarshal(m, b)
+}
+func (m *RenamableField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RenamableField.Marshal(b, m, deterministic)
+}
+func (m *RenamableField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenamableField.Merge(m, src)
+}
+func (m *RenamableField) XXX_Size() int {
+	return xxx_messageInfo_RenamableField.Size(m)
+}
+func (m *RenamableField) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenamableField.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenamableField proto.InternalMessageInfo
+
+func (m *RenamableField) GetInternalName() string {
+	if m != nil {
+		return m.InternalName
+	}
+	return ""
+}
+
+func (m *RenamableField) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *RenamableField) GetVisible() bool {
+	if m != nil {
+		return m.Visible
+	}
+	return false
+}
+
+func (m *RenamableField) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *RenamableField) GetAlign() string {
+	if m != nil {
+		return m.Align
+	}
+	return ""
+}
+
+type Color struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Type                 string   `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	Hex                  string   `protobuf:"bytes,3,opt,name=Hex,proto3" json:"Hex,omitempty"`
+	Name                 string   `protobuf:"bytes,4,opt,name=Name,proto3" json:"Name,omitempty"`
+	Value                string   `protobuf:"bytes,5,opt,name=Value,proto3" json:"Value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Color) Reset()         { *m = Color{} }
+func (m *Color) String() string { return proto.CompactTextString(m) }
+func (*Color) ProtoMessage()    {}
+func (*Color) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{20}
+}
+func (m *Color) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Color.Unmarshal(m, b)
+}
+func (m *Color) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Color.Marshal(b, m, deterministic)
+}
+func (m *Color) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Color.Merge(m, src)
+}
+func (m *Color) XXX_Size() int {
+	return xxx_messageInfo_Color.Size(m)
+}
+func (m *Color) XXX_DiscardUnknown() {
+	xxx_messageInfo_Color.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Color proto.InternalMessageInfo
+
+func (m *Color) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *Color) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Color) GetHex() string {
+	if m != nil {
+		return m.Hex
+	}
+	return ""
+}
+
+func (m *Color) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Color) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type Legend struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty"`
+	Orientation          string   `protobuf:"bytes,2,opt,name=Orientation,proto3" json:"Orientation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Legend) Reset()         { *m = Legend{} }
+func (m *Legend) String() string { return proto.CompactTextString(m) }
+func (*Legend) ProtoMessage()    {}
+func (*Legend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{21}
+}
+func (m *Legend) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Legend.Unmarshal(m, b)
+}
+func (m *Legend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Legend.Marshal(b, m, deterministic)
+}
+func (m *Legend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Legend.Merge(m, src)
+}
+func (m *Legend) XXX_Size() int {
+	return xxx_messageInfo_Legend.Size(m)
+}
+func (m *Legend) XXX_DiscardUnknown() {
+	xxx_messageInfo_Legend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Legend proto.InternalMessageInfo
+
+func (m *Legend) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Legend) GetOrientation() string {
+	if m != nil {
+		return m.Orientation
+	}
+	return ""
+}
+
+type Axis struct {
+	LegacyBounds         []int64  `protobuf:"varint,1,rep,packed,name=legacyBounds,proto3" json:"legacyBounds,omitempty"`
+	Bounds               []string `protobuf:"bytes,2,rep,name=bounds,proto3" json:"bounds,omitempty"`
+	Label                string   `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Prefix               string   `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Suffix               string   `protobuf:"bytes,5,opt,name=suffix,proto3" json:"suffix,omitempty"`
+	Base                 string   `protobuf:"bytes,6,opt,name=base,proto3" json:"base,omitempty"`
+	Scale                string   `protobuf:"bytes,7,opt,name=scale,proto3" json:"scale,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Axis) Reset()         { *m = Axis{} }
+func (m *Axis) String() string { return proto.CompactTextString(m) }
+func (*Axis) ProtoMessage()    {}
+func (*Axis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{22}
+}
+func (m *Axis) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Axis.Unmarshal(m, b)
+}
+func (m *Axis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Axis.Marshal(b, m, deterministic)
+}
+func (m *Axis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Axis.Merge(m, src)
+}
+func (m *Axis) XXX_Size() int {
+	return xxx_messageInfo_Axis.Size(m)
+}
+func (m *Axis) XXX_DiscardUnknown() {
+	xxx_messageInfo_Axis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Axis proto.InternalMessageInfo
+
+func (m *Axis) GetLegacyBounds() []int64 {
+	if m != nil {
+		return m.LegacyBounds
+	}
+	return nil
+}
+
+func (m *Axis) GetBounds() []string {
+	if m != nil {
+		return m.Bounds
+	}
+	return nil
+}
+
+func (m *Axis) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *Axis) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *Axis) GetSuffix() string {
+	if m != nil {
+		return m.Suffix
+	}
+	return ""
+}
+
+func (m *Axis) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *Axis) GetScale() string {
+	if m != nil {
+		return m.Scale
+	}
+	return ""
+}
+
+type Template struct {
+	ID                   string           `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	TempVar              string           `protobuf:"bytes,2,opt,name=temp_var,json=tempVar,proto3" json:"temp_var,omitempty"`
+	Values               []*TemplateValue `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	Type                 string           `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Label                string           `protobuf:"bytes,5,opt,name=label,proto3" json:"label,omitempty"`
+	Query                *TemplateQuery   `protobuf:"bytes,6,opt,name=query,proto3" json:"query,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *Template) Reset()         { *m = Template{} }
+func (m *Template) String() string { return proto.CompactTextString(m) }
+func (*Template) ProtoMessage()    {}
+func (*Template) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{23}
+}
+func (m *Template) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Template.Unmarshal(m, b)
+}
+func (m *Template) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Template.Marshal(b, m, deterministic)
+}
+func (m *Template) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Template.Merge(m, src)
+}
+func (m *Template) XXX_Size() int {
+	return xxx_messageInfo_Template.Size(m)
+}
+func (m *Template) XXX_DiscardUnknown() {
+	xxx_messageInfo_Template.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Template proto.InternalMessageInfo
+
+func (m *Template) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *Template) GetTempVar() string {
+	if m != nil {
+		return m.TempVar
+	}
+	return ""
+}
+
+func (m *Template) GetValues() []*TemplateValue {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *Template) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Template) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *Template) GetQuery() *TemplateQuery {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+type TemplateValue struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Selected             bool     `protobuf:"varint,3,opt,name=selected,proto3" json:"selected,omitempty"`
+	Key                  string   `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TemplateValue) Reset()         { *m = TemplateValue{} }
+func (m *TemplateValue) String() string { return proto.CompactTextString(m) }
+func (*TemplateValue) ProtoMessage()    {}
+func (*TemplateValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{24}
+}
+func (m *TemplateValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TemplateValue.Unmarshal(m, b)
+}
+func (m *TemplateValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TemplateValue.Marshal(b, m, deterministic)
+}
+func (m *TemplateValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TemplateValue.Merge(m, src)
+}
+func (m *TemplateValue) XXX_Size() int {
+	return xxx_messageInfo_TemplateValue.Size(m)
+}
+func (m *TemplateValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_TemplateValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TemplateValue proto.InternalMessageInfo
+
+func (m *TemplateValue) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *TemplateValue) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *TemplateValue) GetSelected() bool {
+	if m != nil {
+		return m.Selected
+	}
+	return false
+}
+
+func (m *TemplateValue) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type TemplateQuery struct {
+	Command              string   `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
+	Db                   string   `protobuf:"bytes,2,opt,name=db,proto3" json:"db,omitempty"`
+	Rp                   string   `protobuf:"bytes,3,opt,name=rp,proto3" json:"rp,omitempty"`
+	Measurement          string   `protobuf:"bytes,4,opt,name=measurement,proto3" json:"measurement,omitempty"`
+	TagKey               string   `protobuf:"bytes,5,opt,name=tag_key,json=tagKey,proto3" json:"tag_key,omitempty"`
+	FieldKey             string   `protobuf:"bytes,6,opt,name=field_key,json=fieldKey,proto3" json:"field_key,omitempty"`
+	Flux                 string   `protobuf:"bytes,7,opt,name=flux,proto3" json:"flux,omitempty"`
+	Url                  string   `protobuf:"bytes,8,opt,name=url,proto3" json:"url,omitempty"`
+	Format               string   `protobuf:"bytes,9,opt,name=format,proto3" json:"format,omitempty"`
+	Column               string   `protobuf:"bytes,10,opt,name=column,proto3" json:"column,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TemplateQuery) Reset()         { *m = TemplateQuery{} }
+func (m *TemplateQuery) String() string { return proto.CompactTextString(m) }
+func (*TemplateQuery) ProtoMessage()    {}
+func (*TemplateQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{25}
+}
+func (m *TemplateQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TemplateQuery.Unmarshal(m, b)
+}
+func (m *TemplateQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TemplateQuery.Marshal(b, m, deterministic)
+}
+func (m *TemplateQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TemplateQuery.Merge(m, src)
+}
+func (m *TemplateQuery) XXX_Size() int {
+	return xxx_messageInfo_TemplateQuery.Size(m)
+}
+func (m *TemplateQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_TemplateQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TemplateQuery proto.InternalMessageInfo
+
+func (m *TemplateQuery) GetCommand() string {
+	if m != nil {
+		return m.Command
+	}
+	return ""
+}
+
+func (m *TemplateQuery) GetDb() string {
+	if m != nil {
+		return m.Db
+	}
+	return ""
+}
+
+func (m *TemplateQuery) GetRp() string {
+	if m != nil {
+		return m.Rp
+	}
+	return ""
+}
+
+func (m *TemplateQuery) GetMeasurement() string {
+	if m != nil {
+		return m.Measurement
+	}
+	return ""
+}
+
+func (m *TemplateQuery) GetTagKey() string {
+	if m != nil {
+		return m.TagKey
+	}
+	return ""
+}
+
+func (m *TemplateQuery) GetFieldKey() string {
+	if m != nil {
+		return m.FieldKey
+	}
+	return ""
+}
+
+func (m *TemplateQuery) GetFlux() string {
+	if m != nil {
+		return m.Flux
+	}
+	return ""
+}
+
+func (m *TemplateQuery) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *TemplateQuery) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *TemplateQuery) GetColumn() string {
+	if m != nil {
+		return m.Column
+	}
+	return ""
+}
+
+type Query struct {
+	Command              string       `protobuf:"bytes,1,opt,name=Command,proto3" json:"Command,omitempty"`
+	DB                   string       `protobuf:"bytes,2,opt,name=DB,proto3" json:"DB,omitempty"`
+	RP                   string       `protobuf:"bytes,3,opt,name=RP,proto3" json:"RP,omitempty"`
+	GroupBys             []string     `protobuf:"bytes,4,rep,name=GroupBys,proto3" json:"GroupBys,omitempty"`
+	Wheres               []string     `protobuf:"bytes,5,rep,name=Wheres,proto3" json:"Wheres,omitempty"`
+	Label                string       `protobuf:"bytes,6,opt,name=Label,proto3" json:"Label,omitempty"`
+	Range                *Range       `protobuf:"bytes,7,opt,name=Range,proto3" json:"Range,omitempty"`
+	Source               string       `protobuf:"bytes,8,opt,name=Source,proto3" json:"Source,omitempty"`
+	Shifts               []*TimeShift `protobuf:"bytes,9,rep,name=Shifts,proto3" json:"Shifts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *Query) Reset()         { *m = Query{} }
+func (m *Query) String() string { return proto.CompactTextString(m) }
+func (*Query) ProtoMessage()    {}
+func (*Query) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{26}
+}
+func (m *Query) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Query.Unmarshal(m, b)
+}
+func (m *Query) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Query.Marshal(b, m, deterministic)
+}
+func (m *Query) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Query.Merge(m, src)
+}
+func (m *Query) XXX_Size() int {
+	return xxx_messageInfo_Query.Size(m)
+}
+func (m *Query) XXX_DiscardUnknown() {
+	xxx_messageInfo_Query.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Query proto.InternalMessageInfo
+
+func (m *Query) GetCommand() string {
+	if m != nil {
+		return m.Command
+	}
+	return ""
+}
+
+func (m *Query) GetDB() string {
+	if m != nil {
+		return m.DB
+	}
+	return ""
+}
+
+func (m *Query) GetRP() string {
+	if m != nil {
+		return m.RP
+	}
+	return ""
+}
+
+func (m *Query) GetGroupBys() []string {
+	if m != nil {
+		return m.GroupBys
+	}
+	return nil
+}
+
+func (m *Query) GetWheres() []string {
+	if m != nil {
+		return m.Wheres
+	}
+	return nil
+}
+
+func (m *Query) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *Query) GetRange() *Range {
+	if m != nil {
+		return m.Range
+	}
+	return nil
+}
+
+func (m *Query) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *Query) GetShifts() []*TimeShift {
+	if m != nil {
+		return m.Shifts
+	}
+	return nil
+}
+
+type TimeShift struct {
+	Label                string   `protobuf:"bytes,1,opt,name=Label,proto3" json:"Label,omitempty"`
+	Unit                 string   `protobuf:"bytes,2,opt,name=Unit,proto3" json:"Unit,omitempty"`
+	Quantity             string   `protobuf:"bytes,3,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TimeShift) Reset()         { *m = TimeShift{} }
+func (m *TimeShift) String() string { return proto.CompactTextString(m) }
+func (*TimeShift) ProtoMessage()    {}
+func (*TimeShift) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{27}
+}
+func (m *TimeShift) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TimeShift.Unmarshal(m, b)
+}
+func (m *TimeShift) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TimeShift.Marshal(b, m, deterministic)
+}
+func (m *TimeShift) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeShift.Merge(m, src)
+}
+func (m *TimeShift) XXX_Size() int {
+	return xxx_messageInfo_TimeShift.Size(m)
+}
+func (m *TimeShift) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeShift.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeShift proto.InternalMessageInfo
+
+func (m *TimeShift) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *TimeShift) GetUnit() string {
+	if m != nil {
+		return m.Unit
+	}
+	return ""
+}
+
+func (m *TimeShift) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
+}
+
+type Range struct {
+	Upper                int64    `protobuf:"varint,1,opt,name=Upper,proto3" json:"Upper,omitempty"`
+	Lower                int64    `protobuf:"varint,2,opt,name=Lower,proto3" json:"Lower,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Range) Reset()         { *m = Range{} }
+func (m *Range) String() string { return proto.CompactTextString(m) }
+func (*Range) ProtoMessage()    {}
+func (*Range) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{28}
+}
+func (m *Range) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Range.Unmarshal(m, b)
+}
+func (m *Range) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Range.Marshal(b, m, deterministic)
+}
+func (m *Range) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Range.Merge(m, src)
+}
+func (m *Range) XXX_Size() int {
+	return xxx_messageInfo_Range.Size(m)
+}
+func (m *Range) XXX_DiscardUnknown() {
+	xxx_messageInfo_Range.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Range proto.InternalMessageInfo
+
+func (m *Range) GetUpper() int64 {
+	if m != nil {
+		return m.Upper
+	}
+	return 0
+}
+
+func (m *Range) GetLower() int64 {
+	if m != nil {
+		return m.Lower
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Empty)(nil), "admin.Empty")
+	proto.RegisterType((*ListRequest)(nil), "admin.ListRequest")
+	proto.RegisterType((*UserRequest)(nil), "admin.UserRequest")
+	proto.RegisterType((*OrganizationRequest)(nil), "admin.OrganizationRequest")
+	proto.RegisterType((*SourceRequest)(nil), "admin.SourceRequest")
+	proto.RegisterType((*DashboardRequest)(nil), "admin.DashboardRequest")
+	proto.RegisterType((*Users)(nil), "admin.Users")
+	proto.RegisterType((*Organizations)(nil), "admin.Organizations")
+	proto.RegisterType((*Sources)(nil), "admin.Sources")
+	proto.RegisterType((*Dashboards)(nil), "admin.Dashboards")
+	proto.RegisterType((*User)(nil), "admin.User")
+	proto.RegisterType((*Role)(nil), "admin.Role")
+	proto.RegisterType((*Organization)(nil), "admin.Organization")
+	proto.RegisterType((*OrganizationQuotas)(nil), "admin.OrganizationQuotas")
+	proto.RegisterType((*Source)(nil), "admin.Source")
+	proto.RegisterType((*Dashboard)(nil), "admin.Dashboard")
+	proto.RegisterType((*DashboardCell)(nil), "admin.DashboardCell")
+	proto.RegisterMapType((map[string]*Axis)(nil), "admin.DashboardCell.AxesEntry")
+	proto.RegisterType((*DecimalPlaces)(nil), "admin.DecimalPlaces")
+	proto.RegisterType((*TableOptions)(nil), "admin.TableOptions")
+	proto.RegisterType((*RenamableField)(nil), "admin.RenamableField")
+	proto.RegisterType((*Color)(nil), "admin.Color")
+	proto.RegisterType((*Legend)(nil), "admin.Legend")
+	proto.RegisterType((*Axis)(nil), "admin.Axis")
+	proto.RegisterType((*Template)(nil), "admin.Template")
+	proto.RegisterType((*TemplateValue)(nil), "admin.TemplateValue")
+	proto.RegisterType((*TemplateQuery)(nil), "admin.TemplateQuery")
+	proto.RegisterType((*Query)(nil), "admin.Query")
+	proto.RegisterType((*TimeShift)(nil), "admin.TimeShift")
+	proto.RegisterType((*Range)(nil), "admin.Range")
+}
+
+func init() { proto.RegisterFile("admin.proto", fileDescriptor_73a7fc70dcc2027c) }
+
+var fileDescriptor_73a7fc70dcc2027c = []byte{
+	// 1903 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x5f, 0x6f, 0xdb, 0xc8,
+	0x11, 0x07, 0x25, 0x51, 0x96, 0x46, 0x92, 0xed, 0xec, 0xa5, 0x39, 0x9e, 0xdb, 0x5e, 0x5d, 0xe2,
+	0x2e, 0x0d, 0x82, 0xc4, 0xe8, 0xf9, 0x50, 0xdc, 0x9f, 0x87, 0x00, 0xb1, 0x95, 0xb8, 0xc9, 0x39,
+	0x8d, 0xb3, 0x92, 0xd3, 0xc7, 0xc3, 0x4a, 0x5c, 0xc9, 0xc4, 0x51, 0x24, 0x6f, 0xb9, 0xb4, 0xa5,
+	0x7b, 0x2e, 0xd0, 0x6f, 0xd1, 0xcf, 0x50, 0xf4, 0xad, 0x1f, 0xa4, 0xdf, 0xa2, 0x28, 0xd0, 0x97,
+	0xf6, 0xb5, 0x98, 0xfd, 0x43, 0x91, 0x12, 0xdd, 0xba, 0xf7, 0xb6, 0xbf, 0x99, 0xd9, 0xdd, 0x99,
+	0xd9, 0xf9, 0xb3, 0xbb, 0xd0, 0x63, 0xc1, 0x22, 0x8c, 0x8f, 0x52, 0x91, 0xc8, 0x84, 0xb8, 0x0a,
+	0xf8, 0x3b, 0xe0, 0xbe, 0x58, 0xa4, 0x72, 0xe5, 0x7f, 0x06, 0xbd, 0xf3, 0x30, 0x93, 0x94, 0x7f,
+	0x9f, 0xf3, 0x4c, 0x12, 0x1f, 0xfa, 0x6f, 0xc5, 0x9c, 0xc5, 0xe1, 0x0f, 0x4c, 0x86, 0x49, 0xec,
+	0x39, 0x87, 0xce, 0xa3, 0x2e, 0xad, 0xd0, 0xfc, 0x9f, 0x43, 0xef, 0x32, 0xe3, 0xc2, 0x4e, 0xd9,
+	0x85, 0xc6, 0xab, 0xa1, 0x12, 0x6c, 0xd1, 0xc6, 0xab, 0xa1, 0xff, 0x29, 0x7c, 0x50, 0x16, 0xdf,
+	0x16, 0xeb, 0x2a, 0xb1, 0x5f, 0xc0, 0x60, 0x94, 0xe4, 0x62, 0xca, 0xb7, 0x05, 0x9a, 0x4a, 0xc0,
+	0x87, 0xfd, 0x21, 0xcb, 0xae, 0x26, 0x09, 0x13, 0xc1, 0x6d, 0x32, 0x8f, 0xc1, 0x45, 0x55, 0x32,
+	0xf2, 0x4b, 0x33, 0xf0, 0x9c, 0xc3, 0xe6, 0xa3, 0xde, 0x71, 0xef, 0x48, 0xdb, 0xac, 0xf4, 0xd4,
+	0x1c, 0xff, 0x35, 0x0c, 0xca, 0x7a, 0x65, 0xe4, 0xab, 0x0d, 0x82, 0x99, 0xfb, 0x81, 0x99, 0x5b,
+	0x31, 0xa2, 0x2a, 0xe9, 0x1f, 0xc3, 0x8e, 0x56, 0x3e, 0x23, 0xbf, 0x2a, 0x86, 0x66, 0xfe, 0xc0,
+	0xcc, 0x37, 0xd6, 0x59, 0xae, 0xff, 0x0c, 0xa0, 0xb0, 0x27, 0x23, 0xbf, 0x2e, 0x23, 0x33, 0x73,
+	0xdf, 0xcc, 0x5c, 0x9b, 0x5d, 0x92, 0xf1, 0xff, 0xea, 0x40, 0x0b, 0x2d, 0xd9, 0x74, 0x38, 0x21,
+	0xd0, 0xfa, 0x1d, 0x5b, 0x70, 0xaf, 0xa1, 0x7c, 0xab, 0xc6, 0xe4, 0x00, 0x3a, 0x17, 0x22, 0xb9,
+	0x0e, 0x03, 0x2e, 0xbc, 0xa6, 0xa2, 0x17, 0x98, 0x3c, 0x80, 0xf6, 0x68, 0x7a, 0xc5, 0x17, 0xdc,
+	0x6b, 0x29, 0x8e, 0x41, 0xe8, 0x43, 0x9a, 0x44, 0x3c, 0xf3, 0xdc, 0x8a, 0x0f, 0x91, 0x46, 0x35,
+	0x87, 0x7c, 0x0c, 0x30, 0xca, 0x53, 0x2e, 0x9e, 0x23, 0xc7, 0x6b, 0x1f, 0x3a, 0x8f, 0x3a, 0xb4,
+	0x44, 0x51, 0x4b, 0x4b, 0x26, 0xf3, 0xcc, 0xdb, 0x31, 0x4b, 0x2b, 0xe4, 0x3f, 0x83, 0x16, 0x2e,
+	0x70, 0x97, 0xf0, 0xaa, 0x33, 0xc7, 0xff, 0xa3, 0x53, 0x9d, 0xb8, 0x19, 0x4d, 0xb5, 0x3e, 0x38,
+	0x84, 0xde, 0x90, 0xcf, 0x58, 0x1e, 0x49, 0xdc, 0xdb, 0xb8, 0xa1, 0x4c, 0x22, 0x9f, 0x41, 0xfb,
+	0x5d, 0x9e, 0x48, 0x96, 0x29, 0x4f, 0xf4, 0x8e, 0x3f, 0xaa, 0x39, 0x7a, 0x2d, 0x40, 0x8d, 0xa0,
+	0xff, 0x07, 0x07, 0xc8, 0x36, 0x9b, 0x7c, 0x02, 0x83, 0x37, 0x6c, 0x59, 0x39, 0x51, 0x8c, 0xd1,
+	0x2a, 0x11, 0x4f, 0xe5, 0x0d, 0x5b, 0xea, 0x40, 0x6d, 0x28, 0x81, 0x02, 0x93, 0x27, 0x70, 0xef,
+	0x0d, 0x5b, 0x7e, 0xc3, 0x52, 0x36, 0x0d, 0x65, 0x22, 0x68, 0x8e, 0x27, 0xd1, 0x54, 0x42, 0xdb,
+	0x0c, 0xff, 0xef, 0x4d, 0x68, 0xeb, 0xc0, 0xda, 0xcc, 0x89, 0x5a, 0x57, 0x10, 0x68, 0x8d, 0x57,
+	0xa9, 0xf5, 0x81, 0x1a, 0xa3, 0x32, 0xb8, 0x73, 0xcc, 0x8a, 0x40, 0x28, 0xb0, 0x0a, 0x1f, 0x96,
+	0x65, 0x37, 0x89, 0x08, 0x3c, 0xd7, 0x84, 0x8f, 0xc1, 0x64, 0x1f, 0x9a, 0x97, 0xf4, 0x5c, 0x1d,
+	0x7e, 0x97, 0xe2, 0x90, 0x78, 0xb0, 0x63, 0xbc, 0xaa, 0x8e, 0xbd, 0x43, 0x2d, 0xc4, 0x75, 0xc6,
+	0x3c, 0xe2, 0x73, 0xc1, 0x66, 0x5e, 0x47, 0xaf, 0x63, 0x31, 0x39, 0x02, 0xf2, 0x2a, 0xce, 0xf8,
+	0x34, 0x17, 0x7c, 0xf4, 0x5d, 0x98, 0xbe, 0xe7, 0x22, 0x9c, 0xad, 0xbc, 0xae, 0x5a, 0xa0, 0x86,
+	0x83, 0xbb, 0xbc, 0xe1, 0x92, 0xe1, 0xde, 0xa0, 0x96, 0xb2, 0x10, 0xa3, 0x6a, 0x74, 0xc5, 0x04,
+	0x0f, 0x46, 0x7c, 0x2a, 0xb8, 0xf4, 0x7a, 0x3a, 0xaa, 0xca, 0xb4, 0xad, 0xc8, 0xeb, 0xd7, 0x47,
+	0x9e, 0x8a, 0x94, 0x81, 0xf6, 0x12, 0x8e, 0xc9, 0xcf, 0xa0, 0x6b, 0x23, 0xe6, 0xc2, 0xdb, 0x55,
+	0x8c, 0x35, 0x81, 0xdc, 0x07, 0x77, 0x7c, 0x3e, 0x3a, 0x7d, 0xee, 0xed, 0x29, 0x8e, 0x06, 0xa8,
+	0x29, 0x0e, 0xb8, 0x90, 0xde, 0xbe, 0xd6, 0xd4, 0x40, 0xcc, 0x8f, 0xf1, 0xf9, 0xe8, 0x1b, 0xbe,
+	0xf2, 0xee, 0xe9, 0xfc, 0xd0, 0x08, 0xf3, 0x6a, 0x18, 0x66, 0xd3, 0xe4, 0x9a, 0x0b, 0x1e, 0x78,
+	0x44, 0xe7, 0xd5, 0x9a, 0xe2, 0xff, 0xcb, 0x81, 0x6e, 0x11, 0x47, 0x77, 0x3a, 0xf1, 0xc7, 0xe0,
+	0x4e, 0x79, 0x14, 0x61, 0x08, 0x61, 0x32, 0xdf, 0xdf, 0x2c, 0x2d, 0xa7, 0x3c, 0x8a, 0xa8, 0x16,
+	0x21, 0x4f, 0xa1, 0x2b, 0xf9, 0x22, 0x8d, 0x98, 0xe4, 0x98, 0x09, 0x28, 0xbf, 0x67, 0xe4, 0xc7,
+	0x86, 0x4e, 0xd7, 0x12, 0x5b, 0xae, 0x74, 0x6b, 0x5c, 0xf9, 0x00, 0xda, 0x2f, 0x93, 0x08, 0xab,
+	0x8f, 0x8e, 0x13, 0x83, 0x30, 0x20, 0x4e, 0xd9, 0xf4, 0x8a, 0x8f, 0xc7, 0xe7, 0xa6, 0x44, 0x14,
+	0x18, 0xcd, 0x18, 0x45, 0xf9, 0xdc, 0x04, 0x8a, 0x1a, 0xfb, 0xff, 0x68, 0xc1, 0xa0, 0xa2, 0x33,
+	0xe9, 0x83, 0xb3, 0x54, 0xb6, 0xbb, 0xd4, 0x59, 0x22, 0x5a, 0x29, 0xbb, 0x5d, 0xea, 0xac, 0x10,
+	0xdd, 0xa8, 0x18, 0x77, 0xa9, 0x73, 0x83, 0xe8, 0x4a, 0x45, 0xb6, 0x4b, 0x9d, 0x2b, 0xf2, 0x10,
+	0x76, 0xbe, 0xcf, 0xb9, 0x08, 0x8b, 0xfa, 0xd6, 0x37, 0x26, 0xbe, 0xcb, 0xb9, 0x58, 0x51, 0xcb,
+	0x44, 0x2d, 0x54, 0x4a, 0x68, 0xbd, 0xd5, 0x18, 0x69, 0x12, 0xd3, 0x47, 0x6b, 0xac, 0xc6, 0xe6,
+	0x10, 0x3a, 0x45, 0x05, 0x3a, 0x86, 0x16, 0x5b, 0xf2, 0xcc, 0xeb, 0xaa, 0xc5, 0x3f, 0xae, 0xf3,
+	0xf7, 0xd1, 0xf3, 0x25, 0xcf, 0x5e, 0xc4, 0x52, 0xac, 0xa8, 0x92, 0x25, 0x9f, 0x40, 0x7b, 0x9a,
+	0x44, 0x89, 0xc8, 0x3c, 0xa8, 0xa8, 0x74, 0x8a, 0x44, 0x6a, 0x78, 0xe4, 0x53, 0x68, 0x47, 0x7c,
+	0xce, 0xe3, 0x40, 0x05, 0xf6, 0xba, 0xc1, 0x9c, 0x2b, 0x22, 0x35, 0x4c, 0xf2, 0x05, 0xf4, 0x25,
+	0x9b, 0x44, 0xfc, 0x6d, 0xaa, 0xbb, 0x59, 0xff, 0xd0, 0x29, 0x75, 0xb3, 0x71, 0x89, 0x45, 0x2b,
+	0x82, 0xe4, 0x2b, 0xe8, 0xcf, 0x42, 0x1e, 0x05, 0x76, 0xe2, 0x40, 0xe9, 0xf2, 0x13, 0x33, 0x91,
+	0xf2, 0x98, 0x2d, 0x50, 0xfc, 0x25, 0xca, 0xd0, 0x8a, 0x28, 0xc6, 0xad, 0x0c, 0x17, 0xfc, 0x65,
+	0x22, 0x16, 0x4c, 0x9a, 0xf4, 0x28, 0x51, 0xc8, 0xd7, 0x30, 0x08, 0xf8, 0x34, 0x5c, 0xb0, 0xe8,
+	0x22, 0x62, 0xd8, 0x22, 0xf7, 0x0e, 0x9d, 0x72, 0x34, 0x96, 0x79, 0xb4, 0x2a, 0x8a, 0x21, 0x94,
+	0x0a, 0x3e, 0x0b, 0x97, 0x26, 0x89, 0x0c, 0x42, 0x7a, 0x96, 0xcf, 0x90, 0x6e, 0x72, 0x48, 0xa3,
+	0x83, 0x21, 0x74, 0x0b, 0xff, 0x62, 0x91, 0xfa, 0x8e, 0xaf, 0x4c, 0x83, 0xc0, 0x21, 0x76, 0xb7,
+	0x6b, 0x16, 0xe5, 0x3a, 0x4b, 0xd6, 0xdd, 0xed, 0xf9, 0x32, 0xcc, 0xa8, 0xe6, 0x7c, 0xdd, 0xf8,
+	0xd2, 0xf1, 0xcf, 0x60, 0x50, 0xd1, 0x0a, 0x4d, 0x0c, 0xb3, 0x17, 0xf1, 0x2c, 0x11, 0x53, 0x1e,
+	0xa8, 0x05, 0x3b, 0xb4, 0x44, 0x41, 0x75, 0x82, 0x70, 0x1e, 0xca, 0xcc, 0x84, 0xa1, 0x41, 0xfe,
+	0xdf, 0x1c, 0xe8, 0x97, 0x9d, 0x4e, 0x1e, 0xc3, 0xfe, 0x35, 0x17, 0x32, 0x9c, 0xb2, 0x68, 0x1c,
+	0x2e, 0x38, 0x6e, 0xac, 0xa6, 0x74, 0xe8, 0x16, 0x9d, 0x3c, 0x85, 0x76, 0x96, 0x08, 0x79, 0xb2,
+	0x52, 0xd1, 0x7c, 0xeb, 0x61, 0x18, 0x21, 0xcc, 0xaa, 0x1b, 0xc1, 0xd2, 0x34, 0x8c, 0xe7, 0xb6,
+	0x94, 0x5b, 0x4c, 0x1e, 0xc2, 0xee, 0x2c, 0x5c, 0xbe, 0x0c, 0x45, 0x26, 0x4f, 0x93, 0x28, 0x5f,
+	0xd8, 0xb6, 0xbd, 0x41, 0xc5, 0x35, 0x52, 0x36, 0xe7, 0xa3, 0xf0, 0x07, 0x1d, 0xe7, 0x2e, 0x2d,
+	0xf0, 0xeb, 0x56, 0xc7, 0xd9, 0x6f, 0xbc, 0x6e, 0x75, 0xdc, 0xfd, 0xb6, 0xff, 0x27, 0x07, 0x76,
+	0xab, 0x6a, 0x60, 0x41, 0x08, 0x63, 0x89, 0xad, 0x23, 0x52, 0x75, 0xc8, 0x74, 0xf5, 0x32, 0x0d,
+	0x9b, 0x71, 0x10, 0x66, 0x69, 0xc4, 0x56, 0xa5, 0x52, 0x55, 0x26, 0x61, 0xd5, 0xbc, 0x0e, 0xb3,
+	0x70, 0x62, 0x5a, 0x75, 0x87, 0x5a, 0x88, 0x2e, 0x9e, 0xe9, 0x08, 0x33, 0x17, 0x16, 0x8d, 0xb0,
+	0xfa, 0xb2, 0x28, 0x9c, 0xdb, 0x0a, 0xa4, 0x81, 0x3f, 0x07, 0x57, 0xe5, 0x4f, 0xdd, 0x1d, 0x41,
+	0x35, 0xc1, 0x46, 0xa9, 0x09, 0xee, 0x43, 0xf3, 0xb7, 0x7c, 0x69, 0xfa, 0x22, 0x0e, 0x8b, 0x62,
+	0xda, 0x2a, 0x15, 0xd3, 0xfb, 0xe0, 0xbe, 0x57, 0xb1, 0x63, 0x36, 0x52, 0xc0, 0x7f, 0x06, 0x6d,
+	0x9d, 0x82, 0xc5, 0xca, 0x4e, 0x69, 0xe5, 0x43, 0xe8, 0xbd, 0x15, 0x21, 0x8f, 0xa5, 0x2e, 0x92,
+	0xc6, 0xe0, 0x12, 0xc9, 0xff, 0x8b, 0x03, 0x2d, 0x75, 0xda, 0x3e, 0xf4, 0x23, 0x3e, 0x67, 0xd3,
+	0xd5, 0x49, 0x92, 0xc7, 0xe6, 0x36, 0xd8, 0xa4, 0x15, 0x1a, 0xfa, 0x60, 0xa2, 0xb9, 0x8d, 0xc3,
+	0x26, 0xfa, 0x40, 0x23, 0x54, 0x2d, 0x62, 0x13, 0x1e, 0x19, 0x13, 0x34, 0x28, 0xe5, 0x4e, 0xeb,
+	0x96, 0xdc, 0x71, 0xcb, 0xb9, 0x83, 0x06, 0x4c, 0x58, 0x56, 0x14, 0x3d, 0x1c, 0xe3, 0xca, 0xd9,
+	0x94, 0x45, 0xb6, 0xea, 0x69, 0x80, 0xb7, 0xd0, 0x8e, 0x6d, 0x0a, 0x5b, 0x1e, 0xfe, 0x08, 0x3a,
+	0xd8, 0x26, 0xbe, 0xbd, 0x66, 0xc2, 0x18, 0xbc, 0x83, 0xf8, 0x3d, 0x13, 0xe4, 0x09, 0xb4, 0x55,
+	0x92, 0x6d, 0x36, 0x24, 0xbb, 0x96, 0x72, 0x29, 0x35, 0x32, 0x45, 0xc1, 0x6d, 0x95, 0x0a, 0x6e,
+	0x61, 0xa9, 0x5b, 0xb6, 0xf4, 0x31, 0xb8, 0x58, 0xb9, 0x57, 0x4a, 0xf5, 0xed, 0x65, 0x75, 0x71,
+	0xd7, 0x22, 0xfe, 0x1c, 0x06, 0x95, 0xed, 0x8a, 0x6d, 0x9c, 0xea, 0x36, 0xeb, 0x3a, 0xd1, 0x35,
+	0xa5, 0x01, 0xb3, 0x23, 0xe3, 0x11, 0x9f, 0x4a, 0x1e, 0x98, 0xe8, 0x2c, 0xb0, 0xad, 0x35, 0xad,
+	0xa2, 0xd6, 0xf8, 0xff, 0x76, 0x60, 0x50, 0xd1, 0x00, 0x83, 0x7b, 0x9a, 0x2c, 0x16, 0x2c, 0x0e,
+	0xcc, 0x66, 0x16, 0xa2, 0x0f, 0x83, 0x89, 0xd9, 0xac, 0x11, 0x4c, 0x10, 0x8b, 0xd4, 0x9c, 0x66,
+	0x43, 0xa4, 0x18, 0x47, 0x0b, 0xce, 0xb2, 0x5c, 0xf0, 0x05, 0x8f, 0x6d, 0x06, 0x94, 0x49, 0xe4,
+	0x43, 0xd8, 0x91, 0x6c, 0xfe, 0x2d, 0xea, 0x60, 0x4e, 0x55, 0xb2, 0x39, 0xde, 0x2a, 0x7e, 0x0a,
+	0x5d, 0x55, 0xad, 0x15, 0x4b, 0x1f, 0x6d, 0x47, 0x11, 0x90, 0x49, 0xa0, 0x35, 0x8b, 0xf2, 0xa5,
+	0xed, 0x69, 0x38, 0x46, 0x4b, 0x72, 0x11, 0x99, 0xa6, 0x86, 0xc3, 0x52, 0xea, 0x75, 0x2b, 0xa9,
+	0xf7, 0x40, 0x75, 0x2e, 0xac, 0x26, 0xfa, 0x2e, 0x66, 0x90, 0xff, 0x4f, 0x07, 0xdc, 0xc2, 0xe2,
+	0xd3, 0xaa, 0xc5, 0xa7, 0x6b, 0x8b, 0x87, 0x27, 0xd6, 0xe2, 0xe1, 0x09, 0x62, 0x7a, 0x61, 0x2d,
+	0xa6, 0x17, 0xe8, 0xeb, 0x33, 0x91, 0xe4, 0xe9, 0xc9, 0x4a, 0xdf, 0x46, 0xba, 0xb4, 0xc0, 0xb8,
+	0xef, 0xef, 0xaf, 0xb8, 0x30, 0x4d, 0xbc, 0x4b, 0x0d, 0xc2, 0x53, 0x3b, 0x57, 0xc1, 0xa1, 0xcd,
+	0xd4, 0x80, 0xf8, 0xe0, 0x52, 0x16, 0xcf, 0x75, 0x08, 0xaf, 0xdb, 0xab, 0xa2, 0x51, 0xcd, 0x22,
+	0x0f, 0xec, 0x45, 0xda, 0x98, 0x6d, 0x10, 0x79, 0x04, 0xed, 0xd1, 0x55, 0x38, 0x93, 0xb6, 0xa3,
+	0xdb, 0xc7, 0x19, 0xd6, 0x68, 0xc5, 0xa0, 0x86, 0xef, 0xbf, 0x83, 0x6e, 0x41, 0x5c, 0x2b, 0xe2,
+	0x94, 0x15, 0x21, 0xd0, 0xba, 0x8c, 0x43, 0x69, 0x4b, 0x0f, 0x8e, 0xd1, 0xcc, 0x77, 0x39, 0x8b,
+	0x65, 0x28, 0x57, 0xf6, 0x89, 0x66, 0xb1, 0xff, 0xb9, 0x51, 0x1c, 0x97, 0xbb, 0x4c, 0x53, 0x2e,
+	0xcc, 0x6d, 0x4f, 0x03, 0xb5, 0x49, 0x72, 0xc3, 0x85, 0x79, 0x44, 0x68, 0x70, 0xfc, 0xe7, 0x0e,
+	0xb8, 0xfa, 0x19, 0xf6, 0x14, 0xba, 0xf8, 0xa8, 0xd7, 0x0f, 0x0b, 0x62, 0xaf, 0x0b, 0xeb, 0x67,
+	0xfe, 0x41, 0xbf, 0xf4, 0x3e, 0xc6, 0xce, 0xb4, 0x73, 0xc6, 0x95, 0x74, 0x21, 0x5c, 0x7a, 0xe0,
+	0x1f, 0x94, 0x1f, 0xd3, 0xe4, 0x21, 0xc0, 0xa9, 0xe0, 0x4c, 0x72, 0x85, 0xca, 0xac, 0x2d, 0xb9,
+	0xcb, 0x34, 0xf8, 0xdf, 0x72, 0x47, 0x00, 0x43, 0x1e, 0x71, 0xc9, 0x6f, 0xdd, 0xde, 0xea, 0xaa,
+	0xfe, 0x2b, 0xc8, 0x17, 0x70, 0x0f, 0x0d, 0xa9, 0xbe, 0xe4, 0x2b, 0x22, 0x07, 0xf7, 0x6b, 0x5e,
+	0x71, 0x19, 0x39, 0x81, 0xbd, 0x33, 0x5e, 0x99, 0x47, 0x0e, 0x6a, 0x04, 0xed, 0xae, 0x75, 0xbf,
+	0x00, 0xe4, 0x19, 0x10, 0x6d, 0x7c, 0x85, 0x5a, 0x27, 0x7a, 0xeb, 0x7c, 0xed, 0x94, 0x1f, 0x3f,
+	0x5f, 0x3b, 0xeb, 0xce, 0x66, 0x54, 0x9d, 0x67, 0x3e, 0x7b, 0xec, 0xd7, 0x45, 0x5d, 0x64, 0xec,
+	0x56, 0x7e, 0x2f, 0xf0, 0x9f, 0xa2, 0x7b, 0xc6, 0xcd, 0x0c, 0x72, 0xbf, 0xc2, 0xb4, 0x53, 0xaa,
+	0x1f, 0x1e, 0xe4, 0x09, 0xf4, 0xb5, 0x93, 0x0c, 0xae, 0xb2, 0x6b, 0xa4, 0xb5, 0x4b, 0xee, 0x24,
+	0x7d, 0x0c, 0x7d, 0xed, 0x80, 0xff, 0xaa, 0xd0, 0x66, 0xc4, 0xec, 0xa2, 0x81, 0xa5, 0x67, 0x78,
+	0x9d, 0xdd, 0xf7, 0x36, 0x2f, 0xec, 0xea, 0x5e, 0x7c, 0xc6, 0xd7, 0xf3, 0xc8, 0x87, 0x9b, 0x22,
+	0x76, 0xee, 0xd6, 0xbf, 0x0d, 0xf9, 0x0d, 0xec, 0x69, 0x1f, 0xac, 0x49, 0x5b, 0x42, 0xf5, 0xd3,
+	0xb4, 0x33, 0xfe, 0xbf, 0x69, 0x5f, 0xc2, 0x9e, 0xf6, 0xca, 0x1d, 0x74, 0xad, 0xf8, 0x66, 0xd2,
+	0x56, 0x9f, 0x82, 0x9f, 0xff, 0x67, 0x00, 0x19, 0x4a, 0xb5, 0xc8, 0x23, 0x14, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AdminClient is the client API for Admin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AdminClient interface {
+	// ListUsers lists the users, or the users with a role in an organization
+	ListUsers(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*Users, error)
+	GetUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*User, error)
+	// CreateUser adds a user; the ID and Status of the user are ignored
+	CreateUser(ctx context.Context, in *User, opts ...grpc.CallOption) (*User, error)
+	// UpdateUser replaces the roles, SuperAdmin and Status of a user
+	UpdateUser(ctx context.Context, in *User, opts ...grpc.CallOption) (*User, error)
+	DeleteUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*Empty, error)
+	ListOrganizations(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Organizations, error)
+	GetOrganization(ctx context.Context, in *OrganizationRequest, opts ...grpc.CallOption) (*Organization, error)
+	// CreateOrganization adds an organization; the ID of the organization is
+	// ignored and, unlike with the HTTP API, the client is given no role in it
+	CreateOrganization(ctx context.Context, in *Organization, opts ...grpc.CallOption) (*Organization, error)
+	// UpdateOrganization replaces the name, default role and quotas of an organization
+	UpdateOrganization(ctx context.Context, in *Organization, opts ...grpc.CallOption) (*Organization, error)
+	DeleteOrganization(ctx context.Context, in *OrganizationRequest, opts ...grpc.CallOption) (*Empty, error)
+	// ListSources lists the sources, or the sources of an organization
+	ListSources(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*Sources, error)
+	GetSource(ctx context.Context, in *SourceRequest, opts ...grpc.CallOption) (*Source, error)
+	// CreateSource adds a source; the ID of the source is ignored
+	CreateSource(ctx context.Context, in *Source, opts ...grpc.CallOption) (*Source, error)
+	// UpdateSource replaces a source, keeping its secrets when they are empty
+	UpdateSource(ctx context.Context, in *Source, opts ...grpc.CallOption) (*Source, error)
+	DeleteSource(ctx context.Context, in *SourceRequest, opts ...grpc.CallOption) (*Empty, error)
+	// ListDashboards lists the dashboards, or the dashboards of an organization
+	ListDashboards(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*Dashboards, error)
+	GetDashboard(ctx context.Context, in *DashboardRequest, opts ...grpc.CallOption) (*Dashboard, error)
+	// CreateDashboard adds a dashboard; the ID of the dashboard is ignored
+	CreateDashboard(ctx context.Context, in *Dashboard, opts ...grpc.CallOption) (*Dashboard, error)
+	// UpdateDashboard replaces a dashboard
+	UpdateDashboard(ctx context.Context, in *Dashboard, opts ...grpc.CallOption) (*Dashboard, error)
+	DeleteDashboard(ctx context.Context, in *DashboardRequest, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type adminClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAdminClient(cc *grpc.ClientConn) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) ListUsers(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*Users, error) {
+	out := new(Users)
+	err := c.cc.Invoke(ctx, "/admin.Admin/ListUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/admin.Admin/GetUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) CreateUser(ctx context.Context, in *User, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/admin.Admin/CreateUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) UpdateUser(ctx context.Context, in *User, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/admin.Admin/UpdateUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) DeleteUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/admin.Admin/DeleteUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ListOrganizations(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Organizations, error) {
+	out := new(Organizations)
+	err := c.cc.Invoke(ctx, "/admin.Admin/ListOrganizations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetOrganization(ctx context.Context, in *OrganizationRequest, opts ...grpc.CallOption) (*Organization, error) {
+	out := new(Organization)
+	err := c.cc.Invoke(ctx, "/admin.Admin/GetOrganization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) CreateOrganization(ctx context.Context, in *Organization, opts ...grpc.CallOption) (*Organization, error) {
+	out := new(Organization)
+	err := c.cc.Invoke(ctx, "/admin.Admin/CreateOrganization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) UpdateOrganization(ctx context.Context, in *Organization, opts ...grpc.CallOption) (*Organization, error) {
+	out := new(Organization)
+	err := c.cc.Invoke(ctx, "/admin.Admin/UpdateOrganization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) DeleteOrganization(ctx context.Context, in *OrganizationRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/admin.Admin/DeleteOrganization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ListSources(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*Sources, error) {
+	out := new(Sources)
+	err := c.cc.Invoke(ctx, "/admin.Admin/ListSources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetSource(ctx context.Context, in *SourceRequest, opts ...grpc.CallOption) (*Source, error) {
+	out := new(Source)
+	err := c.cc.Invoke(ctx, "/admin.Admin/GetSource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) CreateSource(ctx context.Context, in *Source, opts ...grpc.CallOption) (*Source, error) {
+	out := new(Source)
+	err := c.cc.Invoke(ctx, "/admin.Admin/CreateSource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) UpdateSource(ctx context.Context, in *Source, opts ...grpc.CallOption) (*Source, error) {
+	out := new(Source)
+	err := c.cc.Invoke(ctx, "/admin.Admin/UpdateSource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) DeleteSource(ctx context.Context, in *SourceRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/admin.Admin/DeleteSource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ListDashboards(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*Dashboards, error) {
+	out := new(Dashboards)
+	err := c.cc.Invoke(ctx, "/admin.Admin/ListDashboards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetDashboard(ctx context.Context, in *DashboardRequest, opts ...grpc.CallOption) (*Dashboard, error) {
+	out := new(Dashboard)
+	err := c.cc.Invoke(ctx, "/admin.Admin/GetDashboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) CreateDashboard(ctx context.Context, in *Dashboard, opts ...grpc.CallOption) (*Dashboard, error) {
+	out := new(Dashboard)
+	err := c.cc.Invoke(ctx, "/admin.Admin/CreateDashboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) UpdateDashboard(ctx context.Context, in *Dashboard, opts ...grpc.CallOption) (*Dashboard, error) {
+	out := new(Dashboard)
+	err := c.cc.Invoke(ctx, "/admin.Admin/UpdateDashboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) DeleteDashboard(ctx context.Context, in *DashboardRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/admin.Admin/DeleteDashboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServer is the server API for Admin service.
+type AdminServer interface {
+	// ListUsers lists the users, or the users with a role in an organization
+	ListUsers(context.Context, *ListRequest) (*Users, error)
+	GetUser(context.Context, *UserRequest) (*User, error)
+	// CreateUser adds a user; the ID and Status of the user are ignored
+	CreateUser(context.Context, *User) (*User, error)
+	// UpdateUser replaces the roles, SuperAdmin and Status of a user
+	UpdateUser(context.Context, *User) (*User, error)
+	DeleteUser(context.Context, *UserRequest) (*Empty, error)
+	ListOrganizations(context.Context, *Empty) (*Organizations, error)
+	GetOrganization(context.Context, *OrganizationRequest) (*Organization, error)
+	// CreateOrganization adds an organization; the ID of the organization is
+	// ignored and, unlike with the HTTP API, the client is given no role in it
+	CreateOrganization(context.Context, *Organization) (*Organization, error)
+	// UpdateOrganization replaces the name, default role and quotas of an organization
+	UpdateOrganization(context.Context, *Organization) (*Organization, error)
+	DeleteOrganization(context.Context, *OrganizationRequest) (*Empty, error)
+	// ListSources lists the sources, or the sources of an organization
+	ListSources(context.Context, *ListRequest) (*Sources, error)
+	GetSource(context.Context, *SourceRequest) (*Source, error)
+	// CreateSource adds a source; the ID of the source is ignored
+	CreateSource(context.Context, *Source) (*Source, error)
+	// UpdateSource replaces a source, keeping its secrets when they are empty
+	UpdateSource(context.Context, *Source) (*Source, error)
+	DeleteSource(context.Context, *SourceRequest) (*Empty, error)
+	// ListDashboards lists the dashboards, or the dashboards of an organization
+	ListDashboards(context.Context, *ListRequest) (*Dashboards, error)
+	GetDashboard(context.Context, *DashboardRequest) (*Dashboard, error)
+	// CreateDashboard adds a dashboard; the ID of the dashboard is ignored
+	CreateDashboard(context.Context, *Dashboard) (*Dashboard, error)
+	// UpdateDashboard replaces a dashboard
+	UpdateDashboard(context.Context, *Dashboard) (*Dashboard, error)
+	DeleteDashboard(context.Context, *DashboardRequest) (*Empty, error)
+}
+
+func RegisterAdminServer(s *grpc.Server, srv AdminServer) {
+	s.RegisterService(&_Admin_serviceDesc, srv)
+}
+
+func _Admin_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.Admin/ListUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ListUsers(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.Admin/GetUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetUser(ctx, req.(*UserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(User)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.Admin/CreateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).CreateUser(ctx, req.(*User))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(User)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.Admin/UpdateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).UpdateUser(ctx, req.(*User))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.Admin/DeleteUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).DeleteUser(ctx, req.(*UserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ListOrganizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ListOrganizations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.Admin/ListOrganizations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ListOrganizations(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.Admin/GetOrganization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetOrganization(ctx, req.(*OrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_CreateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Organization)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).CreateOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.Admin/CreateOrganization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).CreateOrganization(ctx, req.(*Organization))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_UpdateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Organization)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).UpdateOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.Admin/UpdateOrganization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).UpdateOrganization(ctx, req.(*Organization))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_DeleteOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DeleteOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.Admin/DeleteOrganization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).DeleteOrganization(ctx, req.(*OrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ListSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ListSources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.Admin/ListSources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ListSources(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.Admin/GetSource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetSource(ctx, req.(*SourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_CreateSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Source)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).CreateSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.Admin/CreateSource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).CreateSource(ctx, req.(*Source))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_UpdateSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Source)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).UpdateSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.Admin/UpdateSource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).UpdateSource(ctx, req.(*Source))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_DeleteSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DeleteSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.Admin/DeleteSource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).DeleteSource(ctx, req.(*SourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ListDashboards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ListDashboards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.Admin/ListDashboards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ListDashboards(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetDashboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DashboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetDashboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.Admin/GetDashboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetDashboard(ctx, req.(*DashboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_CreateDashboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Dashboard)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).CreateDashboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.Admin/CreateDashboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).CreateDashboard(ctx, req.(*Dashboard))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_UpdateDashboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Dashboard)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).UpdateDashboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.Admin/UpdateDashboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).UpdateDashboard(ctx, req.(*Dashboard))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_DeleteDashboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DashboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DeleteDashboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.Admin/DeleteDashboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).DeleteDashboard(ctx, req.(*DashboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Admin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "admin.Admin",
+	HandlerType: (*AdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListUsers",
+			Handler:    _Admin_ListUsers_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _Admin_GetUser_Handler,
+		},
+		{
+			MethodName: "CreateUser",
+			Handler:    _Admin_CreateUser_Handler,
+		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _Admin_UpdateUser_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _Admin_DeleteUser_Handler,
+		},
+		{
+			MethodName: "ListOrganizations",
+			Handler:    _Admin_ListOrganizations_Handler,
+		},
+		{
+			MethodName: "GetOrganization",
+			Handler:    _Admin_GetOrganization_Handler,
+		},
+		{
+			MethodName: "CreateOrganization",
+			Handler:    _Admin_CreateOrganization_Handler,
+		},
+		{
+			MethodName: "UpdateOrganization",
+			Handler:    _Admin_UpdateOrganization_Handler,
+		},
+		{
+			MethodName: "DeleteOrganization",
+			Handler:    _Admin_DeleteOrganization_Handler,
+		},
+		{
+			MethodName: "ListSources",
+			Handler:    _Admin_ListSources_Handler,
+		},
+		{
+			MethodName: "GetSource",
+			Handler:    _Admin_GetSource_Handler,
+		},
+		{
+			MethodName: "CreateSource",
+			Handler:    _Admin_CreateSource_Handler,
+		},
+		{
+			MethodName: "UpdateSource",
+			Handler:    _Admin_UpdateSource_Handler,
+		},
+		{
+			MethodName: "DeleteSource",
+			Handler:    _Admin_DeleteSource_Handler,
+		},
+		{
+			MethodName: "ListDashboards",
+			Handler:    _Admin_ListDashboards_Handler,
+		},
+		{
+			MethodName: "GetDashboard",
+			Handler:    _Admin_GetDashboard_Handler,
+		},
+		{
+			MethodName: "CreateDashboard",
+			Handler:    _Admin_CreateDashboard_Handler,
+		},
+		{
+			MethodName: "UpdateDashboard",
+			Handler:    _Admin_UpdateDashboard_Handler,
+		},
+		{
+			MethodName: "DeleteDashboard",
+			Handler:    _Admin_DeleteDashboard_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "admin.proto",
+}
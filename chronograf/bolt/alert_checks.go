@@ -0,0 +1,160 @@
+package bolt
+
+import (
+	"context"
+
+	bolt "github.com/coreos/bbolt"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt/internal"
+	"github.com/influxdata/influxdb/kit/tracing"
+)
+
+// Ensure AlertChecksStore implements chronograf.AlertChecksStore.
+var _ chronograf.AlertChecksStore = &AlertChecksStore{}
+
+var (
+	// AlertChecksBucket is the bucket where alert checks are stored. It
+	// holds a nested bucket of checks for each source.
+	AlertChecksBucket = []byte("alertchecksv1")
+)
+
+// AlertChecksStore uses bolt to store and retrieve the alert checks of
+// sources
+type AlertChecksStore struct {
+	client *Client
+}
+
+// Migrate is a noop as there is no previous schema of alert checks
+func (s *AlertChecksStore) Migrate(ctx context.Context) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return nil
+}
+
+// All returns the checks of a source
+func (s *AlertChecksStore) All(ctx context.Context, sourceID int) ([]chronograf.AlertCheck, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	checks := []chronograf.AlertCheck{}
+	err := s.client.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(AlertChecksBucket).Bucket(itob(sourceID))
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			var c chronograf.AlertCheck
+			if err := internal.UnmarshalAlertCheck(v, &c); err != nil {
+				return err
+			}
+			checks = append(checks, c)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return checks, nil
+}
+
+// Add creates a new check of a source
+func (s *AlertChecksStore) Add(ctx context.Context, c *chronograf.AlertCheck) (*chronograf.AlertCheck, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	err := s.client.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.Bucket(AlertChecksBucket).CreateBucketIfNotExists(itob(c.SourceID))
+		if err != nil {
+			return err
+		}
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		c.ID = seq
+
+		data, err := internal.MarshalAlertCheck(c)
+		if err != nil {
+			return err
+		}
+		return b.Put(u64tob(c.ID), data)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Get returns the check id of a source
+func (s *AlertChecksStore) Get(ctx context.Context, sourceID int, id uint64) (*chronograf.AlertCheck, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	var c chronograf.AlertCheck
+	err := s.client.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(AlertChecksBucket).Bucket(itob(sourceID))
+		if b == nil {
+			return chronograf.ErrAlertCheckNotFound
+		}
+		v := b.Get(u64tob(id))
+		if v == nil {
+			return chronograf.ErrAlertCheckNotFound
+		}
+		return internal.UnmarshalAlertCheck(v, &c)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+// Update replaces a check
+func (s *AlertChecksStore) Update(ctx context.Context, c *chronograf.AlertCheck) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(AlertChecksBucket).Bucket(itob(c.SourceID))
+		if b == nil || b.Get(u64tob(c.ID)) == nil {
+			return chronograf.ErrAlertCheckNotFound
+		}
+
+		data, err := internal.MarshalAlertCheck(c)
+		if err != nil {
+			return err
+		}
+		return b.Put(u64tob(c.ID), data)
+	})
+}
+
+// Delete removes a check
+func (s *AlertChecksStore) Delete(ctx context.Context, c *chronograf.AlertCheck) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(AlertChecksBucket).Bucket(itob(c.SourceID))
+		if b == nil || b.Get(u64tob(c.ID)) == nil {
+			return chronograf.ErrAlertCheckNotFound
+		}
+		return b.Delete(u64tob(c.ID))
+	})
+}
+
+// DeleteSource removes the checks of a source
+func (s *AlertChecksStore) DeleteSource(ctx context.Context, sourceID int) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		err := tx.Bucket(AlertChecksBucket).DeleteBucket(itob(sourceID))
+		if err == bolt.ErrBucketNotFound {
+			return nil
+		}
+		return err
+	})
+}
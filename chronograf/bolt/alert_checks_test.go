@@ -0,0 +1,121 @@
+package bolt_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func TestAlertChecksStore(t *testing.T) {
+	client, err := NewTestClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	s := client.AlertChecksStore
+
+	checks := []chronograf.AlertCheck{
+		{
+			SourceID: 1,
+			Name:     "cpu",
+			Query:    `SELECT mean("usage_user") FROM "cpu" WHERE time > now() - 5m GROUP BY "host"`,
+			DB:       "telegraf",
+			RP:       "autogen",
+			Every:    time.Minute,
+			Operator: ">",
+			Thresholds: []chronograf.AlertCheckThreshold{
+				{Level: "CRITICAL", Value: 90},
+				{Level: "WARNING", Value: 75.5},
+			},
+			Message: "{{ .ID }} is {{ .Level }}",
+			Enabled: true,
+			States: map[string]string{
+				"cpu:host=a": "CRITICAL",
+				"cpu:host=b": "OK",
+			},
+			NextRun:  time.Date(2026, 10, 16, 12, 1, 0, 0, time.UTC),
+			LastRun:  time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC),
+			Template: "1",
+			Params:   map[string]string{"host": "a", "threshold": "90"},
+		},
+		{
+			SourceID:   1,
+			Name:       "disk",
+			Query:      `SELECT last("used_percent") FROM "disk"`,
+			Every:      5 * time.Minute,
+			Operator:   ">=",
+			Thresholds: []chronograf.AlertCheckThreshold{},
+			States:     map[string]string{},
+			LastError:  "database not found: telegraf",
+		},
+		{
+			SourceID:   2,
+			Name:       "other source",
+			Query:      `SELECT last("free") FROM "mem"`,
+			Every:      time.Minute,
+			Operator:   "<",
+			Thresholds: []chronograf.AlertCheckThreshold{},
+			States:     map[string]string{},
+		},
+	}
+	for i := range checks {
+		if _, err := s.Add(ctx, &checks[i]); err != nil {
+			t.Fatalf("Add() error = %v", err)
+		}
+		if checks[i].ID == 0 {
+			t.Errorf("Add() did not set ID of check %d", i)
+		}
+	}
+
+	got, err := s.All(ctx, 1)
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	if diff := cmp.Diff(got, checks[:2]); diff != "" {
+		t.Errorf("All() diff (-got +want):\n%s", diff)
+	}
+
+	c := checks[0]
+	c.Enabled = false
+	c.States = nil
+	c.NextRun = time.Time{}
+	if err := s.Update(ctx, &c); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	updated, err := s.Get(ctx, 1, c.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	c.States = map[string]string{}
+	if diff := cmp.Diff(*updated, c); diff != "" {
+		t.Errorf("Get() after Update() diff (-got +want):\n%s", diff)
+	}
+
+	if err := s.Delete(ctx, &c); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := s.Get(ctx, 1, c.ID); err != chronograf.ErrAlertCheckNotFound {
+		t.Errorf("Get() after Delete() error = %v, want %v", err, chronograf.ErrAlertCheckNotFound)
+	}
+	if err := s.Update(ctx, &c); err != chronograf.ErrAlertCheckNotFound {
+		t.Errorf("Update() after Delete() error = %v, want %v", err, chronograf.ErrAlertCheckNotFound)
+	}
+	if err := s.Delete(ctx, &c); err != chronograf.ErrAlertCheckNotFound {
+		t.Errorf("Delete() after Delete() error = %v, want %v", err, chronograf.ErrAlertCheckNotFound)
+	}
+
+	if err := s.DeleteSource(ctx, 1); err != nil {
+		t.Fatalf("DeleteSource() error = %v", err)
+	}
+	if got, err := s.All(ctx, 1); err != nil || len(got) != 0 {
+		t.Errorf("All() after DeleteSource() = %#v, %v", got, err)
+	}
+	if got, err := s.All(ctx, 2); err != nil || len(got) != 1 {
+		t.Errorf("All() of another source = %#v, %v", got, err)
+	}
+}
@@ -0,0 +1,170 @@
+package bolt
+
+import (
+	"context"
+
+	bolt "github.com/coreos/bbolt"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt/internal"
+	"github.com/influxdata/influxdb/kit/tracing"
+)
+
+// Ensure AlertEventsStore implements chronograf.AlertEventsStore.
+var _ chronograf.AlertEventsStore = &AlertEventsStore{}
+
+var (
+	// AlertEventsBucket is the bucket where alert events are stored. It
+	// holds a nested bucket of events for each source.
+	AlertEventsBucket = []byte("alerteventsv1")
+)
+
+// DefaultAlertEventsHistory is the number of alert events kept for each source
+const DefaultAlertEventsHistory = 10000
+
+// AlertEventsStore uses bolt to store and retrieve the alert events of sources
+type AlertEventsStore struct {
+	client *Client
+	// History is the number of events kept for each source; the oldest
+	// events are discarded first
+	History int
+}
+
+// Migrate is a noop as there is no previous schema of alert events
+func (s *AlertEventsStore) Migrate(ctx context.Context) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return nil
+}
+
+// All returns the alert events of a source, oldest first
+func (s *AlertEventsStore) All(ctx context.Context, sourceID int) ([]chronograf.AlertEvent, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	events := []chronograf.AlertEvent{}
+	err := s.client.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(AlertEventsBucket).Bucket(itob(sourceID))
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			var e chronograf.AlertEvent
+			if err := internal.UnmarshalAlertEvent(v, &e); err != nil {
+				return err
+			}
+			events = append(events, e)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// Add records the alert event e of a source, discarding its oldest events
+// beyond the history of the store
+func (s *AlertEventsStore) Add(ctx context.Context, e *chronograf.AlertEvent) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.Bucket(AlertEventsBucket).CreateBucketIfNotExists(itob(e.SourceID))
+		if err != nil {
+			return err
+		}
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		e.ID = seq
+		if e.Time.IsZero() {
+			e.Time = s.client.Now().UTC()
+		}
+
+		data, err := internal.MarshalAlertEvent(e)
+		if err != nil {
+			return err
+		}
+		if err := b.Put(u64tob(e.ID), data); err != nil {
+			return err
+		}
+
+		history := s.History
+		if history <= 0 {
+			history = DefaultAlertEventsHistory
+		}
+		var keys [][]byte
+		if err := b.ForEach(func(k, v []byte) error {
+			keys = append(keys, k)
+			return nil
+		}); err != nil {
+			return err
+		}
+		for i := 0; i < len(keys)-history; i++ {
+			if err := b.Delete(keys[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Get returns the alert event id of a source
+func (s *AlertEventsStore) Get(ctx context.Context, sourceID int, id uint64) (*chronograf.AlertEvent, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	var e chronograf.AlertEvent
+	err := s.client.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(AlertEventsBucket).Bucket(itob(sourceID))
+		if b == nil {
+			return chronograf.ErrAlertEventNotFound
+		}
+		v := b.Get(u64tob(id))
+		if v == nil {
+			return chronograf.ErrAlertEventNotFound
+		}
+		return internal.UnmarshalAlertEvent(v, &e)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &e, nil
+}
+
+// Update replaces an alert event that has not been discarded yet
+func (s *AlertEventsStore) Update(ctx context.Context, e *chronograf.AlertEvent) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(AlertEventsBucket).Bucket(itob(e.SourceID))
+		if b == nil || b.Get(u64tob(e.ID)) == nil {
+			return chronograf.ErrAlertEventNotFound
+		}
+
+		data, err := internal.MarshalAlertEvent(e)
+		if err != nil {
+			return err
+		}
+		return b.Put(u64tob(e.ID), data)
+	})
+}
+
+// Delete removes the alert events of a source
+func (s *AlertEventsStore) Delete(ctx context.Context, sourceID int) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		err := tx.Bucket(AlertEventsBucket).DeleteBucket(itob(sourceID))
+		if err == bolt.ErrBucketNotFound {
+			return nil
+		}
+		return err
+	})
+}
@@ -0,0 +1,114 @@
+package bolt_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func TestAlertEventsStore(t *testing.T) {
+	client, err := NewTestClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	s := client.AlertEventsStore
+	s.History = 2
+
+	alertTime := time.Date(2026, 10, 6, 12, 0, 0, 0, time.UTC)
+	events := []chronograf.AlertEvent{
+		{
+			SourceID:      1,
+			AlertID:       "cpu:host=a",
+			Message:       "cpu is high",
+			Level:         "CRITICAL",
+			PreviousLevel: "OK",
+			Time:          alertTime,
+		},
+		{
+			SourceID:      1,
+			AlertID:       "cpu:host=a",
+			Message:       "cpu is fine",
+			Level:         "OK",
+			PreviousLevel: "CRITICAL",
+			Duration:      time.Minute,
+		},
+		{
+			SourceID: 1,
+			AlertID:  "mem:host=a",
+			Level:    "WARNING",
+			Details:  "<b>mem</b>",
+			Time:     alertTime,
+		},
+		{
+			SourceID: 2,
+			AlertID:  "disk",
+			Level:    "INFO",
+			Time:     alertTime,
+		},
+	}
+	for i := range events {
+		if err := s.Add(ctx, &events[i]); err != nil {
+			t.Fatalf("Add() error = %v", err)
+		}
+		if events[i].ID == 0 {
+			t.Errorf("Add() did not set ID of event %d", i)
+		}
+		events[i].Comments = []chronograf.AlertEventComment{}
+	}
+	if !events[1].Time.Equal(TestNow) {
+		t.Errorf("Add() Time = %v, want %v", events[1].Time, TestNow)
+	}
+
+	// Only the last two events of source 1 are kept
+	got, err := s.All(ctx, 1)
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	if diff := cmp.Diff(got, events[1:3]); diff != "" {
+		t.Errorf("All() diff (-got +want):\n%s", diff)
+	}
+	if _, err := s.Get(ctx, 1, events[0].ID); err != chronograf.ErrAlertEventNotFound {
+		t.Errorf("Get() of discarded event error = %v, want %v", err, chronograf.ErrAlertEventNotFound)
+	}
+
+	e := events[2]
+	e.Acknowledged = true
+	e.AcknowledgedBy = "bob"
+	e.AcknowledgedAt = alertTime.Add(time.Hour)
+	e.Comments = []chronograf.AlertEventComment{
+		{UserID: 7, Author: "bob", Text: "looking", Created: alertTime.Add(time.Hour)},
+	}
+	if err := s.Update(ctx, &e); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	updated, err := s.Get(ctx, 1, e.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if diff := cmp.Diff(*updated, e); diff != "" {
+		t.Errorf("Get() after Update() diff (-got +want):\n%s", diff)
+	}
+	missing := events[0]
+	if err := s.Update(ctx, &missing); err != chronograf.ErrAlertEventNotFound {
+		t.Errorf("Update() of discarded event error = %v, want %v", err, chronograf.ErrAlertEventNotFound)
+	}
+
+	if err := s.Delete(ctx, 1); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if got, err := s.All(ctx, 1); err != nil || len(got) != 0 {
+		t.Errorf("All() after Delete() = %#v, %v", got, err)
+	}
+	if _, err := s.Get(ctx, 1, e.ID); err != chronograf.ErrAlertEventNotFound {
+		t.Errorf("Get() after Delete() error = %v, want %v", err, chronograf.ErrAlertEventNotFound)
+	}
+	if got, err := s.All(ctx, 2); err != nil || len(got) != 1 {
+		t.Errorf("All() of another source = %#v, %v", got, err)
+	}
+}
@@ -0,0 +1,160 @@
+package bolt
+
+import (
+	"context"
+
+	bolt "github.com/coreos/bbolt"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt/internal"
+	"github.com/influxdata/influxdb/kit/tracing"
+)
+
+// Ensure AlertPoliciesStore implements chronograf.AlertPoliciesStore.
+var _ chronograf.AlertPoliciesStore = &AlertPoliciesStore{}
+
+var (
+	// AlertPoliciesBucket is the bucket where alert policies are stored. It
+	// holds a nested bucket of policies for each source.
+	AlertPoliciesBucket = []byte("alertpoliciesv1")
+)
+
+// AlertPoliciesStore uses bolt to store and retrieve the alert policies of
+// sources
+type AlertPoliciesStore struct {
+	client *Client
+}
+
+// Migrate is a noop as there is no previous schema of alert policies
+func (s *AlertPoliciesStore) Migrate(ctx context.Context) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return nil
+}
+
+// All returns the policies of a source
+func (s *AlertPoliciesStore) All(ctx context.Context, sourceID int) ([]chronograf.AlertPolicy, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	policies := []chronograf.AlertPolicy{}
+	err := s.client.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(AlertPoliciesBucket).Bucket(itob(sourceID))
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			var p chronograf.AlertPolicy
+			if err := internal.UnmarshalAlertPolicy(v, &p); err != nil {
+				return err
+			}
+			policies = append(policies, p)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// Add creates a new policy of a source
+func (s *AlertPoliciesStore) Add(ctx context.Context, p *chronograf.AlertPolicy) (*chronograf.AlertPolicy, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	err := s.client.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.Bucket(AlertPoliciesBucket).CreateBucketIfNotExists(itob(p.SourceID))
+		if err != nil {
+			return err
+		}
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		p.ID = seq
+
+		data, err := internal.MarshalAlertPolicy(p)
+		if err != nil {
+			return err
+		}
+		return b.Put(u64tob(p.ID), data)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// Get returns the policy id of a source
+func (s *AlertPoliciesStore) Get(ctx context.Context, sourceID int, id uint64) (*chronograf.AlertPolicy, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	var p chronograf.AlertPolicy
+	err := s.client.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(AlertPoliciesBucket).Bucket(itob(sourceID))
+		if b == nil {
+			return chronograf.ErrAlertPolicyNotFound
+		}
+		v := b.Get(u64tob(id))
+		if v == nil {
+			return chronograf.ErrAlertPolicyNotFound
+		}
+		return internal.UnmarshalAlertPolicy(v, &p)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+// Update replaces a policy
+func (s *AlertPoliciesStore) Update(ctx context.Context, p *chronograf.AlertPolicy) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(AlertPoliciesBucket).Bucket(itob(p.SourceID))
+		if b == nil || b.Get(u64tob(p.ID)) == nil {
+			return chronograf.ErrAlertPolicyNotFound
+		}
+
+		data, err := internal.MarshalAlertPolicy(p)
+		if err != nil {
+			return err
+		}
+		return b.Put(u64tob(p.ID), data)
+	})
+}
+
+// Delete removes a policy
+func (s *AlertPoliciesStore) Delete(ctx context.Context, p *chronograf.AlertPolicy) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(AlertPoliciesBucket).Bucket(itob(p.SourceID))
+		if b == nil || b.Get(u64tob(p.ID)) == nil {
+			return chronograf.ErrAlertPolicyNotFound
+		}
+		return b.Delete(u64tob(p.ID))
+	})
+}
+
+// DeleteSource removes the policies of a source
+func (s *AlertPoliciesStore) DeleteSource(ctx context.Context, sourceID int) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		err := tx.Bucket(AlertPoliciesBucket).DeleteBucket(itob(sourceID))
+		if err == bolt.ErrBucketNotFound {
+			return nil
+		}
+		return err
+	})
+}
@@ -0,0 +1,92 @@
+package bolt_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func TestAlertPoliciesStore(t *testing.T) {
+	client, err := NewTestClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	s := client.AlertPoliciesStore
+
+	policies := []chronograf.AlertPolicy{
+		{
+			SourceID:    1,
+			Rule:        "cpu",
+			Throttle:    10 * time.Minute,
+			FlapWindow:  time.Hour,
+			FlapChanges: 5,
+		},
+		{
+			SourceID: 1,
+			Rule:     "disk",
+			Throttle: time.Hour,
+		},
+		{
+			SourceID:    2,
+			Rule:        "other source",
+			FlapWindow:  30 * time.Minute,
+			FlapChanges: 3,
+		},
+	}
+	for i := range policies {
+		if _, err := s.Add(ctx, &policies[i]); err != nil {
+			t.Fatalf("Add() error = %v", err)
+		}
+		if policies[i].ID == 0 {
+			t.Errorf("Add() did not set ID of policy %d", i)
+		}
+	}
+
+	got, err := s.All(ctx, 1)
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	if diff := cmp.Diff(got, policies[:2]); diff != "" {
+		t.Errorf("All() diff (-got +want):\n%s", diff)
+	}
+
+	p := policies[0]
+	p.Throttle = 0
+	p.FlapChanges = 10
+	if err := s.Update(ctx, &p); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	updated, err := s.Get(ctx, 1, p.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if diff := cmp.Diff(*updated, p); diff != "" {
+		t.Errorf("Get() after Update() diff (-got +want):\n%s", diff)
+	}
+
+	if err := s.Delete(ctx, &p); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := s.Get(ctx, 1, p.ID); err != chronograf.ErrAlertPolicyNotFound {
+		t.Errorf("Get() after Delete() error = %v, want %v", err, chronograf.ErrAlertPolicyNotFound)
+	}
+	if err := s.Update(ctx, &p); err != chronograf.ErrAlertPolicyNotFound {
+		t.Errorf("Update() after Delete() error = %v, want %v", err, chronograf.ErrAlertPolicyNotFound)
+	}
+
+	if err := s.DeleteSource(ctx, 1); err != nil {
+		t.Fatalf("DeleteSource() error = %v", err)
+	}
+	if got, err := s.All(ctx, 1); err != nil || len(got) != 0 {
+		t.Errorf("All() after DeleteSource() = %#v, %v", got, err)
+	}
+	if got, err := s.All(ctx, 2); err != nil || len(got) != 1 {
+		t.Errorf("All() of another source = %#v, %v", got, err)
+	}
+}
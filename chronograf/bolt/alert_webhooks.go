@@ -0,0 +1,160 @@
+package bolt
+
+import (
+	"context"
+
+	bolt "github.com/coreos/bbolt"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt/internal"
+	"github.com/influxdata/influxdb/kit/tracing"
+)
+
+// Ensure AlertWebhooksStore implements chronograf.AlertWebhooksStore.
+var _ chronograf.AlertWebhooksStore = &AlertWebhooksStore{}
+
+var (
+	// AlertWebhooksBucket is the bucket where alert webhooks are stored. It
+	// holds a nested bucket of webhooks for each source.
+	AlertWebhooksBucket = []byte("alertwebhooksv1")
+)
+
+// AlertWebhooksStore uses bolt to store and retrieve the alert webhooks of
+// sources
+type AlertWebhooksStore struct {
+	client *Client
+}
+
+// Migrate is a noop as there is no previous schema of alert webhooks
+func (s *AlertWebhooksStore) Migrate(ctx context.Context) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return nil
+}
+
+// All returns the webhooks of a source
+func (s *AlertWebhooksStore) All(ctx context.Context, sourceID int) ([]chronograf.AlertWebhook, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	hooks := []chronograf.AlertWebhook{}
+	err := s.client.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(AlertWebhooksBucket).Bucket(itob(sourceID))
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			var h chronograf.AlertWebhook
+			if err := internal.UnmarshalAlertWebhook(v, &h); err != nil {
+				return err
+			}
+			hooks = append(hooks, h)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return hooks, nil
+}
+
+// Add creates a new webhook of a source
+func (s *AlertWebhooksStore) Add(ctx context.Context, h *chronograf.AlertWebhook) (*chronograf.AlertWebhook, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	err := s.client.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.Bucket(AlertWebhooksBucket).CreateBucketIfNotExists(itob(h.SourceID))
+		if err != nil {
+			return err
+		}
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		h.ID = seq
+
+		data, err := internal.MarshalAlertWebhook(h)
+		if err != nil {
+			return err
+		}
+		return b.Put(u64tob(h.ID), data)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// Get returns the webhook id of a source
+func (s *AlertWebhooksStore) Get(ctx context.Context, sourceID int, id uint64) (*chronograf.AlertWebhook, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	var h chronograf.AlertWebhook
+	err := s.client.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(AlertWebhooksBucket).Bucket(itob(sourceID))
+		if b == nil {
+			return chronograf.ErrAlertWebhookNotFound
+		}
+		v := b.Get(u64tob(id))
+		if v == nil {
+			return chronograf.ErrAlertWebhookNotFound
+		}
+		return internal.UnmarshalAlertWebhook(v, &h)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &h, nil
+}
+
+// Update replaces a webhook
+func (s *AlertWebhooksStore) Update(ctx context.Context, h *chronograf.AlertWebhook) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(AlertWebhooksBucket).Bucket(itob(h.SourceID))
+		if b == nil || b.Get(u64tob(h.ID)) == nil {
+			return chronograf.ErrAlertWebhookNotFound
+		}
+
+		data, err := internal.MarshalAlertWebhook(h)
+		if err != nil {
+			return err
+		}
+		return b.Put(u64tob(h.ID), data)
+	})
+}
+
+// Delete removes a webhook
+func (s *AlertWebhooksStore) Delete(ctx context.Context, h *chronograf.AlertWebhook) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(AlertWebhooksBucket).Bucket(itob(h.SourceID))
+		if b == nil || b.Get(u64tob(h.ID)) == nil {
+			return chronograf.ErrAlertWebhookNotFound
+		}
+		return b.Delete(u64tob(h.ID))
+	})
+}
+
+// DeleteSource removes the webhooks of a source
+func (s *AlertWebhooksStore) DeleteSource(ctx context.Context, sourceID int) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		err := tx.Bucket(AlertWebhooksBucket).DeleteBucket(itob(sourceID))
+		if err == bolt.ErrBucketNotFound {
+			return nil
+		}
+		return err
+	})
+}
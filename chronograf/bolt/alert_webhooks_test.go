@@ -0,0 +1,114 @@
+package bolt_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func TestAlertWebhooksStore(t *testing.T) {
+	client, err := NewTestClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	s := client.AlertWebhooksStore
+
+	hooks := []chronograf.AlertWebhook{
+		{
+			SourceID: 1,
+			Name:     "incidents",
+			URL:      "https://incidents.example.com/alerts",
+			Rules:    []string{"cpu", "mem"},
+			Headers: map[string]string{
+				"X-Team":        "ops",
+				"Authorization": "Bearer howdy",
+			},
+			Template: `{"summary": {{ json .Message }}}`,
+			Secret:   "doody",
+		},
+		{
+			SourceID: 1,
+			Name:     "everything",
+			Type:     "teams",
+			URL:      "https://archive.example.com",
+			Rules:    []string{},
+			Headers:  map[string]string{},
+		},
+		{
+			SourceID:    1,
+			Name:        "on call",
+			Type:        "pagerduty",
+			URL:         "https://events.pagerduty.com/v2/enqueue",
+			Rules:       []string{"cpu", "disk"},
+			Headers:     map[string]string{},
+			RoutingKey:  "0123456789abcdef0123456789abcdef",
+			RoutingKeys: map[string]string{"disk": "fedcba9876543210fedcba9876543210"},
+		},
+		{
+			SourceID: 2,
+			Name:     "other source",
+			URL:      "https://example.com",
+			Rules:    []string{},
+			Headers:  map[string]string{},
+		},
+	}
+	for i := range hooks {
+		if _, err := s.Add(ctx, &hooks[i]); err != nil {
+			t.Fatalf("Add() error = %v", err)
+		}
+		if hooks[i].ID == 0 {
+			t.Errorf("Add() did not set ID of webhook %d", i)
+		}
+	}
+
+	got, err := s.All(ctx, 1)
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	if diff := cmp.Diff(got, hooks[:3]); diff != "" {
+		t.Errorf("All() diff (-got +want):\n%s", diff)
+	}
+
+	h := hooks[0]
+	h.Rules = nil
+	h.Secret = ""
+	if err := s.Update(ctx, &h); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	updated, err := s.Get(ctx, 1, h.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	h.Rules = []string{}
+	if diff := cmp.Diff(*updated, h); diff != "" {
+		t.Errorf("Get() after Update() diff (-got +want):\n%s", diff)
+	}
+
+	if err := s.Delete(ctx, &h); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := s.Get(ctx, 1, h.ID); err != chronograf.ErrAlertWebhookNotFound {
+		t.Errorf("Get() after Delete() error = %v, want %v", err, chronograf.ErrAlertWebhookNotFound)
+	}
+	if err := s.Update(ctx, &h); err != chronograf.ErrAlertWebhookNotFound {
+		t.Errorf("Update() after Delete() error = %v, want %v", err, chronograf.ErrAlertWebhookNotFound)
+	}
+	if err := s.Delete(ctx, &h); err != chronograf.ErrAlertWebhookNotFound {
+		t.Errorf("Delete() after Delete() error = %v, want %v", err, chronograf.ErrAlertWebhookNotFound)
+	}
+
+	if err := s.DeleteSource(ctx, 1); err != nil {
+		t.Fatalf("DeleteSource() error = %v", err)
+	}
+	if got, err := s.All(ctx, 1); err != nil || len(got) != 0 {
+		t.Errorf("All() after DeleteSource() = %#v, %v", got, err)
+	}
+	if got, err := s.All(ctx, 2); err != nil || len(got) != 1 {
+		t.Errorf("All() of another source = %#v, %v", got, err)
+	}
+}
@@ -0,0 +1,140 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	bolt "github.com/coreos/bbolt"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt/internal"
+	"github.com/influxdata/influxdb/kit/tracing"
+)
+
+// Ensure AnnotationsStore implements chronograf.AnnotationStore.
+var _ chronograf.AnnotationStore = &AnnotationsStore{}
+
+var (
+	// AnnotationsBucket is the bucket where annotations independent of a
+	// source are stored.
+	AnnotationsBucket = []byte("annotationsv1")
+)
+
+// AnnotationsStore uses bolt to store and retrieve annotations shown across
+// the dashboards of an organization
+type AnnotationsStore struct {
+	client *Client
+}
+
+// Migrate is a noop as there is no previous schema of annotations
+func (s *AnnotationsStore) Migrate(ctx context.Context) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return nil
+}
+
+// All returns the annotations overlapping start and stop, the most recent first
+func (s *AnnotationsStore) All(ctx context.Context, start, stop time.Time) ([]chronograf.Annotation, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	annotations := []chronograf.Annotation{}
+	err := s.client.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(AnnotationsBucket).ForEach(func(k, v []byte) error {
+			var a chronograf.Annotation
+			if err := internal.UnmarshalAnnotation(v, &a); err != nil {
+				return err
+			}
+			if a.EndTime.Before(start) || a.StartTime.After(stop) {
+				return nil
+			}
+			annotations = append(annotations, a)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(annotations, func(i, j int) bool {
+		return annotations[i].EndTime.After(annotations[j].EndTime)
+	})
+	return annotations, nil
+}
+
+// Add creates a new annotation in the AnnotationsStore
+func (s *AnnotationsStore) Add(ctx context.Context, a *chronograf.Annotation) (*chronograf.Annotation, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	if err := s.client.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(AnnotationsBucket)
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		a.ID = fmt.Sprintf("%d", seq)
+
+		v, err := internal.MarshalAnnotation(a)
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(a.ID), v)
+	}); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// Delete the annotation from the AnnotationsStore
+func (s *AnnotationsStore) Delete(ctx context.Context, id string) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	if _, err := s.Get(ctx, id); err != nil {
+		return err
+	}
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(AnnotationsBucket).Delete([]byte(id))
+	})
+}
+
+// Get retrieves an annotation by ID
+func (s *AnnotationsStore) Get(ctx context.Context, id string) (*chronograf.Annotation, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	var a chronograf.Annotation
+	if err := s.client.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(AnnotationsBucket).Get([]byte(id))
+		if v == nil {
+			return chronograf.ErrAnnotationNotFound
+		}
+		return internal.UnmarshalAnnotation(v, &a)
+	}); err != nil {
+		return nil, err
+	}
+
+	return &a, nil
+}
+
+// Update replaces the annotation
+func (s *AnnotationsStore) Update(ctx context.Context, a *chronograf.Annotation) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(AnnotationsBucket)
+		if v := b.Get([]byte(a.ID)); v == nil {
+			return chronograf.ErrAnnotationNotFound
+		}
+		v, err := internal.MarshalAnnotation(a)
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(a.ID), v)
+	})
+}
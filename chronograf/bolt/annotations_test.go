@@ -0,0 +1,83 @@
+package bolt_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func TestAnnotationsStore(t *testing.T) {
+	client, err := NewTestClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	s := client.AnnotationsStore
+
+	deployed := time.Date(2018, 1, 1, 9, 0, 0, 0, time.UTC)
+	deploy := &chronograf.Annotation{
+		StartTime:    deployed,
+		EndTime:      deployed,
+		Text:         "Deployed api v1.2.0",
+		Type:         "deploy",
+		Stream:       "deploys",
+		Tags:         []string{"api", "production"},
+		Organization: "default",
+	}
+	if _, err := s.Add(ctx, deploy); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	maintenance := &chronograf.Annotation{
+		StartTime:    deployed.Add(time.Hour),
+		EndTime:      deployed.Add(3 * time.Hour),
+		Text:         "Database maintenance",
+		Stream:       "maintenance",
+		Organization: "default",
+	}
+	if _, err := s.Add(ctx, maintenance); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+
+	got, err := s.All(ctx, deployed, deployed.Add(24*time.Hour))
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	want := []chronograf.Annotation{*maintenance, *deploy}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("All() diff (-got +want):\n%s", diff)
+	}
+
+	// Regions overlapping the range are included even if they start before it
+	got, err = s.All(ctx, deployed.Add(2*time.Hour), deployed.Add(24*time.Hour))
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	if diff := cmp.Diff(got, []chronograf.Annotation{*maintenance}); diff != "" {
+		t.Errorf("All() of an overlapping range diff (-got +want):\n%s", diff)
+	}
+
+	deploy.Text = "Deployed api v1.2.1"
+	deploy.Tags = []string{"api"}
+	if err := s.Update(ctx, deploy); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	a, err := s.Get(ctx, deploy.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if diff := cmp.Diff(a, deploy); diff != "" {
+		t.Errorf("Get() after Update() diff (-got +want):\n%s", diff)
+	}
+
+	if err := s.Delete(ctx, deploy.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := s.Get(ctx, deploy.ID); err != chronograf.ErrAnnotationNotFound {
+		t.Errorf("Get() after Delete() error = %v, want %v", err, chronograf.ErrAnnotationNotFound)
+	}
+}
@@ -1,6 +1,9 @@
 package bolt
 
 import (
+	"context"
+	"fmt"
+	"strconv"
 	"time"
 
 	bolt "github.com/coreos/bbolt"
@@ -9,6 +12,12 @@ import (
 // SchemaVersionBucket stores ids of completed migrations
 var SchemaVersionBucket = []byte("SchemaVersions")
 
+// SchemaBucket stores the schema version of the database, which is the
+// number of migrations applied to it in order
+var SchemaBucket = []byte("SchemaV1")
+
+var schemaVersionKey = []byte("version")
+
 // IsMigrationComplete checks for the presence of a particular migration id
 func IsMigrationComplete(db *bolt.DB, id string) (bool, error) {
 	complete := false
@@ -40,19 +49,23 @@ func MarkMigrationAsComplete(db *bolt.DB, id string) error {
 // Migration defines a database state/schema transition
 //  ID: 	After the migration is run, this id is stored in the database.
 //      	We don't want to run a state transition twice
+//  Description: What the migration changes, as listed by chronoctl migrate.
 //  Up: 	The forward-transition function. After a version upgrade, a number
 // 				of these will run on database startup in order to bring a user's
 // 				schema in line with struct definitions in the new version.
-//  Down: The backward-transition function. We don't expect these to be
-// 				run on a user's database -- if the user needs to rollback
-// 				to a previous version, it will be easier for them to replace
-// 				their current database with one of their backups. The primary
-// 				purpose of a Down() function is to help contributors move across
-// 				development branches that have different schema definitions.
+//  Down: The backward-transition function, run by chronoctl migrate when
+// 				a user rolls back to a previous version. Migrations without
+// 				one cannot be rolled back; users replace their database with
+// 				one of their backups instead.
+//
+// Changes of the structs kept in the database are made by appending a
+// Migration to migrations rather than by handling the old fields wherever
+// the structs are read.
 type Migration struct {
-	ID   string
-	Up   func(db *bolt.DB) error
-	Down func(db *bolt.DB) error
+	ID          string
+	Description string
+	Up          func(db *bolt.DB) error
+	Down        func(db *bolt.DB) error
 }
 
 // Migrate runs one migration's Up() function, if it has not already been run
@@ -78,15 +91,108 @@ func (m Migration) Migrate(client *Client) error {
 
 // MigrateAll iterates through all known migrations and runs them in order
 func MigrateAll(client *Client) error {
-	for _, m := range migrations {
-		err := m.Migrate(client)
+	_, err := client.MigrateTo(context.Background(), LatestSchemaVersion(), false)
+	return err
+}
+
+// Migrations returns all known migrations in the order they are run
+func Migrations() []Migration {
+	return append([]Migration{}, migrations...)
+}
+
+// LatestSchemaVersion is the schema version of databases once all known
+// migrations have run
+func LatestSchemaVersion() int {
+	return len(migrations)
+}
 
-		if err != nil {
+// SchemaVersion returns the schema version of the database
+func (c *Client) SchemaVersion(ctx context.Context) (int, error) {
+	version := 0
+	err := c.db.View(func(tx *bolt.Tx) error {
+		if v := tx.Bucket(SchemaBucket).Get(schemaVersionKey); v != nil {
+			var err error
+			version, err = strconv.Atoi(string(v))
 			return err
 		}
+
+		// Databases migrated before the schema version was kept have run
+		// the leading migrations whose ids they store
+		for _, m := range migrations {
+			if tx.Bucket(SchemaVersionBucket).Get([]byte(m.ID)) == nil {
+				break
+			}
+			version++
+		}
+		return nil
+	})
+	return version, err
+}
+
+func (c *Client) setSchemaVersion(version int) error {
+	return c.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(SchemaBucket).Put(schemaVersionKey, []byte(strconv.Itoa(version)))
+	})
+}
+
+// MigrationStep is a migration run by MigrateTo
+type MigrationStep struct {
+	Migration
+	Rollback bool // Rollback is true if the Down function of the migration is run
+	Version  int  // Version is the schema version of the database after the step
+}
+
+// MigrateTo runs the Up functions of the migrations up to the schema version
+// or the Down functions of those after it, and returns the steps it ran in
+// order. If dryRun is true, the steps are returned without running them.
+func (c *Client) MigrateTo(ctx context.Context, version int, dryRun bool) ([]MigrationStep, error) {
+	if version < 0 || version > LatestSchemaVersion() {
+		return nil, fmt.Errorf("unknown schema version %d; the latest version is %d", version, LatestSchemaVersion())
+	}
+	current, err := c.SchemaVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if current > LatestSchemaVersion() {
+		return nil, fmt.Errorf("schema version %d of the database is newer than version %d of this build; roll it back with the chronoctl of the newer build or restore a backup", current, LatestSchemaVersion())
+	}
+
+	steps := []MigrationStep{}
+	for v := current; v < version; v++ {
+		steps = append(steps, MigrationStep{Migration: migrations[v], Version: v + 1})
+	}
+	for v := current; v > version; v-- {
+		m := migrations[v-1]
+		if m.Down == nil {
+			return nil, fmt.Errorf("migration %s cannot be rolled back", m.ID)
+		}
+		steps = append(steps, MigrationStep{Migration: m, Rollback: true, Version: v - 1})
+	}
+	if dryRun {
+		return steps, nil
 	}
 
-	return nil
+	for _, step := range steps {
+		if step.Rollback {
+			if c.logger != nil {
+				c.logger.Info("Rolling back migration ", step.ID, "")
+			}
+			if err := step.Down(c.db); err != nil {
+				return nil, err
+			}
+			if err := c.db.Update(func(tx *bolt.Tx) error {
+				return tx.Bucket(SchemaVersionBucket).Delete([]byte(step.ID))
+			}); err != nil {
+				return nil, err
+			}
+		} else if err := step.Migrate(c); err != nil {
+			return nil, err
+		}
+		if err := c.setSchemaVersion(step.Version); err != nil {
+			return nil, err
+		}
+	}
+	return steps, nil
 }
 
 var migrations = []Migration{
@@ -0,0 +1,72 @@
+package bolt_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+func TestClient_MigrateTo(t *testing.T) {
+	ctx := context.Background()
+	client, err := NewTestClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	latest := bolt.LatestSchemaVersion()
+	if v, err := client.SchemaVersion(ctx); err != nil || v != latest {
+		t.Fatalf("SchemaVersion() of a new database = %d, %v, want %d", v, err, latest)
+	}
+
+	steps, err := client.MigrateTo(ctx, 0, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(steps) != latest || !steps[0].Rollback || steps[len(steps)-1].Version != 0 {
+		t.Errorf("MigrateTo(0) dry run = %#v", steps)
+	}
+	if v, _ := client.SchemaVersion(ctx); v != latest {
+		t.Errorf("SchemaVersion() after a dry run = %d, want %d", v, latest)
+	}
+
+	if _, err := client.MigrateTo(ctx, 0, false); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := client.SchemaVersion(ctx); v != 0 {
+		t.Errorf("SchemaVersion() after rolling back = %d, want 0", v)
+	}
+
+	// Rolled back databases stay rolled back when migrations are skipped
+	if err := client.Client.Close(); err != nil {
+		t.Fatal(err)
+	}
+	reopened := bolt.NewClient()
+	reopened.Path = client.Path
+	reopened.SkipMigrations = true
+	if err := reopened.Open(ctx, mocks.NewLogger(), chronograf.BuildInfo{Version: "version"}); err != nil {
+		t.Fatal(err)
+	}
+	defer reopened.Close()
+	if v, _ := reopened.SchemaVersion(ctx); v != 0 {
+		t.Errorf("SchemaVersion() after skipping migrations = %d, want 0", v)
+	}
+
+	steps, err = reopened.MigrateTo(ctx, latest, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(steps) != latest || steps[0].Rollback {
+		t.Errorf("MigrateTo(%d) = %#v", latest, steps)
+	}
+	if v, _ := reopened.SchemaVersion(ctx); v != latest {
+		t.Errorf("SchemaVersion() after migrating = %d, want %d", v, latest)
+	}
+
+	if _, err := reopened.MigrateTo(ctx, latest+1, false); err == nil {
+		t.Errorf("MigrateTo() an unknown version succeeded")
+	}
+}
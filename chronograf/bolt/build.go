@@ -6,6 +6,7 @@ import (
 	bolt "github.com/coreos/bbolt"
 	"github.com/influxdata/influxdb/chronograf"
 	"github.com/influxdata/influxdb/chronograf/bolt/internal"
+	"github.com/influxdata/influxdb/kit/tracing"
 )
 
 // Ensure BuildStore struct implements chronograf.BuildStore interface
@@ -24,6 +25,9 @@ type BuildStore struct {
 
 // Get retrieves Chronograf build information from the database
 func (s *BuildStore) Get(ctx context.Context) (chronograf.BuildInfo, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	var build chronograf.BuildInfo
 	if err := s.client.db.View(func(tx *bolt.Tx) error {
 		var err error
@@ -41,6 +45,9 @@ func (s *BuildStore) Get(ctx context.Context) (chronograf.BuildInfo, error) {
 
 // Update overwrites the current Chronograf build information in the database
 func (s *BuildStore) Update(ctx context.Context, build chronograf.BuildInfo) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	if err := s.client.db.Update(func(tx *bolt.Tx) error {
 		return s.update(ctx, build, tx)
 	}); err != nil {
@@ -52,6 +59,9 @@ func (s *BuildStore) Update(ctx context.Context, build chronograf.BuildInfo) err
 
 // Migrate simply stores the current version in the database
 func (s *BuildStore) Migrate(ctx context.Context, build chronograf.BuildInfo) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	return s.Update(ctx, build)
 }
 
@@ -0,0 +1,132 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+
+	bolt "github.com/coreos/bbolt"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt/internal"
+	"github.com/influxdata/influxdb/kit/tracing"
+)
+
+// Ensure CertificatesStore implements chronograf.CertificatesStore.
+var _ chronograf.CertificatesStore = &CertificatesStore{}
+
+var (
+	// CertificatesBucket is the bucket where the mappings of TLS
+	// client certificates to users are stored.
+	CertificatesBucket = []byte("certificatesv1")
+)
+
+// CertificatesStore uses bolt to store and retrieve the mappings of
+// TLS client certificates to users
+type CertificatesStore struct {
+	client *Client
+}
+
+// Migrate is a noop as there is no previous schema of certificate mappings
+func (s *CertificatesStore) Migrate(ctx context.Context) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return nil
+}
+
+// All returns all certificate mappings
+func (s *CertificatesStore) All(ctx context.Context) ([]chronograf.CertificateMapping, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	mappings := []chronograf.CertificateMapping{}
+	err := s.client.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(CertificatesBucket).ForEach(func(k, v []byte) error {
+			var m chronograf.CertificateMapping
+			if err := internal.UnmarshalCertificateMapping(v, &m); err != nil {
+				return err
+			}
+			mappings = append(mappings, m)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mappings, nil
+}
+
+// Add creates a new certificate mapping in the CertificatesStore
+func (s *CertificatesStore) Add(ctx context.Context, m *chronograf.CertificateMapping) (*chronograf.CertificateMapping, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	if err := s.client.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(CertificatesBucket)
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		m.ID = fmt.Sprintf("%d", seq)
+
+		v, err := internal.MarshalCertificateMapping(m)
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(m.ID), v)
+	}); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Delete the certificate mapping from the CertificatesStore
+func (s *CertificatesStore) Delete(ctx context.Context, m *chronograf.CertificateMapping) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	if _, err := s.Get(ctx, m.ID); err != nil {
+		return err
+	}
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(CertificatesBucket).Delete([]byte(m.ID))
+	})
+}
+
+// Get retrieves a certificate mapping by ID
+func (s *CertificatesStore) Get(ctx context.Context, id string) (*chronograf.CertificateMapping, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	var m chronograf.CertificateMapping
+	if err := s.client.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(CertificatesBucket).Get([]byte(id))
+		if v == nil {
+			return chronograf.ErrCertificateMappingNotFound
+		}
+		return internal.UnmarshalCertificateMapping(v, &m)
+	}); err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// Update replaces the certificate mapping in the CertificatesStore
+func (s *CertificatesStore) Update(ctx context.Context, m *chronograf.CertificateMapping) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(CertificatesBucket)
+		if v := b.Get([]byte(m.ID)); v == nil {
+			return chronograf.ErrCertificateMappingNotFound
+		}
+		v, err := internal.MarshalCertificateMapping(m)
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(m.ID), v)
+	})
+}
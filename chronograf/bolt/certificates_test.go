@@ -0,0 +1,69 @@
+package bolt_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func TestCertificatesStore(t *testing.T) {
+	client, err := NewTestClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	s := client.CertificatesStore
+
+	ingest := &chronograf.CertificateMapping{
+		Subject:      "CN=ingest,OU=services,O=Acme",
+		UserID:       1,
+		Organization: "default",
+	}
+	alerts := &chronograf.CertificateMapping{
+		Subject: "CN=alerts,OU=services,O=Acme",
+		UserID:  2,
+	}
+	for _, m := range []*chronograf.CertificateMapping{ingest, alerts} {
+		if _, err := s.Add(ctx, m); err != nil {
+			t.Fatalf("Add() error = %v", err)
+		}
+	}
+
+	got, err := s.All(ctx)
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	want := []chronograf.CertificateMapping{*ingest, *alerts}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("All() diff (-got +want):\n%s", diff)
+	}
+
+	alerts.UserID = 3
+	if err := s.Update(ctx, alerts); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	m, err := s.Get(ctx, alerts.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if diff := cmp.Diff(m, alerts); diff != "" {
+		t.Errorf("Get() diff (-got +want):\n%s", diff)
+	}
+
+	if err := s.Delete(ctx, ingest); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := s.Get(ctx, ingest.ID); err != chronograf.ErrCertificateMappingNotFound {
+		t.Errorf("Get() after Delete() error = %v, want %v", err, chronograf.ErrCertificateMappingNotFound)
+	}
+	if err := s.Update(ctx, ingest); err != chronograf.ErrCertificateMappingNotFound {
+		t.Errorf("Update() of a removed mapping error = %v, want %v", err, chronograf.ErrCertificateMappingNotFound)
+	}
+	if err := s.Delete(ctx, ingest); err != chronograf.ErrCertificateMappingNotFound {
+		t.Errorf("Delete() of a removed mapping error = %v, want %v", err, chronograf.ErrCertificateMappingNotFound)
+	}
+}
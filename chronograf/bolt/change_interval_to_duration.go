@@ -13,9 +13,10 @@ import (
 // After, we only support queries with `GROUP BY time(:interval:)`
 // thereby allowing non_negative_derivative(_____, :interval)
 var changeIntervalToDuration = Migration{
-	ID:   "59b0cda4fc7909ff84ee5c4f9cb4b655b6a26620",
-	Up:   up,
-	Down: down,
+	ID:          "59b0cda4fc7909ff84ee5c4f9cb4b655b6a26620",
+	Description: "Group dashboard queries by time(:interval:) instead of :interval:",
+	Up:          up,
+	Down:        down,
 }
 
 func updateDashboard(board *Dashboard) {
@@ -22,16 +22,40 @@ type Client struct {
 	Now       func() time.Time
 	LayoutIDs chronograf.ID
 
+	// SkipMigrations opens the database without running the migrations of
+	// its schema, which chronoctl migrate runs or rolls back instead
+	SkipMigrations bool
+
 	BuildStore              *BuildStore
 	SourcesStore            *SourcesStore
 	ServersStore            *ServersStore
 	LayoutsStore            *LayoutsStore
 	DashboardsStore         *DashboardsStore
+	DashboardVersionsStore  *DashboardVersionsStore
+	DashboardSnapshotsStore *DashboardSnapshotsStore
+	FoldersStore            *FoldersStore
+	ReportsStore            *ReportsStore
+	AnnotationsStore        *AnnotationsStore
+	SourceHealthStore       *SourceHealthStore
+	QueryHistoryStore       *QueryHistoryStore
+	AlertEventsStore        *AlertEventsStore
+	AlertWebhooksStore      *AlertWebhooksStore
+	AlertChecksStore        *AlertChecksStore
+	AlertPoliciesStore      *AlertPoliciesStore
+	RuleTemplatesStore      *RuleTemplatesStore
 	UsersStore              *UsersStore
 	OrganizationsStore      *OrganizationsStore
 	ConfigStore             *ConfigStore
 	MappingsStore           *MappingsStore
 	OrganizationConfigStore *OrganizationConfigStore
+	NotificationsStore      *NotificationsStore
+	TokensStore             *TokensStore
+	InvitationsStore        *InvitationsStore
+	PreferencesStore        *PreferencesStore
+	CertificatesStore       *CertificatesStore
+	GroupsStore             *GroupsStore
+	WebhooksStore           *WebhooksStore
+	WebhookDeliveriesStore  *WebhookDeliveriesStore
 }
 
 // NewClient initializes all stores
@@ -48,11 +72,31 @@ func NewClient() *Client {
 		client: c,
 		IDs:    &id.UUID{},
 	}
+	c.DashboardVersionsStore = &DashboardVersionsStore{client: c}
+	c.DashboardSnapshotsStore = &DashboardSnapshotsStore{client: c}
+	c.FoldersStore = &FoldersStore{client: c}
+	c.ReportsStore = &ReportsStore{client: c}
+	c.AnnotationsStore = &AnnotationsStore{client: c}
+	c.SourceHealthStore = &SourceHealthStore{client: c}
+	c.QueryHistoryStore = &QueryHistoryStore{client: c}
+	c.AlertEventsStore = &AlertEventsStore{client: c}
+	c.AlertWebhooksStore = &AlertWebhooksStore{client: c}
+	c.AlertChecksStore = &AlertChecksStore{client: c}
+	c.AlertPoliciesStore = &AlertPoliciesStore{client: c}
+	c.RuleTemplatesStore = &RuleTemplatesStore{client: c}
 	c.UsersStore = &UsersStore{client: c}
 	c.OrganizationsStore = &OrganizationsStore{client: c}
 	c.ConfigStore = &ConfigStore{client: c}
 	c.MappingsStore = &MappingsStore{client: c}
 	c.OrganizationConfigStore = &OrganizationConfigStore{client: c}
+	c.NotificationsStore = &NotificationsStore{client: c}
+	c.TokensStore = &TokensStore{client: c}
+	c.InvitationsStore = &InvitationsStore{client: c}
+	c.PreferencesStore = &PreferencesStore{client: c}
+	c.CertificatesStore = &CertificatesStore{client: c}
+	c.GroupsStore = &GroupsStore{client: c}
+	c.WebhooksStore = &WebhooksStore{client: c}
+	c.WebhookDeliveriesStore = &WebhookDeliveriesStore{client: c}
 	return c
 }
 
@@ -124,6 +168,10 @@ func (c *Client) initialize(ctx context.Context) error {
 		if _, err := tx.CreateBucketIfNotExists(SchemaVersionBucket); err != nil {
 			return err
 		}
+		// Always create Schema bucket.
+		if _, err := tx.CreateBucketIfNotExists(SchemaBucket); err != nil {
+			return err
+		}
 		// Always create Organizations bucket.
 		if _, err := tx.CreateBucketIfNotExists(OrganizationsBucket); err != nil {
 			return err
@@ -164,6 +212,90 @@ func (c *Client) initialize(ctx context.Context) error {
 		if _, err := tx.CreateBucketIfNotExists(OrganizationConfigBucket); err != nil {
 			return err
 		}
+		// Always create Notifications bucket.
+		if _, err := tx.CreateBucketIfNotExists(NotificationsBucket); err != nil {
+			return err
+		}
+		// Always create Tokens bucket.
+		if _, err := tx.CreateBucketIfNotExists(TokensBucket); err != nil {
+			return err
+		}
+		// Always create Invitations bucket.
+		if _, err := tx.CreateBucketIfNotExists(InvitationsBucket); err != nil {
+			return err
+		}
+		// Always create Certificates bucket.
+		if _, err := tx.CreateBucketIfNotExists(CertificatesBucket); err != nil {
+			return err
+		}
+		// Always create Groups bucket.
+		if _, err := tx.CreateBucketIfNotExists(GroupsBucket); err != nil {
+			return err
+		}
+		// Always create Webhooks bucket.
+		if _, err := tx.CreateBucketIfNotExists(WebhooksBucket); err != nil {
+			return err
+		}
+		// Always create WebhookDeliveries bucket.
+		if _, err := tx.CreateBucketIfNotExists(WebhookDeliveriesBucket); err != nil {
+			return err
+		}
+		// Always create DashboardVersions bucket.
+		if _, err := tx.CreateBucketIfNotExists(DashboardVersionsBucket); err != nil {
+			return err
+		}
+		// Always create DashboardSnapshots bucket.
+		if _, err := tx.CreateBucketIfNotExists(DashboardSnapshotsBucket); err != nil {
+			return err
+		}
+		// Always create Folders bucket.
+		if _, err := tx.CreateBucketIfNotExists(FoldersBucket); err != nil {
+			return err
+		}
+		// Always create Reports bucket.
+		if _, err := tx.CreateBucketIfNotExists(ReportsBucket); err != nil {
+			return err
+		}
+		// Always create Annotations bucket.
+		if _, err := tx.CreateBucketIfNotExists(AnnotationsBucket); err != nil {
+			return err
+		}
+		// Always create SourceHealth bucket.
+		if _, err := tx.CreateBucketIfNotExists(SourceHealthBucket); err != nil {
+			return err
+		}
+		// Always create QueryHistory bucket.
+		if _, err := tx.CreateBucketIfNotExists(QueryHistoryBucket); err != nil {
+			return err
+		}
+		// Always create AlertEvents bucket.
+		if _, err := tx.CreateBucketIfNotExists(AlertEventsBucket); err != nil {
+			return err
+		}
+		// Always create AlertWebhooks bucket.
+		if _, err := tx.CreateBucketIfNotExists(AlertWebhooksBucket); err != nil {
+			return err
+		}
+		// Always create AlertChecks bucket.
+		if _, err := tx.CreateBucketIfNotExists(AlertChecksBucket); err != nil {
+			return err
+		}
+		// Always create AlertPolicies bucket.
+		if _, err := tx.CreateBucketIfNotExists(AlertPoliciesBucket); err != nil {
+			return err
+		}
+		// Always create RuleTemplates bucket.
+		if _, err := tx.CreateBucketIfNotExists(RuleTemplatesBucket); err != nil {
+			return err
+		}
+		// Always create Preferences bucket.
+		if _, err := tx.CreateBucketIfNotExists(PreferencesBucket); err != nil {
+			return err
+		}
+		// Always create Health bucket.
+		if _, err := tx.CreateBucketIfNotExists(HealthBucket); err != nil {
+			return err
+		}
 		return nil
 	}); err != nil {
 		return err
@@ -203,8 +335,72 @@ func (c *Client) migrate(ctx context.Context, build chronograf.BuildInfo) error
 		if err := c.OrganizationConfigStore.Migrate(ctx); err != nil {
 			return err
 		}
+		if err := c.NotificationsStore.Migrate(ctx); err != nil {
+			return err
+		}
+		if err := c.TokensStore.Migrate(ctx); err != nil {
+			return err
+		}
+		if err := c.InvitationsStore.Migrate(ctx); err != nil {
+			return err
+		}
+		if err := c.CertificatesStore.Migrate(ctx); err != nil {
+			return err
+		}
+		if err := c.GroupsStore.Migrate(ctx); err != nil {
+			return err
+		}
+		if err := c.WebhooksStore.Migrate(ctx); err != nil {
+			return err
+		}
+		if err := c.WebhookDeliveriesStore.Migrate(ctx); err != nil {
+			return err
+		}
+		if err := c.DashboardVersionsStore.Migrate(ctx); err != nil {
+			return err
+		}
+		if err := c.DashboardSnapshotsStore.Migrate(ctx); err != nil {
+			return err
+		}
+		if err := c.FoldersStore.Migrate(ctx); err != nil {
+			return err
+		}
+		if err := c.ReportsStore.Migrate(ctx); err != nil {
+			return err
+		}
+		if err := c.AnnotationsStore.Migrate(ctx); err != nil {
+			return err
+		}
+		if err := c.SourceHealthStore.Migrate(ctx); err != nil {
+			return err
+		}
+		if err := c.QueryHistoryStore.Migrate(ctx); err != nil {
+			return err
+		}
+		if err := c.AlertEventsStore.Migrate(ctx); err != nil {
+			return err
+		}
+		if err := c.AlertWebhooksStore.Migrate(ctx); err != nil {
+			return err
+		}
+		if err := c.AlertChecksStore.Migrate(ctx); err != nil {
+			return err
+		}
+		if err := c.AlertPoliciesStore.Migrate(ctx); err != nil {
+			return err
+		}
+		if err := c.RuleTemplatesStore.Migrate(ctx); err != nil {
+			return err
+		}
+		if err := c.PreferencesStore.Migrate(ctx); err != nil {
+			return err
+		}
 
-		MigrateAll(c)
+		if !c.SkipMigrations {
+			if err := MigrateAll(c); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -0,0 +1,59 @@
+package bolt
+
+import (
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt/internal"
+)
+
+// The protobuf encoding of the stores is exported for other backends, such
+// as etcd, so that every backend stores the same representation.
+
+// MarshalSource encodes a source
+func MarshalSource(s chronograf.Source) ([]byte, error) {
+	return internal.MarshalSource(s)
+}
+
+// UnmarshalSource decodes a source
+func UnmarshalSource(data []byte, s *chronograf.Source) error {
+	return internal.UnmarshalSource(data, s)
+}
+
+// MarshalServer encodes a server
+func MarshalServer(s chronograf.Server) ([]byte, error) {
+	return internal.MarshalServer(s)
+}
+
+// UnmarshalServer decodes a server
+func UnmarshalServer(data []byte, s *chronograf.Server) error {
+	return internal.UnmarshalServer(data, s)
+}
+
+// MarshalLayout encodes a layout
+func MarshalLayout(l chronograf.Layout) ([]byte, error) {
+	return internal.MarshalLayout(l)
+}
+
+// UnmarshalLayout decodes a layout
+func UnmarshalLayout(data []byte, l *chronograf.Layout) error {
+	return internal.UnmarshalLayout(data, l)
+}
+
+// MarshalDashboard encodes a dashboard
+func MarshalDashboard(d chronograf.Dashboard) ([]byte, error) {
+	return internal.MarshalDashboard(d)
+}
+
+// UnmarshalDashboard decodes a dashboard
+func UnmarshalDashboard(data []byte, d *chronograf.Dashboard) error {
+	return internal.UnmarshalDashboard(data, d)
+}
+
+// MarshalUser encodes a user
+func MarshalUser(u *chronograf.User) ([]byte, error) {
+	return internal.MarshalUser(u)
+}
+
+// UnmarshalUser decodes a user
+func UnmarshalUser(data []byte, u *chronograf.User) error {
+	return internal.UnmarshalUser(data, u)
+}
@@ -7,6 +7,7 @@ import (
 	bolt "github.com/coreos/bbolt"
 	"github.com/influxdata/influxdb/chronograf"
 	"github.com/influxdata/influxdb/chronograf/bolt/internal"
+	"github.com/influxdata/influxdb/kit/tracing"
 )
 
 // Ensure ConfigStore implements chronograf.ConfigStore.
@@ -25,6 +26,9 @@ type ConfigStore struct {
 }
 
 func (s *ConfigStore) Migrate(ctx context.Context) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	if _, err := s.Get(ctx); err != nil {
 		return s.Initialize(ctx)
 	}
@@ -32,6 +36,9 @@ func (s *ConfigStore) Migrate(ctx context.Context) error {
 }
 
 func (s *ConfigStore) Initialize(ctx context.Context) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	cfg := chronograf.Config{
 		Auth: chronograf.AuthConfig{
 			SuperAdminNewUsers: false,
@@ -41,6 +48,9 @@ func (s *ConfigStore) Initialize(ctx context.Context) error {
 }
 
 func (s *ConfigStore) Get(ctx context.Context) (*chronograf.Config, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	var cfg chronograf.Config
 	err := s.client.db.View(func(tx *bolt.Tx) error {
 		v := tx.Bucket(ConfigBucket).Get(configID)
@@ -57,6 +67,9 @@ func (s *ConfigStore) Get(ctx context.Context) (*chronograf.Config, error) {
 }
 
 func (s *ConfigStore) Update(ctx context.Context, cfg *chronograf.Config) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	if cfg == nil {
 		return fmt.Errorf("config provided was nil")
 	}
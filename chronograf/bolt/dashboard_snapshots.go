@@ -0,0 +1,141 @@
+package bolt
+
+import (
+	"context"
+
+	bolt "github.com/coreos/bbolt"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt/internal"
+	"github.com/influxdata/influxdb/kit/tracing"
+)
+
+// Ensure DashboardSnapshotsStore implements chronograf.DashboardSnapshotsStore.
+var _ chronograf.DashboardSnapshotsStore = &DashboardSnapshotsStore{}
+
+var (
+	// DashboardSnapshotsBucket is the bucket where dashboard snapshots are
+	// stored. It holds a nested bucket of snapshots for each dashboard.
+	DashboardSnapshotsBucket = []byte("dashboardsnapshotsv1")
+)
+
+// DashboardSnapshotsStore uses bolt to store and retrieve dashboard snapshots
+type DashboardSnapshotsStore struct {
+	client *Client
+}
+
+// Migrate is a noop as there is no previous schema of dashboard snapshots
+func (s *DashboardSnapshotsStore) Migrate(ctx context.Context) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return nil
+}
+
+// All returns the snapshots of a dashboard, oldest first
+func (s *DashboardSnapshotsStore) All(ctx context.Context, id chronograf.DashboardID) ([]chronograf.DashboardSnapshot, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	snapshots := []chronograf.DashboardSnapshot{}
+	err := s.client.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(DashboardSnapshotsBucket).Bucket(itob(int(id)))
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			var snapshot chronograf.DashboardSnapshot
+			if err := internal.UnmarshalDashboardSnapshot(v, &snapshot); err != nil {
+				return err
+			}
+			snapshots = append(snapshots, snapshot)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshots, nil
+}
+
+// Add stores a new snapshot of the dashboard of snap
+func (s *DashboardSnapshotsStore) Add(ctx context.Context, snap *chronograf.DashboardSnapshot) (*chronograf.DashboardSnapshot, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	if err := s.client.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.Bucket(DashboardSnapshotsBucket).CreateBucketIfNotExists(itob(int(snap.DashboardID)))
+		if err != nil {
+			return err
+		}
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		snap.ID = int(seq)
+		if snap.CreatedAt.IsZero() {
+			snap.CreatedAt = s.client.Now().UTC()
+		}
+
+		data, err := internal.MarshalDashboardSnapshot(snap)
+		if err != nil {
+			return err
+		}
+		return b.Put(itob(snap.ID), data)
+	}); err != nil {
+		return nil, err
+	}
+
+	return snap, nil
+}
+
+// Delete removes a snapshot of a dashboard
+func (s *DashboardSnapshotsStore) Delete(ctx context.Context, snap *chronograf.DashboardSnapshot) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(DashboardSnapshotsBucket).Bucket(itob(int(snap.DashboardID)))
+		if b == nil || b.Get(itob(snap.ID)) == nil {
+			return chronograf.ErrDashboardSnapshotNotFound
+		}
+		return b.Delete(itob(snap.ID))
+	})
+}
+
+// DeleteAll removes all snapshots of a dashboard
+func (s *DashboardSnapshotsStore) DeleteAll(ctx context.Context, id chronograf.DashboardID) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		err := tx.Bucket(DashboardSnapshotsBucket).DeleteBucket(itob(int(id)))
+		if err == bolt.ErrBucketNotFound {
+			return nil
+		}
+		return err
+	})
+}
+
+// Get retrieves a snapshot of a dashboard
+func (s *DashboardSnapshotsStore) Get(ctx context.Context, id chronograf.DashboardID, snapshot int) (*chronograf.DashboardSnapshot, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	var snap chronograf.DashboardSnapshot
+	if err := s.client.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(DashboardSnapshotsBucket).Bucket(itob(int(id)))
+		if b == nil {
+			return chronograf.ErrDashboardSnapshotNotFound
+		}
+		v := b.Get(itob(snapshot))
+		if v == nil {
+			return chronograf.ErrDashboardSnapshotNotFound
+		}
+		return internal.UnmarshalDashboardSnapshot(v, &snap)
+	}); err != nil {
+		return nil, err
+	}
+
+	return &snap, nil
+}
@@ -0,0 +1,87 @@
+package bolt_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func TestDashboardSnapshotsStore(t *testing.T) {
+	client, err := NewTestClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	s := client.DashboardSnapshotsStore
+
+	snap := &chronograf.DashboardSnapshot{
+		DashboardID: 1,
+		Name:        "Outage of November 5th",
+		Dashboard:   chronograf.Dashboard{ID: 1, Name: "Flux Capacitor", Organization: "default"},
+		Lower:       "2015-10-21T07:28:00Z",
+		Upper:       "2015-10-21T08:28:00Z",
+		Results: []chronograf.SnapshotResult{
+			{
+				CellID: "a",
+				Query:  "SELECT mean(gigawatts) FROM capacitor",
+				Source: "/chronograf/v1/sources/1",
+				Result: []byte(`{"results":[]}`),
+			},
+			{
+				CellID: "b",
+				Query:  "SELECT max(mph) FROM delorean",
+				Source: "/chronograf/v1/sources/2",
+				Error:  "source not found",
+			},
+		},
+		Author: "marty",
+	}
+	if _, err := s.Add(ctx, snap); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if snap.ID != 1 || !snap.CreatedAt.Equal(TestNow) {
+		t.Errorf("Add() ID = %d at %v, want 1 at %v", snap.ID, snap.CreatedAt, TestNow)
+	}
+	other := &chronograf.DashboardSnapshot{
+		DashboardID: 1,
+		Dashboard:   chronograf.Dashboard{ID: 1, Name: "Flux Capacitor", Organization: "default"},
+	}
+	if _, err := s.Add(ctx, other); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+
+	got, err := s.Get(ctx, 1, 1)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if diff := cmp.Diff(got.Results, snap.Results); diff != "" || got.Name != snap.Name || got.Lower != snap.Lower || got.Upper != snap.Upper {
+		t.Errorf("Get() = %#v, want %#v", got, snap)
+	}
+	if _, err := s.Get(ctx, 2, 1); err != chronograf.ErrDashboardSnapshotNotFound {
+		t.Errorf("Get() of another dashboard error = %v, want %v", err, chronograf.ErrDashboardSnapshotNotFound)
+	}
+
+	if err := s.Delete(ctx, snap); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if err := s.Delete(ctx, snap); err != chronograf.ErrDashboardSnapshotNotFound {
+		t.Errorf("Delete() of a removed snapshot error = %v, want %v", err, chronograf.ErrDashboardSnapshotNotFound)
+	}
+	if snapshots, err := s.All(ctx, 1); err != nil || len(snapshots) != 1 || snapshots[0].ID != 2 {
+		t.Errorf("All() after Delete() = %#v, %v", snapshots, err)
+	}
+
+	if err := s.DeleteAll(ctx, 1); err != nil {
+		t.Fatalf("DeleteAll() error = %v", err)
+	}
+	if snapshots, err := s.All(ctx, 1); err != nil || len(snapshots) != 0 {
+		t.Errorf("All() after DeleteAll() = %#v, %v", snapshots, err)
+	}
+	if err := s.DeleteAll(ctx, 1); err != nil {
+		t.Errorf("DeleteAll() of removed snapshots error = %v", err)
+	}
+}
@@ -0,0 +1,127 @@
+package bolt
+
+import (
+	"context"
+
+	bolt "github.com/coreos/bbolt"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt/internal"
+	"github.com/influxdata/influxdb/kit/tracing"
+)
+
+// Ensure DashboardVersionsStore implements chronograf.DashboardVersionsStore.
+var _ chronograf.DashboardVersionsStore = &DashboardVersionsStore{}
+
+var (
+	// DashboardVersionsBucket is the bucket where dashboard revisions are
+	// stored. It holds a nested bucket of revisions for each dashboard.
+	DashboardVersionsBucket = []byte("dashboardversionsv1")
+)
+
+// DashboardVersionsStore uses bolt to store and retrieve dashboard revisions
+type DashboardVersionsStore struct {
+	client *Client
+}
+
+// Migrate is a noop as there is no previous schema of dashboard revisions
+func (s *DashboardVersionsStore) Migrate(ctx context.Context) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return nil
+}
+
+// All returns the revisions of a dashboard, oldest first
+func (s *DashboardVersionsStore) All(ctx context.Context, id chronograf.DashboardID) ([]chronograf.DashboardVersion, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	versions := []chronograf.DashboardVersion{}
+	err := s.client.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(DashboardVersionsBucket).Bucket(itob(int(id)))
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			var version chronograf.DashboardVersion
+			if err := internal.UnmarshalDashboardVersion(v, &version); err != nil {
+				return err
+			}
+			versions = append(versions, version)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return versions, nil
+}
+
+// Add records the next revision of the dashboard of v
+func (s *DashboardVersionsStore) Add(ctx context.Context, v *chronograf.DashboardVersion) (*chronograf.DashboardVersion, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	if err := s.client.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.Bucket(DashboardVersionsBucket).CreateBucketIfNotExists(itob(int(v.DashboardID)))
+		if err != nil {
+			return err
+		}
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		v.Revision = int(seq)
+		if v.CreatedAt.IsZero() {
+			v.CreatedAt = s.client.Now().UTC()
+		}
+
+		data, err := internal.MarshalDashboardVersion(v)
+		if err != nil {
+			return err
+		}
+		return b.Put(itob(v.Revision), data)
+	}); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// Delete removes all revisions of a dashboard
+func (s *DashboardVersionsStore) Delete(ctx context.Context, id chronograf.DashboardID) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		err := tx.Bucket(DashboardVersionsBucket).DeleteBucket(itob(int(id)))
+		if err == bolt.ErrBucketNotFound {
+			return nil
+		}
+		return err
+	})
+}
+
+// Get retrieves a revision of a dashboard
+func (s *DashboardVersionsStore) Get(ctx context.Context, id chronograf.DashboardID, revision int) (*chronograf.DashboardVersion, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	var version chronograf.DashboardVersion
+	if err := s.client.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(DashboardVersionsBucket).Bucket(itob(int(id)))
+		if b == nil {
+			return chronograf.ErrDashboardVersionNotFound
+		}
+		v := b.Get(itob(revision))
+		if v == nil {
+			return chronograf.ErrDashboardVersionNotFound
+		}
+		return internal.UnmarshalDashboardVersion(v, &version)
+	}); err != nil {
+		return nil, err
+	}
+
+	return &version, nil
+}
@@ -0,0 +1,80 @@
+package bolt_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func TestDashboardVersionsStore(t *testing.T) {
+	client, err := NewTestClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	s := client.DashboardVersionsStore
+
+	for _, name := range []string{"Flux Capacitor", "Time Circuits"} {
+		v := &chronograf.DashboardVersion{
+			DashboardID: 1,
+			Dashboard:   chronograf.Dashboard{ID: 1, Name: name, Organization: "default"},
+			Author:      "marty",
+		}
+		if _, err := s.Add(ctx, v); err != nil {
+			t.Fatalf("Add() error = %v", err)
+		}
+	}
+	other := &chronograf.DashboardVersion{
+		DashboardID: 2,
+		Dashboard:   chronograf.Dashboard{ID: 2, Name: "DeLorean", Organization: "default"},
+	}
+	if _, err := s.Add(ctx, other); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if other.Revision != 1 || !other.CreatedAt.Equal(TestNow) {
+		t.Errorf("Add() revision = %d at %v, want 1 at %v", other.Revision, other.CreatedAt, TestNow)
+	}
+
+	versions, err := s.All(ctx, 1)
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	if len(versions) != 2 {
+		t.Fatalf("All() returned %d revisions, want 2", len(versions))
+	}
+	for i, name := range []string{"Flux Capacitor", "Time Circuits"} {
+		if versions[i].Revision != i+1 || versions[i].Dashboard.Name != name || versions[i].Author != "marty" {
+			t.Errorf("All()[%d] = %#v, want revision %d of %s", i, versions[i], i+1, name)
+		}
+	}
+
+	v, err := s.Get(ctx, 1, 1)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if v.Dashboard.Name != "Flux Capacitor" {
+		t.Errorf("Get() dashboard = %s, want Flux Capacitor", v.Dashboard.Name)
+	}
+	if _, err := s.Get(ctx, 1, 3); err != chronograf.ErrDashboardVersionNotFound {
+		t.Errorf("Get() of a missing revision error = %v, want %v", err, chronograf.ErrDashboardVersionNotFound)
+	}
+
+	if err := s.Delete(ctx, 1); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if versions, err := s.All(ctx, 1); err != nil || len(versions) != 0 {
+		t.Errorf("All() after Delete() = %#v, %v", versions, err)
+	}
+	if _, err := s.Get(ctx, 1, 1); err != chronograf.ErrDashboardVersionNotFound {
+		t.Errorf("Get() after Delete() error = %v, want %v", err, chronograf.ErrDashboardVersionNotFound)
+	}
+	if err := s.Delete(ctx, 1); err != nil {
+		t.Errorf("Delete() of removed revisions error = %v", err)
+	}
+	if versions, err := s.All(ctx, 2); err != nil || len(versions) != 1 {
+		t.Errorf("All() of another dashboard after Delete() = %#v, %v", versions, err)
+	}
+}
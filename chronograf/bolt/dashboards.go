@@ -7,6 +7,7 @@ import (
 	bolt "github.com/coreos/bbolt"
 	"github.com/influxdata/influxdb/chronograf"
 	"github.com/influxdata/influxdb/chronograf/bolt/internal"
+	"github.com/influxdata/influxdb/kit/tracing"
 )
 
 // Ensure DashboardsStore implements chronograf.DashboardsStore.
@@ -23,6 +24,9 @@ type DashboardsStore struct {
 
 // AddIDs is a migration function that adds ID information to existing dashboards
 func (d *DashboardsStore) AddIDs(ctx context.Context, boards []chronograf.Dashboard) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	for _, board := range boards {
 		update := false
 		for i, cell := range board.Cells {
@@ -49,6 +53,9 @@ func (d *DashboardsStore) AddIDs(ctx context.Context, boards []chronograf.Dashbo
 
 // Migrate updates the dashboards at runtime
 func (d *DashboardsStore) Migrate(ctx context.Context) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	// 1. Add UUIDs to cells without one
 	boards, err := d.All(ctx)
 	if err != nil {
@@ -77,6 +84,9 @@ func (d *DashboardsStore) Migrate(ctx context.Context) error {
 
 // All returns all known dashboards
 func (d *DashboardsStore) All(ctx context.Context) ([]chronograf.Dashboard, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	var srcs []chronograf.Dashboard
 	if err := d.client.db.View(func(tx *bolt.Tx) error {
 		if err := tx.Bucket(DashboardsBucket).ForEach(func(k, v []byte) error {
@@ -99,6 +109,9 @@ func (d *DashboardsStore) All(ctx context.Context) ([]chronograf.Dashboard, erro
 
 // Add creates a new Dashboard in the DashboardsStore
 func (d *DashboardsStore) Add(ctx context.Context, src chronograf.Dashboard) (chronograf.Dashboard, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	if err := d.client.db.Update(func(tx *bolt.Tx) error {
 		b := tx.Bucket(DashboardsBucket)
 		id, _ := b.NextSequence()
@@ -128,6 +141,9 @@ func (d *DashboardsStore) Add(ctx context.Context, src chronograf.Dashboard) (ch
 
 // Get returns a Dashboard if the id exists.
 func (d *DashboardsStore) Get(ctx context.Context, id chronograf.DashboardID) (chronograf.Dashboard, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	var src chronograf.Dashboard
 	if err := d.client.db.View(func(tx *bolt.Tx) error {
 		strID := strconv.Itoa(int(id))
@@ -146,6 +162,9 @@ func (d *DashboardsStore) Get(ctx context.Context, id chronograf.DashboardID) (c
 
 // Delete the dashboard from DashboardsStore
 func (d *DashboardsStore) Delete(ctx context.Context, dash chronograf.Dashboard) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	if err := d.client.db.Update(func(tx *bolt.Tx) error {
 		strID := strconv.Itoa(int(dash.ID))
 		if err := tx.Bucket(DashboardsBucket).Delete([]byte(strID)); err != nil {
@@ -161,6 +180,9 @@ func (d *DashboardsStore) Delete(ctx context.Context, dash chronograf.Dashboard)
 
 // Update the dashboard in DashboardsStore
 func (d *DashboardsStore) Update(ctx context.Context, dash chronograf.Dashboard) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	if err := d.client.db.Update(func(tx *bolt.Tx) error {
 		// Get an existing dashboard with the same ID.
 		b := tx.Bucket(DashboardsBucket)
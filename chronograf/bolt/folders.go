@@ -0,0 +1,130 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+
+	bolt "github.com/coreos/bbolt"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt/internal"
+	"github.com/influxdata/influxdb/kit/tracing"
+)
+
+// Ensure FoldersStore implements chronograf.FoldersStore.
+var _ chronograf.FoldersStore = &FoldersStore{}
+
+var (
+	// FoldersBucket is the bucket where dashboard folders are stored.
+	FoldersBucket = []byte("foldersv1")
+)
+
+// FoldersStore uses bolt to store and retrieve dashboard folders
+type FoldersStore struct {
+	client *Client
+}
+
+// Migrate is a noop as there is no previous schema of folders
+func (s *FoldersStore) Migrate(ctx context.Context) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return nil
+}
+
+// All returns all folders
+func (s *FoldersStore) All(ctx context.Context) ([]chronograf.Folder, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	folders := []chronograf.Folder{}
+	err := s.client.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(FoldersBucket).ForEach(func(k, v []byte) error {
+			var f chronograf.Folder
+			if err := internal.UnmarshalFolder(v, &f); err != nil {
+				return err
+			}
+			folders = append(folders, f)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return folders, nil
+}
+
+// Add creates a new folder in the FoldersStore
+func (s *FoldersStore) Add(ctx context.Context, f *chronograf.Folder) (*chronograf.Folder, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	if err := s.client.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(FoldersBucket)
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		f.ID = fmt.Sprintf("%d", seq)
+
+		v, err := internal.MarshalFolder(f)
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(f.ID), v)
+	}); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// Delete the folder from the FoldersStore
+func (s *FoldersStore) Delete(ctx context.Context, f *chronograf.Folder) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	if _, err := s.Get(ctx, f.ID); err != nil {
+		return err
+	}
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(FoldersBucket).Delete([]byte(f.ID))
+	})
+}
+
+// Get retrieves a folder by ID
+func (s *FoldersStore) Get(ctx context.Context, id string) (*chronograf.Folder, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	var f chronograf.Folder
+	if err := s.client.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(FoldersBucket).Get([]byte(id))
+		if v == nil {
+			return chronograf.ErrFolderNotFound
+		}
+		return internal.UnmarshalFolder(v, &f)
+	}); err != nil {
+		return nil, err
+	}
+
+	return &f, nil
+}
+
+// Update replaces the folder information
+func (s *FoldersStore) Update(ctx context.Context, f *chronograf.Folder) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(FoldersBucket)
+		if v := b.Get([]byte(f.ID)); v == nil {
+			return chronograf.ErrFolderNotFound
+		}
+		v, err := internal.MarshalFolder(f)
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(f.ID), v)
+	})
+}
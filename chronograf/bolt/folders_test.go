@@ -0,0 +1,69 @@
+package bolt_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func TestFoldersStore(t *testing.T) {
+	client, err := NewTestClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	s := client.FoldersStore
+
+	ops := &chronograf.Folder{
+		Name:         "Operations",
+		Organization: "default",
+	}
+	if _, err := s.Add(ctx, ops); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	db := &chronograf.Folder{
+		Name:         "Databases",
+		Parent:       ops.ID,
+		Organization: "default",
+		Role:         "editor",
+	}
+	if _, err := s.Add(ctx, db); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+
+	got, err := s.All(ctx)
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	want := []chronograf.Folder{*ops, *db}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("All() diff (-got +want):\n%s", diff)
+	}
+
+	db.Parent = ""
+	db.Name = "Storage"
+	if err := s.Update(ctx, db); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	f, err := s.Get(ctx, db.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if diff := cmp.Diff(f, db); diff != "" {
+		t.Errorf("Get() after Update() diff (-got +want):\n%s", diff)
+	}
+
+	if err := s.Delete(ctx, ops); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := s.Get(ctx, ops.ID); err != chronograf.ErrFolderNotFound {
+		t.Errorf("Get() after Delete() error = %v, want %v", err, chronograf.ErrFolderNotFound)
+	}
+	if err := s.Update(ctx, ops); err != chronograf.ErrFolderNotFound {
+		t.Errorf("Update() of a removed folder error = %v, want %v", err, chronograf.ErrFolderNotFound)
+	}
+}
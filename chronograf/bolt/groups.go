@@ -0,0 +1,130 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+
+	bolt "github.com/coreos/bbolt"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt/internal"
+	"github.com/influxdata/influxdb/kit/tracing"
+)
+
+// Ensure GroupsStore implements chronograf.GroupsStore.
+var _ chronograf.GroupsStore = &GroupsStore{}
+
+var (
+	// GroupsBucket is the bucket where the groups of users are stored.
+	GroupsBucket = []byte("groupsv1")
+)
+
+// GroupsStore uses bolt to store and retrieve groups of users
+type GroupsStore struct {
+	client *Client
+}
+
+// Migrate is a noop as there is no previous schema of groups
+func (s *GroupsStore) Migrate(ctx context.Context) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return nil
+}
+
+// All returns all groups
+func (s *GroupsStore) All(ctx context.Context) ([]chronograf.Group, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	groups := []chronograf.Group{}
+	err := s.client.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(GroupsBucket).ForEach(func(k, v []byte) error {
+			var g chronograf.Group
+			if err := internal.UnmarshalGroup(v, &g); err != nil {
+				return err
+			}
+			groups = append(groups, g)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
+
+// Add creates a new group in the GroupsStore
+func (s *GroupsStore) Add(ctx context.Context, g *chronograf.Group) (*chronograf.Group, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	if err := s.client.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(GroupsBucket)
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		g.ID = fmt.Sprintf("%d", seq)
+
+		v, err := internal.MarshalGroup(g)
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(g.ID), v)
+	}); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// Delete the group from the GroupsStore
+func (s *GroupsStore) Delete(ctx context.Context, g *chronograf.Group) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	if _, err := s.Get(ctx, g.ID); err != nil {
+		return err
+	}
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(GroupsBucket).Delete([]byte(g.ID))
+	})
+}
+
+// Get retrieves a group by ID
+func (s *GroupsStore) Get(ctx context.Context, id string) (*chronograf.Group, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	var g chronograf.Group
+	if err := s.client.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(GroupsBucket).Get([]byte(id))
+		if v == nil {
+			return chronograf.ErrGroupNotFound
+		}
+		return internal.UnmarshalGroup(v, &g)
+	}); err != nil {
+		return nil, err
+	}
+
+	return &g, nil
+}
+
+// Update replaces the group in the GroupsStore
+func (s *GroupsStore) Update(ctx context.Context, g *chronograf.Group) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(GroupsBucket)
+		if v := b.Get([]byte(g.ID)); v == nil {
+			return chronograf.ErrGroupNotFound
+		}
+		v, err := internal.MarshalGroup(g)
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(g.ID), v)
+	})
+}
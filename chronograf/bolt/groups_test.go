@@ -0,0 +1,73 @@
+package bolt_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func TestGroupsStore(t *testing.T) {
+	client, err := NewTestClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	s := client.GroupsStore
+
+	oncall := &chronograf.Group{
+		Name:  "on-call",
+		Users: []uint64{1, 2},
+		Roles: []chronograf.Role{{Name: "editor", Organization: "default"}},
+	}
+	sre := &chronograf.Group{
+		Name:  "sre",
+		Users: []uint64{3},
+		Roles: []chronograf.Role{
+			{Name: "admin", Organization: "default"},
+			{Name: "viewer", Organization: "2"},
+		},
+	}
+	for _, g := range []*chronograf.Group{oncall, sre} {
+		if _, err := s.Add(ctx, g); err != nil {
+			t.Fatalf("Add() error = %v", err)
+		}
+	}
+
+	got, err := s.All(ctx)
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	want := []chronograf.Group{*oncall, *sre}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("All() diff (-got +want):\n%s", diff)
+	}
+
+	oncall.Users = []uint64{2, 4}
+	if err := s.Update(ctx, oncall); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	g, err := s.Get(ctx, oncall.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if diff := cmp.Diff(g, oncall); diff != "" {
+		t.Errorf("Get() diff (-got +want):\n%s", diff)
+	}
+
+	if err := s.Delete(ctx, sre); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := s.Get(ctx, sre.ID); err != chronograf.ErrGroupNotFound {
+		t.Errorf("Get() after Delete() error = %v, want %v", err, chronograf.ErrGroupNotFound)
+	}
+	if err := s.Update(ctx, sre); err != chronograf.ErrGroupNotFound {
+		t.Errorf("Update() of a removed group error = %v, want %v", err, chronograf.ErrGroupNotFound)
+	}
+	if err := s.Delete(ctx, sre); err != chronograf.ErrGroupNotFound {
+		t.Errorf("Delete() of a removed group error = %v, want %v", err, chronograf.ErrGroupNotFound)
+	}
+}
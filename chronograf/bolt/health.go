@@ -0,0 +1,27 @@
+package bolt
+
+import (
+	"context"
+
+	bolt "github.com/coreos/bbolt"
+)
+
+var (
+	// HealthBucket is the bucket written to check that the boltDB file is
+	// writable.
+	HealthBucket = []byte("healthv1")
+	// healthProbeKey is the key of the time of the last check
+	healthProbeKey = []byte("probe")
+)
+
+// CheckWritable checks that the boltDB file is writable by writing the time
+// of the check to the health bucket, as probes of Chronograf do
+func (c *Client) CheckWritable(ctx context.Context) error {
+	return c.db.Update(func(tx *bolt.Tx) error {
+		now, err := c.Now().UTC().MarshalText()
+		if err != nil {
+			return err
+		}
+		return tx.Bucket(HealthBucket).Put(healthProbeKey, now)
+	})
+}
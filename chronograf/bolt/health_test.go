@@ -0,0 +1,26 @@
+package bolt_test
+
+import (
+	"context"
+	"testing"
+)
+
+func TestClient_CheckWritable(t *testing.T) {
+	client, err := NewTestClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	if err := client.CheckWritable(context.Background()); err != nil {
+		t.Errorf("CheckWritable() error = %v", err)
+	}
+
+	// A closed boltDB file cannot be written
+	if err := client.Client.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.CheckWritable(context.Background()); err == nil {
+		t.Errorf("CheckWritable() of closed database error = nil")
+	}
+}
@@ -3,6 +3,8 @@ package internal
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/influxdata/influxdb/chronograf"
@@ -47,6 +49,10 @@ func MarshalSource(s chronograf.Source) ([]byte, error) {
 		Organization:       s.Organization,
 		Role:               s.Role,
 		DefaultRP:          s.DefaultRP,
+		TLSCA:              s.TLSCA,
+		TLSCert:            s.TLSCert,
+		TLSKey:             s.TLSKey,
+		Discovered:         s.Discovered,
 	})
 }
 
@@ -71,6 +77,10 @@ func UnmarshalSource(data []byte, s *chronograf.Source) error {
 	s.Organization = pb.Organization
 	s.Role = pb.Role
 	s.DefaultRP = pb.DefaultRP
+	s.TLSCA = pb.TLSCA
+	s.TLSCert = pb.TLSCert
+	s.TLSKey = pb.TLSKey
+	s.Discovered = pb.Discovered
 	return nil
 }
 
@@ -296,6 +306,7 @@ func MarshalDashboard(d chronograf.Dashboard) ([]byte, error) {
 			SortBy:           sortBy,
 			Wrapping:         c.TableOptions.Wrapping,
 			FixFirstColumn:   c.TableOptions.FixFirstColumn,
+			PageSize:         c.TableOptions.PageSize,
 		}
 
 		decimalPlaces := &DecimalPlaces{
@@ -309,6 +320,8 @@ func MarshalDashboard(d chronograf.Dashboard) ([]byte, error) {
 				InternalName: field.InternalName,
 				DisplayName:  field.DisplayName,
 				Visible:      field.Visible,
+				Format:       field.Format,
+				Align:        field.Align,
 			}
 		}
 
@@ -331,6 +344,8 @@ func MarshalDashboard(d chronograf.Dashboard) ([]byte, error) {
 			FieldOptions:  fieldOptions,
 			TimeFormat:    c.TimeFormat,
 			DecimalPlaces: decimalPlaces,
+			Prefix:        c.Prefix,
+			Suffix:        c.Suffix,
 		}
 	}
 	templates := make([]*Template, len(d.Templates))
@@ -360,6 +375,10 @@ func MarshalDashboard(d chronograf.Dashboard) ([]byte, error) {
 				Measurement: t.Query.Measurement,
 				TagKey:      t.Query.TagKey,
 				FieldKey:    t.Query.FieldKey,
+				Flux:        t.Query.Flux,
+				Url:         t.Query.URL,
+				Format:      t.Query.Format,
+				Column:      t.Query.Column,
 			}
 		}
 		templates[i] = template
@@ -370,6 +389,9 @@ func MarshalDashboard(d chronograf.Dashboard) ([]byte, error) {
 		Templates:    templates,
 		Name:         d.Name,
 		Organization: d.Organization,
+		Folder:       d.Folder,
+		CacheTTL:     d.CacheTTL,
+		Slug:         d.Slug,
 	})
 }
 
@@ -470,6 +492,7 @@ func UnmarshalDashboard(data []byte, d *chronograf.Dashboard) error {
 			tableOptions.VerticalTimeAxis = c.TableOptions.VerticalTimeAxis
 			tableOptions.Wrapping = c.TableOptions.Wrapping
 			tableOptions.FixFirstColumn = c.TableOptions.FixFirstColumn
+			tableOptions.PageSize = c.TableOptions.PageSize
 		}
 
 		fieldOptions := make([]chronograf.RenamableField, len(c.FieldOptions))
@@ -478,6 +501,8 @@ func UnmarshalDashboard(data []byte, d *chronograf.Dashboard) error {
 			fieldOptions[i].InternalName = field.InternalName
 			fieldOptions[i].DisplayName = field.DisplayName
 			fieldOptions[i].Visible = field.Visible
+			fieldOptions[i].Format = field.Format
+			fieldOptions[i].Align = field.Align
 		}
 
 		decimalPlaces := chronograf.DecimalPlaces{}
@@ -512,6 +537,8 @@ func UnmarshalDashboard(data []byte, d *chronograf.Dashboard) error {
 			FieldOptions:  fieldOptions,
 			TimeFormat:    c.TimeFormat,
 			DecimalPlaces: decimalPlaces,
+			Prefix:        c.Prefix,
+			Suffix:        c.Suffix,
 		}
 	}
 
@@ -545,6 +572,10 @@ func UnmarshalDashboard(data []byte, d *chronograf.Dashboard) error {
 				Measurement: t.Query.Measurement,
 				TagKey:      t.Query.TagKey,
 				FieldKey:    t.Query.FieldKey,
+				Flux:        t.Query.Flux,
+				URL:         t.Query.Url,
+				Format:      t.Query.Format,
+				Column:      t.Query.Column,
 			}
 		}
 		templates[i] = template
@@ -555,6 +586,9 @@ func UnmarshalDashboard(data []byte, d *chronograf.Dashboard) error {
 	d.Templates = templates
 	d.Name = pb.Name
 	d.Organization = pb.Organization
+	d.Folder = pb.Folder
+	d.CacheTTL = pb.CacheTTL
+	d.Slug = pb.Slug
 	return nil
 }
 
@@ -606,12 +640,18 @@ func MarshalUser(u *chronograf.User) ([]byte, error) {
 		}
 	}
 	return MarshalUserPB(&User{
-		ID:         u.ID,
-		Name:       u.Name,
-		Provider:   u.Provider,
-		Scheme:     u.Scheme,
-		Roles:      roles,
-		SuperAdmin: u.SuperAdmin,
+		ID:                 u.ID,
+		Name:               u.Name,
+		Provider:           u.Provider,
+		Scheme:             u.Scheme,
+		Roles:              roles,
+		SuperAdmin:         u.SuperAdmin,
+		Status:             u.Status,
+		PasswordHash:       u.PasswordHash,
+		TOTPSecret:         u.TOTPSecret,
+		TOTPEnabled:        u.TOTPEnabled,
+		TOTPCounter:        u.TOTPCounter,
+		RecoveryCodeHashes: u.RecoveryCodeHashes,
 	})
 }
 
@@ -640,6 +680,12 @@ func UnmarshalUser(data []byte, u *chronograf.User) error {
 	u.Provider = pb.Provider
 	u.Scheme = pb.Scheme
 	u.SuperAdmin = pb.SuperAdmin
+	u.Status = pb.Status
+	u.PasswordHash = pb.PasswordHash
+	u.TOTPSecret = pb.TOTPSecret
+	u.TOTPEnabled = pb.TOTPEnabled
+	u.TOTPCounter = pb.TOTPCounter
+	u.RecoveryCodeHashes = pb.RecoveryCodeHashes
 	u.Roles = roles
 
 	return nil
@@ -683,11 +729,20 @@ func UnmarshalRolePB(data []byte, r *Role) error {
 
 // MarshalOrganization encodes a organization to binary protobuf format.
 func MarshalOrganization(o *chronograf.Organization) ([]byte, error) {
+	var quotas *OrganizationQuotas
+	if o.Quotas != nil {
+		quotas = &OrganizationQuotas{
+			MaxDashboards:     int64(o.Quotas.MaxDashboards),
+			MaxUsers:          int64(o.Quotas.MaxUsers),
+			MaxKapacitorRules: int64(o.Quotas.MaxKapacitorRules),
+		}
+	}
 
 	return MarshalOrganizationPB(&Organization{
 		ID:          o.ID,
 		Name:        o.Name,
 		DefaultRole: o.DefaultRole,
+		Quotas:      quotas,
 	})
 }
 
@@ -705,6 +760,13 @@ func UnmarshalOrganization(data []byte, o *chronograf.Organization) error {
 	o.ID = pb.ID
 	o.Name = pb.Name
 	o.DefaultRole = pb.DefaultRole
+	if pb.Quotas != nil {
+		o.Quotas = &chronograf.OrganizationQuotas{
+			MaxDashboards:     int(pb.Quotas.MaxDashboards),
+			MaxUsers:          int(pb.Quotas.MaxUsers),
+			MaxKapacitorRules: int(pb.Quotas.MaxKapacitorRules),
+		}
+	}
 
 	return nil
 }
@@ -718,7 +780,10 @@ func UnmarshalOrganizationPB(data []byte, o *Organization) error {
 func MarshalConfig(c *chronograf.Config) ([]byte, error) {
 	return MarshalConfigPB(&Config{
 		Auth: &AuthConfig{
-			SuperAdminNewUsers: c.Auth.SuperAdminNewUsers,
+			SuperAdminNewUsers:    c.Auth.SuperAdminNewUsers,
+			AutoProvisionNewUsers: c.Auth.AutoProvisionNewUsers,
+			NewUsersOrganization:  c.Auth.NewUsersOrganization,
+			NewUsersRole:          c.Auth.NewUsersRole,
 		},
 	})
 }
@@ -738,6 +803,9 @@ func UnmarshalConfig(data []byte, c *chronograf.Config) error {
 		return fmt.Errorf("auth config is nil")
 	}
 	c.Auth.SuperAdminNewUsers = pb.Auth.SuperAdminNewUsers
+	c.Auth.AutoProvisionNewUsers = pb.Auth.AutoProvisionNewUsers
+	c.Auth.NewUsersOrganization = pb.Auth.NewUsersOrganization
+	c.Auth.NewUsersRole = pb.Auth.NewUsersRole
 
 	return nil
 }
@@ -831,6 +899,7 @@ func MarshalMapping(m *chronograf.Mapping) ([]byte, error) {
 		ProviderOrganization: m.ProviderOrganization,
 		ID:                   m.ID,
 		Organization:         m.Organization,
+		Role:                 m.Role,
 	})
 }
 
@@ -851,6 +920,7 @@ func UnmarshalMapping(data []byte, m *chronograf.Mapping) error {
 	m.ProviderOrganization = pb.ProviderOrganization
 	m.Organization = pb.Organization
 	m.ID = pb.ID
+	m.Role = pb.Role
 
 	return nil
 }
@@ -859,3 +929,928 @@ func UnmarshalMapping(data []byte, m *chronograf.Mapping) error {
 func UnmarshalMappingPB(data []byte, m *Mapping) error {
 	return proto.Unmarshal(data, m)
 }
+
+// MarshalNotification encodes a notification to binary protobuf format.
+func MarshalNotification(n *chronograf.Notification) ([]byte, error) {
+	return proto.Marshal(&Notification{
+		ID:           n.ID,
+		UserID:       n.UserID,
+		Organization: n.Organization,
+		Type:         n.Type,
+		Title:        n.Title,
+		Message:      n.Message,
+		Link:         n.Link,
+		Read:         n.Read,
+		CreatedAt:    n.CreatedAt.UnixNano(),
+	})
+}
+
+// UnmarshalNotification decodes a notification from binary protobuf data.
+func UnmarshalNotification(data []byte, n *chronograf.Notification) error {
+	var pb Notification
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+
+	n.ID = pb.ID
+	n.UserID = pb.UserID
+	n.Organization = pb.Organization
+	n.Type = pb.Type
+	n.Title = pb.Title
+	n.Message = pb.Message
+	n.Link = pb.Link
+	n.Read = pb.Read
+	n.CreatedAt = time.Unix(0, pb.CreatedAt).UTC()
+
+	return nil
+}
+
+// MarshalToken encodes a token to binary protobuf format.
+func MarshalToken(t *chronograf.Token) ([]byte, error) {
+	var expiresAt int64
+	if t.ExpiresAt != nil {
+		expiresAt = t.ExpiresAt.UnixNano()
+	}
+	return proto.Marshal(&Token{
+		ID:           t.ID,
+		Name:         t.Name,
+		Organization: t.Organization,
+		Role:         t.Role,
+		Scopes:       t.Scopes,
+		Hash:         t.Hash,
+		CreatedBy:    t.CreatedBy,
+		CreatedAt:    t.CreatedAt.UnixNano(),
+		ExpiresAt:    expiresAt,
+	})
+}
+
+// UnmarshalToken decodes a token from binary protobuf data.
+func UnmarshalToken(data []byte, t *chronograf.Token) error {
+	var pb Token
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+
+	t.ID = pb.ID
+	t.Name = pb.Name
+	t.Organization = pb.Organization
+	t.Role = pb.Role
+	t.Scopes = pb.Scopes
+	if t.Scopes == nil {
+		t.Scopes = []string{}
+	}
+	t.Hash = pb.Hash
+	t.CreatedBy = pb.CreatedBy
+	t.CreatedAt = time.Unix(0, pb.CreatedAt).UTC()
+	t.ExpiresAt = nil
+	if pb.ExpiresAt != 0 {
+		expiresAt := time.Unix(0, pb.ExpiresAt).UTC()
+		t.ExpiresAt = &expiresAt
+	}
+
+	return nil
+}
+
+// MarshalInvitation encodes an invitation to binary protobuf format.
+func MarshalInvitation(i *chronograf.Invitation) ([]byte, error) {
+	return proto.Marshal(&Invitation{
+		ID:           i.ID,
+		Organization: i.Organization,
+		Role:         i.Role,
+		Name:         i.Name,
+		Provider:     i.Provider,
+		Scheme:       i.Scheme,
+		InvitedBy:    i.InvitedBy,
+		CreatedAt:    i.CreatedAt.UnixNano(),
+	})
+}
+
+// UnmarshalInvitation decodes an invitation from binary protobuf data.
+func UnmarshalInvitation(data []byte, i *chronograf.Invitation) error {
+	var pb Invitation
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+
+	i.ID = pb.ID
+	i.Organization = pb.Organization
+	i.Role = pb.Role
+	i.Name = pb.Name
+	i.Provider = pb.Provider
+	i.Scheme = pb.Scheme
+	i.InvitedBy = pb.InvitedBy
+	i.CreatedAt = time.Unix(0, pb.CreatedAt).UTC()
+
+	return nil
+}
+
+// MarshalCertificateMapping encodes a certificate mapping to binary protobuf format.
+func MarshalCertificateMapping(m *chronograf.CertificateMapping) ([]byte, error) {
+	return proto.Marshal(&CertificateMapping{
+		ID:           m.ID,
+		Subject:      m.Subject,
+		UserID:       m.UserID,
+		Organization: m.Organization,
+	})
+}
+
+// UnmarshalCertificateMapping decodes a certificate mapping from binary protobuf data.
+func UnmarshalCertificateMapping(data []byte, m *chronograf.CertificateMapping) error {
+	var pb CertificateMapping
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+
+	m.ID = pb.ID
+	m.Subject = pb.Subject
+	m.UserID = pb.UserID
+	m.Organization = pb.Organization
+
+	return nil
+}
+
+// MarshalWebhook encodes a webhook to binary protobuf format.
+func MarshalWebhook(h *chronograf.Webhook) ([]byte, error) {
+	return proto.Marshal(&Webhook{
+		ID:     h.ID,
+		Name:   h.Name,
+		URL:    h.URL,
+		Events: h.Events,
+		Secret: h.Secret,
+	})
+}
+
+// UnmarshalWebhook decodes a webhook from binary protobuf data.
+func UnmarshalWebhook(data []byte, h *chronograf.Webhook) error {
+	var pb Webhook
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+
+	h.ID = pb.ID
+	h.Name = pb.Name
+	h.URL = pb.URL
+	h.Events = pb.Events
+	h.Secret = pb.Secret
+
+	return nil
+}
+
+// MarshalWebhookDelivery encodes a delivery of an event to a webhook to binary protobuf format.
+func MarshalWebhookDelivery(d *chronograf.WebhookDelivery) ([]byte, error) {
+	return proto.Marshal(&WebhookDelivery{
+		WebhookID:   d.WebhookID,
+		EventID:     d.EventID,
+		Event:       d.Event,
+		Attempt:     int64(d.Attempt),
+		StatusCode:  int64(d.StatusCode),
+		Duration:    int64(d.Duration),
+		Status:      d.Status,
+		Error:       d.Error,
+		DeliveredAt: d.DeliveredAt.UnixNano(),
+	})
+}
+
+// UnmarshalWebhookDelivery decodes a delivery of an event to a webhook from binary protobuf data.
+func UnmarshalWebhookDelivery(data []byte, d *chronograf.WebhookDelivery) error {
+	var pb WebhookDelivery
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+
+	d.WebhookID = pb.WebhookID
+	d.EventID = pb.EventID
+	d.Event = pb.Event
+	d.Attempt = int(pb.Attempt)
+	d.StatusCode = int(pb.StatusCode)
+	d.Duration = time.Duration(pb.Duration)
+	d.Status = pb.Status
+	d.Error = pb.Error
+	d.DeliveredAt = time.Unix(0, pb.DeliveredAt).UTC()
+
+	return nil
+}
+
+// MarshalGroup encodes a group of users to binary protobuf format.
+func MarshalGroup(g *chronograf.Group) ([]byte, error) {
+	roles := make([]*Role, len(g.Roles))
+	for i, role := range g.Roles {
+		roles[i] = &Role{
+			Organization: role.Organization,
+			Name:         role.Name,
+		}
+	}
+	return proto.Marshal(&Group{
+		ID:    g.ID,
+		Name:  g.Name,
+		Users: g.Users,
+		Roles: roles,
+	})
+}
+
+// UnmarshalGroup decodes a group of users from binary protobuf data.
+func UnmarshalGroup(data []byte, g *chronograf.Group) error {
+	var pb Group
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+
+	g.ID = pb.ID
+	g.Name = pb.Name
+	g.Users = pb.Users
+	g.Roles = make([]chronograf.Role, len(pb.Roles))
+	for i, role := range pb.Roles {
+		g.Roles[i] = chronograf.Role{
+			Organization: role.Organization,
+			Name:         role.Name,
+		}
+	}
+
+	return nil
+}
+
+// MarshalPreferences encodes the preferences of a user to binary protobuf format.
+func MarshalPreferences(p *chronograf.Preferences) ([]byte, error) {
+	starred := make([]int64, len(p.StarredDashboards))
+	for i, id := range p.StarredDashboards {
+		starred[i] = int64(id)
+	}
+	recent := make([]*DashboardView, len(p.RecentDashboards))
+	for i, v := range p.RecentDashboards {
+		recent[i] = &DashboardView{
+			DashboardID: int64(v.DashboardID),
+			ViewedAt:    v.ViewedAt.UnixNano(),
+		}
+	}
+	return proto.Marshal(&Preferences{
+		UserID:            p.UserID,
+		DisplayName:       p.DisplayName,
+		Timezone:          p.Timezone,
+		DefaultDashboard:  int64(p.DefaultDashboard),
+		Theme:             p.Theme,
+		StarredDashboards: starred,
+		RecentDashboards:  recent,
+	})
+}
+
+// UnmarshalPreferences decodes the preferences of a user from binary protobuf data.
+func UnmarshalPreferences(data []byte, p *chronograf.Preferences) error {
+	var pb Preferences
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+
+	p.UserID = pb.UserID
+	p.DisplayName = pb.DisplayName
+	p.Timezone = pb.Timezone
+	p.DefaultDashboard = chronograf.DashboardID(pb.DefaultDashboard)
+	p.Theme = pb.Theme
+
+	p.StarredDashboards = nil
+	for _, id := range pb.StarredDashboards {
+		p.StarredDashboards = append(p.StarredDashboards, chronograf.DashboardID(id))
+	}
+	p.RecentDashboards = nil
+	for _, v := range pb.RecentDashboards {
+		p.RecentDashboards = append(p.RecentDashboards, chronograf.DashboardView{
+			DashboardID: chronograf.DashboardID(v.DashboardID),
+			ViewedAt:    time.Unix(0, v.ViewedAt).UTC(),
+		})
+	}
+
+	return nil
+}
+
+// MarshalDashboardVersion encodes a dashboard revision to binary protobuf format.
+func MarshalDashboardVersion(v *chronograf.DashboardVersion) ([]byte, error) {
+	dashboard, err := MarshalDashboard(v.Dashboard)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(&DashboardVersion{
+		DashboardID: int64(v.DashboardID),
+		Revision:    int64(v.Revision),
+		Dashboard:   dashboard,
+		Author:      v.Author,
+		CreatedAt:   v.CreatedAt.UnixNano(),
+	})
+}
+
+// UnmarshalDashboardVersion decodes a dashboard revision from binary protobuf data.
+func UnmarshalDashboardVersion(data []byte, v *chronograf.DashboardVersion) error {
+	var pb DashboardVersion
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+	if err := UnmarshalDashboard(pb.Dashboard, &v.Dashboard); err != nil {
+		return err
+	}
+
+	v.DashboardID = chronograf.DashboardID(pb.DashboardID)
+	v.Revision = int(pb.Revision)
+	v.Author = pb.Author
+	v.CreatedAt = time.Unix(0, pb.CreatedAt).UTC()
+
+	return nil
+}
+
+// MarshalFolder encodes a folder to binary protobuf format.
+func MarshalFolder(f *chronograf.Folder) ([]byte, error) {
+	return proto.Marshal(&Folder{
+		ID:           f.ID,
+		Name:         f.Name,
+		Parent:       f.Parent,
+		Organization: f.Organization,
+		Role:         f.Role,
+	})
+}
+
+// UnmarshalFolder decodes a folder from binary protobuf data.
+func UnmarshalFolder(data []byte, f *chronograf.Folder) error {
+	var pb Folder
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+
+	f.ID = pb.ID
+	f.Name = pb.Name
+	f.Parent = pb.Parent
+	f.Organization = pb.Organization
+	f.Role = pb.Role
+
+	return nil
+}
+
+// MarshalDashboardSnapshot encodes a dashboard snapshot to binary protobuf format.
+func MarshalDashboardSnapshot(s *chronograf.DashboardSnapshot) ([]byte, error) {
+	dashboard, err := MarshalDashboard(s.Dashboard)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*SnapshotResult, len(s.Results))
+	for i, r := range s.Results {
+		results[i] = &SnapshotResult{
+			CellID: r.CellID,
+			Query:  r.Query,
+			Source: r.Source,
+			Result: r.Result,
+			Error:  r.Error,
+		}
+	}
+	return proto.Marshal(&DashboardSnapshot{
+		ID:          int64(s.ID),
+		DashboardID: int64(s.DashboardID),
+		Name:        s.Name,
+		Dashboard:   dashboard,
+		Lower:       s.Lower,
+		Upper:       s.Upper,
+		Results:     results,
+		Author:      s.Author,
+		CreatedAt:   s.CreatedAt.UnixNano(),
+	})
+}
+
+// UnmarshalDashboardSnapshot decodes a dashboard snapshot from binary protobuf data.
+func UnmarshalDashboardSnapshot(data []byte, s *chronograf.DashboardSnapshot) error {
+	var pb DashboardSnapshot
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+	if err := UnmarshalDashboard(pb.Dashboard, &s.Dashboard); err != nil {
+		return err
+	}
+
+	s.Results = make([]chronograf.SnapshotResult, len(pb.Results))
+	for i, r := range pb.Results {
+		s.Results[i] = chronograf.SnapshotResult{
+			CellID: r.CellID,
+			Query:  r.Query,
+			Source: r.Source,
+			Result: r.Result,
+			Error:  r.Error,
+		}
+	}
+
+	s.ID = int(pb.ID)
+	s.DashboardID = chronograf.DashboardID(pb.DashboardID)
+	s.Name = pb.Name
+	s.Lower = pb.Lower
+	s.Upper = pb.Upper
+	s.Author = pb.Author
+	s.CreatedAt = time.Unix(0, pb.CreatedAt).UTC()
+
+	return nil
+}
+
+// MarshalReport encodes a report to binary protobuf format.
+func MarshalReport(r *chronograf.Report) ([]byte, error) {
+	// The zero time, a report that has never run, is stored as zero
+	var nextRun, lastRun int64
+	if !r.NextRun.IsZero() {
+		nextRun = r.NextRun.UnixNano()
+	}
+	if !r.LastRun.IsZero() {
+		lastRun = r.LastRun.UnixNano()
+	}
+	return proto.Marshal(&Report{
+		ID:           r.ID,
+		Name:         r.Name,
+		DashboardID:  int64(r.DashboardID),
+		Source:       r.Source,
+		Start:        r.Start.UnixNano(),
+		Every:        int64(r.Every),
+		Range:        int64(r.Range),
+		Recipients:   r.Recipients,
+		Format:       r.Format,
+		Organization: r.Organization,
+		NextRun:      nextRun,
+		LastRun:      lastRun,
+		LastError:    r.LastError,
+	})
+}
+
+// UnmarshalReport decodes a report from binary protobuf data.
+func UnmarshalReport(data []byte, r *chronograf.Report) error {
+	var pb Report
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+
+	r.ID = pb.ID
+	r.Name = pb.Name
+	r.DashboardID = chronograf.DashboardID(pb.DashboardID)
+	r.Source = pb.Source
+	r.Start = time.Unix(0, pb.Start).UTC()
+	r.Every = time.Duration(pb.Every)
+	r.Range = time.Duration(pb.Range)
+	r.Recipients = pb.Recipients
+	r.Format = pb.Format
+	r.Organization = pb.Organization
+	r.NextRun = time.Time{}
+	if pb.NextRun != 0 {
+		r.NextRun = time.Unix(0, pb.NextRun).UTC()
+	}
+	r.LastRun = time.Time{}
+	if pb.LastRun != 0 {
+		r.LastRun = time.Unix(0, pb.LastRun).UTC()
+	}
+	r.LastError = pb.LastError
+
+	return nil
+}
+
+// MarshalAnnotation encodes an annotation to binary protobuf format.
+func MarshalAnnotation(a *chronograf.Annotation) ([]byte, error) {
+	return proto.Marshal(&Annotation{
+		ID:           a.ID,
+		StartTime:    a.StartTime.UnixNano(),
+		EndTime:      a.EndTime.UnixNano(),
+		Text:         a.Text,
+		Type:         a.Type,
+		Stream:       a.Stream,
+		Tags:         a.Tags,
+		Organization: a.Organization,
+	})
+}
+
+// UnmarshalAnnotation decodes an annotation from binary protobuf data.
+func UnmarshalAnnotation(data []byte, a *chronograf.Annotation) error {
+	var pb Annotation
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+
+	a.ID = pb.ID
+	a.StartTime = time.Unix(0, pb.StartTime).UTC()
+	a.EndTime = time.Unix(0, pb.EndTime).UTC()
+	a.Text = pb.Text
+	a.Type = pb.Type
+	a.Stream = pb.Stream
+	a.Tags = pb.Tags
+	a.Organization = pb.Organization
+
+	return nil
+}
+
+// MarshalSourceHealth encodes a health check to binary protobuf format.
+func MarshalSourceHealth(h *chronograf.SourceHealth) ([]byte, error) {
+	return proto.Marshal(&SourceHealth{
+		SourceID:  int64(h.SourceID),
+		ServerID:  int64(h.ServerID),
+		Status:    h.Status,
+		Version:   h.Version,
+		Build:     h.Build,
+		Latency:   int64(h.Latency),
+		Error:     h.Error,
+		CheckedAt: h.CheckedAt.UnixNano(),
+	})
+}
+
+// UnmarshalSourceHealth decodes a health check from binary protobuf data.
+func UnmarshalSourceHealth(data []byte, h *chronograf.SourceHealth) error {
+	var pb SourceHealth
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+
+	h.SourceID = int(pb.SourceID)
+	h.ServerID = int(pb.ServerID)
+	h.Status = pb.Status
+	h.Version = pb.Version
+	h.Build = pb.Build
+	h.Latency = time.Duration(pb.Latency)
+	h.Error = pb.Error
+	h.CheckedAt = time.Unix(0, pb.CheckedAt).UTC()
+
+	return nil
+}
+
+// MarshalQueryHistoryEntry encodes a query of the history of a user to binary protobuf format.
+func MarshalQueryHistoryEntry(q *chronograf.QueryHistoryEntry) ([]byte, error) {
+	return proto.Marshal(&QueryHistoryEntry{
+		UserID:       q.UserID,
+		SourceID:     int64(q.SourceID),
+		Organization: q.Organization,
+		Query:        q.Query,
+		DB:           q.DB,
+		RP:           q.RP,
+		Duration:     int64(q.Duration),
+		Status:       q.Status,
+		Error:        q.Error,
+		RanAt:        q.RanAt.UnixNano(),
+	})
+}
+
+// UnmarshalQueryHistoryEntry decodes a query of the history of a user from binary protobuf data.
+func UnmarshalQueryHistoryEntry(data []byte, q *chronograf.QueryHistoryEntry) error {
+	var pb QueryHistoryEntry
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+
+	q.UserID = pb.UserID
+	q.SourceID = int(pb.SourceID)
+	q.Organization = pb.Organization
+	q.Query = pb.Query
+	q.DB = pb.DB
+	q.RP = pb.RP
+	q.Duration = time.Duration(pb.Duration)
+	q.Status = pb.Status
+	q.Error = pb.Error
+	q.RanAt = time.Unix(0, pb.RanAt).UTC()
+
+	return nil
+}
+
+// MarshalAlertEvent encodes an alert event to binary protobuf format.
+func MarshalAlertEvent(e *chronograf.AlertEvent) ([]byte, error) {
+	comments := make([]*AlertEventComment, len(e.Comments))
+	for i, c := range e.Comments {
+		comments[i] = &AlertEventComment{
+			UserID:  c.UserID,
+			Author:  c.Author,
+			Text:    c.Text,
+			Created: c.Created.UnixNano(),
+		}
+	}
+	var ackAt int64
+	if !e.AcknowledgedAt.IsZero() {
+		ackAt = e.AcknowledgedAt.UnixNano()
+	}
+	return proto.Marshal(&AlertEvent{
+		ID:             e.ID,
+		SourceID:       int64(e.SourceID),
+		AlertID:        e.AlertID,
+		Message:        e.Message,
+		Details:        e.Details,
+		Level:          e.Level,
+		PreviousLevel:  e.PreviousLevel,
+		Duration:       int64(e.Duration),
+		Time:           e.Time.UnixNano(),
+		Acknowledged:   e.Acknowledged,
+		AcknowledgedBy: e.AcknowledgedBy,
+		AcknowledgedAt: ackAt,
+		Comments:       comments,
+	})
+}
+
+// UnmarshalAlertEvent decodes an alert event from binary protobuf data.
+func UnmarshalAlertEvent(data []byte, e *chronograf.AlertEvent) error {
+	var pb AlertEvent
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+
+	e.ID = pb.ID
+	e.SourceID = int(pb.SourceID)
+	e.AlertID = pb.AlertID
+	e.Message = pb.Message
+	e.Details = pb.Details
+	e.Level = pb.Level
+	e.PreviousLevel = pb.PreviousLevel
+	e.Duration = time.Duration(pb.Duration)
+	e.Time = time.Unix(0, pb.Time).UTC()
+	e.Acknowledged = pb.Acknowledged
+	e.AcknowledgedBy = pb.AcknowledgedBy
+	e.AcknowledgedAt = time.Time{}
+	if pb.AcknowledgedAt != 0 {
+		e.AcknowledgedAt = time.Unix(0, pb.AcknowledgedAt).UTC()
+	}
+	e.Comments = make([]chronograf.AlertEventComment, len(pb.Comments))
+	for i, c := range pb.Comments {
+		e.Comments[i] = chronograf.AlertEventComment{
+			UserID:  c.UserID,
+			Author:  c.Author,
+			Text:    c.Text,
+			Created: time.Unix(0, c.Created).UTC(),
+		}
+	}
+
+	return nil
+}
+
+// MarshalAlertWebhook encodes an alert webhook to binary protobuf format.
+// Headers and routing keys are sorted so that equal webhooks encode the same.
+func MarshalAlertWebhook(h *chronograf.AlertWebhook) ([]byte, error) {
+	names := make([]string, 0, len(h.Headers))
+	for name := range h.Headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	headers := make([]*AlertWebhookHeader, len(names))
+	for i, name := range names {
+		headers[i] = &AlertWebhookHeader{
+			Name:  name,
+			Value: h.Headers[name],
+		}
+	}
+	rules := make([]string, 0, len(h.RoutingKeys))
+	for rule := range h.RoutingKeys {
+		rules = append(rules, rule)
+	}
+	sort.Strings(rules)
+	keys := make([]*AlertWebhookRoutingKey, len(rules))
+	for i, rule := range rules {
+		keys[i] = &AlertWebhookRoutingKey{
+			Rule: rule,
+			Key:  h.RoutingKeys[rule],
+		}
+	}
+	return proto.Marshal(&AlertWebhook{
+		ID:          h.ID,
+		SourceID:    int64(h.SourceID),
+		Name:        h.Name,
+		URL:         h.URL,
+		Rules:       h.Rules,
+		Headers:     headers,
+		Template:    h.Template,
+		Secret:      h.Secret,
+		Type:        h.Type,
+		RoutingKey:  h.RoutingKey,
+		RoutingKeys: keys,
+	})
+}
+
+// UnmarshalAlertWebhook decodes an alert webhook from binary protobuf data.
+func UnmarshalAlertWebhook(data []byte, h *chronograf.AlertWebhook) error {
+	var pb AlertWebhook
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+
+	h.ID = pb.ID
+	h.SourceID = int(pb.SourceID)
+	h.Name = pb.Name
+	h.URL = pb.URL
+	h.Rules = pb.Rules
+	if h.Rules == nil {
+		h.Rules = []string{}
+	}
+	h.Headers = make(map[string]string, len(pb.Headers))
+	for _, header := range pb.Headers {
+		h.Headers[header.Name] = header.Value
+	}
+	h.Template = pb.Template
+	h.Secret = pb.Secret
+	h.Type = pb.Type
+	h.RoutingKey = pb.RoutingKey
+	if len(pb.RoutingKeys) > 0 {
+		h.RoutingKeys = make(map[string]string, len(pb.RoutingKeys))
+		for _, key := range pb.RoutingKeys {
+			h.RoutingKeys[key.Rule] = key.Key
+		}
+	}
+
+	return nil
+}
+
+// MarshalAlertCheck encodes an alert check to binary protobuf format.
+// States and parameters are sorted so that equal checks encode the same.
+func MarshalAlertCheck(c *chronograf.AlertCheck) ([]byte, error) {
+	thresholds := make([]*AlertCheckThreshold, len(c.Thresholds))
+	for i, t := range c.Thresholds {
+		thresholds[i] = &AlertCheckThreshold{
+			Level: t.Level,
+			Value: t.Value,
+		}
+	}
+	ids := make([]string, 0, len(c.States))
+	for id := range c.States {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	states := make([]*AlertCheckState, len(ids))
+	for i, id := range ids {
+		states[i] = &AlertCheckState{
+			AlertID: id,
+			Level:   c.States[id],
+		}
+	}
+	names := make([]string, 0, len(c.Params))
+	for name := range c.Params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	params := make([]*AlertCheckParam, len(names))
+	for i, name := range names {
+		params[i] = &AlertCheckParam{
+			Name:  name,
+			Value: c.Params[name],
+		}
+	}
+	var nextRun, lastRun int64
+	if !c.NextRun.IsZero() {
+		nextRun = c.NextRun.UnixNano()
+	}
+	if !c.LastRun.IsZero() {
+		lastRun = c.LastRun.UnixNano()
+	}
+	return proto.Marshal(&AlertCheck{
+		ID:         c.ID,
+		SourceID:   int64(c.SourceID),
+		Name:       c.Name,
+		Query:      c.Query,
+		DB:         c.DB,
+		RP:         c.RP,
+		Every:      int64(c.Every),
+		Operator:   c.Operator,
+		Thresholds: thresholds,
+		Message:    c.Message,
+		Enabled:    c.Enabled,
+		States:     states,
+		NextRun:    nextRun,
+		LastRun:    lastRun,
+		LastError:  c.LastError,
+		Template:   c.Template,
+		Params:     params,
+	})
+}
+
+// UnmarshalAlertCheck decodes an alert check from binary protobuf data.
+func UnmarshalAlertCheck(data []byte, c *chronograf.AlertCheck) error {
+	var pb AlertCheck
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+
+	c.ID = pb.ID
+	c.SourceID = int(pb.SourceID)
+	c.Name = pb.Name
+	c.Query = pb.Query
+	c.DB = pb.DB
+	c.RP = pb.RP
+	c.Every = time.Duration(pb.Every)
+	c.Operator = pb.Operator
+	c.Thresholds = make([]chronograf.AlertCheckThreshold, len(pb.Thresholds))
+	for i, t := range pb.Thresholds {
+		c.Thresholds[i] = chronograf.AlertCheckThreshold{
+			Level: t.Level,
+			Value: t.Value,
+		}
+	}
+	c.Message = pb.Message
+	c.Enabled = pb.Enabled
+	c.States = make(map[string]string, len(pb.States))
+	for _, s := range pb.States {
+		c.States[s.AlertID] = s.Level
+	}
+	c.NextRun = time.Time{}
+	if pb.NextRun != 0 {
+		c.NextRun = time.Unix(0, pb.NextRun).UTC()
+	}
+	c.LastRun = time.Time{}
+	if pb.LastRun != 0 {
+		c.LastRun = time.Unix(0, pb.LastRun).UTC()
+	}
+	c.LastError = pb.LastError
+	c.Template = pb.Template
+	c.Params = nil
+	if len(pb.Params) > 0 {
+		c.Params = make(map[string]string, len(pb.Params))
+		for _, p := range pb.Params {
+			c.Params[p.Name] = p.Value
+		}
+	}
+
+	return nil
+}
+
+// MarshalAlertPolicy encodes an alert policy to binary protobuf format.
+func MarshalAlertPolicy(p *chronograf.AlertPolicy) ([]byte, error) {
+	return proto.Marshal(&AlertPolicy{
+		ID:          p.ID,
+		SourceID:    int64(p.SourceID),
+		Rule:        p.Rule,
+		Throttle:    int64(p.Throttle),
+		FlapWindow:  int64(p.FlapWindow),
+		FlapChanges: int64(p.FlapChanges),
+	})
+}
+
+// UnmarshalAlertPolicy decodes an alert policy from binary protobuf data.
+func UnmarshalAlertPolicy(data []byte, p *chronograf.AlertPolicy) error {
+	var pb AlertPolicy
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+
+	p.ID = pb.ID
+	p.SourceID = int(pb.SourceID)
+	p.Rule = pb.Rule
+	p.Throttle = time.Duration(pb.Throttle)
+	p.FlapWindow = time.Duration(pb.FlapWindow)
+	p.FlapChanges = int(pb.FlapChanges)
+
+	return nil
+}
+
+// MarshalRuleTemplate encodes a rule template to binary protobuf format.
+func MarshalRuleTemplate(t *chronograf.RuleTemplate) ([]byte, error) {
+	params := make([]*RuleTemplateParam, len(t.Params))
+	for i, p := range t.Params {
+		params[i] = &RuleTemplateParam{
+			Name:        p.Name,
+			Description: p.Description,
+			Default:     p.Default,
+		}
+	}
+	thresholds := make([]*RuleTemplateThreshold, len(t.Thresholds))
+	for i, th := range t.Thresholds {
+		thresholds[i] = &RuleTemplateThreshold{
+			Level: th.Level,
+			Value: th.Value,
+		}
+	}
+	return proto.Marshal(&RuleTemplate{
+		ID:           t.ID,
+		Name:         t.Name,
+		Description:  t.Description,
+		Params:       params,
+		CheckName:    t.CheckName,
+		Query:        t.Query,
+		DB:           t.DB,
+		RP:           t.RP,
+		Every:        int64(t.Every),
+		Operator:     t.Operator,
+		Thresholds:   thresholds,
+		Message:      t.Message,
+		Organization: t.Organization,
+	})
+}
+
+// UnmarshalRuleTemplate decodes a rule template from binary protobuf data.
+func UnmarshalRuleTemplate(data []byte, t *chronograf.RuleTemplate) error {
+	var pb RuleTemplate
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+
+	t.ID = pb.ID
+	t.Name = pb.Name
+	t.Description = pb.Description
+	t.Params = make([]chronograf.RuleTemplateParam, len(pb.Params))
+	for i, p := range pb.Params {
+		t.Params[i] = chronograf.RuleTemplateParam{
+			Name:        p.Name,
+			Description: p.Description,
+			Default:     p.Default,
+		}
+	}
+	t.CheckName = pb.CheckName
+	t.Query = pb.Query
+	t.DB = pb.DB
+	t.RP = pb.RP
+	t.Every = time.Duration(pb.Every)
+	t.Operator = pb.Operator
+	t.Thresholds = make([]chronograf.RuleTemplateThreshold, len(pb.Thresholds))
+	for i, th := range pb.Thresholds {
+		t.Thresholds[i] = chronograf.RuleTemplateThreshold{
+			Level: th.Level,
+			Value: th.Value,
+		}
+	}
+	t.Message = pb.Message
+	t.Organization = pb.Organization
+
+	return nil
+}
@@ -3,9 +3,11 @@
 
 package internal
 
-import proto "github.com/gogo/protobuf/proto"
-import fmt "fmt"
-import math "math"
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	math "math"
+)
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
@@ -33,6 +35,10 @@ type Source struct {
 	Organization         string   `protobuf:"bytes,12,opt,name=Organization,proto3" json:"Organization,omitempty"`
 	Role                 string   `protobuf:"bytes,13,opt,name=Role,proto3" json:"Role,omitempty"`
 	DefaultRP            string   `protobuf:"bytes,14,opt,name=DefaultRP,proto3" json:"DefaultRP,omitempty"`
+	TLSCA                string   `protobuf:"bytes,15,opt,name=TLSCA,proto3" json:"TLSCA,omitempty"`
+	TLSCert              string   `protobuf:"bytes,16,opt,name=TLSCert,proto3" json:"TLSCert,omitempty"`
+	TLSKey               string   `protobuf:"bytes,17,opt,name=TLSKey,proto3" json:"TLSKey,omitempty"`
+	Discovered           bool     `protobuf:"varint,18,opt,name=Discovered,proto3" json:"Discovered,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -42,7 +48,7 @@ func (m *Source) Reset()         { *m = Source{} }
 func (m *Source) String() string { return proto.CompactTextString(m) }
 func (*Source) ProtoMessage()    {}
 func (*Source) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_6148f2d00a5dfb1b, []int{0}
+	return fileDescriptor_41f4a519b878ee3b, []int{0}
 }
 func (m *Source) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Source.Unmarshal(m, b)
@@ -50,8 +56,8 @@ func (m *Source) XXX_Unmarshal(b []byte) error {
 func (m *Source) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_Source.Marshal(b, m, deterministic)
 }
-func (dst *Source) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Source.Merge(dst, src)
+func (m *Source) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Source.Merge(m, src)
 }
 func (m *Source) XXX_Size() int {
 	return xxx_messageInfo_Source.Size(m)
@@ -160,12 +166,43 @@ func (m *Source) GetDefaultRP() string {
 	return ""
 }
 
+func (m *Source) GetTLSCA() string {
+	if m != nil {
+		return m.TLSCA
+	}
+	return ""
+}
+
+func (m *Source) GetTLSCert() string {
+	if m != nil {
+		return m.TLSCert
+	}
+	return ""
+}
+
+func (m *Source) GetTLSKey() string {
+	if m != nil {
+		return m.TLSKey
+	}
+	return ""
+}
+
+func (m *Source) GetDiscovered() bool {
+	if m != nil {
+		return m.Discovered
+	}
+	return false
+}
+
 type Dashboard struct {
 	ID                   int64            `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
 	Name                 string           `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	Cells                []*DashboardCell `protobuf:"bytes,3,rep,name=cells" json:"cells,omitempty"`
-	Templates            []*Template      `protobuf:"bytes,4,rep,name=templates" json:"templates,omitempty"`
+	Cells                []*DashboardCell `protobuf:"bytes,3,rep,name=cells,proto3" json:"cells,omitempty"`
+	Templates            []*Template      `protobuf:"bytes,4,rep,name=templates,proto3" json:"templates,omitempty"`
 	Organization         string           `protobuf:"bytes,5,opt,name=Organization,proto3" json:"Organization,omitempty"`
+	Folder               string           `protobuf:"bytes,6,opt,name=Folder,proto3" json:"Folder,omitempty"`
+	CacheTTL             string           `protobuf:"bytes,7,opt,name=CacheTTL,proto3" json:"CacheTTL,omitempty"`
+	Slug                 string           `protobuf:"bytes,8,opt,name=Slug,proto3" json:"Slug,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -175,7 +212,7 @@ func (m *Dashboard) Reset()         { *m = Dashboard{} }
 func (m *Dashboard) String() string { return proto.CompactTextString(m) }
 func (*Dashboard) ProtoMessage()    {}
 func (*Dashboard) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_6148f2d00a5dfb1b, []int{1}
+	return fileDescriptor_41f4a519b878ee3b, []int{1}
 }
 func (m *Dashboard) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Dashboard.Unmarshal(m, b)
@@ -183,8 +220,8 @@ func (m *Dashboard) XXX_Unmarshal(b []byte) error {
 func (m *Dashboard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_Dashboard.Marshal(b, m, deterministic)
 }
-func (dst *Dashboard) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Dashboard.Merge(dst, src)
+func (m *Dashboard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Dashboard.Merge(m, src)
 }
 func (m *Dashboard) XXX_Size() int {
 	return xxx_messageInfo_Dashboard.Size(m)
@@ -230,22 +267,45 @@ func (m *Dashboard) GetOrganization() string {
 	return ""
 }
 
+func (m *Dashboard) GetFolder() string {
+	if m != nil {
+		return m.Folder
+	}
+	return ""
+}
+
+func (m *Dashboard) GetCacheTTL() string {
+	if m != nil {
+		return m.CacheTTL
+	}
+	return ""
+}
+
+func (m *Dashboard) GetSlug() string {
+	if m != nil {
+		return m.Slug
+	}
+	return ""
+}
+
 type DashboardCell struct {
 	X                    int32             `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
 	Y                    int32             `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
 	W                    int32             `protobuf:"varint,3,opt,name=w,proto3" json:"w,omitempty"`
 	H                    int32             `protobuf:"varint,4,opt,name=h,proto3" json:"h,omitempty"`
-	Queries              []*Query          `protobuf:"bytes,5,rep,name=queries" json:"queries,omitempty"`
+	Queries              []*Query          `protobuf:"bytes,5,rep,name=queries,proto3" json:"queries,omitempty"`
 	Name                 string            `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
 	Type                 string            `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`
 	ID                   string            `protobuf:"bytes,8,opt,name=ID,proto3" json:"ID,omitempty"`
-	Axes                 map[string]*Axis  `protobuf:"bytes,9,rep,name=axes" json:"axes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
-	Colors               []*Color          `protobuf:"bytes,10,rep,name=colors" json:"colors,omitempty"`
-	Legend               *Legend           `protobuf:"bytes,11,opt,name=legend" json:"legend,omitempty"`
-	TableOptions         *TableOptions     `protobuf:"bytes,12,opt,name=tableOptions" json:"tableOptions,omitempty"`
-	FieldOptions         []*RenamableField `protobuf:"bytes,13,rep,name=fieldOptions" json:"fieldOptions,omitempty"`
+	Axes                 map[string]*Axis  `protobuf:"bytes,9,rep,name=axes,proto3" json:"axes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Colors               []*Color          `protobuf:"bytes,10,rep,name=colors,proto3" json:"colors,omitempty"`
+	Legend               *Legend           `protobuf:"bytes,11,opt,name=legend,proto3" json:"legend,omitempty"`
+	TableOptions         *TableOptions     `protobuf:"bytes,12,opt,name=tableOptions,proto3" json:"tableOptions,omitempty"`
+	FieldOptions         []*RenamableField `protobuf:"bytes,13,rep,name=fieldOptions,proto3" json:"fieldOptions,omitempty"`
 	TimeFormat           string            `protobuf:"bytes,14,opt,name=timeFormat,proto3" json:"timeFormat,omitempty"`
-	DecimalPlaces        *DecimalPlaces    `protobuf:"bytes,15,opt,name=decimalPlaces" json:"decimalPlaces,omitempty"`
+	DecimalPlaces        *DecimalPlaces    `protobuf:"bytes,15,opt,name=decimalPlaces,proto3" json:"decimalPlaces,omitempty"`
+	Prefix               string            `protobuf:"bytes,16,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Suffix               string            `protobuf:"bytes,17,opt,name=suffix,proto3" json:"suffix,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -255,7 +315,7 @@ func (m *DashboardCell) Reset()         { *m = DashboardCell{} }
 func (m *DashboardCell) String() string { return proto.CompactTextString(m) }
 func (*DashboardCell) ProtoMessage()    {}
 func (*DashboardCell) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_6148f2d00a5dfb1b, []int{2}
+	return fileDescriptor_41f4a519b878ee3b, []int{2}
 }
 func (m *DashboardCell) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DashboardCell.Unmarshal(m, b)
@@ -263,8 +323,8 @@ func (m *DashboardCell) XXX_Unmarshal(b []byte) error {
 func (m *DashboardCell) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_DashboardCell.Marshal(b, m, deterministic)
 }
-func (dst *DashboardCell) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DashboardCell.Merge(dst, src)
+func (m *DashboardCell) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardCell.Merge(m, src)
 }
 func (m *DashboardCell) XXX_Size() int {
 	return xxx_messageInfo_DashboardCell.Size(m)
@@ -380,6 +440,20 @@ func (m *DashboardCell) GetDecimalPlaces() *DecimalPlaces {
 	return nil
 }
 
+func (m *DashboardCell) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *DashboardCell) GetSuffix() string {
+	if m != nil {
+		return m.Suffix
+	}
+	return ""
+}
+
 type DecimalPlaces struct {
 	IsEnforced           bool     `protobuf:"varint,1,opt,name=isEnforced,proto3" json:"isEnforced,omitempty"`
 	Digits               int32    `protobuf:"varint,2,opt,name=digits,proto3" json:"digits,omitempty"`
@@ -392,7 +466,7 @@ func (m *DecimalPlaces) Reset()         { *m = DecimalPlaces{} }
 func (m *DecimalPlaces) String() string { return proto.CompactTextString(m) }
 func (*DecimalPlaces) ProtoMessage()    {}
 func (*DecimalPlaces) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_6148f2d00a5dfb1b, []int{3}
+	return fileDescriptor_41f4a519b878ee3b, []int{3}
 }
 func (m *DecimalPlaces) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DecimalPlaces.Unmarshal(m, b)
@@ -400,8 +474,8 @@ func (m *DecimalPlaces) XXX_Unmarshal(b []byte) error {
 func (m *DecimalPlaces) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_DecimalPlaces.Marshal(b, m, deterministic)
 }
-func (dst *DecimalPlaces) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DecimalPlaces.Merge(dst, src)
+func (m *DecimalPlaces) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecimalPlaces.Merge(m, src)
 }
 func (m *DecimalPlaces) XXX_Size() int {
 	return xxx_messageInfo_DecimalPlaces.Size(m)
@@ -428,9 +502,10 @@ func (m *DecimalPlaces) GetDigits() int32 {
 
 type TableOptions struct {
 	VerticalTimeAxis     bool            `protobuf:"varint,2,opt,name=verticalTimeAxis,proto3" json:"verticalTimeAxis,omitempty"`
-	SortBy               *RenamableField `protobuf:"bytes,3,opt,name=sortBy" json:"sortBy,omitempty"`
+	SortBy               *RenamableField `protobuf:"bytes,3,opt,name=sortBy,proto3" json:"sortBy,omitempty"`
 	Wrapping             string          `protobuf:"bytes,4,opt,name=wrapping,proto3" json:"wrapping,omitempty"`
 	FixFirstColumn       bool            `protobuf:"varint,6,opt,name=fixFirstColumn,proto3" json:"fixFirstColumn,omitempty"`
+	PageSize             int32           `protobuf:"varint,7,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
 	XXX_sizecache        int32           `json:"-"`
@@ -440,7 +515,7 @@ func (m *TableOptions) Reset()         { *m = TableOptions{} }
 func (m *TableOptions) String() string { return proto.CompactTextString(m) }
 func (*TableOptions) ProtoMessage()    {}
 func (*TableOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_6148f2d00a5dfb1b, []int{4}
+	return fileDescriptor_41f4a519b878ee3b, []int{4}
 }
 func (m *TableOptions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TableOptions.Unmarshal(m, b)
@@ -448,8 +523,8 @@ func (m *TableOptions) XXX_Unmarshal(b []byte) error {
 func (m *TableOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_TableOptions.Marshal(b, m, deterministic)
 }
-func (dst *TableOptions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TableOptions.Merge(dst, src)
+func (m *TableOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TableOptions.Merge(m, src)
 }
 func (m *TableOptions) XXX_Size() int {
 	return xxx_messageInfo_TableOptions.Size(m)
@@ -488,10 +563,19 @@ func (m *TableOptions) GetFixFirstColumn() bool {
 	return false
 }
 
+func (m *TableOptions) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
 type RenamableField struct {
 	InternalName         string   `protobuf:"bytes,1,opt,name=internalName,proto3" json:"internalName,omitempty"`
 	DisplayName          string   `protobuf:"bytes,2,opt,name=displayName,proto3" json:"displayName,omitempty"`
 	Visible              bool     `protobuf:"varint,3,opt,name=visible,proto3" json:"visible,omitempty"`
+	Format               string   `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+	Align                string   `protobuf:"bytes,5,opt,name=align,proto3" json:"align,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -501,7 +585,7 @@ func (m *RenamableField) Reset()         { *m = RenamableField{} }
 func (m *RenamableField) String() string { return proto.CompactTextString(m) }
 func (*RenamableField) ProtoMessage()    {}
 func (*RenamableField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_6148f2d00a5dfb1b, []int{5}
+	return fileDescriptor_41f4a519b878ee3b, []int{5}
 }
 func (m *RenamableField) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RenamableField.Unmarshal(m, b)
@@ -509,8 +593,8 @@ func (m *RenamableField) XXX_Unmarshal(b []byte) error {
 func (m *RenamableField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_RenamableField.Marshal(b, m, deterministic)
 }
-func (dst *RenamableField) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RenamableField.Merge(dst, src)
+func (m *RenamableField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenamableField.Merge(m, src)
 }
 func (m *RenamableField) XXX_Size() int {
 	return xxx_messageInfo_RenamableField.Size(m)
@@ -542,6 +626,20 @@ func (m *RenamableField) GetVisible() bool {
 	return false
 }
 
+func (m *RenamableField) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *RenamableField) GetAlign() string {
+	if m != nil {
+		return m.Align
+	}
+	return ""
+}
+
 type Color struct {
 	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
 	Type                 string   `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
@@ -557,7 +655,7 @@ func (m *Color) Reset()         { *m = Color{} }
 func (m *Color) String() string { return proto.CompactTextString(m) }
 func (*Color) ProtoMessage()    {}
 func (*Color) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_6148f2d00a5dfb1b, []int{6}
+	return fileDescriptor_41f4a519b878ee3b, []int{6}
 }
 func (m *Color) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Color.Unmarshal(m, b)
@@ -565,8 +663,8 @@ func (m *Color) XXX_Unmarshal(b []byte) error {
 func (m *Color) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_Color.Marshal(b, m, deterministic)
 }
-func (dst *Color) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Color.Merge(dst, src)
+func (m *Color) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Color.Merge(m, src)
 }
 func (m *Color) XXX_Size() int {
 	return xxx_messageInfo_Color.Size(m)
@@ -624,7 +722,7 @@ func (m *Legend) Reset()         { *m = Legend{} }
 func (m *Legend) String() string { return proto.CompactTextString(m) }
 func (*Legend) ProtoMessage()    {}
 func (*Legend) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_6148f2d00a5dfb1b, []int{7}
+	return fileDescriptor_41f4a519b878ee3b, []int{7}
 }
 func (m *Legend) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Legend.Unmarshal(m, b)
@@ -632,8 +730,8 @@ func (m *Legend) XXX_Unmarshal(b []byte) error {
 func (m *Legend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_Legend.Marshal(b, m, deterministic)
 }
-func (dst *Legend) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Legend.Merge(dst, src)
+func (m *Legend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Legend.Merge(m, src)
 }
 func (m *Legend) XXX_Size() int {
 	return xxx_messageInfo_Legend.Size(m)
@@ -659,8 +757,8 @@ func (m *Legend) GetOrientation() string {
 }
 
 type Axis struct {
-	LegacyBounds         []int64  `protobuf:"varint,1,rep,packed,name=legacyBounds" json:"legacyBounds,omitempty"`
-	Bounds               []string `protobuf:"bytes,2,rep,name=bounds" json:"bounds,omitempty"`
+	LegacyBounds         []int64  `protobuf:"varint,1,rep,packed,name=legacyBounds,proto3" json:"legacyBounds,omitempty"`
+	Bounds               []string `protobuf:"bytes,2,rep,name=bounds,proto3" json:"bounds,omitempty"`
 	Label                string   `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
 	Prefix               string   `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
 	Suffix               string   `protobuf:"bytes,5,opt,name=suffix,proto3" json:"suffix,omitempty"`
@@ -675,7 +773,7 @@ func (m *Axis) Reset()         { *m = Axis{} }
 func (m *Axis) String() string { return proto.CompactTextString(m) }
 func (*Axis) ProtoMessage()    {}
 func (*Axis) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_6148f2d00a5dfb1b, []int{8}
+	return fileDescriptor_41f4a519b878ee3b, []int{8}
 }
 func (m *Axis) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Axis.Unmarshal(m, b)
@@ -683,8 +781,8 @@ func (m *Axis) XXX_Unmarshal(b []byte) error {
 func (m *Axis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_Axis.Marshal(b, m, deterministic)
 }
-func (dst *Axis) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Axis.Merge(dst, src)
+func (m *Axis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Axis.Merge(m, src)
 }
 func (m *Axis) XXX_Size() int {
 	return xxx_messageInfo_Axis.Size(m)
@@ -747,10 +845,10 @@ func (m *Axis) GetScale() string {
 type Template struct {
 	ID                   string           `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
 	TempVar              string           `protobuf:"bytes,2,opt,name=temp_var,json=tempVar,proto3" json:"temp_var,omitempty"`
-	Values               []*TemplateValue `protobuf:"bytes,3,rep,name=values" json:"values,omitempty"`
+	Values               []*TemplateValue `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
 	Type                 string           `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
 	Label                string           `protobuf:"bytes,5,opt,name=label,proto3" json:"label,omitempty"`
-	Query                *TemplateQuery   `protobuf:"bytes,6,opt,name=query" json:"query,omitempty"`
+	Query                *TemplateQuery   `protobuf:"bytes,6,opt,name=query,proto3" json:"query,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -760,7 +858,7 @@ func (m *Template) Reset()         { *m = Template{} }
 func (m *Template) String() string { return proto.CompactTextString(m) }
 func (*Template) ProtoMessage()    {}
 func (*Template) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_6148f2d00a5dfb1b, []int{9}
+	return fileDescriptor_41f4a519b878ee3b, []int{9}
 }
 func (m *Template) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Template.Unmarshal(m, b)
@@ -768,8 +866,8 @@ func (m *Template) XXX_Unmarshal(b []byte) error {
 func (m *Template) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_Template.Marshal(b, m, deterministic)
 }
-func (dst *Template) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Template.Merge(dst, src)
+func (m *Template) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Template.Merge(m, src)
 }
 func (m *Template) XXX_Size() int {
 	return xxx_messageInfo_Template.Size(m)
@@ -836,7 +934,7 @@ func (m *TemplateValue) Reset()         { *m = TemplateValue{} }
 func (m *TemplateValue) String() string { return proto.CompactTextString(m) }
 func (*TemplateValue) ProtoMessage()    {}
 func (*TemplateValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_6148f2d00a5dfb1b, []int{10}
+	return fileDescriptor_41f4a519b878ee3b, []int{10}
 }
 func (m *TemplateValue) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TemplateValue.Unmarshal(m, b)
@@ -844,8 +942,8 @@ func (m *TemplateValue) XXX_Unmarshal(b []byte) error {
 func (m *TemplateValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_TemplateValue.Marshal(b, m, deterministic)
 }
-func (dst *TemplateValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TemplateValue.Merge(dst, src)
+func (m *TemplateValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TemplateValue.Merge(m, src)
 }
 func (m *TemplateValue) XXX_Size() int {
 	return xxx_messageInfo_TemplateValue.Size(m)
@@ -891,6 +989,10 @@ type TemplateQuery struct {
 	Measurement          string   `protobuf:"bytes,4,opt,name=measurement,proto3" json:"measurement,omitempty"`
 	TagKey               string   `protobuf:"bytes,5,opt,name=tag_key,json=tagKey,proto3" json:"tag_key,omitempty"`
 	FieldKey             string   `protobuf:"bytes,6,opt,name=field_key,json=fieldKey,proto3" json:"field_key,omitempty"`
+	Flux                 string   `protobuf:"bytes,7,opt,name=flux,proto3" json:"flux,omitempty"`
+	Url                  string   `protobuf:"bytes,8,opt,name=url,proto3" json:"url,omitempty"`
+	Format               string   `protobuf:"bytes,9,opt,name=format,proto3" json:"format,omitempty"`
+	Column               string   `protobuf:"bytes,10,opt,name=column,proto3" json:"column,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -900,7 +1002,7 @@ func (m *TemplateQuery) Reset()         { *m = TemplateQuery{} }
 func (m *TemplateQuery) String() string { return proto.CompactTextString(m) }
 func (*TemplateQuery) ProtoMessage()    {}
 func (*TemplateQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_6148f2d00a5dfb1b, []int{11}
+	return fileDescriptor_41f4a519b878ee3b, []int{11}
 }
 func (m *TemplateQuery) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TemplateQuery.Unmarshal(m, b)
@@ -908,8 +1010,8 @@ func (m *TemplateQuery) XXX_Unmarshal(b []byte) error {
 func (m *TemplateQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_TemplateQuery.Marshal(b, m, deterministic)
 }
-func (dst *TemplateQuery) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TemplateQuery.Merge(dst, src)
+func (m *TemplateQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TemplateQuery.Merge(m, src)
 }
 func (m *TemplateQuery) XXX_Size() int {
 	return xxx_messageInfo_TemplateQuery.Size(m)
@@ -962,6 +1064,34 @@ func (m *TemplateQuery) GetFieldKey() string {
 	return ""
 }
 
+func (m *TemplateQuery) GetFlux() string {
+	if m != nil {
+		return m.Flux
+	}
+	return ""
+}
+
+func (m *TemplateQuery) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *TemplateQuery) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *TemplateQuery) GetColumn() string {
+	if m != nil {
+		return m.Column
+	}
+	return ""
+}
+
 type Server struct {
 	ID                   int64    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
 	Name                 string   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
@@ -983,7 +1113,7 @@ func (m *Server) Reset()         { *m = Server{} }
 func (m *Server) String() string { return proto.CompactTextString(m) }
 func (*Server) ProtoMessage()    {}
 func (*Server) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_6148f2d00a5dfb1b, []int{12}
+	return fileDescriptor_41f4a519b878ee3b, []int{12}
 }
 func (m *Server) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Server.Unmarshal(m, b)
@@ -991,8 +1121,8 @@ func (m *Server) XXX_Unmarshal(b []byte) error {
 func (m *Server) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_Server.Marshal(b, m, deterministic)
 }
-func (dst *Server) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Server.Merge(dst, src)
+func (m *Server) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Server.Merge(m, src)
 }
 func (m *Server) XXX_Size() int {
 	return xxx_messageInfo_Server.Size(m)
@@ -1084,7 +1214,7 @@ type Layout struct {
 	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
 	Application          string   `protobuf:"bytes,2,opt,name=Application,proto3" json:"Application,omitempty"`
 	Measurement          string   `protobuf:"bytes,3,opt,name=Measurement,proto3" json:"Measurement,omitempty"`
-	Cells                []*Cell  `protobuf:"bytes,4,rep,name=Cells" json:"Cells,omitempty"`
+	Cells                []*Cell  `protobuf:"bytes,4,rep,name=Cells,proto3" json:"Cells,omitempty"`
 	Autoflow             bool     `protobuf:"varint,5,opt,name=Autoflow,proto3" json:"Autoflow,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -1095,7 +1225,7 @@ func (m *Layout) Reset()         { *m = Layout{} }
 func (m *Layout) String() string { return proto.CompactTextString(m) }
 func (*Layout) ProtoMessage()    {}
 func (*Layout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_6148f2d00a5dfb1b, []int{13}
+	return fileDescriptor_41f4a519b878ee3b, []int{13}
 }
 func (m *Layout) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Layout.Unmarshal(m, b)
@@ -1103,8 +1233,8 @@ func (m *Layout) XXX_Unmarshal(b []byte) error {
 func (m *Layout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_Layout.Marshal(b, m, deterministic)
 }
-func (dst *Layout) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Layout.Merge(dst, src)
+func (m *Layout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Layout.Merge(m, src)
 }
 func (m *Layout) XXX_Size() int {
 	return xxx_messageInfo_Layout.Size(m)
@@ -1155,13 +1285,13 @@ type Cell struct {
 	Y                    int32            `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
 	W                    int32            `protobuf:"varint,3,opt,name=w,proto3" json:"w,omitempty"`
 	H                    int32            `protobuf:"varint,4,opt,name=h,proto3" json:"h,omitempty"`
-	Queries              []*Query         `protobuf:"bytes,5,rep,name=queries" json:"queries,omitempty"`
+	Queries              []*Query         `protobuf:"bytes,5,rep,name=queries,proto3" json:"queries,omitempty"`
 	I                    string           `protobuf:"bytes,6,opt,name=i,proto3" json:"i,omitempty"`
 	Name                 string           `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
-	Yranges              []int64          `protobuf:"varint,8,rep,packed,name=yranges" json:"yranges,omitempty"`
-	Ylabels              []string         `protobuf:"bytes,9,rep,name=ylabels" json:"ylabels,omitempty"`
+	Yranges              []int64          `protobuf:"varint,8,rep,packed,name=yranges,proto3" json:"yranges,omitempty"`
+	Ylabels              []string         `protobuf:"bytes,9,rep,name=ylabels,proto3" json:"ylabels,omitempty"`
 	Type                 string           `protobuf:"bytes,10,opt,name=type,proto3" json:"type,omitempty"`
-	Axes                 map[string]*Axis `protobuf:"bytes,11,rep,name=axes" json:"axes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+	Axes                 map[string]*Axis `protobuf:"bytes,11,rep,name=axes,proto3" json:"axes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -1171,7 +1301,7 @@ func (m *Cell) Reset()         { *m = Cell{} }
 func (m *Cell) String() string { return proto.CompactTextString(m) }
 func (*Cell) ProtoMessage()    {}
 func (*Cell) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_6148f2d00a5dfb1b, []int{14}
+	return fileDescriptor_41f4a519b878ee3b, []int{14}
 }
 func (m *Cell) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Cell.Unmarshal(m, b)
@@ -1179,8 +1309,8 @@ func (m *Cell) XXX_Unmarshal(b []byte) error {
 func (m *Cell) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_Cell.Marshal(b, m, deterministic)
 }
-func (dst *Cell) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Cell.Merge(dst, src)
+func (m *Cell) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Cell.Merge(m, src)
 }
 func (m *Cell) XXX_Size() int {
 	return xxx_messageInfo_Cell.Size(m)
@@ -1272,12 +1402,12 @@ type Query struct {
 	Command              string       `protobuf:"bytes,1,opt,name=Command,proto3" json:"Command,omitempty"`
 	DB                   string       `protobuf:"bytes,2,opt,name=DB,proto3" json:"DB,omitempty"`
 	RP                   string       `protobuf:"bytes,3,opt,name=RP,proto3" json:"RP,omitempty"`
-	GroupBys             []string     `protobuf:"bytes,4,rep,name=GroupBys" json:"GroupBys,omitempty"`
-	Wheres               []string     `protobuf:"bytes,5,rep,name=Wheres" json:"Wheres,omitempty"`
+	GroupBys             []string     `protobuf:"bytes,4,rep,name=GroupBys,proto3" json:"GroupBys,omitempty"`
+	Wheres               []string     `protobuf:"bytes,5,rep,name=Wheres,proto3" json:"Wheres,omitempty"`
 	Label                string       `protobuf:"bytes,6,opt,name=Label,proto3" json:"Label,omitempty"`
-	Range                *Range       `protobuf:"bytes,7,opt,name=Range" json:"Range,omitempty"`
+	Range                *Range       `protobuf:"bytes,7,opt,name=Range,proto3" json:"Range,omitempty"`
 	Source               string       `protobuf:"bytes,8,opt,name=Source,proto3" json:"Source,omitempty"`
-	Shifts               []*TimeShift `protobuf:"bytes,9,rep,name=Shifts" json:"Shifts,omitempty"`
+	Shifts               []*TimeShift `protobuf:"bytes,9,rep,name=Shifts,proto3" json:"Shifts,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -1287,7 +1417,7 @@ func (m *Query) Reset()         { *m = Query{} }
 func (m *Query) String() string { return proto.CompactTextString(m) }
 func (*Query) ProtoMessage()    {}
 func (*Query) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_6148f2d00a5dfb1b, []int{15}
+	return fileDescriptor_41f4a519b878ee3b, []int{15}
 }
 func (m *Query) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Query.Unmarshal(m, b)
@@ -1295,8 +1425,8 @@ func (m *Query) XXX_Unmarshal(b []byte) error {
 func (m *Query) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_Query.Marshal(b, m, deterministic)
 }
-func (dst *Query) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Query.Merge(dst, src)
+func (m *Query) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Query.Merge(m, src)
 }
 func (m *Query) XXX_Size() int {
 	return xxx_messageInfo_Query.Size(m)
@@ -1383,7 +1513,7 @@ func (m *TimeShift) Reset()         { *m = TimeShift{} }
 func (m *TimeShift) String() string { return proto.CompactTextString(m) }
 func (*TimeShift) ProtoMessage()    {}
 func (*TimeShift) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_6148f2d00a5dfb1b, []int{16}
+	return fileDescriptor_41f4a519b878ee3b, []int{16}
 }
 func (m *TimeShift) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TimeShift.Unmarshal(m, b)
@@ -1391,8 +1521,8 @@ func (m *TimeShift) XXX_Unmarshal(b []byte) error {
 func (m *TimeShift) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_TimeShift.Marshal(b, m, deterministic)
 }
-func (dst *TimeShift) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TimeShift.Merge(dst, src)
+func (m *TimeShift) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeShift.Merge(m, src)
 }
 func (m *TimeShift) XXX_Size() int {
 	return xxx_messageInfo_TimeShift.Size(m)
@@ -1436,7 +1566,7 @@ func (m *Range) Reset()         { *m = Range{} }
 func (m *Range) String() string { return proto.CompactTextString(m) }
 func (*Range) ProtoMessage()    {}
 func (*Range) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_6148f2d00a5dfb1b, []int{17}
+	return fileDescriptor_41f4a519b878ee3b, []int{17}
 }
 func (m *Range) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Range.Unmarshal(m, b)
@@ -1444,8 +1574,8 @@ func (m *Range) XXX_Unmarshal(b []byte) error {
 func (m *Range) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_Range.Marshal(b, m, deterministic)
 }
-func (dst *Range) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Range.Merge(dst, src)
+func (m *Range) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Range.Merge(m, src)
 }
 func (m *Range) XXX_Size() int {
 	return xxx_messageInfo_Range.Size(m)
@@ -1484,7 +1614,7 @@ func (m *AlertRule) Reset()         { *m = AlertRule{} }
 func (m *AlertRule) String() string { return proto.CompactTextString(m) }
 func (*AlertRule) ProtoMessage()    {}
 func (*AlertRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_6148f2d00a5dfb1b, []int{18}
+	return fileDescriptor_41f4a519b878ee3b, []int{18}
 }
 func (m *AlertRule) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AlertRule.Unmarshal(m, b)
@@ -1492,8 +1622,8 @@ func (m *AlertRule) XXX_Unmarshal(b []byte) error {
 func (m *AlertRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_AlertRule.Marshal(b, m, deterministic)
 }
-func (dst *AlertRule) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AlertRule.Merge(dst, src)
+func (m *AlertRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertRule.Merge(m, src)
 }
 func (m *AlertRule) XXX_Size() int {
 	return xxx_messageInfo_AlertRule.Size(m)
@@ -1537,8 +1667,14 @@ type User struct {
 	Name                 string   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
 	Provider             string   `protobuf:"bytes,3,opt,name=Provider,proto3" json:"Provider,omitempty"`
 	Scheme               string   `protobuf:"bytes,4,opt,name=Scheme,proto3" json:"Scheme,omitempty"`
-	Roles                []*Role  `protobuf:"bytes,5,rep,name=Roles" json:"Roles,omitempty"`
+	Roles                []*Role  `protobuf:"bytes,5,rep,name=Roles,proto3" json:"Roles,omitempty"`
 	SuperAdmin           bool     `protobuf:"varint,6,opt,name=SuperAdmin,proto3" json:"SuperAdmin,omitempty"`
+	Status               string   `protobuf:"bytes,7,opt,name=Status,proto3" json:"Status,omitempty"`
+	PasswordHash         string   `protobuf:"bytes,8,opt,name=PasswordHash,proto3" json:"PasswordHash,omitempty"`
+	TOTPSecret           string   `protobuf:"bytes,9,opt,name=TOTPSecret,proto3" json:"TOTPSecret,omitempty"`
+	TOTPEnabled          bool     `protobuf:"varint,10,opt,name=TOTPEnabled,proto3" json:"TOTPEnabled,omitempty"`
+	TOTPCounter          uint64   `protobuf:"varint,11,opt,name=TOTPCounter,proto3" json:"TOTPCounter,omitempty"`
+	RecoveryCodeHashes   []string `protobuf:"bytes,12,rep,name=RecoveryCodeHashes,proto3" json:"RecoveryCodeHashes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1548,7 +1684,7 @@ func (m *User) Reset()         { *m = User{} }
 func (m *User) String() string { return proto.CompactTextString(m) }
 func (*User) ProtoMessage()    {}
 func (*User) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_6148f2d00a5dfb1b, []int{19}
+	return fileDescriptor_41f4a519b878ee3b, []int{19}
 }
 func (m *User) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_User.Unmarshal(m, b)
@@ -1556,8 +1692,8 @@ func (m *User) XXX_Unmarshal(b []byte) error {
 func (m *User) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_User.Marshal(b, m, deterministic)
 }
-func (dst *User) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_User.Merge(dst, src)
+func (m *User) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_User.Merge(m, src)
 }
 func (m *User) XXX_Size() int {
 	return xxx_messageInfo_User.Size(m)
@@ -1610,6 +1746,48 @@ func (m *User) GetSuperAdmin() bool {
 	return false
 }
 
+func (m *User) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *User) GetPasswordHash() string {
+	if m != nil {
+		return m.PasswordHash
+	}
+	return ""
+}
+
+func (m *User) GetTOTPSecret() string {
+	if m != nil {
+		return m.TOTPSecret
+	}
+	return ""
+}
+
+func (m *User) GetTOTPEnabled() bool {
+	if m != nil {
+		return m.TOTPEnabled
+	}
+	return false
+}
+
+func (m *User) GetTOTPCounter() uint64 {
+	if m != nil {
+		return m.TOTPCounter
+	}
+	return 0
+}
+
+func (m *User) GetRecoveryCodeHashes() []string {
+	if m != nil {
+		return m.RecoveryCodeHashes
+	}
+	return nil
+}
+
 type Role struct {
 	Organization         string   `protobuf:"bytes,1,opt,name=Organization,proto3" json:"Organization,omitempty"`
 	Name                 string   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
@@ -1622,7 +1800,7 @@ func (m *Role) Reset()         { *m = Role{} }
 func (m *Role) String() string { return proto.CompactTextString(m) }
 func (*Role) ProtoMessage()    {}
 func (*Role) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_6148f2d00a5dfb1b, []int{20}
+	return fileDescriptor_41f4a519b878ee3b, []int{20}
 }
 func (m *Role) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Role.Unmarshal(m, b)
@@ -1630,8 +1808,8 @@ func (m *Role) XXX_Unmarshal(b []byte) error {
 func (m *Role) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_Role.Marshal(b, m, deterministic)
 }
-func (dst *Role) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Role.Merge(dst, src)
+func (m *Role) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Role.Merge(m, src)
 }
 func (m *Role) XXX_Size() int {
 	return xxx_messageInfo_Role.Size(m)
@@ -1662,6 +1840,7 @@ type Mapping struct {
 	ProviderOrganization string   `protobuf:"bytes,3,opt,name=ProviderOrganization,proto3" json:"ProviderOrganization,omitempty"`
 	ID                   string   `protobuf:"bytes,4,opt,name=ID,proto3" json:"ID,omitempty"`
 	Organization         string   `protobuf:"bytes,5,opt,name=Organization,proto3" json:"Organization,omitempty"`
+	Role                 string   `protobuf:"bytes,6,opt,name=Role,proto3" json:"Role,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1671,7 +1850,7 @@ func (m *Mapping) Reset()         { *m = Mapping{} }
 func (m *Mapping) String() string { return proto.CompactTextString(m) }
 func (*Mapping) ProtoMessage()    {}
 func (*Mapping) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_6148f2d00a5dfb1b, []int{21}
+	return fileDescriptor_41f4a519b878ee3b, []int{21}
 }
 func (m *Mapping) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Mapping.Unmarshal(m, b)
@@ -1679,8 +1858,8 @@ func (m *Mapping) XXX_Unmarshal(b []byte) error {
 func (m *Mapping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_Mapping.Marshal(b, m, deterministic)
 }
-func (dst *Mapping) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Mapping.Merge(dst, src)
+func (m *Mapping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Mapping.Merge(m, src)
 }
 func (m *Mapping) XXX_Size() int {
 	return xxx_messageInfo_Mapping.Size(m)
@@ -1726,20 +1905,28 @@ func (m *Mapping) GetOrganization() string {
 	return ""
 }
 
+func (m *Mapping) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
 type Organization struct {
-	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	DefaultRole          string   `protobuf:"bytes,3,opt,name=DefaultRole,proto3" json:"DefaultRole,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ID                   string              `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name                 string              `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	DefaultRole          string              `protobuf:"bytes,3,opt,name=DefaultRole,proto3" json:"DefaultRole,omitempty"`
+	Quotas               *OrganizationQuotas `protobuf:"bytes,4,opt,name=Quotas,proto3" json:"Quotas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *Organization) Reset()         { *m = Organization{} }
 func (m *Organization) String() string { return proto.CompactTextString(m) }
 func (*Organization) ProtoMessage()    {}
 func (*Organization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_6148f2d00a5dfb1b, []int{22}
+	return fileDescriptor_41f4a519b878ee3b, []int{22}
 }
 func (m *Organization) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Organization.Unmarshal(m, b)
@@ -1747,8 +1934,8 @@ func (m *Organization) XXX_Unmarshal(b []byte) error {
 func (m *Organization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_Organization.Marshal(b, m, deterministic)
 }
-func (dst *Organization) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Organization.Merge(dst, src)
+func (m *Organization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Organization.Merge(m, src)
 }
 func (m *Organization) XXX_Size() int {
 	return xxx_messageInfo_Organization.Size(m)
@@ -1780,8 +1967,15 @@ func (m *Organization) GetDefaultRole() string {
 	return ""
 }
 
+func (m *Organization) GetQuotas() *OrganizationQuotas {
+	if m != nil {
+		return m.Quotas
+	}
+	return nil
+}
+
 type Config struct {
-	Auth                 *AuthConfig `protobuf:"bytes,1,opt,name=Auth" json:"Auth,omitempty"`
+	Auth                 *AuthConfig `protobuf:"bytes,1,opt,name=Auth,proto3" json:"Auth,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -1791,7 +1985,7 @@ func (m *Config) Reset()         { *m = Config{} }
 func (m *Config) String() string { return proto.CompactTextString(m) }
 func (*Config) ProtoMessage()    {}
 func (*Config) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_6148f2d00a5dfb1b, []int{23}
+	return fileDescriptor_41f4a519b878ee3b, []int{23}
 }
 func (m *Config) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Config.Unmarshal(m, b)
@@ -1799,8 +1993,8 @@ func (m *Config) XXX_Unmarshal(b []byte) error {
 func (m *Config) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_Config.Marshal(b, m, deterministic)
 }
-func (dst *Config) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Config.Merge(dst, src)
+func (m *Config) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Config.Merge(m, src)
 }
 func (m *Config) XXX_Size() int {
 	return xxx_messageInfo_Config.Size(m)
@@ -1819,17 +2013,20 @@ func (m *Config) GetAuth() *AuthConfig {
 }
 
 type AuthConfig struct {
-	SuperAdminNewUsers   bool     `protobuf:"varint,1,opt,name=SuperAdminNewUsers,proto3" json:"SuperAdminNewUsers,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	SuperAdminNewUsers    bool     `protobuf:"varint,1,opt,name=SuperAdminNewUsers,proto3" json:"SuperAdminNewUsers,omitempty"`
+	AutoProvisionNewUsers bool     `protobuf:"varint,2,opt,name=AutoProvisionNewUsers,proto3" json:"AutoProvisionNewUsers,omitempty"`
+	NewUsersOrganization  string   `protobuf:"bytes,3,opt,name=NewUsersOrganization,proto3" json:"NewUsersOrganization,omitempty"`
+	NewUsersRole          string   `protobuf:"bytes,4,opt,name=NewUsersRole,proto3" json:"NewUsersRole,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
 func (m *AuthConfig) Reset()         { *m = AuthConfig{} }
 func (m *AuthConfig) String() string { return proto.CompactTextString(m) }
 func (*AuthConfig) ProtoMessage()    {}
 func (*AuthConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_6148f2d00a5dfb1b, []int{24}
+	return fileDescriptor_41f4a519b878ee3b, []int{24}
 }
 func (m *AuthConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AuthConfig.Unmarshal(m, b)
@@ -1837,8 +2034,8 @@ func (m *AuthConfig) XXX_Unmarshal(b []byte) error {
 func (m *AuthConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_AuthConfig.Marshal(b, m, deterministic)
 }
-func (dst *AuthConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthConfig.Merge(dst, src)
+func (m *AuthConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthConfig.Merge(m, src)
 }
 func (m *AuthConfig) XXX_Size() int {
 	return xxx_messageInfo_AuthConfig.Size(m)
@@ -1856,9 +2053,30 @@ func (m *AuthConfig) GetSuperAdminNewUsers() bool {
 	return false
 }
 
+func (m *AuthConfig) GetAutoProvisionNewUsers() bool {
+	if m != nil {
+		return m.AutoProvisionNewUsers
+	}
+	return false
+}
+
+func (m *AuthConfig) GetNewUsersOrganization() string {
+	if m != nil {
+		return m.NewUsersOrganization
+	}
+	return ""
+}
+
+func (m *AuthConfig) GetNewUsersRole() string {
+	if m != nil {
+		return m.NewUsersRole
+	}
+	return ""
+}
+
 type OrganizationConfig struct {
 	OrganizationID       string           `protobuf:"bytes,1,opt,name=OrganizationID,proto3" json:"OrganizationID,omitempty"`
-	LogViewer            *LogViewerConfig `protobuf:"bytes,2,opt,name=LogViewer" json:"LogViewer,omitempty"`
+	LogViewer            *LogViewerConfig `protobuf:"bytes,2,opt,name=LogViewer,proto3" json:"LogViewer,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -1868,7 +2086,7 @@ func (m *OrganizationConfig) Reset()         { *m = OrganizationConfig{} }
 func (m *OrganizationConfig) String() string { return proto.CompactTextString(m) }
 func (*OrganizationConfig) ProtoMessage()    {}
 func (*OrganizationConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_6148f2d00a5dfb1b, []int{25}
+	return fileDescriptor_41f4a519b878ee3b, []int{25}
 }
 func (m *OrganizationConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OrganizationConfig.Unmarshal(m, b)
@@ -1876,8 +2094,8 @@ func (m *OrganizationConfig) XXX_Unmarshal(b []byte) error {
 func (m *OrganizationConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_OrganizationConfig.Marshal(b, m, deterministic)
 }
-func (dst *OrganizationConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OrganizationConfig.Merge(dst, src)
+func (m *OrganizationConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrganizationConfig.Merge(m, src)
 }
 func (m *OrganizationConfig) XXX_Size() int {
 	return xxx_messageInfo_OrganizationConfig.Size(m)
@@ -1903,7 +2121,7 @@ func (m *OrganizationConfig) GetLogViewer() *LogViewerConfig {
 }
 
 type LogViewerConfig struct {
-	Columns              []*LogViewerColumn `protobuf:"bytes,1,rep,name=Columns" json:"Columns,omitempty"`
+	Columns              []*LogViewerColumn `protobuf:"bytes,1,rep,name=Columns,proto3" json:"Columns,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
@@ -1913,7 +2131,7 @@ func (m *LogViewerConfig) Reset()         { *m = LogViewerConfig{} }
 func (m *LogViewerConfig) String() string { return proto.CompactTextString(m) }
 func (*LogViewerConfig) ProtoMessage()    {}
 func (*LogViewerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_6148f2d00a5dfb1b, []int{26}
+	return fileDescriptor_41f4a519b878ee3b, []int{26}
 }
 func (m *LogViewerConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LogViewerConfig.Unmarshal(m, b)
@@ -1921,8 +2139,8 @@ func (m *LogViewerConfig) XXX_Unmarshal(b []byte) error {
 func (m *LogViewerConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_LogViewerConfig.Marshal(b, m, deterministic)
 }
-func (dst *LogViewerConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LogViewerConfig.Merge(dst, src)
+func (m *LogViewerConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogViewerConfig.Merge(m, src)
 }
 func (m *LogViewerConfig) XXX_Size() int {
 	return xxx_messageInfo_LogViewerConfig.Size(m)
@@ -1943,7 +2161,7 @@ func (m *LogViewerConfig) GetColumns() []*LogViewerColumn {
 type LogViewerColumn struct {
 	Name                 string            `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
 	Position             int32             `protobuf:"varint,2,opt,name=Position,proto3" json:"Position,omitempty"`
-	Encodings            []*ColumnEncoding `protobuf:"bytes,3,rep,name=Encodings" json:"Encodings,omitempty"`
+	Encodings            []*ColumnEncoding `protobuf:"bytes,3,rep,name=Encodings,proto3" json:"Encodings,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1953,7 +2171,7 @@ func (m *LogViewerColumn) Reset()         { *m = LogViewerColumn{} }
 func (m *LogViewerColumn) String() string { return proto.CompactTextString(m) }
 func (*LogViewerColumn) ProtoMessage()    {}
 func (*LogViewerColumn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_6148f2d00a5dfb1b, []int{27}
+	return fileDescriptor_41f4a519b878ee3b, []int{27}
 }
 func (m *LogViewerColumn) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LogViewerColumn.Unmarshal(m, b)
@@ -1961,8 +2179,8 @@ func (m *LogViewerColumn) XXX_Unmarshal(b []byte) error {
 func (m *LogViewerColumn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_LogViewerColumn.Marshal(b, m, deterministic)
 }
-func (dst *LogViewerColumn) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LogViewerColumn.Merge(dst, src)
+func (m *LogViewerColumn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogViewerColumn.Merge(m, src)
 }
 func (m *LogViewerColumn) XXX_Size() int {
 	return xxx_messageInfo_LogViewerColumn.Size(m)
@@ -2007,7 +2225,7 @@ func (m *ColumnEncoding) Reset()         { *m = ColumnEncoding{} }
 func (m *ColumnEncoding) String() string { return proto.CompactTextString(m) }
 func (*ColumnEncoding) ProtoMessage()    {}
 func (*ColumnEncoding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_6148f2d00a5dfb1b, []int{28}
+	return fileDescriptor_41f4a519b878ee3b, []int{28}
 }
 func (m *ColumnEncoding) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ColumnEncoding.Unmarshal(m, b)
@@ -2015,8 +2233,8 @@ func (m *ColumnEncoding) XXX_Unmarshal(b []byte) error {
 func (m *ColumnEncoding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ColumnEncoding.Marshal(b, m, deterministic)
 }
-func (dst *ColumnEncoding) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ColumnEncoding.Merge(dst, src)
+func (m *ColumnEncoding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ColumnEncoding.Merge(m, src)
 }
 func (m *ColumnEncoding) XXX_Size() int {
 	return xxx_messageInfo_ColumnEncoding.Size(m)
@@ -2060,7 +2278,7 @@ func (m *BuildInfo) Reset()         { *m = BuildInfo{} }
 func (m *BuildInfo) String() string { return proto.CompactTextString(m) }
 func (*BuildInfo) ProtoMessage()    {}
 func (*BuildInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_internal_6148f2d00a5dfb1b, []int{29}
+	return fileDescriptor_41f4a519b878ee3b, []int{29}
 }
 func (m *BuildInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BuildInfo.Unmarshal(m, b)
@@ -2068,8 +2286,8 @@ func (m *BuildInfo) XXX_Unmarshal(b []byte) error {
 func (m *BuildInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_BuildInfo.Marshal(b, m, deterministic)
 }
-func (dst *BuildInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BuildInfo.Merge(dst, src)
+func (m *BuildInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildInfo.Merge(m, src)
 }
 func (m *BuildInfo) XXX_Size() int {
 	return xxx_messageInfo_BuildInfo.Size(m)
@@ -2094,156 +2312,2854 @@ func (m *BuildInfo) GetCommit() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Source)(nil), "internal.Source")
-	proto.RegisterType((*Dashboard)(nil), "internal.Dashboard")
-	proto.RegisterType((*DashboardCell)(nil), "internal.DashboardCell")
-	proto.RegisterMapType((map[string]*Axis)(nil), "internal.DashboardCell.AxesEntry")
-	proto.RegisterType((*DecimalPlaces)(nil), "internal.DecimalPlaces")
-	proto.RegisterType((*TableOptions)(nil), "internal.TableOptions")
-	proto.RegisterType((*RenamableField)(nil), "internal.RenamableField")
-	proto.RegisterType((*Color)(nil), "internal.Color")
-	proto.RegisterType((*Legend)(nil), "internal.Legend")
-	proto.RegisterType((*Axis)(nil), "internal.Axis")
-	proto.RegisterType((*Template)(nil), "internal.Template")
-	proto.RegisterType((*TemplateValue)(nil), "internal.TemplateValue")
-	proto.RegisterType((*TemplateQuery)(nil), "internal.TemplateQuery")
-	proto.RegisterType((*Server)(nil), "internal.Server")
-	proto.RegisterType((*Layout)(nil), "internal.Layout")
-	proto.RegisterType((*Cell)(nil), "internal.Cell")
-	proto.RegisterMapType((map[string]*Axis)(nil), "internal.Cell.AxesEntry")
-	proto.RegisterType((*Query)(nil), "internal.Query")
-	proto.RegisterType((*TimeShift)(nil), "internal.TimeShift")
-	proto.RegisterType((*Range)(nil), "internal.Range")
-	proto.RegisterType((*AlertRule)(nil), "internal.AlertRule")
-	proto.RegisterType((*User)(nil), "internal.User")
-	proto.RegisterType((*Role)(nil), "internal.Role")
-	proto.RegisterType((*Mapping)(nil), "internal.Mapping")
-	proto.RegisterType((*Organization)(nil), "internal.Organization")
-	proto.RegisterType((*Config)(nil), "internal.Config")
-	proto.RegisterType((*AuthConfig)(nil), "internal.AuthConfig")
-	proto.RegisterType((*OrganizationConfig)(nil), "internal.OrganizationConfig")
-	proto.RegisterType((*LogViewerConfig)(nil), "internal.LogViewerConfig")
-	proto.RegisterType((*LogViewerColumn)(nil), "internal.LogViewerColumn")
-	proto.RegisterType((*ColumnEncoding)(nil), "internal.ColumnEncoding")
-	proto.RegisterType((*BuildInfo)(nil), "internal.BuildInfo")
+type Notification struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	UserID               uint64   `protobuf:"varint,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Organization         string   `protobuf:"bytes,3,opt,name=Organization,proto3" json:"Organization,omitempty"`
+	Type                 string   `protobuf:"bytes,4,opt,name=Type,proto3" json:"Type,omitempty"`
+	Title                string   `protobuf:"bytes,5,opt,name=Title,proto3" json:"Title,omitempty"`
+	Message              string   `protobuf:"bytes,6,opt,name=Message,proto3" json:"Message,omitempty"`
+	Link                 string   `protobuf:"bytes,7,opt,name=Link,proto3" json:"Link,omitempty"`
+	Read                 bool     `protobuf:"varint,8,opt,name=Read,proto3" json:"Read,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,9,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Notification) Reset()         { *m = Notification{} }
+func (m *Notification) String() string { return proto.CompactTextString(m) }
+func (*Notification) ProtoMessage()    {}
+func (*Notification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{30}
+}
+func (m *Notification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Notification.Unmarshal(m, b)
+}
+func (m *Notification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Notification.Marshal(b, m, deterministic)
+}
+func (m *Notification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Notification.Merge(m, src)
+}
+func (m *Notification) XXX_Size() int {
+	return xxx_messageInfo_Notification.Size(m)
+}
+func (m *Notification) XXX_DiscardUnknown() {
+	xxx_messageInfo_Notification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Notification proto.InternalMessageInfo
+
+func (m *Notification) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *Notification) GetUserID() uint64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *Notification) GetOrganization() string {
+	if m != nil {
+		return m.Organization
+	}
+	return ""
+}
+
+func (m *Notification) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Notification) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Notification) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("internal.proto", fileDescriptor_internal_6148f2d00a5dfb1b) }
-
-var fileDescriptor_internal_6148f2d00a5dfb1b = []byte{
-	// 1807 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4b, 0x6f, 0xdc, 0xc8,
-	0x11, 0x06, 0x67, 0x86, 0xa3, 0x61, 0x8d, 0x24, 0x0b, 0x1d, 0x63, 0x97, 0xbb, 0x09, 0x82, 0x09,
-	0x91, 0x6c, 0x94, 0xc7, 0x3a, 0x0b, 0x19, 0x79, 0x60, 0xb1, 0xbb, 0x80, 0x1e, 0xb6, 0x23, 0x5b,
-	0xb6, 0xe5, 0x96, 0xac, 0x9c, 0x82, 0x45, 0x8b, 0xec, 0x99, 0x69, 0x98, 0x43, 0x32, 0x4d, 0x52,
-	0x12, 0x73, 0xce, 0xef, 0x08, 0x10, 0x20, 0xb9, 0x07, 0x41, 0x8e, 0x01, 0x72, 0xcf, 0x0f, 0xc8,
-	0x5f, 0xc9, 0x35, 0xa8, 0x7e, 0x90, 0x4d, 0x69, 0x6c, 0x38, 0x40, 0xb0, 0xb7, 0xfe, 0xaa, 0x6a,
-	0xaa, 0xab, 0xab, 0xab, 0xbe, 0x2e, 0x0e, 0x6c, 0x8b, 0xac, 0xe2, 0x32, 0x63, 0xe9, 0x83, 0x42,
-	0xe6, 0x55, 0x4e, 0x26, 0x16, 0x47, 0x7f, 0x18, 0xc2, 0xf8, 0x2c, 0xaf, 0x65, 0xcc, 0xc9, 0x36,
-	0x0c, 0x8e, 0x8f, 0x42, 0x6f, 0xe6, 0xed, 0x0e, 0xe9, 0xe0, 0xf8, 0x88, 0x10, 0x18, 0xbd, 0x60,
-	0x2b, 0x1e, 0x0e, 0x66, 0xde, 0x6e, 0x40, 0xd5, 0x1a, 0x65, 0xe7, 0x4d, 0xc1, 0xc3, 0xa1, 0x96,
-	0xe1, 0x9a, 0x7c, 0x0c, 0x93, 0xd7, 0x25, 0x7a, 0x5b, 0xf1, 0x70, 0xa4, 0xe4, 0x2d, 0x46, 0xdd,
-	0x29, 0x2b, 0xcb, 0xeb, 0x5c, 0x26, 0xa1, 0xaf, 0x75, 0x16, 0x93, 0x1d, 0x18, 0xbe, 0xa6, 0x27,
-	0xe1, 0x58, 0x89, 0x71, 0x49, 0x42, 0xd8, 0x38, 0xe2, 0x73, 0x56, 0xa7, 0x55, 0xb8, 0x31, 0xf3,
-	0x76, 0x27, 0xd4, 0x42, 0xf4, 0x73, 0xce, 0x53, 0xbe, 0x90, 0x6c, 0x1e, 0x4e, 0xb4, 0x1f, 0x8b,
-	0xc9, 0x03, 0x20, 0xc7, 0x59, 0xc9, 0xe3, 0x5a, 0xf2, 0xb3, 0x37, 0xa2, 0xb8, 0xe0, 0x52, 0xcc,
-	0x9b, 0x30, 0x50, 0x0e, 0xd6, 0x68, 0x70, 0x97, 0xe7, 0xbc, 0x62, 0xb8, 0x37, 0x28, 0x57, 0x16,
-	0x92, 0x08, 0x36, 0xcf, 0x96, 0x4c, 0xf2, 0xe4, 0x8c, 0xc7, 0x92, 0x57, 0xe1, 0x54, 0xa9, 0x7b,
-	0x32, 0xb4, 0x79, 0x29, 0x17, 0x2c, 0x13, 0xbf, 0x67, 0x95, 0xc8, 0xb3, 0x70, 0x53, 0xdb, 0xb8,
-	0x32, 0xcc, 0x12, 0xcd, 0x53, 0x1e, 0x6e, 0xe9, 0x2c, 0xe1, 0x9a, 0x7c, 0x07, 0x02, 0x73, 0x18,
-	0x7a, 0x1a, 0x6e, 0x2b, 0x45, 0x27, 0x88, 0xfe, 0xee, 0x41, 0x70, 0xc4, 0xca, 0xe5, 0x65, 0xce,
-	0x64, 0xf2, 0x5e, 0x37, 0xf1, 0x29, 0xf8, 0x31, 0x4f, 0xd3, 0x32, 0x1c, 0xce, 0x86, 0xbb, 0xd3,
-	0xbd, 0x0f, 0x1f, 0xb4, 0x57, 0xdc, 0xfa, 0x39, 0xe4, 0x69, 0x4a, 0xb5, 0x15, 0xf9, 0x0c, 0x82,
-	0x8a, 0xaf, 0x8a, 0x94, 0x55, 0xbc, 0x0c, 0x47, 0xea, 0x27, 0xa4, 0xfb, 0xc9, 0xb9, 0x51, 0xd1,
-	0xce, 0xe8, 0xce, 0x41, 0xfd, 0xbb, 0x07, 0x8d, 0xfe, 0x3d, 0x82, 0xad, 0xde, 0x76, 0x64, 0x13,
-	0xbc, 0x1b, 0x15, 0xb9, 0x4f, 0xbd, 0x1b, 0x44, 0x8d, 0x8a, 0xda, 0xa7, 0x5e, 0x83, 0xe8, 0x5a,
-	0x55, 0x8e, 0x4f, 0xbd, 0x6b, 0x44, 0x4b, 0x55, 0x2f, 0x3e, 0xf5, 0x96, 0xe4, 0x47, 0xb0, 0xf1,
-	0xbb, 0x9a, 0x4b, 0xc1, 0xcb, 0xd0, 0x57, 0xd1, 0xdd, 0xeb, 0xa2, 0x7b, 0x55, 0x73, 0xd9, 0x50,
-	0xab, 0xc7, 0x6c, 0xa8, 0x5a, 0xd3, 0x85, 0xa3, 0xd6, 0x28, 0xab, 0xb0, 0x2e, 0x37, 0xb4, 0x0c,
-	0xd7, 0x26, 0x8b, 0xba, 0x5a, 0x30, 0x8b, 0x3f, 0x87, 0x11, 0xbb, 0xe1, 0x65, 0x18, 0x28, 0xff,
-	0xdf, 0x7b, 0x4b, 0xc2, 0x1e, 0xec, 0xdf, 0xf0, 0xf2, 0x51, 0x56, 0xc9, 0x86, 0x2a, 0x73, 0xf2,
-	0x43, 0x18, 0xc7, 0x79, 0x9a, 0xcb, 0x32, 0x84, 0xdb, 0x81, 0x1d, 0xa2, 0x9c, 0x1a, 0x35, 0xd9,
-	0x85, 0x71, 0xca, 0x17, 0x3c, 0x4b, 0x54, 0xdd, 0x4c, 0xf7, 0x76, 0x3a, 0xc3, 0x13, 0x25, 0xa7,
-	0x46, 0x4f, 0x3e, 0x87, 0xcd, 0x8a, 0x5d, 0xa6, 0xfc, 0x65, 0x81, 0x59, 0x2c, 0x55, 0x0d, 0x4d,
-	0xf7, 0x3e, 0x70, 0xee, 0xc3, 0xd1, 0xd2, 0x9e, 0x2d, 0xf9, 0x02, 0x36, 0xe7, 0x82, 0xa7, 0x89,
-	0xfd, 0xed, 0x96, 0x0a, 0x2a, 0xec, 0x7e, 0x4b, 0x79, 0xc6, 0x56, 0xf8, 0x8b, 0xc7, 0x68, 0x46,
-	0x7b, 0xd6, 0xe4, 0xbb, 0x00, 0x95, 0x58, 0xf1, 0xc7, 0xb9, 0x5c, 0xb1, 0xca, 0x94, 0xa1, 0x23,
-	0x21, 0x5f, 0xc2, 0x56, 0xc2, 0x63, 0xb1, 0x62, 0xe9, 0x69, 0xca, 0x62, 0x5e, 0x86, 0xf7, 0x54,
-	0x68, 0x6e, 0x75, 0xb9, 0x6a, 0xda, 0xb7, 0xfe, 0xf8, 0x09, 0x04, 0x6d, 0xfa, 0xb0, 0xbf, 0xdf,
-	0xf0, 0x46, 0x15, 0x43, 0x40, 0x71, 0x49, 0xbe, 0x0f, 0xfe, 0x15, 0x4b, 0x6b, 0x5d, 0xc8, 0xd3,
-	0xbd, 0xed, 0xce, 0xeb, 0xfe, 0x8d, 0x28, 0xa9, 0x56, 0x7e, 0x3e, 0xf8, 0x95, 0x17, 0x3d, 0x81,
-	0xad, 0xde, 0x46, 0x18, 0xb8, 0x28, 0x1f, 0x65, 0xf3, 0x5c, 0xc6, 0x3c, 0x51, 0x3e, 0x27, 0xd4,
-	0x91, 0x90, 0x0f, 0x60, 0x9c, 0x88, 0x85, 0xa8, 0x4a, 0x53, 0x6e, 0x06, 0x45, 0xff, 0xf0, 0x60,
-	0xd3, 0xcd, 0x26, 0xf9, 0x31, 0xec, 0x5c, 0x71, 0x59, 0x89, 0x98, 0xa5, 0xe7, 0x62, 0xc5, 0x71,
-	0x63, 0xf5, 0x93, 0x09, 0xbd, 0x23, 0x27, 0x9f, 0xc1, 0xb8, 0xcc, 0x65, 0x75, 0xd0, 0xa8, 0xaa,
-	0x7d, 0x57, 0x96, 0x8d, 0x1d, 0xf2, 0xd4, 0xb5, 0x64, 0x45, 0x21, 0xb2, 0x85, 0xe5, 0x42, 0x8b,
-	0xc9, 0x27, 0xb0, 0x3d, 0x17, 0x37, 0x8f, 0x85, 0x2c, 0xab, 0xc3, 0x3c, 0xad, 0x57, 0x99, 0xaa,
-	0xe0, 0x09, 0xbd, 0x25, 0x7d, 0x3a, 0x9a, 0x78, 0x3b, 0x83, 0xa7, 0xa3, 0x89, 0xbf, 0x33, 0x8e,
-	0x0a, 0xd8, 0xee, 0xef, 0x84, 0x6d, 0x69, 0x83, 0x50, 0x9c, 0xa0, 0xd3, 0xdb, 0x93, 0x91, 0x19,
-	0x4c, 0x13, 0x51, 0x16, 0x29, 0x6b, 0x1c, 0xda, 0x70, 0x45, 0xc8, 0x81, 0x57, 0xa2, 0x14, 0x97,
-	0xa9, 0xa6, 0xf2, 0x09, 0xb5, 0x30, 0x5a, 0x80, 0xaf, 0xca, 0xda, 0x21, 0xa1, 0xc0, 0x92, 0x90,
-	0xa2, 0xfe, 0x81, 0x43, 0xfd, 0x3b, 0x30, 0xfc, 0x35, 0xbf, 0x31, 0xaf, 0x01, 0x2e, 0x5b, 0xaa,
-	0x1a, 0x39, 0x54, 0x75, 0x1f, 0xfc, 0x0b, 0x75, 0xed, 0x9a, 0x42, 0x34, 0x88, 0xbe, 0x82, 0xb1,
-	0x6e, 0x8b, 0xd6, 0xb3, 0xe7, 0x78, 0x9e, 0xc1, 0xf4, 0xa5, 0x14, 0x3c, 0xab, 0x34, 0xf9, 0x98,
-	0x23, 0x38, 0xa2, 0xe8, 0x6f, 0x1e, 0x8c, 0xd4, 0x2d, 0x45, 0xb0, 0x99, 0xf2, 0x05, 0x8b, 0x9b,
-	0x83, 0xbc, 0xce, 0x92, 0x32, 0xf4, 0x66, 0xc3, 0xdd, 0x21, 0xed, 0xc9, 0xb0, 0x3c, 0x2e, 0xb5,
-	0x76, 0x30, 0x1b, 0xee, 0x06, 0xd4, 0x20, 0x0c, 0x2d, 0x65, 0x97, 0x3c, 0x35, 0x47, 0xd0, 0x00,
-	0xad, 0x0b, 0xc9, 0xe7, 0xe2, 0xc6, 0x1c, 0xc3, 0x20, 0x94, 0x97, 0xf5, 0x1c, 0xe5, 0xfa, 0x24,
-	0x06, 0xe1, 0x01, 0x2e, 0x59, 0xd9, 0x32, 0x12, 0xae, 0xd1, 0x73, 0x19, 0xb3, 0xd4, 0x52, 0x92,
-	0x06, 0xd1, 0x3f, 0x3d, 0x7c, 0xc8, 0x34, 0xc5, 0xde, 0xc9, 0xf0, 0x47, 0x30, 0x41, 0xfa, 0xfd,
-	0xfa, 0x8a, 0x49, 0x73, 0xe0, 0x0d, 0xc4, 0x17, 0x4c, 0x92, 0x9f, 0xc1, 0x58, 0x35, 0xc7, 0x1a,
-	0xba, 0xb7, 0xee, 0x54, 0x56, 0xa9, 0x31, 0x6b, 0x09, 0x71, 0xe4, 0x10, 0x62, 0x7b, 0x58, 0xdf,
-	0x3d, 0xec, 0xa7, 0xe0, 0x23, 0xb3, 0x36, 0x2a, 0xfa, 0xb5, 0x9e, 0x35, 0xff, 0x6a, 0xab, 0x68,
-	0x01, 0x5b, 0xbd, 0x1d, 0xdb, 0x9d, 0xbc, 0xfe, 0x4e, 0x5d, 0xa3, 0x07, 0xa6, 0xb1, 0xb1, 0x39,
-	0x4a, 0x9e, 0xf2, 0xb8, 0xe2, 0x89, 0xa9, 0xba, 0x16, 0x5b, 0xb2, 0x18, 0xb5, 0x64, 0x11, 0xfd,
-	0xc9, 0xeb, 0x76, 0x52, 0x11, 0x60, 0xd1, 0xc6, 0xf9, 0x6a, 0xc5, 0xb2, 0xc4, 0x6c, 0x66, 0x21,
-	0x66, 0x32, 0xb9, 0x34, 0x9b, 0x0d, 0x92, 0x4b, 0xc4, 0xb2, 0x30, 0x77, 0x3a, 0x90, 0x05, 0x56,
-	0xd3, 0x8a, 0xb3, 0xb2, 0x96, 0x7c, 0xc5, 0xb3, 0xca, 0xec, 0xe2, 0x8a, 0xc8, 0x87, 0xb0, 0x51,
-	0xb1, 0xc5, 0xd7, 0x18, 0x83, 0xb9, 0xdb, 0x8a, 0x2d, 0x9e, 0xf1, 0x86, 0x7c, 0x1b, 0x02, 0xc5,
-	0xa0, 0x4a, 0xa5, 0x2f, 0x78, 0xa2, 0x04, 0xcf, 0x78, 0x13, 0xfd, 0x75, 0x00, 0xe3, 0x33, 0x2e,
-	0xaf, 0xb8, 0x7c, 0xaf, 0x37, 0xdb, 0x9d, 0x94, 0x86, 0xef, 0x98, 0x94, 0x46, 0xeb, 0x27, 0x25,
-	0xbf, 0x9b, 0x94, 0xee, 0x83, 0x7f, 0x26, 0xe3, 0xe3, 0x23, 0x15, 0xd1, 0x90, 0x6a, 0x80, 0xf5,
-	0xb9, 0x1f, 0x57, 0xe2, 0x8a, 0x9b, 0xf1, 0xc9, 0xa0, 0x3b, 0x4f, 0xf9, 0x64, 0xcd, 0xcc, 0xf2,
-	0xbf, 0x4e, 0x51, 0xb6, 0x69, 0xc1, 0x69, 0xda, 0x08, 0x36, 0x71, 0x94, 0x4a, 0x58, 0xc5, 0x9e,
-	0x9e, 0xbd, 0x7c, 0x61, 0xe7, 0x27, 0x57, 0x16, 0xfd, 0xd1, 0x83, 0xf1, 0x09, 0x6b, 0xf2, 0xba,
-	0xba, 0x53, 0xff, 0x33, 0x98, 0xee, 0x17, 0x45, 0x2a, 0xe2, 0x5e, 0xcf, 0x3b, 0x22, 0xb4, 0x78,
-	0xee, 0xdc, 0xa3, 0xce, 0xa1, 0x2b, 0xc2, 0x27, 0xe6, 0x50, 0x8d, 0x45, 0x7a, 0xc6, 0x71, 0x9e,
-	0x18, 0x3d, 0x0d, 0x29, 0x25, 0x26, 0x7b, 0xbf, 0xae, 0xf2, 0x79, 0x9a, 0x5f, 0xab, 0xac, 0x4e,
-	0x68, 0x8b, 0xa3, 0x7f, 0x0d, 0x60, 0xf4, 0x4d, 0x8d, 0x32, 0x9b, 0xe0, 0x09, 0x53, 0x54, 0x9e,
-	0x68, 0x07, 0x9b, 0x0d, 0x67, 0xb0, 0x09, 0x61, 0xa3, 0x91, 0x2c, 0x5b, 0xf0, 0x32, 0x9c, 0x28,
-	0x5e, 0xb3, 0x50, 0x69, 0x54, 0x07, 0xeb, 0x89, 0x26, 0xa0, 0x16, 0xb6, 0x1d, 0x09, 0x4e, 0x47,
-	0xfe, 0xd4, 0x0c, 0x3f, 0xd3, 0xdb, 0xe3, 0xc2, 0xba, 0x99, 0xe7, 0xff, 0xf7, 0x8e, 0xff, 0xc7,
-	0x03, 0xbf, 0x6d, 0xde, 0xc3, 0x7e, 0xf3, 0x1e, 0x76, 0xcd, 0x7b, 0x74, 0x60, 0x9b, 0xf7, 0xe8,
-	0x00, 0x31, 0x3d, 0xb5, 0xcd, 0x4b, 0x4f, 0xf1, 0xb2, 0x9e, 0xc8, 0xbc, 0x2e, 0x0e, 0x1a, 0x7d,
-	0xab, 0x01, 0x6d, 0x31, 0x56, 0xfc, 0x6f, 0x96, 0x5c, 0x9a, 0x54, 0x07, 0xd4, 0x20, 0xec, 0x8f,
-	0x13, 0x45, 0x75, 0x3a, 0xb9, 0x1a, 0x90, 0x1f, 0x80, 0x4f, 0x31, 0x79, 0x2a, 0xc3, 0xbd, 0x7b,
-	0x51, 0x62, 0xaa, 0xb5, 0xe8, 0x54, 0x7f, 0x12, 0x99, 0x46, 0xb1, 0x1f, 0x48, 0x3f, 0x81, 0xf1,
-	0xd9, 0x52, 0xcc, 0x2b, 0x3b, 0x42, 0x7e, 0xcb, 0xa1, 0x4a, 0xb1, 0xe2, 0x4a, 0x47, 0x8d, 0x49,
-	0xf4, 0x0a, 0x82, 0x56, 0xd8, 0x85, 0xe3, 0xb9, 0xe1, 0x10, 0x18, 0xbd, 0xce, 0x44, 0x65, 0x29,
-	0x02, 0xd7, 0x78, 0xd8, 0x57, 0x35, 0xcb, 0x2a, 0x51, 0x35, 0x96, 0x22, 0x2c, 0x8e, 0x1e, 0x9a,
-	0xf0, 0xd1, 0xdd, 0xeb, 0xa2, 0xe0, 0xd2, 0xd0, 0x8d, 0x06, 0x6a, 0x93, 0xfc, 0x9a, 0xeb, 0xb7,
-	0x63, 0x48, 0x35, 0x88, 0x7e, 0x0b, 0xc1, 0x7e, 0xca, 0x65, 0x45, 0xeb, 0x94, 0xaf, 0x7b, 0xd3,
-	0x55, 0xa3, 0x9a, 0x08, 0x70, 0xdd, 0x51, 0xcb, 0xf0, 0x16, 0xb5, 0x3c, 0x63, 0x05, 0x3b, 0x3e,
-	0x52, 0x75, 0x3e, 0xa4, 0x06, 0x45, 0x7f, 0xf6, 0x60, 0x84, 0x1c, 0xe6, 0xb8, 0x1e, 0xbd, 0x8b,
-	0xff, 0x4e, 0x65, 0x7e, 0x25, 0x12, 0x2e, 0xed, 0xe1, 0x2c, 0x56, 0x49, 0x8f, 0x97, 0xbc, 0x1d,
-	0x1d, 0x0c, 0xc2, 0x5a, 0xc3, 0xef, 0x27, 0xdb, 0x4b, 0x4e, 0xad, 0xa1, 0x98, 0x6a, 0x25, 0x8e,
-	0x87, 0x67, 0x75, 0xc1, 0xe5, 0x7e, 0xb2, 0x12, 0x76, 0xae, 0x72, 0x24, 0xd1, 0x57, 0xfa, 0x8b,
-	0xec, 0x0e, 0x13, 0x7a, 0xeb, 0xbf, 0xde, 0x6e, 0x47, 0x1e, 0xfd, 0xc5, 0x83, 0x8d, 0xe7, 0x66,
-	0x8e, 0x73, 0x4f, 0xe1, 0xbd, 0xf5, 0x14, 0x83, 0xde, 0x29, 0xf6, 0xe0, 0xbe, 0xb5, 0xe9, 0xed,
-	0xaf, 0xb3, 0xb0, 0x56, 0x67, 0x32, 0x3a, 0x6a, 0x2f, 0xeb, 0x7d, 0x3e, 0xc8, 0xce, 0xfb, 0x36,
-	0xeb, 0x2e, 0xfc, 0xce, 0xad, 0xcc, 0x60, 0x6a, 0x3f, 0x44, 0xf3, 0xd4, 0x3e, 0x4c, 0xae, 0x28,
-	0xda, 0x83, 0xf1, 0x61, 0x9e, 0xcd, 0xc5, 0x82, 0xec, 0xc2, 0x68, 0xbf, 0xae, 0x96, 0xca, 0xe3,
-	0x74, 0xef, 0xbe, 0xd3, 0xf8, 0x75, 0xb5, 0xd4, 0x36, 0x54, 0x59, 0x44, 0x5f, 0x00, 0x74, 0x32,
-	0x7c, 0x5d, 0xba, 0xdb, 0x78, 0xc1, 0xaf, 0xb1, 0x64, 0x4a, 0x33, 0xc6, 0xaf, 0xd1, 0x44, 0x35,
-	0x10, 0xf7, 0x1c, 0xc6, 0xcb, 0x27, 0xb0, 0xed, 0x4a, 0xdb, 0x93, 0xdd, 0x92, 0x92, 0x5f, 0x42,
-	0x70, 0x92, 0x2f, 0x2e, 0x04, 0xb7, 0xdd, 0x30, 0xdd, 0xfb, 0xc8, 0xf9, 0x18, 0xb3, 0x2a, 0x13,
-	0x6f, 0x67, 0x1b, 0x3d, 0x86, 0x7b, 0xb7, 0xb4, 0xe4, 0x21, 0xf2, 0x16, 0xce, 0xe5, 0x7a, 0xb0,
-	0x7c, 0x9b, 0x27, 0xb4, 0xa0, 0xd6, 0x32, 0x6a, 0x7a, 0x7e, 0x50, 0xd6, 0x66, 0xde, 0xbb, 0xd5,
-	0x0f, 0x79, 0x29, 0xda, 0xd7, 0xce, 0xa7, 0x2d, 0x26, 0xbf, 0x80, 0xe0, 0x51, 0x16, 0xe7, 0x89,
-	0xc8, 0x16, 0x76, 0xe8, 0x0b, 0x7b, 0x5f, 0x9e, 0xf5, 0x2a, 0xb3, 0x06, 0xb4, 0x33, 0x8d, 0x5e,
-	0xc0, 0x76, 0x5f, 0xb9, 0x76, 0xbc, 0x6e, 0x47, 0xf2, 0x81, 0x33, 0x92, 0xb7, 0x31, 0x0e, 0x9d,
-	0xca, 0xff, 0x12, 0x82, 0x83, 0x5a, 0xa4, 0xc9, 0x71, 0x36, 0xcf, 0x91, 0xc4, 0x2f, 0xb8, 0x2c,
-	0xbb, 0xce, 0xb1, 0x10, 0x0b, 0x1f, 0xf9, 0xbc, 0x65, 0x33, 0x83, 0x2e, 0xc7, 0xea, 0x0f, 0xa7,
-	0x87, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x58, 0xd6, 0x93, 0x3e, 0x82, 0x12, 0x00, 0x00,
+func (m *Notification) GetLink() string {
+	if m != nil {
+		return m.Link
+	}
+	return ""
+}
+
+func (m *Notification) GetRead() bool {
+	if m != nil {
+		return m.Read
+	}
+	return false
+}
+
+func (m *Notification) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type Token struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Organization         string   `protobuf:"bytes,3,opt,name=Organization,proto3" json:"Organization,omitempty"`
+	Role                 string   `protobuf:"bytes,4,opt,name=Role,proto3" json:"Role,omitempty"`
+	Scopes               []string `protobuf:"bytes,5,rep,name=Scopes,proto3" json:"Scopes,omitempty"`
+	Hash                 string   `protobuf:"bytes,6,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	CreatedBy            uint64   `protobuf:"varint,7,opt,name=CreatedBy,proto3" json:"CreatedBy,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,8,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	ExpiresAt            int64    `protobuf:"varint,9,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Token) Reset()         { *m = Token{} }
+func (m *Token) String() string { return proto.CompactTextString(m) }
+func (*Token) ProtoMessage()    {}
+func (*Token) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{31}
+}
+func (m *Token) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Token.Unmarshal(m, b)
+}
+func (m *Token) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Token.Marshal(b, m, deterministic)
+}
+func (m *Token) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Token.Merge(m, src)
+}
+func (m *Token) XXX_Size() int {
+	return xxx_messageInfo_Token.Size(m)
+}
+func (m *Token) XXX_DiscardUnknown() {
+	xxx_messageInfo_Token.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Token proto.InternalMessageInfo
+
+func (m *Token) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *Token) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Token) GetOrganization() string {
+	if m != nil {
+		return m.Organization
+	}
+	return ""
+}
+
+func (m *Token) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *Token) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *Token) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *Token) GetCreatedBy() uint64 {
+	if m != nil {
+		return m.CreatedBy
+	}
+	return 0
+}
+
+func (m *Token) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Token) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+type Invitation struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Organization         string   `protobuf:"bytes,2,opt,name=Organization,proto3" json:"Organization,omitempty"`
+	Role                 string   `protobuf:"bytes,3,opt,name=Role,proto3" json:"Role,omitempty"`
+	Name                 string   `protobuf:"bytes,4,opt,name=Name,proto3" json:"Name,omitempty"`
+	Provider             string   `protobuf:"bytes,5,opt,name=Provider,proto3" json:"Provider,omitempty"`
+	Scheme               string   `protobuf:"bytes,6,opt,name=Scheme,proto3" json:"Scheme,omitempty"`
+	InvitedBy            uint64   `protobuf:"varint,7,opt,name=InvitedBy,proto3" json:"InvitedBy,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,8,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Invitation) Reset()         { *m = Invitation{} }
+func (m *Invitation) String() string { return proto.CompactTextString(m) }
+func (*Invitation) ProtoMessage()    {}
+func (*Invitation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{32}
+}
+func (m *Invitation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Invitation.Unmarshal(m, b)
+}
+func (m *Invitation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Invitation.Marshal(b, m, deterministic)
+}
+func (m *Invitation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Invitation.Merge(m, src)
+}
+func (m *Invitation) XXX_Size() int {
+	return xxx_messageInfo_Invitation.Size(m)
+}
+func (m *Invitation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Invitation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Invitation proto.InternalMessageInfo
+
+func (m *Invitation) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *Invitation) GetOrganization() string {
+	if m != nil {
+		return m.Organization
+	}
+	return ""
+}
+
+func (m *Invitation) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *Invitation) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Invitation) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *Invitation) GetScheme() string {
+	if m != nil {
+		return m.Scheme
+	}
+	return ""
+}
+
+func (m *Invitation) GetInvitedBy() uint64 {
+	if m != nil {
+		return m.InvitedBy
+	}
+	return 0
+}
+
+func (m *Invitation) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type Preferences struct {
+	UserID               uint64           `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	DisplayName          string           `protobuf:"bytes,2,opt,name=DisplayName,proto3" json:"DisplayName,omitempty"`
+	Timezone             string           `protobuf:"bytes,3,opt,name=Timezone,proto3" json:"Timezone,omitempty"`
+	DefaultDashboard     int64            `protobuf:"varint,4,opt,name=DefaultDashboard,proto3" json:"DefaultDashboard,omitempty"`
+	Theme                string           `protobuf:"bytes,5,opt,name=Theme,proto3" json:"Theme,omitempty"`
+	StarredDashboards    []int64          `protobuf:"varint,6,rep,packed,name=StarredDashboards,proto3" json:"StarredDashboards,omitempty"`
+	RecentDashboards     []*DashboardView `protobuf:"bytes,7,rep,name=RecentDashboards,proto3" json:"RecentDashboards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *Preferences) Reset()         { *m = Preferences{} }
+func (m *Preferences) String() string { return proto.CompactTextString(m) }
+func (*Preferences) ProtoMessage()    {}
+func (*Preferences) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{33}
+}
+func (m *Preferences) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Preferences.Unmarshal(m, b)
+}
+func (m *Preferences) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Preferences.Marshal(b, m, deterministic)
+}
+func (m *Preferences) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Preferences.Merge(m, src)
+}
+func (m *Preferences) XXX_Size() int {
+	return xxx_messageInfo_Preferences.Size(m)
+}
+func (m *Preferences) XXX_DiscardUnknown() {
+	xxx_messageInfo_Preferences.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Preferences proto.InternalMessageInfo
+
+func (m *Preferences) GetUserID() uint64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *Preferences) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *Preferences) GetTimezone() string {
+	if m != nil {
+		return m.Timezone
+	}
+	return ""
+}
+
+func (m *Preferences) GetDefaultDashboard() int64 {
+	if m != nil {
+		return m.DefaultDashboard
+	}
+	return 0
+}
+
+func (m *Preferences) GetTheme() string {
+	if m != nil {
+		return m.Theme
+	}
+	return ""
+}
+
+func (m *Preferences) GetStarredDashboards() []int64 {
+	if m != nil {
+		return m.StarredDashboards
+	}
+	return nil
+}
+
+func (m *Preferences) GetRecentDashboards() []*DashboardView {
+	if m != nil {
+		return m.RecentDashboards
+	}
+	return nil
+}
+
+type OrganizationQuotas struct {
+	MaxDashboards        int64    `protobuf:"varint,1,opt,name=MaxDashboards,proto3" json:"MaxDashboards,omitempty"`
+	MaxUsers             int64    `protobuf:"varint,2,opt,name=MaxUsers,proto3" json:"MaxUsers,omitempty"`
+	MaxKapacitorRules    int64    `protobuf:"varint,3,opt,name=MaxKapacitorRules,proto3" json:"MaxKapacitorRules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrganizationQuotas) Reset()         { *m = OrganizationQuotas{} }
+func (m *OrganizationQuotas) String() string { return proto.CompactTextString(m) }
+func (*OrganizationQuotas) ProtoMessage()    {}
+func (*OrganizationQuotas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{34}
+}
+func (m *OrganizationQuotas) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrganizationQuotas.Unmarshal(m, b)
+}
+func (m *OrganizationQuotas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrganizationQuotas.Marshal(b, m, deterministic)
+}
+func (m *OrganizationQuotas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrganizationQuotas.Merge(m, src)
+}
+func (m *OrganizationQuotas) XXX_Size() int {
+	return xxx_messageInfo_OrganizationQuotas.Size(m)
+}
+func (m *OrganizationQuotas) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrganizationQuotas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrganizationQuotas proto.InternalMessageInfo
+
+func (m *OrganizationQuotas) GetMaxDashboards() int64 {
+	if m != nil {
+		return m.MaxDashboards
+	}
+	return 0
+}
+
+func (m *OrganizationQuotas) GetMaxUsers() int64 {
+	if m != nil {
+		return m.MaxUsers
+	}
+	return 0
+}
+
+func (m *OrganizationQuotas) GetMaxKapacitorRules() int64 {
+	if m != nil {
+		return m.MaxKapacitorRules
+	}
+	return 0
+}
+
+type DashboardVersion struct {
+	DashboardID          int64    `protobuf:"varint,1,opt,name=DashboardID,proto3" json:"DashboardID,omitempty"`
+	Revision             int64    `protobuf:"varint,2,opt,name=Revision,proto3" json:"Revision,omitempty"`
+	Dashboard            []byte   `protobuf:"bytes,3,opt,name=Dashboard,proto3" json:"Dashboard,omitempty"`
+	Author               string   `protobuf:"bytes,4,opt,name=Author,proto3" json:"Author,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,5,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DashboardVersion) Reset()         { *m = DashboardVersion{} }
+func (m *DashboardVersion) String() string { return proto.CompactTextString(m) }
+func (*DashboardVersion) ProtoMessage()    {}
+func (*DashboardVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{35}
+}
+func (m *DashboardVersion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardVersion.Unmarshal(m, b)
+}
+func (m *DashboardVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardVersion.Marshal(b, m, deterministic)
+}
+func (m *DashboardVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardVersion.Merge(m, src)
+}
+func (m *DashboardVersion) XXX_Size() int {
+	return xxx_messageInfo_DashboardVersion.Size(m)
+}
+func (m *DashboardVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DashboardVersion proto.InternalMessageInfo
+
+func (m *DashboardVersion) GetDashboardID() int64 {
+	if m != nil {
+		return m.DashboardID
+	}
+	return 0
+}
+
+func (m *DashboardVersion) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *DashboardVersion) GetDashboard() []byte {
+	if m != nil {
+		return m.Dashboard
+	}
+	return nil
+}
+
+func (m *DashboardVersion) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
+}
+
+func (m *DashboardVersion) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type Folder struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Parent               string   `protobuf:"bytes,3,opt,name=Parent,proto3" json:"Parent,omitempty"`
+	Organization         string   `protobuf:"bytes,4,opt,name=Organization,proto3" json:"Organization,omitempty"`
+	Role                 string   `protobuf:"bytes,5,opt,name=Role,proto3" json:"Role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Folder) Reset()         { *m = Folder{} }
+func (m *Folder) String() string { return proto.CompactTextString(m) }
+func (*Folder) ProtoMessage()    {}
+func (*Folder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{36}
+}
+func (m *Folder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Folder.Unmarshal(m, b)
+}
+func (m *Folder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Folder.Marshal(b, m, deterministic)
+}
+func (m *Folder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Folder.Merge(m, src)
+}
+func (m *Folder) XXX_Size() int {
+	return xxx_messageInfo_Folder.Size(m)
+}
+func (m *Folder) XXX_DiscardUnknown() {
+	xxx_messageInfo_Folder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Folder proto.InternalMessageInfo
+
+func (m *Folder) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *Folder) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Folder) GetParent() string {
+	if m != nil {
+		return m.Parent
+	}
+	return ""
+}
+
+func (m *Folder) GetOrganization() string {
+	if m != nil {
+		return m.Organization
+	}
+	return ""
+}
+
+func (m *Folder) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+type DashboardSnapshot struct {
+	ID                   int64             `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	DashboardID          int64             `protobuf:"varint,2,opt,name=DashboardID,proto3" json:"DashboardID,omitempty"`
+	Name                 string            `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	Dashboard            []byte            `protobuf:"bytes,4,opt,name=Dashboard,proto3" json:"Dashboard,omitempty"`
+	Lower                string            `protobuf:"bytes,5,opt,name=Lower,proto3" json:"Lower,omitempty"`
+	Upper                string            `protobuf:"bytes,6,opt,name=Upper,proto3" json:"Upper,omitempty"`
+	Results              []*SnapshotResult `protobuf:"bytes,7,rep,name=Results,proto3" json:"Results,omitempty"`
+	Author               string            `protobuf:"bytes,8,opt,name=Author,proto3" json:"Author,omitempty"`
+	CreatedAt            int64             `protobuf:"varint,9,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DashboardSnapshot) Reset()         { *m = DashboardSnapshot{} }
+func (m *DashboardSnapshot) String() string { return proto.CompactTextString(m) }
+func (*DashboardSnapshot) ProtoMessage()    {}
+func (*DashboardSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{37}
+}
+func (m *DashboardSnapshot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardSnapshot.Unmarshal(m, b)
+}
+func (m *DashboardSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardSnapshot.Marshal(b, m, deterministic)
+}
+func (m *DashboardSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardSnapshot.Merge(m, src)
+}
+func (m *DashboardSnapshot) XXX_Size() int {
+	return xxx_messageInfo_DashboardSnapshot.Size(m)
+}
+func (m *DashboardSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DashboardSnapshot proto.InternalMessageInfo
+
+func (m *DashboardSnapshot) GetID() int64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *DashboardSnapshot) GetDashboardID() int64 {
+	if m != nil {
+		return m.DashboardID
+	}
+	return 0
+}
+
+func (m *DashboardSnapshot) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DashboardSnapshot) GetDashboard() []byte {
+	if m != nil {
+		return m.Dashboard
+	}
+	return nil
+}
+
+func (m *DashboardSnapshot) GetLower() string {
+	if m != nil {
+		return m.Lower
+	}
+	return ""
+}
+
+func (m *DashboardSnapshot) GetUpper() string {
+	if m != nil {
+		return m.Upper
+	}
+	return ""
+}
+
+func (m *DashboardSnapshot) GetResults() []*SnapshotResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *DashboardSnapshot) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
+}
+
+func (m *DashboardSnapshot) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type SnapshotResult struct {
+	CellID               string   `protobuf:"bytes,1,opt,name=CellID,proto3" json:"CellID,omitempty"`
+	Query                string   `protobuf:"bytes,2,opt,name=Query,proto3" json:"Query,omitempty"`
+	Source               string   `protobuf:"bytes,3,opt,name=Source,proto3" json:"Source,omitempty"`
+	Result               []byte   `protobuf:"bytes,4,opt,name=Result,proto3" json:"Result,omitempty"`
+	Error                string   `protobuf:"bytes,5,opt,name=Error,proto3" json:"Error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SnapshotResult) Reset()         { *m = SnapshotResult{} }
+func (m *SnapshotResult) String() string { return proto.CompactTextString(m) }
+func (*SnapshotResult) ProtoMessage()    {}
+func (*SnapshotResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{38}
+}
+func (m *SnapshotResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SnapshotResult.Unmarshal(m, b)
+}
+func (m *SnapshotResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SnapshotResult.Marshal(b, m, deterministic)
+}
+func (m *SnapshotResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotResult.Merge(m, src)
+}
+func (m *SnapshotResult) XXX_Size() int {
+	return xxx_messageInfo_SnapshotResult.Size(m)
+}
+func (m *SnapshotResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotResult proto.InternalMessageInfo
+
+func (m *SnapshotResult) GetCellID() string {
+	if m != nil {
+		return m.CellID
+	}
+	return ""
+}
+
+func (m *SnapshotResult) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *SnapshotResult) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *SnapshotResult) GetResult() []byte {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *SnapshotResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type Report struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	DashboardID          int64    `protobuf:"varint,3,opt,name=DashboardID,proto3" json:"DashboardID,omitempty"`
+	Source               string   `protobuf:"bytes,4,opt,name=Source,proto3" json:"Source,omitempty"`
+	Start                int64    `protobuf:"varint,5,opt,name=Start,proto3" json:"Start,omitempty"`
+	Every                int64    `protobuf:"varint,6,opt,name=Every,proto3" json:"Every,omitempty"`
+	Range                int64    `protobuf:"varint,7,opt,name=Range,proto3" json:"Range,omitempty"`
+	Recipients           []string `protobuf:"bytes,8,rep,name=Recipients,proto3" json:"Recipients,omitempty"`
+	Format               string   `protobuf:"bytes,9,opt,name=Format,proto3" json:"Format,omitempty"`
+	Organization         string   `protobuf:"bytes,10,opt,name=Organization,proto3" json:"Organization,omitempty"`
+	NextRun              int64    `protobuf:"varint,11,opt,name=NextRun,proto3" json:"NextRun,omitempty"`
+	LastRun              int64    `protobuf:"varint,12,opt,name=LastRun,proto3" json:"LastRun,omitempty"`
+	LastError            string   `protobuf:"bytes,13,opt,name=LastError,proto3" json:"LastError,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Report) Reset()         { *m = Report{} }
+func (m *Report) String() string { return proto.CompactTextString(m) }
+func (*Report) ProtoMessage()    {}
+func (*Report) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{39}
+}
+func (m *Report) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Report.Unmarshal(m, b)
+}
+func (m *Report) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Report.Marshal(b, m, deterministic)
+}
+func (m *Report) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Report.Merge(m, src)
+}
+func (m *Report) XXX_Size() int {
+	return xxx_messageInfo_Report.Size(m)
+}
+func (m *Report) XXX_DiscardUnknown() {
+	xxx_messageInfo_Report.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Report proto.InternalMessageInfo
+
+func (m *Report) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *Report) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Report) GetDashboardID() int64 {
+	if m != nil {
+		return m.DashboardID
+	}
+	return 0
+}
+
+func (m *Report) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *Report) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *Report) GetEvery() int64 {
+	if m != nil {
+		return m.Every
+	}
+	return 0
+}
+
+func (m *Report) GetRange() int64 {
+	if m != nil {
+		return m.Range
+	}
+	return 0
+}
+
+func (m *Report) GetRecipients() []string {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+func (m *Report) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *Report) GetOrganization() string {
+	if m != nil {
+		return m.Organization
+	}
+	return ""
+}
+
+func (m *Report) GetNextRun() int64 {
+	if m != nil {
+		return m.NextRun
+	}
+	return 0
+}
+
+func (m *Report) GetLastRun() int64 {
+	if m != nil {
+		return m.LastRun
+	}
+	return 0
+}
+
+func (m *Report) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+type DashboardView struct {
+	DashboardID          int64    `protobuf:"varint,1,opt,name=DashboardID,proto3" json:"DashboardID,omitempty"`
+	ViewedAt             int64    `protobuf:"varint,2,opt,name=ViewedAt,proto3" json:"ViewedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DashboardView) Reset()         { *m = DashboardView{} }
+func (m *DashboardView) String() string { return proto.CompactTextString(m) }
+func (*DashboardView) ProtoMessage()    {}
+func (*DashboardView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{40}
+}
+func (m *DashboardView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardView.Unmarshal(m, b)
+}
+func (m *DashboardView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardView.Marshal(b, m, deterministic)
+}
+func (m *DashboardView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardView.Merge(m, src)
+}
+func (m *DashboardView) XXX_Size() int {
+	return xxx_messageInfo_DashboardView.Size(m)
+}
+func (m *DashboardView) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DashboardView proto.InternalMessageInfo
+
+func (m *DashboardView) GetDashboardID() int64 {
+	if m != nil {
+		return m.DashboardID
+	}
+	return 0
+}
+
+func (m *DashboardView) GetViewedAt() int64 {
+	if m != nil {
+		return m.ViewedAt
+	}
+	return 0
+}
+
+type Annotation struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	StartTime            int64    `protobuf:"varint,2,opt,name=StartTime,proto3" json:"StartTime,omitempty"`
+	EndTime              int64    `protobuf:"varint,3,opt,name=EndTime,proto3" json:"EndTime,omitempty"`
+	Text                 string   `protobuf:"bytes,4,opt,name=Text,proto3" json:"Text,omitempty"`
+	Type                 string   `protobuf:"bytes,5,opt,name=Type,proto3" json:"Type,omitempty"`
+	Stream               string   `protobuf:"bytes,6,opt,name=Stream,proto3" json:"Stream,omitempty"`
+	Tags                 []string `protobuf:"bytes,7,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	Organization         string   `protobuf:"bytes,8,opt,name=Organization,proto3" json:"Organization,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Annotation) Reset()         { *m = Annotation{} }
+func (m *Annotation) String() string { return proto.CompactTextString(m) }
+func (*Annotation) ProtoMessage()    {}
+func (*Annotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{41}
+}
+func (m *Annotation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Annotation.Unmarshal(m, b)
+}
+func (m *Annotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Annotation.Marshal(b, m, deterministic)
+}
+func (m *Annotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Annotation.Merge(m, src)
+}
+func (m *Annotation) XXX_Size() int {
+	return xxx_messageInfo_Annotation.Size(m)
+}
+func (m *Annotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Annotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Annotation proto.InternalMessageInfo
+
+func (m *Annotation) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *Annotation) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *Annotation) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *Annotation) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *Annotation) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Annotation) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *Annotation) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Annotation) GetOrganization() string {
+	if m != nil {
+		return m.Organization
+	}
+	return ""
+}
+
+type SourceHealth struct {
+	SourceID             int64    `protobuf:"varint,1,opt,name=SourceID,proto3" json:"SourceID,omitempty"`
+	ServerID             int64    `protobuf:"varint,2,opt,name=ServerID,proto3" json:"ServerID,omitempty"`
+	Status               string   `protobuf:"bytes,3,opt,name=Status,proto3" json:"Status,omitempty"`
+	Version              string   `protobuf:"bytes,4,opt,name=Version,proto3" json:"Version,omitempty"`
+	Build                string   `protobuf:"bytes,5,opt,name=Build,proto3" json:"Build,omitempty"`
+	Latency              int64    `protobuf:"varint,6,opt,name=Latency,proto3" json:"Latency,omitempty"`
+	Error                string   `protobuf:"bytes,7,opt,name=Error,proto3" json:"Error,omitempty"`
+	CheckedAt            int64    `protobuf:"varint,8,opt,name=CheckedAt,proto3" json:"CheckedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SourceHealth) Reset()         { *m = SourceHealth{} }
+func (m *SourceHealth) String() string { return proto.CompactTextString(m) }
+func (*SourceHealth) ProtoMessage()    {}
+func (*SourceHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{42}
+}
+func (m *SourceHealth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SourceHealth.Unmarshal(m, b)
+}
+func (m *SourceHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SourceHealth.Marshal(b, m, deterministic)
+}
+func (m *SourceHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceHealth.Merge(m, src)
+}
+func (m *SourceHealth) XXX_Size() int {
+	return xxx_messageInfo_SourceHealth.Size(m)
+}
+func (m *SourceHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SourceHealth proto.InternalMessageInfo
+
+func (m *SourceHealth) GetSourceID() int64 {
+	if m != nil {
+		return m.SourceID
+	}
+	return 0
+}
+
+func (m *SourceHealth) GetServerID() int64 {
+	if m != nil {
+		return m.ServerID
+	}
+	return 0
+}
+
+func (m *SourceHealth) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *SourceHealth) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *SourceHealth) GetBuild() string {
+	if m != nil {
+		return m.Build
+	}
+	return ""
+}
+
+func (m *SourceHealth) GetLatency() int64 {
+	if m != nil {
+		return m.Latency
+	}
+	return 0
+}
+
+func (m *SourceHealth) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *SourceHealth) GetCheckedAt() int64 {
+	if m != nil {
+		return m.CheckedAt
+	}
+	return 0
+}
+
+type QueryHistoryEntry struct {
+	UserID               uint64   `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	SourceID             int64    `protobuf:"varint,2,opt,name=SourceID,proto3" json:"SourceID,omitempty"`
+	Organization         string   `protobuf:"bytes,3,opt,name=Organization,proto3" json:"Organization,omitempty"`
+	Query                string   `protobuf:"bytes,4,opt,name=Query,proto3" json:"Query,omitempty"`
+	DB                   string   `protobuf:"bytes,5,opt,name=DB,proto3" json:"DB,omitempty"`
+	RP                   string   `protobuf:"bytes,6,opt,name=RP,proto3" json:"RP,omitempty"`
+	Duration             int64    `protobuf:"varint,7,opt,name=Duration,proto3" json:"Duration,omitempty"`
+	Status               string   `protobuf:"bytes,8,opt,name=Status,proto3" json:"Status,omitempty"`
+	Error                string   `protobuf:"bytes,9,opt,name=Error,proto3" json:"Error,omitempty"`
+	RanAt                int64    `protobuf:"varint,10,opt,name=RanAt,proto3" json:"RanAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryHistoryEntry) Reset()         { *m = QueryHistoryEntry{} }
+func (m *QueryHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoryEntry) ProtoMessage()    {}
+func (*QueryHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{43}
+}
+func (m *QueryHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryHistoryEntry.Unmarshal(m, b)
+}
+func (m *QueryHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryHistoryEntry.Marshal(b, m, deterministic)
+}
+func (m *QueryHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoryEntry.Merge(m, src)
+}
+func (m *QueryHistoryEntry) XXX_Size() int {
+	return xxx_messageInfo_QueryHistoryEntry.Size(m)
+}
+func (m *QueryHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHistoryEntry proto.InternalMessageInfo
+
+func (m *QueryHistoryEntry) GetUserID() uint64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *QueryHistoryEntry) GetSourceID() int64 {
+	if m != nil {
+		return m.SourceID
+	}
+	return 0
+}
+
+func (m *QueryHistoryEntry) GetOrganization() string {
+	if m != nil {
+		return m.Organization
+	}
+	return ""
+}
+
+func (m *QueryHistoryEntry) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *QueryHistoryEntry) GetDB() string {
+	if m != nil {
+		return m.DB
+	}
+	return ""
+}
+
+func (m *QueryHistoryEntry) GetRP() string {
+	if m != nil {
+		return m.RP
+	}
+	return ""
+}
+
+func (m *QueryHistoryEntry) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *QueryHistoryEntry) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *QueryHistoryEntry) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *QueryHistoryEntry) GetRanAt() int64 {
+	if m != nil {
+		return m.RanAt
+	}
+	return 0
+}
+
+type AlertEvent struct {
+	ID                   uint64               `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	SourceID             int64                `protobuf:"varint,2,opt,name=SourceID,proto3" json:"SourceID,omitempty"`
+	AlertID              string               `protobuf:"bytes,3,opt,name=AlertID,proto3" json:"AlertID,omitempty"`
+	Message              string               `protobuf:"bytes,4,opt,name=Message,proto3" json:"Message,omitempty"`
+	Details              string               `protobuf:"bytes,5,opt,name=Details,proto3" json:"Details,omitempty"`
+	Level                string               `protobuf:"bytes,6,opt,name=Level,proto3" json:"Level,omitempty"`
+	PreviousLevel        string               `protobuf:"bytes,7,opt,name=PreviousLevel,proto3" json:"PreviousLevel,omitempty"`
+	Duration             int64                `protobuf:"varint,8,opt,name=Duration,proto3" json:"Duration,omitempty"`
+	Time                 int64                `protobuf:"varint,9,opt,name=Time,proto3" json:"Time,omitempty"`
+	Acknowledged         bool                 `protobuf:"varint,10,opt,name=Acknowledged,proto3" json:"Acknowledged,omitempty"`
+	AcknowledgedBy       string               `protobuf:"bytes,11,opt,name=AcknowledgedBy,proto3" json:"AcknowledgedBy,omitempty"`
+	AcknowledgedAt       int64                `protobuf:"varint,12,opt,name=AcknowledgedAt,proto3" json:"AcknowledgedAt,omitempty"`
+	Comments             []*AlertEventComment `protobuf:"bytes,13,rep,name=Comments,proto3" json:"Comments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *AlertEvent) Reset()         { *m = AlertEvent{} }
+func (m *AlertEvent) String() string { return proto.CompactTextString(m) }
+func (*AlertEvent) ProtoMessage()    {}
+func (*AlertEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{44}
+}
+func (m *AlertEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlertEvent.Unmarshal(m, b)
+}
+func (m *AlertEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlertEvent.Marshal(b, m, deterministic)
+}
+func (m *AlertEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertEvent.Merge(m, src)
+}
+func (m *AlertEvent) XXX_Size() int {
+	return xxx_messageInfo_AlertEvent.Size(m)
+}
+func (m *AlertEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertEvent proto.InternalMessageInfo
+
+func (m *AlertEvent) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *AlertEvent) GetSourceID() int64 {
+	if m != nil {
+		return m.SourceID
+	}
+	return 0
+}
+
+func (m *AlertEvent) GetAlertID() string {
+	if m != nil {
+		return m.AlertID
+	}
+	return ""
+}
+
+func (m *AlertEvent) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *AlertEvent) GetDetails() string {
+	if m != nil {
+		return m.Details
+	}
+	return ""
+}
+
+func (m *AlertEvent) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+func (m *AlertEvent) GetPreviousLevel() string {
+	if m != nil {
+		return m.PreviousLevel
+	}
+	return ""
+}
+
+func (m *AlertEvent) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *AlertEvent) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *AlertEvent) GetAcknowledged() bool {
+	if m != nil {
+		return m.Acknowledged
+	}
+	return false
+}
+
+func (m *AlertEvent) GetAcknowledgedBy() string {
+	if m != nil {
+		return m.AcknowledgedBy
+	}
+	return ""
+}
+
+func (m *AlertEvent) GetAcknowledgedAt() int64 {
+	if m != nil {
+		return m.AcknowledgedAt
+	}
+	return 0
+}
+
+func (m *AlertEvent) GetComments() []*AlertEventComment {
+	if m != nil {
+		return m.Comments
+	}
+	return nil
+}
+
+type AlertEventComment struct {
+	UserID               uint64   `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Author               string   `protobuf:"bytes,2,opt,name=Author,proto3" json:"Author,omitempty"`
+	Text                 string   `protobuf:"bytes,3,opt,name=Text,proto3" json:"Text,omitempty"`
+	Created              int64    `protobuf:"varint,4,opt,name=Created,proto3" json:"Created,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlertEventComment) Reset()         { *m = AlertEventComment{} }
+func (m *AlertEventComment) String() string { return proto.CompactTextString(m) }
+func (*AlertEventComment) ProtoMessage()    {}
+func (*AlertEventComment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{45}
+}
+func (m *AlertEventComment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlertEventComment.Unmarshal(m, b)
+}
+func (m *AlertEventComment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlertEventComment.Marshal(b, m, deterministic)
+}
+func (m *AlertEventComment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertEventComment.Merge(m, src)
+}
+func (m *AlertEventComment) XXX_Size() int {
+	return xxx_messageInfo_AlertEventComment.Size(m)
+}
+func (m *AlertEventComment) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertEventComment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertEventComment proto.InternalMessageInfo
+
+func (m *AlertEventComment) GetUserID() uint64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *AlertEventComment) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
+}
+
+func (m *AlertEventComment) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *AlertEventComment) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+type AlertWebhook struct {
+	ID                   uint64                    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	SourceID             int64                     `protobuf:"varint,2,opt,name=SourceID,proto3" json:"SourceID,omitempty"`
+	Name                 string                    `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	URL                  string                    `protobuf:"bytes,4,opt,name=URL,proto3" json:"URL,omitempty"`
+	Rules                []string                  `protobuf:"bytes,5,rep,name=Rules,proto3" json:"Rules,omitempty"`
+	Headers              []*AlertWebhookHeader     `protobuf:"bytes,6,rep,name=Headers,proto3" json:"Headers,omitempty"`
+	Template             string                    `protobuf:"bytes,7,opt,name=Template,proto3" json:"Template,omitempty"`
+	Secret               string                    `protobuf:"bytes,8,opt,name=Secret,proto3" json:"Secret,omitempty"`
+	Type                 string                    `protobuf:"bytes,9,opt,name=Type,proto3" json:"Type,omitempty"`
+	RoutingKey           string                    `protobuf:"bytes,10,opt,name=RoutingKey,proto3" json:"RoutingKey,omitempty"`
+	RoutingKeys          []*AlertWebhookRoutingKey `protobuf:"bytes,11,rep,name=RoutingKeys,proto3" json:"RoutingKeys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *AlertWebhook) Reset()         { *m = AlertWebhook{} }
+func (m *AlertWebhook) String() string { return proto.CompactTextString(m) }
+func (*AlertWebhook) ProtoMessage()    {}
+func (*AlertWebhook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{46}
+}
+func (m *AlertWebhook) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlertWebhook.Unmarshal(m, b)
+}
+func (m *AlertWebhook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlertWebhook.Marshal(b, m, deterministic)
+}
+func (m *AlertWebhook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertWebhook.Merge(m, src)
+}
+func (m *AlertWebhook) XXX_Size() int {
+	return xxx_messageInfo_AlertWebhook.Size(m)
+}
+func (m *AlertWebhook) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertWebhook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertWebhook proto.InternalMessageInfo
+
+func (m *AlertWebhook) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *AlertWebhook) GetSourceID() int64 {
+	if m != nil {
+		return m.SourceID
+	}
+	return 0
+}
+
+func (m *AlertWebhook) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AlertWebhook) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *AlertWebhook) GetRules() []string {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+func (m *AlertWebhook) GetHeaders() []*AlertWebhookHeader {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *AlertWebhook) GetTemplate() string {
+	if m != nil {
+		return m.Template
+	}
+	return ""
+}
+
+func (m *AlertWebhook) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *AlertWebhook) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *AlertWebhook) GetRoutingKey() string {
+	if m != nil {
+		return m.RoutingKey
+	}
+	return ""
+}
+
+func (m *AlertWebhook) GetRoutingKeys() []*AlertWebhookRoutingKey {
+	if m != nil {
+		return m.RoutingKeys
+	}
+	return nil
+}
+
+type AlertWebhookHeader struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlertWebhookHeader) Reset()         { *m = AlertWebhookHeader{} }
+func (m *AlertWebhookHeader) String() string { return proto.CompactTextString(m) }
+func (*AlertWebhookHeader) ProtoMessage()    {}
+func (*AlertWebhookHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{47}
+}
+func (m *AlertWebhookHeader) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlertWebhookHeader.Unmarshal(m, b)
+}
+func (m *AlertWebhookHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlertWebhookHeader.Marshal(b, m, deterministic)
+}
+func (m *AlertWebhookHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertWebhookHeader.Merge(m, src)
+}
+func (m *AlertWebhookHeader) XXX_Size() int {
+	return xxx_messageInfo_AlertWebhookHeader.Size(m)
+}
+func (m *AlertWebhookHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertWebhookHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertWebhookHeader proto.InternalMessageInfo
+
+func (m *AlertWebhookHeader) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AlertWebhookHeader) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type AlertCheck struct {
+	ID                   uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	SourceID             int64                  `protobuf:"varint,2,opt,name=SourceID,proto3" json:"SourceID,omitempty"`
+	Name                 string                 `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	Query                string                 `protobuf:"bytes,4,opt,name=Query,proto3" json:"Query,omitempty"`
+	DB                   string                 `protobuf:"bytes,5,opt,name=DB,proto3" json:"DB,omitempty"`
+	RP                   string                 `protobuf:"bytes,6,opt,name=RP,proto3" json:"RP,omitempty"`
+	Every                int64                  `protobuf:"varint,7,opt,name=Every,proto3" json:"Every,omitempty"`
+	Operator             string                 `protobuf:"bytes,8,opt,name=Operator,proto3" json:"Operator,omitempty"`
+	Thresholds           []*AlertCheckThreshold `protobuf:"bytes,9,rep,name=Thresholds,proto3" json:"Thresholds,omitempty"`
+	Message              string                 `protobuf:"bytes,10,opt,name=Message,proto3" json:"Message,omitempty"`
+	Enabled              bool                   `protobuf:"varint,11,opt,name=Enabled,proto3" json:"Enabled,omitempty"`
+	States               []*AlertCheckState     `protobuf:"bytes,12,rep,name=States,proto3" json:"States,omitempty"`
+	NextRun              int64                  `protobuf:"varint,13,opt,name=NextRun,proto3" json:"NextRun,omitempty"`
+	LastRun              int64                  `protobuf:"varint,14,opt,name=LastRun,proto3" json:"LastRun,omitempty"`
+	LastError            string                 `protobuf:"bytes,15,opt,name=LastError,proto3" json:"LastError,omitempty"`
+	Template             string                 `protobuf:"bytes,16,opt,name=Template,proto3" json:"Template,omitempty"`
+	Params               []*AlertCheckParam     `protobuf:"bytes,17,rep,name=Params,proto3" json:"Params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *AlertCheck) Reset()         { *m = AlertCheck{} }
+func (m *AlertCheck) String() string { return proto.CompactTextString(m) }
+func (*AlertCheck) ProtoMessage()    {}
+func (*AlertCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{48}
+}
+func (m *AlertCheck) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlertCheck.Unmarshal(m, b)
+}
+func (m *AlertCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlertCheck.Marshal(b, m, deterministic)
+}
+func (m *AlertCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertCheck.Merge(m, src)
+}
+func (m *AlertCheck) XXX_Size() int {
+	return xxx_messageInfo_AlertCheck.Size(m)
+}
+func (m *AlertCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertCheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertCheck proto.InternalMessageInfo
+
+func (m *AlertCheck) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *AlertCheck) GetSourceID() int64 {
+	if m != nil {
+		return m.SourceID
+	}
+	return 0
+}
+
+func (m *AlertCheck) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AlertCheck) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *AlertCheck) GetDB() string {
+	if m != nil {
+		return m.DB
+	}
+	return ""
+}
+
+func (m *AlertCheck) GetRP() string {
+	if m != nil {
+		return m.RP
+	}
+	return ""
+}
+
+func (m *AlertCheck) GetEvery() int64 {
+	if m != nil {
+		return m.Every
+	}
+	return 0
+}
+
+func (m *AlertCheck) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *AlertCheck) GetThresholds() []*AlertCheckThreshold {
+	if m != nil {
+		return m.Thresholds
+	}
+	return nil
+}
+
+func (m *AlertCheck) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *AlertCheck) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *AlertCheck) GetStates() []*AlertCheckState {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
+func (m *AlertCheck) GetNextRun() int64 {
+	if m != nil {
+		return m.NextRun
+	}
+	return 0
+}
+
+func (m *AlertCheck) GetLastRun() int64 {
+	if m != nil {
+		return m.LastRun
+	}
+	return 0
+}
+
+func (m *AlertCheck) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *AlertCheck) GetTemplate() string {
+	if m != nil {
+		return m.Template
+	}
+	return ""
+}
+
+func (m *AlertCheck) GetParams() []*AlertCheckParam {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+type AlertCheckThreshold struct {
+	Level                string   `protobuf:"bytes,1,opt,name=Level,proto3" json:"Level,omitempty"`
+	Value                float64  `protobuf:"fixed64,2,opt,name=Value,proto3" json:"Value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlertCheckThreshold) Reset()         { *m = AlertCheckThreshold{} }
+func (m *AlertCheckThreshold) String() string { return proto.CompactTextString(m) }
+func (*AlertCheckThreshold) ProtoMessage()    {}
+func (*AlertCheckThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{49}
+}
+func (m *AlertCheckThreshold) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlertCheckThreshold.Unmarshal(m, b)
+}
+func (m *AlertCheckThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlertCheckThreshold.Marshal(b, m, deterministic)
+}
+func (m *AlertCheckThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertCheckThreshold.Merge(m, src)
+}
+func (m *AlertCheckThreshold) XXX_Size() int {
+	return xxx_messageInfo_AlertCheckThreshold.Size(m)
+}
+func (m *AlertCheckThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertCheckThreshold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertCheckThreshold proto.InternalMessageInfo
+
+func (m *AlertCheckThreshold) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+func (m *AlertCheckThreshold) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+type AlertCheckState struct {
+	AlertID              string   `protobuf:"bytes,1,opt,name=AlertID,proto3" json:"AlertID,omitempty"`
+	Level                string   `protobuf:"bytes,2,opt,name=Level,proto3" json:"Level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlertCheckState) Reset()         { *m = AlertCheckState{} }
+func (m *AlertCheckState) String() string { return proto.CompactTextString(m) }
+func (*AlertCheckState) ProtoMessage()    {}
+func (*AlertCheckState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{50}
+}
+func (m *AlertCheckState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlertCheckState.Unmarshal(m, b)
+}
+func (m *AlertCheckState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlertCheckState.Marshal(b, m, deterministic)
+}
+func (m *AlertCheckState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertCheckState.Merge(m, src)
+}
+func (m *AlertCheckState) XXX_Size() int {
+	return xxx_messageInfo_AlertCheckState.Size(m)
+}
+func (m *AlertCheckState) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertCheckState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertCheckState proto.InternalMessageInfo
+
+func (m *AlertCheckState) GetAlertID() string {
+	if m != nil {
+		return m.AlertID
+	}
+	return ""
+}
+
+func (m *AlertCheckState) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+type AlertPolicy struct {
+	ID                   uint64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	SourceID             int64    `protobuf:"varint,2,opt,name=SourceID,proto3" json:"SourceID,omitempty"`
+	Rule                 string   `protobuf:"bytes,3,opt,name=Rule,proto3" json:"Rule,omitempty"`
+	Throttle             int64    `protobuf:"varint,4,opt,name=Throttle,proto3" json:"Throttle,omitempty"`
+	FlapWindow           int64    `protobuf:"varint,5,opt,name=FlapWindow,proto3" json:"FlapWindow,omitempty"`
+	FlapChanges          int64    `protobuf:"varint,6,opt,name=FlapChanges,proto3" json:"FlapChanges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlertPolicy) Reset()         { *m = AlertPolicy{} }
+func (m *AlertPolicy) String() string { return proto.CompactTextString(m) }
+func (*AlertPolicy) ProtoMessage()    {}
+func (*AlertPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{51}
+}
+func (m *AlertPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlertPolicy.Unmarshal(m, b)
+}
+func (m *AlertPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlertPolicy.Marshal(b, m, deterministic)
+}
+func (m *AlertPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertPolicy.Merge(m, src)
+}
+func (m *AlertPolicy) XXX_Size() int {
+	return xxx_messageInfo_AlertPolicy.Size(m)
+}
+func (m *AlertPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertPolicy proto.InternalMessageInfo
+
+func (m *AlertPolicy) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *AlertPolicy) GetSourceID() int64 {
+	if m != nil {
+		return m.SourceID
+	}
+	return 0
+}
+
+func (m *AlertPolicy) GetRule() string {
+	if m != nil {
+		return m.Rule
+	}
+	return ""
+}
+
+func (m *AlertPolicy) GetThrottle() int64 {
+	if m != nil {
+		return m.Throttle
+	}
+	return 0
+}
+
+func (m *AlertPolicy) GetFlapWindow() int64 {
+	if m != nil {
+		return m.FlapWindow
+	}
+	return 0
+}
+
+func (m *AlertPolicy) GetFlapChanges() int64 {
+	if m != nil {
+		return m.FlapChanges
+	}
+	return 0
+}
+
+type AlertCheckParam struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlertCheckParam) Reset()         { *m = AlertCheckParam{} }
+func (m *AlertCheckParam) String() string { return proto.CompactTextString(m) }
+func (*AlertCheckParam) ProtoMessage()    {}
+func (*AlertCheckParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{52}
+}
+func (m *AlertCheckParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlertCheckParam.Unmarshal(m, b)
+}
+func (m *AlertCheckParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlertCheckParam.Marshal(b, m, deterministic)
+}
+func (m *AlertCheckParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertCheckParam.Merge(m, src)
+}
+func (m *AlertCheckParam) XXX_Size() int {
+	return xxx_messageInfo_AlertCheckParam.Size(m)
+}
+func (m *AlertCheckParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertCheckParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertCheckParam proto.InternalMessageInfo
+
+func (m *AlertCheckParam) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AlertCheckParam) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type RuleTemplate struct {
+	ID                   string                   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name                 string                   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description          string                   `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
+	Params               []*RuleTemplateParam     `protobuf:"bytes,4,rep,name=Params,proto3" json:"Params,omitempty"`
+	CheckName            string                   `protobuf:"bytes,5,opt,name=CheckName,proto3" json:"CheckName,omitempty"`
+	Query                string                   `protobuf:"bytes,6,opt,name=Query,proto3" json:"Query,omitempty"`
+	DB                   string                   `protobuf:"bytes,7,opt,name=DB,proto3" json:"DB,omitempty"`
+	RP                   string                   `protobuf:"bytes,8,opt,name=RP,proto3" json:"RP,omitempty"`
+	Every                int64                    `protobuf:"varint,9,opt,name=Every,proto3" json:"Every,omitempty"`
+	Operator             string                   `protobuf:"bytes,10,opt,name=Operator,proto3" json:"Operator,omitempty"`
+	Thresholds           []*RuleTemplateThreshold `protobuf:"bytes,11,rep,name=Thresholds,proto3" json:"Thresholds,omitempty"`
+	Message              string                   `protobuf:"bytes,12,opt,name=Message,proto3" json:"Message,omitempty"`
+	Organization         string                   `protobuf:"bytes,13,opt,name=Organization,proto3" json:"Organization,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *RuleTemplate) Reset()         { *m = RuleTemplate{} }
+func (m *RuleTemplate) String() string { return proto.CompactTextString(m) }
+func (*RuleTemplate) ProtoMessage()    {}
+func (*RuleTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{53}
+}
+func (m *RuleTemplate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RuleTemplate.Unmarshal(m, b)
+}
+func (m *RuleTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RuleTemplate.Marshal(b, m, deterministic)
+}
+func (m *RuleTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuleTemplate.Merge(m, src)
+}
+func (m *RuleTemplate) XXX_Size() int {
+	return xxx_messageInfo_RuleTemplate.Size(m)
+}
+func (m *RuleTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuleTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuleTemplate proto.InternalMessageInfo
+
+func (m *RuleTemplate) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *RuleTemplate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RuleTemplate) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *RuleTemplate) GetParams() []*RuleTemplateParam {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+func (m *RuleTemplate) GetCheckName() string {
+	if m != nil {
+		return m.CheckName
+	}
+	return ""
+}
+
+func (m *RuleTemplate) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *RuleTemplate) GetDB() string {
+	if m != nil {
+		return m.DB
+	}
+	return ""
+}
+
+func (m *RuleTemplate) GetRP() string {
+	if m != nil {
+		return m.RP
+	}
+	return ""
+}
+
+func (m *RuleTemplate) GetEvery() int64 {
+	if m != nil {
+		return m.Every
+	}
+	return 0
+}
+
+func (m *RuleTemplate) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *RuleTemplate) GetThresholds() []*RuleTemplateThreshold {
+	if m != nil {
+		return m.Thresholds
+	}
+	return nil
+}
+
+func (m *RuleTemplate) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *RuleTemplate) GetOrganization() string {
+	if m != nil {
+		return m.Organization
+	}
+	return ""
+}
+
+type RuleTemplateParam struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
+	Default              string   `protobuf:"bytes,3,opt,name=Default,proto3" json:"Default,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RuleTemplateParam) Reset()         { *m = RuleTemplateParam{} }
+func (m *RuleTemplateParam) String() string { return proto.CompactTextString(m) }
+func (*RuleTemplateParam) ProtoMessage()    {}
+func (*RuleTemplateParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{54}
+}
+func (m *RuleTemplateParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RuleTemplateParam.Unmarshal(m, b)
+}
+func (m *RuleTemplateParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RuleTemplateParam.Marshal(b, m, deterministic)
+}
+func (m *RuleTemplateParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuleTemplateParam.Merge(m, src)
+}
+func (m *RuleTemplateParam) XXX_Size() int {
+	return xxx_messageInfo_RuleTemplateParam.Size(m)
+}
+func (m *RuleTemplateParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuleTemplateParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuleTemplateParam proto.InternalMessageInfo
+
+func (m *RuleTemplateParam) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RuleTemplateParam) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *RuleTemplateParam) GetDefault() string {
+	if m != nil {
+		return m.Default
+	}
+	return ""
+}
+
+type RuleTemplateThreshold struct {
+	Level                string   `protobuf:"bytes,1,opt,name=Level,proto3" json:"Level,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RuleTemplateThreshold) Reset()         { *m = RuleTemplateThreshold{} }
+func (m *RuleTemplateThreshold) String() string { return proto.CompactTextString(m) }
+func (*RuleTemplateThreshold) ProtoMessage()    {}
+func (*RuleTemplateThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{55}
+}
+func (m *RuleTemplateThreshold) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RuleTemplateThreshold.Unmarshal(m, b)
+}
+func (m *RuleTemplateThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RuleTemplateThreshold.Marshal(b, m, deterministic)
+}
+func (m *RuleTemplateThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuleTemplateThreshold.Merge(m, src)
+}
+func (m *RuleTemplateThreshold) XXX_Size() int {
+	return xxx_messageInfo_RuleTemplateThreshold.Size(m)
+}
+func (m *RuleTemplateThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuleTemplateThreshold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuleTemplateThreshold proto.InternalMessageInfo
+
+func (m *RuleTemplateThreshold) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+func (m *RuleTemplateThreshold) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type CertificateMapping struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Subject              string   `protobuf:"bytes,2,opt,name=Subject,proto3" json:"Subject,omitempty"`
+	UserID               uint64   `protobuf:"varint,3,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Organization         string   `protobuf:"bytes,4,opt,name=Organization,proto3" json:"Organization,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CertificateMapping) Reset()         { *m = CertificateMapping{} }
+func (m *CertificateMapping) String() string { return proto.CompactTextString(m) }
+func (*CertificateMapping) ProtoMessage()    {}
+func (*CertificateMapping) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{56}
+}
+func (m *CertificateMapping) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CertificateMapping.Unmarshal(m, b)
+}
+func (m *CertificateMapping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CertificateMapping.Marshal(b, m, deterministic)
+}
+func (m *CertificateMapping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CertificateMapping.Merge(m, src)
+}
+func (m *CertificateMapping) XXX_Size() int {
+	return xxx_messageInfo_CertificateMapping.Size(m)
+}
+func (m *CertificateMapping) XXX_DiscardUnknown() {
+	xxx_messageInfo_CertificateMapping.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CertificateMapping proto.InternalMessageInfo
+
+func (m *CertificateMapping) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *CertificateMapping) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *CertificateMapping) GetUserID() uint64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *CertificateMapping) GetOrganization() string {
+	if m != nil {
+		return m.Organization
+	}
+	return ""
+}
+
+type Webhook struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	URL                  string   `protobuf:"bytes,3,opt,name=URL,proto3" json:"URL,omitempty"`
+	Events               []string `protobuf:"bytes,4,rep,name=Events,proto3" json:"Events,omitempty"`
+	Secret               string   `protobuf:"bytes,5,opt,name=Secret,proto3" json:"Secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Webhook) Reset()         { *m = Webhook{} }
+func (m *Webhook) String() string { return proto.CompactTextString(m) }
+func (*Webhook) ProtoMessage()    {}
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{57}
+}
+func (m *Webhook) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Webhook.Unmarshal(m, b)
+}
+func (m *Webhook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Webhook.Marshal(b, m, deterministic)
+}
+func (m *Webhook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Webhook.Merge(m, src)
+}
+func (m *Webhook) XXX_Size() int {
+	return xxx_messageInfo_Webhook.Size(m)
+}
+func (m *Webhook) XXX_DiscardUnknown() {
+	xxx_messageInfo_Webhook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Webhook proto.InternalMessageInfo
+
+func (m *Webhook) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *Webhook) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Webhook) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *Webhook) GetEvents() []string {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *Webhook) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+type WebhookDelivery struct {
+	WebhookID            string   `protobuf:"bytes,1,opt,name=WebhookID,proto3" json:"WebhookID,omitempty"`
+	EventID              string   `protobuf:"bytes,2,opt,name=EventID,proto3" json:"EventID,omitempty"`
+	Event                string   `protobuf:"bytes,3,opt,name=Event,proto3" json:"Event,omitempty"`
+	Attempt              int64    `protobuf:"varint,4,opt,name=Attempt,proto3" json:"Attempt,omitempty"`
+	StatusCode           int64    `protobuf:"varint,5,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"`
+	Duration             int64    `protobuf:"varint,6,opt,name=Duration,proto3" json:"Duration,omitempty"`
+	Status               string   `protobuf:"bytes,7,opt,name=Status,proto3" json:"Status,omitempty"`
+	Error                string   `protobuf:"bytes,8,opt,name=Error,proto3" json:"Error,omitempty"`
+	DeliveredAt          int64    `protobuf:"varint,9,opt,name=DeliveredAt,proto3" json:"DeliveredAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WebhookDelivery) Reset()         { *m = WebhookDelivery{} }
+func (m *WebhookDelivery) String() string { return proto.CompactTextString(m) }
+func (*WebhookDelivery) ProtoMessage()    {}
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{58}
+}
+func (m *WebhookDelivery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebhookDelivery.Unmarshal(m, b)
+}
+func (m *WebhookDelivery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebhookDelivery.Marshal(b, m, deterministic)
+}
+func (m *WebhookDelivery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookDelivery.Merge(m, src)
+}
+func (m *WebhookDelivery) XXX_Size() int {
+	return xxx_messageInfo_WebhookDelivery.Size(m)
+}
+func (m *WebhookDelivery) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookDelivery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookDelivery proto.InternalMessageInfo
+
+func (m *WebhookDelivery) GetWebhookID() string {
+	if m != nil {
+		return m.WebhookID
+	}
+	return ""
+}
+
+func (m *WebhookDelivery) GetEventID() string {
+	if m != nil {
+		return m.EventID
+	}
+	return ""
+}
+
+func (m *WebhookDelivery) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
+
+func (m *WebhookDelivery) GetAttempt() int64 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *WebhookDelivery) GetStatusCode() int64 {
+	if m != nil {
+		return m.StatusCode
+	}
+	return 0
+}
+
+func (m *WebhookDelivery) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *WebhookDelivery) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *WebhookDelivery) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *WebhookDelivery) GetDeliveredAt() int64 {
+	if m != nil {
+		return m.DeliveredAt
+	}
+	return 0
+}
+
+type Group struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Users                []uint64 `protobuf:"varint,3,rep,packed,name=Users,proto3" json:"Users,omitempty"`
+	Roles                []*Role  `protobuf:"bytes,4,rep,name=Roles,proto3" json:"Roles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Group) Reset()         { *m = Group{} }
+func (m *Group) String() string { return proto.CompactTextString(m) }
+func (*Group) ProtoMessage()    {}
+func (*Group) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{59}
+}
+func (m *Group) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Group.Unmarshal(m, b)
+}
+func (m *Group) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Group.Marshal(b, m, deterministic)
+}
+func (m *Group) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Group.Merge(m, src)
+}
+func (m *Group) XXX_Size() int {
+	return xxx_messageInfo_Group.Size(m)
+}
+func (m *Group) XXX_DiscardUnknown() {
+	xxx_messageInfo_Group.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Group proto.InternalMessageInfo
+
+func (m *Group) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *Group) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Group) GetUsers() []uint64 {
+	if m != nil {
+		return m.Users
+	}
+	return nil
+}
+
+func (m *Group) GetRoles() []*Role {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+type AlertWebhookRoutingKey struct {
+	Rule                 string   `protobuf:"bytes,1,opt,name=Rule,proto3" json:"Rule,omitempty"`
+	Key                  string   `protobuf:"bytes,2,opt,name=Key,proto3" json:"Key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlertWebhookRoutingKey) Reset()         { *m = AlertWebhookRoutingKey{} }
+func (m *AlertWebhookRoutingKey) String() string { return proto.CompactTextString(m) }
+func (*AlertWebhookRoutingKey) ProtoMessage()    {}
+func (*AlertWebhookRoutingKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{60}
+}
+func (m *AlertWebhookRoutingKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlertWebhookRoutingKey.Unmarshal(m, b)
+}
+func (m *AlertWebhookRoutingKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlertWebhookRoutingKey.Marshal(b, m, deterministic)
+}
+func (m *AlertWebhookRoutingKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertWebhookRoutingKey.Merge(m, src)
+}
+func (m *AlertWebhookRoutingKey) XXX_Size() int {
+	return xxx_messageInfo_AlertWebhookRoutingKey.Size(m)
+}
+func (m *AlertWebhookRoutingKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertWebhookRoutingKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertWebhookRoutingKey proto.InternalMessageInfo
+
+func (m *AlertWebhookRoutingKey) GetRule() string {
+	if m != nil {
+		return m.Rule
+	}
+	return ""
+}
+
+func (m *AlertWebhookRoutingKey) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Source)(nil), "internal.Source")
+	proto.RegisterType((*Dashboard)(nil), "internal.Dashboard")
+	proto.RegisterType((*DashboardCell)(nil), "internal.DashboardCell")
+	proto.RegisterMapType((map[string]*Axis)(nil), "internal.DashboardCell.AxesEntry")
+	proto.RegisterType((*DecimalPlaces)(nil), "internal.DecimalPlaces")
+	proto.RegisterType((*TableOptions)(nil), "internal.TableOptions")
+	proto.RegisterType((*RenamableField)(nil), "internal.RenamableField")
+	proto.RegisterType((*Color)(nil), "internal.Color")
+	proto.RegisterType((*Legend)(nil), "internal.Legend")
+	proto.RegisterType((*Axis)(nil), "internal.Axis")
+	proto.RegisterType((*Template)(nil), "internal.Template")
+	proto.RegisterType((*TemplateValue)(nil), "internal.TemplateValue")
+	proto.RegisterType((*TemplateQuery)(nil), "internal.TemplateQuery")
+	proto.RegisterType((*Server)(nil), "internal.Server")
+	proto.RegisterType((*Layout)(nil), "internal.Layout")
+	proto.RegisterType((*Cell)(nil), "internal.Cell")
+	proto.RegisterMapType((map[string]*Axis)(nil), "internal.Cell.AxesEntry")
+	proto.RegisterType((*Query)(nil), "internal.Query")
+	proto.RegisterType((*TimeShift)(nil), "internal.TimeShift")
+	proto.RegisterType((*Range)(nil), "internal.Range")
+	proto.RegisterType((*AlertRule)(nil), "internal.AlertRule")
+	proto.RegisterType((*User)(nil), "internal.User")
+	proto.RegisterType((*Role)(nil), "internal.Role")
+	proto.RegisterType((*Mapping)(nil), "internal.Mapping")
+	proto.RegisterType((*Organization)(nil), "internal.Organization")
+	proto.RegisterType((*Config)(nil), "internal.Config")
+	proto.RegisterType((*AuthConfig)(nil), "internal.AuthConfig")
+	proto.RegisterType((*OrganizationConfig)(nil), "internal.OrganizationConfig")
+	proto.RegisterType((*LogViewerConfig)(nil), "internal.LogViewerConfig")
+	proto.RegisterType((*LogViewerColumn)(nil), "internal.LogViewerColumn")
+	proto.RegisterType((*ColumnEncoding)(nil), "internal.ColumnEncoding")
+	proto.RegisterType((*BuildInfo)(nil), "internal.BuildInfo")
+	proto.RegisterType((*Notification)(nil), "internal.Notification")
+	proto.RegisterType((*Token)(nil), "internal.Token")
+	proto.RegisterType((*Invitation)(nil), "internal.Invitation")
+	proto.RegisterType((*Preferences)(nil), "internal.Preferences")
+	proto.RegisterType((*OrganizationQuotas)(nil), "internal.OrganizationQuotas")
+	proto.RegisterType((*DashboardVersion)(nil), "internal.DashboardVersion")
+	proto.RegisterType((*Folder)(nil), "internal.Folder")
+	proto.RegisterType((*DashboardSnapshot)(nil), "internal.DashboardSnapshot")
+	proto.RegisterType((*SnapshotResult)(nil), "internal.SnapshotResult")
+	proto.RegisterType((*Report)(nil), "internal.Report")
+	proto.RegisterType((*DashboardView)(nil), "internal.DashboardView")
+	proto.RegisterType((*Annotation)(nil), "internal.Annotation")
+	proto.RegisterType((*SourceHealth)(nil), "internal.SourceHealth")
+	proto.RegisterType((*QueryHistoryEntry)(nil), "internal.QueryHistoryEntry")
+	proto.RegisterType((*AlertEvent)(nil), "internal.AlertEvent")
+	proto.RegisterType((*AlertEventComment)(nil), "internal.AlertEventComment")
+	proto.RegisterType((*AlertWebhook)(nil), "internal.AlertWebhook")
+	proto.RegisterType((*AlertWebhookHeader)(nil), "internal.AlertWebhookHeader")
+	proto.RegisterType((*AlertCheck)(nil), "internal.AlertCheck")
+	proto.RegisterType((*AlertCheckThreshold)(nil), "internal.AlertCheckThreshold")
+	proto.RegisterType((*AlertCheckState)(nil), "internal.AlertCheckState")
+	proto.RegisterType((*AlertPolicy)(nil), "internal.AlertPolicy")
+	proto.RegisterType((*AlertCheckParam)(nil), "internal.AlertCheckParam")
+	proto.RegisterType((*RuleTemplate)(nil), "internal.RuleTemplate")
+	proto.RegisterType((*RuleTemplateParam)(nil), "internal.RuleTemplateParam")
+	proto.RegisterType((*RuleTemplateThreshold)(nil), "internal.RuleTemplateThreshold")
+	proto.RegisterType((*CertificateMapping)(nil), "internal.CertificateMapping")
+	proto.RegisterType((*Webhook)(nil), "internal.Webhook")
+	proto.RegisterType((*WebhookDelivery)(nil), "internal.WebhookDelivery")
+	proto.RegisterType((*Group)(nil), "internal.Group")
+	proto.RegisterType((*AlertWebhookRoutingKey)(nil), "internal.AlertWebhookRoutingKey")
+}
+
+func init() { proto.RegisterFile("internal.proto", fileDescriptor_41f4a519b878ee3b) }
+
+var fileDescriptor_41f4a519b878ee3b = []byte{
+	// 3739 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0x4d, 0x8c, 0x24, 0xc9,
+	0x55, 0x56, 0x56, 0xd6, 0xef, 0xab, 0xea, 0x9e, 0x9e, 0xf4, 0xb8, 0x9d, 0x5e, 0x0f, 0xa6, 0x49,
+	0x19, 0x33, 0x80, 0xbd, 0xd8, 0xbd, 0xc6, 0x46, 0x86, 0x5d, 0xd4, 0x3f, 0x33, 0x3b, 0xb3, 0xee,
+	0x99, 0xe9, 0x8d, 0xee, 0x9d, 0x3d, 0x21, 0x2b, 0xba, 0x2a, 0xba, 0x2a, 0x99, 0xac, 0xcc, 0x72,
+	0x64, 0x66, 0x77, 0xd5, 0x8a, 0x0b, 0x92, 0xe1, 0x80, 0x04, 0x67, 0x4e, 0x70, 0xe1, 0xc6, 0x05,
+	0x71, 0xe3, 0xc4, 0xdd, 0xe2, 0x8c, 0x10, 0xe2, 0xc8, 0x05, 0x89, 0x23, 0xd2, 0x1e, 0xe0, 0x80,
+	0xde, 0x8b, 0x9f, 0x8c, 0xac, 0xca, 0x6a, 0x7a, 0x01, 0xf9, 0x96, 0xdf, 0x8b, 0x57, 0x91, 0x11,
+	0x2f, 0xde, 0xfb, 0xde, 0x8b, 0x97, 0x05, 0xbb, 0x71, 0x5a, 0x08, 0x99, 0xf2, 0xe4, 0xdd, 0x85,
+	0xcc, 0x8a, 0x2c, 0xe8, 0x1b, 0x1c, 0xfd, 0xbb, 0x0f, 0xdd, 0x8b, 0xac, 0x94, 0x63, 0x11, 0xec,
+	0x42, 0xeb, 0xc5, 0x69, 0xe8, 0x1d, 0x78, 0x4f, 0x7c, 0xd6, 0x7a, 0x71, 0x1a, 0x04, 0xd0, 0x7e,
+	0xc5, 0xe7, 0x22, 0x6c, 0x1d, 0x78, 0x4f, 0x06, 0x8c, 0x9e, 0x51, 0x76, 0xb9, 0x5a, 0x88, 0xd0,
+	0x57, 0x32, 0x7c, 0x0e, 0xde, 0x81, 0xfe, 0x27, 0x39, 0xce, 0x36, 0x17, 0x61, 0x9b, 0xe4, 0x16,
+	0xe3, 0xd8, 0x39, 0xcf, 0xf3, 0xdb, 0x4c, 0x4e, 0xc2, 0x8e, 0x1a, 0x33, 0x38, 0xd8, 0x03, 0xff,
+	0x13, 0x76, 0x16, 0x76, 0x49, 0x8c, 0x8f, 0x41, 0x08, 0xbd, 0x53, 0x71, 0xcd, 0xcb, 0xa4, 0x08,
+	0x7b, 0x07, 0xde, 0x93, 0x3e, 0x33, 0x10, 0xe7, 0xb9, 0x14, 0x89, 0x98, 0x4a, 0x7e, 0x1d, 0xf6,
+	0xd5, 0x3c, 0x06, 0x07, 0xef, 0x42, 0xf0, 0x22, 0xcd, 0xc5, 0xb8, 0x94, 0xe2, 0xe2, 0x6d, 0xbc,
+	0x78, 0x23, 0x64, 0x7c, 0xbd, 0x0a, 0x07, 0x34, 0x41, 0xc3, 0x08, 0xbe, 0xe5, 0xa5, 0x28, 0x38,
+	0xbe, 0x1b, 0x68, 0x2a, 0x03, 0x83, 0x08, 0x46, 0x17, 0x33, 0x2e, 0xc5, 0xe4, 0x42, 0x8c, 0xa5,
+	0x28, 0xc2, 0x21, 0x0d, 0xd7, 0x64, 0xa8, 0xf3, 0x5a, 0x4e, 0x79, 0x1a, 0x7f, 0xc6, 0x8b, 0x38,
+	0x4b, 0xc3, 0x91, 0xd2, 0x71, 0x65, 0x68, 0x25, 0x96, 0x25, 0x22, 0xdc, 0x51, 0x56, 0xc2, 0xe7,
+	0xe0, 0x31, 0x0c, 0xf4, 0x66, 0xd8, 0x79, 0xb8, 0x4b, 0x03, 0x95, 0x20, 0x78, 0x04, 0x9d, 0xcb,
+	0xb3, 0x8b, 0x93, 0xa3, 0xf0, 0x01, 0x8d, 0x28, 0x80, 0x2b, 0xc5, 0x07, 0x21, 0x8b, 0x70, 0x4f,
+	0xad, 0x54, 0xc3, 0x60, 0x1f, 0xba, 0x97, 0x67, 0x17, 0x3f, 0x12, 0xab, 0xf0, 0x21, 0x0d, 0x68,
+	0x14, 0x7c, 0x1d, 0xe0, 0x34, 0xce, 0xc7, 0xd9, 0x8d, 0x90, 0x62, 0x12, 0x06, 0x64, 0x03, 0x47,
+	0x12, 0xfd, 0x97, 0x07, 0x83, 0x53, 0x9e, 0xcf, 0xae, 0x32, 0x2e, 0x27, 0xf7, 0x3a, 0xf1, 0x6f,
+	0x43, 0x67, 0x2c, 0x92, 0x24, 0x0f, 0xfd, 0x03, 0xff, 0xc9, 0xf0, 0xf0, 0x2b, 0xef, 0x5a, 0x57,
+	0xb2, 0xf3, 0x9c, 0x88, 0x24, 0x61, 0x4a, 0x2b, 0xf8, 0x0e, 0x0c, 0x0a, 0x31, 0x5f, 0x24, 0xbc,
+	0x10, 0x79, 0xd8, 0xa6, 0x9f, 0x04, 0xd5, 0x4f, 0x2e, 0xf5, 0x10, 0xab, 0x94, 0x36, 0x0c, 0xda,
+	0x69, 0x30, 0xe8, 0x3e, 0x74, 0x9f, 0x65, 0xc9, 0x44, 0x48, 0xed, 0x2d, 0x1a, 0xa1, 0x5b, 0x9c,
+	0xf0, 0xf1, 0x4c, 0x5c, 0x5e, 0x9e, 0x91, 0xc7, 0x0c, 0x98, 0xc5, 0xb8, 0x99, 0x8b, 0xa4, 0x9c,
+	0x6a, 0x77, 0xa1, 0xe7, 0xe8, 0x8f, 0x3b, 0xb0, 0x53, 0x5b, 0x76, 0x30, 0x02, 0x6f, 0x49, 0x16,
+	0xe8, 0x30, 0x6f, 0x89, 0x68, 0x45, 0xbb, 0xef, 0x30, 0x6f, 0x85, 0xe8, 0x96, 0x3c, 0xbd, 0xc3,
+	0xbc, 0x5b, 0x44, 0x33, 0xf2, 0xef, 0x0e, 0xf3, 0x66, 0xc1, 0xaf, 0x42, 0xef, 0x27, 0xa5, 0x90,
+	0xb1, 0xc8, 0xc3, 0x0e, 0xed, 0xf2, 0x41, 0xb5, 0xcb, 0x8f, 0x4b, 0x21, 0x57, 0xcc, 0x8c, 0xe3,
+	0x42, 0x28, 0x36, 0xd4, 0xd2, 0xe9, 0x19, 0x65, 0x05, 0xc6, 0x91, 0x5a, 0x34, 0x3d, 0xeb, 0xd3,
+	0x50, 0xcb, 0xc5, 0xd3, 0xf8, 0x4d, 0x68, 0xf3, 0xa5, 0xc8, 0xc3, 0x01, 0xcd, 0xff, 0x4b, 0x5b,
+	0x0c, 0xff, 0xee, 0xd1, 0x52, 0xe4, 0x4f, 0xd3, 0x42, 0xae, 0x18, 0xa9, 0x07, 0xbf, 0x02, 0xdd,
+	0x71, 0x96, 0x64, 0x32, 0x0f, 0x61, 0x7d, 0x61, 0x27, 0x28, 0x67, 0x7a, 0x38, 0x78, 0x02, 0xdd,
+	0x44, 0x4c, 0x45, 0x3a, 0x21, 0x3f, 0x1f, 0x1e, 0xee, 0x55, 0x8a, 0x67, 0x24, 0x67, 0x7a, 0x3c,
+	0xf8, 0x21, 0x8c, 0x0a, 0x7e, 0x95, 0x88, 0xd7, 0x0b, 0x3c, 0x8d, 0x9c, 0x7c, 0x7e, 0x78, 0xb8,
+	0xef, 0x9c, 0xab, 0x33, 0xca, 0x6a, 0xba, 0xc1, 0xef, 0xc0, 0xe8, 0x3a, 0x16, 0xc9, 0xc4, 0xfc,
+	0x76, 0x87, 0x16, 0x15, 0x56, 0xbf, 0x65, 0x22, 0xe5, 0x73, 0xfc, 0xc5, 0x33, 0x54, 0x63, 0x35,
+	0x6d, 0xf4, 0xe7, 0x22, 0x9e, 0x8b, 0x67, 0x99, 0x9c, 0xf3, 0x42, 0x87, 0x8d, 0x23, 0x09, 0xde,
+	0x87, 0x9d, 0x89, 0x18, 0xc7, 0x73, 0x9e, 0x9c, 0x27, 0x7c, 0x2c, 0x72, 0x8a, 0x9f, 0xba, 0x97,
+	0xba, 0xc3, 0xac, 0xae, 0x8d, 0x7e, 0xb5, 0x90, 0xe2, 0x3a, 0x5e, 0xea, 0xf8, 0xd2, 0x08, 0xe5,
+	0x79, 0x79, 0x8d, 0x72, 0x1d, 0x5e, 0x0a, 0xbd, 0xf3, 0x21, 0x0c, 0xac, 0xb9, 0x91, 0xbf, 0xde,
+	0x8a, 0x15, 0x39, 0xcf, 0x80, 0xe1, 0x63, 0xf0, 0x0d, 0xe8, 0xdc, 0xf0, 0xa4, 0x54, 0x01, 0x34,
+	0x3c, 0xdc, 0xad, 0x56, 0x71, 0xb4, 0x8c, 0x73, 0xa6, 0x06, 0x7f, 0xd8, 0xfa, 0x2d, 0x2f, 0xfa,
+	0x10, 0x76, 0x6a, 0x0b, 0xc3, 0x8d, 0xc6, 0xf9, 0xd3, 0xf4, 0x3a, 0x93, 0x63, 0x31, 0xa1, 0x39,
+	0xfb, 0xcc, 0x91, 0xe0, 0x8a, 0x26, 0xf1, 0x34, 0x2e, 0x72, 0xed, 0x9e, 0x1a, 0x45, 0xff, 0xe4,
+	0xc1, 0xc8, 0xb5, 0x7e, 0xf0, 0x6b, 0xb0, 0x77, 0x23, 0x64, 0x11, 0x8f, 0x79, 0x72, 0x19, 0xcf,
+	0x05, 0xbe, 0x98, 0x7e, 0xd2, 0x67, 0x1b, 0xf2, 0xe0, 0x3b, 0xd0, 0xcd, 0x33, 0x59, 0x1c, 0xaf,
+	0xc8, 0xcb, 0xef, 0x3a, 0x15, 0xad, 0x87, 0x01, 0x77, 0x2b, 0xf9, 0x62, 0x11, 0xa7, 0x53, 0xc3,
+	0xf5, 0x06, 0x07, 0xdf, 0x84, 0xdd, 0xeb, 0x78, 0xf9, 0x2c, 0x96, 0x79, 0x71, 0x92, 0x25, 0xe5,
+	0x3c, 0x25, 0x8f, 0xef, 0xb3, 0x35, 0x29, 0xce, 0xb1, 0xe0, 0x53, 0x71, 0x11, 0x7f, 0xa6, 0xfc,
+	0xbf, 0xc3, 0x2c, 0xfe, 0xa8, 0xdd, 0xf7, 0xf6, 0x5a, 0x1f, 0xb5, 0xfb, 0x9d, 0xbd, 0x6e, 0xf4,
+	0x17, 0x1e, 0xec, 0xd6, 0x97, 0x81, 0x5c, 0x61, 0x56, 0x48, 0x44, 0xa5, 0x6c, 0x5f, 0x93, 0x05,
+	0x07, 0x30, 0x9c, 0xc4, 0xf9, 0x22, 0xe1, 0x2b, 0x87, 0xcb, 0x5c, 0x11, 0xd2, 0xea, 0x4d, 0x9c,
+	0xc7, 0x57, 0x89, 0xca, 0x63, 0x7d, 0x66, 0x20, 0x5a, 0xf9, 0x5a, 0xb9, 0x9a, 0xda, 0x9c, 0x46,
+	0x48, 0xcf, 0x3c, 0x89, 0xa7, 0x86, 0x9c, 0x14, 0x88, 0xa6, 0xd0, 0xa1, 0x88, 0x72, 0x78, 0x74,
+	0x60, 0x78, 0x94, 0xb2, 0x64, 0xcb, 0xc9, 0x92, 0x7b, 0xe0, 0x3f, 0x17, 0x4b, 0x9d, 0x38, 0xf1,
+	0xd1, 0xb2, 0x6d, 0xdb, 0x61, 0xdb, 0x47, 0xd0, 0x79, 0x43, 0x1e, 0xa4, 0x5f, 0x44, 0x20, 0xfa,
+	0x00, 0xba, 0x2a, 0x22, 0xed, 0xcc, 0x9e, 0x33, 0xf3, 0x01, 0x0c, 0x5f, 0xcb, 0x58, 0xa4, 0x85,
+	0xe2, 0x4f, 0xbd, 0x61, 0x47, 0x14, 0xfd, 0xad, 0x07, 0x6d, 0x3a, 0xf0, 0x08, 0x46, 0x89, 0x98,
+	0xf2, 0xf1, 0xea, 0x38, 0x2b, 0xd3, 0x49, 0x1e, 0x7a, 0x07, 0xfe, 0x13, 0x9f, 0xd5, 0x64, 0x68,
+	0x83, 0x2b, 0x35, 0xda, 0x3a, 0xf0, 0xd1, 0x06, 0x0a, 0xe1, 0xd2, 0x12, 0x7e, 0x25, 0x12, 0xbd,
+	0x05, 0x05, 0x9c, 0x08, 0x6a, 0x6f, 0x89, 0xa0, 0x8e, 0x1b, 0x41, 0xb8, 0x81, 0x2b, 0x9e, 0x5b,
+	0x32, 0xc4, 0x67, 0x9c, 0x39, 0x1f, 0xf3, 0xc4, 0xb0, 0xa1, 0x02, 0xd1, 0xdf, 0x7b, 0x98, 0xf3,
+	0x55, 0x96, 0xd8, 0xb0, 0xf0, 0x57, 0xa1, 0x8f, 0x19, 0xe4, 0xc7, 0x37, 0x5c, 0xea, 0x0d, 0xf7,
+	0x10, 0xbf, 0xe1, 0x32, 0xf8, 0x0d, 0xe8, 0x52, 0x9c, 0x35, 0x64, 0x2c, 0x33, 0x1d, 0x59, 0x95,
+	0x69, 0x35, 0xcb, 0xc5, 0x6d, 0x87, 0x8b, 0xed, 0x66, 0x3b, 0xee, 0x66, 0xbf, 0x0d, 0x1d, 0x24,
+	0xf5, 0x15, 0xad, 0xbe, 0x71, 0x66, 0x45, 0xfd, 0x4a, 0x2b, 0x9a, 0xc2, 0x4e, 0xed, 0x8d, 0xf6,
+	0x4d, 0x5e, 0xfd, 0x4d, 0x15, 0x67, 0x0c, 0x34, 0x47, 0x60, 0x8c, 0xe4, 0x22, 0x11, 0xe3, 0x42,
+	0x4c, 0xb4, 0x8f, 0x5a, 0x6c, 0x78, 0xa7, 0x6d, 0x79, 0x27, 0xfa, 0xdc, 0x83, 0x9d, 0xda, 0x0a,
+	0xd0, 0xc5, 0xc7, 0xd9, 0x7c, 0xce, 0xd3, 0x89, 0x7e, 0x99, 0x81, 0x68, 0xc9, 0xc9, 0x95, 0x7e,
+	0x59, 0x6b, 0x72, 0x85, 0x58, 0x2e, 0xf4, 0x99, 0xb6, 0xe4, 0x02, 0xbd, 0x69, 0x2e, 0x78, 0x5e,
+	0x4a, 0x31, 0x17, 0xa9, 0x89, 0x03, 0x57, 0x14, 0x7c, 0x05, 0x7a, 0x05, 0x9f, 0xfe, 0x18, 0xd7,
+	0xa0, 0xcf, 0xb6, 0xe0, 0x53, 0x2c, 0x3e, 0xbe, 0x06, 0x03, 0x22, 0x6f, 0x1a, 0x52, 0x07, 0xdc,
+	0x27, 0x01, 0x0e, 0x06, 0xd0, 0xbe, 0x4e, 0xca, 0xa5, 0xc9, 0x78, 0xf8, 0x8c, 0x3b, 0x29, 0x65,
+	0xa2, 0x53, 0x1e, 0x3e, 0x3a, 0x01, 0x38, 0xa8, 0x05, 0xe0, 0x3e, 0x25, 0x35, 0xe4, 0x14, 0x55,
+	0xb2, 0x69, 0x14, 0xfd, 0x4d, 0x0b, 0xba, 0x17, 0x42, 0xde, 0x08, 0x79, 0xaf, 0x62, 0xc6, 0x2d,
+	0x55, 0xfd, 0x3b, 0x4a, 0xd5, 0x76, 0x73, 0xa9, 0xda, 0xa9, 0x4a, 0xd5, 0x47, 0xd0, 0xb9, 0x90,
+	0xe3, 0x17, 0xa7, 0xb4, 0x4f, 0x9f, 0x29, 0x80, 0xcb, 0x3c, 0x1a, 0x17, 0xf1, 0x8d, 0xd0, 0xf5,
+	0xab, 0x46, 0x1b, 0x35, 0x4e, 0xbf, 0xa1, 0xc6, 0xf9, 0xa2, 0x65, 0xac, 0xa1, 0x02, 0x70, 0xa8,
+	0x20, 0x82, 0x11, 0xd6, 0xb2, 0x13, 0x5e, 0xf0, 0x8f, 0x2e, 0x5e, 0xbf, 0x32, 0x05, 0xac, 0x2b,
+	0x43, 0x5a, 0xed, 0x9e, 0xf1, 0x55, 0x56, 0x16, 0x1b, 0x51, 0x75, 0x00, 0xc3, 0xa3, 0xc5, 0x22,
+	0x89, 0xc7, 0x35, 0x26, 0x71, 0x44, 0xa8, 0xf1, 0xd2, 0xf1, 0x0e, 0x65, 0x43, 0x57, 0x84, 0x39,
+	0xf0, 0x84, 0xea, 0x45, 0x55, 0xfc, 0x39, 0x39, 0x50, 0x95, 0x89, 0x34, 0x88, 0xc6, 0x3e, 0x2a,
+	0x8b, 0xec, 0x3a, 0xc9, 0x6e, 0xc9, 0xaa, 0x7d, 0x66, 0x71, 0xf4, 0xb3, 0x16, 0xb4, 0x7f, 0x5e,
+	0xb5, 0xd9, 0x08, 0xbc, 0x58, 0xbb, 0xaa, 0x17, 0xdb, 0x4a, 0xad, 0xe7, 0x54, 0x6a, 0x21, 0xf4,
+	0x56, 0x92, 0xa7, 0x53, 0x91, 0x87, 0x7d, 0x62, 0x4b, 0x03, 0x69, 0x84, 0x78, 0x41, 0x95, 0x68,
+	0x03, 0x66, 0xa0, 0x8d, 0x73, 0x70, 0xe2, 0xfc, 0x5b, 0xba, 0x9a, 0x1b, 0xae, 0xd7, 0x3f, 0x4d,
+	0x45, 0xdc, 0xff, 0x5f, 0xa1, 0xf1, 0xb9, 0x07, 0x1d, 0x4b, 0x09, 0x27, 0x75, 0x4a, 0x38, 0xa9,
+	0x28, 0xe1, 0xf4, 0xd8, 0x50, 0xc2, 0xe9, 0x31, 0x62, 0x76, 0x6e, 0x28, 0x81, 0x9d, 0xe3, 0x61,
+	0x7d, 0x28, 0xb3, 0x72, 0x71, 0xbc, 0x52, 0xa7, 0x3a, 0x60, 0x16, 0xa3, 0xc7, 0x7f, 0x3a, 0x13,
+	0x52, 0x9b, 0x7a, 0xc0, 0x34, 0xc2, 0xf8, 0x38, 0x23, 0x02, 0x55, 0xc6, 0x55, 0x20, 0xf8, 0x65,
+	0xe8, 0x30, 0x34, 0x1e, 0x59, 0xb8, 0x76, 0x2e, 0x24, 0x66, 0x6a, 0x34, 0xd8, 0x37, 0x77, 0x52,
+	0x1d, 0x28, 0x1a, 0x05, 0xbf, 0x0e, 0xdd, 0x8b, 0x59, 0x7c, 0x5d, 0x98, 0x9a, 0xf8, 0x4b, 0x0e,
+	0x01, 0xc7, 0x73, 0x41, 0x63, 0x4c, 0xab, 0x44, 0x1f, 0xc3, 0xc0, 0x0a, 0xab, 0xe5, 0x78, 0xee,
+	0x72, 0x02, 0x68, 0x7f, 0x92, 0xc6, 0x85, 0xa1, 0x08, 0x7c, 0xc6, 0xcd, 0x7e, 0x5c, 0xf2, 0xb4,
+	0x88, 0x8b, 0x95, 0xa1, 0x08, 0x83, 0xa3, 0xf7, 0xf4, 0xf2, 0x71, 0xba, 0x4f, 0x16, 0x0b, 0x21,
+	0x35, 0xdd, 0x28, 0x40, 0x2f, 0xc9, 0x6e, 0x85, 0xca, 0x48, 0x3e, 0x53, 0x20, 0xfa, 0x3d, 0x18,
+	0x1c, 0x25, 0x42, 0x16, 0xac, 0x4c, 0x44, 0x53, 0xa5, 0x40, 0x81, 0xaa, 0x57, 0x80, 0xcf, 0x15,
+	0xb5, 0xf8, 0x6b, 0xd4, 0xf2, 0x23, 0xbe, 0xe0, 0x2f, 0x4e, 0xc9, 0xcf, 0x7d, 0xa6, 0x51, 0xf4,
+	0x1f, 0x2d, 0x68, 0x23, 0x87, 0x39, 0x53, 0xb7, 0xef, 0xe2, 0xbf, 0x73, 0x99, 0xdd, 0xc4, 0x78,
+	0x93, 0xd2, 0x9b, 0x33, 0x98, 0x8c, 0x3e, 0x9e, 0x09, 0x5b, 0x90, 0x68, 0x84, 0xbe, 0x86, 0x17,
+	0x58, 0x13, 0x4b, 0x8e, 0xaf, 0xa1, 0x98, 0xa9, 0x41, 0xac, 0x5f, 0x2f, 0xca, 0x85, 0x90, 0x47,
+	0x93, 0x79, 0x6c, 0x0a, 0x3f, 0x47, 0x42, 0xb3, 0x17, 0xbc, 0x28, 0x73, 0x1d, 0x5c, 0x1a, 0x21,
+	0x63, 0x19, 0x96, 0x7d, 0xce, 0xf3, 0x99, 0x61, 0x46, 0x57, 0x86, 0x73, 0x5f, 0xbe, 0xbe, 0x3c,
+	0xd7, 0x97, 0x72, 0x95, 0x18, 0x1c, 0x09, 0x92, 0x12, 0xa2, 0xa7, 0x29, 0x16, 0x8a, 0x13, 0x8a,
+	0xba, 0x3e, 0x73, 0x45, 0x46, 0xe3, 0x24, 0x2b, 0x71, 0xed, 0x44, 0x8b, 0x6d, 0xe6, 0x8a, 0x90,
+	0x7d, 0x99, 0xa0, 0x5b, 0xf2, 0xea, 0x24, 0x9b, 0x08, 0x7c, 0xaf, 0xc0, 0x8b, 0x0e, 0xfa, 0x74,
+	0xc3, 0x48, 0xf4, 0x81, 0xba, 0xe2, 0x6f, 0x30, 0xbb, 0xd7, 0xdc, 0x0e, 0x58, 0x3f, 0x89, 0xe8,
+	0xef, 0x3c, 0xe8, 0xbd, 0xd4, 0x85, 0xb3, 0x7b, 0x2a, 0xde, 0xd6, 0x53, 0x69, 0xd5, 0x4e, 0xe5,
+	0x10, 0x1e, 0x19, 0x9d, 0xda, 0xfb, 0xd5, 0xa9, 0x36, 0x8e, 0x69, 0x0f, 0x69, 0x5b, 0xe7, 0xbb,
+	0xcf, 0xcd, 0xdb, 0xb4, 0x32, 0xba, 0x55, 0x2b, 0x23, 0xfa, 0x13, 0x0f, 0x46, 0x0d, 0x13, 0xd7,
+	0xbc, 0x7a, 0xc3, 0xf5, 0x0e, 0x60, 0x68, 0xda, 0x1d, 0x59, 0x62, 0xb2, 0xaf, 0x2b, 0x0a, 0xbe,
+	0x07, 0xdd, 0x8f, 0xcb, 0xac, 0xe0, 0x39, 0x2d, 0x71, 0x78, 0xf8, 0xb8, 0xf2, 0x34, 0xf7, 0x6d,
+	0x4a, 0x87, 0x69, 0xdd, 0xe8, 0x10, 0xba, 0x27, 0x59, 0x7a, 0x1d, 0x4f, 0x83, 0x27, 0xd0, 0x3e,
+	0x2a, 0x8b, 0x19, 0xad, 0x63, 0x78, 0xf8, 0xc8, 0xe1, 0xc4, 0xb2, 0x98, 0x29, 0x1d, 0x46, 0x1a,
+	0xd1, 0xcf, 0x3c, 0x80, 0x4a, 0x88, 0x67, 0x5f, 0x79, 0xea, 0x2b, 0x71, 0x8b, 0xe1, 0x94, 0xeb,
+	0x3b, 0x58, 0xc3, 0x48, 0xf0, 0x3d, 0xf8, 0x32, 0x26, 0x2b, 0xb2, 0x71, 0x1e, 0x67, 0xd5, 0x4f,
+	0xd4, 0x3d, 0xab, 0x79, 0x10, 0x4f, 0xcc, 0x3c, 0x37, 0x9d, 0x58, 0xd3, 0x18, 0x9e, 0x90, 0x91,
+	0x93, 0xd5, 0xd4, 0xd9, 0xd5, 0x64, 0x51, 0x09, 0x81, 0xfb, 0x1b, 0xbd, 0xa7, 0x6f, 0xc2, 0xae,
+	0x2b, 0xb5, 0xc7, 0xb3, 0x26, 0x0d, 0x7e, 0x00, 0x83, 0xb3, 0x6c, 0xfa, 0x26, 0x16, 0x86, 0xb7,
+	0x86, 0x87, 0x5f, 0x75, 0xfa, 0x00, 0x66, 0x48, 0x9b, 0xaf, 0xd2, 0x8d, 0x9e, 0xc1, 0x83, 0xb5,
+	0xd1, 0xe0, 0x3d, 0xcc, 0x30, 0x58, 0x96, 0xa9, 0x8b, 0xc5, 0xb6, 0x99, 0x50, 0x83, 0x19, 0xcd,
+	0x68, 0x55, 0x9b, 0x07, 0x65, 0xd6, 0x7d, 0xbc, 0x35, 0xe6, 0xca, 0xf2, 0xd8, 0xd6, 0x25, 0x1d,
+	0x66, 0x71, 0xf0, 0x7d, 0x18, 0x3c, 0x4d, 0xc7, 0xd9, 0x24, 0x4e, 0xa7, 0xa6, 0xe8, 0x0f, 0x6b,
+	0x4d, 0x8f, 0x72, 0x9e, 0x1a, 0x05, 0x56, 0xa9, 0x46, 0xaf, 0x60, 0xb7, 0x3e, 0xd8, 0x78, 0xbd,
+	0xb2, 0x57, 0xb2, 0x96, 0x73, 0x25, 0xb3, 0x6b, 0xf4, 0x9d, 0x98, 0x7e, 0x1f, 0x06, 0xc7, 0x65,
+	0x9c, 0x4c, 0x5e, 0xa4, 0xd7, 0x19, 0xa6, 0xdb, 0x37, 0x42, 0xe6, 0x15, 0x27, 0x18, 0x88, 0x21,
+	0x8d, 0x99, 0xd7, 0xe6, 0x1d, 0x8d, 0xa2, 0x7f, 0xf5, 0x60, 0xf4, 0x2a, 0x2b, 0xe2, 0xeb, 0x78,
+	0xdc, 0x1c, 0x56, 0xfb, 0xd0, 0xc5, 0x63, 0x7f, 0x71, 0x4a, 0x3f, 0x6c, 0x33, 0x8d, 0x36, 0xe2,
+	0xd8, 0x6f, 0x8e, 0xe3, 0x4b, 0xe7, 0x92, 0x63, 0x76, 0x76, 0x19, 0x17, 0x89, 0xbd, 0x6c, 0x12,
+	0x50, 0xed, 0xd1, 0x3c, 0xe7, 0x53, 0x13, 0xf4, 0x06, 0xe2, 0x1c, 0x67, 0x71, 0xfa, 0xd6, 0x94,
+	0x47, 0xf8, 0x8c, 0x32, 0x26, 0xf8, 0x84, 0x78, 0xbb, 0xcf, 0xe8, 0x19, 0x5b, 0x9d, 0x27, 0x52,
+	0xf0, 0x42, 0x4c, 0x8e, 0x14, 0x5d, 0xfb, 0xac, 0x12, 0x44, 0xff, 0xe6, 0x41, 0xe7, 0x32, 0x7b,
+	0x2b, 0xee, 0x47, 0x1b, 0xf7, 0xdc, 0x9b, 0x13, 0x1d, 0xf4, 0xac, 0x78, 0x33, 0x5b, 0x54, 0x75,
+	0x89, 0x42, 0xa8, 0x4b, 0x79, 0x46, 0xf3, 0x19, 0x3e, 0x3b, 0xeb, 0x3d, 0x5e, 0xd1, 0xe6, 0xda,
+	0xac, 0x12, 0xd4, 0x77, 0xd3, 0x5f, 0xdb, 0x0d, 0x8e, 0x3e, 0x5d, 0x2e, 0x62, 0x29, 0xf2, 0x6a,
+	0xaf, 0x56, 0x80, 0xdd, 0x19, 0x78, 0x91, 0xde, 0xc4, 0x45, 0xf3, 0x81, 0xae, 0x6f, 0xae, 0x75,
+	0xc7, 0xe6, 0x7c, 0x67, 0x73, 0x4d, 0x9d, 0x03, 0x37, 0x89, 0x74, 0xb6, 0x26, 0x91, 0x6e, 0x2d,
+	0x89, 0x3c, 0x86, 0x01, 0xad, 0xce, 0xdd, 0xb8, 0x15, 0xdc, 0xbd, 0xf1, 0xe8, 0xcf, 0x5b, 0x30,
+	0x3c, 0x97, 0xe2, 0x5a, 0x48, 0x91, 0xea, 0x56, 0x9a, 0x76, 0x4e, 0xaf, 0xe6, 0x9c, 0xc8, 0xfb,
+	0x9b, 0xed, 0x18, 0x47, 0x44, 0xbd, 0xfd, 0x78, 0x2e, 0x3e, 0xcb, 0x52, 0x7b, 0x29, 0x33, 0x18,
+	0xbb, 0x59, 0x3a, 0x45, 0xd8, 0xa6, 0xa7, 0xae, 0x7f, 0x36, 0xe4, 0xe4, 0xce, 0xb4, 0x49, 0xe3,
+	0xce, 0xb4, 0xc7, 0x6f, 0xc1, 0xc3, 0x8b, 0x82, 0x4b, 0x29, 0x26, 0x56, 0x33, 0x0f, 0xbb, 0x54,
+	0xc9, 0x6f, 0x0e, 0x04, 0x27, 0xb0, 0xc7, 0xc4, 0x58, 0xa4, 0x85, 0xa3, 0xdc, 0xdb, 0xda, 0xf8,
+	0x46, 0xd6, 0x62, 0x1b, 0x3f, 0x88, 0x7e, 0xea, 0xd5, 0x29, 0x59, 0x65, 0xaa, 0xe0, 0x1b, 0xb0,
+	0xf3, 0x92, 0x2f, 0x9d, 0x89, 0x55, 0xf1, 0x58, 0x17, 0xa2, 0x35, 0x5e, 0xf2, 0x65, 0x95, 0x4f,
+	0x7c, 0x66, 0x31, 0xee, 0xe5, 0x25, 0x5f, 0x62, 0xe1, 0x37, 0x8e, 0x8b, 0x4c, 0x62, 0x45, 0x99,
+	0xeb, 0x2a, 0x71, 0x73, 0x20, 0xfa, 0x2b, 0x0f, 0xf6, 0xaa, 0xa5, 0x6a, 0xf2, 0xc1, 0xe3, 0x30,
+	0x32, 0x7b, 0x5d, 0x76, 0x45, 0xb8, 0x00, 0x26, 0x54, 0xee, 0x32, 0x0b, 0x30, 0x98, 0x3e, 0x62,
+	0xd8, 0x73, 0xc0, 0x17, 0x8f, 0x58, 0x25, 0xa0, 0xdb, 0x6f, 0x59, 0xcc, 0x32, 0x69, 0x2a, 0x48,
+	0x85, 0xea, 0x8e, 0xd4, 0x59, 0x77, 0xa4, 0x3f, 0x30, 0xbd, 0xfd, 0x7b, 0xf1, 0xc1, 0x3e, 0x74,
+	0xcf, 0xb9, 0xac, 0xee, 0x9e, 0x1a, 0x6d, 0x84, 0x52, 0xfb, 0x8e, 0x50, 0xea, 0x38, 0xb5, 0xcc,
+	0x9f, 0xb6, 0xe0, 0xa1, 0xdd, 0xc1, 0x45, 0xca, 0x17, 0xf9, 0x2c, 0x2b, 0x36, 0x7a, 0x09, 0x6b,
+	0x56, 0x6b, 0x6d, 0x5a, 0xad, 0x21, 0x1f, 0xd4, 0xad, 0xd5, 0x5e, 0xb7, 0x96, 0xbd, 0x2d, 0x68,
+	0x77, 0x25, 0x50, 0xdd, 0x2c, 0xf4, 0xbd, 0x89, 0x40, 0x70, 0x08, 0x3d, 0x26, 0xf2, 0x32, 0x29,
+	0x8c, 0x37, 0x3a, 0xf9, 0xcd, 0x2c, 0x5a, 0x29, 0x30, 0xa3, 0xe8, 0x9c, 0x46, 0x7f, 0xfb, 0x69,
+	0x6c, 0xb0, 0xf3, 0x4f, 0x3d, 0xd8, 0xad, 0xcf, 0x48, 0xf9, 0x4a, 0x24, 0x89, 0x3d, 0x1a, 0x8d,
+	0x82, 0x47, 0xfa, 0x66, 0x69, 0x12, 0x23, 0x01, 0xe7, 0xee, 0xe6, 0xd7, 0xee, 0x6e, 0xfb, 0xd0,
+	0x55, 0xf3, 0x69, 0x4b, 0x68, 0x84, 0xb3, 0x3c, 0x95, 0x32, 0xb3, 0x66, 0x20, 0x10, 0xfd, 0x63,
+	0x0b, 0xd5, 0x17, 0x99, 0x2c, 0xee, 0x5d, 0x5c, 0x3a, 0xe7, 0xe3, 0x6f, 0x9e, 0x4f, 0xb5, 0xac,
+	0x76, 0x6d, 0x59, 0x78, 0xd9, 0x2a, 0xb8, 0x34, 0x7e, 0xa9, 0x00, 0x2d, 0xea, 0xc6, 0x34, 0xfa,
+	0x7c, 0xa6, 0x40, 0xf0, 0x48, 0x5f, 0xff, 0x88, 0x2a, 0x7d, 0x73, 0x59, 0xfd, 0x3a, 0x00, 0x13,
+	0xe3, 0x78, 0x81, 0xed, 0x56, 0xd5, 0x23, 0x18, 0x30, 0x47, 0xa2, 0xbe, 0x5d, 0xb9, 0x2d, 0x2d,
+	0x85, 0x36, 0x3c, 0x16, 0x1a, 0x3c, 0x36, 0x84, 0xde, 0x2b, 0xb1, 0x2c, 0x58, 0x99, 0xd2, 0x9d,
+	0xc5, 0x67, 0x06, 0xe2, 0xc8, 0x19, 0xcf, 0x69, 0x64, 0xa4, 0x46, 0x34, 0xc4, 0xf3, 0xc5, 0x47,
+	0x65, 0x54, 0xf5, 0x05, 0xb2, 0x12, 0x44, 0x2f, 0x61, 0xa7, 0x46, 0x5f, 0xf7, 0x23, 0x04, 0xd4,
+	0x24, 0x7f, 0xd1, 0x84, 0x60, 0x70, 0xf4, 0x0f, 0x58, 0x49, 0xa7, 0x69, 0xb6, 0x25, 0xc1, 0x3d,
+	0x86, 0x01, 0x19, 0x14, 0xf9, 0x5c, 0xff, 0xb6, 0x12, 0xe0, 0x1e, 0x9e, 0xa6, 0x13, 0x1a, 0x53,
+	0x27, 0x66, 0x20, 0x55, 0x2b, 0x62, 0x59, 0xd8, 0x6a, 0x45, 0x2c, 0x0b, 0x5b, 0xc1, 0x74, 0x9c,
+	0x0a, 0x86, 0x6e, 0x95, 0x52, 0xf0, 0xb9, 0x4d, 0x6c, 0x84, 0x48, 0x97, 0x4f, 0x55, 0xb0, 0xa0,
+	0x2e, 0x9f, 0xe6, 0xf7, 0xe9, 0xc1, 0x45, 0xff, 0xe2, 0xc1, 0x48, 0x39, 0xc6, 0x73, 0xc1, 0x93,
+	0x62, 0x86, 0x7b, 0x57, 0xd8, 0x9a, 0xc6, 0x62, 0x1a, 0xa3, 0xd6, 0xa3, 0x65, 0x04, 0x8b, 0x9d,
+	0xeb, 0xae, 0x5f, 0xbb, 0xee, 0x3a, 0x55, 0x61, 0xbb, 0x5e, 0x15, 0x3e, 0x82, 0x0e, 0x15, 0x8f,
+	0x26, 0x0e, 0x08, 0xa8, 0x63, 0x2e, 0x44, 0x3a, 0x36, 0xae, 0x68, 0x60, 0x15, 0x37, 0x3d, 0x27,
+	0x6e, 0x28, 0xb8, 0x67, 0x62, 0xfc, 0xb6, 0x96, 0xb3, 0x8d, 0x20, 0xfa, 0xc3, 0x16, 0x3c, 0xa4,
+	0x28, 0x7d, 0x1e, 0xe7, 0x45, 0x26, 0x57, 0xaa, 0xbd, 0xb4, 0x2d, 0x73, 0xbb, 0x7b, 0x6f, 0xad,
+	0xed, 0xfd, 0x3e, 0x65, 0x99, 0xe5, 0x87, 0xb6, 0xcb, 0x0f, 0xaa, 0xd9, 0xd4, 0x59, 0x6b, 0x36,
+	0x75, 0xdd, 0x66, 0xd3, 0x69, 0x29, 0xd5, 0xac, 0x2a, 0xce, 0x2c, 0x76, 0xac, 0xda, 0xaf, 0x59,
+	0xd5, 0xda, 0x62, 0xe0, 0xda, 0x42, 0x85, 0xeb, 0x51, 0x11, 0x82, 0x0d, 0xd7, 0xa3, 0x22, 0xfa,
+	0x4b, 0x1f, 0x80, 0xfa, 0x31, 0x4f, 0x6f, 0x30, 0x6f, 0xac, 0x77, 0x4d, 0xee, 0xda, 0x74, 0x08,
+	0x3d, 0xfa, 0xa5, 0x66, 0x98, 0x01, 0x33, 0xd0, 0xad, 0x99, 0xdb, 0xf5, 0x9a, 0x99, 0xfe, 0xd2,
+	0x50, 0xf0, 0x38, 0xc9, 0xf5, 0x9e, 0x0d, 0x24, 0xfe, 0x17, 0x37, 0x4e, 0x87, 0x0c, 0x01, 0x16,
+	0x09, 0xe7, 0x52, 0xdc, 0xc4, 0x59, 0x99, 0xab, 0x51, 0x75, 0xbc, 0x75, 0x61, 0xcd, 0x48, 0xfd,
+	0x35, 0x23, 0xa1, 0xef, 0x63, 0x48, 0x29, 0x6a, 0xa7, 0x67, 0x3c, 0xae, 0xa3, 0xf1, 0xdb, 0x34,
+	0xbb, 0x4d, 0xc4, 0x64, 0x6a, 0x5b, 0x24, 0x35, 0x19, 0xde, 0x18, 0x5d, 0x7c, 0xbc, 0xd2, 0xdd,
+	0xe3, 0x35, 0xe9, 0xba, 0xde, 0x51, 0xa1, 0x09, 0x68, 0x4d, 0x1a, 0xfc, 0x00, 0xfa, 0x78, 0xb1,
+	0x21, 0x56, 0x54, 0x1f, 0x7d, 0xbf, 0xe6, 0x5c, 0xc9, 0xed, 0x09, 0x68, 0x1d, 0x66, 0x95, 0xa3,
+	0x9f, 0xc0, 0xc3, 0x8d, 0xe1, 0xad, 0x4e, 0x5a, 0x65, 0xb9, 0x56, 0x2d, 0xcb, 0x19, 0x06, 0xf1,
+	0x1d, 0x06, 0xc1, 0x0e, 0xa8, 0x4a, 0x74, 0xba, 0x86, 0x34, 0x30, 0xfa, 0xe7, 0x16, 0x8c, 0xe8,
+	0x9d, 0x9f, 0x8a, 0xab, 0x59, 0x96, 0xbd, 0xfd, 0x42, 0x6e, 0xd1, 0x94, 0xfa, 0xf5, 0x07, 0x83,
+	0x76, 0xed, 0x83, 0x81, 0xaa, 0xd7, 0xd4, 0x7d, 0x44, 0x81, 0xe0, 0xfb, 0xd0, 0x7b, 0x2e, 0xf8,
+	0x44, 0x48, 0x55, 0x93, 0xd6, 0x9a, 0x1e, 0xee, 0x82, 0x94, 0x12, 0x33, 0xca, 0xea, 0xff, 0x30,
+	0xea, 0x83, 0x8f, 0xf9, 0xe3, 0x83, 0xc1, 0x14, 0x25, 0xaa, 0x55, 0x66, 0xa2, 0x84, 0x90, 0x25,
+	0xd0, 0x81, 0x43, 0xa0, 0x98, 0xbc, 0xb2, 0xb2, 0x88, 0x53, 0xfc, 0x80, 0xa3, 0x53, 0x90, 0x23,
+	0x09, 0x8e, 0x61, 0x58, 0x21, 0xd3, 0xbc, 0x3e, 0x68, 0x5e, 0x63, 0xa5, 0xc8, 0xdc, 0x1f, 0x45,
+	0x1f, 0x40, 0xb0, 0xb9, 0x95, 0xc6, 0x4b, 0x7e, 0xe3, 0x55, 0x3b, 0xfa, 0x4f, 0x13, 0xb1, 0x44,
+	0x64, 0xff, 0xe7, 0xa3, 0xf9, 0xdf, 0xd1, 0x92, 0xad, 0x08, 0x7a, 0x6e, 0x45, 0xf0, 0x0e, 0xf4,
+	0x5f, 0x2f, 0x84, 0xe4, 0x85, 0xad, 0xb2, 0x2c, 0x0e, 0xde, 0x07, 0xb8, 0x9c, 0x49, 0x91, 0xcf,
+	0xb2, 0x64, 0x62, 0x1a, 0xd6, 0xbf, 0xb0, 0x66, 0x39, 0xda, 0x91, 0xd5, 0x62, 0xce, 0x0f, 0x5c,
+	0x4a, 0x81, 0x0d, 0x4a, 0x31, 0xad, 0xce, 0xa1, 0xfa, 0x7c, 0xad, 0x61, 0xf0, 0x5d, 0xc5, 0x8f,
+	0xba, 0x71, 0x59, 0xeb, 0xbf, 0x54, 0xaf, 0x23, 0x0d, 0xa6, 0x15, 0xdd, 0x0a, 0x63, 0x67, 0x6b,
+	0x85, 0xb1, 0x7b, 0x47, 0x85, 0xf1, 0x60, 0xad, 0xc2, 0xa8, 0xb9, 0xe6, 0xde, 0x9a, 0x6b, 0x7e,
+	0x97, 0xaa, 0x77, 0x3e, 0xcf, 0xc3, 0x87, 0xdb, 0x17, 0x48, 0x1a, 0x4c, 0x2b, 0x46, 0x47, 0xf0,
+	0xa5, 0x06, 0x53, 0x55, 0xec, 0xe9, 0xb9, 0xec, 0x59, 0x73, 0x20, 0xcf, 0x38, 0xd0, 0x11, 0x3c,
+	0x58, 0xdb, 0xbe, 0x4b, 0xe5, 0x5e, 0x9d, 0xca, 0xed, 0xc4, 0x2d, 0x67, 0xe2, 0xe8, 0xaf, 0x3d,
+	0x18, 0x92, 0xc6, 0x79, 0x96, 0xc4, 0xe3, 0xd5, 0x17, 0x75, 0x42, 0x0c, 0x76, 0x7b, 0x83, 0xc7,
+	0xef, 0x00, 0x68, 0xa4, 0x99, 0xcc, 0x8a, 0x42, 0xb7, 0x2d, 0x7c, 0x66, 0x31, 0xc6, 0xe4, 0xb3,
+	0x84, 0x2f, 0x3e, 0x8d, 0xd3, 0x89, 0xfe, 0x3a, 0xe6, 0x33, 0x47, 0x82, 0x15, 0x1b, 0xa2, 0x93,
+	0x99, 0xfa, 0x2a, 0xa5, 0xea, 0x02, 0x57, 0x14, 0xfd, 0xb6, 0xbb, 0x61, 0xb2, 0xe3, 0x17, 0x08,
+	0xb7, 0x3f, 0xf3, 0x61, 0x84, 0x6b, 0xdc, 0xfa, 0xed, 0x7d, 0x6b, 0x77, 0x37, 0x1f, 0xcb, 0x78,
+	0xe1, 0x94, 0x03, 0xae, 0x28, 0x78, 0xcf, 0x1e, 0x7d, 0x7b, 0x3d, 0x19, 0xb8, 0x6f, 0xab, 0x1d,
+	0xbe, 0x2d, 0x67, 0xe8, 0x7d, 0x2a, 0x38, 0x2b, 0x41, 0x15, 0xc9, 0xdd, 0xcd, 0x48, 0xee, 0xad,
+	0x45, 0x72, 0x7f, 0x33, 0x92, 0x07, 0xdb, 0x22, 0x19, 0xd6, 0x22, 0xf9, 0x77, 0x6b, 0x91, 0xac,
+	0x38, 0xf0, 0x17, 0x9b, 0x97, 0xff, 0x3f, 0xc6, 0xf2, 0xa8, 0x1e, 0xcb, 0xeb, 0x75, 0xd4, 0x4e,
+	0x43, 0x51, 0x3a, 0x86, 0x87, 0x1b, 0x16, 0x6a, 0x3c, 0xcf, 0xb5, 0x43, 0x68, 0x6d, 0x1e, 0x82,
+	0xf3, 0x07, 0x4b, 0xdf, 0x54, 0x23, 0x04, 0xa3, 0x13, 0xf8, 0x72, 0xe3, 0x3e, 0xee, 0x13, 0x68,
+	0xd6, 0x75, 0x3e, 0x83, 0x00, 0xff, 0x9d, 0xa8, 0x3a, 0x98, 0xc2, 0x7c, 0xde, 0x58, 0xf7, 0x9f,
+	0x10, 0x7a, 0x17, 0xe5, 0xd5, 0xef, 0x8b, 0xb1, 0x69, 0x80, 0x1a, 0xe8, 0x24, 0x79, 0xff, 0xce,
+	0x06, 0x67, 0xc3, 0xe5, 0x3e, 0xca, 0xa0, 0xb7, 0x99, 0xbc, 0xb7, 0x3b, 0xac, 0x4e, 0xd0, 0x7e,
+	0x95, 0xa0, 0xf7, 0xa1, 0x4b, 0x15, 0x87, 0xf9, 0xc6, 0xa9, 0x91, 0x93, 0x4e, 0x3b, 0x6e, 0x3a,
+	0x8d, 0xfe, 0xa8, 0x05, 0x0f, 0xf4, 0x1b, 0x4f, 0x45, 0x12, 0x93, 0x17, 0x3d, 0x86, 0x81, 0x16,
+	0xd9, 0x05, 0x54, 0x02, 0x22, 0x6e, 0x9c, 0x53, 0x73, 0xc4, 0x80, 0x19, 0xa8, 0x7d, 0xd2, 0x36,
+	0x35, 0x14, 0x20, 0x92, 0x2a, 0xf0, 0x6f, 0x2d, 0x85, 0xa9, 0x57, 0x34, 0xa4, 0xaf, 0x6d, 0x54,
+	0xfa, 0xe2, 0x17, 0x29, 0x43, 0x11, 0x95, 0xa4, 0x56, 0x1f, 0x76, 0xb7, 0x16, 0xd1, 0xbd, 0xe6,
+	0x22, 0xba, 0xef, 0x16, 0xd1, 0xe4, 0x53, 0xb4, 0x3b, 0xa7, 0x5f, 0xe0, 0x8a, 0xf0, 0x5f, 0x50,
+	0xf4, 0x35, 0xf8, 0x5e, 0x66, 0xc7, 0xf6, 0x06, 0xb5, 0xb6, 0xb0, 0x4d, 0xdf, 0x66, 0x0a, 0x54,
+	0x9f, 0x18, 0xdb, 0x77, 0x7c, 0x62, 0x8c, 0x3e, 0x80, 0xfd, 0xe6, 0x72, 0xc3, 0x32, 0xac, 0xe7,
+	0x30, 0xec, 0x1e, 0xf8, 0x58, 0xd2, 0xa8, 0x97, 0xe3, 0xe3, 0x55, 0x97, 0xfe, 0xfb, 0xfc, 0xde,
+	0x7f, 0x0f, 0x00, 0x63, 0x04, 0x0c, 0x82, 0x0d, 0x2d, 0x00, 0x00,
 }
@@ -198,9 +198,53 @@ func Test_MarshalDashboard(t *testing.T) {
 				FieldOptions: []chronograf.RenamableField{},
 				TimeFormat:   "",
 			},
+			{
+				ID:      "4a6e4a8e-5b0f-4a3c-9b8e-0f0e3e1d8c2a",
+				W:       4,
+				H:       4,
+				Name:    "Requests",
+				Queries: []chronograf.DashboardQuery{},
+				Axes:    map[string]chronograf.Axis{},
+				Type:    "table",
+				CellColors: []chronograf.CellColor{
+					{
+						ID:    "base",
+						Type:  "text",
+						Hex:   "#00C9FF",
+						Name:  "laser",
+						Value: "-1000000000000000000",
+					},
+				},
+				TableOptions: chronograf.TableOptions{
+					SortBy: chronograf.RenamableField{
+						InternalName: "time",
+						Visible:      true,
+					},
+					Wrapping: "truncate",
+					PageSize: 50,
+				},
+				FieldOptions: []chronograf.RenamableField{
+					{
+						InternalName: "http.bytes",
+						DisplayName:  "Bytes",
+						Visible:      true,
+						Format:       "bytes",
+						Align:        "right",
+					},
+				},
+				DecimalPlaces: chronograf.DecimalPlaces{
+					IsEnforced: true,
+					Digits:     1,
+				},
+				Prefix: "~",
+				Suffix: "req/s",
+			},
 		},
 		Templates: []chronograf.Template{},
 		Name:      "Dashboard",
+		Folder:    "1",
+		CacheTTL:  "30s",
+		Slug:      "requests",
 	}
 
 	var actual chronograf.Dashboard
@@ -0,0 +1,115 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+
+	bolt "github.com/coreos/bbolt"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt/internal"
+	"github.com/influxdata/influxdb/kit/tracing"
+)
+
+// Ensure InvitationsStore implements chronograf.InvitationsStore.
+var _ chronograf.InvitationsStore = &InvitationsStore{}
+
+var (
+	// InvitationsBucket is the bucket where pending invitations are stored.
+	InvitationsBucket = []byte("invitationsv1")
+)
+
+// InvitationsStore uses bolt to store and retrieve pending invitations
+type InvitationsStore struct {
+	client *Client
+}
+
+// Migrate is a noop as there is no previous schema of invitations
+func (s *InvitationsStore) Migrate(ctx context.Context) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return nil
+}
+
+// All returns all pending invitations
+func (s *InvitationsStore) All(ctx context.Context) ([]chronograf.Invitation, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	invitations := []chronograf.Invitation{}
+	err := s.client.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(InvitationsBucket).ForEach(func(k, v []byte) error {
+			var i chronograf.Invitation
+			if err := internal.UnmarshalInvitation(v, &i); err != nil {
+				return err
+			}
+			invitations = append(invitations, i)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return invitations, nil
+}
+
+// Add creates a new invitation in the InvitationsStore
+func (s *InvitationsStore) Add(ctx context.Context, i *chronograf.Invitation) (*chronograf.Invitation, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	if err := s.client.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(InvitationsBucket)
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		i.ID = fmt.Sprintf("%d", seq)
+		if i.CreatedAt.IsZero() {
+			i.CreatedAt = s.client.Now().UTC()
+		}
+
+		v, err := internal.MarshalInvitation(i)
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(i.ID), v)
+	}); err != nil {
+		return nil, err
+	}
+
+	return i, nil
+}
+
+// Delete the invitation from the InvitationsStore
+func (s *InvitationsStore) Delete(ctx context.Context, i *chronograf.Invitation) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	if _, err := s.Get(ctx, i.ID); err != nil {
+		return err
+	}
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(InvitationsBucket).Delete([]byte(i.ID))
+	})
+}
+
+// Get retrieves an invitation by ID
+func (s *InvitationsStore) Get(ctx context.Context, id string) (*chronograf.Invitation, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	var i chronograf.Invitation
+	if err := s.client.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(InvitationsBucket).Get([]byte(id))
+		if v == nil {
+			return chronograf.ErrInvitationNotFound
+		}
+		return internal.UnmarshalInvitation(v, &i)
+	}); err != nil {
+		return nil, err
+	}
+
+	return &i, nil
+}
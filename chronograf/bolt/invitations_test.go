@@ -0,0 +1,72 @@
+package bolt_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func TestInvitationsStore(t *testing.T) {
+	client, err := NewTestClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	s := client.InvitationsStore
+
+	marty := &chronograf.Invitation{
+		Organization: "default",
+		Role:         "editor",
+		Name:         "marty",
+		Provider:     "github",
+		Scheme:       "oauth2",
+		InvitedBy:    1,
+	}
+	doc := &chronograf.Invitation{
+		Organization: "default",
+		Role:         "viewer",
+		Name:         "doc",
+		Provider:     "google",
+		Scheme:       "oauth2",
+		InvitedBy:    1,
+	}
+	for _, i := range []*chronograf.Invitation{marty, doc} {
+		if _, err := s.Add(ctx, i); err != nil {
+			t.Fatalf("Add() error = %v", err)
+		}
+	}
+	if !doc.CreatedAt.Equal(TestNow) {
+		t.Errorf("Add() CreatedAt = %v, want %v", doc.CreatedAt, TestNow)
+	}
+
+	got, err := s.All(ctx)
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	want := []chronograf.Invitation{*marty, *doc}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("All() diff (-got +want):\n%s", diff)
+	}
+
+	i, err := s.Get(ctx, doc.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if diff := cmp.Diff(i, doc); diff != "" {
+		t.Errorf("Get() diff (-got +want):\n%s", diff)
+	}
+
+	if err := s.Delete(ctx, marty); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := s.Get(ctx, marty.ID); err != chronograf.ErrInvitationNotFound {
+		t.Errorf("Get() after Delete() error = %v, want %v", err, chronograf.ErrInvitationNotFound)
+	}
+	if err := s.Delete(ctx, marty); err != chronograf.ErrInvitationNotFound {
+		t.Errorf("Delete() of a removed invitation error = %v, want %v", err, chronograf.ErrInvitationNotFound)
+	}
+}
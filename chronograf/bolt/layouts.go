@@ -6,6 +6,7 @@ import (
 	bolt "github.com/coreos/bbolt"
 	"github.com/influxdata/influxdb/chronograf"
 	"github.com/influxdata/influxdb/chronograf/bolt/internal"
+	"github.com/influxdata/influxdb/kit/tracing"
 )
 
 // Ensure LayoutsStore implements chronograf.LayoutsStore.
@@ -21,11 +22,17 @@ type LayoutsStore struct {
 }
 
 func (s *LayoutsStore) Migrate(ctx context.Context) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	return nil
 }
 
 // All returns all known layouts
 func (s *LayoutsStore) All(ctx context.Context) ([]chronograf.Layout, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	var srcs []chronograf.Layout
 	if err := s.client.db.View(func(tx *bolt.Tx) error {
 		if err := tx.Bucket(LayoutsBucket).ForEach(func(k, v []byte) error {
@@ -49,6 +56,9 @@ func (s *LayoutsStore) All(ctx context.Context) ([]chronograf.Layout, error) {
 
 // Add creates a new Layout in the LayoutsStore.
 func (s *LayoutsStore) Add(ctx context.Context, src chronograf.Layout) (chronograf.Layout, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	if err := s.client.db.Update(func(tx *bolt.Tx) error {
 		b := tx.Bucket(LayoutsBucket)
 		id, err := s.IDs.Generate()
@@ -72,6 +82,9 @@ func (s *LayoutsStore) Add(ctx context.Context, src chronograf.Layout) (chronogr
 
 // Delete removes the Layout from the LayoutsStore
 func (s *LayoutsStore) Delete(ctx context.Context, src chronograf.Layout) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	_, err := s.Get(ctx, src.ID)
 	if err != nil {
 		return err
@@ -90,6 +103,9 @@ func (s *LayoutsStore) Delete(ctx context.Context, src chronograf.Layout) error
 
 // Get returns a Layout if the id exists.
 func (s *LayoutsStore) Get(ctx context.Context, id string) (chronograf.Layout, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	var src chronograf.Layout
 	if err := s.client.db.View(func(tx *bolt.Tx) error {
 		if v := tx.Bucket(LayoutsBucket).Get([]byte(id)); v == nil {
@@ -107,6 +123,9 @@ func (s *LayoutsStore) Get(ctx context.Context, id string) (chronograf.Layout, e
 
 // Update a Layout
 func (s *LayoutsStore) Update(ctx context.Context, src chronograf.Layout) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	if err := s.client.db.Update(func(tx *bolt.Tx) error {
 		// Get an existing layout with the same ID.
 		b := tx.Bucket(LayoutsBucket)
@@ -7,6 +7,7 @@ import (
 	bolt "github.com/coreos/bbolt"
 	"github.com/influxdata/influxdb/chronograf"
 	"github.com/influxdata/influxdb/chronograf/bolt/internal"
+	"github.com/influxdata/influxdb/kit/tracing"
 )
 
 // Ensure MappingsStore implements chronograf.MappingsStore.
@@ -24,11 +25,17 @@ type MappingsStore struct {
 
 // Migrate sets the default organization at runtime
 func (s *MappingsStore) Migrate(ctx context.Context) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	return nil
 }
 
 // Add creates a new Mapping in the MappingsStore
 func (s *MappingsStore) Add(ctx context.Context, o *chronograf.Mapping) (*chronograf.Mapping, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	err := s.client.db.Update(func(tx *bolt.Tx) error {
 		b := tx.Bucket(MappingsBucket)
 		seq, err := b.NextSequence()
@@ -54,6 +61,9 @@ func (s *MappingsStore) Add(ctx context.Context, o *chronograf.Mapping) (*chrono
 
 // All returns all known organizations
 func (s *MappingsStore) All(ctx context.Context) ([]chronograf.Mapping, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	var mappings []chronograf.Mapping
 	err := s.each(ctx, func(m *chronograf.Mapping) {
 		mappings = append(mappings, *m)
@@ -68,6 +78,9 @@ func (s *MappingsStore) All(ctx context.Context) ([]chronograf.Mapping, error) {
 
 // Delete the organization from MappingsStore
 func (s *MappingsStore) Delete(ctx context.Context, o *chronograf.Mapping) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	_, err := s.get(ctx, o.ID)
 	if err != nil {
 		return err
@@ -112,11 +125,17 @@ func (s *MappingsStore) each(ctx context.Context, fn func(*chronograf.Mapping))
 
 // Get returns a Mapping if the id exists.
 func (s *MappingsStore) Get(ctx context.Context, id string) (*chronograf.Mapping, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	return s.get(ctx, id)
 }
 
 // Update the organization in MappingsStore
 func (s *MappingsStore) Update(ctx context.Context, o *chronograf.Mapping) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	return s.client.db.Update(func(tx *bolt.Tx) error {
 		if v, err := internal.MarshalMapping(o); err != nil {
 			return err
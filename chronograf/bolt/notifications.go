@@ -0,0 +1,139 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	bolt "github.com/coreos/bbolt"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt/internal"
+	"github.com/influxdata/influxdb/kit/tracing"
+)
+
+// Ensure NotificationsStore implements chronograf.NotificationsStore.
+var _ chronograf.NotificationsStore = &NotificationsStore{}
+
+var (
+	// NotificationsBucket is the bucket where notifications are stored.
+	NotificationsBucket = []byte("notificationsv1")
+)
+
+// NotificationsStore uses bolt to store and retrieve the inboxes of users
+type NotificationsStore struct {
+	client *Client
+}
+
+// Migrate is a noop as there is no previous schema of notifications
+func (s *NotificationsStore) Migrate(ctx context.Context) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return nil
+}
+
+// All returns the notifications of a user ordered from newest to oldest
+func (s *NotificationsStore) All(ctx context.Context, userID uint64) ([]chronograf.Notification, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	notifications := []chronograf.Notification{}
+	err := s.client.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(NotificationsBucket).ForEach(func(k, v []byte) error {
+			var n chronograf.Notification
+			if err := internal.UnmarshalNotification(v, &n); err != nil {
+				return err
+			}
+			if n.UserID == userID {
+				notifications = append(notifications, n)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(notifications, func(i, j int) bool {
+		return notifications[i].CreatedAt.After(notifications[j].CreatedAt)
+	})
+	return notifications, nil
+}
+
+// Add creates a new notification in the NotificationsStore
+func (s *NotificationsStore) Add(ctx context.Context, n *chronograf.Notification) (*chronograf.Notification, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	if err := s.client.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(NotificationsBucket)
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		n.ID = fmt.Sprintf("%d", seq)
+		if n.CreatedAt.IsZero() {
+			n.CreatedAt = s.client.Now().UTC()
+		}
+
+		v, err := internal.MarshalNotification(n)
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(n.ID), v)
+	}); err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
+// Delete the notification from the NotificationsStore
+func (s *NotificationsStore) Delete(ctx context.Context, n *chronograf.Notification) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	if _, err := s.Get(ctx, n.ID); err != nil {
+		return err
+	}
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(NotificationsBucket).Delete([]byte(n.ID))
+	})
+}
+
+// Get retrieves a notification by ID
+func (s *NotificationsStore) Get(ctx context.Context, id string) (*chronograf.Notification, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	var n chronograf.Notification
+	if err := s.client.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(NotificationsBucket).Get([]byte(id))
+		if v == nil {
+			return chronograf.ErrNotificationNotFound
+		}
+		return internal.UnmarshalNotification(v, &n)
+	}); err != nil {
+		return nil, err
+	}
+
+	return &n, nil
+}
+
+// Update replaces the notification in the NotificationsStore
+func (s *NotificationsStore) Update(ctx context.Context, n *chronograf.Notification) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(NotificationsBucket)
+		if v := b.Get([]byte(n.ID)); v == nil {
+			return chronograf.ErrNotificationNotFound
+		}
+		v, err := internal.MarshalNotification(n)
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(n.ID), v)
+	})
+}
@@ -0,0 +1,81 @@
+package bolt_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func TestNotificationsStore(t *testing.T) {
+	client, err := NewTestClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	s := client.NotificationsStore
+
+	older := &chronograf.Notification{
+		UserID:    1,
+		Type:      chronograf.BroadcastNotification,
+		Title:     "Maintenance",
+		Message:   "Chronograf restarts tonight",
+		CreatedAt: TestNow.Add(-time.Hour),
+	}
+	newer := &chronograf.Notification{
+		UserID:       1,
+		Organization: "default",
+		Type:         chronograf.AlertNotification,
+		Title:        "CPU",
+		Message:      "cpu is critical",
+		Link:         "/sources/1/alerts",
+	}
+	other := &chronograf.Notification{
+		UserID: 2,
+		Type:   chronograf.BroadcastNotification,
+		Title:  "Maintenance",
+	}
+	for _, n := range []*chronograf.Notification{older, newer, other} {
+		if _, err := s.Add(ctx, n); err != nil {
+			t.Fatalf("Add() error = %v", err)
+		}
+	}
+	if !newer.CreatedAt.Equal(TestNow) {
+		t.Errorf("Add() CreatedAt = %v, want %v", newer.CreatedAt, TestNow)
+	}
+
+	got, err := s.All(ctx, 1)
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	want := []chronograf.Notification{*newer, *older}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("All() diff (-got +want):\n%s", diff)
+	}
+
+	newer.Read = true
+	if err := s.Update(ctx, newer); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	n, err := s.Get(ctx, newer.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if !n.Read {
+		t.Errorf("Get() Read = false after Update()")
+	}
+
+	if err := s.Delete(ctx, other); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := s.Get(ctx, other.ID); err != chronograf.ErrNotificationNotFound {
+		t.Errorf("Get() error = %v, want %v", err, chronograf.ErrNotificationNotFound)
+	}
+	if err := s.Update(ctx, other); err != chronograf.ErrNotificationNotFound {
+		t.Errorf("Update() error = %v, want %v", err, chronograf.ErrNotificationNotFound)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	bolt "github.com/coreos/bbolt"
 	"github.com/influxdata/influxdb/chronograf"
 	"github.com/influxdata/influxdb/chronograf/bolt/internal"
+	"github.com/influxdata/influxdb/kit/tracing"
 )
 
 // Ensure OrganizationConfigStore implements chronograf.OrganizationConfigStore.
@@ -21,11 +22,17 @@ type OrganizationConfigStore struct {
 }
 
 func (s *OrganizationConfigStore) Migrate(ctx context.Context) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	return nil
 }
 
 // Get retrieves an OrganizationConfig from the store
 func (s *OrganizationConfigStore) Get(ctx context.Context, orgID string) (*chronograf.OrganizationConfig, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	var c chronograf.OrganizationConfig
 
 	err := s.client.db.View(func(tx *bolt.Tx) error {
@@ -49,6 +56,9 @@ func (s *OrganizationConfigStore) get(ctx context.Context, tx *bolt.Tx, orgID st
 
 // FindOrCreate gets an OrganizationConfig from the store or creates one if none exists for this organization
 func (s *OrganizationConfigStore) FindOrCreate(ctx context.Context, orgID string) (*chronograf.OrganizationConfig, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	var c chronograf.OrganizationConfig
 	err := s.client.db.Update(func(tx *bolt.Tx) error {
 		err := s.get(ctx, tx, orgID, &c)
@@ -67,6 +77,9 @@ func (s *OrganizationConfigStore) FindOrCreate(ctx context.Context, orgID string
 
 // Put replaces the OrganizationConfig in the store
 func (s *OrganizationConfigStore) Put(ctx context.Context, c *chronograf.OrganizationConfig) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	return s.client.db.Update(func(tx *bolt.Tx) error {
 		return s.put(ctx, tx, c)
 	})
@@ -3,11 +3,14 @@ package bolt
 import (
 	"context"
 	"fmt"
+	"math"
+	"time"
 
 	bolt "github.com/coreos/bbolt"
 	"github.com/influxdata/influxdb/chronograf"
 	"github.com/influxdata/influxdb/chronograf/bolt/internal"
 	"github.com/influxdata/influxdb/chronograf/organizations"
+	"github.com/influxdata/influxdb/kit/tracing"
 )
 
 // Ensure OrganizationsStore implements chronograf.OrganizationsStore.
@@ -30,15 +33,57 @@ const (
 // OrganizationsStore uses bolt to store and retrieve Organizations
 type OrganizationsStore struct {
 	client *Client
+
+	// Stores kept outside of boltDB, such as in etcd, or caching those of
+	// the client replace them when the resources of deleted organizations
+	// are deleted
+	SourcesStore    chronograf.SourcesStore
+	ServersStore    chronograf.ServersStore
+	DashboardsStore chronograf.DashboardsStore
+	UsersStore      chronograf.UsersStore
+}
+
+func (s *OrganizationsStore) sources() chronograf.SourcesStore {
+	if s.SourcesStore != nil {
+		return s.SourcesStore
+	}
+	return s.client.SourcesStore
+}
+
+func (s *OrganizationsStore) servers() chronograf.ServersStore {
+	if s.ServersStore != nil {
+		return s.ServersStore
+	}
+	return s.client.ServersStore
+}
+
+func (s *OrganizationsStore) dashboards() chronograf.DashboardsStore {
+	if s.DashboardsStore != nil {
+		return s.DashboardsStore
+	}
+	return s.client.DashboardsStore
+}
+
+func (s *OrganizationsStore) users() chronograf.UsersStore {
+	if s.UsersStore != nil {
+		return s.UsersStore
+	}
+	return s.client.UsersStore
 }
 
 // Migrate sets the default organization at runtime
 func (s *OrganizationsStore) Migrate(ctx context.Context) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	return s.CreateDefault(ctx)
 }
 
 // CreateDefault does a findOrCreate on the default organization
 func (s *OrganizationsStore) CreateDefault(ctx context.Context) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	o := chronograf.Organization{
 		ID:          string(DefaultOrganizationID),
 		Name:        DefaultOrganizationName,
@@ -91,6 +136,9 @@ func (s *OrganizationsStore) nameIsUnique(ctx context.Context, name string) bool
 
 // DefaultOrganizationID returns the ID of the default organization
 func (s *OrganizationsStore) DefaultOrganization(ctx context.Context) (*chronograf.Organization, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	var org chronograf.Organization
 	if err := s.client.db.View(func(tx *bolt.Tx) error {
 		v := tx.Bucket(OrganizationsBucket).Get(DefaultOrganizationID)
@@ -104,6 +152,9 @@ func (s *OrganizationsStore) DefaultOrganization(ctx context.Context) (*chronogr
 
 // Add creates a new Organization in the OrganizationsStore
 func (s *OrganizationsStore) Add(ctx context.Context, o *chronograf.Organization) (*chronograf.Organization, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	if !s.nameIsUnique(ctx, o.Name) {
 		return nil, chronograf.ErrOrganizationAlreadyExists
 	}
@@ -128,6 +179,9 @@ func (s *OrganizationsStore) Add(ctx context.Context, o *chronograf.Organization
 
 // All returns all known organizations
 func (s *OrganizationsStore) All(ctx context.Context) ([]chronograf.Organization, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	var orgs []chronograf.Organization
 	err := s.each(ctx, func(o *chronograf.Organization) {
 		orgs = append(orgs, *o)
@@ -142,6 +196,9 @@ func (s *OrganizationsStore) All(ctx context.Context) ([]chronograf.Organization
 
 // Delete the organization from OrganizationsStore
 func (s *OrganizationsStore) Delete(ctx context.Context, o *chronograf.Organization) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	if o.ID == string(DefaultOrganizationID) {
 		return chronograf.ErrCannotDeleteDefaultOrganization
 	}
@@ -161,7 +218,7 @@ func (s *OrganizationsStore) Delete(ctx context.Context, o *chronograf.Organizat
 	// set on the context.
 	ctx = context.WithValue(ctx, organizations.ContextKey, o.ID)
 
-	sourcesStore := organizations.NewSourcesStore(s.client.SourcesStore, o.ID)
+	sourcesStore := organizations.NewSourcesStore(s.sources(), o.ID)
 	sources, err := sourcesStore.All(ctx)
 	if err != nil {
 		return err
@@ -170,9 +227,24 @@ func (s *OrganizationsStore) Delete(ctx context.Context, o *chronograf.Organizat
 		if err := sourcesStore.Delete(ctx, source); err != nil {
 			return err
 		}
+		if err := s.client.SourceHealthStore.Delete(ctx, source.ID); err != nil {
+			return err
+		}
+		if err := s.client.AlertEventsStore.Delete(ctx, source.ID); err != nil {
+			return err
+		}
+		if err := s.client.AlertWebhooksStore.DeleteSource(ctx, source.ID); err != nil {
+			return err
+		}
+		if err := s.client.AlertChecksStore.DeleteSource(ctx, source.ID); err != nil {
+			return err
+		}
+		if err := s.client.AlertPoliciesStore.DeleteSource(ctx, source.ID); err != nil {
+			return err
+		}
 	}
 
-	serversStore := organizations.NewServersStore(s.client.ServersStore, o.ID)
+	serversStore := organizations.NewServersStore(s.servers(), o.ID)
 	servers, err := serversStore.All(ctx)
 	if err != nil {
 		return err
@@ -183,7 +255,7 @@ func (s *OrganizationsStore) Delete(ctx context.Context, o *chronograf.Organizat
 		}
 	}
 
-	dashboardsStore := organizations.NewDashboardsStore(s.client.DashboardsStore, o.ID)
+	dashboardsStore := organizations.NewDashboardsStore(s.dashboards(), o.ID)
 	dashboards, err := dashboardsStore.All(ctx)
 	if err != nil {
 		return err
@@ -192,9 +264,15 @@ func (s *OrganizationsStore) Delete(ctx context.Context, o *chronograf.Organizat
 		if err := dashboardsStore.Delete(ctx, dashboard); err != nil {
 			return err
 		}
+		if err := s.client.DashboardVersionsStore.Delete(ctx, dashboard.ID); err != nil {
+			return err
+		}
+		if err := s.client.DashboardSnapshotsStore.DeleteAll(ctx, dashboard.ID); err != nil {
+			return err
+		}
 	}
 
-	usersStore := organizations.NewUsersStore(s.client.UsersStore, o.ID)
+	usersStore := organizations.NewUsersStore(s.users(), o.ID)
 	users, err := usersStore.All(ctx)
 	if err != nil {
 		return err
@@ -205,6 +283,78 @@ func (s *OrganizationsStore) Delete(ctx context.Context, o *chronograf.Organizat
 		}
 	}
 
+	tokensStore := organizations.NewTokensStore(s.client.TokensStore, o.ID)
+	tokens, err := tokensStore.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, token := range tokens {
+		if err := tokensStore.Delete(ctx, &token); err != nil {
+			return err
+		}
+	}
+
+	invitationsStore := organizations.NewInvitationsStore(s.client.InvitationsStore, o.ID)
+	invitations, err := invitationsStore.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, invitation := range invitations {
+		if err := invitationsStore.Delete(ctx, &invitation); err != nil {
+			return err
+		}
+	}
+
+	foldersStore := organizations.NewFoldersStore(s.client.FoldersStore, o.ID)
+	folders, err := foldersStore.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, folder := range folders {
+		if err := foldersStore.Delete(ctx, &folder); err != nil {
+			return err
+		}
+	}
+
+	reportsStore := organizations.NewReportsStore(s.client.ReportsStore, o.ID)
+	reports, err := reportsStore.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, report := range reports {
+		if err := reportsStore.Delete(ctx, &report); err != nil {
+			return err
+		}
+	}
+
+	ruleTemplatesStore := organizations.NewRuleTemplatesStore(s.client.RuleTemplatesStore, o.ID)
+	templates, err := ruleTemplatesStore.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, template := range templates {
+		if err := ruleTemplatesStore.Delete(ctx, &template); err != nil {
+			return err
+		}
+	}
+
+	annotationsStore := organizations.NewAnnotationsStore(s.client.AnnotationsStore, o.ID)
+	annotations, err := annotationsStore.All(ctx, time.Unix(0, math.MinInt64), time.Unix(0, math.MaxInt64))
+	if err != nil {
+		return err
+	}
+	for _, annotation := range annotations {
+		if err := annotationsStore.Delete(ctx, annotation.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := s.client.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(OrganizationConfigBucket).Delete([]byte(o.ID))
+	}); err != nil {
+		return err
+	}
+
 	mappings, err := s.client.MappingsStore.All(ctx)
 	if err != nil {
 		return err
@@ -255,6 +405,9 @@ func (s *OrganizationsStore) each(ctx context.Context, fn func(*chronograf.Organ
 // If Name is provided, the lookup time will be O(n).
 // Get expects that only one of ID or Name will be specified, but will prefer ID over Name if both are specified.
 func (s *OrganizationsStore) Get(ctx context.Context, q chronograf.OrganizationQuery) (*chronograf.Organization, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	if q.ID != nil {
 		return s.get(ctx, *q.ID)
 	}
@@ -286,6 +439,9 @@ func (s *OrganizationsStore) Get(ctx context.Context, q chronograf.OrganizationQ
 
 // Update the organization in OrganizationsStore
 func (s *OrganizationsStore) Update(ctx context.Context, o *chronograf.Organization) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	org, err := s.get(ctx, o.ID)
 	if err != nil {
 		return err
@@ -487,6 +487,43 @@ func TestOrganizationStore_Delete(t *testing.T) {
 	}
 }
 
+func TestOrganizationStore_DeleteDependents(t *testing.T) {
+	client, err := NewTestClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	org, err := client.OrganizationsStore.Add(ctx, &chronograf.Organization{Name: "The Deleted Place"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, o := range []string{org.ID, "default"} {
+		if _, err := client.TokensStore.Add(ctx, &chronograf.Token{Name: "ci", Organization: o, Role: "viewer", Hash: o}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := client.OrganizationConfigStore.FindOrCreate(ctx, org.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.OrganizationsStore.Delete(ctx, org); err != nil {
+		t.Fatal(err)
+	}
+
+	tokens, err := client.TokensStore.All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tokens) != 1 || tokens[0].Organization != "default" {
+		t.Errorf("OrganizationsStore.Delete() left tokens %v", tokens)
+	}
+	if _, err := client.OrganizationConfigStore.Get(ctx, org.ID); err != chronograf.ErrOrganizationConfigNotFound {
+		t.Errorf("OrganizationsStore.Delete() left the organization config: %v", err)
+	}
+}
+
 func TestOrganizationStore_DeleteDefaultOrg(t *testing.T) {
 	type args struct {
 		ctx context.Context
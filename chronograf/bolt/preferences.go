@@ -0,0 +1,65 @@
+package bolt
+
+import (
+	"context"
+
+	bolt "github.com/coreos/bbolt"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt/internal"
+	"github.com/influxdata/influxdb/kit/tracing"
+)
+
+// Ensure PreferencesStore implements chronograf.PreferencesStore.
+var _ chronograf.PreferencesStore = &PreferencesStore{}
+
+var (
+	// PreferencesBucket is the bucket where the preferences of users are stored.
+	PreferencesBucket = []byte("preferencesv1")
+)
+
+// PreferencesStore uses bolt to store and retrieve the preferences of users
+// keyed by user ID
+type PreferencesStore struct {
+	client *Client
+}
+
+// Migrate is a noop as there is no previous schema of preferences
+func (s *PreferencesStore) Migrate(ctx context.Context) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return nil
+}
+
+// Get retrieves the preferences of a user
+func (s *PreferencesStore) Get(ctx context.Context, userID uint64) (*chronograf.Preferences, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	var p chronograf.Preferences
+	if err := s.client.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(PreferencesBucket).Get(u64tob(userID))
+		if v == nil {
+			return chronograf.ErrPreferencesNotFound
+		}
+		return internal.UnmarshalPreferences(v, &p)
+	}); err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+// Put replaces the preferences of a user, creating them if needed
+func (s *PreferencesStore) Put(ctx context.Context, p *chronograf.Preferences) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		v, err := internal.MarshalPreferences(p)
+		if err != nil {
+			return err
+		}
+		return tx.Bucket(PreferencesBucket).Put(u64tob(p.UserID), v)
+	})
+}
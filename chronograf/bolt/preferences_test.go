@@ -0,0 +1,66 @@
+package bolt_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func TestPreferencesStore(t *testing.T) {
+	client, err := NewTestClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	s := client.PreferencesStore
+
+	if _, err := s.Get(ctx, 1); err != chronograf.ErrPreferencesNotFound {
+		t.Fatalf("Get() of a user without preferences error = %v, want %v", err, chronograf.ErrPreferencesNotFound)
+	}
+
+	want := &chronograf.Preferences{
+		UserID:            1,
+		DisplayName:       "Bob",
+		Timezone:          "America/New_York",
+		DefaultDashboard:  2,
+		Theme:             chronograf.DarkTheme,
+		StarredDashboards: []chronograf.DashboardID{2, 5},
+		RecentDashboards: []chronograf.DashboardView{
+			{DashboardID: 5, ViewedAt: time.Date(2018, 1, 1, 9, 0, 0, 0, time.UTC)},
+			{DashboardID: 3, ViewedAt: time.Date(2018, 1, 1, 8, 0, 0, 0, time.UTC)},
+		},
+	}
+	if err := s.Put(ctx, want); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if err := s.Put(ctx, &chronograf.Preferences{UserID: 2, Theme: chronograf.LightTheme}); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	got, err := s.Get(ctx, 1)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Get() diff (-got +want):\n%s", diff)
+	}
+
+	want.Theme = chronograf.LightTheme
+	want.DefaultDashboard = 0
+	want.StarredDashboards = nil
+	if err := s.Put(ctx, want); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	got, err = s.Get(ctx, 1)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Get() after replacing diff (-got +want):\n%s", diff)
+	}
+}
@@ -0,0 +1,126 @@
+package bolt
+
+import (
+	"context"
+
+	bolt "github.com/coreos/bbolt"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt/internal"
+	"github.com/influxdata/influxdb/kit/tracing"
+)
+
+// Ensure QueryHistoryStore implements chronograf.QueryHistoryStore.
+var _ chronograf.QueryHistoryStore = &QueryHistoryStore{}
+
+var (
+	// QueryHistoryBucket is the bucket where the queries of users are
+	// stored. It holds a nested bucket of queries for each user.
+	QueryHistoryBucket = []byte("queryhistoryv1")
+)
+
+// DefaultQueryHistory is the number of queries kept for each user
+const DefaultQueryHistory = 1000
+
+// QueryHistoryStore uses bolt to store and retrieve the queries run by users
+type QueryHistoryStore struct {
+	client *Client
+	// History is the number of queries kept for each user; the oldest
+	// queries are discarded first
+	History int
+}
+
+// Migrate is a noop as there is no previous schema of query history
+func (s *QueryHistoryStore) Migrate(ctx context.Context) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return nil
+}
+
+// All returns the queries of a user, oldest first
+func (s *QueryHistoryStore) All(ctx context.Context, userID uint64) ([]chronograf.QueryHistoryEntry, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	queries := []chronograf.QueryHistoryEntry{}
+	err := s.client.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(QueryHistoryBucket).Bucket(u64tob(userID))
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			var q chronograf.QueryHistoryEntry
+			if err := internal.UnmarshalQueryHistoryEntry(v, &q); err != nil {
+				return err
+			}
+			queries = append(queries, q)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return queries, nil
+}
+
+// Add records the query q of a user, discarding their oldest queries beyond
+// the history of the store
+func (s *QueryHistoryStore) Add(ctx context.Context, q *chronograf.QueryHistoryEntry) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.Bucket(QueryHistoryBucket).CreateBucketIfNotExists(u64tob(q.UserID))
+		if err != nil {
+			return err
+		}
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		if q.RanAt.IsZero() {
+			q.RanAt = s.client.Now().UTC()
+		}
+
+		data, err := internal.MarshalQueryHistoryEntry(q)
+		if err != nil {
+			return err
+		}
+		if err := b.Put(u64tob(seq), data); err != nil {
+			return err
+		}
+
+		history := s.History
+		if history <= 0 {
+			history = DefaultQueryHistory
+		}
+		var keys [][]byte
+		if err := b.ForEach(func(k, v []byte) error {
+			keys = append(keys, k)
+			return nil
+		}); err != nil {
+			return err
+		}
+		for i := 0; i < len(keys)-history; i++ {
+			if err := b.Delete(keys[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Delete removes the queries of a user
+func (s *QueryHistoryStore) Delete(ctx context.Context, userID uint64) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		err := tx.Bucket(QueryHistoryBucket).DeleteBucket(u64tob(userID))
+		if err == bolt.ErrBucketNotFound {
+			return nil
+		}
+		return err
+	})
+}
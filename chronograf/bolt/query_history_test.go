@@ -0,0 +1,86 @@
+package bolt_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func TestQueryHistoryStore(t *testing.T) {
+	client, err := NewTestClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	s := client.QueryHistoryStore
+	s.History = 2
+
+	queries := []chronograf.QueryHistoryEntry{
+		{
+			UserID:       1,
+			SourceID:     1,
+			Organization: "default",
+			Query:        "SELECT mean(usage_user) FROM cpu WHERE time > now() - 1h",
+			DB:           "telegraf",
+			Duration:     20 * time.Millisecond,
+			Status:       chronograf.QuerySuccess,
+		},
+		{
+			UserID:       1,
+			SourceID:     2,
+			Organization: "default",
+			Query:        "SELECT used FROM mem",
+			DB:           "telegraf",
+			RP:           "autogen",
+			Duration:     time.Second,
+			Status:       chronograf.QueryError,
+			Error:        "timeout",
+		},
+		{
+			UserID:       1,
+			SourceID:     1,
+			Organization: "default",
+			Query:        "SHOW DATABASES",
+			Status:       chronograf.QuerySuccess,
+		},
+		{
+			UserID:       2,
+			SourceID:     1,
+			Organization: "default",
+			Query:        "SHOW MEASUREMENTS",
+			Status:       chronograf.QuerySuccess,
+		},
+	}
+	for i := range queries {
+		if err := s.Add(ctx, &queries[i]); err != nil {
+			t.Fatalf("Add() error = %v", err)
+		}
+		if !queries[i].RanAt.Equal(TestNow) {
+			t.Errorf("Add() RanAt = %v, want %v", queries[i].RanAt, TestNow)
+		}
+	}
+
+	// Only the last two queries of user 1 are kept
+	got, err := s.All(ctx, 1)
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	if diff := cmp.Diff(got, queries[1:3]); diff != "" {
+		t.Errorf("All() diff (-got +want):\n%s", diff)
+	}
+
+	if err := s.Delete(ctx, 1); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if got, err := s.All(ctx, 1); err != nil || len(got) != 0 {
+		t.Errorf("All() after Delete() = %#v, %v", got, err)
+	}
+	if got, err := s.All(ctx, 2); err != nil || len(got) != 1 {
+		t.Errorf("All() of another user = %#v, %v", got, err)
+	}
+}
@@ -0,0 +1,130 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+
+	bolt "github.com/coreos/bbolt"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt/internal"
+	"github.com/influxdata/influxdb/kit/tracing"
+)
+
+// Ensure ReportsStore implements chronograf.ReportsStore.
+var _ chronograf.ReportsStore = &ReportsStore{}
+
+var (
+	// ReportsBucket is the bucket where scheduled reports are stored.
+	ReportsBucket = []byte("reportsv1")
+)
+
+// ReportsStore uses bolt to store and retrieve scheduled reports
+type ReportsStore struct {
+	client *Client
+}
+
+// Migrate is a noop as there is no previous schema of reports
+func (s *ReportsStore) Migrate(ctx context.Context) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return nil
+}
+
+// All returns all reports
+func (s *ReportsStore) All(ctx context.Context) ([]chronograf.Report, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	reports := []chronograf.Report{}
+	err := s.client.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(ReportsBucket).ForEach(func(k, v []byte) error {
+			var r chronograf.Report
+			if err := internal.UnmarshalReport(v, &r); err != nil {
+				return err
+			}
+			reports = append(reports, r)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reports, nil
+}
+
+// Add creates a new report in the ReportsStore
+func (s *ReportsStore) Add(ctx context.Context, r *chronograf.Report) (*chronograf.Report, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	if err := s.client.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(ReportsBucket)
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		r.ID = fmt.Sprintf("%d", seq)
+
+		v, err := internal.MarshalReport(r)
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(r.ID), v)
+	}); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Delete the report from the ReportsStore
+func (s *ReportsStore) Delete(ctx context.Context, r *chronograf.Report) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	if _, err := s.Get(ctx, r.ID); err != nil {
+		return err
+	}
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(ReportsBucket).Delete([]byte(r.ID))
+	})
+}
+
+// Get retrieves a report by ID
+func (s *ReportsStore) Get(ctx context.Context, id string) (*chronograf.Report, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	var r chronograf.Report
+	if err := s.client.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(ReportsBucket).Get([]byte(id))
+		if v == nil {
+			return chronograf.ErrReportNotFound
+		}
+		return internal.UnmarshalReport(v, &r)
+	}); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// Update replaces the report information
+func (s *ReportsStore) Update(ctx context.Context, r *chronograf.Report) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(ReportsBucket)
+		if v := b.Get([]byte(r.ID)); v == nil {
+			return chronograf.ErrReportNotFound
+		}
+		v, err := internal.MarshalReport(r)
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(r.ID), v)
+	})
+}
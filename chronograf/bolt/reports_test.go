@@ -0,0 +1,85 @@
+package bolt_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func TestReportsStore(t *testing.T) {
+	client, err := NewTestClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	s := client.ReportsStore
+
+	start := time.Date(2018, 1, 1, 9, 0, 0, 0, time.UTC)
+	capacity := &chronograf.Report{
+		Name:         "Weekly capacity",
+		DashboardID:  1,
+		Source:       "1",
+		Start:        start,
+		Every:        7 * 24 * time.Hour,
+		Range:        7 * 24 * time.Hour,
+		Recipients:   []string{"ops@example.com"},
+		Format:       chronograf.ReportFormatHTML,
+		Organization: "default",
+		NextRun:      start,
+	}
+	if _, err := s.Add(ctx, capacity); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	errors := &chronograf.Report{
+		Name:         "Daily errors",
+		DashboardID:  2,
+		Start:        start,
+		Every:        24 * time.Hour,
+		Range:        time.Hour,
+		Recipients:   []string{"dev@example.com", "ops@example.com"},
+		Format:       chronograf.ReportFormatPNG,
+		Organization: "default",
+		NextRun:      start,
+	}
+	if _, err := s.Add(ctx, errors); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+
+	got, err := s.All(ctx)
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	want := []chronograf.Report{*capacity, *errors}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("All() diff (-got +want):\n%s", diff)
+	}
+
+	capacity.LastRun = start
+	capacity.NextRun = start.Add(capacity.Every)
+	capacity.LastError = "unable to deliver report"
+	if err := s.Update(ctx, capacity); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	r, err := s.Get(ctx, capacity.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if diff := cmp.Diff(r, capacity); diff != "" {
+		t.Errorf("Get() after Update() diff (-got +want):\n%s", diff)
+	}
+
+	if err := s.Delete(ctx, capacity); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := s.Get(ctx, capacity.ID); err != chronograf.ErrReportNotFound {
+		t.Errorf("Get() after Delete() error = %v, want %v", err, chronograf.ErrReportNotFound)
+	}
+	if err := s.Update(ctx, capacity); err != chronograf.ErrReportNotFound {
+		t.Errorf("Update() of a removed report error = %v, want %v", err, chronograf.ErrReportNotFound)
+	}
+}
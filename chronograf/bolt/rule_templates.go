@@ -0,0 +1,130 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+
+	bolt "github.com/coreos/bbolt"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt/internal"
+	"github.com/influxdata/influxdb/kit/tracing"
+)
+
+// Ensure RuleTemplatesStore implements chronograf.RuleTemplatesStore.
+var _ chronograf.RuleTemplatesStore = &RuleTemplatesStore{}
+
+var (
+	// RuleTemplatesBucket is the bucket where alert rule templates are stored.
+	RuleTemplatesBucket = []byte("ruletemplatesv1")
+)
+
+// RuleTemplatesStore uses bolt to store and retrieve alert rule templates
+type RuleTemplatesStore struct {
+	client *Client
+}
+
+// Migrate is a noop as there is no previous schema of rule templates
+func (s *RuleTemplatesStore) Migrate(ctx context.Context) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return nil
+}
+
+// All returns all rule templates
+func (s *RuleTemplatesStore) All(ctx context.Context) ([]chronograf.RuleTemplate, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	templates := []chronograf.RuleTemplate{}
+	err := s.client.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(RuleTemplatesBucket).ForEach(func(k, v []byte) error {
+			var t chronograf.RuleTemplate
+			if err := internal.UnmarshalRuleTemplate(v, &t); err != nil {
+				return err
+			}
+			templates = append(templates, t)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+// Add creates a new rule template in the RuleTemplatesStore
+func (s *RuleTemplatesStore) Add(ctx context.Context, t *chronograf.RuleTemplate) (*chronograf.RuleTemplate, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	if err := s.client.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(RuleTemplatesBucket)
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		t.ID = fmt.Sprintf("%d", seq)
+
+		v, err := internal.MarshalRuleTemplate(t)
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(t.ID), v)
+	}); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// Delete the rule template from the RuleTemplatesStore
+func (s *RuleTemplatesStore) Delete(ctx context.Context, t *chronograf.RuleTemplate) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	if _, err := s.Get(ctx, t.ID); err != nil {
+		return err
+	}
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(RuleTemplatesBucket).Delete([]byte(t.ID))
+	})
+}
+
+// Get retrieves a rule template by ID
+func (s *RuleTemplatesStore) Get(ctx context.Context, id string) (*chronograf.RuleTemplate, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	var t chronograf.RuleTemplate
+	if err := s.client.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(RuleTemplatesBucket).Get([]byte(id))
+		if v == nil {
+			return chronograf.ErrRuleTemplateNotFound
+		}
+		return internal.UnmarshalRuleTemplate(v, &t)
+	}); err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// Update replaces the rule template information
+func (s *RuleTemplatesStore) Update(ctx context.Context, t *chronograf.RuleTemplate) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(RuleTemplatesBucket)
+		if v := b.Get([]byte(t.ID)); v == nil {
+			return chronograf.ErrRuleTemplateNotFound
+		}
+		v, err := internal.MarshalRuleTemplate(t)
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(t.ID), v)
+	})
+}
@@ -0,0 +1,90 @@
+package bolt_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func TestRuleTemplatesStore(t *testing.T) {
+	client, err := NewTestClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	s := client.RuleTemplatesStore
+
+	cpu := &chronograf.RuleTemplate{
+		Name:        "High CPU",
+		Description: "CPU usage of a host",
+		Params: []chronograf.RuleTemplateParam{
+			{Name: "host", Description: "Host to check"},
+			{Name: "threshold", Default: "90"},
+		},
+		CheckName: "high_cpu_:host:",
+		Query:     `SELECT mean("usage_user") FROM "cpu" WHERE "host" = ':host:' AND time > now() - 5m`,
+		DB:        "telegraf",
+		RP:        "autogen",
+		Every:     time.Minute,
+		Operator:  ">",
+		Thresholds: []chronograf.RuleTemplateThreshold{
+			{Level: "CRITICAL", Value: ":threshold:"},
+			{Level: "WARNING", Value: "75"},
+		},
+		Message:      "{{ .ID }} is {{ .Level }}",
+		Organization: "default",
+	}
+	if _, err := s.Add(ctx, cpu); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	disk := &chronograf.RuleTemplate{
+		Name:         "Disk full",
+		Params:       []chronograf.RuleTemplateParam{},
+		CheckName:    "disk",
+		Query:        `SELECT last("used_percent") FROM "disk"`,
+		Every:        5 * time.Minute,
+		Operator:     ">=",
+		Thresholds:   []chronograf.RuleTemplateThreshold{},
+		Organization: "other",
+	}
+	if _, err := s.Add(ctx, disk); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+
+	got, err := s.All(ctx)
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	want := []chronograf.RuleTemplate{*cpu, *disk}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("All() diff (-got +want):\n%s", diff)
+	}
+
+	cpu.Thresholds = cpu.Thresholds[:1]
+	cpu.Params[1].Default = "95"
+	if err := s.Update(ctx, cpu); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	tmpl, err := s.Get(ctx, cpu.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if diff := cmp.Diff(tmpl, cpu); diff != "" {
+		t.Errorf("Get() after Update() diff (-got +want):\n%s", diff)
+	}
+
+	if err := s.Delete(ctx, cpu); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := s.Get(ctx, cpu.ID); err != chronograf.ErrRuleTemplateNotFound {
+		t.Errorf("Get() after Delete() error = %v, want %v", err, chronograf.ErrRuleTemplateNotFound)
+	}
+	if err := s.Update(ctx, cpu); err != chronograf.ErrRuleTemplateNotFound {
+		t.Errorf("Update() of a removed rule template error = %v, want %v", err, chronograf.ErrRuleTemplateNotFound)
+	}
+}
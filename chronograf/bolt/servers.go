@@ -6,6 +6,7 @@ import (
 	bolt "github.com/coreos/bbolt"
 	"github.com/influxdata/influxdb/chronograf"
 	"github.com/influxdata/influxdb/chronograf/bolt/internal"
+	"github.com/influxdata/influxdb/kit/tracing"
 )
 
 // Ensure ServersStore implements chronograf.ServersStore.
@@ -21,6 +22,9 @@ type ServersStore struct {
 }
 
 func (s *ServersStore) Migrate(ctx context.Context) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	servers, err := s.All(ctx)
 	if err != nil {
 		return err
@@ -45,6 +49,9 @@ func (s *ServersStore) Migrate(ctx context.Context) error {
 
 // All returns all known servers
 func (s *ServersStore) All(ctx context.Context) ([]chronograf.Server, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	var srcs []chronograf.Server
 	if err := s.client.db.View(func(tx *bolt.Tx) error {
 		var err error
@@ -63,6 +70,9 @@ func (s *ServersStore) All(ctx context.Context) ([]chronograf.Server, error) {
 
 // Add creates a new Server in the ServerStore.
 func (s *ServersStore) Add(ctx context.Context, src chronograf.Server) (chronograf.Server, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	if err := s.client.db.Update(func(tx *bolt.Tx) error {
 		b := tx.Bucket(ServersBucket)
 		seq, err := b.NextSequence()
@@ -90,6 +100,9 @@ func (s *ServersStore) Add(ctx context.Context, src chronograf.Server) (chronogr
 
 // Delete removes the Server from the ServersStore
 func (s *ServersStore) Delete(ctx context.Context, src chronograf.Server) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	if err := s.client.db.Update(func(tx *bolt.Tx) error {
 		if err := tx.Bucket(ServersBucket).Delete(itob(src.ID)); err != nil {
 			return err
@@ -104,6 +117,9 @@ func (s *ServersStore) Delete(ctx context.Context, src chronograf.Server) error
 
 // Get returns a Server if the id exists.
 func (s *ServersStore) Get(ctx context.Context, id int) (chronograf.Server, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	var src chronograf.Server
 	if err := s.client.db.View(func(tx *bolt.Tx) error {
 		if v := tx.Bucket(ServersBucket).Get(itob(id)); v == nil {
@@ -121,6 +137,9 @@ func (s *ServersStore) Get(ctx context.Context, id int) (chronograf.Server, erro
 
 // Update a Server
 func (s *ServersStore) Update(ctx context.Context, src chronograf.Server) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	if err := s.client.db.Update(func(tx *bolt.Tx) error {
 		// Get an existing server with the same ID.
 		b := tx.Bucket(ServersBucket)
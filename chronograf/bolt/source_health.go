@@ -0,0 +1,128 @@
+package bolt
+
+import (
+	"context"
+
+	bolt "github.com/coreos/bbolt"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt/internal"
+	"github.com/influxdata/influxdb/kit/tracing"
+)
+
+// Ensure SourceHealthStore implements chronograf.SourceHealthStore.
+var _ chronograf.SourceHealthStore = &SourceHealthStore{}
+
+var (
+	// SourceHealthBucket is the bucket where health checks are stored. It
+	// holds a nested bucket of checks for each source.
+	SourceHealthBucket = []byte("sourcehealthv1")
+)
+
+// DefaultSourceHealthHistory is the number of health checks kept for each
+// source and its Kapacitors
+const DefaultSourceHealthHistory = 1000
+
+// SourceHealthStore uses bolt to store and retrieve the health checks of
+// sources and their Kapacitors
+type SourceHealthStore struct {
+	client *Client
+	// History is the number of checks kept for each source; the oldest
+	// checks are discarded first
+	History int
+}
+
+// Migrate is a noop as there is no previous schema of health checks
+func (s *SourceHealthStore) Migrate(ctx context.Context) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return nil
+}
+
+// All returns the health checks of a source and its Kapacitors, oldest first
+func (s *SourceHealthStore) All(ctx context.Context, sourceID int) ([]chronograf.SourceHealth, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	checks := []chronograf.SourceHealth{}
+	err := s.client.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(SourceHealthBucket).Bucket(itob(sourceID))
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			var check chronograf.SourceHealth
+			if err := internal.UnmarshalSourceHealth(v, &check); err != nil {
+				return err
+			}
+			checks = append(checks, check)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return checks, nil
+}
+
+// Add records the health check h of a source, discarding its oldest checks
+// beyond the history of the store
+func (s *SourceHealthStore) Add(ctx context.Context, h *chronograf.SourceHealth) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.Bucket(SourceHealthBucket).CreateBucketIfNotExists(itob(h.SourceID))
+		if err != nil {
+			return err
+		}
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		if h.CheckedAt.IsZero() {
+			h.CheckedAt = s.client.Now().UTC()
+		}
+
+		data, err := internal.MarshalSourceHealth(h)
+		if err != nil {
+			return err
+		}
+		if err := b.Put(itob(int(seq)), data); err != nil {
+			return err
+		}
+
+		history := s.History
+		if history <= 0 {
+			history = DefaultSourceHealthHistory
+		}
+		var keys [][]byte
+		if err := b.ForEach(func(k, v []byte) error {
+			keys = append(keys, k)
+			return nil
+		}); err != nil {
+			return err
+		}
+		for i := 0; i < len(keys)-history; i++ {
+			if err := b.Delete(keys[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Delete removes the health checks of a source
+func (s *SourceHealthStore) Delete(ctx context.Context, sourceID int) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		err := tx.Bucket(SourceHealthBucket).DeleteBucket(itob(sourceID))
+		if err == bolt.ErrBucketNotFound {
+			return nil
+		}
+		return err
+	})
+}
@@ -0,0 +1,80 @@
+package bolt_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func TestSourceHealthStore(t *testing.T) {
+	client, err := NewTestClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	s := client.SourceHealthStore
+	s.History = 2
+
+	checks := []chronograf.SourceHealth{
+		{
+			SourceID: 1,
+			Status:   chronograf.HealthDown,
+			Latency:  time.Second,
+			Error:    "connection refused",
+		},
+		{
+			SourceID: 1,
+			Status:   chronograf.HealthUp,
+			Version:  "1.7.8",
+			Build:    chronograf.InfluxDB,
+			Latency:  5 * time.Millisecond,
+		},
+		{
+			SourceID: 1,
+			ServerID: 3,
+			Status:   chronograf.HealthDegraded,
+			Version:  "1.5.2",
+			Build:    "OSS",
+			Latency:  2 * time.Second,
+		},
+		{
+			SourceID: 2,
+			Status:   chronograf.HealthUp,
+		},
+	}
+	for i := range checks {
+		if err := s.Add(ctx, &checks[i]); err != nil {
+			t.Fatalf("Add() error = %v", err)
+		}
+		if !checks[i].CheckedAt.Equal(TestNow) {
+			t.Errorf("Add() CheckedAt = %v, want %v", checks[i].CheckedAt, TestNow)
+		}
+	}
+
+	// Only the last two checks of source 1 are kept
+	got, err := s.All(ctx, 1)
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	if diff := cmp.Diff(got, checks[1:3]); diff != "" {
+		t.Errorf("All() diff (-got +want):\n%s", diff)
+	}
+
+	if err := s.Delete(ctx, 1); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if got, err := s.All(ctx, 1); err != nil || len(got) != 0 {
+		t.Errorf("All() after Delete() = %#v, %v", got, err)
+	}
+	if err := s.Delete(ctx, 1); err != nil {
+		t.Errorf("Delete() of removed checks error = %v", err)
+	}
+	if got, err := s.All(ctx, 2); err != nil || len(got) != 1 {
+		t.Errorf("All() of another source = %#v, %v", got, err)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/influxdata/influxdb/chronograf"
 	"github.com/influxdata/influxdb/chronograf/bolt/internal"
 	"github.com/influxdata/influxdb/chronograf/roles"
+	"github.com/influxdata/influxdb/kit/tracing"
 )
 
 // Ensure SourcesStore implements chronograf.SourcesStore.
@@ -32,6 +33,9 @@ type SourcesStore struct {
 
 // Migrate adds the default source to an existing boltdb.
 func (s *SourcesStore) Migrate(ctx context.Context) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	sources, err := s.All(ctx)
 	if err != nil {
 		return err
@@ -64,6 +68,9 @@ func (s *SourcesStore) Migrate(ctx context.Context) error {
 
 // All returns all known sources
 func (s *SourcesStore) All(ctx context.Context) ([]chronograf.Source, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	var srcs []chronograf.Source
 	if err := s.client.db.View(func(tx *bolt.Tx) error {
 		var err error
@@ -82,6 +89,8 @@ func (s *SourcesStore) All(ctx context.Context) ([]chronograf.Source, error) {
 
 // Add creates a new Source in the SourceStore.
 func (s *SourcesStore) Add(ctx context.Context, src chronograf.Source) (chronograf.Source, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
 
 	// force first source added to be default
 	if srcs, err := s.All(ctx); err != nil {
@@ -101,6 +110,9 @@ func (s *SourcesStore) Add(ctx context.Context, src chronograf.Source) (chronogr
 
 // Delete removes the Source from the SourcesStore
 func (s *SourcesStore) Delete(ctx context.Context, src chronograf.Source) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	if err := s.client.db.Update(func(tx *bolt.Tx) error {
 		if err := s.setRandomDefault(ctx, src, tx); err != nil {
 			return err
@@ -115,6 +127,9 @@ func (s *SourcesStore) Delete(ctx context.Context, src chronograf.Source) error
 
 // Get returns a Source if the id exists.
 func (s *SourcesStore) Get(ctx context.Context, id int) (chronograf.Source, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	var src chronograf.Source
 	if err := s.client.db.View(func(tx *bolt.Tx) error {
 		var err error
@@ -132,6 +147,9 @@ func (s *SourcesStore) Get(ctx context.Context, id int) (chronograf.Source, erro
 
 // Update a Source
 func (s *SourcesStore) Update(ctx context.Context, src chronograf.Source) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	if err := s.client.db.Update(func(tx *bolt.Tx) error {
 		return s.update(ctx, src, tx)
 	}); err != nil {
@@ -158,6 +176,9 @@ func (s *SourcesStore) all(ctx context.Context, tx *bolt.Tx) ([]chronograf.Sourc
 
 // Put updates the source.
 func (s *SourcesStore) Put(ctx context.Context, src *chronograf.Source) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	return s.client.db.Update(func(tx *bolt.Tx) error {
 		return s.put(ctx, src, tx)
 	})
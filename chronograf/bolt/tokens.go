@@ -0,0 +1,149 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+
+	bolt "github.com/coreos/bbolt"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt/internal"
+	"github.com/influxdata/influxdb/kit/tracing"
+)
+
+// Ensure TokensStore implements chronograf.TokensStore.
+var _ chronograf.TokensStore = &TokensStore{}
+
+var (
+	// TokensBucket is the bucket where API tokens are stored.
+	TokensBucket = []byte("tokensv1")
+)
+
+// TokensStore uses bolt to store and retrieve API tokens
+type TokensStore struct {
+	client *Client
+}
+
+// Migrate is a noop as there is no previous schema of tokens
+func (s *TokensStore) Migrate(ctx context.Context) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return nil
+}
+
+// All returns all known tokens
+func (s *TokensStore) All(ctx context.Context) ([]chronograf.Token, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	tokens := []chronograf.Token{}
+	err := s.client.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(TokensBucket).ForEach(func(k, v []byte) error {
+			var t chronograf.Token
+			if err := internal.UnmarshalToken(v, &t); err != nil {
+				return err
+			}
+			tokens = append(tokens, t)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+// Add creates a new token in the TokensStore
+func (s *TokensStore) Add(ctx context.Context, t *chronograf.Token) (*chronograf.Token, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	if err := s.client.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(TokensBucket)
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		t.ID = fmt.Sprintf("%d", seq)
+		if t.CreatedAt.IsZero() {
+			t.CreatedAt = s.client.Now().UTC()
+		}
+
+		v, err := internal.MarshalToken(t)
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(t.ID), v)
+	}); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// Delete the token from the TokensStore
+func (s *TokensStore) Delete(ctx context.Context, t *chronograf.Token) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	if _, err := s.Get(ctx, chronograf.TokenQuery{ID: &t.ID}); err != nil {
+		return err
+	}
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(TokensBucket).Delete([]byte(t.ID))
+	})
+}
+
+// Get retrieves a token by ID or by the hash of its secret
+func (s *TokensStore) Get(ctx context.Context, q chronograf.TokenQuery) (*chronograf.Token, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	var t chronograf.Token
+	if q.ID != nil {
+		if err := s.client.db.View(func(tx *bolt.Tx) error {
+			v := tx.Bucket(TokensBucket).Get([]byte(*q.ID))
+			if v == nil {
+				return chronograf.ErrTokenNotFound
+			}
+			return internal.UnmarshalToken(v, &t)
+		}); err != nil {
+			return nil, err
+		}
+		return &t, nil
+	}
+
+	if q.Hash != nil {
+		tokens, err := s.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range tokens {
+			if t.Hash == *q.Hash {
+				return &t, nil
+			}
+		}
+		return nil, chronograf.ErrTokenNotFound
+	}
+
+	return nil, fmt.Errorf("must specify either ID, or Hash in TokenQuery")
+}
+
+// Update replaces the token in the TokensStore
+func (s *TokensStore) Update(ctx context.Context, t *chronograf.Token) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(TokensBucket)
+		if v := b.Get([]byte(t.ID)); v == nil {
+			return chronograf.ErrTokenNotFound
+		}
+		v, err := internal.MarshalToken(t)
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(t.ID), v)
+	})
+}
@@ -0,0 +1,88 @@
+package bolt_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func TestTokensStore(t *testing.T) {
+	client, err := NewTestClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	s := client.TokensStore
+
+	expiresAt := TestNow.Add(24 * time.Hour)
+	grafana := &chronograf.Token{
+		Name:         "grafana",
+		Organization: "default",
+		Role:         "viewer",
+		Scopes:       []string{"dashboards"},
+		Hash:         "aaaa",
+		CreatedBy:    1,
+		ExpiresAt:    &expiresAt,
+	}
+	ci := &chronograf.Token{
+		Name:         "ci",
+		Organization: "default",
+		Role:         "editor",
+		Scopes:       []string{},
+		Hash:         "bbbb",
+		CreatedBy:    1,
+	}
+	for _, tok := range []*chronograf.Token{grafana, ci} {
+		if _, err := s.Add(ctx, tok); err != nil {
+			t.Fatalf("Add() error = %v", err)
+		}
+	}
+	if !ci.CreatedAt.Equal(TestNow) {
+		t.Errorf("Add() CreatedAt = %v, want %v", ci.CreatedAt, TestNow)
+	}
+
+	got, err := s.All(ctx)
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	want := []chronograf.Token{*grafana, *ci}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("All() diff (-got +want):\n%s", diff)
+	}
+
+	hash := "bbbb"
+	tok, err := s.Get(ctx, chronograf.TokenQuery{Hash: &hash})
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if tok.ID != ci.ID {
+		t.Errorf("Get() by hash = %s, want %s", tok.ID, ci.ID)
+	}
+
+	grafana.Name = "grafana-dashboards"
+	if err := s.Update(ctx, grafana); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	tok, err = s.Get(ctx, chronograf.TokenQuery{ID: &grafana.ID})
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if diff := cmp.Diff(tok, grafana); diff != "" {
+		t.Errorf("Get() diff (-got +want):\n%s", diff)
+	}
+
+	if err := s.Delete(ctx, ci); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := s.Get(ctx, chronograf.TokenQuery{Hash: &hash}); err != chronograf.ErrTokenNotFound {
+		t.Errorf("Get() error = %v, want %v", err, chronograf.ErrTokenNotFound)
+	}
+	if err := s.Update(ctx, ci); err != chronograf.ErrTokenNotFound {
+		t.Errorf("Update() error = %v, want %v", err, chronograf.ErrTokenNotFound)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	bolt "github.com/coreos/bbolt"
 	"github.com/influxdata/influxdb/chronograf"
 	"github.com/influxdata/influxdb/chronograf/bolt/internal"
+	"github.com/influxdata/influxdb/kit/tracing"
 )
 
 // Ensure UsersStore implements chronograf.UsersStore.
@@ -53,6 +54,9 @@ func (s *UsersStore) each(ctx context.Context, fn func(*chronograf.User)) error
 
 // Num returns the number of users in the UsersStore
 func (s *UsersStore) Num(ctx context.Context) (int, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	count := 0
 
 	err := s.client.db.View(func(tx *bolt.Tx) error {
@@ -71,6 +75,9 @@ func (s *UsersStore) Num(ctx context.Context) (int, error) {
 
 // Get searches the UsersStore for user with name
 func (s *UsersStore) Get(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	if q.ID != nil {
 		return s.get(ctx, *q.ID)
 	}
@@ -117,8 +124,28 @@ func (s *UsersStore) userExists(ctx context.Context, u *chronograf.User) (bool,
 	return true, nil
 }
 
+// Filter returns the users matching f
+func (s *UsersStore) Filter(ctx context.Context, f chronograf.UserFilter) ([]chronograf.User, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	users := []chronograf.User{}
+	if err := s.each(ctx, func(u *chronograf.User) {
+		if f.Matches(u) {
+			users = append(users, *u)
+		}
+	}); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
 // Add a new User to the UsersStore.
 func (s *UsersStore) Add(ctx context.Context, u *chronograf.User) (*chronograf.User, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	if u == nil {
 		return nil, fmt.Errorf("user provided is nil")
 	}
@@ -149,19 +176,113 @@ func (s *UsersStore) Add(ctx context.Context, u *chronograf.User) (*chronograf.U
 	return u, nil
 }
 
+// AddMany adds all users to the UsersStore within a single transaction. If
+// any of the users already exists, none of them are added. The users added are
+// returned with their IDs; us is left unchanged.
+func (s *UsersStore) AddMany(ctx context.Context, us []*chronograf.User) ([]*chronograf.User, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	for _, u := range us {
+		if u == nil {
+			return nil, fmt.Errorf("user provided is nil")
+		}
+	}
+	added := make([]*chronograf.User, len(us))
+	if err := s.client.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(UsersBucket)
+		exists := map[string]bool{}
+		if err := b.ForEach(func(k, v []byte) error {
+			var user chronograf.User
+			if err := internal.UnmarshalUser(v, &user); err != nil {
+				return err
+			}
+			exists[userKey(&user)] = true
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		for i, u := range us {
+			key := userKey(u)
+			if exists[key] {
+				return chronograf.ErrUserAlreadyExists
+			}
+			exists[key] = true
+
+			seq, err := b.NextSequence()
+			if err != nil {
+				return err
+			}
+			user := *u
+			user.ID = seq
+			if v, err := internal.MarshalUser(&user); err != nil {
+				return err
+			} else if err := b.Put(u64tob(seq), v); err != nil {
+				return err
+			}
+			added[i] = &user
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return added, nil
+}
+
+// UpdateMany updates all users within a single transaction. If any of the
+// users does not exist, none of them are updated.
+func (s *UsersStore) UpdateMany(ctx context.Context, us []*chronograf.User) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(UsersBucket)
+		for _, u := range us {
+			if u == nil {
+				return fmt.Errorf("user provided is nil")
+			}
+			if b.Get(u64tob(u.ID)) == nil {
+				return chronograf.ErrUserNotFound
+			}
+			if v, err := internal.MarshalUser(u); err != nil {
+				return err
+			} else if err := b.Put(u64tob(u.ID), v); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// userKey identifies a user by the name, provider and scheme used to log in
+func userKey(u *chronograf.User) string {
+	return u.Provider + ":" + u.Scheme + ":" + u.Name
+}
+
 // Delete a user from the UsersStore
 func (s *UsersStore) Delete(ctx context.Context, u *chronograf.User) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	_, err := s.get(ctx, u.ID)
 	if err != nil {
 		return err
 	}
-	return s.client.db.Update(func(tx *bolt.Tx) error {
+	if err := s.client.db.Update(func(tx *bolt.Tx) error {
 		return tx.Bucket(UsersBucket).Delete(u64tob(u.ID))
-	})
+	}); err != nil {
+		return err
+	}
+	return s.client.QueryHistoryStore.Delete(ctx, u.ID)
 }
 
 // Update a user
 func (s *UsersStore) Update(ctx context.Context, u *chronograf.User) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	_, err := s.get(ctx, u.ID)
 	if err != nil {
 		return err
@@ -178,6 +299,9 @@ func (s *UsersStore) Update(ctx context.Context, u *chronograf.User) error {
 
 // All returns all users
 func (s *UsersStore) All(ctx context.Context) ([]chronograf.User, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
 	var users []chronograf.User
 	if err := s.client.db.View(func(tx *bolt.Tx) error {
 		return tx.Bucket(UsersBucket).ForEach(func(k, v []byte) error {
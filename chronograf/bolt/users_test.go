@@ -446,9 +446,11 @@ func TestUsersStore_All(t *testing.T) {
 	}{
 		{
 			name: "No users",
+			ctx:  context.Background(),
 		},
 		{
 			name: "Update new user",
+			ctx:  context.Background(),
 			want: []chronograf.User{
 				{
 					Name:     "howdy",
@@ -501,6 +503,156 @@ func TestUsersStore_All(t *testing.T) {
 	}
 }
 
+func TestUsersStore_AddMany(t *testing.T) {
+	client, err := NewTestClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	s := client.UsersStore
+	if _, err := s.Add(ctx, &chronograf.User{Name: "howdy", Provider: "github", Scheme: "oauth2"}); err != nil {
+		t.Fatal(err)
+	}
+
+	failed := []*chronograf.User{
+		{Name: "doody", Provider: "github", Scheme: "oauth2"},
+		{Name: "howdy", Provider: "github", Scheme: "oauth2"},
+	}
+	_, err = s.AddMany(ctx, failed)
+	if err != chronograf.ErrUserAlreadyExists {
+		t.Fatalf("UsersStore.AddMany() error = %v, want %v", err, chronograf.ErrUserAlreadyExists)
+	}
+	if n, _ := s.Num(ctx); n != 1 {
+		t.Fatalf("UsersStore.AddMany() added users of a failed batch, got %d users", n)
+	}
+	if failed[0].ID != 0 {
+		t.Errorf("UsersStore.AddMany() gave ID %d to a user of a failed batch", failed[0].ID)
+	}
+
+	added, err := s.AddMany(ctx, []*chronograf.User{
+		{Name: "doody", Provider: "github", Scheme: "oauth2"},
+		{Name: "howdy", Provider: "google", Scheme: "oauth2"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, u := range added {
+		got, err := s.Get(ctx, chronograf.UserQuery{ID: &u.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, u, cmpOptions...); diff != "" {
+			t.Errorf("UsersStore.AddMany():\n-got/+want\ndiff %s", diff)
+		}
+	}
+}
+
+func TestUsersStore_UpdateMany(t *testing.T) {
+	client, err := NewTestClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	s := client.UsersStore
+	u, err := s.Add(ctx, &chronograf.User{Name: "howdy", Provider: "github", Scheme: "oauth2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	editor := []chronograf.Role{{Name: "editor", Organization: "1"}}
+	err = s.UpdateMany(ctx, []*chronograf.User{
+		{ID: u.ID, Name: "howdy", Provider: "github", Scheme: "oauth2", Roles: editor},
+		{ID: u.ID + 1, Name: "doody", Provider: "github", Scheme: "oauth2", Roles: editor},
+	})
+	if err != chronograf.ErrUserNotFound {
+		t.Fatalf("UsersStore.UpdateMany() error = %v, want %v", err, chronograf.ErrUserNotFound)
+	}
+	if got, _ := s.Get(ctx, chronograf.UserQuery{ID: &u.ID}); len(got.Roles) != 0 {
+		t.Fatalf("UsersStore.UpdateMany() updated users of a failed batch, got roles %v", got.Roles)
+	}
+
+	u.Roles = editor
+	if err := s.UpdateMany(ctx, []*chronograf.User{u}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := s.Get(ctx, chronograf.UserQuery{ID: &u.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(got, u, cmpOptions...); diff != "" {
+		t.Errorf("UsersStore.UpdateMany():\n-got/+want\ndiff %s", diff)
+	}
+}
+
+func TestUsersStore_Filter(t *testing.T) {
+	client, err := NewTestClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	s := client.UsersStore
+	users := []chronograf.User{
+		{
+			Name:     "Howdy",
+			Provider: "github",
+			Scheme:   "oauth2",
+			Roles:    []chronograf.Role{{Name: "viewer", Organization: "1"}},
+		},
+		{
+			Name:     "doody",
+			Provider: "google",
+			Scheme:   "oauth2",
+			Roles:    []chronograf.Role{{Name: "editor", Organization: "1"}},
+		},
+	}
+	for i := range users {
+		if _, err := s.Add(ctx, &users[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		filter chronograf.UserFilter
+		want   []string
+	}{
+		{
+			name:   "Name ignores case",
+			filter: chronograf.UserFilter{Name: "howd"},
+			want:   []string{"Howdy"},
+		},
+		{
+			name:   "Provider and role",
+			filter: chronograf.UserFilter{Provider: "google", Role: "editor"},
+			want:   []string{"doody"},
+		},
+		{
+			name:   "No match",
+			filter: chronograf.UserFilter{Provider: "github", Role: "editor"},
+			want:   []string{},
+		},
+	}
+	for _, tt := range tests {
+		got, err := s.Filter(ctx, tt.filter)
+		if err != nil {
+			t.Fatalf("%q. UsersStore.Filter() error = %v", tt.name, err)
+		}
+		names := []string{}
+		for _, u := range got {
+			names = append(names, u.Name)
+		}
+		if diff := cmp.Diff(names, tt.want); diff != "" {
+			t.Errorf("%q. UsersStore.Filter():\n-got/+want\ndiff %s", tt.name, diff)
+		}
+	}
+}
+
 func TestUsersStore_Num(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -511,10 +663,12 @@ func TestUsersStore_Num(t *testing.T) {
 	}{
 		{
 			name: "No users",
+			ctx:  context.Background(),
 			want: 0,
 		},
 		{
 			name: "Update new user",
+			ctx:  context.Background(),
 			want: 2,
 			users: []chronograf.User{
 				{
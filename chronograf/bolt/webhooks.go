@@ -0,0 +1,248 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+
+	bolt "github.com/coreos/bbolt"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt/internal"
+	"github.com/influxdata/influxdb/kit/tracing"
+)
+
+// Ensure WebhooksStore implements chronograf.WebhooksStore.
+var _ chronograf.WebhooksStore = &WebhooksStore{}
+
+var (
+	// WebhooksBucket is the bucket where the webhooks of events are stored.
+	WebhooksBucket = []byte("webhooksv1")
+)
+
+// WebhooksStore uses bolt to store and retrieve the webhooks of events
+type WebhooksStore struct {
+	client *Client
+}
+
+// Migrate is a noop as there is no previous schema of webhooks
+func (s *WebhooksStore) Migrate(ctx context.Context) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return nil
+}
+
+// All returns all webhooks
+func (s *WebhooksStore) All(ctx context.Context) ([]chronograf.Webhook, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	hooks := []chronograf.Webhook{}
+	err := s.client.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(WebhooksBucket).ForEach(func(k, v []byte) error {
+			var h chronograf.Webhook
+			if err := internal.UnmarshalWebhook(v, &h); err != nil {
+				return err
+			}
+			hooks = append(hooks, h)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return hooks, nil
+}
+
+// Add creates a new webhook in the WebhooksStore
+func (s *WebhooksStore) Add(ctx context.Context, h *chronograf.Webhook) (*chronograf.Webhook, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	if err := s.client.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(WebhooksBucket)
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		h.ID = fmt.Sprintf("%d", seq)
+
+		v, err := internal.MarshalWebhook(h)
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(h.ID), v)
+	}); err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// Delete the webhook from the WebhooksStore
+func (s *WebhooksStore) Delete(ctx context.Context, h *chronograf.Webhook) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	if _, err := s.Get(ctx, h.ID); err != nil {
+		return err
+	}
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(WebhooksBucket).Delete([]byte(h.ID))
+	})
+}
+
+// Get retrieves a webhook by ID
+func (s *WebhooksStore) Get(ctx context.Context, id string) (*chronograf.Webhook, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	var h chronograf.Webhook
+	if err := s.client.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(WebhooksBucket).Get([]byte(id))
+		if v == nil {
+			return chronograf.ErrWebhookNotFound
+		}
+		return internal.UnmarshalWebhook(v, &h)
+	}); err != nil {
+		return nil, err
+	}
+
+	return &h, nil
+}
+
+// Update replaces the webhook in the WebhooksStore
+func (s *WebhooksStore) Update(ctx context.Context, h *chronograf.Webhook) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(WebhooksBucket)
+		if v := b.Get([]byte(h.ID)); v == nil {
+			return chronograf.ErrWebhookNotFound
+		}
+		v, err := internal.MarshalWebhook(h)
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(h.ID), v)
+	})
+}
+
+// Ensure WebhookDeliveriesStore implements chronograf.WebhookDeliveriesStore.
+var _ chronograf.WebhookDeliveriesStore = &WebhookDeliveriesStore{}
+
+var (
+	// WebhookDeliveriesBucket is the bucket where the deliveries of events
+	// to webhooks are stored. It holds a nested bucket of deliveries for
+	// each webhook.
+	WebhookDeliveriesBucket = []byte("webhookdeliveriesv1")
+)
+
+// DefaultWebhookDeliveries is the number of deliveries kept for each webhook
+const DefaultWebhookDeliveries = 100
+
+// WebhookDeliveriesStore uses bolt to store and retrieve the log of the
+// deliveries of events to webhooks
+type WebhookDeliveriesStore struct {
+	client *Client
+	// Log is the number of deliveries kept for each webhook; the oldest
+	// deliveries are discarded first
+	Log int
+}
+
+// Migrate is a noop as there is no previous schema of webhook deliveries
+func (s *WebhookDeliveriesStore) Migrate(ctx context.Context) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return nil
+}
+
+// All returns the deliveries of a webhook, oldest first
+func (s *WebhookDeliveriesStore) All(ctx context.Context, webhookID string) ([]chronograf.WebhookDelivery, error) {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	deliveries := []chronograf.WebhookDelivery{}
+	err := s.client.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(WebhookDeliveriesBucket).Bucket([]byte(webhookID))
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			var d chronograf.WebhookDelivery
+			if err := internal.UnmarshalWebhookDelivery(v, &d); err != nil {
+				return err
+			}
+			deliveries = append(deliveries, d)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// Add records the delivery d, discarding the oldest deliveries of its
+// webhook beyond the log of the store
+func (s *WebhookDeliveriesStore) Add(ctx context.Context, d *chronograf.WebhookDelivery) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.Bucket(WebhookDeliveriesBucket).CreateBucketIfNotExists([]byte(d.WebhookID))
+		if err != nil {
+			return err
+		}
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		if d.DeliveredAt.IsZero() {
+			d.DeliveredAt = s.client.Now().UTC()
+		}
+
+		data, err := internal.MarshalWebhookDelivery(d)
+		if err != nil {
+			return err
+		}
+		if err := b.Put(u64tob(seq), data); err != nil {
+			return err
+		}
+
+		log := s.Log
+		if log <= 0 {
+			log = DefaultWebhookDeliveries
+		}
+		var keys [][]byte
+		if err := b.ForEach(func(k, v []byte) error {
+			keys = append(keys, k)
+			return nil
+		}); err != nil {
+			return err
+		}
+		for i := 0; i < len(keys)-log; i++ {
+			if err := b.Delete(keys[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Delete removes the deliveries of a webhook
+func (s *WebhookDeliveriesStore) Delete(ctx context.Context, webhookID string) error {
+	span, _ := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	return s.client.db.Update(func(tx *bolt.Tx) error {
+		err := tx.Bucket(WebhookDeliveriesBucket).DeleteBucket([]byte(webhookID))
+		if err == bolt.ErrBucketNotFound {
+			return nil
+		}
+		return err
+	})
+}
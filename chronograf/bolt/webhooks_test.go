@@ -0,0 +1,147 @@
+package bolt_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func TestWebhooksStore(t *testing.T) {
+	client, err := NewTestClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	s := client.WebhooksStore
+
+	catalog := &chronograf.Webhook{
+		Name:   "catalog",
+		URL:    "https://catalog.example.com/hooks/chronograf",
+		Events: []string{"dashboard.created", "dashboard.updated"},
+		Secret: "hunter2",
+	}
+	audit := &chronograf.Webhook{
+		Name: "audit",
+		URL:  "https://audit.example.com/events",
+	}
+	for _, h := range []*chronograf.Webhook{catalog, audit} {
+		if _, err := s.Add(ctx, h); err != nil {
+			t.Fatalf("Add() error = %v", err)
+		}
+	}
+
+	got, err := s.All(ctx)
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	want := []chronograf.Webhook{*catalog, *audit}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("All() diff (-got +want):\n%s", diff)
+	}
+
+	audit.Events = []string{"user.created"}
+	if err := s.Update(ctx, audit); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	h, err := s.Get(ctx, audit.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if diff := cmp.Diff(h, audit); diff != "" {
+		t.Errorf("Get() diff (-got +want):\n%s", diff)
+	}
+
+	if err := s.Delete(ctx, catalog); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := s.Get(ctx, catalog.ID); err != chronograf.ErrWebhookNotFound {
+		t.Errorf("Get() after Delete() error = %v, want %v", err, chronograf.ErrWebhookNotFound)
+	}
+	if err := s.Update(ctx, catalog); err != chronograf.ErrWebhookNotFound {
+		t.Errorf("Update() of a removed webhook error = %v, want %v", err, chronograf.ErrWebhookNotFound)
+	}
+	if err := s.Delete(ctx, catalog); err != chronograf.ErrWebhookNotFound {
+		t.Errorf("Delete() of a removed webhook error = %v, want %v", err, chronograf.ErrWebhookNotFound)
+	}
+}
+
+func TestWebhookDeliveriesStore(t *testing.T) {
+	client, err := NewTestClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	s := client.WebhookDeliveriesStore
+	s.Log = 2
+
+	deliveries := []chronograf.WebhookDelivery{
+		{
+			WebhookID: "1",
+			EventID:   "a",
+			Event:     "user.created",
+			Attempt:   1,
+			Duration:  time.Second,
+			Status:    chronograf.WebhookDeliveryError,
+			Error:     "connection refused",
+		},
+		{
+			WebhookID:  "1",
+			EventID:    "a",
+			Event:      "user.created",
+			Attempt:    2,
+			StatusCode: 200,
+			Duration:   20 * time.Millisecond,
+			Status:     chronograf.WebhookDeliverySuccess,
+		},
+		{
+			WebhookID:  "1",
+			EventID:    "b",
+			Event:      "source.deleted",
+			Attempt:    1,
+			StatusCode: 204,
+			Status:     chronograf.WebhookDeliverySuccess,
+		},
+		{
+			WebhookID:  "2",
+			EventID:    "b",
+			Event:      "source.deleted",
+			Attempt:    1,
+			StatusCode: 200,
+			Status:     chronograf.WebhookDeliverySuccess,
+		},
+	}
+	for i := range deliveries {
+		if err := s.Add(ctx, &deliveries[i]); err != nil {
+			t.Fatalf("Add() error = %v", err)
+		}
+		if !deliveries[i].DeliveredAt.Equal(TestNow) {
+			t.Errorf("Add() DeliveredAt = %v, want %v", deliveries[i].DeliveredAt, TestNow)
+		}
+	}
+
+	// Only the last two deliveries of webhook 1 are kept
+	got, err := s.All(ctx, "1")
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	if diff := cmp.Diff(got, deliveries[1:3]); diff != "" {
+		t.Errorf("All() diff (-got +want):\n%s", diff)
+	}
+
+	if err := s.Delete(ctx, "1"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if got, err := s.All(ctx, "1"); err != nil || len(got) != 0 {
+		t.Errorf("All() after Delete() = %#v, %v", got, err)
+	}
+	if got, err := s.All(ctx, "2"); err != nil || len(got) != 1 {
+		t.Errorf("All() of another webhook = %#v, %v", got, err)
+	}
+}
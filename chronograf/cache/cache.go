@@ -0,0 +1,81 @@
+// Package cache keeps the results of frequent reads of the stores, such as
+// users, sources and dashboards, in memory for a while so that page loads do
+// not read the same values from the stores again and again.
+//
+// Every write through a cached store flushes its cache. Writes made by other
+// Chronograf replicas sharing a store are seen once the cached values expire.
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+type entry struct {
+	value   interface{}
+	expires time.Time
+}
+
+// cache holds values by key until they expire or the cache is flushed
+type cache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	gen     uint64 // gen counts the flushes so that reads racing a write are not cached
+	entries map[string]entry
+}
+
+func newCache(ttl time.Duration) *cache {
+	return &cache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: map[string]entry{},
+	}
+}
+
+// get returns the value of key if it has not expired
+func (c *cache) get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !c.now().Before(e.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return e.value, true
+}
+
+// generation returns the generation a value is read at before it is set
+func (c *cache) generation() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.gen
+}
+
+// set keeps the value of key unless the cache was flushed since gen
+func (c *cache) set(gen uint64, key string, value interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if gen != c.gen {
+		return
+	}
+	c.entries[key] = entry{
+		value:   value,
+		expires: c.now().Add(c.ttl),
+	}
+}
+
+// flush removes all values
+func (c *cache) flush() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.gen++
+	c.entries = map[string]entry{}
+}
@@ -0,0 +1,157 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	return c.now
+}
+
+type usersStore struct {
+	chronograf.UsersStore
+	reads int
+	users map[uint64]chronograf.User
+}
+
+func (s *usersStore) Get(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+	s.reads++
+	u, ok := s.users[*q.ID]
+	if !ok {
+		return nil, chronograf.ErrUserNotFound
+	}
+	return &u, nil
+}
+
+func (s *usersStore) All(ctx context.Context) ([]chronograf.User, error) {
+	s.reads++
+	users := []chronograf.User{}
+	for _, u := range s.users {
+		users = append(users, u)
+	}
+	return users, nil
+}
+
+func (s *usersStore) Update(ctx context.Context, u *chronograf.User) error {
+	s.users[u.ID] = *u
+	return nil
+}
+
+func TestUsersStore(t *testing.T) {
+	ctx := context.Background()
+	c := &clock{now: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	raw := &usersStore{users: map[uint64]chronograf.User{
+		1: {ID: 1, Name: "marty", Roles: []chronograf.Role{{Name: "viewer", Organization: "default"}}},
+	}}
+	s := NewUsersStore(raw, time.Minute)
+	s.cache.now = c.Now
+
+	id := uint64(1)
+	q := chronograf.UserQuery{ID: &id}
+	u, err := s.Get(ctx, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Callers changing users do not change the cache
+	u.Roles[0].Name = "admin"
+	if u, err = s.Get(ctx, q); err != nil || u.Roles[0].Name != "viewer" {
+		t.Errorf("Get() = %#v, %v, want the cached viewer", u, err)
+	}
+	if raw.reads != 1 {
+		t.Errorf("store reads = %d, want 1", raw.reads)
+	}
+
+	missing := uint64(2)
+	if _, err := s.Get(ctx, chronograf.UserQuery{ID: &missing}); err != chronograf.ErrUserNotFound {
+		t.Errorf("Get() of a missing user = %v, want %v", err, chronograf.ErrUserNotFound)
+	}
+
+	// Writes flush the cache
+	u.Roles[0].Name = "editor"
+	if err := s.Update(ctx, u); err != nil {
+		t.Fatal(err)
+	}
+	if u, err = s.Get(ctx, q); err != nil || u.Roles[0].Name != "editor" {
+		t.Errorf("Get() after Update() = %#v, %v", u, err)
+	}
+
+	// Changes made elsewhere are read once the cache expires
+	raw.users[1] = chronograf.User{ID: 1, Name: "doc"}
+	if u, _ = s.Get(ctx, q); u.Name != "marty" {
+		t.Errorf("Get() before expiry = %s, want marty", u.Name)
+	}
+	c.now = c.now.Add(time.Minute)
+	if u, _ = s.Get(ctx, q); u.Name != "doc" {
+		t.Errorf("Get() after expiry = %s, want doc", u.Name)
+	}
+
+	reads := raw.reads
+	if users, err := s.Filter(ctx, chronograf.UserFilter{Name: "DO"}); err != nil || len(users) != 1 {
+		t.Errorf("Filter() = %#v, %v", users, err)
+	}
+	if _, err := s.All(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if raw.reads != reads+1 {
+		t.Errorf("store reads of Filter() and All() = %d, want 1", raw.reads-reads)
+	}
+}
+
+type sourcesStore struct {
+	chronograf.SourcesStore
+	reads int
+	srcs  []chronograf.Source
+}
+
+func (s *sourcesStore) All(ctx context.Context) ([]chronograf.Source, error) {
+	s.reads++
+	return append([]chronograf.Source{}, s.srcs...), nil
+}
+
+func (s *sourcesStore) Add(ctx context.Context, src chronograf.Source) (chronograf.Source, error) {
+	src.ID = len(s.srcs) + 1
+	s.srcs = append(s.srcs, src)
+	return src, nil
+}
+
+func TestSourcesStore(t *testing.T) {
+	ctx := context.Background()
+	raw := &sourcesStore{srcs: []chronograf.Source{{ID: 1, Name: "influx", Organization: "default"}}}
+	s := NewSourcesStore(raw, time.Minute)
+
+	srcs, err := s.All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Callers filtering sources in place do not change the cache
+	srcs[0] = chronograf.Source{}
+	if srcs, _ = s.All(ctx); srcs[0].Name != "influx" || raw.reads != 1 {
+		t.Errorf("All() = %#v after %d reads", srcs, raw.reads)
+	}
+
+	if _, err := s.Add(ctx, chronograf.Source{Name: "other"}); err != nil {
+		t.Fatal(err)
+	}
+	if srcs, _ = s.All(ctx); len(srcs) != 2 {
+		t.Errorf("All() after Add() = %#v", srcs)
+	}
+}
+
+func TestCache_staleRead(t *testing.T) {
+	c := newCache(time.Minute)
+	gen := c.generation()
+	// A write flushes the cache while a read is in flight
+	c.flush()
+	c.set(gen, "all", "stale")
+	if _, ok := c.get("all"); ok {
+		t.Errorf("value read before a flush was cached")
+	}
+}
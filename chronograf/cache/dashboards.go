@@ -0,0 +1,100 @@
+package cache
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt"
+)
+
+// Ensure DashboardsStore implements chronograf.DashboardsStore.
+var _ chronograf.DashboardsStore = &DashboardsStore{}
+
+// DashboardsStore facade on a DashboardsStore that caches the dashboards it
+// reads. Dashboards are cached encoded so that callers changing their cells
+// do not change the cached dashboards.
+type DashboardsStore struct {
+	store chronograf.DashboardsStore
+	cache *cache
+}
+
+// NewDashboardsStore creates a new DashboardsStore from an existing
+// chronograf.DashboardsStore caching dashboards for ttl
+func NewDashboardsStore(s chronograf.DashboardsStore, ttl time.Duration) *DashboardsStore {
+	return &DashboardsStore{
+		store: s,
+		cache: newCache(ttl),
+	}
+}
+
+// All returns all dashboards, reading them from the underlying
+// DashboardsStore when they are not cached
+func (s *DashboardsStore) All(ctx context.Context) ([]chronograf.Dashboard, error) {
+	if v, ok := s.cache.get("all"); ok {
+		data := v.([][]byte)
+		dashboards := make([]chronograf.Dashboard, len(data))
+		for i := range data {
+			if err := bolt.UnmarshalDashboard(data[i], &dashboards[i]); err != nil {
+				return nil, err
+			}
+		}
+		return dashboards, nil
+	}
+
+	gen := s.cache.generation()
+	dashboards, err := s.store.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data := make([][]byte, len(dashboards))
+	for i := range dashboards {
+		if data[i], err = bolt.MarshalDashboard(dashboards[i]); err != nil {
+			return dashboards, nil
+		}
+	}
+	s.cache.set(gen, "all", data)
+	return dashboards, nil
+}
+
+// Add creates a dashboard in the underlying DashboardsStore
+func (s *DashboardsStore) Add(ctx context.Context, d chronograf.Dashboard) (chronograf.Dashboard, error) {
+	defer s.cache.flush()
+	return s.store.Add(ctx, d)
+}
+
+// Delete removes a dashboard from the underlying DashboardsStore
+func (s *DashboardsStore) Delete(ctx context.Context, d chronograf.Dashboard) error {
+	defer s.cache.flush()
+	return s.store.Delete(ctx, d)
+}
+
+// Get returns a dashboard, reading it from the underlying DashboardsStore
+// when it is not cached
+func (s *DashboardsStore) Get(ctx context.Context, id chronograf.DashboardID) (chronograf.Dashboard, error) {
+	key := "id:" + strconv.Itoa(int(id))
+	if v, ok := s.cache.get(key); ok {
+		var d chronograf.Dashboard
+		if err := bolt.UnmarshalDashboard(v.([]byte), &d); err != nil {
+			return chronograf.Dashboard{}, err
+		}
+		return d, nil
+	}
+
+	gen := s.cache.generation()
+	d, err := s.store.Get(ctx, id)
+	if err != nil {
+		return chronograf.Dashboard{}, err
+	}
+	if data, err := bolt.MarshalDashboard(d); err == nil {
+		s.cache.set(gen, key, data)
+	}
+	return d, nil
+}
+
+// Update updates a dashboard in the underlying DashboardsStore
+func (s *DashboardsStore) Update(ctx context.Context, d chronograf.Dashboard) error {
+	defer s.cache.flush()
+	return s.store.Update(ctx, d)
+}
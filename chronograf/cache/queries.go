@@ -0,0 +1,103 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// QueryKey identifies the result of a query of a source. The time range of
+// the query is part of its command, so queries of different ranges have
+// different keys.
+type QueryKey struct {
+	Source  int
+	DB      string
+	RP      string
+	Epoch   string
+	Command string
+}
+
+type queryEntry struct {
+	key     QueryKey
+	value   interface{}
+	expires time.Time
+}
+
+// QueryCache keeps the results of queries of sources in memory so that many
+// viewers of the same dashboard do not run the same queries again and again.
+// Every result is kept for the TTL it is set with. Once the cache holds its
+// maximum number of results, the least recently used one is evicted.
+type QueryCache struct {
+	max int
+	now func() time.Time
+
+	mu      sync.Mutex
+	lru     *list.List // lru holds the entries, the most recently used first
+	entries map[QueryKey]*list.Element
+}
+
+// NewQueryCache creates a QueryCache holding up to max results
+func NewQueryCache(max int) *QueryCache {
+	return &QueryCache{
+		max:     max,
+		now:     time.Now,
+		lru:     list.New(),
+		entries: map[QueryKey]*list.Element{},
+	}
+}
+
+// Get returns the result of the query of key if it has not expired
+func (c *QueryCache) Get(key QueryKey) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	e := el.Value.(*queryEntry)
+	if !c.now().Before(e.expires) {
+		c.remove(el)
+		return nil, false
+	}
+	c.lru.MoveToFront(el)
+	return e.value, true
+}
+
+// Set keeps the result of the query of key for ttl
+func (c *QueryCache) Set(key QueryKey, value interface{}, ttl time.Duration) {
+	if ttl <= 0 || c.max <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e := &queryEntry{
+		key:     key,
+		value:   value,
+		expires: c.now().Add(ttl),
+	}
+	if el, ok := c.entries[key]; ok {
+		el.Value = e
+		c.lru.MoveToFront(el)
+		return
+	}
+	for c.lru.Len() >= c.max {
+		c.remove(c.lru.Back())
+	}
+	c.entries[key] = c.lru.PushFront(e)
+}
+
+// Len returns the number of results held, including expired ones not yet
+// evicted
+func (c *QueryCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+func (c *QueryCache) remove(el *list.Element) {
+	c.lru.Remove(el)
+	delete(c.entries, el.Value.(*queryEntry).key)
+}
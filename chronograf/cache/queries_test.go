@@ -0,0 +1,58 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestQueryCache(t *testing.T) {
+	c := &clock{now: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	qc := NewQueryCache(2)
+	qc.now = c.Now
+
+	cpu := QueryKey{Source: 1, DB: "telegraf", Command: "SELECT mean(usage_user) FROM cpu WHERE time > now() - 1h"}
+	mem := QueryKey{Source: 1, DB: "telegraf", Command: "SELECT mean(used) FROM mem WHERE time > now() - 1h"}
+	disk := QueryKey{Source: 1, DB: "telegraf", Command: "SELECT mean(used) FROM disk WHERE time > now() - 1h"}
+
+	qc.Set(cpu, "cpu", time.Minute)
+	qc.Set(mem, "mem", 10*time.Second)
+	if v, ok := qc.Get(cpu); !ok || v != "cpu" {
+		t.Errorf("Get() = %v, %v, want the cached cpu result", v, ok)
+	}
+
+	// Another source does not share the result
+	other := cpu
+	other.Source = 2
+	if _, ok := qc.Get(other); ok {
+		t.Errorf("Get() of another source hit the cache")
+	}
+
+	// Results expire after their own TTL
+	c.now = c.now.Add(30 * time.Second)
+	if _, ok := qc.Get(mem); ok {
+		t.Errorf("Get() of an expired result hit the cache")
+	}
+	if _, ok := qc.Get(cpu); !ok {
+		t.Errorf("Get() of a fresh result missed the cache")
+	}
+
+	// The least recently used result is evicted once the cache is full
+	qc.Set(mem, "mem", time.Minute)
+	qc.Get(cpu)
+	qc.Set(disk, "disk", time.Minute)
+	if qc.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", qc.Len())
+	}
+	if _, ok := qc.Get(mem); ok {
+		t.Errorf("Get() of the least recently used result hit the cache")
+	}
+	if _, ok := qc.Get(cpu); !ok {
+		t.Errorf("Get() of a recently used result missed the cache")
+	}
+
+	// Results without a TTL are not cached
+	qc.Set(mem, "mem", 0)
+	if _, ok := qc.Get(mem); ok {
+		t.Errorf("Get() of a result without TTL hit the cache")
+	}
+}
@@ -0,0 +1,78 @@
+package cache
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Ensure SourcesStore implements chronograf.SourcesStore.
+var _ chronograf.SourcesStore = &SourcesStore{}
+
+// SourcesStore facade on a SourcesStore that caches the sources it reads
+type SourcesStore struct {
+	store chronograf.SourcesStore
+	cache *cache
+}
+
+// NewSourcesStore creates a new SourcesStore from an existing
+// chronograf.SourcesStore caching sources for ttl
+func NewSourcesStore(s chronograf.SourcesStore, ttl time.Duration) *SourcesStore {
+	return &SourcesStore{
+		store: s,
+		cache: newCache(ttl),
+	}
+}
+
+// All returns all sources, reading them from the underlying SourcesStore
+// when they are not cached
+func (s *SourcesStore) All(ctx context.Context) ([]chronograf.Source, error) {
+	if v, ok := s.cache.get("all"); ok {
+		return append([]chronograf.Source{}, v.([]chronograf.Source)...), nil
+	}
+
+	gen := s.cache.generation()
+	srcs, err := s.store.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.cache.set(gen, "all", append([]chronograf.Source{}, srcs...))
+	return srcs, nil
+}
+
+// Add creates a source in the underlying SourcesStore
+func (s *SourcesStore) Add(ctx context.Context, src chronograf.Source) (chronograf.Source, error) {
+	defer s.cache.flush()
+	return s.store.Add(ctx, src)
+}
+
+// Delete removes a source from the underlying SourcesStore
+func (s *SourcesStore) Delete(ctx context.Context, src chronograf.Source) error {
+	defer s.cache.flush()
+	return s.store.Delete(ctx, src)
+}
+
+// Get returns a source, reading it from the underlying SourcesStore when it
+// is not cached
+func (s *SourcesStore) Get(ctx context.Context, id int) (chronograf.Source, error) {
+	key := "id:" + strconv.Itoa(id)
+	if v, ok := s.cache.get(key); ok {
+		return v.(chronograf.Source), nil
+	}
+
+	gen := s.cache.generation()
+	src, err := s.store.Get(ctx, id)
+	if err != nil {
+		return chronograf.Source{}, err
+	}
+	s.cache.set(gen, key, src)
+	return src, nil
+}
+
+// Update updates a source in the underlying SourcesStore
+func (s *SourcesStore) Update(ctx context.Context, src chronograf.Source) error {
+	defer s.cache.flush()
+	return s.store.Update(ctx, src)
+}
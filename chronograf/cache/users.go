@@ -0,0 +1,164 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt"
+)
+
+// Ensure UsersStore implements chronograf.UsersStore.
+var _ chronograf.UsersStore = &UsersStore{}
+
+// UsersStore facade on a UsersStore that caches the users it reads. Users
+// are cached encoded so that callers changing their roles do not change the
+// cached users.
+type UsersStore struct {
+	store chronograf.UsersStore
+	cache *cache
+}
+
+// NewUsersStore creates a new UsersStore from an existing
+// chronograf.UsersStore caching users for ttl
+func NewUsersStore(s chronograf.UsersStore, ttl time.Duration) *UsersStore {
+	return &UsersStore{
+		store: s,
+		cache: newCache(ttl),
+	}
+}
+
+// All returns all users, reading them from the underlying UsersStore when
+// they are not cached
+func (s *UsersStore) All(ctx context.Context) ([]chronograf.User, error) {
+	if v, ok := s.cache.get("all"); ok {
+		return decodeUsers(v.([][]byte))
+	}
+
+	gen := s.cache.generation()
+	users, err := s.store.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if data, err := encodeUsers(users); err == nil {
+		s.cache.set(gen, "all", data)
+	}
+	return users, nil
+}
+
+// Add creates a user in the underlying UsersStore
+func (s *UsersStore) Add(ctx context.Context, u *chronograf.User) (*chronograf.User, error) {
+	defer s.cache.flush()
+	return s.store.Add(ctx, u)
+}
+
+// AddMany creates users in the underlying UsersStore
+func (s *UsersStore) AddMany(ctx context.Context, us []*chronograf.User) ([]*chronograf.User, error) {
+	defer s.cache.flush()
+	return s.store.AddMany(ctx, us)
+}
+
+// Delete removes a user from the underlying UsersStore
+func (s *UsersStore) Delete(ctx context.Context, u *chronograf.User) error {
+	defer s.cache.flush()
+	return s.store.Delete(ctx, u)
+}
+
+// Get returns a user, reading it from the underlying UsersStore when it is
+// not cached
+func (s *UsersStore) Get(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+	var key string
+	switch {
+	case q.ID != nil:
+		key = "id:" + strconv.FormatUint(*q.ID, 10)
+	case q.Name != nil && q.Provider != nil && q.Scheme != nil:
+		key = fmt.Sprintf("name:%q:%q:%q", *q.Name, *q.Provider, *q.Scheme)
+	default:
+		return s.store.Get(ctx, q)
+	}
+
+	if v, ok := s.cache.get(key); ok {
+		var u chronograf.User
+		if err := bolt.UnmarshalUser(v.([]byte), &u); err != nil {
+			return nil, err
+		}
+		return &u, nil
+	}
+
+	gen := s.cache.generation()
+	u, err := s.store.Get(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	if data, err := bolt.MarshalUser(u); err == nil {
+		s.cache.set(gen, key, data)
+	}
+	return u, nil
+}
+
+// Update updates a user in the underlying UsersStore
+func (s *UsersStore) Update(ctx context.Context, u *chronograf.User) error {
+	defer s.cache.flush()
+	return s.store.Update(ctx, u)
+}
+
+// UpdateMany updates users in the underlying UsersStore
+func (s *UsersStore) UpdateMany(ctx context.Context, us []*chronograf.User) error {
+	defer s.cache.flush()
+	return s.store.UpdateMany(ctx, us)
+}
+
+// Num returns the number of users, counting them in the underlying
+// UsersStore when the number is not cached
+func (s *UsersStore) Num(ctx context.Context) (int, error) {
+	if v, ok := s.cache.get("num"); ok {
+		return v.(int), nil
+	}
+
+	gen := s.cache.generation()
+	n, err := s.store.Num(ctx)
+	if err != nil {
+		return 0, err
+	}
+	s.cache.set(gen, "num", n)
+	return n, nil
+}
+
+// Filter returns the users matching f among all users
+func (s *UsersStore) Filter(ctx context.Context, f chronograf.UserFilter) ([]chronograf.User, error) {
+	all, err := s.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	users := []chronograf.User{}
+	for i := range all {
+		if f.Matches(&all[i]) {
+			users = append(users, all[i])
+		}
+	}
+	return users, nil
+}
+
+func encodeUsers(users []chronograf.User) ([][]byte, error) {
+	data := make([][]byte, len(users))
+	for i := range users {
+		var err error
+		if data[i], err = bolt.MarshalUser(&users[i]); err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+func decodeUsers(data [][]byte) ([]chronograf.User, error) {
+	users := make([]chronograf.User, len(data))
+	for i := range data {
+		if err := bolt.UnmarshalUser(data[i], &users[i]); err != nil {
+			return nil, err
+		}
+	}
+	return users, nil
+}
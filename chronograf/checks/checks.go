@@ -0,0 +1,271 @@
+// Package checks evaluates the alert checks Chronograf runs itself, for
+// installs without Kapacitor. A check compares the last value of each series
+// returned by its query with thresholds and reports the series whose level
+// changed since the previous run.
+package checks
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Levels of the series of a check
+const (
+	OK       = "OK"
+	Info     = "INFO"
+	Warning  = "WARNING"
+	Critical = "CRITICAL"
+)
+
+// DefaultMessage is the message of the alerts of checks without one
+const DefaultMessage = `{{ .ID }} is {{ .Level }} ({{ .Field }} = {{ .Value }})`
+
+// MinEvery is the shortest interval between the runs of a check
+const MinEvery = 10 * time.Second
+
+// operators compare a value with a threshold
+var operators = map[string]func(v, t float64) bool{
+	">":  func(v, t float64) bool { return v > t },
+	">=": func(v, t float64) bool { return v >= t },
+	"<":  func(v, t float64) bool { return v < t },
+	"<=": func(v, t float64) bool { return v <= t },
+	"==": func(v, t float64) bool { return v == t },
+	"!=": func(v, t float64) bool { return v != t },
+}
+
+// thresholdLevels are the levels thresholds may reach
+var thresholdLevels = map[string]bool{
+	Info:     true,
+	Warning:  true,
+	Critical: true,
+}
+
+// Alert is a change of the level of a series of a check
+type Alert struct {
+	ID            string            // ID is the name of the check followed by the tags of the series
+	Check         string            // Check is the name of the check
+	Name          string            // Name is the measurement of the series
+	Tags          map[string]string // Tags are the tags of the series
+	Field         string            // Field is the column of the series that was checked
+	Value         float64           // Value is the last value of the series
+	Time          time.Time         // Time is the time of the last value
+	Level         string
+	PreviousLevel string
+	Message       string // Message is the message of the check rendered for the alert
+}
+
+// Validate checks that a check can be evaluated
+func Validate(c *chronograf.AlertCheck) error {
+	if c.Name == "" {
+		return fmt.Errorf("name required on check")
+	}
+	if strings.TrimSpace(c.Query) == "" {
+		return fmt.Errorf("query required on check")
+	}
+	if c.Every < MinEvery {
+		return fmt.Errorf("every must be at least %v", MinEvery)
+	}
+	if _, ok := operators[c.Operator]; !ok {
+		return fmt.Errorf("unknown operator %q. Valid operators are >, >=, <, <=, == and !=", c.Operator)
+	}
+	if len(c.Thresholds) == 0 {
+		return fmt.Errorf("at least one threshold required on check")
+	}
+	for _, t := range c.Thresholds {
+		if !thresholdLevels[t.Level] {
+			return fmt.Errorf("unknown level %s. Valid levels are 'INFO', 'WARNING', and 'CRITICAL'", t.Level)
+		}
+	}
+	if _, err := parseMessage(c.Message); err != nil {
+		return err
+	}
+	return nil
+}
+
+// parseMessage parses the message template of a check
+func parseMessage(message string) (*template.Template, error) {
+	if message == "" {
+		message = DefaultMessage
+	}
+	tmpl, err := template.New("message").Option("missingkey=zero").Parse(message)
+	if err != nil {
+		return nil, fmt.Errorf("invalid message template: %v", err)
+	}
+	return tmpl, nil
+}
+
+// Level returns the level of a value: that of the first threshold the value
+// crosses, or OK when it crosses none
+func Level(c *chronograf.AlertCheck, v float64) string {
+	cmp, ok := operators[c.Operator]
+	if !ok {
+		return OK
+	}
+	for _, t := range c.Thresholds {
+		if cmp(v, t.Value) {
+			return t.Level
+		}
+	}
+	return OK
+}
+
+// Evaluate compares the last value of each series of data, a query result
+// with an epoch of ms, with the thresholds of c. It returns an alert for
+// every series whose level changed and records the levels in the states of
+// c. Series without a previous state were OK. Series missing from data keep
+// their state.
+func Evaluate(c *chronograf.AlertCheck, data []byte) ([]Alert, error) {
+	tmpl, err := parseMessage(c.Message)
+	if err != nil {
+		return nil, err
+	}
+	points, err := lastPoints(data)
+	if err != nil {
+		return nil, err
+	}
+	if c.States == nil {
+		c.States = map[string]string{}
+	}
+
+	alerts := []Alert{}
+	for _, p := range points {
+		a := Alert{
+			ID:    alertID(c.Name, p.tags),
+			Check: c.Name,
+			Name:  p.name,
+			Tags:  p.tags,
+			Field: p.field,
+			Value: p.value,
+			Time:  p.time,
+			Level: Level(c, p.value),
+		}
+		a.PreviousLevel = c.States[a.ID]
+		if a.PreviousLevel == "" {
+			a.PreviousLevel = OK
+		}
+		c.States[a.ID] = a.Level
+		if a.Level == a.PreviousLevel {
+			continue
+		}
+		var msg bytes.Buffer
+		if err := tmpl.Execute(&msg, a); err != nil {
+			return nil, fmt.Errorf("unable to render message of %s: %v", a.ID, err)
+		}
+		a.Message = msg.String()
+		alerts = append(alerts, a)
+	}
+	return alerts, nil
+}
+
+// alertID identifies a series of a check by the name of the check and the
+// sorted tags of the series, such as cpu:host=a,region=us
+func alertID(check string, tags map[string]string) string {
+	if len(tags) == 0 {
+		return check
+	}
+	pairs := make([]string, 0, len(tags))
+	for k, v := range tags {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return check + ":" + strings.Join(pairs, ",")
+}
+
+// point is the last value of the first field of a series
+type point struct {
+	name  string
+	tags  map[string]string
+	field string
+	value float64
+	time  time.Time
+}
+
+// influxResponse is the JSON written by the proxy of chronograf for InfluxDB
+// queries with an epoch of ms
+type influxResponse struct {
+	Results struct {
+		Results []struct {
+			Series []struct {
+				Name    string            `json:"name"`
+				Tags    map[string]string `json:"tags"`
+				Columns []string          `json:"columns"`
+				Values  [][]interface{}   `json:"values"`
+			} `json:"series"`
+			Error string `json:"error"`
+		} `json:"results"`
+	} `json:"results"`
+}
+
+// lastPoints returns the last numeric value of the first field of every
+// series of data. Series without numeric values are left out.
+func lastPoints(data []byte) ([]point, error) {
+	var res influxResponse
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&res); err != nil {
+		return nil, fmt.Errorf("unable to parse query result: %v", err)
+	}
+
+	points := []point{}
+	for _, r := range res.Results.Results {
+		if r.Error != "" {
+			return nil, fmt.Errorf("%s", r.Error)
+		}
+		for _, s := range r.Series {
+			col := -1
+			for i, c := range s.Columns {
+				if c != "time" {
+					col = i
+					break
+				}
+			}
+			if col < 0 {
+				continue
+			}
+			for i := len(s.Values) - 1; i >= 0; i-- {
+				row := s.Values[i]
+				if col >= len(row) {
+					continue
+				}
+				v, ok := number(row[col])
+				if !ok {
+					continue
+				}
+				p := point{
+					name:  s.Name,
+					tags:  s.Tags,
+					field: s.Columns[col],
+					value: v,
+				}
+				if p.tags == nil {
+					p.tags = map[string]string{}
+				}
+				if s.Columns[0] == "time" {
+					if ms, ok := number(row[0]); ok {
+						p.time = time.Unix(0, int64(ms)*int64(time.Millisecond)).UTC()
+					}
+				}
+				points = append(points, p)
+				break
+			}
+		}
+	}
+	return points, nil
+}
+
+// number returns the float value of a JSON number
+func number(v interface{}) (float64, bool) {
+	n, ok := v.(json.Number)
+	if !ok {
+		return 0, false
+	}
+	f, err := n.Float64()
+	return f, err == nil
+}
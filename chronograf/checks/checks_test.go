@@ -0,0 +1,140 @@
+package checks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func TestEvaluate(t *testing.T) {
+	c := &chronograf.AlertCheck{
+		Name:     "cpu",
+		Operator: ">",
+		Thresholds: []chronograf.AlertCheckThreshold{
+			{Level: Critical, Value: 90},
+			{Level: Warning, Value: 75},
+		},
+		Message: "{{ .ID }} is {{ .Level }} at {{ .Tags.host }}",
+		States: map[string]string{
+			"cpu:host=b": Warning,
+			"cpu:host=c": Critical,
+			"cpu:host=d": Critical,
+		},
+	}
+	data := []byte(`{"results":{"results":[{"series":[
+		{"name":"cpu","tags":{"host":"a"},"columns":["time","mean"],"values":[[1791979200000,50],[1791979260000,95.5]]},
+		{"name":"cpu","tags":{"host":"b"},"columns":["time","mean"],"values":[[1791979200000,80],[1791979260000,null]]},
+		{"name":"cpu","tags":{"host":"c"},"columns":["time","mean"],"values":[[1791979260000,10]]}
+	]}]}}`)
+
+	got, err := Evaluate(c, data)
+	if err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+	want := []Alert{
+		{
+			ID:            "cpu:host=a",
+			Check:         "cpu",
+			Name:          "cpu",
+			Tags:          map[string]string{"host": "a"},
+			Field:         "mean",
+			Value:         95.5,
+			Time:          time.Unix(1791979260, 0).UTC(),
+			Level:         Critical,
+			PreviousLevel: OK,
+			Message:       "cpu:host=a is CRITICAL at a",
+		},
+		{
+			ID:            "cpu:host=c",
+			Check:         "cpu",
+			Name:          "cpu",
+			Tags:          map[string]string{"host": "c"},
+			Field:         "mean",
+			Value:         10,
+			Time:          time.Unix(1791979260, 0).UTC(),
+			Level:         OK,
+			PreviousLevel: Critical,
+			Message:       "cpu:host=c is OK at c",
+		},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Evaluate() diff (-got +want):\n%s", diff)
+	}
+	wantStates := map[string]string{
+		"cpu:host=a": Critical,
+		"cpu:host=b": Warning,
+		"cpu:host=c": OK,
+		"cpu:host=d": Critical,
+	}
+	if diff := cmp.Diff(c.States, wantStates); diff != "" {
+		t.Errorf("Evaluate() states diff (-got +want):\n%s", diff)
+	}
+
+	if _, err := Evaluate(c, []byte(`{"results":{"results":[{"error":"database not found: telegraf"}]}}`)); err == nil || err.Error() != "database not found: telegraf" {
+		t.Errorf("Evaluate() of failed query error = %v", err)
+	}
+}
+
+func TestLevel(t *testing.T) {
+	thresholds := []chronograf.AlertCheckThreshold{
+		{Level: Critical, Value: 10},
+		{Level: Warning, Value: 20},
+	}
+	tests := []struct {
+		operator string
+		value    float64
+		want     string
+	}{
+		{"<", 5, Critical},
+		{"<", 15, Warning},
+		{"<", 20, OK},
+		{"<=", 20, Warning},
+		{"==", 10, Critical},
+		{"!=", 10, Warning},
+		{">", 25, Critical},
+		{"~", 25, OK},
+	}
+	for _, tt := range tests {
+		c := &chronograf.AlertCheck{Operator: tt.operator, Thresholds: thresholds}
+		if got := Level(c, tt.value); got != tt.want {
+			t.Errorf("Level(%s %v) = %v, want %v", tt.operator, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	valid := func() *chronograf.AlertCheck {
+		return &chronograf.AlertCheck{
+			Name:       "cpu",
+			Query:      `SELECT last("usage_user") FROM "cpu"`,
+			Every:      time.Minute,
+			Operator:   ">",
+			Thresholds: []chronograf.AlertCheckThreshold{{Level: Critical, Value: 90}},
+		}
+	}
+	if err := Validate(valid()); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		change func(c *chronograf.AlertCheck)
+	}{
+		{"no name", func(c *chronograf.AlertCheck) { c.Name = "" }},
+		{"no query", func(c *chronograf.AlertCheck) { c.Query = " " }},
+		{"too frequent", func(c *chronograf.AlertCheck) { c.Every = time.Second }},
+		{"unknown operator", func(c *chronograf.AlertCheck) { c.Operator = "=~" }},
+		{"no threshold", func(c *chronograf.AlertCheck) { c.Thresholds = nil }},
+		{"OK threshold", func(c *chronograf.AlertCheck) { c.Thresholds[0].Level = OK }},
+		{"unparsable message", func(c *chronograf.AlertCheck) { c.Message = "{{ .ID" }},
+	}
+	for _, tt := range tests {
+		c := valid()
+		tt.change(c)
+		if err := Validate(c); err == nil {
+			t.Errorf("Validate() %s error = nil", tt.name)
+		}
+	}
+}
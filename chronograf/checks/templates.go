@@ -0,0 +1,93 @@
+package checks
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// paramName is the syntax of the names of the parameters of rule templates
+var paramName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// ValidateTemplate checks the parameters of a rule template and that its
+// instances can be evaluated. Parameters without a default are set to 0 to
+// instantiate the template.
+func ValidateTemplate(t *chronograf.RuleTemplate) error {
+	if t.Name == "" {
+		return fmt.Errorf("name required on rule template")
+	}
+	seen := map[string]bool{}
+	params := map[string]string{}
+	for _, p := range t.Params {
+		if !paramName.MatchString(p.Name) {
+			return fmt.Errorf("invalid parameter name %q: names are letters, digits and underscores", p.Name)
+		}
+		if seen[p.Name] {
+			return fmt.Errorf("duplicate parameter %s", p.Name)
+		}
+		seen[p.Name] = true
+		if p.Default == "" {
+			params[p.Name] = "0"
+		}
+	}
+	return Instantiate(t, params, &chronograf.AlertCheck{})
+}
+
+// Instantiate sets the fields of c from a rule template, replacing each
+// reference :name: to a parameter with its value in params or its default.
+// Parameters without a default are required and params may only set
+// parameters of the template. Only params are recorded on c, so that
+// instances follow the changes of the defaults.
+func Instantiate(t *chronograf.RuleTemplate, params map[string]string, c *chronograf.AlertCheck) error {
+	values := map[string]string{}
+	for _, p := range t.Params {
+		values[p.Name] = p.Default
+	}
+	for name, v := range params {
+		if _, ok := values[name]; !ok {
+			return fmt.Errorf("unknown parameter %s of rule template %s", name, t.Name)
+		}
+		values[name] = v
+	}
+	oldnew := make([]string, 0, 2*len(t.Params))
+	for _, p := range t.Params {
+		if values[p.Name] == "" {
+			return fmt.Errorf("parameter %s of rule template %s required", p.Name, t.Name)
+		}
+		oldnew = append(oldnew, ":"+p.Name+":", values[p.Name])
+	}
+	r := strings.NewReplacer(oldnew...)
+
+	thresholds := make([]chronograf.AlertCheckThreshold, len(t.Thresholds))
+	for i, th := range t.Thresholds {
+		value := r.Replace(th.Value)
+		v, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return fmt.Errorf("value %q of threshold %s is not a number", value, th.Level)
+		}
+		thresholds[i] = chronograf.AlertCheckThreshold{
+			Level: th.Level,
+			Value: v,
+		}
+	}
+	c.Name = r.Replace(t.CheckName)
+	c.Query = r.Replace(t.Query)
+	c.DB = r.Replace(t.DB)
+	c.RP = r.Replace(t.RP)
+	c.Every = t.Every
+	c.Operator = t.Operator
+	c.Thresholds = thresholds
+	c.Message = r.Replace(t.Message)
+	c.Template = t.ID
+	c.Params = nil
+	if len(params) > 0 {
+		c.Params = make(map[string]string, len(params))
+		for name, v := range params {
+			c.Params[name] = v
+		}
+	}
+	return Validate(c)
+}
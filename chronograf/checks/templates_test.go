@@ -0,0 +1,148 @@
+package checks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func highCPU() *chronograf.RuleTemplate {
+	return &chronograf.RuleTemplate{
+		ID:   "1",
+		Name: "High CPU",
+		Params: []chronograf.RuleTemplateParam{
+			{Name: "host"},
+			{Name: "threshold", Default: "90"},
+		},
+		CheckName: "high_cpu_:host:",
+		Query:     `SELECT mean("usage_user") FROM "cpu" WHERE "host" = ':host:' AND time > now() - 5m`,
+		DB:        "telegraf",
+		Every:     time.Minute,
+		Operator:  ">",
+		Thresholds: []chronograf.RuleTemplateThreshold{
+			{Level: Critical, Value: ":threshold:"},
+			{Level: Warning, Value: "75"},
+		},
+		Message: "CPU of :host: is {{ .Level }}",
+	}
+}
+
+func TestInstantiate(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  map[string]string
+		want    chronograf.AlertCheck
+		wantErr bool
+	}{
+		{
+			name:   "Default threshold",
+			params: map[string]string{"host": "a"},
+			want: chronograf.AlertCheck{
+				Name:     "high_cpu_a",
+				Query:    `SELECT mean("usage_user") FROM "cpu" WHERE "host" = 'a' AND time > now() - 5m`,
+				DB:       "telegraf",
+				Every:    time.Minute,
+				Operator: ">",
+				Thresholds: []chronograf.AlertCheckThreshold{
+					{Level: Critical, Value: 90},
+					{Level: Warning, Value: 75},
+				},
+				Message:  "CPU of a is {{ .Level }}",
+				Template: "1",
+				Params:   map[string]string{"host": "a"},
+			},
+		},
+		{
+			name:   "Given threshold",
+			params: map[string]string{"host": "b", "threshold": "97.5"},
+			want: chronograf.AlertCheck{
+				Name:     "high_cpu_b",
+				Query:    `SELECT mean("usage_user") FROM "cpu" WHERE "host" = 'b' AND time > now() - 5m`,
+				DB:       "telegraf",
+				Every:    time.Minute,
+				Operator: ">",
+				Thresholds: []chronograf.AlertCheckThreshold{
+					{Level: Critical, Value: 97.5},
+					{Level: Warning, Value: 75},
+				},
+				Message:  "CPU of b is {{ .Level }}",
+				Template: "1",
+				Params:   map[string]string{"host": "b", "threshold": "97.5"},
+			},
+		},
+		{
+			name:    "Missing required parameter",
+			params:  map[string]string{"threshold": "80"},
+			wantErr: true,
+		},
+		{
+			name:    "Unknown parameter",
+			params:  map[string]string{"host": "a", "region": "us"},
+			wantErr: true,
+		},
+		{
+			name:    "Threshold not a number",
+			params:  map[string]string{"host": "a", "threshold": "high"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got chronograf.AlertCheck
+			err := Instantiate(highCPU(), tt.params, &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Instantiate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("Instantiate() diff (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateTemplate(t *testing.T) {
+	tests := []struct {
+		name    string
+		update  func(*chronograf.RuleTemplate)
+		wantErr bool
+	}{
+		{
+			name:   "Valid template",
+			update: func(*chronograf.RuleTemplate) {},
+		},
+		{
+			name:    "Invalid parameter name",
+			update:  func(t *chronograf.RuleTemplate) { t.Params[0].Name = "host name" },
+			wantErr: true,
+		},
+		{
+			name:    "Duplicate parameter",
+			update:  func(t *chronograf.RuleTemplate) { t.Params[1].Name = "host" },
+			wantErr: true,
+		},
+		{
+			name:    "Default threshold not a number",
+			update:  func(t *chronograf.RuleTemplate) { t.Params[1].Default = "high" },
+			wantErr: true,
+		},
+		{
+			name:    "Unknown operator",
+			update:  func(t *chronograf.RuleTemplate) { t.Operator = "=~" },
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl := highCPU()
+			tt.update(tmpl)
+			if err := ValidateTemplate(tmpl); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateTemplate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
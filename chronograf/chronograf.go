@@ -4,6 +4,7 @@ import (
 	"context"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -29,16 +30,39 @@ const (
 	ErrInvalidLegendType               = Error("Invalid legend type. Valid legend type is 'static'")
 	ErrInvalidLegendOrient             = Error("Invalid orientation type. Valid orientation types are 'top', 'bottom', 'right', 'left'")
 	ErrUserAlreadyExists               = Error("user already exists")
+	ErrUserSuspended                   = Error("user is suspended")
 	ErrOrganizationNotFound            = Error("organization not found")
 	ErrMappingNotFound                 = Error("mapping not found")
 	ErrOrganizationAlreadyExists       = Error("organization already exists")
 	ErrCannotDeleteDefaultOrganization = Error("cannot delete default organization")
 	ErrConfigNotFound                  = Error("cannot find configuration")
 	ErrAnnotationNotFound              = Error("annotation not found")
-	ErrInvalidCellOptionsText          = Error("invalid text wrapping option. Valid wrappings are 'truncate', 'wrap', and 'single line'")
+	ErrInvalidCellOptionsText          = Error("invalid text wrapping option. Valid wrappings are 'truncate', 'wrap', and 'single-line'")
+	ErrInvalidCellOptionsPageSize      = Error("invalid table page size. Page size must be between 0 and 1000")
+	ErrInvalidFieldFormat              = Error("invalid field format. Valid formats are 'number', 'percent', 'bytes', and 'duration'")
+	ErrInvalidFieldAlign               = Error("invalid field alignment. Valid alignments are 'left', 'center', and 'right'")
+	ErrInvalidThreshold                = Error("Invalid threshold. Color values must be unique numbers")
+	ErrInvalidGauge                    = Error("Invalid gauge. Gauges need one min and one max color with thresholds between them")
 	ErrInvalidCellOptionsSort          = Error("cell options sortby cannot be empty'")
 	ErrInvalidCellOptionsColumns       = Error("cell options columns cannot be empty'")
 	ErrOrganizationConfigNotFound      = Error("could not find organization config")
+	ErrNotificationNotFound            = Error("notification not found")
+	ErrTokenNotFound                   = Error("token not found")
+	ErrInvitationNotFound              = Error("invitation not found")
+	ErrCertificateMappingNotFound      = Error("certificate mapping not found")
+	ErrGroupNotFound                   = Error("group not found")
+	ErrWebhookNotFound                 = Error("webhook not found")
+	ErrPreferencesNotFound             = Error("preferences not found")
+	ErrDashboardVersionNotFound        = Error("dashboard version not found")
+	ErrFolderNotFound                  = Error("folder not found")
+	ErrDashboardSnapshotNotFound       = Error("dashboard snapshot not found")
+	ErrReportNotFound                  = Error("report not found")
+	ErrAlertEventNotFound              = Error("alert event not found")
+	ErrAlertWebhookNotFound            = Error("alert webhook not found")
+	ErrAlertCheckNotFound              = Error("alert check not found")
+	ErrAlertPolicyNotFound             = Error("alert policy not found")
+	ErrRuleTemplateNotFound            = Error("rule template not found")
+	ErrFluxTaskNotFound                = Error("flux task not found")
 )
 
 // Error is a domain error encountered while processing chronograf requests
@@ -109,6 +133,9 @@ const (
 	InfluxEnterprise = "influx-enterprise"
 	// InfluxRelay is the basic HA layer over InfluxDB
 	InfluxRelay = "influx-relay"
+	// InfluxDBv2 is InfluxDB 2.x, which authenticates with API tokens and
+	// stores data in the buckets of an organization
+	InfluxDBv2 = "influx-v2"
 )
 
 // TSDBStatus represents the current status of a time series database
@@ -149,6 +176,127 @@ type TimeSeries interface {
 	Roles(context.Context) (RolesStore, error)
 }
 
+// FluxQuerier is a TimeSeries able to run Flux scripts
+type FluxQuerier interface {
+	// FluxQuery runs a Flux script and returns its result as annotated CSV
+	FluxQuery(ctx context.Context, script string) ([]byte, error)
+}
+
+// ResultSeries is a series of the results of an InfluxQL query
+type ResultSeries struct {
+	Name    string            `json:"name"`
+	Tags    map[string]string `json:"tags,omitempty"`
+	Columns []string          `json:"columns"`
+	Values  [][]interface{}   `json:"values"`
+}
+
+// ChunkedQuerier is a TimeSeries able to stream the results of a query
+type ChunkedQuerier interface {
+	// ChunkedQuery runs a query and calls fn with its series as they arrive.
+	// The values of a series may be split across several calls of fn.
+	ChunkedQuery(ctx context.Context, q Query, fn func(ResultSeries) error) error
+	// ChunkedResponse runs a query and returns the chunks of its results
+	// as sent by the database, each of up to size points. The response
+	// must be closed.
+	ChunkedResponse(ctx context.Context, q Query, size int) (io.ReadCloser, error)
+}
+
+// QueryPlanNode is a step of running an InfluxQL query as shown by EXPLAIN
+// ANALYZE, such as planning it or creating the iterator of a shard
+type QueryPlanNode struct {
+	Name     string            `json:"name"`
+	Labels   map[string]string `json:"labels,omitempty"`   // Labels describe the step, e.g. its measurement and shard_id
+	Fields   map[string]string `json:"fields,omitempty"`   // Fields are the statistics of the step, e.g. cursors_ref and execution_time
+	Children []QueryPlanNode   `json:"children,omitempty"` // Children are the steps this step is made of
+}
+
+// QueryPlan is the plan of a SELECT statement and the statistics of running
+// it, as shown by EXPLAIN and EXPLAIN ANALYZE
+type QueryPlan struct {
+	Series        int64            `json:"series"`        // Series is the number of series the statement reads
+	Shards        int64            `json:"shards"`        // Shards is the number of shards the statement reads
+	ShardIDs      []uint64         `json:"shardIDs"`      // ShardIDs are the shards iterators were created for
+	Files         int64            `json:"files"`         // Files is the number of TSM files the statement reads
+	Blocks        int64            `json:"blocks"`        // Blocks is the number of TSM blocks the statement reads
+	BlocksSize    int64            `json:"blocksSize"`    // BlocksSize is the size in bytes of the blocks the statement reads
+	CachedValues  int64            `json:"cachedValues"`  // CachedValues is the number of values the statement reads from the cache
+	PlanningTime  string           `json:"planningTime"`  // PlanningTime is the time spent planning the statement
+	ExecutionTime string           `json:"executionTime"` // ExecutionTime is the time spent running the statement
+	TotalTime     string           `json:"totalTime"`     // TotalTime is the time spent planning and running the statement
+	Cursors       map[string]int64 `json:"cursors"`       // Cursors are the cursor statistics of all iterators, e.g. cursors_ref and float_blocks_decoded
+	Plan          []string         `json:"plan"`          // Plan is the text of EXPLAIN
+	Tree          QueryPlanNode    `json:"tree"`          // Tree is the parsed result of EXPLAIN ANALYZE
+}
+
+// QueryExplainer is a TimeSeries able to explain the plan of a query
+type QueryExplainer interface {
+	// Explain runs EXPLAIN and EXPLAIN ANALYZE for the SELECT statement of q.
+	// Note that EXPLAIN ANALYZE runs the statement.
+	Explain(ctx context.Context, q Query) (QueryPlan, error)
+}
+
+// Status of a Flux task
+const (
+	FluxTaskActive   = "active"   // FluxTaskActive tasks run on their schedule
+	FluxTaskInactive = "inactive" // FluxTaskInactive tasks do not run
+)
+
+// FluxTask is a Flux script InfluxDB 2.x runs on a schedule, such as one
+// downsampling a bucket. The name and schedule of a task are set by the
+// task option of its script.
+type FluxTask struct {
+	ID              string    `json:"id"`
+	Name            string    `json:"name"`
+	Description     string    `json:"description,omitempty"`
+	Status          string    `json:"status"`                  // Status is active or inactive
+	Flux            string    `json:"flux"`                    // Flux is the script of the task, declaring the task option
+	Every           string    `json:"every,omitempty"`         // Every is the interval of the runs of the task, e.g. 1h
+	Cron            string    `json:"cron,omitempty"`          // Cron is the schedule of tasks without an interval
+	Offset          string    `json:"offset,omitempty"`        // Offset delays the runs of the task
+	LatestCompleted time.Time `json:"latestCompleted"`         // LatestCompleted is the schedule of the latest run that finished
+	LastRunStatus   string    `json:"lastRunStatus,omitempty"` // LastRunStatus is success, failed or canceled
+	LastRunError    string    `json:"lastRunError,omitempty"`
+	CreatedAt       time.Time `json:"createdAt"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+// FluxTaskRun is a run of a Flux task. The times of runs that have not
+// started or finished yet are zero.
+type FluxTaskRun struct {
+	ID           string    `json:"id"`
+	TaskID       string    `json:"taskID"`
+	Status       string    `json:"status"`       // Status is scheduled, started, success, failed or canceled
+	ScheduledFor time.Time `json:"scheduledFor"` // ScheduledFor is the time the run processes data up to
+	StartedAt    time.Time `json:"startedAt"`
+	FinishedAt   time.Time `json:"finishedAt"`
+}
+
+// FluxTaskLog is a message logged by a run of a Flux task
+type FluxTaskLog struct {
+	RunID   string    `json:"runID"`
+	Time    time.Time `json:"time"`
+	Message string    `json:"message"`
+}
+
+// FluxTasker is a TimeSeries able to manage the Flux tasks of InfluxDB 2.x
+type FluxTasker interface {
+	// AllFluxTasks lists the tasks of the organization of the source
+	AllFluxTasks(ctx context.Context) ([]FluxTask, error)
+	// GetFluxTask retrieves a task, ErrFluxTaskNotFound if it does not exist
+	GetFluxTask(ctx context.Context, id string) (*FluxTask, error)
+	// CreateFluxTask creates a task from its script, description and status
+	CreateFluxTask(ctx context.Context, task *FluxTask) (*FluxTask, error)
+	// UpdateFluxTask replaces the script, description and status of a task
+	UpdateFluxTask(ctx context.Context, task *FluxTask) (*FluxTask, error)
+	// DeleteFluxTask removes a task along with its runs
+	DeleteFluxTask(ctx context.Context, id string) error
+	// FluxTaskRuns lists up to limit of the latest runs of a task
+	FluxTaskRuns(ctx context.Context, id string, limit int) ([]FluxTaskRun, error)
+	// FluxTaskLogs lists the logs of a task, only those of the run runID
+	// when it is not empty
+	FluxTaskLogs(ctx context.Context, id, runID string) ([]FluxTaskLog, error)
+}
+
 // Role is a restricted set of permissions assigned to a set of users.
 type Role struct {
 	Name         string      `json:"name"`
@@ -180,7 +328,7 @@ type Range struct {
 // TemplateValue is a value use to replace a template in an InfluxQL query
 type TemplateValue struct {
 	Value    string `json:"value"`         // Value is the specific value used to replace a template in an InfluxQL query
-	Type     string `json:"type"`          // Type can be tagKey, tagValue, fieldKey, csv, map, measurement, database, constant, influxql
+	Type     string `json:"type"`          // Type can be tagKey, tagValue, fieldKey, csv, map, measurement, database, constant, influxql, flux, url
 	Selected bool   `json:"selected"`      // Selected states that this variable has been picked to use for replacement
 	Key      string `json:"key,omitempty"` // Key is the key for the Value if the Template Type is 'map'
 }
@@ -198,7 +346,7 @@ type TemplateID string
 type Template struct {
 	TemplateVar
 	ID    TemplateID     `json:"id"`              // ID is the unique ID associated with this template
-	Type  string         `json:"type"`            // Type can be fieldKeys, tagKeys, tagValues, csv, constant, measurements, databases, map, influxql, text
+	Type  string         `json:"type"`            // Type can be fieldKeys, tagKeys, tagValues, csv, constant, measurements, databases, map, influxql, text, flux, url
 	Label string         `json:"label"`           // Label is a user-facing description of the Template
 	Query *TemplateQuery `json:"query,omitempty"` // Query is used to generate the choices for a template
 }
@@ -228,12 +376,16 @@ type DashboardQuery struct {
 
 // TemplateQuery is used to retrieve choices for template replacement
 type TemplateQuery struct {
-	Command     string `json:"influxql"`     // Command is the query itself
-	DB          string `json:"db,omitempty"` // DB is optional and if empty will not be used.
-	RP          string `json:"rp,omitempty"` // RP is a retention policy and optional; if empty will not be used.
-	Measurement string `json:"measurement"`  // Measurement is the optionally selected measurement for the query
-	TagKey      string `json:"tagKey"`       // TagKey is the optionally selected tag key for the query
-	FieldKey    string `json:"fieldKey"`     // FieldKey is the optionally selected field key for the query
+	Command     string `json:"influxql"`         // Command is the query itself
+	DB          string `json:"db,omitempty"`     // DB is optional and if empty will not be used.
+	RP          string `json:"rp,omitempty"`     // RP is a retention policy and optional; if empty will not be used.
+	Measurement string `json:"measurement"`      // Measurement is the optionally selected measurement for the query
+	TagKey      string `json:"tagKey"`           // TagKey is the optionally selected tag key for the query
+	FieldKey    string `json:"fieldKey"`         // FieldKey is the optionally selected field key for the query
+	Flux        string `json:"flux,omitempty"`   // Flux is the Flux script listing the choices of templates of type flux
+	URL         string `json:"url,omitempty"`    // URL is the address of the CSV or JSON list of choices of templates of type url
+	Format      string `json:"format,omitempty"` // Format is either csv or json; csv is the default
+	Column      string `json:"column,omitempty"` // Column is the column or key holding the choices of templates of type flux or url
 }
 
 // Response is the result of a query against a TimeSeries
@@ -246,12 +398,16 @@ type Source struct {
 	ID                 int    `json:"id,string"`                    // ID is the unique ID of the source
 	Name               string `json:"name"`                         // Name is the user-defined name for the source
 	Type               string `json:"type,omitempty"`               // Type specifies which kinds of source (enterprise vs oss)
-	Username           string `json:"username,omitempty"`           // Username is the username to connect to the source
-	Password           string `json:"password,omitempty"`           // Password is in CLEARTEXT
+	Username           string `json:"username,omitempty"`           // Username is the username to connect to the source, or the organization of InfluxDB 2.x sources
+	Password           string `json:"password,omitempty"`           // Password is in CLEARTEXT, and is the API token of InfluxDB 2.x sources
 	SharedSecret       string `json:"sharedSecret,omitempty"`       // ShareSecret is the optional signing secret for Influx JWT authorization
 	URL                string `json:"url"`                          // URL are the connections to the source
 	MetaURL            string `json:"metaUrl,omitempty"`            // MetaURL is the url for the meta node
 	InsecureSkipVerify bool   `json:"insecureSkipVerify,omitempty"` // InsecureSkipVerify as true means any certificate presented by the source is accepted.
+	TLSCA              string `json:"tlsCA,omitempty"`              // TLSCA is the PEM encoded bundle of certificate authorities verifying the source, in addition to those of the system
+	TLSCert            string `json:"tlsCert,omitempty"`            // TLSCert is the PEM encoded client certificate presented to sources requiring mutual TLS
+	TLSKey             string `json:"tlsKey,omitempty"`             // TLSKey is the PEM encoded private key of TLSCert and is in CLEARTEXT
+	Discovered         bool   `json:"discovered,omitempty"`         // Discovered is true for sources of the data nodes of the cluster of MetaURL, which are kept in sync with the cluster
 	Default            bool   `json:"default"`                      // Default specifies the default source for the application
 	Telegraf           string `json:"telegraf"`                     // Telegraf is the db telegraf is written to.  By default it is "telegraf"
 	Organization       string `json:"organization"`                 // Organization is the organization ID that resource belongs to
@@ -273,6 +429,274 @@ type SourcesStore interface {
 	Update(context.Context, Source) error
 }
 
+// Health statuses of sources and Kapacitors
+const (
+	// HealthUp is the status of a source or Kapacitor responding promptly
+	HealthUp = "up"
+	// HealthDegraded is the status of a source or Kapacitor responding slowly
+	HealthDegraded = "degraded"
+	// HealthDown is the status of a source or Kapacitor not responding
+	HealthDown = "down"
+	// HealthUnknown is the status of a source or Kapacitor not checked yet
+	HealthUnknown = "unknown"
+)
+
+// SourceHealth is the result of a health check of a source or of one of its
+// Kapacitors
+type SourceHealth struct {
+	SourceID  int           `json:"sourceID"`
+	ServerID  int           `json:"serverID,omitempty"` // ServerID is the ID of the Kapacitor checked; 0 when the source itself was checked
+	Status    string        `json:"status"`             // Status is either up, degraded or down
+	Version   string        `json:"version,omitempty"`
+	Build     string        `json:"build,omitempty"` // Build is the kind of source (e.g. influx-enterprise) or Kapacitor (e.g. OSS)
+	Latency   time.Duration `json:"latency"`
+	Error     string        `json:"error,omitempty"` // Error is the reason the check failed, if it did
+	CheckedAt time.Time     `json:"checkedAt"`
+}
+
+// SourceHealthStore is the storage and retrieval of the health checks of
+// sources and their Kapacitors
+type SourceHealthStore interface {
+	// All lists the health checks of a source and its Kapacitors, oldest first
+	All(ctx context.Context, sourceID int) ([]SourceHealth, error)
+	// Add records a health check, discarding the oldest checks of its
+	// source beyond the history kept by the store
+	Add(context.Context, *SourceHealth) error
+	// Delete removes the health checks of a source
+	Delete(ctx context.Context, sourceID int) error
+}
+
+// Statuses of the queries of the query history
+const (
+	QuerySuccess = "success"
+	QueryError   = "error"
+)
+
+// QueryHistoryEntry is a query a user ran against a source through the proxy
+type QueryHistoryEntry struct {
+	UserID       uint64        `json:"userID,string"`
+	SourceID     int           `json:"sourceID,string"`
+	Organization string        `json:"organization"` // Organization is the organization ID of the source queried
+	Query        string        `json:"query"`
+	DB           string        `json:"db,omitempty"`
+	RP           string        `json:"rp,omitempty"`
+	Duration     time.Duration `json:"duration"` // Duration is how long the query ran
+	Status       string        `json:"status"`   // Status is either success or error
+	Error        string        `json:"error,omitempty"`
+	RanAt        time.Time     `json:"ranAt"`
+}
+
+// QueryHistoryStore is the storage and retrieval of the queries run by users
+type QueryHistoryStore interface {
+	// All lists the queries of a user, oldest first
+	All(ctx context.Context, userID uint64) ([]QueryHistoryEntry, error)
+	// Add records a query, discarding the oldest queries of its user beyond
+	// the history kept by the store
+	Add(context.Context, *QueryHistoryEntry) error
+	// Delete removes the query history of a user
+	Delete(ctx context.Context, userID uint64) error
+}
+
+// AlertEventComment is a note a user left on an alert event while triaging it
+type AlertEventComment struct {
+	UserID  uint64    `json:"userID,string"`
+	Author  string    `json:"author"` // Author is the name of the user at the time of the comment
+	Text    string    `json:"text"`
+	Created time.Time `json:"created"`
+}
+
+// AlertEvent is a state transition of an alert received from a Kapacitor of
+// a source
+type AlertEvent struct {
+	ID             uint64              `json:"id,string"` // ID is unique among the alert events of a source
+	SourceID       int                 `json:"sourceID,string"`
+	AlertID        string              `json:"alertID"` // AlertID is the ID of the alert in Kapacitor, e.g. its rule and group
+	Message        string              `json:"message"`
+	Details        string              `json:"details,omitempty"`
+	Level          string              `json:"level"`                   // Level is one of OK, INFO, WARNING or CRITICAL
+	PreviousLevel  string              `json:"previousLevel,omitempty"` // PreviousLevel is the level the alert transitioned from
+	Duration       time.Duration       `json:"duration"`                // Duration is how long the alert has been in a level other than OK
+	Time           time.Time           `json:"time"`
+	Acknowledged   bool                `json:"acknowledged"`
+	AcknowledgedBy string              `json:"acknowledgedBy,omitempty"` // AcknowledgedBy is the name of the user who acknowledged the event
+	AcknowledgedAt time.Time           `json:"acknowledgedAt,omitempty"`
+	Comments       []AlertEventComment `json:"comments"`
+}
+
+// AlertEventsStore is the storage and retrieval of the alert events of sources
+type AlertEventsStore interface {
+	// All lists the alert events of a source, oldest first
+	All(ctx context.Context, sourceID int) ([]AlertEvent, error)
+	// Add records an alert event and sets its ID, discarding the oldest
+	// events of its source beyond the history kept by the store
+	Add(context.Context, *AlertEvent) error
+	// Get retrieves an alert event of a source
+	Get(ctx context.Context, sourceID int, id uint64) (*AlertEvent, error)
+	// Update replaces an alert event, e.g. to acknowledge or comment on it
+	Update(context.Context, *AlertEvent) error
+	// Delete removes the alert events of a source
+	Delete(ctx context.Context, sourceID int) error
+}
+
+// AlertWebhook relays the alerts Kapacitor sends to a source to an HTTP
+// endpoint. The payload of each alert is rendered from Template, which has
+// access to the fields and tags of the alert, and is signed with Secret.
+// Webhooks of Microsoft Teams, Discord and Google Chat are posted messages in
+// the format of their service instead, and PagerDuty is sent the events of
+// its Events API v2.
+type AlertWebhook struct {
+	ID       uint64            `json:"id,string"` // ID is unique among the webhooks of a source
+	SourceID int               `json:"sourceID,string"`
+	Name     string            `json:"name"`
+	Type     string            `json:"type"`     // Type is the service the payloads are formatted for: webhook if empty, teams, discord, googlechat or pagerduty
+	URL      string            `json:"url"`      // URL is the endpoint the payloads are posted to
+	Rules    []string          `json:"rules"`    // Rules are the names of the rules whose alerts are relayed; all alerts are relayed if empty
+	Headers  map[string]string `json:"headers"`  // Headers are added to each request
+	Template string            `json:"template"` // Template is the Go template of the JSON payload of webhooks of type webhook
+	Secret   string            `json:"-"`        // Secret is the HMAC-SHA256 key signing each payload, if any
+
+	RoutingKey  string            `json:"-"` // RoutingKey is the PagerDuty integration key of the alerts of webhooks of type pagerduty
+	RoutingKeys map[string]string `json:"-"` // RoutingKeys are the PagerDuty integration keys of the alerts of rules, replacing RoutingKey
+}
+
+// AlertWebhooksStore is the storage and retrieval of the alert webhooks of
+// sources
+type AlertWebhooksStore interface {
+	// All lists the webhooks of a source
+	All(ctx context.Context, sourceID int) ([]AlertWebhook, error)
+	// Add creates a new webhook of a source and sets its ID
+	Add(context.Context, *AlertWebhook) (*AlertWebhook, error)
+	// Get retrieves a webhook of a source
+	Get(ctx context.Context, sourceID int, id uint64) (*AlertWebhook, error)
+	// Update replaces a webhook
+	Update(context.Context, *AlertWebhook) error
+	// Delete removes a webhook
+	Delete(context.Context, *AlertWebhook) error
+}
+
+// AlertCheck is an alert rule evaluated by Chronograf itself, for installs
+// without Kapacitor. Every Every the Query runs against the source and the
+// last value of each series it returns is compared with the Thresholds.
+// Changes of the level of a series are recorded as alert events of the source
+// and relayed to its webhooks.
+type AlertCheck struct {
+	ID         uint64                `json:"id,string"` // ID is unique among the checks of a source
+	SourceID   int                   `json:"sourceID,string"`
+	Name       string                `json:"name"`
+	Query      string                `json:"query"` // Query is the InfluxQL query whose first field is checked
+	DB         string                `json:"db"`
+	RP         string                `json:"rp"`
+	Every      time.Duration         `json:"every"`
+	Operator   string                `json:"operator"`   // Operator compares values with thresholds: >, >=, <, <=, == or !=
+	Thresholds []AlertCheckThreshold `json:"thresholds"` // Thresholds are compared in order; the first one crossed sets the level, OK when none is
+	Message    string                `json:"message"`    // Message is the Go template of the message of alerts
+	Enabled    bool                  `json:"enabled"`
+	States     map[string]string     `json:"states"` // States is the last level of each series keyed by its alert ID
+	NextRun    time.Time             `json:"nextRun"`
+	LastRun    time.Time             `json:"lastRun"`
+	LastError  string                `json:"lastError"` // LastError is the reason the last run failed, if it did
+	Template   string                `json:"template"`  // Template is the ID of the rule template the check is an instance of, if it is one
+	Params     map[string]string     `json:"params"`    // Params are the values of the parameters of the rule template
+}
+
+// AlertCheckThreshold is the value past which a check reaches a level
+type AlertCheckThreshold struct {
+	Level string  `json:"level"` // Level is one of INFO, WARNING or CRITICAL
+	Value float64 `json:"value"`
+}
+
+// AlertChecksStore is the storage and retrieval of the alert checks of
+// sources
+type AlertChecksStore interface {
+	// All lists the checks of a source
+	All(ctx context.Context, sourceID int) ([]AlertCheck, error)
+	// Add creates a new check of a source and sets its ID
+	Add(context.Context, *AlertCheck) (*AlertCheck, error)
+	// Get retrieves a check of a source
+	Get(ctx context.Context, sourceID int, id uint64) (*AlertCheck, error)
+	// Update replaces a check
+	Update(context.Context, *AlertCheck) error
+	// Delete removes a check
+	Delete(context.Context, *AlertCheck) error
+}
+
+// AlertPolicy limits the notifications of the alerts of a rule that are
+// relayed to the webhooks of a source, to stop floods of notifications
+// during incidents. Alerts whose notifications are suppressed are still
+// recorded as alert events.
+type AlertPolicy struct {
+	ID          uint64        `json:"id,string"` // ID is unique among the policies of a source
+	SourceID    int           `json:"sourceID,string"`
+	Rule        string        `json:"rule"`        // Rule is the name of the rule or check whose alerts, including those of its groups, the policy applies to
+	Throttle    time.Duration `json:"throttle"`    // Throttle is the least time between two notifications of an alert ID; 0 does not throttle
+	FlapWindow  time.Duration `json:"flapWindow"`  // FlapWindow is the time the level changes of an alert ID are counted over
+	FlapChanges int           `json:"flapChanges"` // FlapChanges is the number of level changes within FlapWindow of a flapping alert ID; 0 does not detect flapping
+}
+
+// AlertPoliciesStore is the storage and retrieval of the alert policies of
+// sources
+type AlertPoliciesStore interface {
+	// All lists the policies of a source
+	All(ctx context.Context, sourceID int) ([]AlertPolicy, error)
+	// Add creates a new policy of a source and sets its ID
+	Add(context.Context, *AlertPolicy) (*AlertPolicy, error)
+	// Get retrieves a policy of a source
+	Get(ctx context.Context, sourceID int, id uint64) (*AlertPolicy, error)
+	// Update replaces a policy
+	Update(context.Context, *AlertPolicy) error
+	// Delete removes a policy
+	Delete(context.Context, *AlertPolicy) error
+}
+
+// RuleTemplate is a parameterized alert check defined once and instantiated
+// for each host or group with values of its parameters. Its check name,
+// query, database, retention policy, message and threshold values reference
+// parameters as :name:, like the template variables of dashboards. The checks
+// instantiated from a template follow its updates.
+type RuleTemplate struct {
+	ID           string                  `json:"id"`
+	Name         string                  `json:"name"`
+	Description  string                  `json:"description"`
+	Params       []RuleTemplateParam     `json:"params"`
+	CheckName    string                  `json:"checkName"` // CheckName is the name of the instantiated checks, such as high_cpu_:host:
+	Query        string                  `json:"query"`
+	DB           string                  `json:"db"`
+	RP           string                  `json:"rp"`
+	Every        time.Duration           `json:"every"`
+	Operator     string                  `json:"operator"`
+	Thresholds   []RuleTemplateThreshold `json:"thresholds"`
+	Message      string                  `json:"message"`
+	Organization string                  `json:"organization"` // Organization is the organization ID that resource belongs to
+}
+
+// RuleTemplateParam is a parameter of a rule template
+type RuleTemplateParam struct {
+	Name        string `json:"name"` // Name is referenced as :name: by the template
+	Description string `json:"description"`
+	Default     string `json:"default"` // Default is the value of instances that do not set the parameter; parameters without one are required
+}
+
+// RuleTemplateThreshold is a threshold of a rule template, whose value is
+// either a number or a parameter
+type RuleTemplateThreshold struct {
+	Level string `json:"level"`
+	Value string `json:"value"` // Value is a number such as 90 or a parameter such as :threshold:
+}
+
+// RuleTemplatesStore is the storage and retrieval of rule templates
+type RuleTemplatesStore interface {
+	// All lists all rule templates in the RuleTemplatesStore
+	All(context.Context) ([]RuleTemplate, error)
+	// Add creates a new rule template in the RuleTemplatesStore
+	Add(context.Context, *RuleTemplate) (*RuleTemplate, error)
+	// Delete the rule template from the RuleTemplatesStore
+	Delete(context.Context, *RuleTemplate) error
+	// Get retrieves a rule template by ID
+	Get(ctx context.Context, id string) (*RuleTemplate, error)
+	// Update replaces the rule template information
+	Update(context.Context, *RuleTemplate) error
+}
+
 // DBRP represents a database and retention policy for a time series source
 type DBRP struct {
 	DB string `json:"db"`
@@ -446,6 +870,30 @@ type User struct {
 	Provider    string      `json:"provider,omitempty"`
 	Scheme      string      `json:"scheme,omitempty"`
 	SuperAdmin  bool        `json:"superAdmin,omitempty"`
+	Status      string      `json:"status,omitempty"`
+	// PasswordHash is the bcrypt hash of the password of users of the basic scheme
+	PasswordHash string `json:"-"`
+	// TOTPSecret is the secret of the time-based one-time passwords of users
+	// of the basic scheme. Logins require them once TOTPEnabled.
+	TOTPSecret  string `json:"-"`
+	TOTPEnabled bool   `json:"-"`
+	// TOTPCounter is the time step of the last accepted one-time password,
+	// which may not be used again
+	TOTPCounter uint64 `json:"-"`
+	// RecoveryCodeHashes are the bcrypt hashes of the unused recovery codes,
+	// which replace a one-time password once each
+	RecoveryCodeHashes []string `json:"-"`
+}
+
+// Statuses of a Chronograf user. Users without a status are active.
+const (
+	UserStatusActive    = "active"
+	UserStatusSuspended = "suspended"
+)
+
+// Suspended returns true if u may not authenticate
+func (u *User) Suspended() bool {
+	return u.Status == UserStatusSuspended
 }
 
 // UserQuery represents the attributes that a user may be retrieved by.
@@ -471,14 +919,309 @@ type UsersStore interface {
 	All(context.Context) ([]User, error)
 	// Create a new User in the UsersStore
 	Add(context.Context, *User) (*User, error)
+	// AddMany creates either all of the users in the UsersStore or none of them
+	AddMany(context.Context, []*User) ([]*User, error)
 	// Delete the User from the UsersStore
 	Delete(context.Context, *User) error
 	// Get retrieves a user if name exists.
 	Get(ctx context.Context, q UserQuery) (*User, error)
 	// Update the user's permissions or roles
 	Update(context.Context, *User) error
+	// UpdateMany updates either all of the users in the UsersStore or none of them
+	UpdateMany(context.Context, []*User) error
 	// Num returns the number of users in the UsersStore
 	Num(context.Context) (int, error)
+	// Filter lists the users of the UsersStore matching the filter
+	Filter(context.Context, UserFilter) ([]User, error)
+}
+
+// UserFilter selects users by their attributes. Empty fields match every user.
+type UserFilter struct {
+	Name     string // Name matches users whose name contains Name, ignoring case
+	Provider string // Provider matches users authenticated by Provider
+	Scheme   string // Scheme matches users authenticated with Scheme
+	Role     string // Role matches users having a role named Role
+	Status   string // Status matches users that are active or suspended
+}
+
+// Matches returns true if u satisfies every non-empty field of the filter
+func (f UserFilter) Matches(u *User) bool {
+	if f.Name != "" && !strings.Contains(strings.ToLower(u.Name), strings.ToLower(f.Name)) {
+		return false
+	}
+	if f.Provider != "" && u.Provider != f.Provider {
+		return false
+	}
+	if f.Scheme != "" && u.Scheme != f.Scheme {
+		return false
+	}
+	if f.Status != "" && (f.Status == UserStatusSuspended) != u.Suspended() {
+		return false
+	}
+	if f.Role == "" {
+		return true
+	}
+	for _, r := range u.Roles {
+		if r.Name == f.Role {
+			return true
+		}
+	}
+	return false
+}
+
+// Kinds of notifications delivered to the inbox of a user
+const (
+	AlertNotification      = "alert"
+	InvitationNotification = "invitation"
+	BroadcastNotification  = "broadcast"
+)
+
+// Notification is a message within the inbox of a single user
+type Notification struct {
+	ID           string    `json:"id"`
+	UserID       uint64    `json:"userID,string"`          // UserID is the ID of the user whose inbox contains the notification
+	Organization string    `json:"organization,omitempty"` // Organization is the organization ID the notification relates to, if any
+	Type         string    `json:"type"`                   // Type is one of alert, invitation, or broadcast
+	Title        string    `json:"title"`
+	Message      string    `json:"message"`
+	Link         string    `json:"link,omitempty"` // Link is an optional location within chronograf the notification refers to
+	Read         bool      `json:"read"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// NotificationsStore is the storage and retrieval of the inboxes of users
+type NotificationsStore interface {
+	// All lists the notifications of a user
+	All(ctx context.Context, userID uint64) ([]Notification, error)
+	// Add creates a new notification in the NotificationsStore
+	Add(context.Context, *Notification) (*Notification, error)
+	// Delete the notification from the NotificationsStore
+	Delete(context.Context, *Notification) error
+	// Get retrieves a notification by ID
+	Get(ctx context.Context, id string) (*Notification, error)
+	// Update replaces the notification in the NotificationsStore
+	Update(context.Context, *Notification) error
+}
+
+// Themes of the chronograf UI a user may prefer
+const (
+	LightTheme = "light"
+	DarkTheme  = "dark"
+)
+
+// Preferences are the settings of a single user that follow them across
+// browsers. Empty fields fall back to the defaults of the UI.
+type Preferences struct {
+	UserID            uint64          `json:"userID,string"`              // UserID is the ID of the user the preferences belong to
+	DisplayName       string          `json:"displayName"`                // DisplayName is shown in place of the login name of the user
+	Timezone          string          `json:"timezone"`                   // Timezone is an IANA time zone name such as America/New_York
+	DefaultDashboard  DashboardID     `json:"defaultDashboard,omitempty"` // DefaultDashboard is the ID of the dashboard opened after login; zero is none
+	Theme             string          `json:"theme"`                      // Theme is either light or dark
+	StarredDashboards []DashboardID   `json:"-"`                          // StarredDashboards are the IDs of the dashboards starred by the user
+	RecentDashboards  []DashboardView `json:"-"`                          // RecentDashboards are the dashboards last viewed by the user, most recent first
+}
+
+// MaxRecentDashboards is the number of recently viewed dashboards kept for each user
+const MaxRecentDashboards = 20
+
+// DashboardView records when a user last opened a dashboard
+type DashboardView struct {
+	DashboardID DashboardID `json:"dashboardID"`
+	ViewedAt    time.Time   `json:"viewedAt"`
+}
+
+// PreferencesStore is the storage and retrieval of the preferences of users
+type PreferencesStore interface {
+	// Get retrieves the preferences of a user
+	Get(ctx context.Context, userID uint64) (*Preferences, error)
+	// Put replaces the preferences of a user, creating them if needed
+	Put(context.Context, *Preferences) error
+}
+
+// Token is a long-lived API token granting machine access to a single
+// organization without going through the OAuth flow. Only a hash of the
+// secret is stored; the secret itself is shown once when the token is created.
+type Token struct {
+	ID           string     `json:"id"`
+	Name         string     `json:"name"`
+	Organization string     `json:"organization"`     // Organization is the organization ID the token grants access to
+	Role         string     `json:"role"`             // Role is the role within the organization granted by the token
+	Scopes       []string   `json:"scopes"`           // Scopes restricts the token to some API resources, such as dashboards; empty means all
+	Hash         string     `json:"-"`                // Hash is the hex encoded SHA-256 of the secret of the token
+	CreatedBy    uint64     `json:"createdBy,string"` // CreatedBy is the ID of the user who created the token
+	CreatedAt    time.Time  `json:"createdAt"`
+	ExpiresAt    *time.Time `json:"expiresAt,omitempty"` // ExpiresAt is when the token stops being valid; tokens without it never expire
+}
+
+// Expired is true when the token is no longer valid at now
+func (t *Token) Expired(now time.Time) bool {
+	return t.ExpiresAt != nil && !now.Before(*t.ExpiresAt)
+}
+
+// Allows is true when the scopes of the token include the API resource
+func (t *Token) Allows(resource string) bool {
+	if len(t.Scopes) == 0 {
+		return true
+	}
+	for _, s := range t.Scopes {
+		if s == resource {
+			return true
+		}
+	}
+	return false
+}
+
+// TokenQuery represents the attributes that a token may be retrieved by.
+// It is predominantly used in the TokensStore.Get method.
+type TokenQuery struct {
+	ID   *string
+	Hash *string
+}
+
+// TokensStore is the storage and retrieval of API tokens
+type TokensStore interface {
+	// All lists all tokens in the TokensStore
+	All(context.Context) ([]Token, error)
+	// Add creates a new token in the TokensStore
+	Add(context.Context, *Token) (*Token, error)
+	// Delete the token from the TokensStore
+	Delete(context.Context, *Token) error
+	// Get retrieves a token by ID or by the hash of its secret
+	Get(ctx context.Context, q TokenQuery) (*Token, error)
+	// Update replaces the token in the TokensStore
+	Update(context.Context, *Token) error
+}
+
+// Invitation is a pending offer of a role within an organization to the user
+// of a provider identity, who may not have logged in yet. The invitation is
+// removed once the user accepts or declines it.
+type Invitation struct {
+	ID           string    `json:"id"`
+	Organization string    `json:"organization"`     // Organization is the organization ID the user is invited into
+	Role         string    `json:"role"`             // Role is the role within the organization granted on acceptance
+	Name         string    `json:"name"`             // Name is the username of the invitee at the provider
+	Provider     string    `json:"provider"`         // Provider is the authentication provider of the invitee, e.g. github
+	Scheme       string    `json:"scheme"`           // Scheme is the authentication scheme of the provider
+	InvitedBy    uint64    `json:"invitedBy,string"` // InvitedBy is the ID of the user who sent the invitation
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// InvitationsStore is the storage and retrieval of pending invitations
+type InvitationsStore interface {
+	// All lists all invitations in the InvitationsStore
+	All(context.Context) ([]Invitation, error)
+	// Add creates a new invitation in the InvitationsStore
+	Add(context.Context, *Invitation) (*Invitation, error)
+	// Delete the invitation from the InvitationsStore
+	Delete(context.Context, *Invitation) error
+	// Get retrieves an invitation by ID
+	Get(ctx context.Context, id string) (*Invitation, error)
+}
+
+// CertificateMapping maps the subject of a TLS client certificate to a
+// user, so that services presenting the certificate call the API as that
+// user without logging in
+type CertificateMapping struct {
+	ID           string `json:"id"`
+	Subject      string `json:"subject"`        // Subject is the distinguished name of the certificate, e.g. CN=ingest,OU=services,O=Acme
+	UserID       uint64 `json:"userId,string"`  // UserID is the ID of the user the certificate authenticates as
+	Organization string `json:"organizationId"` // Organization is the organization ID of the requests; the default organization when empty
+}
+
+// CertificatesStore is the storage and retrieval of the mappings of
+// TLS client certificates to users
+type CertificatesStore interface {
+	// All lists all certificate mappings in the CertificatesStore
+	All(context.Context) ([]CertificateMapping, error)
+	// Add creates a new certificate mapping in the CertificatesStore
+	Add(context.Context, *CertificateMapping) (*CertificateMapping, error)
+	// Delete the certificate mapping from the CertificatesStore
+	Delete(context.Context, *CertificateMapping) error
+	// Get retrieves a certificate mapping by ID
+	Get(ctx context.Context, id string) (*CertificateMapping, error)
+	// Update replaces the certificate mapping in the CertificatesStore
+	Update(context.Context, *CertificateMapping) error
+}
+
+// Group is a set of users who have the roles of the group in addition to
+// their own, so that roles are granted to teams rather than to each of their
+// members. Within an organization a member has the most privileged of their
+// own role and the roles of their groups.
+type Group struct {
+	ID    string   `json:"id"`
+	Name  string   `json:"name"`  // Name is the unique name of the group, e.g. on-call
+	Users []uint64 `json:"users"` // Users are the IDs of the members of the group
+	Roles []Role   `json:"roles"` // Roles are the roles of the members, at most one per organization
+}
+
+// GroupsStore is the storage and retrieval of groups of users
+type GroupsStore interface {
+	// All lists all groups in the GroupsStore
+	All(context.Context) ([]Group, error)
+	// Add creates a new group in the GroupsStore
+	Add(context.Context, *Group) (*Group, error)
+	// Delete the group from the GroupsStore
+	Delete(context.Context, *Group) error
+	// Get retrieves a group by ID
+	Get(ctx context.Context, id string) (*Group, error)
+	// Update replaces the group in the GroupsStore
+	Update(context.Context, *Group) error
+}
+
+// Webhook posts the events of Chronograf, such as the creation of a user or
+// the update of a dashboard, to an HTTP endpoint, so that external catalogs
+// stay in sync with Chronograf. Each payload is signed with Secret.
+type Webhook struct {
+	ID     string   `json:"id"`
+	Name   string   `json:"name"`
+	URL    string   `json:"url"`    // URL is the endpoint the events are posted to
+	Events []string `json:"events"` // Events are the types of the events posted, e.g. dashboard.updated; all events are posted if empty
+	Secret string   `json:"-"`      // Secret is the HMAC-SHA256 key signing each payload, if any
+}
+
+// WebhooksStore is the storage and retrieval of the webhooks of events
+type WebhooksStore interface {
+	// All lists all webhooks in the WebhooksStore
+	All(context.Context) ([]Webhook, error)
+	// Add creates a new webhook in the WebhooksStore
+	Add(context.Context, *Webhook) (*Webhook, error)
+	// Delete the webhook from the WebhooksStore
+	Delete(context.Context, *Webhook) error
+	// Get retrieves a webhook by ID
+	Get(ctx context.Context, id string) (*Webhook, error)
+	// Update replaces the webhook in the WebhooksStore
+	Update(context.Context, *Webhook) error
+}
+
+// WebhookDelivery is an attempt to post an event to a webhook
+type WebhookDelivery struct {
+	WebhookID   string        `json:"webhookId"`
+	EventID     string        `json:"eventId"`              // EventID is the same for all attempts to deliver an event
+	Event       string        `json:"event"`                // Event is the type of the event, e.g. user.created
+	Attempt     int           `json:"attempt"`              // Attempt counts the deliveries of the event, starting at 1
+	StatusCode  int           `json:"statusCode,omitempty"` // StatusCode is the HTTP status of the response, if any
+	Duration    time.Duration `json:"duration"`
+	Status      string        `json:"status"` // Status is either success or error
+	Error       string        `json:"error,omitempty"`
+	DeliveredAt time.Time     `json:"deliveredAt"`
+}
+
+// Statuses of the deliveries of webhooks
+const (
+	WebhookDeliverySuccess = "success"
+	WebhookDeliveryError   = "error"
+)
+
+// WebhookDeliveriesStore is the storage and retrieval of the log of the
+// deliveries of events to webhooks
+type WebhookDeliveriesStore interface {
+	// All lists the deliveries of a webhook, oldest first
+	All(ctx context.Context, webhookID string) ([]WebhookDelivery, error)
+	// Add records a delivery, discarding the oldest deliveries of its
+	// webhook beyond the log kept by the store
+	Add(context.Context, *WebhookDelivery) error
+	// Delete removes the deliveries of a webhook
+	Delete(ctx context.Context, webhookID string) error
 }
 
 // Database represents a database in a time series source
@@ -498,11 +1241,45 @@ type RetentionPolicy struct {
 	Default       bool   `json:"isDefault,omitempty"`     // whether the RP should be the default
 }
 
+// ContinuousQuery represents a continuous query in a time series source
+type ContinuousQuery struct {
+	Name          string `json:"name"`                    // a unique string identifier for the continuous query within its database
+	Query         string `json:"query"`                   // the SELECT ... INTO ... GROUP BY time() statement run by the continuous query
+	ResampleEvery string `json:"resampleEvery,omitempty"` // how often the query runs; defaults to the GROUP BY time() interval
+	ResampleFor   string `json:"resampleFor,omitempty"`   // the time range covered by each run; defaults to the GROUP BY time() interval
+}
+
+// Shard represents a shard of a retention policy in a time series source
+type Shard struct {
+	ID              uint64    `json:"id,string"`       // the unique identifier of the shard
+	RetentionPolicy string    `json:"retentionPolicy"` // the retention policy the shard belongs to
+	StartTime       time.Time `json:"startTime"`       // the start of the time range of the shard
+	EndTime         time.Time `json:"endTime"`         // the end of the time range of the shard
+	ExpiryTime      time.Time `json:"expiryTime"`      // when the shard is dropped by the retention policy
+}
+
+// RunningQuery represents a query running in a time series source
+type RunningQuery struct {
+	ID       uint64 `json:"id,string"`          // the identifier of the query assigned by the source
+	Query    string `json:"query"`              // the InfluxQL of the query
+	Database string `json:"database,omitempty"` // the database the query runs against
+	Duration string `json:"duration"`           // how long the query has been running, e.g. 1m30s
+	Status   string `json:"status,omitempty"`   // whether the query is running or being killed
+	Host     string `json:"host,omitempty"`     // the TCP address of the InfluxDB Enterprise data node running the query
+}
+
 // Measurement represents a measurement in a time series source
 type Measurement struct {
 	Name string `json:"name"` // a unique string identifier for the measurement
 }
 
+// Cardinality represents how many series a measurement has, or how many values
+// a tag key has, in a time series source
+type Cardinality struct {
+	Name  string `json:"name"`  // the name of the measurement or tag key
+	Count int64  `json:"count"` // the number of series of the measurement or of values of the tag key
+}
+
 // Databases represents a databases in a time series source
 type Databases interface {
 	// AllDB lists all databases in the current data source
@@ -522,18 +1299,49 @@ type Databases interface {
 	UpdateRP(context.Context, string, string, *RetentionPolicy) (*RetentionPolicy, error)
 	// DropRP drops a retention policy in the current data source
 	DropRP(context.Context, string, string) error
+	// AllShards lists the shards of a database in the current data source
+	AllShards(context.Context, string) ([]Shard, error)
+
+	// AllCQ lists all continuous queries of a database in the current data source
+	AllCQ(context.Context, string) ([]ContinuousQuery, error)
+	// CreateCQ creates a continuous query in the current data source
+	CreateCQ(context.Context, string, *ContinuousQuery) (*ContinuousQuery, error)
+	// DropCQ drops a continuous query in the current data source
+	DropCQ(context.Context, string, string) error
+
+	// AllQueries lists the queries running in the current data source
+	AllQueries(context.Context) ([]RunningQuery, error)
+	// KillQuery stops a running query of the current data source
+	KillQuery(context.Context, *RunningQuery) error
 
 	// GetMeasurements lists measurements in the current data source
 	GetMeasurements(ctx context.Context, db string, limit, offset int) ([]Measurement, error)
+
+	// SeriesCardinality estimates the number of series of a database in the current data source
+	SeriesCardinality(ctx context.Context, db string) (int64, error)
+	// MeasurementsCardinality counts the series of every measurement of a database in the current data source
+	MeasurementsCardinality(ctx context.Context, db string) ([]Cardinality, error)
+	// TagKeysCardinality counts the values of every tag key of a measurement in the current data source
+	TagKeysCardinality(ctx context.Context, db, measurement string) ([]Cardinality, error)
 }
 
-// Annotation represents a time-based metadata associated with a source
+// Annotation represents a time-based metadata associated with a source or,
+// when stored by Chronograf, with an organization. An annotation whose EndTime
+// is after its StartTime marks a region rather than a point in time.
 type Annotation struct {
-	ID        string    // ID is the unique annotation identifier
-	StartTime time.Time // StartTime starts the annotation
-	EndTime   time.Time // EndTime ends the annotation
-	Text      string    // Text is the associated user-facing text describing the annotation
-	Type      string    // Type describes the kind of annotation
+	ID           string    // ID is the unique annotation identifier
+	StartTime    time.Time // StartTime starts the annotation
+	EndTime      time.Time // EndTime ends the annotation
+	Text         string    // Text is the associated user-facing text describing the annotation
+	Type         string    // Type describes the kind of annotation
+	Stream       string    // Stream is the name of the stream, such as deploys, the annotation belongs to
+	Tags         []string  // Tags are the labels annotations are filtered by
+	Organization string    // Organization is the organization ID that resource belongs to
+}
+
+// Region is true when the annotation spans a period of time
+func (a *Annotation) Region() bool {
+	return a.EndTime.After(a.StartTime)
 }
 
 // AnnotationStore represents storage and retrieval of annotations
@@ -554,7 +1362,10 @@ type Dashboard struct {
 	Cells        []DashboardCell `json:"cells"`
 	Templates    []Template      `json:"templates"`
 	Name         string          `json:"name"`
-	Organization string          `json:"organization"` // Organization is the organization ID that resource belongs to
+	Organization string          `json:"organization"`       // Organization is the organization ID that resource belongs to
+	Folder       string          `json:"folder,omitempty"`   // Folder is the ID of the folder of the dashboard; empty is the top level
+	CacheTTL     string          `json:"cacheTTL,omitempty"` // CacheTTL is how long query results of the dashboard are cached, such as 30s; empty is the server default
+	Slug         string          `json:"slug,omitempty"`     // Slug is the natural key of the dashboard, unique within its organization, such as hosts-overview
 }
 
 // Axis represents the visible extents of a visualization
@@ -600,6 +1411,8 @@ type DashboardCell struct {
 	FieldOptions  []RenamableField `json:"fieldOptions"`
 	TimeFormat    string           `json:"timeFormat"`
 	DecimalPlaces DecimalPlaces    `json:"decimalPlaces"`
+	Prefix        string           `json:"prefix,omitempty"` // Prefix is the unit shown before the values of single-stat, gauge and table cells
+	Suffix        string           `json:"suffix,omitempty"` // Suffix is the unit shown after the values of single-stat, gauge and table cells
 }
 
 // RenamableField is a column/row field in a DashboardCell of type Table
@@ -607,6 +1420,8 @@ type RenamableField struct {
 	InternalName string `json:"internalName"`
 	DisplayName  string `json:"displayName"`
 	Visible      bool   `json:"visible"`
+	Format       string `json:"format,omitempty"` // Format is how the values of the field are formatted. Supported: "number", "percent", "bytes", "duration"
+	Align        string `json:"align,omitempty"`  // Align is how the values of the field are aligned. Supported: "left", "center", "right"
 }
 
 // TableOptions is a type of options for a DashboardCell with type Table
@@ -615,6 +1430,7 @@ type TableOptions struct {
 	SortBy           RenamableField `json:"sortBy"`
 	Wrapping         string         `json:"wrapping"`
 	FixFirstColumn   bool           `json:"fixFirstColumn"`
+	PageSize         int32          `json:"pageSize,omitempty"` // PageSize is the number of rows per page of the table, all rows if zero
 }
 
 // DecimalPlaces indicates whether decimal places should be enforced, and how many digits it should show.
@@ -637,6 +1453,132 @@ type DashboardsStore interface {
 	Update(context.Context, Dashboard) error
 }
 
+// Folder groups the dashboards of an organization. Folders nest within a
+// parent folder and may require a minimum role to see them along with the
+// dashboards and folders within them.
+type Folder struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Parent       string `json:"parent"`       // Parent is the ID of the parent folder; empty is the top level
+	Organization string `json:"organization"` // Organization is the organization ID that resource belongs to
+	Role         string `json:"role"`         // Role is the minimum role required to see the folder; empty is any role
+}
+
+// FoldersStore is the storage and retrieval of dashboard folders
+type FoldersStore interface {
+	// All lists all folders in the FoldersStore
+	All(context.Context) ([]Folder, error)
+	// Add creates a new folder in the FoldersStore
+	Add(context.Context, *Folder) (*Folder, error)
+	// Delete the folder from the FoldersStore
+	Delete(context.Context, *Folder) error
+	// Get retrieves a folder by ID
+	Get(ctx context.Context, id string) (*Folder, error)
+	// Update replaces the folder information
+	Update(context.Context, *Folder) error
+}
+
+// Report formats
+const (
+	// ReportFormatHTML reports summarize the series of each cell in a table
+	ReportFormatHTML = "html"
+	// ReportFormatPNG reports add a chart of the series of each cell
+	ReportFormatPNG = "png"
+)
+
+// Report emails a summary of a dashboard to its recipients on a schedule.
+// The report runs at Start and then every Every, querying the cells of the
+// dashboard over the Range before each run.
+type Report struct {
+	ID           string        `json:"id"`
+	Name         string        `json:"name"`
+	DashboardID  DashboardID   `json:"dashboardID"`
+	Source       string        `json:"source"` // Source is the ID of the source of queries without one
+	Start        time.Time     `json:"start"`
+	Every        time.Duration `json:"every"`
+	Range        time.Duration `json:"range"`
+	Recipients   []string      `json:"recipients"`
+	Format       string        `json:"format"`       // Format is either html or png
+	Organization string        `json:"organization"` // Organization is the organization ID that resource belongs to
+	NextRun      time.Time     `json:"nextRun"`
+	LastRun      time.Time     `json:"lastRun"`
+	LastError    string        `json:"lastError"` // LastError is the reason the last run failed, if it did
+}
+
+// ReportsStore is the storage and retrieval of scheduled reports
+type ReportsStore interface {
+	// All lists all reports in the ReportsStore
+	All(context.Context) ([]Report, error)
+	// Add creates a new report in the ReportsStore
+	Add(context.Context, *Report) (*Report, error)
+	// Delete the report from the ReportsStore
+	Delete(context.Context, *Report) error
+	// Get retrieves a report by ID
+	Get(ctx context.Context, id string) (*Report, error)
+	// Update replaces the report information
+	Update(context.Context, *Report) error
+}
+
+// DashboardVersion is an immutable revision of a dashboard recorded whenever
+// the dashboard is written so that a bad edit can be rolled back.
+type DashboardVersion struct {
+	DashboardID DashboardID `json:"dashboardID"`
+	Revision    int         `json:"revision"` // Revision increases with every write of the dashboard starting at 1
+	Dashboard   Dashboard   `json:"dashboard"`
+	Author      string      `json:"author"` // Author is the name of the user who wrote the dashboard; empty without authentication
+	CreatedAt   time.Time   `json:"createdAt"`
+}
+
+// DashboardVersionsStore is the storage and retrieval of dashboard revisions
+type DashboardVersionsStore interface {
+	// All lists the revisions of a dashboard, oldest first
+	All(ctx context.Context, id DashboardID) ([]DashboardVersion, error)
+	// Add records the next revision of a dashboard
+	Add(context.Context, *DashboardVersion) (*DashboardVersion, error)
+	// Delete removes all revisions of a dashboard
+	Delete(ctx context.Context, id DashboardID) error
+	// Get retrieves a revision of a dashboard
+	Get(ctx context.Context, id DashboardID, revision int) (*DashboardVersion, error)
+}
+
+// DashboardSnapshot is an immutable copy of a dashboard along with the
+// results of the queries of its cells at the time the snapshot was taken. It
+// remains viewable after the data queried has expired.
+type DashboardSnapshot struct {
+	ID          int              `json:"id"`
+	DashboardID DashboardID      `json:"dashboardID"`
+	Name        string           `json:"name"`
+	Dashboard   Dashboard        `json:"dashboard"`
+	Lower       string           `json:"lower"` // Lower is the start of the time range of the queries
+	Upper       string           `json:"upper"` // Upper is the end of the time range of the queries
+	Results     []SnapshotResult `json:"results"`
+	Author      string           `json:"author"` // Author is the name of the user who took the snapshot; empty without authentication
+	CreatedAt   time.Time        `json:"createdAt"`
+}
+
+// SnapshotResult is the frozen result of a single query of a dashboard cell
+type SnapshotResult struct {
+	CellID string `json:"cellID"`
+	Query  string `json:"query"`  // Query is the query run with its template variables replaced
+	Source string `json:"source"` // Source is the link of the source queried
+	Result []byte `json:"result"` // Result is the JSON response of the source
+	Error  string `json:"error"`  // Error is the reason the query failed, if it did
+}
+
+// DashboardSnapshotsStore is the storage and retrieval of dashboard snapshots
+type DashboardSnapshotsStore interface {
+	// All lists the snapshots of a dashboard, oldest first
+	All(ctx context.Context, id DashboardID) ([]DashboardSnapshot, error)
+	// Add stores a new snapshot of a dashboard
+	Add(context.Context, *DashboardSnapshot) (*DashboardSnapshot, error)
+	// Delete removes a snapshot of a dashboard
+	Delete(context.Context, *DashboardSnapshot) error
+	// DeleteAll removes all snapshots of a dashboard
+	DeleteAll(ctx context.Context, id DashboardID) error
+	// Get retrieves a snapshot of a dashboard
+	Get(ctx context.Context, id DashboardID, snapshot int) (*DashboardSnapshot, error)
+}
+
 // Cell is a rectangle and multiple time series queries to visualize.
 type Cell struct {
 	X          int32           `json:"x"`
@@ -683,19 +1625,27 @@ const MappingWildcard string = "*"
 // explicit role within the organization.
 //
 // One can think of a mapping like so:
-//     Provider:Scheme:Group -> Organization
-//     github:oauth2:influxdata -> Happy
-//     beyondcorp:ldap:influxdata -> TheBillHilliettas
+//
+//	Provider:Scheme:Group -> Organization
+//	github:oauth2:influxdata -> Happy
+//	beyondcorp:ldap:influxdata -> TheBillHilliettas
 //
 // Any of Provider, Scheme, or Group may be provided as a wildcard *
-//     github:oauth2:* -> MyOrg
-//     *:*:* -> AllOrg
+//
+//	github:oauth2:* -> MyOrg
+//	*:*:* -> AllOrg
+//
+// A mapping may also grant a role within the organization; without a role
+// the default role of the organization is granted
+//
+//	github:oauth2:influxdata/team-ops -> Happy:admin
 type Mapping struct {
 	ID                   string `json:"id"`
 	Organization         string `json:"organizationId"`
 	Provider             string `json:"provider"`
 	Scheme               string `json:"scheme"`
 	ProviderOrganization string `json:"providerOrganization"`
+	Role                 string `json:"role,omitempty"` // Role is granted within Organization; empty grants its default role
 }
 
 // MappingsStore is the storage and retrieval of Mappings
@@ -720,6 +1670,16 @@ type Organization struct {
 	Name string `json:"name"`
 	// DefaultRole is the name of the role that is the default for any users added to the organization
 	DefaultRole string `json:"defaultRole,omitempty"`
+	// Quotas limit the resources of the organization; unlimited when nil
+	Quotas *OrganizationQuotas `json:"quotas,omitempty"`
+}
+
+// OrganizationQuotas are the maximum number of resources within an
+// organization. Zero means unlimited.
+type OrganizationQuotas struct {
+	MaxDashboards     int `json:"maxDashboards"`     // MaxDashboards is the maximum number of dashboards
+	MaxUsers          int `json:"maxUsers"`          // MaxUsers is the maximum number of users with a role in the organization
+	MaxKapacitorRules int `json:"maxKapacitorRules"` // MaxKapacitorRules is the maximum number of tasks across the Kapacitors of the organization
 }
 
 // OrganizationQuery represents the attributes that a organization may be retrieved by.
@@ -767,6 +1727,15 @@ type Config struct {
 type AuthConfig struct {
 	// SuperAdminNewUsers configuration option that specifies which users will auto become super admin
 	SuperAdminNewUsers bool `json:"superAdminNewUsers"`
+	// AutoProvisionNewUsers specifies whether unknown users who authenticate
+	// successfully are created even if no mapping grants them a role
+	AutoProvisionNewUsers bool `json:"autoProvisionNewUsers"`
+	// NewUsersOrganization is the ID of the organization auto-provisioned users
+	// are added to; the default organization when empty
+	NewUsersOrganization string `json:"newUsersOrganization"`
+	// NewUsersRole is the role of auto-provisioned users within their
+	// organization; the default role of the organization when empty
+	NewUsersRole string `json:"newUsersRole"`
 }
 
 // ConfigStore is the storage and retrieval of global application Config
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf/encryption"
+)
+
+type EncryptCommand struct {
+	BoltPath      string `short:"b" long:"bolt-path" description:"Full path to boltDB file (e.g. './chronograf-v1.db')" env:"BOLT_PATH" default:"chronograf-v1.db"`
+	EncryptionKey string `long:"encryption-key" description:"Base64 encoded 32 byte master key encrypting the passwords of sources and kapacitors" env:"ENCRYPTION_KEY"`
+	VaultAddr     string `long:"encryption-vault-addr" description:"URL of HashiCorp Vault whose transit secrets engine holds the master key" env:"ENCRYPTION_VAULT_ADDR"`
+	VaultToken    string `long:"encryption-vault-token" description:"Token authenticating with Vault" env:"ENCRYPTION_VAULT_TOKEN"`
+	VaultMount    string `long:"encryption-vault-mount" description:"Path of the transit secrets engine in Vault" env:"ENCRYPTION_VAULT_MOUNT" default:"transit"`
+	VaultKey      string `long:"encryption-vault-key" description:"Name of the transit key in Vault" env:"ENCRYPTION_VAULT_KEY" default:"chronograf"`
+}
+
+var encryptCommand EncryptCommand
+
+func (l *EncryptCommand) Execute(args []string) error {
+	var wrapper encryption.KeyWrapper
+	switch {
+	case l.EncryptionKey != "" && l.VaultAddr != "":
+		return fmt.Errorf("only one of encryption-key and encryption-vault-addr can be set")
+	case l.EncryptionKey != "":
+		k, err := encryption.NewLocalKey(l.EncryptionKey)
+		if err != nil {
+			return err
+		}
+		wrapper = k
+	case l.VaultAddr != "":
+		wrapper = &encryption.VaultTransit{
+			Addr:    l.VaultAddr,
+			Token:   l.VaultToken,
+			Mount:   l.VaultMount,
+			Key:     l.VaultKey,
+			Timeout: 10 * time.Second,
+		}
+	default:
+		return fmt.Errorf("one of encryption-key and encryption-vault-addr is required")
+	}
+
+	ctx := context.Background()
+	cipher, err := encryption.NewCipher(ctx, wrapper)
+	if err != nil {
+		return err
+	}
+
+	c, err := NewBoltClient(l.BoltPath)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	n, err := encryption.Migrate(ctx, c.SourcesStore, c.ServersStore, cipher)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Encrypted the secrets of %d sources and kapacitors\n", n)
+
+	return nil
+}
+
+func init() {
+	parser.AddCommand("encrypt-secrets",
+		"Encrypts the secrets stored in CLEARTEXT",
+		"The encrypt-secrets command will encrypt the passwords of sources and kapacitors in the chronograf boltdb instance that are stored in CLEARTEXT, e.g. before chronograf was started with an encryption key",
+		&encryptCommand)
+}
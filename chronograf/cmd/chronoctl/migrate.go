@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+type MigrateCommand struct {
+	BoltPath string `short:"b" long:"bolt-path" description:"Full path to boltDB file (e.g. './chronograf-v1.db')" env:"BOLT_PATH" default:"chronograf-v1.db"`
+	Version  *int   `short:"v" long:"version" description:"Schema version to migrate or roll back to. Defaults to the latest version"`
+	DryRun   bool   `short:"n" long:"dry-run" description:"List the migrations that would run without running them"`
+}
+
+var migrateCommand MigrateCommand
+
+func (l *MigrateCommand) Execute(args []string) error {
+	c := bolt.NewClient()
+	c.Path = l.BoltPath
+	c.SkipMigrations = true
+
+	ctx := context.Background()
+	var bi chronograf.BuildInfo
+	if err := c.Open(ctx, mocks.NewLogger(), bi); err != nil {
+		return err
+	}
+	defer c.Close()
+
+	current, err := c.SchemaVersion(ctx)
+	if err != nil {
+		return err
+	}
+	version := bolt.LatestSchemaVersion()
+	if l.Version != nil {
+		version = *l.Version
+	}
+
+	steps, err := c.MigrateTo(ctx, version, l.DryRun)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Schema version %d, latest %d\n", current, bolt.LatestSchemaVersion())
+	w := NewTabWriter()
+	fmt.Fprintln(w, "Version\tDirection\tID\tDescription")
+	for _, step := range steps {
+		direction := "up"
+		if step.Rollback {
+			direction = "down"
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", step.Version, direction, step.ID, step.Description)
+	}
+	w.Flush()
+
+	if l.DryRun {
+		fmt.Printf("Dry run; %d migrations would run\n", len(steps))
+	} else {
+		fmt.Printf("Migrated to schema version %d\n", version)
+	}
+	return nil
+}
+
+func init() {
+	parser.AddCommand("migrate",
+		"Migrates the schema of the database",
+		"The migrate command will run the migrations of the chronograf boltdb instance up to the latest schema version, or roll them back to an earlier version with --version. Stop chronograf and back up the database first.",
+		&migrateCommand)
+}
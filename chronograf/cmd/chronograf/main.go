@@ -28,12 +28,21 @@ func main() {
 	parser.ShortDescription = `Chronograf`
 	parser.LongDescription = `Options for Chronograf`
 
-	if _, err := parser.Parse(); err != nil {
+	// SIGHUP parses the options and the config file again
+	srv.ReloadOptions = func(s *server.Server) error {
+		_, err := server.ParseOptions(flags.NewParser(s, flags.Default), os.Args[1:])
+		return err
+	}
+
+	if _, err := server.ParseOptions(parser, os.Args[1:]); err != nil {
 		code := 1
 		if fe, ok := err.(*flags.Error); ok {
 			if fe.Type == flags.ErrHelp {
 				code = 0
 			}
+		} else {
+			// Errors of the config file are not printed by the parser
+			log.Println(err)
 		}
 		os.Exit(code)
 	}
@@ -43,6 +52,14 @@ func main() {
 		os.Exit(0)
 	}
 
+	if srv.ValidateConfig {
+		if err := srv.Validate(); err != nil {
+			log.Fatalln(err)
+		}
+		log.Println("Configuration is valid")
+		os.Exit(0)
+	}
+
 	ctx := context.Background()
 	if err := srv.Serve(ctx); err != nil {
 		log.Fatalln(err)
@@ -0,0 +1,151 @@
+// Package encryption encrypts the secrets kept in the stores of Chronograf,
+// such as the passwords of sources and kapacitors, so that they are not
+// stored in CLEARTEXT.
+//
+// Secrets are encrypted with envelope encryption: a data key generated when
+// Chronograf starts encrypts each secret with AES-256-GCM, and the data key
+// itself is encrypted by a KeyWrapper holding the master key, either locally
+// or within a key management service. Every encrypted secret carries its
+// wrapped data key, so secrets written with earlier data keys remain readable
+// as long as the master key is available.
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// prefix marks encrypted secrets; values without it are in CLEARTEXT
+const prefix = "chronograf:enc:v1:"
+
+// KeyWrapper encrypts and decrypts data keys with a master key
+type KeyWrapper interface {
+	// Wrap encrypts a data key
+	Wrap(ctx context.Context, key []byte) ([]byte, error)
+	// Unwrap decrypts a data key encrypted by Wrap
+	Unwrap(ctx context.Context, wrapped []byte) ([]byte, error)
+}
+
+// Cipher encrypts and decrypts secrets
+type Cipher struct {
+	wrapper KeyWrapper
+
+	aead    cipher.AEAD
+	wrapped string // wrapped is the encoded wrapped data key of aead
+
+	mu    sync.Mutex
+	aeads map[string]cipher.AEAD // aeads caches the unwrapped data keys by their encoded wrapped key
+}
+
+// NewCipher generates a data key and wraps it with the master key of wrapper
+func NewCipher(ctx context.Context, wrapper KeyWrapper) (*Cipher, error) {
+	key := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	wrapped, err := wrapper.Wrap(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("unable to wrap data key: %v", err)
+	}
+
+	c := &Cipher{
+		wrapper: wrapper,
+		aead:    aead,
+		wrapped: base64.RawURLEncoding.EncodeToString(wrapped),
+		aeads:   map[string]cipher.AEAD{},
+	}
+	c.aeads[c.wrapped] = aead
+	return c, nil
+}
+
+// IsEncrypted returns true if s was encrypted by a Cipher
+func IsEncrypted(s string) bool {
+	return strings.HasPrefix(s, prefix)
+}
+
+// Encrypt encrypts a secret. Empty and encrypted secrets are returned as is.
+func (c *Cipher) Encrypt(s string) (string, error) {
+	if s == "" || IsEncrypted(s) {
+		return s, nil
+	}
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+	sealed := c.aead.Seal(nonce, nonce, []byte(s), nil)
+	return prefix + c.wrapped + ":" + base64.RawURLEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt decrypts a secret encrypted by Encrypt. Secrets in CLEARTEXT,
+// which were stored before encryption was enabled, are returned as is.
+func (c *Cipher) Decrypt(ctx context.Context, s string) (string, error) {
+	if !IsEncrypted(s) {
+		return s, nil
+	}
+	parts := strings.SplitN(strings.TrimPrefix(s, prefix), ":", 2)
+	if len(parts) != 2 {
+		return "", fmt.Errorf("malformed encrypted secret")
+	}
+	aead, err := c.unwrap(ctx, parts[0])
+	if err != nil {
+		return "", err
+	}
+	sealed, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", fmt.Errorf("malformed encrypted secret: %v", err)
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", fmt.Errorf("malformed encrypted secret")
+	}
+	plain, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], nil)
+	if err != nil {
+		return "", fmt.Errorf("unable to decrypt secret: %v", err)
+	}
+	return string(plain), nil
+}
+
+// unwrap returns the AEAD of an encoded wrapped data key
+func (c *Cipher) unwrap(ctx context.Context, wrapped string) (cipher.AEAD, error) {
+	c.mu.Lock()
+	aead, ok := c.aeads[wrapped]
+	c.mu.Unlock()
+	if ok {
+		return aead, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("malformed encrypted secret: %v", err)
+	}
+	key, err := c.wrapper.Unwrap(ctx, raw)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unwrap data key: %v", err)
+	}
+	if aead, err = newAEAD(key); err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.aeads[wrapped] = aead
+	c.mu.Unlock()
+	return aead, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,236 @@
+package encryption_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/encryption"
+)
+
+var testKey = base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))
+
+func newCipher(t *testing.T, encoded string) *encryption.Cipher {
+	k, err := encryption.NewLocalKey(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := encryption.NewCipher(context.Background(), k)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestCipher(t *testing.T) {
+	ctx := context.Background()
+	c := newCipher(t, testKey)
+
+	enc, err := c.Encrypt("hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !encryption.IsEncrypted(enc) || strings.Contains(enc, "hunter2") {
+		t.Fatalf("Encrypt() = %s", enc)
+	}
+	if again, _ := c.Encrypt(enc); again != enc {
+		t.Errorf("Encrypt() of an encrypted secret changed it")
+	}
+	if empty, _ := c.Encrypt(""); empty != "" {
+		t.Errorf("Encrypt() of an empty secret = %s", empty)
+	}
+
+	// A restarted Chronograf has another data key
+	restarted := newCipher(t, testKey)
+	if got, err := restarted.Decrypt(ctx, enc); err != nil || got != "hunter2" {
+		t.Errorf("Decrypt() = %q, %v, want hunter2", got, err)
+	}
+	if got, err := restarted.Decrypt(ctx, "cleartext"); err != nil || got != "cleartext" {
+		t.Errorf("Decrypt() of cleartext = %q, %v", got, err)
+	}
+
+	other := newCipher(t, base64.StdEncoding.EncodeToString([]byte("fedcba9876543210fedcba9876543210")))
+	if _, err := other.Decrypt(ctx, enc); err == nil {
+		t.Errorf("Decrypt() with another master key succeeded")
+	}
+}
+
+func TestNewLocalKey(t *testing.T) {
+	for _, key := range []string{"", "not base64!", base64.StdEncoding.EncodeToString([]byte("short"))} {
+		if _, err := encryption.NewLocalKey(key); err == nil {
+			t.Errorf("NewLocalKey(%q) succeeded", key)
+		}
+	}
+}
+
+// fakeTransit is the transit secrets engine of Vault "encrypting" by
+// prefixing the plaintext
+func fakeTransit(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "token" {
+			w.WriteHeader(http.StatusForbidden)
+			json.NewEncoder(w).Encode(map[string][]string{"errors": {"permission denied"}})
+			return
+		}
+		var req map[string]string
+		json.NewDecoder(r.Body).Decode(&req)
+		switch r.URL.Path {
+		case "/v1/transit/encrypt/chronograf":
+			json.NewEncoder(w).Encode(map[string]map[string]string{"data": {"ciphertext": "vault:v1:" + req["plaintext"]}})
+		case "/v1/transit/decrypt/chronograf":
+			json.NewEncoder(w).Encode(map[string]map[string]string{"data": {"plaintext": strings.TrimPrefix(req["ciphertext"], "vault:v1:")}})
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestVaultTransit(t *testing.T) {
+	ctx := context.Background()
+	srv := fakeTransit(t)
+	defer srv.Close()
+
+	v := &encryption.VaultTransit{Addr: srv.URL, Token: "token", Key: "chronograf"}
+	c, err := encryption.NewCipher(ctx, v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc, err := c.Encrypt("hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	restarted, err := encryption.NewCipher(ctx, v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := restarted.Decrypt(ctx, enc); err != nil || got != "hunter2" {
+		t.Errorf("Decrypt() = %q, %v, want hunter2", got, err)
+	}
+
+	denied := &encryption.VaultTransit{Addr: srv.URL, Token: "wrong", Key: "chronograf"}
+	if _, err := encryption.NewCipher(ctx, denied); err == nil || !strings.Contains(err.Error(), "permission denied") {
+		t.Errorf("NewCipher() with a wrong token = %v", err)
+	}
+}
+
+type sourcesStore struct {
+	chronograf.SourcesStore
+	srcs map[int]chronograf.Source
+}
+
+func (s *sourcesStore) All(ctx context.Context) ([]chronograf.Source, error) {
+	srcs := []chronograf.Source{}
+	for i := 1; i <= len(s.srcs); i++ {
+		srcs = append(srcs, s.srcs[i])
+	}
+	return srcs, nil
+}
+
+func (s *sourcesStore) Add(ctx context.Context, src chronograf.Source) (chronograf.Source, error) {
+	src.ID = len(s.srcs) + 1
+	s.srcs[src.ID] = src
+	return src, nil
+}
+
+func (s *sourcesStore) Get(ctx context.Context, id int) (chronograf.Source, error) {
+	src, ok := s.srcs[id]
+	if !ok {
+		return src, chronograf.ErrSourceNotFound
+	}
+	return src, nil
+}
+
+func (s *sourcesStore) Update(ctx context.Context, src chronograf.Source) error {
+	s.srcs[src.ID] = src
+	return nil
+}
+
+type serversStore struct {
+	chronograf.ServersStore
+	srvs map[int]chronograf.Server
+}
+
+func (s *serversStore) All(ctx context.Context) ([]chronograf.Server, error) {
+	srvs := []chronograf.Server{}
+	for i := 1; i <= len(s.srvs); i++ {
+		srvs = append(srvs, s.srvs[i])
+	}
+	return srvs, nil
+}
+
+func (s *serversStore) Get(ctx context.Context, id int) (chronograf.Server, error) {
+	return s.srvs[id], nil
+}
+
+func (s *serversStore) Update(ctx context.Context, srv chronograf.Server) error {
+	s.srvs[srv.ID] = srv
+	return nil
+}
+
+func TestSourcesStore(t *testing.T) {
+	ctx := context.Background()
+	raw := &sourcesStore{srcs: map[int]chronograf.Source{}}
+	s := encryption.NewSourcesStore(raw, newCipher(t, testKey))
+
+	src, err := s.Add(ctx, chronograf.Source{Name: "influx", Username: "marty", Password: "hunter2", SharedSecret: "secret", TLSKey: "key"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if src.Password != "hunter2" || src.SharedSecret != "secret" || src.TLSKey != "key" {
+		t.Errorf("Add() = %#v, want the secrets in cleartext", src)
+	}
+	stored := raw.srcs[src.ID]
+	if !encryption.IsEncrypted(stored.Password) || !encryption.IsEncrypted(stored.SharedSecret) || !encryption.IsEncrypted(stored.TLSKey) || stored.Username != "marty" {
+		t.Errorf("stored source = %#v, want encrypted secrets", stored)
+	}
+
+	src.Password = "hunter3"
+	if err := s.Update(ctx, src); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := s.Get(ctx, src.ID); err != nil || got.Password != "hunter3" {
+		t.Errorf("Get() = %#v, %v", got, err)
+	}
+	if srcs, err := s.All(ctx); err != nil || len(srcs) != 1 || srcs[0].SharedSecret != "secret" {
+		t.Errorf("All() = %#v, %v", srcs, err)
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	ctx := context.Background()
+	c := newCipher(t, testKey)
+	sources := &sourcesStore{srcs: map[int]chronograf.Source{
+		1: {ID: 1, Name: "plain", Password: "hunter2"},
+		2: {ID: 2, Name: "nopassword"},
+	}}
+	servers := &serversStore{srvs: map[int]chronograf.Server{
+		1: {ID: 1, Name: "kapacitor", Password: "hunter2", Active: true},
+	}}
+
+	n, err := encryption.Migrate(ctx, sources, servers, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Errorf("Migrate() = %d, want 2", n)
+	}
+	if !encryption.IsEncrypted(sources.srcs[1].Password) || sources.srcs[2].Password != "" {
+		t.Errorf("migrated sources = %#v", sources.srcs)
+	}
+	if srv := servers.srvs[1]; !encryption.IsEncrypted(srv.Password) || !srv.Active {
+		t.Errorf("migrated server = %#v", srv)
+	}
+	if got, err := encryption.NewServersStore(servers, c).Get(ctx, 1); err != nil || got.Password != "hunter2" {
+		t.Errorf("Get() after Migrate() = %#v, %v", got, err)
+	}
+
+	if n, err := encryption.Migrate(ctx, sources, servers, c); err != nil || n != 0 {
+		t.Errorf("Migrate() again = %d, %v, want 0", n, err)
+	}
+}
@@ -0,0 +1,149 @@
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Ensure LocalKey and VaultTransit implement KeyWrapper.
+var (
+	_ KeyWrapper = &LocalKey{}
+	_ KeyWrapper = &VaultTransit{}
+)
+
+// LocalKey wraps data keys with a master key known to Chronograf, e.g. given
+// by a flag or environment variable
+type LocalKey struct {
+	aead cipher.AEAD
+}
+
+// NewLocalKey decodes a base64 encoded 32 byte master key
+func NewLocalKey(encoded string) (*LocalKey, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("encryption key is not base64 encoded: %v", err)
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("encryption key must be 32 bytes; got %d", len(key))
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	return &LocalKey{aead: aead}, nil
+}
+
+// Wrap encrypts a data key with AES-256-GCM
+func (k *LocalKey) Wrap(ctx context.Context, key []byte) ([]byte, error) {
+	nonce := make([]byte, k.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return k.aead.Seal(nonce, nonce, key, nil), nil
+}
+
+// Unwrap decrypts a data key encrypted by Wrap
+func (k *LocalKey) Unwrap(ctx context.Context, wrapped []byte) ([]byte, error) {
+	if len(wrapped) < k.aead.NonceSize() {
+		return nil, fmt.Errorf("malformed wrapped key")
+	}
+	return k.aead.Open(nil, wrapped[:k.aead.NonceSize()], wrapped[k.aead.NonceSize():], nil)
+}
+
+// VaultTransit wraps data keys with a key of the transit secrets engine of
+// HashiCorp Vault, so that the master key never leaves Vault
+type VaultTransit struct {
+	Addr    string        // Addr is the URL of Vault, e.g. https://vault:8200
+	Token   string        // Token authenticates with Vault
+	Mount   string        // Mount is the path of the transit engine; transit when empty
+	Key     string        // Key is the name of the transit key
+	Timeout time.Duration // Timeout bounds every request to Vault
+
+	Client *http.Client // Client sends the requests to Vault; a client with Timeout when nil
+}
+
+type vaultRequest struct {
+	Plaintext  string `json:"plaintext,omitempty"`
+	Ciphertext string `json:"ciphertext,omitempty"`
+}
+
+type vaultResponse struct {
+	Data struct {
+		Plaintext  string `json:"plaintext"`
+		Ciphertext string `json:"ciphertext"`
+	} `json:"data"`
+	Errors []string `json:"errors"`
+}
+
+// Wrap encrypts a data key with the transit key
+func (v *VaultTransit) Wrap(ctx context.Context, key []byte) ([]byte, error) {
+	res, err := v.post(ctx, "encrypt", vaultRequest{
+		Plaintext: base64.StdEncoding.EncodeToString(key),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.Data.Ciphertext == "" {
+		return nil, fmt.Errorf("vault returned no ciphertext")
+	}
+	return []byte(res.Data.Ciphertext), nil
+}
+
+// Unwrap decrypts a data key encrypted by Wrap
+func (v *VaultTransit) Unwrap(ctx context.Context, wrapped []byte) ([]byte, error) {
+	res, err := v.post(ctx, "decrypt", vaultRequest{
+		Ciphertext: string(wrapped),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(res.Data.Plaintext)
+}
+
+func (v *VaultTransit) post(ctx context.Context, op string, req vaultRequest) (*vaultResponse, error) {
+	mount := v.Mount
+	if mount == "" {
+		mount = "transit"
+	}
+	u := strings.TrimSuffix(v.Addr, "/") + "/v1/" + strings.Trim(mount, "/") + "/" + op + "/" + url.PathEscape(v.Key)
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	r, err := http.NewRequest("POST", u, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set("X-Vault-Token", v.Token)
+
+	client := v.Client
+	if client == nil {
+		client = &http.Client{Timeout: v.Timeout}
+	}
+	resp, err := client.Do(r.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var res vaultResponse
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil && resp.StatusCode == http.StatusOK {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("vault: %s %s", resp.Status, strings.Join(res.Errors, "; "))
+	}
+	return &res, nil
+}
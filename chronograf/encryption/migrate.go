@@ -0,0 +1,50 @@
+package encryption
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Migrate encrypts the secrets of sources and servers stored in CLEARTEXT,
+// e.g. before encryption was enabled. It returns the number of sources and
+// servers that were updated. Migrating again updates nothing.
+func Migrate(ctx context.Context, sources chronograf.SourcesStore, servers chronograf.ServersStore, c *Cipher) (int, error) {
+	n := 0
+
+	srcs, err := sources.All(ctx)
+	if err != nil {
+		return n, err
+	}
+	enc := NewSourcesStore(sources, c)
+	for _, src := range srcs {
+		if !needsEncryption(src.Password) && !needsEncryption(src.SharedSecret) && !needsEncryption(src.TLSKey) {
+			continue
+		}
+		if err := enc.Update(ctx, src); err != nil {
+			return n, err
+		}
+		n++
+	}
+
+	srvs, err := servers.All(ctx)
+	if err != nil {
+		return n, err
+	}
+	encSrvs := NewServersStore(servers, c)
+	for _, srv := range srvs {
+		if !needsEncryption(srv.Password) {
+			continue
+		}
+		if err := encSrvs.Update(ctx, srv); err != nil {
+			return n, err
+		}
+		n++
+	}
+
+	return n, nil
+}
+
+func needsEncryption(s string) bool {
+	return s != "" && !IsEncrypted(s)
+}
@@ -0,0 +1,86 @@
+package encryption
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Ensure ServersStore implements chronograf.ServersStore.
+var _ chronograf.ServersStore = &ServersStore{}
+
+// ServersStore facade on a ServersStore that encrypts the password of servers,
+// such as kapacitors, before they are stored and decrypts it when they are
+// read.
+type ServersStore struct {
+	store  chronograf.ServersStore
+	cipher *Cipher
+}
+
+// NewServersStore creates a new ServersStore from an existing
+// chronograf.ServersStore and a Cipher
+func NewServersStore(s chronograf.ServersStore, c *Cipher) *ServersStore {
+	return &ServersStore{
+		store:  s,
+		cipher: c,
+	}
+}
+
+// All retrieves all servers from the underlying ServersStore and decrypts
+// their passwords
+func (s *ServersStore) All(ctx context.Context) ([]chronograf.Server, error) {
+	srvs, err := s.store.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range srvs {
+		if srvs[i].Password, err = s.cipher.Decrypt(ctx, srvs[i].Password); err != nil {
+			return nil, err
+		}
+	}
+	return srvs, nil
+}
+
+// Add encrypts the password of a server and adds it to the underlying
+// ServersStore
+func (s *ServersStore) Add(ctx context.Context, srv chronograf.Server) (chronograf.Server, error) {
+	var err error
+	if srv.Password, err = s.cipher.Encrypt(srv.Password); err != nil {
+		return chronograf.Server{}, err
+	}
+	if srv, err = s.store.Add(ctx, srv); err != nil {
+		return chronograf.Server{}, err
+	}
+	if srv.Password, err = s.cipher.Decrypt(ctx, srv.Password); err != nil {
+		return chronograf.Server{}, err
+	}
+	return srv, nil
+}
+
+// Delete removes a server from the underlying ServersStore
+func (s *ServersStore) Delete(ctx context.Context, srv chronograf.Server) error {
+	return s.store.Delete(ctx, srv)
+}
+
+// Get retrieves a server from the underlying ServersStore and decrypts its
+// password
+func (s *ServersStore) Get(ctx context.Context, id int) (chronograf.Server, error) {
+	srv, err := s.store.Get(ctx, id)
+	if err != nil {
+		return chronograf.Server{}, err
+	}
+	if srv.Password, err = s.cipher.Decrypt(ctx, srv.Password); err != nil {
+		return chronograf.Server{}, err
+	}
+	return srv, nil
+}
+
+// Update encrypts the password of a server and updates it in the underlying
+// ServersStore
+func (s *ServersStore) Update(ctx context.Context, srv chronograf.Server) error {
+	var err error
+	if srv.Password, err = s.cipher.Encrypt(srv.Password); err != nil {
+		return err
+	}
+	return s.store.Update(ctx, srv)
+}
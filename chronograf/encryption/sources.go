@@ -0,0 +1,108 @@
+package encryption
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Ensure SourcesStore implements chronograf.SourcesStore.
+var _ chronograf.SourcesStore = &SourcesStore{}
+
+// SourcesStore facade on a SourcesStore that encrypts the password, shared
+// secret and TLS client key of sources before they are stored and decrypts
+// them when they are read.
+type SourcesStore struct {
+	store  chronograf.SourcesStore
+	cipher *Cipher
+}
+
+// NewSourcesStore creates a new SourcesStore from an existing
+// chronograf.SourcesStore and a Cipher
+func NewSourcesStore(s chronograf.SourcesStore, c *Cipher) *SourcesStore {
+	return &SourcesStore{
+		store:  s,
+		cipher: c,
+	}
+}
+
+// All retrieves all sources from the underlying SourcesStore and decrypts
+// their secrets
+func (s *SourcesStore) All(ctx context.Context) ([]chronograf.Source, error) {
+	srcs, err := s.store.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range srcs {
+		if srcs[i], err = s.decrypt(ctx, srcs[i]); err != nil {
+			return nil, err
+		}
+	}
+	return srcs, nil
+}
+
+// Add encrypts the secrets of a source and adds it to the underlying
+// SourcesStore
+func (s *SourcesStore) Add(ctx context.Context, src chronograf.Source) (chronograf.Source, error) {
+	enc, err := s.encrypt(src)
+	if err != nil {
+		return chronograf.Source{}, err
+	}
+	if enc, err = s.store.Add(ctx, enc); err != nil {
+		return chronograf.Source{}, err
+	}
+	return s.decrypt(ctx, enc)
+}
+
+// Delete removes a source from the underlying SourcesStore
+func (s *SourcesStore) Delete(ctx context.Context, src chronograf.Source) error {
+	return s.store.Delete(ctx, src)
+}
+
+// Get retrieves a source from the underlying SourcesStore and decrypts its
+// secrets
+func (s *SourcesStore) Get(ctx context.Context, id int) (chronograf.Source, error) {
+	src, err := s.store.Get(ctx, id)
+	if err != nil {
+		return chronograf.Source{}, err
+	}
+	return s.decrypt(ctx, src)
+}
+
+// Update encrypts the secrets of a source and updates it in the underlying
+// SourcesStore
+func (s *SourcesStore) Update(ctx context.Context, src chronograf.Source) error {
+	enc, err := s.encrypt(src)
+	if err != nil {
+		return err
+	}
+	return s.store.Update(ctx, enc)
+}
+
+func (s *SourcesStore) encrypt(src chronograf.Source) (chronograf.Source, error) {
+	var err error
+	if src.Password, err = s.cipher.Encrypt(src.Password); err != nil {
+		return src, err
+	}
+	if src.SharedSecret, err = s.cipher.Encrypt(src.SharedSecret); err != nil {
+		return src, err
+	}
+	if src.TLSKey, err = s.cipher.Encrypt(src.TLSKey); err != nil {
+		return src, err
+	}
+	return src, nil
+}
+
+func (s *SourcesStore) decrypt(ctx context.Context, src chronograf.Source) (chronograf.Source, error) {
+	var err error
+	if src.Password, err = s.cipher.Decrypt(ctx, src.Password); err != nil {
+		return src, err
+	}
+	if src.SharedSecret, err = s.cipher.Decrypt(ctx, src.SharedSecret); err != nil {
+		return src, err
+	}
+	if src.TLSKey, err = s.cipher.Decrypt(ctx, src.TLSKey); err != nil {
+		return src, err
+	}
+	return src, nil
+}
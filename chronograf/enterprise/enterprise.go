@@ -2,6 +2,9 @@ package enterprise
 
 import (
 	"container/ring"
+	"fmt"
+	"io"
+	"net/http"
 	"net/url"
 	"strings"
 
@@ -12,6 +15,9 @@ import (
 )
 
 var _ chronograf.TimeSeries = &Client{}
+var _ chronograf.FluxQuerier = &Client{}
+var _ chronograf.ChunkedQuerier = &Client{}
+var _ chronograf.QueryExplainer = &Client{}
 
 // Ctrl represents administrative controls over an Influx Enterprise cluster
 type Ctrl interface {
@@ -57,7 +63,29 @@ func NewClientWithTimeSeries(lg chronograf.Logger, mu string, authorizer influx.
 		return nil, err
 	}
 
-	ctrl := NewMetaClient(metaURL, insecure, authorizer)
+	return newClientWithTimeSeries(lg, NewMetaClient(metaURL, insecure, authorizer), series...), nil
+}
+
+// NewClientWithTransport initializes a Client with a known set of TimeSeries
+// whose meta nodes are requested through transport, such as one trusting
+// custom certificate authorities or presenting a client certificate.
+func NewClientWithTransport(lg chronograf.Logger, mu string, authorizer influx.Authorizer, tls bool, transport http.RoundTripper, series ...chronograf.TimeSeries) (*Client, error) {
+	metaURL, err := parseMetaURL(mu, tls)
+	if err != nil {
+		return nil, err
+	}
+
+	ctrl := &MetaClient{
+		URL: metaURL,
+		client: &defaultClient{
+			Transport: transport,
+		},
+		authorizer: authorizer,
+	}
+	return newClientWithTimeSeries(lg, ctrl, series...), nil
+}
+
+func newClientWithTimeSeries(lg chronograf.Logger, ctrl *MetaClient, series ...chronograf.TimeSeries) *Client {
 	c := &Client{
 		Ctrl: ctrl,
 		UsersStore: &UserStore{
@@ -77,7 +105,7 @@ func NewClientWithTimeSeries(lg chronograf.Logger, mu string, authorizer influx.
 		c.dataNodes = c.dataNodes.Next()
 	}
 
-	return c, nil
+	return c
 }
 
 // NewClientWithURL initializes an Enterprise client with a URL to a Meta Node.
@@ -144,6 +172,55 @@ func (c *Client) Query(ctx context.Context, q chronograf.Query) (chronograf.Resp
 	return c.nextDataNode().Query(ctx, q)
 }
 
+// FluxQuery runs a Flux script against the next data node able to run Flux
+func (c *Client) FluxQuery(ctx context.Context, script string) ([]byte, error) {
+	if !c.opened {
+		return nil, chronograf.ErrUninitialized
+	}
+	flux, ok := c.nextDataNode().(chronograf.FluxQuerier)
+	if !ok {
+		return nil, fmt.Errorf("data nodes do not support Flux")
+	}
+	return flux.FluxQuery(ctx, script)
+}
+
+// ChunkedQuery streams the results of a query from the next data node
+func (c *Client) ChunkedQuery(ctx context.Context, q chronograf.Query, fn func(chronograf.ResultSeries) error) error {
+	if !c.opened {
+		return chronograf.ErrUninitialized
+	}
+	chunked, ok := c.nextDataNode().(chronograf.ChunkedQuerier)
+	if !ok {
+		return fmt.Errorf("data nodes do not support chunked queries")
+	}
+	return chunked.ChunkedQuery(ctx, q, fn)
+}
+
+// ChunkedResponse returns the chunked response of a query of the next data
+// node
+func (c *Client) ChunkedResponse(ctx context.Context, q chronograf.Query, size int) (io.ReadCloser, error) {
+	if !c.opened {
+		return nil, chronograf.ErrUninitialized
+	}
+	chunked, ok := c.nextDataNode().(chronograf.ChunkedQuerier)
+	if !ok {
+		return nil, fmt.Errorf("data nodes do not support chunked queries")
+	}
+	return chunked.ChunkedResponse(ctx, q, size)
+}
+
+// Explain explains the plan of a query on the next data node
+func (c *Client) Explain(ctx context.Context, q chronograf.Query) (chronograf.QueryPlan, error) {
+	if !c.opened {
+		return chronograf.QueryPlan{}, chronograf.ErrUninitialized
+	}
+	explainer, ok := c.nextDataNode().(chronograf.QueryExplainer)
+	if !ok {
+		return chronograf.QueryPlan{}, fmt.Errorf("data nodes do not support explaining queries")
+	}
+	return explainer.Explain(ctx, q)
+}
+
 // Write records points into a time series
 func (c *Client) Write(ctx context.Context, points []chronograf.Point) error {
 	if !c.opened {
@@ -466,6 +466,9 @@ func (m *MetaClient) Post(ctx context.Context, path string, action interface{},
 type defaultClient struct {
 	Leader             string
 	InsecureSkipVerify bool
+	// Transport, when set, replaces the shared transports, such as to present
+	// a client certificate to meta nodes requiring mutual TLS
+	Transport http.RoundTripper
 }
 
 // Do is a helper function to interface with Influx Enterprise's Meta API
@@ -504,7 +507,9 @@ func (d *defaultClient) Do(URL *url.URL, path, method string, authorizer influx.
 		CheckRedirect: d.AuthedCheckRedirect,
 	}
 
-	if d.InsecureSkipVerify {
+	if d.Transport != nil {
+		client.Transport = d.Transport
+	} else if d.InsecureSkipVerify {
 		client.Transport = skipVerifyTransport
 	} else {
 		client.Transport = defaultTransport
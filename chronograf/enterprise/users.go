@@ -32,6 +32,23 @@ func (c *UserStore) Add(ctx context.Context, u *chronograf.User) (*chronograf.Us
 	return c.Get(ctx, chronograf.UserQuery{Name: &u.Name})
 }
 
+// AddMany creates users in Influx Enterprise one after the other. The users
+// already created are deleted if one of them fails.
+func (c *UserStore) AddMany(ctx context.Context, us []*chronograf.User) ([]*chronograf.User, error) {
+	added := make([]*chronograf.User, 0, len(us))
+	for _, u := range us {
+		res, err := c.Add(ctx, u)
+		if err != nil {
+			for _, a := range added {
+				_ = c.Delete(ctx, a)
+			}
+			return nil, err
+		}
+		added = append(added, res)
+	}
+	return added, nil
+}
+
 // Delete the User from Influx Enterprise
 func (c *UserStore) Delete(ctx context.Context, u *chronograf.User) error {
 	return c.Ctrl.DeleteUser(ctx, u.Name)
@@ -47,6 +64,22 @@ func (c *UserStore) Num(ctx context.Context) (int, error) {
 	return len(all), nil
 }
 
+// Filter returns the users in Influx matching f
+func (c *UserStore) Filter(ctx context.Context, f chronograf.UserFilter) ([]chronograf.User, error) {
+	all, err := c.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	users := []chronograf.User{}
+	for i := range all {
+		if f.Matches(&all[i]) {
+			users = append(users, all[i])
+		}
+	}
+	return users, nil
+}
+
 // Get retrieves a user if name exists.
 func (c *UserStore) Get(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
 	if q.Name == nil {
@@ -129,6 +162,30 @@ func (c *UserStore) Update(ctx context.Context, u *chronograf.User) error {
 	return nil
 }
 
+// UpdateMany updates users in Influx Enterprise one after the other. The
+// users already updated are restored if one of them fails.
+func (c *UserStore) UpdateMany(ctx context.Context, us []*chronograf.User) error {
+	updated := make([]*chronograf.User, 0, len(us))
+	restore := func() {
+		for _, u := range updated {
+			_ = c.Update(ctx, u)
+		}
+	}
+	for _, u := range us {
+		prev, err := c.Get(ctx, chronograf.UserQuery{Name: &u.Name})
+		if err != nil {
+			restore()
+			return err
+		}
+		updated = append(updated, prev)
+		if err := c.Update(ctx, u); err != nil {
+			restore()
+			return err
+		}
+	}
+	return nil
+}
+
 // All is all users in influx
 func (c *UserStore) All(ctx context.Context) ([]chronograf.User, error) {
 	all, err := c.Ctrl.Users(ctx, nil)
@@ -0,0 +1,213 @@
+// Package etcd stores Chronograf users, dashboards, sources, servers and
+// layouts in etcd so that several Chronograf replicas behind a load
+// balancer share their state.
+//
+// The client speaks to the JSON gateway of the etcd v3 API, which etcd
+// serves on its client URLs, e.g. http://localhost:2379.
+package etcd
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/id"
+)
+
+// DefaultPrefix is the prefix of the keys of Chronograf in etcd
+const DefaultPrefix = "/chronograf/"
+
+// errUnauthenticated is returned by etcd when the token of a client expired
+var errUnauthenticated = errors.New("etcd: unauthenticated")
+
+// Client is a client for etcd storing the state shared by Chronograf replicas
+type Client struct {
+	Endpoints          []string      // Endpoints are the client URLs of the etcd cluster; failed requests are retried on the next one
+	Prefix             string        // Prefix of all keys of Chronograf
+	Username           string        // Username authenticates with etcd when not empty
+	Password           string        // Password of the etcd user
+	InsecureSkipVerify bool          // InsecureSkipVerify disables the verification of the certificates of https:// endpoints
+	Timeout            time.Duration // Timeout bounds every request to etcd
+	Now                func() time.Time
+
+	client *http.Client
+	logger chronograf.Logger
+
+	mu    sync.Mutex
+	token string
+
+	SourcesStore    *SourcesStore
+	ServersStore    *ServersStore
+	LayoutsStore    *LayoutsStore
+	DashboardsStore *DashboardsStore
+	UsersStore      *UsersStore
+}
+
+// NewClient initializes all stores
+func NewClient() *Client {
+	c := &Client{
+		Prefix:  DefaultPrefix,
+		Timeout: 5 * time.Second,
+		Now:     time.Now,
+	}
+	c.SourcesStore = &SourcesStore{client: c}
+	c.ServersStore = &ServersStore{client: c}
+	c.LayoutsStore = &LayoutsStore{
+		client: c,
+		IDs:    &id.UUID{},
+	}
+	c.DashboardsStore = &DashboardsStore{
+		client: c,
+		IDs:    &id.UUID{},
+	}
+	c.UsersStore = &UsersStore{client: c}
+	return c
+}
+
+// Open connects to etcd and authenticates if a username is set
+func (c *Client) Open(ctx context.Context, logger chronograf.Logger) error {
+	if len(c.Endpoints) == 0 {
+		return fmt.Errorf("no etcd endpoints")
+	}
+	c.logger = logger
+	c.client = &http.Client{
+		Timeout: c.Timeout,
+	}
+	if c.InsecureSkipVerify {
+		c.client.Transport = &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		}
+	}
+
+	if c.Username != "" {
+		if err := c.authenticate(ctx); err != nil {
+			return fmt.Errorf("unable to authenticate with etcd: %v", err)
+		}
+	}
+
+	// Read a single key to check that etcd is reachable
+	if _, _, err := c.rangeKeys(ctx, []byte(c.Prefix), nil, 1); err != nil {
+		return fmt.Errorf("unable to connect to etcd: %v", err)
+	}
+	return nil
+}
+
+// Close releases the idle connections to etcd
+func (c *Client) Close() error {
+	if c.client != nil {
+		if t, ok := c.client.Transport.(*http.Transport); ok {
+			t.CloseIdleConnections()
+		}
+	}
+	return nil
+}
+
+type authenticateRequest struct {
+	Name     string `json:"name"`
+	Password string `json:"password"`
+}
+
+type authenticateResponse struct {
+	Token string `json:"token"`
+}
+
+// authenticate exchanges the username and password for a token
+func (c *Client) authenticate(ctx context.Context) error {
+	var res authenticateResponse
+	if err := c.post(ctx, "/v3/auth/authenticate", "", &authenticateRequest{
+		Name:     c.Username,
+		Password: c.Password,
+	}, &res); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	c.token = res.Token
+	c.mu.Unlock()
+	return nil
+}
+
+// call posts a request to the etcd v3 API, authenticating again once if the
+// token of the client expired
+func (c *Client) call(ctx context.Context, path string, req, res interface{}) error {
+	c.mu.Lock()
+	token := c.token
+	c.mu.Unlock()
+
+	err := c.post(ctx, path, token, req, res)
+	if err == errUnauthenticated && c.Username != "" {
+		if err := c.authenticate(ctx); err != nil {
+			return err
+		}
+		c.mu.Lock()
+		token = c.token
+		c.mu.Unlock()
+		err = c.post(ctx, path, token, req, res)
+	}
+	return err
+}
+
+type gatewayError struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+}
+
+// post sends a request to the first endpoint answering it
+func (c *Client) post(ctx context.Context, path, token string, req, res interface{}) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	var lastErr error
+	for _, endpoint := range c.Endpoints {
+		r, err := http.NewRequest("POST", strings.TrimSuffix(endpoint, "/")+path, bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		r.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			r.Header.Set("Authorization", token)
+		}
+
+		resp, err := c.client.Do(r.WithContext(ctx))
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		dec := json.NewDecoder(resp.Body)
+		if resp.StatusCode != http.StatusOK {
+			var e gatewayError
+			_ = dec.Decode(&e)
+			resp.Body.Close()
+			msg := e.Message
+			if msg == "" {
+				msg = e.Error
+			}
+			if resp.StatusCode == http.StatusUnauthorized || strings.Contains(msg, "invalid auth token") {
+				return errUnauthenticated
+			}
+			if resp.StatusCode >= http.StatusInternalServerError {
+				lastErr = fmt.Errorf("etcd %s: %s %s", endpoint, resp.Status, msg)
+				continue
+			}
+			return fmt.Errorf("etcd: %s %s", resp.Status, msg)
+		}
+
+		err = dec.Decode(res)
+		resp.Body.Close()
+		return err
+	}
+	return lastErr
+}
@@ -0,0 +1,122 @@
+package etcd
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt"
+)
+
+// Ensure DashboardsStore implements chronograf.DashboardsStore.
+var _ chronograf.DashboardsStore = &DashboardsStore{}
+
+// DashboardsStore is the etcd implementation of storing dashboards
+type DashboardsStore struct {
+	client *Client
+	IDs    chronograf.ID
+}
+
+// All returns all known dashboards
+func (d *DashboardsStore) All(ctx context.Context) ([]chronograf.Dashboard, error) {
+	var srcs []chronograf.Dashboard
+	if err := d.client.view(ctx, DashboardsBucket, func(tx *Tx) error {
+		return tx.ForEach(func(k, v []byte) error {
+			var src chronograf.Dashboard
+			if err := bolt.UnmarshalDashboard(v, &src); err != nil {
+				return err
+			}
+			srcs = append(srcs, src)
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return srcs, nil
+}
+
+// Add creates a new Dashboard in the DashboardsStore
+func (d *DashboardsStore) Add(ctx context.Context, src chronograf.Dashboard) (chronograf.Dashboard, error) {
+	if err := d.client.update(ctx, DashboardsBucket, func(tx *Tx) error {
+		id, err := tx.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		src.ID = chronograf.DashboardID(id)
+		strID := strconv.Itoa(int(id))
+		for i, cell := range src.Cells {
+			cid, err := d.IDs.Generate()
+			if err != nil {
+				return err
+			}
+			cell.ID = cid
+			src.Cells[i] = cell
+		}
+		v, err := bolt.MarshalDashboard(src)
+		if err != nil {
+			return err
+		}
+		return tx.Put([]byte(strID), v)
+	}); err != nil {
+		return chronograf.Dashboard{}, err
+	}
+
+	return src, nil
+}
+
+// Get returns a Dashboard if the id exists.
+func (d *DashboardsStore) Get(ctx context.Context, id chronograf.DashboardID) (chronograf.Dashboard, error) {
+	var src chronograf.Dashboard
+	if err := d.client.view(ctx, DashboardsBucket, func(tx *Tx) error {
+		strID := strconv.Itoa(int(id))
+		if v := tx.Get([]byte(strID)); v == nil {
+			return chronograf.ErrDashboardNotFound
+		} else if err := bolt.UnmarshalDashboard(v, &src); err != nil {
+			return err
+		}
+		return nil
+	}); err != nil {
+		return chronograf.Dashboard{}, err
+	}
+
+	return src, nil
+}
+
+// Delete the dashboard from DashboardsStore
+func (d *DashboardsStore) Delete(ctx context.Context, dash chronograf.Dashboard) error {
+	return d.client.update(ctx, DashboardsBucket, func(tx *Tx) error {
+		strID := strconv.Itoa(int(dash.ID))
+		return tx.Delete([]byte(strID))
+	})
+}
+
+// Update the dashboard in DashboardsStore
+func (d *DashboardsStore) Update(ctx context.Context, dash chronograf.Dashboard) error {
+	return d.client.update(ctx, DashboardsBucket, func(tx *Tx) error {
+		// Get an existing dashboard with the same ID.
+		strID := strconv.Itoa(int(dash.ID))
+		if v := tx.Get([]byte(strID)); v == nil {
+			return chronograf.ErrDashboardNotFound
+		}
+
+		for i, cell := range dash.Cells {
+			if cell.ID != "" {
+				continue
+			}
+			cid, err := d.IDs.Generate()
+			if err != nil {
+				return err
+			}
+			cell.ID = cid
+			dash.Cells[i] = cell
+		}
+		if v, err := bolt.MarshalDashboard(dash); err != nil {
+			return err
+		} else if err := tx.Put([]byte(strID), v); err != nil {
+			return err
+		}
+		return nil
+	})
+}
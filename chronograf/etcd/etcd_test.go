@@ -0,0 +1,442 @@
+package etcd_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/etcd"
+)
+
+// fakeEtcd serves the subset of the JSON gateway of the etcd v3 API used by
+// the client from memory
+type fakeEtcd struct {
+	mu       sync.Mutex
+	revision int64
+	kvs      map[string]fakeKV
+	password string // password of the root user; authentication is disabled when empty
+	token    string
+}
+
+type fakeKV struct {
+	value       []byte
+	modRevision int64
+}
+
+type fakeRange struct {
+	Key      []byte `json:"key"`
+	RangeEnd []byte `json:"range_end"`
+	Limit    int64  `json:"limit,string"`
+}
+
+type fakeCompare struct {
+	Target      string `json:"target"`
+	Result      string `json:"result"`
+	Key         []byte `json:"key"`
+	RangeEnd    []byte `json:"range_end"`
+	ModRevision int64  `json:"mod_revision,string"`
+}
+
+type fakeOp struct {
+	RequestPut *struct {
+		Key   []byte `json:"key"`
+		Value []byte `json:"value"`
+	} `json:"request_put"`
+	RequestDeleteRange *struct {
+		Key []byte `json:"key"`
+	} `json:"request_delete_range"`
+}
+
+type fakeTxn struct {
+	Compare []fakeCompare `json:"compare"`
+	Success []fakeOp      `json:"success"`
+}
+
+func newFakeEtcd() *fakeEtcd {
+	return &fakeEtcd{kvs: map[string]fakeKV{}}
+}
+
+// keys returns the sorted keys from key up to end, or key alone if end is empty
+func (f *fakeEtcd) keys(key, end []byte) []string {
+	var ks []string
+	for k := range f.kvs {
+		if len(end) == 0 {
+			if k == string(key) {
+				ks = append(ks, k)
+			}
+			continue
+		}
+		if k >= string(key) && k < string(end) {
+			ks = append(ks, k)
+		}
+	}
+	sort.Strings(ks)
+	return ks
+}
+
+func (f *fakeEtcd) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.URL.Path == "/v3/auth/authenticate" {
+		var req struct {
+			Name     string `json:"name"`
+			Password string `json:"password"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		if req.Name != "root" || req.Password != f.password {
+			http.Error(w, `{"error":"authentication failed, invalid user ID or password","code":3}`, http.StatusBadRequest)
+			return
+		}
+		f.token = "token" + strconv.FormatInt(f.revision, 10)
+		json.NewEncoder(w).Encode(map[string]string{"token": f.token})
+		return
+	}
+	if f.password != "" && r.Header.Get("Authorization") != f.token {
+		http.Error(w, `{"error":"etcdserver: invalid auth token","code":16}`, http.StatusUnauthorized)
+		return
+	}
+
+	header := map[string]string{"revision": strconv.FormatInt(f.revision, 10)}
+	switch r.URL.Path {
+	case "/v3/kv/range":
+		var req fakeRange
+		json.NewDecoder(r.Body).Decode(&req)
+		kvs := []map[string]interface{}{}
+		for _, k := range f.keys(req.Key, req.RangeEnd) {
+			if req.Limit > 0 && int64(len(kvs)) == req.Limit {
+				break
+			}
+			kvs = append(kvs, map[string]interface{}{
+				"key":          []byte(k),
+				"value":        f.kvs[k].value,
+				"mod_revision": strconv.FormatInt(f.kvs[k].modRevision, 10),
+			})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"header": header, "kvs": kvs})
+	case "/v3/kv/txn":
+		var req fakeTxn
+		json.NewDecoder(r.Body).Decode(&req)
+		succeeded := true
+		for _, c := range req.Compare {
+			if c.Target != "MOD" || c.Result != "LESS" {
+				http.Error(w, `{"error":"unsupported compare"}`, http.StatusBadRequest)
+				return
+			}
+			for _, k := range f.keys(c.Key, c.RangeEnd) {
+				if f.kvs[k].modRevision >= c.ModRevision {
+					succeeded = false
+				}
+			}
+		}
+		if succeeded {
+			f.revision++
+			for _, op := range req.Success {
+				switch {
+				case op.RequestPut != nil:
+					f.kvs[string(op.RequestPut.Key)] = fakeKV{value: op.RequestPut.Value, modRevision: f.revision}
+				case op.RequestDeleteRange != nil:
+					delete(f.kvs, string(op.RequestDeleteRange.Key))
+				}
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"header": header, "succeeded": succeeded})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// NewTestClient opens a client of a fake etcd, which is stopped by the
+// returned function
+func NewTestClient(t *testing.T) (*etcd.Client, *fakeEtcd, func()) {
+	f := newFakeEtcd()
+	srv := httptest.NewServer(f)
+
+	c := etcd.NewClient()
+	c.Endpoints = []string{srv.URL}
+	if err := c.Open(context.Background(), &chronograf.NoopLogger{}); err != nil {
+		srv.Close()
+		t.Fatal(err)
+	}
+	return c, f, srv.Close
+}
+
+func TestClient_Open(t *testing.T) {
+	f := newFakeEtcd()
+	f.password = "secret"
+	srv := httptest.NewServer(f)
+	defer srv.Close()
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+
+	c := etcd.NewClient()
+	c.Endpoints = []string{down.URL, srv.URL}
+	c.Username = "root"
+	c.Password = "wrong"
+	if err := c.Open(context.Background(), &chronograf.NoopLogger{}); err == nil {
+		t.Fatal("Open() with a wrong password succeeded")
+	}
+
+	c.Password = "secret"
+	if err := c.Open(context.Background(), &chronograf.NoopLogger{}); err != nil {
+		t.Fatalf("Open() = %v", err)
+	}
+
+	// Tokens are renewed once they expire
+	f.mu.Lock()
+	f.token = "expired"
+	f.mu.Unlock()
+	if _, err := c.LayoutsStore.Add(context.Background(), chronograf.Layout{Application: "cpu"}); err != nil {
+		t.Fatalf("Add() after the token expired = %v", err)
+	}
+}
+
+func TestClient_sharedState(t *testing.T) {
+	ctx := context.Background()
+	a, f, stop := NewTestClient(t)
+	defer stop()
+	srv := httptest.NewServer(f)
+	defer srv.Close()
+	b := etcd.NewClient()
+	b.Endpoints = []string{srv.URL}
+	if err := b.Open(ctx, &chronograf.NoopLogger{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := a.UsersStore.Add(ctx, &chronograf.User{Name: "marty", Provider: "github", Scheme: "oauth2"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.UsersStore.Add(ctx, &chronograf.User{Name: "marty", Provider: "github", Scheme: "oauth2"}); err != chronograf.ErrUserAlreadyExists {
+		t.Fatalf("Add() on another replica = %v, want %v", err, chronograf.ErrUserAlreadyExists)
+	}
+	u, err := b.UsersStore.Add(ctx, &chronograf.User{Name: "doc", Provider: "github", Scheme: "oauth2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.ID != 2 {
+		t.Errorf("Add() on another replica ID = %d, want 2", u.ID)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			c := a
+			if i%2 == 1 {
+				c = b
+			}
+			if _, err := c.DashboardsStore.Add(ctx, chronograf.Dashboard{Name: strconv.Itoa(i)}); err != nil {
+				t.Error(err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	ds, err := a.DashboardsStore.All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := map[chronograf.DashboardID]bool{}
+	for _, d := range ds {
+		ids[d.ID] = true
+	}
+	if len(ds) != 5 || len(ids) != 5 {
+		t.Errorf("All() after concurrent adds = %d dashboards with %d IDs, want 5", len(ds), len(ids))
+	}
+}
+
+func TestSourcesStore(t *testing.T) {
+	ctx := context.Background()
+	c, _, stop := NewTestClient(t)
+	defer stop()
+	s := c.SourcesStore
+
+	first, err := s.Add(ctx, chronograf.Source{Name: "first", URL: "http://localhost:8086"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !first.Default {
+		t.Errorf("first source is not the default")
+	}
+	second, err := s.Add(ctx, chronograf.Source{Name: "second", URL: "http://localhost:8087", Default: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first, err = s.Get(ctx, first.ID); err != nil {
+		t.Fatal(err)
+	} else if first.Default {
+		t.Errorf("first source is still the default after adding a default source")
+	}
+
+	if err := s.Delete(ctx, second); err != nil {
+		t.Fatal(err)
+	}
+	if first, err = s.Get(ctx, first.ID); err != nil {
+		t.Fatal(err)
+	} else if !first.Default {
+		t.Errorf("first source did not become the default after deleting the default source")
+	}
+	if _, err := s.Get(ctx, second.ID); err != chronograf.ErrSourceNotFound {
+		t.Errorf("Get() of a deleted source = %v, want %v", err, chronograf.ErrSourceNotFound)
+	}
+	if err := s.Update(ctx, second); err != chronograf.ErrSourceNotFound {
+		t.Errorf("Update() of a deleted source = %v, want %v", err, chronograf.ErrSourceNotFound)
+	}
+}
+
+func TestServersStore(t *testing.T) {
+	ctx := context.Background()
+	c, _, stop := NewTestClient(t)
+	defer stop()
+	s := c.ServersStore
+
+	first, err := s.Add(ctx, chronograf.Server{Name: "first", URL: "http://localhost:9092"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := s.Add(ctx, chronograf.Server{Name: "second", URL: "http://localhost:9093"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !second.Active {
+		t.Errorf("added server is not active")
+	}
+	if first, err = s.Get(ctx, first.ID); err != nil {
+		t.Fatal(err)
+	} else if first.Active {
+		t.Errorf("first server is still active after adding another server")
+	}
+
+	if err := s.Delete(ctx, first); err != nil {
+		t.Fatal(err)
+	}
+	srvs, err := s.All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(srvs) != 1 || srvs[0].Name != "second" {
+		t.Errorf("All() = %#v, want the second server only", srvs)
+	}
+}
+
+func TestUsersStore(t *testing.T) {
+	ctx := context.Background()
+	c, _, stop := NewTestClient(t)
+	defer stop()
+	s := c.UsersStore
+
+	us, err := s.AddMany(ctx, []*chronograf.User{
+		{Name: "marty", Provider: "github", Scheme: "oauth2"},
+		{Name: "doc", Provider: "github", Scheme: "oauth2"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.AddMany(ctx, []*chronograf.User{
+		{Name: "biff", Provider: "github", Scheme: "oauth2"},
+		{Name: "doc", Provider: "github", Scheme: "oauth2"},
+	}); err != chronograf.ErrUserAlreadyExists {
+		t.Fatalf("AddMany() of an existing user = %v, want %v", err, chronograf.ErrUserAlreadyExists)
+	}
+	if n, err := s.Num(ctx); err != nil || n != 2 {
+		t.Fatalf("Num() = %d, %v, want 2", n, err)
+	}
+
+	name, provider, scheme := "doc", "github", "oauth2"
+	u, err := s.Get(ctx, chronograf.UserQuery{Name: &name, Provider: &provider, Scheme: &scheme})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.ID != us[1].ID {
+		t.Errorf("Get() ID = %d, want %d", u.ID, us[1].ID)
+	}
+
+	u.Roles = []chronograf.Role{{Name: "admin", Organization: "0"}}
+	if err := s.Update(ctx, u); err != nil {
+		t.Fatal(err)
+	}
+	if u, err = s.Get(ctx, chronograf.UserQuery{ID: &u.ID}); err != nil {
+		t.Fatal(err)
+	} else if len(u.Roles) != 1 {
+		t.Errorf("Get() after Update() roles = %#v", u.Roles)
+	}
+
+	if err := s.Delete(ctx, u); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Delete(ctx, u); err != chronograf.ErrUserNotFound {
+		t.Errorf("Delete() of a deleted user = %v, want %v", err, chronograf.ErrUserNotFound)
+	}
+}
+
+func TestDashboardsStore(t *testing.T) {
+	ctx := context.Background()
+	c, _, stop := NewTestClient(t)
+	defer stop()
+	s := c.DashboardsStore
+
+	d, err := s.Add(ctx, chronograf.Dashboard{
+		Name:  "weather",
+		Cells: []chronograf.DashboardCell{{Name: "rain"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Cells[0].ID == "" {
+		t.Errorf("Add() did not set the ID of the cell")
+	}
+
+	d.Cells = append(d.Cells, chronograf.DashboardCell{Name: "wind"})
+	if err := s.Update(ctx, d); err != nil {
+		t.Fatal(err)
+	}
+	got, err := s.Get(ctx, d.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Cells) != 2 || got.Cells[1].ID == "" {
+		t.Errorf("Get() after Update() cells = %#v", got.Cells)
+	}
+	if err := s.Delete(ctx, d); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Get(ctx, d.ID); err != chronograf.ErrDashboardNotFound {
+		t.Errorf("Get() of a deleted dashboard = %v, want %v", err, chronograf.ErrDashboardNotFound)
+	}
+}
+
+func TestLayoutsStore(t *testing.T) {
+	ctx := context.Background()
+	c, _, stop := NewTestClient(t)
+	defer stop()
+	s := c.LayoutsStore
+
+	l, err := s.Add(ctx, chronograf.Layout{Application: "cpu", Measurement: "cpu"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.Measurement = "system"
+	if err := s.Update(ctx, l); err != nil {
+		t.Fatal(err)
+	}
+	got, err := s.Get(ctx, l.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Measurement != "system" {
+		t.Errorf("Get() after Update() measurement = %s", got.Measurement)
+	}
+	if err := s.Delete(ctx, l); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Delete(ctx, l); err != chronograf.ErrLayoutNotFound {
+		t.Errorf("Delete() of a deleted layout = %v, want %v", err, chronograf.ErrLayoutNotFound)
+	}
+}
@@ -0,0 +1,102 @@
+package etcd
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt"
+)
+
+// Ensure LayoutsStore implements chronograf.LayoutsStore.
+var _ chronograf.LayoutsStore = &LayoutsStore{}
+
+// LayoutsStore is the etcd implementation to store layouts
+type LayoutsStore struct {
+	client *Client
+	IDs    chronograf.ID
+}
+
+// All returns all known layouts
+func (s *LayoutsStore) All(ctx context.Context) ([]chronograf.Layout, error) {
+	var srcs []chronograf.Layout
+	if err := s.client.view(ctx, LayoutsBucket, func(tx *Tx) error {
+		return tx.ForEach(func(k, v []byte) error {
+			var src chronograf.Layout
+			if err := bolt.UnmarshalLayout(v, &src); err != nil {
+				return err
+			}
+			srcs = append(srcs, src)
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return srcs, nil
+}
+
+// Add creates a new Layout in the LayoutsStore.
+func (s *LayoutsStore) Add(ctx context.Context, src chronograf.Layout) (chronograf.Layout, error) {
+	if err := s.client.update(ctx, LayoutsBucket, func(tx *Tx) error {
+		id, err := s.IDs.Generate()
+		if err != nil {
+			return err
+		}
+
+		src.ID = id
+		if v, err := bolt.MarshalLayout(src); err != nil {
+			return err
+		} else if err := tx.Put([]byte(src.ID), v); err != nil {
+			return err
+		}
+		return nil
+	}); err != nil {
+		return chronograf.Layout{}, err
+	}
+
+	return src, nil
+}
+
+// Delete removes the Layout from the LayoutsStore
+func (s *LayoutsStore) Delete(ctx context.Context, src chronograf.Layout) error {
+	return s.client.update(ctx, LayoutsBucket, func(tx *Tx) error {
+		if v := tx.Get([]byte(src.ID)); v == nil {
+			return chronograf.ErrLayoutNotFound
+		}
+		return tx.Delete([]byte(src.ID))
+	})
+}
+
+// Get returns a Layout if the id exists.
+func (s *LayoutsStore) Get(ctx context.Context, id string) (chronograf.Layout, error) {
+	var src chronograf.Layout
+	if err := s.client.view(ctx, LayoutsBucket, func(tx *Tx) error {
+		if v := tx.Get([]byte(id)); v == nil {
+			return chronograf.ErrLayoutNotFound
+		} else if err := bolt.UnmarshalLayout(v, &src); err != nil {
+			return err
+		}
+		return nil
+	}); err != nil {
+		return chronograf.Layout{}, err
+	}
+
+	return src, nil
+}
+
+// Update a Layout
+func (s *LayoutsStore) Update(ctx context.Context, src chronograf.Layout) error {
+	return s.client.update(ctx, LayoutsBucket, func(tx *Tx) error {
+		// Get an existing layout with the same ID.
+		if v := tx.Get([]byte(src.ID)); v == nil {
+			return chronograf.ErrLayoutNotFound
+		}
+
+		if v, err := bolt.MarshalLayout(src); err != nil {
+			return err
+		} else if err := tx.Put([]byte(src.ID), v); err != nil {
+			return err
+		}
+		return nil
+	})
+}
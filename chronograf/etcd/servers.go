@@ -0,0 +1,142 @@
+package etcd
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt"
+)
+
+// Ensure ServersStore implements chronograf.ServersStore.
+var _ chronograf.ServersStore = &ServersStore{}
+
+// ServersStore is the etcd implementation to store servers in a store.
+// Used store servers that are associated in some way with a source
+type ServersStore struct {
+	client *Client
+}
+
+// All returns all known servers
+func (s *ServersStore) All(ctx context.Context) ([]chronograf.Server, error) {
+	var srcs []chronograf.Server
+	if err := s.client.view(ctx, ServersBucket, func(tx *Tx) error {
+		var err error
+		srcs, err = s.all(ctx, tx)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return srcs, nil
+}
+
+// Add creates a new Server in the ServerStore.
+func (s *ServersStore) Add(ctx context.Context, src chronograf.Server) (chronograf.Server, error) {
+	if err := s.client.update(ctx, ServersBucket, func(tx *Tx) error {
+		seq, err := tx.NextSequence()
+		if err != nil {
+			return err
+		}
+		src.ID = int(seq)
+
+		// make the newly added source "active"
+		if err := s.resetActiveServer(ctx, tx); err != nil {
+			return err
+		}
+		src.Active = true
+
+		if v, err := bolt.MarshalServer(src); err != nil {
+			return err
+		} else if err := tx.Put(itob(src.ID), v); err != nil {
+			return err
+		}
+		return nil
+	}); err != nil {
+		return chronograf.Server{}, err
+	}
+
+	return src, nil
+}
+
+// Delete removes the Server from the ServersStore
+func (s *ServersStore) Delete(ctx context.Context, src chronograf.Server) error {
+	return s.client.update(ctx, ServersBucket, func(tx *Tx) error {
+		return tx.Delete(itob(src.ID))
+	})
+}
+
+// Get returns a Server if the id exists.
+func (s *ServersStore) Get(ctx context.Context, id int) (chronograf.Server, error) {
+	var src chronograf.Server
+	if err := s.client.view(ctx, ServersBucket, func(tx *Tx) error {
+		if v := tx.Get(itob(id)); v == nil {
+			return chronograf.ErrServerNotFound
+		} else if err := bolt.UnmarshalServer(v, &src); err != nil {
+			return err
+		}
+		return nil
+	}); err != nil {
+		return chronograf.Server{}, err
+	}
+
+	return src, nil
+}
+
+// Update a Server
+func (s *ServersStore) Update(ctx context.Context, src chronograf.Server) error {
+	return s.client.update(ctx, ServersBucket, func(tx *Tx) error {
+		// Get an existing server with the same ID.
+		if v := tx.Get(itob(src.ID)); v == nil {
+			return chronograf.ErrServerNotFound
+		}
+
+		// only one server can be active at a time
+		if src.Active {
+			if err := s.resetActiveServer(ctx, tx); err != nil {
+				return err
+			}
+		}
+
+		if v, err := bolt.MarshalServer(src); err != nil {
+			return err
+		} else if err := tx.Put(itob(src.ID), v); err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func (s *ServersStore) all(ctx context.Context, tx *Tx) ([]chronograf.Server, error) {
+	var srcs []chronograf.Server
+	if err := tx.ForEach(func(k, v []byte) error {
+		var src chronograf.Server
+		if err := bolt.UnmarshalServer(v, &src); err != nil {
+			return err
+		}
+		srcs = append(srcs, src)
+		return nil
+	}); err != nil {
+		return srcs, err
+	}
+	return srcs, nil
+}
+
+// resetActiveServer unsets the Active flag on all sources
+func (s *ServersStore) resetActiveServer(ctx context.Context, tx *Tx) error {
+	srcs, err := s.all(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	for _, other := range srcs {
+		if other.Active {
+			other.Active = false
+			if v, err := bolt.MarshalServer(other); err != nil {
+				return err
+			} else if err := tx.Put(itob(other.ID), v); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
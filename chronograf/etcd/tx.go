@@ -0,0 +1,287 @@
+package etcd
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+)
+
+// maxRetries bounds how often an update is retried when other replicas
+// change the same bucket concurrently
+const maxRetries = 10
+
+// ErrConflict is returned when an update kept conflicting with the updates
+// of other replicas
+var ErrConflict = errors.New("etcd: too many concurrent updates")
+
+// Buckets group the keys of each store like the buckets of BoltDB
+const (
+	SourcesBucket    = "sources"
+	ServersBucket    = "servers"
+	LayoutsBucket    = "layouts"
+	DashboardsBucket = "dashboards"
+	UsersBucket      = "users"
+)
+
+type keyValue struct {
+	Key         []byte `json:"key"`
+	Value       []byte `json:"value"`
+	ModRevision int64  `json:"mod_revision,string"`
+}
+
+type responseHeader struct {
+	Revision int64 `json:"revision,string"`
+}
+
+type rangeRequest struct {
+	Key      []byte `json:"key"`
+	RangeEnd []byte `json:"range_end,omitempty"`
+	Limit    int64  `json:"limit,string,omitempty"`
+}
+
+type rangeResponse struct {
+	Header responseHeader `json:"header"`
+	Kvs    []keyValue     `json:"kvs"`
+}
+
+type putRequest struct {
+	Key   []byte `json:"key"`
+	Value []byte `json:"value"`
+}
+
+type deleteRangeRequest struct {
+	Key []byte `json:"key"`
+}
+
+type compare struct {
+	Target      string `json:"target"`
+	Result      string `json:"result"`
+	Key         []byte `json:"key"`
+	RangeEnd    []byte `json:"range_end,omitempty"`
+	ModRevision int64  `json:"mod_revision,string"`
+}
+
+type requestOp struct {
+	RequestPut         *putRequest         `json:"request_put,omitempty"`
+	RequestDeleteRange *deleteRangeRequest `json:"request_delete_range,omitempty"`
+}
+
+type txnRequest struct {
+	Compare []compare   `json:"compare"`
+	Success []requestOp `json:"success"`
+}
+
+type txnResponse struct {
+	Header    responseHeader `json:"header"`
+	Succeeded bool           `json:"succeeded"`
+}
+
+// prefixEnd returns the end of the range of keys starting with prefix
+func prefixEnd(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	// The prefix is all 0xff; range to the end of the keyspace
+	return []byte{0}
+}
+
+// rangeKeys reads the keys from key up to end, or the single key when end is nil
+func (c *Client) rangeKeys(ctx context.Context, key, end []byte, limit int64) ([]keyValue, int64, error) {
+	var res rangeResponse
+	if err := c.call(ctx, "/v3/kv/range", &rangeRequest{
+		Key:      key,
+		RangeEnd: end,
+		Limit:    limit,
+	}, &res); err != nil {
+		return nil, 0, err
+	}
+	return res.Kvs, res.Header.Revision, nil
+}
+
+// Tx is a snapshot of a bucket. Writes are applied to the snapshot right
+// away and written to etcd at once when the update commits.
+type Tx struct {
+	prefix   string
+	items    map[string][]byte
+	seq      uint64
+	writable bool
+
+	changed    map[string]bool // changed keys are written on commit
+	seqChanged bool
+}
+
+func (tx *Tx) itemKey(k []byte) []byte {
+	return []byte(tx.prefix + "items/" + string(k))
+}
+
+func (tx *Tx) seqKey() []byte {
+	return []byte(tx.prefix + "seq")
+}
+
+// Get returns the value of a key or nil if it does not exist
+func (tx *Tx) Get(k []byte) []byte {
+	return tx.items[string(k)]
+}
+
+// Put sets the value of a key
+func (tx *Tx) Put(k, v []byte) error {
+	if !tx.writable {
+		return errors.New("etcd: put in read-only transaction")
+	}
+	tx.items[string(k)] = v
+	tx.changed[string(k)] = true
+	return nil
+}
+
+// Delete removes a key
+func (tx *Tx) Delete(k []byte) error {
+	if !tx.writable {
+		return errors.New("etcd: delete in read-only transaction")
+	}
+	delete(tx.items, string(k))
+	tx.changed[string(k)] = true
+	return nil
+}
+
+// ForEach calls fn for every key of the bucket in key order
+func (tx *Tx) ForEach(fn func(k, v []byte) error) error {
+	keys := make([]string, 0, len(tx.items))
+	for k := range tx.items {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		v, ok := tx.items[k]
+		if !ok {
+			continue
+		}
+		if err := fn([]byte(k), v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NextSequence returns a new identifier unique within the bucket
+func (tx *Tx) NextSequence() (uint64, error) {
+	if !tx.writable {
+		return 0, errors.New("etcd: sequence in read-only transaction")
+	}
+	tx.seq++
+	tx.seqChanged = true
+	return tx.seq, nil
+}
+
+// requests returns the operations writing the changes of the transaction.
+// etcd rejects transactions changing a key twice, so each key is written once.
+func (tx *Tx) requests() []requestOp {
+	keys := make([]string, 0, len(tx.changed))
+	for k := range tx.changed {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	ops := []requestOp{}
+	for _, k := range keys {
+		if v, ok := tx.items[k]; ok {
+			ops = append(ops, requestOp{
+				RequestPut: &putRequest{Key: tx.itemKey([]byte(k)), Value: v},
+			})
+			continue
+		}
+		ops = append(ops, requestOp{
+			RequestDeleteRange: &deleteRangeRequest{Key: tx.itemKey([]byte(k))},
+		})
+	}
+	if tx.seqChanged {
+		ops = append(ops, requestOp{
+			RequestPut: &putRequest{Key: tx.seqKey(), Value: []byte(strconv.FormatUint(tx.seq, 10))},
+		})
+	}
+	return ops
+}
+
+// load reads the snapshot of a bucket and the revision it was read at
+func (c *Client) load(ctx context.Context, bucket string, writable bool) (*Tx, int64, error) {
+	tx := &Tx{
+		prefix:   c.Prefix + bucket + "/",
+		items:    map[string][]byte{},
+		writable: writable,
+		changed:  map[string]bool{},
+	}
+	kvs, rev, err := c.rangeKeys(ctx, []byte(tx.prefix), prefixEnd([]byte(tx.prefix)), 0)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	items := tx.prefix + "items/"
+	for _, kv := range kvs {
+		k := string(kv.Key)
+		switch {
+		case k == string(tx.seqKey()):
+			seq, err := strconv.ParseUint(string(kv.Value), 10, 64)
+			if err != nil {
+				return nil, 0, err
+			}
+			tx.seq = seq
+		case len(k) > len(items) && k[:len(items)] == items:
+			tx.items[k[len(items):]] = kv.Value
+		}
+	}
+	return tx, rev, nil
+}
+
+// view calls fn with a snapshot of a bucket
+func (c *Client) view(ctx context.Context, bucket string, fn func(*Tx) error) error {
+	tx, _, err := c.load(ctx, bucket, false)
+	if err != nil {
+		return err
+	}
+	return fn(tx)
+}
+
+// update calls fn with a snapshot of a bucket and writes its changes unless
+// the bucket changed since the snapshot was read. fn is called again with a
+// new snapshot in that case, so it must not have other side effects.
+func (c *Client) update(ctx context.Context, bucket string, fn func(*Tx) error) error {
+	for i := 0; i < maxRetries; i++ {
+		tx, rev, err := c.load(ctx, bucket, true)
+		if err != nil {
+			return err
+		}
+		if err := fn(tx); err != nil {
+			return err
+		}
+		ops := tx.requests()
+		if len(ops) == 0 {
+			return nil
+		}
+
+		prefix := []byte(tx.prefix)
+		var res txnResponse
+		if err := c.call(ctx, "/v3/kv/txn", &txnRequest{
+			Compare: []compare{
+				{
+					Target:      "MOD",
+					Result:      "LESS",
+					Key:         prefix,
+					RangeEnd:    prefixEnd(prefix),
+					ModRevision: rev + 1,
+				},
+			},
+			Success: ops,
+		}, &res); err != nil {
+			return err
+		}
+		if res.Succeeded {
+			return nil
+		}
+	}
+	return ErrConflict
+}
@@ -0,0 +1,235 @@
+package etcd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt"
+)
+
+// Ensure UsersStore implements chronograf.UsersStore.
+var _ chronograf.UsersStore = &UsersStore{}
+
+// UsersStore uses etcd to store and retrieve users
+type UsersStore struct {
+	client *Client
+}
+
+// get searches the UsersStore for user with id
+func (s *UsersStore) get(ctx context.Context, id uint64) (*chronograf.User, error) {
+	var u chronograf.User
+	err := s.client.view(ctx, UsersBucket, func(tx *Tx) error {
+		v := tx.Get(u64tob(id))
+		if v == nil {
+			return chronograf.ErrUserNotFound
+		}
+		return bolt.UnmarshalUser(v, &u)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &u, nil
+}
+
+func (s *UsersStore) each(ctx context.Context, fn func(*chronograf.User)) error {
+	return s.client.view(ctx, UsersBucket, func(tx *Tx) error {
+		return tx.ForEach(func(k, v []byte) error {
+			var user chronograf.User
+			if err := bolt.UnmarshalUser(v, &user); err != nil {
+				return err
+			}
+			fn(&user)
+			return nil
+		})
+	})
+}
+
+// Num returns the number of users in the UsersStore
+func (s *UsersStore) Num(ctx context.Context) (int, error) {
+	count := 0
+	if err := s.client.view(ctx, UsersBucket, func(tx *Tx) error {
+		count = len(tx.items)
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// Get searches the UsersStore for user with name
+func (s *UsersStore) Get(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+	if q.ID != nil {
+		return s.get(ctx, *q.ID)
+	}
+
+	if q.Name != nil && q.Provider != nil && q.Scheme != nil {
+		var user *chronograf.User
+		err := s.each(ctx, func(u *chronograf.User) {
+			if user != nil {
+				return
+			}
+			if u.Name == *q.Name && u.Provider == *q.Provider && u.Scheme == *q.Scheme {
+				user = u
+			}
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		if user == nil {
+			return nil, chronograf.ErrUserNotFound
+		}
+
+		return user, nil
+	}
+
+	return nil, fmt.Errorf("must specify either ID, or Name, Provider, and Scheme in UserQuery")
+}
+
+// Filter returns the users matching f
+func (s *UsersStore) Filter(ctx context.Context, f chronograf.UserFilter) ([]chronograf.User, error) {
+	users := []chronograf.User{}
+	if err := s.each(ctx, func(u *chronograf.User) {
+		if f.Matches(u) {
+			users = append(users, *u)
+		}
+	}); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// Add a new User to the UsersStore.
+func (s *UsersStore) Add(ctx context.Context, u *chronograf.User) (*chronograf.User, error) {
+	if u == nil {
+		return nil, fmt.Errorf("user provided is nil")
+	}
+	us, err := s.AddMany(ctx, []*chronograf.User{u})
+	if err != nil {
+		return nil, err
+	}
+	u.ID = us[0].ID
+	return u, nil
+}
+
+// AddMany adds all users to the UsersStore within a single transaction. If
+// any of the users already exists, none of them are added. The users added are
+// returned with their IDs; us is left unchanged.
+func (s *UsersStore) AddMany(ctx context.Context, us []*chronograf.User) ([]*chronograf.User, error) {
+	for _, u := range us {
+		if u == nil {
+			return nil, fmt.Errorf("user provided is nil")
+		}
+	}
+	// Users are checked against the other users of the same snapshot, so
+	// that replicas cannot add the same user concurrently
+	added := make([]*chronograf.User, len(us))
+	if err := s.client.update(ctx, UsersBucket, func(tx *Tx) error {
+		exists := map[string]bool{}
+		if err := tx.ForEach(func(k, v []byte) error {
+			var user chronograf.User
+			if err := bolt.UnmarshalUser(v, &user); err != nil {
+				return err
+			}
+			exists[userKey(&user)] = true
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		for i, u := range us {
+			key := userKey(u)
+			if exists[key] {
+				return chronograf.ErrUserAlreadyExists
+			}
+			exists[key] = true
+
+			seq, err := tx.NextSequence()
+			if err != nil {
+				return err
+			}
+			user := *u
+			user.ID = seq
+			if v, err := bolt.MarshalUser(&user); err != nil {
+				return err
+			} else if err := tx.Put(u64tob(seq), v); err != nil {
+				return err
+			}
+			added[i] = &user
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return added, nil
+}
+
+// userKey identifies a user by the name, provider and scheme used to log in
+func userKey(u *chronograf.User) string {
+	return u.Provider + ":" + u.Scheme + ":" + u.Name
+}
+
+// Delete a user from the UsersStore
+func (s *UsersStore) Delete(ctx context.Context, u *chronograf.User) error {
+	return s.client.update(ctx, UsersBucket, func(tx *Tx) error {
+		if v := tx.Get(u64tob(u.ID)); v == nil {
+			return chronograf.ErrUserNotFound
+		}
+		return tx.Delete(u64tob(u.ID))
+	})
+}
+
+// Update a user
+func (s *UsersStore) Update(ctx context.Context, u *chronograf.User) error {
+	return s.client.update(ctx, UsersBucket, func(tx *Tx) error {
+		if v := tx.Get(u64tob(u.ID)); v == nil {
+			return chronograf.ErrUserNotFound
+		}
+		if v, err := bolt.MarshalUser(u); err != nil {
+			return err
+		} else if err := tx.Put(u64tob(u.ID), v); err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+// UpdateMany updates all users within a single transaction. If any of the
+// users does not exist, none of them are updated.
+func (s *UsersStore) UpdateMany(ctx context.Context, us []*chronograf.User) error {
+	return s.client.update(ctx, UsersBucket, func(tx *Tx) error {
+		for _, u := range us {
+			if u == nil {
+				return fmt.Errorf("user provided is nil")
+			}
+			if v := tx.Get(u64tob(u.ID)); v == nil {
+				return chronograf.ErrUserNotFound
+			}
+			if v, err := bolt.MarshalUser(u); err != nil {
+				return err
+			} else if err := tx.Put(u64tob(u.ID), v); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// All returns all users
+func (s *UsersStore) All(ctx context.Context) ([]chronograf.User, error) {
+	var users []chronograf.User
+	if err := s.each(ctx, func(u *chronograf.User) {
+		users = append(users, *u)
+	}); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
@@ -21,8 +21,15 @@ type NoAuthorization struct{}
 // Set does not add authorization
 func (n *NoAuthorization) Set(req *http.Request) error { return nil }
 
-// DefaultAuthorization creates either a shared JWT builder, basic auth or Noop
+// DefaultAuthorization creates either a shared JWT builder, basic auth or Noop.
+// Sources of InfluxDB 2.x authenticate with the API token of their password.
 func DefaultAuthorization(src *chronograf.Source) Authorizer {
+	if src.Type == chronograf.InfluxDBv2 {
+		if src.Password != "" {
+			return &TokenAuth{Token: src.Password}
+		}
+		return &NoAuthorization{}
+	}
 	// Optionally, add the shared secret JWT token creation
 	if src.Username != "" && src.SharedSecret != "" {
 		return &BearerJWT{
@@ -50,6 +57,17 @@ func (b *BasicAuth) Set(r *http.Request) error {
 	return nil
 }
 
+// TokenAuth adds Authorization: Token to the request header
+type TokenAuth struct {
+	Token string
+}
+
+// Set adds the InfluxDB 2.x API token to the request
+func (t *TokenAuth) Set(r *http.Request) error {
+	r.Header.Set("Authorization", "Token "+t.Token)
+	return nil
+}
+
 // BearerJWT is the default Bearer for InfluxDB
 type BearerJWT struct {
 	Username     string
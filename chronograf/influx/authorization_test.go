@@ -1,8 +1,12 @@
 package influx
 
 import (
+	"net/http/httptest"
+	"reflect"
 	"testing"
 	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
 )
 
 func TestJWT(t *testing.T) {
@@ -42,3 +46,54 @@ func TestJWT(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaultAuthorization(t *testing.T) {
+	tests := []struct {
+		name string
+		src  chronograf.Source
+		want Authorizer
+	}{
+		{
+			name: "Shared secret",
+			src:  chronograf.Source{Username: "marty", Password: "mcfly", SharedSecret: "hunter2"},
+			want: &BearerJWT{Username: "marty", SharedSecret: "hunter2"},
+		},
+		{
+			name: "Username and password",
+			src:  chronograf.Source{Username: "marty", Password: "mcfly"},
+			want: &BasicAuth{Username: "marty", Password: "mcfly"},
+		},
+		{
+			name: "InfluxDB 2.x token",
+			src:  chronograf.Source{Type: chronograf.InfluxDBv2, Username: "my-org", Password: "my-token"},
+			want: &TokenAuth{Token: "my-token"},
+		},
+		{
+			name: "InfluxDB 2.x without token",
+			src:  chronograf.Source{Type: chronograf.InfluxDBv2, Username: "my-org"},
+			want: &NoAuthorization{},
+		},
+		{
+			name: "No credentials",
+			src:  chronograf.Source{},
+			want: &NoAuthorization{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DefaultAuthorization(&tt.src); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DefaultAuthorization() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTokenAuth_Set(t *testing.T) {
+	r := httptest.NewRequest("GET", "http://any.url/api/v2/buckets", nil)
+	if err := (&TokenAuth{Token: "my-token"}).Set(r); err != nil {
+		t.Fatal(err)
+	}
+	if got := r.Header.Get("Authorization"); got != "Token my-token" {
+		t.Errorf("TokenAuth.Set() Authorization = %q", got)
+	}
+}
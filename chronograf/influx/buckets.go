@@ -0,0 +1,235 @@
+package influx
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+	clog "github.com/influxdata/influxdb/chronograf/log"
+	"github.com/influxdata/influxdb/kit/tracing"
+)
+
+// bucketsPageSize is the number of buckets read per request to InfluxDB 2.x
+const bucketsPageSize = 100
+
+// bucketRP is the name of the only retention policy of a bucket
+const bucketRP = "autogen"
+
+// ErrBucketsReadOnly is returned when changing the databases or retention
+// policies of an InfluxDB 2.x source, which are its buckets
+var ErrBucketsReadOnly = errors.New("databases and retention policies of InfluxDB 2.x sources are buckets, which are managed in InfluxDB")
+
+type bucket struct {
+	ID             string          `json:"id"`
+	Name           string          `json:"name"`
+	RetentionRules []retentionRule `json:"retentionRules"`
+}
+
+type retentionRule struct {
+	Type         string `json:"type"`
+	EverySeconds int64  `json:"everySeconds"`
+}
+
+// duration returns the retention period of the bucket, 0s meaning infinite as
+// it does for retention policies
+func (b bucket) duration() string {
+	for _, r := range b.RetentionRules {
+		if r.Type == "expire" && r.EverySeconds > 0 {
+			return (time.Duration(r.EverySeconds) * time.Second).String()
+		}
+	}
+	return "0s"
+}
+
+// buckets lists the buckets of the organization of the client, only the one
+// of the given name when name is not empty
+func (c *Client) buckets(ctx context.Context, name string) ([]bucket, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	var all []bucket
+	for offset := 0; ; offset += bucketsPageSize {
+		params := url.Values{}
+		if c.Org != "" {
+			params.Set("org", c.Org)
+		}
+		if name != "" {
+			params.Set("name", name)
+		}
+		params.Set("limit", strconv.Itoa(bucketsPageSize))
+		params.Set("offset", strconv.Itoa(offset))
+
+		u := *c.URL
+		u.Path = "api/v2/buckets"
+		u.RawQuery = params.Encode()
+		req, err := http.NewRequest("GET", u.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+		req = req.WithContext(ctx)
+		tracing.InjectToHTTPRequest(span, req)
+		clog.InjectRequestID(ctx, req)
+		if c.Authorizer != nil {
+			if err := c.Authorizer.Set(req); err != nil {
+				return nil, err
+			}
+		}
+
+		hc := &http.Client{Transport: c.transport()}
+		resp, err := hc.Do(req)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, chronograf.ErrUpstreamTimeout
+			}
+			return nil, err
+		}
+
+		var page struct {
+			Buckets []bucket `json:"buckets"`
+			Message string   `json:"message"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			if page.Message != "" {
+				return nil, fmt.Errorf("received status code %d from server: err: %s", resp.StatusCode, page.Message)
+			}
+			return nil, fmt.Errorf("received status code %d from server", resp.StatusCode)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, page.Buckets...)
+		if len(page.Buckets) < bucketsPageSize {
+			return all, nil
+		}
+	}
+}
+
+// bucketDatabases lists the buckets of InfluxDB 2.x as databases
+func (c *Client) bucketDatabases(ctx context.Context) ([]chronograf.Database, error) {
+	buckets, err := c.buckets(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	dbs := make([]chronograf.Database, len(buckets))
+	for i, b := range buckets {
+		dbs[i] = chronograf.Database{
+			Name:     b.Name,
+			Duration: b.duration(),
+		}
+	}
+	return dbs, nil
+}
+
+// bucketRetentionPolicies returns the retention of the bucket db as its only
+// retention policy
+func (c *Client) bucketRetentionPolicies(ctx context.Context, db string) ([]chronograf.RetentionPolicy, error) {
+	buckets, err := c.buckets(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range buckets {
+		if b.Name == db {
+			return []chronograf.RetentionPolicy{
+				{
+					Name:     bucketRP,
+					Duration: b.duration(),
+					Default:  true,
+				},
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("bucket %q not found", db)
+}
+
+// bucketMeasurements lists the measurements of the bucket db with Flux
+func (c *Client) bucketMeasurements(ctx context.Context, db string, limit, offset int) ([]chronograf.Measurement, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	script := fmt.Sprintf("import \"influxdata/influxdb/v1\"\n\nv1.measurements(bucket: %s)", fluxString(db))
+	data, err := c.FluxQuery(ctx, script)
+	if err != nil {
+		return nil, err
+	}
+	names, err := fluxColumn(data, "_value")
+	if err != nil {
+		return nil, err
+	}
+
+	if offset > len(names) {
+		offset = len(names)
+	}
+	names = names[offset:]
+	if limit > 0 && limit < len(names) {
+		names = names[:limit]
+	}
+	measurements := make([]chronograf.Measurement, len(names))
+	for i, name := range names {
+		measurements[i] = chronograf.Measurement{Name: name}
+	}
+	return measurements, nil
+}
+
+// fluxString quotes s as a Flux string literal
+func fluxString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, `$`, `\$`).Replace(s) + `"`
+}
+
+// fluxColumn returns the distinct values of column in every table of an
+// annotated CSV result, or the error of the result if there is one
+func fluxColumn(data []byte, column string) ([]string, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+
+	var values []string
+	seen := map[string]bool{}
+	header := true
+	col, errCol := -1, -1
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if strings.HasPrefix(record[0], "#") {
+			header = true
+			continue
+		}
+		if header {
+			col, errCol = -1, -1
+			for i, name := range record {
+				switch name {
+				case column:
+					col = i
+				case "error":
+					errCol = i
+				}
+			}
+			header = false
+			continue
+		}
+		if errCol >= 0 && errCol < len(record) && record[errCol] != "" {
+			return nil, fmt.Errorf("flux error: %s", record[errCol])
+		}
+		if col >= 0 && col < len(record) && !seen[record[col]] {
+			seen[record[col]] = true
+			values = append(values, record[col])
+		}
+	}
+	return values, nil
+}
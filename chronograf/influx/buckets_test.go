@@ -0,0 +1,148 @@
+package influx_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/influx"
+)
+
+// newV2Server fakes the ping, buckets and query endpoints of InfluxDB 2.x
+func newV2Server(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/ping" {
+			rw.Header().Set("X-Influxdb-Build", "OSS")
+			rw.Header().Set("X-Influxdb-Version", "v2.0.4")
+			rw.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if auth := r.Header.Get("Authorization"); auth != "Token my-token" {
+			rw.WriteHeader(http.StatusUnauthorized)
+			rw.Write([]byte(`{"code":"unauthorized","message":"unauthorized access"}`))
+			return
+		}
+		if org := r.URL.Query().Get("org"); org != "my-org" {
+			t.Error("Expected the org to be `my-org` but was", org)
+		}
+		switch r.URL.Path {
+		case "/api/v2/buckets":
+			buckets := []string{
+				`{"id":"1","name":"telegraf","retentionRules":[{"type":"expire","everySeconds":604800}]}`,
+				`{"id":"2","name":"_monitoring","retentionRules":[]}`,
+			}
+			if name := r.URL.Query().Get("name"); name != "" {
+				var named []string
+				for _, b := range buckets {
+					if strings.Contains(b, `"name":"`+name+`"`) {
+						named = append(named, b)
+					}
+				}
+				buckets = named
+			}
+			rw.WriteHeader(http.StatusOK)
+			rw.Write([]byte(`{"buckets":[` + strings.Join(buckets, ",") + `]}`))
+		case "/api/v2/query":
+			var req struct {
+				Query string `json:"query"`
+			}
+			json.NewDecoder(r.Body).Decode(&req)
+			if !strings.Contains(req.Query, `v1.measurements(bucket: "telegraf")`) {
+				t.Error("Unexpected query", req.Query)
+			}
+			rw.WriteHeader(http.StatusOK)
+			rw.Write([]byte("#datatype,string,long,string\n,result,table,_value\n,_result,0,cpu\n,_result,0,disk\n,_result,0,mem\n"))
+		default:
+			t.Error("Unexpected request to", r.URL.Path)
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestClient_V2(t *testing.T) {
+	ts := newV2Server(t)
+	defer ts.Close()
+
+	ctx := context.Background()
+	c := &influx.Client{Logger: &chronograf.NoopLogger{}}
+	if err := c.Connect(ctx, &chronograf.Source{
+		URL:      ts.URL,
+		Type:     chronograf.InfluxDBv2,
+		Username: "my-org",
+		Password: "my-token",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	tsdbType, err := c.Type(ctx)
+	if err != nil || tsdbType != chronograf.InfluxDBv2 {
+		t.Errorf("Client.Type() = %v, %v, want %v", tsdbType, err, chronograf.InfluxDBv2)
+	}
+
+	dbs, err := c.AllDB(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantDBs := []chronograf.Database{
+		{Name: "telegraf", Duration: "168h0m0s"},
+		{Name: "_monitoring", Duration: "0s"},
+	}
+	if diff := cmp.Diff(dbs, wantDBs); diff != "" {
+		t.Errorf("Client.AllDB() diff (-got +want):\n%s", diff)
+	}
+
+	rps, err := c.AllRP(ctx, "telegraf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantRPs := []chronograf.RetentionPolicy{
+		{Name: "autogen", Duration: "168h0m0s", Default: true},
+	}
+	if diff := cmp.Diff(rps, wantRPs); diff != "" {
+		t.Errorf("Client.AllRP() diff (-got +want):\n%s", diff)
+	}
+	if _, err := c.AllRP(ctx, "unknown"); err == nil {
+		t.Error("Client.AllRP() of an unknown bucket want error")
+	}
+
+	measurements, err := c.GetMeasurements(ctx, "telegraf", 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(measurements, []chronograf.Measurement{{Name: "disk"}}); diff != "" {
+		t.Errorf("Client.GetMeasurements() diff (-got +want):\n%s", diff)
+	}
+
+	if _, err := c.CreateDB(ctx, &chronograf.Database{Name: "telegraf"}); err != influx.ErrBucketsReadOnly {
+		t.Errorf("Client.CreateDB() error = %v, want %v", err, influx.ErrBucketsReadOnly)
+	}
+	if err := c.DropRP(ctx, "telegraf", "autogen"); err != influx.ErrBucketsReadOnly {
+		t.Errorf("Client.DropRP() error = %v, want %v", err, influx.ErrBucketsReadOnly)
+	}
+}
+
+func TestClient_V2_Unauthorized(t *testing.T) {
+	ts := newV2Server(t)
+	defer ts.Close()
+
+	ctx := context.Background()
+	c := &influx.Client{Logger: &chronograf.NoopLogger{}}
+	if err := c.Connect(ctx, &chronograf.Source{
+		URL:      ts.URL,
+		Type:     chronograf.InfluxDBv2,
+		Username: "my-org",
+		Password: "other-token",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := c.AllDB(ctx)
+	if err == nil || !strings.Contains(err.Error(), "unauthorized access") {
+		t.Errorf("Client.AllDB() error = %v", err)
+	}
+}
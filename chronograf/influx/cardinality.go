@@ -0,0 +1,159 @@
+package influx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/kit/tracing"
+	"github.com/influxdata/influxql"
+)
+
+// SeriesCardinality estimates the number of series of a database with SHOW
+// SERIES CARDINALITY, which stays cheap on databases with many series
+func (c *Client) SeriesCardinality(ctx context.Context, db string) (int64, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	results, err := c.showCardinality(ctx, db, `SHOW SERIES CARDINALITY ON `+influxql.QuoteIdent(db))
+	if err != nil {
+		return 0, err
+	}
+	var n int64
+	for _, card := range results.Cardinalities() {
+		n += card.Count
+	}
+	return n, nil
+}
+
+// MeasurementsCardinality counts the series of every measurement of a
+// database, the measurements with the most series first
+func (c *Client) MeasurementsCardinality(ctx context.Context, db string) ([]chronograf.Cardinality, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	results, err := c.showCardinality(ctx, db, `SHOW SERIES EXACT CARDINALITY ON `+influxql.QuoteIdent(db))
+	if err != nil {
+		return nil, err
+	}
+	res := results.Cardinalities()
+	sortCardinalities(res)
+	return res, nil
+}
+
+// TagKeysCardinality counts the values of every tag key of a measurement, the
+// tag keys with the most values first. The values of all tag keys are
+// counted by a single request.
+func (c *Client) TagKeysCardinality(ctx context.Context, db, measurement string) ([]chronograf.Cardinality, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	on := fmt.Sprintf(`ON %s FROM %s`, influxql.QuoteIdent(db), influxql.QuoteIdent(measurement))
+	results, err := c.showCardinality(ctx, db, `SHOW TAG KEYS `+on)
+	if err != nil {
+		return nil, err
+	}
+	keys := results.TagKeys()
+	if len(keys) == 0 {
+		return []chronograf.Cardinality{}, nil
+	}
+
+	stmts := make([]string, len(keys))
+	for i, key := range keys {
+		stmts[i] = fmt.Sprintf(`SHOW TAG VALUES EXACT CARDINALITY %s WITH KEY = %s`, on, influxql.QuoteIdent(key))
+	}
+	results, err = c.showCardinality(ctx, db, strings.Join(stmts, "; "))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]chronograf.Cardinality, len(keys))
+	for i, key := range keys {
+		res[i].Name = key
+		if i >= len(results) {
+			continue
+		}
+		stmt := results[i : i+1]
+		for _, card := range stmt.Cardinalities() {
+			res[i].Count += card.Count
+		}
+	}
+	sortCardinalities(res)
+	return res, nil
+}
+
+func (c *Client) showCardinality(ctx context.Context, db, command string) (showResults, error) {
+	res, err := c.Query(ctx, chronograf.Query{
+		Command: command,
+		DB:      db,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := resultsError(res); err != nil {
+		return nil, err
+	}
+	octets, err := res.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	results := showResults{}
+	if err := json.Unmarshal(octets, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// Cardinalities converts SHOW ... CARDINALITY to the count of every series,
+// which is named after its measurement
+func (r *showResults) Cardinalities() []chronograf.Cardinality {
+	res := []chronograf.Cardinality{}
+	for _, u := range *r {
+		for _, s := range u.Series {
+			card := chronograf.Cardinality{Name: s.Name}
+			for _, v := range s.Values {
+				if len(v) == 0 {
+					continue
+				}
+				if n, ok := v[0].(float64); ok {
+					card.Count += int64(n)
+				}
+			}
+			res = append(res, card)
+		}
+	}
+	return res
+}
+
+// TagKeys converts SHOW TAG KEYS to the tag keys it lists
+func (r *showResults) TagKeys() []string {
+	res := []string{}
+	for _, u := range *r {
+		for _, s := range u.Series {
+			for _, v := range s.Values {
+				if len(v) == 0 {
+					continue
+				}
+				if key, ok := v[0].(string); ok {
+					res = append(res, key)
+				}
+			}
+		}
+	}
+	return res
+}
+
+// sortCardinalities orders cardinalities by descending count, so that
+// cardinality explosions are listed first
+func sortCardinalities(cards []chronograf.Cardinality) {
+	sort.SliceStable(cards, func(i, j int) bool {
+		if cards[i].Count != cards[j].Count {
+			return cards[i].Count > cards[j].Count
+		}
+		return cards[i].Name < cards[j].Name
+	})
+}
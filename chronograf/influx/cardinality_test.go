@@ -0,0 +1,99 @@
+package influx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func TestClient_Cardinality(t *testing.T) {
+	t.Parallel()
+	responses := map[string]string{
+		`SHOW SERIES CARDINALITY ON telegraf`: `{"results":[{"statement_id":0,"series":[{"columns":["cardinality estimation"],"values":[[1210]]}]}]}`,
+		`SHOW SERIES EXACT CARDINALITY ON telegraf`: `{"results":[{"statement_id":0,"series":[
+			{"name":"cpu","columns":["count"],"values":[[9]]},
+			{"name":"docker_container_cpu","columns":["count"],"values":[[1200]]},
+			{"name":"mem","columns":["count"],"values":[[9]]}
+		]}]}`,
+		`SHOW TAG KEYS ON telegraf FROM "docker container"`: `{"results":[{"statement_id":0,"series":[{"name":"docker container","columns":["tagKey"],"values":[["container_name"],["host"],["name"]]}]}]}`,
+		`SHOW TAG VALUES EXACT CARDINALITY ON telegraf FROM "docker container" WITH KEY = container_name; ` +
+			`SHOW TAG VALUES EXACT CARDINALITY ON telegraf FROM "docker container" WITH KEY = host; ` +
+			`SHOW TAG VALUES EXACT CARDINALITY ON telegraf FROM "docker container" WITH KEY = "name"`: `{"results":[
+			{"statement_id":0,"series":[{"name":"docker container","columns":["count"],"values":[[300]]}]},
+			{"statement_id":1,"series":[{"name":"docker container","columns":["count"],"values":[[4]]}]},
+			{"statement_id":2}
+		]}`,
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		q := r.FormValue("q")
+		res, ok := responses[q]
+		if !ok {
+			t.Errorf("unexpected query %s", q)
+			res = `{"results":[{"statement_id":0,"error":"unexpected query"}]}`
+		}
+		rw.Write([]byte(res))
+	}))
+	defer ts.Close()
+	u, _ := url.Parse(ts.URL)
+	c := &Client{
+		URL:    u,
+		Logger: &chronograf.NoopLogger{},
+	}
+	ctx := context.Background()
+
+	series, err := c.SeriesCardinality(ctx, "telegraf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if series != 1210 {
+		t.Errorf("SeriesCardinality() = %d, want 1210", series)
+	}
+
+	measurements, err := c.MeasurementsCardinality(ctx, "telegraf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantMeasurements := []chronograf.Cardinality{
+		{Name: "docker_container_cpu", Count: 1200},
+		{Name: "cpu", Count: 9},
+		{Name: "mem", Count: 9},
+	}
+	if !reflect.DeepEqual(measurements, wantMeasurements) {
+		t.Errorf("MeasurementsCardinality() = %v, want %v", measurements, wantMeasurements)
+	}
+
+	tagKeys, err := c.TagKeysCardinality(ctx, "telegraf", "docker container")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantTagKeys := []chronograf.Cardinality{
+		{Name: "container_name", Count: 300},
+		{Name: "host", Count: 4},
+		{Name: "name", Count: 0},
+	}
+	if !reflect.DeepEqual(tagKeys, wantTagKeys) {
+		t.Errorf("TagKeysCardinality() = %v, want %v", tagKeys, wantTagKeys)
+	}
+}
+
+func TestClient_TagKeysCardinality_error(t *testing.T) {
+	t.Parallel()
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Write([]byte(`{"results":[{"statement_id":0,"error":"database not found: missing"}]}`))
+	}))
+	defer ts.Close()
+	u, _ := url.Parse(ts.URL)
+	c := &Client{
+		URL:    u,
+		Logger: &chronograf.NoopLogger{},
+	}
+
+	if _, err := c.TagKeysCardinality(context.Background(), "missing", "cpu"); err == nil {
+		t.Error("TagKeysCardinality() expected the error of the statement")
+	}
+}
@@ -0,0 +1,129 @@
+package influx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/influxdata/influxdb/chronograf"
+	clog "github.com/influxdata/influxdb/chronograf/log"
+	"github.com/influxdata/influxdb/kit/tracing"
+)
+
+var _ chronograf.ChunkedQuerier = &Client{}
+
+// chunkSize is the number of points of every chunk of results InfluxDB
+// streams back for chunked queries
+const chunkSize = 10000
+
+// chunkedResponse is a chunk of the results of a query with chunked
+// responses, each written by InfluxDB as its own JSON object
+type chunkedResponse struct {
+	Results []struct {
+		StatementID int                       `json:"statement_id"`
+		Series      []chronograf.ResultSeries `json:"series"`
+		Err         string                    `json:"error"`
+	} `json:"results"`
+	Err string `json:"error"`
+}
+
+// ChunkedResponse runs a query with chunked responses of InfluxDB and
+// returns the body of the response as is: a JSON object per chunk of up to
+// size points, or of the default size of InfluxDB if size is not positive.
+// The body must be closed once read.
+func (c *Client) ChunkedResponse(ctx context.Context, q chronograf.Query, size int) (io.ReadCloser, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	u := *c.URL
+	u.Path = "query"
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+
+	params := req.URL.Query()
+	params.Set("q", q.Command)
+	params.Set("db", q.DB)
+	params.Set("rp", q.RP)
+	params.Set("epoch", "ms")
+	if q.Epoch != "" {
+		params.Set("epoch", q.Epoch)
+	}
+	params.Set("chunked", "true")
+	if size > 0 {
+		params.Set("chunk_size", strconv.Itoa(size))
+	}
+	req.URL.RawQuery = params.Encode()
+	tracing.InjectToHTTPRequest(span, req)
+	clog.InjectRequestID(ctx, req)
+
+	if c.Authorizer != nil {
+		if err := c.Authorizer.Set(req); err != nil {
+			return nil, err
+		}
+	}
+
+	hc := &http.Client{Transport: c.transport()}
+	resp, err := hc.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, chronograf.ErrUpstreamTimeout
+		}
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		var response chunkedResponse
+		if err := json.NewDecoder(resp.Body).Decode(&response); err != nil || response.Err == "" {
+			return nil, fmt.Errorf("received status code %d from server", resp.StatusCode)
+		}
+		return nil, fmt.Errorf("received status code %d from server: err: %s", resp.StatusCode, response.Err)
+	}
+	return resp.Body, nil
+}
+
+// ChunkedQuery runs a query with chunked responses of InfluxDB and calls fn
+// with the series of every chunk as it is decoded, so that large results are
+// never held in memory at once. Numbers are decoded as json.Number to keep
+// their precision. Errors of statements and errors returned by fn stop the
+// query.
+func (c *Client) ChunkedQuery(ctx context.Context, q chronograf.Query, fn func(chronograf.ResultSeries) error) error {
+	body, err := c.ChunkedResponse(ctx, q, chunkSize)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	dec := json.NewDecoder(body)
+	dec.UseNumber()
+	for {
+		var chunk chunkedResponse
+		if err := dec.Decode(&chunk); err == io.EOF {
+			return nil
+		} else if err != nil {
+			if ctx.Err() != nil {
+				return chronograf.ErrUpstreamTimeout
+			}
+			return err
+		}
+		if chunk.Err != "" {
+			return fmt.Errorf("%s", chunk.Err)
+		}
+		for _, res := range chunk.Results {
+			if res.Err != "" {
+				return fmt.Errorf("%s", res.Err)
+			}
+			for _, s := range res.Series {
+				if err := fn(s); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package influx
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func TestClient_ChunkedQuery(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		want    []chronograf.ResultSeries
+		wantErr bool
+	}{
+		{
+			name:   "Series split across chunks",
+			status: http.StatusOK,
+			body: `{"results":[{"statement_id":0,"series":[{"name":"cpu","tags":{"host":"a"},"columns":["time","usage"],"values":[[1000,0.5]]}],"partial":true}]}
+{"results":[{"statement_id":0,"series":[{"name":"cpu","tags":{"host":"a"},"columns":["time","usage"],"values":[[2000,null]]}]}]}
+`,
+			want: []chronograf.ResultSeries{
+				{Name: "cpu", Tags: map[string]string{"host": "a"}, Columns: []string{"time", "usage"}, Values: [][]interface{}{{json.Number("1000"), json.Number("0.5")}}},
+				{Name: "cpu", Tags: map[string]string{"host": "a"}, Columns: []string{"time", "usage"}, Values: [][]interface{}{{json.Number("2000"), nil}}},
+			},
+		},
+		{
+			name:   "Error of a statement",
+			status: http.StatusOK,
+			body: `{"results":[{"statement_id":0,"series":[{"name":"cpu","columns":["time","usage"],"values":[[1000,0.5]]}]}]}
+{"results":[{"statement_id":1,"error":"database not found: missing"}]}
+`,
+			want: []chronograf.ResultSeries{
+				{Name: "cpu", Columns: []string{"time", "usage"}, Values: [][]interface{}{{json.Number("1000"), json.Number("0.5")}}},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "Error of the server",
+			status:  http.StatusUnauthorized,
+			body:    `{"error":"authorization failed"}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				if r.FormValue("chunked") != "true" {
+					t.Errorf("ChunkedQuery() did not ask for chunked results")
+				}
+				rw.WriteHeader(tt.status)
+				rw.Write([]byte(tt.body))
+			}))
+			defer ts.Close()
+			u, _ := url.Parse(ts.URL)
+			c := &Client{
+				URL:    u,
+				Logger: &chronograf.NoopLogger{},
+			}
+
+			var got []chronograf.ResultSeries
+			err := c.ChunkedQuery(context.Background(), chronograf.Query{Command: "SELECT usage FROM cpu", DB: "telegraf"}, func(s chronograf.ResultSeries) error {
+				got = append(got, s)
+				return nil
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ChunkedQuery() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ChunkedQuery() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_ChunkedResponse(t *testing.T) {
+	t.Parallel()
+	body := `{"results":[{"statement_id":0,"series":[{"name":"cpu","columns":["time","usage"],"values":[[1000,0.5]]}],"partial":true}]}
+{"results":[{"statement_id":0,"series":[{"name":"cpu","columns":["time","usage"],"values":[[2000,0.7]]}]}]}
+`
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.FormValue("chunked") != "true" || r.FormValue("chunk_size") != "1" {
+			t.Errorf("ChunkedResponse() sent chunked=%s chunk_size=%s", r.FormValue("chunked"), r.FormValue("chunk_size"))
+		}
+		rw.Write([]byte(body))
+	}))
+	defer ts.Close()
+	u, _ := url.Parse(ts.URL)
+	c := &Client{
+		URL:    u,
+		Logger: &chronograf.NoopLogger{},
+	}
+
+	res, err := c.ChunkedResponse(context.Background(), chronograf.Query{Command: "SELECT usage FROM cpu", DB: "telegraf"}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Close()
+	got, err := ioutil.ReadAll(res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != body {
+		t.Errorf("ChunkedResponse() = %s, want %s", got, body)
+	}
+}
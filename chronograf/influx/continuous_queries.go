@@ -0,0 +1,246 @@
+package influx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/kit/tracing"
+	"github.com/influxdata/influxql"
+)
+
+// AllCQ returns all continuous queries of a database
+func (c *Client) AllCQ(ctx context.Context, db string) ([]chronograf.ContinuousQuery, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	res, err := c.Query(ctx, chronograf.Query{
+		Command: `SHOW CONTINUOUS QUERIES`,
+		DB:      db,
+	})
+	if err != nil {
+		return nil, err
+	}
+	octets, err := res.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	results := showResults{}
+	if err := json.Unmarshal(octets, &results); err != nil {
+		return nil, err
+	}
+
+	return results.ContinuousQueries(db), nil
+}
+
+// CreateCQ creates a continuous query within a database
+func (c *Client) CreateCQ(ctx context.Context, db string, cq *chronograf.ContinuousQuery) (*chronograf.ContinuousQuery, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	res, err := c.Query(ctx, chronograf.Query{
+		Command: CreateCQStatement(db, cq),
+		DB:      db,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := resultsError(res); err != nil {
+		return nil, err
+	}
+
+	cqs, err := c.AllCQ(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	for _, q := range cqs {
+		if q.Name == cq.Name {
+			return &q, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown continuous query")
+}
+
+// DropCQ removes a continuous query from a database
+func (c *Client) DropCQ(ctx context.Context, db string, name string) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	res, err := c.Query(ctx, chronograf.Query{
+		Command: fmt.Sprintf(`DROP CONTINUOUS QUERY %s ON %s`, influxql.QuoteIdent(name), influxql.QuoteIdent(db)),
+		DB:      db,
+	})
+	if err != nil {
+		return err
+	}
+	return resultsError(res)
+}
+
+// AllShards returns the shards of all retention policies of a database
+func (c *Client) AllShards(ctx context.Context, db string) ([]chronograf.Shard, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	res, err := c.Query(ctx, chronograf.Query{
+		Command: `SHOW SHARDS`,
+	})
+	if err != nil {
+		return nil, err
+	}
+	octets, err := res.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	results := showResults{}
+	if err := json.Unmarshal(octets, &results); err != nil {
+		return nil, err
+	}
+
+	return results.Shards(db), nil
+}
+
+// CreateCQStatement renders the InfluxQL creating cq within db
+func CreateCQStatement(db string, cq *chronograf.ContinuousQuery) string {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `CREATE CONTINUOUS QUERY %s ON %s`, influxql.QuoteIdent(cq.Name), influxql.QuoteIdent(db))
+	if cq.ResampleEvery != "" || cq.ResampleFor != "" {
+		b.WriteString(" RESAMPLE")
+		if cq.ResampleEvery != "" {
+			b.WriteString(" EVERY " + cq.ResampleEvery)
+		}
+		if cq.ResampleFor != "" {
+			b.WriteString(" FOR " + cq.ResampleFor)
+		}
+	}
+	fmt.Fprintf(&b, ` BEGIN %s END`, cq.Query)
+	return b.String()
+}
+
+// resultsError returns the first error of the statements of a response.
+// Statements such as CREATE CONTINUOUS QUERY report their errors within the
+// results rather than with the status of the response.
+func resultsError(res chronograf.Response) error {
+	octets, err := res.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	results := make([]struct{ Error string }, 0)
+	if err := json.Unmarshal(octets, &results); err != nil {
+		return err
+	}
+
+	for _, r := range results {
+		if r.Error != "" {
+			return errors.New(r.Error)
+		}
+	}
+	return nil
+}
+
+// ContinuousQueries converts SHOW CONTINUOUS QUERIES of db to chronograf ContinuousQueries
+func (r *showResults) ContinuousQueries(db string) []chronograf.ContinuousQuery {
+	res := []chronograf.ContinuousQuery{}
+	for _, u := range *r {
+		for _, s := range u.Series {
+			if s.Name != db {
+				continue
+			}
+			for _, v := range s.Values {
+				if len(v) < 2 {
+					continue
+				}
+				if name, ok := v[0].(string); !ok {
+					continue
+				} else if query, ok := v[1].(string); !ok {
+					continue
+				} else {
+					res = append(res, parseCQ(name, query))
+				}
+			}
+		}
+	}
+	return res
+}
+
+// parseCQ splits the CREATE CONTINUOUS QUERY statement shown by influx into
+// its SELECT statement and resample schedule. Statements that cannot be parsed
+// are returned as is.
+func parseCQ(name, query string) chronograf.ContinuousQuery {
+	cq := chronograf.ContinuousQuery{
+		Name:  name,
+		Query: query,
+	}
+	stmt, err := influxql.ParseStatement(query)
+	if err != nil {
+		return cq
+	}
+	create, ok := stmt.(*influxql.CreateContinuousQueryStatement)
+	if !ok {
+		return cq
+	}
+	cq.Query = create.Source.String()
+	if create.ResampleEvery != 0 {
+		cq.ResampleEvery = influxql.FormatDuration(create.ResampleEvery)
+	}
+	if create.ResampleFor != 0 {
+		cq.ResampleFor = influxql.FormatDuration(create.ResampleFor)
+	}
+	return cq
+}
+
+// Shards converts SHOW SHARDS of db to chronograf Shards
+func (r *showResults) Shards(db string) []chronograf.Shard {
+	res := []chronograf.Shard{}
+	for _, u := range *r {
+		for _, s := range u.Series {
+			if s.Name != db {
+				continue
+			}
+			col := map[string]int{}
+			for i, c := range s.Columns {
+				col[c] = i
+			}
+			for _, v := range s.Values {
+				shard := chronograf.Shard{}
+				if id, ok := columnValue(v, col, "id").(float64); ok {
+					shard.ID = uint64(id)
+				}
+				if rp, ok := columnValue(v, col, "retention_policy").(string); ok {
+					shard.RetentionPolicy = rp
+				}
+				shard.StartTime = columnTime(v, col, "start_time")
+				shard.EndTime = columnTime(v, col, "end_time")
+				shard.ExpiryTime = columnTime(v, col, "expiry_time")
+				res = append(res, shard)
+			}
+		}
+	}
+	return res
+}
+
+func columnValue(v []interface{}, col map[string]int, name string) interface{} {
+	i, ok := col[name]
+	if !ok || i >= len(v) {
+		return nil
+	}
+	return v[i]
+}
+
+func columnTime(v []interface{}, col map[string]int, name string) time.Time {
+	s, ok := columnValue(v, col, name).(string)
+	if !ok {
+		return time.Time{}
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
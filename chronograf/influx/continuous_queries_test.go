@@ -0,0 +1,86 @@
+package influx
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func TestShowResults_ContinuousQueries(t *testing.T) {
+	t.Parallel()
+	octets := []byte(`[{"series":[
+		{"name":"_internal","columns":["name","query"]},
+		{"name":"telegraf","columns":["name","query"],"values":[
+			["cpu_1h","CREATE CONTINUOUS QUERY cpu_1h ON telegraf RESAMPLE EVERY 30m FOR 2h BEGIN SELECT mean(usage_idle) INTO telegraf.\"1y\".cpu FROM telegraf.autogen.cpu GROUP BY time(1h), * END"],
+			["mem_1h","CREATE CONTINUOUS QUERY mem_1h ON telegraf BEGIN SELECT max(used) INTO telegraf.\"1y\".mem FROM telegraf.autogen.mem GROUP BY time(1h) END"]
+		]}
+	]}]`)
+	results := showResults{}
+	if err := json.Unmarshal(octets, &results); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []chronograf.ContinuousQuery{
+		{
+			Name:          "cpu_1h",
+			Query:         `SELECT mean(usage_idle) INTO telegraf."1y".cpu FROM telegraf.autogen.cpu GROUP BY time(1h), *`,
+			ResampleEvery: "30m",
+			ResampleFor:   "2h",
+		},
+		{
+			Name:  "mem_1h",
+			Query: `SELECT max(used) INTO telegraf."1y".mem FROM telegraf.autogen.mem GROUP BY time(1h)`,
+		},
+	}
+	if got := results.ContinuousQueries("telegraf"); !reflect.DeepEqual(got, want) {
+		t.Errorf("ContinuousQueries() = %v, want %v", got, want)
+	}
+	if got := results.ContinuousQueries("_internal"); len(got) != 0 {
+		t.Errorf("ContinuousQueries() = %v, want none", got)
+	}
+}
+
+func TestCreateCQStatement(t *testing.T) {
+	t.Parallel()
+	cq := &chronograf.ContinuousQuery{
+		Name:          "cpu 1h",
+		Query:         `SELECT mean(usage_idle) INTO cpu_1h FROM cpu GROUP BY time(1h)`,
+		ResampleEvery: "30m",
+	}
+	want := `CREATE CONTINUOUS QUERY "cpu 1h" ON telegraf RESAMPLE EVERY 30m BEGIN SELECT mean(usage_idle) INTO cpu_1h FROM cpu GROUP BY time(1h) END`
+	if got := CreateCQStatement("telegraf", cq); got != want {
+		t.Errorf("CreateCQStatement() = %s, want %s", got, want)
+	}
+}
+
+func TestShowResults_Shards(t *testing.T) {
+	t.Parallel()
+	octets := []byte(`[{"series":[
+		{"name":"telegraf","columns":["id","database","retention_policy","shard_group","start_time","end_time","expiry_time","owners"],"values":[
+			[3,"telegraf","autogen",3,"2018-01-01T00:00:00Z","2018-01-08T00:00:00Z","2018-01-08T00:00:00Z",""]
+		]},
+		{"name":"_internal","columns":["id","database","retention_policy","shard_group","start_time","end_time","expiry_time","owners"],"values":[
+			[1,"_internal","monitor",1,"2018-01-01T00:00:00Z","2018-01-02T00:00:00Z","2018-01-09T00:00:00Z",""]
+		]}
+	]}]`)
+	results := showResults{}
+	if err := json.Unmarshal(octets, &results); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []chronograf.Shard{
+		{
+			ID:              3,
+			RetentionPolicy: "autogen",
+			StartTime:       time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC),
+			EndTime:         time.Date(2018, 1, 8, 0, 0, 0, 0, time.UTC),
+			ExpiryTime:      time.Date(2018, 1, 8, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	if got := results.Shards("telegraf"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Shards() = %v, want %v", got, want)
+	}
+}
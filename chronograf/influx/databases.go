@@ -5,16 +5,22 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/influxdata/influxdb/chronograf"
 	"github.com/influxdata/influxdb/kit/tracing"
+	"github.com/influxdata/influxql"
 )
 
-// AllDB returns all databases from within Influx
+// AllDB returns all databases from within Influx, which are the buckets of
+// InfluxDB 2.x
 func (c *Client) AllDB(ctx context.Context) ([]chronograf.Database, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx)
 	defer span.Finish()
 
+	if c.V2 {
+		return c.bucketDatabases(ctx)
+	}
 	return c.showDatabases(ctx)
 }
 
@@ -23,16 +29,43 @@ func (c *Client) CreateDB(ctx context.Context, db *chronograf.Database) (*chrono
 	span, ctx := tracing.StartSpanFromContext(ctx)
 	defer span.Finish()
 
-	_, err := c.Query(ctx, chronograf.Query{
-		Command: fmt.Sprintf(`CREATE DATABASE "%s"`, db.Name),
+	if c.V2 {
+		return nil, ErrBucketsReadOnly
+	}
+
+	// The default retention policy of the database is created with the
+	// duration, replication and shard duration of db, when given
+	query := `CREATE DATABASE ` + influxql.QuoteIdent(db.Name)
+	var with []string
+	if db.Duration != "" {
+		with = append(with, "DURATION "+db.Duration)
+	}
+	if db.Replication > 0 {
+		with = append(with, fmt.Sprintf("REPLICATION %d", db.Replication))
+	}
+	if db.ShardDuration != "" {
+		with = append(with, "SHARD DURATION "+db.ShardDuration)
+	}
+	if len(with) > 0 {
+		query += " WITH " + strings.Join(with, " ")
+	}
+
+	res, err := c.Query(ctx, chronograf.Query{
+		Command: query,
 	})
 	if err != nil {
 		return nil, err
 	}
+	if err := resultsError(res); err != nil {
+		return nil, err
+	}
 
-	res := &chronograf.Database{Name: db.Name}
-
-	return res, nil
+	return &chronograf.Database{
+		Name:          db.Name,
+		Duration:      db.Duration,
+		Replication:   db.Replication,
+		ShardDuration: db.ShardDuration,
+	}, nil
 }
 
 // DropDB drops a database within Influx
@@ -40,21 +73,29 @@ func (c *Client) DropDB(ctx context.Context, db string) error {
 	span, ctx := tracing.StartSpanFromContext(ctx)
 	defer span.Finish()
 
-	_, err := c.Query(ctx, chronograf.Query{
-		Command: fmt.Sprintf(`DROP DATABASE "%s"`, db),
+	if c.V2 {
+		return ErrBucketsReadOnly
+	}
+
+	res, err := c.Query(ctx, chronograf.Query{
+		Command: `DROP DATABASE ` + influxql.QuoteIdent(db),
 		DB:      db,
 	})
 	if err != nil {
 		return err
 	}
-	return nil
+	return resultsError(res)
 }
 
-// AllRP returns all the retention policies for a specific database
+// AllRP returns all the retention policies for a specific database. Buckets
+// of InfluxDB 2.x have a single retention policy.
 func (c *Client) AllRP(ctx context.Context, db string) ([]chronograf.RetentionPolicy, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx)
 	defer span.Finish()
 
+	if c.V2 {
+		return c.bucketRetentionPolicies(ctx, db)
+	}
 	return c.showRetentionPolicies(ctx, db)
 }
 
@@ -80,7 +121,11 @@ func (c *Client) CreateRP(ctx context.Context, db string, rp *chronograf.Retenti
 	span, ctx := tracing.StartSpanFromContext(ctx)
 	defer span.Finish()
 
-	query := fmt.Sprintf(`CREATE RETENTION POLICY "%s" ON "%s" DURATION %s REPLICATION %d`, rp.Name, db, rp.Duration, rp.Replication)
+	if c.V2 {
+		return nil, ErrBucketsReadOnly
+	}
+
+	query := fmt.Sprintf(`CREATE RETENTION POLICY %s ON %s DURATION %s REPLICATION %d`, influxql.QuoteIdent(rp.Name), influxql.QuoteIdent(db), rp.Duration, rp.Replication)
 	if len(rp.ShardDuration) != 0 {
 		query = fmt.Sprintf(`%s SHARD DURATION %s`, query, rp.ShardDuration)
 	}
@@ -89,13 +134,16 @@ func (c *Client) CreateRP(ctx context.Context, db string, rp *chronograf.Retenti
 		query = fmt.Sprintf(`%s DEFAULT`, query)
 	}
 
-	_, err := c.Query(ctx, chronograf.Query{
+	queryRes, err := c.Query(ctx, chronograf.Query{
 		Command: query,
 		DB:      db,
 	})
 	if err != nil {
 		return nil, err
 	}
+	if err := resultsError(queryRes); err != nil {
+		return nil, err
+	}
 
 	res, err := c.getRP(ctx, db, rp.Name)
 	if err != nil {
@@ -110,8 +158,12 @@ func (c *Client) UpdateRP(ctx context.Context, db string, rp string, upd *chrono
 	span, ctx := tracing.StartSpanFromContext(ctx)
 	defer span.Finish()
 
+	if c.V2 {
+		return nil, ErrBucketsReadOnly
+	}
+
 	var buffer bytes.Buffer
-	buffer.WriteString(fmt.Sprintf(`ALTER RETENTION POLICY "%s" ON "%s"`, rp, db))
+	buffer.WriteString(fmt.Sprintf(`ALTER RETENTION POLICY %s ON %s`, influxql.QuoteIdent(rp), influxql.QuoteIdent(db)))
 	if len(upd.Duration) > 0 {
 		buffer.WriteString(" DURATION " + upd.Duration)
 	}
@@ -165,24 +217,33 @@ func (c *Client) DropRP(ctx context.Context, db string, rp string) error {
 	span, ctx := tracing.StartSpanFromContext(ctx)
 	defer span.Finish()
 
-	_, err := c.Query(ctx, chronograf.Query{
-		Command: fmt.Sprintf(`DROP RETENTION POLICY "%s" ON "%s"`, rp, db),
+	if c.V2 {
+		return ErrBucketsReadOnly
+	}
+
+	res, err := c.Query(ctx, chronograf.Query{
+		Command: fmt.Sprintf(`DROP RETENTION POLICY %s ON %s`, influxql.QuoteIdent(rp), influxql.QuoteIdent(db)),
 		DB:      db,
 		RP:      rp,
 	})
 	if err != nil {
 		return err
 	}
-	return nil
+	return resultsError(res)
 }
 
 // GetMeasurements returns measurements in a specified database, paginated by
 // optional limit and offset. If no limit or offset is provided, it defaults to
-// a limit of 100 measurements with no offset.
+// a limit of 100 measurements with no offset. Measurements of InfluxDB 2.x
+// buckets are listed with Flux.
 func (c *Client) GetMeasurements(ctx context.Context, db string, limit, offset int) ([]chronograf.Measurement, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx)
 	defer span.Finish()
 
+	if c.V2 {
+		return c.bucketMeasurements(ctx, db, limit, offset)
+	}
+
 	return c.showMeasurements(ctx, db, limit, offset)
 }
 
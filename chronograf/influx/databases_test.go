@@ -0,0 +1,81 @@
+package influx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func TestClient_CreateDB(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		db   chronograf.Database
+		want string
+	}{
+		{
+			name: "Database",
+			db:   chronograf.Database{Name: "telegraf"},
+			want: `CREATE DATABASE telegraf`,
+		},
+		{
+			name: "Default retention policy",
+			db:   chronograf.Database{Name: "telegraf", Duration: "30d", Replication: 2, ShardDuration: "1d"},
+			want: `CREATE DATABASE telegraf WITH DURATION 30d REPLICATION 2 SHARD DURATION 1d`,
+		},
+		{
+			name: "Quoted name",
+			db:   chronograf.Database{Name: `tele"graf"; DROP DATABASE x`},
+			want: `CREATE DATABASE "tele\"graf\"; DROP DATABASE x"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				got = r.FormValue("q")
+				rw.Write([]byte(`{"results":[{"statement_id":0}]}`))
+			}))
+			defer ts.Close()
+			u, _ := url.Parse(ts.URL)
+			c := &Client{
+				URL:    u,
+				Logger: &chronograf.NoopLogger{},
+			}
+
+			db := tt.db
+			if _, err := c.CreateDB(context.Background(), &db); err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("CreateDB() sent %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_DropRP(t *testing.T) {
+	t.Parallel()
+	var got string
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		got = r.FormValue("q")
+		rw.Write([]byte(`{"results":[{"statement_id":0,"error":"retention policy not found: 1 week"}]}`))
+	}))
+	defer ts.Close()
+	u, _ := url.Parse(ts.URL)
+	c := &Client{
+		URL:    u,
+		Logger: &chronograf.NoopLogger{},
+	}
+
+	if err := c.DropRP(context.Background(), "telegraf", "1 week"); err == nil {
+		t.Error("DropRP() expected the error of the statement")
+	}
+	if want := `DROP RETENTION POLICY "1 week" ON telegraf`; got != want {
+		t.Errorf("DropRP() sent %s, want %s", got, want)
+	}
+}
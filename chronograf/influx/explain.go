@@ -0,0 +1,236 @@
+package influx
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/kit/tracing"
+)
+
+var _ chronograf.QueryExplainer = &Client{}
+
+// planIndent is the number of characters of every level of the tree of
+// EXPLAIN ANALYZE, such as "├── " and "│   "
+const planIndent = 4
+
+// Explain runs EXPLAIN and EXPLAIN ANALYZE for the SELECT statement of q and
+// returns the plan of the statement along with the statistics of running it
+func (c *Client) Explain(ctx context.Context, q chronograf.Query) (chronograf.QueryPlan, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	plan, err := c.explainLines(ctx, q, "EXPLAIN ")
+	if err != nil {
+		return chronograf.QueryPlan{}, err
+	}
+	analyze, err := c.explainLines(ctx, q, "EXPLAIN ANALYZE ")
+	if err != nil {
+		return chronograf.QueryPlan{}, err
+	}
+
+	res := parseExplain(plan)
+	res.Tree = parseExplainAnalyze(analyze)
+	analyzeStats(&res)
+	return res, nil
+}
+
+// explainLines runs the statement of q behind prefix and returns the rows of
+// its single column
+func (c *Client) explainLines(ctx context.Context, q chronograf.Query, prefix string) ([]string, error) {
+	res, err := c.Query(ctx, chronograf.Query{
+		Command: prefix + q.Command,
+		DB:      q.DB,
+		RP:      q.RP,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := resultsError(res); err != nil {
+		return nil, err
+	}
+	octets, err := res.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	results := showResults{}
+	if err := json.Unmarshal(octets, &results); err != nil {
+		return nil, err
+	}
+	lines := []string{}
+	for _, r := range results {
+		for _, s := range r.Series {
+			for _, v := range s.Values {
+				if len(v) == 0 {
+					continue
+				}
+				if line, ok := v[0].(string); ok {
+					lines = append(lines, line)
+				}
+			}
+		}
+	}
+	return lines, nil
+}
+
+// parseExplain reads the KEY: value lines of EXPLAIN. Statements selecting
+// several expressions have lines for each of them, which are added up but
+// for the number of shards they share.
+func parseExplain(lines []string) chronograf.QueryPlan {
+	plan := chronograf.QueryPlan{
+		ShardIDs: []uint64{},
+		Cursors:  map[string]int64{},
+		Plan:     lines,
+	}
+	for _, line := range lines {
+		i := strings.Index(line, ": ")
+		if i < 0 {
+			continue
+		}
+		n, err := strconv.ParseInt(strings.TrimSpace(line[i+2:]), 10, 64)
+		if err != nil {
+			continue
+		}
+		switch line[:i] {
+		case "NUMBER OF SERIES":
+			plan.Series += n
+		case "NUMBER OF SHARDS":
+			if n > plan.Shards {
+				plan.Shards = n
+			}
+		case "NUMBER OF FILES":
+			plan.Files += n
+		case "NUMBER OF BLOCKS":
+			plan.Blocks += n
+		case "SIZE OF BLOCKS":
+			plan.BlocksSize += n
+		case "CACHED VALUES":
+			plan.CachedValues += n
+		}
+	}
+	return plan
+}
+
+// planLine is a line of the tree of EXPLAIN ANALYZE and the lines below it
+type planLine struct {
+	text     string
+	children []*planLine
+}
+
+// parseExplainAnalyze parses the tree drawn by EXPLAIN ANALYZE. Every step
+// is a line with its labels in a labels line below it and its statistics in
+// key: value lines.
+func parseExplainAnalyze(lines []string) chronograf.QueryPlanNode {
+	root := &planLine{}
+	stack := []*planLine{root}
+	for _, line := range lines {
+		text := strings.TrimLeft(line, "│├└─ ")
+		if text == "" {
+			continue
+		}
+		prefix := line[:len(line)-len(text)]
+		depth := utf8.RuneCountInString(prefix) / planIndent
+		if depth == 0 {
+			root.text = text
+			continue
+		}
+		if depth > len(stack) {
+			depth = len(stack)
+		}
+		stack = stack[:depth]
+		l := &planLine{text: text}
+		parent := stack[depth-1]
+		parent.children = append(parent.children, l)
+		stack = append(stack, l)
+	}
+
+	// The tree of EXPLAIN ANALYZE starts from a "." holding the statement
+	if (root.text == "" || root.text == ".") && len(root.children) == 1 {
+		root = root.children[0]
+	}
+	return root.node()
+}
+
+// node converts the line of a step and the lines below it
+func (l *planLine) node() chronograf.QueryPlanNode {
+	n := chronograf.QueryPlanNode{
+		Name: l.text,
+	}
+	for _, c := range l.children {
+		if c.text == "labels" {
+			for _, label := range c.children {
+				if k, v, ok := planField(label.text); ok {
+					if n.Labels == nil {
+						n.Labels = map[string]string{}
+					}
+					n.Labels[k] = v
+				}
+			}
+			continue
+		}
+		if k, v, ok := planField(c.text); ok && len(c.children) == 0 {
+			if n.Fields == nil {
+				n.Fields = map[string]string{}
+			}
+			n.Fields[k] = v
+			continue
+		}
+		n.Children = append(n.Children, c.node())
+	}
+	return n
+}
+
+// planField splits a key: value line
+func planField(text string) (string, string, bool) {
+	i := strings.Index(text, ": ")
+	if i < 0 {
+		return "", "", false
+	}
+	return text[:i], text[i+2:], true
+}
+
+// analyzeStats sets the timings, shards and cursor statistics of plan from
+// its tree. Cursor statistics are added up across the iterators of all
+// shards.
+func analyzeStats(plan *chronograf.QueryPlan) {
+	plan.PlanningTime = plan.Tree.Fields["planning_time"]
+	plan.ExecutionTime = plan.Tree.Fields["execution_time"]
+	plan.TotalTime = plan.Tree.Fields["total_time"]
+
+	seen := map[uint64]bool{}
+	var walk func(n chronograf.QueryPlanNode)
+	walk = func(n chronograf.QueryPlanNode) {
+		if id, err := strconv.ParseUint(n.Labels["shard_id"], 10, 64); err == nil && !seen[id] {
+			seen[id] = true
+			plan.ShardIDs = append(plan.ShardIDs, id)
+		}
+		for k, v := range n.Fields {
+			if !cursorStat(k) {
+				continue
+			}
+			if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+				plan.Cursors[k] += i
+			}
+		}
+		for _, c := range n.Children {
+			walk(c)
+		}
+	}
+	walk(plan.Tree)
+	sort.Slice(plan.ShardIDs, func(i, j int) bool {
+		return plan.ShardIDs[i] < plan.ShardIDs[j]
+	})
+}
+
+// cursorStat is true for the statistics of the cursors of an iterator, such
+// as cursors_ref, float_blocks_decoded and float_blocks_size_bytes
+func cursorStat(k string) bool {
+	return strings.HasPrefix(k, "cursors_") ||
+		strings.HasSuffix(k, "_blocks_decoded") ||
+		strings.HasSuffix(k, "_blocks_size_bytes")
+}
@@ -0,0 +1,165 @@
+package influx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// explainAnalyzeLines is the tree of EXPLAIN ANALYZE for a statement reading
+// two shards
+var explainAnalyzeLines = []string{
+	".",
+	"└── select",
+	"    ├── execution_time: 2.25823ms",
+	"    ├── planning_time: 18.381616ms",
+	"    ├── total_time: 20.639846ms",
+	"    └── field_iterators",
+	"        ├── labels",
+	"        │   └── statement: SELECT max(usage_idle::float) FROM telegraf.autogen.cpu",
+	"        └── expression",
+	"            ├── labels",
+	"            │   └── expr: max(usage_idle::float)",
+	"            ├── create_iterator",
+	"            │   ├── labels",
+	"            │   │   ├── measurement: cpu",
+	"            │   │   └── shard_id: 608",
+	"            │   ├── cursors_ref: 779",
+	"            │   ├── cursors_aux: 0",
+	"            │   ├── float_blocks_decoded: 431",
+	"            │   ├── float_blocks_size_bytes: 1003552",
+	"            │   └── planning_time: 575.6µs",
+	"            └── create_iterator",
+	"                ├── labels",
+	"                │   ├── measurement: cpu",
+	"                │   └── shard_id: 12",
+	"                ├── cursors_ref: 21",
+	"                └── float_blocks_decoded: 9",
+}
+
+func TestClient_Explain(t *testing.T) {
+	t.Parallel()
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		q := r.FormValue("q")
+		var lines []string
+		switch {
+		case strings.HasPrefix(q, "EXPLAIN ANALYZE "):
+			lines = explainAnalyzeLines
+		case strings.HasPrefix(q, "EXPLAIN "):
+			lines = []string{
+				"EXPRESSION: max(usage_idle::float)",
+				"NUMBER OF SHARDS: 2",
+				"NUMBER OF SERIES: 10",
+				"CACHED VALUES: 4",
+				"NUMBER OF FILES: 23",
+				"NUMBER OF BLOCKS: 845",
+				"SIZE OF BLOCKS: 3044373",
+			}
+		default:
+			t.Errorf("Explain() sent %s", q)
+		}
+		values := make([][]string, len(lines))
+		for i, l := range lines {
+			values[i] = []string{l}
+		}
+		json.NewEncoder(rw).Encode(map[string]interface{}{
+			"results": []interface{}{
+				map[string]interface{}{
+					"statement_id": 0,
+					"series": []interface{}{
+						map[string]interface{}{
+							"columns": []string{"QUERY PLAN"},
+							"values":  values,
+						},
+					},
+				},
+			},
+		})
+	}))
+	defer ts.Close()
+	u, _ := url.Parse(ts.URL)
+	c := &Client{
+		URL:    u,
+		Logger: &chronograf.NoopLogger{},
+	}
+
+	got, err := c.Explain(context.Background(), chronograf.Query{Command: "SELECT max(usage_idle) FROM cpu", DB: "telegraf"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Series != 10 || got.Shards != 2 || got.Files != 23 || got.Blocks != 845 || got.BlocksSize != 3044373 || got.CachedValues != 4 {
+		t.Errorf("Explain() = %+v, want the counts of EXPLAIN", got)
+	}
+	if got.PlanningTime != "18.381616ms" || got.ExecutionTime != "2.25823ms" || got.TotalTime != "20.639846ms" {
+		t.Errorf("Explain() times = %s %s %s", got.PlanningTime, got.ExecutionTime, got.TotalTime)
+	}
+	if want := []uint64{12, 608}; !reflect.DeepEqual(got.ShardIDs, want) {
+		t.Errorf("Explain() shardIDs = %v, want %v", got.ShardIDs, want)
+	}
+	wantCursors := map[string]int64{
+		"cursors_ref":             800,
+		"cursors_aux":             0,
+		"float_blocks_decoded":    440,
+		"float_blocks_size_bytes": 1003552,
+	}
+	if !reflect.DeepEqual(got.Cursors, wantCursors) {
+		t.Errorf("Explain() cursors = %v, want %v", got.Cursors, wantCursors)
+	}
+
+	if got.Tree.Name != "select" || len(got.Tree.Children) != 1 {
+		t.Fatalf("Explain() tree = %+v", got.Tree)
+	}
+	expr := got.Tree.Children[0].Children[0]
+	wantExpr := chronograf.QueryPlanNode{
+		Name:   "expression",
+		Labels: map[string]string{"expr": "max(usage_idle::float)"},
+		Children: []chronograf.QueryPlanNode{
+			{
+				Name:   "create_iterator",
+				Labels: map[string]string{"measurement": "cpu", "shard_id": "608"},
+				Fields: map[string]string{
+					"cursors_ref":             "779",
+					"cursors_aux":             "0",
+					"float_blocks_decoded":    "431",
+					"float_blocks_size_bytes": "1003552",
+					"planning_time":           "575.6µs",
+				},
+			},
+			{
+				Name:   "create_iterator",
+				Labels: map[string]string{"measurement": "cpu", "shard_id": "12"},
+				Fields: map[string]string{
+					"cursors_ref":          "21",
+					"float_blocks_decoded": "9",
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(expr, wantExpr) {
+		t.Errorf("Explain() expression = %+v, want %+v", expr, wantExpr)
+	}
+}
+
+func TestClient_Explain_error(t *testing.T) {
+	t.Parallel()
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Write([]byte(`{"results":[{"statement_id":0,"error":"database not found: missing"}]}`))
+	}))
+	defer ts.Close()
+	u, _ := url.Parse(ts.URL)
+	c := &Client{
+		URL:    u,
+		Logger: &chronograf.NoopLogger{},
+	}
+
+	if _, err := c.Explain(context.Background(), chronograf.Query{Command: "SELECT * FROM cpu", DB: "missing"}); err == nil {
+		t.Error("Explain() expected the error of the statement")
+	}
+}
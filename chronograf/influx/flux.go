@@ -0,0 +1,100 @@
+package influx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/influxdata/influxdb/chronograf"
+	clog "github.com/influxdata/influxdb/chronograf/log"
+	"github.com/influxdata/influxdb/kit/tracing"
+)
+
+var _ chronograf.FluxQuerier = &Client{}
+
+// fluxRequest is the body of a request to the Flux endpoint of InfluxDB.
+// Every table of the response starts with a datatype annotation row followed
+// by a header row.
+type fluxRequest struct {
+	Query   string      `json:"query"`
+	Type    string      `json:"type"`
+	Dialect fluxDialect `json:"dialect"`
+}
+
+type fluxDialect struct {
+	Header      bool     `json:"header"`
+	Annotations []string `json:"annotations"`
+}
+
+// FluxQuery runs a Flux script with the /api/v2/query endpoint of InfluxDB
+// 1.7 or later and returns its result as annotated CSV. Scripts sent to
+// InfluxDB 2.x run in the organization of the client. In-flight requests can
+// be cancelled using the provided context.
+func (c *Client) FluxQuery(ctx context.Context, script string) ([]byte, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	body, err := json.Marshal(fluxRequest{
+		Query: script,
+		Type:  "flux",
+		Dialect: fluxDialect{
+			Header:      true,
+			Annotations: []string{"datatype"},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u := *c.URL
+	u.Path = "api/v2/query"
+	if c.Org != "" {
+		params := url.Values{}
+		params.Set("org", c.Org)
+		u.RawQuery = params.Encode()
+	}
+	req, err := http.NewRequest("POST", u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/csv")
+	tracing.InjectToHTTPRequest(span, req)
+	clog.InjectRequestID(ctx, req)
+
+	if c.Authorizer != nil {
+		if err := c.Authorizer.Set(req); err != nil {
+			return nil, err
+		}
+	}
+
+	hc := &http.Client{Transport: c.transport()}
+	resp, err := hc.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, chronograf.ErrUpstreamTimeout
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var response struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(data, &response); err != nil || response.Error == "" {
+			return nil, fmt.Errorf("received status code %d from server", resp.StatusCode)
+		}
+		return nil, fmt.Errorf("received status code %d from server: err: %s", resp.StatusCode, response.Error)
+	}
+	return data, nil
+}
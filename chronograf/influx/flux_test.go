@@ -0,0 +1,61 @@
+package influx_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func Test_Influx_FluxQuery(t *testing.T) {
+	t.Parallel()
+	csv := "#datatype,string,long,string\n,result,table,host\n,_result,0,a\n"
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if path := r.URL.Path; path != "/api/v2/query" {
+			t.Error("Expected the path to be `/api/v2/query` but was", path)
+		}
+		if accept := r.Header.Get("Accept"); accept != "application/csv" {
+			t.Error("Expected to accept CSV but accepted", accept)
+		}
+		var req struct {
+			Query string `json:"query"`
+			Type  string `json:"type"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Error("Unexpected error decoding request: err:", err)
+		}
+		if req.Type != "flux" || !strings.HasPrefix(req.Query, "from(") {
+			t.Error("Unexpected request", req)
+		}
+		if strings.Contains(req.Query, "unknown") {
+			rw.WriteHeader(http.StatusBadRequest)
+			rw.Write([]byte(`{"error":"bucket \"unknown\" not found"}`))
+			return
+		}
+		rw.WriteHeader(http.StatusOK)
+		rw.Write([]byte(csv))
+	}))
+	defer ts.Close()
+
+	series, err := NewClient(ts.URL, &chronograf.NoopLogger{})
+	if err != nil {
+		t.Fatal("Unexpected error initializing client: err:", err)
+	}
+
+	got, err := series.FluxQuery(context.Background(), `from(bucket: "telegraf")`)
+	if err != nil {
+		t.Fatal("Expected no error but was", err)
+	}
+	if string(got) != csv {
+		t.Errorf("FluxQuery() = %q, want %q", got, csv)
+	}
+
+	_, err = series.FluxQuery(context.Background(), `from(bucket: "unknown")`)
+	if err == nil || !strings.Contains(err.Error(), `bucket "unknown" not found`) {
+		t.Errorf("FluxQuery() error = %v", err)
+	}
+}
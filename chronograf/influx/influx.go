@@ -12,6 +12,7 @@ import (
 	"strings"
 
 	"github.com/influxdata/influxdb/chronograf"
+	clog "github.com/influxdata/influxdb/chronograf/log"
 	"github.com/influxdata/influxdb/kit/tracing"
 )
 
@@ -33,6 +34,13 @@ type Client struct {
 	Authorizer         Authorizer
 	InsecureSkipVerify bool
 	Logger             chronograf.Logger
+	// V2 is true for InfluxDB 2.x, whose buckets are listed as databases
+	V2 bool
+	// Org is the InfluxDB 2.x organization of Flux queries and buckets
+	Org string
+	// TLSTransport connects to sources trusting custom certificate
+	// authorities or requiring a client certificate
+	TLSTransport *http.Transport
 }
 
 // Response is a partial JSON decoded InfluxQL response used
@@ -58,7 +66,7 @@ func (c *Client) query(ctx context.Context, u *url.URL, q chronograf.Query) (chr
 	}
 	req.Header.Set("Content-Type", "application/json")
 	command := q.Command
-	logs := c.Logger.
+	logs := clog.FromContext(ctx, c.Logger).
 		WithField("component", "proxy").
 		WithField("host", req.Host).
 		WithField("command", command).
@@ -76,6 +84,7 @@ func (c *Client) query(ctx context.Context, u *url.URL, q chronograf.Query) (chr
 	}
 	req.URL.RawQuery = params.Encode()
 	tracing.InjectToHTTPRequest(span, req)
+	clog.InjectRequestID(ctx, req)
 
 	if c.Authorizer != nil {
 		if err := c.Authorizer.Set(req); err != nil {
@@ -84,12 +93,7 @@ func (c *Client) query(ctx context.Context, u *url.URL, q chronograf.Query) (chr
 		}
 	}
 
-	hc := &http.Client{}
-	if c.InsecureSkipVerify {
-		hc.Transport = skipVerifyTransport
-	} else {
-		hc.Transport = defaultTransport
-	}
+	hc := &http.Client{Transport: c.transport()}
 	resp, err := hc.Do(req)
 	if err != nil {
 		return nil, err
@@ -166,15 +170,34 @@ func (c *Client) Connect(ctx context.Context, src *chronograf.Source) error {
 		return err
 	}
 	c.Authorizer = DefaultAuthorization(src)
+	c.V2 = src.Type == chronograf.InfluxDBv2
+	c.Org = ""
+	if c.V2 {
+		c.Org = src.Username
+	}
 	// Only allow acceptance of all certs if the scheme is https AND the user opted into to the setting.
 	if u.Scheme == "https" && src.InsecureSkipVerify {
 		c.InsecureSkipVerify = src.InsecureSkipVerify
 	}
+	if c.TLSTransport, err = Transport(src); err != nil {
+		return err
+	}
 
 	c.URL = u
 	return nil
 }
 
+// transport returns the transport of the requests to the source
+func (c *Client) transport() http.RoundTripper {
+	if c.TLSTransport != nil {
+		return c.TLSTransport
+	}
+	if c.InsecureSkipVerify {
+		return skipVerifyTransport
+	}
+	return defaultTransport
+}
+
 // Users transforms InfluxDB into a user store
 func (c *Client) Users(ctx context.Context) chronograf.UsersStore {
 	return c
@@ -238,13 +261,9 @@ func (c *Client) ping(ctx context.Context, u *url.URL) (string, string, error) {
 		return "", "", err
 	}
 	tracing.InjectToHTTPRequest(span, req)
+	clog.InjectRequestID(ctx, req)
 
-	hc := &http.Client{}
-	if c.InsecureSkipVerify {
-		hc.Transport = skipVerifyTransport
-	} else {
-		hc.Transport = defaultTransport
-	}
+	hc := &http.Client{Transport: c.transport()}
 
 	resp, err := hc.Do(req)
 	if err != nil {
@@ -262,12 +281,14 @@ func (c *Client) ping(ctx context.Context, u *url.URL) (string, string, error) {
 		return "", "", err
 	}
 
-	version := resp.Header.Get("X-Influxdb-Build")
-	if version == "ENT" {
-		return version, chronograf.InfluxEnterprise, nil
+	build := resp.Header.Get("X-Influxdb-Build")
+	if build == "ENT" {
+		return build, chronograf.InfluxEnterprise, nil
 	}
-	version = resp.Header.Get("X-Influxdb-Version")
-	if strings.Contains(version, "-c") {
+	version := resp.Header.Get("X-Influxdb-Version")
+	if build == "Cloud" || strings.HasPrefix(strings.TrimPrefix(version, "v"), "2.") {
+		return version, chronograf.InfluxDBv2, nil
+	} else if strings.Contains(version, "-c") {
 		return version, chronograf.InfluxEnterprise, nil
 	} else if strings.Contains(version, "relay") {
 		return version, chronograf.InfluxRelay, nil
@@ -346,13 +367,9 @@ func (c *Client) write(ctx context.Context, u *url.URL, db, rp, lp string) error
 	params.Set("rp", rp)
 	req.URL.RawQuery = params.Encode()
 	tracing.InjectToHTTPRequest(span, req)
+	clog.InjectRequestID(ctx, req)
 
-	hc := &http.Client{}
-	if c.InsecureSkipVerify {
-		hc.Transport = skipVerifyTransport
-	} else {
-		hc.Transport = defaultTransport
-	}
+	hc := &http.Client{Transport: c.transport()}
 
 	errChan := make(chan (error))
 	go func() {
@@ -13,6 +13,7 @@ import (
 	gojwt "github.com/dgrijalva/jwt-go"
 	"github.com/influxdata/influxdb/chronograf"
 	"github.com/influxdata/influxdb/chronograf/influx"
+	clog "github.com/influxdata/influxdb/chronograf/log"
 	"github.com/influxdata/influxdb/chronograf/mocks"
 )
 
@@ -62,6 +63,30 @@ func Test_Influx_MakesRequestsToQueryEndpoint(t *testing.T) {
 	}
 }
 
+func Test_Influx_ForwardsRequestID(t *testing.T) {
+	t.Parallel()
+	var got string
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("X-Request-Id")
+		rw.WriteHeader(http.StatusOK)
+		rw.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	series, err := NewClient(ts.URL, &chronograf.NoopLogger{})
+	if err != nil {
+		t.Fatal("Unexpected error initializing client: err:", err)
+	}
+
+	ctx := clog.WithRequestID(context.Background(), "abc123")
+	if _, err := series.Query(ctx, chronograf.Query{Command: "show databases"}); err != nil {
+		t.Fatal("Expected no error but was", err)
+	}
+	if got != "abc123" {
+		t.Errorf("Expected the request ID abc123 to be forwarded but was %q", got)
+	}
+}
+
 type MockAuthorization struct {
 	Bearer string
 	Error  error
@@ -82,3 +82,16 @@ func toLineProtocol(point *chronograf.Point) (string, error) {
 	}
 	return lp, nil
 }
+
+// LineProtocol converts points to line protocol, a line per point
+func LineProtocol(points []chronograf.Point) (string, error) {
+	lines := make([]string, len(points))
+	for i := range points {
+		lp, err := toLineProtocol(&points[i])
+		if err != nil {
+			return "", fmt.Errorf("point %d: %v", i, err)
+		}
+		lines[i] = lp
+	}
+	return strings.Join(lines, "\n"), nil
+}
@@ -45,7 +45,9 @@ func (c *Client) Permissions(context.Context) chronograf.Permissions {
 // showResults is used to deserialize InfluxQL SHOW commands
 type showResults []struct {
 	Series []struct {
-		Values [][]interface{} `json:"values"`
+		Name    string          `json:"name"`
+		Columns []string        `json:"columns"`
+		Values  [][]interface{} `json:"values"`
 	} `json:"series"`
 }
 
@@ -0,0 +1,84 @@
+package influx
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/kit/tracing"
+	"github.com/influxdata/influxql"
+)
+
+// AllQueries returns the queries running in the source
+func (c *Client) AllQueries(ctx context.Context) ([]chronograf.RunningQuery, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	res, err := c.Query(ctx, chronograf.Query{
+		Command: `SHOW QUERIES`,
+	})
+	if err != nil {
+		return nil, err
+	}
+	octets, err := res.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	results := showResults{}
+	if err := json.Unmarshal(octets, &results); err != nil {
+		return nil, err
+	}
+
+	return results.RunningQueries(), nil
+}
+
+// KillQuery stops a running query. Queries of InfluxDB Enterprise are
+// killed on the data node running them.
+func (c *Client) KillQuery(ctx context.Context, q *chronograf.RunningQuery) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	kill := &influxql.KillQueryStatement{
+		QueryID: q.ID,
+		Host:    q.Host,
+	}
+	res, err := c.Query(ctx, chronograf.Query{
+		Command: kill.String(),
+	})
+	if err != nil {
+		return err
+	}
+	return resultsError(res)
+}
+
+// RunningQueries converts SHOW QUERIES to chronograf RunningQueries. The
+// columns are looked up by name as InfluxDB Enterprise adds the node_id and
+// tcp_host columns.
+func (r *showResults) RunningQueries() []chronograf.RunningQuery {
+	res := []chronograf.RunningQuery{}
+	for _, u := range *r {
+		for _, s := range u.Series {
+			col := map[string]int{}
+			for i, c := range s.Columns {
+				col[c] = i
+			}
+			for _, v := range s.Values {
+				id, ok := columnValue(v, col, "qid").(float64)
+				if !ok {
+					continue
+				}
+				q := chronograf.RunningQuery{
+					ID: uint64(id),
+				}
+				q.Query, _ = columnValue(v, col, "query").(string)
+				q.Database, _ = columnValue(v, col, "database").(string)
+				q.Duration, _ = columnValue(v, col, "duration").(string)
+				q.Status, _ = columnValue(v, col, "status").(string)
+				q.Host, _ = columnValue(v, col, "tcp_host").(string)
+				res = append(res, q)
+			}
+		}
+	}
+	return res
+}
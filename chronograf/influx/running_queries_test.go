@@ -0,0 +1,110 @@
+package influx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func TestShowResults_RunningQueries(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		octets []byte
+		want   []chronograf.RunningQuery
+	}{
+		{
+			name: "InfluxDB",
+			octets: []byte(`[{"series":[{"columns":["qid","query","database","duration","status"],"values":[
+				[12,"SELECT mean(usage_user) FROM cpu","telegraf","1m30s","running"],
+				[13,"SHOW QUERIES","","52µs","running"]
+			]}]}]`),
+			want: []chronograf.RunningQuery{
+				{ID: 12, Query: "SELECT mean(usage_user) FROM cpu", Database: "telegraf", Duration: "1m30s", Status: "running"},
+				{ID: 13, Query: "SHOW QUERIES", Duration: "52µs", Status: "running"},
+			},
+		},
+		{
+			name: "InfluxDB Enterprise",
+			octets: []byte(`[{"series":[{"columns":["qid","node_id","tcp_host","query","database","duration","status"],"values":[
+				[12,4,"data-1:8088","SELECT mean(usage_user) FROM cpu","telegraf","10s","killed"]
+			]}]}]`),
+			want: []chronograf.RunningQuery{
+				{ID: 12, Query: "SELECT mean(usage_user) FROM cpu", Database: "telegraf", Duration: "10s", Status: "killed", Host: "data-1:8088"},
+			},
+		},
+		{
+			name:   "No queries",
+			octets: []byte(`[{}]`),
+			want:   []chronograf.RunningQuery{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := showResults{}
+			if err := json.Unmarshal(tt.octets, &results); err != nil {
+				t.Fatal(err)
+			}
+			if got := results.RunningQueries(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RunningQueries() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_KillQuery(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		query   chronograf.RunningQuery
+		body    string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "Query of InfluxDB",
+			query: chronograf.RunningQuery{ID: 12},
+			body:  `{"results":[{"statement_id":0}]}`,
+			want:  `KILL QUERY 12`,
+		},
+		{
+			name:  "Query of a data node",
+			query: chronograf.RunningQuery{ID: 12, Host: "data-1:8088"},
+			body:  `{"results":[{"statement_id":0}]}`,
+			want:  `KILL QUERY 12 ON "data-1:8088"`,
+		},
+		{
+			name:    "Query that is gone",
+			query:   chronograf.RunningQuery{ID: 14},
+			body:    `{"results":[{"statement_id":0,"error":"no such query id: 14"}]}`,
+			want:    `KILL QUERY 14`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				if got := r.FormValue("q"); got != tt.want {
+					t.Errorf("KillQuery() sent %q, want %q", got, tt.want)
+				}
+				rw.Write([]byte(tt.body))
+			}))
+			defer ts.Close()
+			u, _ := url.Parse(ts.URL)
+			c := &Client{
+				URL:    u,
+				Logger: &chronograf.NoopLogger{},
+			}
+
+			if err := c.KillQuery(context.Background(), &tt.query); (err != nil) != tt.wantErr {
+				t.Errorf("KillQuery() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package influx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/influxdata/influxdb/chronograf"
+	clog "github.com/influxdata/influxdb/chronograf/log"
+	"github.com/influxdata/influxdb/kit/tracing"
+)
+
+var _ chronograf.FluxTasker = &Client{}
+
+// tasksPageSize is the number of tasks read per request to InfluxDB 2.x
+const tasksPageSize = 100
+
+// ErrFluxTasksUnsupported is returned when managing the Flux tasks of a
+// source that is not InfluxDB 2.x
+var ErrFluxTasksUnsupported = errors.New("flux tasks are only supported by InfluxDB 2.x sources")
+
+// fluxTaskRequest is the body of the requests creating and updating tasks
+type fluxTaskRequest struct {
+	Org         string `json:"org,omitempty"`
+	Flux        string `json:"flux"`
+	Description string `json:"description"`
+	Status      string `json:"status"`
+}
+
+// tasksRequest sends a request to the tasks API of InfluxDB 2.x and decodes
+// the JSON response into out unless it is nil. Missing resources are
+// reported as notFound.
+func (c *Client) tasksRequest(ctx context.Context, method, path string, params url.Values, body, out interface{}, notFound error) error {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	if !c.V2 {
+		return ErrFluxTasksUnsupported
+	}
+
+	var r io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		r = bytes.NewReader(b)
+	}
+
+	u := *c.URL
+	u.Path = path
+	u.RawQuery = params.Encode()
+	req, err := http.NewRequest(method, u.String(), r)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	tracing.InjectToHTTPRequest(span, req)
+	clog.InjectRequestID(ctx, req)
+	if c.Authorizer != nil {
+		if err := c.Authorizer.Set(req); err != nil {
+			return err
+		}
+	}
+
+	hc := &http.Client{Transport: c.transport()}
+	resp, err := hc.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return chronograf.ErrUpstreamTimeout
+		}
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound && notFound != nil {
+		return notFound
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var response struct {
+			Message string `json:"message"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&response); err != nil || response.Message == "" {
+			return fmt.Errorf("received status code %d from server", resp.StatusCode)
+		}
+		return fmt.Errorf("received status code %d from server: err: %s", resp.StatusCode, response.Message)
+	}
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// AllFluxTasks lists the tasks of the organization of the source
+func (c *Client) AllFluxTasks(ctx context.Context) ([]chronograf.FluxTask, error) {
+	tasks := []chronograf.FluxTask{}
+	after := ""
+	for {
+		params := url.Values{}
+		if c.Org != "" {
+			params.Set("org", c.Org)
+		}
+		if after != "" {
+			params.Set("after", after)
+		}
+		params.Set("limit", strconv.Itoa(tasksPageSize))
+
+		var page struct {
+			Tasks []chronograf.FluxTask `json:"tasks"`
+		}
+		if err := c.tasksRequest(ctx, "GET", "api/v2/tasks", params, nil, &page, nil); err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, page.Tasks...)
+		if len(page.Tasks) < tasksPageSize {
+			return tasks, nil
+		}
+		after = page.Tasks[len(page.Tasks)-1].ID
+	}
+}
+
+// GetFluxTask retrieves a task of the source
+func (c *Client) GetFluxTask(ctx context.Context, id string) (*chronograf.FluxTask, error) {
+	var task chronograf.FluxTask
+	if err := c.tasksRequest(ctx, "GET", "api/v2/tasks/"+id, nil, nil, &task, chronograf.ErrFluxTaskNotFound); err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
+
+// CreateFluxTask creates a task in the organization of the source. Its name
+// and schedule are read by InfluxDB from the task option of its script.
+func (c *Client) CreateFluxTask(ctx context.Context, task *chronograf.FluxTask) (*chronograf.FluxTask, error) {
+	req := fluxTaskRequest{
+		Org:         c.Org,
+		Flux:        task.Flux,
+		Description: task.Description,
+		Status:      task.Status,
+	}
+	var created chronograf.FluxTask
+	if err := c.tasksRequest(ctx, "POST", "api/v2/tasks", nil, req, &created, nil); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateFluxTask replaces the script, description and status of a task
+func (c *Client) UpdateFluxTask(ctx context.Context, task *chronograf.FluxTask) (*chronograf.FluxTask, error) {
+	req := fluxTaskRequest{
+		Flux:        task.Flux,
+		Description: task.Description,
+		Status:      task.Status,
+	}
+	var updated chronograf.FluxTask
+	if err := c.tasksRequest(ctx, "PATCH", "api/v2/tasks/"+task.ID, nil, req, &updated, chronograf.ErrFluxTaskNotFound); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteFluxTask removes a task of the source along with its runs
+func (c *Client) DeleteFluxTask(ctx context.Context, id string) error {
+	return c.tasksRequest(ctx, "DELETE", "api/v2/tasks/"+id, nil, nil, nil, chronograf.ErrFluxTaskNotFound)
+}
+
+// FluxTaskRuns lists up to limit of the latest runs of a task
+func (c *Client) FluxTaskRuns(ctx context.Context, id string, limit int) ([]chronograf.FluxTaskRun, error) {
+	params := url.Values{}
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+	var res struct {
+		Runs []chronograf.FluxTaskRun `json:"runs"`
+	}
+	if err := c.tasksRequest(ctx, "GET", "api/v2/tasks/"+id+"/runs", params, nil, &res, chronograf.ErrFluxTaskNotFound); err != nil {
+		return nil, err
+	}
+	if res.Runs == nil {
+		res.Runs = []chronograf.FluxTaskRun{}
+	}
+	return res.Runs, nil
+}
+
+// FluxTaskLogs lists the logs of a task, only those of the run runID when it
+// is not empty
+func (c *Client) FluxTaskLogs(ctx context.Context, id, runID string) ([]chronograf.FluxTaskLog, error) {
+	path := "api/v2/tasks/" + id + "/logs"
+	if runID != "" {
+		path = "api/v2/tasks/" + id + "/runs/" + runID + "/logs"
+	}
+	var res struct {
+		Events []chronograf.FluxTaskLog `json:"events"`
+	}
+	if err := c.tasksRequest(ctx, "GET", path, nil, nil, &res, chronograf.ErrFluxTaskNotFound); err != nil {
+		return nil, err
+	}
+	if res.Events == nil {
+		res.Events = []chronograf.FluxTaskLog{}
+	}
+	return res.Events, nil
+}
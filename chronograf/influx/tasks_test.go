@@ -0,0 +1,142 @@
+package influx_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/influx"
+)
+
+const downsampleFlux = `option task = {name: "downsample", every: 1h}
+
+from(bucket: "telegraf") |> range(start: -task.every) |> aggregateWindow(every: 5m, fn: mean) |> to(bucket: "telegraf_5m")`
+
+// newTasksServer fakes the tasks API of InfluxDB 2.x, recording the bodies
+// of the requests creating and updating tasks
+func newTasksServer(t *testing.T, bodies *[]map[string]string) *httptest.Server {
+	task := `{"id":"0a1","orgID":"05e","name":"downsample","status":"active","flux":"option task = {name: \"downsample\", every: 1h}","every":"1h","latestCompleted":"2026-10-16T11:00:00Z","lastRunStatus":"success","createdAt":"2026-10-15T09:00:00Z","updatedAt":"2026-10-15T09:00:00Z"}`
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if auth := r.Header.Get("Authorization"); auth != "Token my-token" {
+			rw.WriteHeader(http.StatusUnauthorized)
+			rw.Write([]byte(`{"code":"unauthorized","message":"unauthorized access"}`))
+			return
+		}
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v2/tasks":
+			if org := r.URL.Query().Get("org"); org != "my-org" {
+				t.Error("Expected the org to be `my-org` but was", org)
+			}
+			rw.Write([]byte(`{"tasks":[` + task + `]}`))
+		case "POST /api/v2/tasks", "PATCH /api/v2/tasks/0a1":
+			var body map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			*bodies = append(*bodies, body)
+			if r.Method == "POST" {
+				rw.WriteHeader(http.StatusCreated)
+			}
+			rw.Write([]byte(task))
+		case "GET /api/v2/tasks/0a1/runs":
+			if limit := r.URL.Query().Get("limit"); limit != "2" {
+				t.Error("Expected the limit to be 2 but was", limit)
+			}
+			rw.Write([]byte(`{"runs":[{"id":"0b2","taskID":"0a1","status":"failed","scheduledFor":"2026-10-16T11:00:00Z","startedAt":"2026-10-16T11:00:01Z","finishedAt":"2026-10-16T11:00:02Z"}]}`))
+		case "GET /api/v2/tasks/0a1/runs/0b2/logs":
+			rw.Write([]byte(`{"events":[{"runID":"0b2","time":"2026-10-16T11:00:02Z","message":"bucket \"telegraf_5m\" not found"}]}`))
+		case "DELETE /api/v2/tasks/0a1":
+			rw.WriteHeader(http.StatusNoContent)
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+			rw.Write([]byte(`{"code":"not found","message":"task not found"}`))
+		}
+	}))
+}
+
+func TestClient_FluxTasks(t *testing.T) {
+	var bodies []map[string]string
+	ts := newTasksServer(t, &bodies)
+	defer ts.Close()
+
+	ctx := context.Background()
+	c := &influx.Client{Logger: &chronograf.NoopLogger{}}
+	if err := c.Connect(ctx, &chronograf.Source{
+		URL:      ts.URL,
+		Type:     chronograf.InfluxDBv2,
+		Username: "my-org",
+		Password: "my-token",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	tasks, err := c.AllFluxTasks(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := chronograf.FluxTask{
+		ID:              "0a1",
+		Name:            "downsample",
+		Status:          chronograf.FluxTaskActive,
+		Flux:            `option task = {name: "downsample", every: 1h}`,
+		Every:           "1h",
+		LatestCompleted: time.Date(2026, 10, 16, 11, 0, 0, 0, time.UTC),
+		LastRunStatus:   "success",
+		CreatedAt:       time.Date(2026, 10, 15, 9, 0, 0, 0, time.UTC),
+		UpdatedAt:       time.Date(2026, 10, 15, 9, 0, 0, 0, time.UTC),
+	}
+	if !cmp.Equal(tasks, []chronograf.FluxTask{want}) {
+		t.Errorf("Client.AllFluxTasks() = %s", cmp.Diff(tasks, []chronograf.FluxTask{want}))
+	}
+
+	if _, err := c.CreateFluxTask(ctx, &chronograf.FluxTask{Flux: downsampleFlux, Status: chronograf.FluxTaskActive}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.UpdateFluxTask(ctx, &chronograf.FluxTask{ID: "0a1", Flux: downsampleFlux, Status: chronograf.FluxTaskInactive}); err != nil {
+		t.Fatal(err)
+	}
+	wantBodies := []map[string]string{
+		{"org": "my-org", "flux": downsampleFlux, "description": "", "status": "active"},
+		{"flux": downsampleFlux, "description": "", "status": "inactive"},
+	}
+	if !cmp.Equal(bodies, wantBodies) {
+		t.Errorf("Client.CreateFluxTask() and UpdateFluxTask() bodies = %s", cmp.Diff(bodies, wantBodies))
+	}
+
+	runs, err := c.FluxTaskRuns(ctx, "0a1", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(runs) != 1 || runs[0].Status != "failed" || !runs[0].FinishedAt.Equal(time.Date(2026, 10, 16, 11, 0, 2, 0, time.UTC)) {
+		t.Errorf("Client.FluxTaskRuns() = %v", runs)
+	}
+
+	logs, err := c.FluxTaskLogs(ctx, "0a1", "0b2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(logs) != 1 || logs[0].Message != `bucket "telegraf_5m" not found` {
+		t.Errorf("Client.FluxTaskLogs() = %v", logs)
+	}
+
+	if err := c.DeleteFluxTask(ctx, "0a1"); err != nil {
+		t.Errorf("Client.DeleteFluxTask() error = %v", err)
+	}
+	if _, err := c.GetFluxTask(ctx, "0ff"); err != chronograf.ErrFluxTaskNotFound {
+		t.Errorf("Client.GetFluxTask() error = %v, want %v", err, chronograf.ErrFluxTaskNotFound)
+	}
+}
+
+func TestClient_FluxTasksV1(t *testing.T) {
+	ctx := context.Background()
+	c := &influx.Client{Logger: &chronograf.NoopLogger{}}
+	if err := c.Connect(ctx, &chronograf.Source{URL: "http://localhost:8086"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.AllFluxTasks(ctx); err != influx.ErrFluxTasksUnsupported {
+		t.Errorf("Client.AllFluxTasks() error = %v, want %v", err, influx.ErrFluxTasksUnsupported)
+	}
+}
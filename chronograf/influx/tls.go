@@ -0,0 +1,76 @@
+package influx
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// ErrInvalidTLSCA is returned when the certificate authorities of a source
+// hold no PEM encoded certificate
+var ErrInvalidTLSCA = errors.New("no certificate found within the certificate authorities of the source")
+
+// Shared transports of sources with custom TLS configurations, keyed by the
+// digest of their configuration, to prevent leaking connections
+var (
+	tlsTransportsMu sync.Mutex
+	tlsTransports   = map[string]*http.Transport{}
+)
+
+// TLSConfig returns the TLS configuration of a source trusting custom
+// certificate authorities or presenting a client certificate, or nil when the
+// source does neither
+func TLSConfig(src *chronograf.Source) (*tls.Config, error) {
+	if src.TLSCA == "" && src.TLSCert == "" && src.TLSKey == "" {
+		return nil, nil
+	}
+
+	cfg := &tls.Config{InsecureSkipVerify: src.InsecureSkipVerify}
+	if src.TLSCA != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(src.TLSCA)) {
+			return nil, ErrInvalidTLSCA
+		}
+		cfg.RootCAs = pool
+	}
+	if src.TLSCert != "" || src.TLSKey != "" {
+		cert, err := tls.X509KeyPair([]byte(src.TLSCert), []byte(src.TLSKey))
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate of the source: %v", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return cfg, nil
+}
+
+// Transport returns the shared transport of a source with a custom TLS
+// configuration, or nil when the source has none
+func Transport(src *chronograf.Source) (*http.Transport, error) {
+	cfg, err := TLSConfig(src)
+	if err != nil || cfg == nil {
+		return nil, err
+	}
+
+	h := sha256.New()
+	fmt.Fprintf(h, "%t\x00%s\x00%s\x00%s", src.InsecureSkipVerify, src.TLSCA, src.TLSCert, src.TLSKey)
+	key := hex.EncodeToString(h.Sum(nil))
+
+	tlsTransportsMu.Lock()
+	defer tlsTransportsMu.Unlock()
+	t, ok := tlsTransports[key]
+	if !ok {
+		t = &http.Transport{TLSClientConfig: cfg}
+		tlsTransports[key] = t
+	}
+	return t, nil
+}
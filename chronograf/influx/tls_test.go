@@ -0,0 +1,215 @@
+package influx_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/influx"
+)
+
+// testCert is a certificate and its private key, PEM encoded
+type testCert struct {
+	cert, key string
+	x509      *x509.Certificate
+	priv      *ecdsa.PrivateKey
+}
+
+// newTestCert creates a certificate signed by parent, or self-signed when
+// parent is nil
+func newTestCert(t *testing.T, serial int64, parent *testCert, tmpl *x509.Certificate) *testCert {
+	t.Helper()
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl.SerialNumber = big.NewInt(serial)
+	tmpl.NotBefore = time.Now().Add(-time.Hour)
+	tmpl.NotAfter = time.Now().Add(time.Hour)
+
+	signer, signerKey := tmpl, priv
+	if parent != nil {
+		signer, signerKey = parent.x509, parent.priv
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signer, &priv.PublicKey, signerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCert{
+		cert: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		key:  string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})),
+		x509: cert,
+		priv: priv,
+	}
+}
+
+func TestClient_MutualTLS(t *testing.T) {
+	ca := newTestCert(t, 1, nil, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "ca"},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	})
+	server := newTestCert(t, 2, ca, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "influxdb"},
+		IPAddresses: []net.IP{net.ParseIP("127.0.0.1")},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	client := newTestCert(t, 3, ca, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "chronograf"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+
+	serverCert, err := tls.X509KeyPair([]byte(server.cert), []byte(server.key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.x509)
+
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("X-Influxdb-Build", "OSS")
+		rw.Header().Set("X-Influxdb-Version", "1.7.8")
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	ts.TLS = &tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    pool,
+	}
+	ts.StartTLS()
+	defer ts.Close()
+
+	tests := []struct {
+		name       string
+		src        chronograf.Source
+		wantErr    bool
+		connectErr bool
+	}{
+		{
+			name: "Client certificate and custom CA",
+			src: chronograf.Source{
+				TLSCA:   ca.cert,
+				TLSCert: client.cert,
+				TLSKey:  client.key,
+			},
+		},
+		{
+			name: "Custom CA without client certificate",
+			src: chronograf.Source{
+				TLSCA: ca.cert,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Client certificate without custom CA",
+			src: chronograf.Source{
+				TLSCert: client.cert,
+				TLSKey:  client.key,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Client certificate skipping verification",
+			src: chronograf.Source{
+				TLSCert:            client.cert,
+				TLSKey:             client.key,
+				InsecureSkipVerify: true,
+			},
+		},
+		{
+			name: "Invalid CA",
+			src: chronograf.Source{
+				TLSCA: "not a certificate",
+			},
+			connectErr: true,
+		},
+		{
+			name: "Key not matching the client certificate",
+			src: chronograf.Source{
+				TLSCA:   ca.cert,
+				TLSCert: client.cert,
+				TLSKey:  server.key,
+			},
+			connectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			tt.src.URL = ts.URL
+			c := &influx.Client{Logger: &chronograf.NoopLogger{}}
+			err := c.Connect(ctx, &tt.src)
+			if (err != nil) != tt.connectErr {
+				t.Fatalf("Client.Connect() error = %v, connectErr %v", err, tt.connectErr)
+			}
+			if tt.connectErr {
+				return
+			}
+			version, err := c.Version(ctx)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Client.Version() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && version != "1.7.8" {
+				t.Errorf("Client.Version() = %v, want 1.7.8", version)
+			}
+		})
+	}
+}
+
+func TestTransport(t *testing.T) {
+	if tr, err := influx.Transport(&chronograf.Source{InsecureSkipVerify: true}); tr != nil || err != nil {
+		t.Errorf("Transport() of a source without TLS configuration = %v, %v", tr, err)
+	}
+
+	src := &chronograf.Source{TLSCA: "not a certificate"}
+	if _, err := influx.Transport(src); err != influx.ErrInvalidTLSCA {
+		t.Errorf("Transport() error = %v, want %v", err, influx.ErrInvalidTLSCA)
+	}
+
+	// Sources with the same TLS configuration share their transport
+	ca := newTestCert(t, 1, nil, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "ca"},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	})
+	first, err := influx.Transport(&chronograf.Source{ID: 1, TLSCA: ca.cert})
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := influx.Transport(&chronograf.Source{ID: 2, TLSCA: ca.cert})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first == nil || first != second {
+		t.Errorf("Transport() = %p and %p, want the same transport", first, second)
+	}
+	insecure, err := influx.Transport(&chronograf.Source{TLSCA: ca.cert, InsecureSkipVerify: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if insecure == first {
+		t.Error("Transport() skipping verification shares the transport verifying certificates")
+	}
+}
@@ -24,6 +24,23 @@ func (c *Client) Add(ctx context.Context, u *chronograf.User) (*chronograf.User,
 	return c.Get(ctx, chronograf.UserQuery{Name: &u.Name})
 }
 
+// AddMany creates users in InfluxDB one after the other. InfluxDB has no
+// transactions, so the users already created are dropped if one of them fails.
+func (c *Client) AddMany(ctx context.Context, us []*chronograf.User) ([]*chronograf.User, error) {
+	added := make([]*chronograf.User, 0, len(us))
+	for _, u := range us {
+		res, err := c.Add(ctx, u)
+		if err != nil {
+			for _, a := range added {
+				_ = c.Delete(ctx, a)
+			}
+			return nil, err
+		}
+		added = append(added, res)
+	}
+	return added, nil
+}
+
 // Delete the User from InfluxDB
 func (c *Client) Delete(ctx context.Context, u *chronograf.User) error {
 	res, err := c.Query(ctx, chronograf.Query{
@@ -106,6 +123,30 @@ func (c *Client) Update(ctx context.Context, u *chronograf.User) error {
 	return nil
 }
 
+// UpdateMany updates users in InfluxDB one after the other. InfluxDB has no
+// transactions, so the users already updated are restored if one of them fails.
+func (c *Client) UpdateMany(ctx context.Context, us []*chronograf.User) error {
+	updated := make([]*chronograf.User, 0, len(us))
+	restore := func() {
+		for _, u := range updated {
+			_ = c.Update(ctx, u)
+		}
+	}
+	for _, u := range us {
+		prev, err := c.Get(ctx, chronograf.UserQuery{Name: &u.Name})
+		if err != nil {
+			restore()
+			return err
+		}
+		updated = append(updated, prev)
+		if err := c.Update(ctx, u); err != nil {
+			restore()
+			return err
+		}
+	}
+	return nil
+}
+
 // All users in influx
 func (c *Client) All(ctx context.Context) ([]chronograf.User, error) {
 	users, err := c.showUsers(ctx)
@@ -136,6 +177,22 @@ func (c *Client) Num(ctx context.Context) (int, error) {
 	return len(all), nil
 }
 
+// Filter is the users in DB matching f
+func (c *Client) Filter(ctx context.Context, f chronograf.UserFilter) ([]chronograf.User, error) {
+	all, err := c.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	users := []chronograf.User{}
+	for i := range all {
+		if f.Matches(&all[i]) {
+			users = append(users, all[i])
+		}
+	}
+	return users, nil
+}
+
 // showUsers runs SHOW USERS InfluxQL command and returns chronograf users.
 func (c *Client) showUsers(ctx context.Context) ([]chronograf.User, error) {
 	res, err := c.Query(ctx, chronograf.Query{
@@ -0,0 +1,169 @@
+package ldap
+
+import (
+	"bufio"
+	"errors"
+	"io"
+)
+
+// Classes and the constructed flag of BER identifier octets
+const (
+	classUniversal   = 0x00
+	classApplication = 0x40
+	classContext     = 0x80
+	constructed      = 0x20
+)
+
+// Universal tags used by LDAP
+const (
+	tagBoolean     = 0x01
+	tagInteger     = 0x02
+	tagOctetString = 0x04
+	tagEnumerated  = 0x0a
+	tagSequence    = 0x10 | constructed
+	tagSet         = 0x11 | constructed
+)
+
+// maxElementLength bounds the length of elements read from a directory
+const maxElementLength = 16 << 20
+
+var errMalformed = errors.New("ldap: malformed BER element")
+
+// element is a decoded BER element. Only identifiers of a single octet are
+// supported as LDAP does not use tags above 30.
+type element struct {
+	id    byte
+	value []byte
+}
+
+// encodeLength returns the definite length octets of n
+func encodeLength(n int) []byte {
+	if n < 0x80 {
+		return []byte{byte(n)}
+	}
+	var b []byte
+	for ; n > 0; n >>= 8 {
+		b = append([]byte{byte(n)}, b...)
+	}
+	return append([]byte{0x80 | byte(len(b))}, b...)
+}
+
+// tlv encodes a single element
+func tlv(id byte, value []byte) []byte {
+	b := append([]byte{id}, encodeLength(len(value))...)
+	return append(b, value...)
+}
+
+// seq encodes a constructed element of the encoded children
+func seq(id byte, children ...[]byte) []byte {
+	var value []byte
+	for _, c := range children {
+		value = append(value, c...)
+	}
+	return tlv(id|constructed, value)
+}
+
+// berInt encodes v as the minimal two's complement integer
+func berInt(id byte, v int64) []byte {
+	b := []byte{byte(v)}
+	for v > 0x7f || v < -0x80 {
+		v >>= 8
+		b = append([]byte{byte(v)}, b...)
+	}
+	return tlv(id, b)
+}
+
+func berString(id byte, s string) []byte {
+	return tlv(id, []byte(s))
+}
+
+func berBool(id byte, v bool) []byte {
+	if v {
+		return tlv(id, []byte{0xff})
+	}
+	return tlv(id, []byte{0x00})
+}
+
+// parse decodes the first element of b and returns the remaining octets
+func parse(b []byte) (element, []byte, error) {
+	if len(b) < 2 {
+		return element{}, nil, errMalformed
+	}
+	id, l := b[0], int(b[1])
+	b = b[2:]
+	if l&0x80 != 0 {
+		n := l & 0x7f
+		// Indefinite lengths are not permitted by LDAP
+		if n == 0 || n > 4 || len(b) < n {
+			return element{}, nil, errMalformed
+		}
+		l = 0
+		for _, o := range b[:n] {
+			l = l<<8 | int(o)
+		}
+		b = b[n:]
+	}
+	if l > len(b) {
+		return element{}, nil, errMalformed
+	}
+	return element{id: id, value: b[:l]}, b[l:], nil
+}
+
+// children decodes the elements of a constructed element
+func (e element) children() ([]element, error) {
+	var es []element
+	b := e.value
+	for len(b) > 0 {
+		c, rest, err := parse(b)
+		if err != nil {
+			return nil, err
+		}
+		es = append(es, c)
+		b = rest
+	}
+	return es, nil
+}
+
+// int decodes the value of an integer or enumerated element
+func (e element) int() (int64, error) {
+	if len(e.value) == 0 || len(e.value) > 8 {
+		return 0, errMalformed
+	}
+	v := int64(int8(e.value[0]))
+	for _, o := range e.value[1:] {
+		v = v<<8 | int64(o)
+	}
+	return v, nil
+}
+
+// readElement reads the octets of the next complete element from r
+func readElement(r *bufio.Reader) ([]byte, error) {
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	l := int(header[1])
+	if l&0x80 != 0 {
+		n := l & 0x7f
+		if n == 0 || n > 4 {
+			return nil, errMalformed
+		}
+		octets := make([]byte, n)
+		if _, err := io.ReadFull(r, octets); err != nil {
+			return nil, err
+		}
+		header = append(header, octets...)
+		l = 0
+		for _, o := range octets {
+			l = l<<8 | int(o)
+		}
+	}
+	if l > maxElementLength {
+		return nil, errMalformed
+	}
+	value := make([]byte, l)
+	if _, err := io.ReadFull(r, value); err != nil {
+		return nil, err
+	}
+	return append(header, value...), nil
+}
@@ -0,0 +1,121 @@
+package ldap
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrInvalidCredentials is returned for unknown users and wrong passwords alike
+var ErrInvalidCredentials = errors.New("ldap: invalid credentials")
+
+// Directory authenticates users by binding as them and looks up the groups
+// they are members of
+type Directory struct {
+	URL                string        // URL is the ldap:// or ldaps:// URL of the directory
+	InsecureSkipVerify bool          // InsecureSkipVerify disables the verification of the certificate of an ldaps:// directory
+	Timeout            time.Duration // Timeout bounds every operation
+	BindDN             string        // BindDN is the service account searching users and groups; empty searches anonymously
+	BindPassword       string
+	UserBaseDN         string // UserBaseDN is the subtree users are searched in
+	UserFilter         string // UserFilter finds a user, e.g. (uid=%s); %s is replaced by the escaped username
+	GroupBaseDN        string // GroupBaseDN is the subtree groups are searched in; empty uses the memberOf attribute of users
+	GroupFilter        string // GroupFilter finds the groups of a user, e.g. (member=%s); %s is replaced by the escaped DN of the user
+	GroupAttribute     string // GroupAttribute is the attribute naming a group, e.g. cn
+}
+
+// Authenticate verifies the password of the user and returns the names of
+// the groups of the user. Users not found, found more than once, or with a
+// wrong password return ErrInvalidCredentials.
+func (d *Directory) Authenticate(username, password string) ([]string, error) {
+	if username == "" || password == "" {
+		return nil, ErrInvalidCredentials
+	}
+
+	conn, err := Dial(d.URL, &tls.Config{InsecureSkipVerify: d.InsecureSkipVerify}, d.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if err := d.bindServiceAccount(conn); err != nil {
+		return nil, err
+	}
+	users, err := conn.Search(d.UserBaseDN, expand(d.UserFilter, username), []string{"memberOf"})
+	if err != nil {
+		return nil, err
+	}
+	if len(users) != 1 {
+		return nil, ErrInvalidCredentials
+	}
+	user := users[0]
+
+	if err := conn.Bind(user.DN, password); IsResultCode(err, ResultInvalidCredentials) {
+		return nil, ErrInvalidCredentials
+	} else if err != nil {
+		return nil, err
+	}
+
+	if d.GroupBaseDN == "" {
+		groups := []string{}
+		for _, dn := range user.Attribute("memberOf") {
+			if name := firstRDNValue(dn); name != "" {
+				groups = append(groups, name)
+			}
+		}
+		return groups, nil
+	}
+
+	// Groups are searched as the service account as users may not be
+	// permitted to read them
+	if err := d.bindServiceAccount(conn); err != nil {
+		return nil, err
+	}
+	entries, err := conn.Search(d.GroupBaseDN, expand(d.GroupFilter, user.DN), []string{d.GroupAttribute})
+	if err != nil {
+		return nil, err
+	}
+	groups := []string{}
+	for _, entry := range entries {
+		groups = append(groups, entry.Attribute(d.GroupAttribute)...)
+	}
+	return groups, nil
+}
+
+func (d *Directory) bindServiceAccount(conn *Conn) error {
+	if d.BindDN == "" {
+		return nil
+	}
+	if err := conn.Bind(d.BindDN, d.BindPassword); err != nil {
+		return fmt.Errorf("ldap: bind as %s: %v", d.BindDN, err)
+	}
+	return nil
+}
+
+// expand replaces each %s of a filter by the escaped value
+func expand(filter, value string) string {
+	return strings.Replace(filter, "%s", EscapeFilter(value), -1)
+}
+
+// firstRDNValue returns the value of the first relative distinguished name
+// of dn, e.g. ops of cn=ops,ou=groups,dc=example,dc=com
+func firstRDNValue(dn string) string {
+	rdn := dn
+	for i := 0; i < len(dn); i++ {
+		if dn[i] == '\\' {
+			i++
+			continue
+		}
+		if dn[i] == ',' {
+			rdn = dn[:i]
+			break
+		}
+	}
+	i := strings.IndexByte(rdn, '=')
+	if i < 0 {
+		return ""
+	}
+	return strings.TrimSpace(strings.Replace(rdn[i+1:], `\`, "", -1))
+}
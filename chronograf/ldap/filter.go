@@ -0,0 +1,181 @@
+package ldap
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// Context tags of the choices of a search filter
+const (
+	filterAnd            = classContext | constructed | 0
+	filterOr             = classContext | constructed | 1
+	filterNot            = classContext | constructed | 2
+	filterEqualityMatch  = classContext | constructed | 3
+	filterSubstrings     = classContext | constructed | 4
+	filterGreaterOrEqual = classContext | constructed | 5
+	filterLessOrEqual    = classContext | constructed | 6
+	filterPresent        = classContext | 7
+	filterApproxMatch    = classContext | constructed | 8
+
+	substringInitial = classContext | 0
+	substringAny     = classContext | 1
+	substringFinal   = classContext | 2
+)
+
+// EscapeFilter escapes the special characters of a value within a search
+// filter so that user input cannot change the meaning of the filter
+func EscapeFilter(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\\', '*', '(', ')', 0:
+			fmt.Fprintf(&b, "\\%02x", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// compileFilter encodes the string representation of a search filter as
+// defined by RFC 4515, e.g. (&(objectClass=person)(uid=marty))
+func compileFilter(filter string) ([]byte, error) {
+	f, rest, err := compile(strings.TrimSpace(filter))
+	if err != nil {
+		return nil, err
+	}
+	if rest != "" {
+		return nil, fmt.Errorf("ldap: unexpected %q after filter", rest)
+	}
+	return f, nil
+}
+
+// compile encodes the filter at the start of s and returns the rest of s
+func compile(s string) ([]byte, string, error) {
+	if !strings.HasPrefix(s, "(") {
+		return nil, "", fmt.Errorf("ldap: filter must start with (")
+	}
+	s = s[1:]
+	if s == "" {
+		return nil, "", fmt.Errorf("ldap: unterminated filter")
+	}
+
+	var f []byte
+	switch s[0] {
+	case '&', '|':
+		id := byte(filterAnd)
+		if s[0] == '|' {
+			id = filterOr
+		}
+		s = s[1:]
+		var children [][]byte
+		for strings.HasPrefix(s, "(") {
+			child, rest, err := compile(s)
+			if err != nil {
+				return nil, "", err
+			}
+			children = append(children, child)
+			s = rest
+		}
+		f = seq(id, children...)
+	case '!':
+		child, rest, err := compile(s[1:])
+		if err != nil {
+			return nil, "", err
+		}
+		f = seq(filterNot, child)
+		s = rest
+	default:
+		end := strings.IndexByte(s, ')')
+		if end < 0 {
+			return nil, "", fmt.Errorf("ldap: unterminated filter")
+		}
+		item, err := compileItem(s[:end])
+		if err != nil {
+			return nil, "", err
+		}
+		f = item
+		s = s[end:]
+	}
+
+	if !strings.HasPrefix(s, ")") {
+		return nil, "", fmt.Errorf("ldap: unterminated filter")
+	}
+	return f, s[1:], nil
+}
+
+// compileItem encodes a simple filter such as uid=marty or cn=*ops*
+func compileItem(item string) ([]byte, error) {
+	i := strings.IndexByte(item, '=')
+	if i < 1 {
+		return nil, fmt.Errorf("ldap: invalid filter item %q", item)
+	}
+	attr, value := item[:i], item[i+1:]
+
+	id := byte(filterEqualityMatch)
+	switch attr[len(attr)-1] {
+	case '>':
+		id, attr = filterGreaterOrEqual, attr[:len(attr)-1]
+	case '<':
+		id, attr = filterLessOrEqual, attr[:len(attr)-1]
+	case '~':
+		id, attr = filterApproxMatch, attr[:len(attr)-1]
+	}
+	if attr == "" {
+		return nil, fmt.Errorf("ldap: invalid filter item %q", item)
+	}
+
+	if id == filterEqualityMatch && value == "*" {
+		return berString(filterPresent, attr), nil
+	}
+	if id == filterEqualityMatch && strings.Contains(value, "*") {
+		parts := strings.Split(value, "*")
+		var subs [][]byte
+		for j, part := range parts {
+			if part == "" {
+				continue
+			}
+			v, err := unescapeFilter(part)
+			if err != nil {
+				return nil, err
+			}
+			sub := byte(substringAny)
+			switch j {
+			case 0:
+				sub = substringInitial
+			case len(parts) - 1:
+				sub = substringFinal
+			}
+			subs = append(subs, berString(sub, v))
+		}
+		return seq(filterSubstrings, berString(tagOctetString, attr), seq(tagSequence, subs...)), nil
+	}
+
+	v, err := unescapeFilter(value)
+	if err != nil {
+		return nil, err
+	}
+	return seq(id, berString(tagOctetString, attr), berString(tagOctetString, v)), nil
+}
+
+// unescapeFilter decodes the \XX escapes of a value within a filter
+func unescapeFilter(s string) (string, error) {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			b.WriteByte(s[i])
+			continue
+		}
+		if i+3 > len(s) {
+			return "", fmt.Errorf("ldap: invalid escape in %q", s)
+		}
+		octet, err := hex.DecodeString(s[i+1 : i+3])
+		if err != nil {
+			return "", fmt.Errorf("ldap: invalid escape in %q", s)
+		}
+		b.Write(octet)
+		i += 2
+	}
+	return b.String(), nil
+}
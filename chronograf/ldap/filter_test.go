@@ -0,0 +1,107 @@
+package ldap
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestEscapeFilter(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "marty", want: "marty"},
+		{in: "*", want: `\2a`},
+		{in: "marty)(uid=*", want: `marty\29\28uid=\2a`},
+		{in: `back\slash`, want: `back\5cslash`},
+		{in: "nul\x00", want: `nul\00`},
+	}
+	for _, tt := range tests {
+		if got := EscapeFilter(tt.in); got != tt.want {
+			t.Errorf("EscapeFilter(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestCompileFilter(t *testing.T) {
+	attr := func(s string) []byte { return berString(tagOctetString, s) }
+	tests := []struct {
+		name    string
+		filter  string
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:   "Equality",
+			filter: "(uid=marty)",
+			want:   seq(filterEqualityMatch, attr("uid"), attr("marty")),
+		},
+		{
+			name:   "Escaped value",
+			filter: `(cn=time\2c travel\2a)`,
+			want:   seq(filterEqualityMatch, attr("cn"), attr("time, travel*")),
+		},
+		{
+			name:   "Presence",
+			filter: "(objectClass=*)",
+			want:   berString(filterPresent, "objectClass"),
+		},
+		{
+			name:   "Substrings",
+			filter: "(cn=ti*me*vel)",
+			want: seq(filterSubstrings, attr("cn"), seq(tagSequence,
+				berString(substringInitial, "ti"),
+				berString(substringAny, "me"),
+				berString(substringFinal, "vel"),
+			)),
+		},
+		{
+			name:   "Comparisons",
+			filter: "(|(uidNumber>=1000)(uidNumber<=10)(cn~=marty))",
+			want: seq(filterOr,
+				seq(filterGreaterOrEqual, attr("uidNumber"), attr("1000")),
+				seq(filterLessOrEqual, attr("uidNumber"), attr("10")),
+				seq(filterApproxMatch, attr("cn"), attr("marty")),
+			),
+		},
+		{
+			name:   "Nested",
+			filter: "(&(objectClass=person)(!(uid=biff)))",
+			want: seq(filterAnd,
+				seq(filterEqualityMatch, attr("objectClass"), attr("person")),
+				seq(filterNot, seq(filterEqualityMatch, attr("uid"), attr("biff"))),
+			),
+		},
+		{
+			name:    "Unterminated",
+			filter:  "(&(uid=marty)",
+			wantErr: true,
+		},
+		{
+			name:    "Trailing filter",
+			filter:  "(uid=marty)(uid=biff)",
+			wantErr: true,
+		},
+		{
+			name:    "Missing attribute",
+			filter:  "(=marty)",
+			wantErr: true,
+		},
+		{
+			name:    "Invalid escape",
+			filter:  `(uid=mar\zz)`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := compileFilter(tt.filter)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("compileFilter(%q) error = %v, wantErr %v", tt.filter, err, tt.wantErr)
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("compileFilter(%q) = %x, want %x", tt.filter, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,312 @@
+// Package ldap is a minimal LDAPv3 client. It implements the simple bind and
+// search operations needed to authenticate users against a directory such
+// as OpenLDAP or Active Directory and to look up the groups of the users.
+package ldap
+
+import (
+	"bufio"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// Application tags of the protocol operations
+const (
+	opBindRequest           = classApplication | constructed | 0
+	opBindResponse          = classApplication | constructed | 1
+	opUnbindRequest         = classApplication | 2
+	opSearchRequest         = classApplication | constructed | 3
+	opSearchResultEntry     = classApplication | constructed | 4
+	opSearchResultDone      = classApplication | constructed | 5
+	opSearchResultReference = classApplication | constructed | 19
+)
+
+// Result codes of operations
+const (
+	ResultSuccess            = 0
+	ResultNoSuchObject       = 32
+	ResultInvalidCredentials = 49
+)
+
+const (
+	protocolVersion = 3
+	scopeSubtree    = 2
+	derefNever      = 0
+)
+
+// Error is a result code other than success returned by a directory
+type Error struct {
+	Code    int64
+	Message string
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("ldap: result code %d", e.Code)
+	}
+	return fmt.Sprintf("ldap: result code %d: %s", e.Code, e.Message)
+}
+
+// IsResultCode is true if err is an Error with the result code
+func IsResultCode(err error, code int64) bool {
+	e, ok := err.(*Error)
+	return ok && e.Code == code
+}
+
+// Entry is an object returned by a search
+type Entry struct {
+	DN         string
+	Attributes map[string][]string
+}
+
+// Attribute returns the values of the attribute with name, which is compared
+// case-insensitively as are attribute descriptions in LDAP
+func (e *Entry) Attribute(name string) []string {
+	for attr, values := range e.Attributes {
+		if strings.EqualFold(attr, name) {
+			return values
+		}
+	}
+	return nil
+}
+
+// Conn is a connection to a directory. Operations of a Conn must not be
+// issued concurrently.
+type Conn struct {
+	conn    net.Conn
+	r       *bufio.Reader
+	msgID   int64
+	timeout time.Duration
+}
+
+// Dial connects to the directory at an ldap:// or ldaps:// URL. The
+// connection of an ldaps:// URL is secured by TLS with the configuration cfg.
+func Dial(rawurl string, cfg *tls.Config, timeout time.Duration) (*Conn, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, err
+	}
+
+	host := u.Host
+	dialer := &net.Dialer{Timeout: timeout}
+	var conn net.Conn
+	switch u.Scheme {
+	case "ldap":
+		if u.Port() == "" {
+			host = net.JoinHostPort(u.Hostname(), "389")
+		}
+		conn, err = dialer.Dial("tcp", host)
+	case "ldaps":
+		if u.Port() == "" {
+			host = net.JoinHostPort(u.Hostname(), "636")
+		}
+		if cfg == nil {
+			cfg = &tls.Config{}
+		}
+		if cfg.ServerName == "" {
+			cfg = cfg.Clone()
+			cfg.ServerName = u.Hostname()
+		}
+		conn, err = tls.DialWithDialer(dialer, "tcp", host, cfg)
+	default:
+		return nil, fmt.Errorf("ldap: unsupported scheme %q", u.Scheme)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return NewConn(conn, timeout), nil
+}
+
+// NewConn uses an established connection to a directory. Every operation
+// must complete within timeout unless it is zero.
+func NewConn(conn net.Conn, timeout time.Duration) *Conn {
+	return &Conn{
+		conn:    conn,
+		r:       bufio.NewReader(conn),
+		timeout: timeout,
+	}
+}
+
+// Close unbinds and closes the connection
+func (c *Conn) Close() error {
+	_ = c.send(tlv(opUnbindRequest, nil))
+	return c.conn.Close()
+}
+
+// Bind authenticates the connection as dn by simple authentication.
+// An empty password is rejected as directories treat it as an anonymous
+// bind that always succeeds.
+func (c *Conn) Bind(dn, password string) error {
+	if password == "" {
+		return &Error{Code: ResultInvalidCredentials, Message: "empty password"}
+	}
+	id, err := c.request(seq(opBindRequest,
+		berInt(tagInteger, protocolVersion),
+		berString(tagOctetString, dn),
+		berString(classContext|0, password),
+	))
+	if err != nil {
+		return err
+	}
+
+	op, err := c.response(id)
+	if err != nil {
+		return err
+	}
+	if op.id != opBindResponse {
+		return errMalformed
+	}
+	return result(op)
+}
+
+// Search returns the entries below baseDN matching the filter. Only the named
+// attributes of the entries are returned, or all user attributes if none are.
+func (c *Conn) Search(baseDN, filter string, attributes []string) ([]Entry, error) {
+	f, err := compileFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	attrs := make([][]byte, len(attributes))
+	for i, attr := range attributes {
+		attrs[i] = berString(tagOctetString, attr)
+	}
+
+	id, err := c.request(seq(opSearchRequest,
+		berString(tagOctetString, baseDN),
+		berInt(tagEnumerated, scopeSubtree),
+		berInt(tagEnumerated, derefNever),
+		berInt(tagInteger, 0), // no size limit
+		berInt(tagInteger, 0), // no time limit
+		berBool(tagBoolean, false),
+		f,
+		seq(tagSequence, attrs...),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []Entry{}
+	for {
+		op, err := c.response(id)
+		if err != nil {
+			return nil, err
+		}
+		switch op.id {
+		case opSearchResultEntry:
+			entry, err := decodeEntry(op)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, entry)
+		case opSearchResultReference:
+			// Referrals to other directories are not followed
+		case opSearchResultDone:
+			if err := result(op); err != nil && !IsResultCode(err, ResultNoSuchObject) {
+				return nil, err
+			}
+			return entries, nil
+		default:
+			return nil, errMalformed
+		}
+	}
+}
+
+// request sends the protocol operation op in a new message and returns the
+// ID of the message
+func (c *Conn) request(op []byte) (int64, error) {
+	id := atomic.AddInt64(&c.msgID, 1)
+	return id, c.send(seq(tagSequence, berInt(tagInteger, id), op))
+}
+
+func (c *Conn) send(msg []byte) error {
+	if c.timeout > 0 {
+		if err := c.conn.SetWriteDeadline(time.Now().Add(c.timeout)); err != nil {
+			return err
+		}
+	}
+	_, err := c.conn.Write(msg)
+	return err
+}
+
+// response reads the protocol operation of the next message responding to
+// the message id
+func (c *Conn) response(id int64) (element, error) {
+	for {
+		if c.timeout > 0 {
+			if err := c.conn.SetReadDeadline(time.Now().Add(c.timeout)); err != nil {
+				return element{}, err
+			}
+		}
+		b, err := readElement(c.r)
+		if err != nil {
+			return element{}, err
+		}
+		msg, _, err := parse(b)
+		if err != nil {
+			return element{}, err
+		}
+		parts, err := msg.children()
+		if err != nil || len(parts) < 2 {
+			return element{}, errMalformed
+		}
+		msgID, err := parts[0].int()
+		if err != nil {
+			return element{}, err
+		}
+		// Unsolicited notifications have the ID zero and are ignored
+		if msgID != id {
+			continue
+		}
+		return parts[1], nil
+	}
+}
+
+// result returns the Error of an LDAPResult other than success
+func result(op element) error {
+	parts, err := op.children()
+	if err != nil || len(parts) < 3 {
+		return errMalformed
+	}
+	code, err := parts[0].int()
+	if err != nil {
+		return err
+	}
+	if code == ResultSuccess {
+		return nil
+	}
+	return &Error{Code: code, Message: string(parts[2].value)}
+}
+
+func decodeEntry(op element) (Entry, error) {
+	parts, err := op.children()
+	if err != nil || len(parts) != 2 {
+		return Entry{}, errMalformed
+	}
+	entry := Entry{
+		DN:         string(parts[0].value),
+		Attributes: map[string][]string{},
+	}
+	attrs, err := parts[1].children()
+	if err != nil {
+		return Entry{}, err
+	}
+	for _, attr := range attrs {
+		typeAndValues, err := attr.children()
+		if err != nil || len(typeAndValues) != 2 {
+			return Entry{}, errMalformed
+		}
+		values, err := typeAndValues[1].children()
+		if err != nil {
+			return Entry{}, err
+		}
+		name := string(typeAndValues[0].value)
+		for _, v := range values {
+			entry.Attributes[name] = append(entry.Attributes[name], string(v.value))
+		}
+	}
+	return entry, nil
+}
@@ -0,0 +1,233 @@
+package ldap
+
+import (
+	"bufio"
+	"net"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+// fakeServer is a directory answering simple binds and searches
+type fakeServer struct {
+	t         *testing.T
+	passwords map[string]string  // passwords of DNs
+	entries   map[string][]Entry // entries keyed by searchKey
+}
+
+func searchKey(t *testing.T, baseDN, filter string) string {
+	f, err := compileFilter(filter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return baseDN + "\x00" + string(f)
+}
+
+func ldapResult(op byte, code int64, message string) []byte {
+	return seq(op,
+		berInt(tagEnumerated, code),
+		berString(tagOctetString, ""),
+		berString(tagOctetString, message),
+	)
+}
+
+func encodeEntry(e Entry) []byte {
+	var attrs [][]byte
+	for name, values := range e.Attributes {
+		var vs [][]byte
+		for _, v := range values {
+			vs = append(vs, berString(tagOctetString, v))
+		}
+		attrs = append(attrs, seq(tagSequence, berString(tagOctetString, name), seq(tagSet, vs...)))
+	}
+	return seq(opSearchResultEntry, berString(tagOctetString, e.DN), seq(tagSequence, attrs...))
+}
+
+// listen serves the directory on a loopback address and returns its URL
+func (s *fakeServer) listen() (string, func()) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return "ldap://" + l.Addr().String(), func() { l.Close() }
+}
+
+func (s *fakeServer) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for {
+		b, err := readElement(r)
+		if err != nil {
+			return
+		}
+		msg, _, err := parse(b)
+		if err != nil {
+			return
+		}
+		parts, err := msg.children()
+		if err != nil || len(parts) != 2 {
+			return
+		}
+		id, _ := parts[0].int()
+		reply := func(op []byte) {
+			conn.Write(seq(tagSequence, berInt(tagInteger, id), op))
+		}
+
+		op := parts[1]
+		fields, _ := op.children()
+		switch op.id {
+		case opBindRequest:
+			dn, password := string(fields[1].value), string(fields[2].value)
+			if want, ok := s.passwords[dn]; !ok || want != password {
+				reply(ldapResult(opBindResponse, ResultInvalidCredentials, "invalid credentials"))
+				continue
+			}
+			reply(ldapResult(opBindResponse, ResultSuccess, ""))
+		case opSearchRequest:
+			filter := fields[6]
+			key := string(fields[0].value) + "\x00" + string(tlv(filter.id, filter.value))
+			for _, e := range s.entries[key] {
+				reply(encodeEntry(e))
+			}
+			reply(ldapResult(opSearchResultDone, ResultSuccess, ""))
+		default:
+			return
+		}
+	}
+}
+
+func TestConn_Bind(t *testing.T) {
+	s := &fakeServer{
+		t:         t,
+		passwords: map[string]string{"uid=marty,ou=people,dc=example,dc=com": "delorean"},
+	}
+	url, stop := s.listen()
+	defer stop()
+
+	conn, err := Dial(url, nil, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	if err := conn.Bind("uid=marty,ou=people,dc=example,dc=com", "delorean"); err != nil {
+		t.Errorf("Bind() error = %v", err)
+	}
+	if err := conn.Bind("uid=marty,ou=people,dc=example,dc=com", "biff"); !IsResultCode(err, ResultInvalidCredentials) {
+		t.Errorf("Bind() with a wrong password error = %v, want invalid credentials", err)
+	}
+	if err := conn.Bind("uid=marty,ou=people,dc=example,dc=com", ""); !IsResultCode(err, ResultInvalidCredentials) {
+		t.Errorf("Bind() with an empty password error = %v, want invalid credentials", err)
+	}
+}
+
+func TestDirectory_Authenticate(t *testing.T) {
+	const (
+		martyDN = "uid=marty,ou=people,dc=example,dc=com"
+		bindDN  = "cn=chronograf,dc=example,dc=com"
+	)
+	s := &fakeServer{
+		t: t,
+		passwords: map[string]string{
+			martyDN: "delorean",
+			bindDN:  "secret",
+		},
+		entries: map[string][]Entry{
+			searchKey(t, "ou=people,dc=example,dc=com", "(uid=marty)"): {{
+				DN: martyDN,
+				Attributes: map[string][]string{
+					"memberOf": {"cn=ops,ou=groups,dc=example,dc=com", `cn=time\, travel,ou=groups,dc=example,dc=com`},
+				},
+			}},
+			searchKey(t, "ou=people,dc=example,dc=com", `(uid=\2a)`): {
+				{DN: martyDN},
+				{DN: "uid=doc,ou=people,dc=example,dc=com"},
+			},
+			searchKey(t, "ou=groups,dc=example,dc=com", "(member=uid=marty,ou=people,dc=example,dc=com)"): {
+				{DN: "cn=ops,ou=groups,dc=example,dc=com", Attributes: map[string][]string{"cn": {"ops"}}},
+				{DN: "cn=dev,ou=groups,dc=example,dc=com", Attributes: map[string][]string{"CN": {"dev"}}},
+			},
+		},
+	}
+	url, stop := s.listen()
+	defer stop()
+
+	tests := []struct {
+		name        string
+		groupBaseDN string
+		username    string
+		password    string
+		want        []string
+		wantErr     error
+	}{
+		{
+			name:     "Groups of memberOf",
+			username: "marty",
+			password: "delorean",
+			want:     []string{"ops", "time, travel"},
+		},
+		{
+			name:        "Groups of a search",
+			groupBaseDN: "ou=groups,dc=example,dc=com",
+			username:    "marty",
+			password:    "delorean",
+			want:        []string{"dev", "ops"},
+		},
+		{
+			name:     "Wrong password",
+			username: "marty",
+			password: "biff",
+			wantErr:  ErrInvalidCredentials,
+		},
+		{
+			name:     "Unknown user",
+			username: "biff",
+			password: "delorean",
+			wantErr:  ErrInvalidCredentials,
+		},
+		{
+			name:     "Wildcards are escaped",
+			username: "*",
+			password: "delorean",
+			wantErr:  ErrInvalidCredentials,
+		},
+		{
+			name:     "Empty password",
+			username: "marty",
+			wantErr:  ErrInvalidCredentials,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &Directory{
+				URL:            url,
+				Timeout:        time.Second,
+				BindDN:         bindDN,
+				BindPassword:   "secret",
+				UserBaseDN:     "ou=people,dc=example,dc=com",
+				UserFilter:     "(uid=%s)",
+				GroupBaseDN:    tt.groupBaseDN,
+				GroupFilter:    "(member=%s)",
+				GroupAttribute: "cn",
+			}
+			got, err := d.Authenticate(tt.username, tt.password)
+			if err != tt.wantErr {
+				t.Fatalf("Authenticate() error = %v, want %v", err, tt.wantErr)
+			}
+			sort.Strings(got)
+			if tt.wantErr == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Authenticate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
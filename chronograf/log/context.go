@@ -0,0 +1,79 @@
+package log
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+type contextKey string
+
+// RequestIDKey is the key used to specify the correlation ID of a request
+// via context
+const RequestIDKey = contextKey("request_id")
+
+// RequestIDHeader is the header carrying the correlation ID of a request to
+// Chronograf, in its response and to the InfluxDB and Kapacitor servers it
+// proxies
+const RequestIDHeader = "X-Request-Id"
+
+// maxRequestIDLength bounds the correlation IDs accepted from clients
+const maxRequestIDLength = 128
+
+// NewRequestID generates a random correlation ID
+func NewRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
+
+// ValidRequestID is true when id is short and only has printable ASCII
+// characters, so that correlation IDs of clients are safe to log and forward
+func ValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, c := range id {
+		if c <= ' ' || c > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// WithRequestID returns a context with the correlation ID of a request
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, RequestIDKey, id)
+}
+
+// RequestID returns the correlation ID of the request of ctx
+func RequestID(ctx context.Context) (string, bool) {
+	// prevents panic in case of nil context
+	if ctx == nil {
+		return "", false
+	}
+	id, ok := ctx.Value(RequestIDKey).(string)
+	return id, ok && id != ""
+}
+
+// FromContext adds the correlation ID of the request of ctx to the entries
+// of logger
+func FromContext(ctx context.Context, logger chronograf.Logger) chronograf.Logger {
+	if id, ok := RequestID(ctx); ok {
+		return logger.WithField("request_id", id)
+	}
+	return logger
+}
+
+// InjectRequestID sets the correlation ID of the request of ctx on an
+// outgoing request
+func InjectRequestID(ctx context.Context, req *http.Request) {
+	if id, ok := RequestID(ctx); ok {
+		req.Header.Set(RequestIDHeader, id)
+	}
+}
@@ -0,0 +1,228 @@
+// Package log is a structured chronograf.Logger writing its entries as JSON
+// or logfmt, one entry per line.
+package log
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+var _ chronograf.Logger = &Logger{}
+
+// Format is the encoding of the log entries
+type Format string
+
+const (
+	// LogfmtFormat writes entries as key=value pairs
+	LogfmtFormat Format = "logfmt"
+	// JSONFormat writes entries as JSON objects
+	JSONFormat Format = "json"
+)
+
+// Level is the severity of a log entry. Entries below the level of a Logger
+// are discarded.
+type Level int
+
+const (
+	DebugLevel Level = iota
+	InfoLevel
+	ErrorLevel
+)
+
+// String is the name of the level as written in the entries
+func (l Level) String() string {
+	switch l {
+	case DebugLevel:
+		return "debug"
+	case ErrorLevel:
+		return "error"
+	default:
+		return "info"
+	}
+}
+
+// ParseLevel parses the name of a level, defaulting to the info level
+func ParseLevel(s string) Level {
+	switch strings.ToLower(s) {
+	case "debug":
+		return DebugLevel
+	case "error":
+		return ErrorLevel
+	default:
+		return InfoLevel
+	}
+}
+
+// ParseFormat parses the name of a format, defaulting to logfmt
+func ParseFormat(s string) Format {
+	if Format(strings.ToLower(s)) == JSONFormat {
+		return JSONFormat
+	}
+	return LogfmtFormat
+}
+
+type field struct {
+	key   string
+	value interface{}
+}
+
+// output serializes the writes of the loggers sharing a writer
+type output struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// Logger is a chronograf.Logger writing structured entries. Each entry has
+// the time, level and message of the entry followed by the fields of the
+// Logger in the order they were added.
+type Logger struct {
+	out    *output
+	format Format
+	level  *int32 // level is shared with the loggers of WithField
+	fields []field
+	now    func() time.Time
+}
+
+// New returns a Logger writing the entries of level and above to w
+func New(w io.Writer, format Format, level Level) *Logger {
+	l := int32(level)
+	return &Logger{
+		out:    &output{w: w},
+		format: format,
+		level:  &l,
+		now:    time.Now,
+	}
+}
+
+// SetLevel changes the level of the Logger and of the loggers returned by
+// its WithField
+func (l *Logger) SetLevel(level Level) {
+	atomic.StoreInt32(l.level, int32(level))
+}
+
+// Debug logs an entry at the debug level
+func (l *Logger) Debug(args ...interface{}) {
+	l.log(DebugLevel, args...)
+}
+
+// Info logs an entry at the info level
+func (l *Logger) Info(args ...interface{}) {
+	l.log(InfoLevel, args...)
+}
+
+// Error logs an entry at the error level
+func (l *Logger) Error(args ...interface{}) {
+	l.log(ErrorLevel, args...)
+}
+
+// WithField returns a Logger adding the field key to its entries. A field
+// with the same key replaces the previous one.
+func (l *Logger) WithField(key string, value interface{}) chronograf.Logger {
+	fields := make([]field, 0, len(l.fields)+1)
+	for _, f := range l.fields {
+		if f.key != key {
+			fields = append(fields, f)
+		}
+	}
+	next := *l
+	next.fields = append(fields, field{key, value})
+	return &next
+}
+
+// Writer returns a writer whose lines are logged at the error level. It is
+// used by the loggers of the standard library, such as the error log of
+// http.Server, and must be closed by the caller.
+func (l *Logger) Writer() *io.PipeWriter {
+	r, w := io.Pipe()
+	go func() {
+		s := bufio.NewScanner(r)
+		for s.Scan() {
+			l.Error(s.Text())
+		}
+		r.CloseWithError(s.Err())
+	}()
+	return w
+}
+
+func (l *Logger) log(level Level, args ...interface{}) {
+	if level < Level(atomic.LoadInt32(l.level)) {
+		return
+	}
+	fields := make([]field, 0, len(l.fields)+3)
+	fields = append(fields,
+		field{"ts", l.now().UTC().Format(time.RFC3339Nano)},
+		field{"lvl", level.String()},
+		field{"msg", fmt.Sprint(args...)},
+	)
+	fields = append(fields, l.fields...)
+
+	var buf bytes.Buffer
+	if l.format == JSONFormat {
+		encodeJSON(&buf, fields)
+	} else {
+		encodeLogfmt(&buf, fields)
+	}
+	buf.WriteByte('\n')
+
+	l.out.mu.Lock()
+	defer l.out.mu.Unlock()
+	l.out.w.Write(buf.Bytes())
+}
+
+// value is the value of a field, errors and types with a String method being
+// written as their text
+func value(v interface{}) interface{} {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case error:
+		return v.Error()
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return v
+	}
+}
+
+func encodeJSON(buf *bytes.Buffer, fields []field) {
+	buf.WriteByte('{')
+	for i, f := range fields {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		k, _ := json.Marshal(f.key)
+		buf.Write(k)
+		buf.WriteByte(':')
+		v, err := json.Marshal(value(f.value))
+		if err != nil {
+			v, _ = json.Marshal(fmt.Sprint(f.value))
+		}
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+}
+
+func encodeLogfmt(buf *bytes.Buffer, fields []field) {
+	for i, f := range fields {
+		if i > 0 {
+			buf.WriteByte(' ')
+		}
+		buf.WriteString(f.key)
+		buf.WriteByte('=')
+		s := fmt.Sprint(value(f.value))
+		if s == "" || strings.ContainsAny(s, " =\"\t\r\n") || !strconv.CanBackquote(s) {
+			s = strconv.Quote(s)
+		}
+		buf.WriteString(s)
+	}
+}
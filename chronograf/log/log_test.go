@@ -0,0 +1,120 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestLogger(t *testing.T) {
+	tests := []struct {
+		name   string
+		format Format
+		level  Level
+		log    func(l *Logger)
+		want   string
+	}{
+		{
+			name:   "logfmt",
+			format: LogfmtFormat,
+			level:  InfoLevel,
+			log: func(l *Logger) {
+				l.WithField("component", "server").
+					WithField("url", "/chronograf/v1/sources?id=1").
+					WithField("err", errors.New("no such source")).
+					Info("Response: ", "Not Found")
+			},
+			want: `ts=2019-08-20T12:00:00Z lvl=info msg="Response: Not Found" component=server url="/chronograf/v1/sources?id=1" err="no such source"` + "\n",
+		},
+		{
+			name:   "JSON",
+			format: JSONFormat,
+			level:  InfoLevel,
+			log: func(l *Logger) {
+				l.WithField("status", 404).
+					WithField("response_time", 1500*time.Millisecond).
+					Error("not found")
+			},
+			want: `{"ts":"2019-08-20T12:00:00Z","lvl":"error","msg":"not found","status":404,"response_time":"1.5s"}` + "\n",
+		},
+		{
+			name:   "Entries below the level are discarded",
+			format: JSONFormat,
+			level:  ErrorLevel,
+			log: func(l *Logger) {
+				l.Debug("debug")
+				l.Info("info")
+			},
+		},
+		{
+			name:   "Fields replace the fields of the same key",
+			format: LogfmtFormat,
+			level:  DebugLevel,
+			log: func(l *Logger) {
+				l.WithField("component", "server").
+					WithField("component", "proxy").
+					Debug("query")
+			},
+			want: "ts=2019-08-20T12:00:00Z lvl=debug msg=query component=proxy\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			l := New(&buf, tt.format, tt.level)
+			l.now = func() time.Time { return time.Date(2019, 8, 20, 12, 0, 0, 0, time.UTC) }
+			tt.log(l)
+			if got := buf.String(); got != tt.want {
+				t.Errorf("Logger wrote %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLogger_SetLevel(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, LogfmtFormat, ErrorLevel)
+	l.now = func() time.Time { return time.Date(2019, 8, 20, 12, 0, 0, 0, time.UTC) }
+	server := l.WithField("component", "server")
+
+	server.Debug("before")
+	l.SetLevel(DebugLevel)
+	server.Debug("after")
+	want := "ts=2019-08-20T12:00:00Z lvl=debug msg=after component=server\n"
+	if got := buf.String(); got != want {
+		t.Errorf("SetLevel() logger wrote %q, want %q", got, want)
+	}
+}
+
+func TestRequestID(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, LogfmtFormat, InfoLevel)
+	l.now = func() time.Time { return time.Date(2019, 8, 20, 12, 0, 0, 0, time.UTC) }
+
+	ctx := WithRequestID(context.Background(), "abc123")
+	FromContext(ctx, l).Info("query")
+	FromContext(context.Background(), l).Info("query")
+	want := "ts=2019-08-20T12:00:00Z lvl=info msg=query request_id=abc123\n" +
+		"ts=2019-08-20T12:00:00Z lvl=info msg=query\n"
+	if got := buf.String(); got != want {
+		t.Errorf("FromContext() wrote %q, want %q", got, want)
+	}
+
+	req := httptest.NewRequest("GET", "http://any.url", nil)
+	InjectRequestID(ctx, req)
+	if got := req.Header.Get(RequestIDHeader); got != "abc123" {
+		t.Errorf("InjectRequestID() header = %q, want %q", got, "abc123")
+	}
+
+	if id := NewRequestID(); !ValidRequestID(id) || len(id) != 32 {
+		t.Errorf("NewRequestID() = %q is not a valid request ID", id)
+	}
+	for _, id := range []string{"", "has space", "new\nline", string(make([]byte, maxRequestIDLength+1))} {
+		if ValidRequestID(id) {
+			t.Errorf("ValidRequestID(%q) = true, want false", id)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+// Package metrics collects the internal metrics of Chronograf, such as the
+// latency of its HTTP routes and of its stores, and exposes them in the
+// Prometheus format so that Chronograf itself can be monitored.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "chronograf"
+
+// Results of proxied queries and of cache lookups
+const (
+	resultSuccess = "success"
+	resultError   = "error"
+	resultHit     = "hit"
+	resultMiss    = "miss"
+	resultAllowed = "allowed"
+	resultLimited = "limited"
+)
+
+// Metrics collects the internal metrics of a Chronograf server. The methods
+// of a nil *Metrics do nothing, so that servers and tests without metrics
+// need not check for them.
+type Metrics struct {
+	registry *prometheus.Registry
+	sessions *sessions
+
+	requestDuration *prometheus.HistogramVec
+	storeDuration   *prometheus.HistogramVec
+	proxyQueries    *prometheus.CounterVec
+	cacheLookups    *prometheus.CounterVec
+	rateLimits      *prometheus.CounterVec
+}
+
+// New creates Metrics counting the sessions that made a request within
+// idleTimeout as active
+func New(idleTimeout time.Duration) *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		sessions: newSessions(idleTimeout),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "Duration of the HTTP requests by method, route and status code",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		storeDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "store",
+			Name:      "operation_duration_seconds",
+			Help:      "Duration of the operations of the stores by store and operation",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"store", "operation"}),
+		proxyQueries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "proxy",
+			Name:      "queries_total",
+			Help:      "Number of queries proxied to sources by source and result",
+		}, []string{"source", "result"}),
+		cacheLookups: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "cache",
+			Name:      "lookups_total",
+			Help:      "Number of lookups of the query and cardinality caches by cache and result",
+		}, []string{"cache", "result"}),
+		rateLimits: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "rate_limit_requests_total",
+			Help:      "Number of requests checked against the rate limits by bucket and result",
+		}, []string{"bucket", "result"}),
+	}
+	sessions := prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "active_sessions",
+		Help:      "Number of users who made a request within the idle timeout of sessions",
+	}, func() float64 {
+		return float64(m.sessions.active())
+	})
+
+	m.registry.MustRegister(
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+		m.requestDuration,
+		m.storeDuration,
+		m.proxyQueries,
+		m.cacheLookups,
+		m.rateLimits,
+		sessions,
+	)
+	return m
+}
+
+// Handler serves the metrics in the Prometheus text format
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// ObserveRequest records the duration of a request to an HTTP route, which
+// is the pattern of the route, such as /chronograf/v1/sources/:id, so that
+// requests of different resources share their metrics.
+func (m *Metrics) ObserveRequest(method, route string, status int, d time.Duration) {
+	if m == nil {
+		return
+	}
+	m.requestDuration.WithLabelValues(method, route, strconv.Itoa(status)).Observe(d.Seconds())
+}
+
+// observeStore records the duration of an operation of a store started at
+// start. It is deferred by the operations of the stores.
+func (m *Metrics) observeStore(store, operation string, start time.Time) {
+	if m == nil {
+		return
+	}
+	m.storeDuration.WithLabelValues(store, operation).Observe(time.Since(start).Seconds())
+}
+
+// ProxyQuery counts a query proxied to a source, which failed if err is not
+// nil
+func (m *Metrics) ProxyQuery(source int, err error) {
+	if m == nil {
+		return
+	}
+	result := resultSuccess
+	if err != nil {
+		result = resultError
+	}
+	m.proxyQueries.WithLabelValues(strconv.Itoa(source), result).Inc()
+}
+
+// CacheLookup counts a lookup of a cache, such as the query cache, which hit
+// when the result was cached
+func (m *Metrics) CacheLookup(cache string, hit bool) {
+	if m == nil {
+		return
+	}
+	result := resultMiss
+	if hit {
+		result = resultHit
+	}
+	m.cacheLookups.WithLabelValues(cache, result).Inc()
+}
+
+// RateLimit counts a request checked against the rate limit of a bucket,
+// such as the user bucket, which was limited when it was not allowed
+func (m *Metrics) RateLimit(bucket string, allowed bool) {
+	if m == nil {
+		return
+	}
+	result := resultLimited
+	if allowed {
+		result = resultAllowed
+	}
+	m.rateLimits.WithLabelValues(bucket, result).Inc()
+}
+
+// Session marks the session of a user, identified by id, as active
+func (m *Metrics) Session(id string) {
+	if m == nil {
+		return
+	}
+	m.sessions.see(id)
+}
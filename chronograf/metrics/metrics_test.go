@@ -0,0 +1,98 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	return c.now
+}
+
+func TestSessions(t *testing.T) {
+	c := &clock{now: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)}
+	s := newSessions(5 * time.Minute)
+	s.now = c.Now
+
+	s.see("github:alice")
+	s.see("github:bob")
+	s.see("github:alice")
+	if got := s.active(); got != 2 {
+		t.Errorf("active() = %d, want 2", got)
+	}
+
+	c.now = c.now.Add(3 * time.Minute)
+	s.see("github:bob")
+	c.now = c.now.Add(2 * time.Minute)
+	if got := s.active(); got != 1 {
+		t.Errorf("active() after the idle timeout of alice = %d, want 1", got)
+	}
+	if _, ok := s.seen["github:alice"]; ok {
+		t.Errorf("active() did not forget the expired session of alice")
+	}
+}
+
+func TestMetrics_Handler(t *testing.T) {
+	m := New(5 * time.Minute)
+	m.ObserveRequest("GET", "/chronograf/v1/sources/:id", 200, 20*time.Millisecond)
+	m.ProxyQuery(1, nil)
+	m.ProxyQuery(1, nil)
+	m.ProxyQuery(1, errors.New("timeout"))
+	m.CacheLookup("query", true)
+	m.CacheLookup("query", false)
+	m.RateLimit("ip", true)
+	m.RateLimit("ip", false)
+	m.Session("github:alice")
+
+	sources := NewSourcesStore(&mocks.SourcesStore{
+		GetF: func(ctx context.Context, id int) (chronograf.Source, error) {
+			return chronograf.Source{ID: id}, nil
+		},
+	}, m)
+	if _, err := sources.Get(context.Background(), 1); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	w := httptest.NewRecorder()
+	m.Handler().ServeHTTP(w, httptest.NewRequest("GET", "http://any.url/metrics", nil))
+	body, _ := ioutil.ReadAll(w.Result().Body)
+	want := []string{
+		`chronograf_http_request_duration_seconds_count{method="GET",route="/chronograf/v1/sources/:id",status="200"} 1`,
+		`chronograf_store_operation_duration_seconds_count{operation="get",store="sources"} 1`,
+		`chronograf_proxy_queries_total{result="success",source="1"} 2`,
+		`chronograf_proxy_queries_total{result="error",source="1"} 1`,
+		`chronograf_cache_lookups_total{cache="query",result="hit"} 1`,
+		`chronograf_cache_lookups_total{cache="query",result="miss"} 1`,
+		`chronograf_http_rate_limit_requests_total{bucket="ip",result="allowed"} 1`,
+		`chronograf_http_rate_limit_requests_total{bucket="ip",result="limited"} 1`,
+		`chronograf_active_sessions 1`,
+		`go_goroutines`,
+	}
+	for _, line := range want {
+		if !strings.Contains(string(body), line) {
+			t.Errorf("Handler() is missing %s in:\n%s", line, body)
+		}
+	}
+}
+
+func TestMetrics_Nil(t *testing.T) {
+	var m *Metrics
+	m.ObserveRequest("GET", "/", 200, time.Second)
+	m.ProxyQuery(1, nil)
+	m.CacheLookup("query", true)
+	m.RateLimit("user", false)
+	m.Session("github:alice")
+	m.observeStore("sources", "get", time.Now())
+}
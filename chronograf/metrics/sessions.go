@@ -0,0 +1,46 @@
+package metrics
+
+import (
+	"sync"
+	"time"
+)
+
+// sessions remembers when each user last made a request. Sessions are
+// stateless tokens, so a session is active while its user makes requests
+// within the idle timeout that would otherwise expire it.
+type sessions struct {
+	timeout time.Duration
+	now     func() time.Time
+
+	mu   sync.Mutex
+	seen map[string]time.Time
+}
+
+func newSessions(timeout time.Duration) *sessions {
+	return &sessions{
+		timeout: timeout,
+		now:     time.Now,
+		seen:    map[string]time.Time{},
+	}
+}
+
+// see marks the session of id as active
+func (s *sessions) see(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.seen[id] = s.now()
+}
+
+// active counts the active sessions and forgets the expired ones
+func (s *sessions) active() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for id, t := range s.seen {
+		if now.Sub(t) >= s.timeout {
+			delete(s.seen, id)
+		}
+	}
+	return len(s.seen)
+}
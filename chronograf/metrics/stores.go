@@ -0,0 +1,267 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Ensure the stores implement their chronograf interfaces.
+var (
+	_ chronograf.LayoutsStore    = &LayoutsStore{}
+	_ chronograf.DashboardsStore = &DashboardsStore{}
+	_ chronograf.SourcesStore    = &SourcesStore{}
+	_ chronograf.ServersStore    = &ServersStore{}
+	_ chronograf.UsersStore      = &UsersStore{}
+)
+
+// LayoutsStore facade on a LayoutsStore that times its operations
+type LayoutsStore struct {
+	store   chronograf.LayoutsStore
+	metrics *Metrics
+}
+
+// NewLayoutsStore creates a new LayoutsStore from an existing
+// chronograf.LayoutsStore recording the duration of its operations in m
+func NewLayoutsStore(s chronograf.LayoutsStore, m *Metrics) *LayoutsStore {
+	return &LayoutsStore{
+		store:   s,
+		metrics: m,
+	}
+}
+
+// All returns all layouts of the underlying LayoutsStore
+func (s *LayoutsStore) All(ctx context.Context) ([]chronograf.Layout, error) {
+	defer s.metrics.observeStore("layouts", "all", time.Now())
+	return s.store.All(ctx)
+}
+
+// Add creates a layout in the underlying LayoutsStore
+func (s *LayoutsStore) Add(ctx context.Context, l chronograf.Layout) (chronograf.Layout, error) {
+	defer s.metrics.observeStore("layouts", "add", time.Now())
+	return s.store.Add(ctx, l)
+}
+
+// Delete removes a layout from the underlying LayoutsStore
+func (s *LayoutsStore) Delete(ctx context.Context, l chronograf.Layout) error {
+	defer s.metrics.observeStore("layouts", "delete", time.Now())
+	return s.store.Delete(ctx, l)
+}
+
+// Get returns a layout of the underlying LayoutsStore
+func (s *LayoutsStore) Get(ctx context.Context, id string) (chronograf.Layout, error) {
+	defer s.metrics.observeStore("layouts", "get", time.Now())
+	return s.store.Get(ctx, id)
+}
+
+// Update updates a layout in the underlying LayoutsStore
+func (s *LayoutsStore) Update(ctx context.Context, l chronograf.Layout) error {
+	defer s.metrics.observeStore("layouts", "update", time.Now())
+	return s.store.Update(ctx, l)
+}
+
+// DashboardsStore facade on a DashboardsStore that times its operations
+type DashboardsStore struct {
+	store   chronograf.DashboardsStore
+	metrics *Metrics
+}
+
+// NewDashboardsStore creates a new DashboardsStore from an existing
+// chronograf.DashboardsStore recording the duration of its operations in m
+func NewDashboardsStore(s chronograf.DashboardsStore, m *Metrics) *DashboardsStore {
+	return &DashboardsStore{
+		store:   s,
+		metrics: m,
+	}
+}
+
+// All returns all dashboards of the underlying DashboardsStore
+func (s *DashboardsStore) All(ctx context.Context) ([]chronograf.Dashboard, error) {
+	defer s.metrics.observeStore("dashboards", "all", time.Now())
+	return s.store.All(ctx)
+}
+
+// Add creates a dashboard in the underlying DashboardsStore
+func (s *DashboardsStore) Add(ctx context.Context, d chronograf.Dashboard) (chronograf.Dashboard, error) {
+	defer s.metrics.observeStore("dashboards", "add", time.Now())
+	return s.store.Add(ctx, d)
+}
+
+// Delete removes a dashboard from the underlying DashboardsStore
+func (s *DashboardsStore) Delete(ctx context.Context, d chronograf.Dashboard) error {
+	defer s.metrics.observeStore("dashboards", "delete", time.Now())
+	return s.store.Delete(ctx, d)
+}
+
+// Get returns a dashboard of the underlying DashboardsStore
+func (s *DashboardsStore) Get(ctx context.Context, id chronograf.DashboardID) (chronograf.Dashboard, error) {
+	defer s.metrics.observeStore("dashboards", "get", time.Now())
+	return s.store.Get(ctx, id)
+}
+
+// Update updates a dashboard in the underlying DashboardsStore
+func (s *DashboardsStore) Update(ctx context.Context, d chronograf.Dashboard) error {
+	defer s.metrics.observeStore("dashboards", "update", time.Now())
+	return s.store.Update(ctx, d)
+}
+
+// SourcesStore facade on a SourcesStore that times its operations
+type SourcesStore struct {
+	store   chronograf.SourcesStore
+	metrics *Metrics
+}
+
+// NewSourcesStore creates a new SourcesStore from an existing
+// chronograf.SourcesStore recording the duration of its operations in m
+func NewSourcesStore(s chronograf.SourcesStore, m *Metrics) *SourcesStore {
+	return &SourcesStore{
+		store:   s,
+		metrics: m,
+	}
+}
+
+// All returns all sources of the underlying SourcesStore
+func (s *SourcesStore) All(ctx context.Context) ([]chronograf.Source, error) {
+	defer s.metrics.observeStore("sources", "all", time.Now())
+	return s.store.All(ctx)
+}
+
+// Add creates a source in the underlying SourcesStore
+func (s *SourcesStore) Add(ctx context.Context, src chronograf.Source) (chronograf.Source, error) {
+	defer s.metrics.observeStore("sources", "add", time.Now())
+	return s.store.Add(ctx, src)
+}
+
+// Delete removes a source from the underlying SourcesStore
+func (s *SourcesStore) Delete(ctx context.Context, src chronograf.Source) error {
+	defer s.metrics.observeStore("sources", "delete", time.Now())
+	return s.store.Delete(ctx, src)
+}
+
+// Get returns a source of the underlying SourcesStore
+func (s *SourcesStore) Get(ctx context.Context, id int) (chronograf.Source, error) {
+	defer s.metrics.observeStore("sources", "get", time.Now())
+	return s.store.Get(ctx, id)
+}
+
+// Update updates a source in the underlying SourcesStore
+func (s *SourcesStore) Update(ctx context.Context, src chronograf.Source) error {
+	defer s.metrics.observeStore("sources", "update", time.Now())
+	return s.store.Update(ctx, src)
+}
+
+// ServersStore facade on a ServersStore that times its operations
+type ServersStore struct {
+	store   chronograf.ServersStore
+	metrics *Metrics
+}
+
+// NewServersStore creates a new ServersStore from an existing
+// chronograf.ServersStore recording the duration of its operations in m
+func NewServersStore(s chronograf.ServersStore, m *Metrics) *ServersStore {
+	return &ServersStore{
+		store:   s,
+		metrics: m,
+	}
+}
+
+// All returns all servers of the underlying ServersStore
+func (s *ServersStore) All(ctx context.Context) ([]chronograf.Server, error) {
+	defer s.metrics.observeStore("servers", "all", time.Now())
+	return s.store.All(ctx)
+}
+
+// Add creates a server in the underlying ServersStore
+func (s *ServersStore) Add(ctx context.Context, srv chronograf.Server) (chronograf.Server, error) {
+	defer s.metrics.observeStore("servers", "add", time.Now())
+	return s.store.Add(ctx, srv)
+}
+
+// Delete removes a server from the underlying ServersStore
+func (s *ServersStore) Delete(ctx context.Context, srv chronograf.Server) error {
+	defer s.metrics.observeStore("servers", "delete", time.Now())
+	return s.store.Delete(ctx, srv)
+}
+
+// Get returns a server of the underlying ServersStore
+func (s *ServersStore) Get(ctx context.Context, id int) (chronograf.Server, error) {
+	defer s.metrics.observeStore("servers", "get", time.Now())
+	return s.store.Get(ctx, id)
+}
+
+// Update updates a server in the underlying ServersStore
+func (s *ServersStore) Update(ctx context.Context, srv chronograf.Server) error {
+	defer s.metrics.observeStore("servers", "update", time.Now())
+	return s.store.Update(ctx, srv)
+}
+
+// UsersStore facade on a UsersStore that times its operations
+type UsersStore struct {
+	store   chronograf.UsersStore
+	metrics *Metrics
+}
+
+// NewUsersStore creates a new UsersStore from an existing
+// chronograf.UsersStore recording the duration of its operations in m
+func NewUsersStore(s chronograf.UsersStore, m *Metrics) *UsersStore {
+	return &UsersStore{
+		store:   s,
+		metrics: m,
+	}
+}
+
+// All returns all users of the underlying UsersStore
+func (s *UsersStore) All(ctx context.Context) ([]chronograf.User, error) {
+	defer s.metrics.observeStore("users", "all", time.Now())
+	return s.store.All(ctx)
+}
+
+// Add creates a user in the underlying UsersStore
+func (s *UsersStore) Add(ctx context.Context, u *chronograf.User) (*chronograf.User, error) {
+	defer s.metrics.observeStore("users", "add", time.Now())
+	return s.store.Add(ctx, u)
+}
+
+// AddMany creates either all of the users in the underlying UsersStore or
+// none of them
+func (s *UsersStore) AddMany(ctx context.Context, users []*chronograf.User) ([]*chronograf.User, error) {
+	defer s.metrics.observeStore("users", "add_many", time.Now())
+	return s.store.AddMany(ctx, users)
+}
+
+// Delete removes a user from the underlying UsersStore
+func (s *UsersStore) Delete(ctx context.Context, u *chronograf.User) error {
+	defer s.metrics.observeStore("users", "delete", time.Now())
+	return s.store.Delete(ctx, u)
+}
+
+// Get returns a user of the underlying UsersStore
+func (s *UsersStore) Get(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+	defer s.metrics.observeStore("users", "get", time.Now())
+	return s.store.Get(ctx, q)
+}
+
+// Update updates a user in the underlying UsersStore
+func (s *UsersStore) Update(ctx context.Context, u *chronograf.User) error {
+	defer s.metrics.observeStore("users", "update", time.Now())
+	return s.store.Update(ctx, u)
+}
+
+// UpdateMany updates users in the underlying UsersStore
+func (s *UsersStore) UpdateMany(ctx context.Context, users []*chronograf.User) error {
+	defer s.metrics.observeStore("users", "update_many", time.Now())
+	return s.store.UpdateMany(ctx, users)
+}
+
+// Num returns the number of users of the underlying UsersStore
+func (s *UsersStore) Num(ctx context.Context) (int, error) {
+	defer s.metrics.observeStore("users", "num", time.Now())
+	return s.store.Num(ctx)
+}
+
+// Filter lists the users of the underlying UsersStore matching the filter
+func (s *UsersStore) Filter(ctx context.Context, f chronograf.UserFilter) ([]chronograf.User, error) {
+	defer s.metrics.observeStore("users", "filter", time.Now())
+	return s.store.Filter(ctx, f)
+}
@@ -0,0 +1,43 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+var _ chronograf.AlertChecksStore = &AlertChecksStore{}
+
+// AlertChecksStore mock allows all functions to be set for testing
+type AlertChecksStore struct {
+	AllF    func(ctx context.Context, sourceID int) ([]chronograf.AlertCheck, error)
+	AddF    func(context.Context, *chronograf.AlertCheck) (*chronograf.AlertCheck, error)
+	GetF    func(ctx context.Context, sourceID int, id uint64) (*chronograf.AlertCheck, error)
+	UpdateF func(context.Context, *chronograf.AlertCheck) error
+	DeleteF func(context.Context, *chronograf.AlertCheck) error
+}
+
+// All lists the checks of a source
+func (s *AlertChecksStore) All(ctx context.Context, sourceID int) ([]chronograf.AlertCheck, error) {
+	return s.AllF(ctx, sourceID)
+}
+
+// Add creates a new check of a source
+func (s *AlertChecksStore) Add(ctx context.Context, c *chronograf.AlertCheck) (*chronograf.AlertCheck, error) {
+	return s.AddF(ctx, c)
+}
+
+// Get retrieves a check of a source
+func (s *AlertChecksStore) Get(ctx context.Context, sourceID int, id uint64) (*chronograf.AlertCheck, error) {
+	return s.GetF(ctx, sourceID, id)
+}
+
+// Update replaces a check
+func (s *AlertChecksStore) Update(ctx context.Context, c *chronograf.AlertCheck) error {
+	return s.UpdateF(ctx, c)
+}
+
+// Delete removes a check
+func (s *AlertChecksStore) Delete(ctx context.Context, c *chronograf.AlertCheck) error {
+	return s.DeleteF(ctx, c)
+}
@@ -0,0 +1,43 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+var _ chronograf.AlertEventsStore = &AlertEventsStore{}
+
+// AlertEventsStore mock allows all functions to be set for testing
+type AlertEventsStore struct {
+	AllF    func(ctx context.Context, sourceID int) ([]chronograf.AlertEvent, error)
+	AddF    func(context.Context, *chronograf.AlertEvent) error
+	GetF    func(ctx context.Context, sourceID int, id uint64) (*chronograf.AlertEvent, error)
+	UpdateF func(context.Context, *chronograf.AlertEvent) error
+	DeleteF func(ctx context.Context, sourceID int) error
+}
+
+// All lists the alert events of a source
+func (s *AlertEventsStore) All(ctx context.Context, sourceID int) ([]chronograf.AlertEvent, error) {
+	return s.AllF(ctx, sourceID)
+}
+
+// Add records an alert event
+func (s *AlertEventsStore) Add(ctx context.Context, e *chronograf.AlertEvent) error {
+	return s.AddF(ctx, e)
+}
+
+// Get retrieves an alert event of a source
+func (s *AlertEventsStore) Get(ctx context.Context, sourceID int, id uint64) (*chronograf.AlertEvent, error) {
+	return s.GetF(ctx, sourceID, id)
+}
+
+// Update replaces an alert event
+func (s *AlertEventsStore) Update(ctx context.Context, e *chronograf.AlertEvent) error {
+	return s.UpdateF(ctx, e)
+}
+
+// Delete removes the alert events of a source
+func (s *AlertEventsStore) Delete(ctx context.Context, sourceID int) error {
+	return s.DeleteF(ctx, sourceID)
+}
@@ -0,0 +1,43 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+var _ chronograf.AlertPoliciesStore = &AlertPoliciesStore{}
+
+// AlertPoliciesStore mock allows all functions to be set for testing
+type AlertPoliciesStore struct {
+	AllF    func(ctx context.Context, sourceID int) ([]chronograf.AlertPolicy, error)
+	AddF    func(context.Context, *chronograf.AlertPolicy) (*chronograf.AlertPolicy, error)
+	GetF    func(ctx context.Context, sourceID int, id uint64) (*chronograf.AlertPolicy, error)
+	UpdateF func(context.Context, *chronograf.AlertPolicy) error
+	DeleteF func(context.Context, *chronograf.AlertPolicy) error
+}
+
+// All lists the policies of a source
+func (s *AlertPoliciesStore) All(ctx context.Context, sourceID int) ([]chronograf.AlertPolicy, error) {
+	return s.AllF(ctx, sourceID)
+}
+
+// Add creates a new policy of a source
+func (s *AlertPoliciesStore) Add(ctx context.Context, p *chronograf.AlertPolicy) (*chronograf.AlertPolicy, error) {
+	return s.AddF(ctx, p)
+}
+
+// Get retrieves a policy of a source
+func (s *AlertPoliciesStore) Get(ctx context.Context, sourceID int, id uint64) (*chronograf.AlertPolicy, error) {
+	return s.GetF(ctx, sourceID, id)
+}
+
+// Update replaces a policy
+func (s *AlertPoliciesStore) Update(ctx context.Context, p *chronograf.AlertPolicy) error {
+	return s.UpdateF(ctx, p)
+}
+
+// Delete removes a policy
+func (s *AlertPoliciesStore) Delete(ctx context.Context, p *chronograf.AlertPolicy) error {
+	return s.DeleteF(ctx, p)
+}
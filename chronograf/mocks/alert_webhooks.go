@@ -0,0 +1,43 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+var _ chronograf.AlertWebhooksStore = &AlertWebhooksStore{}
+
+// AlertWebhooksStore mock allows all functions to be set for testing
+type AlertWebhooksStore struct {
+	AllF    func(ctx context.Context, sourceID int) ([]chronograf.AlertWebhook, error)
+	AddF    func(context.Context, *chronograf.AlertWebhook) (*chronograf.AlertWebhook, error)
+	GetF    func(ctx context.Context, sourceID int, id uint64) (*chronograf.AlertWebhook, error)
+	UpdateF func(context.Context, *chronograf.AlertWebhook) error
+	DeleteF func(context.Context, *chronograf.AlertWebhook) error
+}
+
+// All lists the webhooks of a source
+func (s *AlertWebhooksStore) All(ctx context.Context, sourceID int) ([]chronograf.AlertWebhook, error) {
+	return s.AllF(ctx, sourceID)
+}
+
+// Add creates a new webhook of a source
+func (s *AlertWebhooksStore) Add(ctx context.Context, h *chronograf.AlertWebhook) (*chronograf.AlertWebhook, error) {
+	return s.AddF(ctx, h)
+}
+
+// Get retrieves a webhook of a source
+func (s *AlertWebhooksStore) Get(ctx context.Context, sourceID int, id uint64) (*chronograf.AlertWebhook, error) {
+	return s.GetF(ctx, sourceID, id)
+}
+
+// Update replaces a webhook
+func (s *AlertWebhooksStore) Update(ctx context.Context, h *chronograf.AlertWebhook) error {
+	return s.UpdateF(ctx, h)
+}
+
+// Delete removes a webhook
+func (s *AlertWebhooksStore) Delete(ctx context.Context, h *chronograf.AlertWebhook) error {
+	return s.DeleteF(ctx, h)
+}
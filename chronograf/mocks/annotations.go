@@ -0,0 +1,44 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+var _ chronograf.AnnotationStore = &AnnotationsStore{}
+
+// AnnotationsStore mock allows all functions to be set for testing
+type AnnotationsStore struct {
+	AllF    func(ctx context.Context, start, stop time.Time) ([]chronograf.Annotation, error)
+	AddF    func(context.Context, *chronograf.Annotation) (*chronograf.Annotation, error)
+	DeleteF func(ctx context.Context, id string) error
+	GetF    func(ctx context.Context, id string) (*chronograf.Annotation, error)
+	UpdateF func(context.Context, *chronograf.Annotation) error
+}
+
+// All lists the annotations between start and stop
+func (s *AnnotationsStore) All(ctx context.Context, start, stop time.Time) ([]chronograf.Annotation, error) {
+	return s.AllF(ctx, start, stop)
+}
+
+// Add creates a new annotation
+func (s *AnnotationsStore) Add(ctx context.Context, a *chronograf.Annotation) (*chronograf.Annotation, error) {
+	return s.AddF(ctx, a)
+}
+
+// Delete removes an annotation
+func (s *AnnotationsStore) Delete(ctx context.Context, id string) error {
+	return s.DeleteF(ctx, id)
+}
+
+// Get retrieves an annotation by ID
+func (s *AnnotationsStore) Get(ctx context.Context, id string) (*chronograf.Annotation, error) {
+	return s.GetF(ctx, id)
+}
+
+// Update replaces an annotation
+func (s *AnnotationsStore) Update(ctx context.Context, a *chronograf.Annotation) error {
+	return s.UpdateF(ctx, a)
+}
@@ -0,0 +1,43 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+var _ chronograf.CertificatesStore = &CertificatesStore{}
+
+// CertificatesStore mock allows all functions to be set for testing
+type CertificatesStore struct {
+	AddF    func(context.Context, *chronograf.CertificateMapping) (*chronograf.CertificateMapping, error)
+	AllF    func(context.Context) ([]chronograf.CertificateMapping, error)
+	DeleteF func(context.Context, *chronograf.CertificateMapping) error
+	GetF    func(context.Context, string) (*chronograf.CertificateMapping, error)
+	UpdateF func(context.Context, *chronograf.CertificateMapping) error
+}
+
+// Add creates a new certificate mapping
+func (s *CertificatesStore) Add(ctx context.Context, m *chronograf.CertificateMapping) (*chronograf.CertificateMapping, error) {
+	return s.AddF(ctx, m)
+}
+
+// All lists all certificate mappings
+func (s *CertificatesStore) All(ctx context.Context) ([]chronograf.CertificateMapping, error) {
+	return s.AllF(ctx)
+}
+
+// Delete the certificate mapping
+func (s *CertificatesStore) Delete(ctx context.Context, m *chronograf.CertificateMapping) error {
+	return s.DeleteF(ctx, m)
+}
+
+// Get retrieves a certificate mapping by ID
+func (s *CertificatesStore) Get(ctx context.Context, id string) (*chronograf.CertificateMapping, error) {
+	return s.GetF(ctx, id)
+}
+
+// Update replaces the certificate mapping
+func (s *CertificatesStore) Update(ctx context.Context, m *chronograf.CertificateMapping) error {
+	return s.UpdateF(ctx, m)
+}
@@ -0,0 +1,43 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+var _ chronograf.DashboardSnapshotsStore = &DashboardSnapshotsStore{}
+
+// DashboardSnapshotsStore mock allows all functions to be set for testing
+type DashboardSnapshotsStore struct {
+	AllF       func(ctx context.Context, id chronograf.DashboardID) ([]chronograf.DashboardSnapshot, error)
+	AddF       func(context.Context, *chronograf.DashboardSnapshot) (*chronograf.DashboardSnapshot, error)
+	DeleteF    func(context.Context, *chronograf.DashboardSnapshot) error
+	DeleteAllF func(ctx context.Context, id chronograf.DashboardID) error
+	GetF       func(ctx context.Context, id chronograf.DashboardID, snapshot int) (*chronograf.DashboardSnapshot, error)
+}
+
+// All lists the snapshots of a dashboard
+func (s *DashboardSnapshotsStore) All(ctx context.Context, id chronograf.DashboardID) ([]chronograf.DashboardSnapshot, error) {
+	return s.AllF(ctx, id)
+}
+
+// Add stores a new snapshot of a dashboard
+func (s *DashboardSnapshotsStore) Add(ctx context.Context, snap *chronograf.DashboardSnapshot) (*chronograf.DashboardSnapshot, error) {
+	return s.AddF(ctx, snap)
+}
+
+// Delete removes a snapshot of a dashboard
+func (s *DashboardSnapshotsStore) Delete(ctx context.Context, snap *chronograf.DashboardSnapshot) error {
+	return s.DeleteF(ctx, snap)
+}
+
+// DeleteAll removes all snapshots of a dashboard
+func (s *DashboardSnapshotsStore) DeleteAll(ctx context.Context, id chronograf.DashboardID) error {
+	return s.DeleteAllF(ctx, id)
+}
+
+// Get retrieves a snapshot of a dashboard
+func (s *DashboardSnapshotsStore) Get(ctx context.Context, id chronograf.DashboardID, snapshot int) (*chronograf.DashboardSnapshot, error) {
+	return s.GetF(ctx, id, snapshot)
+}
@@ -0,0 +1,37 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+var _ chronograf.DashboardVersionsStore = &DashboardVersionsStore{}
+
+// DashboardVersionsStore mock allows all functions to be set for testing
+type DashboardVersionsStore struct {
+	AllF    func(ctx context.Context, id chronograf.DashboardID) ([]chronograf.DashboardVersion, error)
+	AddF    func(context.Context, *chronograf.DashboardVersion) (*chronograf.DashboardVersion, error)
+	DeleteF func(ctx context.Context, id chronograf.DashboardID) error
+	GetF    func(ctx context.Context, id chronograf.DashboardID, revision int) (*chronograf.DashboardVersion, error)
+}
+
+// All lists the revisions of a dashboard
+func (s *DashboardVersionsStore) All(ctx context.Context, id chronograf.DashboardID) ([]chronograf.DashboardVersion, error) {
+	return s.AllF(ctx, id)
+}
+
+// Add records the next revision of a dashboard
+func (s *DashboardVersionsStore) Add(ctx context.Context, v *chronograf.DashboardVersion) (*chronograf.DashboardVersion, error) {
+	return s.AddF(ctx, v)
+}
+
+// Delete removes all revisions of a dashboard
+func (s *DashboardVersionsStore) Delete(ctx context.Context, id chronograf.DashboardID) error {
+	return s.DeleteF(ctx, id)
+}
+
+// Get retrieves a revision of a dashboard
+func (s *DashboardVersionsStore) Get(ctx context.Context, id chronograf.DashboardID, revision int) (*chronograf.DashboardVersion, error) {
+	return s.GetF(ctx, id, revision)
+}
@@ -20,7 +20,20 @@ type Databases struct {
 	UpdateRPF func(context.Context, string, string, *chronograf.RetentionPolicy) (*chronograf.RetentionPolicy, error)
 	DropRPF   func(context.Context, string, string) error
 
+	AllShardsF func(context.Context, string) ([]chronograf.Shard, error)
+
+	AllCQF    func(context.Context, string) ([]chronograf.ContinuousQuery, error)
+	CreateCQF func(context.Context, string, *chronograf.ContinuousQuery) (*chronograf.ContinuousQuery, error)
+	DropCQF   func(context.Context, string, string) error
+
+	AllQueriesF func(context.Context) ([]chronograf.RunningQuery, error)
+	KillQueryF  func(context.Context, *chronograf.RunningQuery) error
+
 	GetMeasurementsF func(ctx context.Context, db string, limit, offset int) ([]chronograf.Measurement, error)
+
+	SeriesCardinalityF       func(context.Context, string) (int64, error)
+	MeasurementsCardinalityF func(context.Context, string) ([]chronograf.Cardinality, error)
+	TagKeysCardinalityF      func(context.Context, string, string) ([]chronograf.Cardinality, error)
 }
 
 // AllDB lists all databases in the current data source
@@ -63,7 +76,52 @@ func (d *Databases) DropRP(ctx context.Context, rpX string, rpY string) error {
 	return d.DropRPF(ctx, rpX, rpY)
 }
 
+// AllShards lists the shards of a database in the current data source
+func (d *Databases) AllShards(ctx context.Context, db string) ([]chronograf.Shard, error) {
+	return d.AllShardsF(ctx, db)
+}
+
+// AllCQ lists all continuous queries of a database in the current data source
+func (d *Databases) AllCQ(ctx context.Context, db string) ([]chronograf.ContinuousQuery, error) {
+	return d.AllCQF(ctx, db)
+}
+
+// CreateCQ creates a continuous query in the current data source
+func (d *Databases) CreateCQ(ctx context.Context, db string, cq *chronograf.ContinuousQuery) (*chronograf.ContinuousQuery, error) {
+	return d.CreateCQF(ctx, db, cq)
+}
+
+// DropCQ drops a continuous query in the current data source
+func (d *Databases) DropCQ(ctx context.Context, db string, name string) error {
+	return d.DropCQF(ctx, db, name)
+}
+
+// AllQueries lists the queries running in the current data source
+func (d *Databases) AllQueries(ctx context.Context) ([]chronograf.RunningQuery, error) {
+	return d.AllQueriesF(ctx)
+}
+
+// KillQuery stops a running query of the current data source
+func (d *Databases) KillQuery(ctx context.Context, q *chronograf.RunningQuery) error {
+	return d.KillQueryF(ctx, q)
+}
+
 // GetMeasurements lists measurements in the current data source
 func (d *Databases) GetMeasurements(ctx context.Context, db string, limit, offset int) ([]chronograf.Measurement, error) {
 	return d.GetMeasurementsF(ctx, db, limit, offset)
 }
+
+// SeriesCardinality estimates the number of series of a database in the current data source
+func (d *Databases) SeriesCardinality(ctx context.Context, db string) (int64, error) {
+	return d.SeriesCardinalityF(ctx, db)
+}
+
+// MeasurementsCardinality counts the series of every measurement of a database in the current data source
+func (d *Databases) MeasurementsCardinality(ctx context.Context, db string) ([]chronograf.Cardinality, error) {
+	return d.MeasurementsCardinalityF(ctx, db)
+}
+
+// TagKeysCardinality counts the values of every tag key of a measurement in the current data source
+func (d *Databases) TagKeysCardinality(ctx context.Context, db, measurement string) ([]chronograf.Cardinality, error) {
+	return d.TagKeysCardinalityF(ctx, db, measurement)
+}
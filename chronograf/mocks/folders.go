@@ -0,0 +1,43 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+var _ chronograf.FoldersStore = &FoldersStore{}
+
+// FoldersStore mock allows all functions to be set for testing
+type FoldersStore struct {
+	AllF    func(context.Context) ([]chronograf.Folder, error)
+	AddF    func(context.Context, *chronograf.Folder) (*chronograf.Folder, error)
+	DeleteF func(context.Context, *chronograf.Folder) error
+	GetF    func(ctx context.Context, id string) (*chronograf.Folder, error)
+	UpdateF func(context.Context, *chronograf.Folder) error
+}
+
+// All lists all folders
+func (s *FoldersStore) All(ctx context.Context) ([]chronograf.Folder, error) {
+	return s.AllF(ctx)
+}
+
+// Add creates a new folder
+func (s *FoldersStore) Add(ctx context.Context, f *chronograf.Folder) (*chronograf.Folder, error) {
+	return s.AddF(ctx, f)
+}
+
+// Delete removes a folder
+func (s *FoldersStore) Delete(ctx context.Context, f *chronograf.Folder) error {
+	return s.DeleteF(ctx, f)
+}
+
+// Get retrieves a folder by ID
+func (s *FoldersStore) Get(ctx context.Context, id string) (*chronograf.Folder, error) {
+	return s.GetF(ctx, id)
+}
+
+// Update replaces a folder
+func (s *FoldersStore) Update(ctx context.Context, f *chronograf.Folder) error {
+	return s.UpdateF(ctx, f)
+}
@@ -0,0 +1,43 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+var _ chronograf.GroupsStore = &GroupsStore{}
+
+// GroupsStore mock allows all functions to be set for testing
+type GroupsStore struct {
+	AddF    func(context.Context, *chronograf.Group) (*chronograf.Group, error)
+	AllF    func(context.Context) ([]chronograf.Group, error)
+	DeleteF func(context.Context, *chronograf.Group) error
+	GetF    func(context.Context, string) (*chronograf.Group, error)
+	UpdateF func(context.Context, *chronograf.Group) error
+}
+
+// Add creates a new group
+func (s *GroupsStore) Add(ctx context.Context, g *chronograf.Group) (*chronograf.Group, error) {
+	return s.AddF(ctx, g)
+}
+
+// All lists all groups
+func (s *GroupsStore) All(ctx context.Context) ([]chronograf.Group, error) {
+	return s.AllF(ctx)
+}
+
+// Delete the group
+func (s *GroupsStore) Delete(ctx context.Context, g *chronograf.Group) error {
+	return s.DeleteF(ctx, g)
+}
+
+// Get retrieves a group by ID
+func (s *GroupsStore) Get(ctx context.Context, id string) (*chronograf.Group, error) {
+	return s.GetF(ctx, id)
+}
+
+// Update replaces the group
+func (s *GroupsStore) Update(ctx context.Context, g *chronograf.Group) error {
+	return s.UpdateF(ctx, g)
+}
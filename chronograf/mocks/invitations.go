@@ -0,0 +1,37 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+var _ chronograf.InvitationsStore = &InvitationsStore{}
+
+// InvitationsStore mock allows all functions to be set for testing
+type InvitationsStore struct {
+	AllF    func(context.Context) ([]chronograf.Invitation, error)
+	AddF    func(context.Context, *chronograf.Invitation) (*chronograf.Invitation, error)
+	DeleteF func(context.Context, *chronograf.Invitation) error
+	GetF    func(ctx context.Context, id string) (*chronograf.Invitation, error)
+}
+
+// All lists all invitations
+func (s *InvitationsStore) All(ctx context.Context) ([]chronograf.Invitation, error) {
+	return s.AllF(ctx)
+}
+
+// Add creates a new invitation
+func (s *InvitationsStore) Add(ctx context.Context, i *chronograf.Invitation) (*chronograf.Invitation, error) {
+	return s.AddF(ctx, i)
+}
+
+// Delete the invitation
+func (s *InvitationsStore) Delete(ctx context.Context, i *chronograf.Invitation) error {
+	return s.DeleteF(ctx, i)
+}
+
+// Get retrieves an invitation by ID
+func (s *InvitationsStore) Get(ctx context.Context, id string) (*chronograf.Invitation, error) {
+	return s.GetF(ctx, id)
+}
@@ -0,0 +1,43 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+var _ chronograf.NotificationsStore = &NotificationsStore{}
+
+// NotificationsStore mock allows all functions to be set for testing
+type NotificationsStore struct {
+	AllF    func(ctx context.Context, userID uint64) ([]chronograf.Notification, error)
+	AddF    func(context.Context, *chronograf.Notification) (*chronograf.Notification, error)
+	DeleteF func(context.Context, *chronograf.Notification) error
+	GetF    func(ctx context.Context, id string) (*chronograf.Notification, error)
+	UpdateF func(context.Context, *chronograf.Notification) error
+}
+
+// All lists the notifications of a user
+func (s *NotificationsStore) All(ctx context.Context, userID uint64) ([]chronograf.Notification, error) {
+	return s.AllF(ctx, userID)
+}
+
+// Add creates a new notification
+func (s *NotificationsStore) Add(ctx context.Context, n *chronograf.Notification) (*chronograf.Notification, error) {
+	return s.AddF(ctx, n)
+}
+
+// Delete the notification
+func (s *NotificationsStore) Delete(ctx context.Context, n *chronograf.Notification) error {
+	return s.DeleteF(ctx, n)
+}
+
+// Get retrieves a notification by ID
+func (s *NotificationsStore) Get(ctx context.Context, id string) (*chronograf.Notification, error) {
+	return s.GetF(ctx, id)
+}
+
+// Update replaces the notification
+func (s *NotificationsStore) Update(ctx context.Context, n *chronograf.Notification) error {
+	return s.UpdateF(ctx, n)
+}
@@ -0,0 +1,25 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+var _ chronograf.PreferencesStore = &PreferencesStore{}
+
+// PreferencesStore mock allows all functions to be set for testing
+type PreferencesStore struct {
+	GetF func(ctx context.Context, userID uint64) (*chronograf.Preferences, error)
+	PutF func(context.Context, *chronograf.Preferences) error
+}
+
+// Get retrieves the preferences of a user
+func (s *PreferencesStore) Get(ctx context.Context, userID uint64) (*chronograf.Preferences, error) {
+	return s.GetF(ctx, userID)
+}
+
+// Put replaces the preferences of a user
+func (s *PreferencesStore) Put(ctx context.Context, p *chronograf.Preferences) error {
+	return s.PutF(ctx, p)
+}
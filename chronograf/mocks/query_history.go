@@ -0,0 +1,31 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+var _ chronograf.QueryHistoryStore = &QueryHistoryStore{}
+
+// QueryHistoryStore mock allows all functions to be set for testing
+type QueryHistoryStore struct {
+	AllF    func(ctx context.Context, userID uint64) ([]chronograf.QueryHistoryEntry, error)
+	AddF    func(context.Context, *chronograf.QueryHistoryEntry) error
+	DeleteF func(ctx context.Context, userID uint64) error
+}
+
+// All lists the queries of a user
+func (s *QueryHistoryStore) All(ctx context.Context, userID uint64) ([]chronograf.QueryHistoryEntry, error) {
+	return s.AllF(ctx, userID)
+}
+
+// Add records a query
+func (s *QueryHistoryStore) Add(ctx context.Context, q *chronograf.QueryHistoryEntry) error {
+	return s.AddF(ctx, q)
+}
+
+// Delete removes the queries of a user
+func (s *QueryHistoryStore) Delete(ctx context.Context, userID uint64) error {
+	return s.DeleteF(ctx, userID)
+}
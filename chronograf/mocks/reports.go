@@ -0,0 +1,43 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+var _ chronograf.ReportsStore = &ReportsStore{}
+
+// ReportsStore mock allows all functions to be set for testing
+type ReportsStore struct {
+	AllF    func(context.Context) ([]chronograf.Report, error)
+	AddF    func(context.Context, *chronograf.Report) (*chronograf.Report, error)
+	DeleteF func(context.Context, *chronograf.Report) error
+	GetF    func(ctx context.Context, id string) (*chronograf.Report, error)
+	UpdateF func(context.Context, *chronograf.Report) error
+}
+
+// All lists all reports
+func (s *ReportsStore) All(ctx context.Context) ([]chronograf.Report, error) {
+	return s.AllF(ctx)
+}
+
+// Add creates a new report
+func (s *ReportsStore) Add(ctx context.Context, r *chronograf.Report) (*chronograf.Report, error) {
+	return s.AddF(ctx, r)
+}
+
+// Delete removes a report
+func (s *ReportsStore) Delete(ctx context.Context, r *chronograf.Report) error {
+	return s.DeleteF(ctx, r)
+}
+
+// Get retrieves a report by ID
+func (s *ReportsStore) Get(ctx context.Context, id string) (*chronograf.Report, error) {
+	return s.GetF(ctx, id)
+}
+
+// Update replaces a report
+func (s *ReportsStore) Update(ctx context.Context, r *chronograf.Report) error {
+	return s.UpdateF(ctx, r)
+}
@@ -0,0 +1,43 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+var _ chronograf.RuleTemplatesStore = &RuleTemplatesStore{}
+
+// RuleTemplatesStore mock allows all functions to be set for testing
+type RuleTemplatesStore struct {
+	AllF    func(context.Context) ([]chronograf.RuleTemplate, error)
+	AddF    func(context.Context, *chronograf.RuleTemplate) (*chronograf.RuleTemplate, error)
+	DeleteF func(context.Context, *chronograf.RuleTemplate) error
+	GetF    func(ctx context.Context, id string) (*chronograf.RuleTemplate, error)
+	UpdateF func(context.Context, *chronograf.RuleTemplate) error
+}
+
+// All lists all rule templates
+func (s *RuleTemplatesStore) All(ctx context.Context) ([]chronograf.RuleTemplate, error) {
+	return s.AllF(ctx)
+}
+
+// Add creates a new rule template
+func (s *RuleTemplatesStore) Add(ctx context.Context, t *chronograf.RuleTemplate) (*chronograf.RuleTemplate, error) {
+	return s.AddF(ctx, t)
+}
+
+// Delete removes a rule template
+func (s *RuleTemplatesStore) Delete(ctx context.Context, t *chronograf.RuleTemplate) error {
+	return s.DeleteF(ctx, t)
+}
+
+// Get retrieves a rule template by ID
+func (s *RuleTemplatesStore) Get(ctx context.Context, id string) (*chronograf.RuleTemplate, error) {
+	return s.GetF(ctx, id)
+}
+
+// Update replaces a rule template
+func (s *RuleTemplatesStore) Update(ctx context.Context, t *chronograf.RuleTemplate) error {
+	return s.UpdateF(ctx, t)
+}
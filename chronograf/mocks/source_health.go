@@ -0,0 +1,31 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+var _ chronograf.SourceHealthStore = &SourceHealthStore{}
+
+// SourceHealthStore mock allows all functions to be set for testing
+type SourceHealthStore struct {
+	AllF    func(ctx context.Context, sourceID int) ([]chronograf.SourceHealth, error)
+	AddF    func(context.Context, *chronograf.SourceHealth) error
+	DeleteF func(ctx context.Context, sourceID int) error
+}
+
+// All lists the health checks of a source
+func (s *SourceHealthStore) All(ctx context.Context, sourceID int) ([]chronograf.SourceHealth, error) {
+	return s.AllF(ctx, sourceID)
+}
+
+// Add records a health check
+func (s *SourceHealthStore) Add(ctx context.Context, h *chronograf.SourceHealth) error {
+	return s.AddF(ctx, h)
+}
+
+// Delete removes the health checks of a source
+func (s *SourceHealthStore) Delete(ctx context.Context, sourceID int) error {
+	return s.DeleteF(ctx, sourceID)
+}
@@ -14,9 +14,29 @@ type Store struct {
 	LayoutsStore            chronograf.LayoutsStore
 	UsersStore              chronograf.UsersStore
 	DashboardsStore         chronograf.DashboardsStore
+	DashboardVersionsStore  chronograf.DashboardVersionsStore
+	DashboardSnapshotsStore chronograf.DashboardSnapshotsStore
+	FoldersStore            chronograf.FoldersStore
+	ReportsStore            chronograf.ReportsStore
+	AnnotationsStore        chronograf.AnnotationStore
+	SourceHealthStore       chronograf.SourceHealthStore
+	QueryHistoryStore       chronograf.QueryHistoryStore
+	AlertEventsStore        chronograf.AlertEventsStore
+	AlertWebhooksStore      chronograf.AlertWebhooksStore
+	AlertChecksStore        chronograf.AlertChecksStore
+	AlertPoliciesStore      chronograf.AlertPoliciesStore
+	RuleTemplatesStore      chronograf.RuleTemplatesStore
 	OrganizationsStore      chronograf.OrganizationsStore
 	ConfigStore             chronograf.ConfigStore
 	OrganizationConfigStore chronograf.OrganizationConfigStore
+	NotificationsStore      chronograf.NotificationsStore
+	TokensStore             chronograf.TokensStore
+	InvitationsStore        chronograf.InvitationsStore
+	PreferencesStore        chronograf.PreferencesStore
+	CertificatesStore       chronograf.CertificatesStore
+	GroupsStore             chronograf.GroupsStore
+	WebhooksStore           chronograf.WebhooksStore
+	WebhookDeliveriesStore  chronograf.WebhookDeliveriesStore
 }
 
 func (s *Store) Sources(ctx context.Context) chronograf.SourcesStore {
@@ -46,6 +66,54 @@ func (s *Store) Dashboards(ctx context.Context) chronograf.DashboardsStore {
 	return s.DashboardsStore
 }
 
+func (s *Store) DashboardVersions(ctx context.Context) chronograf.DashboardVersionsStore {
+	return s.DashboardVersionsStore
+}
+
+func (s *Store) DashboardSnapshots(ctx context.Context) chronograf.DashboardSnapshotsStore {
+	return s.DashboardSnapshotsStore
+}
+
+func (s *Store) Folders(ctx context.Context) chronograf.FoldersStore {
+	return s.FoldersStore
+}
+
+func (s *Store) Reports(ctx context.Context) chronograf.ReportsStore {
+	return s.ReportsStore
+}
+
+func (s *Store) Annotations(ctx context.Context) chronograf.AnnotationStore {
+	return s.AnnotationsStore
+}
+
+func (s *Store) SourceHealth(ctx context.Context) chronograf.SourceHealthStore {
+	return s.SourceHealthStore
+}
+
+func (s *Store) QueryHistory(ctx context.Context) chronograf.QueryHistoryStore {
+	return s.QueryHistoryStore
+}
+
+func (s *Store) AlertEvents(ctx context.Context) chronograf.AlertEventsStore {
+	return s.AlertEventsStore
+}
+
+func (s *Store) AlertWebhooks(ctx context.Context) chronograf.AlertWebhooksStore {
+	return s.AlertWebhooksStore
+}
+
+func (s *Store) AlertChecks(ctx context.Context) chronograf.AlertChecksStore {
+	return s.AlertChecksStore
+}
+
+func (s *Store) AlertPolicies(ctx context.Context) chronograf.AlertPoliciesStore {
+	return s.AlertPoliciesStore
+}
+
+func (s *Store) RuleTemplates(ctx context.Context) chronograf.RuleTemplatesStore {
+	return s.RuleTemplatesStore
+}
+
 func (s *Store) Config(ctx context.Context) chronograf.ConfigStore {
 	return s.ConfigStore
 }
@@ -53,3 +121,35 @@ func (s *Store) Config(ctx context.Context) chronograf.ConfigStore {
 func (s *Store) OrganizationConfig(ctx context.Context) chronograf.OrganizationConfigStore {
 	return s.OrganizationConfigStore
 }
+
+func (s *Store) Notifications(ctx context.Context) chronograf.NotificationsStore {
+	return s.NotificationsStore
+}
+
+func (s *Store) Tokens(ctx context.Context) chronograf.TokensStore {
+	return s.TokensStore
+}
+
+func (s *Store) Invitations(ctx context.Context) chronograf.InvitationsStore {
+	return s.InvitationsStore
+}
+
+func (s *Store) Preferences(ctx context.Context) chronograf.PreferencesStore {
+	return s.PreferencesStore
+}
+
+func (s *Store) Certificates(ctx context.Context) chronograf.CertificatesStore {
+	return s.CertificatesStore
+}
+
+func (s *Store) Groups(ctx context.Context) chronograf.GroupsStore {
+	return s.GroupsStore
+}
+
+func (s *Store) Webhooks(ctx context.Context) chronograf.WebhooksStore {
+	return s.WebhooksStore
+}
+
+func (s *Store) WebhookDeliveries(ctx context.Context) chronograf.WebhookDeliveriesStore {
+	return s.WebhookDeliveriesStore
+}
@@ -2,11 +2,15 @@ package mocks
 
 import (
 	"context"
+	"io"
 
 	"github.com/influxdata/influxdb/chronograf"
 )
 
 var _ chronograf.TimeSeries = &TimeSeries{}
+var _ chronograf.FluxQuerier = &TimeSeries{}
+var _ chronograf.ChunkedQuerier = &TimeSeries{}
+var _ chronograf.FluxTasker = &TimeSeries{}
 
 // TimeSeries is a mockable chronograf time series by overriding the functions.
 type TimeSeries struct {
@@ -22,6 +26,28 @@ type TimeSeries struct {
 	PermissionsF func(context.Context) chronograf.Permissions
 	// RolesF represents the roles. Roles group permissions and Users
 	RolesF func(context.Context) (chronograf.RolesStore, error)
+	// FluxQueryF runs a Flux script
+	FluxQueryF func(context.Context, string) ([]byte, error)
+	// ChunkedQueryF streams the series of a query
+	ChunkedQueryF func(context.Context, chronograf.Query, func(chronograf.ResultSeries) error) error
+	// ChunkedResponseF returns the chunks of the results of a query
+	ChunkedResponseF func(context.Context, chronograf.Query, int) (io.ReadCloser, error)
+	// ExplainF explains the plan of a query
+	ExplainF func(context.Context, chronograf.Query) (chronograf.QueryPlan, error)
+	// AllFluxTasksF lists the Flux tasks of the source
+	AllFluxTasksF func(context.Context) ([]chronograf.FluxTask, error)
+	// GetFluxTaskF retrieves a Flux task
+	GetFluxTaskF func(context.Context, string) (*chronograf.FluxTask, error)
+	// CreateFluxTaskF creates a Flux task
+	CreateFluxTaskF func(context.Context, *chronograf.FluxTask) (*chronograf.FluxTask, error)
+	// UpdateFluxTaskF updates a Flux task
+	UpdateFluxTaskF func(context.Context, *chronograf.FluxTask) (*chronograf.FluxTask, error)
+	// DeleteFluxTaskF removes a Flux task
+	DeleteFluxTaskF func(context.Context, string) error
+	// FluxTaskRunsF lists the latest runs of a Flux task
+	FluxTaskRunsF func(context.Context, string, int) ([]chronograf.FluxTaskRun, error)
+	// FluxTaskLogsF lists the logs of a Flux task
+	FluxTaskLogsF func(context.Context, string, string) ([]chronograf.FluxTaskLog, error)
 }
 
 // New implements TimeSeriesClient
@@ -58,3 +84,58 @@ func (t *TimeSeries) Roles(ctx context.Context) (chronograf.RolesStore, error) {
 func (t *TimeSeries) Permissions(ctx context.Context) chronograf.Permissions {
 	return t.PermissionsF(ctx)
 }
+
+// FluxQuery runs a Flux script and returns its result as annotated CSV
+func (t *TimeSeries) FluxQuery(ctx context.Context, script string) ([]byte, error) {
+	return t.FluxQueryF(ctx, script)
+}
+
+// ChunkedQuery runs a query and calls fn with its series as they arrive
+func (t *TimeSeries) ChunkedQuery(ctx context.Context, q chronograf.Query, fn func(chronograf.ResultSeries) error) error {
+	return t.ChunkedQueryF(ctx, q, fn)
+}
+
+// ChunkedResponse returns the chunks of the results of a query
+func (t *TimeSeries) ChunkedResponse(ctx context.Context, q chronograf.Query, size int) (io.ReadCloser, error) {
+	return t.ChunkedResponseF(ctx, q, size)
+}
+
+// Explain runs EXPLAIN and EXPLAIN ANALYZE for a query
+func (t *TimeSeries) Explain(ctx context.Context, q chronograf.Query) (chronograf.QueryPlan, error) {
+	return t.ExplainF(ctx, q)
+}
+
+// AllFluxTasks lists the Flux tasks of the source
+func (t *TimeSeries) AllFluxTasks(ctx context.Context) ([]chronograf.FluxTask, error) {
+	return t.AllFluxTasksF(ctx)
+}
+
+// GetFluxTask retrieves a Flux task
+func (t *TimeSeries) GetFluxTask(ctx context.Context, id string) (*chronograf.FluxTask, error) {
+	return t.GetFluxTaskF(ctx, id)
+}
+
+// CreateFluxTask creates a Flux task
+func (t *TimeSeries) CreateFluxTask(ctx context.Context, task *chronograf.FluxTask) (*chronograf.FluxTask, error) {
+	return t.CreateFluxTaskF(ctx, task)
+}
+
+// UpdateFluxTask updates a Flux task
+func (t *TimeSeries) UpdateFluxTask(ctx context.Context, task *chronograf.FluxTask) (*chronograf.FluxTask, error) {
+	return t.UpdateFluxTaskF(ctx, task)
+}
+
+// DeleteFluxTask removes a Flux task
+func (t *TimeSeries) DeleteFluxTask(ctx context.Context, id string) error {
+	return t.DeleteFluxTaskF(ctx, id)
+}
+
+// FluxTaskRuns lists the latest runs of a Flux task
+func (t *TimeSeries) FluxTaskRuns(ctx context.Context, id string, limit int) ([]chronograf.FluxTaskRun, error) {
+	return t.FluxTaskRunsF(ctx, id, limit)
+}
+
+// FluxTaskLogs lists the logs of a Flux task
+func (t *TimeSeries) FluxTaskLogs(ctx context.Context, id, runID string) ([]chronograf.FluxTaskLog, error) {
+	return t.FluxTaskLogsF(ctx, id, runID)
+}
@@ -0,0 +1,43 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+var _ chronograf.TokensStore = &TokensStore{}
+
+// TokensStore mock allows all functions to be set for testing
+type TokensStore struct {
+	AllF    func(context.Context) ([]chronograf.Token, error)
+	AddF    func(context.Context, *chronograf.Token) (*chronograf.Token, error)
+	DeleteF func(context.Context, *chronograf.Token) error
+	GetF    func(ctx context.Context, q chronograf.TokenQuery) (*chronograf.Token, error)
+	UpdateF func(context.Context, *chronograf.Token) error
+}
+
+// All lists all tokens
+func (s *TokensStore) All(ctx context.Context) ([]chronograf.Token, error) {
+	return s.AllF(ctx)
+}
+
+// Add creates a new token
+func (s *TokensStore) Add(ctx context.Context, t *chronograf.Token) (*chronograf.Token, error) {
+	return s.AddF(ctx, t)
+}
+
+// Delete the token
+func (s *TokensStore) Delete(ctx context.Context, t *chronograf.Token) error {
+	return s.DeleteF(ctx, t)
+}
+
+// Get retrieves a token by ID or by the hash of its secret
+func (s *TokensStore) Get(ctx context.Context, q chronograf.TokenQuery) (*chronograf.Token, error) {
+	return s.GetF(ctx, q)
+}
+
+// Update replaces the token
+func (s *TokensStore) Update(ctx context.Context, t *chronograf.Token) error {
+	return s.UpdateF(ctx, t)
+}
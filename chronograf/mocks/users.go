@@ -10,12 +10,15 @@ var _ chronograf.UsersStore = &UsersStore{}
 
 // UsersStore mock allows all functions to be set for testing
 type UsersStore struct {
-	AllF    func(context.Context) ([]chronograf.User, error)
-	AddF    func(context.Context, *chronograf.User) (*chronograf.User, error)
-	DeleteF func(context.Context, *chronograf.User) error
-	GetF    func(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error)
-	UpdateF func(context.Context, *chronograf.User) error
-	NumF    func(context.Context) (int, error)
+	AllF        func(context.Context) ([]chronograf.User, error)
+	AddF        func(context.Context, *chronograf.User) (*chronograf.User, error)
+	AddManyF    func(context.Context, []*chronograf.User) ([]*chronograf.User, error)
+	DeleteF     func(context.Context, *chronograf.User) error
+	GetF        func(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error)
+	UpdateF     func(context.Context, *chronograf.User) error
+	UpdateManyF func(context.Context, []*chronograf.User) error
+	NumF        func(context.Context) (int, error)
+	FilterF     func(context.Context, chronograf.UserFilter) ([]chronograf.User, error)
 }
 
 // All lists all users from the UsersStore
@@ -33,6 +36,11 @@ func (s *UsersStore) Add(ctx context.Context, u *chronograf.User) (*chronograf.U
 	return s.AddF(ctx, u)
 }
 
+// AddMany creates all of the users in the UsersStore
+func (s *UsersStore) AddMany(ctx context.Context, us []*chronograf.User) ([]*chronograf.User, error) {
+	return s.AddManyF(ctx, us)
+}
+
 // Delete the User from the UsersStore
 func (s *UsersStore) Delete(ctx context.Context, u *chronograf.User) error {
 	return s.DeleteF(ctx, u)
@@ -47,3 +55,13 @@ func (s *UsersStore) Get(ctx context.Context, q chronograf.UserQuery) (*chronogr
 func (s *UsersStore) Update(ctx context.Context, u *chronograf.User) error {
 	return s.UpdateF(ctx, u)
 }
+
+// UpdateMany updates all of the users in the UsersStore
+func (s *UsersStore) UpdateMany(ctx context.Context, us []*chronograf.User) error {
+	return s.UpdateManyF(ctx, us)
+}
+
+// Filter lists the users of the UsersStore matching the filter
+func (s *UsersStore) Filter(ctx context.Context, f chronograf.UserFilter) ([]chronograf.User, error) {
+	return s.FilterF(ctx, f)
+}
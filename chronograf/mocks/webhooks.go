@@ -0,0 +1,67 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+var _ chronograf.WebhooksStore = &WebhooksStore{}
+
+// WebhooksStore mock allows all functions to be set for testing
+type WebhooksStore struct {
+	AddF    func(context.Context, *chronograf.Webhook) (*chronograf.Webhook, error)
+	AllF    func(context.Context) ([]chronograf.Webhook, error)
+	DeleteF func(context.Context, *chronograf.Webhook) error
+	GetF    func(context.Context, string) (*chronograf.Webhook, error)
+	UpdateF func(context.Context, *chronograf.Webhook) error
+}
+
+// Add creates a new webhook
+func (s *WebhooksStore) Add(ctx context.Context, h *chronograf.Webhook) (*chronograf.Webhook, error) {
+	return s.AddF(ctx, h)
+}
+
+// All lists all webhooks
+func (s *WebhooksStore) All(ctx context.Context) ([]chronograf.Webhook, error) {
+	return s.AllF(ctx)
+}
+
+// Delete the webhook
+func (s *WebhooksStore) Delete(ctx context.Context, h *chronograf.Webhook) error {
+	return s.DeleteF(ctx, h)
+}
+
+// Get retrieves a webhook by ID
+func (s *WebhooksStore) Get(ctx context.Context, id string) (*chronograf.Webhook, error) {
+	return s.GetF(ctx, id)
+}
+
+// Update replaces the webhook
+func (s *WebhooksStore) Update(ctx context.Context, h *chronograf.Webhook) error {
+	return s.UpdateF(ctx, h)
+}
+
+var _ chronograf.WebhookDeliveriesStore = &WebhookDeliveriesStore{}
+
+// WebhookDeliveriesStore mock allows all functions to be set for testing
+type WebhookDeliveriesStore struct {
+	AllF    func(ctx context.Context, webhookID string) ([]chronograf.WebhookDelivery, error)
+	AddF    func(context.Context, *chronograf.WebhookDelivery) error
+	DeleteF func(ctx context.Context, webhookID string) error
+}
+
+// All lists the deliveries of a webhook
+func (s *WebhookDeliveriesStore) All(ctx context.Context, webhookID string) ([]chronograf.WebhookDelivery, error) {
+	return s.AllF(ctx, webhookID)
+}
+
+// Add records a delivery
+func (s *WebhookDeliveriesStore) Add(ctx context.Context, d *chronograf.WebhookDelivery) error {
+	return s.AddF(ctx, d)
+}
+
+// Delete removes the deliveries of a webhook
+func (s *WebhookDeliveriesStore) Delete(ctx context.Context, webhookID string) error {
+	return s.DeleteF(ctx, webhookID)
+}
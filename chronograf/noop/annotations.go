@@ -0,0 +1,34 @@
+package noop
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// ensure AnnotationsStore implements chronograf.AnnotationStore
+var _ chronograf.AnnotationStore = &AnnotationsStore{}
+
+type AnnotationsStore struct{}
+
+func (s *AnnotationsStore) All(context.Context, time.Time, time.Time) ([]chronograf.Annotation, error) {
+	return nil, fmt.Errorf("no annotations found")
+}
+
+func (s *AnnotationsStore) Add(context.Context, *chronograf.Annotation) (*chronograf.Annotation, error) {
+	return nil, fmt.Errorf("failed to add annotation")
+}
+
+func (s *AnnotationsStore) Delete(context.Context, string) error {
+	return fmt.Errorf("failed to delete annotation")
+}
+
+func (s *AnnotationsStore) Get(ctx context.Context, id string) (*chronograf.Annotation, error) {
+	return nil, chronograf.ErrAnnotationNotFound
+}
+
+func (s *AnnotationsStore) Update(context.Context, *chronograf.Annotation) error {
+	return fmt.Errorf("failed to update annotation")
+}
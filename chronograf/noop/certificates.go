@@ -0,0 +1,33 @@
+package noop
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// ensure CertificatesStore implements chronograf.CertificatesStore
+var _ chronograf.CertificatesStore = &CertificatesStore{}
+
+type CertificatesStore struct{}
+
+func (s *CertificatesStore) All(context.Context) ([]chronograf.CertificateMapping, error) {
+	return nil, fmt.Errorf("no certificate mappings found")
+}
+
+func (s *CertificatesStore) Add(context.Context, *chronograf.CertificateMapping) (*chronograf.CertificateMapping, error) {
+	return nil, fmt.Errorf("failed to add certificate mapping")
+}
+
+func (s *CertificatesStore) Delete(context.Context, *chronograf.CertificateMapping) error {
+	return fmt.Errorf("failed to delete certificate mapping")
+}
+
+func (s *CertificatesStore) Get(ctx context.Context, ID string) (*chronograf.CertificateMapping, error) {
+	return nil, chronograf.ErrCertificateMappingNotFound
+}
+
+func (s *CertificatesStore) Update(context.Context, *chronograf.CertificateMapping) error {
+	return fmt.Errorf("failed to update certificate mapping")
+}
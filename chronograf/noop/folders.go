@@ -0,0 +1,33 @@
+package noop
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// ensure FoldersStore implements chronograf.FoldersStore
+var _ chronograf.FoldersStore = &FoldersStore{}
+
+type FoldersStore struct{}
+
+func (s *FoldersStore) All(context.Context) ([]chronograf.Folder, error) {
+	return nil, fmt.Errorf("no folders found")
+}
+
+func (s *FoldersStore) Add(context.Context, *chronograf.Folder) (*chronograf.Folder, error) {
+	return nil, fmt.Errorf("failed to add folder")
+}
+
+func (s *FoldersStore) Delete(context.Context, *chronograf.Folder) error {
+	return fmt.Errorf("failed to delete folder")
+}
+
+func (s *FoldersStore) Get(ctx context.Context, id string) (*chronograf.Folder, error) {
+	return nil, chronograf.ErrFolderNotFound
+}
+
+func (s *FoldersStore) Update(context.Context, *chronograf.Folder) error {
+	return fmt.Errorf("failed to update folder")
+}
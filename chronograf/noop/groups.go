@@ -0,0 +1,33 @@
+package noop
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// ensure GroupsStore implements chronograf.GroupsStore
+var _ chronograf.GroupsStore = &GroupsStore{}
+
+type GroupsStore struct{}
+
+func (s *GroupsStore) All(context.Context) ([]chronograf.Group, error) {
+	return nil, fmt.Errorf("no groups found")
+}
+
+func (s *GroupsStore) Add(context.Context, *chronograf.Group) (*chronograf.Group, error) {
+	return nil, fmt.Errorf("failed to add group")
+}
+
+func (s *GroupsStore) Delete(context.Context, *chronograf.Group) error {
+	return fmt.Errorf("failed to delete group")
+}
+
+func (s *GroupsStore) Get(ctx context.Context, ID string) (*chronograf.Group, error) {
+	return nil, chronograf.ErrGroupNotFound
+}
+
+func (s *GroupsStore) Update(context.Context, *chronograf.Group) error {
+	return fmt.Errorf("failed to update group")
+}
@@ -0,0 +1,29 @@
+package noop
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// ensure InvitationsStore implements chronograf.InvitationsStore
+var _ chronograf.InvitationsStore = &InvitationsStore{}
+
+type InvitationsStore struct{}
+
+func (s *InvitationsStore) All(context.Context) ([]chronograf.Invitation, error) {
+	return nil, fmt.Errorf("no invitations found")
+}
+
+func (s *InvitationsStore) Add(context.Context, *chronograf.Invitation) (*chronograf.Invitation, error) {
+	return nil, fmt.Errorf("failed to add invitation")
+}
+
+func (s *InvitationsStore) Delete(context.Context, *chronograf.Invitation) error {
+	return fmt.Errorf("failed to delete invitation")
+}
+
+func (s *InvitationsStore) Get(ctx context.Context, id string) (*chronograf.Invitation, error) {
+	return nil, chronograf.ErrInvitationNotFound
+}
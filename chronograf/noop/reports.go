@@ -0,0 +1,33 @@
+package noop
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// ensure ReportsStore implements chronograf.ReportsStore
+var _ chronograf.ReportsStore = &ReportsStore{}
+
+type ReportsStore struct{}
+
+func (s *ReportsStore) All(context.Context) ([]chronograf.Report, error) {
+	return nil, fmt.Errorf("no reports found")
+}
+
+func (s *ReportsStore) Add(context.Context, *chronograf.Report) (*chronograf.Report, error) {
+	return nil, fmt.Errorf("failed to add report")
+}
+
+func (s *ReportsStore) Delete(context.Context, *chronograf.Report) error {
+	return fmt.Errorf("failed to delete report")
+}
+
+func (s *ReportsStore) Get(ctx context.Context, id string) (*chronograf.Report, error) {
+	return nil, chronograf.ErrReportNotFound
+}
+
+func (s *ReportsStore) Update(context.Context, *chronograf.Report) error {
+	return fmt.Errorf("failed to update report")
+}
@@ -0,0 +1,33 @@
+package noop
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// ensure RuleTemplatesStore implements chronograf.RuleTemplatesStore
+var _ chronograf.RuleTemplatesStore = &RuleTemplatesStore{}
+
+type RuleTemplatesStore struct{}
+
+func (s *RuleTemplatesStore) All(context.Context) ([]chronograf.RuleTemplate, error) {
+	return nil, fmt.Errorf("no rule templates found")
+}
+
+func (s *RuleTemplatesStore) Add(context.Context, *chronograf.RuleTemplate) (*chronograf.RuleTemplate, error) {
+	return nil, fmt.Errorf("failed to add rule template")
+}
+
+func (s *RuleTemplatesStore) Delete(context.Context, *chronograf.RuleTemplate) error {
+	return fmt.Errorf("failed to delete rule template")
+}
+
+func (s *RuleTemplatesStore) Get(ctx context.Context, id string) (*chronograf.RuleTemplate, error) {
+	return nil, chronograf.ErrRuleTemplateNotFound
+}
+
+func (s *RuleTemplatesStore) Update(context.Context, *chronograf.RuleTemplate) error {
+	return fmt.Errorf("failed to update rule template")
+}
@@ -0,0 +1,33 @@
+package noop
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// ensure TokensStore implements chronograf.TokensStore
+var _ chronograf.TokensStore = &TokensStore{}
+
+type TokensStore struct{}
+
+func (s *TokensStore) All(context.Context) ([]chronograf.Token, error) {
+	return nil, fmt.Errorf("no tokens found")
+}
+
+func (s *TokensStore) Add(context.Context, *chronograf.Token) (*chronograf.Token, error) {
+	return nil, fmt.Errorf("failed to add token")
+}
+
+func (s *TokensStore) Delete(context.Context, *chronograf.Token) error {
+	return fmt.Errorf("failed to delete token")
+}
+
+func (s *TokensStore) Get(ctx context.Context, q chronograf.TokenQuery) (*chronograf.Token, error) {
+	return nil, chronograf.ErrTokenNotFound
+}
+
+func (s *TokensStore) Update(context.Context, *chronograf.Token) error {
+	return fmt.Errorf("failed to update token")
+}
@@ -20,6 +20,10 @@ func (s *UsersStore) Add(context.Context, *chronograf.User) (*chronograf.User, e
 	return nil, fmt.Errorf("failed to add user")
 }
 
+func (s *UsersStore) AddMany(context.Context, []*chronograf.User) ([]*chronograf.User, error) {
+	return nil, fmt.Errorf("failed to add users")
+}
+
 func (s *UsersStore) Delete(context.Context, *chronograf.User) error {
 	return fmt.Errorf("failed to delete user")
 }
@@ -32,6 +36,14 @@ func (s *UsersStore) Update(context.Context, *chronograf.User) error {
 	return fmt.Errorf("failed to update user")
 }
 
+func (s *UsersStore) UpdateMany(context.Context, []*chronograf.User) error {
+	return fmt.Errorf("failed to update users")
+}
+
 func (s *UsersStore) Num(context.Context) (int, error) {
 	return 0, fmt.Errorf("failed to get number of users")
 }
+
+func (s *UsersStore) Filter(context.Context, chronograf.UserFilter) ([]chronograf.User, error) {
+	return nil, fmt.Errorf("no users found")
+}
@@ -0,0 +1,50 @@
+package noop
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// ensure WebhooksStore implements chronograf.WebhooksStore
+var _ chronograf.WebhooksStore = &WebhooksStore{}
+
+type WebhooksStore struct{}
+
+func (s *WebhooksStore) All(context.Context) ([]chronograf.Webhook, error) {
+	return nil, fmt.Errorf("no webhooks found")
+}
+
+func (s *WebhooksStore) Add(context.Context, *chronograf.Webhook) (*chronograf.Webhook, error) {
+	return nil, fmt.Errorf("failed to add webhook")
+}
+
+func (s *WebhooksStore) Delete(context.Context, *chronograf.Webhook) error {
+	return fmt.Errorf("failed to delete webhook")
+}
+
+func (s *WebhooksStore) Get(ctx context.Context, ID string) (*chronograf.Webhook, error) {
+	return nil, chronograf.ErrWebhookNotFound
+}
+
+func (s *WebhooksStore) Update(context.Context, *chronograf.Webhook) error {
+	return fmt.Errorf("failed to update webhook")
+}
+
+// ensure WebhookDeliveriesStore implements chronograf.WebhookDeliveriesStore
+var _ chronograf.WebhookDeliveriesStore = &WebhookDeliveriesStore{}
+
+type WebhookDeliveriesStore struct{}
+
+func (s *WebhookDeliveriesStore) All(context.Context, string) ([]chronograf.WebhookDelivery, error) {
+	return nil, fmt.Errorf("no webhook deliveries found")
+}
+
+func (s *WebhookDeliveriesStore) Add(context.Context, *chronograf.WebhookDelivery) error {
+	return fmt.Errorf("failed to add webhook delivery")
+}
+
+func (s *WebhookDeliveriesStore) Delete(context.Context, string) error {
+	return fmt.Errorf("failed to delete webhook deliveries")
+}
@@ -0,0 +1,131 @@
+package oauth2
+
+import (
+	"sync"
+	"time"
+)
+
+// cacheRetryInterval limits how often an expired value is refreshed in the
+// background while its provider fails
+const cacheRetryInterval = 10 * time.Second
+
+// Cache keeps the values fetched from identity providers, such as the JSON
+// Web Key Sets of key discovery services and the groups of users, for TTL.
+// An expired value is still returned for Stale while it is refreshed in the
+// background, so that a provider which is briefly unreachable does not fail
+// logins. A nil Cache, or a Cache without TTL, fetches every value.
+type Cache struct {
+	TTL   time.Duration    // TTL is how long a fetched value is fresh
+	Stale time.Duration    // Stale is how long an expired value is returned while it cannot be refreshed
+	Now   func() time.Time // Now returns the current time (for testing)
+
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+type cacheEntry struct {
+	value      interface{}
+	fetched    time.Time // fetched is when value was fetched
+	refreshing bool      // refreshing is true while value is refreshed in the background
+	attempted  time.Time // attempted is when the last background refresh started
+}
+
+// NewCache keeps values fresh for ttl and returns them for stale once
+// expired, while they are refreshed
+func NewCache(ttl, stale time.Duration) *Cache {
+	return &Cache{
+		TTL:     ttl,
+		Stale:   stale,
+		Now:     DefaultNowTime,
+		entries: map[string]*cacheEntry{},
+	}
+}
+
+func (c *Cache) enabled() bool {
+	return c != nil && c.TTL > 0
+}
+
+func (c *Cache) now() time.Time {
+	if c.Now != nil {
+		return c.Now()
+	}
+	return time.Now()
+}
+
+// Get returns the value of key, calling fetch when the value is not cached
+// or expired for longer than Stale. A value expired for less than Stale is
+// returned as is and refreshed by fetch in the background.
+func (c *Cache) Get(key string, fetch func() (interface{}, error)) (interface{}, error) {
+	if !c.enabled() {
+		return fetch()
+	}
+
+	now := c.now()
+	c.mu.Lock()
+	if e, ok := c.entries[key]; ok {
+		age := now.Sub(e.fetched)
+		if age < c.TTL+c.Stale {
+			if age >= c.TTL && !e.refreshing && now.Sub(e.attempted) >= cacheRetryInterval {
+				e.refreshing = true
+				e.attempted = now
+				go c.refresh(key, fetch)
+			}
+			value := e.value
+			c.mu.Unlock()
+			return value, nil
+		}
+	}
+	c.mu.Unlock()
+	return c.fetch(key, fetch)
+}
+
+// Refresh fetches the value of key again unless it was fetched within
+// interval, such as when a key set lacks the key of a token because the
+// provider rotated its keys
+func (c *Cache) Refresh(key string, interval time.Duration, fetch func() (interface{}, error)) (interface{}, error) {
+	if !c.enabled() {
+		return fetch()
+	}
+
+	c.mu.Lock()
+	if e, ok := c.entries[key]; ok && c.now().Sub(e.fetched) < interval {
+		value := e.value
+		c.mu.Unlock()
+		return value, nil
+	}
+	c.mu.Unlock()
+	return c.fetch(key, fetch)
+}
+
+// fetch calls fetch and caches the value of key
+func (c *Cache) fetch(key string, fetch func() (interface{}, error)) (interface{}, error) {
+	value, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil {
+		c.entries = map[string]*cacheEntry{}
+	}
+	c.entries[key] = &cacheEntry{
+		value:   value,
+		fetched: c.now(),
+	}
+	return value, nil
+}
+
+// refresh fetches an expired value in the background. The expired value is
+// kept when the provider fails, to be refreshed again after
+// cacheRetryInterval.
+func (c *Cache) refresh(key string, fetch func() (interface{}, error)) {
+	if _, err := c.fetch(key, fetch); err == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[key]; ok {
+		e.refreshing = false
+	}
+}
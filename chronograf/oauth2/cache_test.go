@@ -0,0 +1,139 @@
+package oauth2
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCache_Get(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewCache(time.Minute, 10*time.Minute)
+	c.Now = func() time.Time { return now }
+
+	fetched := make(chan struct{}, 10)
+	value, failing := "v1", false
+	fetch := func() (interface{}, error) {
+		defer func() { fetched <- struct{}{} }()
+		if failing {
+			return nil, errors.New("provider unreachable")
+		}
+		return value, nil
+	}
+	get := func(want interface{}) {
+		t.Helper()
+		got, err := c.Get("groups", fetch)
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		if got != want {
+			t.Fatalf("Get() = %v, want %v", got, want)
+		}
+	}
+	waitFetch := func() {
+		t.Helper()
+		select {
+		case <-fetched:
+		case <-time.After(5 * time.Second):
+			t.Fatal("Get() did not fetch the value")
+		}
+	}
+	// waitRefresh waits for the background refresh of an expired value
+	waitRefresh := func() {
+		t.Helper()
+		waitFetch()
+		for {
+			c.mu.Lock()
+			refreshing := c.entries["groups"].refreshing
+			c.mu.Unlock()
+			if !refreshing {
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+	noFetch := func() {
+		t.Helper()
+		select {
+		case <-fetched:
+			t.Fatal("Get() fetched a cached value")
+		case <-time.After(20 * time.Millisecond):
+		}
+	}
+
+	get("v1")
+	waitFetch()
+
+	// Fresh values are not fetched again
+	value = "v2"
+	now = now.Add(30 * time.Second)
+	get("v1")
+	noFetch()
+
+	// Expired values are returned while they are refreshed
+	now = now.Add(time.Minute)
+	get("v1")
+	waitRefresh()
+	get("v2")
+
+	// Expired values are returned while the provider is unreachable, and
+	// refreshed again after cacheRetryInterval
+	failing = true
+	now = now.Add(2 * time.Minute)
+	get("v2")
+	waitRefresh()
+	get("v2")
+	noFetch()
+	now = now.Add(cacheRetryInterval)
+	get("v2")
+	waitRefresh()
+
+	// Values expired for longer than Stale are fetched again
+	now = now.Add(10 * time.Minute)
+	if _, err := c.Get("groups", fetch); err == nil {
+		t.Error("Get() of a value expired for longer than Stale did not fail with the provider")
+	}
+	waitFetch()
+}
+
+func TestCache_Refresh(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewCache(time.Hour, 0)
+	c.Now = func() time.Time { return now }
+
+	fetches := 0
+	fetch := func() (interface{}, error) {
+		fetches++
+		return fetches, nil
+	}
+
+	if got, _ := c.Get("keys", fetch); got != 1 {
+		t.Fatalf("Get() = %v, want 1", got)
+	}
+	if got, _ := c.Refresh("keys", time.Minute, fetch); got != 1 {
+		t.Errorf("Refresh() within interval = %v, want 1", got)
+	}
+	now = now.Add(time.Minute)
+	if got, _ := c.Refresh("keys", time.Minute, fetch); got != 2 {
+		t.Errorf("Refresh() after interval = %v, want 2", got)
+	}
+	if got, _ := c.Get("keys", fetch); got != 2 {
+		t.Errorf("Get() after Refresh() = %v, want 2", got)
+	}
+}
+
+func TestCache_Disabled(t *testing.T) {
+	fetches := 0
+	fetch := func() (interface{}, error) {
+		fetches++
+		return fetches, nil
+	}
+	for _, c := range []*Cache{nil, NewCache(0, time.Minute)} {
+		fetches = 0
+		c.Get("keys", fetch)
+		c.Get("keys", fetch)
+		if fetches != 2 {
+			t.Errorf("Get() of a disabled cache fetched %d times, want 2", fetches)
+		}
+	}
+}
@@ -26,7 +26,18 @@ type cookie struct {
 
 // NewCookieJWT creates an Authenticator that uses cookies for auth
 func NewCookieJWT(secret string, lifespan time.Duration) Authenticator {
-	inactivity := DefaultInactivityDuration
+	return NewSlidingCookieJWT(secret, lifespan, DefaultInactivityDuration)
+}
+
+// NewSlidingCookieJWT creates an Authenticator that uses cookies for auth
+// whose sessions expire after inactivity without requests. Every request
+// extends the session by inactivity until lifespan after login, which is the
+// maximum lifetime of a session. previousSecrets validate the sessions signed
+// before the secret was rotated until they are extended with the secret.
+func NewSlidingCookieJWT(secret string, lifespan, inactivity time.Duration, previousSecrets ...string) Authenticator {
+	if inactivity <= 0 {
+		inactivity = DefaultInactivityDuration
+	}
 	// Server interprets a token duration longer than the cookie lifespan as
 	// a token that was issued by a server with a longer auth-duration and is
 	// thus invalid, as a security precaution. So, inactivity must be set to
@@ -40,8 +51,9 @@ func NewCookieJWT(secret string, lifespan time.Duration) Authenticator {
 		Inactivity: inactivity,
 		Now:        DefaultNowTime,
 		Tokens: &JWT{
-			Secret: secret,
-			Now:    DefaultNowTime,
+			Secret:          secret,
+			PreviousSecrets: previousSecrets,
+			Now:             DefaultNowTime,
 		},
 	}
 }
@@ -65,6 +77,13 @@ func (c *cookie) Extend(ctx context.Context, w http.ResponseWriter, p Principal)
 		return Principal{}, ErrAuthentication
 	}
 
+	// Sessions end at the latest lifespan after login. Beyond it the token
+	// would be rejected for claiming a duration longer than the lifespan.
+	if maxExp := p.IssuedAt.Add(c.Lifespan); c.Lifespan > 0 && p.ExpiresAt.After(maxExp) {
+		p.ExpiresAt = maxExp
+	}
+	p.ExpiresAt = impersonationEnd(p)
+
 	// Creating a new token with the extended principal
 	token, err := c.Tokens.Create(ctx, p)
 	if err != nil {
@@ -89,6 +108,7 @@ func (c *cookie) Authorize(ctx context.Context, w http.ResponseWriter, p Princip
 	now := c.Now()
 	p.IssuedAt = now
 	p.ExpiresAt = now.Add(c.Inactivity)
+	p.ExpiresAt = impersonationEnd(p)
 
 	token, err := c.Tokens.Create(ctx, p)
 	if err != nil {
@@ -102,6 +122,15 @@ func (c *cookie) Authorize(ctx context.Context, w http.ResponseWriter, p Princip
 	return nil
 }
 
+// impersonationEnd is the expiration of the token of p, which never outlives
+// the impersonation of its subject
+func impersonationEnd(p Principal) time.Time {
+	if !p.ImpersonationExpiresAt.IsZero() && p.ExpiresAt.After(p.ImpersonationExpiresAt) {
+		return p.ImpersonationExpiresAt
+	}
+	return p.ExpiresAt
+}
+
 // setCookie creates a cookie with value expiring at exp and writes it as a cookie into the response
 func (c *cookie) setCookie(w http.ResponseWriter, value string, exp time.Time) {
 	// Cookie has a Token baked into it
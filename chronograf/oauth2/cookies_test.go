@@ -180,6 +180,62 @@ func TestNewCookieJWT(t *testing.T) {
 	}
 }
 
+func TestNewSlidingCookieJWT(t *testing.T) {
+	auth := NewSlidingCookieJWT("secret", time.Hour, 30*time.Minute)
+	if cookie, ok := auth.(*cookie); !ok {
+		t.Errorf("NewSlidingCookieJWT() did not create cookie Authenticator")
+	} else if cookie.Inactivity != 30*time.Minute {
+		t.Errorf("NewSlidingCookieJWT() inactivity was not thirty minutes: %s", cookie.Inactivity)
+	}
+
+	auth = NewSlidingCookieJWT("secret", time.Hour, 2*time.Hour)
+	if cookie, ok := auth.(*cookie); !ok {
+		t.Errorf("NewSlidingCookieJWT() did not create cookie Authenticator")
+	} else if cookie.Inactivity != 30*time.Minute {
+		t.Errorf("NewSlidingCookieJWT() inactivity was not half the lifespan: %s", cookie.Inactivity)
+	}
+}
+
+func TestCookieExtend_maxLifetime(t *testing.T) {
+	login := time.Unix(-446774400, 0)
+	now := login.Add(50 * time.Minute)
+	jwt := &JWT{
+		Secret: "secret",
+		Now:    func() time.Time { return now },
+	}
+	c := &cookie{
+		Name:       DefaultCookieName,
+		Lifespan:   time.Hour,
+		Inactivity: 30 * time.Minute,
+		Now:        jwt.Now,
+		Tokens:     jwt,
+	}
+
+	p := Principal{
+		Subject:   "subject",
+		Issuer:    "github",
+		IssuedAt:  login,
+		ExpiresAt: now.Add(time.Minute),
+	}
+	w := httptest.NewRecorder()
+	got, err := c.Extend(context.Background(), w, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := login.Add(time.Hour); !got.ExpiresAt.Equal(want) {
+		t.Errorf("cookie.Extend() expires at %v, want the end of the lifespan %v", got.ExpiresAt, want)
+	}
+
+	// The extended token remains valid until the end of the lifespan
+	cookies := w.Result().Cookies()
+	if len(cookies) != 1 {
+		t.Fatalf("cookie.Extend() set %d cookies", len(cookies))
+	}
+	if _, err := jwt.ValidPrincipal(context.Background(), Token(cookies[0].Value), c.Lifespan); err != nil {
+		t.Errorf("cookie.Extend() created an invalid token: %v", err)
+	}
+}
+
 func TestCookieExtend(t *testing.T) {
 	history := time.Unix(-446774400, 0)
 	type fields struct {
@@ -294,3 +350,49 @@ func TestCookieExtend(t *testing.T) {
 		})
 	}
 }
+
+func TestCookieExtend_impersonation(t *testing.T) {
+	login := time.Unix(-446774400, 0)
+	now := login.Add(10 * time.Minute)
+	jwt := &JWT{
+		Secret: "secret",
+		Now:    func() time.Time { return now },
+	}
+	c := &cookie{
+		Name:       DefaultCookieName,
+		Lifespan:   time.Hour,
+		Inactivity: 30 * time.Minute,
+		Now:        jwt.Now,
+		Tokens:     jwt,
+	}
+
+	end := login.Add(15 * time.Minute)
+	p := Principal{
+		Subject:                "subject",
+		Issuer:                 "github",
+		IssuedAt:               login,
+		ExpiresAt:              now.Add(time.Minute),
+		Impersonator:           "1",
+		ImpersonationExpiresAt: end,
+	}
+	w := httptest.NewRecorder()
+	got, err := c.Extend(context.Background(), w, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.ExpiresAt.Equal(end) {
+		t.Errorf("cookie.Extend() expires at %v, want the end of the impersonation %v", got.ExpiresAt, end)
+	}
+
+	cookies := w.Result().Cookies()
+	if len(cookies) != 1 {
+		t.Fatalf("cookie.Extend() set %d cookies", len(cookies))
+	}
+	valid, err := jwt.ValidPrincipal(context.Background(), Token(cookies[0].Value), c.Lifespan)
+	if err != nil {
+		t.Fatalf("cookie.Extend() created an invalid token: %v", err)
+	}
+	if valid.Impersonator != "1" || !valid.ImpersonationExpiresAt.Equal(end) {
+		t.Errorf("cookie.Extend() token impersonation = %q until %v, want %q until %v", valid.Impersonator, valid.ImpersonationExpiresAt, "1", end)
+	}
+}
@@ -37,6 +37,7 @@ type Generic struct {
 	TokenURL       string
 	APIURL         string // APIURL returns OpenID Userinfo
 	APIKey         string // APIKey is the JSON key to lookup email address in APIURL response
+	GroupsKey      string // GroupsKey is the optional JSON key to lookup the groups of the user in APIURL response or id_token
 	Logger         chronograf.Logger
 }
 
@@ -120,7 +121,7 @@ func (g *Generic) PrincipalID(provider *http.Client) (string, error) {
 }
 
 // Group returns the domain that a user belongs to in the
-// the generic OAuth.
+// the generic OAuth or, if GroupsKey is set, the groups of the user.
 func (g *Generic) Group(provider *http.Client) (string, error) {
 	res := map[string]interface{}{}
 
@@ -134,6 +135,10 @@ func (g *Generic) Group(provider *http.Client) (string, error) {
 		return "", err
 	}
 
+	if g.GroupsKey != "" {
+		return groupsOf(res[g.GroupsKey]), nil
+	}
+
 	email := ""
 	value := res[g.APIKey]
 	if e, ok := value.(string); ok {
@@ -214,8 +219,12 @@ func (g *Generic) PrincipalIDFromClaims(claims gojwt.MapClaims) (string, error)
 	return "", fmt.Errorf("no claim for %s", g.APIKey)
 }
 
-// GroupFromClaims verifies an optional id_token, extracts the email address of the user and splits off the domain part
+// GroupFromClaims verifies an optional id_token, extracts the email address of the user and splits off the domain part.
+// If GroupsKey is set, the groups of the user are extracted instead.
 func (g *Generic) GroupFromClaims(claims gojwt.MapClaims) (string, error) {
+	if g.GroupsKey != "" {
+		return groupsOf(claims[g.GroupsKey]), nil
+	}
 	if id, ok := claims[g.APIKey].(string); ok {
 		email := strings.Split(id, "@")
 		if len(email) != 2 {
@@ -228,3 +237,21 @@ func (g *Generic) GroupFromClaims(claims gojwt.MapClaims) (string, error) {
 
 	return "", fmt.Errorf("no claim for %s", g.APIKey)
 }
+
+// groupsOf returns a comma delimited string of the groups of a claim, which
+// is either a single string or a list of strings
+func groupsOf(claim interface{}) string {
+	switch v := claim.(type) {
+	case string:
+		return v
+	case []interface{}:
+		groups := []string{}
+		for _, group := range v {
+			if s, ok := group.(string); ok && s != "" {
+				groups = append(groups, s)
+			}
+		}
+		return strings.Join(groups, ",")
+	}
+	return ""
+}
@@ -6,6 +6,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	gojwt "github.com/dgrijalva/jwt-go"
 	"github.com/influxdata/influxdb/chronograf"
 	"github.com/influxdata/influxdb/chronograf/oauth2"
 )
@@ -102,6 +103,66 @@ func TestGenericGroup_withEmail(t *testing.T) {
 	}
 }
 
+func TestGenericGroup_withGroupsKey(t *testing.T) {
+	t.Parallel()
+
+	response := struct {
+		Email  string   `json:"email"`
+		Groups []string `json:"groups"`
+	}{
+		"martymcfly@pinheads.rok",
+		[]string{"team-ops", "team-dev"},
+	}
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		enc := json.NewEncoder(rw)
+
+		rw.WriteHeader(http.StatusOK)
+		_ = enc.Encode(response)
+	}))
+	defer mockAPI.Close()
+
+	logger := &chronograf.NoopLogger{}
+	prov := oauth2.Generic{
+		Logger:    logger,
+		APIURL:    mockAPI.URL,
+		APIKey:    "email",
+		GroupsKey: "groups",
+	}
+	tt, err := oauth2.NewTestTripper(logger, mockAPI, http.DefaultTransport)
+	if err != nil {
+		t.Fatal("Error initializing TestTripper: err:", err)
+	}
+
+	tc := &http.Client{
+		Transport: tt,
+	}
+
+	got, err := prov.Group(tc)
+	if err != nil {
+		t.Fatal("Unexpected error while retrieving Group: err:", err)
+	}
+
+	want := "team-ops,team-dev"
+	if got != want {
+		t.Fatal("Retrieved group was not as expected. Want:", want, "Got:", got)
+	}
+
+	got, err = prov.GroupFromClaims(gojwt.MapClaims{
+		"email":  "martymcfly@pinheads.rok",
+		"groups": []interface{}{"team-ops", "team-dev"},
+	})
+	if err != nil {
+		t.Fatal("Unexpected error while retrieving Group from claims: err:", err)
+	}
+	if got != want {
+		t.Fatal("Retrieved group from claims was not as expected. Want:", want, "Got:", got)
+	}
+}
+
 func TestGenericPrincipalID(t *testing.T) {
 	t.Parallel()
 
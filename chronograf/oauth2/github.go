@@ -87,7 +87,8 @@ func (g *Github) PrincipalID(provider *http.Client) (string, error) {
 }
 
 // Group returns a comma delimited string of Github organizations
-// that a user belongs to in Github
+// and teams that a user belongs to in Github. Teams are qualified by
+// their organization, e.g. influxdata/team-ops.
 func (g *Github) Group(provider *http.Client) (string, error) {
 	client := github.NewClient(provider)
 	orgs, err := getOrganizations(client, g.Logger)
@@ -103,6 +104,19 @@ func (g *Github) Group(provider *http.Client) (string, error) {
 		}
 	}
 
+	// Teams only refine the organizations, so users are still let in
+	// with their organizations if their teams cannot be retrieved
+	teams, err := getTeams(client)
+	if err != nil {
+		g.Logger.Error("Unable to retrieve Github teams ", err.Error())
+		return strings.Join(groups, ","), nil
+	}
+	for _, team := range teams {
+		if team.Slug != nil && team.Organization != nil && team.Organization.Login != nil {
+			groups = append(groups, *team.Organization.Login+"/"+*team.Slug)
+		}
+	}
+
 	return strings.Join(groups, ","), nil
 }
 
@@ -158,6 +172,26 @@ func getOrganizations(client *github.Client, log chronograf.Logger) ([]*github.O
 	return allOrgs, nil
 }
 
+// getTeams gets all teams of the currently authenticated user.
+func getTeams(client *github.Client) ([]*github.Team, error) {
+	var allTeams []*github.Team
+	opt := &github.ListOptions{
+		PerPage: 100,
+	}
+	for {
+		teams, resp, err := client.Teams.ListUserTeams(context.TODO(), opt)
+		if err != nil {
+			return nil, err
+		}
+		allTeams = append(allTeams, teams...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+	return allTeams, nil
+}
+
 // getPrimaryEmail gets the primary email account for the authenticated user.
 func getPrimaryEmail(client *github.Client, log chronograf.Logger) (string, error) {
 	emails, resp, err := client.Users.ListEmails(context.TODO(), nil)
@@ -113,3 +113,48 @@ func TestGithubPrincipalIDOrganization(t *testing.T) {
 		t.Fatal("Retrieved email was not as expected. Want:", expectedUser[0].Email, "Got:", email)
 	}
 }
+
+func TestGithubGroup(t *testing.T) {
+	t.Parallel()
+
+	expectedOrg := []struct {
+		Login string `json:"login"`
+	}{
+		{"influxdata"},
+	}
+	expectedTeams := []map[string]interface{}{
+		{"slug": "team-ops", "organization": map[string]string{"login": "influxdata"}},
+	}
+
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		enc := json.NewEncoder(rw)
+		switch r.URL.Path {
+		case "/user/orgs":
+			rw.WriteHeader(http.StatusOK)
+			_ = enc.Encode(expectedOrg)
+		case "/user/teams":
+			rw.WriteHeader(http.StatusOK)
+			_ = enc.Encode(expectedTeams)
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer mockAPI.Close()
+
+	logger := &chronograf.NoopLogger{}
+	prov := oauth2.Github{
+		Logger: logger,
+	}
+	tt, err := oauth2.NewTestTripper(logger, mockAPI, http.DefaultTransport)
+	if err != nil {
+		t.Fatal("Error initializing TestTripper: err:", err)
+	}
+
+	group, err := prov.Group(&http.Client{Transport: tt})
+	if err != nil {
+		t.Fatal("Unexpected error while retrieving Group: err:", err)
+	}
+	if want := "influxdata,influxdata/team-ops"; group != want {
+		t.Fatal("Retrieved group was not as expected. Want:", want, "Got:", group)
+	}
+}
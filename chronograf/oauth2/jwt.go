@@ -2,6 +2,7 @@ package oauth2
 
 import (
 	"context"
+	"crypto/sha256"
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
@@ -18,22 +19,48 @@ var _ Tokenizer = &JWT{}
 
 // JWT represents a javascript web token that can be validated or marshaled into string.
 type JWT struct {
-	Secret  string
-	Jwksurl string
-	Now     func() time.Time
+	Secret          string
+	PreviousSecrets []string // PreviousSecrets validate the tokens signed before Secret was rotated
+	Jwksurl         string
+	Keys            *Cache // Keys caches the JWKS document of Jwksurl; it is fetched on every validation when nil
+	Now             func() time.Time
 }
 
 // NewJWT creates a new JWT using time.Now
 // secret is used for signing and validating signatures (HS256/HMAC)
 // jwksurl is used for validating RS256 signatures.
-func NewJWT(secret string, jwksurl string) *JWT {
+// previousSecrets are only used for validating signatures of tokens signed
+// before the secret was rotated.
+func NewJWT(secret string, jwksurl string, previousSecrets ...string) *JWT {
 	return &JWT{
-		Secret:  secret,
-		Jwksurl: jwksurl,
-		Now:     DefaultNowTime,
+		Secret:          secret,
+		PreviousSecrets: previousSecrets,
+		Jwksurl:         jwksurl,
+		Now:             DefaultNowTime,
 	}
 }
 
+// KeyID identifies the secret signing a token by the kid header of the token
+// without revealing the secret
+func KeyID(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return base64.RawURLEncoding.EncodeToString(sum[:8])
+}
+
+// hmacKey returns the secret whose KeyID is kid. Tokens without a kid were
+// signed before secrets had key IDs and only validate with the current secret.
+func (j *JWT) hmacKey(kid string) ([]byte, error) {
+	if kid == "" || kid == KeyID(j.Secret) {
+		return []byte(j.Secret), nil
+	}
+	for _, secret := range j.PreviousSecrets {
+		if kid == KeyID(secret) {
+			return []byte(secret), nil
+		}
+	}
+	return nil, fmt.Errorf("unknown signing key %s", kid)
+}
+
 // Ensure Claims implements the jwt.Claims interface
 var _ gojwt.Claims = &Claims{}
 
@@ -52,6 +79,11 @@ type Claims struct {
 	// `family_name`, and `middle_name` in the iana link provided above). I should add the discalimer
 	// I'm currently sick, so this thought process might be off.
 	Group string `json:"grp,omitempty"`
+	// Impersonator is the custom `imp` claim of the sessions of SuperAdmins
+	// impersonating the subject, and ImpersonationExpiresAt the `imp_exp` end of
+	// the impersonation. The `act` claim of RFC 8693 was avoided as it is an object.
+	Impersonator           string `json:"imp,omitempty"`
+	ImpersonationExpiresAt int64  `json:"imp_exp,omitempty"`
 }
 
 // Valid adds an empty subject test to the StandardClaims checks.
@@ -79,7 +111,8 @@ func (j *JWT) ValidPrincipal(ctx context.Context, jwtToken Token, lifespan time.
 // KeyFunc verifies HMAC or RSA/RS256 signatures
 func (j *JWT) KeyFunc(token *gojwt.Token) (interface{}, error) {
 	if _, ok := token.Method.(*gojwt.SigningMethodHMAC); ok {
-		return []byte(j.Secret), nil
+		kid, _ := token.Header["kid"].(string)
+		return j.hmacKey(kid)
 	} else if _, ok := token.Method.(*gojwt.SigningMethodRSA); ok {
 		return j.KeyFuncRS256(token)
 	}
@@ -112,6 +145,10 @@ type JWKS struct {
 	Keys []JWK `json:"keys"`
 }
 
+// jwksRefreshInterval limits how often the JWKS document is fetched again
+// when a token is signed by a key missing from the cached document
+const jwksRefreshInterval = time.Minute
+
 // KeyFuncRS256 verifies RS256 signed JWT tokens, it looks up the signing key in the key discovery service
 func (j *JWT) KeyFuncRS256(token *gojwt.Token) (interface{}, error) {
 	// Don't forget to validate the alg is what you expect:
@@ -124,35 +161,64 @@ func (j *JWT) KeyFuncRS256(token *gojwt.Token) (interface{}, error) {
 		return nil, fmt.Errorf("token JWKSURL not specified, cannot validate RS256 signature")
 	}
 
-	rr, err := http.Get(j.Jwksurl)
+	fetch := func() (interface{}, error) {
+		return fetchJWKS(j.Jwksurl)
+	}
+	jwks, err := j.Keys.Get(j.Jwksurl, fetch)
 	if err != nil {
 		return nil, err
 	}
+	key, err := jwks.(JWKS).key(token.Header["kid"])
+	// Providers rotate their keys; fetch the cached document again for
+	// unknown keys
+	if err != nil && j.Keys.enabled() {
+		if jwks, err = j.Keys.Refresh(j.Jwksurl, jwksRefreshInterval, fetch); err != nil {
+			return nil, err
+		}
+		key, err = jwks.(JWKS).key(token.Header["kid"])
+	}
+	return key, err
+}
+
+// fetchJWKS reads the JWKS document of a key discovery service
+func fetchJWKS(jwksurl string) (JWKS, error) {
+	rr, err := http.Get(jwksurl)
+	if err != nil {
+		return JWKS{}, err
+	}
 	defer rr.Body.Close()
+	if rr.StatusCode != http.StatusOK {
+		return JWKS{}, fmt.Errorf("GET %s: %s", jwksurl, rr.Status)
+	}
 	body, err := ioutil.ReadAll(rr.Body)
 	if err != nil {
-		return nil, err
+		return JWKS{}, err
 	}
 
 	// parse json to struct
 	var jwks JWKS
 	if err := json.Unmarshal([]byte(body), &jwks); err != nil {
-		return nil, err
+		return JWKS{}, err
 	}
+	return jwks, nil
+}
 
+// key returns the public key of the certificate of the JWK with kid
+func (jwks JWKS) key(kid interface{}) (interface{}, error) {
 	// extract cert when kid and alg match
 	var certPkix []byte
+	var err error
 	for _, jwk := range jwks.Keys {
-		if token.Header["kid"] == jwk.Kid {
+		if kid == jwk.Kid {
 			// FIXME: optionally walk the key chain, see rfc7517 section 4.7
 			certPkix, err = base64.StdEncoding.DecodeString(jwk.X5c[0])
 			if err != nil {
-				return nil, fmt.Errorf("base64 decode error for JWK kid %v", token.Header["kid"])
+				return nil, fmt.Errorf("base64 decode error for JWK kid %v", kid)
 			}
 		}
 	}
 	if certPkix == nil {
-		return nil, fmt.Errorf("no signing key found for kid %v", token.Header["kid"])
+		return nil, fmt.Errorf("no signing key found for kid %v", kid)
 	}
 
 	// parse certificate (from PKIX format) and return signing key
@@ -195,14 +261,19 @@ func (j *JWT) ValidClaims(jwtToken Token, lifespan time.Duration, alg gojwt.Keyf
 		return Principal{}, fmt.Errorf("claims duration is different from auth lifespan")
 	}
 
-	return Principal{
+	p := Principal{
 		Subject:      claims.Subject,
 		Issuer:       claims.Issuer,
 		Organization: claims.Organization,
 		Group:        claims.Group,
 		ExpiresAt:    exp,
 		IssuedAt:     iat,
-	}, nil
+		Impersonator: claims.Impersonator,
+	}
+	if claims.ImpersonationExpiresAt != 0 {
+		p.ImpersonationExpiresAt = time.Unix(claims.ImpersonationExpiresAt, 0)
+	}
+	return p, nil
 }
 
 // GetClaims extracts claims from id_token
@@ -241,8 +312,13 @@ func (j *JWT) Create(ctx context.Context, user Principal) (Token, error) {
 		},
 		Organization: user.Organization,
 		Group:        user.Group,
+		Impersonator: user.Impersonator,
+	}
+	if !user.ImpersonationExpiresAt.IsZero() {
+		claims.ImpersonationExpiresAt = user.ImpersonationExpiresAt.Unix()
 	}
 	token := gojwt.NewWithClaims(gojwt.SigningMethodHS256, claims)
+	token.Header["kid"] = KeyID(j.Secret)
 	// Sign and get the complete encoded token as a string using the secret
 	t, err := token.SignedString([]byte(j.Secret))
 	// this will only fail if the JSON can't be encoded correctly
@@ -1,6 +1,7 @@
 package oauth2
 
 import (
+	"context"
 	"net/http"
 	"path"
 	"time"
@@ -43,6 +44,7 @@ type AuthMux struct {
 	FailureURL string            // FailureURL is redirect location after authorization failure
 	Now        func() time.Time  // Now returns the current time (for testing)
 	UseIDToken bool              // UseIDToken enables OpenID id_token support
+	Groups     *Cache            // Groups caches the groups of users looked up from the Provider; they are looked up on every login when nil
 }
 
 // Login uses a Cookie with a random string as the state validation method.  JWTs are
@@ -118,14 +120,36 @@ func (j *AuthMux) Callback() http.Handler {
 			return
 		}
 
-		if token.Extra("id_token") != nil && !j.UseIDToken {
+		// OpenID Connect providers verify their id_token themselves
+		verifier, isOIDC := j.Provider.(IDTokenVerifier)
+		if token.Extra("id_token") != nil && !j.UseIDToken && !isOIDC {
 			log.Info("Found an extra id_token, but option --useidtoken is not set")
 		}
 
 		// if we received an extra id_token, inspect it
 		var id string
 		var group string
-		if j.UseIDToken && token.Extra("id_token") != nil && token.Extra("id_token") != "" {
+		if isOIDC {
+			tokenString, _ := token.Extra("id_token").(string)
+			claims, err := verifier.VerifyIDToken(tokenString)
+			if err != nil {
+				log.Error("Invalid id_token received: ", err.Error())
+				http.Redirect(w, r, j.FailureURL, http.StatusTemporaryRedirect)
+				return
+			}
+			id, err = verifier.PrincipalIDFromClaims(claims)
+			if err != nil {
+				log.Error("Requested claim not found in id_token:", err)
+				http.Redirect(w, r, j.FailureURL, http.StatusTemporaryRedirect)
+				return
+			}
+			group, err = verifier.GroupFromClaims(claims)
+			if err != nil {
+				log.Error("Requested claim not found in id_token:", err)
+				http.Redirect(w, r, j.FailureURL, http.StatusTemporaryRedirect)
+				return
+			}
+		} else if j.UseIDToken && token.Extra("id_token") != nil && token.Extra("id_token") != "" {
 			log.Debug("Found an extra id_token")
 			if provider, ok := j.Provider.(ExtendedProvider); ok {
 				log.Debug("Provider implements PrincipalIDFromClaims()")
@@ -167,7 +191,7 @@ func (j *AuthMux) Callback() http.Handler {
 				http.Redirect(w, r, j.FailureURL, http.StatusTemporaryRedirect)
 				return
 			}
-			group, err = j.Provider.Group(oauthClient)
+			group, err = j.group(id, conf, token)
 			if err != nil {
 				log.Error("Unable to get OAuth Group", err.Error())
 				http.Redirect(w, r, j.FailureURL, http.StatusTemporaryRedirect)
@@ -192,6 +216,19 @@ func (j *AuthMux) Callback() http.Handler {
 	})
 }
 
+// group looks up the groups of the user id from the Provider, unless they
+// are cached. Expired groups are refreshed in the background with the token
+// of the login, which is why the client is not bound to the request.
+func (j *AuthMux) group(id string, conf *oauth2.Config, token *oauth2.Token) (string, error) {
+	group, err := j.Groups.Get(j.Provider.Name()+"/"+id, func() (interface{}, error) {
+		return j.Provider.Group(conf.Client(context.Background(), token))
+	})
+	if err != nil {
+		return "", err
+	}
+	return group.(string), nil
+}
+
 // Logout handler will expire our authentication cookie and redirect to the successURL
 func (j *AuthMux) Logout() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -37,6 +37,10 @@ type Principal struct {
 	Group        string
 	ExpiresAt    time.Time
 	IssuedAt     time.Time
+	// Impersonator is the ID of the SuperAdmin impersonating the subject;
+	// the session of an impersonation ends at ImpersonationExpiresAt.
+	Impersonator           string
+	ImpersonationExpiresAt time.Time
 }
 
 /* Interfaces */
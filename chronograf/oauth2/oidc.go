@@ -0,0 +1,446 @@
+package oauth2
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	gojwt "github.com/dgrijalva/jwt-go"
+	"github.com/influxdata/influxdb/chronograf"
+	"golang.org/x/oauth2"
+)
+
+// IDTokenVerifier is an ExtendedProvider that verifies the id_token of an
+// OpenID Connect token response itself rather than by the Tokenizer of the
+// AuthMux, which only knows the keys of a single issuer.
+type IDTokenVerifier interface {
+	ExtendedProvider
+	// VerifyIDToken returns the claims of a valid id_token
+	VerifyIDToken(idToken string) (gojwt.MapClaims, error)
+}
+
+var _ IDTokenVerifier = &OIDC{}
+
+// keysRefreshInterval limits how often the keys of an issuer are fetched
+// when an id_token is signed by an unknown key
+const keysRefreshInterval = time.Minute
+
+// OIDC is an OpenID Connect provider that only needs the URL of its issuer.
+// The endpoints and keys of the issuer are discovered from its
+// /.well-known/openid-configuration by Discover.
+type OIDC struct {
+	PageName       string // Name displayed on the login page
+	ClientID       string
+	ClientSecret   string
+	RequiredScopes []string // RequiredScopes always include openid
+	Domains        []string // Optional email domain checking
+	RedirectURL    string
+	IssuerURL      string
+	UsernameClaim  string       // UsernameClaim is the claim naming the user, email by default
+	GroupsClaim    string       // GroupsClaim is the optional claim listing the groups of the user, which mappings match instead of the email domain
+	Client         *http.Client // Client fetches the configuration and keys of the issuer
+	Logger         chronograf.Logger
+	Now            func() time.Time // Now returns the current time (for testing)
+
+	discovery oidcDiscovery
+
+	mu          sync.Mutex
+	keys        map[string]interface{}
+	keysFetched time.Time
+}
+
+// oidcDiscovery is the OpenID Provider Configuration of an issuer
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Discover fetches the configuration of the issuer and its signing keys
+func (o *OIDC) Discover(ctx context.Context) error {
+	var d oidcDiscovery
+	u := strings.TrimSuffix(o.IssuerURL, "/") + "/.well-known/openid-configuration"
+	if err := o.getJSON(ctx, u, &d); err != nil {
+		return fmt.Errorf("unable to discover the OpenID configuration of %s: %v", o.IssuerURL, err)
+	}
+	// The issuer must be identical to the URL the configuration was
+	// retrieved from so that another issuer cannot be impersonated
+	if strings.TrimSuffix(d.Issuer, "/") != strings.TrimSuffix(o.IssuerURL, "/") {
+		return fmt.Errorf("OpenID configuration of %s is issued by %s", o.IssuerURL, d.Issuer)
+	}
+	if d.AuthorizationEndpoint == "" || d.TokenEndpoint == "" || d.JWKSURI == "" {
+		return fmt.Errorf("OpenID configuration of %s is incomplete", o.IssuerURL)
+	}
+	o.discovery = d
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.fetchKeys(ctx)
+}
+
+// JWKSURL returns the URL of the signing keys of the issuer, which is known
+// once the issuer is discovered
+func (o *OIDC) JWKSURL() string {
+	return o.discovery.JWKSURI
+}
+
+// Name is the name of the provider
+func (o *OIDC) Name() string {
+	if o.PageName == "" {
+		return "oidc"
+	}
+	return o.PageName
+}
+
+// ID returns the client id
+func (o *OIDC) ID() string {
+	return o.ClientID
+}
+
+// Secret returns the client secret
+func (o *OIDC) Secret() string {
+	return o.ClientSecret
+}
+
+// Scopes returns the scopes requested of the issuer including openid
+func (o *OIDC) Scopes() []string {
+	for _, scope := range o.RequiredScopes {
+		if scope == "openid" {
+			return o.RequiredScopes
+		}
+	}
+	return append([]string{"openid"}, o.RequiredScopes...)
+}
+
+// Config is the OAuth2 exchange information and the discovered endpoints
+func (o *OIDC) Config() *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     o.ID(),
+		ClientSecret: o.Secret(),
+		Scopes:       o.Scopes(),
+		RedirectURL:  o.RedirectURL,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  o.discovery.AuthorizationEndpoint,
+			TokenURL: o.discovery.TokenEndpoint,
+		},
+	}
+}
+
+// PrincipalID returns the username claim of the userinfo of the user
+func (o *OIDC) PrincipalID(provider *http.Client) (string, error) {
+	claims, err := o.userinfo(provider)
+	if err != nil {
+		return "", err
+	}
+	return o.PrincipalIDFromClaims(claims)
+}
+
+// Group returns the groups claim or the email domain of the userinfo of
+// the user
+func (o *OIDC) Group(provider *http.Client) (string, error) {
+	claims, err := o.userinfo(provider)
+	if err != nil {
+		return "", err
+	}
+	return o.GroupFromClaims(claims)
+}
+
+func (o *OIDC) userinfo(provider *http.Client) (gojwt.MapClaims, error) {
+	if o.discovery.UserinfoEndpoint == "" {
+		return nil, fmt.Errorf("issuer %s has no userinfo endpoint", o.IssuerURL)
+	}
+	r, err := provider.Get(o.discovery.UserinfoEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+	claims := gojwt.MapClaims{}
+	if err := json.NewDecoder(r.Body).Decode(&claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// PrincipalIDFromClaims returns the username claim. Unverified email
+// addresses are rejected as anyone could claim them.
+func (o *OIDC) PrincipalIDFromClaims(claims gojwt.MapClaims) (string, error) {
+	claim := o.UsernameClaim
+	if claim == "" {
+		claim = "email"
+	}
+	id, _ := claims[claim].(string)
+	if id == "" {
+		return "", fmt.Errorf("no claim for %s", claim)
+	}
+	if claim == "email" {
+		if verified, ok := claims["email_verified"].(bool); ok && !verified {
+			return "", fmt.Errorf("email address %s is not verified", id)
+		}
+	}
+	if len(o.Domains) > 0 && !ofDomain(o.Domains, id) {
+		o.Logger.Error("Not a member of required domain.")
+		return "", fmt.Errorf("not a member of required domain")
+	}
+	return id, nil
+}
+
+// GroupFromClaims returns the groups claim if present or else the domain of
+// the email address of the user
+func (o *OIDC) GroupFromClaims(claims gojwt.MapClaims) (string, error) {
+	if o.GroupsClaim != "" {
+		if groups, ok := claims[o.GroupsClaim]; ok {
+			return groupsOf(groups), nil
+		}
+	}
+	email, _ := claims["email"].(string)
+	if i := strings.LastIndex(email, "@"); i >= 0 {
+		return email[i+1:], nil
+	}
+	return "", nil
+}
+
+// VerifyIDToken checks the signature of an id_token by the keys of the
+// issuer and that it was issued by the issuer to this client
+func (o *OIDC) VerifyIDToken(idToken string) (gojwt.MapClaims, error) {
+	if idToken == "" {
+		return nil, errors.New("token response has no id_token")
+	}
+	gojwt.TimeFunc = o.now
+	claims := gojwt.MapClaims{}
+	if _, err := gojwt.ParseWithClaims(idToken, claims, o.keyFunc); err != nil {
+		return nil, err
+	}
+
+	if iss, _ := claims["iss"].(string); iss != o.discovery.Issuer {
+		return nil, fmt.Errorf("id_token is issued by %s", iss)
+	}
+	aud := audiences(claims["aud"])
+	if !contains(aud, o.ClientID) {
+		return nil, errors.New("id_token is not issued to this client")
+	}
+	// An id_token of several audiences must name this client as the
+	// authorized party
+	if len(aud) > 1 {
+		if azp, _ := claims["azp"].(string); azp != o.ClientID {
+			return nil, errors.New("id_token is not authorized for this client")
+		}
+	}
+	if _, ok := claims["exp"]; !ok {
+		return nil, errors.New("id_token does not expire")
+	}
+	if sub, _ := claims["sub"].(string); sub == "" {
+		return nil, errors.New("id_token has no subject")
+	}
+	return claims, nil
+}
+
+// audiences returns the aud claim, which is a string or an array of strings
+func audiences(claim interface{}) []string {
+	switch aud := claim.(type) {
+	case string:
+		return []string{aud}
+	case []interface{}:
+		auds := make([]string, 0, len(aud))
+		for _, a := range aud {
+			if s, ok := a.(string); ok {
+				auds = append(auds, s)
+			}
+		}
+		return auds
+	}
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// keyFunc returns the key of the issuer that signed a token. Only asymmetric
+// signatures are accepted so that the client secret cannot sign tokens.
+func (o *OIDC) keyFunc(token *gojwt.Token) (interface{}, error) {
+	switch token.Method.(type) {
+	case *gojwt.SigningMethodRSA, *gojwt.SigningMethodECDSA:
+	default:
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+	kid, _ := token.Header["kid"].(string)
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if key := o.key(kid); key != nil {
+		return key, nil
+	}
+	// Issuers rotate their keys; refetch them for unknown keys
+	if o.now().Sub(o.keysFetched) >= keysRefreshInterval {
+		if err := o.fetchKeys(context.Background()); err != nil {
+			return nil, err
+		}
+		if key := o.key(kid); key != nil {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("no signing key found for kid %v", kid)
+}
+
+// key returns the key with kid or the only key of an issuer for tokens
+// without kid. o.mu must be held.
+func (o *OIDC) key(kid string) interface{} {
+	if kid == "" && len(o.keys) == 1 {
+		for _, key := range o.keys {
+			return key
+		}
+	}
+	return o.keys[kid]
+}
+
+// fetchKeys reads the signing keys of the issuer. o.mu must be held.
+func (o *OIDC) fetchKeys(ctx context.Context) error {
+	var jwks struct {
+		Keys []oidcJWK `json:"keys"`
+	}
+	if err := o.getJSON(ctx, o.discovery.JWKSURI, &jwks); err != nil {
+		return fmt.Errorf("unable to fetch the keys of %s: %v", o.IssuerURL, err)
+	}
+	keys := map[string]interface{}{}
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			o.Logger.Error("Unable to read key ", jwk.Kid, " of ", o.IssuerURL, ": ", err.Error())
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	o.keys = keys
+	o.keysFetched = o.now()
+	return nil
+}
+
+func (o *OIDC) getJSON(ctx context.Context, u string, v interface{}) error {
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return err
+	}
+	client := o.Client
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", u, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+func (o *OIDC) now() time.Time {
+	if o.Now != nil {
+		return o.Now()
+	}
+	return time.Now()
+}
+
+// oidcJWK is a JSON Web Key as defined by RFC 7517 and 7518
+type oidcJWK struct {
+	Kty string   `json:"kty"`
+	Use string   `json:"use"`
+	Kid string   `json:"kid"`
+	N   string   `json:"n"`
+	E   string   `json:"e"`
+	Crv string   `json:"crv"`
+	X   string   `json:"x"`
+	Y   string   `json:"y"`
+	X5c []string `json:"x5c"`
+}
+
+func (k oidcJWK) publicKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		if k.N == "" && len(k.X5c) > 0 {
+			return k.certificateKey()
+		}
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		if k.X == "" && len(k.X5c) > 0 {
+			return k.certificateKey()
+		}
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("point is not on the curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+	return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+}
+
+func (k oidcJWK) certificateKey() (interface{}, error) {
+	der, err := base64.StdEncoding.DecodeString(k.X5c[0])
+	if err != nil {
+		return nil, err
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+	return cert.PublicKey, nil
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, errors.New("empty key parameter")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,240 @@
+package oauth2_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	gojwt "github.com/dgrijalva/jwt-go"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/oauth2"
+)
+
+// newOIDCIssuer serves the OpenID configuration and keys of an issuer
+// signing with key
+func newOIDCIssuer(t *testing.T, key *rsa.PrivateKey) *httptest.Server {
+	t.Helper()
+	var issuer *httptest.Server
+	issuer = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/.well-known/openid-configuration":
+			_ = json.NewEncoder(rw).Encode(map[string]string{
+				"issuer":                 issuer.URL,
+				"authorization_endpoint": issuer.URL + "/authorize",
+				"token_endpoint":         issuer.URL + "/token",
+				"userinfo_endpoint":      issuer.URL + "/userinfo",
+				"jwks_uri":               issuer.URL + "/keys",
+			})
+		case "/keys":
+			_ = json.NewEncoder(rw).Encode(map[string]interface{}{
+				"keys": []map[string]string{
+					{
+						"kty": "RSA",
+						"use": "sig",
+						"kid": "key1",
+						"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+						"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+					},
+				},
+			})
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return issuer
+}
+
+func TestOIDC_Discover(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	issuer := newOIDCIssuer(t, key)
+	defer issuer.Close()
+
+	prov := oauth2.OIDC{
+		IssuerURL: issuer.URL,
+		Logger:    &chronograf.NoopLogger{},
+	}
+	if err := prov.Discover(context.Background()); err != nil {
+		t.Fatal("Unexpected error discovering issuer: err:", err)
+	}
+	config := prov.Config()
+	if config.Endpoint.AuthURL != issuer.URL+"/authorize" || config.Endpoint.TokenURL != issuer.URL+"/token" {
+		t.Errorf("Config().Endpoint = %v", config.Endpoint)
+	}
+	if got := prov.Scopes(); len(got) != 1 || got[0] != "openid" {
+		t.Errorf("Scopes() = %v, want [openid]", got)
+	}
+
+	// An issuer must not be able to impersonate another
+	other := oauth2.OIDC{
+		IssuerURL: issuer.URL + "/other",
+		Logger:    &chronograf.NoopLogger{},
+	}
+	if err := other.Discover(context.Background()); err == nil {
+		t.Error("Discover() accepted the configuration of another issuer")
+	}
+}
+
+func TestOIDC_VerifyIDToken(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	issuer := newOIDCIssuer(t, key)
+	defer issuer.Close()
+
+	now := time.Date(2018, time.June, 1, 12, 0, 0, 0, time.UTC)
+	claims := func(override gojwt.MapClaims) gojwt.MapClaims {
+		c := gojwt.MapClaims{
+			"iss":            issuer.URL,
+			"aud":            "chronograf",
+			"sub":            "1234",
+			"exp":            now.Add(time.Hour).Unix(),
+			"iat":            now.Unix(),
+			"email":          "martymcfly@pinheads.rok",
+			"email_verified": true,
+			"groups":         []string{"admins", "writers"},
+		}
+		for k, v := range override {
+			if v == nil {
+				delete(c, k)
+				continue
+			}
+			c[k] = v
+		}
+		return c
+	}
+	sign := func(method gojwt.SigningMethod, key interface{}, c gojwt.MapClaims) string {
+		token := gojwt.NewWithClaims(method, c)
+		token.Header["kid"] = "key1"
+		s, err := token.SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+
+	tests := []struct {
+		name      string
+		token     string
+		wantID    string
+		wantGroup string
+		wantErr   bool
+	}{
+		{
+			name:      "Valid id_token",
+			token:     sign(gojwt.SigningMethodRS256, key, claims(nil)),
+			wantID:    "martymcfly@pinheads.rok",
+			wantGroup: "admins,writers",
+		},
+		{
+			name:      "Several audiences authorized for the client",
+			token:     sign(gojwt.SigningMethodRS256, key, claims(gojwt.MapClaims{"aud": []string{"chronograf", "other"}, "azp": "chronograf"})),
+			wantID:    "martymcfly@pinheads.rok",
+			wantGroup: "admins,writers",
+		},
+		{
+			name:      "Email domain without groups claim",
+			token:     sign(gojwt.SigningMethodRS256, key, claims(gojwt.MapClaims{"groups": nil})),
+			wantID:    "martymcfly@pinheads.rok",
+			wantGroup: "pinheads.rok",
+		},
+		{
+			name:    "Several audiences not authorized for the client",
+			token:   sign(gojwt.SigningMethodRS256, key, claims(gojwt.MapClaims{"aud": []string{"chronograf", "other"}, "azp": "other"})),
+			wantErr: true,
+		},
+		{
+			name:    "Issued to another client",
+			token:   sign(gojwt.SigningMethodRS256, key, claims(gojwt.MapClaims{"aud": "other"})),
+			wantErr: true,
+		},
+		{
+			name:    "Issued by another issuer",
+			token:   sign(gojwt.SigningMethodRS256, key, claims(gojwt.MapClaims{"iss": "https://evil.example.com"})),
+			wantErr: true,
+		},
+		{
+			name:    "Expired",
+			token:   sign(gojwt.SigningMethodRS256, key, claims(gojwt.MapClaims{"exp": now.Add(-time.Minute).Unix()})),
+			wantErr: true,
+		},
+		{
+			name:    "Without expiration",
+			token:   sign(gojwt.SigningMethodRS256, key, claims(gojwt.MapClaims{"exp": nil})),
+			wantErr: true,
+		},
+		{
+			name:    "Signed by another key",
+			token:   sign(gojwt.SigningMethodRS256, otherKey, claims(nil)),
+			wantErr: true,
+		},
+		{
+			name:    "Signed by the client secret",
+			token:   sign(gojwt.SigningMethodHS256, []byte("secret"), claims(nil)),
+			wantErr: true,
+		},
+		{
+			name:    "Unverified email address",
+			token:   sign(gojwt.SigningMethodRS256, key, claims(gojwt.MapClaims{"email_verified": false})),
+			wantErr: true,
+		},
+		{
+			name:    "Email address of another domain",
+			token:   sign(gojwt.SigningMethodRS256, key, claims(gojwt.MapClaims{"email": "biff@tannen.com"})),
+			wantErr: true,
+		},
+		{
+			name:    "No id_token",
+			token:   "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prov := oauth2.OIDC{
+				ClientID:     "chronograf",
+				ClientSecret: "secret",
+				IssuerURL:    issuer.URL,
+				Domains:      []string{"pinheads.rok"},
+				GroupsClaim:  "groups",
+				Logger:       &chronograf.NoopLogger{},
+				Now:          func() time.Time { return now },
+			}
+			if err := prov.Discover(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+
+			claims, err := prov.VerifyIDToken(tt.token)
+			var id, group string
+			if err == nil {
+				id, err = prov.PrincipalIDFromClaims(claims)
+			}
+			if err == nil {
+				group, err = prov.GroupFromClaims(claims)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("VerifyIDToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if id != tt.wantID {
+				t.Errorf("PrincipalIDFromClaims() = %q, want %q", id, tt.wantID)
+			}
+			if group != tt.wantGroup {
+				t.Errorf("GroupFromClaims() = %q, want %q", group, tt.wantGroup)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package organizations
+
+import (
+	"context"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// ensure that AnnotationsStore implements chronograf.AnnotationStore
+var _ chronograf.AnnotationStore = &AnnotationsStore{}
+
+// AnnotationsStore facade on an AnnotationStore that filters annotations by
+// organization.
+type AnnotationsStore struct {
+	store        chronograf.AnnotationStore
+	organization string
+}
+
+// NewAnnotationsStore creates a new AnnotationsStore from an existing
+// chronograf.AnnotationStore and an organization string
+func NewAnnotationsStore(s chronograf.AnnotationStore, org string) *AnnotationsStore {
+	return &AnnotationsStore{
+		store:        s,
+		organization: org,
+	}
+}
+
+// All retrieves the annotations between start and stop from the underlying
+// AnnotationStore and filters them by organization.
+func (s *AnnotationsStore) All(ctx context.Context, start, stop time.Time) ([]chronograf.Annotation, error) {
+	err := validOrganization(ctx)
+	if err != nil {
+		return nil, err
+	}
+	as, err := s.store.All(ctx, start, stop)
+	if err != nil {
+		return nil, err
+	}
+
+	// This filters annotations without allocating
+	// https://github.com/golang/go/wiki/SliceTricks#filtering-without-allocating
+	annotations := as[:0]
+	for _, a := range as {
+		if a.Organization == s.organization {
+			annotations = append(annotations, a)
+		}
+	}
+
+	return annotations, nil
+}
+
+// Add creates a new Annotation in the AnnotationStore with
+// annotation.Organization set to be the organization from the annotation store.
+func (s *AnnotationsStore) Add(ctx context.Context, a *chronograf.Annotation) (*chronograf.Annotation, error) {
+	err := validOrganization(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	a.Organization = s.organization
+	return s.store.Add(ctx, a)
+}
+
+// Delete the annotation from AnnotationStore
+func (s *AnnotationsStore) Delete(ctx context.Context, id string) error {
+	err := validOrganization(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.Get(ctx, id); err != nil {
+		return err
+	}
+
+	return s.store.Delete(ctx, id)
+}
+
+// Get returns an Annotation if it exists and belongs to the organization that is set.
+func (s *AnnotationsStore) Get(ctx context.Context, id string) (*chronograf.Annotation, error) {
+	err := validOrganization(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := s.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if a.Organization != s.organization {
+		return nil, chronograf.ErrAnnotationNotFound
+	}
+
+	return a, nil
+}
+
+// Update the annotation in AnnotationStore.
+func (s *AnnotationsStore) Update(ctx context.Context, a *chronograf.Annotation) error {
+	err := validOrganization(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.Get(ctx, a.ID)
+	if err != nil {
+		return err
+	}
+
+	a.Organization = s.organization
+	return s.store.Update(ctx, a)
+}
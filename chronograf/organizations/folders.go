@@ -0,0 +1,111 @@
+package organizations
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// ensure that FoldersStore implements chronograf.FoldersStore
+var _ chronograf.FoldersStore = &FoldersStore{}
+
+// FoldersStore facade on a FoldersStore that filters folders by organization.
+type FoldersStore struct {
+	store        chronograf.FoldersStore
+	organization string
+}
+
+// NewFoldersStore creates a new FoldersStore from an existing
+// chronograf.FoldersStore and an organization string
+func NewFoldersStore(s chronograf.FoldersStore, org string) *FoldersStore {
+	return &FoldersStore{
+		store:        s,
+		organization: org,
+	}
+}
+
+// All retrieves all folders from the underlying FoldersStore and filters them
+// by organization.
+func (s *FoldersStore) All(ctx context.Context) ([]chronograf.Folder, error) {
+	err := validOrganization(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fs, err := s.store.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// This filters folders without allocating
+	// https://github.com/golang/go/wiki/SliceTricks#filtering-without-allocating
+	folders := fs[:0]
+	for _, f := range fs {
+		if f.Organization == s.organization {
+			folders = append(folders, f)
+		}
+	}
+
+	return folders, nil
+}
+
+// Add creates a new Folder in the FoldersStore with folder.Organization set to
+// be the organization from the folder store.
+func (s *FoldersStore) Add(ctx context.Context, f *chronograf.Folder) (*chronograf.Folder, error) {
+	err := validOrganization(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	f.Organization = s.organization
+	return s.store.Add(ctx, f)
+}
+
+// Delete the folder from FoldersStore
+func (s *FoldersStore) Delete(ctx context.Context, f *chronograf.Folder) error {
+	err := validOrganization(ctx)
+	if err != nil {
+		return err
+	}
+
+	f, err = s.Get(ctx, f.ID)
+	if err != nil {
+		return err
+	}
+
+	return s.store.Delete(ctx, f)
+}
+
+// Get returns a Folder if it exists and belongs to the organization that is set.
+func (s *FoldersStore) Get(ctx context.Context, id string) (*chronograf.Folder, error) {
+	err := validOrganization(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := s.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if f.Organization != s.organization {
+		return nil, chronograf.ErrFolderNotFound
+	}
+
+	return f, nil
+}
+
+// Update the folder in FoldersStore.
+func (s *FoldersStore) Update(ctx context.Context, f *chronograf.Folder) error {
+	err := validOrganization(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.Get(ctx, f.ID)
+	if err != nil {
+		return err
+	}
+
+	f.Organization = s.organization
+	return s.store.Update(ctx, f)
+}
@@ -0,0 +1,55 @@
+package organizations_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+	"github.com/influxdata/influxdb/chronograf/organizations"
+)
+
+func TestFolders_All(t *testing.T) {
+	store := &mocks.FoldersStore{
+		AllF: func(ctx context.Context) ([]chronograf.Folder, error) {
+			return []chronograf.Folder{
+				{ID: "1", Name: "Operations", Organization: "1337"},
+				{ID: "2", Name: "Databases", Organization: "1338"},
+			}, nil
+		},
+	}
+	ctx := context.WithValue(context.Background(), organizations.ContextKey, "1337")
+	got, err := organizations.NewFoldersStore(store, "1337").All(ctx)
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	want := []chronograf.Folder{{ID: "1", Name: "Operations", Organization: "1337"}}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("All() diff (-got +want):\n%s", diff)
+	}
+}
+
+func TestFolders_Update(t *testing.T) {
+	var updated *chronograf.Folder
+	store := &mocks.FoldersStore{
+		GetF: func(ctx context.Context, id string) (*chronograf.Folder, error) {
+			return &chronograf.Folder{ID: id, Name: "Databases", Organization: "1338"}, nil
+		},
+		UpdateF: func(ctx context.Context, f *chronograf.Folder) error {
+			updated = f
+			return nil
+		},
+	}
+	ctx := context.WithValue(context.Background(), organizations.ContextKey, "1337")
+	f := &chronograf.Folder{ID: "2", Name: "Storage", Organization: "1338"}
+	if err := organizations.NewFoldersStore(store, "1337").Update(ctx, f); err != chronograf.ErrFolderNotFound {
+		t.Errorf("Update() of folder of another organization error = %v, want %v", err, chronograf.ErrFolderNotFound)
+	}
+	if updated != nil {
+		t.Errorf("Update() updated the folder of another organization")
+	}
+	if err := organizations.NewFoldersStore(store, "1338").Update(ctx, f); err != nil || updated == nil {
+		t.Errorf("Update() error = %v", err)
+	}
+}
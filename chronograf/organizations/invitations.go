@@ -0,0 +1,96 @@
+package organizations
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// ensure that InvitationsStore implements chronograf.InvitationsStore
+var _ chronograf.InvitationsStore = &InvitationsStore{}
+
+// InvitationsStore facade on an InvitationsStore that filters invitations
+// by organization.
+type InvitationsStore struct {
+	store        chronograf.InvitationsStore
+	organization string
+}
+
+// NewInvitationsStore creates a new InvitationsStore from an existing
+// chronograf.InvitationsStore and an organization string
+func NewInvitationsStore(s chronograf.InvitationsStore, org string) *InvitationsStore {
+	return &InvitationsStore{
+		store:        s,
+		organization: org,
+	}
+}
+
+// All retrieves all invitations from the underlying InvitationsStore and
+// filters them by organization.
+func (s *InvitationsStore) All(ctx context.Context) ([]chronograf.Invitation, error) {
+	err := validOrganization(ctx)
+	if err != nil {
+		return nil, err
+	}
+	is, err := s.store.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// This filters invitations without allocating
+	// https://github.com/golang/go/wiki/SliceTricks#filtering-without-allocating
+	invitations := is[:0]
+	for _, i := range is {
+		if i.Organization == s.organization {
+			invitations = append(invitations, i)
+		}
+	}
+
+	return invitations, nil
+}
+
+// Add creates a new Invitation in the InvitationsStore with
+// invitation.Organization set to be the organization from the invitation store.
+func (s *InvitationsStore) Add(ctx context.Context, i *chronograf.Invitation) (*chronograf.Invitation, error) {
+	err := validOrganization(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	i.Organization = s.organization
+	return s.store.Add(ctx, i)
+}
+
+// Delete the invitation from InvitationsStore
+func (s *InvitationsStore) Delete(ctx context.Context, i *chronograf.Invitation) error {
+	err := validOrganization(ctx)
+	if err != nil {
+		return err
+	}
+
+	i, err = s.Get(ctx, i.ID)
+	if err != nil {
+		return err
+	}
+
+	return s.store.Delete(ctx, i)
+}
+
+// Get returns an Invitation if it exists and belongs to the organization that is set.
+func (s *InvitationsStore) Get(ctx context.Context, id string) (*chronograf.Invitation, error) {
+	err := validOrganization(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	i, err := s.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if i.Organization != s.organization {
+		return nil, chronograf.ErrInvitationNotFound
+	}
+
+	return i, nil
+}
@@ -0,0 +1,58 @@
+package organizations_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+	"github.com/influxdata/influxdb/chronograf/organizations"
+)
+
+func TestInvitations_All(t *testing.T) {
+	store := &mocks.InvitationsStore{
+		AllF: func(ctx context.Context) ([]chronograf.Invitation, error) {
+			return []chronograf.Invitation{
+				{ID: "1", Name: "marty", Organization: "1337"},
+				{ID: "2", Name: "doc", Organization: "1338"},
+			}, nil
+		},
+	}
+	ctx := context.WithValue(context.Background(), organizations.ContextKey, "1337")
+	got, err := organizations.NewInvitationsStore(store, "1337").All(ctx)
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	want := []chronograf.Invitation{{ID: "1", Name: "marty", Organization: "1337"}}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("All() diff (-got +want):\n%s", diff)
+	}
+
+	if _, err := organizations.NewInvitationsStore(store, "1337").All(context.Background()); err == nil {
+		t.Errorf("All() without organization on context succeeded")
+	}
+}
+
+func TestInvitations_Delete(t *testing.T) {
+	deleted := false
+	store := &mocks.InvitationsStore{
+		GetF: func(ctx context.Context, id string) (*chronograf.Invitation, error) {
+			return &chronograf.Invitation{ID: id, Name: "doc", Organization: "1338"}, nil
+		},
+		DeleteF: func(ctx context.Context, i *chronograf.Invitation) error {
+			deleted = true
+			return nil
+		},
+	}
+	ctx := context.WithValue(context.Background(), organizations.ContextKey, "1337")
+	if err := organizations.NewInvitationsStore(store, "1337").Delete(ctx, &chronograf.Invitation{ID: "2"}); err != chronograf.ErrInvitationNotFound {
+		t.Errorf("Delete() of invitation of another organization error = %v, want %v", err, chronograf.ErrInvitationNotFound)
+	}
+	if deleted {
+		t.Errorf("Delete() deleted the invitation of another organization")
+	}
+	if err := organizations.NewInvitationsStore(store, "1338").Delete(ctx, &chronograf.Invitation{ID: "2"}); err != nil || !deleted {
+		t.Errorf("Delete() error = %v", err)
+	}
+}
@@ -0,0 +1,111 @@
+package organizations
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// ensure that ReportsStore implements chronograf.ReportsStore
+var _ chronograf.ReportsStore = &ReportsStore{}
+
+// ReportsStore facade on a ReportsStore that filters reports by organization.
+type ReportsStore struct {
+	store        chronograf.ReportsStore
+	organization string
+}
+
+// NewReportsStore creates a new ReportsStore from an existing
+// chronograf.ReportsStore and an organization string
+func NewReportsStore(s chronograf.ReportsStore, org string) *ReportsStore {
+	return &ReportsStore{
+		store:        s,
+		organization: org,
+	}
+}
+
+// All retrieves all reports from the underlying ReportsStore and filters them
+// by organization.
+func (s *ReportsStore) All(ctx context.Context) ([]chronograf.Report, error) {
+	err := validOrganization(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rs, err := s.store.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// This filters reports without allocating
+	// https://github.com/golang/go/wiki/SliceTricks#filtering-without-allocating
+	reports := rs[:0]
+	for _, r := range rs {
+		if r.Organization == s.organization {
+			reports = append(reports, r)
+		}
+	}
+
+	return reports, nil
+}
+
+// Add creates a new Report in the ReportsStore with report.Organization set to
+// be the organization from the report store.
+func (s *ReportsStore) Add(ctx context.Context, r *chronograf.Report) (*chronograf.Report, error) {
+	err := validOrganization(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r.Organization = s.organization
+	return s.store.Add(ctx, r)
+}
+
+// Delete the report from ReportsStore
+func (s *ReportsStore) Delete(ctx context.Context, r *chronograf.Report) error {
+	err := validOrganization(ctx)
+	if err != nil {
+		return err
+	}
+
+	r, err = s.Get(ctx, r.ID)
+	if err != nil {
+		return err
+	}
+
+	return s.store.Delete(ctx, r)
+}
+
+// Get returns a Report if it exists and belongs to the organization that is set.
+func (s *ReportsStore) Get(ctx context.Context, id string) (*chronograf.Report, error) {
+	err := validOrganization(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := s.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Organization != s.organization {
+		return nil, chronograf.ErrReportNotFound
+	}
+
+	return r, nil
+}
+
+// Update the report in ReportsStore.
+func (s *ReportsStore) Update(ctx context.Context, r *chronograf.Report) error {
+	err := validOrganization(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.Get(ctx, r.ID)
+	if err != nil {
+		return err
+	}
+
+	r.Organization = s.organization
+	return s.store.Update(ctx, r)
+}
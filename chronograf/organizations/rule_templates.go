@@ -0,0 +1,113 @@
+package organizations
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// ensure that RuleTemplatesStore implements chronograf.RuleTemplatesStore
+var _ chronograf.RuleTemplatesStore = &RuleTemplatesStore{}
+
+// RuleTemplatesStore facade on a RuleTemplatesStore that filters rule
+// templates by organization.
+type RuleTemplatesStore struct {
+	store        chronograf.RuleTemplatesStore
+	organization string
+}
+
+// NewRuleTemplatesStore creates a new RuleTemplatesStore from an existing
+// chronograf.RuleTemplatesStore and an organization string
+func NewRuleTemplatesStore(s chronograf.RuleTemplatesStore, org string) *RuleTemplatesStore {
+	return &RuleTemplatesStore{
+		store:        s,
+		organization: org,
+	}
+}
+
+// All retrieves all rule templates from the underlying RuleTemplatesStore and
+// filters them by organization.
+func (s *RuleTemplatesStore) All(ctx context.Context) ([]chronograf.RuleTemplate, error) {
+	err := validOrganization(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ts, err := s.store.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// This filters rule templates without allocating
+	// https://github.com/golang/go/wiki/SliceTricks#filtering-without-allocating
+	templates := ts[:0]
+	for _, t := range ts {
+		if t.Organization == s.organization {
+			templates = append(templates, t)
+		}
+	}
+
+	return templates, nil
+}
+
+// Add creates a new RuleTemplate in the RuleTemplatesStore with
+// template.Organization set to be the organization from the template store.
+func (s *RuleTemplatesStore) Add(ctx context.Context, t *chronograf.RuleTemplate) (*chronograf.RuleTemplate, error) {
+	err := validOrganization(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	t.Organization = s.organization
+	return s.store.Add(ctx, t)
+}
+
+// Delete the rule template from RuleTemplatesStore
+func (s *RuleTemplatesStore) Delete(ctx context.Context, t *chronograf.RuleTemplate) error {
+	err := validOrganization(ctx)
+	if err != nil {
+		return err
+	}
+
+	t, err = s.Get(ctx, t.ID)
+	if err != nil {
+		return err
+	}
+
+	return s.store.Delete(ctx, t)
+}
+
+// Get returns a RuleTemplate if it exists and belongs to the organization
+// that is set.
+func (s *RuleTemplatesStore) Get(ctx context.Context, id string) (*chronograf.RuleTemplate, error) {
+	err := validOrganization(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := s.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.Organization != s.organization {
+		return nil, chronograf.ErrRuleTemplateNotFound
+	}
+
+	return t, nil
+}
+
+// Update the rule template in RuleTemplatesStore.
+func (s *RuleTemplatesStore) Update(ctx context.Context, t *chronograf.RuleTemplate) error {
+	err := validOrganization(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.Get(ctx, t.ID)
+	if err != nil {
+		return err
+	}
+
+	t.Organization = s.organization
+	return s.store.Update(ctx, t)
+}
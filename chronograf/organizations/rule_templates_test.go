@@ -0,0 +1,55 @@
+package organizations_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+	"github.com/influxdata/influxdb/chronograf/organizations"
+)
+
+func TestRuleTemplates_All(t *testing.T) {
+	store := &mocks.RuleTemplatesStore{
+		AllF: func(ctx context.Context) ([]chronograf.RuleTemplate, error) {
+			return []chronograf.RuleTemplate{
+				{ID: "1", Name: "High CPU", Organization: "1337"},
+				{ID: "2", Name: "Disk full", Organization: "1338"},
+			}, nil
+		},
+	}
+	ctx := context.WithValue(context.Background(), organizations.ContextKey, "1337")
+	got, err := organizations.NewRuleTemplatesStore(store, "1337").All(ctx)
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	want := []chronograf.RuleTemplate{{ID: "1", Name: "High CPU", Organization: "1337"}}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("All() diff (-got +want):\n%s", diff)
+	}
+}
+
+func TestRuleTemplates_Delete(t *testing.T) {
+	var deleted *chronograf.RuleTemplate
+	store := &mocks.RuleTemplatesStore{
+		GetF: func(ctx context.Context, id string) (*chronograf.RuleTemplate, error) {
+			return &chronograf.RuleTemplate{ID: id, Name: "Disk full", Organization: "1338"}, nil
+		},
+		DeleteF: func(ctx context.Context, t *chronograf.RuleTemplate) error {
+			deleted = t
+			return nil
+		},
+	}
+	ctx := context.WithValue(context.Background(), organizations.ContextKey, "1337")
+	tmpl := &chronograf.RuleTemplate{ID: "2"}
+	if err := organizations.NewRuleTemplatesStore(store, "1337").Delete(ctx, tmpl); err != chronograf.ErrRuleTemplateNotFound {
+		t.Errorf("Delete() of rule template of another organization error = %v, want %v", err, chronograf.ErrRuleTemplateNotFound)
+	}
+	if deleted != nil {
+		t.Errorf("Delete() removed the rule template of another organization")
+	}
+	if err := organizations.NewRuleTemplatesStore(store, "1338").Delete(ctx, tmpl); err != nil || deleted == nil {
+		t.Errorf("Delete() error = %v", err)
+	}
+}
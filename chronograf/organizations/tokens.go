@@ -0,0 +1,112 @@
+package organizations
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// ensure that TokensStore implements chronograf.TokensStore
+var _ chronograf.TokensStore = &TokensStore{}
+
+// TokensStore facade on a TokensStore that filters tokens
+// by organization.
+type TokensStore struct {
+	store        chronograf.TokensStore
+	organization string
+}
+
+// NewTokensStore creates a new TokensStore from an existing
+// chronograf.TokensStore and an organization string
+func NewTokensStore(s chronograf.TokensStore, org string) *TokensStore {
+	return &TokensStore{
+		store:        s,
+		organization: org,
+	}
+}
+
+// All retrieves all tokens from the underlying TokensStore and filters them
+// by organization.
+func (s *TokensStore) All(ctx context.Context) ([]chronograf.Token, error) {
+	err := validOrganization(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ts, err := s.store.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// This filters tokens without allocating
+	// https://github.com/golang/go/wiki/SliceTricks#filtering-without-allocating
+	tokens := ts[:0]
+	for _, t := range ts {
+		if t.Organization == s.organization {
+			tokens = append(tokens, t)
+		}
+	}
+
+	return tokens, nil
+}
+
+// Add creates a new Token in the TokensStore with token.Organization set to be the
+// organization from the token store.
+func (s *TokensStore) Add(ctx context.Context, t *chronograf.Token) (*chronograf.Token, error) {
+	err := validOrganization(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	t.Organization = s.organization
+	return s.store.Add(ctx, t)
+}
+
+// Delete the token from TokensStore
+func (s *TokensStore) Delete(ctx context.Context, t *chronograf.Token) error {
+	err := validOrganization(ctx)
+	if err != nil {
+		return err
+	}
+
+	t, err = s.Get(ctx, chronograf.TokenQuery{ID: &t.ID})
+	if err != nil {
+		return err
+	}
+
+	return s.store.Delete(ctx, t)
+}
+
+// Get returns a Token if it exists and belongs to the organization that is set.
+func (s *TokensStore) Get(ctx context.Context, q chronograf.TokenQuery) (*chronograf.Token, error) {
+	err := validOrganization(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := s.store.Get(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.Organization != s.organization {
+		return nil, chronograf.ErrTokenNotFound
+	}
+
+	return t, nil
+}
+
+// Update the token in TokensStore.
+func (s *TokensStore) Update(ctx context.Context, t *chronograf.Token) error {
+	err := validOrganization(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.Get(ctx, chronograf.TokenQuery{ID: &t.ID})
+	if err != nil {
+		return err
+	}
+
+	t.Organization = s.organization
+	return s.store.Update(ctx, t)
+}
@@ -0,0 +1,51 @@
+package organizations_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+	"github.com/influxdata/influxdb/chronograf/organizations"
+)
+
+func TestTokens_All(t *testing.T) {
+	store := &mocks.TokensStore{
+		AllF: func(ctx context.Context) ([]chronograf.Token, error) {
+			return []chronograf.Token{
+				{ID: "1", Name: "grafana", Organization: "1337"},
+				{ID: "2", Name: "ci", Organization: "1338"},
+			}, nil
+		},
+	}
+	ctx := context.WithValue(context.Background(), organizations.ContextKey, "1337")
+	got, err := organizations.NewTokensStore(store, "1337").All(ctx)
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	want := []chronograf.Token{{ID: "1", Name: "grafana", Organization: "1337"}}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("All() diff (-got +want):\n%s", diff)
+	}
+
+	if _, err := organizations.NewTokensStore(store, "1337").All(context.Background()); err == nil {
+		t.Errorf("All() without organization on context succeeded")
+	}
+}
+
+func TestTokens_Get(t *testing.T) {
+	store := &mocks.TokensStore{
+		GetF: func(ctx context.Context, q chronograf.TokenQuery) (*chronograf.Token, error) {
+			return &chronograf.Token{ID: *q.ID, Name: "ci", Organization: "1338"}, nil
+		},
+	}
+	ctx := context.WithValue(context.Background(), organizations.ContextKey, "1337")
+	id := "2"
+	if _, err := organizations.NewTokensStore(store, "1337").Get(ctx, chronograf.TokenQuery{ID: &id}); err != chronograf.ErrTokenNotFound {
+		t.Errorf("Get() of token of another organization error = %v, want %v", err, chronograf.ErrTokenNotFound)
+	}
+	if _, err := organizations.NewTokensStore(store, "1338").Get(ctx, chronograf.TokenQuery{ID: &id}); err != nil {
+		t.Errorf("Get() error = %v", err)
+	}
+}
@@ -100,13 +100,7 @@ func (s *UsersStore) Add(ctx context.Context, u *chronograf.User) (*chronograf.U
 		return nil, err
 	}
 
-	// retrieve the user from the underlying store
-	usr, err := s.store.Get(ctx, chronograf.UserQuery{
-		Name:     &u.Name,
-		Provider: &u.Provider,
-		Scheme:   &u.Scheme,
-	})
-
+	usr, err := s.merge(ctx, u)
 	switch err {
 	case nil:
 		// If there is no error continue to the rest of the code
@@ -119,6 +113,74 @@ func (s *UsersStore) Add(ctx context.Context, u *chronograf.User) (*chronograf.U
 		return nil, err
 	}
 
+	// Update the user in the underlying store
+	if err := s.store.Update(ctx, usr); err != nil {
+		return nil, err
+	}
+
+	// Return the provided user with ID set
+	u.ID = usr.ID
+	return u, nil
+}
+
+// AddMany creates users in the UsersStore as Add does. Every user is validated
+// before any of them is written. Users not found in the underlying store are
+// added to it at once, after which the users found are updated.
+func (s *UsersStore) AddMany(ctx context.Context, us []*chronograf.User) ([]*chronograf.User, error) {
+	err := validOrganization(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	added := []*chronograf.User{}
+	merged := map[*chronograf.User]*chronograf.User{}
+	for _, u := range us {
+		if err := validOrganizationRoles(s.organization, u); err != nil {
+			return nil, err
+		}
+		usr, err := s.merge(ctx, u)
+		switch err {
+		case nil:
+			merged[u] = usr
+		case chronograf.ErrUserNotFound:
+			added = append(added, u)
+		default:
+			return nil, err
+		}
+	}
+
+	if len(added) > 0 {
+		res, err := s.store.AddMany(ctx, added)
+		if err != nil {
+			return nil, err
+		}
+		for i, u := range added {
+			u.ID = res[i].ID
+		}
+	}
+
+	for u, usr := range merged {
+		if err := s.store.Update(ctx, usr); err != nil {
+			return nil, err
+		}
+		u.ID = usr.ID
+	}
+	return us, nil
+}
+
+// merge retrieves u from the underlying store and grants it the roles of u.
+// It returns chronograf.ErrUserNotFound if u does not exist in the underlying store.
+func (s *UsersStore) merge(ctx context.Context, u *chronograf.User) (*chronograf.User, error) {
+	// retrieve the user from the underlying store
+	usr, err := s.store.Get(ctx, chronograf.UserQuery{
+		Name:     &u.Name,
+		Provider: &u.Provider,
+		Scheme:   &u.Scheme,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	// Filter the retrieved users roles so that the resulting struct only contains roles
 	// that are not from the organization on the UsersStore.
 	roles := usr.Roles[:0]
@@ -156,14 +218,7 @@ func (s *UsersStore) Add(ctx context.Context, u *chronograf.User) (*chronograf.U
 		usr.SuperAdmin = true
 	}
 
-	// Update the user in the underlying store
-	if err := s.store.Update(ctx, usr); err != nil {
-		return nil, err
-	}
-
-	// Return the provided user with ID set
-	u.ID = usr.ID
-	return u, nil
+	return usr, nil
 }
 
 // Delete a user from the UsersStore. This is done by stripping a user of
@@ -199,15 +254,42 @@ func (s *UsersStore) Update(ctx context.Context, usr *chronograf.User) error {
 		return err
 	}
 
+	user, err := s.withOtherRoles(ctx, usr)
+	if err != nil {
+		return err
+	}
+	return s.store.Update(ctx, user)
+}
+
+// UpdateMany updates users in the UsersStore as Update does, either all of
+// them or none of them.
+func (s *UsersStore) UpdateMany(ctx context.Context, us []*chronograf.User) error {
+	err := validOrganization(ctx)
+	if err != nil {
+		return err
+	}
+
+	users := make([]*chronograf.User, len(us))
+	for i, usr := range us {
+		if users[i], err = s.withOtherRoles(ctx, usr); err != nil {
+			return err
+		}
+	}
+	return s.store.UpdateMany(ctx, users)
+}
+
+// withOtherRoles returns a copy of usr with its roles in the organization
+// added to the roles the user has in other organizations.
+func (s *UsersStore) withOtherRoles(ctx context.Context, usr *chronograf.User) (*chronograf.User, error) {
 	// Validates that the users roles are only for the current organization.
 	if err := validOrganizationRoles(s.organization, usr); err != nil {
-		return err
+		return nil, err
 	}
 
 	// retrieve the user from the underlying store
 	u, err := s.store.Get(ctx, chronograf.UserQuery{ID: &usr.ID})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Filter the retrieved users roles so that the resulting slice contains
@@ -227,7 +309,7 @@ func (s *UsersStore) Update(ctx context.Context, usr *chronograf.User) error {
 	// and the user that was found in the underlying store
 	user.Roles = append(roles, usr.Roles...)
 
-	return s.store.Update(ctx, &user)
+	return &user, nil
 }
 
 // All returns all users where roles have been filters to be exclusively for
@@ -282,3 +364,40 @@ func (s *UsersStore) Num(ctx context.Context) (int, error) {
 
 	return len(usrs), nil
 }
+
+// Filter returns the users of the organization matching f. Roles are matched
+// against the role of the user within the organization only.
+func (s *UsersStore) Filter(ctx context.Context, f chronograf.UserFilter) ([]chronograf.User, error) {
+	err := validOrganization(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Roles in other organizations must not match, so only the remaining
+	// fields are given to the underlying UsersStore.
+	role := f.Role
+	f.Role = ""
+	usrs, err := s.store.Filter(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	us := usrs[:0]
+	for _, usr := range usrs {
+		roles := usr.Roles[:0]
+		for _, r := range usr.Roles {
+			if r.Organization == s.organization {
+				roles = append(roles, r)
+			}
+		}
+		if len(roles) == 0 {
+			continue
+		}
+		usr.Roles = roles
+		if (chronograf.UserFilter{Role: role}).Matches(&usr) {
+			us = append(us, usr)
+		}
+	}
+
+	return us, nil
+}
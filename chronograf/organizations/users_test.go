@@ -1099,3 +1099,52 @@ func TestUsersStore_Num(t *testing.T) {
 		}
 	}
 }
+
+func TestUsersStore_Filter(t *testing.T) {
+	underlying := &mocks.UsersStore{
+		FilterF: func(ctx context.Context, f chronograf.UserFilter) ([]chronograf.User, error) {
+			if f.Role != "" {
+				t.Errorf("Filter() passed role %q to the underlying store", f.Role)
+			}
+			return []chronograf.User{
+				{
+					Name: "howdy",
+					Roles: []chronograf.Role{
+						{Organization: "1338", Name: "viewer"},
+						{Organization: "1336", Name: "admin"},
+					},
+				},
+				{
+					Name: "doody",
+					Roles: []chronograf.Role{
+						{Organization: "1338", Name: "admin"},
+					},
+				},
+				{
+					Name: "other",
+					Roles: []chronograf.Role{
+						{Organization: "1336", Name: "admin"},
+					},
+				},
+			}, nil
+		},
+	}
+	ctx := context.WithValue(context.Background(), organizations.ContextKey, "1338")
+	s := organizations.NewUsersStore(underlying, "1338")
+
+	got, err := s.Filter(ctx, chronograf.UserFilter{Role: "admin"})
+	if err != nil {
+		t.Fatalf("Filter() error = %v", err)
+	}
+	want := []chronograf.User{
+		{
+			Name: "doody",
+			Roles: []chronograf.Role{
+				{Organization: "1338", Name: "admin"},
+			},
+		},
+	}
+	if diff := cmp.Diff(got, want, userCmpOptions...); diff != "" {
+		t.Errorf("Filter() diff (-got +want):\n%s", diff)
+	}
+}
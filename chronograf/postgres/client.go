@@ -0,0 +1,171 @@
+// Package postgres stores Chronograf users, dashboards, sources, servers and
+// layouts in PostgreSQL, so that managed databases keep them, back them up
+// consistently and share them between Chronograf replicas.
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/id"
+	// Register the PostgreSQL driver of database/sql
+	_ "github.com/lib/pq"
+)
+
+// Client is a client for PostgreSQL
+type Client struct {
+	URL          string // URL of the database, e.g. postgres://chronograf@localhost/chronograf?sslmode=disable
+	MaxOpenConns int    // MaxOpenConns bounds the connections to the database; zero is unlimited
+	Now          func() time.Time
+
+	db     *sql.DB
+	logger chronograf.Logger
+
+	SourcesStore    *SourcesStore
+	ServersStore    *ServersStore
+	LayoutsStore    *LayoutsStore
+	DashboardsStore *DashboardsStore
+	UsersStore      *UsersStore
+}
+
+// NewClient initializes all stores
+func NewClient() *Client {
+	c := &Client{Now: time.Now}
+	c.SourcesStore = &SourcesStore{client: c}
+	c.ServersStore = &ServersStore{client: c}
+	c.LayoutsStore = &LayoutsStore{
+		client: c,
+		IDs:    &id.UUID{},
+	}
+	c.DashboardsStore = &DashboardsStore{
+		client: c,
+		IDs:    &id.UUID{},
+	}
+	c.UsersStore = &UsersStore{client: c}
+	return c
+}
+
+// WithDB sets the database of a client. It should not be called after a
+// call to Open.
+func (c *Client) WithDB(db *sql.DB) {
+	c.db = db
+}
+
+// Open connects to the database and migrates its schema
+func (c *Client) Open(ctx context.Context, logger chronograf.Logger) error {
+	c.logger = logger
+	if c.db == nil {
+		db, err := sql.Open("postgres", c.URL)
+		if err != nil {
+			return fmt.Errorf("unable to open postgres: %v", err)
+		}
+		db.SetMaxOpenConns(c.MaxOpenConns)
+		c.db = db
+	}
+
+	if err := c.db.PingContext(ctx); err != nil {
+		return fmt.Errorf("unable to connect to postgres: %v", err)
+	}
+	if err := c.migrate(ctx); err != nil {
+		return fmt.Errorf("unable to migrate postgres: %v", err)
+	}
+	return nil
+}
+
+// Close the connections to the database
+func (c *Client) Close() error {
+	if c.db != nil {
+		return c.db.Close()
+	}
+	return nil
+}
+
+// update calls fn within a transaction holding an exclusive lock of table,
+// so that replicas change a table one at a time like the writers of BoltDB.
+// Readers are not blocked.
+func (c *Client) update(ctx context.Context, table string, fn func(*sql.Tx) error) error {
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "LOCK TABLE "+table+" IN EXCLUSIVE MODE"); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// querier is implemented by both *sql.DB and *sql.Tx
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// each calls fn with the id and data of every row of a table in id order
+func each(ctx context.Context, q querier, table string, fn func(id int64, data []byte) error) error {
+	rows, err := q.QueryContext(ctx, "SELECT id, data FROM "+table+" ORDER BY id")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id   int64
+			data []byte
+		)
+		if err := rows.Scan(&id, &data); err != nil {
+			return err
+		}
+		if err := fn(id, data); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// get returns the data of the row with id or nil if there is no such row
+func get(ctx context.Context, q querier, table string, id interface{}) ([]byte, error) {
+	var data []byte
+	err := q.QueryRowContext(ctx, "SELECT data FROM "+table+" WHERE id = $1", id).Scan(&data)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return data, err
+}
+
+// insert adds an empty row of an organization to a table and returns its id
+func insert(ctx context.Context, q querier, table, organization string) (int64, error) {
+	var id int64
+	err := q.QueryRowContext(ctx, "INSERT INTO "+table+" (organization, data) VALUES ($1, '') RETURNING id", organization).Scan(&id)
+	return id, err
+}
+
+// put replaces the organization and data of the row with id. It returns
+// false if there is no such row.
+func put(ctx context.Context, q querier, table string, id int64, organization string, data []byte) (bool, error) {
+	res, err := q.ExecContext(ctx, "UPDATE "+table+" SET organization = $1, data = $2 WHERE id = $3", organization, data, id)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+// remove deletes the row with id. It returns false if there is no such row.
+func remove(ctx context.Context, q querier, table string, id interface{}) (bool, error) {
+	res, err := q.ExecContext(ctx, "DELETE FROM "+table+" WHERE id = $1", id)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt"
+)
+
+// Ensure DashboardsStore implements chronograf.DashboardsStore.
+var _ chronograf.DashboardsStore = &DashboardsStore{}
+
+// DashboardsStore is the PostgreSQL implementation of storing dashboards
+type DashboardsStore struct {
+	client *Client
+	IDs    chronograf.ID
+}
+
+// All returns all known dashboards
+func (d *DashboardsStore) All(ctx context.Context) ([]chronograf.Dashboard, error) {
+	var srcs []chronograf.Dashboard
+	if err := each(ctx, d.client.db, DashboardsTable, func(id int64, data []byte) error {
+		var src chronograf.Dashboard
+		if err := bolt.UnmarshalDashboard(data, &src); err != nil {
+			return err
+		}
+		srcs = append(srcs, src)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return srcs, nil
+}
+
+// Add creates a new Dashboard in the DashboardsStore
+func (d *DashboardsStore) Add(ctx context.Context, src chronograf.Dashboard) (chronograf.Dashboard, error) {
+	if err := d.client.update(ctx, DashboardsTable, func(tx *sql.Tx) error {
+		id, err := insert(ctx, tx, DashboardsTable, src.Organization)
+		if err != nil {
+			return err
+		}
+
+		src.ID = chronograf.DashboardID(id)
+		for i, cell := range src.Cells {
+			cid, err := d.IDs.Generate()
+			if err != nil {
+				return err
+			}
+			cell.ID = cid
+			src.Cells[i] = cell
+		}
+		data, err := bolt.MarshalDashboard(src)
+		if err != nil {
+			return err
+		}
+		_, err = put(ctx, tx, DashboardsTable, id, src.Organization, data)
+		return err
+	}); err != nil {
+		return chronograf.Dashboard{}, err
+	}
+
+	return src, nil
+}
+
+// Get returns a Dashboard if the id exists.
+func (d *DashboardsStore) Get(ctx context.Context, id chronograf.DashboardID) (chronograf.Dashboard, error) {
+	data, err := get(ctx, d.client.db, DashboardsTable, int64(id))
+	if err != nil {
+		return chronograf.Dashboard{}, err
+	}
+	if data == nil {
+		return chronograf.Dashboard{}, chronograf.ErrDashboardNotFound
+	}
+
+	var src chronograf.Dashboard
+	if err := bolt.UnmarshalDashboard(data, &src); err != nil {
+		return chronograf.Dashboard{}, err
+	}
+	return src, nil
+}
+
+// Delete the dashboard from DashboardsStore
+func (d *DashboardsStore) Delete(ctx context.Context, dash chronograf.Dashboard) error {
+	_, err := remove(ctx, d.client.db, DashboardsTable, int64(dash.ID))
+	return err
+}
+
+// Update the dashboard in DashboardsStore
+func (d *DashboardsStore) Update(ctx context.Context, dash chronograf.Dashboard) error {
+	for i, cell := range dash.Cells {
+		if cell.ID != "" {
+			continue
+		}
+		cid, err := d.IDs.Generate()
+		if err != nil {
+			return err
+		}
+		cell.ID = cid
+		dash.Cells[i] = cell
+	}
+
+	data, err := bolt.MarshalDashboard(dash)
+	if err != nil {
+		return err
+	}
+	if ok, err := put(ctx, d.client.db, DashboardsTable, int64(dash.ID), dash.Organization, data); err != nil {
+		return err
+	} else if !ok {
+		return chronograf.ErrDashboardNotFound
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt"
+)
+
+// Ensure LayoutsStore implements chronograf.LayoutsStore.
+var _ chronograf.LayoutsStore = &LayoutsStore{}
+
+// LayoutsStore is the PostgreSQL implementation to store layouts
+type LayoutsStore struct {
+	client *Client
+	IDs    chronograf.ID
+}
+
+// All returns all known layouts
+func (s *LayoutsStore) All(ctx context.Context) ([]chronograf.Layout, error) {
+	rows, err := s.client.db.QueryContext(ctx, "SELECT data FROM "+LayoutsTable+" ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var srcs []chronograf.Layout
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		var src chronograf.Layout
+		if err := bolt.UnmarshalLayout(data, &src); err != nil {
+			return nil, err
+		}
+		srcs = append(srcs, src)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return srcs, nil
+}
+
+// Add creates a new Layout in the LayoutsStore.
+func (s *LayoutsStore) Add(ctx context.Context, src chronograf.Layout) (chronograf.Layout, error) {
+	id, err := s.IDs.Generate()
+	if err != nil {
+		return chronograf.Layout{}, err
+	}
+	src.ID = id
+
+	data, err := bolt.MarshalLayout(src)
+	if err != nil {
+		return chronograf.Layout{}, err
+	}
+	if _, err := s.client.db.ExecContext(ctx, "INSERT INTO "+LayoutsTable+" (id, data) VALUES ($1, $2)", src.ID, data); err != nil {
+		return chronograf.Layout{}, err
+	}
+
+	return src, nil
+}
+
+// Delete removes the Layout from the LayoutsStore
+func (s *LayoutsStore) Delete(ctx context.Context, src chronograf.Layout) error {
+	if ok, err := remove(ctx, s.client.db, LayoutsTable, src.ID); err != nil {
+		return err
+	} else if !ok {
+		return chronograf.ErrLayoutNotFound
+	}
+	return nil
+}
+
+// Get returns a Layout if the id exists.
+func (s *LayoutsStore) Get(ctx context.Context, id string) (chronograf.Layout, error) {
+	data, err := get(ctx, s.client.db, LayoutsTable, id)
+	if err != nil {
+		return chronograf.Layout{}, err
+	}
+	if data == nil {
+		return chronograf.Layout{}, chronograf.ErrLayoutNotFound
+	}
+
+	var src chronograf.Layout
+	if err := bolt.UnmarshalLayout(data, &src); err != nil {
+		return chronograf.Layout{}, err
+	}
+	return src, nil
+}
+
+// Update a Layout
+func (s *LayoutsStore) Update(ctx context.Context, src chronograf.Layout) error {
+	data, err := bolt.MarshalLayout(src)
+	if err != nil {
+		return err
+	}
+	res, err := s.client.db.ExecContext(ctx, "UPDATE "+LayoutsTable+" SET data = $1 WHERE id = $2", data, src.ID)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return chronograf.ErrLayoutNotFound
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+)
+
+// Tables of the stores
+const (
+	SourcesTable    = "chronograf_sources"
+	ServersTable    = "chronograf_servers"
+	LayoutsTable    = "chronograf_layouts"
+	DashboardsTable = "chronograf_dashboards"
+	UsersTable      = "chronograf_users"
+
+	// MigrationsTable records the migrations applied to the database
+	MigrationsTable = "chronograf_migrations"
+)
+
+// migrationLock is the advisory lock held while migrating so that replicas
+// starting together apply each migration once
+const migrationLock = 0x6368726f6e6f // "chrono"
+
+type migration struct {
+	version int
+	name    string
+	stmts   []string
+}
+
+// migrations change the schema in order of version. Released migrations
+// must not be changed; add a new one instead.
+var migrations = []migration{
+	{
+		version: 1,
+		name:    "create stores",
+		stmts: []string{
+			`CREATE TABLE ` + SourcesTable + ` (
+				id bigserial PRIMARY KEY,
+				organization text NOT NULL,
+				data bytea NOT NULL
+			)`,
+			`CREATE TABLE ` + ServersTable + ` (
+				id bigserial PRIMARY KEY,
+				organization text NOT NULL,
+				data bytea NOT NULL
+			)`,
+			`CREATE TABLE ` + LayoutsTable + ` (
+				id text PRIMARY KEY,
+				data bytea NOT NULL
+			)`,
+			`CREATE TABLE ` + DashboardsTable + ` (
+				id bigserial PRIMARY KEY,
+				organization text NOT NULL,
+				data bytea NOT NULL
+			)`,
+			`CREATE TABLE ` + UsersTable + ` (
+				id bigserial PRIMARY KEY,
+				name text NOT NULL,
+				provider text NOT NULL,
+				scheme text NOT NULL,
+				data bytea NOT NULL,
+				UNIQUE (name, provider, scheme)
+			)`,
+		},
+	},
+}
+
+// migrate applies the migrations missing from the database
+func (c *Client) migrate(ctx context.Context) error {
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", migrationLock); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS `+MigrationsTable+` (
+		version integer PRIMARY KEY,
+		name text NOT NULL,
+		applied_at timestamptz NOT NULL
+	)`); err != nil {
+		return err
+	}
+
+	var current sql.NullInt64
+	if err := tx.QueryRowContext(ctx, "SELECT max(version) FROM "+MigrationsTable).Scan(&current); err != nil {
+		return err
+	}
+
+	for _, m := range migrations {
+		if int64(m.version) <= current.Int64 {
+			continue
+		}
+		for _, stmt := range m.stmts {
+			if _, err := tx.ExecContext(ctx, stmt); err != nil {
+				return err
+			}
+		}
+		if _, err := tx.ExecContext(ctx, "INSERT INTO "+MigrationsTable+" (version, name, applied_at) VALUES ($1, $2, $3)",
+			m.version, m.name, c.Now().UTC()); err != nil {
+			return err
+		}
+		if c.logger != nil {
+			c.logger.
+				WithField("component", "postgres").
+				WithField("migration", m.name).
+				Info("Applied migration ", m.version)
+		}
+	}
+
+	return tx.Commit()
+}
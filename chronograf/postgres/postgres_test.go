@@ -0,0 +1,298 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/postgres"
+)
+
+// NewTestClient opens a client of an emptied database named by
+// CHRONOGRAF_TEST_POSTGRES_URL and skips the test if it is not set
+func NewTestClient(t *testing.T) *postgres.Client {
+	url := os.Getenv("CHRONOGRAF_TEST_POSTGRES_URL")
+	if url == "" {
+		t.Skip("CHRONOGRAF_TEST_POSTGRES_URL is not set")
+	}
+
+	db, err := sql.Open("postgres", url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	for _, table := range []string{
+		postgres.SourcesTable,
+		postgres.ServersTable,
+		postgres.LayoutsTable,
+		postgres.DashboardsTable,
+		postgres.UsersTable,
+		postgres.MigrationsTable,
+	} {
+		if _, err := db.Exec("DROP TABLE IF EXISTS " + table); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	c := postgres.NewClient()
+	c.URL = url
+	if err := c.Open(context.Background(), &chronograf.NoopLogger{}); err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestClient_Open(t *testing.T) {
+	ctx := context.Background()
+	c := NewTestClient(t)
+	defer c.Close()
+
+	// Opening a migrated database again applies no migration
+	b := postgres.NewClient()
+	b.URL = c.URL
+	if err := b.Open(ctx, &chronograf.NoopLogger{}); err != nil {
+		t.Fatal(err)
+	}
+	defer b.Close()
+}
+
+func TestClient_sharedState(t *testing.T) {
+	ctx := context.Background()
+	a := NewTestClient(t)
+	defer a.Close()
+	b := postgres.NewClient()
+	b.URL = a.URL
+	if err := b.Open(ctx, &chronograf.NoopLogger{}); err != nil {
+		t.Fatal(err)
+	}
+	defer b.Close()
+
+	if _, err := a.UsersStore.Add(ctx, &chronograf.User{Name: "marty", Provider: "github", Scheme: "oauth2"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.UsersStore.Add(ctx, &chronograf.User{Name: "marty", Provider: "github", Scheme: "oauth2"}); err != chronograf.ErrUserAlreadyExists {
+		t.Fatalf("Add() on another replica = %v, want %v", err, chronograf.ErrUserAlreadyExists)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			c := a
+			if i%2 == 1 {
+				c = b
+			}
+			if _, err := c.SourcesStore.Add(ctx, chronograf.Source{Name: strconv.Itoa(i), Default: true}); err != nil {
+				t.Error(err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	srcs, err := a.SourcesStore.All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defaults := 0
+	for _, src := range srcs {
+		if src.Default {
+			defaults++
+		}
+	}
+	if len(srcs) != 5 || defaults != 1 {
+		t.Errorf("All() after concurrent adds = %d sources with %d defaults, want 5 with 1", len(srcs), defaults)
+	}
+}
+func TestSourcesStore(t *testing.T) {
+	ctx := context.Background()
+	c := NewTestClient(t)
+	defer c.Close()
+	s := c.SourcesStore
+
+	first, err := s.Add(ctx, chronograf.Source{Name: "first", URL: "http://localhost:8086"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !first.Default {
+		t.Errorf("first source is not the default")
+	}
+	second, err := s.Add(ctx, chronograf.Source{Name: "second", URL: "http://localhost:8087", Default: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first, err = s.Get(ctx, first.ID); err != nil {
+		t.Fatal(err)
+	} else if first.Default {
+		t.Errorf("first source is still the default after adding a default source")
+	}
+
+	if err := s.Delete(ctx, second); err != nil {
+		t.Fatal(err)
+	}
+	if first, err = s.Get(ctx, first.ID); err != nil {
+		t.Fatal(err)
+	} else if !first.Default {
+		t.Errorf("first source did not become the default after deleting the default source")
+	}
+	if _, err := s.Get(ctx, second.ID); err != chronograf.ErrSourceNotFound {
+		t.Errorf("Get() of a deleted source = %v, want %v", err, chronograf.ErrSourceNotFound)
+	}
+	if err := s.Update(ctx, second); err != chronograf.ErrSourceNotFound {
+		t.Errorf("Update() of a deleted source = %v, want %v", err, chronograf.ErrSourceNotFound)
+	}
+}
+
+func TestServersStore(t *testing.T) {
+	ctx := context.Background()
+	c := NewTestClient(t)
+	defer c.Close()
+	s := c.ServersStore
+
+	first, err := s.Add(ctx, chronograf.Server{Name: "first", URL: "http://localhost:9092"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := s.Add(ctx, chronograf.Server{Name: "second", URL: "http://localhost:9093"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !second.Active {
+		t.Errorf("added server is not active")
+	}
+	if first, err = s.Get(ctx, first.ID); err != nil {
+		t.Fatal(err)
+	} else if first.Active {
+		t.Errorf("first server is still active after adding another server")
+	}
+
+	if err := s.Delete(ctx, first); err != nil {
+		t.Fatal(err)
+	}
+	srvs, err := s.All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(srvs) != 1 || srvs[0].Name != "second" {
+		t.Errorf("All() = %#v, want the second server only", srvs)
+	}
+}
+
+func TestUsersStore(t *testing.T) {
+	ctx := context.Background()
+	c := NewTestClient(t)
+	defer c.Close()
+	s := c.UsersStore
+
+	us, err := s.AddMany(ctx, []*chronograf.User{
+		{Name: "marty", Provider: "github", Scheme: "oauth2"},
+		{Name: "doc", Provider: "github", Scheme: "oauth2"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.AddMany(ctx, []*chronograf.User{
+		{Name: "biff", Provider: "github", Scheme: "oauth2"},
+		{Name: "doc", Provider: "github", Scheme: "oauth2"},
+	}); err != chronograf.ErrUserAlreadyExists {
+		t.Fatalf("AddMany() of an existing user = %v, want %v", err, chronograf.ErrUserAlreadyExists)
+	}
+	if n, err := s.Num(ctx); err != nil || n != 2 {
+		t.Fatalf("Num() = %d, %v, want 2", n, err)
+	}
+
+	name, provider, scheme := "doc", "github", "oauth2"
+	u, err := s.Get(ctx, chronograf.UserQuery{Name: &name, Provider: &provider, Scheme: &scheme})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.ID != us[1].ID {
+		t.Errorf("Get() ID = %d, want %d", u.ID, us[1].ID)
+	}
+
+	u.Roles = []chronograf.Role{{Name: "admin", Organization: "0"}}
+	if err := s.Update(ctx, u); err != nil {
+		t.Fatal(err)
+	}
+	if u, err = s.Get(ctx, chronograf.UserQuery{ID: &u.ID}); err != nil {
+		t.Fatal(err)
+	} else if len(u.Roles) != 1 {
+		t.Errorf("Get() after Update() roles = %#v", u.Roles)
+	}
+
+	if err := s.Delete(ctx, u); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Delete(ctx, u); err != chronograf.ErrUserNotFound {
+		t.Errorf("Delete() of a deleted user = %v, want %v", err, chronograf.ErrUserNotFound)
+	}
+}
+
+func TestDashboardsStore(t *testing.T) {
+	ctx := context.Background()
+	c := NewTestClient(t)
+	defer c.Close()
+	s := c.DashboardsStore
+
+	d, err := s.Add(ctx, chronograf.Dashboard{
+		Name:  "weather",
+		Cells: []chronograf.DashboardCell{{Name: "rain"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Cells[0].ID == "" {
+		t.Errorf("Add() did not set the ID of the cell")
+	}
+
+	d.Cells = append(d.Cells, chronograf.DashboardCell{Name: "wind"})
+	if err := s.Update(ctx, d); err != nil {
+		t.Fatal(err)
+	}
+	got, err := s.Get(ctx, d.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Cells) != 2 || got.Cells[1].ID == "" {
+		t.Errorf("Get() after Update() cells = %#v", got.Cells)
+	}
+	if err := s.Delete(ctx, d); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Get(ctx, d.ID); err != chronograf.ErrDashboardNotFound {
+		t.Errorf("Get() of a deleted dashboard = %v, want %v", err, chronograf.ErrDashboardNotFound)
+	}
+}
+
+func TestLayoutsStore(t *testing.T) {
+	ctx := context.Background()
+	c := NewTestClient(t)
+	defer c.Close()
+	s := c.LayoutsStore
+
+	l, err := s.Add(ctx, chronograf.Layout{Application: "cpu", Measurement: "cpu"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.Measurement = "system"
+	if err := s.Update(ctx, l); err != nil {
+		t.Fatal(err)
+	}
+	got, err := s.Get(ctx, l.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Measurement != "system" {
+		t.Errorf("Get() after Update() measurement = %s", got.Measurement)
+	}
+	if err := s.Delete(ctx, l); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Delete(ctx, l); err != chronograf.ErrLayoutNotFound {
+		t.Errorf("Delete() of a deleted layout = %v, want %v", err, chronograf.ErrLayoutNotFound)
+	}
+}
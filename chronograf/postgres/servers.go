@@ -0,0 +1,137 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt"
+)
+
+// Ensure ServersStore implements chronograf.ServersStore.
+var _ chronograf.ServersStore = &ServersStore{}
+
+// ServersStore is the PostgreSQL implementation to store servers in a store.
+// Used store servers that are associated in some way with a source
+type ServersStore struct {
+	client *Client
+}
+
+// All returns all known servers
+func (s *ServersStore) All(ctx context.Context) ([]chronograf.Server, error) {
+	return s.all(ctx, s.client.db)
+}
+
+// Add creates a new Server in the ServerStore.
+func (s *ServersStore) Add(ctx context.Context, src chronograf.Server) (chronograf.Server, error) {
+	if err := s.client.update(ctx, ServersTable, func(tx *sql.Tx) error {
+		id, err := insert(ctx, tx, ServersTable, src.Organization)
+		if err != nil {
+			return err
+		}
+		src.ID = int(id)
+
+		// make the newly added source "active"
+		if err := s.resetActiveServer(ctx, tx); err != nil {
+			return err
+		}
+		src.Active = true
+
+		data, err := bolt.MarshalServer(src)
+		if err != nil {
+			return err
+		}
+		_, err = put(ctx, tx, ServersTable, id, src.Organization, data)
+		return err
+	}); err != nil {
+		return chronograf.Server{}, err
+	}
+
+	return src, nil
+}
+
+// Delete removes the Server from the ServersStore
+func (s *ServersStore) Delete(ctx context.Context, src chronograf.Server) error {
+	_, err := remove(ctx, s.client.db, ServersTable, int64(src.ID))
+	return err
+}
+
+// Get returns a Server if the id exists.
+func (s *ServersStore) Get(ctx context.Context, id int) (chronograf.Server, error) {
+	data, err := get(ctx, s.client.db, ServersTable, int64(id))
+	if err != nil {
+		return chronograf.Server{}, err
+	}
+	if data == nil {
+		return chronograf.Server{}, chronograf.ErrServerNotFound
+	}
+
+	var src chronograf.Server
+	if err := bolt.UnmarshalServer(data, &src); err != nil {
+		return chronograf.Server{}, err
+	}
+	return src, nil
+}
+
+// Update a Server
+func (s *ServersStore) Update(ctx context.Context, src chronograf.Server) error {
+	return s.client.update(ctx, ServersTable, func(tx *sql.Tx) error {
+		// Get an existing server with the same ID.
+		if data, err := get(ctx, tx, ServersTable, int64(src.ID)); err != nil {
+			return err
+		} else if data == nil {
+			return chronograf.ErrServerNotFound
+		}
+
+		// only one server can be active at a time
+		if src.Active {
+			if err := s.resetActiveServer(ctx, tx); err != nil {
+				return err
+			}
+		}
+
+		data, err := bolt.MarshalServer(src)
+		if err != nil {
+			return err
+		}
+		_, err = put(ctx, tx, ServersTable, int64(src.ID), src.Organization, data)
+		return err
+	})
+}
+
+func (s *ServersStore) all(ctx context.Context, q querier) ([]chronograf.Server, error) {
+	var srcs []chronograf.Server
+	if err := each(ctx, q, ServersTable, func(id int64, data []byte) error {
+		var src chronograf.Server
+		if err := bolt.UnmarshalServer(data, &src); err != nil {
+			return err
+		}
+		srcs = append(srcs, src)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return srcs, nil
+}
+
+// resetActiveServer unsets the Active flag on all sources
+func (s *ServersStore) resetActiveServer(ctx context.Context, tx *sql.Tx) error {
+	srcs, err := s.all(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	for _, other := range srcs {
+		if other.Active {
+			other.Active = false
+			data, err := bolt.MarshalServer(other)
+			if err != nil {
+				return err
+			}
+			if _, err := put(ctx, tx, ServersTable, int64(other.ID), other.Organization, data); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt"
+)
+
+// Ensure SourcesStore implements chronograf.SourcesStore.
+var _ chronograf.SourcesStore = &SourcesStore{}
+
+// SourcesStore is a PostgreSQL implementation to store time-series source information.
+type SourcesStore struct {
+	client *Client
+}
+
+// All returns all known sources
+func (s *SourcesStore) All(ctx context.Context) ([]chronograf.Source, error) {
+	return s.all(ctx, s.client.db)
+}
+
+// Add creates a new Source in the SourceStore.
+func (s *SourcesStore) Add(ctx context.Context, src chronograf.Source) (chronograf.Source, error) {
+	if err := s.client.update(ctx, SourcesTable, func(tx *sql.Tx) error {
+		// force first source added to be default
+		srcs, err := s.all(ctx, tx)
+		if err != nil {
+			return err
+		}
+		if len(srcs) == 0 {
+			src.Default = true
+		}
+
+		id, err := insert(ctx, tx, SourcesTable, src.Organization)
+		if err != nil {
+			return err
+		}
+		src.ID = int(id)
+
+		if src.Default {
+			if err := s.resetDefaultSource(ctx, tx); err != nil {
+				return err
+			}
+		}
+		return s.put(ctx, tx, src)
+	}); err != nil {
+		return chronograf.Source{}, err
+	}
+
+	return src, nil
+}
+
+// Delete removes the Source from the SourcesStore
+func (s *SourcesStore) Delete(ctx context.Context, src chronograf.Source) error {
+	return s.client.update(ctx, SourcesTable, func(tx *sql.Tx) error {
+		if err := s.setRandomDefault(ctx, src, tx); err != nil {
+			return err
+		}
+		_, err := remove(ctx, tx, SourcesTable, int64(src.ID))
+		return err
+	})
+}
+
+// Get returns a Source if the id exists.
+func (s *SourcesStore) Get(ctx context.Context, id int) (chronograf.Source, error) {
+	return s.get(ctx, s.client.db, id)
+}
+
+// Update a Source
+func (s *SourcesStore) Update(ctx context.Context, src chronograf.Source) error {
+	return s.client.update(ctx, SourcesTable, func(tx *sql.Tx) error {
+		return s.update(ctx, src, tx)
+	})
+}
+
+func (s *SourcesStore) all(ctx context.Context, q querier) ([]chronograf.Source, error) {
+	var srcs []chronograf.Source
+	if err := each(ctx, q, SourcesTable, func(id int64, data []byte) error {
+		var src chronograf.Source
+		if err := bolt.UnmarshalSource(data, &src); err != nil {
+			return err
+		}
+		srcs = append(srcs, src)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return srcs, nil
+}
+
+func (s *SourcesStore) get(ctx context.Context, q querier, id int) (chronograf.Source, error) {
+	var src chronograf.Source
+	data, err := get(ctx, q, SourcesTable, int64(id))
+	if err != nil {
+		return src, err
+	}
+	if data == nil {
+		return src, chronograf.ErrSourceNotFound
+	}
+	if err := bolt.UnmarshalSource(data, &src); err != nil {
+		return src, err
+	}
+	return src, nil
+}
+
+func (s *SourcesStore) put(ctx context.Context, tx *sql.Tx, src chronograf.Source) error {
+	data, err := bolt.MarshalSource(src)
+	if err != nil {
+		return err
+	}
+	_, err = put(ctx, tx, SourcesTable, int64(src.ID), src.Organization, data)
+	return err
+}
+
+func (s *SourcesStore) update(ctx context.Context, src chronograf.Source, tx *sql.Tx) error {
+	// Get an existing source with the same ID.
+	if _, err := s.get(ctx, tx, src.ID); err != nil {
+		return err
+	}
+
+	if src.Default {
+		if err := s.resetDefaultSource(ctx, tx); err != nil {
+			return err
+		}
+	}
+
+	return s.put(ctx, tx, src)
+}
+
+// resetDefaultSource unsets the Default flag on all sources
+func (s *SourcesStore) resetDefaultSource(ctx context.Context, tx *sql.Tx) error {
+	srcs, err := s.all(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	for _, other := range srcs {
+		if other.Default {
+			other.Default = false
+			if err := s.put(ctx, tx, other); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// setRandomDefault will locate a source other than the provided
+// chronograf.Source and set it as the default source. If no other sources are
+// available, the provided source will be set to the default source if is not
+// already. It assumes that the provided chronograf.Source has been persisted.
+func (s *SourcesStore) setRandomDefault(ctx context.Context, src chronograf.Source, tx *sql.Tx) error {
+	// Check if requested source is the current default
+	if target, err := s.get(ctx, tx, src.ID); err != nil {
+		return err
+	} else if target.Default {
+		// Locate another source to be the new default
+		srcs, err := s.all(ctx, tx)
+		if err != nil {
+			return err
+		}
+		var other *chronograf.Source
+		for idx := range srcs {
+			other = &srcs[idx]
+			// avoid selecting the source we're about to delete as the new default
+			if other.ID != target.ID {
+				break
+			}
+		}
+
+		// set the other to be the default
+		other.Default = true
+		if err := s.update(ctx, *other, tx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
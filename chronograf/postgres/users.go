@@ -0,0 +1,203 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/bolt"
+)
+
+// Ensure UsersStore implements chronograf.UsersStore.
+var _ chronograf.UsersStore = &UsersStore{}
+
+// UsersStore uses PostgreSQL to store and retrieve users
+type UsersStore struct {
+	client *Client
+}
+
+func (s *UsersStore) each(ctx context.Context, fn func(*chronograf.User)) error {
+	return each(ctx, s.client.db, UsersTable, func(id int64, data []byte) error {
+		var user chronograf.User
+		if err := bolt.UnmarshalUser(data, &user); err != nil {
+			return err
+		}
+		fn(&user)
+		return nil
+	})
+}
+
+// Num returns the number of users in the UsersStore
+func (s *UsersStore) Num(ctx context.Context) (int, error) {
+	var count int
+	if err := s.client.db.QueryRowContext(ctx, "SELECT count(*) FROM "+UsersTable).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// Get searches the UsersStore for user with name
+func (s *UsersStore) Get(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+	var (
+		data []byte
+		err  error
+	)
+	switch {
+	case q.ID != nil:
+		err = s.client.db.QueryRowContext(ctx, "SELECT data FROM "+UsersTable+" WHERE id = $1", int64(*q.ID)).Scan(&data)
+	case q.Name != nil && q.Provider != nil && q.Scheme != nil:
+		err = s.client.db.QueryRowContext(ctx, "SELECT data FROM "+UsersTable+" WHERE name = $1 AND provider = $2 AND scheme = $3",
+			*q.Name, *q.Provider, *q.Scheme).Scan(&data)
+	default:
+		return nil, fmt.Errorf("must specify either ID, or Name, Provider, and Scheme in UserQuery")
+	}
+	if err == sql.ErrNoRows {
+		return nil, chronograf.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var u chronograf.User
+	if err := bolt.UnmarshalUser(data, &u); err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+// Filter returns the users matching f
+func (s *UsersStore) Filter(ctx context.Context, f chronograf.UserFilter) ([]chronograf.User, error) {
+	users := []chronograf.User{}
+	if err := s.each(ctx, func(u *chronograf.User) {
+		if f.Matches(u) {
+			users = append(users, *u)
+		}
+	}); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// Add a new User to the UsersStore.
+func (s *UsersStore) Add(ctx context.Context, u *chronograf.User) (*chronograf.User, error) {
+	if u == nil {
+		return nil, fmt.Errorf("user provided is nil")
+	}
+	us, err := s.AddMany(ctx, []*chronograf.User{u})
+	if err != nil {
+		return nil, err
+	}
+	u.ID = us[0].ID
+	return u, nil
+}
+
+// AddMany adds all users to the UsersStore within a single transaction. If
+// any of the users already exists, none of them are added. The users added are
+// returned with their IDs; us is left unchanged.
+func (s *UsersStore) AddMany(ctx context.Context, us []*chronograf.User) ([]*chronograf.User, error) {
+	for _, u := range us {
+		if u == nil {
+			return nil, fmt.Errorf("user provided is nil")
+		}
+	}
+	added := make([]*chronograf.User, len(us))
+	if err := s.client.update(ctx, UsersTable, func(tx *sql.Tx) error {
+		for i, u := range us {
+			var id int64
+			err := tx.QueryRowContext(ctx, "INSERT INTO "+UsersTable+" (name, provider, scheme, data) VALUES ($1, $2, $3, '') ON CONFLICT DO NOTHING RETURNING id",
+				u.Name, u.Provider, u.Scheme).Scan(&id)
+			if err == sql.ErrNoRows {
+				return chronograf.ErrUserAlreadyExists
+			}
+			if err != nil {
+				return err
+			}
+
+			user := *u
+			user.ID = uint64(id)
+			data, err := bolt.MarshalUser(&user)
+			if err != nil {
+				return err
+			}
+			if _, err := tx.ExecContext(ctx, "UPDATE "+UsersTable+" SET data = $1 WHERE id = $2", data, id); err != nil {
+				return err
+			}
+			added[i] = &user
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return added, nil
+}
+
+// Delete a user from the UsersStore
+func (s *UsersStore) Delete(ctx context.Context, u *chronograf.User) error {
+	if ok, err := remove(ctx, s.client.db, UsersTable, int64(u.ID)); err != nil {
+		return err
+	} else if !ok {
+		return chronograf.ErrUserNotFound
+	}
+	return nil
+}
+
+// Update a user
+func (s *UsersStore) Update(ctx context.Context, u *chronograf.User) error {
+	data, err := bolt.MarshalUser(u)
+	if err != nil {
+		return err
+	}
+	res, err := s.client.db.ExecContext(ctx, "UPDATE "+UsersTable+" SET name = $1, provider = $2, scheme = $3, data = $4 WHERE id = $5",
+		u.Name, u.Provider, u.Scheme, data, int64(u.ID))
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return chronograf.ErrUserNotFound
+	}
+	return nil
+}
+
+// UpdateMany updates all users within a single transaction. If any of the
+// users does not exist, none of them are updated.
+func (s *UsersStore) UpdateMany(ctx context.Context, us []*chronograf.User) error {
+	return s.client.update(ctx, UsersTable, func(tx *sql.Tx) error {
+		for _, u := range us {
+			if u == nil {
+				return fmt.Errorf("user provided is nil")
+			}
+			data, err := bolt.MarshalUser(u)
+			if err != nil {
+				return err
+			}
+			res, err := tx.ExecContext(ctx, "UPDATE "+UsersTable+" SET name = $1, provider = $2, scheme = $3, data = $4 WHERE id = $5",
+				u.Name, u.Provider, u.Scheme, data, int64(u.ID))
+			if err != nil {
+				return err
+			}
+			if n, err := res.RowsAffected(); err != nil {
+				return err
+			} else if n == 0 {
+				return chronograf.ErrUserNotFound
+			}
+		}
+		return nil
+	})
+}
+
+// All returns all users
+func (s *UsersStore) All(ctx context.Context) ([]chronograf.User, error) {
+	var users []chronograf.User
+	if err := s.each(ctx, func(u *chronograf.User) {
+		users = append(users, *u)
+	}); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
@@ -0,0 +1,499 @@
+// Package qrcode encodes text as QR codes (ISO/IEC 18004) in byte mode with
+// error correction level M. It supports versions 1 to 10, which hold up to
+// 213 bytes; enough for the otpauth URIs of authenticator apps.
+package qrcode
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+)
+
+// ErrTooLong is returned for text longer than the largest supported version holds
+var ErrTooLong = errors.New("text is too long for a QR code")
+
+// QuietZone is the width in modules of the light border around a code
+const QuietZone = 4
+
+// versions are the codewords of versions 1 to 10 at error correction level M
+var versions = []struct {
+	codewords int   // codewords is the total number of codewords
+	ecc       int   // ecc is the number of error correction codewords per block
+	blocks    int   // blocks is the number of error correction blocks
+	alignment []int // alignment are the row and column centers of alignment patterns
+}{
+	{26, 10, 1, nil},
+	{44, 16, 1, []int{6, 18}},
+	{70, 26, 1, []int{6, 22}},
+	{100, 18, 2, []int{6, 26}},
+	{134, 24, 2, []int{6, 30}},
+	{172, 16, 4, []int{6, 34}},
+	{196, 18, 4, []int{6, 22, 38}},
+	{242, 22, 4, []int{6, 24, 42}},
+	{292, 22, 5, []int{6, 26, 46}},
+	{346, 26, 5, []int{6, 28, 50}},
+}
+
+// Code is a QR code whose dark modules are true
+type Code struct {
+	Version int
+	Size    int
+	modules [][]bool
+	// function marks the modules of finder, timing and alignment patterns
+	// and of format and version information, which are not masked
+	function [][]bool
+}
+
+// Dark returns whether the module at column x and row y is dark
+func (c *Code) Dark(x, y int) bool {
+	return c.modules[y][x]
+}
+
+// Encode returns the QR code of the smallest version that holds text
+func Encode(text string) (*Code, error) {
+	data := []byte(text)
+	for i, v := range versions {
+		version := i + 1
+		capacity := (v.codewords - v.ecc*v.blocks) * 8
+		countBits := 8
+		if version >= 10 {
+			countBits = 16
+		}
+		if 4+countBits+len(data)*8 > capacity {
+			continue
+		}
+
+		c := newCode(version)
+		c.drawCodewords(c.addECC(encodeData(data, countBits, capacity/8)))
+		best, penalty := 0, -1
+		for mask := 0; mask < 8; mask++ {
+			c.applyMask(mask)
+			c.drawFormat(mask)
+			if p := c.penalty(); penalty < 0 || p < penalty {
+				best, penalty = mask, p
+			}
+			// masks are their own inverse
+			c.applyMask(mask)
+		}
+		c.applyMask(best)
+		c.drawFormat(best)
+		return c, nil
+	}
+	return nil, ErrTooLong
+}
+
+// encodeData returns the data codewords of text in byte mode
+func encodeData(data []byte, countBits, codewords int) []byte {
+	var bits bitBuffer
+	bits.append(0x4, 4) // byte mode
+	bits.append(len(data), countBits)
+	for _, b := range data {
+		bits.append(int(b), 8)
+	}
+	// terminator of up to four zeros and zeros up to a byte boundary
+	terminator := codewords*8 - len(bits)
+	if terminator > 4 {
+		terminator = 4
+	}
+	bits.append(0, terminator)
+	bits.append(0, (8-len(bits)%8)%8)
+
+	out := make([]byte, 0, codewords)
+	for i := 0; i < len(bits); i += 8 {
+		var b byte
+		for _, bit := range bits[i : i+8] {
+			b = b<<1 | bit
+		}
+		out = append(out, b)
+	}
+	for pad := byte(0xEC); len(out) < codewords; pad ^= 0xEC ^ 0x11 {
+		out = append(out, pad)
+	}
+	return out
+}
+
+type bitBuffer []byte
+
+func (b *bitBuffer) append(v, n int) {
+	for i := n - 1; i >= 0; i-- {
+		*b = append(*b, byte(v>>uint(i))&1)
+	}
+}
+
+func newCode(version int) *Code {
+	size := version*4 + 17
+	c := &Code{
+		Version:  version,
+		Size:     size,
+		modules:  make([][]bool, size),
+		function: make([][]bool, size),
+	}
+	for y := range c.modules {
+		c.modules[y] = make([]bool, size)
+		c.function[y] = make([]bool, size)
+	}
+
+	for i := 0; i < size; i++ {
+		c.setFunction(6, i, i%2 == 0)
+		c.setFunction(i, 6, i%2 == 0)
+	}
+	c.drawFinder(3, 3)
+	c.drawFinder(size-4, 3)
+	c.drawFinder(3, size-4)
+
+	align := versions[version-1].alignment
+	last := len(align) - 1
+	for i, y := range align {
+		for j, x := range align {
+			// alignment patterns never overlap finder patterns
+			if i == 0 && j == 0 || i == 0 && j == last || i == last && j == 0 {
+				continue
+			}
+			c.drawAlignment(x, y)
+		}
+	}
+
+	// reserve the format information until the mask is chosen
+	c.drawFormat(0)
+	c.drawVersion()
+	return c
+}
+
+func (c *Code) setFunction(x, y int, dark bool) {
+	c.modules[y][x] = dark
+	c.function[y][x] = true
+}
+
+func (c *Code) drawFinder(x, y int) {
+	for dy := -4; dy <= 4; dy++ {
+		for dx := -4; dx <= 4; dx++ {
+			xx, yy := x+dx, y+dy
+			if xx < 0 || xx >= c.Size || yy < 0 || yy >= c.Size {
+				continue
+			}
+			dist := max(abs(dx), abs(dy))
+			c.setFunction(xx, yy, dist != 2 && dist != 4)
+		}
+	}
+}
+
+func (c *Code) drawAlignment(x, y int) {
+	for dy := -2; dy <= 2; dy++ {
+		for dx := -2; dx <= 2; dx++ {
+			c.setFunction(x+dx, y+dy, max(abs(dx), abs(dy)) != 1)
+		}
+	}
+}
+
+// formatBits returns the BCH encoded format information of level M and mask
+func formatBits(mask int) int {
+	data := 0<<3 | mask // level M is 00
+	rem := data
+	for i := 0; i < 10; i++ {
+		rem = rem<<1 ^ (rem>>9)*0x537
+	}
+	return (data<<10 | rem) ^ 0x5412
+}
+
+func (c *Code) drawFormat(mask int) {
+	bits := formatBits(mask)
+	bit := func(i int) bool { return bits>>uint(i)&1 != 0 }
+
+	// around the top left finder pattern
+	for i := 0; i <= 5; i++ {
+		c.setFunction(8, i, bit(i))
+	}
+	c.setFunction(8, 7, bit(6))
+	c.setFunction(8, 8, bit(7))
+	c.setFunction(7, 8, bit(8))
+	for i := 9; i < 15; i++ {
+		c.setFunction(14-i, 8, bit(i))
+	}
+
+	// next to the other finder patterns
+	for i := 0; i < 8; i++ {
+		c.setFunction(c.Size-1-i, 8, bit(i))
+	}
+	for i := 8; i < 15; i++ {
+		c.setFunction(8, c.Size-15+i, bit(i))
+	}
+	c.setFunction(8, c.Size-8, true) // the dark module
+}
+
+func (c *Code) drawVersion() {
+	if c.Version < 7 {
+		return
+	}
+	rem := c.Version
+	for i := 0; i < 12; i++ {
+		rem = rem<<1 ^ (rem>>11)*0x1F25
+	}
+	bits := c.Version<<12 | rem
+	for i := 0; i < 18; i++ {
+		dark := bits>>uint(i)&1 != 0
+		a, b := c.Size-11+i%3, i/3
+		c.setFunction(a, b, dark)
+		c.setFunction(b, a, dark)
+	}
+}
+
+// addECC splits data into blocks, appends the error correction codewords of
+// each block and interleaves the blocks
+func (c *Code) addECC(data []byte) []byte {
+	v := versions[c.Version-1]
+	shortBlocks := v.blocks - v.codewords%v.blocks
+	shortLen := v.codewords / v.blocks
+	divisor := rsDivisor(v.ecc)
+
+	blocks := make([][]byte, v.blocks)
+	k := 0
+	for i := range blocks {
+		n := shortLen - v.ecc
+		if i >= shortBlocks {
+			n++
+		}
+		block := append([]byte{}, data[k:k+n]...)
+		k += n
+		ecc := rsRemainder(block, divisor)
+		if i < shortBlocks {
+			block = append(block, 0) // skipped while interleaving
+		}
+		blocks[i] = append(block, ecc...)
+	}
+
+	out := make([]byte, 0, v.codewords)
+	for i := range blocks[0] {
+		for j, block := range blocks {
+			if i != shortLen-v.ecc || j >= shortBlocks {
+				out = append(out, block[i])
+			}
+		}
+	}
+	return out
+}
+
+// drawCodewords places the codewords in the zigzag of two module wide
+// columns from the bottom right corner
+func (c *Code) drawCodewords(codewords []byte) {
+	i := 0
+	for right := c.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5 // skip the vertical timing pattern
+		}
+		upward := (right+1)&2 == 0
+		for vert := 0; vert < c.Size; vert++ {
+			for j := 0; j < 2; j++ {
+				x, y := right-j, vert
+				if upward {
+					y = c.Size - 1 - vert
+				}
+				if c.function[y][x] || i >= len(codewords)*8 {
+					continue
+				}
+				c.modules[y][x] = codewords[i>>3]>>uint(7-i&7)&1 != 0
+				i++
+			}
+		}
+	}
+}
+
+func masked(mask, x, y int) bool {
+	switch mask {
+	case 0:
+		return (x+y)%2 == 0
+	case 1:
+		return y%2 == 0
+	case 2:
+		return x%3 == 0
+	case 3:
+		return (x+y)%3 == 0
+	case 4:
+		return (x/3+y/2)%2 == 0
+	case 5:
+		return x*y%2+x*y%3 == 0
+	case 6:
+		return (x*y%2+x*y%3)%2 == 0
+	default:
+		return ((x+y)%2+x*y%3)%2 == 0
+	}
+}
+
+func (c *Code) applyMask(mask int) {
+	for y := 0; y < c.Size; y++ {
+		for x := 0; x < c.Size; x++ {
+			if !c.function[y][x] && masked(mask, x, y) {
+				c.modules[y][x] = !c.modules[y][x]
+			}
+		}
+	}
+}
+
+// penalty scores the patterns of the code that confuse readers
+func (c *Code) penalty() int {
+	const (
+		n1 = 3
+		n2 = 3
+		n3 = 40
+		n4 = 10
+	)
+	result := 0
+	line := func(dark func(i int) bool) {
+		runColor, run := false, 0
+		var history [7]int
+		for i := 0; i < c.Size; i++ {
+			if dark(i) == runColor {
+				run++
+				if run == 5 {
+					result += n1
+				} else if run > 5 {
+					result++
+				}
+				continue
+			}
+			c.addHistory(run, &history)
+			if !runColor {
+				result += countFinderLike(&history) * n3
+			}
+			runColor, run = dark(i), 1
+		}
+		// the quiet zone ends every line with a light run
+		if runColor {
+			c.addHistory(run, &history)
+			run = 0
+		}
+		c.addHistory(run+c.Size, &history)
+		result += countFinderLike(&history) * n3
+	}
+	for y := 0; y < c.Size; y++ {
+		line(func(x int) bool { return c.modules[y][x] })
+	}
+	for x := 0; x < c.Size; x++ {
+		line(func(y int) bool { return c.modules[y][x] })
+	}
+
+	dark := 0
+	for y := 0; y < c.Size; y++ {
+		for x := 0; x < c.Size; x++ {
+			m := c.modules[y][x]
+			if m {
+				dark++
+			}
+			if x < c.Size-1 && y < c.Size-1 &&
+				m == c.modules[y][x+1] && m == c.modules[y+1][x] && m == c.modules[y+1][x+1] {
+				result += n2
+			}
+		}
+	}
+	total := c.Size * c.Size
+	k := (abs(dark*20-total*10)+total-1)/total - 1
+	return result + k*n4
+}
+
+// addHistory records the length of a run; the first run of a line includes
+// the quiet zone
+func (c *Code) addHistory(run int, history *[7]int) {
+	if history[0] == 0 {
+		run += c.Size
+	}
+	copy(history[1:], history[:6])
+	history[0] = run
+}
+
+// countFinderLike counts the 1:1:3:1:1 patterns with a light border of four
+// at either side in the run history
+func countFinderLike(h *[7]int) int {
+	n := h[1]
+	core := n > 0 && h[2] == n && h[3] == n*3 && h[4] == n && h[5] == n
+	count := 0
+	if core && h[0] >= n*4 && h[6] >= n {
+		count++
+	}
+	if core && h[6] >= n*4 && h[0] >= n {
+		count++
+	}
+	return count
+}
+
+// Image returns the code with its quiet zone where each module is scale
+// pixels wide
+func (c *Code) Image(scale int) image.Image {
+	width := (c.Size + 2*QuietZone) * scale
+	img := image.NewGray(image.Rect(0, 0, width, width))
+	for y := 0; y < width; y++ {
+		for x := 0; x < width; x++ {
+			mx, my := x/scale-QuietZone, y/scale-QuietZone
+			if mx >= 0 && mx < c.Size && my >= 0 && my < c.Size && c.modules[my][mx] {
+				img.SetGray(x, y, color.Gray{Y: 0})
+			} else {
+				img.SetGray(x, y, color.Gray{Y: 255})
+			}
+		}
+	}
+	return img
+}
+
+// PNG returns the PNG encoded Image of the code
+func (c *Code) PNG(scale int) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, c.Image(scale)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// rsDivisor returns the generator polynomial of degree for Reed-Solomon
+// codes over GF(2^8) with the polynomial 0x11D, without the leading term
+func rsDivisor(degree int) []byte {
+	result := make([]byte, degree)
+	result[degree-1] = 1
+	root := byte(1)
+	for i := 0; i < degree; i++ {
+		for j := range result {
+			result[j] = gfMultiply(result[j], root)
+			if j+1 < len(result) {
+				result[j] ^= result[j+1]
+			}
+		}
+		root = gfMultiply(root, 0x02)
+	}
+	return result
+}
+
+// rsRemainder returns the error correction codewords of data
+func rsRemainder(data, divisor []byte) []byte {
+	result := make([]byte, len(divisor))
+	for _, b := range data {
+		factor := b ^ result[0]
+		copy(result, result[1:])
+		result[len(result)-1] = 0
+		for i, d := range divisor {
+			result[i] ^= gfMultiply(d, factor)
+		}
+	}
+	return result
+}
+
+func gfMultiply(x, y byte) byte {
+	z := 0
+	for i := 7; i >= 0; i-- {
+		z = z<<1 ^ (z>>7)*0x11D
+		z ^= int(y>>uint(i)&1) * int(x)
+	}
+	return byte(z)
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,255 @@
+package qrcode
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+// decode reads the text of a code back by reversing each step of Encode
+func decode(t *testing.T, c *Code) string {
+	t.Helper()
+
+	// format information around the top left finder pattern
+	format := 0
+	for i := 0; i <= 5; i++ {
+		format |= bit(c.Dark(8, i)) << uint(i)
+	}
+	format |= bit(c.Dark(8, 7))<<6 | bit(c.Dark(8, 8))<<7 | bit(c.Dark(7, 8))<<8
+	for i := 9; i < 15; i++ {
+		format |= bit(c.Dark(14-i, 8)) << uint(i)
+	}
+	// and its copy next to the other finder patterns
+	second := 0
+	for i := 0; i < 8; i++ {
+		second |= bit(c.Dark(c.Size-1-i, 8)) << uint(i)
+	}
+	for i := 8; i < 15; i++ {
+		second |= bit(c.Dark(8, c.Size-15+i)) << uint(i)
+	}
+	if format != second {
+		t.Fatalf("format information %015b differs from its copy %015b", format, second)
+	}
+	mask := -1
+	for m := 0; m < 8; m++ {
+		if formatBits(m) == format {
+			mask = m
+		}
+	}
+	if mask < 0 {
+		t.Fatalf("format information %015b is not of level M", format)
+	}
+
+	if c.Version >= 7 {
+		version := 0
+		for i := 0; i < 18; i++ {
+			version |= bit(c.Dark(c.Size-11+i%3, i/3)) << uint(i)
+		}
+		if version>>12 != c.Version {
+			t.Fatalf("version information %018b is not of version %d", version, c.Version)
+		}
+	}
+
+	// the codewords in zigzag order without the mask
+	function := newCode(c.Version).function
+	var codewords []byte
+	var b byte
+	n := 0
+	for right := c.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		upward := (right+1)&2 == 0
+		for vert := 0; vert < c.Size; vert++ {
+			for j := 0; j < 2; j++ {
+				x, y := right-j, vert
+				if upward {
+					y = c.Size - 1 - vert
+				}
+				if function[y][x] {
+					continue
+				}
+				dark := c.Dark(x, y) != masked(mask, x, y)
+				b = b<<1 | byte(bit(dark))
+				if n++; n%8 == 0 {
+					codewords = append(codewords, b)
+				}
+			}
+		}
+	}
+
+	v := versions[c.Version-1]
+	codewords = codewords[:v.codewords]
+	shortBlocks := v.blocks - v.codewords%v.blocks
+	shortLen := v.codewords / v.blocks
+	blocks := make([][]byte, v.blocks)
+	k := 0
+	for i := 0; i < shortLen+1; i++ {
+		for j := range blocks {
+			// short blocks have no data codeword at the end of the data
+			if i == shortLen-v.ecc && j < shortBlocks {
+				continue
+			}
+			blocks[j] = append(blocks[j], codewords[k])
+			k++
+		}
+	}
+
+	var data []byte
+	for i, block := range blocks {
+		dataLen := len(block) - v.ecc
+		if got := rsRemainder(block[:dataLen], rsDivisor(v.ecc)); !bytes.Equal(got, block[dataLen:]) {
+			t.Fatalf("error correction codewords of block %d are invalid", i)
+		}
+		data = append(data, block[:dataLen]...)
+	}
+
+	if data[0]>>4 != 0x4 {
+		t.Fatalf("mode %04b is not byte mode", data[0]>>4)
+	}
+	var bits []byte
+	for _, b := range data {
+		for i := 7; i >= 0; i-- {
+			bits = append(bits, b>>uint(i)&1)
+		}
+	}
+	read := func(from, n int) int {
+		v := 0
+		for _, bit := range bits[from : from+n] {
+			v = v<<1 | int(bit)
+		}
+		return v
+	}
+	countBits := 8
+	if c.Version >= 10 {
+		countBits = 16
+	}
+	count := read(4, countBits)
+	text := make([]byte, count)
+	for i := range text {
+		text[i] = byte(read(4+countBits+8*i, 8))
+	}
+	return string(text)
+}
+
+func bit(dark bool) int {
+	if dark {
+		return 1
+	}
+	return 0
+}
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		version int
+	}{
+		{
+			name:    "Version 1",
+			text:    "chronograf",
+			version: 1,
+		},
+		{
+			name:    "Version 5 with two blocks",
+			text:    strings.Repeat("x", 80),
+			version: 5,
+		},
+		{
+			name:    "Version 7 with version information",
+			text:    "otpauth://totp/Chronograf:marty?secret=JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP&issuer=Chronograf&algorithm=SHA1&digits=6",
+			version: 7,
+		},
+		{
+			name:    "Version 8 with blocks of two lengths",
+			text:    strings.Repeat("y", 150),
+			version: 8,
+		},
+		{
+			name:    "Version 10 with a 16 bit count",
+			text:    strings.Repeat("z", 213),
+			version: 10,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Encode(tt.text)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if c.Version != tt.version || c.Size != tt.version*4+17 {
+				t.Errorf("Encode() version = %d, size = %d, want version %d", c.Version, c.Size, tt.version)
+			}
+			if got := decode(t, c); got != tt.text {
+				t.Errorf("Encode() decodes to %q, want %q", got, tt.text)
+			}
+		})
+	}
+}
+
+func TestEncode_tooLong(t *testing.T) {
+	if _, err := Encode(strings.Repeat("z", 214)); err != ErrTooLong {
+		t.Errorf("Encode() error = %v, want %v", err, ErrTooLong)
+	}
+}
+
+func TestCode_PNG(t *testing.T) {
+	c, err := Encode("chronograf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := c.PNG(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := png.Decode(bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if width := (21 + 2*QuietZone) * 4; img.Bounds().Dx() != width {
+		t.Errorf("PNG() width = %d, want %d", img.Bounds().Dx(), width)
+	}
+	// the top left module of the finder pattern is dark
+	if r, _, _, _ := img.At(QuietZone*4, QuietZone*4).RGBA(); r != 0 {
+		t.Errorf("PNG() top left module is light")
+	}
+}
+
+func TestRSRemainder(t *testing.T) {
+	// The codewords of 01234567 at version 1-M from ISO/IEC 18004 Annex I
+	data := []byte{0x10, 0x20, 0x0C, 0x56, 0x61, 0x80, 0xEC, 0x11, 0xEC, 0x11, 0xEC, 0x11, 0xEC, 0x11, 0xEC, 0x11}
+	want := []byte{0xA5, 0x24, 0xD4, 0xC1, 0xED, 0x36, 0xC7, 0x87, 0x2C, 0x55}
+	if got := rsRemainder(data, rsDivisor(10)); !bytes.Equal(got, want) {
+		t.Errorf("rsRemainder() = % X, want % X", got, want)
+	}
+}
+
+func TestFormatBits(t *testing.T) {
+	want := []int{
+		0x5412, // 101010000010010
+		0x5125, // 101000100100101
+		0x5E7C, // 101111001111100
+		0x5B4B, // 101101101001011
+		0x45F9, // 100010111111001
+		0x40CE, // 100000011001110
+		0x4F97, // 100111110010111
+		0x4AA0, // 100101010100000
+	}
+	for mask, w := range want {
+		if got := formatBits(mask); got != w {
+			t.Errorf("formatBits(%d) = %015b, want %015b", mask, got, w)
+		}
+	}
+}
+
+func TestVersionInformation(t *testing.T) {
+	c := newCode(7)
+	version := 0
+	for i := 0; i < 18; i++ {
+		version |= bit(c.Dark(c.Size-11+i%3, i/3)) << uint(i)
+	}
+	if want := 0x07C94; version != want {
+		t.Errorf("version information = %018b, want %018b", version, want)
+	}
+}
@@ -0,0 +1,140 @@
+package reports
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"time"
+)
+
+// Grid of rendered dashboards in pixels. Cells are placed on the twelve
+// columns of the dashboard grid of chronograf.
+const (
+	DashboardWidth = 1200
+	gridColumns    = 12
+	gridRowHeight  = 80
+	headerHeight   = 56
+	tilePadding    = 6
+	tileInset      = 10
+)
+
+var (
+	dashboardBackground = color.RGBA{0xf6, 0xf6, 0xf8, 0xff}
+	tileBorder          = color.RGBA{0xc6, 0xca, 0xd3, 0xff}
+	textColor           = color.RGBA{0x20, 0x20, 0x28, 0xff}
+	mutedColor          = color.RGBA{0x67, 0x69, 0x78, 0xff}
+	errorColor          = color.RGBA{0xdc, 0x4e, 0x58, 0xff}
+)
+
+// Tile is a cell of a dashboard along with the series of its queries
+type Tile struct {
+	X          int32 // X, Y, W and H are the position and size of the cell in grid units
+	Y          int32
+	W          int32
+	H          int32
+	Title      string
+	SingleStat bool // SingleStat tiles show the last value of their first series
+	Series     []Series
+	Error      string // Error is the reason a query of the cell failed, if one did
+}
+
+// DrawDashboard lays tiles out as the cells of a dashboard from lower to
+// upper below a header of the title and time range
+func DrawDashboard(title string, lower, upper time.Time, tiles []Tile) *Drawing {
+	rows := int32(0)
+	for _, t := range tiles {
+		if t.Y+t.H > rows {
+			rows = t.Y + t.H
+		}
+	}
+	colWidth := DashboardWidth / gridColumns
+	d := NewDrawing(DashboardWidth, headerHeight+int(rows)*gridRowHeight+tilePadding)
+	d.Rect(image.Rect(0, 0, d.Width, d.Height), dashboardBackground, transparent)
+
+	d.Text(image.Pt(tileInset, tileInset), 3, Truncate(title, 3, DashboardWidth-2*tileInset), textColor)
+	timeRange := fmt.Sprintf("%s to %s", lower.UTC().Format(time.RFC1123), upper.UTC().Format(time.RFC1123))
+	d.Text(image.Pt(tileInset, tileInset+3*glyphHeight+6), 1, timeRange, mutedColor)
+
+	for _, t := range tiles {
+		r := image.Rect(
+			int(t.X)*colWidth+tilePadding,
+			headerHeight+int(t.Y)*gridRowHeight+tilePadding,
+			int(t.X+t.W)*colWidth-tilePadding,
+			headerHeight+int(t.Y+t.H)*gridRowHeight-tilePadding,
+		)
+		drawTile(d, r, t, lower, upper)
+	}
+	return d
+}
+
+// drawTile draws the title of a tile and a chart, a single value or the
+// reason there is neither within r
+func drawTile(d *Drawing, r image.Rectangle, t Tile, lower, upper time.Time) {
+	d.Rect(r, chartBackground, tileBorder)
+	inner := r.Inset(tileInset)
+	if inner.Empty() {
+		return
+	}
+	d.Text(inner.Min, 2, Truncate(t.Title, 2, inner.Dx()), textColor)
+	body := inner
+	body.Min.Y += 2*glyphHeight + tileInset
+	if body.Empty() {
+		return
+	}
+
+	switch {
+	case t.Error != "":
+		y := body.Min.Y
+		for _, l := range wrap(t.Error, 1, body.Dx()) {
+			if y+glyphHeight > body.Max.Y {
+				break
+			}
+			d.Text(image.Pt(body.Min.X, y), 1, l, errorColor)
+			y += glyphHeight + 4
+		}
+	case len(t.Series) == 0:
+		d.Text(body.Min, 1, "No data", mutedColor)
+	case t.SingleStat:
+		points := t.Series[0].Points
+		value := fmt.Sprintf("%.4g", points[len(points)-1].Value)
+		scale := 6
+		for scale > 1 && (TextWidth(value, scale) > body.Dx() || glyphHeight*scale > body.Dy()) {
+			scale--
+		}
+		p := image.Pt(
+			body.Min.X+(body.Dx()-TextWidth(value, scale))/2,
+			body.Min.Y+(body.Dy()-glyphHeight*scale)/2,
+		)
+		d.Text(p, scale, value, palette[0])
+	default:
+		// The range of values is labelled to the left of the chart
+		min, max := valueRange(t.Series)
+		top, bottom := fmt.Sprintf("%.4g", max), fmt.Sprintf("%.4g", min)
+		gutter := TextWidth(top, 1)
+		if w := TextWidth(bottom, 1); w > gutter {
+			gutter = w
+		}
+		d.Text(body.Min, 1, top, mutedColor)
+		d.Text(image.Pt(body.Min.X, body.Max.Y-glyphHeight), 1, bottom, mutedColor)
+		area := body
+		area.Min.X += gutter + 4
+		if !area.Empty() {
+			plot(d, area, t.Series, lower, upper)
+		}
+	}
+}
+
+// wrap breaks s into lines that fit within width at scale
+func wrap(s string, scale, width int) []string {
+	max := width / (glyphWidth * scale)
+	if max < 1 {
+		return nil
+	}
+	runes := []rune(s)
+	var lines []string
+	for len(runes) > max {
+		lines = append(lines, string(runes[:max]))
+		runes = runes[max:]
+	}
+	return append(lines, string(runes))
+}
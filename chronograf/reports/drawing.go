@@ -0,0 +1,265 @@
+package reports
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"strings"
+)
+
+// transparent leaves the fill or stroke of a rectangle out
+var transparent = color.RGBA{}
+
+type rectShape struct {
+	r      image.Rectangle
+	fill   color.RGBA
+	stroke color.RGBA
+}
+
+type lineShape struct {
+	points []image.Point
+	c      color.RGBA
+}
+
+type textShape struct {
+	p     image.Point
+	scale int
+	s     string
+	c     color.RGBA
+}
+
+// Drawing is a picture of rectangles, lines and text in pixels from the top
+// left corner. It is rasterized as PNG or written as a vector PDF page.
+type Drawing struct {
+	Width  int
+	Height int
+	shapes []interface{}
+}
+
+// NewDrawing returns an empty drawing of the given size
+func NewDrawing(width, height int) *Drawing {
+	return &Drawing{
+		Width:  width,
+		Height: height,
+	}
+}
+
+// Rect draws a rectangle; either color may be transparent
+func (d *Drawing) Rect(r image.Rectangle, fill, stroke color.RGBA) {
+	d.shapes = append(d.shapes, rectShape{r, fill, stroke})
+}
+
+// Line draws a line through points
+func (d *Drawing) Line(points []image.Point, c color.RGBA) {
+	d.shapes = append(d.shapes, lineShape{points, c})
+}
+
+// Text draws a single line of text whose top left corner is at p. Glyphs are
+// TextWidth(s, scale) / len(s) pixels wide and 8 * scale pixels high.
+func (d *Drawing) Text(p image.Point, scale int, s string, c color.RGBA) {
+	d.shapes = append(d.shapes, textShape{p, scale, s, c})
+}
+
+// TextWidth returns the width of s drawn at scale
+func TextWidth(s string, scale int) int {
+	return len([]rune(s)) * glyphWidth * scale
+}
+
+// Truncate shortens s with an ellipsis to fit within width at scale
+func Truncate(s string, scale, width int) string {
+	runes := []rune(s)
+	max := width / (glyphWidth * scale)
+	if len(runes) <= max {
+		return s
+	}
+	if max <= 3 {
+		return ""
+	}
+	return string(runes[:max-3]) + "..."
+}
+
+// Image rasterizes the drawing
+func (d *Drawing) Image() *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, d.Width, d.Height))
+	for _, s := range d.shapes {
+		switch s := s.(type) {
+		case rectShape:
+			if s.fill.A != 0 {
+				draw.Draw(img, s.r, &image.Uniform{s.fill}, image.ZP, draw.Src)
+			}
+			if s.stroke.A != 0 {
+				r := s.r
+				line(img, r.Min.X, r.Min.Y, r.Max.X-1, r.Min.Y, s.stroke)
+				line(img, r.Max.X-1, r.Min.Y, r.Max.X-1, r.Max.Y-1, s.stroke)
+				line(img, r.Max.X-1, r.Max.Y-1, r.Min.X, r.Max.Y-1, s.stroke)
+				line(img, r.Min.X, r.Max.Y-1, r.Min.X, r.Min.Y, s.stroke)
+			}
+		case lineShape:
+			for i, p := range s.points {
+				if i == 0 {
+					img.Set(p.X, p.Y, s.c)
+					continue
+				}
+				prev := s.points[i-1]
+				line(img, prev.X, prev.Y, p.X, p.Y, s.c)
+			}
+		case textShape:
+			x := s.p.X
+			for _, r := range s.s {
+				g := glyph(r)
+				for col, bits := range g {
+					for row := 0; row < glyphHeight; row++ {
+						if bits&(1<<uint(row)) == 0 {
+							continue
+						}
+						px := image.Rect(x+col*s.scale, s.p.Y+row*s.scale, x+(col+1)*s.scale, s.p.Y+(row+1)*s.scale)
+						draw.Draw(img, px, &image.Uniform{s.c}, image.ZP, draw.Src)
+					}
+				}
+				x += glyphWidth * s.scale
+			}
+		}
+	}
+	return img
+}
+
+// PNG rasterizes the drawing as a PNG image
+func (d *Drawing) PNG() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, d.Image()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// PDF writes the drawing as a single page PDF document of the size of the
+// drawing, one point per pixel. Text is set in Helvetica, which PDF readers
+// provide, so that it stays sharp and searchable.
+func (d *Drawing) PDF() ([]byte, error) {
+	var content bytes.Buffer
+	for _, s := range d.shapes {
+		switch s := s.(type) {
+		case rectShape:
+			x, y := s.r.Min.X, d.Height-s.r.Max.Y
+			if s.fill.A != 0 {
+				fmt.Fprintf(&content, "%s rg %d %d %d %d re f\n", pdfColor(s.fill), x, y, s.r.Dx(), s.r.Dy())
+			}
+			if s.stroke.A != 0 {
+				fmt.Fprintf(&content, "%s RG 1 w %d %d %d %d re S\n", pdfColor(s.stroke), x, y, s.r.Dx(), s.r.Dy())
+			}
+		case lineShape:
+			if len(s.points) < 2 {
+				continue
+			}
+			fmt.Fprintf(&content, "%s RG 1.5 w", pdfColor(s.c))
+			for i, p := range s.points {
+				op := "l"
+				if i == 0 {
+					op = "m"
+				}
+				fmt.Fprintf(&content, " %d %d %s", p.X, d.Height-p.Y, op)
+			}
+			content.WriteString(" S\n")
+		case textShape:
+			// Helvetica at the glyph height lines up with the baseline of
+			// the rasterized glyphs
+			size := glyphHeight * s.scale
+			baseline := d.Height - s.p.Y - 7*s.scale
+			fmt.Fprintf(&content, "BT /F1 %d Tf %s rg %d %d Td (%s) Tj ET\n", size, pdfColor(s.c), s.p.X, baseline, pdfString(s.s))
+		}
+	}
+
+	var stream bytes.Buffer
+	zw := zlib.NewWriter(&stream)
+	if _, err := zw.Write(content.Bytes()); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Contents 4 0 R /Resources << /Font << /F1 5 0 R >> >> >>", d.Width, d.Height),
+		fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", stream.Len(), stream.Bytes()),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return buf.Bytes(), nil
+}
+
+// pdfColor formats c as PDF RGB components
+func pdfColor(c color.RGBA) string {
+	return fmt.Sprintf("%.3f %.3f %.3f", float64(c.R)/255, float64(c.G)/255, float64(c.B)/255)
+}
+
+// pdfString escapes s as the contents of a PDF literal string. Characters
+// outside ASCII are replaced as the bitmap font replaces them.
+func pdfString(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < ' ' || r > '~':
+			b.WriteByte('?')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// line draws a line from (x0, y0) to (x1, y1) with Bresenham's algorithm
+func line(img *image.RGBA, x0, y0, x1, y1 int, c color.Color) {
+	dx, dy := abs(x1-x0), -abs(y1-y0)
+	sx, sy := 1, 1
+	if x0 > x1 {
+		sx = -1
+	}
+	if y0 > y1 {
+		sy = -1
+	}
+	e := dx + dy
+	for {
+		img.Set(x0, y0, c)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e2 := 2 * e
+		if e2 >= dy {
+			e += dy
+			x0 += sx
+		}
+		if e2 <= dx {
+			e += dx
+			y0 += sy
+		}
+	}
+}
+
+func abs(i int) int {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
@@ -0,0 +1,141 @@
+package reports
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"image/color"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestDrawing_Image(t *testing.T) {
+	black := color.RGBA{0, 0, 0, 0xff}
+	d := NewDrawing(20, 20)
+	d.Text(image.Pt(2, 2), 2, "I", black)
+
+	img := d.Image()
+	// The stem of I is the third column of its glyph, doubled at scale 2
+	for y := 2; y < 2+7*2; y++ {
+		if img.RGBAAt(2+2*2, y) != black {
+			t.Fatalf("Image() pixel (%d, %d) of the stem of I = %v", 2+2*2, y, img.RGBAAt(2+2*2, y))
+		}
+	}
+	if img.RGBAAt(2, 4) == black {
+		t.Errorf("Image() drew the first column of I")
+	}
+}
+
+func TestDrawing_PDF(t *testing.T) {
+	d := NewDrawing(300, 200)
+	d.Rect(image.Rect(10, 10, 110, 60), chartBackground, tileBorder)
+	d.Line([]image.Point{{10, 190}, {290, 10}}, palette[0])
+	d.Text(image.Pt(10, 10), 2, `CPU (max) \ host`, textColor)
+
+	data, err := d.PDF()
+	if err != nil {
+		t.Fatalf("PDF() error = %v", err)
+	}
+	if !bytes.HasPrefix(data, []byte("%PDF-1.4\n")) || !bytes.HasSuffix(data, []byte("%%EOF\n")) {
+		t.Fatalf("PDF() = %q", data)
+	}
+
+	// Every entry of the cross-reference table points at its object
+	start := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(data)
+	if start == nil {
+		t.Fatalf("PDF() has no startxref")
+	}
+	xref, _ := strconv.Atoi(string(start[1]))
+	if !bytes.HasPrefix(data[xref:], []byte("xref\n0 6\n")) {
+		t.Fatalf("PDF() startxref %d does not point at the cross-reference table", xref)
+	}
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(data[xref:], -1)
+	if len(entries) != 5 {
+		t.Fatalf("PDF() has %d objects, want 5", len(entries))
+	}
+	for i, e := range entries {
+		off, _ := strconv.Atoi(string(e[1]))
+		if want := fmt.Sprintf("%d 0 obj\n", i+1); !bytes.HasPrefix(data[off:], []byte(want)) {
+			t.Errorf("PDF() offset of object %d points at %q", i+1, data[off:off+10])
+		}
+	}
+
+	stream := regexp.MustCompile(`(?s)stream\n(.*)\nendstream`).FindSubmatch(data)
+	if stream == nil {
+		t.Fatalf("PDF() has no content stream")
+	}
+	zr, err := zlib.NewReader(bytes.NewReader(stream[1]))
+	if err != nil {
+		t.Fatalf("zlib.NewReader() error = %v", err)
+	}
+	content, _ := ioutil.ReadAll(zr)
+	for _, want := range []string{
+		"10 140 100 50 re f",
+		"10 10 m 290 190 l S",
+		`BT /F1 16 Tf 0.125 0.125 0.157 rg 10 176 Td (CPU \(max\) \\ host) Tj ET`,
+	} {
+		if !bytes.Contains(content, []byte(want)) {
+			t.Errorf("PDF() content = %s, want it to contain %s", content, want)
+		}
+	}
+}
+
+func TestDrawDashboard(t *testing.T) {
+	lower := time.Date(2018, 1, 1, 9, 0, 0, 0, time.UTC)
+	upper := lower.Add(time.Hour)
+	tiles := []Tile{
+		{
+			X: 0, Y: 0, W: 6, H: 4,
+			Title: "CPU",
+			Series: []Series{
+				{Name: "cpu usage_user", Points: []Point{{lower, 10}, {upper, 30}}},
+			},
+		},
+		{
+			X: 6, Y: 0, W: 6, H: 2,
+			Title:      "Load",
+			SingleStat: true,
+			Series:     []Series{{Name: "system load1", Points: []Point{{upper, 1.5}}}},
+		},
+		{
+			X: 6, Y: 2, W: 6, H: 2,
+			Title: "Disk",
+			Error: "database not found: telegraf",
+		},
+	}
+
+	d := DrawDashboard("Capacity", lower, upper, tiles)
+	if d.Width != DashboardWidth || d.Height != headerHeight+4*gridRowHeight+tilePadding {
+		t.Fatalf("DrawDashboard() size = %dx%d", d.Width, d.Height)
+	}
+
+	img := d.Image()
+	// Tiles have a border within their grid cells
+	if got := img.RGBAAt(tilePadding, headerHeight+tilePadding); got != tileBorder {
+		t.Errorf("DrawDashboard() top left corner of the first tile = %v, want %v", got, tileBorder)
+	}
+	if got := img.RGBAAt(600+tilePadding, headerHeight+2*gridRowHeight+tilePadding); got != tileBorder {
+		t.Errorf("DrawDashboard() top left corner of the third tile = %v, want %v", got, tileBorder)
+	}
+	// The single value is drawn in the first color of the palette
+	found := false
+	for x := 600; x < DashboardWidth && !found; x++ {
+		for y := headerHeight; y < headerHeight+2*gridRowHeight; y++ {
+			if img.RGBAAt(x, y) == palette[0] {
+				found = true
+				break
+			}
+		}
+	}
+	if !found {
+		t.Errorf("DrawDashboard() did not draw the single value")
+	}
+
+	if _, err := d.PDF(); err != nil {
+		t.Errorf("PDF() error = %v", err)
+	}
+}
@@ -0,0 +1,144 @@
+package reports
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Image is a file attached inline to a message and referenced by the HTML of
+// the message as cid:ContentID
+type Image struct {
+	ContentID string
+	Name      string
+	Data      []byte // Data is the PNG encoded image
+}
+
+// Message is an HTML email
+type Message struct {
+	To      []string
+	Subject string
+	HTML    []byte
+	Images  []Image
+}
+
+// Mailer delivers messages
+type Mailer interface {
+	Send(Message) error
+}
+
+// SMTP delivers messages through an SMTP server. The connection is upgraded
+// with STARTTLS when the server supports it.
+type SMTP struct {
+	Host     string
+	Port     int
+	Username string // Username authenticates with PLAIN when set
+	Password string
+	From     string
+}
+
+// Send delivers m to its recipients
+func (s *SMTP) Send(m Message) error {
+	var auth smtp.Auth
+	if s.Username != "" {
+		auth = smtp.PlainAuth("", s.Username, s.Password, s.Host)
+	}
+	addr := net.JoinHostPort(s.Host, strconv.Itoa(s.Port))
+	data, err := m.Bytes(s.From, time.Now())
+	if err != nil {
+		return err
+	}
+	return smtp.SendMail(addr, auth, s.From, m.To, data)
+}
+
+// Bytes encodes m as an RFC 5322 message from from sent at date. Messages
+// with images are multipart/related so that mail clients show the images
+// within the HTML rather than as attachments.
+func (m Message) Bytes(from string, date time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", strings.Join(m.To, ", "))
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", m.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+
+	if len(m.Images) == 0 {
+		buf.WriteString("Content-Type: text/html; charset=utf-8\r\n")
+		buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+		if err := writeQuotedPrintable(&buf, m.HTML); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	fmt.Fprintf(&buf, "Content-Type: multipart/related; boundary=%s; type=\"text/html\"\r\n\r\n", mw.Boundary())
+
+	w, err := mw.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {"text/html; charset=utf-8"},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := writeQuotedPrintable(w, m.HTML); err != nil {
+		return nil, err
+	}
+
+	for _, img := range m.Images {
+		w, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {fmt.Sprintf("image/png; name=%q", img.Name)},
+			"Content-Transfer-Encoding": {"base64"},
+			"Content-ID":                {"<" + img.ContentID + ">"},
+			"Content-Disposition":       {fmt.Sprintf("inline; filename=%q", img.Name)},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := writeBase64(w, img.Data); err != nil {
+			return nil, err
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	buf.Write(body.Bytes())
+	return buf.Bytes(), nil
+}
+
+func writeQuotedPrintable(w io.Writer, data []byte) error {
+	qp := quotedprintable.NewWriter(w)
+	if _, err := qp.Write(data); err != nil {
+		return err
+	}
+	return qp.Close()
+}
+
+// writeBase64 writes data in base64 lines of 76 characters as RFC 2045
+// requires
+func writeBase64(w io.Writer, data []byte) error {
+	enc := base64.StdEncoding.EncodeToString(data)
+	for len(enc) > 0 {
+		n := 76
+		if len(enc) < n {
+			n = len(enc)
+		}
+		if _, err := fmt.Fprintf(w, "%s\r\n", enc[:n]); err != nil {
+			return err
+		}
+		enc = enc[n:]
+	}
+	return nil
+}
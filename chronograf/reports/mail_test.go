@@ -0,0 +1,88 @@
+package reports
+
+import (
+	"bytes"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"testing"
+	"time"
+)
+
+func TestMessage_Bytes(t *testing.T) {
+	m := Message{
+		To:      []string{"ops@example.com", "dev@example.com"},
+		Subject: "Weekly capacity ✓",
+		HTML:    []byte(`<p>Disk usage is at 60%</p><img src="cid:panel-0@chronograf">`),
+		Images: []Image{
+			{ContentID: "panel-0@chronograf", Name: "panel-0.png", Data: []byte("\x89PNG")},
+		},
+	}
+	data, err := m.Bytes("chronograf@example.com", time.Date(2018, 1, 1, 9, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Bytes() error = %v", err)
+	}
+
+	msg, err := mail.ReadMessage(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("mail.ReadMessage() error = %v", err)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil || subject != m.Subject {
+		t.Errorf("Subject = %q, want %q", subject, m.Subject)
+	}
+	if to := msg.Header.Get("To"); to != "ops@example.com, dev@example.com" {
+		t.Errorf("To = %q", to)
+	}
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/related" {
+		t.Fatalf("Content-Type = %q", msg.Header.Get("Content-Type"))
+	}
+	mr := multipart.NewReader(msg.Body, params["boundary"])
+
+	part, err := mr.NextPart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	html, _ := ioutil.ReadAll(quotedprintable.NewReader(part))
+	if !bytes.Equal(html, m.HTML) {
+		t.Errorf("HTML part = %s, want %s", html, m.HTML)
+	}
+
+	part, err = mr.NextPart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id := part.Header.Get("Content-ID"); id != "<panel-0@chronograf>" {
+		t.Errorf("Content-ID = %q", id)
+	}
+	if _, err := mr.NextPart(); err == nil {
+		t.Errorf("Bytes() wrote more than two parts")
+	}
+}
+
+func TestMessage_Bytes_WithoutImages(t *testing.T) {
+	m := Message{
+		To:      []string{"ops@example.com"},
+		Subject: "Weekly capacity",
+		HTML:    []byte(`<p>No data</p>`),
+	}
+	data, err := m.Bytes("chronograf@example.com", time.Now())
+	if err != nil {
+		t.Fatalf("Bytes() error = %v", err)
+	}
+	msg, err := mail.ReadMessage(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("mail.ReadMessage() error = %v", err)
+	}
+	if ct := msg.Header.Get("Content-Type"); ct != "text/html; charset=utf-8" {
+		t.Errorf("Content-Type = %q", ct)
+	}
+	html, _ := ioutil.ReadAll(quotedprintable.NewReader(msg.Body))
+	if !bytes.Equal(html, m.HTML) {
+		t.Errorf("body = %s, want %s", html, m.HTML)
+	}
+}
@@ -0,0 +1,183 @@
+package reports
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"image"
+	"image/color"
+	"math"
+	"time"
+)
+
+// Chart dimensions in pixels
+const (
+	ChartWidth  = 640
+	ChartHeight = 240
+	chartMargin = 8
+)
+
+// palette colors the series of a chart in turn
+var palette = []color.RGBA{
+	{0x22, 0xad, 0xf6, 0xff},
+	{0x4e, 0xd8, 0xa0, 0xff},
+	{0xff, 0xb9, 0x4a, 0xff},
+	{0xdc, 0x4e, 0x58, 0xff},
+	{0x9f, 0x8f, 0xef, 0xff},
+	{0x7a, 0x65, 0xf2, 0xff},
+}
+
+var (
+	chartBackground = color.RGBA{0xff, 0xff, 0xff, 0xff}
+	chartAxis       = color.RGBA{0xc6, 0xca, 0xd3, 0xff}
+)
+
+var documentTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"time": func(t time.Time) string {
+		return t.UTC().Format(time.RFC1123)
+	},
+	"value": func(f float64) string {
+		return fmt.Sprintf("%.4g", f)
+	},
+}).Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>{{.Title}}</title></head>
+<body style="font-family: Helvetica, Arial, sans-serif; color: #202028;">
+<h1 style="font-size: 20px;">{{.Title}}</h1>
+<p style="color: #676978;">{{time .Lower}} to {{time .Upper}}</p>
+{{range .Panels}}<h2 style="font-size: 16px; margin-top: 24px;">{{.Title}}</h2>
+<p style="font-family: monospace; font-size: 12px; color: #676978;">{{.Query}}</p>
+{{if .Error}}<p style="color: #dc4e58;">{{.Error}}</p>
+{{else if not .Rows}}<p style="color: #676978;">No data</p>
+{{else}}{{if .Chart}}<img src="cid:{{.Chart}}" width="{{$.Width}}" height="{{$.Height}}" alt="{{.Title}}">
+{{end}}<table cellpadding="4" style="border-collapse: collapse; font-size: 13px;">
+<tr style="text-align: left; border-bottom: 1px solid #c6cad3;"><th>Series</th><th>Min</th><th>Max</th><th>Mean</th><th>Last</th></tr>
+{{range .Rows}}<tr><td>{{.Name}}</td><td>{{value .Min}}</td><td>{{value .Max}}</td><td>{{value .Mean}}</td><td>{{value .Last}}</td></tr>
+{{end}}</table>
+{{end}}{{end}}</body>
+</html>
+`))
+
+type documentView struct {
+	Title  string
+	Lower  time.Time
+	Upper  time.Time
+	Width  int
+	Height int
+	Panels []panelView
+}
+
+type panelView struct {
+	Title string
+	Query string
+	Error string
+	Chart string // Chart is the content ID of the chart of the panel, if any
+	Rows  []rowView
+}
+
+type rowView struct {
+	Name string
+	Stats
+}
+
+// Render writes doc as an HTML document with a table of the minimum, maximum,
+// mean and last value of every series. With charts, every panel with data is
+// drawn as a line chart as well; the returned images are referenced by the
+// document by their content IDs and must be sent along with it.
+func Render(doc Document, charts bool) ([]byte, []Image, error) {
+	view := documentView{
+		Title:  doc.Title,
+		Lower:  doc.Lower,
+		Upper:  doc.Upper,
+		Width:  ChartWidth,
+		Height: ChartHeight,
+		Panels: make([]panelView, len(doc.Panels)),
+	}
+	images := []Image{}
+	for i, p := range doc.Panels {
+		pv := panelView{
+			Title: p.Title,
+			Query: p.Query,
+			Error: p.Error,
+		}
+		for _, s := range p.Series {
+			pv.Rows = append(pv.Rows, rowView{
+				Name:  s.Name,
+				Stats: s.Stats(),
+			})
+		}
+		if charts && p.Error == "" && len(p.Series) > 0 {
+			data, err := Chart(p.Series, doc.Lower, doc.Upper)
+			if err != nil {
+				return nil, nil, err
+			}
+			pv.Chart = fmt.Sprintf("panel-%d@chronograf", i)
+			images = append(images, Image{
+				ContentID: pv.Chart,
+				Name:      fmt.Sprintf("panel-%d.png", i),
+				Data:      data,
+			})
+		}
+		view.Panels[i] = pv
+	}
+
+	var buf bytes.Buffer
+	if err := documentTemplate.Execute(&buf, view); err != nil {
+		return nil, nil, err
+	}
+	return buf.Bytes(), images, nil
+}
+
+// Chart draws series as a PNG line chart from lower to upper. The value axis
+// spans the values of all series. Charts carry no text; the table of the
+// document names the series in palette order.
+func Chart(series []Series, lower, upper time.Time) ([]byte, error) {
+	d := NewDrawing(ChartWidth, ChartHeight)
+	d.Rect(image.Rect(0, 0, ChartWidth, ChartHeight), chartBackground, transparent)
+	plot(d, image.Rect(chartMargin, chartMargin, ChartWidth-chartMargin, ChartHeight-chartMargin), series, lower, upper)
+	return d.PNG()
+}
+
+// valueRange returns the smallest and largest value of series. Flat series
+// are widened so that they are drawn across the middle of a chart.
+func valueRange(series []Series) (float64, float64) {
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, s := range series {
+		for _, p := range s.Points {
+			min = math.Min(min, p.Value)
+			max = math.Max(max, p.Value)
+		}
+	}
+	if max == min {
+		min, max = min-1, max+1
+	}
+	return min, max
+}
+
+// plot draws the axes of area and series as lines within it. Points outside
+// of lower and upper are drawn at the edges of area.
+func plot(d *Drawing, area image.Rectangle, series []Series, lower, upper time.Time) {
+	left, top := area.Min.X, area.Min.Y
+	right, bottom := area.Max.X-1, area.Max.Y-1
+	d.Line([]image.Point{{left, top}, {left, bottom}, {right, bottom}}, chartAxis)
+
+	min, max := valueRange(series)
+	span := float64(upper.Sub(lower))
+	if span <= 0 {
+		span = 1
+	}
+	x := func(t time.Time) int {
+		f := math.Max(0, math.Min(1, float64(t.Sub(lower))/span))
+		return left + int(f*float64(right-left))
+	}
+	y := func(v float64) int {
+		return bottom - int((v-min)/(max-min)*float64(bottom-top))
+	}
+	for i, s := range series {
+		points := make([]image.Point, len(s.Points))
+		for j, p := range s.Points {
+			points[j] = image.Point{x(p.Time), y(p.Value)}
+		}
+		d.Line(points, palette[i%len(palette)])
+	}
+}
@@ -0,0 +1,175 @@
+// Package reports summarizes the query results of dashboards as HTML
+// documents, optionally with PNG charts, and delivers them by email on a
+// schedule.
+package reports
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Next returns the first run of a schedule that starts at start and repeats
+// every every that is after after. Runs missed while the server was down are
+// skipped rather than caught up on. A schedule that does not repeat has no
+// run after start, which is reported as the zero time.
+func Next(start time.Time, every time.Duration, after time.Time) time.Time {
+	if after.Before(start) {
+		return start
+	}
+	if every <= 0 {
+		return time.Time{}
+	}
+	n := after.Sub(start)/every + 1
+	return start.Add(n * every)
+}
+
+// Point is a single value of a series
+type Point struct {
+	Time  time.Time
+	Value float64
+}
+
+// Series is the values of one column of one series of a query result
+type Series struct {
+	Name   string
+	Points []Point
+}
+
+// Stats summarizes the values of a series
+type Stats struct {
+	Count int
+	Min   float64
+	Max   float64
+	Mean  float64
+	Last  float64
+}
+
+// Stats summarizes the values of s. All values are zero without points.
+func (s Series) Stats() Stats {
+	var st Stats
+	if len(s.Points) == 0 {
+		return st
+	}
+	st.Min, st.Max = math.Inf(1), math.Inf(-1)
+	sum := 0.0
+	for _, p := range s.Points {
+		st.Min = math.Min(st.Min, p.Value)
+		st.Max = math.Max(st.Max, p.Value)
+		sum += p.Value
+	}
+	st.Count = len(s.Points)
+	st.Mean = sum / float64(st.Count)
+	st.Last = s.Points[len(s.Points)-1].Value
+	return st
+}
+
+// influxResponse is the JSON written by the proxy of chronograf for InfluxDB
+// queries with an epoch of ms
+type influxResponse struct {
+	Results struct {
+		Results []struct {
+			Series []struct {
+				Name    string            `json:"name"`
+				Tags    map[string]string `json:"tags"`
+				Columns []string          `json:"columns"`
+				Values  [][]interface{}   `json:"values"`
+			} `json:"series"`
+			Error string `json:"error"`
+		} `json:"results"`
+	} `json:"results"`
+}
+
+// ParseResult returns a series for every numeric column of every series of a
+// query result. Columns other than time whose values are not numbers, such as
+// tag values returned by SHOW queries, are left out.
+func ParseResult(data []byte) ([]Series, error) {
+	var res influxResponse
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&res); err != nil {
+		return nil, fmt.Errorf("unable to parse query result: %v", err)
+	}
+
+	all := []Series{}
+	for _, r := range res.Results.Results {
+		if r.Error != "" {
+			return nil, fmt.Errorf("%s", r.Error)
+		}
+		for _, s := range r.Series {
+			name := s.Name
+			if len(s.Tags) > 0 {
+				name += " " + formatTags(s.Tags)
+			}
+			for col, c := range s.Columns {
+				if c == "time" {
+					continue
+				}
+				series := Series{
+					Name:   name + " " + c,
+					Points: []Point{},
+				}
+				for _, row := range s.Values {
+					if col >= len(row) {
+						continue
+					}
+					v, ok := number(row[col])
+					if !ok {
+						continue
+					}
+					p := Point{Value: v}
+					if len(row) > 0 && s.Columns[0] == "time" {
+						if ms, ok := number(row[0]); ok {
+							p.Time = time.Unix(0, int64(ms)*int64(time.Millisecond)).UTC()
+						}
+					}
+					series.Points = append(series.Points, p)
+				}
+				if len(series.Points) > 0 {
+					all = append(all, series)
+				}
+			}
+		}
+	}
+	return all, nil
+}
+
+// number returns the float value of a JSON number
+func number(v interface{}) (float64, bool) {
+	n, ok := v.(json.Number)
+	if !ok {
+		return 0, false
+	}
+	f, err := n.Float64()
+	return f, err == nil
+}
+
+// formatTags formats tags as sorted key=value pairs
+func formatTags(tags map[string]string) string {
+	pairs := make([]string, 0, len(tags))
+	for k, v := range tags {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return "{" + strings.Join(pairs, ", ") + "}"
+}
+
+// Panel is the summary of a single query of a dashboard cell
+type Panel struct {
+	Title  string
+	Query  string
+	Series []Series
+	Error  string // Error is the reason the query failed, if it did
+}
+
+// Document is the summary of a dashboard over a time range
+type Document struct {
+	Title  string
+	Lower  time.Time
+	Upper  time.Time
+	Panels []Panel
+}
@@ -0,0 +1,142 @@
+package reports
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNext(t *testing.T) {
+	start := time.Date(2018, 1, 1, 9, 0, 0, 0, time.UTC)
+	week := 7 * 24 * time.Hour
+	tests := []struct {
+		name  string
+		every time.Duration
+		after time.Time
+		want  time.Time
+	}{
+		{
+			name:  "Before the start",
+			every: week,
+			after: start.Add(-time.Hour),
+			want:  start,
+		},
+		{
+			name:  "At the start",
+			every: week,
+			after: start,
+			want:  start.Add(week),
+		},
+		{
+			name:  "Missed runs are skipped",
+			every: week,
+			after: start.Add(3*week + time.Hour),
+			want:  start.Add(4 * week),
+		},
+		{
+			name:  "Without repetition",
+			after: start,
+		},
+	}
+	for _, tt := range tests {
+		if got := Next(start, tt.every, tt.after); !got.Equal(tt.want) {
+			t.Errorf("%q. Next() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestParseResult(t *testing.T) {
+	data := []byte(`{"results":{"results":[{"statement_id":0,"series":[
+{"name":"cpu","tags":{"host":"b","dc":"west"},"columns":["time","mean","max"],"values":[[1514797200000,1.5,3],[1514797260000,null,4],[1514797320000,4.5,5]]},
+{"name":"databases","columns":["name"],"values":[["telegraf"]]}]}]}}`)
+	got, err := ParseResult(data)
+	if err != nil {
+		t.Fatalf("ParseResult() error = %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("ParseResult() = %d series, want 2: %#v", len(got), got)
+	}
+	if got[0].Name != "cpu {dc=west, host=b} mean" || got[1].Name != "cpu {dc=west, host=b} max" {
+		t.Errorf("ParseResult() names = %q, %q", got[0].Name, got[1].Name)
+	}
+	if len(got[0].Points) != 2 || !got[0].Points[1].Time.Equal(time.Date(2018, 1, 1, 9, 2, 0, 0, time.UTC)) {
+		t.Errorf("ParseResult() points = %#v", got[0].Points)
+	}
+	want := Stats{Count: 2, Min: 1.5, Max: 4.5, Mean: 3, Last: 4.5}
+	if st := got[0].Stats(); st != want {
+		t.Errorf("Stats() = %#v, want %#v", st, want)
+	}
+
+	if _, err := ParseResult([]byte(`{"results":{"results":[{"error":"database not found: telegraf"}]}}`)); err == nil || err.Error() != "database not found: telegraf" {
+		t.Errorf("ParseResult() of a failed statement error = %v", err)
+	}
+}
+
+func TestRender(t *testing.T) {
+	lower := time.Date(2018, 1, 1, 9, 0, 0, 0, time.UTC)
+	doc := Document{
+		Title: "Weekly <capacity>",
+		Lower: lower,
+		Upper: lower.Add(time.Hour),
+		Panels: []Panel{
+			{
+				Title: "Disk",
+				Query: `SELECT max("used_percent") FROM disk`,
+				Series: []Series{
+					{
+						Name: "disk max",
+						Points: []Point{
+							{Time: lower, Value: 40},
+							{Time: lower.Add(30 * time.Minute), Value: 60},
+							{Time: lower.Add(time.Hour), Value: 50},
+						},
+					},
+				},
+			},
+			{
+				Title: "Memory",
+				Error: "source 2 not found",
+			},
+			{
+				Title: "Network",
+			},
+		},
+	}
+
+	html, images, err := Render(doc, false)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if len(images) != 0 {
+		t.Errorf("Render() without charts returned %d images", len(images))
+	}
+	for _, want := range []string{"Weekly &lt;capacity&gt;", "<td>disk max</td><td>40</td><td>60</td><td>50</td><td>50</td>", "source 2 not found", "No data"} {
+		if !bytes.Contains(html, []byte(want)) {
+			t.Errorf("Render() = %s, want it to contain %s", html, want)
+		}
+	}
+
+	html, images, err = Render(doc, true)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if len(images) != 1 {
+		t.Fatalf("Render() with charts returned %d images, want 1", len(images))
+	}
+	if !strings.Contains(string(html), `src="cid:`+images[0].ContentID+`"`) {
+		t.Errorf("Render() = %s, want a reference to %s", html, images[0].ContentID)
+	}
+	img, err := png.Decode(bytes.NewReader(images[0].Data))
+	if err != nil {
+		t.Fatalf("png.Decode() error = %v", err)
+	}
+	if b := img.Bounds(); b.Dx() != ChartWidth || b.Dy() != ChartHeight {
+		t.Errorf("Render() chart bounds = %v", b)
+	}
+	// The first point is at the left bottom of the chart
+	if r, g, b, _ := img.At(chartMargin, ChartHeight-chartMargin-1).RGBA(); r>>8 != uint32(palette[0].R) || g>>8 != uint32(palette[0].G) || b>>8 != uint32(palette[0].B) {
+		t.Errorf("Render() chart does not start at the first point")
+	}
+}
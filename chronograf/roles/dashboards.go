@@ -0,0 +1,167 @@
+package roles
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// ensure that DashboardsStore implements chronograf.DashboardsStore
+var _ chronograf.DashboardsStore = &DashboardsStore{}
+
+// DashboardsStore facade on a DashboardsStore that filters dashboards by the
+// minimum role required to see their folder.
+//
+// The role is passed around on the context and set when the
+// DashboardsStore is instantiated.
+type DashboardsStore struct {
+	store   chronograf.DashboardsStore
+	folders chronograf.FoldersStore
+	role    string
+}
+
+// NewDashboardsStore creates a new DashboardsStore from an existing
+// chronograf.DashboardsStore, the chronograf.FoldersStore of the folders of
+// its dashboards and a role string
+func NewDashboardsStore(s chronograf.DashboardsStore, folders chronograf.FoldersStore, role string) *DashboardsStore {
+	return &DashboardsStore{
+		store:   s,
+		folders: folders,
+		role:    role,
+	}
+}
+
+// access returns whether the role may see the dashboards within each folder
+func (s *DashboardsStore) access(ctx context.Context) (map[string]bool, error) {
+	fs, err := s.folders.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return folderAccess(fs, s.role), nil
+}
+
+// allows is true if the dashboard is at the top level, in a folder visible to
+// the role, or in a folder that no longer exists.
+func allows(access map[string]bool, d chronograf.Dashboard) bool {
+	ok, exists := access[d.Folder]
+	return d.Folder == "" || !exists || ok
+}
+
+// All retrieves all dashboards from the underlying DashboardsStore and filters
+// them by role.
+func (s *DashboardsStore) All(ctx context.Context) ([]chronograf.Dashboard, error) {
+	err := validRole(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ds, err := s.store.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	access, err := s.access(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// This filters dashboards without allocating
+	// https://github.com/golang/go/wiki/SliceTricks#filtering-without-allocating
+	dashboards := ds[:0]
+	for _, d := range ds {
+		if allows(access, d) {
+			dashboards = append(dashboards, d)
+		}
+	}
+
+	return dashboards, nil
+}
+
+// Add creates a new Dashboard in the DashboardsStore. The folder of the
+// dashboard must be visible to the role.
+func (s *DashboardsStore) Add(ctx context.Context, d chronograf.Dashboard) (chronograf.Dashboard, error) {
+	err := validRole(ctx)
+	if err != nil {
+		return chronograf.Dashboard{}, err
+	}
+
+	if err := s.validFolder(ctx, d); err != nil {
+		return chronograf.Dashboard{}, err
+	}
+
+	return s.store.Add(ctx, d)
+}
+
+// Delete the dashboard from DashboardsStore
+func (s *DashboardsStore) Delete(ctx context.Context, d chronograf.Dashboard) error {
+	err := validRole(ctx)
+	if err != nil {
+		return err
+	}
+
+	d, err = s.Get(ctx, d.ID)
+	if err != nil {
+		return err
+	}
+
+	return s.store.Delete(ctx, d)
+}
+
+// Get returns a Dashboard if the id exists and the role may see its folder.
+func (s *DashboardsStore) Get(ctx context.Context, id chronograf.DashboardID) (chronograf.Dashboard, error) {
+	err := validRole(ctx)
+	if err != nil {
+		return chronograf.Dashboard{}, err
+	}
+
+	d, err := s.store.Get(ctx, id)
+	if err != nil {
+		return chronograf.Dashboard{}, err
+	}
+	access, err := s.access(ctx)
+	if err != nil {
+		return chronograf.Dashboard{}, err
+	}
+
+	if !allows(access, d) {
+		return chronograf.Dashboard{}, chronograf.ErrDashboardNotFound
+	}
+
+	return d, nil
+}
+
+// Update the dashboard in DashboardsStore. The dashboard must be visible to
+// the role as must the folder it is moved into.
+func (s *DashboardsStore) Update(ctx context.Context, d chronograf.Dashboard) error {
+	err := validRole(ctx)
+	if err != nil {
+		return err
+	}
+
+	cur, err := s.Get(ctx, d.ID)
+	if err != nil {
+		return err
+	}
+	if d.Folder != cur.Folder {
+		if err := s.validFolder(ctx, d); err != nil {
+			return err
+		}
+	}
+
+	return s.store.Update(ctx, d)
+}
+
+// validFolder checks that the folder of the dashboard exists and is visible
+// to the role
+func (s *DashboardsStore) validFolder(ctx context.Context, d chronograf.Dashboard) error {
+	if d.Folder == "" {
+		return nil
+	}
+	access, err := s.access(ctx)
+	if err != nil {
+		return err
+	}
+	if !access[d.Folder] {
+		return chronograf.ErrFolderNotFound
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package roles
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// ensure that FoldersStore implements chronograf.FoldersStore
+var _ chronograf.FoldersStore = &FoldersStore{}
+
+// FoldersStore facade on a FoldersStore that filters folders by the minimum
+// role required to see the folder and each of its parents.
+//
+// The role is passed around on the context and set when the
+// FoldersStore is instantiated.
+type FoldersStore struct {
+	store chronograf.FoldersStore
+	role  string
+}
+
+// NewFoldersStore creates a new FoldersStore from an existing
+// chronograf.FoldersStore and a role string
+func NewFoldersStore(s chronograf.FoldersStore, role string) *FoldersStore {
+	return &FoldersStore{
+		store: s,
+		role:  role,
+	}
+}
+
+// All retrieves all folders from the underlying FoldersStore and filters them
+// by role.
+func (s *FoldersStore) All(ctx context.Context) ([]chronograf.Folder, error) {
+	err := validRole(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fs, err := s.store.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	access := folderAccess(fs, s.role)
+
+	folders := []chronograf.Folder{}
+	for _, f := range fs {
+		if access[f.ID] {
+			folders = append(folders, f)
+		}
+	}
+
+	return folders, nil
+}
+
+// Add creates a new Folder in the FoldersStore. The parent of the folder must
+// be visible to the role.
+func (s *FoldersStore) Add(ctx context.Context, f *chronograf.Folder) (*chronograf.Folder, error) {
+	err := validRole(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if f.Parent != "" {
+		if _, err := s.Get(ctx, f.Parent); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.store.Add(ctx, f)
+}
+
+// Delete the folder from FoldersStore
+func (s *FoldersStore) Delete(ctx context.Context, f *chronograf.Folder) error {
+	err := validRole(ctx)
+	if err != nil {
+		return err
+	}
+
+	f, err = s.Get(ctx, f.ID)
+	if err != nil {
+		return err
+	}
+
+	return s.store.Delete(ctx, f)
+}
+
+// Get returns a Folder if it exists and the role may see it.
+func (s *FoldersStore) Get(ctx context.Context, id string) (*chronograf.Folder, error) {
+	err := validRole(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fs, err := s.store.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !folderAccess(fs, s.role)[id] {
+		return nil, chronograf.ErrFolderNotFound
+	}
+
+	return s.store.Get(ctx, id)
+}
+
+// Update the folder in FoldersStore. Both the folder and its new parent must
+// be visible to the role.
+func (s *FoldersStore) Update(ctx context.Context, f *chronograf.Folder) error {
+	err := validRole(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.Get(ctx, f.ID); err != nil {
+		return err
+	}
+	if f.Parent != "" {
+		if _, err := s.Get(ctx, f.Parent); err != nil {
+			return err
+		}
+	}
+
+	return s.store.Update(ctx, f)
+}
+
+// folderAccess reports whether role may see each of the folders. A folder is
+// visible when role has at least the minimum role of the folder and of each
+// of its parents. Parents missing from folders are treated as the top level.
+func folderAccess(folders []chronograf.Folder, role string) map[string]bool {
+	byID := make(map[string]chronograf.Folder, len(folders))
+	for _, f := range folders {
+		byID[f.ID] = f
+	}
+
+	access := make(map[string]bool, len(folders))
+	for _, f := range folders {
+		access[f.ID] = allowsFolder(byID, f, role)
+	}
+	return access
+}
+
+func allowsFolder(byID map[string]chronograf.Folder, f chronograf.Folder, role string) bool {
+	// A walk longer than the number of folders has met a cycle of parents
+	for i := 0; i <= len(byID); i++ {
+		if f.Role != "" && !hasAuthorizedRole(f.Role, role) {
+			return false
+		}
+		parent, ok := byID[f.Parent]
+		if f.Parent == "" || !ok {
+			return true
+		}
+		f = parent
+	}
+	return false
+}
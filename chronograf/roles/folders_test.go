@@ -0,0 +1,119 @@
+package roles
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+var testFolders = []chronograf.Folder{
+	{ID: "1", Name: "Operations"},
+	{ID: "2", Name: "Databases", Parent: "1", Role: "editor"},
+	{ID: "3", Name: "Replication", Parent: "2"},
+	{ID: "4", Name: "Billing", Role: "admin"},
+	{ID: "5", Name: "Orphaned", Parent: "99"},
+}
+
+func TestFolderAccess(t *testing.T) {
+	tests := []struct {
+		role string
+		want map[string]bool
+	}{
+		{
+			role: "viewer",
+			want: map[string]bool{"1": true, "2": false, "3": false, "4": false, "5": true},
+		},
+		{
+			role: "editor",
+			want: map[string]bool{"1": true, "2": true, "3": true, "4": false, "5": true},
+		},
+		{
+			role: "admin",
+			want: map[string]bool{"1": true, "2": true, "3": true, "4": true, "5": true},
+		},
+	}
+	for _, tt := range tests {
+		if got := folderAccess(testFolders, tt.role); !cmp.Equal(got, tt.want) {
+			t.Errorf("folderAccess() for %s diff (-got +want):\n%s", tt.role, cmp.Diff(got, tt.want))
+		}
+	}
+}
+
+func TestFolderAccess_Cycle(t *testing.T) {
+	folders := []chronograf.Folder{
+		{ID: "1", Parent: "2"},
+		{ID: "2", Parent: "1"},
+	}
+	want := map[string]bool{"1": false, "2": false}
+	if got := folderAccess(folders, "admin"); !cmp.Equal(got, want) {
+		t.Errorf("folderAccess() diff (-got +want):\n%s", cmp.Diff(got, want))
+	}
+}
+
+func TestDashboards_All(t *testing.T) {
+	store := NewDashboardsStore(
+		&mocks.DashboardsStore{
+			AllF: func(ctx context.Context) ([]chronograf.Dashboard, error) {
+				return []chronograf.Dashboard{
+					{ID: 1, Name: "top level"},
+					{ID: 2, Name: "operations", Folder: "1"},
+					{ID: 3, Name: "replication", Folder: "3"},
+					{ID: 4, Name: "removed folder", Folder: "42"},
+				}, nil
+			},
+		},
+		&mocks.FoldersStore{
+			AllF: func(ctx context.Context) ([]chronograf.Folder, error) {
+				return testFolders, nil
+			},
+		},
+		"viewer",
+	)
+	ctx := context.WithValue(context.Background(), ContextKey, "viewer")
+	got, err := store.All(ctx)
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	want := []chronograf.Dashboard{
+		{ID: 1, Name: "top level"},
+		{ID: 2, Name: "operations", Folder: "1"},
+		{ID: 4, Name: "removed folder", Folder: "42"},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("All() diff (-got +want):\n%s", diff)
+	}
+}
+
+func TestDashboards_Update(t *testing.T) {
+	var updated bool
+	store := NewDashboardsStore(
+		&mocks.DashboardsStore{
+			GetF: func(ctx context.Context, id chronograf.DashboardID) (chronograf.Dashboard, error) {
+				return chronograf.Dashboard{ID: id, Folder: "1"}, nil
+			},
+			UpdateF: func(ctx context.Context, d chronograf.Dashboard) error {
+				updated = true
+				return nil
+			},
+		},
+		&mocks.FoldersStore{
+			AllF: func(ctx context.Context) ([]chronograf.Folder, error) {
+				return testFolders, nil
+			},
+		},
+		"viewer",
+	)
+	ctx := context.WithValue(context.Background(), ContextKey, "viewer")
+	if err := store.Update(ctx, chronograf.Dashboard{ID: 1, Folder: "4"}); err != chronograf.ErrFolderNotFound {
+		t.Errorf("Update() into a hidden folder error = %v, want %v", err, chronograf.ErrFolderNotFound)
+	}
+	if updated {
+		t.Errorf("Update() moved the dashboard into a hidden folder")
+	}
+	if err := store.Update(ctx, chronograf.Dashboard{ID: 1, Folder: "5"}); err != nil || !updated {
+		t.Errorf("Update() error = %v", err)
+	}
+}
@@ -0,0 +1,119 @@
+package saml
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+)
+
+// canonicalize serializes e by exclusive XML canonicalization without
+// comments as defined by https://www.w3.org/TR/xml-exc-c14n/. The
+// namespaces of the prefixes inclusive are rendered as by inclusive
+// canonicalization, where #default stands for the default namespace.
+// The child skip is omitted, as by the enveloped signature transform.
+func canonicalize(e *element, inclusive []string, skip *element) []byte {
+	var b bytes.Buffer
+	writeCanonical(&b, e, map[string]string{}, inclusive, skip)
+	return b.Bytes()
+}
+
+func writeCanonical(b *bytes.Buffer, e *element, rendered map[string]string, inclusive []string, skip *element) {
+	// Namespaces are rendered where they are visibly utilized by the element
+	// or its attributes unless an output ancestor rendered them already
+	prefixes := map[string]bool{e.prefix: true}
+	var attrs []attr
+	for _, a := range e.attrs {
+		if a.isNamespace() {
+			continue
+		}
+		if a.prefix != "" {
+			prefixes[a.prefix] = true
+		}
+		attrs = append(attrs, a)
+	}
+	for _, p := range inclusive {
+		if p == "#default" {
+			p = ""
+		}
+		if _, ok := e.lookupNamespace(p); ok {
+			prefixes[p] = true
+		}
+	}
+	delete(prefixes, "xml")
+
+	type decl struct{ prefix, ns string }
+	var decls []decl
+	scope := map[string]string{}
+	for p, ns := range rendered {
+		scope[p] = ns
+	}
+	for p := range prefixes {
+		ns, _ := e.lookupNamespace(p)
+		prev, ok := rendered[p]
+		if (!ok && ns == "") || (ok && prev == ns) {
+			continue
+		}
+		decls = append(decls, decl{p, ns})
+		scope[p] = ns
+	}
+	sort.Slice(decls, func(i, j int) bool { return decls[i].prefix < decls[j].prefix })
+
+	// Attributes are sorted by namespace and then by local name, where
+	// unqualified attributes have no namespace
+	attrNS := func(a attr) string {
+		if a.prefix == "" {
+			return ""
+		}
+		ns, _ := e.lookupNamespace(a.prefix)
+		return ns
+	}
+	sort.SliceStable(attrs, func(i, j int) bool {
+		ni, nj := attrNS(attrs[i]), attrNS(attrs[j])
+		if ni != nj {
+			return ni < nj
+		}
+		return attrs[i].name < attrs[j].name
+	})
+
+	name := qualifiedName(e.prefix, e.name)
+	b.WriteString("<" + name)
+	for _, d := range decls {
+		if d.prefix == "" {
+			b.WriteString(` xmlns="`)
+		} else {
+			b.WriteString(" xmlns:" + d.prefix + `="`)
+		}
+		b.WriteString(escapeAttr(d.ns) + `"`)
+	}
+	for _, a := range attrs {
+		b.WriteString(" " + qualifiedName(a.prefix, a.name) + `="` + escapeAttr(a.value) + `"`)
+	}
+	b.WriteString(">")
+
+	for _, c := range e.children {
+		switch c := c.(type) {
+		case *element:
+			if c != skip {
+				writeCanonical(b, c, scope, inclusive, skip)
+			}
+		case string:
+			b.WriteString(escapeText(c))
+		}
+	}
+	b.WriteString("</" + name + ">")
+}
+
+func qualifiedName(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + ":" + name
+}
+
+var (
+	textEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\r", "&#xD;")
+	attrEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", `"`, "&quot;", "\t", "&#x9;", "\n", "&#xA;", "\r", "&#xD;")
+)
+
+func escapeText(s string) string { return textEscaper.Replace(s) }
+func escapeAttr(s string) string { return attrEscaper.Replace(s) }
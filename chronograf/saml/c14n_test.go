@@ -0,0 +1,63 @@
+package saml
+
+import "testing"
+
+func TestCanonicalize(t *testing.T) {
+	tests := []struct {
+		name      string
+		doc       string
+		inclusive []string
+		want      string
+	}{
+		{
+			name: "Namespaces of ancestors that are visibly utilized",
+			doc:  `<a:root xmlns:a="urn:a" xmlns:b="urn:b" xmlns:c="urn:c" xmlns="urn:d"><a:child z="1" b:y="2" a="3"><unused/></a:child></a:root>`,
+			want: `<a:child xmlns:a="urn:a" xmlns:b="urn:b" a="3" z="1" b:y="2"><unused xmlns="urn:d"></unused></a:child>`,
+		},
+		{
+			name: "Declarations rendered by an output ancestor",
+			doc:  `<root><a:child xmlns:a="urn:a"><a:leaf xmlns:a="urn:a" xmlns:b="urn:b"/></a:child></root>`,
+			want: `<a:child xmlns:a="urn:a"><a:leaf></a:leaf></a:child>`,
+		},
+		{
+			name:      "Inclusive namespaces",
+			doc:       `<root xmlns:xs="urn:xs" xmlns:xsi="urn:xsi"><child xsi:type="xs:string">v</child></root>`,
+			inclusive: []string{"xs"},
+			want:      `<child xmlns:xs="urn:xs" xmlns:xsi="urn:xsi" xsi:type="xs:string">v</child>`,
+		},
+		{
+			name: "Escaping",
+			doc:  `<root><child a="&quot;&lt;&gt;&#9;">x &amp; &lt;y&gt; <![CDATA[<z>]]></child></root>`,
+			want: `<child a="&quot;&lt;>&#x9;">x &amp; &lt;y&gt; &lt;z&gt;</child>`,
+		},
+		{
+			name: "Comments are removed",
+			doc:  `<root><child><!-- comment -->text</child></root>`,
+			want: `<child>text</child>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, err := parseXML([]byte(tt.doc))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var child *element
+			for _, c := range root.children {
+				if e, ok := c.(*element); ok {
+					child = e
+				}
+			}
+			if got := string(canonicalize(child, tt.inclusive, nil)); got != tt.want {
+				t.Errorf("canonicalize() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseXML_doctype(t *testing.T) {
+	doc := `<!DOCTYPE root [<!ENTITY e "x">]><root>&e;</root>`
+	if _, err := parseXML([]byte(doc)); err == nil {
+		t.Errorf("parseXML() accepted a document type declaration")
+	}
+}
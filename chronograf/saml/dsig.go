@@ -0,0 +1,160 @@
+package saml
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	// Register the hashes of the supported digest and signature methods
+	_ "crypto/sha1"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+)
+
+const (
+	dsigNamespace = "http://www.w3.org/2000/09/xmldsig#"
+
+	// algExcC14N is also the namespace of the InclusiveNamespaces element
+	algExcC14N            = "http://www.w3.org/2001/10/xml-exc-c14n#"
+	algEnvelopedSignature = "http://www.w3.org/2000/09/xmldsig#enveloped-signature"
+)
+
+var digestMethods = map[string]crypto.Hash{
+	"http://www.w3.org/2000/09/xmldsig#sha1":  crypto.SHA1,
+	"http://www.w3.org/2001/04/xmlenc#sha256": crypto.SHA256,
+	"http://www.w3.org/2001/04/xmlenc#sha512": crypto.SHA512,
+}
+
+var signatureMethods = map[string]crypto.Hash{
+	"http://www.w3.org/2000/09/xmldsig#rsa-sha1":        crypto.SHA1,
+	"http://www.w3.org/2001/04/xmldsig-more#rsa-sha256": crypto.SHA256,
+	"http://www.w3.org/2001/04/xmldsig-more#rsa-sha512": crypto.SHA512,
+}
+
+// errUnsigned is returned for elements without an enveloped signature
+var errUnsigned = errors.New("saml: element is not signed")
+
+// verifySignature verifies the enveloped signature of e by one of the
+// certificates and returns the canonical form of e that was signed. The
+// content of e must only be read from the returned octets as the element
+// might contain more than what its signature covers.
+func verifySignature(e *element, certs []*x509.Certificate) ([]byte, error) {
+	sig := e.child(dsigNamespace, "Signature")
+	if sig == nil {
+		return nil, errUnsigned
+	}
+	signedInfo := sig.child(dsigNamespace, "SignedInfo")
+	if signedInfo == nil {
+		return nil, errors.New("saml: signature has no SignedInfo")
+	}
+
+	c14n := signedInfo.child(dsigNamespace, "CanonicalizationMethod")
+	if c14n == nil || c14n.attr("Algorithm") != algExcC14N {
+		return nil, errors.New("saml: unsupported canonicalization method")
+	}
+	method := signedInfo.child(dsigNamespace, "SignatureMethod")
+	if method == nil {
+		return nil, errors.New("saml: signature has no SignatureMethod")
+	}
+	hash, ok := signatureMethods[method.attr("Algorithm")]
+	if !ok {
+		return nil, fmt.Errorf("saml: unsupported signature method %q", method.attr("Algorithm"))
+	}
+
+	// Only a single reference to the signed element itself is accepted so
+	// that a signature cannot be moved to cover another element
+	refs := signedInfo.childElements(dsigNamespace, "Reference")
+	id := e.attr("ID")
+	if len(refs) != 1 || id == "" || refs[0].attr("URI") != "#"+id {
+		return nil, errors.New("saml: signature does not reference the signed element")
+	}
+	signed, err := digestReference(e, sig, refs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := decodeBase64(sig.child(dsigNamespace, "SignatureValue"))
+	if err != nil {
+		return nil, err
+	}
+	h := hash.New()
+	h.Write(canonicalize(signedInfo, inclusivePrefixes(c14n), nil))
+	hashed := h.Sum(nil)
+	for _, cert := range certs {
+		pub, ok := cert.PublicKey.(*rsa.PublicKey)
+		if !ok {
+			continue
+		}
+		if rsa.VerifyPKCS1v15(pub, hash, hashed, value) == nil {
+			return signed, nil
+		}
+	}
+	return nil, errors.New("saml: invalid signature")
+}
+
+// digestReference applies the transforms of the reference to e and returns
+// the result if it has the digest of the reference
+func digestReference(e, sig, ref *element) ([]byte, error) {
+	var (
+		enveloped bool
+		c14n      *element
+	)
+	if transforms := ref.child(dsigNamespace, "Transforms"); transforms != nil {
+		for _, t := range transforms.childElements(dsigNamespace, "Transform") {
+			switch t.attr("Algorithm") {
+			case algEnvelopedSignature:
+				enveloped = true
+			case algExcC14N:
+				c14n = t
+			default:
+				return nil, fmt.Errorf("saml: unsupported transform %q", t.attr("Algorithm"))
+			}
+		}
+	}
+	if !enveloped || c14n == nil {
+		return nil, errors.New("saml: signature must be enveloped and exclusively canonicalized")
+	}
+
+	method := ref.child(dsigNamespace, "DigestMethod")
+	if method == nil {
+		return nil, errors.New("saml: reference has no DigestMethod")
+	}
+	hash, ok := digestMethods[method.attr("Algorithm")]
+	if !ok {
+		return nil, fmt.Errorf("saml: unsupported digest method %q", method.attr("Algorithm"))
+	}
+	want, err := decodeBase64(ref.child(dsigNamespace, "DigestValue"))
+	if err != nil {
+		return nil, err
+	}
+
+	signed := canonicalize(e, inclusivePrefixes(c14n), sig)
+	h := hash.New()
+	h.Write(signed)
+	if subtle.ConstantTimeCompare(h.Sum(nil), want) != 1 {
+		return nil, errors.New("saml: digest of the signed element does not match")
+	}
+	return signed, nil
+}
+
+// inclusivePrefixes returns the PrefixList of the InclusiveNamespaces of an
+// exclusive canonicalization method
+func inclusivePrefixes(method *element) []string {
+	ns := method.child(algExcC14N, "InclusiveNamespaces")
+	if ns == nil {
+		return nil
+	}
+	return strings.Fields(ns.attr("PrefixList"))
+}
+
+func decodeBase64(e *element) ([]byte, error) {
+	if e == nil {
+		return nil, errors.New("saml: signature is incomplete")
+	}
+	return base64.StdEncoding.DecodeString(strings.Join(strings.Fields(e.text()), ""))
+}
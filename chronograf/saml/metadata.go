@@ -0,0 +1,100 @@
+package saml
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type idpMetadata struct {
+	XMLName  xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntityDescriptor"`
+	EntityID string   `xml:"entityID,attr"`
+	IDP      []struct {
+		Keys []struct {
+			Use          string   `xml:"use,attr"`
+			Certificates []string `xml:"KeyInfo>X509Data>X509Certificate"`
+		} `xml:"KeyDescriptor"`
+		SSO []struct {
+			Binding  string `xml:",attr"`
+			Location string `xml:",attr"`
+		} `xml:"SingleSignOnService"`
+	} `xml:"IDPSSODescriptor"`
+}
+
+// ParseMetadata reads the identity provider of the metadata of an entity.
+// Its signing certificates are trusted as is; metadata must therefore be
+// obtained from a trusted location.
+func ParseMetadata(b []byte) (*IdentityProvider, error) {
+	var md idpMetadata
+	if err := xml.Unmarshal(b, &md); err != nil {
+		return nil, fmt.Errorf("saml: invalid metadata: %v", err)
+	}
+	if len(md.IDP) == 0 {
+		return nil, errors.New("saml: metadata does not describe an identity provider")
+	}
+
+	idp := &IdentityProvider{EntityID: md.EntityID}
+	for _, sso := range md.IDP[0].SSO {
+		if sso.Binding == bindingHTTPRedirect {
+			idp.SSOURL = sso.Location
+			break
+		}
+	}
+	if idp.SSOURL == "" {
+		return nil, errors.New("saml: identity provider does not support the HTTP-Redirect binding")
+	}
+
+	for _, key := range md.IDP[0].Keys {
+		if key.Use == "encryption" {
+			continue
+		}
+		for _, c := range key.Certificates {
+			der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(c), ""))
+			if err != nil {
+				return nil, fmt.Errorf("saml: invalid certificate in metadata: %v", err)
+			}
+			cert, err := x509.ParseCertificate(der)
+			if err != nil {
+				return nil, fmt.Errorf("saml: invalid certificate in metadata: %v", err)
+			}
+			idp.Certificates = append(idp.Certificates, cert)
+		}
+	}
+	if len(idp.Certificates) == 0 {
+		return nil, errors.New("saml: metadata has no signing certificate")
+	}
+	return idp, nil
+}
+
+// LoadMetadata reads the identity provider of the metadata at an http(s)
+// URL or in a file
+func LoadMetadata(location string, timeout time.Duration) (*IdentityProvider, error) {
+	if !strings.HasPrefix(location, "http://") && !strings.HasPrefix(location, "https://") {
+		b, err := ioutil.ReadFile(location)
+		if err != nil {
+			return nil, err
+		}
+		return ParseMetadata(b)
+	}
+
+	client := &http.Client{Timeout: timeout}
+	resp, err := client.Get(location)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("saml: unable to fetch metadata: %s", resp.Status)
+	}
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetadata(b)
+}
@@ -0,0 +1,359 @@
+// Package saml implements a SAML 2.0 service provider authenticating users
+// by the Web Browser SSO profile. Authentication requests are sent by the
+// HTTP-Redirect binding and responses received by the HTTP-POST binding.
+// Responses or their assertions must be signed by the identity provider;
+// encrypted assertions are not supported.
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	protocolNamespace  = "urn:oasis:names:tc:SAML:2.0:protocol"
+	assertionNamespace = "urn:oasis:names:tc:SAML:2.0:assertion"
+
+	bindingHTTPPost     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+	bindingHTTPRedirect = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+	statusSuccess       = "urn:oasis:names:tc:SAML:2.0:status:Success"
+	confirmationBearer  = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+	nameIDUnspecified   = "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified"
+)
+
+// DefaultClockSkew is the tolerated difference between the clocks of the
+// service and identity providers
+const DefaultClockSkew = 3 * time.Minute
+
+// IdentityProvider is the party authenticating users such as ADFS or
+// Shibboleth
+type IdentityProvider struct {
+	EntityID     string              // EntityID identifies the identity provider as the issuer of assertions
+	SSOURL       string              // SSOURL receives authentication requests by the HTTP-Redirect binding
+	Certificates []*x509.Certificate // Certificates verify the signatures of responses and assertions
+}
+
+// ServiceProvider authenticates users with an IdentityProvider
+type ServiceProvider struct {
+	EntityID        string // EntityID identifies the service provider as the audience of assertions
+	ACSURL          string // ACSURL is the assertion consumer service receiving responses by the HTTP-POST binding
+	IDP             IdentityProvider
+	GroupsAttribute string           // GroupsAttribute is the name of the attribute of assertions listing the groups of users
+	ClockSkew       time.Duration    // ClockSkew is tolerated when validating the time conditions of assertions
+	Now             func() time.Time // Now returns the current time (for testing)
+}
+
+// Assertion is the authenticated identity of a user
+type Assertion struct {
+	NameID string
+	Groups []string
+}
+
+type spMetadata struct {
+	XMLName  xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntityDescriptor"`
+	EntityID string   `xml:"entityID,attr"`
+	SP       struct {
+		AuthnRequestsSigned        bool   `xml:",attr"`
+		WantAssertionsSigned       bool   `xml:",attr"`
+		ProtocolSupportEnumeration string `xml:"protocolSupportEnumeration,attr"`
+		NameIDFormat               string
+		ACS                        struct {
+			Binding  string `xml:",attr"`
+			Location string `xml:",attr"`
+			Index    int    `xml:"index,attr"`
+		} `xml:"AssertionConsumerService"`
+	} `xml:"SPSSODescriptor"`
+}
+
+// Metadata returns the metadata describing the service provider to
+// identity providers
+func (sp *ServiceProvider) Metadata() ([]byte, error) {
+	var md spMetadata
+	md.EntityID = sp.EntityID
+	md.SP.WantAssertionsSigned = true
+	md.SP.ProtocolSupportEnumeration = protocolNamespace
+	md.SP.NameIDFormat = nameIDUnspecified
+	md.SP.ACS.Binding = bindingHTTPPost
+	md.SP.ACS.Location = sp.ACSURL
+	md.SP.ACS.Index = 1
+
+	b, err := xml.MarshalIndent(md, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), b...), nil
+}
+
+type authnRequest struct {
+	XMLName                     xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:protocol AuthnRequest"`
+	ID                          string   `xml:",attr"`
+	Version                     string   `xml:",attr"`
+	IssueInstant                string   `xml:",attr"`
+	Destination                 string   `xml:",attr"`
+	AssertionConsumerServiceURL string   `xml:",attr"`
+	ProtocolBinding             string   `xml:",attr"`
+	Issuer                      struct {
+		XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:assertion Issuer"`
+		Value   string   `xml:",chardata"`
+	}
+	NameIDPolicy struct {
+		AllowCreate bool `xml:",attr"`
+	}
+}
+
+// NewRequestID returns a random ID for an authentication request
+func NewRequestID() (string, error) {
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	// IDs are of type xs:ID and must not start with a digit
+	return "id-" + hex.EncodeToString(b), nil
+}
+
+// AuthnRequestURL returns the URL of the identity provider redirecting users
+// to authenticate them for the request with ID id. The identity provider
+// returns relayState unaltered with its response.
+func (sp *ServiceProvider) AuthnRequestURL(id, relayState string) (string, error) {
+	req := authnRequest{
+		ID:                          id,
+		Version:                     "2.0",
+		IssueInstant:                sp.now().UTC().Format(time.RFC3339),
+		Destination:                 sp.IDP.SSOURL,
+		AssertionConsumerServiceURL: sp.ACSURL,
+		ProtocolBinding:             bindingHTTPPost,
+	}
+	req.Issuer.Value = sp.EntityID
+	req.NameIDPolicy.AllowCreate = true
+
+	b, err := xml.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	w, err := flate.NewWriter(&buf, flate.BestCompression)
+	if err != nil {
+		return "", err
+	}
+	if _, err := w.Write(b); err != nil {
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(sp.IDP.SSOURL)
+	if err != nil {
+		return "", err
+	}
+	q := u.Query()
+	q.Set("SAMLRequest", base64.StdEncoding.EncodeToString(buf.Bytes()))
+	q.Set("RelayState", relayState)
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// ParseResponse validates the base64 encoded response of the identity
+// provider to the authentication request with ID requestID and returns the
+// assertion of the identity of the user. Unsolicited responses are rejected.
+func (sp *ServiceProvider) ParseResponse(samlResponse, requestID string) (*Assertion, error) {
+	b, err := base64.StdEncoding.DecodeString(samlResponse)
+	if err != nil {
+		return nil, fmt.Errorf("saml: response is not base64 encoded: %v", err)
+	}
+	resp, err := parseXML(b)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.is(protocolNamespace, "Response") {
+		return nil, errors.New("saml: not a response")
+	}
+
+	// A signed response covers its assertions, which are then read from the
+	// signed octets only
+	responseSigned := false
+	if signed, err := verifySignature(resp, sp.IDP.Certificates); err == nil {
+		if resp, err = parseXML(signed); err != nil {
+			return nil, err
+		}
+		responseSigned = true
+	} else if err != errUnsigned {
+		return nil, err
+	}
+
+	if resp.attr("Version") != "2.0" {
+		return nil, errors.New("saml: unsupported version")
+	}
+	if dest := resp.attr("Destination"); dest != "" && dest != sp.ACSURL {
+		return nil, fmt.Errorf("saml: response is destined to %s", dest)
+	}
+	if requestID == "" || resp.attr("InResponseTo") != requestID {
+		return nil, errors.New("saml: response does not respond to the request")
+	}
+	if err := checkStatus(resp); err != nil {
+		return nil, err
+	}
+
+	if resp.child(assertionNamespace, "EncryptedAssertion") != nil {
+		return nil, errors.New("saml: encrypted assertions are not supported")
+	}
+	assertions := resp.childElements(assertionNamespace, "Assertion")
+	if len(assertions) != 1 {
+		return nil, fmt.Errorf("saml: response has %d assertions, want one", len(assertions))
+	}
+	assertion := assertions[0]
+	if signed, err := verifySignature(assertion, sp.IDP.Certificates); err == nil {
+		if assertion, err = parseXML(signed); err != nil {
+			return nil, err
+		}
+	} else if err != errUnsigned || !responseSigned {
+		return nil, err
+	}
+
+	return sp.validateAssertion(assertion, requestID)
+}
+
+func checkStatus(resp *element) error {
+	status := resp.child(protocolNamespace, "Status")
+	if status == nil {
+		return errors.New("saml: response has no status")
+	}
+	code := status.child(protocolNamespace, "StatusCode")
+	if code == nil || code.attr("Value") != statusSuccess {
+		value := ""
+		if code != nil {
+			value = code.attr("Value")
+		}
+		msg := ""
+		if m := status.child(protocolNamespace, "StatusMessage"); m != nil {
+			msg = m.text()
+		}
+		return fmt.Errorf("saml: authentication failed with status %s %s", value, msg)
+	}
+	return nil
+}
+
+func (sp *ServiceProvider) validateAssertion(a *element, requestID string) (*Assertion, error) {
+	if !a.is(assertionNamespace, "Assertion") || a.attr("Version") != "2.0" {
+		return nil, errors.New("saml: not a SAML 2.0 assertion")
+	}
+	issuer := a.child(assertionNamespace, "Issuer")
+	if issuer == nil || issuer.text() != sp.IDP.EntityID {
+		return nil, errors.New("saml: assertion is not issued by the identity provider")
+	}
+
+	now := sp.now()
+	subject := a.child(assertionNamespace, "Subject")
+	if subject == nil {
+		return nil, errors.New("saml: assertion has no subject")
+	}
+	nameID := subject.child(assertionNamespace, "NameID")
+	if nameID == nil || nameID.text() == "" {
+		return nil, errors.New("saml: assertion has no NameID")
+	}
+	confirmed := false
+	for _, c := range subject.childElements(assertionNamespace, "SubjectConfirmation") {
+		data := c.child(assertionNamespace, "SubjectConfirmationData")
+		if c.attr("Method") != confirmationBearer || data == nil {
+			continue
+		}
+		if data.attr("Recipient") != sp.ACSURL {
+			continue
+		}
+		// A captured assertion cannot be replayed for another request
+		if data.attr("InResponseTo") != requestID {
+			continue
+		}
+		if sp.within(now, data.attr("NotBefore"), data.attr("NotOnOrAfter"), true) == nil {
+			confirmed = true
+			break
+		}
+	}
+	if !confirmed {
+		return nil, errors.New("saml: subject of the assertion is not confirmed")
+	}
+
+	if conditions := a.child(assertionNamespace, "Conditions"); conditions != nil {
+		if err := sp.within(now, conditions.attr("NotBefore"), conditions.attr("NotOnOrAfter"), false); err != nil {
+			return nil, err
+		}
+		for _, restriction := range conditions.childElements(assertionNamespace, "AudienceRestriction") {
+			if !hasAudience(restriction, sp.EntityID) {
+				return nil, errors.New("saml: service provider is not an audience of the assertion")
+			}
+		}
+	}
+
+	groups := []string{}
+	for _, statement := range a.childElements(assertionNamespace, "AttributeStatement") {
+		for _, attribute := range statement.childElements(assertionNamespace, "Attribute") {
+			if sp.GroupsAttribute == "" || (attribute.attr("Name") != sp.GroupsAttribute && attribute.attr("FriendlyName") != sp.GroupsAttribute) {
+				continue
+			}
+			for _, v := range attribute.childElements(assertionNamespace, "AttributeValue") {
+				if g := v.text(); g != "" {
+					groups = append(groups, g)
+				}
+			}
+		}
+	}
+
+	return &Assertion{
+		NameID: nameID.text(),
+		Groups: groups,
+	}, nil
+}
+
+func hasAudience(restriction *element, entityID string) bool {
+	for _, audience := range restriction.childElements(assertionNamespace, "Audience") {
+		if audience.text() == entityID {
+			return true
+		}
+	}
+	return false
+}
+
+// within checks that now is within the optional bounds, tolerating the
+// clock skew. Bounds of subject confirmations require NotOnOrAfter.
+func (sp *ServiceProvider) within(now time.Time, notBefore, notOnOrAfter string, requireExpiry bool) error {
+	skew := sp.ClockSkew
+	if notBefore != "" {
+		t, err := time.Parse(time.RFC3339, notBefore)
+		if err != nil {
+			return fmt.Errorf("saml: invalid NotBefore %q", notBefore)
+		}
+		if now.Add(skew).Before(t) {
+			return errors.New("saml: assertion is not yet valid")
+		}
+	}
+	if notOnOrAfter == "" {
+		if requireExpiry {
+			return errors.New("saml: subject confirmation does not expire")
+		}
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, notOnOrAfter)
+	if err != nil {
+		return fmt.Errorf("saml: invalid NotOnOrAfter %q", notOnOrAfter)
+	}
+	if !now.Add(-skew).Before(t) {
+		return errors.New("saml: assertion has expired")
+	}
+	return nil
+}
+
+func (sp *ServiceProvider) now() time.Time {
+	if sp.Now != nil {
+		return sp.Now()
+	}
+	return time.Now()
+}
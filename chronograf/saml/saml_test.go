@@ -0,0 +1,349 @@
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"io/ioutil"
+	"math/big"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testNow = time.Date(2018, 1, 2, 15, 4, 5, 0, time.UTC)
+
+func testCertificate(t *testing.T) (*rsa.PrivateKey, *x509.Certificate) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    testNow.Add(-time.Hour),
+		NotAfter:     testNow.Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, cert
+}
+
+const testSignature = `<ds:Signature xmlns:ds="http://www.w3.org/2000/09/xmldsig#"><ds:SignedInfo>` +
+	`<ds:CanonicalizationMethod Algorithm="http://www.w3.org/2001/10/xml-exc-c14n#"/>` +
+	`<ds:SignatureMethod Algorithm="http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"/>` +
+	`<ds:Reference URI="#%s"><ds:Transforms>` +
+	`<ds:Transform Algorithm="http://www.w3.org/2000/09/xmldsig#enveloped-signature"/>` +
+	`<ds:Transform Algorithm="http://www.w3.org/2001/10/xml-exc-c14n#"><ec:InclusiveNamespaces xmlns:ec="http://www.w3.org/2001/10/xml-exc-c14n#" PrefixList="xs"/></ds:Transform>` +
+	`</ds:Transforms><ds:DigestMethod Algorithm="http://www.w3.org/2001/04/xmlenc#sha256"/><ds:DigestValue></ds:DigestValue></ds:Reference>` +
+	`</ds:SignedInfo><ds:SignatureValue></ds:SignatureValue></ds:Signature>`
+
+// sign fills in the digest and the value of the signature of e
+func sign(t *testing.T, e *element, key *rsa.PrivateKey) {
+	sig := e.child(dsigNamespace, "Signature")
+	signedInfo := sig.child(dsigNamespace, "SignedInfo")
+	ref := signedInfo.child(dsigNamespace, "Reference")
+
+	digest := sha256.Sum256(canonicalize(e, []string{"xs"}, sig))
+	ref.child(dsigNamespace, "DigestValue").children = []interface{}{base64.StdEncoding.EncodeToString(digest[:])}
+
+	hashed := sha256.Sum256(canonicalize(signedInfo, nil, nil))
+	value, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hashed[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig.child(dsigNamespace, "SignatureValue").children = []interface{}{base64.StdEncoding.EncodeToString(value)}
+}
+
+type testResponse struct {
+	inResponseTo    string
+	status          string
+	recipient       string
+	audience        string
+	notOnOrAfter    time.Time
+	signResponse    bool
+	signAssertion   bool
+	extraAssertion  bool
+	tamperAfterSign bool
+}
+
+func (r testResponse) encode(t *testing.T, key *rsa.PrivateKey) string {
+	responseSig, assertionSig := "", ""
+	if r.signResponse {
+		responseSig = strings.Replace(testSignature, "%s", "resp-1", 1)
+	}
+	if r.signAssertion {
+		assertionSig = strings.Replace(testSignature, "%s", "assertion-1", 1)
+	}
+	assertion := `<saml:Assertion xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" xmlns:xs="http://www.w3.org/2001/XMLSchema" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" ID="assertion-1" Version="2.0" IssueInstant="2018-01-02T15:04:05Z">` +
+		`<saml:Issuer>https://idp.example.com</saml:Issuer>` + assertionSig +
+		`<saml:Subject><saml:NameID>marty@example.com</saml:NameID>` +
+		`<saml:SubjectConfirmation Method="urn:oasis:names:tc:SAML:2.0:cm:bearer"><saml:SubjectConfirmationData InResponseTo="` + r.inResponseTo + `" Recipient="` + r.recipient + `" NotOnOrAfter="` + r.notOnOrAfter.Format(time.RFC3339) + `"/></saml:SubjectConfirmation></saml:Subject>` +
+		`<saml:Conditions NotBefore="2018-01-02T15:00:00.000Z" NotOnOrAfter="` + r.notOnOrAfter.Format(time.RFC3339Nano) + `"><saml:AudienceRestriction><saml:Audience>` + r.audience + `</saml:Audience></saml:AudienceRestriction></saml:Conditions>` +
+		`<saml:AttributeStatement><saml:Attribute Name="groups"><saml:AttributeValue xsi:type="xs:string">ops</saml:AttributeValue><saml:AttributeValue xsi:type="xs:string">dev</saml:AttributeValue></saml:Attribute></saml:AttributeStatement>` +
+		`</saml:Assertion>`
+	doc := `<samlp:Response xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" ID="resp-1" Version="2.0" InResponseTo="` + r.inResponseTo + `" Destination="https://chronograf.example.com/saml/acs">` +
+		responseSig + `<samlp:Status><samlp:StatusCode Value="` + r.status + `"/></samlp:Status>` + assertion + `</samlp:Response>`
+
+	resp, err := parseXML([]byte(doc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := resp.child(assertionNamespace, "Assertion")
+	if r.signAssertion {
+		sign(t, a, key)
+	}
+	if r.signResponse {
+		sign(t, resp, key)
+	}
+	if r.tamperAfterSign {
+		a.child(assertionNamespace, "Subject").child(assertionNamespace, "NameID").children = []interface{}{"biff@example.com"}
+	}
+	if r.extraAssertion {
+		resp.children = append(resp.children, a)
+	}
+	var b bytes.Buffer
+	serialize(&b, resp)
+	return base64.StdEncoding.EncodeToString(b.Bytes())
+}
+
+// serialize writes e with all of its namespace declarations as parsed
+func serialize(b *bytes.Buffer, e *element) {
+	b.WriteString("<" + qualifiedName(e.prefix, e.name))
+	for _, a := range e.attrs {
+		b.WriteString(" " + qualifiedName(a.prefix, a.name) + `="` + escapeAttr(a.value) + `"`)
+	}
+	b.WriteString(">")
+	for _, c := range e.children {
+		switch c := c.(type) {
+		case *element:
+			serialize(b, c)
+		case string:
+			b.WriteString(escapeText(c))
+		}
+	}
+	b.WriteString("</" + qualifiedName(e.prefix, e.name) + ">")
+}
+
+func testServiceProvider(cert *x509.Certificate) *ServiceProvider {
+	return &ServiceProvider{
+		EntityID: "https://chronograf.example.com/saml/metadata",
+		ACSURL:   "https://chronograf.example.com/saml/acs",
+		IDP: IdentityProvider{
+			EntityID:     "https://idp.example.com",
+			SSOURL:       "https://idp.example.com/sso?tenant=1",
+			Certificates: []*x509.Certificate{cert},
+		},
+		GroupsAttribute: "groups",
+		ClockSkew:       DefaultClockSkew,
+		Now:             func() time.Time { return testNow },
+	}
+}
+
+func TestServiceProvider_ParseResponse(t *testing.T) {
+	key, cert := testCertificate(t)
+	otherKey, _ := testCertificate(t)
+	valid := testResponse{
+		inResponseTo:  "id-1",
+		status:        statusSuccess,
+		recipient:     "https://chronograf.example.com/saml/acs",
+		audience:      "https://chronograf.example.com/saml/metadata",
+		notOnOrAfter:  testNow.Add(5 * time.Minute),
+		signAssertion: true,
+	}
+	tests := []struct {
+		name    string
+		resp    func() testResponse
+		key     *rsa.PrivateKey
+		want    *Assertion
+		wantErr bool
+	}{
+		{
+			name: "Signed assertion",
+			resp: func() testResponse { return valid },
+			want: &Assertion{NameID: "marty@example.com", Groups: []string{"ops", "dev"}},
+		},
+		{
+			name: "Signed response",
+			resp: func() testResponse {
+				r := valid
+				r.signAssertion, r.signResponse = false, true
+				return r
+			},
+			want: &Assertion{NameID: "marty@example.com", Groups: []string{"ops", "dev"}},
+		},
+		{
+			name: "Signed response and assertion",
+			resp: func() testResponse {
+				r := valid
+				r.signResponse = true
+				return r
+			},
+			want: &Assertion{NameID: "marty@example.com", Groups: []string{"ops", "dev"}},
+		},
+		{
+			name: "Unsigned",
+			resp: func() testResponse {
+				r := valid
+				r.signAssertion = false
+				return r
+			},
+			wantErr: true,
+		},
+		{
+			name:    "Signed by another key",
+			resp:    func() testResponse { return valid },
+			key:     otherKey,
+			wantErr: true,
+		},
+		{
+			name: "Modified after signing",
+			resp: func() testResponse {
+				r := valid
+				r.tamperAfterSign = true
+				return r
+			},
+			wantErr: true,
+		},
+		{
+			name: "Additional assertion",
+			resp: func() testResponse {
+				r := valid
+				r.extraAssertion = true
+				return r
+			},
+			wantErr: true,
+		},
+		{
+			name: "Response to another request",
+			resp: func() testResponse {
+				r := valid
+				r.inResponseTo = "id-2"
+				return r
+			},
+			wantErr: true,
+		},
+		{
+			name: "Failed authentication",
+			resp: func() testResponse {
+				r := valid
+				r.status = "urn:oasis:names:tc:SAML:2.0:status:Requester"
+				return r
+			},
+			wantErr: true,
+		},
+		{
+			name: "Another recipient",
+			resp: func() testResponse {
+				r := valid
+				r.recipient = "https://evil.example.com/saml/acs"
+				return r
+			},
+			wantErr: true,
+		},
+		{
+			name: "Another audience",
+			resp: func() testResponse {
+				r := valid
+				r.audience = "https://evil.example.com/saml/metadata"
+				return r
+			},
+			wantErr: true,
+		},
+		{
+			name: "Expired",
+			resp: func() testResponse {
+				r := valid
+				r.notOnOrAfter = testNow.Add(-5 * time.Minute)
+				return r
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signer := key
+			if tt.key != nil {
+				signer = tt.key
+			}
+			sp := testServiceProvider(cert)
+			got, err := sp.ParseResponse(tt.resp().encode(t, signer), "id-1")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseResponse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseResponse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServiceProvider_AuthnRequestURL(t *testing.T) {
+	_, cert := testCertificate(t)
+	sp := testServiceProvider(cert)
+	got, err := sp.AuthnRequestURL("id-1", "state")
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := url.Parse(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Host != "idp.example.com" || u.Query().Get("tenant") != "1" || u.Query().Get("RelayState") != "state" {
+		t.Errorf("AuthnRequestURL() = %s", got)
+	}
+
+	deflated, err := base64.StdEncoding.DecodeString(u.Query().Get("SAMLRequest"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadAll(flate.NewReader(bytes.NewReader(deflated)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := parseXML(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !req.is(protocolNamespace, "AuthnRequest") || req.attr("ID") != "id-1" || req.attr("AssertionConsumerServiceURL") != sp.ACSURL {
+		t.Errorf("AuthnRequestURL() request = %s", b)
+	}
+	if issuer := req.child(assertionNamespace, "Issuer"); issuer == nil || issuer.text() != sp.EntityID {
+		t.Errorf("AuthnRequestURL() request = %s", b)
+	}
+}
+
+func TestParseMetadata(t *testing.T) {
+	_, cert := testCertificate(t)
+	md := `<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" xmlns:ds="http://www.w3.org/2000/09/xmldsig#" entityID="https://idp.example.com">` +
+		`<md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">` +
+		`<md:KeyDescriptor use="signing"><ds:KeyInfo><ds:X509Data><ds:X509Certificate>` + base64.StdEncoding.EncodeToString(cert.Raw) + `</ds:X509Certificate></ds:X509Data></ds:KeyInfo></md:KeyDescriptor>` +
+		`<md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://idp.example.com/sso/post"/>` +
+		`<md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://idp.example.com/sso/redirect"/>` +
+		`</md:IDPSSODescriptor></md:EntityDescriptor>`
+
+	idp, err := ParseMetadata([]byte(md))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if idp.EntityID != "https://idp.example.com" || idp.SSOURL != "https://idp.example.com/sso/redirect" {
+		t.Errorf("ParseMetadata() = %+v", idp)
+	}
+	if len(idp.Certificates) != 1 || !idp.Certificates[0].Equal(cert) {
+		t.Errorf("ParseMetadata() certificates = %v", idp.Certificates)
+	}
+}
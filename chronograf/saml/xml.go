@@ -0,0 +1,143 @@
+package saml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const xmlNamespace = "http://www.w3.org/XML/1998/namespace"
+
+// attr is an attribute or a namespace declaration of an element. The prefix
+// of a declaration is xmlns; the default namespace is declared by the name
+// xmlns without a prefix.
+type attr struct {
+	prefix, name, value string
+}
+
+func (a attr) isNamespace() bool {
+	return a.prefix == "xmlns" || (a.prefix == "" && a.name == "xmlns")
+}
+
+// element is a node of a document that keeps the prefixes of its names so
+// that it can be canonicalized as it was signed
+type element struct {
+	prefix, name string
+	attrs        []attr
+	children     []interface{} // *element or string character data
+	parent       *element
+}
+
+// parseXML reads the root element of a document. Document type declarations
+// are rejected so that no entities are declared.
+func parseXML(b []byte) (*element, error) {
+	d := xml.NewDecoder(bytes.NewReader(b))
+	var root, cur *element
+	for {
+		tok, err := d.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if cur == nil && root != nil {
+				return nil, errors.New("saml: document has more than one root element")
+			}
+			e := &element{prefix: t.Name.Space, name: t.Name.Local, parent: cur}
+			for _, a := range t.Attr {
+				e.attrs = append(e.attrs, attr{prefix: a.Name.Space, name: a.Name.Local, value: a.Value})
+			}
+			if cur == nil {
+				root = e
+			} else {
+				cur.children = append(cur.children, e)
+			}
+			cur = e
+		case xml.EndElement:
+			if cur == nil || cur.prefix != t.Name.Space || cur.name != t.Name.Local {
+				return nil, fmt.Errorf("saml: unexpected end element %s", t.Name.Local)
+			}
+			cur = cur.parent
+		case xml.CharData:
+			if cur != nil {
+				cur.children = append(cur.children, string(t))
+			}
+		case xml.Directive:
+			return nil, errors.New("saml: document type declarations are not permitted")
+		}
+	}
+	if root == nil || cur != nil {
+		return nil, errors.New("saml: incomplete document")
+	}
+	return root, nil
+}
+
+// lookupNamespace returns the namespace bound to prefix in the scope of e
+func (e *element) lookupNamespace(prefix string) (string, bool) {
+	if prefix == "xml" {
+		return xmlNamespace, true
+	}
+	for ; e != nil; e = e.parent {
+		for _, a := range e.attrs {
+			if (prefix == "" && a.prefix == "" && a.name == "xmlns") || (prefix != "" && a.prefix == "xmlns" && a.name == prefix) {
+				return a.value, true
+			}
+		}
+	}
+	return "", false
+}
+
+func (e *element) namespace() string {
+	ns, _ := e.lookupNamespace(e.prefix)
+	return ns
+}
+
+func (e *element) is(ns, name string) bool {
+	return e.name == name && e.namespace() == ns
+}
+
+// attr returns the value of the unqualified attribute name
+func (e *element) attr(name string) string {
+	for _, a := range e.attrs {
+		if a.prefix == "" && a.name == name {
+			return a.value
+		}
+	}
+	return ""
+}
+
+// child returns the first child element named name of the namespace ns
+func (e *element) child(ns, name string) *element {
+	for _, c := range e.childElements(ns, name) {
+		return c
+	}
+	return nil
+}
+
+// childElements returns the child elements named name of the namespace ns
+func (e *element) childElements(ns, name string) []*element {
+	var es []*element
+	for _, c := range e.children {
+		if c, ok := c.(*element); ok && c.is(ns, name) {
+			es = append(es, c)
+		}
+	}
+	return es
+}
+
+// text returns the character data of e with surrounding whitespace removed
+func (e *element) text() string {
+	var b strings.Builder
+	for _, c := range e.children {
+		if s, ok := c.(string); ok {
+			b.WriteString(s)
+		}
+	}
+	return strings.TrimSpace(b.String())
+}
@@ -0,0 +1,260 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Attributes are the values of the attributes of a resource by lower case
+// attribute path, such as "username" or "members.value"
+type Attributes map[string][]string
+
+// Filter selects resources by their attributes
+type Filter interface {
+	Match(Attributes) bool
+}
+
+// ParseFilter parses the filter expression of the filter query parameter,
+// for example `userName eq "bob" and active eq true`. Comparisons of strings
+// ignore case. An empty expression matches every resource.
+func ParseFilter(expr string) (Filter, error) {
+	toks, err := lex(expr)
+	if err != nil {
+		return nil, err
+	}
+	if len(toks) == 0 {
+		return all{}, nil
+	}
+	p := &parser{toks: toks}
+	f, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.toks) {
+		return nil, fmt.Errorf("unexpected %s", p.toks[p.pos].text)
+	}
+	return f, nil
+}
+
+type all struct{}
+
+func (all) Match(Attributes) bool { return true }
+
+type and struct{ left, right Filter }
+
+func (f and) Match(a Attributes) bool { return f.left.Match(a) && f.right.Match(a) }
+
+type or struct{ left, right Filter }
+
+func (f or) Match(a Attributes) bool { return f.left.Match(a) || f.right.Match(a) }
+
+type not struct{ f Filter }
+
+func (f not) Match(a Attributes) bool { return !f.f.Match(a) }
+
+type compare struct {
+	attr  string
+	op    string
+	value string
+}
+
+func (f compare) Match(a Attributes) bool {
+	values := a[f.attr]
+	if f.op == "pr" {
+		return len(values) > 0
+	}
+	for _, v := range values {
+		if f.matchValue(strings.ToLower(v)) {
+			return true
+		}
+	}
+	// ne matches resources without the attribute
+	return f.op == "ne" && len(values) == 0
+}
+
+func (f compare) matchValue(v string) bool {
+	switch f.op {
+	case "eq":
+		return v == f.value
+	case "ne":
+		return v != f.value
+	case "co":
+		return strings.Contains(v, f.value)
+	case "sw":
+		return strings.HasPrefix(v, f.value)
+	case "ew":
+		return strings.HasSuffix(v, f.value)
+	case "gt":
+		return v > f.value
+	case "ge":
+		return v >= f.value
+	case "lt":
+		return v < f.value
+	case "le":
+		return v <= f.value
+	}
+	return false
+}
+
+type token struct {
+	text   string
+	quoted bool
+}
+
+func lex(expr string) ([]token, error) {
+	toks := []token{}
+	for i := 0; i < len(expr); {
+		switch c := expr[i]; {
+		case c == ' ' || c == '\t' || c == '\n':
+			i++
+		case c == '(' || c == ')':
+			toks = append(toks, token{text: string(c)})
+			i++
+		case c == '"':
+			j := i + 1
+			for ; j < len(expr) && expr[j] != '"'; j++ {
+				if expr[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(expr) {
+				return nil, fmt.Errorf("unterminated string %s", expr[i:])
+			}
+			var s string
+			if err := json.Unmarshal([]byte(expr[i:j+1]), &s); err != nil {
+				return nil, fmt.Errorf("invalid string %s", expr[i:j+1])
+			}
+			toks = append(toks, token{text: s, quoted: true})
+			i = j + 1
+		default:
+			j := i
+			for ; j < len(expr) && !strings.ContainsRune(" \t\n()\"", rune(expr[j])); j++ {
+			}
+			toks = append(toks, token{text: expr[i:j]})
+			i = j
+		}
+	}
+	return toks, nil
+}
+
+type parser struct {
+	toks []token
+	pos  int
+}
+
+func (p *parser) next() (token, bool) {
+	if p.pos >= len(p.toks) {
+		return token{}, false
+	}
+	t := p.toks[p.pos]
+	p.pos++
+	return t, true
+}
+
+// keyword consumes the next token if it is the unquoted keyword kw
+func (p *parser) keyword(kw string) bool {
+	if p.pos < len(p.toks) && !p.toks[p.pos].quoted && strings.EqualFold(p.toks[p.pos].text, kw) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) or() (Filter, error) {
+	left, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("or") {
+		right, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		left = or{left, right}
+	}
+	return left, nil
+}
+
+func (p *parser) and() (Filter, error) {
+	left, err := p.factor()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("and") {
+		right, err := p.factor()
+		if err != nil {
+			return nil, err
+		}
+		left = and{left, right}
+	}
+	return left, nil
+}
+
+func (p *parser) factor() (Filter, error) {
+	if p.keyword("not") {
+		if !p.keyword("(") {
+			return nil, fmt.Errorf("expected ( after not")
+		}
+		f, err := p.group()
+		if err != nil {
+			return nil, err
+		}
+		return not{f}, nil
+	}
+	if p.keyword("(") {
+		return p.group()
+	}
+
+	attr, ok := p.next()
+	if !ok || attr.quoted {
+		return nil, fmt.Errorf("expected attribute")
+	}
+	op, ok := p.next()
+	if !ok || op.quoted {
+		return nil, fmt.Errorf("expected operator after %s", attr.text)
+	}
+	f := compare{
+		attr: strings.ToLower(attr.text),
+		op:   strings.ToLower(op.text),
+	}
+	switch f.op {
+	case "pr":
+		return f, nil
+	case "eq", "ne", "co", "sw", "ew", "gt", "ge", "lt", "le":
+	default:
+		return nil, fmt.Errorf("unknown operator %s", op.text)
+	}
+	value, ok := p.next()
+	if !ok {
+		return nil, fmt.Errorf("expected value after %s %s", attr.text, op.text)
+	}
+	if !value.quoted {
+		switch strings.ToLower(value.text) {
+		case "true", "false", "null":
+		default:
+			if !isNumber(value.text) {
+				return nil, fmt.Errorf("invalid value %s", value.text)
+			}
+		}
+	}
+	f.value = strings.ToLower(value.text)
+	return f, nil
+}
+
+// group parses the remainder of a parenthesized filter
+func (p *parser) group() (Filter, error) {
+	f, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if !p.keyword(")") {
+		return nil, fmt.Errorf("expected )")
+	}
+	return f, nil
+}
+
+func isNumber(s string) bool {
+	var f float64
+	return json.Unmarshal([]byte(s), &f) == nil
+}
@@ -0,0 +1,69 @@
+package scim
+
+import "testing"
+
+func TestParseFilter(t *testing.T) {
+	attrs := Attributes{
+		"username":     {"Bob@Example.com"},
+		"active":       {"true"},
+		"groups.value": {"1", "2"},
+	}
+	tests := []struct {
+		expr    string
+		want    bool
+		wantErr bool
+	}{
+		{expr: ``, want: true},
+		{expr: `userName eq "bob@example.com"`, want: true},
+		{expr: `userName eq "alice@example.com"`, want: false},
+		{expr: `USERNAME sw "bob" and active eq true`, want: true},
+		{expr: `userName co "example" and active eq false`, want: false},
+		{expr: `userName ew ".org" or groups.value eq "2"`, want: true},
+		{expr: `not (groups.value eq "2")`, want: false},
+		{expr: `(userName eq "x" or userName eq "bob@example.com") and active pr`, want: true},
+		{expr: `externalId pr`, want: false},
+		{expr: `externalId ne "x"`, want: true},
+		{expr: `userName eq "a \"quoted\" name"`, want: false},
+		{expr: `userName eq`, wantErr: true},
+		{expr: `userName is "bob"`, wantErr: true},
+		{expr: `userName eq bob`, wantErr: true},
+		{expr: `(userName eq "bob"`, wantErr: true},
+		{expr: `userName eq "bob`, wantErr: true},
+		{expr: `userName pr active`, wantErr: true},
+	}
+	for _, tt := range tests {
+		f, err := ParseFilter(tt.expr)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseFilter(%q) error = %v, wantErr %v", tt.expr, err, tt.wantErr)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if got := f.Match(attrs); got != tt.want {
+			t.Errorf("ParseFilter(%q).Match() = %v, want %v", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestParsePath(t *testing.T) {
+	attr, f, err := ParsePath(`members[value eq "2"]`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attr != "members" {
+		t.Errorf("ParsePath() attribute = %s, want members", attr)
+	}
+	if !f.Match(Attributes{"value": {"2"}}) || f.Match(Attributes{"value": {"3"}}) {
+		t.Errorf("ParsePath() filter does not select member 2")
+	}
+
+	attr, f, err = ParsePath(`displayName`)
+	if err != nil || attr != "displayname" || f != nil {
+		t.Errorf("ParsePath() = %s, %v, %v, want displayname without filter", attr, f, err)
+	}
+
+	if _, _, err := ParsePath(`members[value eq "2"`); err == nil {
+		t.Errorf("ParsePath() of unterminated filter succeeded")
+	}
+}
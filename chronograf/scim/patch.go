@@ -0,0 +1,72 @@
+package scim
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Operations of a PatchOperation
+const (
+	OpAdd     = "add"
+	OpReplace = "replace"
+	OpRemove  = "remove"
+)
+
+// ParsePath splits the path of a patch operation such as
+// `members[value eq "2"]` into its lower case attribute and value filter.
+// The filter is nil when the path does not select values.
+func ParsePath(path string) (string, Filter, error) {
+	i := strings.IndexByte(path, '[')
+	if i < 0 {
+		return strings.ToLower(path), nil, nil
+	}
+	if !strings.HasSuffix(path, "]") {
+		return "", nil, fmt.Errorf("invalid path %s", path)
+	}
+	f, err := ParseFilter(path[i+1 : len(path)-1])
+	if err != nil {
+		return "", nil, err
+	}
+	return strings.ToLower(path[:i]), f, nil
+}
+
+// MemberValues returns the values of the members of a patch operation
+// value, which is either a list of members or a single member
+func MemberValues(v interface{}) ([]string, error) {
+	switch m := v.(type) {
+	case nil:
+		return nil, nil
+	case []interface{}:
+		values := make([]string, 0, len(m))
+		for _, member := range m {
+			vs, err := MemberValues(member)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, vs...)
+		}
+		return values, nil
+	case map[string]interface{}:
+		value, ok := m["value"].(string)
+		if !ok {
+			return nil, fmt.Errorf("member value must be a string")
+		}
+		return []string{value}, nil
+	default:
+		return nil, fmt.Errorf("invalid members %v", v)
+	}
+}
+
+// Bool returns the boolean of a patch operation value. Some clients send
+// booleans as the strings "True" and "False".
+func Bool(v interface{}) (bool, error) {
+	switch b := v.(type) {
+	case bool:
+		return b, nil
+	case string:
+		return strconv.ParseBool(strings.ToLower(b))
+	default:
+		return false, fmt.Errorf("invalid boolean %v", v)
+	}
+}
@@ -0,0 +1,130 @@
+// Package scim contains the resources and protocol messages of the System for
+// Cross-domain Identity Management (SCIM) 2.0 described by RFC 7643 and RFC 7644.
+package scim
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Schema URNs of the SCIM resources and messages
+const (
+	UserSchema         = "urn:ietf:params:scim:schemas:core:2.0:User"
+	GroupSchema        = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	ChronografSchema   = "urn:ietf:params:scim:schemas:extension:chronograf:2.0:User"
+	ListResponseSchema = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	PatchOpSchema      = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	ErrorSchema        = "urn:ietf:params:scim:api:messages:2.0:Error"
+)
+
+// ContentType is the media type of SCIM requests and responses
+const ContentType = "application/scim+json"
+
+// Error types of SCIM errors
+const (
+	InvalidFilter = "invalidFilter"
+	InvalidSyntax = "invalidSyntax"
+	InvalidPath   = "invalidPath"
+	InvalidValue  = "invalidValue"
+	Uniqueness    = "uniqueness"
+	NoTarget      = "noTarget"
+	Mutability    = "mutability"
+)
+
+// User is a SCIM user resource
+type User struct {
+	Schemas    []string       `json:"schemas"`
+	ID         string         `json:"id,omitempty"`
+	ExternalID string         `json:"externalId,omitempty"`
+	UserName   string         `json:"userName"`
+	Active     *bool          `json:"active,omitempty"`
+	Groups     []Member       `json:"groups,omitempty"`
+	Chronograf *UserExtension `json:"urn:ietf:params:scim:schemas:extension:chronograf:2.0:User,omitempty"`
+	Meta       *Meta          `json:"meta,omitempty"`
+}
+
+// UserExtension holds the attributes of a Chronograf user that are not part of
+// the SCIM core user schema
+type UserExtension struct {
+	Provider   string `json:"provider,omitempty"`
+	Scheme     string `json:"scheme,omitempty"`
+	SuperAdmin bool   `json:"superAdmin"`
+}
+
+// Group is a SCIM group resource
+type Group struct {
+	Schemas     []string `json:"schemas"`
+	ID          string   `json:"id,omitempty"`
+	DisplayName string   `json:"displayName"`
+	Members     []Member `json:"members"`
+	Meta        *Meta    `json:"meta,omitempty"`
+}
+
+// Member references a user of a group, or a group of a user
+type Member struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+// Meta describes a resource
+type Meta struct {
+	ResourceType string     `json:"resourceType"`
+	Location     string     `json:"location"`
+	Created      *time.Time `json:"created,omitempty"`
+	LastModified *time.Time `json:"lastModified,omitempty"`
+}
+
+// ListResponse is a page of the resources matching a query
+type ListResponse struct {
+	Schemas      []string    `json:"schemas"`
+	TotalResults int         `json:"totalResults"`
+	StartIndex   int         `json:"startIndex"`
+	ItemsPerPage int         `json:"itemsPerPage"`
+	Resources    interface{} `json:"Resources"`
+}
+
+// PatchRequest modifies a resource by a sequence of operations
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+// PatchOperation adds, replaces or removes the attribute at Path. When Path
+// is empty, Value is an object of the attributes to modify.
+type PatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// Error is a SCIM error response
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail"`
+	code     int
+}
+
+// NewError creates a SCIM error with an HTTP status code and an optional
+// SCIM error type
+func NewError(code int, scimType, format string, args ...interface{}) *Error {
+	return &Error{
+		Schemas:  []string{ErrorSchema},
+		Status:   strconv.Itoa(code),
+		ScimType: scimType,
+		Detail:   fmt.Sprintf(format, args...),
+		code:     code,
+	}
+}
+
+// Code is the HTTP status code of the error
+func (e *Error) Code() int {
+	return e.code
+}
+
+func (e *Error) Error() string {
+	return e.Detail
+}
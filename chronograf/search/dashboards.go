@@ -0,0 +1,101 @@
+package search
+
+import (
+	"context"
+	"sync"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Ensure DashboardsStore implements chronograf.DashboardsStore.
+var _ chronograf.DashboardsStore = &DashboardsStore{}
+
+// DashboardsStore facade on a DashboardsStore that indexes the dashboards
+// written through it for full-text search
+type DashboardsStore struct {
+	store chronograf.DashboardsStore
+	index *Index
+
+	mu    sync.Mutex
+	built bool // built is true once the index holds every dashboard of store
+}
+
+// NewDashboardsStore creates a new DashboardsStore from an existing
+// chronograf.DashboardsStore
+func NewDashboardsStore(s chronograf.DashboardsStore) *DashboardsStore {
+	return &DashboardsStore{
+		store: s,
+		index: NewIndex(),
+	}
+}
+
+// All returns all dashboards of the underlying DashboardsStore
+func (s *DashboardsStore) All(ctx context.Context) ([]chronograf.Dashboard, error) {
+	return s.store.All(ctx)
+}
+
+// Add creates a dashboard in the underlying DashboardsStore and indexes it
+func (s *DashboardsStore) Add(ctx context.Context, d chronograf.Dashboard) (chronograf.Dashboard, error) {
+	d, err := s.store.Add(ctx, d)
+	if err != nil {
+		return d, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.built {
+		s.index.Add(d)
+	}
+	return d, nil
+}
+
+// Delete removes a dashboard from the underlying DashboardsStore and the index
+func (s *DashboardsStore) Delete(ctx context.Context, d chronograf.Dashboard) error {
+	if err := s.store.Delete(ctx, d); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.built {
+		s.index.Remove(d.ID)
+	}
+	return nil
+}
+
+// Get returns a dashboard of the underlying DashboardsStore
+func (s *DashboardsStore) Get(ctx context.Context, id chronograf.DashboardID) (chronograf.Dashboard, error) {
+	return s.store.Get(ctx, id)
+}
+
+// Update updates a dashboard in the underlying DashboardsStore and indexes it
+func (s *DashboardsStore) Update(ctx context.Context, d chronograf.Dashboard) error {
+	if err := s.store.Update(ctx, d); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.built {
+		s.index.Add(d)
+	}
+	return nil
+}
+
+// Search returns the names, cell titles and queries of the dashboards of an
+// organization matching query. The first search indexes every dashboard of
+// the underlying DashboardsStore.
+func (s *DashboardsStore) Search(ctx context.Context, organization, query string, limit int) ([]Hit, error) {
+	s.mu.Lock()
+	if !s.built {
+		dashboards, err := s.store.All(ctx)
+		if err != nil {
+			s.mu.Unlock()
+			return nil, err
+		}
+		for _, d := range dashboards {
+			s.index.Add(d)
+		}
+		s.built = true
+	}
+	s.mu.Unlock()
+
+	return s.index.Search(organization, query, limit), nil
+}
@@ -0,0 +1,242 @@
+// Package search indexes the names, cell titles and query text of dashboards
+// in memory for full-text search.
+//
+// The index is built from the dashboards store on the first search and kept
+// up to date by every write through the indexed store. Writes made by other
+// Chronograf replicas sharing a store are seen once this replica restarts.
+package search
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Types of the hits of a search
+const (
+	HitDashboard = "dashboard" // HitDashboard is a match of the name of a dashboard
+	HitCell      = "cell"      // HitCell is a match of the title of a cell
+	HitQuery     = "query"     // HitQuery is a match of the text of a query of a cell
+)
+
+// hitRanks orders hits of the same score, names before queries
+var hitRanks = map[string]int{
+	HitDashboard: 0,
+	HitCell:      1,
+	HitQuery:     2,
+}
+
+// Hit is a dashboard, cell or query matching a search
+type Hit struct {
+	Type         string
+	DashboardID  chronograf.DashboardID
+	Dashboard    string // Dashboard is the name of the dashboard
+	Organization string
+	CellID       string // CellID is the cell of cell and query hits
+	Cell         string // Cell is the title of the cell of cell and query hits
+	Text         string // Text is the matching name, title or query
+	Score        int    // Score is the number of search terms Text matches
+}
+
+// field is a text of a dashboard that searches match
+type field struct {
+	typ    string
+	cellID string
+	cell   string
+	text   string
+	tokens []string
+}
+
+type document struct {
+	id     chronograf.DashboardID
+	org    string
+	name   string
+	fields []field
+	tokens map[string]struct{}
+}
+
+// Index is an inverted index of the words of dashboards
+type Index struct {
+	mu     sync.RWMutex
+	docs   map[chronograf.DashboardID]*document
+	tokens map[string]map[chronograf.DashboardID]struct{}
+}
+
+// NewIndex creates an empty Index
+func NewIndex() *Index {
+	return &Index{
+		docs:   map[chronograf.DashboardID]*document{},
+		tokens: map[string]map[chronograf.DashboardID]struct{}{},
+	}
+}
+
+// Add indexes a dashboard, replacing the dashboard of the same ID if it was
+// indexed already
+func (i *Index) Add(d chronograf.Dashboard) {
+	doc := &document{
+		id:     d.ID,
+		org:    d.Organization,
+		name:   d.Name,
+		tokens: map[string]struct{}{},
+	}
+	doc.add(field{typ: HitDashboard, text: d.Name})
+	for _, c := range d.Cells {
+		doc.add(field{typ: HitCell, cellID: c.ID, cell: c.Name, text: c.Name})
+		for _, q := range c.Queries {
+			doc.add(field{typ: HitQuery, cellID: c.ID, cell: c.Name, text: q.Command})
+		}
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.remove(d.ID)
+	i.docs[d.ID] = doc
+	for t := range doc.tokens {
+		if i.tokens[t] == nil {
+			i.tokens[t] = map[chronograf.DashboardID]struct{}{}
+		}
+		i.tokens[t][d.ID] = struct{}{}
+	}
+}
+
+// Remove removes a dashboard from the index
+func (i *Index) Remove(id chronograf.DashboardID) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.remove(id)
+}
+
+func (i *Index) remove(id chronograf.DashboardID) {
+	doc, ok := i.docs[id]
+	if !ok {
+		return
+	}
+	for t := range doc.tokens {
+		delete(i.tokens[t], id)
+		if len(i.tokens[t]) == 0 {
+			delete(i.tokens, t)
+		}
+	}
+	delete(i.docs, id)
+}
+
+// Search returns the names, cell titles and queries of the dashboards of an
+// organization matching query, best matches first. Dashboards match when
+// every word of query starts a word of the dashboard; each name, title or
+// query containing any of the words is a hit. An empty organization searches
+// every organization; a limit of zero returns every hit.
+func (i *Index) Search(organization, query string, limit int) []Hit {
+	terms := tokenize(query)
+	if len(terms) == 0 {
+		return []Hit{}
+	}
+
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	var candidates map[chronograf.DashboardID]struct{}
+	for _, term := range terms {
+		matches := map[chronograf.DashboardID]struct{}{}
+		for t, ids := range i.tokens {
+			if !strings.HasPrefix(t, term) {
+				continue
+			}
+			for id := range ids {
+				if _, ok := candidates[id]; ok || candidates == nil {
+					matches[id] = struct{}{}
+				}
+			}
+		}
+		candidates = matches
+		if len(candidates) == 0 {
+			return []Hit{}
+		}
+	}
+
+	hits := []Hit{}
+	for id := range candidates {
+		doc := i.docs[id]
+		if organization != "" && doc.org != organization {
+			continue
+		}
+		for _, f := range doc.fields {
+			score := matchCount(f.tokens, terms)
+			if score == 0 {
+				continue
+			}
+			hits = append(hits, Hit{
+				Type:         f.typ,
+				DashboardID:  doc.id,
+				Dashboard:    doc.name,
+				Organization: doc.org,
+				CellID:       f.cellID,
+				Cell:         f.cell,
+				Text:         f.text,
+				Score:        score,
+			})
+		}
+	}
+
+	sort.SliceStable(hits, func(a, b int) bool {
+		ha, hb := hits[a], hits[b]
+		if ha.Score != hb.Score {
+			return ha.Score > hb.Score
+		}
+		if hitRanks[ha.Type] != hitRanks[hb.Type] {
+			return hitRanks[ha.Type] < hitRanks[hb.Type]
+		}
+		if ha.DashboardID != hb.DashboardID {
+			return ha.DashboardID < hb.DashboardID
+		}
+		return ha.CellID < hb.CellID
+	})
+	if limit > 0 && len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits
+}
+
+func (d *document) add(f field) {
+	f.tokens = tokenize(f.text)
+	if len(f.tokens) == 0 {
+		return
+	}
+	for _, t := range f.tokens {
+		d.tokens[t] = struct{}{}
+	}
+	d.fields = append(d.fields, f)
+}
+
+// matchCount returns the number of terms starting any of tokens
+func matchCount(tokens, terms []string) int {
+	n := 0
+	for _, term := range terms {
+		for _, t := range tokens {
+			if strings.HasPrefix(t, term) {
+				n++
+				break
+			}
+		}
+	}
+	return n
+}
+
+// tokenize splits text into its distinct lower case words. Underscores are
+// part of words so that measurement and field names stay whole.
+func tokenize(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	})
+	seen := map[string]bool{}
+	tokens := make([]string, 0, len(words))
+	for _, w := range words {
+		if !seen[w] {
+			seen[w] = true
+			tokens = append(tokens, w)
+		}
+	}
+	return tokens
+}
@@ -0,0 +1,180 @@
+package search
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+type dashboardsStore struct {
+	chronograf.DashboardsStore
+	reads      int
+	dashboards map[chronograf.DashboardID]chronograf.Dashboard
+}
+
+func (s *dashboardsStore) All(ctx context.Context) ([]chronograf.Dashboard, error) {
+	s.reads++
+	dashboards := []chronograf.Dashboard{}
+	for _, d := range s.dashboards {
+		dashboards = append(dashboards, d)
+	}
+	return dashboards, nil
+}
+
+func (s *dashboardsStore) Add(ctx context.Context, d chronograf.Dashboard) (chronograf.Dashboard, error) {
+	d.ID = chronograf.DashboardID(len(s.dashboards) + 1)
+	s.dashboards[d.ID] = d
+	return d, nil
+}
+
+func (s *dashboardsStore) Update(ctx context.Context, d chronograf.Dashboard) error {
+	s.dashboards[d.ID] = d
+	return nil
+}
+
+func (s *dashboardsStore) Delete(ctx context.Context, d chronograf.Dashboard) error {
+	delete(s.dashboards, d.ID)
+	return nil
+}
+
+func hitTexts(hits []Hit) []string {
+	texts := []string{}
+	for _, h := range hits {
+		texts = append(texts, h.Type+":"+h.Text)
+	}
+	return texts
+}
+
+func TestIndex_Search(t *testing.T) {
+	idx := NewIndex()
+	idx.Add(chronograf.Dashboard{
+		ID:           1,
+		Name:         "Production hosts",
+		Organization: "default",
+		Cells: []chronograf.DashboardCell{
+			{
+				ID:   "a",
+				Name: "CPU usage",
+				Queries: []chronograf.DashboardQuery{
+					{Command: `SELECT mean("usage_user") FROM "telegraf"."autogen"."cpu" WHERE time > :dashboardTime:`},
+				},
+			},
+			{
+				ID:   "b",
+				Name: "Memory",
+				Queries: []chronograf.DashboardQuery{
+					{Command: `SELECT mean("used_percent") FROM "telegraf"."autogen"."mem"`},
+				},
+			},
+		},
+	})
+	idx.Add(chronograf.Dashboard{
+		ID:           2,
+		Name:         "Staging cpu",
+		Organization: "default",
+	})
+	idx.Add(chronograf.Dashboard{
+		ID:           3,
+		Name:         "Production cpu",
+		Organization: "other",
+	})
+
+	tests := []struct {
+		name  string
+		org   string
+		query string
+		limit int
+		want  []string
+	}{
+		{
+			name:  "Names, titles and queries",
+			org:   "default",
+			query: "cpu",
+			want:  []string{"dashboard:Staging cpu", "cell:CPU usage", `query:SELECT mean("usage_user") FROM "telegraf"."autogen"."cpu" WHERE time > :dashboardTime:`},
+		},
+		{
+			name:  "Every word matches the dashboard",
+			org:   "default",
+			query: "prod cpu",
+			want:  []string{"dashboard:Production hosts", "cell:CPU usage", `query:SELECT mean("usage_user") FROM "telegraf"."autogen"."cpu" WHERE time > :dashboardTime:`},
+		},
+		{
+			name:  "Field names",
+			org:   "default",
+			query: "used_percent",
+			want:  []string{`query:SELECT mean("used_percent") FROM "telegraf"."autogen"."mem"`},
+		},
+		{
+			name:  "Limit",
+			org:   "default",
+			query: "cpu",
+			limit: 1,
+			want:  []string{"dashboard:Staging cpu"},
+		},
+		{
+			name:  "Every organization",
+			query: "production",
+			want:  []string{"dashboard:Production hosts", "dashboard:Production cpu"},
+		},
+		{
+			name:  "No match",
+			org:   "default",
+			query: "disk",
+			want:  []string{},
+		},
+		{
+			name:  "No words",
+			org:   "default",
+			query: " *! ",
+			want:  []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := hitTexts(idx.Search(tt.org, tt.query, tt.limit))
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("Search() diff (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDashboardsStore(t *testing.T) {
+	ctx := context.Background()
+	raw := &dashboardsStore{dashboards: map[chronograf.DashboardID]chronograf.Dashboard{
+		1: {ID: 1, Name: "Production hosts", Organization: "default"},
+	}}
+	s := NewDashboardsStore(raw)
+
+	// The first search indexes the dashboards of the store
+	hits, err := s.Search(ctx, "default", "hosts", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(hitTexts(hits), []string{"dashboard:Production hosts"}); diff != "" {
+		t.Errorf("Search() diff (-got +want):\n%s", diff)
+	}
+
+	// Writes are indexed without reading the store again
+	d, err := s.Add(ctx, chronograf.Dashboard{Name: "Staging hosts", Organization: "default"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Update(ctx, chronograf.Dashboard{ID: 1, Name: "Production servers", Organization: "default"}); err != nil {
+		t.Fatal(err)
+	}
+	if hits, _ = s.Search(ctx, "default", "hosts", 0); !cmp.Equal(hitTexts(hits), []string{"dashboard:Staging hosts"}) {
+		t.Errorf("Search() after writes = %v", hitTexts(hits))
+	}
+	if err := s.Delete(ctx, d); err != nil {
+		t.Fatal(err)
+	}
+	if hits, _ = s.Search(ctx, "default", "hosts", 0); len(hits) != 0 {
+		t.Errorf("Search() of a deleted dashboard = %v", hitTexts(hits))
+	}
+	if raw.reads != 1 {
+		t.Errorf("Search() read the store %d times, want 1", raw.reads)
+	}
+}
@@ -0,0 +1,795 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/admin"
+	"github.com/influxdata/influxdb/chronograf/bolt"
+	"github.com/influxdata/influxdb/chronograf/influx"
+	"github.com/influxdata/influxdb/chronograf/organizations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// AdminServer serves the gRPC administrative API of the admin package over
+// the stores of a Service. The clients are authenticated by Authenticate and
+// act as SuperAdmins with raw store access, so that unlike the HTTP API the
+// resources of every organization are managed over a single connection.
+type AdminServer struct {
+	Service *Service
+}
+
+var _ admin.AdminServer = &AdminServer{}
+
+// NewAdminGRPCServer serves the administrative API of service with TLS
+// config, which must require and verify client certificates
+func NewAdminGRPCServer(service *Service, config *tls.Config) *grpc.Server {
+	a := &AdminServer{
+		Service: service,
+	}
+	srv := grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(config)),
+		grpc.UnaryInterceptor(a.Authenticate),
+	)
+	admin.RegisterAdminServer(srv, a)
+	return srv
+}
+
+// adminTLSConfig requires the clients of the administrative API to present a
+// certificate verified by the TLS client certificate authorities
+func (s *Server) adminTLSConfig() (*tls.Config, error) {
+	cas, err := s.clientCAs()
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		GetCertificate: s.keypair.GetCertificate,
+		ClientCAs:      cas,
+		ClientAuth:     tls.RequireAndVerifyClientCert,
+	}, nil
+}
+
+// serveAdmin serves the administrative API on GRPCPort in the background.
+// The returned function stops the server once the in-flight calls are done.
+func (s *Server) serveAdmin(service *Service, logger chronograf.Logger) (func(), error) {
+	config, err := s.adminTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	listener, err := net.Listen("tcp", net.JoinHostPort(s.Host, strconv.Itoa(s.GRPCPort)))
+	if err != nil {
+		return nil, err
+	}
+
+	srv := NewAdminGRPCServer(service, config)
+	go func() {
+		if err := srv.Serve(listener); err != nil {
+			logger.
+				WithField("component", "grpc_admin").
+				Error(err)
+		}
+	}()
+	logger.
+		WithField("component", "server").
+		Info("Serving the gRPC administrative API at ", listener.Addr())
+	return srv.GracefulStop, nil
+}
+
+// peerCertificate returns the verified TLS client certificate of a call
+func peerCertificate(ctx context.Context) (*x509.Certificate, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return nil, false
+	}
+	return info.State.VerifiedChains[0][0], true
+}
+
+// Authenticate is the unary interceptor authenticating the clients of the
+// administrative API as the user the subject of their TLS client certificate
+// is mapped to. The user must be an active SuperAdmin.
+func (a *AdminServer) Authenticate(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	cert, ok := peerCertificate(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "client certificate required")
+	}
+
+	subject := cert.Subject.String()
+	log := a.Service.Logger.
+		WithField("component", "grpc_admin").
+		WithField("method", info.FullMethod).
+		WithField("subject", subject)
+
+	serverCtx := serverContext(ctx)
+	mapping, err := certificateMapping(serverCtx, a.Service.Store, subject)
+	if err != nil {
+		log.Error(fmt.Sprintf("Failed to retrieve certificate mappings: %v", err))
+		return nil, status.Error(codes.Internal, "failed to retrieve certificate mappings from database")
+	}
+	if mapping == nil {
+		log.Error("Client certificate is not mapped to a user")
+		return nil, status.Error(codes.Unauthenticated, "certificate is not mapped to a user")
+	}
+
+	u, err := a.Service.Store.Users(serverCtx).Get(serverCtx, chronograf.UserQuery{ID: &mapping.UserID})
+	if err != nil {
+		log.Error(fmt.Sprintf("Failed to retrieve user %d of certificate mapping %s", mapping.UserID, mapping.ID))
+		return nil, status.Error(codes.PermissionDenied, "certificate is not authorized")
+	}
+	if u.Suspended() {
+		log.Error(fmt.Sprintf("User %d of certificate mapping %s is suspended", u.ID, mapping.ID))
+		return nil, status.Error(codes.PermissionDenied, "user is suspended")
+	}
+	if !u.SuperAdmin {
+		log.Error(fmt.Sprintf("User %d of certificate mapping %s is not a SuperAdmin", u.ID, mapping.ID))
+		return nil, status.Error(codes.PermissionDenied, "user is not a SuperAdmin")
+	}
+
+	ctx = context.WithValue(ctx, UserContextKey, u)
+	ctx = context.WithValue(ctx, CertificateContextKey, mapping)
+	return handler(ctx, req)
+}
+
+// adminError converts an error of the stores to a status of its gRPC code
+func adminError(err error) error {
+	switch err {
+	case chronograf.ErrUserNotFound, chronograf.ErrOrganizationNotFound, chronograf.ErrSourceNotFound, chronograf.ErrDashboardNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case chronograf.ErrUserAlreadyExists, chronograf.ErrOrganizationAlreadyExists:
+		return status.Error(codes.AlreadyExists, err.Error())
+	case chronograf.ErrCannotDeleteDefaultOrganization:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if _, ok := err.(*quotaError); ok {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func invalidArgument(err error) error {
+	return status.Error(codes.InvalidArgument, err.Error())
+}
+
+func newAdminUser(u *chronograf.User) *admin.User {
+	res := &admin.User{
+		ID:         u.ID,
+		Name:       u.Name,
+		Provider:   u.Provider,
+		Scheme:     u.Scheme,
+		SuperAdmin: u.SuperAdmin,
+		Status:     chronograf.UserStatusActive,
+	}
+	if u.Suspended() {
+		res.Status = chronograf.UserStatusSuspended
+	}
+	for _, role := range u.Roles {
+		res.Roles = append(res.Roles, &admin.Role{
+			Organization: role.Organization,
+			Name:         role.Name,
+		})
+	}
+	return res
+}
+
+func adminUserRequest(u *admin.User) userRequest {
+	req := userRequest{
+		Name:       u.Name,
+		Provider:   u.Provider,
+		Scheme:     u.Scheme,
+		SuperAdmin: u.SuperAdmin,
+		Roles:      []chronograf.Role{},
+	}
+	for _, role := range u.Roles {
+		req.Roles = append(req.Roles, chronograf.Role{
+			Organization: role.Organization,
+			Name:         role.Name,
+		})
+	}
+	return req
+}
+
+func newAdminOrganization(o *chronograf.Organization) *admin.Organization {
+	res := &admin.Organization{
+		ID:          o.ID,
+		Name:        o.Name,
+		DefaultRole: o.DefaultRole,
+	}
+	if o.Quotas != nil {
+		res.Quotas = &admin.OrganizationQuotas{
+			MaxDashboards:     int64(o.Quotas.MaxDashboards),
+			MaxUsers:          int64(o.Quotas.MaxUsers),
+			MaxKapacitorRules: int64(o.Quotas.MaxKapacitorRules),
+		}
+	}
+	return res
+}
+
+func adminOrganizationRequest(o *admin.Organization) organizationRequest {
+	req := organizationRequest{
+		Name:        o.Name,
+		DefaultRole: o.DefaultRole,
+	}
+	if o.Quotas != nil {
+		req.Quotas = &chronograf.OrganizationQuotas{
+			MaxDashboards:     int(o.Quotas.MaxDashboards),
+			MaxUsers:          int(o.Quotas.MaxUsers),
+			MaxKapacitorRules: int(o.Quotas.MaxKapacitorRules),
+		}
+	}
+	return req
+}
+
+// newAdminSource converts a source through the encoding of the stores, which
+// admin.Source shares, without the secrets of the source
+func newAdminSource(src chronograf.Source) (*admin.Source, error) {
+	src.Password = ""
+	src.SharedSecret = ""
+	src.TLSKey = ""
+	data, err := bolt.MarshalSource(src)
+	if err != nil {
+		return nil, err
+	}
+	var res admin.Source
+	if err := proto.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func adminSource(m *admin.Source) (chronograf.Source, error) {
+	var src chronograf.Source
+	data, err := proto.Marshal(m)
+	if err != nil {
+		return src, err
+	}
+	err = bolt.UnmarshalSource(data, &src)
+	return src, err
+}
+
+// newAdminDashboard converts a dashboard through the encoding of the stores,
+// which admin.Dashboard shares
+func newAdminDashboard(d chronograf.Dashboard) (*admin.Dashboard, error) {
+	data, err := bolt.MarshalDashboard(d)
+	if err != nil {
+		return nil, err
+	}
+	var res admin.Dashboard
+	if err := proto.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func adminDashboard(m *admin.Dashboard) (chronograf.Dashboard, error) {
+	var d chronograf.Dashboard
+	data, err := proto.Marshal(m)
+	if err != nil {
+		return d, err
+	}
+	err = bolt.UnmarshalDashboard(data, &d)
+	return d, err
+}
+
+// ListUsers lists the users, or the users with a role in an organization
+func (a *AdminServer) ListUsers(ctx context.Context, req *admin.ListRequest) (*admin.Users, error) {
+	serverCtx := serverContext(ctx)
+	users, err := a.Service.Store.Users(serverCtx).All(serverCtx)
+	if err != nil {
+		return nil, adminError(err)
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].ID < users[j].ID
+	})
+
+	res := &admin.Users{
+		Users: []*admin.User{},
+	}
+	for i := range users {
+		if req.Organization != "" && !hasRoleInOrganization(users[i], req.Organization) {
+			continue
+		}
+		res.Users = append(res.Users, newAdminUser(&users[i]))
+	}
+	return res, nil
+}
+
+// GetUser returns a user with all of its roles
+func (a *AdminServer) GetUser(ctx context.Context, req *admin.UserRequest) (*admin.User, error) {
+	serverCtx := serverContext(ctx)
+	u, err := a.Service.Store.Users(serverCtx).Get(serverCtx, chronograf.UserQuery{ID: &req.ID})
+	if err != nil {
+		return nil, adminError(err)
+	}
+	return newAdminUser(u), nil
+}
+
+// CreateUser adds a user with the same validation as the HTTP API
+func (a *AdminServer) CreateUser(ctx context.Context, req *admin.User) (*admin.User, error) {
+	userReq := adminUserRequest(req)
+	if err := userReq.ValidCreate(); err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	serverCtx := serverContext(ctx)
+	if err := a.Service.validRoles(serverCtx, userReq.Roles); err != nil {
+		return nil, invalidArgument(err)
+	}
+	for _, role := range userReq.Roles {
+		if err := a.Service.ensureQuota(ctx, role.Organization, quotaUsers, 1); err != nil {
+			return nil, adminError(err)
+		}
+	}
+
+	u, err := a.Service.Store.Users(serverCtx).Add(serverCtx, &chronograf.User{
+		Name:       userReq.Name,
+		Provider:   userReq.Provider,
+		Scheme:     userReq.Scheme,
+		Roles:      userReq.Roles,
+		SuperAdmin: userReq.SuperAdmin,
+	})
+	if err != nil {
+		return nil, adminError(err)
+	}
+	a.Service.publishUserEvent(ctx, EventUserCreated, u.ID)
+	return newAdminUser(u), nil
+}
+
+// UpdateUser replaces the roles, SuperAdmin and Status of a user. The name,
+// provider and scheme of users cannot be updated.
+func (a *AdminServer) UpdateUser(ctx context.Context, req *admin.User) (*admin.User, error) {
+	serverCtx := serverContext(ctx)
+	u, err := a.Service.Store.Users(serverCtx).Get(serverCtx, chronograf.UserQuery{ID: &req.ID})
+	if err != nil {
+		return nil, adminError(err)
+	}
+
+	userReq := adminUserRequest(req)
+	if err := userReq.ValidRoles(); err != nil {
+		return nil, invalidArgument(err)
+	}
+	if userReq.Name != "" && userReq.Name != u.Name {
+		return nil, invalidArgument(errorf("cannot update Name"))
+	}
+	if userReq.Provider != "" && userReq.Provider != u.Provider {
+		return nil, invalidArgument(errorf("cannot update Provider"))
+	}
+	if userReq.Scheme != "" && userReq.Scheme != u.Scheme {
+		return nil, invalidArgument(errorf("cannot update Scheme"))
+	}
+	switch req.Status {
+	case "", chronograf.UserStatusActive, chronograf.UserStatusSuspended:
+	default:
+		return nil, invalidArgument(errorf("unknown status %s. Valid statuses are 'active' and 'suspended'", req.Status))
+	}
+	if err := a.Service.validRoles(serverCtx, userReq.Roles); err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	// Neither revoking the SuperAdmin status of the client nor suspending it
+	// is allowed, as it would lock the client out
+	suspend := req.Status == chronograf.UserStatusSuspended
+	if ctxUser, ok := hasUserContext(ctx); ok && ctxUser.ID == u.ID && (!userReq.SuperAdmin || suspend) {
+		return nil, status.Error(codes.FailedPrecondition, "user cannot revoke their own SuperAdmin status or suspend themselves")
+	}
+	if !userReq.SuperAdmin || suspend {
+		if last, err := a.Service.isLastSuperAdmin(ctx, u); err != nil {
+			return nil, adminError(err)
+		} else if last {
+			return nil, status.Error(codes.FailedPrecondition, "cannot revoke the SuperAdmin status of the last SuperAdmin or suspend them")
+		}
+	}
+
+	u.Roles = userReq.Roles
+	u.SuperAdmin = userReq.SuperAdmin
+	if req.Status != "" {
+		u.Status = req.Status
+	}
+	if err := a.Service.Store.Users(serverCtx).Update(serverCtx, u); err != nil {
+		return nil, adminError(err)
+	}
+	a.Service.publishUserEvent(ctx, EventUserUpdated, u.ID)
+	return newAdminUser(u), nil
+}
+
+// DeleteUser deletes a user from all organizations
+func (a *AdminServer) DeleteUser(ctx context.Context, req *admin.UserRequest) (*admin.Empty, error) {
+	serverCtx := serverContext(ctx)
+	u, err := a.Service.Store.Users(serverCtx).Get(serverCtx, chronograf.UserQuery{ID: &req.ID})
+	if err != nil {
+		return nil, adminError(err)
+	}
+	if last, err := a.Service.isLastSuperAdmin(ctx, u); err != nil {
+		return nil, adminError(err)
+	} else if last {
+		return nil, status.Error(codes.FailedPrecondition, "cannot delete the last SuperAdmin")
+	}
+	if err := a.Service.Store.Users(serverCtx).Delete(serverCtx, u); err != nil {
+		return nil, adminError(err)
+	}
+	a.Service.publishEvent(ctx, EventUserDeleted, newUserResponse(u, ""))
+	return &admin.Empty{}, nil
+}
+
+// ListOrganizations lists all organizations
+func (a *AdminServer) ListOrganizations(ctx context.Context, req *admin.Empty) (*admin.Organizations, error) {
+	serverCtx := serverContext(ctx)
+	orgs, err := a.Service.Store.Organizations(serverCtx).All(serverCtx)
+	if err != nil {
+		return nil, adminError(err)
+	}
+
+	res := &admin.Organizations{
+		Organizations: []*admin.Organization{},
+	}
+	for i := range orgs {
+		res.Organizations = append(res.Organizations, newAdminOrganization(&orgs[i]))
+	}
+	return res, nil
+}
+
+// GetOrganization returns an organization
+func (a *AdminServer) GetOrganization(ctx context.Context, req *admin.OrganizationRequest) (*admin.Organization, error) {
+	serverCtx := serverContext(ctx)
+	org, err := a.Service.Store.Organizations(serverCtx).Get(serverCtx, chronograf.OrganizationQuery{ID: &req.ID})
+	if err != nil {
+		return nil, adminError(err)
+	}
+	return newAdminOrganization(org), nil
+}
+
+// CreateOrganization adds an organization without giving the client a role
+// in it, as the client manages the organization as a SuperAdmin
+func (a *AdminServer) CreateOrganization(ctx context.Context, req *admin.Organization) (*admin.Organization, error) {
+	orgReq := adminOrganizationRequest(req)
+	if err := orgReq.ValidCreate(); err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	serverCtx := serverContext(ctx)
+	org, err := a.Service.Store.Organizations(serverCtx).Add(serverCtx, &chronograf.Organization{
+		Name:        orgReq.Name,
+		DefaultRole: orgReq.DefaultRole,
+		Quotas:      orgReq.Quotas,
+	})
+	if err != nil {
+		return nil, adminError(err)
+	}
+	return newAdminOrganization(org), nil
+}
+
+// UpdateOrganization replaces the name, default role and quotas of an
+// organization; organizations without quotas are unlimited
+func (a *AdminServer) UpdateOrganization(ctx context.Context, req *admin.Organization) (*admin.Organization, error) {
+	orgReq := adminOrganizationRequest(req)
+	if err := orgReq.ValidCreate(); err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	serverCtx := serverContext(ctx)
+	org, err := a.Service.Store.Organizations(serverCtx).Get(serverCtx, chronograf.OrganizationQuery{ID: &req.ID})
+	if err != nil {
+		return nil, adminError(err)
+	}
+	org.Name = orgReq.Name
+	org.DefaultRole = orgReq.DefaultRole
+	org.Quotas = orgReq.Quotas
+	if err := a.Service.Store.Organizations(serverCtx).Update(serverCtx, org); err != nil {
+		return nil, adminError(err)
+	}
+	return newAdminOrganization(org), nil
+}
+
+// DeleteOrganization deletes an organization other than the default one
+func (a *AdminServer) DeleteOrganization(ctx context.Context, req *admin.OrganizationRequest) (*admin.Empty, error) {
+	serverCtx := serverContext(ctx)
+	org, err := a.Service.Store.Organizations(serverCtx).Get(serverCtx, chronograf.OrganizationQuery{ID: &req.ID})
+	if err != nil {
+		return nil, adminError(err)
+	}
+	if err := a.Service.Store.Organizations(serverCtx).Delete(serverCtx, org); err != nil {
+		return nil, adminError(err)
+	}
+	return &admin.Empty{}, nil
+}
+
+// validSource verifies the source of a request, which is added to the default
+// organization when it has none
+func (a *AdminServer) validSource(ctx context.Context, src *chronograf.Source) error {
+	if src.Name == "" || src.URL == "" {
+		return invalidArgument(errorf("name and url required"))
+	}
+	if _, err := influx.TLSConfig(src); err != nil {
+		return invalidArgument(err)
+	}
+
+	orgs := a.Service.Store.Organizations(ctx)
+	if src.Organization == "" {
+		org, err := orgs.DefaultOrganization(ctx)
+		if err != nil {
+			return adminError(err)
+		}
+		src.Organization = org.ID
+	} else if _, err := orgs.Get(ctx, chronograf.OrganizationQuery{ID: &src.Organization}); err != nil {
+		return invalidArgument(errorf("organization %s not found", src.Organization))
+	}
+	return nil
+}
+
+// ListSources lists the sources, or the sources of an organization
+func (a *AdminServer) ListSources(ctx context.Context, req *admin.ListRequest) (*admin.Sources, error) {
+	serverCtx := serverContext(ctx)
+	srcs, err := a.Service.Store.Sources(serverCtx).All(serverCtx)
+	if err != nil {
+		return nil, adminError(err)
+	}
+
+	res := &admin.Sources{
+		Sources: []*admin.Source{},
+	}
+	for _, src := range srcs {
+		if req.Organization != "" && src.Organization != req.Organization {
+			continue
+		}
+		s, err := newAdminSource(src)
+		if err != nil {
+			return nil, adminError(err)
+		}
+		res.Sources = append(res.Sources, s)
+	}
+	return res, nil
+}
+
+// GetSource returns a source without its secrets
+func (a *AdminServer) GetSource(ctx context.Context, req *admin.SourceRequest) (*admin.Source, error) {
+	serverCtx := serverContext(ctx)
+	src, err := a.Service.Store.Sources(serverCtx).Get(serverCtx, int(req.ID))
+	if err != nil {
+		return nil, adminError(err)
+	}
+	res, err := newAdminSource(src)
+	if err != nil {
+		return nil, adminError(err)
+	}
+	return res, nil
+}
+
+// CreateSource adds a source
+func (a *AdminServer) CreateSource(ctx context.Context, req *admin.Source) (*admin.Source, error) {
+	src, err := adminSource(req)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+	src.ID = 0
+
+	serverCtx := serverContext(ctx)
+	if err := a.validSource(serverCtx, &src); err != nil {
+		return nil, err
+	}
+	if src, err = a.Service.Store.Sources(serverCtx).Add(serverCtx, src); err != nil {
+		return nil, adminError(err)
+	}
+	a.Service.publishEvent(ctx, EventSourceCreated, newSourceEventData(src))
+
+	res, err := newAdminSource(src)
+	if err != nil {
+		return nil, adminError(err)
+	}
+	return res, nil
+}
+
+// UpdateSource replaces a source. As the secrets of sources are never
+// returned, the password, shared secret and TLS key of the source are kept
+// when they are empty.
+func (a *AdminServer) UpdateSource(ctx context.Context, req *admin.Source) (*admin.Source, error) {
+	serverCtx := serverContext(ctx)
+	orig, err := a.Service.Store.Sources(serverCtx).Get(serverCtx, int(req.ID))
+	if err != nil {
+		return nil, adminError(err)
+	}
+
+	src, err := adminSource(req)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+	if src.Password == "" {
+		src.Password = orig.Password
+	}
+	if src.SharedSecret == "" {
+		src.SharedSecret = orig.SharedSecret
+	}
+	if src.TLSKey == "" {
+		src.TLSKey = orig.TLSKey
+	}
+	if err := a.validSource(serverCtx, &src); err != nil {
+		return nil, err
+	}
+	if err := a.Service.Store.Sources(serverCtx).Update(serverCtx, src); err != nil {
+		return nil, adminError(err)
+	}
+	a.Service.publishEvent(ctx, EventSourceUpdated, newSourceEventData(src))
+
+	res, err := newAdminSource(src)
+	if err != nil {
+		return nil, adminError(err)
+	}
+	return res, nil
+}
+
+// DeleteSource deletes a source along with the Kapacitors of the source
+func (a *AdminServer) DeleteSource(ctx context.Context, req *admin.SourceRequest) (*admin.Empty, error) {
+	serverCtx := serverContext(ctx)
+	src, err := a.Service.Store.Sources(serverCtx).Get(serverCtx, int(req.ID))
+	if err != nil {
+		return nil, adminError(err)
+	}
+	if err := a.Service.Store.Sources(serverCtx).Delete(serverCtx, src); err != nil {
+		return nil, adminError(err)
+	}
+	a.Service.publishEvent(ctx, EventSourceDeleted, newSourceEventData(src))
+
+	srvs, err := a.Service.Store.Servers(serverCtx).All(serverCtx)
+	if err != nil {
+		return nil, adminError(err)
+	}
+	for _, srv := range srvs {
+		if srv.SrcID != src.ID {
+			continue
+		}
+		if err := a.Service.Store.Servers(serverCtx).Delete(serverCtx, srv); err != nil {
+			return nil, adminError(err)
+		}
+	}
+	return &admin.Empty{}, nil
+}
+
+// validDashboard verifies the dashboard of a request with the same
+// validation as the HTTP API. Dashboards without an organization are added
+// to the default organization.
+func (a *AdminServer) validDashboard(ctx context.Context, d *chronograf.Dashboard) error {
+	serverCtx := serverContext(ctx)
+	orgs := a.Service.Store.Organizations(serverCtx)
+	defaultOrg, err := orgs.DefaultOrganization(serverCtx)
+	if err != nil {
+		return adminError(err)
+	}
+	if err := ValidDashboardRequest(d, defaultOrg.ID); err != nil {
+		return invalidArgument(err)
+	}
+	if _, err := orgs.Get(serverCtx, chronograf.OrganizationQuery{ID: &d.Organization}); err != nil {
+		return invalidArgument(errorf("organization %s not found", d.Organization))
+	}
+
+	// The folder must be one of the organization of the dashboard
+	orgCtx := context.WithValue(ctx, organizations.ContextKey, d.Organization)
+	if err := a.Service.validDashboardFolder(orgCtx, d.Folder); err != nil {
+		return invalidArgument(err)
+	}
+	// and its slug, if any, unique within the organization
+	if taken, err := a.Service.dashboardSlugTaken(orgCtx, *d); err != nil {
+		return adminError(err)
+	} else if taken {
+		return status.Error(codes.AlreadyExists, fmt.Sprintf("slug %s is taken by another dashboard", d.Slug))
+	}
+	return nil
+}
+
+// ListDashboards lists the dashboards, or the dashboards of an organization
+func (a *AdminServer) ListDashboards(ctx context.Context, req *admin.ListRequest) (*admin.Dashboards, error) {
+	serverCtx := serverContext(ctx)
+	ds, err := a.Service.Store.Dashboards(serverCtx).All(serverCtx)
+	if err != nil {
+		return nil, adminError(err)
+	}
+
+	res := &admin.Dashboards{
+		Dashboards: []*admin.Dashboard{},
+	}
+	for _, d := range ds {
+		if req.Organization != "" && d.Organization != req.Organization {
+			continue
+		}
+		m, err := newAdminDashboard(d)
+		if err != nil {
+			return nil, adminError(err)
+		}
+		res.Dashboards = append(res.Dashboards, m)
+	}
+	return res, nil
+}
+
+// GetDashboard returns a dashboard
+func (a *AdminServer) GetDashboard(ctx context.Context, req *admin.DashboardRequest) (*admin.Dashboard, error) {
+	serverCtx := serverContext(ctx)
+	d, err := a.Service.Store.Dashboards(serverCtx).Get(serverCtx, chronograf.DashboardID(req.ID))
+	if err != nil {
+		return nil, adminError(err)
+	}
+	res, err := newAdminDashboard(d)
+	if err != nil {
+		return nil, adminError(err)
+	}
+	return res, nil
+}
+
+// CreateDashboard adds a dashboard within the quotas of its organization
+func (a *AdminServer) CreateDashboard(ctx context.Context, req *admin.Dashboard) (*admin.Dashboard, error) {
+	d, err := adminDashboard(req)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+	d.ID = 0
+	if err := a.validDashboard(ctx, &d); err != nil {
+		return nil, err
+	}
+	if err := a.Service.ensureQuota(ctx, d.Organization, quotaDashboards, 1); err != nil {
+		return nil, adminError(err)
+	}
+
+	serverCtx := serverContext(ctx)
+	if d, err = a.Service.Store.Dashboards(serverCtx).Add(serverCtx, d); err != nil {
+		return nil, adminError(err)
+	}
+	a.Service.recordDashboardVersion(serverCtx, d.ID)
+	a.Service.publishDashboardEvent(ctx, EventDashboardCreated, d)
+
+	res, err := newAdminDashboard(d)
+	if err != nil {
+		return nil, adminError(err)
+	}
+	return res, nil
+}
+
+// UpdateDashboard replaces a dashboard, recording its previous revision
+func (a *AdminServer) UpdateDashboard(ctx context.Context, req *admin.Dashboard) (*admin.Dashboard, error) {
+	serverCtx := serverContext(ctx)
+	id := chronograf.DashboardID(req.ID)
+	if _, err := a.Service.Store.Dashboards(serverCtx).Get(serverCtx, id); err != nil {
+		return nil, adminError(err)
+	}
+
+	d, err := adminDashboard(req)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+	if err := a.validDashboard(ctx, &d); err != nil {
+		return nil, err
+	}
+	if err := a.Service.Store.Dashboards(serverCtx).Update(serverCtx, d); err != nil {
+		return nil, adminError(err)
+	}
+	a.Service.recordDashboardVersion(serverCtx, id)
+	a.Service.publishDashboardEvent(ctx, EventDashboardUpdated, d)
+
+	res, err := newAdminDashboard(d)
+	if err != nil {
+		return nil, adminError(err)
+	}
+	return res, nil
+}
+
+// DeleteDashboard deletes a dashboard along with its revisions and snapshots
+func (a *AdminServer) DeleteDashboard(ctx context.Context, req *admin.DashboardRequest) (*admin.Empty, error) {
+	serverCtx := serverContext(ctx)
+	d, err := a.Service.Store.Dashboards(serverCtx).Get(serverCtx, chronograf.DashboardID(req.ID))
+	if err != nil {
+		return nil, adminError(err)
+	}
+	if err := a.Service.deleteDashboard(serverCtx, d); err != nil {
+		return nil, adminError(err)
+	}
+	return &admin.Empty{}, nil
+}
@@ -0,0 +1,354 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"reflect"
+	"testing"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/admin"
+	"github.com/influxdata/influxdb/chronograf/bolt"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestAdminServer_Authenticate(t *testing.T) {
+	mapped := &x509.Certificate{Subject: pkix.Name{CommonName: "terraform", Organization: []string{"Acme"}}}
+	unmapped := &x509.Certificate{Subject: pkix.Name{CommonName: "unknown"}}
+	tests := []struct {
+		name     string
+		cert     *x509.Certificate
+		user     *chronograf.User
+		wantCode codes.Code
+	}{
+		{
+			name:     "SuperAdmin",
+			cert:     mapped,
+			user:     &chronograf.User{ID: 3, Name: "terraform", SuperAdmin: true},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "No certificate",
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "Certificate not mapped",
+			cert:     unmapped,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "Mapped to a missing user",
+			cert:     mapped,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "Mapped to a user who is not a SuperAdmin",
+			cert:     mapped,
+			user:     &chronograf.User{ID: 3, Name: "terraform"},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "Mapped to a suspended SuperAdmin",
+			cert:     mapped,
+			user:     &chronograf.User{ID: 3, Name: "terraform", SuperAdmin: true, Status: chronograf.UserStatusSuspended},
+			wantCode: codes.PermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &AdminServer{
+				Service: &Service{
+					Logger: &chronograf.NoopLogger{},
+					Store: &mocks.Store{
+						CertificatesStore: &mocks.CertificatesStore{
+							AllF: func(ctx context.Context) ([]chronograf.CertificateMapping, error) {
+								return []chronograf.CertificateMapping{
+									{ID: "1", Subject: "CN=terraform,O=Acme", UserID: 3},
+								}, nil
+							},
+						},
+						UsersStore: &mocks.UsersStore{
+							GetF: func(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+								if tt.user == nil || *q.ID != tt.user.ID {
+									return nil, chronograf.ErrUserNotFound
+								}
+								return tt.user, nil
+							},
+						},
+					},
+				},
+			}
+			ctx := context.Background()
+			if tt.cert != nil {
+				ctx = peer.NewContext(ctx, &peer.Peer{
+					AuthInfo: credentials.TLSInfo{
+						State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{tt.cert}}},
+					},
+				})
+			}
+
+			var user *chronograf.User
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				user, _ = hasUserContext(ctx)
+				return &admin.Empty{}, nil
+			}
+			info := &grpc.UnaryServerInfo{FullMethod: "/admin.Admin/ListUsers"}
+			_, err := a.Authenticate(ctx, &admin.ListRequest{}, info, handler)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("Authenticate() = %v, want %v", got, tt.wantCode)
+			}
+			if (user != nil) != (tt.wantCode == codes.OK) || (user != nil && user.ID != tt.user.ID) {
+				t.Errorf("Authenticate() user on context = %v, want %v", user, tt.user)
+			}
+		})
+	}
+}
+
+func TestAdminServer_UpdateUser(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      *admin.User
+		wantCode codes.Code
+		want     *chronograf.User
+	}{
+		{
+			name: "Replace roles and suspend",
+			req: &admin.User{
+				ID:     7,
+				Name:   "billietta",
+				Roles:  []*admin.Role{{Organization: "1", Name: "editor"}},
+				Status: chronograf.UserStatusSuspended,
+			},
+			wantCode: codes.OK,
+			want: &chronograf.User{
+				ID:           7,
+				Name:         "billietta",
+				Provider:     "github",
+				Scheme:       "oauth2",
+				Roles:        []chronograf.Role{{Organization: "1", Name: "editor"}},
+				Status:       chronograf.UserStatusSuspended,
+				PasswordHash: "hash",
+				TOTPSecret:   "secret",
+				TOTPEnabled:  true,
+			},
+		},
+		{
+			name:     "Rename",
+			req:      &admin.User{ID: 7, Name: "billy"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Unknown status",
+			req:      &admin.User{ID: 7, Status: "banned"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Revoke the SuperAdmin status of the client",
+			req:      &admin.User{ID: 1},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "Missing user",
+			req:      &admin.User{ID: 9},
+			wantCode: codes.NotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &chronograf.User{ID: 1, Name: "terraform", SuperAdmin: true}
+			var updated *chronograf.User
+			a := &AdminServer{
+				Service: &Service{
+					Logger: &chronograf.NoopLogger{},
+					Store: &mocks.Store{
+						UsersStore: &mocks.UsersStore{
+							GetF: func(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+								switch *q.ID {
+								case 1:
+									return client, nil
+								case 7:
+									return &chronograf.User{
+										ID:           7,
+										Name:         "billietta",
+										Provider:     "github",
+										Scheme:       "oauth2",
+										Roles:        []chronograf.Role{{Organization: "1", Name: "viewer"}},
+										PasswordHash: "hash",
+										TOTPSecret:   "secret",
+										TOTPEnabled:  true,
+									}, nil
+								}
+								return nil, chronograf.ErrUserNotFound
+							},
+							UpdateF: func(ctx context.Context, u *chronograf.User) error {
+								updated = u
+								return nil
+							},
+						},
+						OrganizationsStore: &mocks.OrganizationsStore{
+							GetF: func(ctx context.Context, q chronograf.OrganizationQuery) (*chronograf.Organization, error) {
+								return &chronograf.Organization{ID: *q.ID, DefaultRole: "viewer"}, nil
+							},
+						},
+					},
+				},
+			}
+			ctx := context.WithValue(context.Background(), UserContextKey, client)
+
+			res, err := a.UpdateUser(ctx, tt.req)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("UpdateUser() = %v, want %v", err, tt.wantCode)
+			}
+			if tt.want == nil {
+				return
+			}
+			// The secrets of users are kept, while they are never returned
+			if !reflect.DeepEqual(updated, tt.want) {
+				t.Errorf("UpdateUser() updated %+v, want %+v", updated, tt.want)
+			}
+			if want := newAdminUser(tt.want); !reflect.DeepEqual(res, want) {
+				t.Errorf("UpdateUser() = %v, want %v", res, want)
+			}
+		})
+	}
+}
+
+func TestAdminServer_UpdateSource(t *testing.T) {
+	orig := chronograf.Source{
+		ID:           2,
+		Name:         "influx",
+		URL:          "http://localhost:8086",
+		Username:     "admin",
+		Password:     "secret",
+		SharedSecret: "shared",
+		Organization: "1",
+	}
+	var updated chronograf.Source
+	a := &AdminServer{
+		Service: &Service{
+			Logger: &chronograf.NoopLogger{},
+			Store: &mocks.Store{
+				SourcesStore: &mocks.SourcesStore{
+					GetF: func(ctx context.Context, id int) (chronograf.Source, error) {
+						if id != orig.ID {
+							return chronograf.Source{}, chronograf.ErrSourceNotFound
+						}
+						return orig, nil
+					},
+					UpdateF: func(ctx context.Context, src chronograf.Source) error {
+						updated = src
+						return nil
+					},
+				},
+				OrganizationsStore: &mocks.OrganizationsStore{
+					GetF: func(ctx context.Context, q chronograf.OrganizationQuery) (*chronograf.Organization, error) {
+						return &chronograf.Organization{ID: *q.ID}, nil
+					},
+				},
+			},
+		},
+	}
+
+	res, err := a.UpdateSource(context.Background(), &admin.Source{
+		ID:           2,
+		Name:         "influx",
+		URL:          "https://influx.example.com:8086",
+		Username:     "admin",
+		Organization: "1",
+	})
+	if err != nil {
+		t.Fatalf("UpdateSource() error = %v", err)
+	}
+	want := orig
+	want.URL = "https://influx.example.com:8086"
+	if !reflect.DeepEqual(updated, want) {
+		t.Errorf("UpdateSource() updated %+v, want %+v", updated, want)
+	}
+	if res.URL != want.URL || res.Password != "" || res.SharedSecret != "" {
+		t.Errorf("UpdateSource() = %v, want the source without its secrets", res)
+	}
+
+	if _, err := a.UpdateSource(context.Background(), &admin.Source{ID: 2, Name: "influx"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("UpdateSource() without URL = %v, want %v", err, codes.InvalidArgument)
+	}
+	if _, err := a.UpdateSource(context.Background(), &admin.Source{ID: 5}); status.Code(err) != codes.NotFound {
+		t.Errorf("UpdateSource() of a missing source = %v, want %v", err, codes.NotFound)
+	}
+}
+
+func TestAdminDashboard(t *testing.T) {
+	d := chronograf.Dashboard{
+		ID:           4,
+		Name:         "hosts",
+		Organization: "1",
+		Folder:       "ops",
+		CacheTTL:     "30s",
+		Cells: []chronograf.DashboardCell{
+			{
+				ID:   "cpu",
+				X:    1,
+				W:    4,
+				H:    4,
+				Name: "CPU",
+				Type: "line",
+				Queries: []chronograf.DashboardQuery{
+					{
+						Command: "SELECT mean(usage_user) FROM cpu WHERE host = :host:",
+						Label:   "%",
+						Range:   &chronograf.Range{Upper: 100},
+						Shifts:  []chronograf.TimeShift{{Label: "yesterday", Unit: "d", Quantity: "1"}},
+					},
+				},
+				Axes: map[string]chronograf.Axis{
+					"y": {Bounds: []string{"0", "100"}, Label: "usage", Scale: "linear", Base: "10"},
+				},
+				CellColors:    []chronograf.CellColor{{ID: "base", Type: "threshold", Hex: "#00C9FF", Name: "laser", Value: "0"}},
+				Legend:        chronograf.Legend{Type: "static", Orientation: "bottom"},
+				FieldOptions:  []chronograf.RenamableField{{InternalName: "time", DisplayName: "Time", Visible: true}},
+				DecimalPlaces: chronograf.DecimalPlaces{IsEnforced: true, Digits: 2},
+				Suffix:        "%",
+			},
+		},
+		Templates: []chronograf.Template{
+			{
+				ID:          "host",
+				TemplateVar: chronograf.TemplateVar{Var: ":host:", Values: []chronograf.TemplateValue{{Type: "tagValue", Value: "web-1", Selected: true}}},
+				Type:        "tagValues",
+				Label:       "Host",
+				Query:       &chronograf.TemplateQuery{Command: "SHOW TAG VALUES WITH KEY = host", DB: "telegraf", TagKey: "host"},
+			},
+		},
+	}
+
+	// admin.Dashboard converts dashboards as the stores encode them
+	data, err := bolt.MarshalDashboard(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want chronograf.Dashboard
+	if err := bolt.UnmarshalDashboard(data, &want); err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := newAdminDashboard(d)
+	if err != nil {
+		t.Fatalf("newAdminDashboard() error = %v", err)
+	}
+	if m.Name != d.Name || len(m.Cells) != 1 || m.Cells[0].Axes["y"].Label != "usage" || m.Templates[0].TempVar != ":host:" {
+		t.Errorf("newAdminDashboard() = %v", m)
+	}
+	got, err := adminDashboard(m)
+	if err != nil {
+		t.Fatalf("adminDashboard() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("adminDashboard() = %+v, want %+v", got, want)
+	}
+}
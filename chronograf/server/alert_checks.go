@@ -0,0 +1,519 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/checks"
+)
+
+type alertCheckLinks struct {
+	Self     string `json:"self"`               // Self link mapping to this resource
+	Run      string `json:"run"`                // Run link evaluating the check immediately
+	Alerts   string `json:"alerts"`             // Alerts link to the alert history of the source
+	Template string `json:"template,omitempty"` // Template link to the rule template of instances of one
+}
+
+type alertCheckResponse struct {
+	ID         uint64                           `json:"id,string"`
+	SourceID   int                              `json:"sourceID,string"`
+	Name       string                           `json:"name"`
+	Query      string                           `json:"query"`
+	DB         string                           `json:"db"`
+	RP         string                           `json:"rp"`
+	Every      string                           `json:"every"`
+	Operator   string                           `json:"operator"`
+	Thresholds []chronograf.AlertCheckThreshold `json:"thresholds"`
+	Message    string                           `json:"message"`
+	Enabled    bool                             `json:"enabled"`
+	States     map[string]string                `json:"states"`            // States is the last level of each series keyed by its alert ID
+	NextRun    *time.Time                       `json:"nextRun,omitempty"` // NextRun is absent for disabled checks
+	LastRun    *time.Time                       `json:"lastRun,omitempty"` // LastRun is absent for checks that have not run yet
+	LastError  string                           `json:"lastError,omitempty"`
+	Template   string                           `json:"template,omitempty"` // Template is the ID of the rule template of instances of one
+	Params     map[string]string                `json:"params,omitempty"`   // Params are the values of the parameters of the rule template
+	Links      alertCheckLinks                  `json:"links"`
+}
+
+func newAlertCheckResponse(c chronograf.AlertCheck) alertCheckResponse {
+	if c.Thresholds == nil {
+		c.Thresholds = []chronograf.AlertCheckThreshold{}
+	}
+	if c.States == nil {
+		c.States = map[string]string{}
+	}
+	self := fmt.Sprintf("%s%d/checks/%d", sourceLinkPrefix, c.SourceID, c.ID)
+	res := alertCheckResponse{
+		ID:         c.ID,
+		SourceID:   c.SourceID,
+		Name:       c.Name,
+		Query:      c.Query,
+		DB:         c.DB,
+		RP:         c.RP,
+		Every:      c.Every.String(),
+		Operator:   c.Operator,
+		Thresholds: c.Thresholds,
+		Message:    c.Message,
+		Enabled:    c.Enabled,
+		States:     c.States,
+		LastError:  c.LastError,
+		Template:   c.Template,
+		Params:     c.Params,
+		Links: alertCheckLinks{
+			Self:   self,
+			Run:    self + "/run",
+			Alerts: fmt.Sprintf("%s%d/alerts", sourceLinkPrefix, c.SourceID),
+		},
+	}
+	if c.Template != "" {
+		res.Links.Template = fmt.Sprintf("%s/%s", ruleTemplatesPath, c.Template)
+	}
+	if !c.NextRun.IsZero() {
+		next := c.NextRun
+		res.NextRun = &next
+	}
+	if !c.LastRun.IsZero() {
+		last := c.LastRun
+		res.LastRun = &last
+	}
+	return res
+}
+
+type alertChecksResponse struct {
+	Links  selfLinks            `json:"links"`
+	Checks []alertCheckResponse `json:"checks"`
+}
+
+// alertCheckRunResponse is the result of running a check on demand
+type alertCheckRunResponse struct {
+	Check  alertCheckResponse   `json:"check"`
+	Events []alertEventResponse `json:"events"` // Events are the level changes recorded by the run
+}
+
+// alertCheckRequest creates or updates a check. Fields left out of an update
+// keep their value. Checks instantiated from a rule template take their query,
+// thresholds and message from the template.
+type alertCheckRequest struct {
+	Name       *string                           `json:"name"`
+	Query      *string                           `json:"query"`
+	DB         *string                           `json:"db"`
+	RP         *string                           `json:"rp"`
+	Every      *string                           `json:"every"`    // Every is a duration such as 1m
+	Operator   *string                           `json:"operator"` // Operator is one of >, >=, <, <=, == or !=
+	Thresholds *[]chronograf.AlertCheckThreshold `json:"thresholds"`
+	Message    *string                           `json:"message"` // Message is a Go template of checks.Alert
+	Enabled    *bool                             `json:"enabled"`
+	Template   *string                           `json:"template"` // Template is the ID of the rule template to instantiate; empty detaches the check from its template
+	Params     *map[string]string                `json:"params"`   // Params are the values of the parameters of the rule template
+}
+
+// apply sets the fields of the request on a check
+func (req *alertCheckRequest) apply(c *chronograf.AlertCheck) error {
+	if req.Name != nil {
+		c.Name = *req.Name
+	}
+	if req.Query != nil {
+		c.Query = *req.Query
+	}
+	if req.DB != nil {
+		c.DB = *req.DB
+	}
+	if req.RP != nil {
+		c.RP = *req.RP
+	}
+	if req.Every != nil {
+		d, err := time.ParseDuration(*req.Every)
+		if err != nil {
+			return errorf("invalid every %q: %v", *req.Every, err)
+		}
+		c.Every = d
+	}
+	if req.Operator != nil {
+		c.Operator = *req.Operator
+	}
+	if req.Thresholds != nil {
+		c.Thresholds = *req.Thresholds
+	}
+	if req.Message != nil {
+		c.Message = *req.Message
+	}
+	if req.Enabled != nil {
+		c.Enabled = *req.Enabled
+	}
+	if req.Template != nil {
+		c.Template = *req.Template
+	}
+	if req.Params != nil {
+		c.Params = *req.Params
+	}
+	return nil
+}
+
+// setsTemplateFields reports whether the request sets fields of a check that
+// its rule template sets
+func (req *alertCheckRequest) setsTemplateFields() bool {
+	return req.Name != nil || req.Query != nil || req.DB != nil || req.RP != nil ||
+		req.Every != nil || req.Operator != nil || req.Thresholds != nil || req.Message != nil
+}
+
+// instantiateAlertCheck sets the fields of a check that is an instance of a
+// rule template of the current organization from the template
+func (s *Service) instantiateAlertCheck(ctx context.Context, req *alertCheckRequest, c *chronograf.AlertCheck) error {
+	if c.Template == "" {
+		c.Params = nil
+		return nil
+	}
+	if req.setsTemplateFields() {
+		return errorf("name, query, db, rp, every, operator, thresholds and message of the check are set by rule template %s", c.Template)
+	}
+	t, err := s.Store.RuleTemplates(ctx).Get(ctx, c.Template)
+	if err != nil {
+		return errorf("rule template %s not found", c.Template)
+	}
+	return applyRuleTemplate(t, c)
+}
+
+// scheduleAlertCheck sets the next run of a check: right away for checks that
+// have not run yet, an interval after the last run otherwise, and never for
+// disabled checks
+func scheduleAlertCheck(c *chronograf.AlertCheck, now time.Time) {
+	switch {
+	case !c.Enabled:
+		c.NextRun = time.Time{}
+	case c.LastRun.IsZero():
+		c.NextRun = now
+	default:
+		c.NextRun = c.LastRun.Add(c.Every)
+	}
+}
+
+// alertCheck retrieves the check of the route within the source of the route
+func (s *Service) alertCheck(ctx context.Context, r *http.Request) (chronograf.Source, *chronograf.AlertCheck, error) {
+	src, err := s.alertEventsSource(ctx, r)
+	if err != nil {
+		return src, nil, err
+	}
+	param, _ := paramStr("cid", r)
+	cid, err := strconv.ParseUint(param, 10, 64)
+	if err != nil {
+		return src, nil, chronograf.ErrAlertCheckNotFound
+	}
+	c, err := s.Store.AlertChecks(ctx).Get(ctx, src.ID, cid)
+	return src, c, err
+}
+
+// AlertChecks returns the checks Chronograf evaluates against a source
+func (s *Service) AlertChecks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	src, err := s.alertEventsSource(ctx, r)
+	if err != nil {
+		id, _ := paramStr("id", r)
+		notFound(w, id, s.Logger)
+		return
+	}
+	all, err := s.Store.AlertChecks(ctx).All(ctx, src.ID)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	res := alertChecksResponse{
+		Links: selfLinks{
+			Self: fmt.Sprintf("%s%d/checks", sourceLinkPrefix, src.ID),
+		},
+		Checks: []alertCheckResponse{},
+	}
+	for _, c := range all {
+		res.Checks = append(res.Checks, newAlertCheckResponse(c))
+	}
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// NewAlertCheck creates a check of a source. Enabled checks first run on the
+// next tick of the scheduler.
+func (s *Service) NewAlertCheck(w http.ResponseWriter, r *http.Request) {
+	var req alertCheckRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	c := &chronograf.AlertCheck{}
+	if err := req.apply(c); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	ctx := r.Context()
+	if err := s.instantiateAlertCheck(ctx, &req, c); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	if err := checks.Validate(c); err != nil {
+		invalidData(w, errorf("%v", err), s.Logger)
+		return
+	}
+
+	src, err := s.alertEventsSource(ctx, r)
+	if err != nil {
+		id, _ := paramStr("id", r)
+		notFound(w, id, s.Logger)
+		return
+	}
+	c.SourceID = src.ID
+	c.States = map[string]string{}
+	scheduleAlertCheck(c, time.Now().UTC())
+	if c, err = s.Store.AlertChecks(ctx).Add(ctx, c); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	res := newAlertCheckResponse(*c)
+	location(w, res.Links.Self)
+	encodeJSON(w, http.StatusCreated, res, s.Logger)
+}
+
+// AlertCheckID returns a single check of a source
+func (s *Service) AlertCheckID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	_, c, err := s.alertCheck(ctx, r)
+	if err != nil {
+		cid, _ := paramStr("cid", r)
+		notFound(w, cid, s.Logger)
+		return
+	}
+	encodeJSON(w, http.StatusOK, newAlertCheckResponse(*c), s.Logger)
+}
+
+// UpdateAlertCheck changes the fields of a check given in the request. The
+// levels of the series of the check are kept.
+func (s *Service) UpdateAlertCheck(w http.ResponseWriter, r *http.Request) {
+	var req alertCheckRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	_, c, err := s.alertCheck(ctx, r)
+	if err != nil {
+		cid, _ := paramStr("cid", r)
+		notFound(w, cid, s.Logger)
+		return
+	}
+	if err := req.apply(c); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	if err := s.instantiateAlertCheck(ctx, &req, c); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	if err := checks.Validate(c); err != nil {
+		invalidData(w, errorf("%v", err), s.Logger)
+		return
+	}
+	scheduleAlertCheck(c, time.Now().UTC())
+	if err := s.Store.AlertChecks(ctx).Update(ctx, c); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	encodeJSON(w, http.StatusOK, newAlertCheckResponse(*c), s.Logger)
+}
+
+// RemoveAlertCheck deletes a check of a source. Its alert history is kept.
+func (s *Service) RemoveAlertCheck(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	_, c, err := s.alertCheck(ctx, r)
+	if err != nil {
+		cid, _ := paramStr("cid", r)
+		notFound(w, cid, s.Logger)
+		return
+	}
+	if err := s.Store.AlertChecks(ctx).Delete(ctx, c); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RunAlertCheck evaluates a check immediately, even when it is disabled, and
+// returns the alert events it recorded. The schedule of the check continues
+// from this run.
+func (s *Service) RunAlertCheck(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	src, c, err := s.alertCheck(ctx, r)
+	if err != nil {
+		cid, _ := paramStr("cid", r)
+		notFound(w, cid, s.Logger)
+		return
+	}
+
+	events, err := s.runAlertCheck(ctx, src, c, time.Now().UTC())
+	if err != nil {
+		Error(w, http.StatusBadGateway, fmt.Sprintf("unable to run check %d: %v", c.ID, err), s.Logger)
+		return
+	}
+
+	res := alertCheckRunResponse{
+		Check:  newAlertCheckResponse(*c),
+		Events: []alertEventResponse{},
+	}
+	for _, e := range events {
+		res.Events = append(res.Events, newAlertEventResponse(e))
+	}
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// RunAlertChecks evaluates the due checks of every source every interval
+// until ctx is done
+func (s *Service) RunAlertChecks(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.runDueAlertChecks(ctx, now.UTC())
+		}
+	}
+}
+
+// runDueAlertChecks evaluates the enabled checks whose next run is not after
+// now. Sources are checked concurrently and the checks of a source one after
+// the other. Failures are recorded as the last error of their checks.
+func (s *Service) runDueAlertChecks(ctx context.Context, now time.Time) {
+	serverCtx := serverContext(ctx)
+	sources, err := s.Store.Sources(serverCtx).All(serverCtx)
+	if err != nil {
+		s.Logger.Error("Unable to list sources for alert checks: ", err)
+		return
+	}
+
+	var wg sync.WaitGroup
+	for _, src := range sources {
+		all, err := s.Store.AlertChecks(serverCtx).All(serverCtx, src.ID)
+		if err != nil {
+			s.Logger.Error("Unable to list alert checks of source ", src.ID, ": ", err)
+			continue
+		}
+		due := []chronograf.AlertCheck{}
+		for _, c := range all {
+			if c.Enabled && !c.NextRun.IsZero() && !c.NextRun.After(now) {
+				due = append(due, c)
+			}
+		}
+		if len(due) == 0 {
+			continue
+		}
+		wg.Add(1)
+		go func(src chronograf.Source, due []chronograf.AlertCheck) {
+			defer wg.Done()
+			for i := range due {
+				if _, err := s.runAlertCheck(serverCtx, src, &due[i], now); err != nil {
+					s.Logger.Error("Unable to run alert check ", due[i].ID, " of source ", src.ID, ": ", err)
+				}
+			}
+		}(src, due)
+	}
+	wg.Wait()
+}
+
+// runAlertCheck queries the source of a check and records and relays the
+// alerts of the series whose level changed. The run and the new levels are
+// stored with the check.
+func (s *Service) runAlertCheck(ctx context.Context, src chronograf.Source, c *chronograf.AlertCheck, now time.Time) ([]chronograf.AlertEvent, error) {
+	events := []chronograf.AlertEvent{}
+	alerts, err := s.evaluateAlertCheck(ctx, src, c)
+	for _, a := range alerts {
+		e := chronograf.AlertEvent{
+			SourceID:      src.ID,
+			AlertID:       a.ID,
+			Message:       a.Message,
+			Level:         a.Level,
+			PreviousLevel: a.PreviousLevel,
+			Time:          now,
+		}
+		if aerr := s.Store.AlertEvents(ctx).Add(ctx, &e); aerr != nil {
+			s.Logger.Error("Unable to record alert ", a.ID, " of source ", src.ID, ": ", aerr)
+			continue
+		}
+		events = append(events, e)
+		s.relayAlert(ctx, src.ID, newCheckAlert(a, now))
+	}
+
+	c.LastRun = now
+	c.LastError = ""
+	if err != nil {
+		c.LastError = err.Error()
+	}
+	scheduleAlertCheck(c, now)
+	if uerr := s.Store.AlertChecks(ctx).Update(ctx, c); uerr != nil {
+		s.Logger.Error("Unable to record run of alert check ", c.ID, ": ", uerr)
+	}
+	return events, err
+}
+
+// evaluateAlertCheck runs the query of a check against its source and
+// compares the result with the thresholds of the check
+func (s *Service) evaluateAlertCheck(ctx context.Context, src chronograf.Source, c *chronograf.AlertCheck) ([]checks.Alert, error) {
+	response, err := s.querySource(ctx, src, chronograf.Query{
+		Command: c.Query,
+		DB:      c.DB,
+		RP:      c.RP,
+		Epoch:   "ms",
+	})
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(postInfluxResponse{Results: response})
+	if err != nil {
+		return nil, err
+	}
+	return checks.Evaluate(c, data)
+}
+
+// newCheckAlert shapes an alert of a check like those Kapacitor posts so that
+// it is relayed the same way
+func newCheckAlert(a checks.Alert, now time.Time) kapacitorAlert {
+	t := a.Time
+	if t.IsZero() {
+		t = now
+	}
+	return kapacitorAlert{
+		ID:            a.ID,
+		Message:       a.Message,
+		Time:          now,
+		Level:         a.Level,
+		PreviousLevel: a.PreviousLevel,
+		Data: kapacitorAlertData{
+			Series: []kapacitorAlertSeries{
+				{
+					Name:    a.Name,
+					Tags:    a.Tags,
+					Columns: []string{"time", a.Field},
+					Values:  [][]interface{}{{t, a.Value}},
+				},
+			},
+		},
+	}
+}
+
+// removeAlertChecks deletes the checks of a removed source
+func (s *Service) removeAlertChecks(ctx context.Context, sourceID int) error {
+	all, err := s.Store.AlertChecks(ctx).All(ctx, sourceID)
+	if err != nil {
+		return err
+	}
+	for i := range all {
+		if err := s.Store.AlertChecks(ctx).Delete(ctx, &all[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
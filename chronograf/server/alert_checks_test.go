@@ -0,0 +1,205 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/influxdata/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+// alertChecksStore keeps the alert checks of the tests in memory
+func alertChecksStore(all *[]chronograf.AlertCheck) *mocks.AlertChecksStore {
+	find := func(sourceID int, id uint64) int {
+		for i, c := range *all {
+			if c.SourceID == sourceID && c.ID == id {
+				return i
+			}
+		}
+		return -1
+	}
+	return &mocks.AlertChecksStore{
+		AllF: func(ctx context.Context, sourceID int) ([]chronograf.AlertCheck, error) {
+			checks := []chronograf.AlertCheck{}
+			for _, c := range *all {
+				if c.SourceID == sourceID {
+					checks = append(checks, c)
+				}
+			}
+			return checks, nil
+		},
+		AddF: func(ctx context.Context, c *chronograf.AlertCheck) (*chronograf.AlertCheck, error) {
+			c.ID = uint64(len(*all) + 1)
+			*all = append(*all, *c)
+			return c, nil
+		},
+		GetF: func(ctx context.Context, sourceID int, id uint64) (*chronograf.AlertCheck, error) {
+			i := find(sourceID, id)
+			if i < 0 {
+				return nil, chronograf.ErrAlertCheckNotFound
+			}
+			c := (*all)[i]
+			return &c, nil
+		},
+		UpdateF: func(ctx context.Context, c *chronograf.AlertCheck) error {
+			i := find(c.SourceID, c.ID)
+			if i < 0 {
+				return chronograf.ErrAlertCheckNotFound
+			}
+			(*all)[i] = *c
+			return nil
+		},
+		DeleteF: func(ctx context.Context, c *chronograf.AlertCheck) error {
+			i := find(c.SourceID, c.ID)
+			if i < 0 {
+				return chronograf.ErrAlertCheckNotFound
+			}
+			*all = append((*all)[:i], (*all)[i+1:]...)
+			return nil
+		},
+	}
+}
+
+func TestService_AlertCheckWorkflow(t *testing.T) {
+	checks := []chronograf.AlertCheck{}
+	events := []chronograf.AlertEvent{}
+	usage := "95"
+	var queried chronograf.Query
+	s := &Service{
+		Store: &mocks.Store{
+			SourcesStore: &mocks.SourcesStore{
+				AllF: func(ctx context.Context) ([]chronograf.Source, error) {
+					return []chronograf.Source{{ID: 1}}, nil
+				},
+				GetF: func(ctx context.Context, ID int) (chronograf.Source, error) {
+					if ID != 1 {
+						return chronograf.Source{}, chronograf.ErrSourceNotFound
+					}
+					return chronograf.Source{ID: ID}, nil
+				},
+			},
+			AlertChecksStore:   alertChecksStore(&checks),
+			AlertEventsStore:   alertEventsStore(&events),
+			AlertWebhooksStore: alertWebhooksStore(&[]chronograf.AlertWebhook{}),
+		},
+		TimeSeriesClient: &mocks.TimeSeries{
+			ConnectF: func(context.Context, *chronograf.Source) error {
+				return nil
+			},
+			QueryF: func(ctx context.Context, q chronograf.Query) (chronograf.Response, error) {
+				queried = q
+				return mocks.NewResponse(`{"results":[{"statement_id":0,"series":[{"name":"cpu","tags":{"host":"a"},"columns":["time","mean"],"values":[[1791979200000,`+usage+`]]}]}]}`, nil), nil
+			},
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+	serve := func(h func(http.ResponseWriter, *http.Request), id, cid, body string) (int, string) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "http://any.url", bytes.NewBufferString(body))
+		ctx := context.WithValue(r.Context(), httprouter.ParamsKey, httprouter.Params{
+			{Key: "id", Value: id},
+			{Key: "cid", Value: cid},
+		})
+		h(w, r.WithContext(ctx))
+		res, _ := ioutil.ReadAll(w.Result().Body)
+		return w.Code, string(res)
+	}
+
+	code, body := serve(s.NewAlertCheck, "1", "", `{"name":"cpu","query":"SELECT mean(\"usage_user\") FROM \"cpu\" WHERE time > now() - 5m GROUP BY \"host\"","db":"telegraf","every":"1m","operator":">","thresholds":[{"level":"CRITICAL","value":90},{"level":"WARNING","value":75}],"message":"{{ .ID }} is {{ .Level }}","enabled":true}`)
+	if code != http.StatusCreated {
+		t.Fatalf("NewAlertCheck() = %v, want %v: %s", code, http.StatusCreated, body)
+	}
+	if checks[0].NextRun.IsZero() {
+		t.Errorf("NewAlertCheck() did not schedule the enabled check")
+	}
+
+	invalid := []struct {
+		name string
+		body string
+	}{
+		{"no query", `{"name":"cpu","every":"1m","operator":">","thresholds":[{"level":"CRITICAL","value":90}]}`},
+		{"invalid every", `{"name":"cpu","query":"SELECT 1","every":"often","operator":">","thresholds":[{"level":"CRITICAL","value":90}]}`},
+		{"unknown operator", `{"name":"cpu","query":"SELECT 1","every":"1m","operator":"=~","thresholds":[{"level":"CRITICAL","value":90}]}`},
+		{"unknown level", `{"name":"cpu","query":"SELECT 1","every":"1m","operator":">","thresholds":[{"level":"PANIC","value":90}]}`},
+	}
+	for _, tt := range invalid {
+		if code, body := serve(s.NewAlertCheck, "1", "", tt.body); code != http.StatusUnprocessableEntity {
+			t.Errorf("NewAlertCheck() %s = %v, want %v: %s", tt.name, code, http.StatusUnprocessableEntity, body)
+		}
+	}
+	if code, body := serve(s.NewAlertCheck, "2", "", `{"name":"cpu","query":"SELECT 1","every":"1m","operator":">","thresholds":[{"level":"CRITICAL","value":90}]}`); code != http.StatusNotFound {
+		t.Errorf("NewAlertCheck() of missing source = %v: %s", code, body)
+	}
+
+	// Running the check records the series crossing a threshold
+	code, body = serve(s.RunAlertCheck, "1", "1", "")
+	if code != http.StatusOK {
+		t.Fatalf("RunAlertCheck() = %v: %s", code, body)
+	}
+	var run struct {
+		Check struct {
+			States  map[string]string `json:"states"`
+			NextRun time.Time         `json:"nextRun"`
+		} `json:"check"`
+		Events []chronograf.AlertEvent `json:"events"`
+	}
+	if err := json.Unmarshal([]byte(body), &run); err != nil {
+		t.Fatalf("RunAlertCheck() = %s: %v", body, err)
+	}
+	if len(run.Events) != 1 || run.Events[0].AlertID != "cpu:host=a" || run.Events[0].Level != "CRITICAL" || run.Events[0].Message != "cpu:host=a is CRITICAL" {
+		t.Errorf("RunAlertCheck() events = %#v", run.Events)
+	}
+	if run.Check.States["cpu:host=a"] != "CRITICAL" {
+		t.Errorf("RunAlertCheck() states = %v", run.Check.States)
+	}
+	if queried.DB != "telegraf" || queried.Epoch != "ms" {
+		t.Errorf("RunAlertCheck() query = %#v", queried)
+	}
+
+	// Scheduled runs only record changes of level
+	s.runDueAlertChecks(context.Background(), run.Check.NextRun.Add(-time.Second))
+	if len(events) != 1 {
+		t.Errorf("runDueAlertChecks() ran a check that was not due: %#v", events)
+	}
+	s.runDueAlertChecks(context.Background(), run.Check.NextRun)
+	if len(events) != 1 {
+		t.Errorf("runDueAlertChecks() recorded an unchanged level: %#v", events)
+	}
+	usage = "10"
+	s.runDueAlertChecks(context.Background(), run.Check.NextRun.Add(time.Minute))
+	if len(events) != 2 || events[1].Level != "OK" || events[1].PreviousLevel != "CRITICAL" {
+		t.Errorf("runDueAlertChecks() events = %#v", events)
+	}
+
+	// Disabled checks are not scheduled
+	if code, body := serve(s.UpdateAlertCheck, "1", "1", `{"enabled":false}`); code != http.StatusOK {
+		t.Fatalf("UpdateAlertCheck() = %v: %s", code, body)
+	}
+	if !checks[0].NextRun.IsZero() || checks[0].States["cpu:host=a"] != "OK" {
+		t.Errorf("UpdateAlertCheck() = %#v", checks[0])
+	}
+	usage = "99"
+	s.runDueAlertChecks(context.Background(), time.Now().Add(time.Hour))
+	if len(events) != 2 {
+		t.Errorf("runDueAlertChecks() ran a disabled check: %#v", events)
+	}
+
+	if code, body := serve(s.RemoveAlertCheck, "1", "1", ""); code != http.StatusNoContent {
+		t.Fatalf("RemoveAlertCheck() = %v: %s", code, body)
+	}
+	if code, body := serve(s.AlertCheckID, "1", "1", ""); code != http.StatusNotFound {
+		t.Errorf("AlertCheckID() after RemoveAlertCheck() = %v: %s", code, body)
+	}
+	code, body = serve(s.AlertChecks, "1", "", "")
+	want := `{"links":{"self":"/chronograf/v1/sources/1/checks"},"checks":[]}`
+	if eq, _ := jsonEqual(body, want); code != http.StatusOK || !eq {
+		t.Errorf("AlertChecks() = %v %s, want %s", code, body, want)
+	}
+}
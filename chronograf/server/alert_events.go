@@ -0,0 +1,341 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+const (
+	// defaultAlertEventsLimit is the number of events of an alert history
+	// request without a limit
+	defaultAlertEventsLimit = 100
+	// maxAlertEventsLimit is the largest number of events of an alert
+	// history request
+	maxAlertEventsLimit = 1000
+)
+
+// alertLevels are the levels of the alerts Kapacitor sends
+var alertLevels = map[string]bool{
+	"OK":       true,
+	"INFO":     true,
+	"WARNING":  true,
+	"CRITICAL": true,
+}
+
+type alertEventLinks struct {
+	Self     string `json:"self"`     // Self link mapping to this resource
+	Comments string `json:"comments"` // Comments link to comment on the event
+}
+
+type alertEventResponse struct {
+	chronograf.AlertEvent
+	Links alertEventLinks `json:"links"`
+}
+
+func newAlertEventResponse(e chronograf.AlertEvent) alertEventResponse {
+	if e.Comments == nil {
+		e.Comments = []chronograf.AlertEventComment{}
+	}
+	self := fmt.Sprintf("%s%d/alerts/%d", sourceLinkPrefix, e.SourceID, e.ID)
+	return alertEventResponse{
+		AlertEvent: e,
+		Links: alertEventLinks{
+			Self:     self,
+			Comments: self + "/comments",
+		},
+	}
+}
+
+type alertEventsResponse struct {
+	Links  selfLinks            `json:"links"`
+	Events []alertEventResponse `json:"events"`
+}
+
+// kapacitorAlert is the body of the requests of the post handler of
+// Kapacitor alerts
+type kapacitorAlert struct {
+	ID            string             `json:"id"`
+	Message       string             `json:"message"`
+	Details       string             `json:"details"`
+	Time          time.Time          `json:"time"`
+	Duration      time.Duration      `json:"duration"`
+	Level         string             `json:"level"`
+	PreviousLevel string             `json:"previousLevel"`
+	Data          kapacitorAlertData `json:"data"`
+}
+
+// kapacitorAlertData holds the points that triggered a Kapacitor alert
+type kapacitorAlertData struct {
+	Series []kapacitorAlertSeries `json:"series"`
+}
+
+// kapacitorAlertSeries is a series of the points of a Kapacitor alert
+type kapacitorAlertSeries struct {
+	Name    string            `json:"name"`
+	Tags    map[string]string `json:"tags"`
+	Columns []string          `json:"columns"`
+	Values  [][]interface{}   `json:"values"`
+}
+
+func (a *kapacitorAlert) Valid() error {
+	if a.ID == "" {
+		return errorf("id required on alert request body")
+	}
+	if !alertLevels[a.Level] {
+		return errorf("unknown level %s. Valid levels are 'OK', 'INFO', 'WARNING', and 'CRITICAL'", a.Level)
+	}
+	return nil
+}
+
+// alertEventsSource retrieves the source of the route within the current
+// organization. Alert events of other organizations are indistinguishable
+// from events of missing sources.
+func (s *Service) alertEventsSource(ctx context.Context, r *http.Request) (chronograf.Source, error) {
+	id, err := paramID("id", r)
+	if err != nil {
+		return chronograf.Source{}, err
+	}
+	return s.Store.Sources(ctx).Get(ctx, id)
+}
+
+// alertEvent retrieves the alert event of the route
+func (s *Service) alertEvent(ctx context.Context, r *http.Request) (*chronograf.AlertEvent, error) {
+	src, err := s.alertEventsSource(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	param, _ := paramStr("aid", r)
+	aid, err := strconv.ParseUint(param, 10, 64)
+	if err != nil {
+		return nil, chronograf.ErrAlertEventNotFound
+	}
+	return s.Store.AlertEvents(ctx).Get(ctx, src.ID, aid)
+}
+
+// NewAlertEvent records an alert Kapacitor sent with its post handler and
+// relays it to the webhooks of the source. The post handler of the alert node
+// of a rule should target this endpoint with an API token of an editor.
+func (s *Service) NewAlertEvent(w http.ResponseWriter, r *http.Request) {
+	var req kapacitorAlert
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	if err := req.Valid(); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	src, err := s.alertEventsSource(ctx, r)
+	if err != nil {
+		id, _ := paramStr("id", r)
+		notFound(w, id, s.Logger)
+		return
+	}
+
+	e := &chronograf.AlertEvent{
+		SourceID:      src.ID,
+		AlertID:       req.ID,
+		Message:       req.Message,
+		Details:       req.Details,
+		Level:         req.Level,
+		PreviousLevel: req.PreviousLevel,
+		Duration:      req.Duration,
+		Time:          req.Time.UTC(),
+	}
+	if err := s.Store.AlertEvents(ctx).Add(ctx, e); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	s.relayAlert(ctx, src.ID, req)
+
+	res := newAlertEventResponse(*e)
+	location(w, res.Links.Self)
+	encodeJSON(w, http.StatusCreated, res, s.Logger)
+}
+
+// AlertEvents returns the alert history of a source, newest first. The
+// level, alertID and acknowledged parameters only return events with a
+// level, of an alert or with an acknowledgment status; the since and until
+// parameters only those within an RFC3339 time range; and the limit
+// parameter caps the number of events.
+func (s *Service) AlertEvents(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	limit := defaultAlertEventsLimit
+	if l := params.Get("limit"); l != "" {
+		var err error
+		if limit, err = strconv.Atoi(l); err != nil || limit < 1 || limit > maxAlertEventsLimit {
+			invalidData(w, errorf("limit must be between 1 and %d", maxAlertEventsLimit), s.Logger)
+			return
+		}
+	}
+	level := strings.ToUpper(params.Get("level"))
+	if level != "" && !alertLevels[level] {
+		invalidData(w, errorf("unknown level %s. Valid levels are 'OK', 'INFO', 'WARNING', and 'CRITICAL'", level), s.Logger)
+		return
+	}
+	var acked *bool
+	if a := params.Get("acknowledged"); a != "" {
+		b, err := strconv.ParseBool(a)
+		if err != nil {
+			invalidData(w, errorf("acknowledged must be true or false"), s.Logger)
+			return
+		}
+		acked = &b
+	}
+	var sinceTime, untilTime time.Time
+	for _, p := range []struct {
+		name string
+		t    *time.Time
+	}{{since, &sinceTime}, {until, &untilTime}} {
+		v := params.Get(p.name)
+		if v == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			invalidData(w, errorf("%s must be an RFC3339 time", p.name), s.Logger)
+			return
+		}
+		*p.t = t
+	}
+	alertID := params.Get("alertID")
+
+	ctx := r.Context()
+	src, err := s.alertEventsSource(ctx, r)
+	if err != nil {
+		id, _ := paramStr("id", r)
+		notFound(w, id, s.Logger)
+		return
+	}
+	all, err := s.Store.AlertEvents(ctx).All(ctx, src.ID)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	res := alertEventsResponse{
+		Links: selfLinks{
+			Self: fmt.Sprintf("%s%d/alerts", sourceLinkPrefix, src.ID),
+		},
+		Events: []alertEventResponse{},
+	}
+	for i := len(all) - 1; i >= 0 && len(res.Events) < limit; i-- {
+		e := all[i]
+		if (level != "" && e.Level != level) ||
+			(alertID != "" && e.AlertID != alertID) ||
+			(acked != nil && e.Acknowledged != *acked) ||
+			(!sinceTime.IsZero() && e.Time.Before(sinceTime)) ||
+			(!untilTime.IsZero() && e.Time.After(untilTime)) {
+			continue
+		}
+		res.Events = append(res.Events, newAlertEventResponse(e))
+	}
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// AlertEventID returns a single alert event of a source
+func (s *Service) AlertEventID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	e, err := s.alertEvent(ctx, r)
+	if err != nil {
+		aid, _ := paramStr("aid", r)
+		notFound(w, aid, s.Logger)
+		return
+	}
+	encodeJSON(w, http.StatusOK, newAlertEventResponse(*e), s.Logger)
+}
+
+type alertEventRequest struct {
+	Acknowledged *bool `json:"acknowledged"`
+}
+
+// UpdateAlertEvent acknowledges an alert event on behalf of the current user,
+// or withdraws its acknowledgment
+func (s *Service) UpdateAlertEvent(w http.ResponseWriter, r *http.Request) {
+	var req alertEventRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	if req.Acknowledged == nil {
+		invalidData(w, errorf("no fields to update"), s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	e, err := s.alertEvent(ctx, r)
+	if err != nil {
+		aid, _ := paramStr("aid", r)
+		notFound(w, aid, s.Logger)
+		return
+	}
+
+	if *req.Acknowledged != e.Acknowledged {
+		e.Acknowledged = *req.Acknowledged
+		e.AcknowledgedBy, e.AcknowledgedAt = "", time.Time{}
+		if e.Acknowledged {
+			if u, ok := hasUserContext(ctx); ok {
+				e.AcknowledgedBy = u.Name
+			}
+			e.AcknowledgedAt = time.Now().UTC()
+		}
+		if err := s.Store.AlertEvents(ctx).Update(ctx, e); err != nil {
+			unknownErrorWithMessage(w, err, s.Logger)
+			return
+		}
+	}
+	encodeJSON(w, http.StatusOK, newAlertEventResponse(*e), s.Logger)
+}
+
+type alertEventCommentRequest struct {
+	Text string `json:"text"`
+}
+
+// NewAlertEventComment adds a comment of the current user to an alert event
+func (s *Service) NewAlertEventComment(w http.ResponseWriter, r *http.Request) {
+	var req alertEventCommentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	text := strings.TrimSpace(req.Text)
+	if text == "" {
+		invalidData(w, errorf("text required on comment request body"), s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	e, err := s.alertEvent(ctx, r)
+	if err != nil {
+		aid, _ := paramStr("aid", r)
+		notFound(w, aid, s.Logger)
+		return
+	}
+
+	c := chronograf.AlertEventComment{
+		Text:    text,
+		Created: time.Now().UTC(),
+	}
+	if u, ok := hasUserContext(ctx); ok {
+		c.UserID = u.ID
+		c.Author = u.Name
+	}
+	e.Comments = append(e.Comments, c)
+	if err := s.Store.AlertEvents(ctx).Update(ctx, e); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	res := newAlertEventResponse(*e)
+	location(w, res.Links.Self)
+	encodeJSON(w, http.StatusCreated, res, s.Logger)
+}
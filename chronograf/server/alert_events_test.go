@@ -0,0 +1,242 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+// alertEventsStore keeps the alert events of the tests in memory
+func alertEventsStore(events *[]chronograf.AlertEvent) *mocks.AlertEventsStore {
+	find := func(sourceID int, id uint64) int {
+		for i, e := range *events {
+			if e.SourceID == sourceID && e.ID == id {
+				return i
+			}
+		}
+		return -1
+	}
+	return &mocks.AlertEventsStore{
+		AllF: func(ctx context.Context, sourceID int) ([]chronograf.AlertEvent, error) {
+			all := []chronograf.AlertEvent{}
+			for _, e := range *events {
+				if e.SourceID == sourceID {
+					all = append(all, e)
+				}
+			}
+			return all, nil
+		},
+		AddF: func(ctx context.Context, e *chronograf.AlertEvent) error {
+			e.ID = uint64(len(*events) + 1)
+			*events = append(*events, *e)
+			return nil
+		},
+		GetF: func(ctx context.Context, sourceID int, id uint64) (*chronograf.AlertEvent, error) {
+			i := find(sourceID, id)
+			if i < 0 {
+				return nil, chronograf.ErrAlertEventNotFound
+			}
+			e := (*events)[i]
+			return &e, nil
+		},
+		UpdateF: func(ctx context.Context, e *chronograf.AlertEvent) error {
+			i := find(e.SourceID, e.ID)
+			if i < 0 {
+				return chronograf.ErrAlertEventNotFound
+			}
+			(*events)[i] = *e
+			return nil
+		},
+	}
+}
+
+func TestService_AlertEvents(t *testing.T) {
+	alertTime := time.Date(2026, 10, 6, 12, 0, 0, 0, time.UTC)
+	events := []chronograf.AlertEvent{
+		{ID: 1, SourceID: 1, AlertID: "cpu", Level: "CRITICAL", PreviousLevel: "OK", Time: alertTime},
+		{ID: 2, SourceID: 1, AlertID: "mem", Level: "WARNING", PreviousLevel: "OK", Time: alertTime.Add(time.Minute), Acknowledged: true},
+		{ID: 3, SourceID: 1, AlertID: "cpu", Level: "OK", PreviousLevel: "CRITICAL", Time: alertTime.Add(2 * time.Minute)},
+		{ID: 4, SourceID: 2, AlertID: "cpu", Level: "CRITICAL", Time: alertTime},
+	}
+	s := &Service{
+		Store: &mocks.Store{
+			SourcesStore: &mocks.SourcesStore{
+				GetF: func(ctx context.Context, ID int) (chronograf.Source, error) {
+					if ID != 1 {
+						return chronograf.Source{}, chronograf.ErrSourceNotFound
+					}
+					return chronograf.Source{ID: 1}, nil
+				},
+			},
+			AlertEventsStore: alertEventsStore(&events),
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+
+	tests := []struct {
+		name       string
+		id         string
+		query      string
+		wantStatus int
+		wantIDs    []uint64
+	}{
+		{
+			name:       "All events newest first",
+			id:         "1",
+			wantStatus: http.StatusOK,
+			wantIDs:    []uint64{3, 2, 1},
+		},
+		{
+			name:       "Events of an alert",
+			id:         "1",
+			query:      "?alertID=cpu&limit=1",
+			wantStatus: http.StatusOK,
+			wantIDs:    []uint64{3},
+		},
+		{
+			name:       "Unacknowledged critical events",
+			id:         "1",
+			query:      "?level=critical&acknowledged=false",
+			wantStatus: http.StatusOK,
+			wantIDs:    []uint64{1},
+		},
+		{
+			name:       "Events within a time range",
+			id:         "1",
+			query:      "?since=2026-10-06T12:01:00Z&until=2026-10-06T12:01:30Z",
+			wantStatus: http.StatusOK,
+			wantIDs:    []uint64{2},
+		},
+		{
+			name:       "Unknown level",
+			id:         "1",
+			query:      "?level=fatal",
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Invalid limit",
+			id:         "1",
+			query:      "?limit=0",
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Source of another organization",
+			id:         "2",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("GET", "http://any.url/chronograf/v1/sources/"+tt.id+"/alerts"+tt.query, nil)
+			r = r.WithContext(context.WithValue(r.Context(), httprouter.ParamsKey, httprouter.Params{
+				{Key: "id", Value: tt.id},
+			}))
+
+			s.AlertEvents(w, r)
+
+			resp := w.Result()
+			if resp.StatusCode != tt.wantStatus {
+				body, _ := ioutil.ReadAll(resp.Body)
+				t.Fatalf("AlertEvents() = %v, want %v: %s", resp.StatusCode, tt.wantStatus, body)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var res alertEventsResponse
+			if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+				t.Fatal(err)
+			}
+			got := []uint64{}
+			for _, e := range res.Events {
+				got = append(got, e.ID)
+			}
+			if diff := cmp.Diff(got, tt.wantIDs); diff != "" {
+				t.Errorf("AlertEvents() diff (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestService_AlertEventWorkflow(t *testing.T) {
+	events := []chronograf.AlertEvent{}
+	s := &Service{
+		Store: &mocks.Store{
+			SourcesStore: &mocks.SourcesStore{
+				GetF: func(ctx context.Context, ID int) (chronograf.Source, error) {
+					return chronograf.Source{ID: ID}, nil
+				},
+			},
+			AlertEventsStore: alertEventsStore(&events),
+			AlertWebhooksStore: &mocks.AlertWebhooksStore{
+				AllF: func(ctx context.Context, sourceID int) ([]chronograf.AlertWebhook, error) {
+					return []chronograf.AlertWebhook{}, nil
+				},
+			},
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+	serve := func(h func(http.ResponseWriter, *http.Request), aid, body string) (int, string) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "http://any.url", bytes.NewBufferString(body))
+		ctx := context.WithValue(r.Context(), UserContextKey, &chronograf.User{ID: 7, Name: "bob"})
+		ctx = context.WithValue(ctx, httprouter.ParamsKey, httprouter.Params{
+			{Key: "id", Value: "1"},
+			{Key: "aid", Value: aid},
+		})
+		h(w, r.WithContext(ctx))
+		res, _ := ioutil.ReadAll(w.Result().Body)
+		return w.Code, string(res)
+	}
+
+	// Kapacitor posts the alert
+	code, body := serve(s.NewAlertEvent, "", `{"id":"cpu:host=a","message":"cpu is high","time":"2026-10-06T12:00:00Z","duration":0,"level":"CRITICAL","previousLevel":"OK","data":{}}`)
+	want := `{"id":"1","sourceID":"1","alertID":"cpu:host=a","message":"cpu is high","level":"CRITICAL","previousLevel":"OK","duration":0,"time":"2026-10-06T12:00:00Z","acknowledged":false,"acknowledgedAt":"0001-01-01T00:00:00Z","comments":[],"links":{"self":"/chronograf/v1/sources/1/alerts/1","comments":"/chronograf/v1/sources/1/alerts/1/comments"}}`
+	if code != http.StatusCreated {
+		t.Fatalf("NewAlertEvent() = %v, want %v: %s", code, http.StatusCreated, body)
+	}
+	if eq, _ := jsonEqual(body, want); !eq {
+		t.Errorf("NewAlertEvent() = %s, want %s", body, want)
+	}
+	if code, body := serve(s.NewAlertEvent, "", `{"id":"cpu","level":"FATAL"}`); code != http.StatusUnprocessableEntity {
+		t.Errorf("NewAlertEvent() of unknown level = %v: %s", code, body)
+	}
+
+	if code, body := serve(s.UpdateAlertEvent, "1", `{"acknowledged":true}`); code != http.StatusOK {
+		t.Fatalf("UpdateAlertEvent() = %v: %s", code, body)
+	}
+	if e := events[0]; !e.Acknowledged || e.AcknowledgedBy != "bob" || e.AcknowledgedAt.IsZero() {
+		t.Errorf("UpdateAlertEvent() acknowledged %#v", e)
+	}
+	if code, body := serve(s.UpdateAlertEvent, "2", `{"acknowledged":true}`); code != http.StatusNotFound {
+		t.Errorf("UpdateAlertEvent() of missing event = %v: %s", code, body)
+	}
+
+	if code, body := serve(s.NewAlertEventComment, "1", `{"text":"  restarting the host  "}`); code != http.StatusCreated {
+		t.Fatalf("NewAlertEventComment() = %v: %s", code, body)
+	}
+	if code, body := serve(s.NewAlertEventComment, "1", `{"text":" "}`); code != http.StatusUnprocessableEntity {
+		t.Errorf("NewAlertEventComment() without text = %v: %s", code, body)
+	}
+	comments := events[0].Comments
+	if len(comments) != 1 || comments[0].Text != "restarting the host" || comments[0].UserID != 7 || comments[0].Author != "bob" {
+		t.Errorf("NewAlertEventComment() comments = %#v", comments)
+	}
+
+	if code, body := serve(s.UpdateAlertEvent, "1", `{"acknowledged":false}`); code != http.StatusOK {
+		t.Fatalf("UpdateAlertEvent() = %v: %s", code, body)
+	}
+	if e := events[0]; e.Acknowledged || e.AcknowledgedBy != "" || !e.AcknowledgedAt.IsZero() {
+		t.Errorf("UpdateAlertEvent() did not withdraw the acknowledgment %#v", e)
+	}
+}
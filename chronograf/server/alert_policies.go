@@ -0,0 +1,384 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Reasons the notification of an alert is suppressed
+const (
+	alertThrottled = "throttled"
+	alertFlapping  = "flapping"
+)
+
+// alertThrottlerSweep is the interval between removals of the state of
+// alerts no policy needs anymore
+const alertThrottlerSweep = time.Minute
+
+// AlertThrottler tracks the alerts relayed to webhooks to suppress the
+// notifications limited by the alert policies of their rules. Its state is
+// kept in memory, so alerts are tracked anew when Chronograf restarts.
+type AlertThrottler struct {
+	mu        sync.Mutex
+	alerts    map[alertThrottleKey]*alertThrottleState
+	lastSweep time.Time
+}
+
+type alertThrottleKey struct {
+	sourceID int
+	alertID  string
+}
+
+// alertThrottleState is what is known of an alert ID of a source
+type alertThrottleState struct {
+	level    string      // level is the level of the last alert
+	changes  []time.Time // changes are the times of the level changes within the flap window
+	lastSent time.Time   // lastSent is when the last notification was relayed
+	expires  time.Time   // expires is when the policy no longer needs the state
+}
+
+// NewAlertThrottler tracks no alert yet
+func NewAlertThrottler() *AlertThrottler {
+	return &AlertThrottler{
+		alerts: map[alertThrottleKey]*alertThrottleState{},
+	}
+}
+
+// Suppress records an alert of a source at now and returns why policy p
+// suppresses its notification, or an empty string when it is relayed. An
+// alert ID is flapping while its level changed at least FlapChanges times
+// within FlapWindow, and throttled within Throttle of its last notification.
+func (t *AlertThrottler) Suppress(p *chronograf.AlertPolicy, sourceID int, alertID, level string, now time.Time) string {
+	if t == nil || p == nil {
+		return ""
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.sweep(now)
+
+	key := alertThrottleKey{sourceID: sourceID, alertID: alertID}
+	st, ok := t.alerts[key]
+	if !ok {
+		st = &alertThrottleState{}
+		t.alerts[key] = st
+	}
+	if st.level != "" && st.level != level {
+		st.changes = append(st.changes, now)
+	}
+	st.level = level
+	keep := p.Throttle
+	if p.FlapWindow > keep {
+		keep = p.FlapWindow
+	}
+	st.expires = now.Add(keep)
+
+	if p.FlapChanges > 0 {
+		i := 0
+		for i < len(st.changes) && !st.changes[i].After(now.Add(-p.FlapWindow)) {
+			i++
+		}
+		st.changes = st.changes[i:]
+		if len(st.changes) >= p.FlapChanges {
+			return alertFlapping
+		}
+	} else {
+		st.changes = nil
+	}
+	if p.Throttle > 0 && !st.lastSent.IsZero() && now.Sub(st.lastSent) < p.Throttle {
+		return alertThrottled
+	}
+	st.lastSent = now
+	return ""
+}
+
+// sweep removes the expired states of alerts at most once every
+// alertThrottlerSweep
+func (t *AlertThrottler) sweep(now time.Time) {
+	if now.Sub(t.lastSweep) < alertThrottlerSweep {
+		return
+	}
+	t.lastSweep = now
+	for key, st := range t.alerts {
+		if now.After(st.expires) {
+			delete(t.alerts, key)
+		}
+	}
+}
+
+// suppressAlert returns why the policy of the rule of an alert suppresses its
+// notification, or an empty string when it is relayed. Alerts are relayed
+// when the policies cannot be read.
+func (s *Service) suppressAlert(ctx context.Context, sourceID int, a kapacitorAlert, now time.Time) string {
+	if s.AlertThrottler == nil {
+		return ""
+	}
+	policies, err := s.Store.AlertPolicies(ctx).All(ctx, sourceID)
+	if err != nil {
+		s.Logger.Error("Unable to list alert policies of source ", sourceID, ": ", err)
+		return ""
+	}
+	for i := range policies {
+		if alertRuleMatches(policies[i].Rule, a.ID) {
+			return s.AlertThrottler.Suppress(&policies[i], sourceID, a.ID, a.Level, now)
+		}
+	}
+	return ""
+}
+
+type alertPolicyResponse struct {
+	ID          uint64    `json:"id,string"`
+	SourceID    int       `json:"sourceID,string"`
+	Rule        string    `json:"rule"`
+	Throttle    string    `json:"throttle"`   // Throttle is a duration such as 10m
+	FlapWindow  string    `json:"flapWindow"` // FlapWindow is a duration such as 1h
+	FlapChanges int       `json:"flapChanges"`
+	Links       selfLinks `json:"links"`
+}
+
+func newAlertPolicyResponse(p chronograf.AlertPolicy) alertPolicyResponse {
+	return alertPolicyResponse{
+		ID:          p.ID,
+		SourceID:    p.SourceID,
+		Rule:        p.Rule,
+		Throttle:    p.Throttle.String(),
+		FlapWindow:  p.FlapWindow.String(),
+		FlapChanges: p.FlapChanges,
+		Links: selfLinks{
+			Self: fmt.Sprintf("%s%d/policies/%d", sourceLinkPrefix, p.SourceID, p.ID),
+		},
+	}
+}
+
+type alertPoliciesResponse struct {
+	Links    selfLinks             `json:"links"`
+	Policies []alertPolicyResponse `json:"policies"`
+}
+
+// alertPolicyRequest creates or updates a policy. Fields left out of an
+// update keep their value.
+type alertPolicyRequest struct {
+	Rule        *string `json:"rule"`
+	Throttle    *string `json:"throttle"`   // Throttle is a duration such as 10m
+	FlapWindow  *string `json:"flapWindow"` // FlapWindow is a duration such as 1h
+	FlapChanges *int    `json:"flapChanges"`
+}
+
+// apply sets the fields of the request on a policy
+func (req *alertPolicyRequest) apply(p *chronograf.AlertPolicy) error {
+	if req.Rule != nil {
+		p.Rule = *req.Rule
+	}
+	if req.Throttle != nil {
+		d, err := time.ParseDuration(*req.Throttle)
+		if err != nil {
+			return errorf("invalid throttle %q: %v", *req.Throttle, err)
+		}
+		p.Throttle = d
+	}
+	if req.FlapWindow != nil {
+		d, err := time.ParseDuration(*req.FlapWindow)
+		if err != nil {
+			return errorf("invalid flapWindow %q: %v", *req.FlapWindow, err)
+		}
+		p.FlapWindow = d
+	}
+	if req.FlapChanges != nil {
+		p.FlapChanges = *req.FlapChanges
+	}
+	return nil
+}
+
+// validAlertPolicy checks a policy before it is stored. A source has at most
+// one policy per rule.
+func validAlertPolicy(p *chronograf.AlertPolicy, others []chronograf.AlertPolicy) error {
+	if p.Rule == "" {
+		return errorf("rule required on policy request body")
+	}
+	if p.Throttle < 0 || p.FlapWindow < 0 || p.FlapChanges < 0 {
+		return errorf("throttle, flapWindow and flapChanges cannot be negative")
+	}
+	if p.Throttle == 0 && p.FlapChanges == 0 {
+		return errorf("policy must set a throttle or flapChanges")
+	}
+	if p.FlapChanges == 1 {
+		return errorf("flapChanges must be at least 2")
+	}
+	if p.FlapChanges > 0 && p.FlapWindow == 0 {
+		return errorf("flapWindow required to detect flapping")
+	}
+	for _, o := range others {
+		if o.ID != p.ID && o.Rule == p.Rule {
+			return errorf("rule %s already has a policy", p.Rule)
+		}
+	}
+	return nil
+}
+
+// alertPolicy retrieves the policy of the route within the source of the
+// route
+func (s *Service) alertPolicy(ctx context.Context, r *http.Request) (*chronograf.AlertPolicy, error) {
+	src, err := s.alertEventsSource(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	param, _ := paramStr("pid", r)
+	pid, err := strconv.ParseUint(param, 10, 64)
+	if err != nil {
+		return nil, chronograf.ErrAlertPolicyNotFound
+	}
+	return s.Store.AlertPolicies(ctx).Get(ctx, src.ID, pid)
+}
+
+// AlertPolicies returns the policies limiting the notifications of the
+// alerts of a source
+func (s *Service) AlertPolicies(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	src, err := s.alertEventsSource(ctx, r)
+	if err != nil {
+		id, _ := paramStr("id", r)
+		notFound(w, id, s.Logger)
+		return
+	}
+	policies, err := s.Store.AlertPolicies(ctx).All(ctx, src.ID)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	res := alertPoliciesResponse{
+		Links: selfLinks{
+			Self: fmt.Sprintf("%s%d/policies", sourceLinkPrefix, src.ID),
+		},
+		Policies: []alertPolicyResponse{},
+	}
+	for _, p := range policies {
+		res.Policies = append(res.Policies, newAlertPolicyResponse(p))
+	}
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// NewAlertPolicy creates the policy of a rule of a source
+func (s *Service) NewAlertPolicy(w http.ResponseWriter, r *http.Request) {
+	var req alertPolicyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	p := &chronograf.AlertPolicy{}
+	if err := req.apply(p); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	src, err := s.alertEventsSource(ctx, r)
+	if err != nil {
+		id, _ := paramStr("id", r)
+		notFound(w, id, s.Logger)
+		return
+	}
+	others, err := s.Store.AlertPolicies(ctx).All(ctx, src.ID)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	if err := validAlertPolicy(p, others); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	p.SourceID = src.ID
+	if p, err = s.Store.AlertPolicies(ctx).Add(ctx, p); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	res := newAlertPolicyResponse(*p)
+	location(w, res.Links.Self)
+	encodeJSON(w, http.StatusCreated, res, s.Logger)
+}
+
+// AlertPolicyID returns a single policy of a source
+func (s *Service) AlertPolicyID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	p, err := s.alertPolicy(ctx, r)
+	if err != nil {
+		pid, _ := paramStr("pid", r)
+		notFound(w, pid, s.Logger)
+		return
+	}
+	encodeJSON(w, http.StatusOK, newAlertPolicyResponse(*p), s.Logger)
+}
+
+// UpdateAlertPolicy changes the fields of a policy given in the request. The
+// alerts already tracked are limited by the updated policy.
+func (s *Service) UpdateAlertPolicy(w http.ResponseWriter, r *http.Request) {
+	var req alertPolicyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	p, err := s.alertPolicy(ctx, r)
+	if err != nil {
+		pid, _ := paramStr("pid", r)
+		notFound(w, pid, s.Logger)
+		return
+	}
+	if err := req.apply(p); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	others, err := s.Store.AlertPolicies(ctx).All(ctx, p.SourceID)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	if err := validAlertPolicy(p, others); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	if err := s.Store.AlertPolicies(ctx).Update(ctx, p); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	encodeJSON(w, http.StatusOK, newAlertPolicyResponse(*p), s.Logger)
+}
+
+// RemoveAlertPolicy deletes a policy of a source, so that the alerts of its
+// rule are no longer limited
+func (s *Service) RemoveAlertPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	p, err := s.alertPolicy(ctx, r)
+	if err != nil {
+		pid, _ := paramStr("pid", r)
+		notFound(w, pid, s.Logger)
+		return
+	}
+	if err := s.Store.AlertPolicies(ctx).Delete(ctx, p); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// removeAlertPolicies deletes the policies of a removed source
+func (s *Service) removeAlertPolicies(ctx context.Context, sourceID int) error {
+	policies, err := s.Store.AlertPolicies(ctx).All(ctx, sourceID)
+	if err != nil {
+		return err
+	}
+	for i := range policies {
+		if err := s.Store.AlertPolicies(ctx).Delete(ctx, &policies[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
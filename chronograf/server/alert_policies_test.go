@@ -0,0 +1,225 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/influxdata/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+// alertPoliciesStore keeps the alert policies of the tests in memory
+func alertPoliciesStore(policies *[]chronograf.AlertPolicy) *mocks.AlertPoliciesStore {
+	find := func(sourceID int, id uint64) int {
+		for i, p := range *policies {
+			if p.SourceID == sourceID && p.ID == id {
+				return i
+			}
+		}
+		return -1
+	}
+	return &mocks.AlertPoliciesStore{
+		AllF: func(ctx context.Context, sourceID int) ([]chronograf.AlertPolicy, error) {
+			all := []chronograf.AlertPolicy{}
+			for _, p := range *policies {
+				if p.SourceID == sourceID {
+					all = append(all, p)
+				}
+			}
+			return all, nil
+		},
+		AddF: func(ctx context.Context, p *chronograf.AlertPolicy) (*chronograf.AlertPolicy, error) {
+			p.ID = uint64(len(*policies) + 1)
+			*policies = append(*policies, *p)
+			return p, nil
+		},
+		GetF: func(ctx context.Context, sourceID int, id uint64) (*chronograf.AlertPolicy, error) {
+			i := find(sourceID, id)
+			if i < 0 {
+				return nil, chronograf.ErrAlertPolicyNotFound
+			}
+			p := (*policies)[i]
+			return &p, nil
+		},
+		UpdateF: func(ctx context.Context, p *chronograf.AlertPolicy) error {
+			i := find(p.SourceID, p.ID)
+			if i < 0 {
+				return chronograf.ErrAlertPolicyNotFound
+			}
+			(*policies)[i] = *p
+			return nil
+		},
+		DeleteF: func(ctx context.Context, p *chronograf.AlertPolicy) error {
+			i := find(p.SourceID, p.ID)
+			if i < 0 {
+				return chronograf.ErrAlertPolicyNotFound
+			}
+			*policies = append((*policies)[:i], (*policies)[i+1:]...)
+			return nil
+		},
+	}
+}
+
+func TestService_AlertPolicyWorkflow(t *testing.T) {
+	policies := []chronograf.AlertPolicy{}
+	s := &Service{
+		Store: &mocks.Store{
+			SourcesStore: &mocks.SourcesStore{
+				GetF: func(ctx context.Context, ID int) (chronograf.Source, error) {
+					if ID != 1 {
+						return chronograf.Source{}, chronograf.ErrSourceNotFound
+					}
+					return chronograf.Source{ID: ID}, nil
+				},
+			},
+			AlertPoliciesStore: alertPoliciesStore(&policies),
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+	serve := func(h func(http.ResponseWriter, *http.Request), id, pid, body string) (int, string) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "http://any.url", bytes.NewBufferString(body))
+		ctx := context.WithValue(r.Context(), httprouter.ParamsKey, httprouter.Params{
+			{Key: "id", Value: id},
+			{Key: "pid", Value: pid},
+		})
+		h(w, r.WithContext(ctx))
+		res, _ := ioutil.ReadAll(w.Result().Body)
+		return w.Code, string(res)
+	}
+
+	code, body := serve(s.NewAlertPolicy, "1", "", `{"rule":"cpu","throttle":"10m","flapWindow":"1h","flapChanges":4}`)
+	want := `{"id":"1","sourceID":"1","rule":"cpu","throttle":"10m0s","flapWindow":"1h0m0s","flapChanges":4,"links":{"self":"/chronograf/v1/sources/1/policies/1"}}`
+	if code != http.StatusCreated {
+		t.Fatalf("NewAlertPolicy() = %v, want %v: %s", code, http.StatusCreated, body)
+	}
+	if eq, _ := jsonEqual(body, want); !eq {
+		t.Errorf("NewAlertPolicy() = %s, want %s", body, want)
+	}
+
+	invalid := []struct {
+		name string
+		body string
+	}{
+		{"no rule", `{"throttle":"10m"}`},
+		{"no limit", `{"rule":"disk"}`},
+		{"unparsable throttle", `{"rule":"disk","throttle":"often"}`},
+		{"negative throttle", `{"rule":"disk","throttle":"-1m"}`},
+		{"single flap change", `{"rule":"disk","flapWindow":"1h","flapChanges":1}`},
+		{"flap changes without window", `{"rule":"disk","flapChanges":3}`},
+		{"rule with a policy", `{"rule":"cpu","throttle":"1m"}`},
+	}
+	for _, tt := range invalid {
+		if code, body := serve(s.NewAlertPolicy, "1", "", tt.body); code != http.StatusUnprocessableEntity {
+			t.Errorf("NewAlertPolicy() %s = %v, want %v: %s", tt.name, code, http.StatusUnprocessableEntity, body)
+		}
+	}
+	if code, body := serve(s.NewAlertPolicy, "2", "", `{"rule":"disk","throttle":"1m"}`); code != http.StatusNotFound {
+		t.Errorf("NewAlertPolicy() of missing source = %v: %s", code, body)
+	}
+
+	// Disabling the throttle keeps the flap detection
+	if code, body := serve(s.UpdateAlertPolicy, "1", "1", `{"throttle":"0s"}`); code != http.StatusOK {
+		t.Fatalf("UpdateAlertPolicy() = %v: %s", code, body)
+	}
+	code, body = serve(s.AlertPolicyID, "1", "1", "")
+	want = `{"id":"1","sourceID":"1","rule":"cpu","throttle":"0s","flapWindow":"1h0m0s","flapChanges":4,"links":{"self":"/chronograf/v1/sources/1/policies/1"}}`
+	if code != http.StatusOK {
+		t.Fatalf("AlertPolicyID() = %v: %s", code, body)
+	}
+	if eq, _ := jsonEqual(body, want); !eq {
+		t.Errorf("AlertPolicyID() = %s, want %s", body, want)
+	}
+	if code, body := serve(s.UpdateAlertPolicy, "1", "1", `{"flapChanges":0}`); code != http.StatusUnprocessableEntity {
+		t.Errorf("UpdateAlertPolicy() leaving no limit = %v: %s", code, body)
+	}
+
+	if code, body := serve(s.RemoveAlertPolicy, "1", "1", ""); code != http.StatusNoContent {
+		t.Fatalf("RemoveAlertPolicy() = %v: %s", code, body)
+	}
+	if code, body := serve(s.AlertPolicyID, "1", "1", ""); code != http.StatusNotFound {
+		t.Errorf("AlertPolicyID() after RemoveAlertPolicy() = %v: %s", code, body)
+	}
+	code, body = serve(s.AlertPolicies, "1", "", "")
+	want = `{"links":{"self":"/chronograf/v1/sources/1/policies"},"policies":[]}`
+	if eq, _ := jsonEqual(body, want); code != http.StatusOK || !eq {
+		t.Errorf("AlertPolicies() = %v %s, want %s", code, body, want)
+	}
+}
+
+func TestAlertThrottler_Suppress(t *testing.T) {
+	type alert struct {
+		after time.Duration // after is the time since the first alert
+		id    string
+		level string
+		want  string
+	}
+	tests := []struct {
+		name   string
+		policy chronograf.AlertPolicy
+		alerts []alert
+	}{
+		{
+			name:   "Throttles each alert ID",
+			policy: chronograf.AlertPolicy{Throttle: 10 * time.Minute},
+			alerts: []alert{
+				{0, "cpu:host=a", "CRITICAL", ""},
+				{time.Minute, "cpu:host=a", "OK", alertThrottled},
+				{2 * time.Minute, "cpu:host=b", "CRITICAL", ""},
+				{10 * time.Minute, "cpu:host=a", "CRITICAL", ""},
+				{15 * time.Minute, "cpu:host=a", "OK", alertThrottled},
+			},
+		},
+		{
+			name:   "Flapping starts and stops",
+			policy: chronograf.AlertPolicy{FlapWindow: 10 * time.Minute, FlapChanges: 3},
+			alerts: []alert{
+				{0, "cpu", "OK", ""},
+				{time.Minute, "cpu", "CRITICAL", ""},
+				{2 * time.Minute, "cpu", "OK", ""},
+				{3 * time.Minute, "cpu", "CRITICAL", alertFlapping},
+				{4 * time.Minute, "cpu", "OK", alertFlapping},
+				{5 * time.Minute, "cpu", "CRITICAL", alertFlapping},
+				{12 * time.Minute, "cpu", "CRITICAL", alertFlapping},
+				{14 * time.Minute, "cpu", "CRITICAL", ""},
+			},
+		},
+		{
+			name:   "Alerts at the same level do not flap",
+			policy: chronograf.AlertPolicy{FlapWindow: 10 * time.Minute, FlapChanges: 2},
+			alerts: []alert{
+				{0, "cpu", "CRITICAL", ""},
+				{time.Minute, "cpu", "CRITICAL", ""},
+				{2 * time.Minute, "cpu", "CRITICAL", ""},
+			},
+		},
+	}
+	start := time.Date(2026, 10, 6, 12, 0, 0, 0, time.UTC)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			throttler := NewAlertThrottler()
+			for i, a := range tt.alerts {
+				if got := throttler.Suppress(&tt.policy, 1, a.id, a.level, start.Add(a.after)); got != a.want {
+					t.Errorf("Suppress() of alert %d = %q, want %q", i, got, a.want)
+				}
+			}
+		})
+	}
+}
+
+func TestAlertThrottler_sweep(t *testing.T) {
+	throttler := NewAlertThrottler()
+	p := &chronograf.AlertPolicy{Throttle: time.Minute}
+	start := time.Date(2026, 10, 6, 12, 0, 0, 0, time.UTC)
+	throttler.Suppress(p, 1, "cpu", "CRITICAL", start)
+	throttler.Suppress(p, 1, "disk", "CRITICAL", start.Add(time.Hour))
+	if len(throttler.alerts) != 1 {
+		t.Errorf("Suppress() kept %d alerts, want the unexpired one", len(throttler.alerts))
+	}
+}
@@ -0,0 +1,315 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Types of alert webhooks. The payloads of webhooks of type webhook are
+// rendered by their templates; the other types post the alerts as messages
+// in the format of their service.
+const (
+	alertWebhookTypeWebhook    = "webhook"
+	alertWebhookTypeTeams      = "teams"
+	alertWebhookTypeDiscord    = "discord"
+	alertWebhookTypeGoogleChat = "googlechat"
+	alertWebhookTypePagerDuty  = "pagerduty"
+)
+
+// pagerDutyEventsURL is the endpoint of the PagerDuty Events API v2, the URL
+// of webhooks of type pagerduty without one
+const pagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+
+// pagerDutyRoutingKey matches the 32 character integration keys of PagerDuty
+var pagerDutyRoutingKey = regexp.MustCompile(`^[0-9A-Za-z]{32}$`)
+
+// pagerDutySeverities map the alert levels to the severities of PagerDuty;
+// alerts of level OK resolve the incidents of their ID
+var pagerDutySeverities = map[string]string{
+	"CRITICAL": "critical",
+	"WARNING":  "warning",
+	"INFO":     "info",
+}
+
+// alertWebhookType is the type of a webhook, webhook when it has none
+func alertWebhookType(h chronograf.AlertWebhook) string {
+	if h.Type == "" {
+		return alertWebhookTypeWebhook
+	}
+	return h.Type
+}
+
+// alertLevelColors are the RGB colors of the messages of the alert levels
+var alertLevelColors = map[string]int{
+	"CRITICAL": 0xDC4E58,
+	"WARNING":  0xFFB94A,
+	"INFO":     0x22ADF6,
+	"OK":       0x4ED8A0,
+}
+
+// alertLevelColor is the color of the messages of an alert level; unknown
+// levels are grey
+func alertLevelColor(level string) int {
+	if c, ok := alertLevelColors[level]; ok {
+		return c
+	}
+	return 0x999DAB
+}
+
+// alertMessageTitle is the title of the chat messages of an alert
+func alertMessageTitle(data alertWebhookData) string {
+	return fmt.Sprintf("[%s] %s", data.Level, data.ID)
+}
+
+// alertMessageText is the message of an alert, its ID if it has none
+func alertMessageText(data alertWebhookData) string {
+	if data.Message != "" {
+		return data.Message
+	}
+	return data.ID
+}
+
+// sortedAlertTags returns the tag keys of an alert in order, so that the
+// facts of the messages of an alert are listed in the same order every time
+func sortedAlertTags(data alertWebhookData) []string {
+	keys := make([]string, 0, len(data.Tags))
+	for k := range data.Tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// teamsPayload is an Office 365 connector card posted to an incoming webhook
+// of Microsoft Teams
+func teamsPayload(data alertWebhookData) interface{} {
+	type fact struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	}
+	facts := []fact{}
+	for _, k := range sortedAlertTags(data) {
+		facts = append(facts, fact{Name: k, Value: data.Tags[k]})
+	}
+	return map[string]interface{}{
+		"@type":      "MessageCard",
+		"@context":   "https://schema.org/extensions",
+		"themeColor": fmt.Sprintf("%06X", alertLevelColor(data.Level)),
+		"summary":    alertMessageTitle(data),
+		"title":      alertMessageTitle(data),
+		"text":       alertMessageText(data),
+		"sections": []interface{}{
+			map[string]interface{}{"facts": facts},
+		},
+	}
+}
+
+// discordPayload is a message with an embed posted to a webhook of a Discord
+// channel
+func discordPayload(data alertWebhookData) interface{} {
+	type field struct {
+		Name   string `json:"name"`
+		Value  string `json:"value"`
+		Inline bool   `json:"inline"`
+	}
+	fields := []field{}
+	for _, k := range sortedAlertTags(data) {
+		fields = append(fields, field{Name: k, Value: data.Tags[k], Inline: true})
+	}
+	return map[string]interface{}{
+		"embeds": []interface{}{
+			map[string]interface{}{
+				"title":       alertMessageTitle(data),
+				"description": alertMessageText(data),
+				"color":       alertLevelColor(data.Level),
+				"timestamp":   data.Time,
+				"fields":      fields,
+			},
+		},
+	}
+}
+
+// googleChatPayload is a text message posted to an incoming webhook of a
+// Google Chat space
+func googleChatPayload(data alertWebhookData) interface{} {
+	text := fmt.Sprintf("*%s*\n%s", alertMessageTitle(data), alertMessageText(data))
+	return map[string]string{"text": text}
+}
+
+// pagerDutyRoutingKeyOf returns the routing key of an alert: the key of its
+// rule if the webhook has one, and the key of the webhook otherwise
+func pagerDutyRoutingKeyOf(h chronograf.AlertWebhook, alertID string) string {
+	key, matched := h.RoutingKey, ""
+	for rule, k := range h.RoutingKeys {
+		// Keys of rules are chosen in the same order every time
+		if alertRuleMatches(rule, alertID) && len(rule) > len(matched) {
+			key, matched = k, rule
+		}
+	}
+	return key
+}
+
+// pagerDutyPayload is an event of the PagerDuty Events API v2. Alerts are
+// deduplicated by their ID, so that an alert of level OK resolves the
+// incident the alerts of the same ID triggered.
+func pagerDutyPayload(h chronograf.AlertWebhook, data alertWebhookData) interface{} {
+	event := map[string]interface{}{
+		"routing_key": pagerDutyRoutingKeyOf(h, data.ID),
+		"dedup_key":   data.ID,
+		"client":      "Chronograf",
+	}
+	if data.Level == "OK" {
+		event["event_action"] = "resolve"
+		return event
+	}
+
+	severity, ok := pagerDutySeverities[data.Level]
+	if !ok {
+		severity = "error"
+	}
+	source := data.Tags["host"]
+	if source == "" {
+		source = data.Name
+	}
+	if source == "" {
+		source = "chronograf"
+	}
+	summary := alertMessageText(data)
+	// PagerDuty rejects summaries longer than 1024 characters
+	if len(summary) > 1024 {
+		summary = summary[:1021] + "..."
+	}
+	event["event_action"] = "trigger"
+	event["payload"] = map[string]interface{}{
+		"summary":   summary,
+		"source":    source,
+		"severity":  severity,
+		"timestamp": data.Time,
+		"component": data.Name,
+		"custom_details": map[string]interface{}{
+			"details": data.Details,
+			"tags":    data.Tags,
+			"fields":  data.Fields,
+		},
+	}
+	return event
+}
+
+// alertWebhookPayload renders the payload of an alert for a webhook
+func alertWebhookPayload(h chronograf.AlertWebhook, data alertWebhookData) ([]byte, error) {
+	var msg interface{}
+	switch alertWebhookType(h) {
+	case alertWebhookTypeTeams:
+		msg = teamsPayload(data)
+	case alertWebhookTypeDiscord:
+		msg = discordPayload(data)
+	case alertWebhookTypeGoogleChat:
+		msg = googleChatPayload(data)
+	case alertWebhookTypePagerDuty:
+		msg = pagerDutyPayload(h, data)
+	case alertWebhookTypeWebhook:
+		tmpl, err := parseAlertWebhookTemplate(h.Template)
+		if err != nil {
+			return nil, err
+		}
+		var payload bytes.Buffer
+		if err := tmpl.Execute(&payload, data); err != nil {
+			return nil, err
+		}
+		return payload.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("unknown webhook type %s", h.Type)
+	}
+	return json.Marshal(msg)
+}
+
+// alertWebhookHosts are the hosts of the webhooks of the chat services with
+// a fixed host, and the path of their webhooks
+var alertWebhookHosts = map[string]struct {
+	hosts []string
+	path  string
+}{
+	alertWebhookTypeDiscord:    {hosts: []string{"discord.com", "discordapp.com"}, path: "/api/webhooks/"},
+	alertWebhookTypeGoogleChat: {hosts: []string{"chat.googleapis.com"}, path: "/v1/spaces/"},
+}
+
+// validAlertWebhookType checks the type of a webhook and the fields that
+// depend on it. Messages of chat services and events of PagerDuty are neither
+// templated nor signed, and are posted to https URLs.
+func validAlertWebhookType(h *chronograf.AlertWebhook, u *url.URL) error {
+	typ := alertWebhookType(*h)
+	switch typ {
+	case alertWebhookTypeWebhook:
+		if h.RoutingKey != "" || len(h.RoutingKeys) > 0 {
+			return errorf("only webhooks of type pagerduty have routing keys")
+		}
+		return nil
+	case alertWebhookTypeTeams, alertWebhookTypeDiscord, alertWebhookTypeGoogleChat:
+		if h.RoutingKey != "" || len(h.RoutingKeys) > 0 {
+			return errorf("only webhooks of type pagerduty have routing keys")
+		}
+	case alertWebhookTypePagerDuty:
+		if err := validPagerDutyRoutingKeys(h); err != nil {
+			return err
+		}
+	default:
+		return errorf("unknown webhook type %s; valid types are webhook, teams, discord, googlechat and pagerduty", h.Type)
+	}
+	if h.Template != "" {
+		return errorf("webhooks of type %s have no template", typ)
+	}
+	if h.Secret != "" {
+		return errorf("webhooks of type %s are not signed", typ)
+	}
+	if u.Scheme != "https" {
+		return errorf("url of webhooks of type %s must be an https URL", typ)
+	}
+	if known, ok := alertWebhookHosts[typ]; ok {
+		for _, host := range known.hosts {
+			if strings.EqualFold(u.Hostname(), host) && strings.HasPrefix(u.Path, known.path) {
+				return nil
+			}
+		}
+		return errorf("url of webhooks of type %s must be https://%s%s...", typ, known.hosts[0], known.path)
+	}
+	return nil
+}
+
+// validPagerDutyRoutingKeys checks that every alert a webhook of type
+// pagerduty relays has a routing key
+func validPagerDutyRoutingKeys(h *chronograf.AlertWebhook) error {
+	if h.RoutingKey != "" && !pagerDutyRoutingKey.MatchString(h.RoutingKey) {
+		return errorf("routing key must be the 32 character integration key of a PagerDuty service")
+	}
+	relayed := map[string]bool{}
+	for _, rule := range h.Rules {
+		relayed[rule] = true
+	}
+	for rule, key := range h.RoutingKeys {
+		if len(h.Rules) > 0 && !relayed[rule] {
+			return errorf("routing key of rule %s whose alerts are not relayed", rule)
+		}
+		if !pagerDutyRoutingKey.MatchString(key) {
+			return errorf("routing key of rule %s must be the 32 character integration key of a PagerDuty service", rule)
+		}
+	}
+	if h.RoutingKey != "" {
+		return nil
+	}
+	if len(h.Rules) == 0 {
+		return errorf("routing key required on webhooks of type pagerduty relaying all alerts")
+	}
+	for _, rule := range h.Rules {
+		if _, ok := h.RoutingKeys[rule]; !ok {
+			return errorf("routing key required for the alerts of rule %s", rule)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+package server
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func Test_alertWebhookPayload(t *testing.T) {
+	data := alertWebhookData{
+		ID:      "cpu:host=a",
+		Message: "cpu is high",
+		Level:   "CRITICAL",
+		Time:    time.Date(2026, 10, 6, 12, 0, 0, 0, time.UTC),
+		Name:    "cpu",
+		Tags:    map[string]string{"host": "a", "dc": "west"},
+		Fields:  map[string]interface{}{"usage_user": 97.5},
+	}
+	tests := []struct {
+		name    string
+		hook    chronograf.AlertWebhook
+		data    *alertWebhookData
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Webhook without type",
+			hook: chronograf.AlertWebhook{Template: `{"summary": {{ json .Message }}}`},
+			want: `{"summary": "cpu is high"}`,
+		},
+		{
+			name: "Microsoft Teams",
+			hook: chronograf.AlertWebhook{Type: "teams"},
+			want: `{"@context":"https://schema.org/extensions","@type":"MessageCard","sections":[{"facts":[{"name":"dc","value":"west"},{"name":"host","value":"a"}]}],"summary":"[CRITICAL] cpu:host=a","text":"cpu is high","themeColor":"DC4E58","title":"[CRITICAL] cpu:host=a"}`,
+		},
+		{
+			name: "Discord",
+			hook: chronograf.AlertWebhook{Type: "discord"},
+			want: `{"embeds":[{"color":14437976,"description":"cpu is high","fields":[{"name":"dc","value":"west","inline":true},{"name":"host","value":"a","inline":true}],"timestamp":"2026-10-06T12:00:00Z","title":"[CRITICAL] cpu:host=a"}]}`,
+		},
+		{
+			name: "Google Chat",
+			hook: chronograf.AlertWebhook{Type: "googlechat"},
+			want: `{"text":"*[CRITICAL] cpu:host=a*\ncpu is high"}`,
+		},
+		{
+			name: "PagerDuty",
+			hook: chronograf.AlertWebhook{Type: "pagerduty", RoutingKey: "all", RoutingKeys: map[string]string{"mem": "mem"}},
+			want: `{"client":"Chronograf","dedup_key":"cpu:host=a","event_action":"trigger","payload":{"component":"cpu","custom_details":{"details":"","fields":{"usage_user":97.5},"tags":{"dc":"west","host":"a"}},"severity":"critical","source":"a","summary":"cpu is high","timestamp":"2026-10-06T12:00:00Z"},"routing_key":"all"}`,
+		},
+		{
+			name: "PagerDuty routing key of the rule",
+			hook: chronograf.AlertWebhook{Type: "pagerduty", RoutingKey: "all", RoutingKeys: map[string]string{"cpu": "cpu"}},
+			want: `{"client":"Chronograf","dedup_key":"cpu:host=a","event_action":"trigger","payload":{"component":"cpu","custom_details":{"details":"","fields":{"usage_user":97.5},"tags":{"dc":"west","host":"a"}},"severity":"critical","source":"a","summary":"cpu is high","timestamp":"2026-10-06T12:00:00Z"},"routing_key":"cpu"}`,
+		},
+		{
+			name: "PagerDuty recovery",
+			hook: chronograf.AlertWebhook{Type: "pagerduty", RoutingKey: "all"},
+			data: &alertWebhookData{ID: "cpu:host=a", Level: "OK"},
+			want: `{"client":"Chronograf","dedup_key":"cpu:host=a","event_action":"resolve","routing_key":"all"}`,
+		},
+		{
+			name:    "Unknown type",
+			hook:    chronograf.AlertWebhook{Type: "slack"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := data
+			if tt.data != nil {
+				data = *tt.data
+			}
+			got, err := alertWebhookPayload(tt.hook, data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("%q. alertWebhookPayload() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if string(got) != tt.want {
+				t.Errorf("%q. alertWebhookPayload() = %s, want %s", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_validAlertWebhookType(t *testing.T) {
+	const key = "0123456789abcdef0123456789abcdef"
+	tests := []struct {
+		name    string
+		hook    chronograf.AlertWebhook
+		wantErr bool
+	}{
+		{
+			name: "Webhook",
+			hook: chronograf.AlertWebhook{URL: "http://incidents.example.com", Template: `{{ json . }}`, Secret: "doody"},
+		},
+		{
+			name: "Microsoft Teams",
+			hook: chronograf.AlertWebhook{Type: "teams", URL: "https://example.webhook.office.com/webhookb2/1"},
+		},
+		{
+			name: "Discord",
+			hook: chronograf.AlertWebhook{Type: "discord", URL: "https://discord.com/api/webhooks/1/token"},
+		},
+		{
+			name: "Google Chat",
+			hook: chronograf.AlertWebhook{Type: "googlechat", URL: "https://chat.googleapis.com/v1/spaces/AAAA/messages?key=k&token=t"},
+		},
+		{
+			name:    "Unknown type",
+			hook:    chronograf.AlertWebhook{Type: "slack", URL: "https://hooks.slack.com/services/1"},
+			wantErr: true,
+		},
+		{
+			name:    "Chat service over http",
+			hook:    chronograf.AlertWebhook{Type: "teams", URL: "http://example.webhook.office.com/webhookb2/1"},
+			wantErr: true,
+		},
+		{
+			name:    "Chat service with a template",
+			hook:    chronograf.AlertWebhook{Type: "teams", URL: "https://example.webhook.office.com/webhookb2/1", Template: `{{ json . }}`},
+			wantErr: true,
+		},
+		{
+			name:    "Chat service with a secret",
+			hook:    chronograf.AlertWebhook{Type: "googlechat", URL: "https://chat.googleapis.com/v1/spaces/AAAA/messages", Secret: "doody"},
+			wantErr: true,
+		},
+		{
+			name: "PagerDuty",
+			hook: chronograf.AlertWebhook{Type: "pagerduty", URL: "https://events.pagerduty.com/v2/enqueue", RoutingKey: key},
+		},
+		{
+			name: "PagerDuty with routing keys of every rule",
+			hook: chronograf.AlertWebhook{Type: "pagerduty", URL: "https://events.pagerduty.com/v2/enqueue", Rules: []string{"cpu", "disk"}, RoutingKeys: map[string]string{"cpu": key, "disk": key}},
+		},
+		{
+			name:    "PagerDuty without routing key",
+			hook:    chronograf.AlertWebhook{Type: "pagerduty", URL: "https://events.pagerduty.com/v2/enqueue"},
+			wantErr: true,
+		},
+		{
+			name:    "PagerDuty without routing key of a rule",
+			hook:    chronograf.AlertWebhook{Type: "pagerduty", URL: "https://events.pagerduty.com/v2/enqueue", Rules: []string{"cpu", "disk"}, RoutingKeys: map[string]string{"cpu": key}},
+			wantErr: true,
+		},
+		{
+			name:    "PagerDuty routing key of a rule not relayed",
+			hook:    chronograf.AlertWebhook{Type: "pagerduty", URL: "https://events.pagerduty.com/v2/enqueue", RoutingKey: key, Rules: []string{"cpu"}, RoutingKeys: map[string]string{"disk": key}},
+			wantErr: true,
+		},
+		{
+			name:    "PagerDuty invalid routing key",
+			hook:    chronograf.AlertWebhook{Type: "pagerduty", URL: "https://events.pagerduty.com/v2/enqueue", RoutingKey: "doody"},
+			wantErr: true,
+		},
+		{
+			name:    "Routing key of a chat service",
+			hook:    chronograf.AlertWebhook{Type: "teams", URL: "https://example.webhook.office.com/webhookb2/1", RoutingKey: key},
+			wantErr: true,
+		},
+		{
+			name:    "Discord webhook of another host",
+			hook:    chronograf.AlertWebhook{Type: "discord", URL: "https://example.com/api/webhooks/1/token"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := url.Parse(tt.hook.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := validAlertWebhookType(&tt.hook, u); (err != nil) != tt.wantErr {
+				t.Errorf("%q. validAlertWebhookType() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,544 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+const (
+	// AlertWebhookSignatureHeader carries the HMAC-SHA256 of the payload of
+	// a webhook with a secret, hex encoded and prefixed with sha256=
+	AlertWebhookSignatureHeader = "X-Chronograf-Signature"
+	// defaultAlertWebhookTemplate renders all fields of an alert
+	defaultAlertWebhookTemplate = `{{ json . }}`
+	// alertWebhookTimeout bounds the delivery of an alert to a webhook
+	alertWebhookTimeout = 10 * time.Second
+)
+
+// alertWebhookFuncs are the functions available to payload templates
+var alertWebhookFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+}
+
+// alertWebhookData is the data of the payload templates of webhooks
+type alertWebhookData struct {
+	ID            string                 `json:"id"`
+	Message       string                 `json:"message"`
+	Details       string                 `json:"details"`
+	Level         string                 `json:"level"`
+	PreviousLevel string                 `json:"previousLevel"`
+	Time          time.Time              `json:"time"`
+	Duration      time.Duration          `json:"duration"`
+	Name          string                 `json:"name"`
+	Tags          map[string]string      `json:"tags"`
+	Fields        map[string]interface{} `json:"fields"`
+}
+
+// newAlertWebhookData flattens an alert of Kapacitor. The name, tags and
+// fields are those of the first point that triggered the alert.
+func newAlertWebhookData(a kapacitorAlert) alertWebhookData {
+	d := alertWebhookData{
+		ID:            a.ID,
+		Message:       a.Message,
+		Details:       a.Details,
+		Level:         a.Level,
+		PreviousLevel: a.PreviousLevel,
+		Time:          a.Time.UTC(),
+		Duration:      a.Duration,
+		Tags:          map[string]string{},
+		Fields:        map[string]interface{}{},
+	}
+	if len(a.Data.Series) == 0 {
+		return d
+	}
+	series := a.Data.Series[0]
+	d.Name = series.Name
+	for k, v := range series.Tags {
+		d.Tags[k] = v
+	}
+	if len(series.Values) > 0 {
+		for i, col := range series.Columns {
+			if col == "time" || i >= len(series.Values[0]) {
+				continue
+			}
+			d.Fields[col] = series.Values[0][i]
+		}
+	}
+	return d
+}
+
+// sampleAlertWebhookData validates templates and is sent by webhook tests
+func sampleAlertWebhookData() alertWebhookData {
+	return alertWebhookData{
+		ID:            "chronograf-test:host=server01",
+		Message:       "Test alert from Chronograf",
+		Details:       "This is a test alert sent by Chronograf",
+		Level:         "CRITICAL",
+		PreviousLevel: "OK",
+		Time:          time.Now().UTC(),
+		Name:          "cpu",
+		Tags:          map[string]string{"host": "server01"},
+		Fields:        map[string]interface{}{"usage_user": 95.5},
+	}
+}
+
+// testAlertWebhookData is the test alert of a webhook at a level. It is an
+// alert of the first rule of the webhook, so that the webhook would relay it
+// and events of PagerDuty carry the routing key of the rule.
+func testAlertWebhookData(h chronograf.AlertWebhook, level string) alertWebhookData {
+	data := sampleAlertWebhookData()
+	if len(h.Rules) > 0 {
+		data.ID = h.Rules[0] + ":host=server01"
+	}
+	data.Level = level
+	return data
+}
+
+// alertWebhookTestLevels are the levels of test alerts; a test alert of level
+// OK resolves the incident of a test alert of PagerDuty
+var alertWebhookTestLevels = map[string]bool{
+	"CRITICAL": true,
+	"WARNING":  true,
+	"INFO":     true,
+	"OK":       true,
+}
+
+// parseAlertWebhookTemplate parses the payload template of a webhook and
+// checks that it renders an alert as JSON
+func parseAlertWebhookTemplate(text string) (*template.Template, error) {
+	if text == "" {
+		text = defaultAlertWebhookTemplate
+	}
+	tmpl, err := template.New("payload").Funcs(alertWebhookFuncs).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %v", err)
+	}
+	var b bytes.Buffer
+	if err := tmpl.Execute(&b, sampleAlertWebhookData()); err != nil {
+		return nil, fmt.Errorf("invalid template: %v", err)
+	}
+	if !json.Valid(b.Bytes()) {
+		return nil, fmt.Errorf("invalid template: payload is not JSON")
+	}
+	return tmpl, nil
+}
+
+// alertRuleMatches reports whether an alert is one of a rule. A rule matches
+// alerts with its ID and the alerts of its groups, whose IDs are prefixed by
+// the rule ID and a colon.
+func alertRuleMatches(rule, alertID string) bool {
+	return alertID == rule || strings.HasPrefix(alertID, rule+":")
+}
+
+// alertWebhookMatches reports whether a webhook relays an alert. Webhooks
+// without rules relay all alerts.
+func alertWebhookMatches(h chronograf.AlertWebhook, alertID string) bool {
+	if len(h.Rules) == 0 {
+		return true
+	}
+	for _, rule := range h.Rules {
+		if alertRuleMatches(rule, alertID) {
+			return true
+		}
+	}
+	return false
+}
+
+// signAlertWebhook is the value of the signature header of a payload
+func signAlertWebhook(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// sendAlertWebhook posts the payload of an alert to a webhook
+func sendAlertWebhook(ctx context.Context, h chronograf.AlertWebhook, data alertWebhookData) error {
+	payload, err := alertWebhookPayload(h, data)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", h.URL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range h.Headers {
+		req.Header.Set(k, v)
+	}
+	if h.Secret != "" {
+		req.Header.Set(AlertWebhookSignatureHeader, signAlertWebhook(h.Secret, payload))
+	}
+
+	client := &http.Client{Timeout: alertWebhookTimeout}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("received status code %d from webhook", resp.StatusCode)
+	}
+	return nil
+}
+
+// relayAlert sends an alert to the matching webhooks of a source in the
+// background, unless the alert policy of its rule suppresses it. Failed
+// deliveries are logged and not retried.
+func (s *Service) relayAlert(ctx context.Context, sourceID int, a kapacitorAlert) {
+	if reason := s.suppressAlert(ctx, sourceID, a, time.Now()); reason != "" {
+		s.Logger.Info("Not relaying alert ", a.ID, " of source ", sourceID, ": ", reason)
+		return
+	}
+	hooks, err := s.Store.AlertWebhooks(ctx).All(ctx, sourceID)
+	if err != nil {
+		s.Logger.Error("Unable to list alert webhooks of source ", sourceID, ": ", err)
+		return
+	}
+	data := newAlertWebhookData(a)
+	for _, h := range hooks {
+		if !alertWebhookMatches(h, a.ID) {
+			continue
+		}
+		go func(h chronograf.AlertWebhook) {
+			if err := sendAlertWebhook(context.Background(), h, data); err != nil {
+				s.Logger.Error("Unable to relay alert ", a.ID, " to webhook ", h.Name, ": ", err)
+			}
+		}(h)
+	}
+}
+
+// removeAlertWebhooks deletes the webhooks of a removed source
+func (s *Service) removeAlertWebhooks(ctx context.Context, sourceID int) error {
+	hooks, err := s.Store.AlertWebhooks(ctx).All(ctx, sourceID)
+	if err != nil {
+		return err
+	}
+	for i := range hooks {
+		if err := s.Store.AlertWebhooks(ctx).Delete(ctx, &hooks[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type alertWebhookLinks struct {
+	Self string `json:"self"` // Self link mapping to this resource
+	Test string `json:"test"` // Test link to send a test alert to the webhook
+}
+
+// alertWebhookResponse leaves out the secret and the routing keys of a
+// webhook, and tells whether it has them
+type alertWebhookResponse struct {
+	ID              uint64            `json:"id,string"`
+	SourceID        int               `json:"sourceID,string"`
+	Name            string            `json:"name"`
+	Type            string            `json:"type"`
+	URL             string            `json:"url"`
+	Rules           []string          `json:"rules"`
+	Headers         map[string]string `json:"headers"`
+	Template        string            `json:"template"`
+	HasSecret       bool              `json:"hasSecret"`
+	HasRoutingKey   bool              `json:"hasRoutingKey"`
+	RoutingKeyRules []string          `json:"routingKeyRules"`
+	Links           alertWebhookLinks `json:"links"`
+}
+
+func newAlertWebhookResponse(h chronograf.AlertWebhook) alertWebhookResponse {
+	if h.Rules == nil {
+		h.Rules = []string{}
+	}
+	if h.Headers == nil {
+		h.Headers = map[string]string{}
+	}
+	keyRules := make([]string, 0, len(h.RoutingKeys))
+	for rule := range h.RoutingKeys {
+		keyRules = append(keyRules, rule)
+	}
+	sort.Strings(keyRules)
+	self := fmt.Sprintf("%s%d/webhooks/%d", sourceLinkPrefix, h.SourceID, h.ID)
+	return alertWebhookResponse{
+		ID:              h.ID,
+		SourceID:        h.SourceID,
+		Name:            h.Name,
+		Type:            alertWebhookType(h),
+		URL:             h.URL,
+		Rules:           h.Rules,
+		Headers:         h.Headers,
+		Template:        h.Template,
+		HasSecret:       h.Secret != "",
+		HasRoutingKey:   h.RoutingKey != "",
+		RoutingKeyRules: keyRules,
+		Links: alertWebhookLinks{
+			Self: self,
+			Test: self + "/test",
+		},
+	}
+}
+
+type alertWebhooksResponse struct {
+	Links    selfLinks              `json:"links"`
+	Webhooks []alertWebhookResponse `json:"webhooks"`
+}
+
+// alertWebhookRequest creates or updates a webhook. Fields left out of an
+// update keep their value; an empty secret stops signing the payloads. The
+// routing keys of webhooks of type pagerduty are the key of the alerts of
+// every rule and the keys of the alerts of single rules.
+type alertWebhookRequest struct {
+	Name        *string            `json:"name"`
+	Type        *string            `json:"type"`
+	URL         *string            `json:"url"`
+	Rules       *[]string          `json:"rules"`
+	Headers     *map[string]string `json:"headers"`
+	Template    *string            `json:"template"`
+	Secret      *string            `json:"secret"`
+	RoutingKey  *string            `json:"routingKey"`
+	RoutingKeys *map[string]string `json:"routingKeys"`
+}
+
+// apply sets the fields of the request on a webhook
+func (req *alertWebhookRequest) apply(h *chronograf.AlertWebhook) {
+	if req.Name != nil {
+		h.Name = *req.Name
+	}
+	if req.Type != nil {
+		h.Type = *req.Type
+	}
+	if req.URL != nil {
+		h.URL = *req.URL
+	}
+	if req.Rules != nil {
+		h.Rules = *req.Rules
+	}
+	if req.Headers != nil {
+		h.Headers = *req.Headers
+	}
+	if req.Template != nil {
+		h.Template = *req.Template
+	}
+	if req.Secret != nil {
+		h.Secret = *req.Secret
+	}
+	if req.RoutingKey != nil {
+		h.RoutingKey = *req.RoutingKey
+	}
+	if req.RoutingKeys != nil {
+		h.RoutingKeys = *req.RoutingKeys
+	}
+	if h.URL == "" && h.Type == alertWebhookTypePagerDuty {
+		h.URL = pagerDutyEventsURL
+	}
+}
+
+// validAlertWebhook checks a webhook before it is stored
+func validAlertWebhook(h *chronograf.AlertWebhook) error {
+	if h.Name == "" {
+		return errorf("name required on webhook request body")
+	}
+	u, err := url.Parse(h.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errorf("url must be an http or https URL")
+	}
+	if err := validAlertWebhookType(h, u); err != nil {
+		return err
+	}
+	for k := range h.Headers {
+		name := textproto.CanonicalMIMEHeaderKey(k)
+		if k == "" || strings.ContainsAny(k, " :\r\n") {
+			return errorf("invalid header name %q", k)
+		}
+		if name == "Content-Type" || name == AlertWebhookSignatureHeader {
+			return errorf("header %s is set by Chronograf", name)
+		}
+	}
+	if _, err := parseAlertWebhookTemplate(h.Template); err != nil {
+		return errorf("%v", err)
+	}
+	return nil
+}
+
+// alertWebhook retrieves the webhook of the route within the source of the
+// route
+func (s *Service) alertWebhook(ctx context.Context, r *http.Request) (*chronograf.AlertWebhook, error) {
+	src, err := s.alertEventsSource(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	param, _ := paramStr("wid", r)
+	wid, err := strconv.ParseUint(param, 10, 64)
+	if err != nil {
+		return nil, chronograf.ErrAlertWebhookNotFound
+	}
+	return s.Store.AlertWebhooks(ctx).Get(ctx, src.ID, wid)
+}
+
+// AlertWebhooks returns the webhooks relaying the alerts of a source
+func (s *Service) AlertWebhooks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	src, err := s.alertEventsSource(ctx, r)
+	if err != nil {
+		id, _ := paramStr("id", r)
+		notFound(w, id, s.Logger)
+		return
+	}
+	hooks, err := s.Store.AlertWebhooks(ctx).All(ctx, src.ID)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	res := alertWebhooksResponse{
+		Links: selfLinks{
+			Self: fmt.Sprintf("%s%d/webhooks", sourceLinkPrefix, src.ID),
+		},
+		Webhooks: []alertWebhookResponse{},
+	}
+	for _, h := range hooks {
+		res.Webhooks = append(res.Webhooks, newAlertWebhookResponse(h))
+	}
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// NewAlertWebhook creates a webhook relaying the alerts of a source. The
+// payload template of webhooks of type webhook is a Go template rendering
+// alertWebhookData as JSON.
+func (s *Service) NewAlertWebhook(w http.ResponseWriter, r *http.Request) {
+	var req alertWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	h := &chronograf.AlertWebhook{}
+	req.apply(h)
+	if err := validAlertWebhook(h); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	src, err := s.alertEventsSource(ctx, r)
+	if err != nil {
+		id, _ := paramStr("id", r)
+		notFound(w, id, s.Logger)
+		return
+	}
+	h.SourceID = src.ID
+	if h, err = s.Store.AlertWebhooks(ctx).Add(ctx, h); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	res := newAlertWebhookResponse(*h)
+	location(w, res.Links.Self)
+	encodeJSON(w, http.StatusCreated, res, s.Logger)
+}
+
+// AlertWebhookID returns a single webhook of a source
+func (s *Service) AlertWebhookID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	h, err := s.alertWebhook(ctx, r)
+	if err != nil {
+		wid, _ := paramStr("wid", r)
+		notFound(w, wid, s.Logger)
+		return
+	}
+	encodeJSON(w, http.StatusOK, newAlertWebhookResponse(*h), s.Logger)
+}
+
+// UpdateAlertWebhook changes the fields of a webhook given in the request
+func (s *Service) UpdateAlertWebhook(w http.ResponseWriter, r *http.Request) {
+	var req alertWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	h, err := s.alertWebhook(ctx, r)
+	if err != nil {
+		wid, _ := paramStr("wid", r)
+		notFound(w, wid, s.Logger)
+		return
+	}
+	req.apply(h)
+	if err := validAlertWebhook(h); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	if err := s.Store.AlertWebhooks(ctx).Update(ctx, h); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	encodeJSON(w, http.StatusOK, newAlertWebhookResponse(*h), s.Logger)
+}
+
+// RemoveAlertWebhook deletes a webhook of a source
+func (s *Service) RemoveAlertWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	h, err := s.alertWebhook(ctx, r)
+	if err != nil {
+		wid, _ := paramStr("wid", r)
+		notFound(w, wid, s.Logger)
+		return
+	}
+	if err := s.Store.AlertWebhooks(ctx).Delete(ctx, h); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type alertWebhookTestResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
+// TestAlertWebhook sends a test alert to a webhook of any type and reports
+// whether it was delivered. The level parameter sets the level of the test
+// alert, CRITICAL by default.
+func (s *Service) TestAlertWebhook(w http.ResponseWriter, r *http.Request) {
+	level := strings.ToUpper(r.URL.Query().Get("level"))
+	if level == "" {
+		level = "CRITICAL"
+	}
+	if !alertWebhookTestLevels[level] {
+		invalidData(w, errorf("level must be one of CRITICAL, WARNING, INFO or OK"), s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	h, err := s.alertWebhook(ctx, r)
+	if err != nil {
+		wid, _ := paramStr("wid", r)
+		notFound(w, wid, s.Logger)
+		return
+	}
+
+	res := alertWebhookTestResponse{Success: true}
+	if err := sendAlertWebhook(ctx, *h, testAlertWebhookData(*h, level)); err != nil {
+		res = alertWebhookTestResponse{Message: err.Error()}
+	}
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
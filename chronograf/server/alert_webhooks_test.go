@@ -0,0 +1,404 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+// alertWebhooksStore keeps the alert webhooks of the tests in memory
+func alertWebhooksStore(hooks *[]chronograf.AlertWebhook) *mocks.AlertWebhooksStore {
+	find := func(sourceID int, id uint64) int {
+		for i, h := range *hooks {
+			if h.SourceID == sourceID && h.ID == id {
+				return i
+			}
+		}
+		return -1
+	}
+	return &mocks.AlertWebhooksStore{
+		AllF: func(ctx context.Context, sourceID int) ([]chronograf.AlertWebhook, error) {
+			all := []chronograf.AlertWebhook{}
+			for _, h := range *hooks {
+				if h.SourceID == sourceID {
+					all = append(all, h)
+				}
+			}
+			return all, nil
+		},
+		AddF: func(ctx context.Context, h *chronograf.AlertWebhook) (*chronograf.AlertWebhook, error) {
+			h.ID = uint64(len(*hooks) + 1)
+			*hooks = append(*hooks, *h)
+			return h, nil
+		},
+		GetF: func(ctx context.Context, sourceID int, id uint64) (*chronograf.AlertWebhook, error) {
+			i := find(sourceID, id)
+			if i < 0 {
+				return nil, chronograf.ErrAlertWebhookNotFound
+			}
+			h := (*hooks)[i]
+			return &h, nil
+		},
+		UpdateF: func(ctx context.Context, h *chronograf.AlertWebhook) error {
+			i := find(h.SourceID, h.ID)
+			if i < 0 {
+				return chronograf.ErrAlertWebhookNotFound
+			}
+			(*hooks)[i] = *h
+			return nil
+		},
+		DeleteF: func(ctx context.Context, h *chronograf.AlertWebhook) error {
+			i := find(h.SourceID, h.ID)
+			if i < 0 {
+				return chronograf.ErrAlertWebhookNotFound
+			}
+			*hooks = append((*hooks)[:i], (*hooks)[i+1:]...)
+			return nil
+		},
+	}
+}
+
+func TestService_AlertWebhookWorkflow(t *testing.T) {
+	hooks := []chronograf.AlertWebhook{}
+	s := &Service{
+		Store: &mocks.Store{
+			SourcesStore: &mocks.SourcesStore{
+				GetF: func(ctx context.Context, ID int) (chronograf.Source, error) {
+					if ID != 1 {
+						return chronograf.Source{}, chronograf.ErrSourceNotFound
+					}
+					return chronograf.Source{ID: ID}, nil
+				},
+			},
+			AlertWebhooksStore: alertWebhooksStore(&hooks),
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+	serve := func(h func(http.ResponseWriter, *http.Request), id, wid, body string) (int, string) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "http://any.url", bytes.NewBufferString(body))
+		ctx := context.WithValue(r.Context(), httprouter.ParamsKey, httprouter.Params{
+			{Key: "id", Value: id},
+			{Key: "wid", Value: wid},
+		})
+		h(w, r.WithContext(ctx))
+		res, _ := ioutil.ReadAll(w.Result().Body)
+		return w.Code, string(res)
+	}
+
+	code, body := serve(s.NewAlertWebhook, "1", "", `{"name":"incidents","url":"https://incidents.example.com","rules":["cpu"],"headers":{"X-Team":"ops"},"template":"{\"summary\": {{ json .Message }}}","secret":"doody"}`)
+	want := `{"id":"1","sourceID":"1","name":"incidents","type":"webhook","url":"https://incidents.example.com","rules":["cpu"],"headers":{"X-Team":"ops"},"template":"{\"summary\": {{ json .Message }}}","hasSecret":true,"hasRoutingKey":false,"routingKeyRules":[],"links":{"self":"/chronograf/v1/sources/1/webhooks/1","test":"/chronograf/v1/sources/1/webhooks/1/test"}}`
+	if code != http.StatusCreated {
+		t.Fatalf("NewAlertWebhook() = %v, want %v: %s", code, http.StatusCreated, body)
+	}
+	if eq, _ := jsonEqual(body, want); !eq {
+		t.Errorf("NewAlertWebhook() = %s, want %s", body, want)
+	}
+	if hooks[0].Secret != "doody" {
+		t.Errorf("NewAlertWebhook() stored secret %q", hooks[0].Secret)
+	}
+
+	invalid := []struct {
+		name string
+		body string
+	}{
+		{"no name", `{"url":"https://example.com"}`},
+		{"not http", `{"name":"a","url":"ftp://example.com"}`},
+		{"unparsable template", `{"name":"a","url":"https://example.com","template":"{{ .Message "}`},
+		{"template not rendering JSON", `{"name":"a","url":"https://example.com","template":"{{ .Message }}"}`},
+		{"signature header", `{"name":"a","url":"https://example.com","headers":{"x-chronograf-signature":"1"}}`},
+		{"unknown type", `{"name":"a","type":"slack","url":"https://example.com"}`},
+		{"chat service with a template", `{"name":"a","type":"teams","url":"https://example.webhook.office.com/webhookb2/1","template":"{{ json . }}"}`},
+		{"routing key of a webhook", `{"name":"a","url":"https://example.com","routingKey":"0123456789abcdef0123456789abcdef"}`},
+		{"pagerduty without routing key", `{"name":"a","type":"pagerduty"}`},
+	}
+	for _, tt := range invalid {
+		if code, body := serve(s.NewAlertWebhook, "1", "", tt.body); code != http.StatusUnprocessableEntity {
+			t.Errorf("NewAlertWebhook() %s = %v, want %v: %s", tt.name, code, http.StatusUnprocessableEntity, body)
+		}
+	}
+	if code, body := serve(s.NewAlertWebhook, "2", "", `{"name":"a","url":"https://example.com"}`); code != http.StatusNotFound {
+		t.Errorf("NewAlertWebhook() of missing source = %v: %s", code, body)
+	}
+
+	// Webhooks of PagerDuty post to the Events API unless given a URL, and
+	// leave out their routing keys
+	code, body = serve(s.NewAlertWebhook, "1", "", `{"name":"on call","type":"pagerduty","rules":["cpu","disk"],"routingKey":"0123456789abcdef0123456789abcdef","routingKeys":{"disk":"fedcba9876543210fedcba9876543210"}}`)
+	want = `{"id":"2","sourceID":"1","name":"on call","type":"pagerduty","url":"https://events.pagerduty.com/v2/enqueue","rules":["cpu","disk"],"headers":{},"template":"","hasSecret":false,"hasRoutingKey":true,"routingKeyRules":["disk"],"links":{"self":"/chronograf/v1/sources/1/webhooks/2","test":"/chronograf/v1/sources/1/webhooks/2/test"}}`
+	if code != http.StatusCreated {
+		t.Fatalf("NewAlertWebhook() of pagerduty = %v, want %v: %s", code, http.StatusCreated, body)
+	}
+	if eq, _ := jsonEqual(body, want); !eq {
+		t.Errorf("NewAlertWebhook() of pagerduty = %s, want %s", body, want)
+	}
+	if hooks[1].RoutingKeys["disk"] != "fedcba9876543210fedcba9876543210" {
+		t.Errorf("NewAlertWebhook() stored routing keys %v", hooks[1].RoutingKeys)
+	}
+	// Relaying only the alerts of a rule without a key leaves them without one
+	if code, body := serve(s.UpdateAlertWebhook, "1", "2", `{"routingKey":""}`); code != http.StatusUnprocessableEntity {
+		t.Errorf("UpdateAlertWebhook() removing the routing key of cpu = %v: %s", code, body)
+	}
+	if code, body := serve(s.UpdateAlertWebhook, "1", "2", `{"rules":["disk"],"routingKey":""}`); code != http.StatusOK {
+		t.Errorf("UpdateAlertWebhook() relaying the rule with a routing key = %v: %s", code, body)
+	}
+	if code, body := serve(s.RemoveAlertWebhook, "1", "2", ""); code != http.StatusNoContent {
+		t.Fatalf("RemoveAlertWebhook() of pagerduty = %v: %s", code, body)
+	}
+
+	// Removing the secret keeps the other fields
+	if code, body := serve(s.UpdateAlertWebhook, "1", "1", `{"secret":""}`); code != http.StatusOK {
+		t.Fatalf("UpdateAlertWebhook() = %v: %s", code, body)
+	}
+	code, body = serve(s.AlertWebhookID, "1", "1", "")
+	want = `{"id":"1","sourceID":"1","name":"incidents","type":"webhook","url":"https://incidents.example.com","rules":["cpu"],"headers":{"X-Team":"ops"},"template":"{\"summary\": {{ json .Message }}}","hasSecret":false,"hasRoutingKey":false,"routingKeyRules":[],"links":{"self":"/chronograf/v1/sources/1/webhooks/1","test":"/chronograf/v1/sources/1/webhooks/1/test"}}`
+	if code != http.StatusOK {
+		t.Fatalf("AlertWebhookID() = %v: %s", code, body)
+	}
+	if eq, _ := jsonEqual(body, want); !eq {
+		t.Errorf("AlertWebhookID() = %s, want %s", body, want)
+	}
+	if code, body := serve(s.UpdateAlertWebhook, "1", "1", `{"url":"incidents"}`); code != http.StatusUnprocessableEntity {
+		t.Errorf("UpdateAlertWebhook() of invalid url = %v: %s", code, body)
+	}
+
+	if code, body := serve(s.RemoveAlertWebhook, "1", "1", ""); code != http.StatusNoContent {
+		t.Fatalf("RemoveAlertWebhook() = %v: %s", code, body)
+	}
+	if code, body := serve(s.AlertWebhookID, "1", "1", ""); code != http.StatusNotFound {
+		t.Errorf("AlertWebhookID() after RemoveAlertWebhook() = %v: %s", code, body)
+	}
+	code, body = serve(s.AlertWebhooks, "1", "", "")
+	want = `{"links":{"self":"/chronograf/v1/sources/1/webhooks"},"webhooks":[]}`
+	if eq, _ := jsonEqual(body, want); code != http.StatusOK || !eq {
+		t.Errorf("AlertWebhooks() = %v %s, want %s", code, body, want)
+	}
+}
+
+func TestService_RelayAlert(t *testing.T) {
+	type delivery struct {
+		header http.Header
+		body   string
+	}
+	deliveries := make(chan delivery, 2)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		deliveries <- delivery{header: r.Header, body: string(b)}
+	}))
+	defer ts.Close()
+
+	events := []chronograf.AlertEvent{}
+	hooks := []chronograf.AlertWebhook{
+		{
+			ID:       1,
+			SourceID: 1,
+			Name:     "incidents",
+			URL:      ts.URL,
+			Rules:    []string{"cpu"},
+			Headers:  map[string]string{"X-Team": "ops"},
+			Template: `{"summary": {{ json .Message }}, "host": {{ json .Tags.host }}, "usage": {{ .Fields.usage_user }}}`,
+			Secret:   "doody",
+		},
+		{
+			ID:       2,
+			SourceID: 1,
+			Name:     "disk only",
+			URL:      ts.URL,
+			Rules:    []string{"disk"},
+		},
+	}
+	s := &Service{
+		Store: &mocks.Store{
+			SourcesStore: &mocks.SourcesStore{
+				GetF: func(ctx context.Context, ID int) (chronograf.Source, error) {
+					return chronograf.Source{ID: ID}, nil
+				},
+			},
+			AlertEventsStore:   alertEventsStore(&events),
+			AlertWebhooksStore: alertWebhooksStore(&hooks),
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("POST", "http://any.url", bytes.NewBufferString(`{"id":"cpu:host=a","message":"cpu is high","time":"2026-10-06T12:00:00Z","level":"CRITICAL","previousLevel":"OK","data":{"series":[{"name":"cpu","tags":{"host":"a"},"columns":["time","usage_user"],"values":[["2026-10-06T12:00:00Z",97.5]]}]}}`))
+	r = r.WithContext(context.WithValue(r.Context(), httprouter.ParamsKey, httprouter.Params{{Key: "id", Value: "1"}}))
+	s.NewAlertEvent(w, r)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("NewAlertEvent() = %v, want %v", w.Code, http.StatusCreated)
+	}
+
+	var got delivery
+	select {
+	case got = <-deliveries:
+	case <-time.After(5 * time.Second):
+		t.Fatal("alert was not relayed to the webhook")
+	}
+	want := `{"summary": "cpu is high", "host": "a", "usage": 97.5}`
+	if diff := cmp.Diff(got.body, want); diff != "" {
+		t.Errorf("relayed payload diff (-got +want):\n%s", diff)
+	}
+	if sig := got.header.Get(AlertWebhookSignatureHeader); sig != signAlertWebhook("doody", []byte(want)) {
+		t.Errorf("relayed signature = %q", sig)
+	}
+	if got.header.Get("X-Team") != "ops" || got.header.Get("Content-Type") != "application/json" {
+		t.Errorf("relayed headers = %v", got.header)
+	}
+	select {
+	case d := <-deliveries:
+		t.Errorf("alert relayed to a webhook of another rule: %s", d.body)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestService_TestAlertWebhook(t *testing.T) {
+	var got string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		got = string(b)
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer ts.Close()
+
+	const key = "0123456789abcdef0123456789abcdef"
+	tests := []struct {
+		name    string
+		hook    chronograf.AlertWebhook
+		level   string
+		code    int
+		success bool
+		want    []string
+	}{
+		{
+			name:    "Webhook",
+			hook:    chronograf.AlertWebhook{URL: ts.URL, Template: `{"summary": {{ json .Message }}, "level": {{ json .Level }}}`},
+			code:    http.StatusOK,
+			success: true,
+			want:    []string{`{"summary": "Test alert from Chronograf", "level": "CRITICAL"}`},
+		},
+		{
+			name:    "Microsoft Teams",
+			hook:    chronograf.AlertWebhook{Type: "teams", URL: ts.URL},
+			level:   "warning",
+			code:    http.StatusOK,
+			success: true,
+			want:    []string{`"@type":"MessageCard"`, `"title":"[WARNING] chronograf-test:host=server01"`},
+		},
+		{
+			name:    "Discord",
+			hook:    chronograf.AlertWebhook{Type: "discord", URL: ts.URL},
+			code:    http.StatusOK,
+			success: true,
+			want:    []string{`"embeds":[`, `"description":"Test alert from Chronograf"`},
+		},
+		{
+			name:    "Google Chat",
+			hook:    chronograf.AlertWebhook{Type: "googlechat", URL: ts.URL},
+			level:   "INFO",
+			code:    http.StatusOK,
+			success: true,
+			want:    []string{`{"text":"*[INFO] chronograf-test:host=server01*\nTest alert from Chronograf"}`},
+		},
+		{
+			name:    "PagerDuty routing key of the first rule",
+			hook:    chronograf.AlertWebhook{Type: "pagerduty", URL: ts.URL, Rules: []string{"disk"}, RoutingKeys: map[string]string{"disk": key}},
+			code:    http.StatusOK,
+			success: true,
+			want:    []string{`"routing_key":"` + key + `"`, `"dedup_key":"disk:host=server01"`, `"event_action":"trigger"`, `"severity":"critical"`},
+		},
+		{
+			name:    "PagerDuty recovery",
+			hook:    chronograf.AlertWebhook{Type: "pagerduty", URL: ts.URL, RoutingKey: key},
+			level:   "OK",
+			code:    http.StatusOK,
+			success: true,
+			want:    []string{`"event_action":"resolve"`, `"dedup_key":"chronograf-test:host=server01"`},
+		},
+		{
+			name: "Rejected test alert",
+			hook: chronograf.AlertWebhook{Type: "teams", URL: ts.URL + "/fail"},
+			code: http.StatusOK,
+		},
+		{
+			name:  "Unknown level",
+			hook:  chronograf.AlertWebhook{URL: ts.URL},
+			level: "PANIC",
+			code:  http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got = ""
+			tt.hook.ID, tt.hook.SourceID, tt.hook.Name = 1, 1, "test"
+			hooks := []chronograf.AlertWebhook{tt.hook}
+			s := &Service{
+				Store: &mocks.Store{
+					SourcesStore: &mocks.SourcesStore{
+						GetF: func(ctx context.Context, ID int) (chronograf.Source, error) {
+							return chronograf.Source{ID: ID}, nil
+						},
+					},
+					AlertWebhooksStore: alertWebhooksStore(&hooks),
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url/chronograf/v1/sources/1/webhooks/1/test?level="+tt.level, nil)
+			r = r.WithContext(context.WithValue(r.Context(), httprouter.ParamsKey, httprouter.Params{
+				{Key: "id", Value: "1"},
+				{Key: "wid", Value: "1"},
+			}))
+			s.TestAlertWebhook(w, r)
+			if w.Code != tt.code {
+				t.Fatalf("%q. TestAlertWebhook() = %v, want %v: %s", tt.name, w.Code, tt.code, w.Body.String())
+			}
+			if tt.code != http.StatusOK {
+				return
+			}
+			var res alertWebhookTestResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+				t.Fatal(err)
+			}
+			if res.Success != tt.success {
+				t.Errorf("%q. TestAlertWebhook() success = %v, want %v: %s", tt.name, res.Success, tt.success, res.Message)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("%q. TestAlertWebhook() sent %s, want it to contain %s", tt.name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func Test_alertWebhookMatches(t *testing.T) {
+	tests := []struct {
+		rules   []string
+		alertID string
+		want    bool
+	}{
+		{nil, "cpu", true},
+		{[]string{"cpu"}, "cpu", true},
+		{[]string{"cpu"}, "cpu:host=a", true},
+		{[]string{"cpu"}, "cpu_idle", false},
+		{[]string{"mem", "cpu"}, "cpu:host=a,region=us", true},
+	}
+	for _, tt := range tests {
+		if got := alertWebhookMatches(chronograf.AlertWebhook{Rules: tt.rules}, tt.alertID); got != tt.want {
+			t.Errorf("alertWebhookMatches(%v, %q) = %v, want %v", tt.rules, tt.alertID, got, tt.want)
+		}
+	}
+}
@@ -31,21 +31,21 @@ func TestService_Annotations(t *testing.T) {
 			name: "error no id",
 			w:    httptest.NewRecorder(),
 			r:    httptest.NewRequest("GET", "/chronograf/v1/sources/1/annotations", bytes.NewReader([]byte(`howdy`))),
-			want: `{"code":422,"message":"error converting ID "}`,
+			want: `{"code":422,"message":"error converting ID ","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"error converting ID "}`,
 		},
 		{
 			name: "no since parameter",
 			ID:   "1",
 			w:    httptest.NewRecorder(),
 			r:    httptest.NewRequest("GET", "/chronograf/v1/sources/1/annotations", bytes.NewReader([]byte(`howdy`))),
-			want: `{"code":422,"message":"since parameter is required"}`,
+			want: `{"code":422,"message":"since parameter is required","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"since parameter is required"}`,
 		},
 		{
 			name: "invalid since parameter",
 			ID:   "1",
 			w:    httptest.NewRecorder(),
 			r:    httptest.NewRequest("GET", "/chronograf/v1/sources/1/annotations?since=howdy", bytes.NewReader([]byte(`howdy`))),
-			want: `{"code":422,"message":"parsing time \"howdy\" as \"2006-01-02T15:04:05.999Z07:00\": cannot parse \"howdy\" as \"2006\""}`,
+			want: `{"code":422,"message":"parsing time \"howdy\" as \"2006-01-02T15:04:05.999Z07:00\": cannot parse \"howdy\" as \"2006\"","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"parsing time \"howdy\" as \"2006-01-02T15:04:05.999Z07:00\": cannot parse \"howdy\" as \"2006\""}`,
 		},
 		{
 			name: "error is returned when get is an error",
@@ -61,7 +61,7 @@ func TestService_Annotations(t *testing.T) {
 			ID:   "1",
 			w:    httptest.NewRecorder(),
 			r:    httptest.NewRequest("GET", "/chronograf/v1/sources/1/annotations?since=1985-04-12T23:20:50.52Z", bytes.NewReader([]byte(`howdy`))),
-			want: `{"code":404,"message":"ID 1 not found"}`,
+			want: `{"code":404,"message":"ID 1 not found","type":"urn:chronograf:problem:not-found","title":"Not Found","status":404,"detail":"ID 1 not found"}`,
 		},
 		{
 			name: "error is returned connect is an error",
@@ -84,7 +84,7 @@ func TestService_Annotations(t *testing.T) {
 			ID:   "1",
 			w:    httptest.NewRecorder(),
 			r:    httptest.NewRequest("GET", "/chronograf/v1/sources/1/annotations?since=1985-04-12T23:20:50.52Z", bytes.NewReader([]byte(`howdy`))),
-			want: `{"code":400,"message":"unable to connect to source 1: error)"}`,
+			want: `{"code":400,"message":"unable to connect to source 1: error)","type":"urn:chronograf:problem:invalid-request","title":"Bad Request","status":400,"detail":"unable to connect to source 1: error)"}`,
 		},
 		{
 			name: "error returned when annotations are invalid",
@@ -110,7 +110,7 @@ func TestService_Annotations(t *testing.T) {
 			ID:   "1",
 			w:    httptest.NewRecorder(),
 			r:    httptest.NewRequest("GET", "/chronograf/v1/sources/1/annotations?since=1985-04-12T23:20:50.52Z", bytes.NewReader([]byte(`howdy`))),
-			want: `{"code":500,"message":"unknown error: error loading annotations: invalid character '[' looking for beginning of object key string"}`,
+			want: `{"code":500,"message":"unknown error: error loading annotations: invalid character '[' looking for beginning of object key string","type":"urn:chronograf:problem:internal-error","title":"Internal Server Error","status":500,"detail":"unknown error: error loading annotations: invalid character '[' looking for beginning of object key string"}`,
 		},
 		{
 			name: "error is returned connect is an error",
@@ -36,7 +36,7 @@ func AuthorizedToken(auth oauth2.Authenticator, logger chronograf.Logger, next h
 		principal, err := auth.Validate(ctx, r)
 		if err != nil {
 			log.Error("Invalid principal")
-			w.WriteHeader(http.StatusForbidden)
+			Error(w, http.StatusForbidden, "invalid principal", logger)
 			return
 		}
 
@@ -45,7 +45,7 @@ func AuthorizedToken(auth oauth2.Authenticator, logger chronograf.Logger, next h
 		principal, err = auth.Extend(ctx, w, principal)
 		if err != nil {
 			log.Error("Unable to extend principal")
-			w.WriteHeader(http.StatusForbidden)
+			Error(w, http.StatusForbidden, "unable to extend principal", logger)
 			return
 		}
 
@@ -122,6 +122,33 @@ func AuthorizedUser(
 			return
 		}
 
+		// API tokens have no principal; they act as a user with only the
+		// role of the token within the organization of the token.
+		if t, ok := hasTokenContext(ctx); ok {
+			if !tokenAllows(t, r.URL.Path) {
+				log.Error(fmt.Sprintf("API token %s is not scoped to this resource", t.ID))
+				Error(w, http.StatusForbidden, "Token is not authorized", logger)
+				return
+			}
+			_, err := store.Organizations(serverCtx).Get(serverCtx, chronograf.OrganizationQuery{ID: &t.Organization})
+			if err != nil {
+				log.Error(fmt.Sprintf("Failed to retrieve organization %s from organizations store", t.Organization))
+				Error(w, http.StatusForbidden, "Token is not authorized", logger)
+				return
+			}
+			u := tokenUser(t)
+			if !hasAuthorizedRole(u, role) {
+				Error(w, http.StatusForbidden, "Token is not authorized", logger)
+				return
+			}
+			ctx = context.WithValue(ctx, organizations.ContextKey, t.Organization)
+			ctx = context.WithValue(ctx, UserContextKey, u)
+			ctx = context.WithValue(ctx, roles.ContextKey, t.Role)
+			r = r.WithContext(ctx)
+			next(w, r)
+			return
+		}
+
 		p, err := getValidPrincipal(ctx)
 		if err != nil {
 			log.Error("Failed to retrieve principal from context")
@@ -148,7 +175,6 @@ func AuthorizedUser(
 			return
 		}
 		ctx = context.WithValue(ctx, organizations.ContextKey, p.Organization)
-		// TODO: seems silly to look up a user twice
 		u, err := store.Users(serverCtx).Get(serverCtx, chronograf.UserQuery{
 			Name:     &p.Subject,
 			Provider: &p.Issuer,
@@ -160,6 +186,11 @@ func AuthorizedUser(
 			Error(w, http.StatusForbidden, "User is not authorized", logger)
 			return
 		}
+		if u.Suspended() {
+			log.Error("User is suspended")
+			Error(w, http.StatusForbidden, "User is suspended", logger)
+			return
+		}
 		// In particular this is used by sever/users.go so that we know when and when not to
 		// allow users to make someone a super admin
 		ctx = context.WithValue(ctx, UserContextKey, u)
@@ -181,19 +212,19 @@ func AuthorizedUser(
 			return
 		}
 
-		u, err = store.Users(ctx).Get(ctx, chronograf.UserQuery{
-			Name:     &p.Subject,
-			Provider: &p.Issuer,
-			Scheme:   &scheme,
-		})
+		// The role of the user within the organization is the most privileged
+		// of their own role and the roles of the groups they are a member of
+		rs, err := effectiveRoles(serverCtx, store, u)
 		if err != nil {
-			log.Error("Failed to retrieve user")
+			log.Error(fmt.Sprintf("Failed to retrieve the groups of user %d: %v", u.ID, err))
 			Error(w, http.StatusForbidden, "User is not authorized", logger)
 			return
 		}
+		orgUser := *u
+		orgUser.Roles = rolesIn(rs, p.Organization)
 
-		if hasAuthorizedRole(u, role) {
-			if len(u.Roles) != 1 {
+		if hasAuthorizedRole(&orgUser, role) {
+			if len(orgUser.Roles) != 1 {
 				msg := `User %d has too many role in organization. User: %#v.Please report this log at https://github.com/influxdata/influxdb/chronograf/issues/new"`
 				log.Error(fmt.Sprint(msg, u.ID, u))
 				unknownErrorWithMessage(w, fmt.Errorf("please have administrator check logs and report error"), logger)
@@ -202,7 +233,7 @@ func AuthorizedUser(
 			// use the first role, since there should only ever be one
 			// for any particular organization and hasAuthorizedRole
 			// should ensure that at least one role for the org exists
-			ctx = context.WithValue(ctx, roles.ContextKey, u.Roles[0].Name)
+			ctx = context.WithValue(ctx, roles.ContextKey, orgUser.Roles[0].Name)
 			r = r.WithContext(ctx)
 			next(w, r)
 			return
@@ -66,6 +66,7 @@ func TestAuthorizedUser(t *testing.T) {
 	type fields struct {
 		UsersStore         chronograf.UsersStore
 		OrganizationsStore chronograf.OrganizationsStore
+		Groups             []chronograf.Group
 		Logger             chronograf.Logger
 	}
 	type args struct {
@@ -1765,6 +1766,231 @@ func TestAuthorizedUser(t *testing.T) {
 			},
 			authorized: false,
 		},
+		{
+			name: "Suspended SuperAdmin is not authorized",
+			fields: fields{
+				UsersStore: &mocks.UsersStore{
+					GetF: func(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+						if q.Name == nil || q.Provider == nil || q.Scheme == nil {
+							return nil, fmt.Errorf("invalid user query: missing Name, Provider, and/or Scheme")
+						}
+						return &chronograf.User{
+							ID:         1337,
+							Name:       "billysteve",
+							Provider:   "google",
+							Scheme:     "oauth2",
+							SuperAdmin: true,
+							Status:     chronograf.UserStatusSuspended,
+							Roles: []chronograf.Role{
+								{
+									Name:         roles.AdminRoleName,
+									Organization: "1337",
+								},
+							},
+						}, nil
+					},
+				},
+				OrganizationsStore: &mocks.OrganizationsStore{
+					DefaultOrganizationF: func(ctx context.Context) (*chronograf.Organization, error) {
+						return &chronograf.Organization{
+							ID: "0",
+						}, nil
+					},
+					GetF: func(ctx context.Context, q chronograf.OrganizationQuery) (*chronograf.Organization, error) {
+						return &chronograf.Organization{
+							ID:   "1337",
+							Name: "The ShillBillThrilliettas",
+						}, nil
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			},
+			args: args{
+				principal: &oauth2.Principal{
+					Subject:      "billysteve",
+					Issuer:       "google",
+					Organization: "1337",
+				},
+				scheme:  "oauth2",
+				role:    "member",
+				useAuth: true,
+			},
+			authorized: false,
+		},
+		{
+			name: "User with viewer role in an editor group is editor authorized",
+			fields: fields{
+				UsersStore: &mocks.UsersStore{
+					GetF: func(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+						return &chronograf.User{
+							ID:       1337,
+							Name:     "billysteve",
+							Provider: "google",
+							Scheme:   "oauth2",
+							Roles: []chronograf.Role{
+								{
+									Name:         roles.ViewerRoleName,
+									Organization: "1337",
+								},
+							},
+						}, nil
+					},
+				},
+				OrganizationsStore: &mocks.OrganizationsStore{
+					DefaultOrganizationF: func(ctx context.Context) (*chronograf.Organization, error) {
+						return &chronograf.Organization{
+							ID: "0",
+						}, nil
+					},
+					GetF: func(ctx context.Context, q chronograf.OrganizationQuery) (*chronograf.Organization, error) {
+						return &chronograf.Organization{
+							ID:   "1337",
+							Name: "The ShillBillThrilliettas",
+						}, nil
+					},
+				},
+				Groups: []chronograf.Group{
+					{
+						ID:    "1",
+						Name:  "on-call",
+						Users: []uint64{1337},
+						Roles: []chronograf.Role{{Name: roles.EditorRoleName, Organization: "1337"}},
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			},
+			args: args{
+				principal: &oauth2.Principal{
+					Subject:      "billysteve",
+					Issuer:       "google",
+					Organization: "1337",
+				},
+				scheme:  "oauth2",
+				role:    "editor",
+				useAuth: true,
+			},
+			authorized:             true,
+			hasOrganizationContext: true,
+			hasSuperAdminContext:   false,
+			hasRoleContext:         true,
+			hasServerContext:       false,
+		},
+		{
+			name: "User only in a group of the organization is viewer authorized",
+			fields: fields{
+				UsersStore: &mocks.UsersStore{
+					GetF: func(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+						return &chronograf.User{
+							ID:       1337,
+							Name:     "billysteve",
+							Provider: "google",
+							Scheme:   "oauth2",
+							Roles: []chronograf.Role{
+								{
+									Name:         roles.AdminRoleName,
+									Organization: "1",
+								},
+							},
+						}, nil
+					},
+				},
+				OrganizationsStore: &mocks.OrganizationsStore{
+					DefaultOrganizationF: func(ctx context.Context) (*chronograf.Organization, error) {
+						return &chronograf.Organization{
+							ID: "0",
+						}, nil
+					},
+					GetF: func(ctx context.Context, q chronograf.OrganizationQuery) (*chronograf.Organization, error) {
+						return &chronograf.Organization{
+							ID:   "1337",
+							Name: "The ShillBillThrilliettas",
+						}, nil
+					},
+				},
+				Groups: []chronograf.Group{
+					{
+						ID:    "1",
+						Name:  "sre",
+						Users: []uint64{1, 1337},
+						Roles: []chronograf.Role{{Name: roles.ViewerRoleName, Organization: "1337"}},
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			},
+			args: args{
+				principal: &oauth2.Principal{
+					Subject:      "billysteve",
+					Issuer:       "google",
+					Organization: "1337",
+				},
+				scheme:  "oauth2",
+				role:    "viewer",
+				useAuth: true,
+			},
+			authorized:             true,
+			hasOrganizationContext: true,
+			hasSuperAdminContext:   false,
+			hasRoleContext:         true,
+			hasServerContext:       false,
+		},
+		{
+			name: "User with viewer role is not editor authorized by a group of others",
+			fields: fields{
+				UsersStore: &mocks.UsersStore{
+					GetF: func(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+						return &chronograf.User{
+							ID:       1337,
+							Name:     "billysteve",
+							Provider: "google",
+							Scheme:   "oauth2",
+							Roles: []chronograf.Role{
+								{
+									Name:         roles.ViewerRoleName,
+									Organization: "1337",
+								},
+							},
+						}, nil
+					},
+				},
+				OrganizationsStore: &mocks.OrganizationsStore{
+					DefaultOrganizationF: func(ctx context.Context) (*chronograf.Organization, error) {
+						return &chronograf.Organization{
+							ID: "0",
+						}, nil
+					},
+					GetF: func(ctx context.Context, q chronograf.OrganizationQuery) (*chronograf.Organization, error) {
+						return &chronograf.Organization{
+							ID:   "1337",
+							Name: "The ShillBillThrilliettas",
+						}, nil
+					},
+				},
+				Groups: []chronograf.Group{
+					{
+						ID:    "1",
+						Name:  "on-call",
+						Users: []uint64{1},
+						Roles: []chronograf.Role{{Name: roles.EditorRoleName, Organization: "1337"}},
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			},
+			args: args{
+				principal: &oauth2.Principal{
+					Subject:      "billysteve",
+					Issuer:       "google",
+					Organization: "1337",
+				},
+				scheme:  "oauth2",
+				role:    "editor",
+				useAuth: true,
+			},
+			authorized:             false,
+			hasOrganizationContext: false,
+			hasSuperAdminContext:   false,
+			hasRoleContext:         false,
+			hasServerContext:       false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1786,6 +2012,7 @@ func TestAuthorizedUser(t *testing.T) {
 				&Store{
 					UsersStore:         tt.fields.UsersStore,
 					OrganizationsStore: tt.fields.OrganizationsStore,
+					GroupsStore:        groupsStore(tt.fields.Groups...),
 				},
 				tt.args.useAuth,
 				tt.args.role,
@@ -0,0 +1,209 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/bouk/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/oauth2"
+)
+
+const (
+	// BasicScheme is the scheme of users authenticated by a username and
+	// password stored within chronograf
+	BasicScheme = "basic"
+	// BasicProvider is the provider of users of the basic scheme and the
+	// issuer of their principals
+	BasicProvider = "local"
+
+	basicLoginPath  = "/basic/login"
+	basicLogoutPath = "/basic/logout"
+
+	// minPasswordLength is the shortest password accepted for users of the
+	// basic scheme
+	minPasswordLength = 8
+)
+
+// errInvalidCredentials does not reveal whether the user or the password was wrong
+var errInvalidCredentials = errorf("invalid username or password")
+
+func validPassword(password string) error {
+	if len(password) < minPasswordLength {
+		return errorf("passwords must be at least %d characters long", minPasswordLength)
+	}
+	return nil
+}
+
+type basicLoginRequest struct {
+	Name     string `json:"name"`
+	Password string `json:"password"`
+	Code     string `json:"code"` // Code is the one-time password or a recovery code of users with two-factor authentication
+}
+
+// BasicLogin authenticates a user of the basic scheme by name and password
+// and sets the session cookie in the same way as the OAuth2 callbacks. Users
+// with two-factor authentication also need a one-time password or a recovery
+// code; logins without one are answered by errMFARequired.
+func (s *Service) BasicLogin(auth oauth2.Authenticator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req basicLoginRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			invalidJSON(w, s.Logger)
+			return
+		}
+
+		ctx := serverContext(r.Context())
+		provider, scheme := BasicProvider, BasicScheme
+		u, err := s.Store.Users(ctx).Get(ctx, chronograf.UserQuery{
+			Name:     &req.Name,
+			Provider: &provider,
+			Scheme:   &scheme,
+		})
+		hash := dummyPasswordHash
+		if err == nil && u.PasswordHash != "" {
+			hash = u.PasswordHash
+		}
+		if checkPassword(hash, req.Password) != nil || err != nil || u.PasswordHash == "" {
+			Error(w, http.StatusUnauthorized, errInvalidCredentials.Error(), s.Logger)
+			return
+		}
+		if u.Suspended() {
+			Error(w, http.StatusForbidden, chronograf.ErrUserSuspended.Error(), s.Logger)
+			return
+		}
+		if u.TOTPEnabled {
+			if req.Code == "" {
+				Error(w, http.StatusUnauthorized, errMFARequired.Error(), s.Logger)
+				return
+			}
+			if !verifyMFA(u, req.Code, time.Now()) {
+				Error(w, http.StatusUnauthorized, errInvalidMFACode.Error(), s.Logger)
+				return
+			}
+			// the one-time password or recovery code may not be used again
+			if err := s.Store.Users(ctx).Update(ctx, u); err != nil {
+				unknownErrorWithMessage(w, err, s.Logger)
+				return
+			}
+		}
+
+		p := oauth2.Principal{
+			Subject: u.Name,
+			Issuer:  BasicProvider,
+		}
+		if err := auth.Authorize(ctx, w, p); err != nil {
+			unknownErrorWithMessage(w, err, s.Logger)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// BasicLogout expires the session cookie of a user of the basic scheme and
+// redirects to nextURL
+func BasicLogout(nextURL, basepath string, auth oauth2.Authenticator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		auth.Expire(w)
+		http.Redirect(w, r, path.Join(basepath, nextURL), http.StatusTemporaryRedirect)
+	}
+}
+
+type mePasswordRequest struct {
+	CurrentPassword string `json:"currentPassword"`
+	Password        string `json:"password"`
+}
+
+// UpdateMePassword changes the password of the current user, who must be of
+// the basic scheme and know their current password
+func (s *Service) UpdateMePassword(w http.ResponseWriter, r *http.Request) {
+	var req mePasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	if err := validPassword(req.Password); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	me, ok := hasUserContext(ctx)
+	if !ok || me.Scheme != BasicScheme {
+		invalidData(w, errorf("only users of the basic scheme have passwords"), s.Logger)
+		return
+	}
+
+	serverCtx := serverContext(ctx)
+	u, err := s.Store.Users(serverCtx).Get(serverCtx, chronograf.UserQuery{ID: &me.ID})
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	if u.PasswordHash == "" || checkPassword(u.PasswordHash, req.CurrentPassword) != nil {
+		Error(w, http.StatusUnauthorized, "current password is incorrect", s.Logger)
+		return
+	}
+
+	if err := s.setPassword(serverCtx, u, req.Password); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type userPasswordRequest struct {
+	Password string `json:"password"`
+}
+
+// ResetUserPassword sets the password of a user of the basic scheme without
+// requiring the current one. It is also how the first password of a user is set.
+func (s *Service) ResetUserPassword(w http.ResponseWriter, r *http.Request) {
+	var req userPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	if err := validPassword(req.Password); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	idStr := httprouter.GetParamFromContext(ctx, "id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		Error(w, http.StatusBadRequest, fmt.Sprintf("invalid user id: %s", err.Error()), s.Logger)
+		return
+	}
+
+	u, err := s.Store.Users(ctx).Get(ctx, chronograf.UserQuery{ID: &id})
+	if err != nil {
+		Error(w, http.StatusNotFound, err.Error(), s.Logger)
+		return
+	}
+	if u.Scheme != BasicScheme {
+		invalidData(w, errorf("only users of the basic scheme have passwords"), s.Logger)
+		return
+	}
+
+	if err := s.setPassword(ctx, u, req.Password); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Service) setPassword(ctx context.Context, u *chronograf.User, password string) error {
+	hash, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+	u.PasswordHash = hash
+	return s.Store.Users(ctx).Update(ctx, u)
+}
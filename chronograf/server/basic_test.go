@@ -0,0 +1,278 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bouk/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+	"github.com/influxdata/influxdb/chronograf/oauth2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func basicTestUsersStore(u *chronograf.User) *mocks.UsersStore {
+	return &mocks.UsersStore{
+		GetF: func(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+			if q.ID != nil && *q.ID == u.ID {
+				return u, nil
+			}
+			if q.Name != nil && *q.Name == u.Name && *q.Provider == u.Provider && *q.Scheme == u.Scheme {
+				return u, nil
+			}
+			return nil, chronograf.ErrUserNotFound
+		},
+		UpdateF: func(ctx context.Context, usr *chronograf.User) error {
+			*u = *usr
+			return nil
+		},
+	}
+}
+
+// basicTestAuthenticator records the principal it authorizes
+type basicTestAuthenticator struct {
+	mocks.Authenticator
+	authorized *oauth2.Principal
+}
+
+func (a *basicTestAuthenticator) Authorize(ctx context.Context, w http.ResponseWriter, p oauth2.Principal) error {
+	a.authorized = &p
+	return nil
+}
+
+func TestService_BasicLogin(t *testing.T) {
+	hash, err := hashPassword("correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name       string
+		user       chronograf.User
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "Valid credentials",
+			user:       chronograf.User{ID: 1, Name: "bob", Provider: BasicProvider, Scheme: BasicScheme, PasswordHash: hash},
+			body:       `{"name":"bob","password":"correct horse"}`,
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "Wrong password",
+			user:       chronograf.User{ID: 1, Name: "bob", Provider: BasicProvider, Scheme: BasicScheme, PasswordHash: hash},
+			body:       `{"name":"bob","password":"battery staple"}`,
+			wantStatus: http.StatusUnauthorized,
+			wantBody:   `{"code":401,"message":"invalid username or password","type":"urn:chronograf:problem:unauthorized","title":"Unauthorized","status":401,"detail":"invalid username or password"}`,
+		},
+		{
+			name:       "Unknown user",
+			user:       chronograf.User{ID: 1, Name: "bob", Provider: BasicProvider, Scheme: BasicScheme, PasswordHash: hash},
+			body:       `{"name":"alice","password":"correct horse"}`,
+			wantStatus: http.StatusUnauthorized,
+			wantBody:   `{"code":401,"message":"invalid username or password","type":"urn:chronograf:problem:unauthorized","title":"Unauthorized","status":401,"detail":"invalid username or password"}`,
+		},
+		{
+			name:       "User without password",
+			user:       chronograf.User{ID: 1, Name: "bob", Provider: BasicProvider, Scheme: BasicScheme},
+			body:       `{"name":"bob","password":""}`,
+			wantStatus: http.StatusUnauthorized,
+			wantBody:   `{"code":401,"message":"invalid username or password","type":"urn:chronograf:problem:unauthorized","title":"Unauthorized","status":401,"detail":"invalid username or password"}`,
+		},
+		{
+			name:       "Suspended user",
+			user:       chronograf.User{ID: 1, Name: "bob", Provider: BasicProvider, Scheme: BasicScheme, PasswordHash: hash, Status: chronograf.UserStatusSuspended},
+			body:       `{"name":"bob","password":"correct horse"}`,
+			wantStatus: http.StatusForbidden,
+			wantBody:   `{"code":403,"message":"user is suspended","type":"urn:chronograf:problem:forbidden","title":"Forbidden","status":403,"detail":"user is suspended"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{
+				Store: &mocks.Store{
+					UsersStore: basicTestUsersStore(&tt.user),
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url/basic/login", bytes.NewBufferString(tt.body))
+
+			auth := &basicTestAuthenticator{}
+			s.BasicLogin(auth)(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("%q. BasicLogin() = %v, want %v", tt.name, resp.StatusCode, tt.wantStatus)
+			}
+			if tt.wantBody == "" {
+				want := oauth2.Principal{Subject: "bob", Issuer: BasicProvider}
+				if auth.authorized == nil || *auth.authorized != want {
+					t.Errorf("%q. BasicLogin() authorized %v, want %v", tt.name, auth.authorized, want)
+				}
+				return
+			}
+			if eq, _ := jsonEqual(string(body), tt.wantBody); !eq {
+				t.Errorf("%q. BasicLogin() = \n***%v***\n,\nwant\n***%v***", tt.name, string(body), tt.wantBody)
+			}
+		})
+	}
+}
+
+func Test_dummyPasswordHash(t *testing.T) {
+	// Logins of unknown users spend as long in bcrypt as those of users
+	cost, err := bcrypt.Cost([]byte(dummyPasswordHash))
+	if err != nil || cost != bcrypt.DefaultCost {
+		t.Errorf("bcrypt.Cost(dummyPasswordHash) = %d, %v, want %d", cost, err, bcrypt.DefaultCost)
+	}
+}
+
+func TestService_UpdateMePassword(t *testing.T) {
+	hash, err := hashPassword("correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name       string
+		user       chronograf.User
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "Change password",
+			user:       chronograf.User{ID: 1, Name: "bob", Provider: BasicProvider, Scheme: BasicScheme, PasswordHash: hash},
+			body:       `{"currentPassword":"correct horse","password":"battery staple"}`,
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "Wrong current password",
+			user:       chronograf.User{ID: 1, Name: "bob", Provider: BasicProvider, Scheme: BasicScheme, PasswordHash: hash},
+			body:       `{"currentPassword":"incorrect horse","password":"battery staple"}`,
+			wantStatus: http.StatusUnauthorized,
+			wantBody:   `{"code":401,"message":"current password is incorrect","type":"urn:chronograf:problem:unauthorized","title":"Unauthorized","status":401,"detail":"current password is incorrect"}`,
+		},
+		{
+			name:       "Short password",
+			user:       chronograf.User{ID: 1, Name: "bob", Provider: BasicProvider, Scheme: BasicScheme, PasswordHash: hash},
+			body:       `{"currentPassword":"correct horse","password":"short"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody:   `{"code":422,"message":"passwords must be at least 8 characters long","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"passwords must be at least 8 characters long"}`,
+		},
+		{
+			name:       "OAuth2 user",
+			user:       chronograf.User{ID: 1, Name: "bob", Provider: "github", Scheme: "oauth2"},
+			body:       `{"currentPassword":"correct horse","password":"battery staple"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody:   `{"code":422,"message":"only users of the basic scheme have passwords","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"only users of the basic scheme have passwords"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{
+				Store: &mocks.Store{
+					UsersStore: basicTestUsersStore(&tt.user),
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+			me := tt.user
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("PUT", "http://any.url/chronograf/v1/me/password", bytes.NewBufferString(tt.body))
+			r = r.WithContext(context.WithValue(r.Context(), UserContextKey, &me))
+
+			s.UpdateMePassword(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("%q. UpdateMePassword() = %v, want %v", tt.name, resp.StatusCode, tt.wantStatus)
+			}
+			if tt.wantBody == "" {
+				if err := checkPassword(tt.user.PasswordHash, "battery staple"); err != nil {
+					t.Errorf("%q. UpdateMePassword() did not change the password", tt.name)
+				}
+				return
+			}
+			if eq, _ := jsonEqual(string(body), tt.wantBody); !eq {
+				t.Errorf("%q. UpdateMePassword() = \n***%v***\n,\nwant\n***%v***", tt.name, string(body), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestService_ResetUserPassword(t *testing.T) {
+	tests := []struct {
+		name       string
+		user       chronograf.User
+		id         string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "Set first password",
+			user:       chronograf.User{ID: 1, Name: "bob", Provider: BasicProvider, Scheme: BasicScheme},
+			id:         "1",
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "OAuth2 user",
+			user:       chronograf.User{ID: 1, Name: "bob", Provider: "github", Scheme: "oauth2"},
+			id:         "1",
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody:   `{"code":422,"message":"only users of the basic scheme have passwords","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"only users of the basic scheme have passwords"}`,
+		},
+		{
+			name:       "Unknown user",
+			user:       chronograf.User{ID: 1, Name: "bob", Provider: BasicProvider, Scheme: BasicScheme},
+			id:         "2",
+			wantStatus: http.StatusNotFound,
+			wantBody:   `{"code":404,"message":"user not found","type":"urn:chronograf:problem:not-found","title":"Not Found","status":404,"detail":"user not found"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{
+				Store: &mocks.Store{
+					UsersStore: basicTestUsersStore(&tt.user),
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("PUT", "http://any.url", bytes.NewBufferString(`{"password":"battery staple"}`))
+			r = r.WithContext(httprouter.WithParams(r.Context(), httprouter.Params{{Key: "id", Value: tt.id}}))
+
+			s.ResetUserPassword(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("%q. ResetUserPassword() = %v, want %v", tt.name, resp.StatusCode, tt.wantStatus)
+			}
+			if tt.wantBody == "" {
+				if err := checkPassword(tt.user.PasswordHash, "battery staple"); err != nil {
+					t.Errorf("%q. ResetUserPassword() did not set the password", tt.name)
+				}
+				return
+			}
+			if eq, _ := jsonEqual(string(body), tt.wantBody); !eq {
+				t.Errorf("%q. ResetUserPassword() = \n***%v***\n,\nwant\n***%v***", tt.name, string(body), tt.wantBody)
+			}
+		})
+	}
+}
+
+func Test_getScheme(t *testing.T) {
+	ctx := context.WithValue(context.Background(), oauth2.PrincipalKey, oauth2.Principal{Subject: "bob", Issuer: BasicProvider})
+	if scheme, _ := getScheme(ctx); scheme != BasicScheme {
+		t.Errorf("getScheme() of a local principal = %s, want %s", scheme, BasicScheme)
+	}
+	ctx = context.WithValue(context.Background(), oauth2.PrincipalKey, oauth2.Principal{Subject: "bob", Issuer: "github"})
+	if scheme, _ := getScheme(ctx); scheme != "oauth2" {
+		t.Errorf("getScheme() of a github principal = %s, want oauth2", scheme)
+	}
+}
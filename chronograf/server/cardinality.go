@@ -0,0 +1,126 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/influxdata/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/cache"
+	"github.com/influxdata/influxql"
+)
+
+type cardinalityLinks struct {
+	Self string `json:"self"` // Self link mapping to this resource
+}
+
+type dbCardinalityResponse struct {
+	Database     string                   `json:"database"`
+	Series       int64                    `json:"series"`       // Series is the estimated number of series of the database
+	Measurements []chronograf.Cardinality `json:"measurements"` // Measurements are the measurements with the most series first
+	Links        cardinalityLinks         `json:"links"`
+}
+
+type measurementCardinalityResponse struct {
+	Database    string                   `json:"database"`
+	Measurement string                   `json:"measurement"`
+	TagKeys     []chronograf.Cardinality `json:"tagKeys"` // TagKeys are the tag keys with the most values first
+	Links       cardinalityLinks         `json:"links"`
+}
+
+// cardinality responds with the cardinality counted by count. Counting
+// series is expensive on large databases, so counts are cached for
+// CardinalityCacheTTL unless the client asks for a fresh count with
+// Cache-Control: no-cache.
+func (s *Service) cardinality(w http.ResponseWriter, r *http.Request, key cache.QueryKey, count func() (interface{}, error)) {
+	cached := s.CardinalityCache != nil && s.CardinalityCacheTTL > 0
+	if cached {
+		if !bypassQueryCache(r) {
+			res, ok := s.CardinalityCache.Get(key)
+			s.Metrics.CacheLookup(cardinalityCacheName, ok)
+			if ok {
+				w.Header().Set(QueryCacheHeader, queryCacheHit)
+				encodeJSON(w, http.StatusOK, res, s.Logger)
+				return
+			}
+		}
+		w.Header().Set(QueryCacheHeader, queryCacheMiss)
+	}
+
+	res, err := count()
+	if err != nil {
+		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+		return
+	}
+	if cached {
+		s.CardinalityCache.Set(key, res, s.CardinalityCacheTTL)
+	}
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// DatabaseCardinality reports the number of series of a database and of
+// each of its measurements
+func (s *Service) DatabaseCardinality(w http.ResponseWriter, r *http.Request) {
+	src, dbsvc, ok := s.sourceDatabases(w, r)
+	if !ok {
+		return
+	}
+
+	ctx := r.Context()
+	db := httprouter.ParamsFromContext(ctx).ByName("db")
+	key := cache.QueryKey{
+		Source:  src.ID,
+		DB:      db,
+		Command: "SHOW SERIES CARDINALITY",
+	}
+	s.cardinality(w, r, key, func() (interface{}, error) {
+		series, err := dbsvc.SeriesCardinality(ctx, db)
+		if err != nil {
+			return nil, err
+		}
+		measurements, err := dbsvc.MeasurementsCardinality(ctx, db)
+		if err != nil {
+			return nil, err
+		}
+		return dbCardinalityResponse{
+			Database:     db,
+			Series:       series,
+			Measurements: measurements,
+			Links: cardinalityLinks{
+				Self: fmt.Sprintf("/chronograf/v1/sources/%d/dbs/%s/cardinality", src.ID, db),
+			},
+		}, nil
+	})
+}
+
+// MeasurementCardinality reports the number of values of each tag key of a
+// measurement
+func (s *Service) MeasurementCardinality(w http.ResponseWriter, r *http.Request) {
+	src, dbsvc, ok := s.sourceDatabases(w, r)
+	if !ok {
+		return
+	}
+
+	ctx := r.Context()
+	params := httprouter.ParamsFromContext(ctx)
+	db, measurement := params.ByName("db"), params.ByName("measurement")
+	key := cache.QueryKey{
+		Source:  src.ID,
+		DB:      db,
+		Command: "SHOW TAG VALUES CARDINALITY FROM " + influxql.QuoteIdent(measurement),
+	}
+	s.cardinality(w, r, key, func() (interface{}, error) {
+		tagKeys, err := dbsvc.TagKeysCardinality(ctx, db, measurement)
+		if err != nil {
+			return nil, err
+		}
+		return measurementCardinalityResponse{
+			Database:    db,
+			Measurement: measurement,
+			TagKeys:     tagKeys,
+			Links: cardinalityLinks{
+				Self: fmt.Sprintf("/chronograf/v1/sources/%d/dbs/%s/measurements/%s/cardinality", src.ID, db, measurement),
+			},
+		}, nil
+	})
+}
@@ -0,0 +1,179 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/influxdata/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/cache"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+func TestService_DatabaseCardinality(t *testing.T) {
+	counts := 0
+	s := &Service{
+		Store: &mocks.Store{
+			SourcesStore: &mocks.SourcesStore{
+				GetF: func(ctx context.Context, ID int) (chronograf.Source, error) {
+					return chronograf.Source{ID: ID}, nil
+				},
+			},
+		},
+		Databases: &mocks.Databases{
+			ConnectF: func(ctx context.Context, src *chronograf.Source) error {
+				return nil
+			},
+			SeriesCardinalityF: func(ctx context.Context, db string) (int64, error) {
+				counts++
+				return 1210, nil
+			},
+			MeasurementsCardinalityF: func(ctx context.Context, db string) ([]chronograf.Cardinality, error) {
+				return []chronograf.Cardinality{{Name: "docker_container_cpu", Count: 1200}, {Name: "cpu", Count: 10}}, nil
+			},
+		},
+		CardinalityCache:    cache.NewQueryCache(10),
+		CardinalityCacheTTL: time.Minute,
+		Logger:              &chronograf.NoopLogger{},
+	}
+
+	tests := []struct {
+		name         string
+		cacheControl string
+		wantCache    string
+		wantCounts   int
+	}{
+		{
+			name:       "Counted",
+			wantCache:  queryCacheMiss,
+			wantCounts: 1,
+		},
+		{
+			name:       "Cached",
+			wantCache:  queryCacheHit,
+			wantCounts: 1,
+		},
+		{
+			name:         "Counted again on demand",
+			cacheControl: "no-cache",
+			wantCache:    queryCacheMiss,
+			wantCounts:   2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("GET", "http://any.url/chronograf/v1/sources/1/dbs/telegraf/cardinality", nil)
+			if tt.cacheControl != "" {
+				r.Header.Set("Cache-Control", tt.cacheControl)
+			}
+			r = r.WithContext(context.WithValue(r.Context(), httprouter.ParamsKey, httprouter.Params{
+				{Key: "id", Value: "1"},
+				{Key: "db", Value: "telegraf"},
+			}))
+			s.DatabaseCardinality(w, r)
+
+			resp := w.Result()
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("DatabaseCardinality() = %v, want %v", resp.StatusCode, http.StatusOK)
+			}
+			if got := resp.Header.Get(QueryCacheHeader); got != tt.wantCache {
+				t.Errorf("DatabaseCardinality() cache = %s, want %s", got, tt.wantCache)
+			}
+			if counts != tt.wantCounts {
+				t.Errorf("DatabaseCardinality() counted %d times, want %d", counts, tt.wantCounts)
+			}
+			var got dbCardinalityResponse
+			if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+				t.Fatal(err)
+			}
+			want := dbCardinalityResponse{
+				Database:     "telegraf",
+				Series:       1210,
+				Measurements: []chronograf.Cardinality{{Name: "docker_container_cpu", Count: 1200}, {Name: "cpu", Count: 10}},
+				Links:        cardinalityLinks{Self: "/chronograf/v1/sources/1/dbs/telegraf/cardinality"},
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("DatabaseCardinality() = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestService_MeasurementCardinality(t *testing.T) {
+	s := &Service{
+		Store: &mocks.Store{
+			SourcesStore: &mocks.SourcesStore{
+				GetF: func(ctx context.Context, ID int) (chronograf.Source, error) {
+					return chronograf.Source{ID: ID}, nil
+				},
+			},
+		},
+		Databases: &mocks.Databases{
+			ConnectF: func(ctx context.Context, src *chronograf.Source) error {
+				return nil
+			},
+			TagKeysCardinalityF: func(ctx context.Context, db, measurement string) ([]chronograf.Cardinality, error) {
+				if measurement != "cpu" {
+					return nil, chronograf.Error("measurement not found")
+				}
+				return []chronograf.Cardinality{{Name: "host", Count: 4}}, nil
+			},
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+
+	tests := []struct {
+		name        string
+		measurement string
+		wantStatus  int
+		want        string
+	}{
+		{
+			name:        "Tag keys of a measurement",
+			measurement: "cpu",
+			wantStatus:  http.StatusOK,
+			want:        `{"database":"telegraf","measurement":"cpu","tagKeys":[{"name":"host","count":4}],"links":{"self":"/chronograf/v1/sources/1/dbs/telegraf/measurements/cpu/cardinality"}}`,
+		},
+		{
+			name:        "Error of the source",
+			measurement: "missing",
+			wantStatus:  http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("GET", "http://any.url", nil)
+			r = r.WithContext(context.WithValue(r.Context(), httprouter.ParamsKey, httprouter.Params{
+				{Key: "id", Value: "1"},
+				{Key: "db", Value: "telegraf"},
+				{Key: "measurement", Value: tt.measurement},
+			}))
+			s.MeasurementCardinality(w, r)
+
+			resp := w.Result()
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("MeasurementCardinality() = %v, want %v", resp.StatusCode, tt.wantStatus)
+			}
+			if got := resp.Header.Get(QueryCacheHeader); got != "" {
+				t.Errorf("MeasurementCardinality() cache = %s without a cache", got)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var got json.RawMessage
+			if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+				t.Fatal(err)
+			}
+			if eq, _ := jsonEqual(string(got), tt.want); !eq {
+				t.Errorf("MeasurementCardinality() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,7 +3,9 @@ package server
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"net/http"
+	"strconv"
 
 	"github.com/influxdata/httprouter"
 	"github.com/influxdata/influxdb/chronograf"
@@ -15,6 +17,8 @@ const (
 	DefaultWidth = 4
 	// DefaultHeight is used if not specified
 	DefaultHeight = 4
+	// MaxTablePageSize is the largest number of rows per page of a table cell
+	MaxTablePageSize = 1000
 )
 
 type dashboardCellLinks struct {
@@ -79,6 +83,11 @@ func ValidDashboardCellRequest(c *chronograf.DashboardCell) error {
 		if err := ValidateQueryConfig(&q.QueryConfig); err != nil {
 			return err
 		}
+		// Each query may link to its own source, so that a cell compares
+		// several sources
+		if _, ok := sourceLinkID(q.Source); q.Source != "" && !ok {
+			return fmt.Errorf("invalid source %q of query %q", q.Source, q.Command)
+		}
 	}
 	MoveTimeShift(c)
 	err := HasCorrectAxes(c)
@@ -88,6 +97,12 @@ func ValidDashboardCellRequest(c *chronograf.DashboardCell) error {
 	if err = HasCorrectColors(c); err != nil {
 		return err
 	}
+	if err = HasCorrectThresholds(c); err != nil {
+		return err
+	}
+	if err = HasCorrectTableOptions(c); err != nil {
+		return err
+	}
 	return HasCorrectLegend(c)
 }
 
@@ -123,6 +138,72 @@ func HasCorrectColors(c *chronograf.DashboardCell) error {
 	return nil
 }
 
+// HasCorrectThresholds verifies that the colors mapping values of a cell have
+// unique numeric values and that gauges have a min and a max color with all
+// thresholds between them. Scale colors of graphs have no value to check.
+func HasCorrectThresholds(c *chronograf.DashboardCell) error {
+	values := map[string]map[float64]bool{}
+	var mins, maxs, thresholds []float64
+	for _, color := range c.CellColors {
+		if color.Type == "scale" {
+			continue
+		}
+		v, err := strconv.ParseFloat(color.Value, 64)
+		if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+			return chronograf.ErrInvalidThreshold
+		}
+		if values[color.Type] == nil {
+			values[color.Type] = map[float64]bool{}
+		}
+		if values[color.Type][v] {
+			return chronograf.ErrInvalidThreshold
+		}
+		values[color.Type][v] = true
+
+		switch color.Type {
+		case "min":
+			mins = append(mins, v)
+		case "max":
+			maxs = append(maxs, v)
+		case "threshold":
+			thresholds = append(thresholds, v)
+		}
+	}
+
+	if c.Type != "gauge" || len(c.CellColors) == 0 {
+		return nil
+	}
+	if len(mins) != 1 || len(maxs) != 1 || mins[0] >= maxs[0] {
+		return chronograf.ErrInvalidGauge
+	}
+	for _, v := range thresholds {
+		if v < mins[0] || v > maxs[0] {
+			return chronograf.ErrInvalidGauge
+		}
+	}
+	return nil
+}
+
+// HasCorrectTableOptions verifies that the table options and the formatting
+// of the fields of a cell are correct
+func HasCorrectTableOptions(c *chronograf.DashboardCell) error {
+	if !oneOf(c.TableOptions.Wrapping, "truncate", "wrap", "single-line", "") {
+		return chronograf.ErrInvalidCellOptionsText
+	}
+	if c.TableOptions.PageSize < 0 || c.TableOptions.PageSize > MaxTablePageSize {
+		return chronograf.ErrInvalidCellOptionsPageSize
+	}
+	for _, field := range c.FieldOptions {
+		if !oneOf(field.Format, "number", "percent", "bytes", "duration", "") {
+			return chronograf.ErrInvalidFieldFormat
+		}
+		if !oneOf(field.Align, "left", "center", "right", "") {
+			return chronograf.ErrInvalidFieldAlign
+		}
+	}
+	return nil
+}
+
 // HasCorrectLegend verifies that the format of the legend is correct
 func HasCorrectLegend(c *chronograf.DashboardCell) error {
 	// No legend set
@@ -216,6 +297,7 @@ func (s *Service) NewDashboardCell(w http.ResponseWriter, r *http.Request) {
 	}
 
 	ctx := r.Context()
+	defer lockResource("dashboards", id)()
 	dash, err := s.Store.Dashboards(ctx).Get(ctx, chronograf.DashboardID(id))
 	if err != nil {
 		notFound(w, id, s.Logger)
@@ -247,6 +329,8 @@ func (s *Service) NewDashboardCell(w http.ResponseWriter, r *http.Request) {
 		Error(w, http.StatusInternalServerError, msg, s.Logger)
 		return
 	}
+	s.recordDashboardVersion(ctx, dash.ID)
+	s.publishDashboardEvent(ctx, EventDashboardUpdated, dash)
 
 	boards := newDashboardResponse(dash)
 	for _, cell := range boards.Cells {
@@ -292,6 +376,7 @@ func (s *Service) RemoveDashboardCell(w http.ResponseWriter, r *http.Request) {
 	}
 
 	ctx := r.Context()
+	defer lockResource("dashboards", id)()
 	dash, err := s.Store.Dashboards(ctx).Get(ctx, chronograf.DashboardID(id))
 	if err != nil {
 		notFound(w, id, s.Logger)
@@ -317,6 +402,8 @@ func (s *Service) RemoveDashboardCell(w http.ResponseWriter, r *http.Request) {
 		Error(w, http.StatusInternalServerError, msg, s.Logger)
 		return
 	}
+	s.recordDashboardVersion(ctx, dash.ID)
+	s.publishDashboardEvent(ctx, EventDashboardUpdated, dash)
 	w.WriteHeader(http.StatusNoContent)
 }
 
@@ -329,6 +416,7 @@ func (s *Service) ReplaceDashboardCell(w http.ResponseWriter, r *http.Request) {
 	}
 
 	ctx := r.Context()
+	defer lockResource("dashboards", id)()
 	dash, err := s.Store.Dashboards(ctx).Get(ctx, chronograf.DashboardID(id))
 	if err != nil {
 		notFound(w, id, s.Logger)
@@ -373,6 +461,8 @@ func (s *Service) ReplaceDashboardCell(w http.ResponseWriter, r *http.Request) {
 		Error(w, http.StatusInternalServerError, msg, s.Logger)
 		return
 	}
+	s.recordDashboardVersion(ctx, dash.ID)
+	s.publishDashboardEvent(ctx, EventDashboardUpdated, dash)
 
 	res := newCellResponse(dash.ID, cell)
 	encodeJSON(w, http.StatusOK, res, s.Logger)
@@ -355,6 +355,176 @@ func TestHasCorrectColors(t *testing.T) {
 	}
 }
 
+func TestHasCorrectThresholds(t *testing.T) {
+	tests := []struct {
+		name    string
+		c       *chronograf.DashboardCell
+		wantErr error
+	}{
+		{
+			name: "single-stat thresholds are valid",
+			c: &chronograf.DashboardCell{
+				Type: "single-stat",
+				CellColors: []chronograf.CellColor{
+					{Type: "text", Hex: "#00C9FF", Value: "-1000000000000000000"},
+					{Type: "text", Hex: "#F95F53", Value: "90.5"},
+				},
+			},
+		},
+		{
+			name: "scale colors have no value",
+			c: &chronograf.DashboardCell{
+				Type: "line",
+				CellColors: []chronograf.CellColor{
+					{Type: "scale", Hex: "#31C0F6", Value: ""},
+					{Type: "scale", Hex: "#A500A5", Value: ""},
+				},
+			},
+		},
+		{
+			name: "threshold value is not a number",
+			c: &chronograf.DashboardCell{
+				Type: "single-stat",
+				CellColors: []chronograf.CellColor{
+					{Type: "text", Hex: "#00C9FF", Value: "high"},
+				},
+			},
+			wantErr: chronograf.ErrInvalidThreshold,
+		},
+		{
+			name: "threshold values are not unique",
+			c: &chronograf.DashboardCell{
+				Type: "table",
+				CellColors: []chronograf.CellColor{
+					{Type: "background", Hex: "#00C9FF", Value: "10"},
+					{Type: "background", Hex: "#F95F53", Value: "10.0"},
+				},
+			},
+			wantErr: chronograf.ErrInvalidThreshold,
+		},
+		{
+			name: "gauge thresholds are between min and max",
+			c: &chronograf.DashboardCell{
+				Type: "gauge",
+				CellColors: []chronograf.CellColor{
+					{Type: "min", Hex: "#00C9FF", Value: "0"},
+					{Type: "threshold", Hex: "#FFB94A", Value: "70"},
+					{Type: "max", Hex: "#9394FF", Value: "100"},
+				},
+			},
+		},
+		{
+			name: "gauge threshold above max",
+			c: &chronograf.DashboardCell{
+				Type: "gauge",
+				CellColors: []chronograf.CellColor{
+					{Type: "min", Hex: "#00C9FF", Value: "0"},
+					{Type: "threshold", Hex: "#FFB94A", Value: "170"},
+					{Type: "max", Hex: "#9394FF", Value: "100"},
+				},
+			},
+			wantErr: chronograf.ErrInvalidGauge,
+		},
+		{
+			name: "gauge without max",
+			c: &chronograf.DashboardCell{
+				Type: "gauge",
+				CellColors: []chronograf.CellColor{
+					{Type: "min", Hex: "#00C9FF", Value: "0"},
+				},
+			},
+			wantErr: chronograf.ErrInvalidGauge,
+		},
+		{
+			name: "gauge min above max",
+			c: &chronograf.DashboardCell{
+				Type: "gauge",
+				CellColors: []chronograf.CellColor{
+					{Type: "min", Hex: "#00C9FF", Value: "100"},
+					{Type: "max", Hex: "#9394FF", Value: "0"},
+				},
+			},
+			wantErr: chronograf.ErrInvalidGauge,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := HasCorrectThresholds(tt.c); err != tt.wantErr {
+				t.Errorf("HasCorrectThresholds() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHasCorrectTableOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		c       *chronograf.DashboardCell
+		wantErr error
+	}{
+		{
+			name: "paginated table with formatted columns",
+			c: &chronograf.DashboardCell{
+				Type: "table",
+				TableOptions: chronograf.TableOptions{
+					Wrapping: "single-line",
+					PageSize: 100,
+				},
+				FieldOptions: []chronograf.RenamableField{
+					{InternalName: "time", Visible: true},
+					{InternalName: "bytes", Visible: true, Format: "bytes", Align: "right"},
+				},
+			},
+		},
+		{
+			name: "invalid wrapping",
+			c: &chronograf.DashboardCell{
+				TableOptions: chronograf.TableOptions{Wrapping: "ellipsis"},
+			},
+			wantErr: chronograf.ErrInvalidCellOptionsText,
+		},
+		{
+			name: "page size too large",
+			c: &chronograf.DashboardCell{
+				TableOptions: chronograf.TableOptions{PageSize: MaxTablePageSize + 1},
+			},
+			wantErr: chronograf.ErrInvalidCellOptionsPageSize,
+		},
+		{
+			name: "negative page size",
+			c: &chronograf.DashboardCell{
+				TableOptions: chronograf.TableOptions{PageSize: -1},
+			},
+			wantErr: chronograf.ErrInvalidCellOptionsPageSize,
+		},
+		{
+			name: "invalid column format",
+			c: &chronograf.DashboardCell{
+				FieldOptions: []chronograf.RenamableField{
+					{InternalName: "bytes", Format: "kilobytes"},
+				},
+			},
+			wantErr: chronograf.ErrInvalidFieldFormat,
+		},
+		{
+			name: "invalid column alignment",
+			c: &chronograf.DashboardCell{
+				FieldOptions: []chronograf.RenamableField{
+					{InternalName: "bytes", Align: "justify"},
+				},
+			},
+			wantErr: chronograf.ErrInvalidFieldAlign,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := HasCorrectTableOptions(tt.c); err != tt.wantErr {
+				t.Errorf("HasCorrectTableOptions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestService_ReplaceDashboardCell(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -548,7 +718,7 @@ func TestService_ReplaceDashboardCell(t *testing.T) {
 			},
 			w:    httptest.NewRecorder(),
 			r:    httptest.NewRequest("PUT", "/chronograf/v1/dashboards/1/cells/3c5c4102-fa40-4585-a8f9-917c77e37192", nil),
-			want: `{"code":404,"message":"ID 1 not found"}`,
+			want: `{"code":404,"message":"ID 1 not found","type":"urn:chronograf:problem:not-found","title":"Not Found","status":404,"detail":"ID 1 not found"}`,
 		},
 		{
 			name: "cell doesn't exist",
@@ -561,7 +731,7 @@ func TestService_ReplaceDashboardCell(t *testing.T) {
 			},
 			w:    httptest.NewRecorder(),
 			r:    httptest.NewRequest("PUT", "/chronograf/v1/dashboards/1/cells/3c5c4102-fa40-4585-a8f9-917c77e37192", nil),
-			want: `{"code":404,"message":"ID 3c5c4102-fa40-4585-a8f9-917c77e37192 not found"}`,
+			want: `{"code":404,"message":"ID 3c5c4102-fa40-4585-a8f9-917c77e37192 not found","type":"urn:chronograf:problem:not-found","title":"Not Found","status":404,"detail":"ID 3c5c4102-fa40-4585-a8f9-917c77e37192 not found"}`,
 		},
 		{
 			name: "invalid query config",
@@ -600,7 +770,7 @@ func TestService_ReplaceDashboardCell(t *testing.T) {
 					  }
 					]
 				  }`))),
-			want: `{"code":422,"message":"invalid field type \"invalidType\" ; expect func, field, integer, number, regex, wildcard"}`,
+			want: `{"code":422,"message":"invalid field type \"invalidType\" ; expect func, field, integer, number, regex, wildcard","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"invalid field type \"invalidType\" ; expect func, field, integer, number, regex, wildcard"}`,
 		},
 		{
 			name: "JSON is not parsable",
@@ -620,7 +790,7 @@ func TestService_ReplaceDashboardCell(t *testing.T) {
 			},
 			w:    httptest.NewRecorder(),
 			r:    httptest.NewRequest("PUT", "/chronograf/v1/dashboards/1/cells/3c5c4102-fa40-4585-a8f9-917c77e37192", nil),
-			want: `{"code":400,"message":"unparsable JSON"}`,
+			want: `{"code":400,"message":"unparsable JSON","type":"urn:chronograf:problem:invalid-request","title":"Bad Request","status":400,"detail":"unparsable JSON"}`,
 		},
 		{
 			name: "not able to update store returns error message",
@@ -662,7 +832,7 @@ func TestService_ReplaceDashboardCell(t *testing.T) {
 					  }
 					]
 				  }`))),
-			want: `{"code":500,"message":"Error updating cell 3c5c4102-fa40-4585-a8f9-917c77e37192 in dashboard 1: error"}`,
+			want: `{"code":500,"message":"Error updating cell 3c5c4102-fa40-4585-a8f9-917c77e37192 in dashboard 1: error","type":"urn:chronograf:problem:internal-error","title":"Internal Server Error","status":500,"detail":"Error updating cell 3c5c4102-fa40-4585-a8f9-917c77e37192 in dashboard 1: error"}`,
 		},
 	}
 	for _, tt := range tests {
@@ -0,0 +1,310 @@
+package server
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/bouk/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/oauth2"
+)
+
+type certificateContextKey string
+
+// CertificateContextKey is the context key for retrieving the mapping of the
+// TLS client certificate authenticating the request
+const CertificateContextKey = certificateContextKey("certificate")
+
+// hasCertificateContext retrieves the mapping of the TLS client certificate
+// authenticating the request
+func hasCertificateContext(ctx context.Context) (*chronograf.CertificateMapping, bool) {
+	// prevents panic in case of nil context
+	if ctx == nil {
+		return nil, false
+	}
+	m, ok := ctx.Value(CertificateContextKey).(*chronograf.CertificateMapping)
+	if !ok || m == nil {
+		return nil, false
+	}
+	return m, true
+}
+
+// clientCertificate returns the verified TLS client certificate of a request
+func clientCertificate(r *http.Request) (*x509.Certificate, bool) {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return nil, false
+	}
+	return r.TLS.VerifiedChains[0][0], true
+}
+
+// AuthorizedClientCertificate authenticates the requests presenting a TLS
+// client certificate verified by the client certificate authorities as the
+// user the subject of the certificate is mapped to. The principal of the user
+// is sent to the next handler via the request's context, so that
+// AuthorizedUser authorizes the user as if they had logged in. Requests
+// without a verified certificate, or whose certificate is not mapped, are
+// passed on unchanged. On a mapping to a missing or suspended user, will
+// return http.StatusForbidden.
+func AuthorizedClientCertificate(store DataStore, logger chronograf.Logger, next http.Handler) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cert, ok := clientCertificate(r)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		subject := cert.Subject.String()
+		log := logger.
+			WithField("component", "client_certificate_auth").
+			WithField("remote_addr", r.RemoteAddr).
+			WithField("method", r.Method).
+			WithField("url", r.URL).
+			WithField("subject", subject)
+
+		ctx := r.Context()
+		serverCtx := serverContext(ctx)
+		mapping, err := certificateMapping(serverCtx, store, subject)
+		if err != nil {
+			log.Error(fmt.Sprintf("Failed to retrieve certificate mappings: %v", err))
+			Error(w, http.StatusInternalServerError, "failed to retrieve certificate mappings from database", logger)
+			return
+		}
+		if mapping == nil {
+			log.Debug("Client certificate is not mapped to a user")
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		u, err := store.Users(serverCtx).Get(serverCtx, chronograf.UserQuery{ID: &mapping.UserID})
+		if err != nil {
+			log.Error(fmt.Sprintf("Failed to retrieve user %d of certificate mapping %s", mapping.UserID, mapping.ID))
+			Error(w, http.StatusForbidden, "Certificate is not authorized", logger)
+			return
+		}
+		if u.Suspended() {
+			log.Error(fmt.Sprintf("User %d of certificate mapping %s is suspended", u.ID, mapping.ID))
+			Error(w, http.StatusForbidden, "User is suspended", logger)
+			return
+		}
+
+		ctx = context.WithValue(ctx, oauth2.PrincipalKey, oauth2.Principal{
+			Subject:      u.Name,
+			Issuer:       u.Provider,
+			Organization: mapping.Organization,
+			ExpiresAt:    cert.NotAfter,
+			IssuedAt:     time.Now(),
+		})
+		ctx = context.WithValue(ctx, CertificateContextKey, mapping)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// certificateMapping returns the mapping of the subject of a client
+// certificate, or nil when the subject is not mapped to a user
+func certificateMapping(ctx context.Context, store DataStore, subject string) (*chronograf.CertificateMapping, error) {
+	mappings, err := store.Certificates(ctx).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range mappings {
+		if mappings[i].Subject == subject {
+			return &mappings[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// clientCAs reads the certificate authorities verifying the TLS client
+// certificates
+func (s *Server) clientCAs() (*x509.CertPool, error) {
+	pem, err := ioutil.ReadFile(string(s.TLSClientCA))
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no PEM encoded certificate in %s", s.TLSClientCA)
+	}
+	return pool, nil
+}
+
+type certificateMappingRequest chronograf.CertificateMapping
+
+// Valid determines if a certificate mapping request is valid
+func (m *certificateMappingRequest) Valid() error {
+	if m.Subject == "" {
+		return fmt.Errorf("certificate mapping must specify subject")
+	}
+	if m.UserID == 0 {
+		return fmt.Errorf("certificate mapping must specify userId")
+	}
+	return nil
+}
+
+type certificateMappingResponse struct {
+	Links selfLinks `json:"links"`
+	chronograf.CertificateMapping
+}
+
+func newCertificateMappingResponse(m chronograf.CertificateMapping) *certificateMappingResponse {
+	return &certificateMappingResponse{
+		Links: selfLinks{
+			Self: fmt.Sprintf("/chronograf/v1/certificates/%s", m.ID),
+		},
+		CertificateMapping: m,
+	}
+}
+
+type certificateMappingsResponse struct {
+	Links        selfLinks                     `json:"links"`
+	Certificates []*certificateMappingResponse `json:"certificates"`
+}
+
+func newCertificateMappingsResponse(ms []chronograf.CertificateMapping) *certificateMappingsResponse {
+	certificates := []*certificateMappingResponse{}
+	for _, m := range ms {
+		certificates = append(certificates, newCertificateMappingResponse(m))
+	}
+	return &certificateMappingsResponse{
+		Links: selfLinks{
+			Self: "/chronograf/v1/certificates",
+		},
+		Certificates: certificates,
+	}
+}
+
+// validCertificateMapping checks that the user and the organization of a
+// certificate mapping exist and that no other mapping has its subject
+func (s *Service) validCertificateMapping(ctx context.Context, m *certificateMappingRequest) (int, error) {
+	if _, err := s.Store.Users(ctx).Get(ctx, chronograf.UserQuery{ID: &m.UserID}); err != nil {
+		return http.StatusUnprocessableEntity, fmt.Errorf("user does not exist")
+	}
+	if m.Organization != "" && !s.organizationExists(ctx, m.Organization) {
+		return http.StatusUnprocessableEntity, fmt.Errorf("organization does not exist")
+	}
+
+	mappings, err := s.Store.Certificates(ctx).All(ctx)
+	if err != nil {
+		return http.StatusInternalServerError, fmt.Errorf("failed to retrieve certificate mappings from database")
+	}
+	for _, mapping := range mappings {
+		if mapping.Subject == m.Subject && mapping.ID != m.ID {
+			return http.StatusConflict, fmt.Errorf("subject is already mapped to a user")
+		}
+	}
+	return 0, nil
+}
+
+// Certificates retrieves all certificate mappings
+func (s *Service) Certificates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	mappings, err := s.Store.Certificates(ctx).All(ctx)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to retrieve certificate mappings from database", s.Logger)
+		return
+	}
+
+	encodeJSON(w, http.StatusOK, newCertificateMappingsResponse(mappings), s.Logger)
+}
+
+// NewCertificate maps the subject of a TLS client certificate to a user
+func (s *Service) NewCertificate(w http.ResponseWriter, r *http.Request) {
+	var req certificateMappingRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	req.ID = ""
+
+	if err := req.Valid(); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	if code, err := s.validCertificateMapping(ctx, &req); err != nil {
+		Error(w, code, err.Error(), s.Logger)
+		return
+	}
+
+	m, err := s.Store.Certificates(ctx).Add(ctx, &chronograf.CertificateMapping{
+		Subject:      req.Subject,
+		UserID:       req.UserID,
+		Organization: req.Organization,
+	})
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to add certificate mapping to database", s.Logger)
+		return
+	}
+
+	res := newCertificateMappingResponse(*m)
+	location(w, res.Links.Self)
+	encodeJSON(w, http.StatusCreated, res, s.Logger)
+}
+
+// UpdateCertificate replaces the mapping of a TLS client certificate
+func (s *Service) UpdateCertificate(w http.ResponseWriter, r *http.Request) {
+	var req certificateMappingRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+
+	if err := req.Valid(); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	req.ID = httprouter.GetParamFromContext(ctx, "id")
+	if _, err := s.Store.Certificates(ctx).Get(ctx, req.ID); err == chronograf.ErrCertificateMappingNotFound {
+		Error(w, http.StatusNotFound, err.Error(), s.Logger)
+		return
+	} else if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to retrieve certificate mapping from database", s.Logger)
+		return
+	}
+	if code, err := s.validCertificateMapping(ctx, &req); err != nil {
+		Error(w, code, err.Error(), s.Logger)
+		return
+	}
+
+	mapping := chronograf.CertificateMapping(req)
+	if err := s.Store.Certificates(ctx).Update(ctx, &mapping); err != nil {
+		Error(w, http.StatusInternalServerError, "failed to update certificate mapping in database", s.Logger)
+		return
+	}
+
+	res := newCertificateMappingResponse(mapping)
+	location(w, res.Links.Self)
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// RemoveCertificate removes the mapping of a TLS client certificate
+func (s *Service) RemoveCertificate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := httprouter.GetParamFromContext(ctx, "id")
+
+	m, err := s.Store.Certificates(ctx).Get(ctx, id)
+	if err == chronograf.ErrCertificateMappingNotFound {
+		Error(w, http.StatusNotFound, err.Error(), s.Logger)
+		return
+	}
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to retrieve certificate mapping from database", s.Logger)
+		return
+	}
+
+	if err := s.Store.Certificates(ctx).Delete(ctx, m); err != nil {
+		Error(w, http.StatusInternalServerError, "failed to remove certificate mapping from database", s.Logger)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
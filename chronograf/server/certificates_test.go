@@ -0,0 +1,236 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bouk/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+	"github.com/influxdata/influxdb/chronograf/oauth2"
+)
+
+func TestAuthorizedClientCertificate(t *testing.T) {
+	mapped := &x509.Certificate{Subject: pkix.Name{CommonName: "ingest", Organization: []string{"Acme"}}}
+	unmapped := &x509.Certificate{Subject: pkix.Name{CommonName: "unknown"}}
+	tests := []struct {
+		name          string
+		cert          *x509.Certificate
+		user          *chronograf.User
+		wantStatus    int
+		wantPrincipal *oauth2.Principal
+	}{
+		{
+			name:       "Mapped certificate",
+			cert:       mapped,
+			user:       &chronograf.User{ID: 3, Name: "ingest", Provider: "chronograf"},
+			wantStatus: http.StatusOK,
+			wantPrincipal: &oauth2.Principal{
+				Subject:      "ingest",
+				Issuer:       "chronograf",
+				Organization: "1",
+			},
+		},
+		{
+			name:       "No certificate",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "Certificate not mapped",
+			cert:       unmapped,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "Mapped to a missing user",
+			cert:       mapped,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "Mapped to a suspended user",
+			cert:       mapped,
+			user:       &chronograf.User{ID: 3, Name: "ingest", Provider: "chronograf", Status: chronograf.UserStatusSuspended},
+			wantStatus: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &mocks.Store{
+				CertificatesStore: &mocks.CertificatesStore{
+					AllF: func(ctx context.Context) ([]chronograf.CertificateMapping, error) {
+						return []chronograf.CertificateMapping{
+							{ID: "1", Subject: "CN=ingest,O=Acme", UserID: 3, Organization: "1"},
+						}, nil
+					},
+				},
+				UsersStore: &mocks.UsersStore{
+					GetF: func(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+						if tt.user == nil || *q.ID != tt.user.ID {
+							return nil, chronograf.ErrUserNotFound
+						}
+						return tt.user, nil
+					},
+				},
+			}
+			var principal *oauth2.Principal
+			var hasCertificate bool
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if p, err := getValidPrincipal(r.Context()); err == nil {
+					principal = &p
+				}
+				_, hasCertificate = hasCertificateContext(r.Context())
+			})
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("GET", "https://any.url/chronograf/v1/dashboards", nil)
+			if tt.cert != nil {
+				r.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{tt.cert}}}
+			}
+
+			AuthorizedClientCertificate(store, &chronograf.NoopLogger{}, next)(w, r)
+
+			if got := w.Result().StatusCode; got != tt.wantStatus {
+				t.Errorf("%q. AuthorizedClientCertificate() = %v, want %v", tt.name, got, tt.wantStatus)
+			}
+			if hasCertificate != (tt.wantPrincipal != nil) {
+				t.Errorf("%q. AuthorizedClientCertificate() certificate on context = %t, want %t", tt.name, hasCertificate, tt.wantPrincipal != nil)
+			}
+			if tt.wantPrincipal == nil {
+				if principal != nil {
+					t.Errorf("%q. AuthorizedClientCertificate() principal = %v, want none", tt.name, principal)
+				}
+				return
+			}
+			if principal == nil {
+				t.Fatalf("%q. AuthorizedClientCertificate() principal = none, want %v", tt.name, tt.wantPrincipal)
+			}
+			if principal.Subject != tt.wantPrincipal.Subject || principal.Issuer != tt.wantPrincipal.Issuer || principal.Organization != tt.wantPrincipal.Organization {
+				t.Errorf("%q. AuthorizedClientCertificate() principal = %v, want %v", tt.name, principal, tt.wantPrincipal)
+			}
+		})
+	}
+}
+
+func TestService_NewCertificate(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "Map a certificate",
+			body:       `{"subject":"CN=ingest,O=Acme","userId":"3","organizationId":"1"}`,
+			wantStatus: http.StatusCreated,
+			wantBody:   `{"links":{"self":"/chronograf/v1/certificates/2"},"id":"2","subject":"CN=ingest,O=Acme","userId":"3","organizationId":"1"}`,
+		},
+		{
+			name:       "Missing subject",
+			body:       `{"userId":"3"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Unknown user",
+			body:       `{"subject":"CN=ingest,O=Acme","userId":"4"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Unknown organization",
+			body:       `{"subject":"CN=ingest,O=Acme","userId":"3","organizationId":"2"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Subject already mapped",
+			body:       `{"subject":"CN=billing,O=Acme","userId":"3"}`,
+			wantStatus: http.StatusConflict,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{
+				Store: &mocks.Store{
+					CertificatesStore: &mocks.CertificatesStore{
+						AllF: func(ctx context.Context) ([]chronograf.CertificateMapping, error) {
+							return []chronograf.CertificateMapping{
+								{ID: "1", Subject: "CN=billing,O=Acme", UserID: 5},
+							}, nil
+						},
+						AddF: func(ctx context.Context, m *chronograf.CertificateMapping) (*chronograf.CertificateMapping, error) {
+							m.ID = "2"
+							return m, nil
+						},
+					},
+					UsersStore: &mocks.UsersStore{
+						GetF: func(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+							if *q.ID != 3 {
+								return nil, chronograf.ErrUserNotFound
+							}
+							return &chronograf.User{ID: 3, Name: "ingest"}, nil
+						},
+					},
+					OrganizationsStore: &mocks.OrganizationsStore{
+						GetF: func(ctx context.Context, q chronograf.OrganizationQuery) (*chronograf.Organization, error) {
+							if *q.ID != "1" {
+								return nil, chronograf.ErrOrganizationNotFound
+							}
+							return &chronograf.Organization{ID: "1"}, nil
+						},
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url", bytes.NewBufferString(tt.body))
+			s.NewCertificate(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("%q. NewCertificate() = %v, want %v: %s", tt.name, resp.StatusCode, tt.wantStatus, body)
+			}
+			if eq, _ := jsonEqual(string(body), tt.wantBody); tt.wantBody != "" && !eq {
+				t.Errorf("%q. NewCertificate() = \n***%v***\n,\nwant\n***%v***", tt.name, string(body), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestService_RemoveCertificate(t *testing.T) {
+	var deleted *chronograf.CertificateMapping
+	s := &Service{
+		Store: &mocks.Store{
+			CertificatesStore: &mocks.CertificatesStore{
+				GetF: func(ctx context.Context, id string) (*chronograf.CertificateMapping, error) {
+					if id != "1" {
+						return nil, chronograf.ErrCertificateMappingNotFound
+					}
+					return &chronograf.CertificateMapping{ID: "1"}, nil
+				},
+				DeleteF: func(ctx context.Context, m *chronograf.CertificateMapping) error {
+					deleted = m
+					return nil
+				},
+			},
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+
+	for id, want := range map[string]int{"1": http.StatusNoContent, "2": http.StatusNotFound} {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("DELETE", "http://any.url", nil)
+		r = r.WithContext(httprouter.WithParams(r.Context(), httprouter.Params{{Key: "id", Value: id}}))
+		s.RemoveCertificate(w, r)
+		if got := w.Result().StatusCode; got != want {
+			t.Errorf("RemoveCertificate(%s) = %v, want %v", id, got, want)
+		}
+	}
+	if deleted == nil || deleted.ID != "1" {
+		t.Errorf("RemoveCertificate() deleted %v, want mapping 1", deleted)
+	}
+}
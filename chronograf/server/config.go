@@ -1,15 +1,18 @@
 package server
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 
 	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/roles"
 )
 
 type configLinks struct {
-	Self string `json:"self"` // Self link mapping to this resource
-	Auth string `json:"auth"` // Auth link to the auth config endpoint
+	Self   string `json:"self"`   // Self link mapping to this resource
+	Auth   string `json:"auth"`   // Auth link to the auth config endpoint
+	Server string `json:"server"` // Server link to the effective options of the server
 }
 
 type selfLinks struct {
@@ -24,8 +27,9 @@ type configResponse struct {
 func newConfigResponse(config chronograf.Config) *configResponse {
 	return &configResponse{
 		Links: configLinks{
-			Self: "/chronograf/v1/config",
-			Auth: "/chronograf/v1/config/auth",
+			Self:   "/chronograf/v1/config",
+			Auth:   "/chronograf/v1/config/auth",
+			Server: "/chronograf/v1/config/server",
 		},
 		Config: config,
 	}
@@ -45,6 +49,23 @@ func newAuthConfigResponse(config chronograf.Config) *authConfigResponse {
 	}
 }
 
+type serverConfigResponse struct {
+	Links   selfLinks              `json:"links"`
+	Options map[string]interface{} `json:"options"`
+}
+
+func newServerConfigResponse(options map[string]interface{}) *serverConfigResponse {
+	if options == nil {
+		options = map[string]interface{}{}
+	}
+	return &serverConfigResponse{
+		Links: selfLinks{
+			Self: "/chronograf/v1/config/server",
+		},
+		Options: options,
+	}
+}
+
 // Config retrieves the global application configuration
 func (s *Service) Config(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -84,6 +105,23 @@ func (s *Service) AuthConfig(w http.ResponseWriter, r *http.Request) {
 	encodeJSON(w, http.StatusOK, res, s.Logger)
 }
 
+// validAuthConfig checks that the auto-provisioning policy names an existing
+// organization and a known role
+func (s *Service) validAuthConfig(ctx context.Context, c *chronograf.AuthConfig) error {
+	switch c.NewUsersRole {
+	case "", roles.MemberRoleName, roles.ViewerRoleName, roles.EditorRoleName, roles.AdminRoleName, roles.WildcardRoleName:
+	default:
+		return errorf("unknown role %s. Valid roles are 'member', 'viewer', 'editor', 'admin', and '*'", c.NewUsersRole)
+	}
+	if c.NewUsersOrganization != "" {
+		id := c.NewUsersOrganization
+		if _, err := s.Store.Organizations(ctx).Get(ctx, chronograf.OrganizationQuery{ID: &id}); err != nil {
+			return errorf("organization %s of new users not found", id)
+		}
+	}
+	return nil
+}
+
 // ReplaceAuthConfig replaces the auth section of the global application configuration
 func (s *Service) ReplaceAuthConfig(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -93,6 +131,10 @@ func (s *Service) ReplaceAuthConfig(w http.ResponseWriter, r *http.Request) {
 		invalidJSON(w, s.Logger)
 		return
 	}
+	if err := s.validAuthConfig(serverContext(ctx), &authConfig); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
 
 	config, err := s.Store.Config(ctx).Get(ctx)
 	if err != nil {
@@ -113,3 +155,10 @@ func (s *Service) ReplaceAuthConfig(w http.ResponseWriter, r *http.Request) {
 
 	encodeJSON(w, http.StatusOK, res, s.Logger)
 }
+
+// ServerConfig retrieves the effective options of the server keyed by their
+// long names, the values of secrets being redacted
+func (s *Service) ServerConfig(w http.ResponseWriter, r *http.Request) {
+	res := newServerConfigResponse(s.ServerOptions)
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/http/httptest"
@@ -40,7 +41,7 @@ func TestConfig(t *testing.T) {
 			wants: wants{
 				statusCode:  200,
 				contentType: "application/json",
-				body:        `{"links":{"self":"/chronograf/v1/config","auth":"/chronograf/v1/config/auth"},"auth":{"superAdminNewUsers":false}}`,
+				body:        `{"links":{"self":"/chronograf/v1/config","auth":"/chronograf/v1/config/auth","server":"/chronograf/v1/config/server"},"auth":{"superAdminNewUsers":false,"autoProvisionNewUsers":false,"newUsersOrganization":"","newUsersRole":""}}`,
 			},
 		},
 	}
@@ -105,7 +106,7 @@ func TestAuthConfig(t *testing.T) {
 			wants: wants{
 				statusCode:  200,
 				contentType: "application/json",
-				body:        `{"superAdminNewUsers": false, "links": {"self": "/chronograf/v1/config/auth"}}`,
+				body:        `{"superAdminNewUsers": false, "autoProvisionNewUsers": false, "newUsersOrganization": "", "newUsersRole": "", "links": {"self": "/chronograf/v1/config/auth"}}`,
 			},
 		},
 	}
@@ -143,7 +144,8 @@ func TestAuthConfig(t *testing.T) {
 
 func TestReplaceAuthConfig(t *testing.T) {
 	type fields struct {
-		ConfigStore chronograf.ConfigStore
+		ConfigStore        chronograf.ConfigStore
+		OrganizationsStore chronograf.OrganizationsStore
 	}
 	type args struct {
 		payload interface{} // expects JSON serializable struct
@@ -179,7 +181,73 @@ func TestReplaceAuthConfig(t *testing.T) {
 			wants: wants{
 				statusCode:  200,
 				contentType: "application/json",
-				body:        `{"superAdminNewUsers": true, "links": {"self": "/chronograf/v1/config/auth"}}`,
+				body:        `{"superAdminNewUsers": true, "autoProvisionNewUsers": false, "newUsersOrganization": "", "newUsersRole": "", "links": {"self": "/chronograf/v1/config/auth"}}`,
+			},
+		},
+		{
+			name: "Set auto-provisioning policy",
+			fields: fields{
+				ConfigStore: &mocks.ConfigStore{
+					Config: &chronograf.Config{},
+				},
+				OrganizationsStore: &mocks.OrganizationsStore{
+					GetF: func(ctx context.Context, q chronograf.OrganizationQuery) (*chronograf.Organization, error) {
+						return &chronograf.Organization{ID: *q.ID}, nil
+					},
+				},
+			},
+			args: args{
+				payload: chronograf.AuthConfig{
+					AutoProvisionNewUsers: true,
+					NewUsersOrganization:  "1337",
+					NewUsersRole:          "viewer",
+				},
+			},
+			wants: wants{
+				statusCode:  200,
+				contentType: "application/json",
+				body:        `{"superAdminNewUsers": false, "autoProvisionNewUsers": true, "newUsersOrganization": "1337", "newUsersRole": "viewer", "links": {"self": "/chronograf/v1/config/auth"}}`,
+			},
+		},
+		{
+			name: "Unknown role of new users",
+			fields: fields{
+				ConfigStore: &mocks.ConfigStore{
+					Config: &chronograf.Config{},
+				},
+			},
+			args: args{
+				payload: chronograf.AuthConfig{
+					AutoProvisionNewUsers: true,
+					NewUsersRole:          "owner",
+				},
+			},
+			wants: wants{
+				statusCode: 422,
+				body:       `{"code":422,"message":"unknown role owner. Valid roles are 'member', 'viewer', 'editor', 'admin', and '*'","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"unknown role owner. Valid roles are 'member', 'viewer', 'editor', 'admin', and '*'"}`,
+			},
+		},
+		{
+			name: "Unknown organization of new users",
+			fields: fields{
+				ConfigStore: &mocks.ConfigStore{
+					Config: &chronograf.Config{},
+				},
+				OrganizationsStore: &mocks.OrganizationsStore{
+					GetF: func(ctx context.Context, q chronograf.OrganizationQuery) (*chronograf.Organization, error) {
+						return nil, chronograf.ErrOrganizationNotFound
+					},
+				},
+			},
+			args: args{
+				payload: chronograf.AuthConfig{
+					AutoProvisionNewUsers: true,
+					NewUsersOrganization:  "1337",
+				},
+			},
+			wants: wants{
+				statusCode: 422,
+				body:       `{"code":422,"message":"organization 1337 of new users not found","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"organization 1337 of new users not found"}`,
 			},
 		},
 	}
@@ -188,7 +256,8 @@ func TestReplaceAuthConfig(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			s := &Service{
 				Store: &mocks.Store{
-					ConfigStore: tt.fields.ConfigStore,
+					ConfigStore:        tt.fields.ConfigStore,
+					OrganizationsStore: tt.fields.OrganizationsStore,
 				},
 				Logger: &chronograf.NoopLogger{},
 			}
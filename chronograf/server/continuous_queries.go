@@ -0,0 +1,480 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/influxdata/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxql"
+)
+
+type cqLinks struct {
+	Self    string `json:"self"`    // Self link mapping to this resource
+	Preview string `json:"preview"` // Preview runs the query of the continuous query without writing its results
+}
+
+type cqResponse struct {
+	chronograf.ContinuousQuery
+	Interval string  `json:"interval,omitempty"` // the GROUP BY time() interval of the query
+	Every    string  `json:"every,omitempty"`    // how often the query runs, once resampling defaults are applied
+	For      string  `json:"for,omitempty"`      // the time range covered by each run, once resampling defaults are applied
+	Links    cqLinks `json:"links"`
+}
+
+func newCQResponse(srcID int, db string, cq chronograf.ContinuousQuery) cqResponse {
+	base := fmt.Sprintf("/chronograf/v1/sources/%d/dbs/%s/cqs", srcID, db)
+	res := cqResponse{
+		ContinuousQuery: cq,
+		Links: cqLinks{
+			Self:    fmt.Sprintf("%s/%s", base, cq.Name),
+			Preview: fmt.Sprintf("%s/preview", base),
+		},
+	}
+
+	// Continuous queries created outside of chronograf are listed even if
+	// their query cannot be parsed, only without their intervals
+	stmt, err := influxql.ParseStatement(cq.Query)
+	if err != nil {
+		return res
+	}
+	sel, ok := stmt.(*influxql.SelectStatement)
+	if !ok {
+		return res
+	}
+	interval, err := sel.GroupByInterval()
+	if err != nil || interval == 0 {
+		return res
+	}
+	every, window := interval, interval
+	if d, err := influxql.ParseDuration(cq.ResampleEvery); err == nil {
+		every = d
+	}
+	if d, err := influxql.ParseDuration(cq.ResampleFor); err == nil {
+		window = d
+	}
+	res.Interval = influxql.FormatDuration(interval)
+	res.Every = influxql.FormatDuration(every)
+	res.For = influxql.FormatDuration(window)
+	return res
+}
+
+type cqsResponse struct {
+	ContinuousQueries []cqResponse `json:"continuousQueries"`
+}
+
+// ValidContinuousQueryRequest checks that a continuous query is named, selects
+// INTO a target grouped by time and has a valid resample schedule. It returns
+// the parsed SELECT statement of the continuous query.
+func ValidContinuousQueryRequest(cq *chronograf.ContinuousQuery) (*influxql.SelectStatement, error) {
+	if len(cq.Name) == 0 {
+		return nil, fmt.Errorf("name is required")
+	}
+	stmt, err := influxql.ParseStatement(cq.Query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid query: %v", err)
+	}
+	sel, ok := stmt.(*influxql.SelectStatement)
+	if !ok {
+		return nil, fmt.Errorf("query must be a SELECT statement")
+	}
+	if sel.Target == nil {
+		return nil, fmt.Errorf("query must select INTO a measurement")
+	}
+	if interval, err := sel.GroupByInterval(); err != nil || interval == 0 {
+		return nil, fmt.Errorf("query must GROUP BY time()")
+	}
+	for _, d := range []string{cq.ResampleEvery, cq.ResampleFor} {
+		if d == "" {
+			continue
+		}
+		if _, err := influxql.ParseDuration(d); err != nil {
+			return nil, fmt.Errorf("invalid resample duration %s", d)
+		}
+	}
+	return sel, nil
+}
+
+// sourceDatabases connects the database service to the source of the route.
+// It writes the error response and returns false if that fails.
+func (s *Service) sourceDatabases(w http.ResponseWriter, r *http.Request) (chronograf.Source, chronograf.Databases, bool) {
+	ctx := r.Context()
+
+	srcID, err := paramID("id", r)
+	if err != nil {
+		Error(w, http.StatusUnprocessableEntity, err.Error(), s.Logger)
+		return chronograf.Source{}, nil, false
+	}
+
+	src, err := s.Store.Sources(ctx).Get(ctx, srcID)
+	if err != nil {
+		notFound(w, srcID, s.Logger)
+		return chronograf.Source{}, nil, false
+	}
+
+	dbsvc := s.Databases
+	if err = dbsvc.Connect(ctx, &src); err != nil {
+		msg := fmt.Sprintf("unable to connect to source %d: %v", srcID, err)
+		Error(w, http.StatusBadRequest, msg, s.Logger)
+		return chronograf.Source{}, nil, false
+	}
+	return src, dbsvc, true
+}
+
+// ContinuousQueries lists the continuous queries of a database
+func (s *Service) ContinuousQueries(w http.ResponseWriter, r *http.Request) {
+	src, dbsvc, ok := s.sourceDatabases(w, r)
+	if !ok {
+		return
+	}
+
+	ctx := r.Context()
+	db := httprouter.ParamsFromContext(ctx).ByName("db")
+	cqs, err := dbsvc.AllCQ(ctx, db)
+	if err != nil {
+		msg := fmt.Sprintf("unable to get continuous queries %d: %v", src.ID, err)
+		Error(w, http.StatusBadRequest, msg, s.Logger)
+		return
+	}
+
+	res := cqsResponse{
+		ContinuousQueries: make([]cqResponse, len(cqs)),
+	}
+	for i, cq := range cqs {
+		res.ContinuousQueries[i] = newCQResponse(src.ID, db, cq)
+	}
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// ContinuousQueryID returns a single continuous query of a database
+func (s *Service) ContinuousQueryID(w http.ResponseWriter, r *http.Request) {
+	src, dbsvc, ok := s.sourceDatabases(w, r)
+	if !ok {
+		return
+	}
+
+	ctx := r.Context()
+	params := httprouter.ParamsFromContext(ctx)
+	db, name := params.ByName("db"), params.ByName("cq")
+	cqs, err := dbsvc.AllCQ(ctx, db)
+	if err != nil {
+		msg := fmt.Sprintf("unable to get continuous queries %d: %v", src.ID, err)
+		Error(w, http.StatusBadRequest, msg, s.Logger)
+		return
+	}
+	for _, cq := range cqs {
+		if cq.Name == name {
+			encodeJSON(w, http.StatusOK, newCQResponse(src.ID, db, cq), s.Logger)
+			return
+		}
+	}
+	notFound(w, name, s.Logger)
+}
+
+// NewContinuousQuery creates a continuous query within a database from its
+// query or from a builder of its query
+func (s *Service) NewContinuousQuery(w http.ResponseWriter, r *http.Request) {
+	var req cqRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	db := httprouter.ParamsFromContext(ctx).ByName("db")
+	cq, err := req.build(db)
+	if err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	if _, err := ValidContinuousQueryRequest(&cq); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	src, dbsvc, ok := s.sourceDatabases(w, r)
+	if !ok {
+		return
+	}
+
+	created, err := dbsvc.CreateCQ(ctx, db, &cq)
+	if err != nil {
+		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+		return
+	}
+
+	res := newCQResponse(src.ID, db, *created)
+	location(w, res.Links.Self)
+	encodeJSON(w, http.StatusCreated, res, s.Logger)
+}
+
+// UpdateContinuousQuery replaces the query or schedule of a continuous query.
+// InfluxDB cannot alter continuous queries, so the continuous query is dropped
+// and created again; if creating the new version fails, the previous one is
+// restored.
+func (s *Service) UpdateContinuousQuery(w http.ResponseWriter, r *http.Request) {
+	var req cqRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	params := httprouter.ParamsFromContext(ctx)
+	db, name := params.ByName("db"), params.ByName("cq")
+	cq, err := req.build(db)
+	if err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	if cq.Name == "" {
+		cq.Name = name
+	}
+	if _, err := ValidContinuousQueryRequest(&cq); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	src, dbsvc, ok := s.sourceDatabases(w, r)
+	if !ok {
+		return
+	}
+
+	cqs, err := dbsvc.AllCQ(ctx, db)
+	if err != nil {
+		msg := fmt.Sprintf("unable to get continuous queries %d: %v", src.ID, err)
+		Error(w, http.StatusBadRequest, msg, s.Logger)
+		return
+	}
+	var previous *chronograf.ContinuousQuery
+	for i := range cqs {
+		if cqs[i].Name == name {
+			previous = &cqs[i]
+		}
+	}
+	if previous == nil {
+		notFound(w, name, s.Logger)
+		return
+	}
+
+	if err := dbsvc.DropCQ(ctx, db, name); err != nil {
+		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+		return
+	}
+	updated, err := dbsvc.CreateCQ(ctx, db, &cq)
+	if err != nil {
+		if _, restoreErr := dbsvc.CreateCQ(ctx, db, previous); restoreErr != nil {
+			s.Logger.
+				WithField("component", "server").
+				WithField("continuous_query", name).
+				Error("Unable to restore continuous query: ", restoreErr)
+		}
+		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+		return
+	}
+
+	encodeJSON(w, http.StatusOK, newCQResponse(src.ID, db, *updated), s.Logger)
+}
+
+// DropContinuousQuery removes a continuous query from a database
+func (s *Service) DropContinuousQuery(w http.ResponseWriter, r *http.Request) {
+	_, dbsvc, ok := s.sourceDatabases(w, r)
+	if !ok {
+		return
+	}
+
+	ctx := r.Context()
+	params := httprouter.ParamsFromContext(ctx)
+	if err := dbsvc.DropCQ(ctx, params.ByName("db"), params.ByName("cq")); err != nil {
+		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// PreviewContinuousQuery runs the query of a continuous query without its INTO
+// clause over the time range the next run would cover and returns the
+// results it would write.
+func (s *Service) PreviewContinuousQuery(w http.ResponseWriter, r *http.Request) {
+	var req cqRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	db := httprouter.ParamsFromContext(ctx).ByName("db")
+	cq, err := req.build(db)
+	if err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	if cq.Name == "" {
+		cq.Name = "preview"
+	}
+	sel, err := ValidContinuousQueryRequest(&cq)
+	if err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	id, err := paramID("id", r)
+	if err != nil {
+		Error(w, http.StatusUnprocessableEntity, err.Error(), s.Logger)
+		return
+	}
+	src, err := s.Store.Sources(ctx).Get(ctx, id)
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+
+	ts, err := s.TimeSeries(src)
+	if err != nil {
+		msg := fmt.Sprintf("unable to connect to source %d: %v", id, err)
+		Error(w, http.StatusBadRequest, msg, s.Logger)
+		return
+	}
+	if err = ts.Connect(ctx, &src); err != nil {
+		msg := fmt.Sprintf("unable to connect to source %d: %v", id, err)
+		Error(w, http.StatusBadRequest, msg, s.Logger)
+		return
+	}
+
+	response, err := ts.Query(ctx, chronograf.Query{
+		Command: previewCQ(sel, cq.ResampleFor),
+		DB:      db,
+	})
+	if err != nil {
+		if err == chronograf.ErrUpstreamTimeout {
+			msg := "Timeout waiting for Influx response"
+			Error(w, http.StatusRequestTimeout, msg, s.Logger)
+			return
+		}
+		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+		return
+	}
+	encodeJSON(w, http.StatusOK, postInfluxResponse{Results: response}, s.Logger)
+}
+
+// previewCQ rewrites the SELECT of a continuous query to read the time range
+// of its next run without writing INTO its target.
+func previewCQ(sel *influxql.SelectStatement, resampleFor string) string {
+	preview := sel.Clone()
+	preview.Target = nil
+
+	window, _ := preview.GroupByInterval()
+	if d, err := influxql.ParseDuration(resampleFor); err == nil && d > window {
+		window = d
+	}
+
+	since := &influxql.BinaryExpr{
+		Op:  influxql.GTE,
+		LHS: &influxql.VarRef{Val: "time"},
+		RHS: &influxql.BinaryExpr{
+			Op:  influxql.SUB,
+			LHS: &influxql.Call{Name: "now"},
+			RHS: &influxql.DurationLiteral{Val: window},
+		},
+	}
+	if preview.Condition == nil {
+		preview.Condition = since
+	} else {
+		preview.Condition = &influxql.BinaryExpr{
+			Op:  influxql.AND,
+			LHS: &influxql.ParenExpr{Expr: preview.Condition},
+			RHS: since,
+		}
+	}
+	return preview.String()
+}
+
+type rpPreviewResponse struct {
+	RetentionPolicy string             `json:"retentionPolicy"`  // the retention policy the preview is for
+	Duration        string             `json:"duration"`         // the proposed duration of the retention policy
+	Cutoff          *time.Time         `json:"cutoff,omitempty"` // data older than cutoff is dropped; absent for infinite durations
+	Shards          []chronograf.Shard `json:"shards"`           // the shards that would be dropped
+}
+
+// PreviewRetentionPolicy reports the shards that would be dropped if the
+// retention policy were altered to the posted duration.
+func (s *Service) PreviewRetentionPolicy(w http.ResponseWriter, r *http.Request) {
+	var upd chronograf.RetentionPolicy
+	if err := json.NewDecoder(r.Body).Decode(&upd); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	if upd.Duration == "" {
+		invalidData(w, fmt.Errorf("duration is required"), s.Logger)
+		return
+	}
+	var duration time.Duration
+	if !strings.EqualFold(upd.Duration, "INF") {
+		d, err := influxql.ParseDuration(upd.Duration)
+		if err != nil {
+			invalidData(w, fmt.Errorf("invalid duration %s", upd.Duration), s.Logger)
+			return
+		}
+		duration = d
+	}
+
+	src, dbsvc, ok := s.sourceDatabases(w, r)
+	if !ok {
+		return
+	}
+
+	ctx := r.Context()
+	params := httprouter.ParamsFromContext(ctx)
+	db, rp := params.ByName("db"), params.ByName("rp")
+	shards, err := dbsvc.AllShards(ctx, db)
+	if err != nil {
+		msg := fmt.Sprintf("unable to get shards %d: %v", src.ID, err)
+		Error(w, http.StatusBadRequest, msg, s.Logger)
+		return
+	}
+
+	res := rpPreviewResponse{
+		RetentionPolicy: rp,
+		Duration:        upd.Duration,
+		Shards:          []chronograf.Shard{},
+	}
+	// A duration of zero keeps data forever
+	if duration > 0 {
+		cutoff := time.Now().UTC().Add(-duration)
+		res.Cutoff = &cutoff
+		for _, shard := range shards {
+			if shard.RetentionPolicy == rp && shard.EndTime.Before(cutoff) {
+				res.Shards = append(res.Shards, shard)
+			}
+		}
+	}
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// RetentionPolicyID returns a single retention policy of a database
+func (s *Service) RetentionPolicyID(w http.ResponseWriter, r *http.Request) {
+	src, dbsvc, ok := s.sourceDatabases(w, r)
+	if !ok {
+		return
+	}
+
+	ctx := r.Context()
+	params := httprouter.ParamsFromContext(ctx)
+	db, name := params.ByName("db"), params.ByName("rp")
+	rps, err := s.allRPs(ctx, dbsvc, src.ID, db)
+	if err != nil {
+		msg := fmt.Sprintf("unable to connect get RPs %d: %v", src.ID, err)
+		Error(w, http.StatusBadRequest, msg, s.Logger)
+		return
+	}
+	for _, rp := range rps {
+		if rp.Name == name {
+			encodeJSON(w, http.StatusOK, rp, s.Logger)
+			return
+		}
+	}
+	notFound(w, name, s.Logger)
+}
@@ -0,0 +1,293 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/influxdata/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+func TestValidContinuousQueryRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		cq      chronograf.ContinuousQuery
+		wantErr string
+	}{
+		{
+			name: "Downsampling query",
+			cq: chronograf.ContinuousQuery{
+				Name:          "cpu_1h",
+				Query:         `SELECT mean(usage_idle) INTO telegraf."1y".cpu FROM cpu GROUP BY time(1h), *`,
+				ResampleEvery: "30m",
+				ResampleFor:   "2h",
+			},
+		},
+		{
+			name:    "Missing name",
+			cq:      chronograf.ContinuousQuery{Query: `SELECT mean(v) INTO b FROM a GROUP BY time(1h)`},
+			wantErr: "name is required",
+		},
+		{
+			name:    "Missing INTO",
+			cq:      chronograf.ContinuousQuery{Name: "cq", Query: `SELECT mean(v) FROM a GROUP BY time(1h)`},
+			wantErr: "query must select INTO a measurement",
+		},
+		{
+			name:    "Missing GROUP BY time",
+			cq:      chronograf.ContinuousQuery{Name: "cq", Query: `SELECT mean(v) INTO b FROM a`},
+			wantErr: "query must GROUP BY time()",
+		},
+		{
+			name:    "Not a SELECT",
+			cq:      chronograf.ContinuousQuery{Name: "cq", Query: `SHOW DATABASES`},
+			wantErr: "query must be a SELECT statement",
+		},
+		{
+			name: "Invalid resample duration",
+			cq: chronograf.ContinuousQuery{
+				Name:        "cq",
+				Query:       `SELECT mean(v) INTO b FROM a GROUP BY time(1h)`,
+				ResampleFor: "soon",
+			},
+			wantErr: "invalid resample duration soon",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ValidContinuousQueryRequest(&tt.cq)
+			if (err == nil) != (tt.wantErr == "") || (err != nil && err.Error() != tt.wantErr) {
+				t.Errorf("ValidContinuousQueryRequest() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_previewCQ(t *testing.T) {
+	tests := []struct {
+		name        string
+		query       string
+		resampleFor string
+		want        string
+	}{
+		{
+			name:  "Covers the GROUP BY interval",
+			query: `SELECT mean(v) INTO b FROM a GROUP BY time(1h)`,
+			want:  `SELECT mean(v) FROM a WHERE time >= now() - 1h GROUP BY time(1h)`,
+		},
+		{
+			name:        "Covers the resample range",
+			query:       `SELECT mean(v) INTO b FROM a WHERE host = 'h' GROUP BY time(1h)`,
+			resampleFor: "3h",
+			want:        `SELECT mean(v) FROM a WHERE (host = 'h') AND time >= now() - 3h GROUP BY time(1h)`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sel, err := ValidContinuousQueryRequest(&chronograf.ContinuousQuery{Name: "cq", Query: tt.query})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := previewCQ(sel, tt.resampleFor); got != tt.want {
+				t.Errorf("previewCQ() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_PreviewRetentionPolicy(t *testing.T) {
+	now := time.Now().UTC()
+	s := &Service{
+		Store: &mocks.Store{
+			SourcesStore: &mocks.SourcesStore{
+				GetF: func(ctx context.Context, ID int) (chronograf.Source, error) {
+					return chronograf.Source{ID: 1}, nil
+				},
+			},
+		},
+		Databases: &mocks.Databases{
+			ConnectF: func(ctx context.Context, src *chronograf.Source) error {
+				return nil
+			},
+			AllShardsF: func(ctx context.Context, db string) ([]chronograf.Shard, error) {
+				return []chronograf.Shard{
+					{ID: 1, RetentionPolicy: "autogen", EndTime: now.Add(-30 * 24 * time.Hour)},
+					{ID: 2, RetentionPolicy: "autogen", EndTime: now.Add(-time.Hour)},
+					{ID: 3, RetentionPolicy: "other", EndTime: now.Add(-30 * 24 * time.Hour)},
+				}, nil
+			},
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+
+	tests := []struct {
+		name       string
+		body       string
+		wantShards []uint64
+	}{
+		{
+			name:       "Shorter duration drops old shards",
+			body:       `{"duration":"7d"}`,
+			wantShards: []uint64{1},
+		},
+		{
+			name: "Infinite duration drops nothing",
+			body: `{"duration":"INF"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url", bytes.NewBufferString(tt.body))
+			r = r.WithContext(context.WithValue(r.Context(), httprouter.ParamsKey, httprouter.Params{
+				{Key: "id", Value: "1"},
+				{Key: "db", Value: "telegraf"},
+				{Key: "rp", Value: "autogen"},
+			}))
+
+			s.PreviewRetentionPolicy(w, r)
+
+			resp := w.Result()
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("PreviewRetentionPolicy() = %v, want %v", resp.StatusCode, http.StatusOK)
+			}
+			var res rpPreviewResponse
+			if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+				t.Fatal(err)
+			}
+			if len(res.Shards) != len(tt.wantShards) {
+				t.Fatalf("PreviewRetentionPolicy() shards = %v, want %v", res.Shards, tt.wantShards)
+			}
+			for i, shard := range res.Shards {
+				if shard.ID != tt.wantShards[i] {
+					t.Errorf("PreviewRetentionPolicy() shard %d = %d, want %d", i, shard.ID, tt.wantShards[i])
+				}
+			}
+		})
+	}
+}
+
+func Test_newCQResponse(t *testing.T) {
+	tests := []struct {
+		name      string
+		cq        chronograf.ContinuousQuery
+		wantEvery string
+		wantFor   string
+	}{
+		{
+			name:      "Intervals default to the GROUP BY time() interval",
+			cq:        chronograf.ContinuousQuery{Name: "cpu_1h", Query: `SELECT mean(usage_idle) INTO cpu_1h FROM cpu GROUP BY time(1h)`},
+			wantEvery: "1h",
+			wantFor:   "1h",
+		},
+		{
+			name:      "Resampled",
+			cq:        chronograf.ContinuousQuery{Name: "cpu_1h", Query: `SELECT mean(usage_idle) INTO cpu_1h FROM cpu GROUP BY time(1h)`, ResampleEvery: "30m", ResampleFor: "2h"},
+			wantEvery: "30m",
+			wantFor:   "2h",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newCQResponse(1, "telegraf", tt.cq)
+			if got.Interval != "1h" || got.Every != tt.wantEvery || got.For != tt.wantFor {
+				t.Errorf("newCQResponse() intervals = %s %s %s, want 1h %s %s", got.Interval, got.Every, got.For, tt.wantEvery, tt.wantFor)
+			}
+			if want := "/chronograf/v1/sources/1/dbs/telegraf/cqs/cpu_1h"; got.Links.Self != want {
+				t.Errorf("newCQResponse() self = %s, want %s", got.Links.Self, want)
+			}
+		})
+	}
+}
+
+func TestService_NewContinuousQuery(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantQuery  string
+	}{
+		{
+			name:       "Query",
+			body:       `{"name":"cpu_1h","query":"SELECT mean(usage_idle) INTO cpu_1h FROM cpu GROUP BY time(1h)"}`,
+			wantStatus: http.StatusCreated,
+			wantQuery:  "SELECT mean(usage_idle) INTO cpu_1h FROM cpu GROUP BY time(1h)",
+		},
+		{
+			name:       "Builder",
+			body:       `{"name":"cpu_1h","builder":{"measurement":"cpu","fields":[{"func":"mean","field":"usage_idle"}],"interval":"1h","into":{"retentionPolicy":"1y","measurement":"cpu_1h"}}}`,
+			wantStatus: http.StatusCreated,
+			wantQuery:  `SELECT mean(usage_idle) AS mean_usage_idle INTO telegraf."1y".cpu_1h FROM telegraf..cpu GROUP BY time(1h)`,
+		},
+		{
+			name:       "Query and builder",
+			body:       `{"name":"cpu_1h","query":"SELECT mean(usage_idle) INTO cpu_1h FROM cpu GROUP BY time(1h)","builder":{"measurement":"cpu","fields":[{"func":"mean","field":"usage_idle"}],"interval":"1h","into":{"measurement":"cpu_1h"}}}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Invalid builder",
+			body:       `{"name":"cpu_1h","builder":{"measurement":"cpu","interval":"1h","into":{"measurement":"cpu_1h"}}}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var created *chronograf.ContinuousQuery
+			s := &Service{
+				Store: &mocks.Store{
+					SourcesStore: &mocks.SourcesStore{
+						GetF: func(ctx context.Context, ID int) (chronograf.Source, error) {
+							return chronograf.Source{ID: 1}, nil
+						},
+					},
+				},
+				Databases: &mocks.Databases{
+					ConnectF: func(ctx context.Context, src *chronograf.Source) error {
+						return nil
+					},
+					CreateCQF: func(ctx context.Context, db string, cq *chronograf.ContinuousQuery) (*chronograf.ContinuousQuery, error) {
+						created = cq
+						return cq, nil
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url", bytes.NewBufferString(tt.body))
+			r = r.WithContext(context.WithValue(r.Context(), httprouter.ParamsKey, httprouter.Params{
+				{Key: "id", Value: "1"},
+				{Key: "db", Value: "telegraf"},
+			}))
+
+			s.NewContinuousQuery(w, r)
+
+			resp := w.Result()
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("NewContinuousQuery() = %v, want %v", resp.StatusCode, tt.wantStatus)
+			}
+			if tt.wantStatus != http.StatusCreated {
+				if created != nil {
+					t.Errorf("NewContinuousQuery() created %+v", created)
+				}
+				return
+			}
+			if created.Query != tt.wantQuery {
+				t.Errorf("NewContinuousQuery() created %s, want %s", created.Query, tt.wantQuery)
+			}
+			var res cqResponse
+			if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+				t.Fatal(err)
+			}
+			if res.Interval != "1h" {
+				t.Errorf("NewContinuousQuery() interval = %s, want 1h", res.Interval)
+			}
+		})
+	}
+}
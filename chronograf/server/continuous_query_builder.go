@@ -0,0 +1,146 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxql"
+)
+
+// cqAggregates are the functions a continuous query built from a cqBuilder
+// may downsample fields with
+var cqAggregates = map[string]bool{
+	"count":    true,
+	"distinct": true,
+	"first":    true,
+	"last":     true,
+	"max":      true,
+	"mean":     true,
+	"median":   true,
+	"min":      true,
+	"mode":     true,
+	"spread":   true,
+	"stddev":   true,
+	"sum":      true,
+}
+
+// cqField is a field downsampled by a continuous query
+type cqField struct {
+	Func  string `json:"func"`            // Func is the aggregate applied to the field, such as mean
+	Field string `json:"field"`           // Field is the name of the field
+	Alias string `json:"alias,omitempty"` // Alias is the field written to; defaults to func_field
+}
+
+// cqTarget is the measurement a continuous query writes into
+type cqTarget struct {
+	RetentionPolicy string `json:"retentionPolicy,omitempty"` // RetentionPolicy defaults to the default one of the database
+	Measurement     string `json:"measurement"`
+}
+
+// cqBuilder describes a continuous query downsampling a measurement, so that
+// it can be created without writing InfluxQL
+type cqBuilder struct {
+	RetentionPolicy string    `json:"retentionPolicy,omitempty"` // RetentionPolicy is read from; defaults to the default one of the database
+	Measurement     string    `json:"measurement"`               // Measurement is read from
+	Fields          []cqField `json:"fields"`
+	Tags            []string  `json:"tags,omitempty"` // Tags are grouped by; * groups by every tag
+	Interval        string    `json:"interval"`       // Interval is the GROUP BY time() of the query
+	Into            cqTarget  `json:"into"`
+}
+
+// Query returns the SELECT ... INTO ... GROUP BY time() statement of the
+// builder in database db. Identifiers are quoted by the InfluxQL AST.
+func (b *cqBuilder) Query(db string) (string, error) {
+	if b.Measurement == "" {
+		return "", fmt.Errorf("builder measurement is required")
+	}
+	if b.Into.Measurement == "" {
+		return "", fmt.Errorf("builder into measurement is required")
+	}
+	if len(b.Fields) == 0 {
+		return "", fmt.Errorf("builder requires at least one field")
+	}
+	interval, err := influxql.ParseDuration(b.Interval)
+	if err != nil || interval <= 0 {
+		return "", fmt.Errorf("invalid builder interval %s", b.Interval)
+	}
+
+	sel := &influxql.SelectStatement{
+		Target: &influxql.Target{
+			Measurement: &influxql.Measurement{
+				Database:        db,
+				RetentionPolicy: b.Into.RetentionPolicy,
+				Name:            b.Into.Measurement,
+			},
+		},
+		Sources: influxql.Sources{
+			&influxql.Measurement{
+				Database:        db,
+				RetentionPolicy: b.RetentionPolicy,
+				Name:            b.Measurement,
+			},
+		},
+		Dimensions: influxql.Dimensions{
+			{
+				Expr: &influxql.Call{
+					Name: "time",
+					Args: []influxql.Expr{&influxql.DurationLiteral{Val: interval}},
+				},
+			},
+		},
+	}
+	for _, f := range b.Fields {
+		fn := strings.ToLower(f.Func)
+		if !cqAggregates[fn] {
+			return "", fmt.Errorf("unsupported builder function %s", f.Func)
+		}
+		if f.Field == "" {
+			return "", fmt.Errorf("builder field is required")
+		}
+		alias := f.Alias
+		if alias == "" {
+			alias = fn + "_" + f.Field
+		}
+		sel.Fields = append(sel.Fields, &influxql.Field{
+			Expr: &influxql.Call{
+				Name: fn,
+				Args: []influxql.Expr{&influxql.VarRef{Val: f.Field}},
+			},
+			Alias: alias,
+		})
+	}
+	for _, tag := range b.Tags {
+		var expr influxql.Expr = &influxql.VarRef{Val: tag}
+		if tag == "*" {
+			expr = &influxql.Wildcard{}
+		}
+		sel.Dimensions = append(sel.Dimensions, &influxql.Dimension{Expr: expr})
+	}
+	return sel.String(), nil
+}
+
+// cqRequest is a continuous query posted either with its query or with a
+// builder of its query
+type cqRequest struct {
+	chronograf.ContinuousQuery
+	Builder *cqBuilder `json:"builder,omitempty"`
+}
+
+// build returns the continuous query of the request in database db,
+// building its query when a builder is posted instead
+func (req *cqRequest) build(db string) (chronograf.ContinuousQuery, error) {
+	if req.Builder == nil {
+		return req.ContinuousQuery, nil
+	}
+	if req.Query != "" {
+		return chronograf.ContinuousQuery{}, fmt.Errorf("query and builder are mutually exclusive")
+	}
+	q, err := req.Builder.Query(db)
+	if err != nil {
+		return chronograf.ContinuousQuery{}, err
+	}
+	cq := req.ContinuousQuery
+	cq.Query = q
+	return cq, nil
+}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"testing"
+)
+
+func Test_cqBuilder_Query(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder cqBuilder
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Downsample every tag",
+			builder: cqBuilder{
+				RetentionPolicy: "autogen",
+				Measurement:     "cpu",
+				Fields:          []cqField{{Func: "mean", Field: "usage_idle"}},
+				Tags:            []string{"*"},
+				Interval:        "1h",
+				Into:            cqTarget{RetentionPolicy: "1y", Measurement: "cpu_1h"},
+			},
+			want: `SELECT mean(usage_idle) AS mean_usage_idle INTO telegraf."1y".cpu_1h FROM telegraf.autogen.cpu GROUP BY time(1h), *`,
+		},
+		{
+			name: "Default retention policies and aliased fields",
+			builder: cqBuilder{
+				Measurement: "disk io",
+				Fields: []cqField{
+					{Func: "MAX", Field: "reads", Alias: "reads"},
+					{Func: "sum", Field: `write"s`},
+				},
+				Tags:     []string{"host", "name"},
+				Interval: "5m",
+				Into:     cqTarget{Measurement: "disk_5m"},
+			},
+			want: `SELECT max(reads) AS reads, sum("write\"s") AS "sum_write\"s" INTO telegraf..disk_5m FROM telegraf.."disk io" GROUP BY time(5m), host, "name"`,
+		},
+		{
+			name: "Missing measurement",
+			builder: cqBuilder{
+				Fields:   []cqField{{Func: "mean", Field: "usage_idle"}},
+				Interval: "1h",
+				Into:     cqTarget{Measurement: "cpu_1h"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Missing fields",
+			builder: cqBuilder{
+				Measurement: "cpu",
+				Interval:    "1h",
+				Into:        cqTarget{Measurement: "cpu_1h"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Function that is not an aggregate",
+			builder: cqBuilder{
+				Measurement: "cpu",
+				Fields:      []cqField{{Func: "derivative", Field: "usage_idle"}},
+				Interval:    "1h",
+				Into:        cqTarget{Measurement: "cpu_1h"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Invalid interval",
+			builder: cqBuilder{
+				Measurement: "cpu",
+				Fields:      []cqField{{Func: "mean", Field: "usage_idle"}},
+				Interval:    "1h) DROP",
+				Into:        cqTarget{Measurement: "cpu_1h"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.builder.Query("telegraf")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("cqBuilder.Query() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("cqBuilder.Query() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
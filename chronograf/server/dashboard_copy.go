@@ -0,0 +1,164 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/influxdata/influxdb/chronograf"
+	idgen "github.com/influxdata/influxdb/chronograf/id"
+	"github.com/influxdata/influxdb/chronograf/organizations"
+	"github.com/influxdata/influxdb/chronograf/roles"
+)
+
+// dashboardCopyRequest names the copy of a dashboard and the organization it
+// is created in; both default to those of the copied dashboard.
+type dashboardCopyRequest struct {
+	Name         *string `json:"name"`
+	Organization string  `json:"organization"`
+}
+
+// CopyDashboard creates a copy of a dashboard along with its cells, templates
+// and queries. The cells and templates of the copy have new IDs. Copies into
+// another organization require an editor role within it and are placed
+// outside of any folder; their queries keep the sources of the dashboard.
+func (s *Service) CopyDashboard(w http.ResponseWriter, r *http.Request) {
+	id, err := paramID("id", r)
+	if err != nil {
+		Error(w, http.StatusUnprocessableEntity, err.Error(), s.Logger)
+		return
+	}
+
+	var req dashboardCopyRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			invalidJSON(w, s.Logger)
+			return
+		}
+	}
+
+	ctx := r.Context()
+	orig, err := s.Store.Dashboards(ctx).Get(ctx, chronograf.DashboardID(id))
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+
+	dashboard, err := copyDashboard(orig, &idgen.UUID{})
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	dashboard.Name = orig.Name + " (Clone)"
+	if req.Name != nil {
+		dashboard.Name = *req.Name
+	}
+
+	if req.Organization != "" && req.Organization != orig.Organization {
+		if ctx, err = s.organizationContext(ctx, req.Organization, roles.EditorRoleName); err != nil {
+			Error(w, http.StatusForbidden, err.Error(), s.Logger)
+			return
+		}
+		dashboard.Organization = req.Organization
+		dashboard.Folder = ""
+	}
+
+	if err := s.ensureQuota(ctx, resourceOrganization(ctx, dashboard.Organization), quotaDashboards, 1); err != nil {
+		quotaExceeded(w, err, s.Logger)
+		return
+	}
+
+	if dashboard, err = s.Store.Dashboards(ctx).Add(ctx, dashboard); err != nil {
+		msg := fmt.Errorf("error storing dashboard %v: %v", dashboard, err)
+		unknownErrorWithMessage(w, msg, s.Logger)
+		return
+	}
+	s.recordDashboardVersion(ctx, dashboard.ID)
+	s.publishDashboardEvent(ctx, EventDashboardCreated, dashboard)
+
+	res := newDashboardResponse(dashboard)
+	location(w, res.Links.Self)
+	encodeJSON(w, http.StatusCreated, res, s.Logger)
+}
+
+// organizationContext switches the organization of ctx to orgID if the
+// current user has at least role within it. Super admins and requests
+// without authentication are admins of every organization.
+func (s *Service) organizationContext(ctx context.Context, orgID, role string) (context.Context, error) {
+	serverCtx := serverContext(ctx)
+	if _, err := s.Store.Organizations(serverCtx).Get(serverCtx, chronograf.OrganizationQuery{ID: &orgID}); err != nil {
+		return nil, errorf("organization %s not found", orgID)
+	}
+
+	granted := roles.AdminRoleName
+	if u, ok := hasUserContext(ctx); ok && !u.SuperAdmin {
+		user, err := s.Store.Users(serverCtx).Get(serverCtx, chronograf.UserQuery{ID: &u.ID})
+		if err != nil {
+			return nil, err
+		}
+		granted = ""
+		for _, r := range user.Roles {
+			if r.Organization == orgID && hasAuthorizedRole(&chronograf.User{Roles: []chronograf.Role{r}}, role) {
+				granted = r.Name
+			}
+		}
+		if granted == "" {
+			return nil, errorf("user is not authorized to create resources in organization %s", orgID)
+		}
+	}
+
+	ctx = context.WithValue(ctx, organizations.ContextKey, orgID)
+	return context.WithValue(ctx, roles.ContextKey, granted), nil
+}
+
+// copyDashboard returns a deep copy of d without its ID and slug whose cells
+// and templates have IDs generated by ids
+func copyDashboard(d chronograf.Dashboard, ids chronograf.ID) (chronograf.Dashboard, error) {
+	c := d
+	c.ID = 0
+	c.Slug = ""
+	c.Cells = make([]chronograf.DashboardCell, len(d.Cells))
+	for i, cell := range d.Cells {
+		cid, err := ids.Generate()
+		if err != nil {
+			return chronograf.Dashboard{}, err
+		}
+		cell.ID = cid
+		cell.Queries = append(cell.Queries[:0:0], cell.Queries...)
+		for j, q := range cell.Queries {
+			if q.Range != nil {
+				r := *q.Range
+				cell.Queries[j].Range = &r
+			}
+			cell.Queries[j].Shifts = append(q.Shifts[:0:0], q.Shifts...)
+		}
+		if cell.Axes != nil {
+			axes := make(map[string]chronograf.Axis, len(cell.Axes))
+			for k, a := range cell.Axes {
+				a.Bounds = append(a.Bounds[:0:0], a.Bounds...)
+				axes[k] = a
+			}
+			cell.Axes = axes
+		}
+		cell.CellColors = append(cell.CellColors[:0:0], cell.CellColors...)
+		cell.FieldOptions = append(cell.FieldOptions[:0:0], cell.FieldOptions...)
+		c.Cells[i] = cell
+	}
+
+	c.Templates = make([]chronograf.Template, len(d.Templates))
+	for i, t := range d.Templates {
+		tid, err := ids.Generate()
+		if err != nil {
+			return chronograf.Dashboard{}, err
+		}
+		t.ID = chronograf.TemplateID(tid)
+		t.Values = append(t.Values[:0:0], t.Values...)
+		if t.Query != nil {
+			q := *t.Query
+			t.Query = &q
+		}
+		c.Templates[i] = t
+	}
+	return c, nil
+}
@@ -0,0 +1,173 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jhttprouter "github.com/influxdata/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+	"github.com/influxdata/influxdb/chronograf/roles"
+)
+
+func TestService_CopyDashboard(t *testing.T) {
+	d := chronograf.Dashboard{
+		ID:           1,
+		Name:         "cpu",
+		Organization: "default",
+		Folder:       "ops",
+		Cells: []chronograf.DashboardCell{
+			{
+				ID:      "a",
+				W:       4,
+				H:       4,
+				Queries: []chronograf.DashboardQuery{{Command: "SELECT mean(usage_user) FROM cpu", Source: "/chronograf/v1/sources/1"}},
+				Axes:    map[string]chronograf.Axis{"y": {Bounds: []string{"0", "100"}}},
+			},
+		},
+		Templates: []chronograf.Template{
+			{
+				ID:          "t",
+				TemplateVar: chronograf.TemplateVar{Var: ":host:", Values: []chronograf.TemplateValue{{Type: "csv", Value: "a"}}},
+				Type:        "csv",
+			},
+		},
+	}
+	users := &mocks.UsersStore{
+		GetF: func(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+			return &chronograf.User{
+				ID: *q.ID,
+				Roles: []chronograf.Role{
+					{Name: roles.EditorRoleName, Organization: "default"},
+					{Name: roles.ViewerRoleName, Organization: "viewers"},
+					{Name: roles.AdminRoleName, Organization: "admins"},
+				},
+			}, nil
+		},
+	}
+
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantName   string
+		wantOrg    string
+		wantFolder string
+	}{
+		{
+			name:       "Copy with default name",
+			wantStatus: http.StatusCreated,
+			wantName:   "cpu (Clone)",
+			wantOrg:    "default",
+			wantFolder: "ops",
+		},
+		{
+			name:       "Copy into another organization",
+			body:       `{"name":"cpu of admins","organization":"admins"}`,
+			wantStatus: http.StatusCreated,
+			wantName:   "cpu of admins",
+			wantOrg:    "admins",
+		},
+		{
+			name:       "Copy into an organization of a viewer",
+			body:       `{"organization":"viewers"}`,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "Invalid JSON",
+			body:       `{"name":`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			added := []chronograf.Dashboard{}
+			s := newExportService(d, &added)
+			s.Store.(*mocks.Store).UsersStore = users
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url/chronograf/v1/dashboards/1/copy", bytes.NewBufferString(tt.body))
+			ctx := context.WithValue(r.Context(), UserContextKey, &chronograf.User{ID: 1, Name: "bob"})
+			ctx = context.WithValue(ctx, jhttprouter.ParamsKey, jhttprouter.Params{{Key: "id", Value: "1"}})
+			r = r.WithContext(ctx)
+
+			s.CopyDashboard(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("%q. CopyDashboard() = %v, want %v: %s", tt.name, resp.StatusCode, tt.wantStatus, body)
+			}
+			if tt.wantStatus != http.StatusCreated {
+				if len(added) != 0 {
+					t.Errorf("%q. CopyDashboard() added %d dashboards", tt.name, len(added))
+				}
+				return
+			}
+			if len(added) != 1 {
+				t.Fatalf("%q. CopyDashboard() added %d dashboards, want 1", tt.name, len(added))
+			}
+			got := added[0]
+			if got.Name != tt.wantName || got.Organization != tt.wantOrg || got.Folder != tt.wantFolder {
+				t.Errorf("%q. CopyDashboard() added %q in organization %q and folder %q", tt.name, got.Name, got.Organization, got.Folder)
+			}
+			if resp.Header.Get("Location") != "/chronograf/v1/dashboards/100" {
+				t.Errorf("%q. CopyDashboard() Location = %s", tt.name, resp.Header.Get("Location"))
+			}
+		})
+	}
+}
+
+type sequenceID struct {
+	next int
+}
+
+func (s *sequenceID) Generate() (string, error) {
+	s.next++
+	return string(rune('0' + s.next)), nil
+}
+
+func Test_copyDashboard(t *testing.T) {
+	d := chronograf.Dashboard{
+		ID:   1,
+		Name: "cpu",
+		Slug: "cpu",
+		Cells: []chronograf.DashboardCell{
+			{
+				ID:      "a",
+				Queries: []chronograf.DashboardQuery{{Command: "SELECT 1", Range: &chronograf.Range{Upper: 100}}},
+				Axes:    map[string]chronograf.Axis{"y": {Bounds: []string{"0", "100"}}},
+			},
+		},
+		Templates: []chronograf.Template{
+			{ID: "t", TemplateVar: chronograf.TemplateVar{Var: ":host:", Values: []chronograf.TemplateValue{{Value: "a"}}}},
+		},
+	}
+
+	c, err := copyDashboard(d, &sequenceID{})
+	if err != nil {
+		t.Fatalf("copyDashboard() error = %v", err)
+	}
+	if c.ID != 0 || c.Slug != "" || c.Cells[0].ID != "1" || c.Templates[0].ID != "2" {
+		t.Errorf("copyDashboard() IDs = %d, %q, %s, %s", c.ID, c.Slug, c.Cells[0].ID, c.Templates[0].ID)
+	}
+
+	// Changing the copy leaves the dashboard untouched
+	c.Cells[0].Queries[0].Command = "SELECT 2"
+	c.Cells[0].Queries[0].Range.Upper = 50
+	c.Cells[0].Axes["y"].Bounds[0] = "10"
+	c.Templates[0].Values[0].Value = "b"
+	if q := d.Cells[0].Queries[0]; q.Command != "SELECT 1" || q.Range.Upper != 100 {
+		t.Errorf("copyDashboard() shares the queries of the dashboard")
+	}
+	if d.Cells[0].Axes["y"].Bounds[0] != "0" {
+		t.Errorf("copyDashboard() shares the axes of the dashboard")
+	}
+	if d.Templates[0].Values[0].Value != "a" {
+		t.Errorf("copyDashboard() shares the template values of the dashboard")
+	}
+}
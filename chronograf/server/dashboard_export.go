@@ -0,0 +1,182 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// dashboardExportVersion is the version of the documents written by
+// ExportDashboard. ImportDashboard rejects documents of later versions.
+const dashboardExportVersion = 1
+
+// dashboardExport is a self-contained JSON document of a single dashboard.
+// Sources lists the sources queried by the cells of the dashboard so that the
+// importing instance can map them to its own sources.
+type dashboardExport struct {
+	Version   int                     `json:"version"`
+	Dashboard chronograf.Dashboard    `json:"dashboard"`
+	Sources   []dashboardExportSource `json:"sources"`
+}
+
+// dashboardExportSource describes a source of the exporting instance without
+// its credentials
+type dashboardExportSource struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type,omitempty"`
+	URL  string `json:"url,omitempty"`
+}
+
+// dashboardImportRequest is a document written by ExportDashboard along with
+// the IDs of the sources of this instance replacing those of the document
+type dashboardImportRequest struct {
+	dashboardExport
+	SourceMapping map[string]string `json:"sourceMapping"`
+}
+
+// sourceIDs parses the source mapping of the request
+func (r *dashboardImportRequest) sourceIDs() (map[int]int, error) {
+	ids := make(map[int]int, len(r.SourceMapping))
+	for from, to := range r.SourceMapping {
+		f, err := strconv.Atoi(from)
+		if err != nil {
+			return nil, fmt.Errorf("invalid source ID %q in sourceMapping", from)
+		}
+		t, err := strconv.Atoi(to)
+		if err != nil {
+			return nil, fmt.Errorf("invalid source ID %q in sourceMapping", to)
+		}
+		ids[f] = t
+	}
+	return ids, nil
+}
+
+// ExportDashboard writes a dashboard, its templates and the queries of its
+// cells as a document that ImportDashboard of any instance accepts. The
+// organization and folder of the dashboard are left out as they only exist
+// within this instance.
+func (s *Service) ExportDashboard(w http.ResponseWriter, r *http.Request) {
+	id, err := paramID("id", r)
+	if err != nil {
+		Error(w, http.StatusUnprocessableEntity, err.Error(), s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	d, err := s.Store.Dashboards(ctx).Get(ctx, chronograf.DashboardID(id))
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+
+	res := dashboardExport{
+		Version:   dashboardExportVersion,
+		Dashboard: DashboardDefaults(d),
+		Sources:   []dashboardExportSource{},
+	}
+	res.Dashboard.ID = 0
+	res.Dashboard.Organization = ""
+	res.Dashboard.Folder = ""
+	res.Dashboard.Slug = ""
+
+	seen := map[int]bool{}
+	for _, c := range d.Cells {
+		for _, q := range c.Queries {
+			srcID, ok := sourceLinkID(q.Source)
+			if !ok || seen[srcID] {
+				continue
+			}
+			seen[srcID] = true
+
+			// Sources removed since the dashboard was written are still
+			// listed so that they can be mapped
+			src := dashboardExportSource{
+				ID: strconv.Itoa(srcID),
+			}
+			if cur, err := s.Store.Sources(ctx).Get(ctx, srcID); err == nil {
+				src.Name = cur.Name
+				src.Type = cur.Type
+				src.URL = cur.URL
+			}
+			res.Sources = append(res.Sources, src)
+		}
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="dashboard-%d.json"`, d.ID))
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// ImportDashboard creates a dashboard within the current organization from a
+// document written by ExportDashboard. The sources of cell queries are
+// replaced as given by sourceMapping; every source queried afterwards must
+// exist within the current organization.
+func (s *Service) ImportDashboard(w http.ResponseWriter, r *http.Request) {
+	var req dashboardImportRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	if req.Version < 1 || req.Version > dashboardExportVersion {
+		Error(w, http.StatusUnprocessableEntity, fmt.Sprintf("unsupported dashboard export version %d; version %d or lower is required", req.Version, dashboardExportVersion), s.Logger)
+		return
+	}
+	srcIDs, err := req.sourceIDs()
+	if err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	dashboard := req.Dashboard
+	dashboard.ID = 0
+	dashboard.Organization = ""
+	dashboard.Folder = ""
+	dashboard.Slug = ""
+	for i, c := range dashboard.Cells {
+		for j, q := range c.Queries {
+			srcID, ok := sourceLinkID(q.Source)
+			if !ok {
+				continue
+			}
+			if mapped, ok := srcIDs[srcID]; ok {
+				srcID = mapped
+			}
+			if _, err := s.Store.Sources(ctx).Get(ctx, srcID); err != nil {
+				invalidData(w, fmt.Errorf("source %d of cell %s not found; map it to a source of this instance with sourceMapping", srcID, c.ID), s.Logger)
+				return
+			}
+			dashboard.Cells[i].Queries[j].Source = sourceLinkPrefix + strconv.Itoa(srcID)
+		}
+	}
+
+	defaultOrg, err := s.Store.Organizations(ctx).DefaultOrganization(ctx)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	if err := ValidDashboardRequest(&dashboard, defaultOrg.ID); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	if err := s.ensureQuota(ctx, resourceOrganization(ctx, dashboard.Organization), quotaDashboards, 1); err != nil {
+		quotaExceeded(w, err, s.Logger)
+		return
+	}
+
+	if dashboard, err = s.Store.Dashboards(ctx).Add(ctx, dashboard); err != nil {
+		msg := fmt.Errorf("error storing dashboard %v: %v", dashboard, err)
+		unknownErrorWithMessage(w, msg, s.Logger)
+		return
+	}
+	s.recordDashboardVersion(ctx, dashboard.ID)
+	s.publishDashboardEvent(ctx, EventDashboardCreated, dashboard)
+
+	res := newDashboardResponse(dashboard)
+	location(w, res.Links.Self)
+	encodeJSON(w, http.StatusCreated, res, s.Logger)
+}
@@ -0,0 +1,177 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jhttprouter "github.com/influxdata/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+// newExportService returns a Service with the sources 1 and 3 of which
+// dashboards are stored in added
+func newExportService(d chronograf.Dashboard, added *[]chronograf.Dashboard) *Service {
+	return &Service{
+		Store: &mocks.Store{
+			DashboardsStore: &mocks.DashboardsStore{
+				GetF: func(ctx context.Context, id chronograf.DashboardID) (chronograf.Dashboard, error) {
+					if id != d.ID {
+						return chronograf.Dashboard{}, chronograf.ErrDashboardNotFound
+					}
+					return d, nil
+				},
+				AddF: func(ctx context.Context, d chronograf.Dashboard) (chronograf.Dashboard, error) {
+					d.ID = chronograf.DashboardID(len(*added) + 100)
+					*added = append(*added, d)
+					return d, nil
+				},
+			},
+			SourcesStore: &mocks.SourcesStore{
+				GetF: func(ctx context.Context, id int) (chronograf.Source, error) {
+					switch id {
+					case 1:
+						return chronograf.Source{ID: 1, Name: "dev", Type: "influx", URL: "http://dev:8086", Password: "secret"}, nil
+					case 3:
+						return chronograf.Source{ID: 3, Name: "prod", Type: "influx", URL: "http://prod:8086"}, nil
+					}
+					return chronograf.Source{}, chronograf.ErrSourceNotFound
+				},
+			},
+			OrganizationsStore: &mocks.OrganizationsStore{
+				DefaultOrganizationF: func(ctx context.Context) (*chronograf.Organization, error) {
+					return &chronograf.Organization{ID: "default"}, nil
+				},
+				GetF: func(ctx context.Context, q chronograf.OrganizationQuery) (*chronograf.Organization, error) {
+					return &chronograf.Organization{ID: *q.ID}, nil
+				},
+			},
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+}
+
+func TestService_ExportDashboard(t *testing.T) {
+	d := chronograf.Dashboard{
+		ID:           1,
+		Name:         "cpu",
+		Organization: "default",
+		Folder:       "4",
+		Cells: []chronograf.DashboardCell{
+			{
+				ID: "a",
+				Queries: []chronograf.DashboardQuery{
+					{Command: "SELECT mean(usage_user) FROM cpu", Source: "/chronograf/v1/sources/1"},
+					{Command: "SELECT mean(usage_idle) FROM cpu", Source: "/chronograf/v1/sources/1"},
+					{Command: "SELECT mean(load1) FROM system", Source: "/chronograf/v1/sources/2"},
+				},
+			},
+		},
+		Templates: []chronograf.Template{
+			{ID: "t", TemplateVar: chronograf.TemplateVar{Var: ":host:"}, Type: "tagValues"},
+		},
+	}
+	s := newExportService(d, nil)
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "http://any.url/chronograf/v1/dashboards/1/export", nil)
+	r = r.WithContext(context.WithValue(r.Context(), jhttprouter.ParamsKey, jhttprouter.Params{{Key: "id", Value: "1"}}))
+
+	s.ExportDashboard(w, r)
+
+	resp := w.Result()
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("ExportDashboard() = %v, want %v: %s", resp.StatusCode, http.StatusOK, body)
+	}
+	var got dashboardExport
+	if err := json.Unmarshal(body, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Version != dashboardExportVersion || got.Dashboard.Name != "cpu" || len(got.Dashboard.Templates) != 1 {
+		t.Errorf("ExportDashboard() = %s", body)
+	}
+	if got.Dashboard.ID != 0 || got.Dashboard.Organization != "" || got.Dashboard.Folder != "" {
+		t.Errorf("ExportDashboard() kept the ID, organization or folder of the dashboard: %s", body)
+	}
+	want := []dashboardExportSource{
+		{ID: "1", Name: "dev", Type: "influx", URL: "http://dev:8086"},
+		{ID: "2"},
+	}
+	if len(got.Sources) != len(want) || got.Sources[0] != want[0] || got.Sources[1] != want[1] {
+		t.Errorf("ExportDashboard() sources = %#v, want %#v", got.Sources, want)
+	}
+	if bytes.Contains(body, []byte("secret")) {
+		t.Errorf("ExportDashboard() exported the credentials of a source")
+	}
+}
+
+func TestService_ImportDashboard(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantSource string
+	}{
+		{
+			name: "Import with mapped sources",
+			body: `{"version":1,"dashboard":{"name":"cpu","cells":[{"i":"a","queries":[{"query":"SELECT 1","source":"/chronograf/v1/sources/1"}]}]},
+"sourceMapping":{"1":"3"}}`,
+			wantStatus: http.StatusCreated,
+			wantSource: "/chronograf/v1/sources/3",
+		},
+		{
+			name:       "Import without mapping",
+			body:       `{"version":1,"dashboard":{"name":"cpu","cells":[{"i":"a","queries":[{"query":"SELECT 1","source":"/chronograf/v1/sources/1"}]}]}}`,
+			wantStatus: http.StatusCreated,
+			wantSource: "/chronograf/v1/sources/1",
+		},
+		{
+			name:       "Unknown source",
+			body:       `{"version":1,"dashboard":{"name":"cpu","cells":[{"i":"a","queries":[{"query":"SELECT 1","source":"/chronograf/v1/sources/2"}]}]}}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Later version",
+			body:       `{"version":2,"dashboard":{"name":"cpu"}}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			added := []chronograf.Dashboard{}
+			s := newExportService(chronograf.Dashboard{}, &added)
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url/chronograf/v1/dashboard-imports", bytes.NewBufferString(tt.body))
+
+			s.ImportDashboard(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("%q. ImportDashboard() = %v, want %v: %s", tt.name, resp.StatusCode, tt.wantStatus, body)
+			}
+			if tt.wantStatus != http.StatusCreated {
+				if len(added) != 0 {
+					t.Errorf("%q. ImportDashboard() added %d dashboards", tt.name, len(added))
+				}
+				return
+			}
+			if len(added) != 1 {
+				t.Fatalf("%q. ImportDashboard() added %d dashboards, want 1", tt.name, len(added))
+			}
+			if got := added[0]; got.Organization != "default" || got.Cells[0].Queries[0].Source != tt.wantSource {
+				t.Errorf("%q. ImportDashboard() added %#v", tt.name, got)
+			}
+			if resp.Header.Get("Location") != "/chronograf/v1/dashboards/100" {
+				t.Errorf("%q. ImportDashboard() Location = %s", tt.name, resp.Header.Get("Location"))
+			}
+		})
+	}
+}
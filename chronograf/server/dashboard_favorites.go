@@ -0,0 +1,177 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+type recentDashboardResponse struct {
+	ViewedAt  time.Time          `json:"viewedAt"`
+	Dashboard *dashboardResponse `json:"dashboard"`
+}
+
+type meDashboardsResponse struct {
+	Links   selfLinks                 `json:"links"`
+	Starred []*dashboardResponse      `json:"starred"`
+	Recent  []recentDashboardResponse `json:"recent"`
+}
+
+// MeDashboards returns the dashboards starred and recently viewed by the
+// current user. Dashboards that were removed or belong to another
+// organization than the current one are left out.
+func (s *Service) MeDashboards(w http.ResponseWriter, r *http.Request) {
+	res := meDashboardsResponse{
+		Links: selfLinks{
+			Self: "/chronograf/v1/me/dashboards",
+		},
+		Starred: []*dashboardResponse{},
+		Recent:  []recentDashboardResponse{},
+	}
+
+	ctx := r.Context()
+	u, ok := hasUserContext(ctx)
+	if !ok {
+		// Without authentication there are no users to have favorites
+		encodeJSON(w, http.StatusOK, res, s.Logger)
+		return
+	}
+
+	p, err := s.preferences(ctx, u)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	store := s.Store.Dashboards(ctx)
+	for _, id := range p.StarredDashboards {
+		d, err := store.Get(ctx, id)
+		if err != nil {
+			continue
+		}
+		res.Starred = append(res.Starred, newDashboardResponse(d))
+	}
+	for _, v := range p.RecentDashboards {
+		d, err := store.Get(ctx, v.DashboardID)
+		if err != nil {
+			continue
+		}
+		res.Recent = append(res.Recent, recentDashboardResponse{
+			ViewedAt:  v.ViewedAt,
+			Dashboard: newDashboardResponse(d),
+		})
+	}
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// StarDashboard adds a dashboard to the favorites of the current user
+func (s *Service) StarDashboard(w http.ResponseWriter, r *http.Request) {
+	id, err := paramID("id", r)
+	if err != nil {
+		Error(w, http.StatusUnprocessableEntity, err.Error(), s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	u, ok := hasUserContext(ctx)
+	if !ok {
+		invalidData(w, errorf("favorites are only stored for authenticated users"), s.Logger)
+		return
+	}
+	if _, err := s.Store.Dashboards(ctx).Get(ctx, chronograf.DashboardID(id)); err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+
+	p, err := s.preferences(ctx, u)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	for _, starred := range p.StarredDashboards {
+		if starred == chronograf.DashboardID(id) {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+	p.StarredDashboards = append(p.StarredDashboards, chronograf.DashboardID(id))
+
+	if err := s.Store.Preferences(ctx).Put(ctx, p); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// UnstarDashboard removes a dashboard from the favorites of the current user.
+// The dashboard need not exist anymore so that stars of removed dashboards
+// can be cleared.
+func (s *Service) UnstarDashboard(w http.ResponseWriter, r *http.Request) {
+	id, err := paramID("id", r)
+	if err != nil {
+		Error(w, http.StatusUnprocessableEntity, err.Error(), s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	u, ok := hasUserContext(ctx)
+	if !ok {
+		invalidData(w, errorf("favorites are only stored for authenticated users"), s.Logger)
+		return
+	}
+
+	p, err := s.preferences(ctx, u)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	starred := p.StarredDashboards[:0]
+	for _, sid := range p.StarredDashboards {
+		if sid != chronograf.DashboardID(id) {
+			starred = append(starred, sid)
+		}
+	}
+	if len(starred) == len(p.StarredDashboards) {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	p.StarredDashboards = starred
+
+	if err := s.Store.Preferences(ctx).Put(ctx, p); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// recordDashboardView moves a dashboard to the front of the recently viewed
+// dashboards of the current user, keeping at most MaxRecentDashboards.
+// Failing to record a view is logged rather than failing the request.
+func (s *Service) recordDashboardView(ctx context.Context, id chronograf.DashboardID, now time.Time) {
+	u, ok := hasUserContext(ctx)
+	if !ok {
+		return
+	}
+	p, err := s.preferences(ctx, u)
+	if err != nil {
+		s.Logger.Error("Unable to record view of dashboard: ", err)
+		return
+	}
+
+	recent := []chronograf.DashboardView{{DashboardID: id, ViewedAt: now}}
+	for _, v := range p.RecentDashboards {
+		if len(recent) == chronograf.MaxRecentDashboards {
+			break
+		}
+		if v.DashboardID != id {
+			recent = append(recent, v)
+		}
+	}
+	p.RecentDashboards = recent
+
+	if err := s.Store.Preferences(ctx).Put(ctx, p); err != nil {
+		s.Logger.Error("Unable to record view of dashboard: ", err)
+	}
+}
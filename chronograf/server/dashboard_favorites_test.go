@@ -0,0 +1,184 @@
+package server
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	jhttprouter "github.com/influxdata/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+func favoritesService(stored *chronograf.Preferences) *Service {
+	return &Service{
+		Store: &mocks.Store{
+			DashboardsStore: &mocks.DashboardsStore{
+				GetF: func(ctx context.Context, id chronograf.DashboardID) (chronograf.Dashboard, error) {
+					if id > 2 {
+						return chronograf.Dashboard{}, chronograf.ErrDashboardNotFound
+					}
+					return chronograf.Dashboard{ID: id, Name: "dashboard", Organization: "0"}, nil
+				},
+			},
+			PreferencesStore: &mocks.PreferencesStore{
+				GetF: func(ctx context.Context, userID uint64) (*chronograf.Preferences, error) {
+					if stored == nil {
+						return nil, chronograf.ErrPreferencesNotFound
+					}
+					p := *stored
+					return &p, nil
+				},
+				PutF: func(ctx context.Context, p *chronograf.Preferences) error {
+					*stored = *p
+					return nil
+				},
+			},
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+}
+
+func TestService_StarDashboard(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		id          string
+		starred     []chronograf.DashboardID
+		wantStatus  int
+		wantStarred []chronograf.DashboardID
+	}{
+		{
+			name:        "Star a dashboard",
+			method:      "PUT",
+			id:          "2",
+			starred:     []chronograf.DashboardID{1},
+			wantStatus:  http.StatusNoContent,
+			wantStarred: []chronograf.DashboardID{1, 2},
+		},
+		{
+			name:        "Star a starred dashboard",
+			method:      "PUT",
+			id:          "1",
+			starred:     []chronograf.DashboardID{1},
+			wantStatus:  http.StatusNoContent,
+			wantStarred: []chronograf.DashboardID{1},
+		},
+		{
+			name:        "Star an unknown dashboard",
+			method:      "PUT",
+			id:          "3",
+			starred:     []chronograf.DashboardID{1},
+			wantStatus:  http.StatusNotFound,
+			wantStarred: []chronograf.DashboardID{1},
+		},
+		{
+			name:        "Unstar a dashboard",
+			method:      "DELETE",
+			id:          "1",
+			starred:     []chronograf.DashboardID{1, 2},
+			wantStatus:  http.StatusNoContent,
+			wantStarred: []chronograf.DashboardID{2},
+		},
+		{
+			name:        "Unstar a removed dashboard",
+			method:      "DELETE",
+			id:          "3",
+			starred:     []chronograf.DashboardID{3},
+			wantStatus:  http.StatusNoContent,
+			wantStarred: []chronograf.DashboardID{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stored := &chronograf.Preferences{UserID: 1, StarredDashboards: tt.starred}
+			s := favoritesService(stored)
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(tt.method, "http://any.url/chronograf/v1/dashboards/"+tt.id+"/star", nil)
+			ctx := context.WithValue(r.Context(), UserContextKey, &chronograf.User{ID: 1, Name: "bob"})
+			ctx = context.WithValue(ctx, jhttprouter.ParamsKey, jhttprouter.Params{{Key: "id", Value: tt.id}})
+			r = r.WithContext(ctx)
+
+			if tt.method == "PUT" {
+				s.StarDashboard(w, r)
+			} else {
+				s.UnstarDashboard(w, r)
+			}
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("%q. status = %v, want %v", tt.name, w.Code, tt.wantStatus)
+			}
+			if diff := cmp.Diff(stored.StarredDashboards, tt.wantStarred); diff != "" {
+				t.Errorf("%q. starred dashboards diff (-got +want):\n%s", tt.name, diff)
+			}
+		})
+	}
+}
+
+func TestService_recordDashboardView(t *testing.T) {
+	now := time.Date(2018, 1, 1, 9, 0, 0, 0, time.UTC)
+	stored := &chronograf.Preferences{UserID: 1}
+	for i := 0; i < chronograf.MaxRecentDashboards+5; i++ {
+		stored.RecentDashboards = append(stored.RecentDashboards, chronograf.DashboardView{
+			DashboardID: chronograf.DashboardID(i + 1),
+			ViewedAt:    now.Add(-time.Duration(i) * time.Minute),
+		})
+	}
+	s := favoritesService(stored)
+	ctx := context.WithValue(context.Background(), UserContextKey, &chronograf.User{ID: 1, Name: "bob"})
+
+	s.recordDashboardView(ctx, 3, now.Add(time.Minute))
+
+	recent := stored.RecentDashboards
+	if len(recent) != chronograf.MaxRecentDashboards {
+		t.Fatalf("recordDashboardView() kept %d views, want %d", len(recent), chronograf.MaxRecentDashboards)
+	}
+	want := []chronograf.DashboardID{3, 1, 2, 4, 5}
+	for i, id := range want {
+		if recent[i].DashboardID != id {
+			t.Errorf("recordDashboardView() view %d is of dashboard %d, want %d", i, recent[i].DashboardID, id)
+		}
+	}
+	if !recent[0].ViewedAt.Equal(now.Add(time.Minute)) {
+		t.Errorf("recordDashboardView() viewed at %v", recent[0].ViewedAt)
+	}
+
+	// Views without a user are not recorded
+	s.recordDashboardView(context.Background(), 4, now)
+	if stored.RecentDashboards[0].DashboardID != 3 {
+		t.Errorf("recordDashboardView() recorded a view without a user")
+	}
+}
+
+func TestService_MeDashboards(t *testing.T) {
+	viewed := time.Date(2018, 1, 1, 9, 0, 0, 0, time.UTC)
+	s := favoritesService(&chronograf.Preferences{
+		UserID:            1,
+		StarredDashboards: []chronograf.DashboardID{3, 2},
+		RecentDashboards: []chronograf.DashboardView{
+			{DashboardID: 1, ViewedAt: viewed},
+			{DashboardID: 3, ViewedAt: viewed.Add(-time.Hour)},
+		},
+	})
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "http://any.url/chronograf/v1/me/dashboards", nil)
+	r = r.WithContext(context.WithValue(r.Context(), UserContextKey, &chronograf.User{ID: 1, Name: "bob"}))
+
+	s.MeDashboards(w, r)
+
+	resp := w.Result()
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("MeDashboards() = %v, want %v", resp.StatusCode, http.StatusOK)
+	}
+	want := `{"links":{"self":"/chronograf/v1/me/dashboards"},"starred":[{"id":2,"cells":[],"templates":[],"name":"dashboard","organization":"0","links":{"self":"/chronograf/v1/dashboards/2","cells":"/chronograf/v1/dashboards/2/cells","templates":"/chronograf/v1/dashboards/2/templates"}}],"recent":[{"viewedAt":"2018-01-01T09:00:00Z","dashboard":{"id":1,"cells":[],"templates":[],"name":"dashboard","organization":"0","links":{"self":"/chronograf/v1/dashboards/1","cells":"/chronograf/v1/dashboards/1/cells","templates":"/chronograf/v1/dashboards/1/templates"}}}]}`
+	if eq, _ := jsonEqual(string(body), want); !eq {
+		t.Errorf("MeDashboards() = \n***%v***\n,\nwant\n***%v***", string(body), want)
+	}
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/reports"
+)
+
+// Formats of rendered dashboards
+const (
+	renderFormatPNG = "png"
+	renderFormatPDF = "pdf"
+)
+
+// singleStatCellType is the type of cells showing only their latest value
+const singleStatCellType = "single-stat"
+
+// RenderDashboard draws a dashboard with the results of its cell queries as a
+// PNG image or a single page PDF document for embedding in tickets and
+// reports. The format query parameter chooses between them and defaults to
+// png; lower, upper and source are those of dashboard snapshots.
+func (s *Service) RenderDashboard(w http.ResponseWriter, r *http.Request) {
+	id, err := paramID("id", r)
+	if err != nil {
+		Error(w, http.StatusUnprocessableEntity, err.Error(), s.Logger)
+		return
+	}
+
+	params := r.URL.Query()
+	format := params.Get("format")
+	if format == "" {
+		format = renderFormatPNG
+	}
+	if format != renderFormatPNG && format != renderFormatPDF {
+		invalidData(w, errorf("unknown format %s. Valid formats are 'png' and 'pdf'", format), s.Logger)
+		return
+	}
+	req := dashboardSnapshotRequest{
+		Lower: params.Get("lower"),
+		Upper: params.Get("upper"),
+	}
+	lower, upper, err := req.timeRange(time.Now())
+	if err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	defaultSource := ""
+	if src := params.Get("source"); src != "" {
+		if _, err := strconv.Atoi(src); err != nil {
+			invalidData(w, fmt.Errorf("invalid source ID %q", src), s.Logger)
+			return
+		}
+		defaultSource = sourceLinkPrefix + src
+	}
+
+	ctx := r.Context()
+	d, err := s.Store.Dashboards(ctx).Get(ctx, chronograf.DashboardID(id))
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+	d = DashboardDefaults(d)
+
+	values := snapshotTemplateValues(d.Templates, nil)
+	tiles := make([]reports.Tile, len(d.Cells))
+	for i, c := range d.Cells {
+		tile := reports.Tile{
+			X:          c.X,
+			Y:          c.Y,
+			W:          c.W,
+			H:          c.H,
+			Title:      c.Name,
+			SingleStat: c.Type == singleStatCellType,
+		}
+		for _, q := range c.Queries {
+			_, series, err := s.querySeries(ctx, q, lower, upper, values, defaultSource)
+			if err != nil {
+				tile.Error = err.Error()
+				continue
+			}
+			tile.Series = append(tile.Series, series...)
+		}
+		tiles[i] = tile
+	}
+
+	drawing := reports.DrawDashboard(d.Name, lower, upper, tiles)
+	var data []byte
+	contentType := "image/png"
+	if format == renderFormatPDF {
+		data, err = drawing.PDF()
+		contentType = "application/pdf"
+	} else {
+		data, err = drawing.PNG()
+	}
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`inline; filename="dashboard-%d.%s"`, d.ID, format))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(data); err != nil {
+		s.Logger.Error("Unable to write rendered dashboard: ", err)
+	}
+}
@@ -0,0 +1,145 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"image/png"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jhttprouter "github.com/influxdata/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+	"github.com/influxdata/influxdb/chronograf/reports"
+)
+
+func TestService_RenderDashboard(t *testing.T) {
+	var queries []chronograf.Query
+	s := &Service{
+		Store: &mocks.Store{
+			DashboardsStore: &mocks.DashboardsStore{
+				GetF: func(ctx context.Context, id chronograf.DashboardID) (chronograf.Dashboard, error) {
+					if id != 1 {
+						return chronograf.Dashboard{}, chronograf.ErrDashboardNotFound
+					}
+					return chronograf.Dashboard{
+						ID:   1,
+						Name: "Capacity",
+						Cells: []chronograf.DashboardCell{
+							{
+								ID: "a", W: 6, H: 4, Name: "CPU",
+								Queries: []chronograf.DashboardQuery{
+									{Command: "SELECT mean(usage_user) FROM cpu WHERE time > :dashboardTime:", Source: "/chronograf/v1/sources/1"},
+								},
+							},
+							{
+								ID: "b", X: 6, W: 6, H: 4, Name: "Load", Type: "single-stat",
+								Queries: []chronograf.DashboardQuery{
+									{Command: "SELECT last(load1) FROM system", Source: "/chronograf/v1/sources/2"},
+								},
+							},
+						},
+					}, nil
+				},
+			},
+			SourcesStore: &mocks.SourcesStore{
+				GetF: func(ctx context.Context, id int) (chronograf.Source, error) {
+					if id != 1 {
+						return chronograf.Source{}, chronograf.ErrSourceNotFound
+					}
+					return chronograf.Source{ID: 1}, nil
+				},
+			},
+		},
+		TimeSeriesClient: &mocks.TimeSeries{
+			ConnectF: func(context.Context, *chronograf.Source) error {
+				return nil
+			},
+			QueryF: func(ctx context.Context, q chronograf.Query) (chronograf.Response, error) {
+				queries = append(queries, q)
+				return mocks.NewResponse(`{"results":[{"statement_id":0,"series":[{"name":"cpu","columns":["time","mean"],"values":[[1445412480000,12],[1445414280000,18]]}]}]}`, nil), nil
+			},
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+
+	tests := []struct {
+		name            string
+		id              string
+		query           string
+		wantStatus      int
+		wantContentType string
+		wantPrefix      string
+	}{
+		{
+			name:            "PNG by default",
+			id:              "1",
+			query:           "lower=2015-10-21T07:28:00Z&upper=2015-10-21T08:28:00Z",
+			wantStatus:      http.StatusOK,
+			wantContentType: "image/png",
+			wantPrefix:      "\x89PNG",
+		},
+		{
+			name:            "PDF",
+			id:              "1",
+			query:           "format=pdf",
+			wantStatus:      http.StatusOK,
+			wantContentType: "application/pdf",
+			wantPrefix:      "%PDF-",
+		},
+		{
+			name:       "Unknown format",
+			id:         "1",
+			query:      "format=svg",
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Unknown dashboard",
+			id:         "2",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queries = nil
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("GET", "http://any.url/chronograf/v1/dashboards/"+tt.id+"/render?"+tt.query, nil)
+			r = r.WithContext(context.WithValue(r.Context(), jhttprouter.ParamsKey, jhttprouter.Params{{Key: "id", Value: tt.id}}))
+
+			s.RenderDashboard(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("%q. RenderDashboard() = %v, want %v: %s", tt.name, resp.StatusCode, tt.wantStatus, body)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if ct := resp.Header.Get("Content-Type"); ct != tt.wantContentType {
+				t.Errorf("%q. RenderDashboard() Content-Type = %s, want %s", tt.name, ct, tt.wantContentType)
+			}
+			if !bytes.HasPrefix(body, []byte(tt.wantPrefix)) {
+				t.Errorf("%q. RenderDashboard() = %q", tt.name, body[:10])
+			}
+			// The query of the missing source 2 is not run
+			if len(queries) != 1 {
+				t.Errorf("%q. RenderDashboard() ran %d queries, want 1", tt.name, len(queries))
+			}
+		})
+	}
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "http://any.url/chronograf/v1/dashboards/1/render", nil)
+	r = r.WithContext(context.WithValue(r.Context(), jhttprouter.ParamsKey, jhttprouter.Params{{Key: "id", Value: "1"}}))
+	s.RenderDashboard(w, r)
+	img, err := png.Decode(w.Result().Body)
+	if err != nil {
+		t.Fatalf("png.Decode() error = %v", err)
+	}
+	if img.Bounds().Dx() != reports.DashboardWidth {
+		t.Errorf("RenderDashboard() width = %d, want %d", img.Bounds().Dx(), reports.DashboardWidth)
+	}
+}
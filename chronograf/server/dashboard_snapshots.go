@@ -0,0 +1,383 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxql"
+)
+
+// defaultSnapshotRange is the time range of snapshots taken without one
+const defaultSnapshotRange = time.Hour
+
+// snapshotPoints is the number of points per series :interval: aims for
+const snapshotPoints = 1000
+
+type dashboardSnapshotRequest struct {
+	Name         string                   `json:"name"`
+	Lower        string                   `json:"lower"`              // Lower is an RFC3339 time; it defaults to an hour before Upper
+	Upper        string                   `json:"upper"`              // Upper is an RFC3339 time; it defaults to now
+	Source       string                   `json:"source"`             // Source is the ID of the source of queries without one
+	TemplateVars []chronograf.TemplateVar `json:"tempVars,omitempty"` // TemplateVars override the selected values of the templates of the dashboard
+}
+
+// timeRange parses the time range of the request
+func (r *dashboardSnapshotRequest) timeRange(now time.Time) (time.Time, time.Time, error) {
+	upper := now
+	if r.Upper != "" {
+		t, err := time.Parse(time.RFC3339, r.Upper)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid upper time %q: must be RFC3339", r.Upper)
+		}
+		upper = t
+	}
+	lower := upper.Add(-defaultSnapshotRange)
+	if r.Lower != "" {
+		t, err := time.Parse(time.RFC3339, r.Lower)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid lower time %q: must be RFC3339", r.Lower)
+		}
+		lower = t
+	}
+	if !lower.Before(upper) {
+		return time.Time{}, time.Time{}, fmt.Errorf("lower time must be before upper time")
+	}
+	return lower.UTC(), upper.UTC(), nil
+}
+
+type dashboardSnapshotResponse struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Lower     string    `json:"lower"`
+	Upper     string    `json:"upper"`
+	Author    string    `json:"author"`
+	CreatedAt time.Time `json:"createdAt"`
+	Links     selfLinks `json:"links"`
+}
+
+func newDashboardSnapshotResponse(snap chronograf.DashboardSnapshot) *dashboardSnapshotResponse {
+	return &dashboardSnapshotResponse{
+		ID:        snap.ID,
+		Name:      snap.Name,
+		Lower:     snap.Lower,
+		Upper:     snap.Upper,
+		Author:    snap.Author,
+		CreatedAt: snap.CreatedAt,
+		Links: selfLinks{
+			Self: fmt.Sprintf("/chronograf/v1/dashboards/%d/snapshots/%d", snap.DashboardID, snap.ID),
+		},
+	}
+}
+
+type dashboardSnapshotsResponse struct {
+	Links     selfLinks                    `json:"links"`
+	Snapshots []*dashboardSnapshotResponse `json:"snapshots"`
+}
+
+type snapshotResultResponse struct {
+	CellID string          `json:"cellID"`
+	Query  string          `json:"query"`
+	Source string          `json:"source"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// dashboardSnapshotDetailResponse is a snapshot along with the dashboard and
+// the query results it froze
+type dashboardSnapshotDetailResponse struct {
+	*dashboardSnapshotResponse
+	Dashboard *dashboardResponse       `json:"dashboard"`
+	Results   []snapshotResultResponse `json:"results"`
+}
+
+func newDashboardSnapshotDetailResponse(snap chronograf.DashboardSnapshot) *dashboardSnapshotDetailResponse {
+	res := &dashboardSnapshotDetailResponse{
+		dashboardSnapshotResponse: newDashboardSnapshotResponse(snap),
+		Dashboard:                 newDashboardResponse(snap.Dashboard),
+		Results:                   make([]snapshotResultResponse, len(snap.Results)),
+	}
+	for i, r := range snap.Results {
+		res.Results[i] = snapshotResultResponse{
+			CellID: r.CellID,
+			Query:  r.Query,
+			Source: r.Source,
+			Result: json.RawMessage(r.Result),
+			Error:  r.Error,
+		}
+	}
+	return res
+}
+
+// snapshotTemplateValues returns the replacement of each template variable of
+// the dashboard. Variables of the request take precedence over the templates
+// of the dashboard.
+func snapshotTemplateValues(templates []chronograf.Template, vars []chronograf.TemplateVar) map[string]string {
+	values := map[string]string{}
+	for k, v := range selectedTemplateValues(templates, vars) {
+		values[k] = templateValue(v)
+	}
+	return values
+}
+
+// templateValue quotes a template value as InfluxQL requires for its type
+func templateValue(v chronograf.TemplateValue) string {
+	switch v.Type {
+	case "tagKey", "fieldKey", "measurement", "database":
+		return quoteIdent(v.Value)
+	case "tagValue":
+		return influxql.QuoteString(v.Value)
+	default:
+		return v.Value
+	}
+}
+
+// renderSnapshotQuery replaces the time range, interval and template
+// variables of a query
+func renderSnapshotQuery(query string, lower, upper time.Time, values map[string]string) string {
+	interval := upper.Sub(lower) / snapshotPoints
+	if interval < time.Millisecond {
+		interval = time.Millisecond
+	}
+	vars := map[string]string{
+		":dashboardTime:":      "'" + lower.Format(time.RFC3339Nano) + "'",
+		":upperDashboardTime:": "'" + upper.Format(time.RFC3339Nano) + "'",
+		":interval:":           strconv.FormatInt(int64(interval/time.Millisecond), 10) + "ms",
+	}
+	for k, v := range values {
+		vars[k] = v
+	}
+	return replaceTemplateVars(query, vars)
+}
+
+// snapshotQuery runs a query against the source of link within the current
+// organization and returns the JSON of its response
+func (s *Service) snapshotQuery(ctx context.Context, link string, q chronograf.Query) ([]byte, error) {
+	_, response, err := s.sourceQuery(ctx, link, q)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(postInfluxResponse{Results: response})
+}
+
+// sourceQuery runs a query against the source of link within the current
+// organization, returning the source along with the response
+func (s *Service) sourceQuery(ctx context.Context, link string, q chronograf.Query) (chronograf.Source, interface{}, error) {
+	src, err := s.linkedSource(ctx, link)
+	if err != nil {
+		return src, nil, err
+	}
+	response, err := s.querySource(ctx, src, q)
+	return src, response, err
+}
+
+// linkedSource returns the source of link within the current organization
+func (s *Service) linkedSource(ctx context.Context, link string) (chronograf.Source, error) {
+	id, ok := sourceLinkID(link)
+	if !ok {
+		return chronograf.Source{}, fmt.Errorf("query has no source")
+	}
+	src, err := s.Store.Sources(ctx).Get(ctx, id)
+	if err != nil {
+		return chronograf.Source{}, fmt.Errorf("source %d not found", id)
+	}
+	return src, nil
+}
+
+// querySource runs a query against src through its plugin, if any
+func (s *Service) querySource(ctx context.Context, src chronograf.Source, q chronograf.Query) (interface{}, error) {
+	if p, ok := s.Plugins.Lookup(src.Type); ok {
+		return p.Query(ctx, src, q)
+	}
+
+	ts, err := s.TimeSeries(src)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to source %d: %v", src.ID, err)
+	}
+	if err := ts.Connect(ctx, &src); err != nil {
+		return nil, fmt.Errorf("unable to connect to source %d: %v", src.ID, err)
+	}
+	return ts.Query(ctx, q)
+}
+
+// NewDashboardSnapshot runs the queries of every cell of a dashboard and
+// stores their results along with the dashboard as an immutable snapshot.
+// Queries that fail are recorded with their error rather than failing the
+// snapshot.
+func (s *Service) NewDashboardSnapshot(w http.ResponseWriter, r *http.Request) {
+	id, err := paramID("id", r)
+	if err != nil {
+		Error(w, http.StatusUnprocessableEntity, err.Error(), s.Logger)
+		return
+	}
+
+	var req dashboardSnapshotRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	lower, upper, err := req.timeRange(time.Now())
+	if err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	defaultSource := ""
+	if req.Source != "" {
+		if _, err := strconv.Atoi(req.Source); err != nil {
+			invalidData(w, fmt.Errorf("invalid source ID %q", req.Source), s.Logger)
+			return
+		}
+		defaultSource = sourceLinkPrefix + req.Source
+	}
+
+	ctx := r.Context()
+	d, err := s.Store.Dashboards(ctx).Get(ctx, chronograf.DashboardID(id))
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+
+	snap := &chronograf.DashboardSnapshot{
+		DashboardID: d.ID,
+		Name:        req.Name,
+		Dashboard:   DashboardDefaults(d),
+		Lower:       lower.Format(time.RFC3339Nano),
+		Upper:       upper.Format(time.RFC3339Nano),
+		Results:     []chronograf.SnapshotResult{},
+	}
+	if snap.Name == "" {
+		snap.Name = fmt.Sprintf("%s at %s", d.Name, snap.Upper)
+	}
+	if u, ok := hasUserContext(ctx); ok {
+		snap.Author = u.Name
+	}
+
+	values := snapshotTemplateValues(d.Templates, req.TemplateVars)
+	for _, c := range d.Cells {
+		for _, q := range c.Queries {
+			res := chronograf.SnapshotResult{
+				CellID: c.ID,
+				Query:  renderSnapshotQuery(q.Command, lower, upper, values),
+				Source: q.Source,
+			}
+			if res.Source == "" {
+				res.Source = defaultSource
+			}
+			data, err := s.snapshotQuery(ctx, res.Source, chronograf.Query{
+				Command: res.Query,
+				DB:      q.QueryConfig.Database,
+				RP:      q.QueryConfig.RetentionPolicy,
+				Epoch:   "ms",
+			})
+			if err != nil {
+				res.Error = err.Error()
+			} else {
+				res.Result = data
+			}
+			snap.Results = append(snap.Results, res)
+		}
+	}
+
+	snap, err = s.Store.DashboardSnapshots(ctx).Add(ctx, snap)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	res := newDashboardSnapshotDetailResponse(*snap)
+	location(w, res.Links.Self)
+	encodeJSON(w, http.StatusCreated, res, s.Logger)
+}
+
+// DashboardSnapshots lists the snapshots of a dashboard, oldest first
+func (s *Service) DashboardSnapshots(w http.ResponseWriter, r *http.Request) {
+	id, err := paramID("id", r)
+	if err != nil {
+		Error(w, http.StatusUnprocessableEntity, err.Error(), s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	d, err := s.Store.Dashboards(ctx).Get(ctx, chronograf.DashboardID(id))
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+
+	snapshots, err := s.Store.DashboardSnapshots(ctx).All(ctx, d.ID)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	res := &dashboardSnapshotsResponse{
+		Links: selfLinks{
+			Self: fmt.Sprintf("/chronograf/v1/dashboards/%d/snapshots", d.ID),
+		},
+		Snapshots: make([]*dashboardSnapshotResponse, len(snapshots)),
+	}
+	for i, snap := range snapshots {
+		res.Snapshots[i] = newDashboardSnapshotResponse(snap)
+	}
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// dashboardSnapshot returns the snapshot of the sid parameter of the
+// dashboard of the id parameter. The dashboard is read first so that only
+// snapshots of dashboards within the current organization are returned.
+func (s *Service) dashboardSnapshot(w http.ResponseWriter, r *http.Request) (*chronograf.DashboardSnapshot, bool) {
+	id, err := paramID("id", r)
+	if err != nil {
+		Error(w, http.StatusUnprocessableEntity, err.Error(), s.Logger)
+		return nil, false
+	}
+	sid, err := paramID("sid", r)
+	if err != nil {
+		Error(w, http.StatusUnprocessableEntity, err.Error(), s.Logger)
+		return nil, false
+	}
+
+	ctx := r.Context()
+	d, err := s.Store.Dashboards(ctx).Get(ctx, chronograf.DashboardID(id))
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return nil, false
+	}
+	snap, err := s.Store.DashboardSnapshots(ctx).Get(ctx, d.ID, sid)
+	if err == chronograf.ErrDashboardSnapshotNotFound {
+		Error(w, http.StatusNotFound, fmt.Sprintf("snapshot %d of dashboard %d not found", sid, id), s.Logger)
+		return nil, false
+	} else if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return nil, false
+	}
+	return snap, true
+}
+
+// DashboardSnapshot returns a snapshot along with its dashboard and results
+func (s *Service) DashboardSnapshot(w http.ResponseWriter, r *http.Request) {
+	snap, ok := s.dashboardSnapshot(w, r)
+	if !ok {
+		return
+	}
+	encodeJSON(w, http.StatusOK, newDashboardSnapshotDetailResponse(*snap), s.Logger)
+}
+
+// RemoveDashboardSnapshot deletes a snapshot of a dashboard
+func (s *Service) RemoveDashboardSnapshot(w http.ResponseWriter, r *http.Request) {
+	snap, ok := s.dashboardSnapshot(w, r)
+	if !ok {
+		return
+	}
+
+	ctx := r.Context()
+	if err := s.Store.DashboardSnapshots(ctx).Delete(ctx, snap); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
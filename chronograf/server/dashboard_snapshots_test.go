@@ -0,0 +1,149 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	jhttprouter "github.com/influxdata/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+func Test_renderSnapshotQuery(t *testing.T) {
+	lower := time.Date(2015, 10, 21, 7, 28, 0, 0, time.UTC)
+	upper := lower.Add(time.Hour)
+	values := snapshotTemplateValues(
+		[]chronograf.Template{
+			{
+				TemplateVar: chronograf.TemplateVar{
+					Var: ":host:",
+					Values: []chronograf.TemplateValue{
+						{Value: "doc", Type: "tagValue"},
+						{Value: "marty", Type: "tagValue", Selected: true},
+					},
+				},
+			},
+			{
+				TemplateVar: chronograf.TemplateVar{
+					Var:    ":measurement:",
+					Values: []chronograf.TemplateValue{{Value: "cpu", Type: "measurement"}},
+				},
+			},
+		},
+		[]chronograf.TemplateVar{
+			{
+				Var:    ":measurement:",
+				Values: []chronograf.TemplateValue{{Value: "mem", Type: "measurement", Selected: true}},
+			},
+		},
+	)
+
+	got := renderSnapshotQuery(`SELECT mean("used") FROM :measurement: WHERE "host" = :host: AND time > :dashboardTime: AND time < :upperDashboardTime: GROUP BY time(:interval:)`, lower, upper, values)
+	want := `SELECT mean("used") FROM "mem" WHERE "host" = 'marty' AND time > '2015-10-21T07:28:00Z' AND time < '2015-10-21T08:28:00Z' GROUP BY time(3600ms)`
+	if got != want {
+		t.Errorf("renderSnapshotQuery() = %s, want %s", got, want)
+	}
+}
+
+func TestService_NewDashboardSnapshot(t *testing.T) {
+	var added *chronograf.DashboardSnapshot
+	var queries []chronograf.Query
+	s := &Service{
+		Store: &mocks.Store{
+			DashboardsStore: &mocks.DashboardsStore{
+				GetF: func(ctx context.Context, id chronograf.DashboardID) (chronograf.Dashboard, error) {
+					return chronograf.Dashboard{
+						ID:   id,
+						Name: "cpu",
+						Cells: []chronograf.DashboardCell{
+							{
+								ID: "a",
+								Queries: []chronograf.DashboardQuery{
+									{
+										Command:     "SELECT mean(usage_user) FROM cpu WHERE time > :dashboardTime:",
+										QueryConfig: chronograf.QueryConfig{Database: "telegraf"},
+									},
+									{
+										Command: "SELECT mean(usage_idle) FROM cpu",
+										Source:  "/chronograf/v1/sources/2",
+									},
+								},
+							},
+						},
+					}, nil
+				},
+			},
+			SourcesStore: &mocks.SourcesStore{
+				GetF: func(ctx context.Context, id int) (chronograf.Source, error) {
+					if id != 1 {
+						return chronograf.Source{}, chronograf.ErrSourceNotFound
+					}
+					return chronograf.Source{ID: 1}, nil
+				},
+			},
+			DashboardSnapshotsStore: &mocks.DashboardSnapshotsStore{
+				AddF: func(ctx context.Context, snap *chronograf.DashboardSnapshot) (*chronograf.DashboardSnapshot, error) {
+					snap.ID = 1
+					added = snap
+					return snap, nil
+				},
+			},
+		},
+		TimeSeriesClient: &mocks.TimeSeries{
+			ConnectF: func(context.Context, *chronograf.Source) error {
+				return nil
+			},
+			QueryF: func(ctx context.Context, q chronograf.Query) (chronograf.Response, error) {
+				queries = append(queries, q)
+				return mocks.NewResponse(`{"results":[{"statement_id":0}]}`, nil), nil
+			},
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+
+	body := `{"name":"outage","lower":"2015-10-21T07:28:00Z","upper":"2015-10-21T08:28:00Z","source":"1"}`
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("POST", "http://any.url/chronograf/v1/dashboards/1/snapshots", bytes.NewBufferString(body))
+	r = r.WithContext(context.WithValue(r.Context(), jhttprouter.ParamsKey, jhttprouter.Params{{Key: "id", Value: "1"}}))
+
+	s.NewDashboardSnapshot(w, r)
+
+	resp := w.Result()
+	content, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("NewDashboardSnapshot() = %v, want %v: %s", resp.StatusCode, http.StatusCreated, content)
+	}
+	if resp.Header.Get("Location") != "/chronograf/v1/dashboards/1/snapshots/1" {
+		t.Errorf("NewDashboardSnapshot() Location = %s", resp.Header.Get("Location"))
+	}
+	if added == nil || len(added.Results) != 2 {
+		t.Fatalf("NewDashboardSnapshot() stored %#v, want two results", added)
+	}
+	if len(queries) != 1 || queries[0].Command != "SELECT mean(usage_user) FROM cpu WHERE time > '2015-10-21T07:28:00Z'" || queries[0].DB != "telegraf" {
+		t.Errorf("NewDashboardSnapshot() queried %#v", queries)
+	}
+	if res := added.Results[0]; res.Source != "/chronograf/v1/sources/1" || res.Error != "" || string(res.Result) != `{"results":{"results":[{"statement_id":0}]}}` {
+		t.Errorf("NewDashboardSnapshot() result of the first query = %#v", res)
+	}
+	if res := added.Results[1]; res.Error != "source 2 not found" || res.Result != nil {
+		t.Errorf("NewDashboardSnapshot() result of a query of a missing source = %#v", res)
+	}
+
+	var got struct {
+		Name    string                   `json:"name"`
+		Lower   string                   `json:"lower"`
+		Results []snapshotResultResponse `json:"results"`
+	}
+	if err := json.Unmarshal(content, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != "outage" || got.Lower != "2015-10-21T07:28:00Z" || len(got.Results) != 2 || string(got.Results[0].Result) != `{"results":{"results":[{"statement_id":0}]}}` {
+		t.Errorf("NewDashboardSnapshot() = %s", content)
+	}
+}
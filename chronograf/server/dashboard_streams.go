@@ -0,0 +1,271 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/organizations"
+)
+
+const (
+	// defaultDashboardStreamRefresh is the refresh interval of dashboard
+	// streams opened without one
+	defaultDashboardStreamRefresh = 10 * time.Second
+	// minDashboardStreamRefresh is the shortest refresh interval of a
+	// dashboard stream
+	minDashboardStreamRefresh = time.Second
+)
+
+// dashboardStreamKey identifies the queries shared by the viewers of a
+// dashboard stream. Viewers of the same dashboard with the same source,
+// range and refresh interval receive the same results.
+type dashboardStreamKey struct {
+	Organization string
+	Dashboard    chronograf.DashboardID
+	Source       string
+	Range        time.Duration
+	Refresh      time.Duration
+}
+
+// dashboardStreamEvent holds the results of one refresh of a dashboard
+type dashboardStreamEvent struct {
+	DashboardID chronograf.DashboardID   `json:"dashboardID"`
+	Lower       string                   `json:"lower"`
+	Upper       string                   `json:"upper"`
+	Results     []snapshotResultResponse `json:"results"`
+	Error       string                   `json:"error,omitempty"` // Error is the reason the dashboard could not be refreshed, if it could not
+}
+
+// dashboardStream runs the queries of a dashboard on its refresh interval
+// for as long as it has subscribers
+type dashboardStream struct {
+	subs   map[chan dashboardStreamEvent]struct{}
+	last   *dashboardStreamEvent // last is sent to new subscribers so they need not wait for a refresh
+	cancel context.CancelFunc
+}
+
+// DashboardStreams runs the queries of dashboards shown by many viewers once
+// per refresh interval and fans out their results to every viewer
+type DashboardStreams struct {
+	mu      sync.Mutex
+	streams map[dashboardStreamKey]*dashboardStream
+}
+
+// NewDashboardStreams returns DashboardStreams without subscribers
+func NewDashboardStreams() *DashboardStreams {
+	return &DashboardStreams{
+		streams: map[dashboardStreamKey]*dashboardStream{},
+	}
+}
+
+// Subscribe returns a channel receiving the results of the dashboard stream
+// of key. The first subscriber of a key starts calling refresh every
+// key.Refresh; the last one to unsubscribe stops it. The returned function
+// must be called to unsubscribe.
+func (d *DashboardStreams) Subscribe(key dashboardStreamKey, refresh func(context.Context) dashboardStreamEvent) (<-chan dashboardStreamEvent, func()) {
+	ch := make(chan dashboardStreamEvent, 1)
+
+	d.mu.Lock()
+	stream, ok := d.streams[key]
+	if !ok {
+		ctx, cancel := context.WithCancel(context.Background())
+		stream = &dashboardStream{
+			subs:   map[chan dashboardStreamEvent]struct{}{},
+			cancel: cancel,
+		}
+		d.streams[key] = stream
+		go d.run(ctx, key, stream, refresh)
+	}
+	stream.subs[ch] = struct{}{}
+	if stream.last != nil {
+		ch <- *stream.last
+	}
+	d.mu.Unlock()
+
+	return ch, func() {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		delete(stream.subs, ch)
+		if len(stream.subs) != 0 {
+			return
+		}
+		stream.cancel()
+		if d.streams[key] == stream {
+			delete(d.streams, key)
+		}
+	}
+}
+
+// run refreshes the stream immediately and then every key.Refresh until ctx
+// is cancelled
+func (d *DashboardStreams) run(ctx context.Context, key dashboardStreamKey, stream *dashboardStream, refresh func(context.Context) dashboardStreamEvent) {
+	ticker := time.NewTicker(key.Refresh)
+	defer ticker.Stop()
+	for {
+		event := refresh(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		d.publish(stream, event)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// publish sends event to the subscribers of stream. Slow subscribers miss
+// refreshes rather than delay the others.
+func (d *DashboardStreams) publish(stream *dashboardStream, event dashboardStreamEvent) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	stream.last = &event
+	for ch := range stream.subs {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// refreshDashboard runs the queries of every cell of a dashboard over the
+// range before now, using the template values saved with the dashboard.
+// Queries that fail are sent with their error rather than failing the refresh.
+func (s *Service) refreshDashboard(ctx context.Context, id chronograf.DashboardID, rng time.Duration, defaultSource string) dashboardStreamEvent {
+	upper := time.Now().UTC()
+	lower := upper.Add(-rng)
+	event := dashboardStreamEvent{
+		DashboardID: id,
+		Lower:       lower.Format(time.RFC3339Nano),
+		Upper:       upper.Format(time.RFC3339Nano),
+		Results:     []snapshotResultResponse{},
+	}
+
+	d, err := s.Store.Dashboards(ctx).Get(ctx, id)
+	if err != nil {
+		event.Error = fmt.Sprintf("dashboard %d not found", id)
+		return event
+	}
+
+	values := snapshotTemplateValues(d.Templates, nil)
+	for _, c := range d.Cells {
+		for _, q := range c.Queries {
+			res := snapshotResultResponse{
+				CellID: c.ID,
+				Query:  renderSnapshotQuery(q.Command, lower, upper, values),
+				Source: q.Source,
+			}
+			if res.Source == "" {
+				res.Source = defaultSource
+			}
+			data, err := s.snapshotQuery(ctx, res.Source, chronograf.Query{
+				Command: res.Query,
+				DB:      q.QueryConfig.Database,
+				RP:      q.QueryConfig.RetentionPolicy,
+				Epoch:   "ms",
+			})
+			if err != nil {
+				res.Error = err.Error()
+			} else {
+				res.Result = json.RawMessage(data)
+			}
+			event.Results = append(event.Results, res)
+		}
+	}
+	return event
+}
+
+// DashboardStream streams the query results of every cell of a dashboard as
+// server-sent events until the client disconnects. The server runs the
+// queries on the refresh interval and shares their results with every viewer
+// of the dashboard, so wallboards add no load to the sources however many
+// of them show it. The source, range and refresh parameters are the default
+// source of queries, the duration queried before each refresh (1h by
+// default) and the refresh interval (10s by default).
+func (s *Service) DashboardStream(w http.ResponseWriter, r *http.Request) {
+	id, err := paramID("id", r)
+	if err != nil {
+		Error(w, http.StatusUnprocessableEntity, err.Error(), s.Logger)
+		return
+	}
+
+	query := r.URL.Query()
+	key := dashboardStreamKey{
+		Dashboard: chronograf.DashboardID(id),
+		Range:     defaultSnapshotRange,
+		Refresh:   defaultDashboardStreamRefresh,
+	}
+	if src := query.Get("source"); src != "" {
+		if _, err := strconv.Atoi(src); err != nil {
+			invalidData(w, fmt.Errorf("invalid source ID %q", src), s.Logger)
+			return
+		}
+		key.Source = sourceLinkPrefix + src
+	}
+	if rng := query.Get("range"); rng != "" {
+		if key.Range, err = time.ParseDuration(rng); err != nil || key.Range <= 0 {
+			invalidData(w, errorf("invalid range %q", rng), s.Logger)
+			return
+		}
+	}
+	if refresh := query.Get("refresh"); refresh != "" {
+		if key.Refresh, err = time.ParseDuration(refresh); err != nil {
+			invalidData(w, errorf("invalid refresh %q", refresh), s.Logger)
+			return
+		}
+		if key.Refresh < minDashboardStreamRefresh {
+			invalidData(w, errorf("refresh must be at least %s", minDashboardStreamRefresh), s.Logger)
+			return
+		}
+	}
+
+	ctx := r.Context()
+	d, err := s.Store.Dashboards(ctx).Get(ctx, key.Dashboard)
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+	key.Organization = d.Organization
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		Error(w, http.StatusInternalServerError, ErrNotFlusher, s.Logger)
+		return
+	}
+
+	// The queries outlive the request of the viewer who started them, so
+	// they run within the organization of the dashboard rather than ctx.
+	events, cancel := s.DashboardStreams.Subscribe(key, func(streamCtx context.Context) dashboardStreamEvent {
+		orgCtx := context.WithValue(streamCtx, organizations.ContextKey, key.Organization)
+		return s.refreshDashboard(orgCtx, key.Dashboard, key.Range, key.Source)
+	})
+	defer cancel()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-events:
+			b, err := json.Marshal(event)
+			if err != nil {
+				s.Logger.Error("Unable to encode dashboard results: ", err)
+				continue
+			}
+			fmt.Fprintf(w, "event: results\ndata: %s\n\n", b)
+			flusher.Flush()
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	jhttprouter "github.com/influxdata/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+func TestDashboardStreams_Subscribe(t *testing.T) {
+	streams := NewDashboardStreams()
+	key := dashboardStreamKey{Dashboard: 1, Range: time.Hour, Refresh: time.Minute}
+
+	var mu sync.Mutex
+	refreshes := 0
+	refresh := func(ctx context.Context) dashboardStreamEvent {
+		mu.Lock()
+		defer mu.Unlock()
+		refreshes++
+		return dashboardStreamEvent{DashboardID: 1}
+	}
+
+	first, unsubscribeFirst := streams.Subscribe(key, refresh)
+	if event := <-first; event.DashboardID != 1 {
+		t.Fatalf("Subscribe() received %#v", event)
+	}
+	// A later viewer receives the last results without another refresh
+	second, unsubscribeSecond := streams.Subscribe(key, refresh)
+	if event := <-second; event.DashboardID != 1 {
+		t.Fatalf("Subscribe() received %#v", event)
+	}
+	mu.Lock()
+	if refreshes != 1 {
+		t.Errorf("Subscribe() refreshed %d times for two viewers, want 1", refreshes)
+	}
+	mu.Unlock()
+
+	unsubscribeFirst()
+	unsubscribeSecond()
+	streams.mu.Lock()
+	defer streams.mu.Unlock()
+	if len(streams.streams) != 0 {
+		t.Errorf("Subscribe() kept %d streams without viewers", len(streams.streams))
+	}
+}
+
+func TestService_DashboardStream(t *testing.T) {
+	var mu sync.Mutex
+	var queries []chronograf.Query
+	s := &Service{
+		Store: &mocks.Store{
+			DashboardsStore: &mocks.DashboardsStore{
+				GetF: func(ctx context.Context, id chronograf.DashboardID) (chronograf.Dashboard, error) {
+					if id != 1 {
+						return chronograf.Dashboard{}, chronograf.ErrDashboardNotFound
+					}
+					return chronograf.Dashboard{
+						ID:           id,
+						Organization: "default",
+						Cells: []chronograf.DashboardCell{
+							{
+								ID: "a",
+								Queries: []chronograf.DashboardQuery{
+									{
+										Command:     "SELECT mean(usage_user) FROM cpu WHERE time > :dashboardTime:",
+										QueryConfig: chronograf.QueryConfig{Database: "telegraf"},
+									},
+								},
+							},
+						},
+					}, nil
+				},
+			},
+			SourcesStore: &mocks.SourcesStore{
+				GetF: func(ctx context.Context, id int) (chronograf.Source, error) {
+					return chronograf.Source{ID: id}, nil
+				},
+			},
+		},
+		TimeSeriesClient: &mocks.TimeSeries{
+			ConnectF: func(context.Context, *chronograf.Source) error {
+				return nil
+			},
+			QueryF: func(ctx context.Context, q chronograf.Query) (chronograf.Response, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				queries = append(queries, q)
+				return mocks.NewResponse(`{"results":[{"statement_id":0}]}`, nil), nil
+			},
+		},
+		Logger:           &chronograf.NoopLogger{},
+		DashboardStreams: NewDashboardStreams(),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/chronograf/v1/streams/dashboards/")
+		r = r.WithContext(context.WithValue(r.Context(), jhttprouter.ParamsKey, jhttprouter.Params{{Key: "id", Value: id}}))
+		s.DashboardStream(w, r)
+	}))
+	defer ts.Close()
+
+	// Every viewer receives the results of the same queries
+	for i := 0; i < 2; i++ {
+		resp, err := http.Get(ts.URL + "/chronograf/v1/streams/dashboards/1?source=1&refresh=1m")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("DashboardStream() = %v, want %v", resp.StatusCode, http.StatusOK)
+		}
+		if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+			t.Errorf("DashboardStream() Content-Type = %s", ct)
+		}
+
+		var event dashboardStreamEvent
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			if data := strings.TrimPrefix(scanner.Text(), "data: "); data != scanner.Text() {
+				if err := json.Unmarshal([]byte(data), &event); err != nil {
+					t.Fatalf("DashboardStream() sent %s", data)
+				}
+				break
+			}
+		}
+		if len(event.Results) != 1 {
+			t.Fatalf("DashboardStream() sent %#v, want one result", event)
+		}
+		if res := event.Results[0]; res.CellID != "a" || res.Source != "/chronograf/v1/sources/1" || res.Error != "" || res.Result == nil {
+			t.Errorf("DashboardStream() sent result %#v", res)
+		}
+	}
+
+	mu.Lock()
+	if len(queries) != 1 || queries[0].DB != "telegraf" {
+		t.Errorf("DashboardStream() queried %#v for two viewers, want one query", queries)
+	}
+	mu.Unlock()
+
+	for _, tt := range []struct {
+		url  string
+		want int
+	}{
+		{url: "/chronograf/v1/streams/dashboards/2", want: http.StatusNotFound},
+		{url: "/chronograf/v1/streams/dashboards/1?refresh=10ms", want: http.StatusUnprocessableEntity},
+		{url: "/chronograf/v1/streams/dashboards/1?range=-1h", want: http.StatusUnprocessableEntity},
+	} {
+		resp, err := http.Get(ts.URL + tt.url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != tt.want {
+			t.Errorf("DashboardStream() of %s = %v, want %v", tt.url, resp.StatusCode, tt.want)
+		}
+	}
+}
@@ -0,0 +1,331 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+type dashboardVersionLinks struct {
+	Self    string `json:"self"`    // Self link mapping to this resource
+	Diff    string `json:"diff"`    // Diff link comparing the revision with the current dashboard
+	Restore string `json:"restore"` // Restore link replacing the current dashboard with the revision
+}
+
+type dashboardVersionResponse struct {
+	Revision  int                   `json:"revision"`
+	Name      string                `json:"name"` // Name is the name of the dashboard at the revision
+	Author    string                `json:"author"`
+	CreatedAt time.Time             `json:"createdAt"`
+	Links     dashboardVersionLinks `json:"links"`
+}
+
+func newDashboardVersionResponse(v chronograf.DashboardVersion) *dashboardVersionResponse {
+	base := fmt.Sprintf("/chronograf/v1/dashboards/%d/versions/%d", v.DashboardID, v.Revision)
+	return &dashboardVersionResponse{
+		Revision:  v.Revision,
+		Name:      v.Dashboard.Name,
+		Author:    v.Author,
+		CreatedAt: v.CreatedAt,
+		Links: dashboardVersionLinks{
+			Self:    base,
+			Diff:    base + "/diff",
+			Restore: base + "/restore",
+		},
+	}
+}
+
+type dashboardVersionsResponse struct {
+	Links    selfLinks                   `json:"links"`
+	Versions []*dashboardVersionResponse `json:"versions"`
+}
+
+// dashboardVersionDetailResponse is a revision along with the dashboard as it
+// was at the revision
+type dashboardVersionDetailResponse struct {
+	*dashboardVersionResponse
+	Dashboard *dashboardResponse `json:"dashboard"`
+}
+
+type dashboardDiffEntry struct {
+	ID   string `json:"id"`
+	Name string `json:"name"` // Name is the name of a cell or the variable of a template
+}
+
+type dashboardDiffChanges struct {
+	Added   []dashboardDiffEntry `json:"added"`
+	Removed []dashboardDiffEntry `json:"removed"`
+	Changed []dashboardDiffEntry `json:"changed"`
+}
+
+type dashboardNameChange struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// dashboardDiffResponse describes the changes needed to turn the dashboard at
+// revision From into the dashboard at revision To. A To of zero is the current
+// dashboard.
+type dashboardDiffResponse struct {
+	From      int                  `json:"from"`
+	To        int                  `json:"to"`
+	Name      *dashboardNameChange `json:"name,omitempty"`
+	Cells     dashboardDiffChanges `json:"cells"`
+	Templates dashboardDiffChanges `json:"templates"`
+}
+
+// diffDashboards compares the names, cells and templates of two dashboards.
+// Cells and templates are matched by ID.
+func diffDashboards(from, to chronograf.Dashboard) dashboardDiffResponse {
+	var res dashboardDiffResponse
+	if from.Name != to.Name {
+		res.Name = &dashboardNameChange{
+			From: from.Name,
+			To:   to.Name,
+		}
+	}
+
+	fromCells, toCells := map[string]interface{}{}, map[string]interface{}{}
+	names := map[string]string{}
+	var cellIDs []string
+	for _, c := range from.Cells {
+		fromCells[c.ID] = c
+		names[c.ID] = c.Name
+		cellIDs = append(cellIDs, c.ID)
+	}
+	for _, c := range to.Cells {
+		toCells[c.ID] = c
+		names[c.ID] = c.Name
+		cellIDs = append(cellIDs, c.ID)
+	}
+	res.Cells = diffByID(fromCells, toCells, names, cellIDs)
+
+	fromTmps, toTmps := map[string]interface{}{}, map[string]interface{}{}
+	vars := map[string]string{}
+	var tmpIDs []string
+	for _, t := range from.Templates {
+		fromTmps[string(t.ID)] = t
+		vars[string(t.ID)] = t.Var
+		tmpIDs = append(tmpIDs, string(t.ID))
+	}
+	for _, t := range to.Templates {
+		toTmps[string(t.ID)] = t
+		vars[string(t.ID)] = t.Var
+		tmpIDs = append(tmpIDs, string(t.ID))
+	}
+	res.Templates = diffByID(fromTmps, toTmps, vars, tmpIDs)
+	return res
+}
+
+// diffByID reports the IDs added to, removed from and changed between from
+// and to in the order of ids, which may contain duplicates.
+func diffByID(from, to map[string]interface{}, names map[string]string, ids []string) dashboardDiffChanges {
+	changes := dashboardDiffChanges{
+		Added:   []dashboardDiffEntry{},
+		Removed: []dashboardDiffEntry{},
+		Changed: []dashboardDiffEntry{},
+	}
+	seen := map[string]bool{}
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		entry := dashboardDiffEntry{
+			ID:   id,
+			Name: names[id],
+		}
+		f, inFrom := from[id]
+		t, inTo := to[id]
+		switch {
+		case !inFrom:
+			changes.Added = append(changes.Added, entry)
+		case !inTo:
+			changes.Removed = append(changes.Removed, entry)
+		case !reflect.DeepEqual(f, t):
+			changes.Changed = append(changes.Changed, entry)
+		}
+	}
+	return changes
+}
+
+// recordDashboardVersion records the stored dashboard as its next revision
+// after a write. The dashboard is read back as the store may normalize it.
+// Failures are logged rather than returned as the write itself succeeded.
+func (s *Service) recordDashboardVersion(ctx context.Context, id chronograf.DashboardID) {
+	versions := s.Store.DashboardVersions(ctx)
+	if versions == nil {
+		return
+	}
+
+	d, err := s.Store.Dashboards(ctx).Get(ctx, id)
+	if err != nil {
+		s.Logger.Error("Unable to read dashboard ", id, " to record its revision: ", err)
+		return
+	}
+
+	v := &chronograf.DashboardVersion{
+		DashboardID: id,
+		Dashboard:   d,
+	}
+	if u, ok := hasUserContext(ctx); ok {
+		v.Author = u.Name
+	}
+	if _, err := versions.Add(ctx, v); err != nil {
+		s.Logger.Error("Unable to record revision of dashboard ", id, ": ", err)
+	}
+}
+
+// dashboardVersion returns the dashboard of the id parameter along with its
+// revision of the rev parameter. The dashboard is read first so that only
+// revisions of dashboards within the current organization are returned.
+func (s *Service) dashboardVersion(w http.ResponseWriter, r *http.Request) (chronograf.Dashboard, *chronograf.DashboardVersion, bool) {
+	id, err := paramID("id", r)
+	if err != nil {
+		Error(w, http.StatusUnprocessableEntity, err.Error(), s.Logger)
+		return chronograf.Dashboard{}, nil, false
+	}
+	rev, err := paramID("rev", r)
+	if err != nil {
+		Error(w, http.StatusUnprocessableEntity, err.Error(), s.Logger)
+		return chronograf.Dashboard{}, nil, false
+	}
+
+	ctx := r.Context()
+	d, err := s.Store.Dashboards(ctx).Get(ctx, chronograf.DashboardID(id))
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return chronograf.Dashboard{}, nil, false
+	}
+	v, err := s.Store.DashboardVersions(ctx).Get(ctx, d.ID, rev)
+	if err == chronograf.ErrDashboardVersionNotFound {
+		Error(w, http.StatusNotFound, fmt.Sprintf("revision %d of dashboard %d not found", rev, id), s.Logger)
+		return chronograf.Dashboard{}, nil, false
+	} else if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return chronograf.Dashboard{}, nil, false
+	}
+	return d, v, true
+}
+
+// DashboardVersions lists the revisions of a dashboard, oldest first
+func (s *Service) DashboardVersions(w http.ResponseWriter, r *http.Request) {
+	id, err := paramID("id", r)
+	if err != nil {
+		Error(w, http.StatusUnprocessableEntity, err.Error(), s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	d, err := s.Store.Dashboards(ctx).Get(ctx, chronograf.DashboardID(id))
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+
+	versions, err := s.Store.DashboardVersions(ctx).All(ctx, d.ID)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	res := &dashboardVersionsResponse{
+		Links: selfLinks{
+			Self: fmt.Sprintf("/chronograf/v1/dashboards/%d/versions", d.ID),
+		},
+		Versions: make([]*dashboardVersionResponse, len(versions)),
+	}
+	for i, v := range versions {
+		res.Versions[i] = newDashboardVersionResponse(v)
+	}
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// DashboardVersion returns a revision of a dashboard along with the dashboard
+// as it was at the revision
+func (s *Service) DashboardVersion(w http.ResponseWriter, r *http.Request) {
+	_, v, ok := s.dashboardVersion(w, r)
+	if !ok {
+		return
+	}
+
+	res := &dashboardVersionDetailResponse{
+		dashboardVersionResponse: newDashboardVersionResponse(*v),
+		Dashboard:                newDashboardResponse(v.Dashboard),
+	}
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// DashboardVersionDiff compares a revision of a dashboard with the revision of
+// the to query parameter or, without one, with the current dashboard
+func (s *Service) DashboardVersionDiff(w http.ResponseWriter, r *http.Request) {
+	d, v, ok := s.dashboardVersion(w, r)
+	if !ok {
+		return
+	}
+
+	to, toRev := d, 0
+	if q := r.URL.Query().Get("to"); q != "" {
+		var err error
+		if toRev, err = strconv.Atoi(q); err != nil || toRev < 1 {
+			Error(w, http.StatusUnprocessableEntity, fmt.Sprintf("invalid revision %q", q), s.Logger)
+			return
+		}
+		ctx := r.Context()
+		tv, err := s.Store.DashboardVersions(ctx).Get(ctx, d.ID, toRev)
+		if err == chronograf.ErrDashboardVersionNotFound {
+			Error(w, http.StatusNotFound, fmt.Sprintf("revision %d of dashboard %d not found", toRev, d.ID), s.Logger)
+			return
+		} else if err != nil {
+			unknownErrorWithMessage(w, err, s.Logger)
+			return
+		}
+		to = tv.Dashboard
+	}
+
+	res := diffDashboards(v.Dashboard, to)
+	res.From = v.Revision
+	res.To = toRev
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// RestoreDashboardVersion replaces a dashboard with one of its revisions. The
+// restore is recorded as a new revision so that it may be undone as well.
+func (s *Service) RestoreDashboardVersion(w http.ResponseWriter, r *http.Request) {
+	// An invalid ID is rejected by dashboardVersion
+	if id, err := paramID("id", r); err == nil {
+		defer lockResource("dashboards", id)()
+	}
+	d, v, ok := s.dashboardVersion(w, r)
+	if !ok {
+		return
+	}
+	if !checkIfMatch(w, r, d, s.Logger) {
+		return
+	}
+
+	restored := v.Dashboard
+	restored.ID = d.ID
+	restored.Organization = d.Organization
+	// Revisions restore the contents of the dashboard rather than its folder
+	restored.Folder = d.Folder
+
+	ctx := r.Context()
+	if err := s.Store.Dashboards(ctx).Update(ctx, restored); err != nil {
+		msg := fmt.Sprintf("Error restoring revision %d of dashboard ID %d: %v", v.Revision, d.ID, err)
+		Error(w, http.StatusInternalServerError, msg, s.Logger)
+		return
+	}
+	s.recordDashboardVersion(ctx, d.ID)
+	s.publishDashboardEvent(ctx, EventDashboardUpdated, restored)
+
+	res := newDashboardResponse(restored)
+	s.setDashboardETag(ctx, w, d.ID)
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
@@ -0,0 +1,187 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	jhttprouter "github.com/influxdata/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+// newVersionsService returns a Service storing a single dashboard and its
+// revisions in memory
+func newVersionsService(current *chronograf.Dashboard, versions *[]chronograf.DashboardVersion) *Service {
+	return &Service{
+		Store: &mocks.Store{
+			DashboardsStore: &mocks.DashboardsStore{
+				GetF: func(ctx context.Context, id chronograf.DashboardID) (chronograf.Dashboard, error) {
+					if id != current.ID {
+						return chronograf.Dashboard{}, chronograf.ErrDashboardNotFound
+					}
+					return *current, nil
+				},
+				UpdateF: func(ctx context.Context, d chronograf.Dashboard) error {
+					*current = d
+					return nil
+				},
+			},
+			DashboardVersionsStore: &mocks.DashboardVersionsStore{
+				AllF: func(ctx context.Context, id chronograf.DashboardID) ([]chronograf.DashboardVersion, error) {
+					return *versions, nil
+				},
+				AddF: func(ctx context.Context, v *chronograf.DashboardVersion) (*chronograf.DashboardVersion, error) {
+					v.Revision = len(*versions) + 1
+					*versions = append(*versions, *v)
+					return v, nil
+				},
+				GetF: func(ctx context.Context, id chronograf.DashboardID, revision int) (*chronograf.DashboardVersion, error) {
+					if revision < 1 || revision > len(*versions) {
+						return nil, chronograf.ErrDashboardVersionNotFound
+					}
+					v := (*versions)[revision-1]
+					return &v, nil
+				},
+			},
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+}
+
+func TestService_UpdateDashboard_RecordsVersion(t *testing.T) {
+	current := chronograf.Dashboard{ID: 1, Name: "cpu", Organization: "default"}
+	versions := []chronograf.DashboardVersion{}
+	s := newVersionsService(&current, &versions)
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("PATCH", "http://any.url/chronograf/v1/dashboards/1", bytes.NewBufferString(`{"name":"memory"}`))
+	ctx := context.WithValue(r.Context(), jhttprouter.ParamsKey, jhttprouter.Params{{Key: "id", Value: "1"}})
+	ctx = context.WithValue(ctx, UserContextKey, &chronograf.User{ID: 42, Name: "marty"})
+	r = r.WithContext(ctx)
+
+	s.UpdateDashboard(w, r)
+
+	if resp := w.Result(); resp.StatusCode != http.StatusOK {
+		t.Fatalf("UpdateDashboard() = %v, want %v", resp.StatusCode, http.StatusOK)
+	}
+	if len(versions) != 1 {
+		t.Fatalf("UpdateDashboard() recorded %d revisions, want 1", len(versions))
+	}
+	if v := versions[0]; v.DashboardID != 1 || v.Dashboard.Name != "memory" || v.Author != "marty" {
+		t.Errorf("UpdateDashboard() recorded %#v", v)
+	}
+}
+
+func TestService_DashboardVersionDiff(t *testing.T) {
+	current := chronograf.Dashboard{
+		ID:   1,
+		Name: "memory",
+		Cells: []chronograf.DashboardCell{
+			{ID: "a", Name: "used", W: 4},
+			{ID: "c", Name: "swap"},
+		},
+	}
+	versions := []chronograf.DashboardVersion{
+		{
+			DashboardID: 1,
+			Revision:    1,
+			Dashboard: chronograf.Dashboard{
+				ID:   1,
+				Name: "cpu",
+				Cells: []chronograf.DashboardCell{
+					{ID: "a", Name: "used", W: 2},
+					{ID: "b", Name: "idle"},
+				},
+				Templates: []chronograf.Template{
+					{ID: "t", TemplateVar: chronograf.TemplateVar{Var: ":host:"}},
+				},
+			},
+		},
+	}
+	s := newVersionsService(&current, &versions)
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "http://any.url/chronograf/v1/dashboards/1/versions/1/diff", nil)
+	r = r.WithContext(context.WithValue(r.Context(), jhttprouter.ParamsKey, jhttprouter.Params{
+		{Key: "id", Value: "1"},
+		{Key: "rev", Value: "1"},
+	}))
+
+	s.DashboardVersionDiff(w, r)
+
+	resp := w.Result()
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("DashboardVersionDiff() = %v, want %v: %s", resp.StatusCode, http.StatusOK, body)
+	}
+	want := `{"from":1,"to":0,"name":{"from":"cpu","to":"memory"},
+"cells":{"added":[{"id":"c","name":"swap"}],"removed":[{"id":"b","name":"idle"}],"changed":[{"id":"a","name":"used"}]},
+"templates":{"added":[],"removed":[{"id":"t","name":":host:"}],"changed":[]}}`
+	if eq, _ := jsonEqual(string(body), want); !eq {
+		t.Errorf("DashboardVersionDiff() = \n***%v***\n,\nwant\n***%v***", string(body), want)
+	}
+}
+
+func TestService_RestoreDashboardVersion(t *testing.T) {
+	tests := []struct {
+		name       string
+		rev        string
+		wantStatus int
+		wantName   string
+		wantRevs   int
+	}{
+		{
+			name:       "Restore a revision",
+			rev:        "1",
+			wantStatus: http.StatusOK,
+			wantName:   "cpu",
+			wantRevs:   3,
+		},
+		{
+			name:       "Unknown revision",
+			rev:        "7",
+			wantStatus: http.StatusNotFound,
+			wantName:   "memory",
+			wantRevs:   2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current := chronograf.Dashboard{ID: 1, Name: "memory", Organization: "default"}
+			versions := []chronograf.DashboardVersion{
+				{DashboardID: 1, Revision: 1, Dashboard: chronograf.Dashboard{ID: 1, Name: "cpu", Organization: "default"}},
+				{DashboardID: 1, Revision: 2, Dashboard: current},
+			}
+			s := newVersionsService(&current, &versions)
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url/chronograf/v1/dashboards/1/versions/"+tt.rev+"/restore", nil)
+			r = r.WithContext(context.WithValue(r.Context(), jhttprouter.ParamsKey, jhttprouter.Params{
+				{Key: "id", Value: "1"},
+				{Key: "rev", Value: tt.rev},
+			}))
+
+			s.RestoreDashboardVersion(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("%q. RestoreDashboardVersion() = %v, want %v: %s", tt.name, resp.StatusCode, tt.wantStatus, body)
+			}
+			if current.Name != tt.wantName {
+				t.Errorf("%q. RestoreDashboardVersion() stored name %s, want %s", tt.name, current.Name, tt.wantName)
+			}
+			if len(versions) != tt.wantRevs {
+				t.Errorf("%q. RestoreDashboardVersion() left %d revisions, want %d", tt.name, len(versions), tt.wantRevs)
+			}
+			if tt.wantStatus == http.StatusOK && !reflect.DeepEqual(versions[len(versions)-1].Dashboard, current) {
+				t.Errorf("%q. RestoreDashboardVersion() recorded %#v, want %#v", tt.name, versions[len(versions)-1].Dashboard, current)
+			}
+		})
+	}
+}
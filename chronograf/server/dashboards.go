@@ -1,9 +1,12 @@
 package server
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"regexp"
+	"time"
 
 	"github.com/influxdata/influxdb/chronograf"
 )
@@ -20,6 +23,9 @@ type dashboardResponse struct {
 	Templates    []templateResponse      `json:"templates"`
 	Name         string                  `json:"name"`
 	Organization string                  `json:"organization"`
+	Folder       string                  `json:"folder,omitempty"`
+	CacheTTL     string                  `json:"cacheTTL,omitempty"`
+	Slug         string                  `json:"slug,omitempty"`
 	Links        dashboardLinks          `json:"links"`
 }
 
@@ -39,6 +45,9 @@ func newDashboardResponse(d chronograf.Dashboard) *dashboardResponse {
 		Cells:        cells,
 		Templates:    templates,
 		Organization: d.Organization,
+		Folder:       d.Folder,
+		CacheTTL:     d.CacheTTL,
+		Slug:         d.Slug,
 		Links: dashboardLinks{
 			Self:      fmt.Sprintf("%s/%d", base, dd.ID),
 			Cells:     fmt.Sprintf("%s/%d/cells", base, dd.ID),
@@ -47,8 +56,15 @@ func newDashboardResponse(d chronograf.Dashboard) *dashboardResponse {
 	}
 }
 
-// Dashboards returns all dashboards within the store
+// Dashboards returns all dashboards within the store, with only the fields
+// of the fields parameter if any
 func (s *Service) Dashboards(w http.ResponseWriter, r *http.Request) {
+	fields, err := validFieldset(r.URL.Query(), dashboardResponse{})
+	if err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
 	ctx := r.Context()
 	dashboards, err := s.Store.Dashboards(ctx).All(ctx)
 	if err != nil {
@@ -63,7 +79,12 @@ func (s *Service) Dashboards(w http.ResponseWriter, r *http.Request) {
 	for _, dashboard := range dashboards {
 		res.Dashboards = append(res.Dashboards, newDashboardResponse(dashboard))
 	}
-	encodeJSON(w, http.StatusOK, res, s.Logger)
+	sparse, err := fields.list(res, "dashboards")
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	encodeJSON(w, http.StatusOK, sparse, s.Logger)
 }
 
 // DashboardID returns a single specified dashboard
@@ -81,7 +102,10 @@ func (s *Service) DashboardID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	s.recordDashboardView(ctx, e.ID, time.Now().UTC())
+
 	res := newDashboardResponse(e)
+	setETag(w, e)
 	encodeJSON(w, http.StatusOK, res, s.Logger)
 }
 
@@ -105,12 +129,30 @@ func (s *Service) NewDashboard(w http.ResponseWriter, r *http.Request) {
 		invalidData(w, err, s.Logger)
 		return
 	}
+	if err := s.validDashboardFolder(ctx, dashboard.Folder); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	if taken, err := s.dashboardSlugTaken(ctx, dashboard); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	} else if taken {
+		slugTaken(w, dashboard.Slug, s.Logger)
+		return
+	}
+
+	if err := s.ensureQuota(ctx, resourceOrganization(ctx, dashboard.Organization), quotaDashboards, 1); err != nil {
+		quotaExceeded(w, err, s.Logger)
+		return
+	}
 
 	if dashboard, err = s.Store.Dashboards(ctx).Add(r.Context(), dashboard); err != nil {
 		msg := fmt.Errorf("error storing dashboard %v: %v", dashboard, err)
 		unknownErrorWithMessage(w, msg, s.Logger)
 		return
 	}
+	s.recordDashboardVersion(ctx, dashboard.ID)
+	s.publishDashboardEvent(ctx, EventDashboardCreated, dashboard)
 
 	res := newDashboardResponse(dashboard)
 	location(w, res.Links.Self)
@@ -126,19 +168,42 @@ func (s *Service) RemoveDashboard(w http.ResponseWriter, r *http.Request) {
 	}
 
 	ctx := r.Context()
+	defer lockResource("dashboards", id)()
 	e, err := s.Store.Dashboards(ctx).Get(ctx, chronograf.DashboardID(id))
 	if err != nil {
 		notFound(w, id, s.Logger)
 		return
 	}
+	if !checkIfMatch(w, r, e, s.Logger) {
+		return
+	}
 
-	if err := s.Store.Dashboards(ctx).Delete(ctx, e); err != nil {
+	if err := s.deleteDashboard(ctx, e); err != nil {
 		unknownErrorWithMessage(w, err, s.Logger)
 		return
 	}
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// deleteDashboard deletes a dashboard along with its revisions and snapshots
+func (s *Service) deleteDashboard(ctx context.Context, d chronograf.Dashboard) error {
+	if err := s.Store.Dashboards(ctx).Delete(ctx, d); err != nil {
+		return err
+	}
+	s.publishDashboardEvent(ctx, EventDashboardDeleted, d)
+	if versions := s.Store.DashboardVersions(ctx); versions != nil {
+		if err := versions.Delete(ctx, d.ID); err != nil {
+			s.Logger.Error("Unable to remove revisions of dashboard ", d.ID, ": ", err)
+		}
+	}
+	if snapshots := s.Store.DashboardSnapshots(ctx); snapshots != nil {
+		if err := snapshots.DeleteAll(ctx, d.ID); err != nil {
+			s.Logger.Error("Unable to remove snapshots of dashboard ", d.ID, ": ", err)
+		}
+	}
+	return nil
+}
+
 // ReplaceDashboard completely replaces a dashboard
 func (s *Service) ReplaceDashboard(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -149,11 +214,15 @@ func (s *Service) ReplaceDashboard(w http.ResponseWriter, r *http.Request) {
 	}
 	id := chronograf.DashboardID(idParam)
 
-	_, err = s.Store.Dashboards(ctx).Get(ctx, id)
+	defer lockResource("dashboards", id)()
+	orig, err := s.Store.Dashboards(ctx).Get(ctx, id)
 	if err != nil {
 		Error(w, http.StatusNotFound, fmt.Sprintf("ID %d not found", id), s.Logger)
 		return
 	}
+	if !checkIfMatch(w, r, orig, s.Logger) {
+		return
+	}
 
 	var req chronograf.Dashboard
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -161,6 +230,20 @@ func (s *Service) ReplaceDashboard(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	req.ID = id
+	// Dashboards replaced without a folder stay within their folder
+	if req.Folder == "" {
+		req.Folder = orig.Folder
+	} else if err := s.validDashboardFolder(ctx, req.Folder); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	// and keep their cache TTL and slug
+	if req.CacheTTL == "" {
+		req.CacheTTL = orig.CacheTTL
+	}
+	if req.Slug == "" {
+		req.Slug = orig.Slug
+	}
 
 	defaultOrg, err := s.Store.Organizations(ctx).DefaultOrganization(ctx)
 	if err != nil {
@@ -172,18 +255,30 @@ func (s *Service) ReplaceDashboard(w http.ResponseWriter, r *http.Request) {
 		invalidData(w, err, s.Logger)
 		return
 	}
+	if taken, err := s.dashboardSlugTaken(ctx, req); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	} else if taken {
+		slugTaken(w, req.Slug, s.Logger)
+		return
+	}
 
 	if err := s.Store.Dashboards(ctx).Update(ctx, req); err != nil {
 		msg := fmt.Sprintf("Error updating dashboard ID %d: %v", id, err)
 		Error(w, http.StatusInternalServerError, msg, s.Logger)
 		return
 	}
+	s.recordDashboardVersion(ctx, id)
+	s.publishDashboardEvent(ctx, EventDashboardUpdated, req)
 
 	res := newDashboardResponse(req)
+	s.setDashboardETag(ctx, w, id)
 	encodeJSON(w, http.StatusOK, res, s.Logger)
 }
 
-// UpdateDashboard completely updates either the dashboard name or the cells
+// UpdateDashboard completely updates either the dashboard name or the cells.
+// Either may be updated along with moving the dashboard into another folder,
+// changing how long its query results are cached or changing its slug.
 func (s *Service) UpdateDashboard(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	idParam, err := paramID("id", r)
@@ -194,19 +289,57 @@ func (s *Service) UpdateDashboard(w http.ResponseWriter, r *http.Request) {
 	}
 	id := chronograf.DashboardID(idParam)
 
+	defer lockResource("dashboards", id)()
 	orig, err := s.Store.Dashboards(ctx).Get(ctx, id)
 	if err != nil {
 		Error(w, http.StatusNotFound, fmt.Sprintf("ID %d not found", id), s.Logger)
 		return
 	}
+	if !checkIfMatch(w, r, orig, s.Logger) {
+		return
+	}
 
-	var req chronograf.Dashboard
+	var req struct {
+		chronograf.Dashboard
+		Folder   *string `json:"folder"`   // Folder moves the dashboard into a folder; empty is the top level
+		CacheTTL *string `json:"cacheTTL"` // CacheTTL changes how long query results of the dashboard are cached; empty is the server default
+		Slug     *string `json:"slug"`     // Slug changes the natural key of the dashboard; empty removes it
+	}
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		invalidJSON(w, s.Logger)
 		return
 	}
 	req.ID = id
 
+	if req.Folder != nil {
+		if err := s.validDashboardFolder(ctx, *req.Folder); err != nil {
+			invalidData(w, err, s.Logger)
+			return
+		}
+		orig.Folder = *req.Folder
+	}
+	if req.CacheTTL != nil {
+		if err := validCacheTTL(*req.CacheTTL); err != nil {
+			invalidData(w, err, s.Logger)
+			return
+		}
+		orig.CacheTTL = *req.CacheTTL
+	}
+	if req.Slug != nil {
+		if err := validSlug(*req.Slug); err != nil {
+			invalidData(w, err, s.Logger)
+			return
+		}
+		orig.Slug = *req.Slug
+		if taken, err := s.dashboardSlugTaken(ctx, orig); err != nil {
+			unknownErrorWithMessage(w, err, s.Logger)
+			return
+		} else if taken {
+			slugTaken(w, orig.Slug, s.Logger)
+			return
+		}
+	}
+
 	if req.Name != "" {
 		orig.Name = req.Name
 	} else if len(req.Cells) > 0 {
@@ -215,13 +348,13 @@ func (s *Service) UpdateDashboard(w http.ResponseWriter, r *http.Request) {
 			unknownErrorWithMessage(w, err, s.Logger)
 			return
 		}
-		if err := ValidDashboardRequest(&req, defaultOrg.ID); err != nil {
+		if err := ValidDashboardRequest(&req.Dashboard, defaultOrg.ID); err != nil {
 			invalidData(w, err, s.Logger)
 			return
 		}
 		orig.Cells = req.Cells
-	} else {
-		invalidData(w, fmt.Errorf("update must include either name or cells"), s.Logger)
+	} else if req.Folder == nil && req.CacheTTL == nil && req.Slug == nil {
+		invalidData(w, fmt.Errorf("update must include either name, cells, folder, cacheTTL or slug"), s.Logger)
 		return
 	}
 
@@ -230,8 +363,11 @@ func (s *Service) UpdateDashboard(w http.ResponseWriter, r *http.Request) {
 		Error(w, http.StatusInternalServerError, msg, s.Logger)
 		return
 	}
+	s.recordDashboardVersion(ctx, id)
+	s.publishDashboardEvent(ctx, EventDashboardUpdated, orig)
 
 	res := newDashboardResponse(orig)
+	s.setDashboardETag(ctx, w, id)
 	encodeJSON(w, http.StatusOK, res, s.Logger)
 }
 
@@ -251,10 +387,64 @@ func ValidDashboardRequest(d *chronograf.Dashboard, defaultOrgID string) error {
 			return err
 		}
 	}
+	if err := validTemplateDependencies(d.Templates); err != nil {
+		return err
+	}
+	if err := validCacheTTL(d.CacheTTL); err != nil {
+		return err
+	}
+	if err := validSlug(d.Slug); err != nil {
+		return err
+	}
 	(*d) = DashboardDefaults(*d)
 	return nil
 }
 
+var slugPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// validSlug checks that the slug of a dashboard is made of lowercase letters,
+// digits, dashes and underscores. An empty slug leaves the dashboard without
+// a natural key.
+func validSlug(slug string) error {
+	if slug == "" || slugPattern.MatchString(slug) {
+		return nil
+	}
+	return errorf("invalid slug %q: use lowercase letters, digits, - and _", slug)
+}
+
+// dashboardBySlug returns the dashboard of the organization of d with the slug
+// of d, or nil if there is none
+func (s *Service) dashboardBySlug(ctx context.Context, d chronograf.Dashboard) (*chronograf.Dashboard, error) {
+	if d.Slug == "" {
+		return nil, nil
+	}
+	dashboards, err := s.Store.Dashboards(ctx).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	org := resourceOrganization(ctx, d.Organization)
+	for _, cur := range dashboards {
+		if cur.Slug == d.Slug && cur.Organization == org {
+			return &cur, nil
+		}
+	}
+	return nil, nil
+}
+
+// dashboardSlugTaken is true if another dashboard of the organization of d
+// has the slug of d
+func (s *Service) dashboardSlugTaken(ctx context.Context, d chronograf.Dashboard) (bool, error) {
+	cur, err := s.dashboardBySlug(ctx, d)
+	if err != nil {
+		return false, err
+	}
+	return cur != nil && cur.ID != d.ID, nil
+}
+
+func slugTaken(w http.ResponseWriter, slug string, logger chronograf.Logger) {
+	Error(w, http.StatusConflict, localize(w, errorf("slug %s is taken by another dashboard", slug)), logger)
+}
+
 // DashboardDefaults updates the dashboard with the default values
 // if none are specified
 func DashboardDefaults(d chronograf.Dashboard) (newDash chronograf.Dashboard) {
@@ -262,6 +452,9 @@ func DashboardDefaults(d chronograf.Dashboard) (newDash chronograf.Dashboard) {
 	newDash.Templates = d.Templates
 	newDash.Name = d.Name
 	newDash.Organization = d.Organization
+	newDash.Folder = d.Folder
+	newDash.CacheTTL = d.CacheTTL
+	newDash.Slug = d.Slug
 	newDash.Cells = make([]chronograf.DashboardCell, len(d.Cells))
 
 	for i, c := range d.Cells {
@@ -7,9 +7,11 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/influxdata/httprouter"
 	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxql"
 )
 
 const (
@@ -20,6 +22,7 @@ const (
 type dbLinks struct {
 	Self         string `json:"self"`              // Self link mapping to this resource
 	RPs          string `json:"retentionPolicies"` // URL for retention policies for this database
+	CQs          string `json:"continuousQueries"` // URL for continuous queries for this database
 	Measurements string `json:"measurements"`      // URL for measurements for this database
 }
 
@@ -41,6 +44,7 @@ func newDBResponse(srcID int, db string, rps []rpResponse) dbResponse {
 		Links: dbLinks{
 			Self:         fmt.Sprintf("%s/%d/dbs/%s", base, srcID, db),
 			RPs:          fmt.Sprintf("%s/%d/dbs/%s/rps", base, srcID, db),
+			CQs:          fmt.Sprintf("%s/%d/dbs/%s/cqs", base, srcID, db),
 			Measurements: fmt.Sprintf("%s/%d/dbs/%s/measurements?limit=100&offset=0", base, srcID, db),
 		},
 	}
@@ -51,7 +55,8 @@ type dbsResponse struct {
 }
 
 type rpLinks struct {
-	Self string `json:"self"` // Self link mapping to this resource
+	Self    string `json:"self"`    // Self link mapping to this resource
+	Preview string `json:"preview"` // Preview reports the data a change of duration would drop
 }
 
 type rpResponse struct {
@@ -67,7 +72,8 @@ type rpResponse struct {
 func (r *rpResponse) WithLinks(srcID int, db string) {
 	base := "/chronograf/v1/sources"
 	r.Links = rpLinks{
-		Self: fmt.Sprintf("%s/%d/dbs/%s/rps/%s", base, srcID, db, r.Name),
+		Self:    fmt.Sprintf("%s/%d/dbs/%s/rps/%s", base, srcID, db, r.Name),
+		Preview: fmt.Sprintf("%s/%d/dbs/%s/rps/%s/preview", base, srcID, db, r.Name),
 	}
 }
 
@@ -193,6 +199,76 @@ func (h *Service) NewDatabase(w http.ResponseWriter, r *http.Request) {
 	encodeJSON(w, http.StatusCreated, res, h.Logger)
 }
 
+// DatabaseID returns a database of a source and its retention policies
+func (h *Service) DatabaseID(w http.ResponseWriter, r *http.Request) {
+	src, dbsvc, ok := h.sourceDatabases(w, r)
+	if !ok {
+		return
+	}
+
+	ctx := r.Context()
+	db := httprouter.ParamsFromContext(ctx).ByName("db")
+	databases, err := dbsvc.AllDB(ctx)
+	if err != nil {
+		Error(w, http.StatusBadRequest, err.Error(), h.Logger)
+		return
+	}
+	for _, d := range databases {
+		if d.Name != db {
+			continue
+		}
+		rps, err := h.allRPs(ctx, dbsvc, src.ID, db)
+		if err != nil {
+			Error(w, http.StatusBadRequest, err.Error(), h.Logger)
+			return
+		}
+		encodeJSON(w, http.StatusOK, newDBResponse(src.ID, db, rps), h.Logger)
+		return
+	}
+	notFound(w, db, h.Logger)
+}
+
+type updateDatabaseRequest struct {
+	DefaultRP string `json:"defaultRP"` // DefaultRP is the retention policy written to when writes name none
+}
+
+// UpdateDatabase alters the properties of a database. InfluxQL only allows
+// to change which of its retention policies is the default one.
+func (h *Service) UpdateDatabase(w http.ResponseWriter, r *http.Request) {
+	src, dbsvc, ok := h.sourceDatabases(w, r)
+	if !ok {
+		return
+	}
+
+	var req updateDatabaseRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, h.Logger)
+		return
+	}
+	if req.DefaultRP == "" {
+		invalidData(w, fmt.Errorf("defaultRP is required"), h.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	db := httprouter.ParamsFromContext(ctx).ByName("db")
+	_, err := dbsvc.UpdateRP(ctx, db, req.DefaultRP, &chronograf.RetentionPolicy{
+		Name:    req.DefaultRP,
+		Default: true,
+	})
+	if err != nil {
+		Error(w, http.StatusBadRequest, err.Error(), h.Logger)
+		return
+	}
+
+	rps, err := h.allRPs(ctx, dbsvc, src.ID, db)
+	if err != nil {
+		Error(w, http.StatusBadRequest, err.Error(), h.Logger)
+		return
+	}
+	encodeJSON(w, http.StatusOK, newDBResponse(src.ID, db, rps), h.Logger)
+}
+
 // DropDatabase removes a database from a data source
 func (h *Service) DropDatabase(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -496,11 +572,34 @@ func validMeasurementQuery(query url.Values) (limit, offset int, err error) {
 	return
 }
 
+// validRPDuration checks that d is an InfluxQL duration or INF, as the
+// durations of retention policies are written into their statements as is
+func validRPDuration(d string) error {
+	if strings.EqualFold(d, "INF") {
+		return nil
+	}
+	if v, err := influxql.ParseDuration(d); err != nil || v < 0 {
+		return fmt.Errorf("invalid duration %s", d)
+	}
+	return nil
+}
+
 // ValidDatabaseRequest checks if the database posted is valid
 func ValidDatabaseRequest(d *chronograf.Database) error {
 	if len(d.Name) == 0 {
 		return fmt.Errorf("name is required")
 	}
+	if d.Replication < 0 {
+		return fmt.Errorf("replication factor is invalid")
+	}
+	for _, duration := range []string{d.Duration, d.ShardDuration} {
+		if duration == "" {
+			continue
+		}
+		if err := validRPDuration(duration); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -512,8 +611,16 @@ func ValidRetentionPolicyRequest(rp *chronograf.RetentionPolicy) error {
 	if len(rp.Duration) == 0 {
 		return fmt.Errorf("duration is required")
 	}
-	if rp.Replication == 0 {
+	if rp.Replication <= 0 {
 		return fmt.Errorf("replication factor is invalid")
 	}
+	for _, duration := range []string{rp.Duration, rp.ShardDuration} {
+		if duration == "" {
+			continue
+		}
+		if err := validRPDuration(duration); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -6,6 +6,8 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/influxdata/influxdb/chronograf"
@@ -389,7 +391,7 @@ func TestService_Measurements(t *testing.T) {
 			},
 			wants: wants{
 				statusCode: 422,
-				body:       `{"code":422,"message":"strconv.Atoi: parsing \"joe\": invalid syntax"}`,
+				body:       `{"code":422,"message":"strconv.Atoi: parsing \"joe\": invalid syntax","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"strconv.Atoi: parsing \"joe\": invalid syntax"}`,
 			},
 		},
 		{
@@ -410,7 +412,7 @@ func TestService_Measurements(t *testing.T) {
 			},
 			wants: wants{
 				statusCode: 422,
-				body:       `{"code":422,"message":"strconv.Atoi: parsing \"bob\": invalid syntax"}`,
+				body:       `{"code":422,"message":"strconv.Atoi: parsing \"bob\": invalid syntax","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"strconv.Atoi: parsing \"bob\": invalid syntax"}`,
 			},
 		},
 		{
@@ -616,7 +618,24 @@ func TestValidDatabaseRequest(t *testing.T) {
 		args    args
 		wantErr bool
 	}{
-		// TODO: Add test cases.
+		{
+			name: "Name only",
+			args: args{d: &chronograf.Database{Name: "telegraf"}},
+		},
+		{
+			name: "Default retention policy",
+			args: args{d: &chronograf.Database{Name: "telegraf", Duration: "30d", Replication: 2, ShardDuration: "1d"}},
+		},
+		{
+			name:    "Missing name",
+			args:    args{d: &chronograf.Database{}},
+			wantErr: true,
+		},
+		{
+			name:    "Duration injecting a statement",
+			args:    args{d: &chronograf.Database{Name: "telegraf", Duration: "1d; DROP DATABASE telegraf"}},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -636,7 +655,29 @@ func TestValidRetentionPolicyRequest(t *testing.T) {
 		args    args
 		wantErr bool
 	}{
-		// TODO: Add test cases.
+		{
+			name: "Retention policy",
+			args: args{rp: &chronograf.RetentionPolicy{Name: "weekly", Duration: "7d", Replication: 1, ShardDuration: "1d"}},
+		},
+		{
+			name: "Infinite duration",
+			args: args{rp: &chronograf.RetentionPolicy{Name: "forever", Duration: "INF", Replication: 1}},
+		},
+		{
+			name:    "Missing duration",
+			args:    args{rp: &chronograf.RetentionPolicy{Name: "weekly", Replication: 1}},
+			wantErr: true,
+		},
+		{
+			name:    "Negative replication",
+			args:    args{rp: &chronograf.RetentionPolicy{Name: "weekly", Duration: "7d", Replication: -1}},
+			wantErr: true,
+		},
+		{
+			name:    "Invalid shard duration",
+			args:    args{rp: &chronograf.RetentionPolicy{Name: "weekly", Duration: "7d", Replication: 1, ShardDuration: "1 DEFAULT"}},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -646,3 +687,146 @@ func TestValidRetentionPolicyRequest(t *testing.T) {
 		})
 	}
 }
+
+// databasesService is a Service of a source with the telegraf database and
+// its autogen and weekly retention policies
+func databasesService(updated *[]chronograf.RetentionPolicy) *Service {
+	rps := []chronograf.RetentionPolicy{
+		{Name: "autogen", Duration: "0s", Replication: 1, ShardDuration: "168h0m0s", Default: true},
+		{Name: "weekly", Duration: "168h0m0s", Replication: 1, ShardDuration: "24h0m0s"},
+	}
+	return &Service{
+		Store: &mocks.Store{
+			SourcesStore: &mocks.SourcesStore{
+				GetF: func(ctx context.Context, id int) (chronograf.Source, error) {
+					return chronograf.Source{ID: id}, nil
+				},
+			},
+		},
+		Databases: &mocks.Databases{
+			ConnectF: func(context.Context, *chronograf.Source) error {
+				return nil
+			},
+			AllDBF: func(context.Context) ([]chronograf.Database, error) {
+				return []chronograf.Database{{Name: "telegraf"}}, nil
+			},
+			AllRPF: func(ctx context.Context, db string) ([]chronograf.RetentionPolicy, error) {
+				return rps, nil
+			},
+			UpdateRPF: func(ctx context.Context, db, rp string, upd *chronograf.RetentionPolicy) (*chronograf.RetentionPolicy, error) {
+				*updated = append(*updated, *upd)
+				for i := range rps {
+					rps[i].Default = rps[i].Name == rp
+				}
+				return upd, nil
+			},
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+}
+
+func TestService_DatabaseID(t *testing.T) {
+	tests := []struct {
+		name       string
+		db         string
+		wantStatus int
+	}{
+		{
+			name:       "Database of the source",
+			db:         "telegraf",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "Missing database",
+			db:         "missing",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := databasesService(&[]chronograf.RetentionPolicy{})
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("GET", "http://any.url/chronograf/v1/sources/1/dbs/"+tt.db, nil)
+			r = r.WithContext(context.WithValue(r.Context(), httprouter.ParamsKey, httprouter.Params{
+				{Key: "id", Value: "1"},
+				{Key: "db", Value: tt.db},
+			}))
+			s.DatabaseID(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("DatabaseID() = %v, want %v: %s", resp.StatusCode, tt.wantStatus, body)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var got dbResponse
+			if err := json.Unmarshal(body, &got); err != nil {
+				t.Fatal(err)
+			}
+			if got.Name != "telegraf" || len(got.RPs) != 2 {
+				t.Errorf("DatabaseID() = %+v", got)
+			}
+		})
+	}
+}
+
+func TestService_UpdateDatabase(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		wantStatus  int
+		wantDefault string
+	}{
+		{
+			name:        "Default retention policy",
+			body:        `{"defaultRP":"weekly"}`,
+			wantStatus:  http.StatusOK,
+			wantDefault: "weekly",
+		},
+		{
+			name:       "Missing default retention policy",
+			body:       `{}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updated []chronograf.RetentionPolicy
+			s := databasesService(&updated)
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("PATCH", "http://any.url/chronograf/v1/sources/1/dbs/telegraf", strings.NewReader(tt.body))
+			r = r.WithContext(context.WithValue(r.Context(), httprouter.ParamsKey, httprouter.Params{
+				{Key: "id", Value: "1"},
+				{Key: "db", Value: "telegraf"},
+			}))
+			s.UpdateDatabase(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("UpdateDatabase() = %v, want %v: %s", resp.StatusCode, tt.wantStatus, body)
+			}
+			if tt.wantStatus != http.StatusOK {
+				if len(updated) != 0 {
+					t.Errorf("UpdateDatabase() altered %+v", updated)
+				}
+				return
+			}
+			want := []chronograf.RetentionPolicy{{Name: tt.wantDefault, Default: true}}
+			if !reflect.DeepEqual(updated, want) {
+				t.Errorf("UpdateDatabase() altered %+v, want %+v", updated, want)
+			}
+			var got dbResponse
+			if err := json.Unmarshal(body, &got); err != nil {
+				t.Fatal(err)
+			}
+			for _, rp := range got.RPs {
+				if rp.Default != (rp.Name == tt.wantDefault) {
+					t.Errorf("UpdateDatabase() retention policy %s default = %v", rp.Name, rp.Default)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/enterprise"
+	"github.com/influxdata/influxdb/chronograf/influx"
+)
+
+// discoveryRemove is the action of a discovered source whose data node left
+// its cluster
+const discoveryRemove = "remove"
+
+type sourceDiscoveryResult struct {
+	Action string `json:"action"`
+	ID     int    `json:"id,string"`
+	Name   string `json:"name"`
+	URL    string `json:"url"`
+}
+
+type sourceDiscoveryResponse struct {
+	MetaURL string                  `json:"metaUrl"`
+	Sources []sourceDiscoveryResult `json:"sources"`
+}
+
+// dataNodeURL is the URL of the HTTP API of a data node
+func dataNodeURL(node enterprise.DataNode) string {
+	scheme := node.HTTPScheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	return scheme + "://" + node.HTTPAddr
+}
+
+// discoveredName names the source of the data node at addr within a cluster
+func discoveredName(cluster, addr string) string {
+	return cluster + " " + addr
+}
+
+// clusterName is the name of the cluster of a discovered source
+func clusterName(src chronograf.Source) string {
+	u, err := url.Parse(src.URL)
+	if err != nil {
+		return src.Name
+	}
+	return strings.TrimSuffix(src.Name, " "+u.Host)
+}
+
+// clusterDataNodes lists the data nodes of the cluster of the meta node of src
+func (s *Service) clusterDataNodes(ctx context.Context, src chronograf.Source) ([]enterprise.DataNode, error) {
+	auth := influx.DefaultAuthorization(&src)
+	tls := strings.Contains(src.MetaURL, "https")
+	transport, err := influx.Transport(&src)
+	if err != nil {
+		return nil, err
+	}
+
+	var c *enterprise.Client
+	if transport != nil {
+		c, err = enterprise.NewClientWithTransport(s.Logger, src.MetaURL, auth, tls, transport)
+	} else {
+		c, err = enterprise.NewClientWithURL(src.MetaURL, auth, tls, src.InsecureSkipVerify, s.Logger)
+	}
+	if err != nil {
+		return nil, err
+	}
+	cluster, err := c.ShowCluster(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return cluster.DataNodes, nil
+}
+
+// syncClusterSources creates a source for each data node of the cluster of
+// the meta node of tmpl, connecting with the credentials of tmpl, and
+// updates the sources discovered before. Sources keep their name once
+// discovered. Discovered sources of data nodes that left the cluster are
+// removed.
+func (s *Service) syncClusterSources(ctx context.Context, tmpl chronograf.Source) ([]sourceDiscoveryResult, error) {
+	nodes, err := s.clusterDataNodes(ctx, tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("unable to discover the data nodes of %s: %v", tmpl.MetaURL, err)
+	}
+
+	srcs, err := s.Store.Sources(ctx).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	discovered := map[string]chronograf.Source{}
+	for _, src := range srcs {
+		if src.Discovered && src.MetaURL == tmpl.MetaURL && src.Organization == tmpl.Organization {
+			discovered[src.URL] = src
+		}
+	}
+
+	results := []sourceDiscoveryResult{}
+	for _, node := range nodes {
+		src := tmpl
+		src.ID = 0
+		src.URL = dataNodeURL(node)
+		src.Name = discoveredName(tmpl.Name, node.HTTPAddr)
+		src.Type = chronograf.InfluxEnterprise
+		src.Discovered = true
+		src.Default = false
+
+		res := sourceDiscoveryResult{Action: reconcileCreate, URL: src.URL}
+		if cur, ok := discovered[src.URL]; ok {
+			delete(discovered, src.URL)
+			src.ID = cur.ID
+			src.Name = cur.Name
+			src.Default = cur.Default
+			res.Action = reconcileUnchanged
+			if !reflect.DeepEqual(cur, src) {
+				res.Action = reconcileUpdate
+				if err := s.Store.Sources(ctx).Update(ctx, src); err != nil {
+					return results, err
+				}
+				s.publishEvent(ctx, EventSourceUpdated, newSourceEventData(src))
+			}
+		} else {
+			if src, err = s.Store.Sources(ctx).Add(ctx, src); err != nil {
+				return results, err
+			}
+			s.publishEvent(ctx, EventSourceCreated, newSourceEventData(src))
+		}
+		res.ID, res.Name = src.ID, src.Name
+		results = append(results, res)
+	}
+
+	// A cluster listing no data node is more likely unavailable than empty,
+	// so its sources are kept
+	if len(nodes) == 0 {
+		return results, nil
+	}
+	removed := make([]chronograf.Source, 0, len(discovered))
+	for _, src := range discovered {
+		removed = append(removed, src)
+	}
+	sort.Slice(removed, func(i, j int) bool { return removed[i].ID < removed[j].ID })
+	for _, src := range removed {
+		if err := s.Store.Sources(ctx).Delete(ctx, src); err != nil {
+			return results, err
+		}
+		if err := s.Store.SourceHealth(ctx).Delete(ctx, src.ID); err != nil {
+			return results, err
+		}
+		if err := s.Store.AlertEvents(ctx).Delete(ctx, src.ID); err != nil {
+			return results, err
+		}
+		if err := s.removeAlertWebhooks(ctx, src.ID); err != nil {
+			return results, err
+		}
+		if err := s.removeAlertChecks(ctx, src.ID); err != nil {
+			return results, err
+		}
+		if err := s.removeAlertPolicies(ctx, src.ID); err != nil {
+			return results, err
+		}
+		s.publishEvent(ctx, EventSourceDeleted, newSourceEventData(src))
+		results = append(results, sourceDiscoveryResult{
+			Action: discoveryRemove,
+			ID:     src.ID,
+			Name:   src.Name,
+			URL:    src.URL,
+		})
+	}
+	return results, nil
+}
+
+// DiscoverSources creates a source for each data node of an InfluxDB
+// Enterprise cluster given the URL of one of its meta nodes. The name of the
+// request prefixes the names of the sources, which connect with the
+// credentials of the request. Discovering a cluster again updates its
+// sources, as does RunSourceDiscovery on a schedule.
+func (s *Service) DiscoverSources(w http.ResponseWriter, r *http.Request) {
+	var req chronograf.Source
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	if req.Name == "" || req.MetaURL == "" {
+		invalidData(w, fmt.Errorf("name and metaUrl required"), s.Logger)
+		return
+	}
+	if _, err := influx.TLSConfig(&req); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	req.Organization, _ = hasOrganizationContext(ctx)
+	results, err := s.syncClusterSources(ctx, req)
+	if err != nil {
+		Error(w, http.StatusBadGateway, err.Error(), s.Logger)
+		return
+	}
+	encodeJSON(w, http.StatusOK, sourceDiscoveryResponse{MetaURL: req.MetaURL, Sources: results}, s.Logger)
+}
+
+// RunSourceDiscovery keeps the discovered sources of every organization in
+// sync with their clusters every interval until ctx is done
+func (s *Service) RunSourceDiscovery(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.runSourceDiscovery(ctx)
+		}
+	}
+}
+
+// runSourceDiscovery syncs each cluster of discovered sources using the
+// credentials of its first source
+func (s *Service) runSourceDiscovery(ctx context.Context) {
+	serverCtx := serverContext(ctx)
+	srcs, err := s.Store.Sources(serverCtx).All(serverCtx)
+	if err != nil {
+		s.Logger.Error("Unable to list sources for discovery: ", err)
+		return
+	}
+
+	type clusterKey struct {
+		organization, metaURL string
+	}
+	seen := map[clusterKey]bool{}
+	for _, src := range srcs {
+		key := clusterKey{src.Organization, src.MetaURL}
+		if !src.Discovered || seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		tmpl := src
+		tmpl.Name = clusterName(src)
+		if _, err := s.syncClusterSources(serverCtx, tmpl); err != nil {
+			s.Logger.Error("Unable to sync the sources of ", src.MetaURL, ": ", err)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+	"github.com/influxdata/influxdb/chronograf/organizations"
+)
+
+func TestService_DiscoverSources(t *testing.T) {
+	var mu sync.Mutex
+	cluster := `{"data":[
+{"id":1,"httpAddr":"data-1:8086","httpScheme":"https"},
+{"id":2,"httpAddr":"data-2:8086"}],"meta":[{"id":3,"addr":"meta-1:8091"}]}`
+	meta := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/show-cluster" {
+			t.Error("Unexpected request to", r.URL.Path)
+		}
+		if user, pass, ok := r.BasicAuth(); !ok || user != "admin" || pass != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error":"unauthorized"}`))
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		w.Write([]byte(cluster))
+	}))
+	defer meta.Close()
+
+	srcs := map[int]chronograf.Source{
+		1: {ID: 1, Name: "manual", URL: "http://data-1:8086", MetaURL: meta.URL, Organization: "default"},
+	}
+	nextID := 2
+	var deletedHealth, deletedEvents, deletedWebhooks, deletedChecks, deletedPolicies []int
+	s := &Service{
+		Store: &mocks.Store{
+			SourcesStore: &mocks.SourcesStore{
+				AllF: func(ctx context.Context) ([]chronograf.Source, error) {
+					all := []chronograf.Source{}
+					for _, src := range srcs {
+						all = append(all, src)
+					}
+					sort.Slice(all, func(i, j int) bool { return all[i].ID < all[j].ID })
+					return all, nil
+				},
+				AddF: func(ctx context.Context, src chronograf.Source) (chronograf.Source, error) {
+					src.ID = nextID
+					nextID++
+					srcs[src.ID] = src
+					return src, nil
+				},
+				UpdateF: func(ctx context.Context, src chronograf.Source) error {
+					srcs[src.ID] = src
+					return nil
+				},
+				DeleteF: func(ctx context.Context, src chronograf.Source) error {
+					delete(srcs, src.ID)
+					return nil
+				},
+			},
+			SourceHealthStore: &mocks.SourceHealthStore{
+				DeleteF: func(ctx context.Context, id int) error {
+					deletedHealth = append(deletedHealth, id)
+					return nil
+				},
+			},
+			AlertEventsStore: &mocks.AlertEventsStore{
+				DeleteF: func(ctx context.Context, id int) error {
+					deletedEvents = append(deletedEvents, id)
+					return nil
+				},
+			},
+			AlertWebhooksStore: &mocks.AlertWebhooksStore{
+				AllF: func(ctx context.Context, id int) ([]chronograf.AlertWebhook, error) {
+					return []chronograf.AlertWebhook{{ID: 1, SourceID: id}}, nil
+				},
+				DeleteF: func(ctx context.Context, h *chronograf.AlertWebhook) error {
+					deletedWebhooks = append(deletedWebhooks, h.SourceID)
+					return nil
+				},
+			},
+			AlertChecksStore: &mocks.AlertChecksStore{
+				AllF: func(ctx context.Context, id int) ([]chronograf.AlertCheck, error) {
+					return []chronograf.AlertCheck{{ID: 1, SourceID: id}}, nil
+				},
+				DeleteF: func(ctx context.Context, c *chronograf.AlertCheck) error {
+					deletedChecks = append(deletedChecks, c.SourceID)
+					return nil
+				},
+			},
+			AlertPoliciesStore: &mocks.AlertPoliciesStore{
+				AllF: func(ctx context.Context, id int) ([]chronograf.AlertPolicy, error) {
+					return []chronograf.AlertPolicy{{ID: 1, SourceID: id}}, nil
+				},
+				DeleteF: func(ctx context.Context, p *chronograf.AlertPolicy) error {
+					deletedPolicies = append(deletedPolicies, p.SourceID)
+					return nil
+				},
+			},
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+
+	discover := func(body string) (int, string) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "http://any.url/chronograf/v1/discovery/sources", bytes.NewBufferString(body))
+		r = r.WithContext(context.WithValue(r.Context(), organizations.ContextKey, "default"))
+		s.DiscoverSources(w, r)
+		resp := w.Result()
+		content, _ := ioutil.ReadAll(resp.Body)
+		return resp.StatusCode, string(content)
+	}
+
+	if status, body := discover(`{"name":"prod","metaUrl":"` + meta.URL + `","username":"admin","password":"wrong"}`); status != http.StatusBadGateway {
+		t.Errorf("DiscoverSources() with wrong credentials = %v: %s", status, body)
+	}
+	if status, body := discover(`{"metaUrl":"` + meta.URL + `"}`); status != http.StatusUnprocessableEntity {
+		t.Errorf("DiscoverSources() without name = %v: %s", status, body)
+	}
+
+	status, body := discover(`{"name":"prod","metaUrl":"` + meta.URL + `","username":"admin","password":"secret","telegraf":"telegraf"}`)
+	if status != http.StatusOK {
+		t.Fatalf("DiscoverSources() = %v: %s", status, body)
+	}
+	want := `{"metaUrl":"` + meta.URL + `","sources":[
+{"action":"create","id":"2","name":"prod data-1:8086","url":"https://data-1:8086"},
+{"action":"create","id":"3","name":"prod data-2:8086","url":"http://data-2:8086"}]}`
+	if eq, _ := jsonEqual(body, want); !eq {
+		t.Errorf("DiscoverSources() = %s, want %s", body, want)
+	}
+	wantSrc := chronograf.Source{
+		ID:           3,
+		Name:         "prod data-2:8086",
+		Type:         chronograf.InfluxEnterprise,
+		Username:     "admin",
+		Password:     "secret",
+		URL:          "http://data-2:8086",
+		MetaURL:      meta.URL,
+		Telegraf:     "telegraf",
+		Organization: "default",
+		Discovered:   true,
+	}
+	if diff := cmp.Diff(srcs[3], wantSrc); diff != "" {
+		t.Errorf("DiscoverSources() source diff (-got +want):\n%s", diff)
+	}
+
+	// Discovering again changes nothing
+	status, body = discover(`{"name":"prod","metaUrl":"` + meta.URL + `","username":"admin","password":"secret","telegraf":"telegraf"}`)
+	want = `{"metaUrl":"` + meta.URL + `","sources":[
+{"action":"unchanged","id":"2","name":"prod data-1:8086","url":"https://data-1:8086"},
+{"action":"unchanged","id":"3","name":"prod data-2:8086","url":"http://data-2:8086"}]}`
+	if eq, _ := jsonEqual(body, want); status != http.StatusOK || !eq {
+		t.Errorf("DiscoverSources() again = %v %s, want %s", status, body, want)
+	}
+
+	// The scheduled sync follows the cluster losing and gaining data nodes
+	mu.Lock()
+	cluster = `{"data":[
+{"id":1,"httpAddr":"data-1:8086","httpScheme":"https"},
+{"id":4,"httpAddr":"data-4:8086"}]}`
+	mu.Unlock()
+	s.runSourceDiscovery(context.Background())
+
+	names := []string{}
+	for _, src := range srcs {
+		names = append(names, src.Name)
+	}
+	sort.Strings(names)
+	if diff := cmp.Diff(names, []string{"manual", "prod data-1:8086", "prod data-4:8086"}); diff != "" {
+		t.Errorf("runSourceDiscovery() sources diff (-got +want):\n%s", diff)
+	}
+	if diff := cmp.Diff(deletedHealth, []int{3}); diff != "" {
+		t.Errorf("runSourceDiscovery() health history removed diff (-got +want):\n%s", diff)
+	}
+	if diff := cmp.Diff(deletedEvents, []int{3}); diff != "" {
+		t.Errorf("runSourceDiscovery() alert events removed diff (-got +want):\n%s", diff)
+	}
+	if diff := cmp.Diff(deletedWebhooks, []int{3}); diff != "" {
+		t.Errorf("runSourceDiscovery() alert webhooks removed diff (-got +want):\n%s", diff)
+	}
+	if diff := cmp.Diff(deletedChecks, []int{3}); diff != "" {
+		t.Errorf("runSourceDiscovery() alert checks removed diff (-got +want):\n%s", diff)
+	}
+	if diff := cmp.Diff(deletedPolicies, []int{3}); diff != "" {
+		t.Errorf("runSourceDiscovery() alert policies removed diff (-got +want):\n%s", diff)
+	}
+	if src := srcs[4]; src.Username != "admin" || src.Organization != "default" || !src.Discovered {
+		t.Errorf("runSourceDiscovery() created %#v", src)
+	}
+}
@@ -0,0 +1,131 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// resourceETag is a strong entity tag of a stored resource derived from its
+// content, so that any change to the resource changes its tag. Fields hidden
+// from JSON, such as password hashes, do not contribute to the tag.
+func resourceETag(v interface{}) (string, error) {
+	octets, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(octets)
+	return fmt.Sprintf(`"%x"`, sum[:16]), nil
+}
+
+// setETag sets the ETag header of the response to the tag of v
+func setETag(w http.ResponseWriter, v interface{}) {
+	if etag, err := resourceETag(v); err == nil {
+		w.Header().Set("ETag", etag)
+	}
+}
+
+// ifMatch is true if the If-Match header of the request is absent or lists
+// the current tag of v. Clients send the ETag of the resource they read so
+// that concurrent modifications are not silently overwritten.
+func ifMatch(r *http.Request, v interface{}) (bool, error) {
+	header := r.Header.Get("If-Match")
+	if header == "" {
+		return true, nil
+	}
+	etag, err := resourceETag(v)
+	if err != nil {
+		return false, err
+	}
+	for _, tag := range strings.Split(header, ",") {
+		// If-Match uses the strong comparison, so weak tags never match
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || tag == etag {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// checkIfMatch writes http.StatusPreconditionFailed and returns false if the
+// If-Match header of the request does not match the current version of v
+func checkIfMatch(w http.ResponseWriter, r *http.Request, v interface{}, logger chronograf.Logger) bool {
+	ok, err := ifMatch(r, v)
+	if err != nil {
+		unknownErrorWithMessage(w, err, logger)
+		return false
+	}
+	if !ok {
+		Error(w, http.StatusPreconditionFailed, "resource was modified since it was read", logger)
+		return false
+	}
+	return true
+}
+
+// resourceLocks are the locks of the resources being written by the API. A
+// lock is dropped once no request holds or waits for it.
+var resourceLocks = struct {
+	sync.Mutex
+	locks map[string]*resourceLock
+}{locks: map[string]*resourceLock{}}
+
+type resourceLock struct {
+	sync.Mutex
+	refs int // refs is the number of requests holding or waiting for the lock
+}
+
+// lockResource blocks until no other request of this server writes the
+// resource of kind with id, and returns the function releasing it. Handlers
+// hold the lock from the read of a resource to its write, so that the
+// resource cannot change between its comparison with If-Match and the write.
+func lockResource(kind string, id interface{}) (unlock func()) {
+	key := fmt.Sprintf("%s/%v", kind, id)
+	resourceLocks.Lock()
+	l, ok := resourceLocks.locks[key]
+	if !ok {
+		l = &resourceLock{}
+		resourceLocks.locks[key] = l
+	}
+	l.refs++
+	resourceLocks.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+		resourceLocks.Lock()
+		if l.refs--; l.refs == 0 {
+			delete(resourceLocks.locks, key)
+		}
+		resourceLocks.Unlock()
+	}
+}
+
+// setUserETag sets the ETag header to the tag of the stored user after a
+// write. The user is read back as the store may normalize it.
+func (s *Service) setUserETag(ctx context.Context, w http.ResponseWriter, id uint64) {
+	if u, err := s.Store.Users(ctx).Get(ctx, chronograf.UserQuery{ID: &id}); err == nil {
+		setETag(w, u)
+	}
+}
+
+// setDashboardETag sets the ETag header to the tag of the stored dashboard
+// after a write. The dashboard is read back as the store may normalize it.
+func (s *Service) setDashboardETag(ctx context.Context, w http.ResponseWriter, id chronograf.DashboardID) {
+	if d, err := s.Store.Dashboards(ctx).Get(ctx, id); err == nil {
+		setETag(w, d)
+	}
+}
+
+// setSourceETag sets the ETag header to the tag of the stored source after a
+// write. The secrets of the source are left out of the tag.
+func (s *Service) setSourceETag(ctx context.Context, w http.ResponseWriter, id int) {
+	if src, err := s.Store.Sources(ctx).Get(ctx, id); err == nil {
+		setETag(w, newSourceEventData(src))
+	}
+}
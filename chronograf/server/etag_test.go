@@ -0,0 +1,328 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bouk/httprouter"
+	jhttprouter "github.com/influxdata/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+	"github.com/influxdata/influxdb/chronograf/organizations"
+)
+
+func Test_ifMatch(t *testing.T) {
+	d := chronograf.Dashboard{ID: 1, Name: "cpu"}
+	etag, err := resourceETag(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		ifMatch string
+		want    bool
+	}{
+		{
+			name: "No precondition",
+			want: true,
+		},
+		{
+			name:    "Current tag",
+			ifMatch: etag,
+			want:    true,
+		},
+		{
+			name:    "Any tag",
+			ifMatch: "*",
+			want:    true,
+		},
+		{
+			name:    "One of several tags",
+			ifMatch: `"stale", ` + etag,
+			want:    true,
+		},
+		{
+			name:    "Stale tag",
+			ifMatch: `"stale"`,
+			want:    false,
+		},
+		{
+			name:    "Weak tags never match",
+			ifMatch: "W/" + etag,
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("PATCH", "http://any.url", nil)
+			if tt.ifMatch != "" {
+				r.Header.Set("If-Match", tt.ifMatch)
+			}
+			got, err := ifMatch(r, d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("%q. ifMatch() = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_UpdateDashboard_IfMatch(t *testing.T) {
+	stored := chronograf.Dashboard{ID: 1, Name: "cpu", Organization: "0"}
+	etag, err := resourceETag(stored)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name       string
+		ifMatch    string
+		wantStatus int
+		wantName   string
+	}{
+		{
+			name:       "Current version",
+			ifMatch:    etag,
+			wantStatus: http.StatusOK,
+			wantName:   "memory",
+		},
+		{
+			name:       "Modified since read",
+			ifMatch:    `"stale"`,
+			wantStatus: http.StatusPreconditionFailed,
+			wantName:   "cpu",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current := stored
+			s := &Service{
+				Store: &mocks.Store{
+					DashboardsStore: &mocks.DashboardsStore{
+						GetF: func(ctx context.Context, id chronograf.DashboardID) (chronograf.Dashboard, error) {
+							return current, nil
+						},
+						UpdateF: func(ctx context.Context, d chronograf.Dashboard) error {
+							current = d
+							return nil
+						},
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("PATCH", "http://any.url/chronograf/v1/dashboards/1", bytes.NewBufferString(`{"name":"memory"}`))
+			r = r.WithContext(context.WithValue(r.Context(), jhttprouter.ParamsKey, jhttprouter.Params{{Key: "id", Value: "1"}}))
+			r.Header.Set("If-Match", tt.ifMatch)
+
+			s.UpdateDashboard(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("%q. UpdateDashboard() = %v, want %v: %s", tt.name, resp.StatusCode, tt.wantStatus, body)
+			}
+			if current.Name != tt.wantName {
+				t.Errorf("%q. UpdateDashboard() stored name %s, want %s", tt.name, current.Name, tt.wantName)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			want, _ := resourceETag(current)
+			if got := resp.Header.Get("ETag"); got != want {
+				t.Errorf("%q. UpdateDashboard() ETag = %s, want %s", tt.name, got, want)
+			}
+		})
+	}
+}
+
+func TestService_UpdateUser_IfMatch(t *testing.T) {
+	stored := &chronograf.User{ID: 1, Name: "bob", Provider: "github", Scheme: "oauth2", Roles: []chronograf.Role{}}
+	s := &Service{
+		Store: &mocks.Store{
+			UsersStore: &mocks.UsersStore{
+				GetF: func(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+					u := *stored
+					return &u, nil
+				},
+				UpdateF: func(ctx context.Context, u *chronograf.User) error {
+					t.Fatalf("Update() called despite a stale If-Match")
+					return nil
+				},
+			},
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "http://any.url/chronograf/v1/users/1", nil)
+	r = r.WithContext(httprouter.WithParams(r.Context(), httprouter.Params{{Key: "id", Value: "1"}}))
+	s.UserID(w, r)
+	etag := w.Result().Header.Get("ETag")
+	if etag == "" {
+		t.Fatalf("UserID() did not set an ETag")
+	}
+
+	// Another admin modifies the user after it was read
+	stored.Roles = []chronograf.Role{{Name: "viewer", Organization: "1"}}
+
+	w = httptest.NewRecorder()
+	r = httptest.NewRequest("PATCH", "http://any.url/chronograf/v1/users/1", bytes.NewBufferString(`{"roles":[]}`))
+	r = r.WithContext(httprouter.WithParams(r.Context(), httprouter.Params{{Key: "id", Value: "1"}}))
+	r.Header.Set("If-Match", etag)
+	s.UpdateUser(w, r)
+
+	resp := w.Result()
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusPreconditionFailed {
+		t.Errorf("UpdateUser() = %v, want %v", resp.StatusCode, http.StatusPreconditionFailed)
+	}
+	want := `{"code":412,"message":"resource was modified since it was read","type":"urn:chronograf:problem:precondition-failed","title":"Precondition Failed","status":412,"detail":"resource was modified since it was read"}`
+	if eq, _ := jsonEqual(string(body), want); !eq {
+		t.Errorf("UpdateUser() = \n***%v***\n,\nwant\n***%v***", string(body), want)
+	}
+}
+
+func TestService_UpdateDashboard_ConcurrentIfMatch(t *testing.T) {
+	var mu sync.Mutex
+	current := chronograf.Dashboard{ID: 1, Name: "cpu", Organization: "0"}
+	etag, err := resourceETag(current)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Service{
+		Store: &mocks.Store{
+			DashboardsStore: &mocks.DashboardsStore{
+				GetF: func(ctx context.Context, id chronograf.DashboardID) (chronograf.Dashboard, error) {
+					mu.Lock()
+					defer mu.Unlock()
+					return current, nil
+				},
+				UpdateF: func(ctx context.Context, d chronograf.Dashboard) error {
+					// Leaves time for the other request to compare its tag
+					time.Sleep(10 * time.Millisecond)
+					mu.Lock()
+					defer mu.Unlock()
+					current = d
+					return nil
+				},
+			},
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+
+	// Both requests read the same version of the dashboard, so only one of
+	// them may modify it
+	statuses := make(chan int, 2)
+	var wg sync.WaitGroup
+	for _, name := range []string{"memory", "disk"} {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("PATCH", "http://any.url/chronograf/v1/dashboards/1", bytes.NewBufferString(`{"name":"`+name+`"}`))
+			r = r.WithContext(context.WithValue(r.Context(), jhttprouter.ParamsKey, jhttprouter.Params{{Key: "id", Value: "1"}}))
+			r.Header.Set("If-Match", etag)
+			s.UpdateDashboard(w, r)
+			statuses <- w.Result().StatusCode
+		}(name)
+	}
+	wg.Wait()
+	close(statuses)
+
+	got := map[int]int{}
+	for status := range statuses {
+		got[status]++
+	}
+	if got[http.StatusOK] != 1 || got[http.StatusPreconditionFailed] != 1 {
+		t.Errorf("UpdateDashboard() statuses = %v, want one %d and one %d", got, http.StatusOK, http.StatusPreconditionFailed)
+	}
+	if len(resourceLocks.locks) != 0 {
+		t.Errorf("lockResource() kept %d locks, want 0", len(resourceLocks.locks))
+	}
+}
+
+func TestService_UpsertSource_IfMatch(t *testing.T) {
+	stored := chronograf.Source{ID: 1, Name: "Influx", URL: "http://influx:8086", Password: "secret", Organization: "1"}
+	tests := []struct {
+		name       string
+		ifMatch    string
+		wantStatus int
+		wantName   string
+	}{
+		{
+			name:       "Current version",
+			wantStatus: http.StatusOK,
+			wantName:   "Influx 2",
+		},
+		{
+			name:       "Modified since read",
+			ifMatch:    `"stale"`,
+			wantStatus: http.StatusPreconditionFailed,
+			wantName:   "Influx",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current := stored
+			s := &Service{
+				Store: &mocks.Store{
+					SourcesStore: &mocks.SourcesStore{
+						AllF: func(ctx context.Context) ([]chronograf.Source, error) {
+							return []chronograf.Source{current}, nil
+						},
+						GetF: func(ctx context.Context, id int) (chronograf.Source, error) {
+							return current, nil
+						},
+						UpdateF: func(ctx context.Context, src chronograf.Source) error {
+							current = src
+							return nil
+						},
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("GET", "http://any.url/chronograf/v1/sources/1", nil)
+			r = r.WithContext(context.WithValue(r.Context(), jhttprouter.ParamsKey, jhttprouter.Params{{Key: "id", Value: "1"}}))
+			s.SourceID(w, r)
+			etag := w.Result().Header.Get("ETag")
+			if etag == "" {
+				t.Fatalf("%q. SourceID() did not set an ETag", tt.name)
+			}
+			if tt.ifMatch == "" {
+				tt.ifMatch = etag
+			}
+
+			w = httptest.NewRecorder()
+			r = httptest.NewRequest("PUT", "http://any.url/chronograf/v1/sources?url=http%3A%2F%2Finflux%3A8086", bytes.NewBufferString(`{"name":"Influx 2"}`))
+			r = r.WithContext(context.WithValue(r.Context(), organizations.ContextKey, "1"))
+			r.Header.Set("If-Match", tt.ifMatch)
+			s.UpsertSource(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("%q. UpsertSource() = %v, want %v: %s", tt.name, resp.StatusCode, tt.wantStatus, body)
+			}
+			if current.Name != tt.wantName {
+				t.Errorf("%q. UpsertSource() stored name %s, want %s", tt.name, current.Name, tt.wantName)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			want, _ := resourceETag(newSourceEventData(current))
+			if got := resp.Header.Get("ETag"); got != want {
+				t.Errorf("%q. UpsertSource() ETag = %s, want %s", tt.name, got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,345 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// bundleVersion is the version of the bundles written by Export. Import
+// rejects bundles of later versions.
+const bundleVersion = 1
+
+// bundle is a portable JSON document of the configuration of a Chronograf
+// instance. IDs within a bundle only tie its resources together; Import
+// remaps them to the IDs of the instance importing the bundle.
+type bundle struct {
+	Version             int                       `json:"version"`
+	DefaultOrganization string                    `json:"defaultOrganization"` // DefaultOrganization is the ID of the default organization of the exporting instance
+	Organizations       []chronograf.Organization `json:"organizations"`
+	Users               []bundleUser              `json:"users"`
+	Sources             []chronograf.Source       `json:"sources"`
+	Kapacitors          []chronograf.Server       `json:"kapacitors"`
+	Dashboards          []chronograf.Dashboard    `json:"dashboards"`
+	Mappings            []chronograf.Mapping      `json:"mappings"`
+}
+
+// bundleUser carries the credentials of users of the basic scheme, which are
+// never part of the JSON of a chronograf.User
+type bundleUser struct {
+	chronograf.User
+	PasswordHash       string   `json:"passwordHash,omitempty"`
+	TOTPSecret         string   `json:"totpSecret,omitempty"`
+	TOTPEnabled        bool     `json:"totpEnabled,omitempty"`
+	RecoveryCodeHashes []string `json:"recoveryCodeHashes,omitempty"`
+}
+
+func newBundleUser(u chronograf.User) bundleUser {
+	return bundleUser{
+		User:               u,
+		PasswordHash:       u.PasswordHash,
+		TOTPSecret:         u.TOTPSecret,
+		TOTPEnabled:        u.TOTPEnabled,
+		RecoveryCodeHashes: u.RecoveryCodeHashes,
+	}
+}
+
+func (u *bundleUser) user() chronograf.User {
+	user := u.User
+	user.PasswordHash = u.PasswordHash
+	user.TOTPSecret = u.TOTPSecret
+	user.TOTPEnabled = u.TOTPEnabled
+	user.RecoveryCodeHashes = u.RecoveryCodeHashes
+	return user
+}
+
+// Export writes the organizations, users, sources, kapacitors, dashboards and
+// mappings of all organizations as a single bundle. The bundle contains the
+// credentials of sources, kapacitors and users in CLEARTEXT.
+func (s *Service) Export(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	def, err := s.Store.Organizations(ctx).DefaultOrganization(ctx)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	b := bundle{
+		Version:             bundleVersion,
+		DefaultOrganization: def.ID,
+		Organizations:       []chronograf.Organization{},
+		Users:               []bundleUser{},
+		Sources:             []chronograf.Source{},
+		Kapacitors:          []chronograf.Server{},
+		Dashboards:          []chronograf.Dashboard{},
+		Mappings:            []chronograf.Mapping{},
+	}
+
+	orgs, err := s.Store.Organizations(ctx).All(ctx)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	b.Organizations = append(b.Organizations, orgs...)
+
+	users, err := s.Store.Users(ctx).All(ctx)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	for _, u := range users {
+		b.Users = append(b.Users, newBundleUser(u))
+	}
+
+	srcs, err := s.Store.Sources(ctx).All(ctx)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	b.Sources = append(b.Sources, srcs...)
+
+	srvs, err := s.Store.Servers(ctx).All(ctx)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	b.Kapacitors = append(b.Kapacitors, srvs...)
+
+	dashes, err := s.Store.Dashboards(ctx).All(ctx)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	for _, d := range dashes {
+		b.Dashboards = append(b.Dashboards, DashboardDefaults(d))
+	}
+
+	mappings, err := s.Store.Mappings(ctx).All(ctx)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	b.Mappings = append(b.Mappings, mappings...)
+
+	w.Header().Set("Content-Disposition", `attachment; filename="chronograf-export.json"`)
+	encodeJSON(w, http.StatusOK, b, s.Logger)
+}
+
+// Import adds the resources of a bundle written by Export to the stores and
+// reports what was created or updated like Reconcile. Resources that exist
+// already are matched as Reconcile matches them; users are matched by name,
+// provider and scheme and mappings by their organization and groups. The
+// default organization of the bundle is imported into the default
+// organization of this instance. When the query parameter dryRun=true is
+// given, the report is produced without any writes.
+func (s *Service) Import(w http.ResponseWriter, r *http.Request) {
+	var b bundle
+	if err := json.NewDecoder(r.Body).Decode(&b); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	if b.Version < 1 || b.Version > bundleVersion {
+		Error(w, http.StatusUnprocessableEntity, fmt.Sprintf("unsupported bundle version %d; version %d or lower is required", b.Version, bundleVersion), s.Logger)
+		return
+	}
+
+	dryRun, err := dryRunParam(r)
+	if err != nil {
+		Error(w, http.StatusUnprocessableEntity, err.Error(), s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	rc := &reconciler{
+		store:   s.Store,
+		dryRun:  dryRun,
+		publish: s.publishEvent,
+		orgIDs:  map[string]string{},
+		srcIDs:  map[int]int{},
+	}
+
+	def, err := s.Store.Organizations(ctx).DefaultOrganization(ctx)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	res := &reconcileResponse{
+		Links: selfLinks{
+			Self: "/chronograf/v1/import",
+		},
+		DryRun:  dryRun,
+		Results: []reconcileResult{},
+	}
+	for _, o := range b.Organizations {
+		if b.DefaultOrganization != "" && o.ID == b.DefaultOrganization {
+			o.Name = def.Name
+		}
+		res.add(rc.organization(ctx, o))
+	}
+	for _, src := range b.Sources {
+		res.add(rc.source(ctx, src))
+	}
+	for _, srv := range b.Kapacitors {
+		res.add(rc.kapacitor(ctx, srv))
+	}
+	for _, d := range b.Dashboards {
+		res.add(rc.dashboard(ctx, d))
+	}
+	for _, u := range b.Users {
+		res.add(rc.user(ctx, u.user()))
+	}
+	for _, m := range b.Mappings {
+		res.add(rc.mapping(ctx, m))
+	}
+
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+func (rc *reconciler) user(ctx context.Context, u chronograf.User) reconcileResult {
+	res := reconcileResult{Kind: "user", Name: u.Name}
+	if u.Name == "" || u.Provider == "" || u.Scheme == "" {
+		return failedResult(res, fmt.Errorf("name, provider and scheme required"))
+	}
+
+	for i, role := range u.Roles {
+		org, err := rc.organizationID(ctx, role.Organization)
+		if err != nil {
+			return failedResult(res, err)
+		}
+		u.Roles[i].Organization = org
+	}
+
+	users := rc.store.Users(ctx)
+	cur, err := users.Get(ctx, chronograf.UserQuery{Name: &u.Name, Provider: &u.Provider, Scheme: &u.Scheme})
+	if err != nil && err != chronograf.ErrUserNotFound {
+		return failedResult(res, err)
+	}
+
+	if cur == nil {
+		res.Action = reconcileCreate
+		if !rc.dryRun {
+			u.ID = 0
+			created, err := users.Add(ctx, &u)
+			if err != nil {
+				return failedResult(res, err)
+			}
+			res.ID = strconv.FormatUint(created.ID, 10)
+		}
+		return res
+	}
+
+	// Roles of the bundle replace those of the user within the same
+	// organization; the credentials of the user are kept.
+	res.ID = strconv.FormatUint(cur.ID, 10)
+	next := *cur
+	next.Roles = append([]chronograf.Role{}, cur.Roles...)
+RolesLoop:
+	for _, role := range u.Roles {
+		for i := range next.Roles {
+			if next.Roles[i].Organization == role.Organization {
+				next.Roles[i].Name = role.Name
+				continue RolesLoop
+			}
+		}
+		next.Roles = append(next.Roles, role)
+	}
+	next.SuperAdmin = cur.SuperAdmin || u.SuperAdmin
+	if reflect.DeepEqual(*cur, next) {
+		res.Action = reconcileUnchanged
+		return res
+	}
+
+	res.Action = reconcileUpdate
+	if !rc.dryRun {
+		if err := users.Update(ctx, &next); err != nil {
+			return failedResult(res, err)
+		}
+	}
+	return res
+}
+
+func (rc *reconciler) mapping(ctx context.Context, m chronograf.Mapping) reconcileResult {
+	res := reconcileResult{Kind: "mapping", Name: m.ProviderOrganization}
+
+	req := mappingsRequest(m)
+	if err := req.Valid(); err != nil {
+		return failedResult(res, err)
+	}
+
+	org, err := rc.organizationID(ctx, m.Organization)
+	if err != nil {
+		return failedResult(res, err)
+	}
+	m.Organization = org
+
+	mappings, err := rc.store.Mappings(ctx).All(ctx)
+	if err != nil {
+		return failedResult(res, err)
+	}
+
+	for _, cur := range mappings {
+		if cur.Organization != m.Organization || cur.Provider != m.Provider ||
+			cur.Scheme != m.Scheme || cur.ProviderOrganization != m.ProviderOrganization {
+			continue
+		}
+		res.ID = cur.ID
+		if cur.Role == m.Role {
+			res.Action = reconcileUnchanged
+			return res
+		}
+		res.Action = reconcileUpdate
+		if !rc.dryRun {
+			cur.Role = m.Role
+			if err := rc.store.Mappings(ctx).Update(ctx, &cur); err != nil {
+				return failedResult(res, err)
+			}
+		}
+		return res
+	}
+
+	res.Action = reconcileCreate
+	if !rc.dryRun {
+		m.ID = ""
+		created, err := rc.store.Mappings(ctx).Add(ctx, &m)
+		if err != nil {
+			return failedResult(res, err)
+		}
+		res.ID = created.ID
+	}
+	return res
+}
+
+// sourceLink maps the link of a source in the bundle, e.g. the source of a
+// dashboard query, to the link of the source within the store
+func (rc *reconciler) sourceLink(link string) string {
+	id, ok := sourceLinkID(link)
+	if !ok {
+		return link
+	}
+	if mapped, ok := rc.srcIDs[id]; ok {
+		return sourceLinkPrefix + strconv.Itoa(mapped)
+	}
+	return link
+}
+
+const sourceLinkPrefix = "/chronograf/v1/sources/"
+
+// sourceLinkID returns the ID of the source of a link such as the source of a
+// dashboard query. Links not pointing at a Chronograf source are not ok.
+func sourceLinkID(link string) (int, bool) {
+	if !strings.HasPrefix(link, sourceLinkPrefix) {
+		return 0, false
+	}
+	id, err := strconv.Atoi(strings.TrimPrefix(link, sourceLinkPrefix))
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
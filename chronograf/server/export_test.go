@@ -0,0 +1,224 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+func TestService_Export(t *testing.T) {
+	s := &Service{
+		Store: &mocks.Store{
+			OrganizationsStore: &mocks.OrganizationsStore{
+				DefaultOrganizationF: func(ctx context.Context) (*chronograf.Organization, error) {
+					return &chronograf.Organization{ID: "default", Name: "Default"}, nil
+				},
+				AllF: func(ctx context.Context) ([]chronograf.Organization, error) {
+					return []chronograf.Organization{{ID: "default", Name: "Default"}, {ID: "1", Name: "Weather"}}, nil
+				},
+			},
+			UsersStore: &mocks.UsersStore{
+				AllF: func(ctx context.Context) ([]chronograf.User, error) {
+					return []chronograf.User{{ID: 1, Name: "marty", Provider: "basic", Scheme: "basic", PasswordHash: "hash"}}, nil
+				},
+			},
+			SourcesStore: &mocks.SourcesStore{
+				AllF: func(ctx context.Context) ([]chronograf.Source, error) {
+					return []chronograf.Source{{ID: 2, Name: "influx", Password: "secret", Organization: "1"}}, nil
+				},
+			},
+			ServersStore: &mocks.ServersStore{
+				AllF: func(ctx context.Context) ([]chronograf.Server, error) {
+					return nil, nil
+				},
+			},
+			DashboardsStore: &mocks.DashboardsStore{
+				AllF: func(ctx context.Context) ([]chronograf.Dashboard, error) {
+					return nil, nil
+				},
+			},
+			MappingsStore: &mocks.MappingsStore{
+				AllF: func(ctx context.Context) ([]chronograf.Mapping, error) {
+					return nil, nil
+				},
+			},
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "http://any.url", nil)
+	s.Export(w, r)
+
+	resp := w.Result()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Export() = %v, want %v", resp.StatusCode, http.StatusOK)
+	}
+	var b bundle
+	if err := json.NewDecoder(resp.Body).Decode(&b); err != nil {
+		t.Fatal(err)
+	}
+	if b.Version != bundleVersion || b.DefaultOrganization != "default" {
+		t.Errorf("Export() version = %d, default organization = %s", b.Version, b.DefaultOrganization)
+	}
+	if len(b.Organizations) != 2 || len(b.Sources) != 1 || b.Kapacitors == nil || b.Dashboards == nil || b.Mappings == nil {
+		t.Errorf("Export() = %#v", b)
+	}
+	if b.Sources[0].Password != "secret" {
+		t.Errorf("Export() source password = %q, want secret", b.Sources[0].Password)
+	}
+	if len(b.Users) != 1 || b.Users[0].user().PasswordHash != "hash" {
+		t.Errorf("Export() users = %#v, want the password hash of marty", b.Users)
+	}
+}
+
+func TestService_Import(t *testing.T) {
+	var (
+		dashboard chronograf.Dashboard
+		user      chronograf.User
+		mapping   chronograf.Mapping
+		orgAdded  []string
+	)
+	s := &Service{
+		Store: &mocks.Store{
+			OrganizationsStore: &mocks.OrganizationsStore{
+				DefaultOrganizationF: func(ctx context.Context) (*chronograf.Organization, error) {
+					return &chronograf.Organization{ID: "default", Name: "Main"}, nil
+				},
+				GetF: func(ctx context.Context, q chronograf.OrganizationQuery) (*chronograf.Organization, error) {
+					if *q.Name == "Main" {
+						return &chronograf.Organization{ID: "default", Name: "Main", DefaultRole: "member"}, nil
+					}
+					return nil, chronograf.ErrOrganizationNotFound
+				},
+				AddF: func(ctx context.Context, o *chronograf.Organization) (*chronograf.Organization, error) {
+					orgAdded = append(orgAdded, o.Name)
+					return &chronograf.Organization{ID: "42", Name: o.Name, DefaultRole: o.DefaultRole}, nil
+				},
+			},
+			SourcesStore: &mocks.SourcesStore{
+				AllF: func(ctx context.Context) ([]chronograf.Source, error) {
+					return nil, nil
+				},
+				AddF: func(ctx context.Context, src chronograf.Source) (chronograf.Source, error) {
+					src.ID = 9
+					return src, nil
+				},
+			},
+			ServersStore: &mocks.ServersStore{},
+			DashboardsStore: &mocks.DashboardsStore{
+				AllF: func(ctx context.Context) ([]chronograf.Dashboard, error) {
+					return nil, nil
+				},
+				AddF: func(ctx context.Context, d chronograf.Dashboard) (chronograf.Dashboard, error) {
+					dashboard = d
+					d.ID = 5
+					return d, nil
+				},
+			},
+			UsersStore: &mocks.UsersStore{
+				GetF: func(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+					return nil, chronograf.ErrUserNotFound
+				},
+				AddF: func(ctx context.Context, u *chronograf.User) (*chronograf.User, error) {
+					user = *u
+					u.ID = 8
+					return u, nil
+				},
+			},
+			MappingsStore: &mocks.MappingsStore{
+				AllF: func(ctx context.Context) ([]chronograf.Mapping, error) {
+					return nil, nil
+				},
+				AddF: func(ctx context.Context, m *chronograf.Mapping) (*chronograf.Mapping, error) {
+					mapping = *m
+					m.ID = "3"
+					return m, nil
+				},
+			},
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+
+	body := `{
+		"version": 1,
+		"defaultOrganization": "default",
+		"organizations": [{"id": "default", "name": "Default", "defaultRole": "member"}, {"id": "1", "name": "Weather", "defaultRole": "viewer"}],
+		"sources": [{"id": "2", "name": "influx", "url": "http://localhost:8086", "organization": "1"}],
+		"dashboards": [{"id": 4, "name": "rain", "organization": "1", "cells": [{"i": "c", "x": 0, "y": 0, "w": 4, "h": 4, "queries": [{"query": "SELECT 1", "source": "/chronograf/v1/sources/2"}]}]}],
+		"users": [{"id": "6", "name": "marty", "provider": "basic", "scheme": "basic", "roles": [{"name": "editor", "organization": "1"}], "passwordHash": "hash"}],
+		"mappings": [{"id": "7", "organizationId": "1", "provider": "*", "scheme": "*", "providerOrganization": "*"}]
+	}`
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("POST", "http://any.url", bytes.NewBufferString(body))
+	s.Import(w, r)
+
+	resp := w.Result()
+	got, _ := ioutil.ReadAll(resp.Body)
+	want := `{"links":{"self":"/chronograf/v1/import"},"dryRun":false,"summary":{"created":5,"updated":0,"unchanged":1,"failed":0},"results":[{"kind":"organization","name":"Main","id":"default","action":"unchanged"},{"kind":"organization","name":"Weather","id":"42","action":"create"},{"kind":"source","name":"influx","id":"9","action":"create"},{"kind":"dashboard","name":"rain","id":"5","action":"create"},{"kind":"user","name":"marty","id":"8","action":"create"},{"kind":"mapping","name":"*","id":"3","action":"create"}]}`
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Import() = %v, want %v: %s", resp.StatusCode, http.StatusOK, got)
+	}
+	if eq, _ := jsonEqual(string(got), want); !eq {
+		t.Errorf("Import() = \n***%v***\n,\nwant\n***%v***", string(got), want)
+	}
+
+	if len(orgAdded) != 1 || orgAdded[0] != "Weather" {
+		t.Errorf("Import() added organizations %v, want [Weather]", orgAdded)
+	}
+	if dashboard.Organization != "42" || dashboard.Cells[0].Queries[0].Source != "/chronograf/v1/sources/9" {
+		t.Errorf("Import() dashboard organization = %s, query source = %s", dashboard.Organization, dashboard.Cells[0].Queries[0].Source)
+	}
+	if len(user.Roles) != 1 || user.Roles[0].Organization != "42" || user.PasswordHash != "hash" {
+		t.Errorf("Import() user = %#v", user)
+	}
+	if mapping.Organization != "42" {
+		t.Errorf("Import() mapping organization = %s, want 42", mapping.Organization)
+	}
+}
+
+func TestService_Import_invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+	}{
+		{
+			name:       "invalid JSON",
+			body:       `{`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "missing version",
+			body:       `{}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "later version",
+			body:       `{"version": 2}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{
+				Store:  &mocks.Store{},
+				Logger: &chronograf.NoopLogger{},
+			}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url", bytes.NewBufferString(tt.body))
+			s.Import(w, r)
+
+			if resp := w.Result(); resp.StatusCode != tt.wantStatus {
+				t.Errorf("%q. Import() = %v, want %v", tt.name, resp.StatusCode, tt.wantStatus)
+			}
+		})
+	}
+}
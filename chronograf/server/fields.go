@@ -0,0 +1,104 @@
+package server
+
+import (
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+const fieldsQuery = "fields"
+
+// fieldset is the set of JSON fields of the resources of a listing selected
+// with the fields parameter, such as ?fields=id,name,roles, so that clients
+// on slow links need not transfer the cells and queries of every dashboard.
+// A nil fieldset selects every field.
+type fieldset map[string]bool
+
+// validFieldset parses the fields parameter of query. The fields must be
+// fields of the JSON encoding of resource.
+func validFieldset(query url.Values, resource interface{}) (fieldset, error) {
+	v := query.Get(fieldsQuery)
+	if v == "" {
+		return nil, nil
+	}
+	known := jsonFields(reflect.TypeOf(resource))
+	fs := fieldset{}
+	for _, f := range strings.Split(v, ",") {
+		f = strings.TrimSpace(f)
+		if f == "" {
+			continue
+		}
+		if !known[f] {
+			return nil, errorf("unknown field %s", f)
+		}
+		fs[f] = true
+	}
+	if len(fs) == 0 {
+		return nil, errorf("no fields selected")
+	}
+	return fs, nil
+}
+
+// jsonFields returns the names of the fields of the JSON encoding of the
+// struct type t, including those of its embedded structs
+func jsonFields(t reflect.Type) map[string]bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	fields := map[string]bool{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if f.Anonymous && name == "" && (f.Type.Kind() == reflect.Struct || f.Type.Kind() == reflect.Ptr) {
+			for n := range jsonFields(f.Type) {
+				fields[n] = true
+			}
+			continue
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = true
+	}
+	return fields
+}
+
+// list returns the JSON object of the listing res with the resources of its
+// key restricted to the fields of fs. Other fields of res, such as its
+// links, are kept.
+func (fs fieldset) list(res interface{}, key string) (interface{}, error) {
+	if fs == nil {
+		return res, nil
+	}
+	octets, err := json.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(octets, &obj); err != nil {
+		return nil, err
+	}
+	var resources []map[string]json.RawMessage
+	if err := json.Unmarshal(obj[key], &resources); err != nil {
+		return nil, err
+	}
+	for _, r := range resources {
+		for f := range r {
+			if !fs[f] {
+				delete(r, f)
+			}
+		}
+	}
+	if obj[key], err = json.Marshal(resources); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
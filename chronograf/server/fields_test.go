@@ -0,0 +1,155 @@
+package server
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+func TestValidFieldset(t *testing.T) {
+	tests := []struct {
+		name    string
+		fields  string
+		want    fieldset
+		wantErr bool
+	}{
+		{
+			name: "No fields parameter selects every field",
+		},
+		{
+			name:   "Fields of the resource",
+			fields: "id, name,roles",
+			want:   fieldset{"id": true, "name": true, "roles": true},
+		},
+		{
+			name:    "Unknown field",
+			fields:  "id,password",
+			wantErr: true,
+		},
+		{
+			name:    "No field",
+			fields:  ",",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := validFieldset(url.Values{"fields": {tt.fields}}, userResponse{})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validFieldset() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("validFieldset() = %v, want %v", got, tt.want)
+			}
+			for f := range tt.want {
+				if !got[f] {
+					t.Errorf("validFieldset() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestJSONFields(t *testing.T) {
+	got := jsonFields(reflect.TypeOf(organizationResponse{}))
+	for _, f := range []string{"links", "id", "name", "defaultRole"} {
+		if !got[f] {
+			t.Errorf("jsonFields() = %v, want field %s", got, f)
+		}
+	}
+}
+
+func TestService_Dashboards_Fields(t *testing.T) {
+	s := &Service{
+		Store: &mocks.Store{
+			DashboardsStore: &mocks.DashboardsStore{
+				AllF: func(ctx context.Context) ([]chronograf.Dashboard, error) {
+					return []chronograf.Dashboard{
+						{
+							ID:           1,
+							Name:         "Hosts",
+							Organization: "default",
+							Cells:        []chronograf.DashboardCell{{ID: "a", Name: "CPU"}},
+						},
+					}, nil
+				},
+			},
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "http://any.url/chronograf/v1/dashboards?fields=id,name", nil)
+	s.Dashboards(w, r)
+
+	resp := w.Result()
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Dashboards() = %v, want %v", resp.StatusCode, http.StatusOK)
+	}
+	want := `{"dashboards":[{"id":1,"name":"Hosts"}]}`
+	if eq, _ := jsonEqual(string(body), want); !eq {
+		t.Errorf("Dashboards() = %s, want %s", body, want)
+	}
+
+	w = httptest.NewRecorder()
+	r = httptest.NewRequest("GET", "http://any.url/chronograf/v1/dashboards?fields=queries", nil)
+	s.Dashboards(w, r)
+	if got := w.Result().StatusCode; got != http.StatusUnprocessableEntity {
+		t.Errorf("Dashboards() of an unknown field = %v, want %v", got, http.StatusUnprocessableEntity)
+	}
+}
+
+func TestService_Sources(t *testing.T) {
+	s := &Service{
+		Store: &mocks.Store{
+			SourcesStore: &mocks.SourcesStore{
+				AllF: func(ctx context.Context) ([]chronograf.Source, error) {
+					return []chronograf.Source{
+						{ID: 2, Name: "Two", URL: "http://two:8086", Password: "secret", Organization: "1"},
+						{ID: 1, Name: "One", URL: "http://one:8086", Organization: "1"},
+					}, nil
+				},
+			},
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{
+			name: "Every field of the sources but their secrets",
+			want: `{"links":{"self":"/chronograf/v1/sources"},"sources":[{"id":"1","name":"One","url":"http://one:8086","default":false,"organization":"1"},{"id":"2","name":"Two","url":"http://two:8086","default":false,"organization":"1"}]}`,
+		},
+		{
+			name:  "Sparse fieldset",
+			query: "?fields=id,url",
+			want:  `{"links":{"self":"/chronograf/v1/sources"},"sources":[{"id":"1","url":"http://one:8086"},{"id":"2","url":"http://two:8086"}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("GET", "http://any.url/chronograf/v1/sources"+tt.query, nil)
+			s.Sources(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("Sources() = %v, want %v", resp.StatusCode, http.StatusOK)
+			}
+			if eq, _ := jsonEqual(string(body), tt.want); !eq {
+				t.Errorf("Sources() = %s, want %s", body, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/parser"
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+type fluxRequest struct {
+	Query string `json:"query"` // Query is the Flux script
+}
+
+type fluxASTResponse struct {
+	AST *ast.Package `json:"ast"`
+}
+
+type fluxBucketsResponse struct {
+	Buckets []string `json:"buckets"`
+}
+
+type fluxMeasurementsResponse struct {
+	Bucket       string   `json:"bucket"`
+	Measurements []string `json:"measurements"`
+}
+
+type fluxFieldsResponse struct {
+	Bucket      string   `json:"bucket"`
+	Measurement string   `json:"measurement,omitempty"`
+	Fields      []string `json:"fields"`
+}
+
+// parseFlux parses a Flux script, returning every syntax error of the script
+// as one error
+func parseFlux(script string) (*ast.Package, error) {
+	if strings.TrimSpace(script) == "" {
+		return nil, fmt.Errorf("query field required")
+	}
+	pkg := parser.ParseSource(script)
+	if errs := ast.GetErrors(pkg); len(errs) != 0 {
+		msgs := make([]string, len(errs))
+		for i, err := range errs {
+			msgs[i] = err.Error()
+		}
+		return nil, fmt.Errorf("invalid flux script: %s", strings.Join(msgs, "; "))
+	}
+	return pkg, nil
+}
+
+// fluxString quotes s as a Flux string literal
+func fluxString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, `$`, `\$`).Replace(s) + `"`
+}
+
+// fluxQuerier connects to a source able to run Flux scripts
+func (s *Service) fluxQuerier(ctx context.Context, src chronograf.Source) (chronograf.FluxQuerier, error) {
+	ts, err := s.TimeSeries(src)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to source %d: %v", src.ID, err)
+	}
+	if err := ts.Connect(ctx, &src); err != nil {
+		return nil, fmt.Errorf("unable to connect to source %d: %v", src.ID, err)
+	}
+	flux, ok := ts.(chronograf.FluxQuerier)
+	if !ok {
+		return nil, fmt.Errorf("source %d does not support Flux", src.ID)
+	}
+	return flux, nil
+}
+
+// FluxAST parses a Flux script and returns its abstract syntax tree, or its
+// syntax errors if it is invalid, so that the Data Explorer can check scripts
+// without running them.
+func (s *Service) FluxAST(w http.ResponseWriter, r *http.Request) {
+	var req fluxRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	pkg, err := parseFlux(req.Query)
+	if err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	encodeJSON(w, http.StatusOK, fluxASTResponse{AST: pkg}, s.Logger)
+}
+
+// Flux proxies Flux scripts to sources of InfluxDB 1.7 or later and returns
+// their results as annotated CSV. Scripts are parsed first so that invalid
+// ones are not sent to the source.
+func (s *Service) Flux(w http.ResponseWriter, r *http.Request) {
+	id, err := paramID("id", r)
+	if err != nil {
+		Error(w, http.StatusUnprocessableEntity, err.Error(), s.Logger)
+		return
+	}
+
+	var req fluxRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	if _, err := parseFlux(req.Query); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	src, err := s.Store.Sources(ctx).Get(ctx, id)
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+	flux, err := s.fluxQuerier(ctx, src)
+	if err != nil {
+		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+		return
+	}
+	data, err := flux.FluxQuery(ctx, req.Query)
+	if err != nil {
+		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(data); err != nil {
+		s.Logger.Error("Unable to write flux response: ", err)
+	}
+}
+
+// fluxSchema runs a Flux script listing the schema of a source and returns
+// the distinct values of column. It responds with the error itself when the
+// script cannot be run.
+func (s *Service) fluxSchema(w http.ResponseWriter, r *http.Request, script, column string) ([]string, bool) {
+	id, err := paramID("id", r)
+	if err != nil {
+		Error(w, http.StatusUnprocessableEntity, err.Error(), s.Logger)
+		return nil, false
+	}
+
+	ctx := r.Context()
+	src, err := s.Store.Sources(ctx).Get(ctx, id)
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return nil, false
+	}
+	flux, err := s.fluxQuerier(ctx, src)
+	if err != nil {
+		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+		return nil, false
+	}
+	data, err := flux.FluxQuery(ctx, script)
+	if err != nil {
+		Error(w, http.StatusBadGateway, err.Error(), s.Logger)
+		return nil, false
+	}
+	values, err := parseFluxChoices(data, column)
+	if err != nil {
+		Error(w, http.StatusBadGateway, err.Error(), s.Logger)
+		return nil, false
+	}
+	if values == nil {
+		values = []string{}
+	}
+	return values, true
+}
+
+// FluxBuckets lists the buckets of a source. Buckets of InfluxDB 1.x are
+// its database and retention policy pairs.
+func (s *Service) FluxBuckets(w http.ResponseWriter, r *http.Request) {
+	buckets, ok := s.fluxSchema(w, r, "buckets()", "name")
+	if !ok {
+		return
+	}
+	encodeJSON(w, http.StatusOK, fluxBucketsResponse{Buckets: buckets}, s.Logger)
+}
+
+// FluxMeasurements lists the measurements of the bucket parameter
+func (s *Service) FluxMeasurements(w http.ResponseWriter, r *http.Request) {
+	bucket := r.URL.Query().Get("bucket")
+	if bucket == "" {
+		invalidData(w, errorf("bucket is required"), s.Logger)
+		return
+	}
+	script := fmt.Sprintf("import \"influxdata/influxdb/v1\"\n\nv1.measurements(bucket: %s)", fluxString(bucket))
+	measurements, ok := s.fluxSchema(w, r, script, fluxValueColumn)
+	if !ok {
+		return
+	}
+	encodeJSON(w, http.StatusOK, fluxMeasurementsResponse{
+		Bucket:       bucket,
+		Measurements: measurements,
+	}, s.Logger)
+}
+
+// FluxFields lists the field keys of the bucket parameter, only those of the
+// measurement parameter if there is one
+func (s *Service) FluxFields(w http.ResponseWriter, r *http.Request) {
+	bucket := r.URL.Query().Get("bucket")
+	if bucket == "" {
+		invalidData(w, errorf("bucket is required"), s.Logger)
+		return
+	}
+	measurement := r.URL.Query().Get("measurement")
+	script := fmt.Sprintf("import \"influxdata/influxdb/v1\"\n\nv1.fieldKeys(bucket: %s)", fluxString(bucket))
+	if measurement != "" {
+		script = fmt.Sprintf("import \"influxdata/influxdb/v1\"\n\nv1.measurementFieldKeys(bucket: %s, measurement: %s)", fluxString(bucket), fluxString(measurement))
+	}
+	fields, ok := s.fluxSchema(w, r, script, fluxValueColumn)
+	if !ok {
+		return
+	}
+	encodeJSON(w, http.StatusOK, fluxFieldsResponse{
+		Bucket:      bucket,
+		Measurement: measurement,
+		Fields:      fields,
+	}, s.Logger)
+}
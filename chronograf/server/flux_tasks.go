@@ -0,0 +1,314 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+const (
+	// defaultFluxTaskRuns is the number of runs of a task listed by default
+	defaultFluxTaskRuns = 20
+	// maxFluxTaskRuns is the most runs of a task InfluxDB lists at once
+	maxFluxTaskRuns = 500
+)
+
+type fluxTaskLinks struct {
+	Self string `json:"self"` // Self link mapping to this resource
+	Runs string `json:"runs"` // Runs link to the latest runs of the task
+	Logs string `json:"logs"` // Logs link to the logs of the runs of the task
+}
+
+type fluxTaskResponse struct {
+	chronograf.FluxTask
+	Links fluxTaskLinks `json:"links"`
+}
+
+func newFluxTaskResponse(srcID int, t chronograf.FluxTask) fluxTaskResponse {
+	self := fmt.Sprintf("%s%d/flux/tasks/%s", sourceLinkPrefix, srcID, t.ID)
+	return fluxTaskResponse{
+		FluxTask: t,
+		Links: fluxTaskLinks{
+			Self: self,
+			Runs: self + "/runs",
+			Logs: self + "/logs",
+		},
+	}
+}
+
+type fluxTasksResponse struct {
+	Links selfLinks          `json:"links"`
+	Tasks []fluxTaskResponse `json:"tasks"`
+}
+
+type fluxTaskRunsResponse struct {
+	Runs []chronograf.FluxTaskRun `json:"runs"`
+}
+
+type fluxTaskLogsResponse struct {
+	Logs []chronograf.FluxTaskLog `json:"logs"`
+}
+
+// fluxTaskRequest creates or updates a task. Fields left out of an update
+// keep their value.
+type fluxTaskRequest struct {
+	Flux        *string `json:"flux"` // Flux is the script of the task, declaring the task option
+	Description *string `json:"description"`
+	Status      *string `json:"status"` // Status is active or inactive
+}
+
+// apply sets the fields of the request on a task and validates it
+func (req *fluxTaskRequest) apply(t *chronograf.FluxTask) error {
+	if req.Flux != nil {
+		t.Flux = *req.Flux
+	}
+	if req.Description != nil {
+		t.Description = *req.Description
+	}
+	if req.Status != nil {
+		t.Status = *req.Status
+	}
+
+	if t.Status != chronograf.FluxTaskActive && t.Status != chronograf.FluxTaskInactive {
+		return errorf("status must be active or inactive")
+	}
+	if req.Flux == nil {
+		return nil
+	}
+	pkg, err := parseFlux(t.Flux)
+	if err != nil {
+		return err
+	}
+	if !hasFluxTaskOption(pkg) {
+		return errorf("flux script must declare the task option, e.g. option task = {name: \"downsample\", every: 1h}")
+	}
+	return nil
+}
+
+// hasFluxTaskOption reports whether a Flux script declares the task option
+// setting the name and schedule of its task
+func hasFluxTaskOption(pkg *ast.Package) bool {
+	for _, f := range pkg.Files {
+		for _, stmt := range f.Body {
+			opt, ok := stmt.(*ast.OptionStatement)
+			if !ok {
+				continue
+			}
+			if a, ok := opt.Assignment.(*ast.VariableAssignment); ok && a.ID.Name == "task" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// fluxTasker connects to the InfluxDB 2.x source of the route, writing the
+// error response when it fails
+func (s *Service) fluxTasker(w http.ResponseWriter, r *http.Request) (chronograf.Source, chronograf.FluxTasker, bool) {
+	id, err := paramID("id", r)
+	if err != nil {
+		Error(w, http.StatusUnprocessableEntity, err.Error(), s.Logger)
+		return chronograf.Source{}, nil, false
+	}
+
+	ctx := r.Context()
+	src, err := s.Store.Sources(ctx).Get(ctx, id)
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return src, nil, false
+	}
+	if src.Type != chronograf.InfluxDBv2 {
+		invalidData(w, errorf("flux tasks are only supported by InfluxDB 2.x sources"), s.Logger)
+		return src, nil, false
+	}
+	ts, err := s.TimeSeries(src)
+	if err != nil {
+		Error(w, http.StatusBadRequest, fmt.Sprintf("unable to connect to source %d: %v", src.ID, err), s.Logger)
+		return src, nil, false
+	}
+	if err := ts.Connect(ctx, &src); err != nil {
+		Error(w, http.StatusBadRequest, fmt.Sprintf("unable to connect to source %d: %v", src.ID, err), s.Logger)
+		return src, nil, false
+	}
+	tasker, ok := ts.(chronograf.FluxTasker)
+	if !ok {
+		Error(w, http.StatusBadRequest, fmt.Sprintf("source %d does not support flux tasks", src.ID), s.Logger)
+		return src, nil, false
+	}
+	return src, tasker, true
+}
+
+// fluxTaskError writes the response of an error of the tasks API of a source
+func (s *Service) fluxTaskError(w http.ResponseWriter, r *http.Request, err error) {
+	if err == chronograf.ErrFluxTaskNotFound {
+		tid, _ := paramStr("tid", r)
+		notFound(w, tid, s.Logger)
+		return
+	}
+	Error(w, http.StatusBadGateway, err.Error(), s.Logger)
+}
+
+// FluxTasks lists the Flux tasks of an InfluxDB 2.x source
+func (s *Service) FluxTasks(w http.ResponseWriter, r *http.Request) {
+	src, tasker, ok := s.fluxTasker(w, r)
+	if !ok {
+		return
+	}
+	tasks, err := tasker.AllFluxTasks(r.Context())
+	if err != nil {
+		s.fluxTaskError(w, r, err)
+		return
+	}
+
+	res := fluxTasksResponse{
+		Links: selfLinks{
+			Self: fmt.Sprintf("%s%d/flux/tasks", sourceLinkPrefix, src.ID),
+		},
+		Tasks: []fluxTaskResponse{},
+	}
+	for _, t := range tasks {
+		res.Tasks = append(res.Tasks, newFluxTaskResponse(src.ID, t))
+	}
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// NewFluxTask creates a Flux task of an InfluxDB 2.x source. Its name and
+// schedule are those of the task option of its script. Tasks are active
+// unless created inactive.
+func (s *Service) NewFluxTask(w http.ResponseWriter, r *http.Request) {
+	var req fluxTaskRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	if req.Flux == nil {
+		invalidData(w, errorf("flux field required"), s.Logger)
+		return
+	}
+	t := &chronograf.FluxTask{Status: chronograf.FluxTaskActive}
+	if err := req.apply(t); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	src, tasker, ok := s.fluxTasker(w, r)
+	if !ok {
+		return
+	}
+	t, err := tasker.CreateFluxTask(r.Context(), t)
+	if err != nil {
+		s.fluxTaskError(w, r, err)
+		return
+	}
+
+	res := newFluxTaskResponse(src.ID, *t)
+	location(w, res.Links.Self)
+	encodeJSON(w, http.StatusCreated, res, s.Logger)
+}
+
+// FluxTaskID returns a single Flux task of an InfluxDB 2.x source
+func (s *Service) FluxTaskID(w http.ResponseWriter, r *http.Request) {
+	src, tasker, ok := s.fluxTasker(w, r)
+	if !ok {
+		return
+	}
+	tid, _ := paramStr("tid", r)
+	t, err := tasker.GetFluxTask(r.Context(), tid)
+	if err != nil {
+		s.fluxTaskError(w, r, err)
+		return
+	}
+	encodeJSON(w, http.StatusOK, newFluxTaskResponse(src.ID, *t), s.Logger)
+}
+
+// UpdateFluxTask changes the script, description or status of a Flux task,
+// such as to deactivate it
+func (s *Service) UpdateFluxTask(w http.ResponseWriter, r *http.Request) {
+	var req fluxTaskRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+
+	src, tasker, ok := s.fluxTasker(w, r)
+	if !ok {
+		return
+	}
+	ctx := r.Context()
+	tid, _ := paramStr("tid", r)
+	t, err := tasker.GetFluxTask(ctx, tid)
+	if err != nil {
+		s.fluxTaskError(w, r, err)
+		return
+	}
+	if err := req.apply(t); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	if t, err = tasker.UpdateFluxTask(ctx, t); err != nil {
+		s.fluxTaskError(w, r, err)
+		return
+	}
+	encodeJSON(w, http.StatusOK, newFluxTaskResponse(src.ID, *t), s.Logger)
+}
+
+// RemoveFluxTask deletes a Flux task of an InfluxDB 2.x source along with
+// its runs
+func (s *Service) RemoveFluxTask(w http.ResponseWriter, r *http.Request) {
+	_, tasker, ok := s.fluxTasker(w, r)
+	if !ok {
+		return
+	}
+	tid, _ := paramStr("tid", r)
+	if err := tasker.DeleteFluxTask(r.Context(), tid); err != nil {
+		s.fluxTaskError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// FluxTaskRuns lists the latest runs of a Flux task, 20 unless the limit
+// parameter is given
+func (s *Service) FluxTaskRuns(w http.ResponseWriter, r *http.Request) {
+	limit := defaultFluxTaskRuns
+	if param := r.URL.Query().Get("limit"); param != "" {
+		n, err := strconv.Atoi(param)
+		if err != nil || n < 1 || n > maxFluxTaskRuns {
+			invalidData(w, errorf("limit must be between 1 and %d", maxFluxTaskRuns), s.Logger)
+			return
+		}
+		limit = n
+	}
+
+	_, tasker, ok := s.fluxTasker(w, r)
+	if !ok {
+		return
+	}
+	tid, _ := paramStr("tid", r)
+	runs, err := tasker.FluxTaskRuns(r.Context(), tid, limit)
+	if err != nil {
+		s.fluxTaskError(w, r, err)
+		return
+	}
+	encodeJSON(w, http.StatusOK, fluxTaskRunsResponse{Runs: runs}, s.Logger)
+}
+
+// FluxTaskLogs lists the logs of a Flux task, only those of the run
+// parameter when it is given
+func (s *Service) FluxTaskLogs(w http.ResponseWriter, r *http.Request) {
+	_, tasker, ok := s.fluxTasker(w, r)
+	if !ok {
+		return
+	}
+	tid, _ := paramStr("tid", r)
+	logs, err := tasker.FluxTaskLogs(r.Context(), tid, r.URL.Query().Get("run"))
+	if err != nil {
+		s.fluxTaskError(w, r, err)
+		return
+	}
+	encodeJSON(w, http.StatusOK, fluxTaskLogsResponse{Logs: logs}, s.Logger)
+}
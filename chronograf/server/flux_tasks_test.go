@@ -0,0 +1,297 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	jhttprouter "github.com/influxdata/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+const downsampleTask = `option task = {name: \"downsample\", every: 1h}\n\nfrom(bucket: \"telegraf\") |> range(start: -task.every) |> to(bucket: \"telegraf_1h\")`
+
+// newFluxTasksService serves the tasks of the InfluxDB 2.x source 1, which
+// has the task 0a1, recording the created and updated tasks
+func newFluxTasksService(saved *[]chronograf.FluxTask) *Service {
+	task := chronograf.FluxTask{
+		ID:     "0a1",
+		Name:   "downsample",
+		Status: chronograf.FluxTaskActive,
+		Flux:   `option task = {name: "downsample", every: 1h}`,
+		Every:  "1h",
+	}
+	return &Service{
+		Store: &mocks.Store{
+			SourcesStore: &mocks.SourcesStore{
+				GetF: func(ctx context.Context, id int) (chronograf.Source, error) {
+					switch id {
+					case 1:
+						return chronograf.Source{ID: 1, Type: chronograf.InfluxDBv2}, nil
+					case 2:
+						return chronograf.Source{ID: 2, Type: chronograf.InfluxDB}, nil
+					}
+					return chronograf.Source{}, chronograf.ErrSourceNotFound
+				},
+			},
+		},
+		TimeSeriesClient: &mocks.TimeSeries{
+			ConnectF: func(context.Context, *chronograf.Source) error {
+				return nil
+			},
+			AllFluxTasksF: func(context.Context) ([]chronograf.FluxTask, error) {
+				return []chronograf.FluxTask{task}, nil
+			},
+			GetFluxTaskF: func(ctx context.Context, id string) (*chronograf.FluxTask, error) {
+				if id != task.ID {
+					return nil, chronograf.ErrFluxTaskNotFound
+				}
+				t := task
+				return &t, nil
+			},
+			CreateFluxTaskF: func(ctx context.Context, t *chronograf.FluxTask) (*chronograf.FluxTask, error) {
+				*saved = append(*saved, *t)
+				created := *t
+				created.ID = "0a2"
+				return &created, nil
+			},
+			UpdateFluxTaskF: func(ctx context.Context, t *chronograf.FluxTask) (*chronograf.FluxTask, error) {
+				*saved = append(*saved, *t)
+				return t, nil
+			},
+			FluxTaskRunsF: func(ctx context.Context, id string, limit int) ([]chronograf.FluxTaskRun, error) {
+				return []chronograf.FluxTaskRun{{ID: fmt.Sprintf("limit-%d", limit), TaskID: id}}, nil
+			},
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+}
+
+func withFluxTaskParams(r *http.Request, id, tid string) *http.Request {
+	params := jhttprouter.Params{{Key: "id", Value: id}}
+	if tid != "" {
+		params = append(params, jhttprouter.Param{Key: "tid", Value: tid})
+	}
+	return r.WithContext(context.WithValue(r.Context(), jhttprouter.ParamsKey, params))
+}
+
+func TestService_FluxTasks(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		wantStatus int
+		wantSelf   string
+	}{
+		{
+			name:       "Lists the tasks of an InfluxDB 2.x source",
+			id:         "1",
+			wantStatus: http.StatusOK,
+			wantSelf:   "/chronograf/v1/sources/1/flux/tasks/0a1",
+		},
+		{
+			name:       "InfluxDB 1.x source",
+			id:         "2",
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Unknown source",
+			id:         "3",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newFluxTasksService(&[]chronograf.FluxTask{})
+			w := httptest.NewRecorder()
+			r := withFluxTaskParams(httptest.NewRequest("GET", "/chronograf/v1/sources/"+tt.id+"/flux/tasks", nil), tt.id, "")
+			s.FluxTasks(w, r)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("FluxTasks() status = %d, want %d: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var res struct {
+				Tasks []struct {
+					ID    string `json:"id"`
+					Links struct {
+						Self string `json:"self"`
+					} `json:"links"`
+				} `json:"tasks"`
+			}
+			if err := json.NewDecoder(w.Body).Decode(&res); err != nil {
+				t.Fatal(err)
+			}
+			if len(res.Tasks) != 1 || res.Tasks[0].Links.Self != tt.wantSelf {
+				t.Errorf("FluxTasks() tasks = %+v", res.Tasks)
+			}
+		})
+	}
+}
+
+func TestService_NewFluxTask(t *testing.T) {
+	tests := []struct {
+		name         string
+		body         string
+		wantStatus   int
+		wantLocation string
+		wantSaved    []chronograf.FluxTask
+	}{
+		{
+			name:         "Creates an active task",
+			body:         `{"flux":"` + downsampleTask + `","description":"hourly means"}`,
+			wantStatus:   http.StatusCreated,
+			wantLocation: "/chronograf/v1/sources/1/flux/tasks/0a2",
+			wantSaved: []chronograf.FluxTask{
+				{
+					Flux:        strings.Replace(strings.Replace(downsampleTask, `\"`, `"`, -1), `\n`, "\n", -1),
+					Description: "hourly means",
+					Status:      chronograf.FluxTaskActive,
+				},
+			},
+		},
+		{
+			name:       "Script without the task option",
+			body:       `{"flux":"from(bucket: \"telegraf\") |> range(start: -1h)"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Unknown status",
+			body:       `{"flux":"` + downsampleTask + `","status":"paused"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Missing script",
+			body:       `{"description":"hourly means"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			saved := []chronograf.FluxTask{}
+			s := newFluxTasksService(&saved)
+			w := httptest.NewRecorder()
+			r := withFluxTaskParams(httptest.NewRequest("POST", "/chronograf/v1/sources/1/flux/tasks", strings.NewReader(tt.body)), "1", "")
+			s.NewFluxTask(w, r)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("NewFluxTask() status = %d, want %d: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if got := w.Header().Get("Location"); got != tt.wantLocation {
+				t.Errorf("NewFluxTask() location = %q, want %q", got, tt.wantLocation)
+			}
+			if tt.wantSaved == nil {
+				tt.wantSaved = []chronograf.FluxTask{}
+			}
+			if !cmp.Equal(saved, tt.wantSaved) {
+				t.Errorf("NewFluxTask() created %s", cmp.Diff(saved, tt.wantSaved))
+			}
+		})
+	}
+}
+
+func TestService_UpdateFluxTask(t *testing.T) {
+	tests := []struct {
+		name       string
+		tid        string
+		body       string
+		wantStatus int
+		wantSaved  []chronograf.FluxTask
+	}{
+		{
+			name:       "Deactivates a task keeping its script",
+			tid:        "0a1",
+			body:       `{"status":"inactive"}`,
+			wantStatus: http.StatusOK,
+			wantSaved: []chronograf.FluxTask{
+				{
+					ID:     "0a1",
+					Name:   "downsample",
+					Status: chronograf.FluxTaskInactive,
+					Flux:   `option task = {name: "downsample", every: 1h}`,
+					Every:  "1h",
+				},
+			},
+		},
+		{
+			name:       "Unknown task",
+			tid:        "0ff",
+			body:       `{"status":"inactive"}`,
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			saved := []chronograf.FluxTask{}
+			s := newFluxTasksService(&saved)
+			w := httptest.NewRecorder()
+			r := withFluxTaskParams(httptest.NewRequest("PATCH", "/chronograf/v1/sources/1/flux/tasks/"+tt.tid, strings.NewReader(tt.body)), "1", tt.tid)
+			s.UpdateFluxTask(w, r)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("UpdateFluxTask() status = %d, want %d: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if tt.wantSaved == nil {
+				tt.wantSaved = []chronograf.FluxTask{}
+			}
+			if !cmp.Equal(saved, tt.wantSaved) {
+				t.Errorf("UpdateFluxTask() updated %s", cmp.Diff(saved, tt.wantSaved))
+			}
+		})
+	}
+}
+
+func TestService_FluxTaskRuns(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+		wantRun    string
+	}{
+		{
+			name:       "Default limit",
+			wantStatus: http.StatusOK,
+			wantRun:    "limit-20",
+		},
+		{
+			name:       "Given limit",
+			query:      "?limit=5",
+			wantStatus: http.StatusOK,
+			wantRun:    "limit-5",
+		},
+		{
+			name:       "Limit too large",
+			query:      "?limit=1000",
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newFluxTasksService(&[]chronograf.FluxTask{})
+			w := httptest.NewRecorder()
+			r := withFluxTaskParams(httptest.NewRequest("GET", "/chronograf/v1/sources/1/flux/tasks/0a1/runs"+tt.query, nil), "1", "0a1")
+			s.FluxTaskRuns(w, r)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("FluxTaskRuns() status = %d, want %d: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var res fluxTaskRunsResponse
+			if err := json.NewDecoder(w.Body).Decode(&res); err != nil {
+				t.Fatal(err)
+			}
+			if len(res.Runs) != 1 || res.Runs[0].ID != tt.wantRun {
+				t.Errorf("FluxTaskRuns() runs = %+v, want %s", res.Runs, tt.wantRun)
+			}
+		})
+	}
+}
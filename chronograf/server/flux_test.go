@@ -0,0 +1,221 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	jhttprouter "github.com/influxdata/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+func newFluxService(scripts *[]string, result string) *Service {
+	return &Service{
+		Store: &mocks.Store{
+			SourcesStore: &mocks.SourcesStore{
+				GetF: func(ctx context.Context, id int) (chronograf.Source, error) {
+					if id != 1 {
+						return chronograf.Source{}, chronograf.ErrSourceNotFound
+					}
+					return chronograf.Source{ID: 1}, nil
+				},
+			},
+		},
+		TimeSeriesClient: &mocks.TimeSeries{
+			ConnectF: func(context.Context, *chronograf.Source) error {
+				return nil
+			},
+			FluxQueryF: func(ctx context.Context, script string) ([]byte, error) {
+				*scripts = append(*scripts, script)
+				return []byte(result), nil
+			},
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+}
+
+func withSourceID(r *http.Request, id string) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), jhttprouter.ParamsKey, jhttprouter.Params{{Key: "id", Value: id}}))
+}
+
+func TestService_Flux(t *testing.T) {
+	result := "#datatype,string,long,double\n,result,table,_value\n,_result,0,1.5\n"
+	tests := []struct {
+		name        string
+		id          string
+		body        string
+		wantStatus  int
+		wantScripts []string
+	}{
+		{
+			name:        "Proxies the script",
+			id:          "1",
+			body:        `{"query":"from(bucket: \"telegraf/autogen\") |> range(start: -1h)"}`,
+			wantStatus:  http.StatusOK,
+			wantScripts: []string{`from(bucket: "telegraf/autogen") |> range(start: -1h)`},
+		},
+		{
+			name:       "Invalid script is not sent",
+			id:         "1",
+			body:       `{"query":"from(bucket: \"telegraf/autogen\" |> range(start: -1h)"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Empty script",
+			id:         "1",
+			body:       `{"query":" "}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Unknown source",
+			id:         "2",
+			body:       `{"query":"buckets()"}`,
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var scripts []string
+			s := newFluxService(&scripts, result)
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url/chronograf/v1/sources/"+tt.id+"/flux", bytes.NewBufferString(tt.body))
+			s.Flux(w, withSourceID(r, tt.id))
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("Flux() = %v, want %v: %s", resp.StatusCode, tt.wantStatus, body)
+			}
+			if !cmp.Equal(scripts, tt.wantScripts) {
+				t.Errorf("Flux() ran %q, want %q", scripts, tt.wantScripts)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, "text/csv") {
+				t.Errorf("Flux() Content-Type = %s", ct)
+			}
+			if string(body) != result {
+				t.Errorf("Flux() = %s, want %s", body, result)
+			}
+		})
+	}
+}
+
+func TestService_FluxAST(t *testing.T) {
+	s := &Service{Logger: &chronograf.NoopLogger{}}
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("POST", "http://any.url/chronograf/v1/flux/ast", bytes.NewBufferString(`{"query":"buckets()"}`))
+	s.FluxAST(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("FluxAST() = %v: %s", w.Code, w.Body.String())
+	}
+	var got struct {
+		AST struct {
+			Type  string `json:"type"`
+			Files []struct {
+				Body []json.RawMessage `json:"body"`
+			} `json:"files"`
+		} `json:"ast"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+		t.Fatalf("FluxAST() = %s", w.Body.String())
+	}
+	if got.AST.Type != "Package" || len(got.AST.Files) != 1 || len(got.AST.Files[0].Body) != 1 {
+		t.Errorf("FluxAST() = %s", w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	r = httptest.NewRequest("POST", "http://any.url/chronograf/v1/flux/ast", bytes.NewBufferString(`{"query":"buckets("}`))
+	s.FluxAST(w, r)
+	if w.Code != http.StatusUnprocessableEntity || !strings.Contains(w.Body.String(), "invalid flux script") {
+		t.Errorf("FluxAST() of an invalid script = %v: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestService_FluxSchema(t *testing.T) {
+	tests := []struct {
+		name       string
+		handler    func(*Service) http.HandlerFunc
+		url        string
+		result     string
+		wantStatus int
+		wantScript string
+		want       string
+	}{
+		{
+			name:       "Buckets",
+			handler:    func(s *Service) http.HandlerFunc { return s.FluxBuckets },
+			url:        "http://any.url/chronograf/v1/sources/1/flux/buckets",
+			result:     "#datatype,string,long,string\n,result,table,name\n,_result,0,telegraf/autogen\n,_result,0,_internal/monitor\n",
+			wantStatus: http.StatusOK,
+			wantScript: "buckets()",
+			want:       `{"buckets":["telegraf/autogen","_internal/monitor"]}`,
+		},
+		{
+			name:       "Measurements",
+			handler:    func(s *Service) http.HandlerFunc { return s.FluxMeasurements },
+			url:        "http://any.url/chronograf/v1/sources/1/flux/measurements?bucket=telegraf/autogen",
+			result:     "#datatype,string,long,string\n,result,table,_value\n,_result,0,cpu\n,_result,0,mem\n",
+			wantStatus: http.StatusOK,
+			wantScript: "import \"influxdata/influxdb/v1\"\n\nv1.measurements(bucket: \"telegraf/autogen\")",
+			want:       `{"bucket":"telegraf/autogen","measurements":["cpu","mem"]}`,
+		},
+		{
+			name:       "Fields of a measurement",
+			handler:    func(s *Service) http.HandlerFunc { return s.FluxFields },
+			url:        "http://any.url/chronograf/v1/sources/1/flux/fields?bucket=telegraf/autogen&measurement=c%22pu",
+			result:     "#datatype,string,long,string\n,result,table,_value\n",
+			wantStatus: http.StatusOK,
+			wantScript: "import \"influxdata/influxdb/v1\"\n\nv1.measurementFieldKeys(bucket: \"telegraf/autogen\", measurement: \"c\\\"pu\")",
+			want:       `{"bucket":"telegraf/autogen","measurement":"c\"pu","fields":[]}`,
+		},
+		{
+			name:       "Measurements without bucket",
+			handler:    func(s *Service) http.HandlerFunc { return s.FluxMeasurements },
+			url:        "http://any.url/chronograf/v1/sources/1/flux/measurements",
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Flux error",
+			handler:    func(s *Service) http.HandlerFunc { return s.FluxBuckets },
+			url:        "http://any.url/chronograf/v1/sources/1/flux/buckets",
+			result:     "#datatype,string,string\n,error,reference\n,unauthorized,\n",
+			wantStatus: http.StatusBadGateway,
+			wantScript: "buckets()",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var scripts []string
+			s := newFluxService(&scripts, tt.result)
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("GET", tt.url, nil)
+			tt.handler(s)(w, withSourceID(r, "1"))
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("%s = %v, want %v: %s", tt.name, resp.StatusCode, tt.wantStatus, body)
+			}
+			if tt.wantScript != "" && (len(scripts) != 1 || scripts[0] != tt.wantScript) {
+				t.Errorf("%s ran %q, want %q", tt.name, scripts, tt.wantScript)
+			}
+			if tt.want != "" {
+				if eq, _ := jsonEqual(string(body), tt.want); !eq {
+					t.Errorf("%s = %s, want %s", tt.name, body, tt.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,328 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/bouk/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/roles"
+)
+
+type folderLinks struct {
+	Self       string `json:"self"`       // Self link mapping to this resource
+	Dashboards string `json:"dashboards"` // Dashboards link to the dashboards within the folder
+}
+
+type folderResponse struct {
+	Links folderLinks `json:"links"`
+	chronograf.Folder
+}
+
+func newFolderResponse(f chronograf.Folder) *folderResponse {
+	return &folderResponse{
+		Links: folderLinks{
+			Self:       fmt.Sprintf("/chronograf/v1/folders/%s", f.ID),
+			Dashboards: fmt.Sprintf("/chronograf/v1/folders/%s/dashboards", f.ID),
+		},
+		Folder: f,
+	}
+}
+
+type foldersResponse struct {
+	Links   selfLinks         `json:"links"`
+	Folders []*folderResponse `json:"folders"`
+}
+
+func newFoldersResponse(fs []chronograf.Folder) *foldersResponse {
+	folders := make([]*folderResponse, len(fs))
+	for i, f := range fs {
+		folders[i] = newFolderResponse(f)
+	}
+	return &foldersResponse{
+		Links: selfLinks{
+			Self: "/chronograf/v1/folders",
+		},
+		Folders: folders,
+	}
+}
+
+type folderRequest struct {
+	Name   *string `json:"name"`
+	Parent *string `json:"parent"` // Parent is the ID of the parent folder; empty is the top level
+	Role   *string `json:"role"`   // Role is the minimum role required to see the folder; empty is any role
+}
+
+// ValidCreate checks that the folder request has a name and a known role
+func (r *folderRequest) ValidCreate() error {
+	if r.Name == nil || *r.Name == "" {
+		return errorf("name required on Chronograf Folder request body")
+	}
+	return r.validRole()
+}
+
+// ValidUpdate checks that the folder request changes the folder and that the
+// name, if changed, is not empty
+func (r *folderRequest) ValidUpdate() error {
+	if r.Name == nil && r.Parent == nil && r.Role == nil {
+		return errorf("no fields to update")
+	}
+	if r.Name != nil && *r.Name == "" {
+		return errorf("name must not be empty")
+	}
+	return r.validRole()
+}
+
+func (r *folderRequest) validRole() error {
+	if r.Role == nil {
+		return nil
+	}
+	switch *r.Role {
+	case "", roles.ViewerRoleName, roles.EditorRoleName, roles.AdminRoleName:
+		return nil
+	default:
+		return errorf("unknown role %s. Valid roles are 'viewer', 'editor', and 'admin'", *r.Role)
+	}
+}
+
+// validFolderRole checks that the current user has at least the role
+// required by a folder so that users cannot lock themselves out of folders
+func validFolderRole(ctx context.Context, role string) error {
+	if role == "" {
+		return nil
+	}
+	current, ok := hasRoleContext(ctx)
+	if !ok {
+		return nil
+	}
+	if !hasAuthorizedRole(&chronograf.User{Roles: []chronograf.Role{{Name: current}}}, role) {
+		return errorf("role %s exceeds the role %s of the current user", role, current)
+	}
+	return nil
+}
+
+// validDashboardFolder checks that the folder of a dashboard exists within
+// the current organization and is visible to the current user
+func (s *Service) validDashboardFolder(ctx context.Context, folder string) error {
+	if folder == "" {
+		return nil
+	}
+	if _, err := s.Store.Folders(ctx).Get(ctx, folder); err != nil {
+		return errorf("folder %s not found", folder)
+	}
+	return nil
+}
+
+// Folders lists the folders of the current organization visible to the
+// current user
+func (s *Service) Folders(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	fs, err := s.Store.Folders(ctx).All(ctx)
+	if err != nil {
+		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+		return
+	}
+
+	encodeJSON(w, http.StatusOK, newFoldersResponse(fs), s.Logger)
+}
+
+// FolderID returns a single folder
+func (s *Service) FolderID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := httprouter.GetParamFromContext(ctx, "id")
+	f, err := s.Store.Folders(ctx).Get(ctx, id)
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+
+	encodeJSON(w, http.StatusOK, newFolderResponse(*f), s.Logger)
+}
+
+// FolderDashboards lists the dashboards directly within a folder
+func (s *Service) FolderDashboards(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := httprouter.GetParamFromContext(ctx, "id")
+	if _, err := s.Store.Folders(ctx).Get(ctx, id); err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+
+	dashboards, err := s.Store.Dashboards(ctx).All(ctx)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "Error loading dashboards", s.Logger)
+		return
+	}
+
+	res := getDashboardsResponse{
+		Dashboards: []*dashboardResponse{},
+	}
+	for _, dashboard := range dashboards {
+		if dashboard.Folder == id {
+			res.Dashboards = append(res.Dashboards, newDashboardResponse(dashboard))
+		}
+	}
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// NewFolder creates a folder within the current organization
+func (s *Service) NewFolder(w http.ResponseWriter, r *http.Request) {
+	var req folderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	if err := req.ValidCreate(); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	f := &chronograf.Folder{
+		Name: *req.Name,
+	}
+	if req.Parent != nil {
+		f.Parent = *req.Parent
+	}
+	if req.Role != nil {
+		f.Role = *req.Role
+	}
+	if err := validFolderRole(ctx, f.Role); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	if f.Parent != "" {
+		if _, err := s.Store.Folders(ctx).Get(ctx, f.Parent); err != nil {
+			invalidData(w, errorf("parent folder %s not found", f.Parent), s.Logger)
+			return
+		}
+	}
+
+	f, err := s.Store.Folders(ctx).Add(ctx, f)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	res := newFolderResponse(*f)
+	location(w, res.Links.Self)
+	encodeJSON(w, http.StatusCreated, res, s.Logger)
+}
+
+// UpdateFolder renames a folder, moves it into another parent or changes the
+// role required to see it
+func (s *Service) UpdateFolder(w http.ResponseWriter, r *http.Request) {
+	var req folderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	if err := req.ValidUpdate(); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	id := httprouter.GetParamFromContext(ctx, "id")
+	f, err := s.Store.Folders(ctx).Get(ctx, id)
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+
+	if req.Name != nil {
+		f.Name = *req.Name
+	}
+	if req.Role != nil {
+		if err := validFolderRole(ctx, *req.Role); err != nil {
+			invalidData(w, err, s.Logger)
+			return
+		}
+		f.Role = *req.Role
+	}
+	if req.Parent != nil {
+		if err := s.validFolderParent(ctx, f.ID, *req.Parent); err != nil {
+			invalidData(w, err, s.Logger)
+			return
+		}
+		f.Parent = *req.Parent
+	}
+
+	if err := s.Store.Folders(ctx).Update(ctx, f); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	encodeJSON(w, http.StatusOK, newFolderResponse(*f), s.Logger)
+}
+
+// validFolderParent checks that the parent exists and that moving the folder
+// into it does not nest the folder within itself
+func (s *Service) validFolderParent(ctx context.Context, id, parent string) error {
+	if parent == "" {
+		return nil
+	}
+	fs, err := s.Store.Folders(ctx).All(ctx)
+	if err != nil {
+		return err
+	}
+	byID := make(map[string]chronograf.Folder, len(fs))
+	for _, f := range fs {
+		byID[f.ID] = f
+	}
+	if _, ok := byID[parent]; !ok {
+		return errorf("parent folder %s not found", parent)
+	}
+	seen := map[string]bool{}
+	for p := parent; p != "" && !seen[p]; p = byID[p].Parent {
+		if p == id {
+			return errorf("folder %s cannot be moved into itself", id)
+		}
+		seen[p] = true
+	}
+	return nil
+}
+
+// RemoveFolder deletes an empty folder. Folders holding dashboards or other
+// folders, including those the current user may not see, are not removed.
+func (s *Service) RemoveFolder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := httprouter.GetParamFromContext(ctx, "id")
+	f, err := s.Store.Folders(ctx).Get(ctx, id)
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+
+	serverCtx := serverContext(ctx)
+	folders, err := s.Store.Folders(serverCtx).All(serverCtx)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	for _, child := range folders {
+		if child.Parent == f.ID {
+			Error(w, http.StatusConflict, "folder is not empty", s.Logger)
+			return
+		}
+	}
+	dashboards, err := s.Store.Dashboards(serverCtx).All(serverCtx)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	for _, d := range dashboards {
+		if d.Folder == f.ID {
+			Error(w, http.StatusConflict, "folder is not empty", s.Logger)
+			return
+		}
+	}
+
+	if err := s.Store.Folders(ctx).Delete(ctx, f); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
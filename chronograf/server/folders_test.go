@@ -0,0 +1,235 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bouk/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+	"github.com/influxdata/influxdb/chronograf/roles"
+)
+
+// newFoldersService returns a Service storing folders and dashboards in
+// memory
+func newFoldersService(folders *[]chronograf.Folder, dashboards []chronograf.Dashboard) *Service {
+	return &Service{
+		Store: &mocks.Store{
+			FoldersStore: &mocks.FoldersStore{
+				AllF: func(ctx context.Context) ([]chronograf.Folder, error) {
+					return *folders, nil
+				},
+				AddF: func(ctx context.Context, f *chronograf.Folder) (*chronograf.Folder, error) {
+					f.ID = "100"
+					*folders = append(*folders, *f)
+					return f, nil
+				},
+				GetF: func(ctx context.Context, id string) (*chronograf.Folder, error) {
+					for _, f := range *folders {
+						if f.ID == id {
+							return &f, nil
+						}
+					}
+					return nil, chronograf.ErrFolderNotFound
+				},
+				UpdateF: func(ctx context.Context, f *chronograf.Folder) error {
+					for i := range *folders {
+						if (*folders)[i].ID == f.ID {
+							(*folders)[i] = *f
+						}
+					}
+					return nil
+				},
+				DeleteF: func(ctx context.Context, f *chronograf.Folder) error {
+					fs := (*folders)[:0]
+					for _, cur := range *folders {
+						if cur.ID != f.ID {
+							fs = append(fs, cur)
+						}
+					}
+					*folders = fs
+					return nil
+				},
+			},
+			DashboardsStore: &mocks.DashboardsStore{
+				AllF: func(ctx context.Context) ([]chronograf.Dashboard, error) {
+					return dashboards, nil
+				},
+			},
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+}
+
+func TestService_NewFolder(t *testing.T) {
+	tests := []struct {
+		name       string
+		role       string
+		body       string
+		wantStatus int
+	}{
+		{
+			name:       "Create a folder",
+			role:       roles.EditorRoleName,
+			body:       `{"name":"Replication","parent":"1","role":"editor"}`,
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "Missing name",
+			role:       roles.EditorRoleName,
+			body:       `{"parent":"1"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Role exceeds the role of the user",
+			role:       roles.EditorRoleName,
+			body:       `{"name":"Billing","role":"admin"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Unknown parent",
+			role:       roles.AdminRoleName,
+			body:       `{"name":"Billing","parent":"42"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			folders := []chronograf.Folder{{ID: "1", Name: "Operations"}}
+			s := newFoldersService(&folders, nil)
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url/chronograf/v1/folders", bytes.NewBufferString(tt.body))
+			r = r.WithContext(context.WithValue(r.Context(), roles.ContextKey, tt.role))
+
+			s.NewFolder(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("%q. NewFolder() = %v, want %v: %s", tt.name, resp.StatusCode, tt.wantStatus, body)
+			}
+			if tt.wantStatus == http.StatusCreated && resp.Header.Get("Location") != "/chronograf/v1/folders/100" {
+				t.Errorf("%q. NewFolder() Location = %s", tt.name, resp.Header.Get("Location"))
+			}
+		})
+	}
+}
+
+func TestService_UpdateFolder(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		body       string
+		wantStatus int
+		wantParent string
+	}{
+		{
+			name:       "Move a folder",
+			id:         "3",
+			body:       `{"parent":"1"}`,
+			wantStatus: http.StatusOK,
+			wantParent: "1",
+		},
+		{
+			name:       "Move a folder into its own child",
+			id:         "1",
+			body:       `{"parent":"2"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "No fields",
+			id:         "3",
+			body:       `{}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantParent: "2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			folders := []chronograf.Folder{
+				{ID: "1", Name: "Operations"},
+				{ID: "2", Name: "Databases", Parent: "1"},
+				{ID: "3", Name: "Replication", Parent: "2"},
+			}
+			s := newFoldersService(&folders, nil)
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("PATCH", "http://any.url/chronograf/v1/folders/"+tt.id, bytes.NewBufferString(tt.body))
+			r = r.WithContext(httprouter.WithParams(r.Context(), httprouter.Params{{Key: "id", Value: tt.id}}))
+
+			s.UpdateFolder(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("%q. UpdateFolder() = %v, want %v: %s", tt.name, resp.StatusCode, tt.wantStatus, body)
+			}
+			if tt.wantParent != "" && folders[2].Parent != tt.wantParent {
+				t.Errorf("%q. UpdateFolder() stored parent %s, want %s", tt.name, folders[2].Parent, tt.wantParent)
+			}
+		})
+	}
+}
+
+func TestService_RemoveFolder(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          string
+		wantStatus  int
+		wantFolders int
+	}{
+		{
+			name:        "Remove an empty folder",
+			id:          "3",
+			wantStatus:  http.StatusNoContent,
+			wantFolders: 2,
+		},
+		{
+			name:        "Folder holding a folder",
+			id:          "1",
+			wantStatus:  http.StatusConflict,
+			wantFolders: 3,
+		},
+		{
+			name:        "Folder holding a dashboard",
+			id:          "2",
+			wantStatus:  http.StatusConflict,
+			wantFolders: 3,
+		},
+		{
+			name:        "Unknown folder",
+			id:          "42",
+			wantStatus:  http.StatusNotFound,
+			wantFolders: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			folders := []chronograf.Folder{
+				{ID: "1", Name: "Operations"},
+				{ID: "2", Name: "Databases", Parent: "1"},
+				{ID: "3", Name: "Billing"},
+			}
+			dashboards := []chronograf.Dashboard{{ID: 1, Name: "influxdb", Folder: "2"}}
+			s := newFoldersService(&folders, dashboards)
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("DELETE", "http://any.url/chronograf/v1/folders/"+tt.id, nil)
+			r = r.WithContext(httprouter.WithParams(r.Context(), httprouter.Params{{Key: "id", Value: tt.id}}))
+
+			s.RemoveFolder(w, r)
+
+			if resp := w.Result(); resp.StatusCode != tt.wantStatus {
+				t.Errorf("%q. RemoveFolder() = %v, want %v", tt.name, resp.StatusCode, tt.wantStatus)
+			}
+			if len(folders) != tt.wantFolders {
+				t.Errorf("%q. RemoveFolder() left %d folders, want %d", tt.name, len(folders), tt.wantFolders)
+			}
+		})
+	}
+}
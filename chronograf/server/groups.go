@@ -0,0 +1,370 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/bouk/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// effectiveRoles returns the roles of u together with the roles of the
+// groups u is a member of, keeping the most privileged role within each
+// organization. The roles are nil if u has no roles and is in no groups with
+// roles. ctx must be a server context.
+func effectiveRoles(ctx context.Context, store DataStore, u *chronograf.User) ([]chronograf.Role, error) {
+	groups, err := store.Groups(ctx).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Copy the roles of u, keeping them nil when u has none
+	rs := u.Roles
+	if rs != nil {
+		rs = append([]chronograf.Role{}, rs...)
+	}
+	for _, g := range groups {
+		if !isGroupMember(g, u.ID) {
+			continue
+		}
+	RolesLoop:
+		for _, role := range g.Roles {
+			for i := range rs {
+				if rs[i].Organization == role.Organization {
+					if roleRank(role.Name) > roleRank(rs[i].Name) {
+						rs[i].Name = role.Name
+					}
+					continue RolesLoop
+				}
+			}
+			rs = append(rs, role)
+		}
+	}
+	return rs, nil
+}
+
+// isGroupMember is true if the user with id is a member of g
+func isGroupMember(g chronograf.Group, id uint64) bool {
+	for _, member := range g.Users {
+		if member == id {
+			return true
+		}
+	}
+	return false
+}
+
+// rolesIn returns the roles of rs within the organization orgID
+func rolesIn(rs []chronograf.Role, orgID string) []chronograf.Role {
+	in := []chronograf.Role{}
+	for _, role := range rs {
+		if role.Organization == orgID {
+			in = append(in, role)
+		}
+	}
+	return in
+}
+
+type groupRequest struct {
+	Name  string            `json:"name"`
+	Users []string          `json:"users"` // Users are the IDs of the members of the group
+	Roles []chronograf.Role `json:"roles"` // Roles are the roles of the members, at most one per organization
+}
+
+// Valid checks the name and the roles of a group and returns the IDs of its
+// members
+func (r *groupRequest) Valid() ([]uint64, error) {
+	if r.Name == "" {
+		return nil, errorf("name required on Chronograf Group request body")
+	}
+
+	roles := userRequest{Roles: r.Roles}
+	if err := roles.ValidRoles(); err != nil {
+		return nil, err
+	}
+
+	ids := []uint64{}
+	seen := map[uint64]bool{}
+	for _, idStr := range r.Users {
+		id, err := strconv.ParseUint(idStr, 10, 64)
+		if err != nil {
+			return nil, errorf("invalid user id %s", idStr)
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+type groupResponse struct {
+	Links selfLinks         `json:"links"`
+	ID    string            `json:"id"`
+	Name  string            `json:"name"`
+	Users []string          `json:"users"`
+	Roles []chronograf.Role `json:"roles"`
+}
+
+func newGroupResponse(g *chronograf.Group) *groupResponse {
+	users := make([]string, len(g.Users))
+	for i, id := range g.Users {
+		users[i] = strconv.FormatUint(id, 10)
+	}
+	roles := g.Roles
+	if roles == nil {
+		roles = []chronograf.Role{}
+	}
+	return &groupResponse{
+		Links: selfLinks{
+			Self: fmt.Sprintf("/chronograf/v1/groups/%s", g.ID),
+		},
+		ID:    g.ID,
+		Name:  g.Name,
+		Users: users,
+		Roles: roles,
+	}
+}
+
+type groupsResponse struct {
+	Links  selfLinks        `json:"links"`
+	Groups []*groupResponse `json:"groups"`
+}
+
+func newGroupsResponse(gs []chronograf.Group) *groupsResponse {
+	sort.Slice(gs, func(i, j int) bool {
+		return gs[i].Name < gs[j].Name
+	})
+	groups := make([]*groupResponse, len(gs))
+	for i := range gs {
+		groups[i] = newGroupResponse(&gs[i])
+	}
+	return &groupsResponse{
+		Links: selfLinks{
+			Self: "/chronograf/v1/groups",
+		},
+		Groups: groups,
+	}
+}
+
+// validGroup checks that the members and the organizations of the roles of
+// g exist and that no other group has its name. Wildcard roles become the
+// default role of their organization.
+func (s *Service) validGroup(ctx context.Context, g *chronograf.Group) (int, error) {
+	for i := range g.Users {
+		if _, err := s.Store.Users(ctx).Get(ctx, chronograf.UserQuery{ID: &g.Users[i]}); err != nil {
+			return http.StatusUnprocessableEntity, errorf("user %d does not exist", g.Users[i])
+		}
+	}
+	if err := s.validRoles(serverContext(ctx), g.Roles); err != nil {
+		return http.StatusUnprocessableEntity, err
+	}
+
+	groups, err := s.Store.Groups(ctx).All(ctx)
+	if err != nil {
+		return http.StatusInternalServerError, fmt.Errorf("failed to retrieve groups from database")
+	}
+	for _, group := range groups {
+		if group.Name == g.Name && group.ID != g.ID {
+			return http.StatusConflict, errorf("group %s already exists", g.Name)
+		}
+	}
+	return 0, nil
+}
+
+// Groups retrieves all groups of users
+func (s *Service) Groups(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	groups, err := s.Store.Groups(ctx).All(ctx)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to retrieve groups from database", s.Logger)
+		return
+	}
+
+	encodeJSON(w, http.StatusOK, newGroupsResponse(groups), s.Logger)
+}
+
+// GroupID retrieves a group of users by ID
+func (s *Service) GroupID(w http.ResponseWriter, r *http.Request) {
+	g, ok := s.group(w, r)
+	if !ok {
+		return
+	}
+
+	res := newGroupResponse(g)
+	location(w, res.Links.Self)
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// NewGroup creates a group of users, granting its roles to its members
+func (s *Service) NewGroup(w http.ResponseWriter, r *http.Request) {
+	var req groupRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+
+	ids, err := req.Valid()
+	if err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	g := &chronograf.Group{
+		Name:  req.Name,
+		Users: ids,
+		Roles: req.Roles,
+	}
+	if code, err := s.validGroup(ctx, g); err != nil {
+		Error(w, code, err.Error(), s.Logger)
+		return
+	}
+
+	g, err = s.Store.Groups(ctx).Add(ctx, g)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to add group to database", s.Logger)
+		return
+	}
+
+	res := newGroupResponse(g)
+	location(w, res.Links.Self)
+	encodeJSON(w, http.StatusCreated, res, s.Logger)
+}
+
+// UpdateGroup replaces the name, the members and the roles of a group
+func (s *Service) UpdateGroup(w http.ResponseWriter, r *http.Request) {
+	var req groupRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+
+	ids, err := req.Valid()
+	if err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	g, ok := s.group(w, r)
+	if !ok {
+		return
+	}
+	g.Name = req.Name
+	g.Users = ids
+	g.Roles = req.Roles
+	if code, err := s.validGroup(ctx, g); err != nil {
+		Error(w, code, err.Error(), s.Logger)
+		return
+	}
+
+	if err := s.Store.Groups(ctx).Update(ctx, g); err != nil {
+		Error(w, http.StatusInternalServerError, "failed to update group in database", s.Logger)
+		return
+	}
+
+	res := newGroupResponse(g)
+	location(w, res.Links.Self)
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// RemoveGroup deletes a group; its members keep only their own roles
+func (s *Service) RemoveGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	g, ok := s.group(w, r)
+	if !ok {
+		return
+	}
+
+	if err := s.Store.Groups(ctx).Delete(ctx, g); err != nil {
+		Error(w, http.StatusInternalServerError, "failed to remove group from database", s.Logger)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AddGroupUser adds the user of the uid parameter to a group
+func (s *Service) AddGroupUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	g, ok := s.group(w, r)
+	if !ok {
+		return
+	}
+
+	uid := httprouter.GetParamFromContext(ctx, "uid")
+	id, err := strconv.ParseUint(uid, 10, 64)
+	if err != nil {
+		Error(w, http.StatusBadRequest, fmt.Sprintf("invalid user id: %s", err.Error()), s.Logger)
+		return
+	}
+	if _, err := s.Store.Users(ctx).Get(ctx, chronograf.UserQuery{ID: &id}); err != nil {
+		Error(w, http.StatusNotFound, err.Error(), s.Logger)
+		return
+	}
+
+	if !isGroupMember(*g, id) {
+		g.Users = append(g.Users, id)
+		if err := s.Store.Groups(ctx).Update(ctx, g); err != nil {
+			Error(w, http.StatusInternalServerError, "failed to update group in database", s.Logger)
+			return
+		}
+	}
+
+	res := newGroupResponse(g)
+	location(w, res.Links.Self)
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// RemoveGroupUser removes the user of the uid parameter from a group
+func (s *Service) RemoveGroupUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	g, ok := s.group(w, r)
+	if !ok {
+		return
+	}
+
+	uid := httprouter.GetParamFromContext(ctx, "uid")
+	users := []uint64{}
+	for _, id := range g.Users {
+		if strconv.FormatUint(id, 10) != uid {
+			users = append(users, id)
+		}
+	}
+	if len(users) == len(g.Users) {
+		Error(w, http.StatusNotFound, fmt.Sprintf("user %s is not a member of group %s", uid, g.Name), s.Logger)
+		return
+	}
+	g.Users = users
+
+	if err := s.Store.Groups(ctx).Update(ctx, g); err != nil {
+		Error(w, http.StatusInternalServerError, "failed to update group in database", s.Logger)
+		return
+	}
+
+	res := newGroupResponse(g)
+	location(w, res.Links.Self)
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// group retrieves the group of the id parameter, responding with an error
+// if there is none
+func (s *Service) group(w http.ResponseWriter, r *http.Request) (*chronograf.Group, bool) {
+	ctx := r.Context()
+	id := httprouter.GetParamFromContext(ctx, "id")
+	g, err := s.Store.Groups(ctx).Get(ctx, id)
+	if err == chronograf.ErrGroupNotFound {
+		Error(w, http.StatusNotFound, err.Error(), s.Logger)
+		return nil, false
+	}
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to retrieve group from database", s.Logger)
+		return nil, false
+	}
+	return g, true
+}
@@ -0,0 +1,270 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/bouk/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+// groupsStore is a GroupsStore of groups
+func groupsStore(groups ...chronograf.Group) *mocks.GroupsStore {
+	return &mocks.GroupsStore{
+		AllF: func(ctx context.Context) ([]chronograf.Group, error) {
+			return append([]chronograf.Group{}, groups...), nil
+		},
+	}
+}
+
+func TestEffectiveRoles(t *testing.T) {
+	store := &mocks.Store{
+		GroupsStore: groupsStore(
+			chronograf.Group{
+				ID:    "1",
+				Name:  "on-call",
+				Users: []uint64{1, 2},
+				Roles: []chronograf.Role{
+					{Name: "editor", Organization: "1"},
+					{Name: "member", Organization: "2"},
+				},
+			},
+			chronograf.Group{
+				ID:    "2",
+				Name:  "auditors",
+				Users: []uint64{1},
+				Roles: []chronograf.Role{
+					{Name: "viewer", Organization: "3"},
+					{Name: "viewer", Organization: "1"},
+				},
+			},
+			chronograf.Group{
+				ID:    "3",
+				Name:  "admins",
+				Users: []uint64{3},
+				Roles: []chronograf.Role{{Name: "admin", Organization: "1"}},
+			},
+		),
+	}
+	u := &chronograf.User{
+		ID: 1,
+		Roles: []chronograf.Role{
+			{Name: "viewer", Organization: "1"},
+			{Name: "admin", Organization: "2"},
+		},
+	}
+
+	got, err := effectiveRoles(context.Background(), store, u)
+	if err != nil {
+		t.Fatalf("effectiveRoles() error = %v", err)
+	}
+	want := []chronograf.Role{
+		{Name: "editor", Organization: "1"},
+		{Name: "admin", Organization: "2"},
+		{Name: "viewer", Organization: "3"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("effectiveRoles() = %v, want %v", got, want)
+	}
+	if u.Roles[0].Name != "viewer" {
+		t.Errorf("effectiveRoles() changed the roles of the user to %v", u.Roles)
+	}
+
+	got, err = effectiveRoles(context.Background(), store, &chronograf.User{ID: 4})
+	if err != nil {
+		t.Fatalf("effectiveRoles() error = %v", err)
+	}
+	if got != nil {
+		t.Errorf("effectiveRoles() of a user without roles nor groups = %#v, want nil", got)
+	}
+}
+
+func TestService_NewGroup(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "New group",
+			body:       `{"name":"on-call","users":["3","3"],"roles":[{"name":"*","organization":"1"}]}`,
+			wantStatus: http.StatusCreated,
+			wantBody:   `{"links":{"self":"/chronograf/v1/groups/2"},"id":"2","name":"on-call","users":["3"],"roles":[{"name":"viewer","organization":"1"}]}`,
+		},
+		{
+			name:       "No name",
+			body:       `{"users":["3"]}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Two roles in an organization",
+			body:       `{"name":"on-call","roles":[{"name":"viewer","organization":"1"},{"name":"editor","organization":"1"}]}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Unknown user",
+			body:       `{"name":"on-call","users":["4"]}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Unknown organization",
+			body:       `{"name":"on-call","roles":[{"name":"viewer","organization":"2"}]}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Name of another group",
+			body:       `{"name":"sre"}`,
+			wantStatus: http.StatusConflict,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groups := groupsStore(chronograf.Group{ID: "1", Name: "sre"})
+			groups.AddF = func(ctx context.Context, g *chronograf.Group) (*chronograf.Group, error) {
+				g.ID = "2"
+				return g, nil
+			}
+			s := &Service{
+				Store: &mocks.Store{
+					GroupsStore: groups,
+					UsersStore: &mocks.UsersStore{
+						GetF: func(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+							if *q.ID != 3 {
+								return nil, chronograf.ErrUserNotFound
+							}
+							return &chronograf.User{ID: 3, Name: "billysteve"}, nil
+						},
+					},
+					OrganizationsStore: &mocks.OrganizationsStore{
+						GetF: func(ctx context.Context, q chronograf.OrganizationQuery) (*chronograf.Organization, error) {
+							if *q.ID != "1" {
+								return nil, chronograf.ErrOrganizationNotFound
+							}
+							return &chronograf.Organization{ID: "1", DefaultRole: "viewer"}, nil
+						},
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url", bytes.NewBufferString(tt.body))
+			s.NewGroup(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("%q. NewGroup() = %v, want %v: %s", tt.name, resp.StatusCode, tt.wantStatus, body)
+			}
+			if eq, _ := jsonEqual(string(body), tt.wantBody); tt.wantBody != "" && !eq {
+				t.Errorf("%q. NewGroup() = \n***%v***\n,\nwant\n***%v***", tt.name, string(body), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestService_GroupUsers(t *testing.T) {
+	var updated *chronograf.Group
+	groups := groupsStore()
+	groups.GetF = func(ctx context.Context, id string) (*chronograf.Group, error) {
+		if id != "1" {
+			return nil, chronograf.ErrGroupNotFound
+		}
+		return &chronograf.Group{ID: "1", Name: "on-call", Users: []uint64{2}}, nil
+	}
+	groups.UpdateF = func(ctx context.Context, g *chronograf.Group) error {
+		updated = g
+		return nil
+	}
+	s := &Service{
+		Store: &mocks.Store{
+			GroupsStore: groups,
+			UsersStore: &mocks.UsersStore{
+				GetF: func(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+					if *q.ID > 3 {
+						return nil, chronograf.ErrUserNotFound
+					}
+					return &chronograf.User{ID: *q.ID}, nil
+				},
+			},
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+	tests := []struct {
+		name       string
+		handler    http.HandlerFunc
+		id, uid    string
+		wantStatus int
+		wantUsers  []uint64
+	}{
+		{
+			name:       "Add a user",
+			handler:    s.AddGroupUser,
+			id:         "1",
+			uid:        "3",
+			wantStatus: http.StatusOK,
+			wantUsers:  []uint64{2, 3},
+		},
+		{
+			name:       "Add an unknown user",
+			handler:    s.AddGroupUser,
+			id:         "1",
+			uid:        "4",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "Add a user to an unknown group",
+			handler:    s.AddGroupUser,
+			id:         "2",
+			uid:        "3",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "Remove a member",
+			handler:    s.RemoveGroupUser,
+			id:         "1",
+			uid:        "2",
+			wantStatus: http.StatusOK,
+			wantUsers:  []uint64{},
+		},
+		{
+			name:       "Remove a user who is not a member",
+			handler:    s.RemoveGroupUser,
+			id:         "1",
+			uid:        "3",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated = nil
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("PUT", "http://any.url", nil)
+			r = r.WithContext(httprouter.WithParams(r.Context(), httprouter.Params{
+				{Key: "id", Value: tt.id},
+				{Key: "uid", Value: tt.uid},
+			}))
+			tt.handler(w, r)
+
+			if got := w.Result().StatusCode; got != tt.wantStatus {
+				t.Fatalf("%q. status = %v, want %v", tt.name, got, tt.wantStatus)
+			}
+			if tt.wantUsers == nil {
+				if updated != nil {
+					t.Errorf("%q. updated the group to %v", tt.name, updated)
+				}
+				return
+			}
+			if updated == nil || !reflect.DeepEqual(updated.Users, tt.wantUsers) {
+				t.Errorf("%q. updated the group to %v, want users %v", tt.name, updated, tt.wantUsers)
+			}
+		})
+	}
+}
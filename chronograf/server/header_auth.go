@@ -0,0 +1,204 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/oauth2"
+)
+
+const (
+	// HeaderScheme is the scheme of users authenticated by an
+	// authenticating reverse proxy
+	HeaderScheme = "header"
+	// HeaderProvider is the provider of users of the header scheme and the
+	// issuer of their principals
+	HeaderProvider = "proxy"
+)
+
+// HeaderAuth trusts the user and the groups named by the headers of the
+// requests of an authenticating reverse proxy, such as oauth2-proxy or
+// Pomerium, sitting in front of Chronograf.
+type HeaderAuth struct {
+	UserHeader     string       // UserHeader names the user authenticated by the proxy, e.g. X-Auth-Request-User
+	GroupsHeader   string       // GroupsHeader lists the comma-separated groups of the user; groups are not mapped when empty
+	TrustedProxies []*net.IPNet // TrustedProxies are the networks of the proxies allowed to set the headers
+	LogoutURL      string       // LogoutURL ends the session of the proxy; logging out reloads Chronograf when empty
+}
+
+// trusted is true when a request was sent by a trusted proxy
+func (h *HeaderAuth) trusted(r *http.Request) bool {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	for _, n := range h.TrustedProxies {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// groups returns the groups of the groups header, which proxies send either
+// as a single comma-separated header or as several headers
+func (h *HeaderAuth) groups(r *http.Request) []string {
+	if h.GroupsHeader == "" {
+		return nil
+	}
+	var groups []string
+	for _, v := range r.Header[http.CanonicalHeaderKey(h.GroupsHeader)] {
+		for _, group := range strings.Split(v, ",") {
+			if group = strings.TrimSpace(group); group != "" {
+				groups = append(groups, group)
+			}
+		}
+	}
+	return groups
+}
+
+type headerAuthContextKey string
+
+// HeaderAuthContextKey is the context key telling that the request was
+// authenticated by the headers of a trusted proxy
+const HeaderAuthContextKey = headerAuthContextKey("header_auth")
+
+// hasHeaderAuthContext is true when the request was authenticated by the
+// headers of a trusted proxy
+func hasHeaderAuthContext(ctx context.Context) bool {
+	// prevents panic in case of nil context
+	if ctx == nil {
+		return false
+	}
+	ok, _ := ctx.Value(HeaderAuthContextKey).(bool)
+	return ok
+}
+
+// homeOrganization is the organization of the requests of a user whose
+// principal has no organization: the default organization when the user is
+// a SuperAdmin or has a role in it, and the organization of its first role
+// otherwise.
+func homeOrganization(u *chronograf.User, defaultOrg *chronograf.Organization) string {
+	if u.SuperAdmin || len(u.Roles) == 0 {
+		return defaultOrg.ID
+	}
+	for _, role := range u.Roles {
+		if role.Organization == defaultOrg.ID {
+			return defaultOrg.ID
+		}
+	}
+	return u.Roles[0].Organization
+}
+
+// AuthorizedProxyHeaders authenticates the requests of a trusted proxy
+// naming a user in its user header as that user. The user is created on its
+// first request with the roles of the mappings of its groups, as on the
+// first login of other users. The principal of the user is sent to the next
+// handler via the request's context, so that AuthorizedUser authorizes the
+// user as if they had logged in. The current organization of the user is
+// kept in the session cookie set by UpdateMe. Requests without the user
+// header are passed on unchanged. On a user header of an untrusted client,
+// or of a suspended user, will return http.StatusForbidden.
+func (s *Service) AuthorizedProxyHeaders(h *HeaderAuth, auth oauth2.Authenticator, next http.Handler) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimSpace(r.Header.Get(h.UserHeader))
+		if name == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		log := s.Logger.
+			WithField("component", "header_auth").
+			WithField("remote_addr", r.RemoteAddr).
+			WithField("method", r.Method).
+			WithField("url", r.URL)
+
+		if !h.trusted(r) {
+			log.Error(fmt.Sprintf("Header %s sent by an untrusted client", h.UserHeader))
+			Error(w, http.StatusForbidden, "Client is not a trusted proxy", s.Logger)
+			return
+		}
+
+		p := oauth2.Principal{
+			Subject: name,
+			Issuer:  HeaderProvider,
+			Group:   strings.Join(h.groups(r), ","),
+		}
+		// The session cookie of the user keeps the organization they switched to
+		if session, err := auth.Validate(r.Context(), r); err == nil && session.Subject == p.Subject && session.Issuer == p.Issuer {
+			p.Organization = session.Organization
+		}
+
+		ctx := r.Context()
+		serverCtx := serverContext(ctx)
+		defaultOrg, err := s.Store.Organizations(serverCtx).DefaultOrganization(serverCtx)
+		if err != nil {
+			unknownErrorWithMessage(w, err, s.Logger)
+			return
+		}
+
+		provider, scheme := HeaderProvider, HeaderScheme
+		u, err := s.Store.Users(serverCtx).Get(serverCtx, chronograf.UserQuery{
+			Name:     &p.Subject,
+			Provider: &provider,
+			Scheme:   &scheme,
+		})
+		if err == chronograf.ErrUserNotFound {
+			u, err = s.provisionUser(serverCtx, p, scheme, defaultOrg)
+			if err == errPrivateChronograf {
+				log.Error(fmt.Sprintf("No role is granted to new user %s", p.Subject))
+				Error(w, http.StatusForbidden, err.Error(), s.Logger)
+				return
+			}
+		}
+		if err != nil {
+			unknownErrorWithMessage(w, err, s.Logger)
+			return
+		}
+		if u.Suspended() {
+			log.Error(fmt.Sprintf("User %s is suspended", p.Subject))
+			Error(w, http.StatusForbidden, chronograf.ErrUserSuspended.Error(), s.Logger)
+			return
+		}
+		if p.Organization == "" {
+			p.Organization = homeOrganization(u, defaultOrg)
+		}
+
+		ctx = context.WithValue(ctx, oauth2.PrincipalKey, p)
+		ctx = context.WithValue(ctx, HeaderAuthContextKey, true)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// parseTrustedProxies parses the CIDRs or IPs of the trusted proxies
+func parseTrustedProxies(proxies []string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(proxies))
+	for _, proxy := range proxies {
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q", proxy)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, n, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q", proxy)
+		}
+		nets = append(nets, n)
+	}
+	return nets, nil
+}
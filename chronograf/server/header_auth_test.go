@@ -0,0 +1,223 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+	"github.com/influxdata/influxdb/chronograf/oauth2"
+	"github.com/influxdata/influxdb/chronograf/roles"
+)
+
+func TestService_AuthorizedProxyHeaders(t *testing.T) {
+	trusted, err := parseTrustedProxies([]string{"10.0.0.0/8"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &HeaderAuth{
+		UserHeader:     "X-Auth-Request-User",
+		GroupsHeader:   "X-Auth-Request-Groups",
+		TrustedProxies: trusted,
+	}
+	tests := []struct {
+		name          string
+		remoteAddr    string
+		headers       map[string]string
+		users         map[string]*chronograf.User
+		session       *oauth2.Principal
+		wantStatus    int
+		wantPrincipal *oauth2.Principal
+		wantAdded     *chronograf.User
+	}{
+		{
+			name:       "Existing user",
+			remoteAddr: "10.0.0.2:4000",
+			headers:    map[string]string{"X-Auth-Request-User": "marty"},
+			users: map[string]*chronograf.User{
+				"marty": {ID: 1, Name: "marty", Provider: HeaderProvider, Scheme: HeaderScheme, Roles: []chronograf.Role{{Organization: "1", Name: roles.EditorRoleName}}},
+			},
+			wantStatus: http.StatusOK,
+			wantPrincipal: &oauth2.Principal{
+				Subject:      "marty",
+				Issuer:       HeaderProvider,
+				Organization: "1",
+			},
+		},
+		{
+			name:       "Organization of the session",
+			remoteAddr: "10.0.0.2:4000",
+			headers:    map[string]string{"X-Auth-Request-User": "marty"},
+			users: map[string]*chronograf.User{
+				"marty": {ID: 1, Name: "marty", Provider: HeaderProvider, Scheme: HeaderScheme, Roles: []chronograf.Role{{Organization: "0", Name: roles.ViewerRoleName}, {Organization: "1", Name: roles.EditorRoleName}}},
+			},
+			session:    &oauth2.Principal{Subject: "marty", Issuer: HeaderProvider, Organization: "1"},
+			wantStatus: http.StatusOK,
+			wantPrincipal: &oauth2.Principal{
+				Subject:      "marty",
+				Issuer:       HeaderProvider,
+				Organization: "1",
+			},
+		},
+		{
+			name:       "New user with the roles of the mappings of its groups",
+			remoteAddr: "10.0.0.2:4000",
+			headers: map[string]string{
+				"X-Auth-Request-User":   "doc",
+				"X-Auth-Request-Groups": "physicists, time-travelers",
+			},
+			wantStatus: http.StatusOK,
+			wantPrincipal: &oauth2.Principal{
+				Subject:      "doc",
+				Issuer:       HeaderProvider,
+				Organization: "1",
+				Group:        "physicists,time-travelers",
+			},
+			wantAdded: &chronograf.User{
+				Name:     "doc",
+				Provider: HeaderProvider,
+				Scheme:   HeaderScheme,
+				Roles:    []chronograf.Role{{Organization: "1", Name: roles.AdminRoleName}},
+			},
+		},
+		{
+			name:       "New user without a mapped group",
+			remoteAddr: "10.0.0.2:4000",
+			headers: map[string]string{
+				"X-Auth-Request-User":   "biff",
+				"X-Auth-Request-Groups": "bullies",
+			},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "Untrusted client",
+			remoteAddr: "192.0.2.1:4000",
+			headers:    map[string]string{"X-Auth-Request-User": "marty"},
+			users: map[string]*chronograf.User{
+				"marty": {ID: 1, Name: "marty", Provider: HeaderProvider, Scheme: HeaderScheme},
+			},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "Suspended user",
+			remoteAddr: "10.0.0.2:4000",
+			headers:    map[string]string{"X-Auth-Request-User": "marty"},
+			users: map[string]*chronograf.User{
+				"marty": {ID: 1, Name: "marty", Provider: HeaderProvider, Scheme: HeaderScheme, Status: chronograf.UserStatusSuspended},
+			},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "No user header",
+			remoteAddr: "192.0.2.1:4000",
+			wantStatus: http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var added *chronograf.User
+			s := &Service{
+				Logger: &chronograf.NoopLogger{},
+				Store: &mocks.Store{
+					ConfigStore: &mocks.ConfigStore{
+						Config: &chronograf.Config{},
+					},
+					MappingsStore: &mocks.MappingsStore{
+						AllF: func(ctx context.Context) ([]chronograf.Mapping, error) {
+							return []chronograf.Mapping{
+								{
+									Organization:         "1",
+									Provider:             HeaderProvider,
+									Scheme:               HeaderScheme,
+									ProviderOrganization: "time-travelers",
+									Role:                 roles.AdminRoleName,
+								},
+							}, nil
+						},
+					},
+					OrganizationsStore: &mocks.OrganizationsStore{
+						DefaultOrganizationF: func(ctx context.Context) (*chronograf.Organization, error) {
+							return &chronograf.Organization{ID: "0", DefaultRole: roles.ViewerRoleName}, nil
+						},
+						GetF: func(ctx context.Context, q chronograf.OrganizationQuery) (*chronograf.Organization, error) {
+							return &chronograf.Organization{ID: *q.ID, DefaultRole: roles.ViewerRoleName}, nil
+						},
+					},
+					UsersStore: &mocks.UsersStore{
+						NumF: func(ctx context.Context) (int, error) {
+							return 1, nil
+						},
+						GetF: func(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+							if *q.Provider != HeaderProvider || *q.Scheme != HeaderScheme {
+								return nil, chronograf.ErrUserNotFound
+							}
+							if u, ok := tt.users[*q.Name]; ok {
+								return u, nil
+							}
+							return nil, chronograf.ErrUserNotFound
+						},
+						AddF: func(ctx context.Context, u *chronograf.User) (*chronograf.User, error) {
+							added = u
+							return u, nil
+						},
+					},
+				},
+			}
+			auth := &mocks.Authenticator{ValidateErr: errors.New("no session")}
+			if tt.session != nil {
+				auth = &mocks.Authenticator{Principal: *tt.session}
+			}
+
+			var principal *oauth2.Principal
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if p, err := getValidPrincipal(r.Context()); err == nil {
+					principal = &p
+				}
+				if (principal != nil) != hasHeaderAuthContext(r.Context()) {
+					t.Errorf("%q. AuthorizedProxyHeaders() header auth on context = %t", tt.name, hasHeaderAuthContext(r.Context()))
+				}
+			})
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("GET", "http://any.url/chronograf/v1/dashboards", nil)
+			r.RemoteAddr = tt.remoteAddr
+			for k, v := range tt.headers {
+				r.Header.Set(k, v)
+			}
+
+			s.AuthorizedProxyHeaders(h, auth, next)(w, r)
+
+			if got := w.Result().StatusCode; got != tt.wantStatus {
+				t.Errorf("%q. AuthorizedProxyHeaders() = %v, want %v", tt.name, got, tt.wantStatus)
+			}
+			if tt.wantPrincipal == nil && principal != nil {
+				t.Errorf("%q. AuthorizedProxyHeaders() principal = %v, want none", tt.name, principal)
+			}
+			if tt.wantPrincipal != nil && !reflect.DeepEqual(principal, tt.wantPrincipal) {
+				t.Errorf("%q. AuthorizedProxyHeaders() principal = %v, want %v", tt.name, principal, tt.wantPrincipal)
+			}
+			if !reflect.DeepEqual(added, tt.wantAdded) {
+				t.Errorf("%q. AuthorizedProxyHeaders() added user = %v, want %v", tt.name, added, tt.wantAdded)
+			}
+		})
+	}
+}
+
+func Test_parseTrustedProxies(t *testing.T) {
+	nets, err := parseTrustedProxies([]string{"10.0.0.0/8", "192.0.2.1", "::1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"10.0.0.0/8", "192.0.2.1/32", "::1/128"}
+	for i, n := range nets {
+		if n.String() != want[i] {
+			t.Errorf("parseTrustedProxies()[%d] = %s, want %s", i, n, want[i])
+		}
+	}
+	if _, err := parseTrustedProxies([]string{"proxy.local"}); err == nil {
+		t.Error("parseTrustedProxies() of a hostname succeeded")
+	}
+}
@@ -0,0 +1,384 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/influx"
+	"github.com/influxdata/influxdb/kit/tracing"
+)
+
+const (
+	// healthCheckTimeout bounds each health check of a source or Kapacitor
+	healthCheckTimeout = 10 * time.Second
+	// healthDegradedLatency is the latency beyond which a source or
+	// Kapacitor responding to its health check is degraded
+	healthDegradedLatency = 2 * time.Second
+	// healthHistory is the number of past checks returned for a source
+	healthHistory = 100
+	// kapacitorPingPath is the ping endpoint of the Kapacitor API
+	kapacitorPingPath = "/kapacitor/v1/ping"
+)
+
+type healthCheck struct {
+	ServerID  int        `json:"serverID,omitempty"` // ServerID is the ID of the Kapacitor checked; 0 when the source itself was checked
+	Status    string     `json:"status"`
+	Version   string     `json:"version,omitempty"`
+	Build     string     `json:"build,omitempty"`
+	Latency   int64      `json:"latency"` // Latency is the duration of the check in milliseconds
+	Error     string     `json:"error,omitempty"`
+	CheckedAt *time.Time `json:"checkedAt,omitempty"`
+}
+
+func newHealthCheck(h chronograf.SourceHealth) healthCheck {
+	checkedAt := h.CheckedAt
+	return healthCheck{
+		Status:    h.Status,
+		Version:   h.Version,
+		Build:     h.Build,
+		Latency:   int64(h.Latency / time.Millisecond),
+		Error:     h.Error,
+		CheckedAt: &checkedAt,
+	}
+}
+
+type kapacitorHealth struct {
+	ID   int    `json:"id,string"`
+	Name string `json:"name"`
+	healthCheck
+	Links selfLinks `json:"links"`
+}
+
+type sourceHealthLinks struct {
+	Self   string `json:"self"`   // Self is the link to the health of the source
+	Source string `json:"source"` // Source is the link to the source
+}
+
+type sourceHealthResponse struct {
+	ID         int               `json:"id,string"`
+	Name       string            `json:"name"`
+	Status     string            `json:"status"` // Status is the status of the source, degraded when one of its Kapacitors is not up
+	Source     healthCheck       `json:"source"`
+	Kapacitors []kapacitorHealth `json:"kapacitors"`
+	Alerting   string            `json:"alerting,omitempty"` // Alerting is the combined status of the Kapacitors of the source, degraded while only some of them are up
+	History    []healthCheck     `json:"history,omitempty"`  // History lists past checks of the source and its Kapacitors, oldest first
+	Links      sourceHealthLinks `json:"links"`
+}
+
+type sourcesHealthResponse struct {
+	Sources []sourceHealthResponse `json:"sources"`
+}
+
+// newSourceHealthResponse summarizes the latest checks of src and its
+// Kapacitors among checks, which are ordered oldest first
+func newSourceHealthResponse(src chronograf.Source, kapacitors []chronograf.Server, checks []chronograf.SourceHealth) sourceHealthResponse {
+	latest := map[int]chronograf.SourceHealth{}
+	for _, h := range checks {
+		latest[h.ServerID] = h
+	}
+
+	res := sourceHealthResponse{
+		ID:         src.ID,
+		Name:       src.Name,
+		Source:     healthCheck{Status: chronograf.HealthUnknown},
+		Kapacitors: []kapacitorHealth{},
+		Links: sourceHealthLinks{
+			Self:   fmt.Sprintf("/chronograf/v1/sources/%d/health", src.ID),
+			Source: fmt.Sprintf("/chronograf/v1/sources/%d", src.ID),
+		},
+	}
+	if h, ok := latest[0]; ok {
+		res.Source = newHealthCheck(h)
+	}
+	res.Status = res.Source.Status
+	for _, srv := range kapacitors {
+		kh := kapacitorHealth{
+			ID:          srv.ID,
+			Name:        srv.Name,
+			healthCheck: healthCheck{Status: chronograf.HealthUnknown},
+			Links: selfLinks{
+				Self: fmt.Sprintf("/chronograf/v1/sources/%d/services/%d", src.ID, srv.ID),
+			},
+		}
+		if h, ok := latest[srv.ID]; ok {
+			kh.healthCheck = newHealthCheck(h)
+		}
+		if res.Status == chronograf.HealthUp && kh.Status != chronograf.HealthUp {
+			res.Status = chronograf.HealthDegraded
+		}
+		res.Kapacitors = append(res.Kapacitors, kh)
+	}
+	res.Alerting = alertingStatus(res.Kapacitors)
+	return res
+}
+
+// alertingStatus combines the status of the Kapacitors of a source. Alerts
+// of rules replicated to several Kapacitors are sent while any of them is
+// up, so alerting is only down once none of them is.
+func alertingStatus(kapacitors []kapacitorHealth) string {
+	if len(kapacitors) == 0 {
+		return ""
+	}
+	var up, working, down int
+	for _, k := range kapacitors {
+		switch k.Status {
+		case chronograf.HealthUp:
+			up++
+			working++
+		case chronograf.HealthDegraded:
+			working++
+		case chronograf.HealthDown:
+			down++
+		}
+	}
+	switch {
+	case up == len(kapacitors):
+		return chronograf.HealthUp
+	case working > 0:
+		return chronograf.HealthDegraded
+	case down > 0:
+		return chronograf.HealthDown
+	default:
+		return chronograf.HealthUnknown
+	}
+}
+
+// sourceKapacitors returns the Kapacitors of the source srcID among servers
+func sourceKapacitors(servers []chronograf.Server, srcID int) []chronograf.Server {
+	kapacitors := []chronograf.Server{}
+	for _, srv := range servers {
+		if srv.SrcID == srcID && isKapacitor(srv) {
+			kapacitors = append(kapacitors, srv)
+		}
+	}
+	return kapacitors
+}
+
+// healthStatus is the status of a check lasting latency and failing with err
+func healthStatus(latency time.Duration, err error) string {
+	if err != nil {
+		return chronograf.HealthDown
+	}
+	if latency > healthDegradedLatency {
+		return chronograf.HealthDegraded
+	}
+	return chronograf.HealthUp
+}
+
+// sourceStatus connects to a source able to report its version and type.
+// Sources whose client cannot, such as those of InfluxDB Enterprise, are
+// pinged through their URL.
+func (s *Service) sourceStatus(ctx context.Context, src chronograf.Source) (chronograf.TSDBStatus, error) {
+	ts, err := s.TimeSeries(src)
+	if err != nil {
+		return nil, err
+	}
+	if status, ok := ts.(chronograf.TSDBStatus); ok {
+		return status, status.Connect(ctx, &src)
+	}
+	client := &influx.Client{Logger: s.Logger}
+	return client, client.Connect(ctx, &src)
+}
+
+// checkSource pings a source, reporting its version and type
+func (s *Service) checkSource(ctx context.Context, src chronograf.Source) chronograf.SourceHealth {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	h := chronograf.SourceHealth{SourceID: src.ID}
+	start := time.Now()
+	status, err := s.sourceStatus(ctx, src)
+	if err == nil {
+		h.Version, err = status.Version(ctx)
+	}
+	h.Latency = time.Since(start)
+	if err == nil {
+		h.Build, err = status.Type(ctx)
+	}
+	h.Status = healthStatus(h.Latency, err)
+	if err != nil {
+		h.Error = err.Error()
+	}
+	return h
+}
+
+// checkKapacitor pings a Kapacitor, reporting its version
+func checkKapacitor(ctx context.Context, srv chronograf.Server) chronograf.SourceHealth {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	h := chronograf.SourceHealth{
+		SourceID: srv.SrcID,
+		ServerID: srv.ID,
+		Build:    "kapacitor",
+	}
+	start := time.Now()
+	version, err := pingKapacitor(ctx, srv)
+	h.Latency = time.Since(start)
+	h.Version = version
+	h.Status = healthStatus(h.Latency, err)
+	if err != nil {
+		h.Error = err.Error()
+	}
+	return h
+}
+
+// pingKapacitor requests the ping endpoint of a Kapacitor, returning its
+// version
+func pingKapacitor(ctx context.Context, srv chronograf.Server) (string, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	client := &http.Client{}
+	if srv.InsecureSkipVerify {
+		client.Transport = &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		}
+	}
+
+	req, err := http.NewRequest("GET", singleJoiningSlash(srv.URL, kapacitorPingPath), nil)
+	if err != nil {
+		return "", err
+	}
+	tracing.InjectToHTTPRequest(span, req)
+	if srv.Username != "" && srv.Password != "" {
+		req.SetBasicAuth(srv.Username, srv.Password)
+	}
+
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		return "", fmt.Errorf("received status code %d from kapacitor", resp.StatusCode)
+	}
+	return resp.Header.Get("X-Kapacitor-Version"), nil
+}
+
+// RunHealthChecks checks the health of the sources and Kapacitors of every
+// organization every interval until ctx is done
+func (s *Service) RunHealthChecks(ctx context.Context, interval time.Duration) {
+	s.runHealthChecks(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.runHealthChecks(ctx)
+		}
+	}
+}
+
+// runHealthChecks checks every source and Kapacitor concurrently and records
+// the results
+func (s *Service) runHealthChecks(ctx context.Context) {
+	serverCtx := serverContext(ctx)
+	sources, err := s.Store.Sources(serverCtx).All(serverCtx)
+	if err != nil {
+		s.Logger.Error("Unable to list sources for health checks: ", err)
+		return
+	}
+	servers, err := s.Store.Servers(serverCtx).All(serverCtx)
+	if err != nil {
+		s.Logger.Error("Unable to list kapacitors for health checks: ", err)
+		return
+	}
+
+	record := func(h chronograf.SourceHealth) {
+		if err := s.Store.SourceHealth(serverCtx).Add(serverCtx, &h); err != nil {
+			s.Logger.Error("Unable to record health of source ", h.SourceID, ": ", err)
+		}
+	}
+
+	var wg sync.WaitGroup
+	for _, src := range sources {
+		wg.Add(1)
+		go func(src chronograf.Source) {
+			defer wg.Done()
+			record(s.checkSource(ctx, src))
+		}(src)
+		for _, srv := range sourceKapacitors(servers, src.ID) {
+			wg.Add(1)
+			go func(srv chronograf.Server) {
+				defer wg.Done()
+				record(checkKapacitor(ctx, srv))
+			}(srv)
+		}
+	}
+	wg.Wait()
+}
+
+// SourceHealth returns the latest health checks of a source and its
+// Kapacitors along with the history of the checks
+func (s *Service) SourceHealth(w http.ResponseWriter, r *http.Request) {
+	id, err := paramID("id", r)
+	if err != nil {
+		Error(w, http.StatusUnprocessableEntity, err.Error(), s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	src, err := s.Store.Sources(ctx).Get(ctx, id)
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+	servers, err := s.Store.Servers(ctx).All(ctx)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "Error loading kapacitors", s.Logger)
+		return
+	}
+	checks, err := s.Store.SourceHealth(ctx).All(ctx, id)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	res := newSourceHealthResponse(src, sourceKapacitors(servers, id), checks)
+	if len(checks) > healthHistory {
+		checks = checks[len(checks)-healthHistory:]
+	}
+	res.History = make([]healthCheck, len(checks))
+	for i, h := range checks {
+		res.History[i] = newHealthCheck(h)
+		res.History[i].ServerID = h.ServerID
+	}
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// SourcesHealth returns the latest health checks of every source of the
+// current organization and of their Kapacitors
+func (s *Service) SourcesHealth(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	sources, err := s.Store.Sources(ctx).All(ctx)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "Error loading sources", s.Logger)
+		return
+	}
+	servers, err := s.Store.Servers(ctx).All(ctx)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "Error loading kapacitors", s.Logger)
+		return
+	}
+
+	res := sourcesHealthResponse{Sources: make([]sourceHealthResponse, 0, len(sources))}
+	for _, src := range sources {
+		checks, err := s.Store.SourceHealth(ctx).All(ctx, src.ID)
+		if err != nil {
+			unknownErrorWithMessage(w, err, s.Logger)
+			return
+		}
+		res.Sources = append(res.Sources, newSourceHealthResponse(src, sourceKapacitors(servers, src.ID), checks))
+	}
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
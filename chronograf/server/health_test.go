@@ -0,0 +1,239 @@
+package server
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+func TestService_runHealthChecks(t *testing.T) {
+	influxdb := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ping" {
+			t.Error("Unexpected request to", r.URL.Path)
+		}
+		w.Header().Set("X-Influxdb-Version", "1.7.8")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer influxdb.Close()
+	kapacitor := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/kapacitor/v1/ping" {
+			t.Error("Unexpected request to", r.URL.Path)
+		}
+		w.Header().Set("X-Kapacitor-Version", "1.5.3")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer kapacitor.Close()
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer down.Close()
+
+	var mu sync.Mutex
+	var got []chronograf.SourceHealth
+	s := &Service{
+		Store: &mocks.Store{
+			SourcesStore: &mocks.SourcesStore{
+				AllF: func(ctx context.Context) ([]chronograf.Source, error) {
+					if !hasServerContext(ctx) {
+						t.Error("Sources are not listed for every organization")
+					}
+					return []chronograf.Source{
+						{ID: 1, URL: influxdb.URL},
+					}, nil
+				},
+			},
+			ServersStore: &mocks.ServersStore{
+				AllF: func(ctx context.Context) ([]chronograf.Server, error) {
+					return []chronograf.Server{
+						{ID: 2, SrcID: 1, URL: kapacitor.URL},
+						{ID: 3, SrcID: 1, URL: down.URL},
+						{ID: 4, SrcID: 1, URL: down.URL, Type: "flux"},
+						{ID: 5, SrcID: 9, URL: down.URL},
+					}, nil
+				},
+			},
+			SourceHealthStore: &mocks.SourceHealthStore{
+				AddF: func(ctx context.Context, h *chronograf.SourceHealth) error {
+					mu.Lock()
+					defer mu.Unlock()
+					got = append(got, *h)
+					return nil
+				},
+			},
+		},
+		TimeSeriesClient: &mocks.TimeSeries{},
+		Logger:           &chronograf.NoopLogger{},
+	}
+	s.runHealthChecks(context.Background())
+
+	sort.Slice(got, func(i, j int) bool { return got[i].ServerID < got[j].ServerID })
+	for i := range got {
+		got[i].Latency = 0
+	}
+	want := []chronograf.SourceHealth{
+		{SourceID: 1, Status: chronograf.HealthUp, Version: "1.7.8", Build: chronograf.InfluxDB},
+		{SourceID: 1, ServerID: 2, Status: chronograf.HealthUp, Version: "1.5.3", Build: "kapacitor"},
+		{SourceID: 1, ServerID: 3, Status: chronograf.HealthDown, Build: "kapacitor", Error: "received status code 503 from kapacitor"},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("runHealthChecks() diff (-got +want):\n%s", diff)
+	}
+}
+
+func TestService_SourceHealth(t *testing.T) {
+	checkedAt := time.Date(2019, time.June, 1, 12, 0, 0, 0, time.UTC)
+	checks := []chronograf.SourceHealth{
+		{SourceID: 1, Status: chronograf.HealthDown, Error: "connection refused", Latency: time.Second, CheckedAt: checkedAt.Add(-time.Minute)},
+		{SourceID: 1, Status: chronograf.HealthUp, Version: "1.7.8", Build: "influx", Latency: 5 * time.Millisecond, CheckedAt: checkedAt},
+		{SourceID: 1, ServerID: 2, Status: chronograf.HealthDegraded, Version: "1.5.3", Build: "kapacitor", Latency: 3 * time.Second, CheckedAt: checkedAt},
+	}
+	store := func(sources []chronograf.Source) *mocks.Store {
+		return &mocks.Store{
+			SourcesStore: &mocks.SourcesStore{
+				AllF: func(ctx context.Context) ([]chronograf.Source, error) {
+					return sources, nil
+				},
+				GetF: func(ctx context.Context, id int) (chronograf.Source, error) {
+					for _, src := range sources {
+						if src.ID == id {
+							return src, nil
+						}
+					}
+					return chronograf.Source{}, chronograf.ErrSourceNotFound
+				},
+			},
+			ServersStore: &mocks.ServersStore{
+				AllF: func(ctx context.Context) ([]chronograf.Server, error) {
+					return []chronograf.Server{
+						{ID: 2, SrcID: 1, Name: "kapa"},
+						{ID: 3, SrcID: 1, Name: "new kapa"},
+					}, nil
+				},
+			},
+			SourceHealthStore: &mocks.SourceHealthStore{
+				AllF: func(ctx context.Context, id int) ([]chronograf.SourceHealth, error) {
+					if id != 1 {
+						return []chronograf.SourceHealth{}, nil
+					}
+					return checks, nil
+				},
+			},
+		}
+	}
+	sources := []chronograf.Source{
+		{ID: 1, Name: "influx"},
+		{ID: 4, Name: "unchecked"},
+	}
+
+	tests := []struct {
+		name       string
+		handler    func(*Service) http.HandlerFunc
+		url        string
+		id         string
+		wantStatus int
+		want       string
+	}{
+		{
+			name:       "Source with history",
+			handler:    func(s *Service) http.HandlerFunc { return s.SourceHealth },
+			url:        "http://any.url/chronograf/v1/sources/1/health",
+			id:         "1",
+			wantStatus: http.StatusOK,
+			want: `{"id":"1","name":"influx","status":"degraded",
+"source":{"status":"up","version":"1.7.8","build":"influx","latency":5,"checkedAt":"2019-06-01T12:00:00Z"},
+"kapacitors":[
+{"id":"2","name":"kapa","status":"degraded","version":"1.5.3","build":"kapacitor","latency":3000,"checkedAt":"2019-06-01T12:00:00Z","links":{"self":"/chronograf/v1/sources/1/services/2"}},
+{"id":"3","name":"new kapa","status":"unknown","latency":0,"links":{"self":"/chronograf/v1/sources/1/services/3"}}],
+"alerting":"degraded",
+"history":[
+{"status":"down","latency":1000,"error":"connection refused","checkedAt":"2019-06-01T11:59:00Z"},
+{"status":"up","version":"1.7.8","build":"influx","latency":5,"checkedAt":"2019-06-01T12:00:00Z"},
+{"serverID":2,"status":"degraded","version":"1.5.3","build":"kapacitor","latency":3000,"checkedAt":"2019-06-01T12:00:00Z"}],
+"links":{"self":"/chronograf/v1/sources/1/health","source":"/chronograf/v1/sources/1"}}`,
+		},
+		{
+			name:       "Unknown source",
+			handler:    func(s *Service) http.HandlerFunc { return s.SourceHealth },
+			url:        "http://any.url/chronograf/v1/sources/7/health",
+			id:         "7",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "Every source",
+			handler:    func(s *Service) http.HandlerFunc { return s.SourcesHealth },
+			url:        "http://any.url/chronograf/v1/health/sources",
+			wantStatus: http.StatusOK,
+			want: `{"sources":[
+{"id":"1","name":"influx","status":"degraded",
+"source":{"status":"up","version":"1.7.8","build":"influx","latency":5,"checkedAt":"2019-06-01T12:00:00Z"},
+"kapacitors":[
+{"id":"2","name":"kapa","status":"degraded","version":"1.5.3","build":"kapacitor","latency":3000,"checkedAt":"2019-06-01T12:00:00Z","links":{"self":"/chronograf/v1/sources/1/services/2"}},
+{"id":"3","name":"new kapa","status":"unknown","latency":0,"links":{"self":"/chronograf/v1/sources/1/services/3"}}],
+"alerting":"degraded",
+"links":{"self":"/chronograf/v1/sources/1/health","source":"/chronograf/v1/sources/1"}},
+{"id":"4","name":"unchecked","status":"unknown","source":{"status":"unknown","latency":0},"kapacitors":[],
+"links":{"self":"/chronograf/v1/sources/4/health","source":"/chronograf/v1/sources/4"}}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{
+				Store:  store(sources),
+				Logger: &chronograf.NoopLogger{},
+			}
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("GET", tt.url, nil)
+			if tt.id != "" {
+				r = withSourceID(r, tt.id)
+			}
+			tt.handler(s)(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("%s = %v, want %v: %s", tt.name, resp.StatusCode, tt.wantStatus, body)
+			}
+			if tt.want != "" {
+				if eq, _ := jsonEqual(string(body), tt.want); !eq {
+					t.Errorf("%s = %s, want %s", tt.name, body, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func Test_alertingStatus(t *testing.T) {
+	kapacitors := func(statuses ...string) []kapacitorHealth {
+		ks := []kapacitorHealth{}
+		for _, status := range statuses {
+			ks = append(ks, kapacitorHealth{healthCheck: healthCheck{Status: status}})
+		}
+		return ks
+	}
+	tests := []struct {
+		name       string
+		kapacitors []kapacitorHealth
+		want       string
+	}{
+		{"No kapacitor", kapacitors(), ""},
+		{"Every kapacitor up", kapacitors(chronograf.HealthUp, chronograf.HealthUp), chronograf.HealthUp},
+		{"One kapacitor down", kapacitors(chronograf.HealthUp, chronograf.HealthDown), chronograf.HealthDegraded},
+		{"Every kapacitor down", kapacitors(chronograf.HealthDown, chronograf.HealthUnknown), chronograf.HealthDown},
+		{"Never checked", kapacitors(chronograf.HealthUnknown), chronograf.HealthUnknown},
+	}
+	for _, tt := range tests {
+		if got := alertingStatus(tt.kapacitors); got != tt.want {
+			t.Errorf("%s: alertingStatus() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+	clog "github.com/influxdata/influxdb/chronograf/log"
+	"github.com/influxdata/influxdb/chronograf/oauth2"
+)
+
+type impersonationResponse struct {
+	User      *userResponse `json:"user"`
+	ExpiresAt time.Time     `json:"expiresAt"`
+}
+
+// ImpersonateUser replaces the session of the SuperAdmin making the request
+// with a session of the user of the id route parameter ending after
+// lifespan, so that support staff can see what the user sees. SuperAdmins
+// and suspended users cannot be impersonated. The impersonation, and every
+// request of its session, are logged by the audit component.
+func (s *Service) ImpersonateUser(auth oauth2.Authenticator, lifespan time.Duration) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		admin, ok := hasUserContext(ctx)
+		if !ok {
+			Error(w, http.StatusInternalServerError, "failed to retrieve user from context", s.Logger)
+			return
+		}
+		// Only the session cookie of a browser can be replaced
+		_, isToken := hasTokenContext(ctx)
+		_, isCertificate := hasCertificateContext(ctx)
+		if isToken || isCertificate || hasHeaderAuthContext(ctx) {
+			Error(w, http.StatusBadRequest, "impersonation requires a session of a browser", s.Logger)
+			return
+		}
+
+		u, err := s.userRoleTarget(ctx)
+		if err != nil {
+			Error(w, http.StatusNotFound, err.Error(), s.Logger)
+			return
+		}
+		if u.SuperAdmin {
+			Error(w, http.StatusForbidden, "SuperAdmins cannot be impersonated", s.Logger)
+			return
+		}
+		if u.Suspended() {
+			Error(w, http.StatusUnprocessableEntity, chronograf.ErrUserSuspended.Error(), s.Logger)
+			return
+		}
+
+		defaultOrg, err := s.Store.Organizations(ctx).DefaultOrganization(ctx)
+		if err != nil {
+			unknownErrorWithMessage(w, err, s.Logger)
+			return
+		}
+
+		end := time.Now().Add(lifespan)
+		p := oauth2.Principal{
+			Subject:                u.Name,
+			Issuer:                 u.Provider,
+			Organization:           homeOrganization(u, defaultOrg),
+			Impersonator:           strconv.FormatUint(admin.ID, 10),
+			ImpersonationExpiresAt: end,
+		}
+		if err := auth.Authorize(ctx, w, p); err != nil {
+			unknownErrorWithMessage(w, err, s.Logger)
+			return
+		}
+
+		clog.FromContext(ctx, s.Logger).
+			WithField("component", "audit").
+			WithField("remote_addr", r.RemoteAddr).
+			WithField("impersonator", admin.Name).
+			WithField("impersonator_id", admin.ID).
+			WithField("user", u.Name).
+			WithField("user_id", u.ID).
+			WithField("provider", u.Provider).
+			WithField("expires_at", end.UTC().Format(time.RFC3339)).
+			Info(fmt.Sprintf("SuperAdmin %s impersonates user %s", admin.Name, u.Name))
+
+		res := impersonationResponse{
+			User:      newUserResponse(u, ""),
+			ExpiresAt: end.UTC().Truncate(time.Second),
+		}
+		encodeJSON(w, http.StatusOK, res, s.Logger)
+	}
+}
+
+// AuditImpersonation logs every request of the sessions of SuperAdmins
+// impersonating users before passing it on to the next handler.
+func AuditImpersonation(logger chronograf.Logger, next http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if p, err := getValidPrincipal(r.Context()); err == nil && p.Impersonator != "" {
+			clog.FromContext(r.Context(), logger).
+				WithField("component", "audit").
+				WithField("remote_addr", r.RemoteAddr).
+				WithField("impersonator_id", p.Impersonator).
+				WithField("user", p.Subject).
+				WithField("provider", p.Issuer).
+				WithField("method", r.Method).
+				WithField("url", r.URL).
+				Info("Impersonated request")
+		}
+		next.ServeHTTP(w, r)
+	}
+}
@@ -0,0 +1,118 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bouk/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+	"github.com/influxdata/influxdb/chronograf/oauth2"
+	"github.com/influxdata/influxdb/chronograf/roles"
+)
+
+func TestService_ImpersonateUser(t *testing.T) {
+	users := map[uint64]*chronograf.User{
+		1: {ID: 1, Name: "billing", Provider: "github", Scheme: "oauth2", SuperAdmin: true},
+		2: {ID: 2, Name: "marty", Provider: "github", Scheme: "oauth2", Roles: []chronograf.Role{{Organization: "1", Name: roles.EditorRoleName}}},
+		3: {ID: 3, Name: "biff", Provider: "github", Scheme: "oauth2", Status: chronograf.UserStatusSuspended},
+	}
+	tests := []struct {
+		name       string
+		id         string
+		token      bool
+		wantStatus int
+		wantOrg    string
+	}{
+		{
+			name:       "Impersonate a user",
+			id:         "2",
+			wantStatus: http.StatusOK,
+			wantOrg:    "1",
+		},
+		{
+			name:       "Unknown user",
+			id:         "4",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "SuperAdmin",
+			id:         "1",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "Suspended user",
+			id:         "3",
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Request of an API token",
+			id:         "2",
+			token:      true,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{
+				Store: &mocks.Store{
+					UsersStore: &mocks.UsersStore{
+						GetF: func(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+							if u, ok := users[*q.ID]; ok {
+								return u, nil
+							}
+							return nil, chronograf.ErrUserNotFound
+						},
+					},
+					OrganizationsStore: &mocks.OrganizationsStore{
+						DefaultOrganizationF: func(ctx context.Context) (*chronograf.Organization, error) {
+							return &chronograf.Organization{ID: "0"}, nil
+						},
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+			auth := oauth2.NewCookieJWT("secret", time.Hour)
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url/chronograf/v1/impersonations/"+tt.id, nil)
+			ctx := httprouter.WithParams(r.Context(), httprouter.Params{{Key: "id", Value: tt.id}})
+			ctx = context.WithValue(ctx, UserContextKey, users[1])
+			if tt.token {
+				ctx = context.WithValue(ctx, TokenContextKey, &chronograf.Token{ID: "1"})
+			}
+			s.ImpersonateUser(auth, 15*time.Minute)(w, r.WithContext(ctx))
+
+			resp := w.Result()
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("%q. ImpersonateUser() = %v, want %v", tt.name, resp.StatusCode, tt.wantStatus)
+			}
+			if tt.wantStatus != http.StatusOK {
+				if len(resp.Cookies()) != 0 {
+					t.Errorf("%q. ImpersonateUser() set a session cookie", tt.name)
+				}
+				return
+			}
+
+			// The session cookie authenticates the impersonated user until
+			// the end of the impersonation
+			session := httptest.NewRequest("GET", "http://any.url/chronograf/v1/me", nil)
+			for _, c := range resp.Cookies() {
+				session.AddCookie(c)
+			}
+			p, err := auth.Validate(context.Background(), session)
+			if err != nil {
+				t.Fatalf("%q. ImpersonateUser() session is invalid: %v", tt.name, err)
+			}
+			if p.Subject != "marty" || p.Issuer != "github" || p.Organization != tt.wantOrg || p.Impersonator != "1" {
+				t.Errorf("%q. ImpersonateUser() principal = %+v", tt.name, p)
+			}
+			if p.ImpersonationExpiresAt.After(time.Now().Add(15*time.Minute)) || p.ExpiresAt.After(p.ImpersonationExpiresAt) {
+				t.Errorf("%q. ImpersonateUser() session expires at %v, impersonation at %v", tt.name, p.ExpiresAt, p.ImpersonationExpiresAt)
+			}
+		})
+	}
+}
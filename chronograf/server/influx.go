@@ -1,9 +1,11 @@
 package server
 
 import (
+	"bytes"
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"net/http"
 	"net/http/httputil"
@@ -11,7 +13,9 @@ import (
 	"time"
 
 	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/cache"
 	"github.com/influxdata/influxdb/chronograf/influx"
+	"github.com/influxdata/influxdb/kit/tracing"
 )
 
 // ValidInfluxRequest checks if queries specify a command.
@@ -26,7 +30,10 @@ type postInfluxResponse struct {
 	Results interface{} `json:"results"` // results from influx
 }
 
-// Influx proxies requests to influxdb.
+// Influx proxies requests to influxdb. Results are streamed as CSV or JSON
+// lines rather than returned as one JSON document when asked for by the
+// format parameter or the Accept header, and passed through as the chunked
+// response of the source when asked for by the chunked parameter.
 func (s *Service) Influx(w http.ResponseWriter, r *http.Request) {
 	id, err := paramID("id", r)
 	if err != nil {
@@ -43,6 +50,20 @@ func (s *Service) Influx(w http.ResponseWriter, r *http.Request) {
 		invalidData(w, err, s.Logger)
 		return
 	}
+	format, err := queryExportFormat(r)
+	if err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	chunked, chunkSize, err := queryChunks(r)
+	if err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	if chunked && format != "" {
+		invalidData(w, errorf("chunked responses are only sent as JSON"), s.Logger)
+		return
+	}
 
 	ctx := r.Context()
 	src, err := s.Store.Sources(ctx).Get(ctx, id)
@@ -51,21 +72,91 @@ func (s *Service) Influx(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Results are cached once the source is known to be readable, so
+	// viewers only share the results of sources they may query. Streamed
+	// and chunked results are never cached.
+	key := cache.QueryKey{
+		Source:  id,
+		DB:      req.DB,
+		RP:      req.RP,
+		Epoch:   req.Epoch,
+		Command: req.Command,
+	}
+	var ttl time.Duration
+	if format == "" && !chunked {
+		ttl = s.queryCacheTTL(ctx, r)
+	}
+	if ttl > 0 {
+		if !bypassQueryCache(r) {
+			response, ok := s.QueryCache.Get(key)
+			s.Metrics.CacheLookup(queryCacheName, ok)
+			if ok {
+				w.Header().Set(QueryCacheHeader, queryCacheHit)
+				encodeJSON(w, http.StatusOK, postInfluxResponse{Results: response}, s.Logger)
+				return
+			}
+		}
+		w.Header().Set(QueryCacheHeader, queryCacheMiss)
+	}
+
+	now := time.Now()
+	if err := s.QueryLimiter.ValidRange(req.Command, now); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	user, _ := hasUserContext(ctx)
+	release, err := s.QueryLimiter.Acquire(id, user, now)
+	if err != nil {
+		queryLimited(w, err, s.Logger)
+		return
+	}
+	defer release()
+
+	// The query is recorded in the history of the user once it has run
+	var queryErr error
+	defer func() { s.recordQuery(ctx, src, req, now, queryErr) }()
+
+	if format != "" {
+		queryErr = s.exportQuery(ctx, w, src, req, format)
+		return
+	}
+	if chunked {
+		queryErr = s.streamQuery(ctx, w, src, req, chunkSize)
+		return
+	}
+
+	if p, ok := s.Plugins.Lookup(src.Type); ok {
+		response, err := p.Query(ctx, src, req)
+		if err != nil {
+			queryErr = err
+			Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+			return
+		}
+		if ttl > 0 {
+			s.QueryCache.Set(key, response, ttl)
+		}
+		encodeJSON(w, http.StatusOK, postInfluxResponse{Results: response}, s.Logger)
+		return
+	}
+
 	ts, err := s.TimeSeries(src)
 	if err != nil {
 		msg := fmt.Sprintf("unable to connect to source %d: %v", id, err)
+		queryErr = err
 		Error(w, http.StatusBadRequest, msg, s.Logger)
 		return
 	}
 
 	if err = ts.Connect(ctx, &src); err != nil {
 		msg := fmt.Sprintf("unable to connect to source %d: %v", id, err)
+		queryErr = err
 		Error(w, http.StatusBadRequest, msg, s.Logger)
 		return
 	}
 
 	response, err := ts.Query(ctx, req)
 	if err != nil {
+		queryErr = err
 		if err == chronograf.ErrUpstreamTimeout {
 			msg := "Timeout waiting for Influx response"
 			Error(w, http.StatusRequestTimeout, msg, s.Logger)
@@ -76,12 +167,19 @@ func (s *Service) Influx(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if ttl > 0 {
+		s.QueryCache.Set(key, response, ttl)
+	}
+
 	res := postInfluxResponse{
 		Results: response,
 	}
 	encodeJSON(w, http.StatusOK, res, s.Logger)
 }
 
+// Write proxies writes of line protocol, or of points as JSON, to a source.
+// Every line is validated first; writes with invalid lines are rejected with
+// the number of each invalid line and why it is invalid.
 func (s *Service) Write(w http.ResponseWriter, r *http.Request) {
 	id, err := paramID("id", r)
 	if err != nil {
@@ -96,6 +194,18 @@ func (s *Service) Write(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Lines are validated before any of them reach the source, which would
+	// otherwise write the valid lines of a partially invalid write
+	body, err := writeBody(r)
+	if err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	if errs := invalidLines(body, r.URL.Query().Get("precision")); len(errs) > 0 {
+		invalidWrite(w, errs, s.Logger)
+		return
+	}
+
 	u, err := url.Parse(src.URL)
 	if err != nil {
 		msg := fmt.Sprintf("Error parsing source url: %v", err)
@@ -105,10 +215,21 @@ func (s *Service) Write(w http.ResponseWriter, r *http.Request) {
 	u.Path = "/write"
 	u.RawQuery = r.URL.RawQuery
 
+	// The write to the source continues the trace of the request
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+	r = r.WithContext(ctx)
+
 	director := func(req *http.Request) {
 		// Set the Host header of the original source URL
 		req.Host = u.Host
 		req.URL = u
+		tracing.InjectToHTTPRequest(span, req)
+		// The body is forwarded as the line protocol it was validated as
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		req.ContentLength = int64(len(body))
+		req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+		req.Header.Del("Content-Encoding")
 		// Because we are acting as a proxy, influxdb needs to have the
 		// basic auth or bearer token information set as a header directly
 		auth := influx.DefaultAuthorization(&src)
@@ -119,6 +240,15 @@ func (s *Service) Write(w http.ResponseWriter, r *http.Request) {
 		Director: director,
 	}
 
+	// Sources trusting custom certificate authorities or requiring a client
+	// certificate share the transport of their TLS configuration
+	transport, err := influx.Transport(&src)
+	if err != nil {
+		msg := fmt.Sprintf("Error with the TLS configuration of the source: %v", err)
+		Error(w, http.StatusUnprocessableEntity, msg, s.Logger)
+		return
+	}
+
 	// The connection to influxdb is using a self-signed certificate.
 	// This modifies uses the same values as http.DefaultTransport but specifies
 	// InsecureSkipVerify
@@ -137,6 +267,9 @@ func (s *Service) Write(w http.ResponseWriter, r *http.Request) {
 			TLSClientConfig:       &tls.Config{InsecureSkipVerify: true},
 		}
 	}
+	if transport != nil {
+		proxy.Transport = transport
+	}
 
 	proxy.ServeHTTP(w, r)
 }
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/cache"
 	"github.com/influxdata/influxdb/chronograf/mocks"
 	"github.com/influxdata/httprouter"
 )
@@ -114,3 +115,102 @@ func TestService_Influx(t *testing.T) {
 
 	}
 }
+
+func TestService_Influx_queryCache(t *testing.T) {
+	queries := 0
+	s := &Service{
+		Store: &mocks.Store{
+			SourcesStore: &mocks.SourcesStore{
+				GetF: func(ctx context.Context, ID int) (chronograf.Source, error) {
+					return chronograf.Source{ID: 1, URL: "http://any.url"}, nil
+				},
+			},
+			DashboardsStore: &mocks.DashboardsStore{
+				GetF: func(ctx context.Context, id chronograf.DashboardID) (chronograf.Dashboard, error) {
+					switch id {
+					case 1:
+						return chronograf.Dashboard{ID: 1, CacheTTL: "1m"}, nil
+					case 2:
+						return chronograf.Dashboard{ID: 2, CacheTTL: "0s"}, nil
+					}
+					return chronograf.Dashboard{}, chronograf.ErrDashboardNotFound
+				},
+			},
+		},
+		TimeSeriesClient: &mocks.TimeSeries{
+			ConnectF: func(ctx context.Context, src *chronograf.Source) error {
+				return nil
+			},
+			QueryF: func(ctx context.Context, query chronograf.Query) (chronograf.Response, error) {
+				queries++
+				return mocks.NewResponse(`{"results":[{"statement_id":0}]}`, nil), nil
+			},
+		},
+		QueryCache: cache.NewQueryCache(10),
+		Logger:     &chronograf.NoopLogger{},
+	}
+
+	tests := []struct {
+		name        string
+		dashboard   string
+		noCache     bool
+		wantQueries int
+		wantHeader  string
+	}{
+		{
+			name:        "First query of a dashboard",
+			dashboard:   "1",
+			wantQueries: 1,
+			wantHeader:  queryCacheMiss,
+		},
+		{
+			name:        "Same query of the dashboard",
+			dashboard:   "1",
+			wantQueries: 1,
+			wantHeader:  queryCacheHit,
+		},
+		{
+			name:        "Refresh bypassing the cache",
+			dashboard:   "1",
+			noCache:     true,
+			wantQueries: 2,
+			wantHeader:  queryCacheMiss,
+		},
+		{
+			name:        "Dashboard without caching",
+			dashboard:   "2",
+			wantQueries: 3,
+		},
+		{
+			name:        "Outside dashboards without a default TTL",
+			wantQueries: 4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url/chronograf/v1/sources/1/proxy?dashboard="+tt.dashboard, bytes.NewBufferString(`{"db":"telegraf","query":"SELECT mean(usage_user) FROM cpu WHERE time > now() - 1h"}`))
+			if tt.noCache {
+				r.Header.Set("Cache-Control", "no-cache")
+			}
+			r = r.WithContext(context.WithValue(r.Context(), httprouter.ParamsKey, httprouter.Params{{Key: "id", Value: "1"}}))
+
+			s.Influx(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("%q. Influx() = %v: %s", tt.name, resp.StatusCode, body)
+			}
+			if string(body) != "{\"results\":{\"results\":[{\"statement_id\":0}]}}\n" {
+				t.Errorf("%q. Influx() = %s", tt.name, body)
+			}
+			if queries != tt.wantQueries {
+				t.Errorf("%q. Influx() queried the source %d times, want %d", tt.name, queries, tt.wantQueries)
+			}
+			if got := resp.Header.Get(QueryCacheHeader); got != tt.wantHeader {
+				t.Errorf("%q. Influx() %s = %q, want %q", tt.name, QueryCacheHeader, got, tt.wantHeader)
+			}
+		})
+	}
+}
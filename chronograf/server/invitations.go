@@ -0,0 +1,375 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/bouk/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/roles"
+)
+
+type invitationLinks struct {
+	Self    string `json:"self"`    // Self link mapping to this resource
+	Accept  string `json:"accept"`  // Accept link of the invitee
+	Decline string `json:"decline"` // Decline link of the invitee
+}
+
+type invitationResponse struct {
+	Links invitationLinks `json:"links"`
+	chronograf.Invitation
+}
+
+func newInvitationResponse(i chronograf.Invitation) *invitationResponse {
+	return &invitationResponse{
+		Links: invitationLinks{
+			Self:    fmt.Sprintf("/chronograf/v1/invitations/%s", i.ID),
+			Accept:  fmt.Sprintf("/chronograf/v1/me/invitations/%s/accept", i.ID),
+			Decline: fmt.Sprintf("/chronograf/v1/me/invitations/%s/decline", i.ID),
+		},
+		Invitation: i,
+	}
+}
+
+type invitationsResponse struct {
+	Links       selfLinks             `json:"links"`
+	Invitations []*invitationResponse `json:"invitations"`
+}
+
+func newInvitationsResponse(is []chronograf.Invitation, self string) *invitationsResponse {
+	invitations := make([]*invitationResponse, len(is))
+	for i, invitation := range is {
+		invitations[i] = newInvitationResponse(invitation)
+	}
+	return &invitationsResponse{
+		Links: selfLinks{
+			Self: self,
+		},
+		Invitations: invitations,
+	}
+}
+
+type invitationRequest struct {
+	Name     string `json:"name"`
+	Provider string `json:"provider"`
+	Scheme   string `json:"scheme"`
+	Role     string `json:"role"`
+}
+
+// ValidCreate checks that the invitation request names a provider identity
+// and a known role. The wildcard role, or no role at all, stands for the
+// default role of the organization.
+func (r *invitationRequest) ValidCreate() error {
+	if r.Name == "" {
+		return errorf("name required on Chronograf Invitation request body")
+	}
+	if r.Provider == "" {
+		return errorf("provider required on Chronograf Invitation request body")
+	}
+	if r.Scheme == "" {
+		return errorf("scheme required on Chronograf Invitation request body")
+	}
+	switch r.Role {
+	case "":
+		r.Role = roles.WildcardRoleName
+	case roles.MemberRoleName, roles.ViewerRoleName, roles.EditorRoleName, roles.AdminRoleName, roles.WildcardRoleName:
+	default:
+		return errorf("unknown role %s. Valid roles are 'member', 'viewer', 'editor', 'admin', and '*'", r.Role)
+	}
+	return nil
+}
+
+// invitedTo is true if the invitation is addressed to the user identity
+func invitedTo(i chronograf.Invitation, name, provider, scheme string) bool {
+	return i.Name == name && i.Provider == provider && i.Scheme == scheme
+}
+
+// Invitations lists the pending invitations into the current organization
+func (s *Service) Invitations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	is, err := s.Store.Invitations(ctx).All(ctx)
+	if err != nil {
+		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+		return
+	}
+
+	encodeJSON(w, http.StatusOK, newInvitationsResponse(is, "/chronograf/v1/invitations"), s.Logger)
+}
+
+// InvitationID returns a single pending invitation into the current organization
+func (s *Service) InvitationID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := httprouter.GetParamFromContext(ctx, "id")
+	i, err := s.Store.Invitations(ctx).Get(ctx, id)
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+
+	encodeJSON(w, http.StatusOK, newInvitationResponse(*i), s.Logger)
+}
+
+// NewInvitation invites the user of a provider identity into the current
+// organization. The user does not need to exist yet; the role is granted
+// once the user logs in and accepts the invitation.
+func (s *Service) NewInvitation(w http.ResponseWriter, r *http.Request) {
+	var req invitationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	if err := req.ValidCreate(); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	orgID, ok := hasOrganizationContext(ctx)
+	if !ok {
+		unknownErrorWithMessage(w, fmt.Errorf("expected organization to be set on context"), s.Logger)
+		return
+	}
+
+	serverCtx := serverContext(ctx)
+	org, err := s.Store.Organizations(serverCtx).Get(serverCtx, chronograf.OrganizationQuery{ID: &orgID})
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	if req.Role == roles.WildcardRoleName {
+		req.Role = org.DefaultRole
+	}
+
+	u, err := s.Store.Users(serverCtx).Get(serverCtx, chronograf.UserQuery{
+		Name:     &req.Name,
+		Provider: &req.Provider,
+		Scheme:   &req.Scheme,
+	})
+	if err != nil && err != chronograf.ErrUserNotFound {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	if u != nil {
+		for _, role := range u.Roles {
+			if role.Organization == orgID {
+				Error(w, http.StatusConflict, "user is already a member of the organization", s.Logger)
+				return
+			}
+		}
+	}
+
+	is, err := s.Store.Invitations(ctx).All(ctx)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	for _, i := range is {
+		if invitedTo(i, req.Name, req.Provider, req.Scheme) {
+			Error(w, http.StatusConflict, "user has already been invited into the organization", s.Logger)
+			return
+		}
+	}
+
+	i := &chronograf.Invitation{
+		Organization: orgID,
+		Role:         req.Role,
+		Name:         req.Name,
+		Provider:     req.Provider,
+		Scheme:       req.Scheme,
+	}
+	if u, ok := hasUserContext(ctx); ok {
+		i.InvitedBy = u.ID
+	}
+
+	i, err = s.Store.Invitations(ctx).Add(ctx, i)
+	if err != nil {
+		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+		return
+	}
+
+	res := newInvitationResponse(*i)
+	location(w, res.Links.Self)
+	encodeJSON(w, http.StatusCreated, res, s.Logger)
+}
+
+// RemoveInvitation revokes a pending invitation into the current organization
+func (s *Service) RemoveInvitation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := httprouter.GetParamFromContext(ctx, "id")
+	i, err := s.Store.Invitations(ctx).Get(ctx, id)
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+
+	if err := s.Store.Invitations(ctx).Delete(ctx, i); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// invitee returns the provider identity of the principal of the request.
+// Invitees are identified by their principal rather than by a stored user
+// as they may not have been added to Chronograf yet.
+func invitee(ctx context.Context) (name, provider, scheme string, err error) {
+	p, err := getValidPrincipal(ctx)
+	if err != nil {
+		return "", "", "", err
+	}
+	scheme, err = getScheme(ctx)
+	if err != nil {
+		return "", "", "", err
+	}
+	return p.Subject, p.Issuer, scheme, nil
+}
+
+// meInvitation returns the invitation of the id route parameter if it is
+// addressed to the principal of the request.
+func (s *Service) meInvitation(ctx context.Context) (*chronograf.Invitation, error) {
+	name, provider, scheme, err := invitee(ctx)
+	if err != nil {
+		return nil, err
+	}
+	serverCtx := serverContext(ctx)
+	id := httprouter.GetParamFromContext(ctx, "id")
+	i, err := s.Store.Invitations(serverCtx).Get(serverCtx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !invitedTo(*i, name, provider, scheme) {
+		return nil, chronograf.ErrInvitationNotFound
+	}
+	return i, nil
+}
+
+// MeInvitations lists the pending invitations of the current user across
+// all organizations.
+func (s *Service) MeInvitations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	name, provider, scheme, err := invitee(ctx)
+	if err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	serverCtx := serverContext(ctx)
+	all, err := s.Store.Invitations(serverCtx).All(serverCtx)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	is := []chronograf.Invitation{}
+	for _, i := range all {
+		if invitedTo(i, name, provider, scheme) {
+			is = append(is, i)
+		}
+	}
+
+	encodeJSON(w, http.StatusOK, newInvitationsResponse(is, "/chronograf/v1/me/invitations"), s.Logger)
+}
+
+// AcceptMeInvitation grants the current user the role of the invitation
+// within its organization, replacing any role the user had there, and
+// removes the invitation. Users that do not exist yet are created.
+func (s *Service) AcceptMeInvitation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	i, err := s.meInvitation(ctx)
+	if err != nil {
+		notFound(w, httprouter.GetParamFromContext(ctx, "id"), s.Logger)
+		return
+	}
+
+	serverCtx := serverContext(ctx)
+	if _, err := s.Store.Organizations(serverCtx).Get(serverCtx, chronograf.OrganizationQuery{ID: &i.Organization}); err != nil {
+		Error(w, http.StatusNotFound, err.Error(), s.Logger)
+		return
+	}
+
+	role := chronograf.Role{
+		Name:         i.Role,
+		Organization: i.Organization,
+	}
+	u, err := s.Store.Users(serverCtx).Get(serverCtx, chronograf.UserQuery{
+		Name:     &i.Name,
+		Provider: &i.Provider,
+		Scheme:   &i.Scheme,
+	})
+	if err != nil && err != chronograf.ErrUserNotFound {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	member := false
+	if u != nil {
+		for _, r := range u.Roles {
+			if r.Organization == role.Organization {
+				member = true
+				break
+			}
+		}
+	}
+	if !member {
+		if err := s.ensureQuota(ctx, role.Organization, quotaUsers, 1); err != nil {
+			quotaExceeded(w, err, s.Logger)
+			return
+		}
+	}
+
+	switch {
+	case err == chronograf.ErrUserNotFound:
+		u, err = s.Store.Users(serverCtx).Add(serverCtx, &chronograf.User{
+			Name:     i.Name,
+			Provider: i.Provider,
+			Scheme:   i.Scheme,
+			Roles:    []chronograf.Role{role},
+		})
+		if err != nil {
+			unknownErrorWithMessage(w, fmt.Errorf("error storing user %s: %v", i.Name, err), s.Logger)
+			return
+		}
+	case u.Suspended():
+		Error(w, http.StatusForbidden, chronograf.ErrUserSuspended.Error(), s.Logger)
+		return
+	default:
+		for j := range u.Roles {
+			if u.Roles[j].Organization == role.Organization {
+				u.Roles = append(u.Roles[:j], u.Roles[j+1:]...)
+				break
+			}
+		}
+		u.Roles = append(u.Roles, role)
+		if err := s.Store.Users(serverCtx).Update(serverCtx, u); err != nil {
+			Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+			return
+		}
+	}
+
+	if err := s.Store.Invitations(serverCtx).Delete(serverCtx, i); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	encodeJSON(w, http.StatusOK, newUserResponse(u, ""), s.Logger)
+}
+
+// DeclineMeInvitation removes an invitation of the current user without
+// granting its role.
+func (s *Service) DeclineMeInvitation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	i, err := s.meInvitation(ctx)
+	if err != nil {
+		notFound(w, httprouter.GetParamFromContext(ctx, "id"), s.Logger)
+		return
+	}
+
+	serverCtx := serverContext(ctx)
+	if err := s.Store.Invitations(serverCtx).Delete(serverCtx, i); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,315 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/bouk/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+	"github.com/influxdata/influxdb/chronograf/oauth2"
+	"github.com/influxdata/influxdb/chronograf/organizations"
+	"github.com/influxdata/influxdb/chronograf/roles"
+)
+
+func TestService_NewInvitation(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		user       *chronograf.User
+		pending    []chronograf.Invitation
+		wantStatus int
+		wantBody   string
+		want       *chronograf.Invitation
+	}{
+		{
+			name:       "Invite with the default role of the organization",
+			body:       `{"name":"marty","provider":"github","scheme":"oauth2"}`,
+			wantStatus: http.StatusCreated,
+			want: &chronograf.Invitation{
+				ID:           "1",
+				Organization: "1337",
+				Role:         roles.EditorRoleName,
+				Name:         "marty",
+				Provider:     "github",
+				Scheme:       "oauth2",
+				InvitedBy:    42,
+			},
+		},
+		{
+			name:       "Invite as admin",
+			body:       `{"name":"marty","provider":"github","scheme":"oauth2","role":"admin"}`,
+			wantStatus: http.StatusCreated,
+			want: &chronograf.Invitation{
+				ID:           "1",
+				Organization: "1337",
+				Role:         roles.AdminRoleName,
+				Name:         "marty",
+				Provider:     "github",
+				Scheme:       "oauth2",
+				InvitedBy:    42,
+			},
+		},
+		{
+			name:       "Unknown role",
+			body:       `{"name":"marty","provider":"github","scheme":"oauth2","role":"owner"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody:   `{"code":422,"message":"unknown role owner. Valid roles are 'member', 'viewer', 'editor', 'admin', and '*'","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"unknown role owner. Valid roles are 'member', 'viewer', 'editor', 'admin', and '*'"}`,
+		},
+		{
+			name:       "No provider",
+			body:       `{"name":"marty","scheme":"oauth2"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody:   `{"code":422,"message":"provider required on Chronograf Invitation request body","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"provider required on Chronograf Invitation request body"}`,
+		},
+		{
+			name: "Already a member",
+			body: `{"name":"marty","provider":"github","scheme":"oauth2"}`,
+			user: &chronograf.User{
+				ID:       2,
+				Name:     "marty",
+				Provider: "github",
+				Scheme:   "oauth2",
+				Roles: []chronograf.Role{
+					{Name: roles.ViewerRoleName, Organization: "1337"},
+				},
+			},
+			wantStatus: http.StatusConflict,
+			wantBody:   `{"code":409,"message":"user is already a member of the organization","type":"urn:chronograf:problem:conflict","title":"Conflict","status":409,"detail":"user is already a member of the organization"}`,
+		},
+		{
+			name: "Already invited",
+			body: `{"name":"marty","provider":"github","scheme":"oauth2"}`,
+			pending: []chronograf.Invitation{
+				{ID: "1", Organization: "1337", Name: "marty", Provider: "github", Scheme: "oauth2"},
+			},
+			wantStatus: http.StatusConflict,
+			wantBody:   `{"code":409,"message":"user has already been invited into the organization","type":"urn:chronograf:problem:conflict","title":"Conflict","status":409,"detail":"user has already been invited into the organization"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var added *chronograf.Invitation
+			s := &Service{
+				Store: &mocks.Store{
+					OrganizationsStore: &mocks.OrganizationsStore{
+						GetF: func(ctx context.Context, q chronograf.OrganizationQuery) (*chronograf.Organization, error) {
+							return &chronograf.Organization{ID: *q.ID, DefaultRole: roles.EditorRoleName}, nil
+						},
+					},
+					UsersStore: &mocks.UsersStore{
+						GetF: func(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+							if tt.user == nil {
+								return nil, chronograf.ErrUserNotFound
+							}
+							return tt.user, nil
+						},
+					},
+					InvitationsStore: &mocks.InvitationsStore{
+						AllF: func(ctx context.Context) ([]chronograf.Invitation, error) {
+							return tt.pending, nil
+						},
+						AddF: func(ctx context.Context, i *chronograf.Invitation) (*chronograf.Invitation, error) {
+							i.ID = "1"
+							added = i
+							return i, nil
+						},
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url/chronograf/v1/invitations", bytes.NewBufferString(tt.body))
+			ctx := context.WithValue(r.Context(), organizations.ContextKey, "1337")
+			ctx = context.WithValue(ctx, UserContextKey, &chronograf.User{ID: 42})
+			r = r.WithContext(ctx)
+
+			s.NewInvitation(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("%q. NewInvitation() = %v, want %v: %s", tt.name, resp.StatusCode, tt.wantStatus, body)
+			}
+			if tt.want == nil {
+				if eq, _ := jsonEqual(string(body), tt.wantBody); !eq {
+					t.Errorf("%q. NewInvitation() = \n***%v***\n,\nwant\n***%v***", tt.name, string(body), tt.wantBody)
+				}
+				return
+			}
+			if !reflect.DeepEqual(added, tt.want) {
+				t.Errorf("%q. NewInvitation() added %#v, want %#v", tt.name, added, tt.want)
+			}
+			if loc := resp.Header.Get("Location"); loc != "/chronograf/v1/invitations/1" {
+				t.Errorf("%q. NewInvitation() Location = %s", tt.name, loc)
+			}
+		})
+	}
+}
+
+func TestService_MeInvitations(t *testing.T) {
+	s := &Service{
+		Store: &mocks.Store{
+			InvitationsStore: &mocks.InvitationsStore{
+				AllF: func(ctx context.Context) ([]chronograf.Invitation, error) {
+					return []chronograf.Invitation{
+						{ID: "1", Organization: "1337", Role: roles.ViewerRoleName, Name: "marty", Provider: "github", Scheme: "oauth2"},
+						{ID: "2", Organization: "1338", Role: roles.ViewerRoleName, Name: "doc", Provider: "github", Scheme: "oauth2"},
+						{ID: "3", Organization: "1338", Role: roles.ViewerRoleName, Name: "marty", Provider: "google", Scheme: "oauth2"},
+					}, nil
+				},
+			},
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "http://any.url/chronograf/v1/me/invitations", nil)
+	r = r.WithContext(context.WithValue(r.Context(), oauth2.PrincipalKey, oauth2.Principal{
+		Subject: "marty",
+		Issuer:  "github",
+	}))
+
+	s.MeInvitations(w, r)
+
+	resp := w.Result()
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("MeInvitations() = %v, want %v: %s", resp.StatusCode, http.StatusOK, body)
+	}
+	want := `{"links":{"self":"/chronograf/v1/me/invitations"},"invitations":[{"links":{"self":"/chronograf/v1/invitations/1","accept":"/chronograf/v1/me/invitations/1/accept","decline":"/chronograf/v1/me/invitations/1/decline"},"id":"1","organization":"1337","role":"viewer","name":"marty","provider":"github","scheme":"oauth2","invitedBy":"0","createdAt":"0001-01-01T00:00:00Z"}]}`
+	if eq, _ := jsonEqual(string(body), want); !eq {
+		t.Errorf("MeInvitations() = \n***%v***\n,\nwant\n***%v***", string(body), want)
+	}
+}
+
+func TestService_AcceptMeInvitation(t *testing.T) {
+	tests := []struct {
+		name       string
+		principal  oauth2.Principal
+		user       *chronograf.User
+		wantStatus int
+		wantRoles  []chronograf.Role
+		wantAdded  bool
+	}{
+		{
+			name:       "New user",
+			principal:  oauth2.Principal{Subject: "marty", Issuer: "github"},
+			wantStatus: http.StatusOK,
+			wantRoles: []chronograf.Role{
+				{Name: roles.EditorRoleName, Organization: "1337"},
+			},
+			wantAdded: true,
+		},
+		{
+			name:      "Existing user replaces their role in the organization",
+			principal: oauth2.Principal{Subject: "marty", Issuer: "github"},
+			user: &chronograf.User{
+				ID:       2,
+				Name:     "marty",
+				Provider: "github",
+				Scheme:   "oauth2",
+				Roles: []chronograf.Role{
+					{Name: roles.ViewerRoleName, Organization: "1337"},
+					{Name: roles.AdminRoleName, Organization: "0"},
+				},
+			},
+			wantStatus: http.StatusOK,
+			wantRoles: []chronograf.Role{
+				{Name: roles.AdminRoleName, Organization: "0"},
+				{Name: roles.EditorRoleName, Organization: "1337"},
+			},
+		},
+		{
+			name:       "Invitation of somebody else",
+			principal:  oauth2.Principal{Subject: "doc", Issuer: "github"},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stored *chronograf.User
+			deleted := false
+			s := &Service{
+				Store: &mocks.Store{
+					OrganizationsStore: &mocks.OrganizationsStore{
+						GetF: func(ctx context.Context, q chronograf.OrganizationQuery) (*chronograf.Organization, error) {
+							return &chronograf.Organization{ID: *q.ID}, nil
+						},
+					},
+					UsersStore: &mocks.UsersStore{
+						GetF: func(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+							if tt.user == nil {
+								return nil, chronograf.ErrUserNotFound
+							}
+							return tt.user, nil
+						},
+						AddF: func(ctx context.Context, u *chronograf.User) (*chronograf.User, error) {
+							u.ID = 3
+							stored = u
+							return u, nil
+						},
+						UpdateF: func(ctx context.Context, u *chronograf.User) error {
+							stored = u
+							return nil
+						},
+					},
+					InvitationsStore: &mocks.InvitationsStore{
+						GetF: func(ctx context.Context, id string) (*chronograf.Invitation, error) {
+							return &chronograf.Invitation{
+								ID:           id,
+								Organization: "1337",
+								Role:         roles.EditorRoleName,
+								Name:         "marty",
+								Provider:     "github",
+								Scheme:       "oauth2",
+							}, nil
+						},
+						DeleteF: func(ctx context.Context, i *chronograf.Invitation) error {
+							deleted = true
+							return nil
+						},
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url/chronograf/v1/me/invitations/1/accept", nil)
+			ctx := context.WithValue(r.Context(), oauth2.PrincipalKey, tt.principal)
+			ctx = httprouter.WithParams(ctx, httprouter.Params{
+				{
+					Key:   "id",
+					Value: "1",
+				},
+			})
+			r = r.WithContext(ctx)
+
+			s.AcceptMeInvitation(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("%q. AcceptMeInvitation() = %v, want %v: %s", tt.name, resp.StatusCode, tt.wantStatus, body)
+			}
+			if tt.wantStatus != http.StatusOK {
+				if deleted || stored != nil {
+					t.Errorf("%q. AcceptMeInvitation() changed the stores", tt.name)
+				}
+				return
+			}
+			if !deleted {
+				t.Errorf("%q. AcceptMeInvitation() did not remove the invitation", tt.name)
+			}
+			if !reflect.DeepEqual(stored.Roles, tt.wantRoles) {
+				t.Errorf("%q. AcceptMeInvitation() roles = %#v, want %#v", tt.name, stored.Roles, tt.wantRoles)
+			}
+			if added := stored.ID == 3; added != tt.wantAdded {
+				t.Errorf("%q. AcceptMeInvitation() added user = %v, want %v", tt.name, added, tt.wantAdded)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/ldap"
+	"github.com/influxdata/influxdb/chronograf/oauth2"
+)
+
+const (
+	// LDAPScheme is the scheme of users authenticated by an LDAP directory
+	LDAPScheme = "ldap"
+	// LDAPProvider is the provider of users of the ldap scheme and the
+	// issuer of their principals
+	LDAPProvider = "ldap"
+
+	ldapLoginPath  = "/ldap/login"
+	ldapLogoutPath = "/ldap/logout"
+)
+
+// LDAPDirectory verifies the password of a user and returns the names of
+// the groups of the user
+type LDAPDirectory interface {
+	Authenticate(name, password string) ([]string, error)
+}
+
+// LDAPLogin authenticates a user by binding to the directory and sets the
+// session cookie in the same way as the OAuth2 callbacks. The groups of the
+// user are carried by the principal so that users are created by Me on their
+// first login with the roles of the mappings of their groups.
+func (s *Service) LDAPLogin(dir LDAPDirectory, auth oauth2.Authenticator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req basicLoginRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			invalidJSON(w, s.Logger)
+			return
+		}
+
+		groups, err := dir.Authenticate(req.Name, req.Password)
+		if err == ldap.ErrInvalidCredentials {
+			Error(w, http.StatusUnauthorized, errInvalidCredentials.Error(), s.Logger)
+			return
+		}
+		if err != nil {
+			s.Logger.
+				WithField("component", "ldap").
+				Error("Unable to authenticate ", req.Name, ": ", err)
+			Error(w, http.StatusBadGateway, "unable to reach the LDAP directory", s.Logger)
+			return
+		}
+
+		ctx := serverContext(r.Context())
+		provider, scheme := LDAPProvider, LDAPScheme
+		u, err := s.Store.Users(ctx).Get(ctx, chronograf.UserQuery{
+			Name:     &req.Name,
+			Provider: &provider,
+			Scheme:   &scheme,
+		})
+		if err != nil && err != chronograf.ErrUserNotFound {
+			unknownErrorWithMessage(w, err, s.Logger)
+			return
+		}
+		if u != nil && u.Suspended() {
+			Error(w, http.StatusForbidden, chronograf.ErrUserSuspended.Error(), s.Logger)
+			return
+		}
+
+		p := oauth2.Principal{
+			Subject: req.Name,
+			Issuer:  LDAPProvider,
+			Group:   strings.Join(groups, ","),
+		}
+		if err := auth.Authorize(ctx, w, p); err != nil {
+			unknownErrorWithMessage(w, err, s.Logger)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
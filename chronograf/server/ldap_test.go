@@ -0,0 +1,118 @@
+package server
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/ldap"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+	"github.com/influxdata/influxdb/chronograf/oauth2"
+)
+
+// testLDAPDirectory knows a single user and their groups
+type testLDAPDirectory struct {
+	name, password string
+	groups         []string
+	err            error
+}
+
+func (d *testLDAPDirectory) Authenticate(name, password string) ([]string, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+	if name != d.name || password != d.password {
+		return nil, ldap.ErrInvalidCredentials
+	}
+	return d.groups, nil
+}
+
+func TestService_LDAPLogin(t *testing.T) {
+	dir := &testLDAPDirectory{name: "marty", password: "delorean", groups: []string{"ops", "dev"}}
+	tests := []struct {
+		name       string
+		user       chronograf.User
+		dir        *testLDAPDirectory
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "First login",
+			dir:        dir,
+			body:       `{"name":"marty","password":"delorean"}`,
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "Existing user",
+			user:       chronograf.User{ID: 1, Name: "marty", Provider: LDAPProvider, Scheme: LDAPScheme},
+			dir:        dir,
+			body:       `{"name":"marty","password":"delorean"}`,
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "Wrong password",
+			dir:        dir,
+			body:       `{"name":"marty","password":"biff"}`,
+			wantStatus: http.StatusUnauthorized,
+			wantBody:   `{"code":401,"message":"invalid username or password","type":"urn:chronograf:problem:unauthorized","title":"Unauthorized","status":401,"detail":"invalid username or password"}`,
+		},
+		{
+			name:       "Suspended user",
+			user:       chronograf.User{ID: 1, Name: "marty", Provider: LDAPProvider, Scheme: LDAPScheme, Status: chronograf.UserStatusSuspended},
+			dir:        dir,
+			body:       `{"name":"marty","password":"delorean"}`,
+			wantStatus: http.StatusForbidden,
+			wantBody:   `{"code":403,"message":"user is suspended","type":"urn:chronograf:problem:forbidden","title":"Forbidden","status":403,"detail":"user is suspended"}`,
+		},
+		{
+			name:       "Basic user of the same name",
+			user:       chronograf.User{ID: 1, Name: "marty", Provider: BasicProvider, Scheme: BasicScheme, Status: chronograf.UserStatusSuspended},
+			dir:        dir,
+			body:       `{"name":"marty","password":"delorean"}`,
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "Unreachable directory",
+			dir:        &testLDAPDirectory{err: errors.New("connection refused")},
+			body:       `{"name":"marty","password":"delorean"}`,
+			wantStatus: http.StatusBadGateway,
+			wantBody:   `{"code":502,"message":"unable to reach the LDAP directory","type":"urn:chronograf:problem:bad-gateway","title":"Bad Gateway","status":502,"detail":"unable to reach the LDAP directory"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{
+				Store: &mocks.Store{
+					UsersStore: basicTestUsersStore(&tt.user),
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url/ldap/login", bytes.NewBufferString(tt.body))
+
+			auth := &basicTestAuthenticator{}
+			s.LDAPLogin(tt.dir, auth)(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("%q. LDAPLogin() = %v, want %v", tt.name, resp.StatusCode, tt.wantStatus)
+			}
+			if tt.wantBody == "" {
+				want := oauth2.Principal{Subject: "marty", Issuer: LDAPProvider, Group: "ops,dev"}
+				if auth.authorized == nil || *auth.authorized != want {
+					t.Errorf("%q. LDAPLogin() authorized %v, want %v", tt.name, auth.authorized, want)
+				}
+				return
+			}
+			if eq, _ := jsonEqual(string(body), tt.wantBody); !eq {
+				t.Errorf("%q. LDAPLogin() = \n***%v***\n,\nwant\n***%v***", tt.name, string(body), tt.wantBody)
+			}
+		})
+	}
+}
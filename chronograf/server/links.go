@@ -12,8 +12,9 @@ type getFluxLinksResponse struct {
 }
 
 type getConfigLinksResponse struct {
-	Self string `json:"self"` // Location of the whole global application configuration
-	Auth string `json:"auth"` // Location of the auth section of the global application configuration
+	Self   string `json:"self"`   // Location of the whole global application configuration
+	Auth   string `json:"auth"`   // Location of the auth section of the global application configuration
+	Server string `json:"server"` // Location of the effective options of the server
 }
 
 type getOrganizationConfigLinksResponse struct {
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/influxdata/influxdb/chronograf"
+	clog "github.com/influxdata/influxdb/chronograf/log"
 )
 
 // statusWriterFlusher captures the status header of an http.ResponseWriter
@@ -22,6 +23,9 @@ func (w *statusWriter) WriteHeader(status int) {
 
 func (w *statusWriter) Status() int { return w.status }
 
+// Unwrap returns the wrapped response writer
+func (w *statusWriter) Unwrap() http.ResponseWriter { return w.ResponseWriter }
+
 // Flush is here because the underlying HTTP chunked transfer response writer
 // to implement http.Flusher.  Without it data is silently buffered.  This
 // was discovered when proxying kapacitor chunked logs.
@@ -31,15 +35,32 @@ func (w *statusWriter) Flush() {
 	}
 }
 
+// RequestID is middleware that correlates the logs, the proxied requests and
+// the response of a request with an ID. The valid X-Request-Id header of a
+// request, such as the one of a load balancer, is kept as its ID.
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(clog.RequestIDHeader)
+		if !clog.ValidRequestID(id) {
+			id = clog.NewRequestID()
+			// Requests proxied to InfluxDB and Kapacitor forward the header
+			r.Header.Set(clog.RequestIDHeader, id)
+		}
+		w.Header().Set(clog.RequestIDHeader, id)
+		next.ServeHTTP(w, r.WithContext(clog.WithRequestID(r.Context(), id)))
+	})
+}
+
 // Logger is middleware that logs the request
 func Logger(logger chronograf.Logger, next http.Handler) http.Handler {
 	fn := func(w http.ResponseWriter, r *http.Request) {
 		now := time.Now()
-		logger.WithField("component", "server").
+		logger := clog.FromContext(r.Context(), logger).
+			WithField("component", "server").
 			WithField("remote_addr", r.RemoteAddr).
 			WithField("method", r.Method).
-			WithField("url", r.URL).
-			Debug("Request")
+			WithField("url", r.URL)
+		logger.Debug("Request")
 
 		sw := &statusWriter{
 			ResponseWriter: w,
@@ -52,9 +73,6 @@ func Logger(logger chronograf.Logger, next http.Handler) http.Handler {
 		elapsed := later.Sub(now)
 
 		logger.
-			WithField("component", "server").
-			WithField("remote_addr", r.RemoteAddr).
-			WithField("method", r.Method).
 			WithField("response_time", elapsed.String()).
 			WithField("status", sw.Status()).
 			Info("Response: ", http.StatusText(sw.Status()))
@@ -0,0 +1,58 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	clog "github.com/influxdata/influxdb/chronograf/log"
+)
+
+func TestRequestID(t *testing.T) {
+	tests := []struct {
+		name      string
+		header    string
+		generated bool
+	}{
+		{
+			name:   "Keeps the ID of the request",
+			header: "lb-1234",
+		},
+		{
+			name:      "Generates an ID without one",
+			generated: true,
+		},
+		{
+			name:      "Replaces an invalid ID",
+			header:    "not valid",
+			generated: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ctxID, forwarded string
+			h := RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				ctxID, _ = clog.RequestID(r.Context())
+				forwarded = r.Header.Get(clog.RequestIDHeader)
+			}))
+
+			req := httptest.NewRequest("GET", "http://any.url", nil)
+			if tt.header != "" {
+				req.Header.Set(clog.RequestIDHeader, tt.header)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			id := w.Header().Get(clog.RequestIDHeader)
+			if tt.generated && (id == tt.header || !clog.ValidRequestID(id)) {
+				t.Errorf("RequestID() responded the ID %q, want a generated ID", id)
+			}
+			if !tt.generated && id != tt.header {
+				t.Errorf("RequestID() responded the ID %q, want %q", id, tt.header)
+			}
+			if ctxID != id || forwarded != id {
+				t.Errorf("RequestID() context ID = %q and header = %q, want %q", ctxID, forwarded, id)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/bouk/httprouter"
 	"github.com/influxdata/influxdb/chronograf"
 	"github.com/influxdata/influxdb/chronograf/oauth2"
+	"github.com/influxdata/influxdb/chronograf/roles"
 )
 
 func (s *Service) mapPrincipalToSuperAdmin(p oauth2.Principal) bool {
@@ -43,18 +44,43 @@ MappingsLoop:
 				continue MappingsLoop
 			}
 
-			for _, role := range roles {
+			name := mapping.Role
+			if name == "" {
+				name = org.DefaultRole
+			}
+
+			// When several mappings match within an organization the most
+			// privileged of their roles is granted
+			for i, role := range roles {
 				if role.Organization == org.ID {
+					if roleRank(name) > roleRank(role.Name) {
+						roles[i].Name = name
+					}
 					continue MappingsLoop
 				}
 			}
-			roles = append(roles, chronograf.Role{Organization: org.ID, Name: org.DefaultRole})
+			roles = append(roles, chronograf.Role{Organization: org.ID, Name: name})
 		}
 	}
 
 	return roles, nil
 }
 
+// roleRank orders the roles within an organization by privilege
+func roleRank(role string) int {
+	switch role {
+	case roles.MemberRoleName:
+		return 1
+	case roles.ViewerRoleName:
+		return 2
+	case roles.EditorRoleName:
+		return 3
+	case roles.AdminRoleName:
+		return 4
+	}
+	return 0
+}
+
 func applyMapping(m chronograf.Mapping, p oauth2.Principal) bool {
 	switch m.Provider {
 	case chronograf.MappingWildcard, p.Issuer:
@@ -63,7 +89,7 @@ func applyMapping(m chronograf.Mapping, p oauth2.Principal) bool {
 	}
 
 	switch m.Scheme {
-	case chronograf.MappingWildcard, "oauth2":
+	case chronograf.MappingWildcard, schemeOf(p):
 	default:
 		return false
 	}
@@ -100,6 +126,11 @@ func (m *mappingsRequest) Valid() error {
 	if m.ProviderOrganization == "" {
 		return fmt.Errorf("mapping must specify group")
 	}
+	switch m.Role {
+	case "", roles.MemberRoleName, roles.ViewerRoleName, roles.EditorRoleName, roles.AdminRoleName:
+	default:
+		return fmt.Errorf("unknown role %s. Valid roles are 'member', 'viewer', 'editor', and 'admin'", m.Role)
+	}
 
 	return nil
 }
@@ -178,6 +209,7 @@ func (s *Service) NewMapping(w http.ResponseWriter, r *http.Request) {
 		Scheme:               req.Scheme,
 		Provider:             req.Provider,
 		ProviderOrganization: req.ProviderOrganization,
+		Role:                 req.Role,
 	}
 
 	m, err := s.Store.Mappings(ctx).Add(ctx, mapping)
@@ -218,6 +250,7 @@ func (s *Service) UpdateMapping(w http.ResponseWriter, r *http.Request) {
 		Scheme:               req.Scheme,
 		Provider:             req.Provider,
 		ProviderOrganization: req.ProviderOrganization,
+		Role:                 req.Role,
 	}
 
 	err := s.Store.Mappings(ctx).Update(ctx, mapping)
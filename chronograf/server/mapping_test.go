@@ -6,11 +6,13 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"net/http/httptest"
+	"reflect"
 	"testing"
 
 	"github.com/bouk/httprouter"
 	"github.com/influxdata/influxdb/chronograf"
 	"github.com/influxdata/influxdb/chronograf/mocks"
+	"github.com/influxdata/influxdb/chronograf/oauth2"
 	"github.com/influxdata/influxdb/chronograf/roles"
 )
 
@@ -136,6 +138,57 @@ func TestMappings_Add(t *testing.T) {
 				body:        `{"links":{"self":"/chronograf/v1/mappings/0"},"id":"0","organizationId":"0","provider":"*","scheme":"*","providerOrganization":"*"}`,
 			},
 		},
+		{
+			name: "create new mapping granting a role",
+			fields: fields{
+				OrganizationsStore: &mocks.OrganizationsStore{
+					GetF: func(ctx context.Context, q chronograf.OrganizationQuery) (*chronograf.Organization, error) {
+						return &chronograf.Organization{
+							ID:          "0",
+							Name:        "The Gnarly Default",
+							DefaultRole: roles.ViewerRoleName,
+						}, nil
+					},
+				},
+				MappingsStore: &mocks.MappingsStore{
+					AddF: func(ctx context.Context, m *chronograf.Mapping) (*chronograf.Mapping, error) {
+						m.ID = "0"
+						return m, nil
+					},
+				},
+			},
+			args: args{
+				mapping: &chronograf.Mapping{
+					Organization:         "0",
+					Provider:             "github",
+					Scheme:               "oauth2",
+					ProviderOrganization: "influxdata/team-ops",
+					Role:                 roles.AdminRoleName,
+				},
+			},
+			wants: wants{
+				statusCode:  201,
+				contentType: "application/json",
+				body:        `{"links":{"self":"/chronograf/v1/mappings/0"},"id":"0","organizationId":"0","provider":"github","scheme":"oauth2","providerOrganization":"influxdata/team-ops","role":"admin"}`,
+			},
+		},
+		{
+			name: "create new mapping with an unknown role",
+			args: args{
+				mapping: &chronograf.Mapping{
+					Organization:         "0",
+					Provider:             "github",
+					Scheme:               "oauth2",
+					ProviderOrganization: "influxdata",
+					Role:                 "owner",
+				},
+			},
+			wants: wants{
+				statusCode:  422,
+				contentType: "application/problem+json",
+				body:        `{"code":422,"message":"unknown role owner. Valid roles are 'member', 'viewer', 'editor', and 'admin'","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"unknown role owner. Valid roles are 'member', 'viewer', 'editor', and 'admin'"}`,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -354,3 +407,99 @@ func TestMappings_Remove(t *testing.T) {
 		})
 	}
 }
+
+func TestService_mapPrincipalToRoles(t *testing.T) {
+	s := &Service{
+		Store: &mocks.Store{
+			MappingsStore: &mocks.MappingsStore{
+				AllF: func(ctx context.Context) ([]chronograf.Mapping, error) {
+					return []chronograf.Mapping{
+						{Organization: "1", Provider: "github", Scheme: "oauth2", ProviderOrganization: "influxdata"},
+						{Organization: "1", Provider: "github", Scheme: "oauth2", ProviderOrganization: "influxdata/team-ops", Role: roles.AdminRoleName},
+						{Organization: "1", Provider: "github", Scheme: "oauth2", ProviderOrganization: "influxdata/team-dev", Role: roles.EditorRoleName},
+						{Organization: "2", Provider: "github", Scheme: "oauth2", ProviderOrganization: "influxdata"},
+						{Organization: "3", Provider: "github", Scheme: "oauth2", ProviderOrganization: "other", Role: roles.AdminRoleName},
+					}, nil
+				},
+			},
+			OrganizationsStore: &mocks.OrganizationsStore{
+				GetF: func(ctx context.Context, q chronograf.OrganizationQuery) (*chronograf.Organization, error) {
+					return &chronograf.Organization{
+						ID:          *q.ID,
+						DefaultRole: roles.MemberRoleName,
+					}, nil
+				},
+			},
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+
+	got, err := s.mapPrincipalToRoles(context.Background(), oauth2.Principal{
+		Subject: "marty@example.com",
+		Issuer:  "github",
+		Group:   "influxdata,influxdata/team-ops,influxdata/team-dev",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []chronograf.Role{
+		{Organization: "1", Name: roles.AdminRoleName},
+		{Organization: "2", Name: roles.MemberRoleName},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("mapPrincipalToRoles() = %v, want %v", got, want)
+	}
+}
+
+func Test_applyMapping_scheme(t *testing.T) {
+	tests := []struct {
+		name    string
+		mapping chronograf.Mapping
+		p       oauth2.Principal
+		want    bool
+	}{
+		{
+			name:    "OAuth2 principal",
+			mapping: chronograf.Mapping{Provider: "github", Scheme: "oauth2", ProviderOrganization: "influxdata"},
+			p:       oauth2.Principal{Issuer: "github", Group: "influxdata"},
+			want:    true,
+		},
+		{
+			name:    "LDAP principal",
+			mapping: chronograf.Mapping{Provider: LDAPProvider, Scheme: LDAPScheme, ProviderOrganization: "ops"},
+			p:       oauth2.Principal{Issuer: LDAPProvider, Group: "dev,ops"},
+			want:    true,
+		},
+		{
+			name:    "LDAP principal of a wildcard mapping",
+			mapping: chronograf.Mapping{Provider: "*", Scheme: "*", ProviderOrganization: "*"},
+			p:       oauth2.Principal{Issuer: LDAPProvider},
+			want:    true,
+		},
+		{
+			name:    "LDAP principal of an oauth2 mapping",
+			mapping: chronograf.Mapping{Provider: "*", Scheme: "oauth2", ProviderOrganization: "*"},
+			p:       oauth2.Principal{Issuer: LDAPProvider},
+			want:    false,
+		},
+		{
+			name:    "SAML principal",
+			mapping: chronograf.Mapping{Provider: SAMLProvider, Scheme: SAMLScheme, ProviderOrganization: "ops"},
+			p:       oauth2.Principal{Issuer: SAMLProvider, Group: "ops"},
+			want:    true,
+		},
+		{
+			name:    "LDAP principal outside of the group",
+			mapping: chronograf.Mapping{Provider: LDAPProvider, Scheme: LDAPScheme, ProviderOrganization: "ops"},
+			p:       oauth2.Principal{Issuer: LDAPProvider, Group: "dev"},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := applyMapping(tt.mapping, tt.p); got != tt.want {
+				t.Errorf("applyMapping() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"sort"
@@ -9,6 +10,7 @@ import (
 	"github.com/influxdata/influxdb/chronograf"
 	"github.com/influxdata/influxdb/chronograf/oauth2"
 	"github.com/influxdata/influxdb/chronograf/organizations"
+	"github.com/influxdata/influxdb/chronograf/roles"
 	"golang.org/x/net/context"
 )
 
@@ -53,11 +55,28 @@ func newMeResponse(usr *chronograf.User, org string) meResponse {
 	}
 }
 
-// TODO: This Scheme value is hard-coded temporarily since we only currently
-// support OAuth2. This hard-coding should be removed whenever we add
-// support for other authentication schemes.
+// getScheme returns the authentication scheme of the principal on context
 func getScheme(ctx context.Context) (string, error) {
-	return "oauth2", nil
+	p, _ := getPrincipal(ctx)
+	return schemeOf(p), nil
+}
+
+// schemeOf returns the authentication scheme of a principal. Principals
+// issued by BasicProvider, LDAPProvider, SAMLProvider and HeaderProvider
+// belong to users of the basic, ldap, saml and header schemes; all others
+// come from an OAuth2 provider.
+func schemeOf(p oauth2.Principal) string {
+	switch p.Issuer {
+	case BasicProvider:
+		return BasicScheme
+	case LDAPProvider:
+		return LDAPScheme
+	case SAMLProvider:
+		return SAMLScheme
+	case HeaderProvider:
+		return HeaderScheme
+	}
+	return "oauth2"
 }
 
 func getPrincipal(ctx context.Context) (oauth2.Principal, error) {
@@ -134,11 +153,15 @@ func (s *Service) UpdateMe(auth oauth2.Authenticator) func(http.ResponseWriter,
 			invalidData(w, err, s.Logger)
 			return
 		}
-		_, err = s.Store.Users(ctx).Get(ctx, chronograf.UserQuery{
+		usr, err := s.Store.Users(ctx).Get(ctx, chronograf.UserQuery{
 			Name:     &p.Subject,
 			Provider: &p.Issuer,
 			Scheme:   &scheme,
 		})
+		if err == nil && usr.Suspended() {
+			Error(w, http.StatusForbidden, chronograf.ErrUserSuspended.Error(), s.Logger)
+			return
+		}
 		if err == chronograf.ErrUserNotFound {
 			// If the user was not found, check to see if they are a super admin. If
 			// they are, add them to the organization.
@@ -152,22 +175,38 @@ func (s *Service) UpdateMe(auth oauth2.Authenticator) func(http.ResponseWriter,
 				return
 			}
 
-			if !u.SuperAdmin {
+			if u.Suspended() {
+				Error(w, http.StatusForbidden, chronograf.ErrUserSuspended.Error(), s.Logger)
+				return
+			}
+
+			// Members of a group with a role in the organization switch to it
+			// as if the role were their own
+			rs, err := effectiveRoles(serverCtx, s.Store, u)
+			if err != nil {
+				unknownErrorWithMessage(w, err, s.Logger)
+				return
+			}
+			grouped := hasRoleIn(rs, org.ID)
+
+			if !u.SuperAdmin && !grouped {
 				// Since a user is not a part of this organization and not a super admin,
 				// we should tell them that they are Forbidden (403) from accessing this resource
 				Error(w, http.StatusForbidden, chronograf.ErrUserNotFound.Error(), s.Logger)
 				return
 			}
 
-			// If the user is a super admin give them an admin role in the
-			// requested organization.
-			u.Roles = append(u.Roles, chronograf.Role{
-				Organization: org.ID,
-				Name:         org.DefaultRole,
-			})
-			if err := s.Store.Users(serverCtx).Update(serverCtx, u); err != nil {
-				unknownErrorWithMessage(w, err, s.Logger)
-				return
+			if !grouped {
+				// If the user is a super admin give them an admin role in the
+				// requested organization.
+				u.Roles = append(u.Roles, chronograf.Role{
+					Organization: org.ID,
+					Name:         org.DefaultRole,
+				})
+				if err := s.Store.Users(serverCtx).Update(serverCtx, u); err != nil {
+					unknownErrorWithMessage(w, err, s.Logger)
+					return
+				}
 			}
 		} else if err != nil {
 			Error(w, http.StatusBadRequest, err.Error(), s.Logger)
@@ -234,6 +273,11 @@ func (s *Service) Me(w http.ResponseWriter, r *http.Request) {
 
 	// user exists
 	if usr != nil {
+		if usr.Suspended() {
+			Error(w, http.StatusForbidden, chronograf.ErrUserSuspended.Error(), s.Logger)
+			return
+		}
+
 		superAdmin := s.mapPrincipalToSuperAdmin(p)
 		if superAdmin && !usr.SuperAdmin {
 			usr.SuperAdmin = superAdmin
@@ -255,6 +299,13 @@ func (s *Service) Me(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		// The user is shown with the roles of their groups as well
+		usr.Roles, err = effectiveRoles(serverCtx, s.Store, usr)
+		if err != nil {
+			unknownErrorWithMessage(w, err, s.Logger)
+			return
+		}
+
 		orgs, err := s.usersOrganizations(serverCtx, usr)
 		if err != nil {
 			unknownErrorWithMessage(w, err, s.Logger)
@@ -269,6 +320,41 @@ func (s *Service) Me(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Because we didnt find a user, making a new one
+	newUser, err := s.provisionUser(serverCtx, p, scheme, defaultOrg)
+	if err == errPrivateChronograf {
+		Error(w, http.StatusForbidden, err.Error(), s.Logger)
+		return
+	}
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	orgs, err := s.usersOrganizations(serverCtx, newUser)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	currentOrg, err := s.Store.Organizations(serverCtx).Get(serverCtx, chronograf.OrganizationQuery{ID: &p.Organization})
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	res := newMeResponse(newUser, currentOrg.ID)
+	res.Organizations = orgs
+	res.CurrentOrganization = currentOrg
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// errPrivateChronograf is returned by provisionUser when neither a mapping
+// nor the auto-provisioning policy grants a role to an unknown user
+var errPrivateChronograf = errors.New("This Chronograf is private. To gain access, you must be explicitly added by an administrator.")
+
+// provisionUser creates the user of a principal logging in for the first
+// time with the roles of the mappings of its groups, or with the role of the
+// auto-provisioning policy when no mapping applies. ctx must be a server
+// context.
+func (s *Service) provisionUser(ctx context.Context, p oauth2.Principal, scheme string, defaultOrg *chronograf.Organization) (*chronograf.User, error) {
 	user := &chronograf.User{
 		Name:     p.Subject,
 		Provider: p.Issuer,
@@ -285,15 +371,23 @@ func (s *Service) Me(w http.ResponseWriter, r *http.Request) {
 		user.SuperAdmin = superAdmin
 	}
 
-	roles, err := s.mapPrincipalToRoles(serverCtx, p)
+	roles, err := s.mapPrincipalToRoles(ctx, p)
 	if err != nil {
-		Error(w, http.StatusInternalServerError, err.Error(), s.Logger)
-		return
+		return nil, err
+	}
+
+	if len(roles) == 0 {
+		role, err := s.newUserRole(ctx, defaultOrg)
+		if err != nil {
+			return nil, err
+		}
+		if role != nil {
+			roles = append(roles, *role)
+		}
 	}
 
 	if !superAdmin && len(roles) == 0 {
-		Error(w, http.StatusForbidden, "This Chronograf is private. To gain access, you must be explicitly added by an administrator.", s.Logger)
-		return
+		return nil, errPrivateChronograf
 	}
 
 	// If the user is a superadmin, give them a role in the default organization
@@ -315,27 +409,11 @@ func (s *Service) Me(w http.ResponseWriter, r *http.Request) {
 
 	user.Roles = roles
 
-	newUser, err := s.Store.Users(serverCtx).Add(serverCtx, user)
+	newUser, err := s.Store.Users(ctx).Add(ctx, user)
 	if err != nil {
-		msg := fmt.Errorf("error storing user %s: %v", user.Name, err)
-		unknownErrorWithMessage(w, msg, s.Logger)
-		return
+		return nil, fmt.Errorf("error storing user %s: %v", user.Name, err)
 	}
-
-	orgs, err := s.usersOrganizations(serverCtx, newUser)
-	if err != nil {
-		unknownErrorWithMessage(w, err, s.Logger)
-		return
-	}
-	currentOrg, err := s.Store.Organizations(serverCtx).Get(serverCtx, chronograf.OrganizationQuery{ID: &p.Organization})
-	if err != nil {
-		unknownErrorWithMessage(w, err, s.Logger)
-		return
-	}
-	res := newMeResponse(newUser, currentOrg.ID)
-	res.Organizations = orgs
-	res.CurrentOrganization = currentOrg
-	encodeJSON(w, http.StatusOK, res, s.Logger)
+	return newUser, nil
 }
 
 func (s *Service) firstUser() bool {
@@ -365,6 +443,44 @@ func (s *Service) newUsersAreSuperAdmin() bool {
 	return cfg.Auth.SuperAdminNewUsers
 }
 
+// newUserRole returns the role of an unknown user no mapping grants a role
+// according to the auto-provisioning policy of the auth config. It is nil
+// when the policy does not create such users or their organization no
+// longer exists.
+func (s *Service) newUserRole(ctx context.Context, defaultOrg *chronograf.Organization) (*chronograf.Role, error) {
+	cfg, err := s.Store.Config(ctx).Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !cfg.Auth.AutoProvisionNewUsers {
+		return nil, nil
+	}
+
+	org := defaultOrg
+	if id := cfg.Auth.NewUsersOrganization; id != "" && id != defaultOrg.ID {
+		org, err = s.Store.Organizations(ctx).Get(ctx, chronograf.OrganizationQuery{ID: &id})
+		if err == chronograf.ErrOrganizationNotFound {
+			s.Logger.
+				WithField("component", "me").
+				WithField("organization", id).
+				Error("Organization of auto-provisioned users not found")
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	name := cfg.Auth.NewUsersRole
+	if name == "" || name == roles.WildcardRoleName {
+		name = org.DefaultRole
+	}
+	return &chronograf.Role{
+		Name:         name,
+		Organization: org.ID,
+	}, nil
+}
+
 func (s *Service) usersOrganizations(ctx context.Context, u *chronograf.User) ([]chronograf.Organization, error) {
 	if u == nil {
 		// TODO(desa): better error
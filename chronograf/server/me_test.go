@@ -22,6 +22,7 @@ func TestService_Me(t *testing.T) {
 		OrganizationsStore       chronograf.OrganizationsStore
 		MappingsStore            chronograf.MappingsStore
 		ConfigStore              chronograf.ConfigStore
+		Groups                   []chronograf.Group
 		SuperAdminProviderGroups superAdminProviderGroups
 		Logger                   chronograf.Logger
 		UseAuth                  bool
@@ -244,8 +245,8 @@ func TestService_Me(t *testing.T) {
 				Organization: "1",
 			},
 			wantStatus:      http.StatusForbidden,
-			wantContentType: "application/json",
-			wantBody:        `{"code":403,"message":"user's current organization was not found"}`,
+			wantContentType: "application/problem+json",
+			wantBody:        `{"code":403,"message":"user's current organization was not found","type":"urn:chronograf:problem:forbidden","title":"Forbidden","status":403,"detail":"user's current organization was not found"}`,
 		},
 		{
 			name: "default mapping applies to new user",
@@ -552,8 +553,8 @@ func TestService_Me(t *testing.T) {
 				Issuer:  "heroku",
 			},
 			wantStatus:      http.StatusForbidden,
-			wantContentType: "application/json",
-			wantBody:        `{"code":403,"message":"This Chronograf is private. To gain access, you must be explicitly added by an administrator."}`,
+			wantContentType: "application/problem+json",
+			wantBody:        `{"code":403,"message":"This Chronograf is private. To gain access, you must be explicitly added by an administrator.","type":"urn:chronograf:problem:forbidden","title":"Forbidden","status":403,"detail":"This Chronograf is private. To gain access, you must be explicitly added by an administrator."}`,
 		},
 		{
 			name: "No Auth",
@@ -653,8 +654,81 @@ func TestService_Me(t *testing.T) {
 				Issuer:  "auth0",
 			},
 			wantStatus:      http.StatusForbidden,
+			wantContentType: "application/problem+json",
+			wantBody:        `{"code":403,"message":"This Chronograf is private. To gain access, you must be explicitly added by an administrator.","type":"urn:chronograf:problem:forbidden","title":"Forbidden","status":403,"detail":"This Chronograf is private. To gain access, you must be explicitly added by an administrator."}`,
+		},
+		{
+			name: "new user - Chronograf is private, new users are auto-provisioned",
+			args: args{
+				w: httptest.NewRecorder(),
+				r: httptest.NewRequest("GET", "http://example.com/foo", nil),
+			},
+			fields: fields{
+				UseAuth: true,
+				Logger:  &chronograf.NoopLogger{},
+				ConfigStore: mocks.ConfigStore{
+					Config: &chronograf.Config{
+						Auth: chronograf.AuthConfig{
+							AutoProvisionNewUsers: true,
+							NewUsersOrganization:  "1",
+							NewUsersRole:          roles.EditorRoleName,
+						},
+					},
+				},
+				MappingsStore: &mocks.MappingsStore{
+					AllF: func(ctx context.Context) ([]chronograf.Mapping, error) {
+						return []chronograf.Mapping{}, nil
+					},
+				},
+				OrganizationsStore: &mocks.OrganizationsStore{
+					DefaultOrganizationF: func(ctx context.Context) (*chronograf.Organization, error) {
+						return &chronograf.Organization{
+							ID:          "0",
+							Name:        "The Gnarly Default",
+							DefaultRole: roles.ViewerRoleName,
+						}, nil
+					},
+					GetF: func(ctx context.Context, q chronograf.OrganizationQuery) (*chronograf.Organization, error) {
+						if *q.ID == "1" {
+							return &chronograf.Organization{
+								ID:          "1",
+								Name:        "Planet Express",
+								DefaultRole: roles.ViewerRoleName,
+							}, nil
+						}
+						return &chronograf.Organization{
+							ID:          "0",
+							Name:        "The Gnarly Default",
+							DefaultRole: roles.ViewerRoleName,
+						}, nil
+					},
+				},
+				UsersStore: &mocks.UsersStore{
+					NumF: func(ctx context.Context) (int, error) {
+						// This function gets to verify that there is at least one first user
+						return 1, nil
+					},
+					GetF: func(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+						if q.Name == nil || q.Provider == nil || q.Scheme == nil {
+							return nil, fmt.Errorf("invalid user query: missing Name, Provider, and/or Scheme")
+						}
+						return nil, chronograf.ErrUserNotFound
+					},
+					AddF: func(ctx context.Context, u *chronograf.User) (*chronograf.User, error) {
+						return u, nil
+					},
+					UpdateF: func(ctx context.Context, u *chronograf.User) error {
+						return nil
+					},
+				},
+			},
+			principal: oauth2.Principal{
+				Subject: "secret",
+				Issuer:  "auth0",
+			},
+			wantStatus:      http.StatusOK,
 			wantContentType: "application/json",
-			wantBody:        `{"code":403,"message":"This Chronograf is private. To gain access, you must be explicitly added by an administrator."}`,
+			wantBody:        `{"name":"secret","roles":[{"name":"editor","organization":"1"}],"provider":"auth0","scheme":"oauth2","links":{"self":"/chronograf/v1/organizations/0/users/0"},"organizations":[{"id":"1","name":"Planet Express","defaultRole":"viewer"}],"currentOrganization":{"id":"0","name":"The Gnarly Default","defaultRole":"viewer"}}`,
 		},
 		{
 			name: "new user - Chronograf is private, user is in auth0 superadmin group",
@@ -789,8 +863,8 @@ func TestService_Me(t *testing.T) {
 				Group:   "not_example",
 			},
 			wantStatus:      http.StatusForbidden,
-			wantContentType: "application/json",
-			wantBody:        `{"code":403,"message":"This Chronograf is private. To gain access, you must be explicitly added by an administrator."}`,
+			wantContentType: "application/problem+json",
+			wantBody:        `{"code":403,"message":"This Chronograf is private. To gain access, you must be explicitly added by an administrator.","type":"urn:chronograf:problem:forbidden","title":"Forbidden","status":403,"detail":"This Chronograf is private. To gain access, you must be explicitly added by an administrator."}`,
 		},
 		{
 			name: "new user - Chronograf is not private, user is in auth0 superadmin group",
@@ -1114,6 +1188,7 @@ func TestService_Me(t *testing.T) {
 				OrganizationsStore: tt.fields.OrganizationsStore,
 				MappingsStore:      tt.fields.MappingsStore,
 				ConfigStore:        tt.fields.ConfigStore,
+				GroupsStore:        groupsStore(tt.fields.Groups...),
 			},
 			Logger:                   tt.fields.Logger,
 			UseAuth:                  tt.fields.UseAuth,
@@ -1145,6 +1220,7 @@ func TestService_UpdateMe(t *testing.T) {
 	type fields struct {
 		UsersStore         chronograf.UsersStore
 		OrganizationsStore chronograf.OrganizationsStore
+		Groups             []chronograf.Group
 		Logger             chronograf.Logger
 		UseAuth            bool
 	}
@@ -1363,8 +1439,8 @@ func TestService_UpdateMe(t *testing.T) {
 				Organization: "1338",
 			},
 			wantStatus:      http.StatusForbidden,
-			wantContentType: "application/json",
-			wantBody:        `{"code":403,"message":"user not found"}`,
+			wantContentType: "application/problem+json",
+			wantBody:        `{"code":403,"message":"user not found","type":"urn:chronograf:problem:forbidden","title":"Forbidden","status":403,"detail":"user not found"}`,
 		},
 		{
 			name: "Unable to find requested organization",
@@ -1417,8 +1493,8 @@ func TestService_UpdateMe(t *testing.T) {
 				Organization: "1338",
 			},
 			wantStatus:      http.StatusBadRequest,
-			wantContentType: "application/json",
-			wantBody:        `{"code":400,"message":"organization not found"}`,
+			wantContentType: "application/problem+json",
+			wantBody:        `{"code":400,"message":"organization not found","type":"urn:chronograf:problem:invalid-request","title":"Bad Request","status":400,"detail":"organization not found"}`,
 		},
 	}
 	for _, tt := range tests {
@@ -1427,6 +1503,7 @@ func TestService_UpdateMe(t *testing.T) {
 			Store: &Store{
 				UsersStore:         tt.fields.UsersStore,
 				OrganizationsStore: tt.fields.OrganizationsStore,
+				GroupsStore:        groupsStore(tt.fields.Groups...),
 			},
 			Logger:  tt.fields.Logger,
 			UseAuth: tt.fields.UseAuth,
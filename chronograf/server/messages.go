@@ -0,0 +1,209 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// defaultLanguage is the language every message is written in within the
+// source code. Its messages double as the keys of every other catalog.
+const defaultLanguage = "en"
+
+// catalogs translate English message formats into other languages. Each
+// translation must use the same formatting verbs in the same order.
+var catalogs = map[string]map[string]string{
+	"de": {
+		"unparsable JSON": "JSON konnte nicht gelesen werden",
+		"ID %v not found": "ID %v nicht gefunden",
+		"name required on Chronograf User request body":                                   "Name ist im Chronograf-Benutzer-Request erforderlich",
+		"provider required on Chronograf User request body":                               "Provider ist im Chronograf-Benutzer-Request erforderlich",
+		"scheme required on Chronograf User request body":                                 "Schema ist im Chronograf-Benutzer-Request erforderlich",
+		"no Roles to update":                                                              "keine Rollen zum Aktualisieren",
+		"no organization was provided":                                                    "es wurde keine Organisation angegeben",
+		"duplicate organization %q in roles":                                              "doppelte Organisation %q in den Rollen",
+		"unknown role %s. Valid roles are 'member', 'viewer', 'editor', 'admin', and '*'": "unbekannte Rolle %s. Gültige Rollen sind 'member', 'viewer', 'editor', 'admin' und '*'",
+		"cannot update Name":                                                              "Name kann nicht geändert werden",
+		"cannot update Provider":                                                          "Provider kann nicht geändert werden",
+		"cannot update Scheme":                                                            "Schema kann nicht geändert werden",
+		"user cannot modify their own SuperAdmin status":                                  "Benutzer können ihren eigenen SuperAdmin-Status nicht ändern",
+		"name required on Chronograf Organization request body":                           "Name ist im Chronograf-Organisations-Request erforderlich",
+		"no fields to update":                                                             "keine Felder zum Aktualisieren",
+		"default role must be member, viewer, editor, or admin":                           "Standardrolle muss member, viewer, editor oder admin sein",
+		"user does not have authorization required to set SuperAdmin status. See https://github.com/influxdata/influxdb/chronograf/issues/2601 for more information": "Benutzer ist nicht berechtigt, den SuperAdmin-Status zu setzen. Weitere Informationen unter https://github.com/influxdata/influxdb/chronograf/issues/2601",
+	},
+	"es": {
+		"unparsable JSON": "JSON no se pudo analizar",
+		"ID %v not found": "ID %v no encontrado",
+		"name required on Chronograf User request body":                                   "el nombre es obligatorio en la petición de usuario de Chronograf",
+		"provider required on Chronograf User request body":                               "el proveedor es obligatorio en la petición de usuario de Chronograf",
+		"scheme required on Chronograf User request body":                                 "el esquema es obligatorio en la petición de usuario de Chronograf",
+		"no Roles to update":                                                              "no hay roles que actualizar",
+		"no organization was provided":                                                    "no se indicó ninguna organización",
+		"duplicate organization %q in roles":                                              "organización %q duplicada en los roles",
+		"unknown role %s. Valid roles are 'member', 'viewer', 'editor', 'admin', and '*'": "rol desconocido %s. Los roles válidos son 'member', 'viewer', 'editor', 'admin' y '*'",
+		"cannot update Name":                                                              "no se puede actualizar el nombre",
+		"cannot update Provider":                                                          "no se puede actualizar el proveedor",
+		"cannot update Scheme":                                                            "no se puede actualizar el esquema",
+		"user cannot modify their own SuperAdmin status":                                  "un usuario no puede modificar su propio estado de SuperAdmin",
+		"name required on Chronograf Organization request body":                           "el nombre es obligatorio en la petición de organización de Chronograf",
+		"no fields to update":                                                             "no hay campos que actualizar",
+		"default role must be member, viewer, editor, or admin":                           "el rol por defecto debe ser member, viewer, editor o admin",
+		"user does not have authorization required to set SuperAdmin status. See https://github.com/influxdata/influxdb/chronograf/issues/2601 for more information": "el usuario no tiene autorización para establecer el estado de SuperAdmin. Consulte https://github.com/influxdata/influxdb/chronograf/issues/2601 para más información",
+	},
+	"fr": {
+		"unparsable JSON": "JSON illisible",
+		"ID %v not found": "ID %v introuvable",
+		"name required on Chronograf User request body":                                   "le nom est requis dans la requête d'utilisateur Chronograf",
+		"provider required on Chronograf User request body":                               "le fournisseur est requis dans la requête d'utilisateur Chronograf",
+		"scheme required on Chronograf User request body":                                 "le schéma est requis dans la requête d'utilisateur Chronograf",
+		"no Roles to update":                                                              "aucun rôle à mettre à jour",
+		"no organization was provided":                                                    "aucune organisation n'a été fournie",
+		"duplicate organization %q in roles":                                              "organisation %q en double dans les rôles",
+		"unknown role %s. Valid roles are 'member', 'viewer', 'editor', 'admin', and '*'": "rôle inconnu %s. Les rôles valides sont 'member', 'viewer', 'editor', 'admin' et '*'",
+		"cannot update Name":                                                              "impossible de modifier le nom",
+		"cannot update Provider":                                                          "impossible de modifier le fournisseur",
+		"cannot update Scheme":                                                            "impossible de modifier le schéma",
+		"user cannot modify their own SuperAdmin status":                                  "un utilisateur ne peut pas modifier son propre statut SuperAdmin",
+		"name required on Chronograf Organization request body":                           "le nom est requis dans la requête d'organisation Chronograf",
+		"no fields to update":                                                             "aucun champ à mettre à jour",
+		"default role must be member, viewer, editor, or admin":                           "le rôle par défaut doit être member, viewer, editor ou admin",
+		"user does not have authorization required to set SuperAdmin status. See https://github.com/influxdata/influxdb/chronograf/issues/2601 for more information": "l'utilisateur n'a pas l'autorisation de définir le statut SuperAdmin. Voir https://github.com/influxdata/influxdb/chronograf/issues/2601 pour plus d'informations",
+	},
+}
+
+// localizedError is an error whose message can be translated. Error returns
+// the message in the default language.
+type localizedError struct {
+	format string
+	args   []interface{}
+}
+
+// errorf is fmt.Errorf for user-facing messages that belong in the catalogs
+func errorf(format string, args ...interface{}) error {
+	return &localizedError{
+		format: format,
+		args:   args,
+	}
+}
+
+func (e *localizedError) Error() string {
+	return fmt.Sprintf(e.format, e.args...)
+}
+
+func (e *localizedError) localize(lang string) string {
+	if f, ok := catalogs[lang][e.format]; ok {
+		return fmt.Sprintf(f, e.args...)
+	}
+	return e.Error()
+}
+
+// negotiateLanguage picks the catalog best matching an Accept-Language
+// header, falling back to the default language.
+func negotiateLanguage(header string) string {
+	type preference struct {
+		lang string
+		q    float64
+	}
+	var prefs []preference
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(strings.TrimSpace(part), ";")
+		lang := strings.ToLower(strings.TrimSpace(fields[0]))
+		if lang == "" {
+			continue
+		}
+		q := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if v, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					q = v
+				}
+			}
+		}
+		if q <= 0 {
+			continue
+		}
+		prefs = append(prefs, preference{lang, q})
+	}
+	sort.SliceStable(prefs, func(i, j int) bool {
+		return prefs[i].q > prefs[j].q
+	})
+
+	for _, p := range prefs {
+		// Only the primary subtag is considered; e.g. de-CH uses the de catalog
+		lang := strings.SplitN(p.lang, "-", 2)[0]
+		if lang == defaultLanguage {
+			return defaultLanguage
+		}
+		if _, ok := catalogs[lang]; ok {
+			return lang
+		}
+	}
+	return defaultLanguage
+}
+
+// localizedResponseWriter carries the negotiated language of a request to
+// the functions writing error responses.
+type localizedResponseWriter struct {
+	http.ResponseWriter
+	lang string
+}
+
+func (w *localizedResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Localize negotiates the language of error messages using the
+// Accept-Language header of the request.
+func Localize(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lw := &localizedResponseWriter{
+			ResponseWriter: w,
+			lang:           negotiateLanguage(r.Header.Get("Accept-Language")),
+		}
+		next.ServeHTTP(lw, r)
+	})
+}
+
+// unwrapper is implemented by the response writers wrapping the writer of
+// Localize, such as the statusWriter of the routes recording metrics or traces
+type unwrapper interface {
+	Unwrap() http.ResponseWriter
+}
+
+// language returns the language negotiated for w, or for the writer it wraps
+func language(w http.ResponseWriter) string {
+	for {
+		switch rw := w.(type) {
+		case *localizedResponseWriter:
+			return rw.lang
+		case unwrapper:
+			w = rw.Unwrap()
+		default:
+			return defaultLanguage
+		}
+	}
+}
+
+// translate returns the message in the language negotiated for w, if the
+// message has a translation.
+func translate(w http.ResponseWriter, msg string) string {
+	if t, ok := catalogs[language(w)][msg]; ok {
+		return t
+	}
+	return msg
+}
+
+// localize returns the message of err in the language negotiated for w.
+// Errors built with errorf are translated including their arguments.
+func localize(w http.ResponseWriter, err error) string {
+	if le, ok := err.(*localizedError); ok {
+		return le.localize(language(w))
+	}
+	return translate(w, err.Error())
+}
@@ -0,0 +1,96 @@
+package server
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func Test_negotiateLanguage(t *testing.T) {
+	tests := []struct {
+		header string
+		want   string
+	}{
+		{header: "", want: "en"},
+		{header: "de", want: "de"},
+		{header: "de-CH", want: "de"},
+		{header: "FR-ca, en;q=0.8", want: "fr"},
+		{header: "en;q=0.5, es;q=0.9", want: "es"},
+		{header: "ja, fr;q=0.1", want: "fr"},
+		{header: "ja, *;q=0.5", want: "en"},
+		{header: "en, de", want: "en"},
+		{header: "de;q=0", want: "en"},
+	}
+	for _, tt := range tests {
+		if got := negotiateLanguage(tt.header); got != tt.want {
+			t.Errorf("negotiateLanguage(%q) = %v, want %v", tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestLocalize(t *testing.T) {
+	tests := []struct {
+		name         string
+		language     string
+		handler      http.HandlerFunc
+		wantBody     string
+		wantLanguage string
+	}{
+		{
+			name:     "Formatted validation error",
+			language: "de-DE,de;q=0.9",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				req := userRequest{Roles: []chronograf.Role{{Name: "chef", Organization: "1"}}}
+				invalidData(w, req.ValidRoles(), &chronograf.NoopLogger{})
+			},
+			wantBody:     `{"code":422,"message":"unbekannte Rolle chef. Gültige Rollen sind 'member', 'viewer', 'editor', 'admin' und '*'","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"unbekannte Rolle chef. Gültige Rollen sind 'member', 'viewer', 'editor', 'admin' und '*'"}`,
+			wantLanguage: "de",
+		},
+		{
+			name:     "Static error",
+			language: "fr",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				invalidJSON(w, &chronograf.NoopLogger{})
+			},
+			wantBody:     `{"code":400,"message":"JSON illisible","type":"urn:chronograf:problem:invalid-request","title":"Bad Request","status":400,"detail":"JSON illisible"}`,
+			wantLanguage: "fr",
+		},
+		{
+			name:     "Untranslated error",
+			language: "es",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				Error(w, http.StatusBadRequest, "something unexpected", &chronograf.NoopLogger{})
+			},
+			wantBody:     `{"code":400,"message":"something unexpected","type":"urn:chronograf:problem:invalid-request","title":"Bad Request","status":400,"detail":"something unexpected"}`,
+			wantLanguage: "es",
+		},
+		{
+			name:     "Unsupported language",
+			language: "ja",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				notFound(w, 7, &chronograf.NoopLogger{})
+			},
+			wantBody: `{"code":404,"message":"ID 7 not found","type":"urn:chronograf:problem:not-found","title":"Not Found","status":404,"detail":"ID 7 not found"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("GET", "http://any.url", nil)
+			r.Header.Set("Accept-Language", tt.language)
+			Localize(tt.handler).ServeHTTP(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if eq, _ := jsonEqual(string(body), tt.wantBody); !eq {
+				t.Errorf("%q. Localize() = %s, want %s", tt.name, string(body), tt.wantBody)
+			}
+			if got := resp.Header.Get("Content-Language"); got != tt.wantLanguage {
+				t.Errorf("%q. Localize() Content-Language = %q, want %q", tt.name, got, tt.wantLanguage)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package server
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/metrics"
+)
+
+const (
+	// metricsPath serves the internal metrics of Chronograf to Prometheus
+	metricsPath = "/metrics"
+	// queryCacheName and cardinalityCacheName label the lookups of the caches
+	// of proxied queries and of cardinalities
+	queryCacheName       = "query"
+	cardinalityCacheName = "cardinality"
+)
+
+var _ chronograf.Router = &InstrumentedRouter{}
+
+// InstrumentedRouter is an implementation of a chronograf.Router which
+// records the duration and status of the requests to each route of a
+// Delegated chronograf.Router. Routes are labelled by their pattern, so that
+// the requests of different resources share their metrics.
+type InstrumentedRouter struct {
+	Metrics  *metrics.Metrics
+	Delegate chronograf.Router
+}
+
+// instrument records the requests of the route of method and path
+func (ir *InstrumentedRouter) instrument(method, path string, next http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		sw := &statusWriter{
+			ResponseWriter: w,
+		}
+		if f, ok := w.(http.Flusher); ok {
+			sw.Flusher = f
+		}
+		next.ServeHTTP(sw, r)
+
+		// Handlers writing the body without a header respond with 200 OK
+		status := sw.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+		ir.Metrics.ObserveRequest(method, path, status, time.Since(start))
+	}
+}
+
+// DELETE defines a route responding to a DELETE request whose requests are
+// recorded
+func (ir *InstrumentedRouter) DELETE(path string, handler http.HandlerFunc) {
+	ir.Delegate.DELETE(path, ir.instrument("DELETE", path, handler))
+}
+
+// GET defines a route responding to a GET request whose requests are
+// recorded
+func (ir *InstrumentedRouter) GET(path string, handler http.HandlerFunc) {
+	ir.Delegate.GET(path, ir.instrument("GET", path, handler))
+}
+
+// POST defines a route responding to a POST request whose requests are
+// recorded
+func (ir *InstrumentedRouter) POST(path string, handler http.HandlerFunc) {
+	ir.Delegate.POST(path, ir.instrument("POST", path, handler))
+}
+
+// PUT defines a route responding to a PUT request whose requests are
+// recorded
+func (ir *InstrumentedRouter) PUT(path string, handler http.HandlerFunc) {
+	ir.Delegate.PUT(path, ir.instrument("PUT", path, handler))
+}
+
+// PATCH defines a route responding to a PATCH request whose requests are
+// recorded
+func (ir *InstrumentedRouter) PATCH(path string, handler http.HandlerFunc) {
+	ir.Delegate.PATCH(path, ir.instrument("PATCH", path, handler))
+}
+
+// Handler defines a route responding to a request type specified in the
+// method parameter whose requests are recorded
+func (ir *InstrumentedRouter) Handler(method string, path string, handler http.Handler) {
+	ir.Delegate.Handler(method, path, ir.instrument(method, path, handler))
+}
+
+// ServeHTTP is an implementation of http.Handler which delegates to the
+// configured Delegate's implementation of http.Handler
+func (ir *InstrumentedRouter) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	ir.Delegate.ServeHTTP(rw, r)
+}
+
+// MetricsAuthorized requires the bearer token of the scrapers of the metrics
+func MetricsAuthorized(token string, logger chronograf.Logger, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		bearer := strings.TrimPrefix(auth, "Bearer ")
+		if bearer == auth || subtle.ConstantTimeCompare([]byte(bearer), []byte(token)) != 1 {
+			logger.
+				WithField("component", "metrics").
+				WithField("remote_addr", r.RemoteAddr).
+				WithField("method", r.Method).
+				WithField("url", r.URL).
+				Error("Invalid metrics bearer token")
+			Error(w, http.StatusUnauthorized, "invalid bearer token", logger)
+			return
+		}
+		next(w, r)
+	}
+}
+
+// ActiveSessions marks the sessions of the principals of the requests as
+// active in m
+func ActiveSessions(m *metrics.Metrics, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if p, err := getValidPrincipal(r.Context()); err == nil {
+			m.Session(p.Issuer + ":" + p.Subject)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
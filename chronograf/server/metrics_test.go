@@ -0,0 +1,86 @@
+package server
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bouk/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/metrics"
+)
+
+func TestInstrumentedRouter(t *testing.T) {
+	m := metrics.New(5 * time.Minute)
+	router := &InstrumentedRouter{
+		Metrics: m,
+		Delegate: &MountableRouter{
+			Prefix:   "/chronograf",
+			Delegate: httprouter.New(),
+		},
+	}
+	router.GET("/chronograf/v1/sources/:id", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "Great Scott!")
+	})
+	router.DELETE("/chronograf/v1/sources/:id", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	router.GET(metricsPath, MetricsAuthorized("secret", &chronograf.NoopLogger{}, m.Handler().ServeHTTP))
+
+	for _, req := range []*http.Request{
+		httptest.NewRequest("GET", "http://any.url/chronograf/chronograf/v1/sources/1", nil),
+		httptest.NewRequest("GET", "http://any.url/chronograf/chronograf/v1/sources/2", nil),
+		httptest.NewRequest("DELETE", "http://any.url/chronograf/chronograf/v1/sources/2", nil),
+	} {
+		router.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "http://any.url/chronograf/metrics", nil))
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("MetricsAuthorized() without token = %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+
+	w = httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "http://any.url/chronograf/metrics", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("MetricsAuthorized() with token = %d, want %d", w.Code, http.StatusOK)
+	}
+	body, _ := ioutil.ReadAll(w.Result().Body)
+	want := []string{
+		`chronograf_http_request_duration_seconds_count{method="GET",route="/chronograf/v1/sources/:id",status="200"} 2`,
+		`chronograf_http_request_duration_seconds_count{method="DELETE",route="/chronograf/v1/sources/:id",status="204"} 1`,
+		`chronograf_http_request_duration_seconds_count{method="GET",route="/metrics",status="401"} 1`,
+	}
+	for _, line := range want {
+		if !strings.Contains(string(body), line) {
+			t.Errorf("InstrumentedRouter did not record %s in:\n%s", line, body)
+		}
+	}
+}
+
+func TestInstrumentedRouter_Localize(t *testing.T) {
+	router := &InstrumentedRouter{
+		Metrics:  metrics.New(5 * time.Minute),
+		Delegate: httprouter.New(),
+	}
+	router.POST("/chronograf/v1/sources", func(w http.ResponseWriter, r *http.Request) {
+		invalidJSON(w, &chronograf.NoopLogger{})
+	})
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("POST", "http://any.url/chronograf/v1/sources", nil)
+	r.Header.Set("Accept-Language", "fr")
+	Localize(router).ServeHTTP(w, r)
+
+	body, _ := ioutil.ReadAll(w.Result().Body)
+	if !strings.Contains(string(body), `"message":"JSON illisible"`) || w.Header().Get("Content-Language") != "fr" {
+		t.Errorf("Localize() of an instrumented route = %s, Content-Language %q", body, w.Header().Get("Content-Language"))
+	}
+}
@@ -0,0 +1,290 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bouk/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/qrcode"
+	"github.com/influxdata/influxdb/chronograf/totp"
+)
+
+const (
+	// totpIssuer names chronograf in authenticator apps
+	totpIssuer = "Chronograf"
+	// recoveryCodeCount is the number of recovery codes of an enrollment
+	recoveryCodeCount = 10
+	// qrCodeScale is the width in pixels of the modules of enrollment QR codes
+	qrCodeScale = 4
+)
+
+var (
+	// errMFARequired asks the client to repeat the login with a one-time password
+	errMFARequired = errorf("one-time password required")
+	// errInvalidMFACode does not reveal whether a one-time password or a
+	// recovery code was meant
+	errInvalidMFACode = errorf("invalid one-time password")
+
+	recoveryEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+)
+
+// verifyMFA checks the one-time password or recovery code of a login of u,
+// which it consumes. It returns whether u must be saved.
+func verifyMFA(u *chronograf.User, code string, now time.Time) bool {
+	if counter, ok := totp.Validate(u.TOTPSecret, code, now, u.TOTPCounter); ok {
+		u.TOTPCounter = counter
+		return true
+	}
+	code = normalizeRecoveryCode(code)
+	for i, hash := range u.RecoveryCodeHashes {
+		if checkPassword(hash, code) == nil {
+			u.RecoveryCodeHashes = append(u.RecoveryCodeHashes[:i:i], u.RecoveryCodeHashes[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// newRecoveryCodes returns recoveryCodeCount codes of 50 random bits
+// formatted as xxxxx-xxxxx and their hashes
+func newRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		b := make([]byte, 7)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		code := strings.ToLower(recoveryEncoding.EncodeToString(b))[:10]
+		hash, err := hashPassword(code)
+		if err != nil {
+			return nil, nil, err
+		}
+		codes[i] = code[:5] + "-" + code[5:]
+		hashes[i] = hash
+	}
+	return codes, hashes, nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	code = strings.Replace(code, "-", "", -1)
+	return strings.Replace(code, " ", "", -1)
+}
+
+type mfaResponse struct {
+	Enabled       bool `json:"enabled"`
+	RecoveryCodes int  `json:"recoveryCodes"` // RecoveryCodes is the number of unused recovery codes
+}
+
+type mfaEnrollRequest struct {
+	Password string `json:"password"`
+}
+
+type mfaEnrollResponse struct {
+	Secret string `json:"secret"`
+	URL    string `json:"url"`    // URL is the otpauth URI of the secret
+	QRCode string `json:"qrCode"` // QRCode is the URL as a PNG data URI
+}
+
+type mfaVerifyRequest struct {
+	Code string `json:"code"`
+}
+
+type mfaVerifyResponse struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+// meBasicUser returns the stored current user, who must be of the basic scheme
+func (s *Service) meBasicUser(ctx context.Context) (*chronograf.User, error) {
+	me, ok := hasUserContext(ctx)
+	if !ok || me.Scheme != BasicScheme {
+		return nil, errorf("only users of the basic scheme have two-factor authentication")
+	}
+	serverCtx := serverContext(ctx)
+	return s.Store.Users(serverCtx).Get(serverCtx, chronograf.UserQuery{ID: &me.ID})
+}
+
+// MeMFA responds whether two-factor authentication of the current user is enabled
+func (s *Service) MeMFA(w http.ResponseWriter, r *http.Request) {
+	u, err := s.meBasicUser(r.Context())
+	if err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	res := mfaResponse{
+		Enabled:       u.TOTPEnabled,
+		RecoveryCodes: len(u.RecoveryCodeHashes),
+	}
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// EnrollMeMFA creates a new TOTP secret of the current user, which logins
+// require once confirmed by VerifyMeMFA. The current password is required.
+func (s *Service) EnrollMeMFA(w http.ResponseWriter, r *http.Request) {
+	var req mfaEnrollRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	u, err := s.meBasicUser(ctx)
+	if err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	if u.PasswordHash == "" || checkPassword(u.PasswordHash, req.Password) != nil {
+		Error(w, http.StatusUnauthorized, "current password is incorrect", s.Logger)
+		return
+	}
+	if u.TOTPEnabled {
+		Error(w, http.StatusConflict, "two-factor authentication is already enabled", s.Logger)
+		return
+	}
+
+	secret, err := totp.NewSecret()
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	url := totp.URL(totpIssuer, u.Name, secret)
+	code, err := qrcode.Encode(url)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	png, err := code.PNG(qrCodeScale)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	u.TOTPSecret = secret
+	u.TOTPCounter = 0
+	serverCtx := serverContext(ctx)
+	if err := s.Store.Users(serverCtx).Update(serverCtx, u); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	res := mfaEnrollResponse{
+		Secret: secret,
+		URL:    url,
+		QRCode: "data:image/png;base64," + base64.StdEncoding.EncodeToString(png),
+	}
+	encodeJSON(w, http.StatusCreated, res, s.Logger)
+}
+
+// VerifyMeMFA enables two-factor authentication of the current user by a
+// one-time password of the secret of EnrollMeMFA and responds with new
+// recovery codes, which are only stored hashed.
+func (s *Service) VerifyMeMFA(w http.ResponseWriter, r *http.Request) {
+	var req mfaVerifyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	u, err := s.meBasicUser(ctx)
+	if err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	if u.TOTPEnabled {
+		Error(w, http.StatusConflict, "two-factor authentication is already enabled", s.Logger)
+		return
+	}
+	if u.TOTPSecret == "" {
+		invalidData(w, errorf("two-factor authentication has not been enrolled"), s.Logger)
+		return
+	}
+	counter, ok := totp.Validate(u.TOTPSecret, req.Code, time.Now(), u.TOTPCounter)
+	if !ok {
+		Error(w, http.StatusUnauthorized, errInvalidMFACode.Error(), s.Logger)
+		return
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	u.TOTPEnabled = true
+	u.TOTPCounter = counter
+	u.RecoveryCodeHashes = hashes
+	serverCtx := serverContext(ctx)
+	if err := s.Store.Users(serverCtx).Update(serverCtx, u); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	encodeJSON(w, http.StatusOK, mfaVerifyResponse{RecoveryCodes: codes}, s.Logger)
+}
+
+// DisableMeMFA turns two-factor authentication of the current user off. The
+// current password is required.
+func (s *Service) DisableMeMFA(w http.ResponseWriter, r *http.Request) {
+	var req mfaEnrollRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	u, err := s.meBasicUser(ctx)
+	if err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	if u.PasswordHash == "" || checkPassword(u.PasswordHash, req.Password) != nil {
+		Error(w, http.StatusUnauthorized, "current password is incorrect", s.Logger)
+		return
+	}
+
+	if err := s.resetMFA(serverContext(ctx), u); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ResetUserMFA turns two-factor authentication of a user off, e.g. for users
+// who lost both their authenticator and their recovery codes
+func (s *Service) ResetUserMFA(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	idStr := httprouter.GetParamFromContext(ctx, "id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		Error(w, http.StatusBadRequest, fmt.Sprintf("invalid user id: %s", err.Error()), s.Logger)
+		return
+	}
+
+	u, err := s.Store.Users(ctx).Get(ctx, chronograf.UserQuery{ID: &id})
+	if err != nil {
+		Error(w, http.StatusNotFound, err.Error(), s.Logger)
+		return
+	}
+	if err := s.resetMFA(ctx, u); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Service) resetMFA(ctx context.Context, u *chronograf.User) error {
+	u.TOTPSecret = ""
+	u.TOTPEnabled = false
+	u.TOTPCounter = 0
+	u.RecoveryCodeHashes = nil
+	return s.Store.Users(ctx).Update(ctx, u)
+}
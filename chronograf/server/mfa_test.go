@@ -0,0 +1,238 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+	"github.com/influxdata/influxdb/chronograf/totp"
+)
+
+const mfaTestSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestService_BasicLogin_mfa(t *testing.T) {
+	hash, err := hashPassword("correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	recoveryHash, err := hashPassword("abcdefghij")
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	code, err := totp.Code(mfaTestSecret, totp.Counter(now))
+	if err != nil {
+		t.Fatal(err)
+	}
+	user := func() chronograf.User {
+		return chronograf.User{
+			ID:                 1,
+			Name:               "bob",
+			Provider:           BasicProvider,
+			Scheme:             BasicScheme,
+			PasswordHash:       hash,
+			TOTPSecret:         mfaTestSecret,
+			TOTPEnabled:        true,
+			RecoveryCodeHashes: []string{recoveryHash},
+		}
+	}
+	used := user()
+	used.TOTPCounter = totp.Counter(now)
+
+	tests := []struct {
+		name              string
+		user              chronograf.User
+		body              string
+		wantStatus        int
+		wantBody          string
+		wantCounter       uint64
+		wantRecoveryCodes int
+	}{
+		{
+			name:       "Missing one-time password",
+			user:       user(),
+			body:       `{"name":"bob","password":"correct horse"}`,
+			wantStatus: http.StatusUnauthorized,
+			wantBody:   `{"code":401,"message":"one-time password required","type":"urn:chronograf:problem:unauthorized","title":"Unauthorized","status":401,"detail":"one-time password required"}`,
+		},
+		{
+			name:              "One-time password",
+			user:              user(),
+			body:              fmt.Sprintf(`{"name":"bob","password":"correct horse","code":"%s"}`, code),
+			wantStatus:        http.StatusNoContent,
+			wantCounter:       totp.Counter(now),
+			wantRecoveryCodes: 1,
+		},
+		{
+			name:       "Used one-time password",
+			user:       used,
+			body:       fmt.Sprintf(`{"name":"bob","password":"correct horse","code":"%s"}`, code),
+			wantStatus: http.StatusUnauthorized,
+			wantBody:   `{"code":401,"message":"invalid one-time password","type":"urn:chronograf:problem:unauthorized","title":"Unauthorized","status":401,"detail":"invalid one-time password"}`,
+		},
+		{
+			name:       "Recovery code",
+			user:       user(),
+			body:       `{"name":"bob","password":"correct horse","code":"ABCDE-FGHIJ"}`,
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "Wrong password with one-time password",
+			user:       user(),
+			body:       fmt.Sprintf(`{"name":"bob","password":"battery staple","code":"%s"}`, code),
+			wantStatus: http.StatusUnauthorized,
+			wantBody:   `{"code":401,"message":"invalid username or password","type":"urn:chronograf:problem:unauthorized","title":"Unauthorized","status":401,"detail":"invalid username or password"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{
+				Store: &mocks.Store{
+					UsersStore: basicTestUsersStore(&tt.user),
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url/basic/login", bytes.NewBufferString(tt.body))
+
+			auth := &basicTestAuthenticator{}
+			s.BasicLogin(auth)(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("%q. BasicLogin() = %v, want %v", tt.name, resp.StatusCode, tt.wantStatus)
+			}
+			if tt.wantBody == "" {
+				if auth.authorized == nil {
+					t.Errorf("%q. BasicLogin() did not authorize", tt.name)
+				}
+				if tt.user.TOTPCounter != tt.wantCounter || len(tt.user.RecoveryCodeHashes) != tt.wantRecoveryCodes {
+					t.Errorf("%q. BasicLogin() did not consume the code", tt.name)
+				}
+				return
+			}
+			if auth.authorized != nil {
+				t.Errorf("%q. BasicLogin() authorized %v", tt.name, auth.authorized)
+			}
+			if eq, _ := jsonEqual(string(body), tt.wantBody); !eq {
+				t.Errorf("%q. BasicLogin() = \n***%v***\n,\nwant\n***%v***", tt.name, string(body), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestService_MeMFA_enrollment(t *testing.T) {
+	hash, err := hashPassword("correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	user := chronograf.User{ID: 1, Name: "bob", Provider: BasicProvider, Scheme: BasicScheme, PasswordHash: hash}
+	s := &Service{
+		Store: &mocks.Store{
+			UsersStore: basicTestUsersStore(&user),
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+	do := func(handler http.HandlerFunc, method, body string) (int, []byte) {
+		me := user
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(method, "http://any.url/chronograf/v1/me/mfa", bytes.NewBufferString(body))
+		r = r.WithContext(context.WithValue(r.Context(), UserContextKey, &me))
+		handler(w, r)
+		resp := w.Result()
+		b, _ := ioutil.ReadAll(resp.Body)
+		return resp.StatusCode, b
+	}
+
+	if status, _ := do(s.EnrollMeMFA, "POST", `{"password":"battery staple"}`); status != http.StatusUnauthorized {
+		t.Fatalf("EnrollMeMFA() with a wrong password = %d, want %d", status, http.StatusUnauthorized)
+	}
+
+	status, body := do(s.EnrollMeMFA, "POST", `{"password":"correct horse"}`)
+	if status != http.StatusCreated {
+		t.Fatalf("EnrollMeMFA() = %d, want %d: %s", status, http.StatusCreated, body)
+	}
+	var enrollment mfaEnrollResponse
+	if err := json.Unmarshal(body, &enrollment); err != nil {
+		t.Fatal(err)
+	}
+	if enrollment.Secret == "" || enrollment.Secret != user.TOTPSecret {
+		t.Errorf("EnrollMeMFA() secret = %q, stored %q", enrollment.Secret, user.TOTPSecret)
+	}
+	if !strings.HasPrefix(enrollment.URL, "otpauth://totp/Chronograf:bob?") {
+		t.Errorf("EnrollMeMFA() url = %q", enrollment.URL)
+	}
+	if !strings.HasPrefix(enrollment.QRCode, "data:image/png;base64,") {
+		t.Errorf("EnrollMeMFA() qrCode = %.40q", enrollment.QRCode)
+	}
+	if user.TOTPEnabled {
+		t.Errorf("EnrollMeMFA() enabled two-factor authentication before verification")
+	}
+
+	if status, _ := do(s.VerifyMeMFA, "POST", `{"code":"000000"}`); status != http.StatusUnauthorized {
+		t.Errorf("VerifyMeMFA() with a wrong code = %d, want %d", status, http.StatusUnauthorized)
+	}
+	code, err := totp.Code(enrollment.Secret, totp.Counter(time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	status, body = do(s.VerifyMeMFA, "POST", fmt.Sprintf(`{"code":"%s"}`, code))
+	if status != http.StatusOK {
+		t.Fatalf("VerifyMeMFA() = %d, want %d: %s", status, http.StatusOK, body)
+	}
+	var verification mfaVerifyResponse
+	if err := json.Unmarshal(body, &verification); err != nil {
+		t.Fatal(err)
+	}
+	if !user.TOTPEnabled || len(verification.RecoveryCodes) != recoveryCodeCount || len(user.RecoveryCodeHashes) != recoveryCodeCount {
+		t.Fatalf("VerifyMeMFA() enabled %v with %d recovery codes", user.TOTPEnabled, len(verification.RecoveryCodes))
+	}
+	for i, code := range verification.RecoveryCodes {
+		if checkPassword(user.RecoveryCodeHashes[i], normalizeRecoveryCode(code)) != nil {
+			t.Errorf("VerifyMeMFA() recovery code %s is not stored", code)
+		}
+	}
+
+	if status, _ := do(s.EnrollMeMFA, "POST", `{"password":"correct horse"}`); status != http.StatusConflict {
+		t.Errorf("EnrollMeMFA() when enabled = %d, want %d", status, http.StatusConflict)
+	}
+	if status, body := do(s.MeMFA, "GET", ""); status != http.StatusOK {
+		t.Errorf("MeMFA() = %d, want %d", status, http.StatusOK)
+	} else if eq, _ := jsonEqual(string(body), `{"enabled":true,"recoveryCodes":10}`); !eq {
+		t.Errorf("MeMFA() = %s", body)
+	}
+
+	if status, _ := do(s.DisableMeMFA, "DELETE", `{"password":"correct horse"}`); status != http.StatusNoContent {
+		t.Errorf("DisableMeMFA() = %d, want %d", status, http.StatusNoContent)
+	}
+	if user.TOTPEnabled || user.TOTPSecret != "" || len(user.RecoveryCodeHashes) != 0 {
+		t.Errorf("DisableMeMFA() did not turn two-factor authentication off")
+	}
+}
+
+func TestService_MeMFA_oauth2(t *testing.T) {
+	user := chronograf.User{ID: 1, Name: "bob", Provider: "github", Scheme: "oauth2"}
+	s := &Service{
+		Store: &mocks.Store{
+			UsersStore: basicTestUsersStore(&user),
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "http://any.url/chronograf/v1/me/mfa", nil)
+	r = r.WithContext(context.WithValue(r.Context(), UserContextKey, &user))
+	s.MeMFA(w, r)
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("MeMFA() = %d, want %d", w.Code, http.StatusUnprocessableEntity)
+	}
+}
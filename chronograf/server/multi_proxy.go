@@ -0,0 +1,153 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// maxProxyQueries is the most queries proxied by a single request
+const maxProxyQueries = 100
+
+type proxyQueryRequest struct {
+	ID      string `json:"id,omitempty"` // ID identifies the query among the results, e.g. the ID of its query config
+	Source  string `json:"source"`       // Source is the link to the source queried, e.g. /chronograf/v1/sources/1
+	Command string `json:"query"`
+	DB      string `json:"db,omitempty"`
+	RP      string `json:"rp,omitempty"`
+	Epoch   string `json:"epoch,omitempty"`
+}
+
+type multiSourceProxyRequest struct {
+	Queries []proxyQueryRequest `json:"queries"`
+}
+
+func (r *multiSourceProxyRequest) Valid() error {
+	if len(r.Queries) == 0 {
+		return fmt.Errorf("queries required")
+	}
+	if len(r.Queries) > maxProxyQueries {
+		return fmt.Errorf("at most %d queries may be proxied at once", maxProxyQueries)
+	}
+	for i, q := range r.Queries {
+		if q.Command == "" {
+			return fmt.Errorf("query field required for query %d", i)
+		}
+		if _, ok := sourceLinkID(q.Source); !ok {
+			return fmt.Errorf("invalid source %q for query %d", q.Source, i)
+		}
+	}
+	return nil
+}
+
+type proxyQuerySource struct {
+	ID   int    `json:"id,string"`
+	Name string `json:"name,omitempty"`
+	Type string `json:"type,omitempty"`
+	Link string `json:"link"`
+}
+
+type proxyQueryResponse struct {
+	ID      string           `json:"id,omitempty"`
+	Source  proxyQuerySource `json:"source"`            // Source describes the source queried, so that series of different sources can be told apart
+	Results interface{}      `json:"results,omitempty"` // Results are the results of the query as returned by the source
+	Error   string           `json:"error,omitempty"`
+}
+
+type multiSourceProxyResponse struct {
+	Results []proxyQueryResponse `json:"results"`
+}
+
+// MultiSourceProxy runs queries against the sources each query links to,
+// such as the queries of a cell comparing two InfluxDB clusters. Queries run
+// concurrently; their results are returned in the order of the queries along
+// with the source that answered them. Queries that fail, including those of
+// sources outside of the current organization, are returned with their error
+// rather than failing the request. Like the proxy of a single source, results
+// may be streamed as CSV or JSON lines instead.
+func (s *Service) MultiSourceProxy(w http.ResponseWriter, r *http.Request) {
+	var req multiSourceProxyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	if err := req.Valid(); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	format, err := queryExportFormat(r)
+	if err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	if format != "" {
+		s.exportMultiSourceQueries(ctx, w, req, format)
+		return
+	}
+	now := time.Now()
+	user, _ := hasUserContext(ctx)
+	res := multiSourceProxyResponse{
+		Results: make([]proxyQueryResponse, len(req.Queries)),
+	}
+	var wg sync.WaitGroup
+	for i, q := range req.Queries {
+		wg.Add(1)
+		go func(i int, q proxyQueryRequest) {
+			defer wg.Done()
+			id, _ := sourceLinkID(q.Source)
+			result := proxyQueryResponse{
+				ID: q.ID,
+				Source: proxyQuerySource{
+					ID:   id,
+					Link: q.Source,
+				},
+			}
+			defer func() { res.Results[i] = result }()
+
+			src, err := s.linkedSource(ctx, q.Source)
+			if err != nil {
+				result.Error = err.Error()
+				return
+			}
+			result.Source.Name = src.Name
+			result.Source.Type = src.Type
+
+			// Queries count against the limits of their source and user
+			// as if they were proxied one by one
+			if err := s.QueryLimiter.ValidRange(q.Command, now); err != nil {
+				result.Error = err.Error()
+				return
+			}
+			release, err := s.QueryLimiter.Acquire(src.ID, user, now)
+			if err != nil {
+				result.Error = err.Error()
+				return
+			}
+			defer release()
+
+			query := chronograf.Query{
+				Command: q.Command,
+				DB:      q.DB,
+				RP:      q.RP,
+				Epoch:   q.Epoch,
+			}
+			start := time.Now()
+			response, err := s.querySource(ctx, src, query)
+			s.recordQuery(ctx, src, query, start, err)
+			if err != nil {
+				result.Error = err.Error()
+				return
+			}
+			result.Results = response
+		}(i, q)
+	}
+	wg.Wait()
+
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+// sourceTimeSeries answers queries with the name of the source queried
+type sourceTimeSeries struct{}
+
+func (sourceTimeSeries) New(src chronograf.Source, lg chronograf.Logger) (chronograf.TimeSeries, error) {
+	return &mocks.TimeSeries{
+		ConnectF: func(context.Context, *chronograf.Source) error {
+			return nil
+		},
+		QueryF: func(ctx context.Context, q chronograf.Query) (chronograf.Response, error) {
+			return mocks.NewResponse(`[{"statement_id":0,"series":[{"name":"cpu","tags":{"cluster":"`+src.Name+`"}}]}]`, nil), nil
+		},
+	}, nil
+}
+
+func TestService_MultiSourceProxy(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		want       string
+	}{
+		{
+			name: "Queries of two sources",
+			body: `{"queries":[
+{"id":"a","source":"/chronograf/v1/sources/1","query":"SELECT usage_user FROM cpu","db":"telegraf"},
+{"id":"b","source":"/chronograf/v1/sources/2","query":"SELECT usage_user FROM cpu","db":"telegraf"}]}`,
+			wantStatus: http.StatusOK,
+			want: `{"results":[
+{"id":"a","source":{"id":"1","name":"east","type":"influx","link":"/chronograf/v1/sources/1"},"results":[{"statement_id":0,"series":[{"name":"cpu","tags":{"cluster":"east"}}]}]},
+{"id":"b","source":{"id":"2","name":"west","type":"influx","link":"/chronograf/v1/sources/2"},"results":[{"statement_id":0,"series":[{"name":"cpu","tags":{"cluster":"west"}}]}]}]}`,
+		},
+		{
+			name: "Source of another organization",
+			body: `{"queries":[
+{"id":"a","source":"/chronograf/v1/sources/1","query":"SELECT usage_user FROM cpu"},
+{"id":"b","source":"/chronograf/v1/sources/3","query":"SELECT usage_user FROM cpu"}]}`,
+			wantStatus: http.StatusOK,
+			want: `{"results":[
+{"id":"a","source":{"id":"1","name":"east","type":"influx","link":"/chronograf/v1/sources/1"},"results":[{"statement_id":0,"series":[{"name":"cpu","tags":{"cluster":"east"}}]}]},
+{"id":"b","source":{"id":"3","link":"/chronograf/v1/sources/3"},"error":"source 3 not found"}]}`,
+		},
+		{
+			name:       "Invalid source link",
+			body:       `{"queries":[{"source":"http://influxdb:8086","query":"SELECT usage_user FROM cpu"}]}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "No queries",
+			body:       `{"queries":[]}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{
+				Store: &mocks.Store{
+					SourcesStore: &mocks.SourcesStore{
+						GetF: func(ctx context.Context, id int) (chronograf.Source, error) {
+							switch id {
+							case 1:
+								return chronograf.Source{ID: 1, Name: "east", Type: chronograf.InfluxDB}, nil
+							case 2:
+								return chronograf.Source{ID: 2, Name: "west", Type: chronograf.InfluxDB}, nil
+							}
+							return chronograf.Source{}, chronograf.ErrSourceNotFound
+						},
+					},
+				},
+				TimeSeriesClient: sourceTimeSeries{},
+				Logger:           &chronograf.NoopLogger{},
+			}
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url/chronograf/v1/proxy", bytes.NewBufferString(tt.body))
+			s.MultiSourceProxy(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("MultiSourceProxy() = %v, want %v: %s", resp.StatusCode, tt.wantStatus, body)
+			}
+			if tt.want != "" {
+				if eq, _ := jsonEqual(string(body), tt.want); !eq {
+					t.Errorf("MultiSourceProxy() = %s, want %s", body, tt.want)
+				}
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"path"
 	"strconv"
 	"strings"
+	"time"
 
 	_ "net/http/pprof"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/bouk/httprouter"
 	jhttprouter "github.com/influxdata/httprouter"
 	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/metrics"
 	"github.com/influxdata/influxdb/chronograf/oauth2"
 	"github.com/influxdata/influxdb/chronograf/roles"
 )
@@ -34,6 +36,17 @@ type MuxOpts struct {
 	StatusFeedURL string            // JSON Feed URL for the client Status page News Feed
 	CustomLinks   map[string]string // Any custom external links for client's User menu
 	PprofEnabled  bool              // Mount pprof routes for profiling
+	SCIMToken     string            // SCIMToken authorizes SCIM clients; SCIM is disabled when empty
+	BasicAuth     bool              // BasicAuth enables the login of users of the basic scheme by username and password
+	LDAP          LDAPDirectory     // LDAP authenticates users of the ldap scheme; LDAP login is disabled when nil
+	SAML          *SAMLAuth         // SAML authenticates users of the saml scheme; SAML login is disabled when nil
+	HeaderAuth    *HeaderAuth       // HeaderAuth authenticates users of the header scheme by the headers of a reverse proxy; it is disabled when nil
+	Metrics       *metrics.Metrics  // Metrics records the requests of the routes and is served at /metrics; metrics are disabled when nil
+	MetricsToken  string            // MetricsToken authorizes the scrapers of /metrics; /metrics is public when empty
+	Tracing       bool              // Tracing starts a span for the requests of every route but /metrics
+	RateLimiter   *RateLimiter      // RateLimiter limits the requests of every route but /metrics by user and by IP; requests are unlimited when nil
+	Impersonation time.Duration     // Impersonation is the lifespan of the sessions of SuperAdmins impersonating users; impersonation is disabled when zero
+	Version       string            // Version of Chronograf documented by the OpenAPI document of the API
 }
 
 // NewMux attaches all the route handlers; handler returned servers chronograf.
@@ -71,6 +84,43 @@ func NewMux(opts MuxOpts, service Service) http.Handler {
 		hr.NotFound = http.StripPrefix(opts.Basepath, hr.NotFound)
 	}
 
+	// Record every route to generate the OpenAPI document of the API
+	routes := &RouteTable{
+		Delegate: router,
+	}
+	router = routes
+
+	if opts.Metrics != nil {
+		// Record the requests of every route added from now on
+		router = &InstrumentedRouter{
+			Metrics:  opts.Metrics,
+			Delegate: router,
+		}
+
+		// Prometheus scrapes the internal metrics of Chronograf
+		serveMetrics := opts.Metrics.Handler().ServeHTTP
+		if opts.MetricsToken != "" {
+			serveMetrics = MetricsAuthorized(opts.MetricsToken, opts.Logger, serveMetrics)
+		}
+		router.GET(metricsPath, serveMetrics)
+	}
+
+	if opts.Tracing {
+		// Trace the requests of every route added from now on
+		router = &TracedRouter{
+			Delegate: router,
+		}
+	}
+
+	if opts.RateLimiter != nil {
+		// Limit the requests of every route added from now on
+		router = &RateLimitedRouter{
+			Limiter:  opts.RateLimiter,
+			Logger:   opts.Logger,
+			Delegate: router,
+		}
+	}
+
 	EnsureMember := func(next http.HandlerFunc) http.HandlerFunc {
 		return AuthorizedUser(
 			service.Store,
@@ -80,7 +130,6 @@ func NewMux(opts MuxOpts, service Service) http.Handler {
 			next,
 		)
 	}
-	_ = EnsureMember
 	EnsureViewer := func(next http.HandlerFunc) http.HandlerFunc {
 		return AuthorizedUser(
 			service.Store,
@@ -136,18 +185,28 @@ func NewMux(opts MuxOpts, service Service) http.Handler {
 		router.GET("/debug/pprof/:thing", http.DefaultServeMux.ServeHTTP)
 	}
 
+	/* Probes */
+	// Liveness and readiness probes of Kubernetes and load balancers
+	router.GET("/healthz", service.Healthz)
+	router.GET("/readyz", service.Readyz)
+
 	/* Documentation */
 	router.GET("/swagger.json", Spec())
 	router.GET("/docs", Redoc("/swagger.json"))
+	// OpenAPI 3.0 document generated from the routes of the API
+	router.GET("/chronograf/v1/swagger.json", OpenAPI(routes, opts))
 
 	/* API */
 	// Organizations
 	router.GET("/chronograf/v1/organizations", EnsureAdmin(service.Organizations))
 	router.POST("/chronograf/v1/organizations", EnsureSuperAdmin(service.NewOrganization))
+	// Upserts create or replace a resource identified by the natural key of a query parameter
+	router.PUT("/chronograf/v1/organizations", EnsureSuperAdmin(service.UpsertOrganization))
 
 	router.GET("/chronograf/v1/organizations/:oid", EnsureAdmin(service.OrganizationID))
 	router.PATCH("/chronograf/v1/organizations/:oid", EnsureSuperAdmin(service.UpdateOrganization))
 	router.DELETE("/chronograf/v1/organizations/:oid", EnsureSuperAdmin(service.RemoveOrganization))
+	router.GET("/chronograf/v1/organizations/:oid/usage", EnsureAdmin(ensureOrgMatches(service.OrganizationUsage)))
 
 	// Mappings
 	router.GET("/chronograf/v1/mappings", EnsureSuperAdmin(service.Mappings))
@@ -156,12 +215,88 @@ func NewMux(opts MuxOpts, service Service) http.Handler {
 	router.PUT("/chronograf/v1/mappings/:id", EnsureSuperAdmin(service.UpdateMapping))
 	router.DELETE("/chronograf/v1/mappings/:id", EnsureSuperAdmin(service.RemoveMapping))
 
+	// Mappings of TLS client certificates to users
+	router.GET("/chronograf/v1/certificates", EnsureSuperAdmin(rawStoreAccess(service.Certificates)))
+	router.POST("/chronograf/v1/certificates", EnsureSuperAdmin(rawStoreAccess(service.NewCertificate)))
+	router.PUT("/chronograf/v1/certificates/:id", EnsureSuperAdmin(rawStoreAccess(service.UpdateCertificate)))
+	router.DELETE("/chronograf/v1/certificates/:id", EnsureSuperAdmin(rawStoreAccess(service.RemoveCertificate)))
+
+	// Groups of users whose members have the roles of the group
+	router.GET("/chronograf/v1/groups", EnsureSuperAdmin(rawStoreAccess(service.Groups)))
+	router.POST("/chronograf/v1/groups", EnsureSuperAdmin(rawStoreAccess(service.NewGroup)))
+	router.GET("/chronograf/v1/groups/:id", EnsureSuperAdmin(rawStoreAccess(service.GroupID)))
+	router.PUT("/chronograf/v1/groups/:id", EnsureSuperAdmin(rawStoreAccess(service.UpdateGroup)))
+	router.DELETE("/chronograf/v1/groups/:id", EnsureSuperAdmin(rawStoreAccess(service.RemoveGroup)))
+	router.PUT("/chronograf/v1/groups/:id/users/:uid", EnsureSuperAdmin(rawStoreAccess(service.AddGroupUser)))
+	router.DELETE("/chronograf/v1/groups/:id/users/:uid", EnsureSuperAdmin(rawStoreAccess(service.RemoveGroupUser)))
+
+	// Webhooks posted the events of users, dashboards and sources
+	router.GET("/chronograf/v1/webhooks", EnsureSuperAdmin(rawStoreAccess(service.Webhooks)))
+	router.POST("/chronograf/v1/webhooks", EnsureSuperAdmin(rawStoreAccess(service.NewWebhook)))
+	router.GET("/chronograf/v1/webhooks/:id", EnsureSuperAdmin(rawStoreAccess(service.WebhookID)))
+	router.PATCH("/chronograf/v1/webhooks/:id", EnsureSuperAdmin(rawStoreAccess(service.UpdateWebhook)))
+	router.DELETE("/chronograf/v1/webhooks/:id", EnsureSuperAdmin(rawStoreAccess(service.RemoveWebhook)))
+	router.GET("/chronograf/v1/webhooks/:id/deliveries", EnsureSuperAdmin(rawStoreAccess(service.WebhookDeliveries)))
+
+	// Reconcile declarative organizations, sources, kapacitors and dashboards
+	router.POST("/chronograf/v1/admin/reconcile", EnsureSuperAdmin(rawStoreAccess(service.Reconcile)))
+
+	// Export and import the configuration of all organizations as a portable bundle
+	router.GET("/chronograf/v1/export", EnsureSuperAdmin(rawStoreAccess(service.Export)))
+	router.POST("/chronograf/v1/import", EnsureSuperAdmin(rawStoreAccess(service.Import)))
+
 	// Source Proxy to Influx; Has gzip compression around the handler
 	influx := gziphandler.GzipHandler(http.HandlerFunc(EnsureViewer(service.Influx)))
 	router.Handler("POST", "/chronograf/v1/sources/:id/proxy", influx)
 
-	// Write proxies line protocol write requests to InfluxDB
-	router.POST("/chronograf/v1/sources/:id/write", EnsureViewer(service.Write))
+	// Proxy fans queries out to the sources each of them links to, such as
+	// the queries of a cell comparing several sources
+	multiProxy := gziphandler.GzipHandler(http.HandlerFunc(EnsureViewer(service.MultiSourceProxy)))
+	router.Handler("POST", "/chronograf/v1/proxy", multiProxy)
+
+	// Query jobs run queries too long for the proxy in the background
+	router.POST("/chronograf/v1/sources/:id/jobs", EnsureViewer(service.NewQueryJob))
+	router.GET("/chronograf/v1/jobs", EnsureViewer(service.QueryJobs))
+	router.GET("/chronograf/v1/jobs/:id", EnsureViewer(service.QueryJob))
+	router.GET("/chronograf/v1/jobs/:id/results", EnsureViewer(service.QueryJobResults))
+	router.DELETE("/chronograf/v1/jobs/:id", EnsureViewer(service.RemoveQueryJob))
+
+	// Explain returns the plan and statistics of a SELECT statement as shown
+	// by EXPLAIN ANALYZE, which runs the statement like the proxy does
+	router.POST("/chronograf/v1/sources/:id/explain", EnsureViewer(service.ExplainQuery))
+
+	// Flux proxies Flux scripts to InfluxDB 1.7+ and lists the schema of its buckets
+	fluxProxy := gziphandler.GzipHandler(http.HandlerFunc(EnsureViewer(service.Flux)))
+	router.Handler("POST", "/chronograf/v1/sources/:id/flux", fluxProxy)
+	router.GET("/chronograf/v1/sources/:id/flux/buckets", EnsureViewer(service.FluxBuckets))
+	router.GET("/chronograf/v1/sources/:id/flux/measurements", EnsureViewer(service.FluxMeasurements))
+	router.GET("/chronograf/v1/sources/:id/flux/fields", EnsureViewer(service.FluxFields))
+	router.POST("/chronograf/v1/flux/ast", EnsureViewer(service.FluxAST))
+
+	// Flux tasks of InfluxDB 2.x sources
+	router.GET("/chronograf/v1/sources/:id/flux/tasks", EnsureViewer(service.FluxTasks))
+	router.POST("/chronograf/v1/sources/:id/flux/tasks", EnsureEditor(service.NewFluxTask))
+	router.GET("/chronograf/v1/sources/:id/flux/tasks/:tid", EnsureViewer(service.FluxTaskID))
+	router.PATCH("/chronograf/v1/sources/:id/flux/tasks/:tid", EnsureEditor(service.UpdateFluxTask))
+	router.DELETE("/chronograf/v1/sources/:id/flux/tasks/:tid", EnsureEditor(service.RemoveFluxTask))
+	router.GET("/chronograf/v1/sources/:id/flux/tasks/:tid/runs", EnsureViewer(service.FluxTaskRuns))
+	router.GET("/chronograf/v1/sources/:id/flux/tasks/:tid/logs", EnsureViewer(service.FluxTaskLogs))
+
+	// Health of sources and their Kapacitors recorded by background checks
+	router.GET("/chronograf/v1/sources/:id/health", EnsureViewer(service.SourceHealth))
+	router.GET("/chronograf/v1/health/sources", EnsureViewer(service.SourcesHealth))
+
+	// Discovery creates and syncs the sources of the data nodes of an InfluxDB Enterprise cluster
+	router.POST("/chronograf/v1/discovery/sources", EnsureEditor(service.DiscoverSources))
+
+	// Sources of the organization, and upserts of a source by its URL
+	// conditional on the ETag of the source
+	router.GET("/chronograf/v1/sources", EnsureViewer(service.Sources))
+	router.PUT("/chronograf/v1/sources", EnsureEditor(service.UpsertSource))
+	router.GET("/chronograf/v1/sources/:id", EnsureViewer(service.SourceID))
+
+	// Write validates and proxies line protocol write requests to InfluxDB
+	router.POST("/chronograf/v1/sources/:id/write", EnsureEditor(service.Write))
 
 	// Queries is used to analyze a specific queries and does not create any
 	// resources. It's a POST because Queries are POSTed to InfluxDB, but this
@@ -171,6 +306,14 @@ func NewMux(opts MuxOpts, service Service) http.Handler {
 	// intended for Chronograf Users with the Viewer Role type.
 	router.POST("/chronograf/v1/sources/:id/queries", EnsureViewer(service.Queries))
 
+	// Rendering replaces the template variables of queries by their values,
+	// quoted and escaped so that the values cannot inject InfluxQL
+	router.POST("/chronograf/v1/queries/render", EnsureViewer(service.RenderQueries))
+
+	// Running queries of a source may be listed by editors and killed by admins
+	router.GET("/chronograf/v1/sources/:id/queries", EnsureEditor(service.RunningQueries))
+	router.DELETE("/chronograf/v1/sources/:id/queries/:qid", EnsureAdmin(service.KillRunningQuery))
+
 	// Annotations are user-defined events associated with this source
 	router.GET("/chronograf/v1/sources/:id/annotations", EnsureViewer(service.Annotations))
 	router.POST("/chronograf/v1/sources/:id/annotations", EnsureEditor(service.NewAnnotation))
@@ -178,6 +321,36 @@ func NewMux(opts MuxOpts, service Service) http.Handler {
 	router.DELETE("/chronograf/v1/sources/:id/annotations/:aid", EnsureEditor(service.RemoveAnnotation))
 	router.PATCH("/chronograf/v1/sources/:id/annotations/:aid", EnsureEditor(service.UpdateAnnotation))
 
+	// Alert events are the alert history Kapacitor posts for this source
+	router.GET("/chronograf/v1/sources/:id/alerts", EnsureViewer(service.AlertEvents))
+	router.POST("/chronograf/v1/sources/:id/alerts", EnsureEditor(service.NewAlertEvent))
+	router.GET("/chronograf/v1/sources/:id/alerts/:aid", EnsureViewer(service.AlertEventID))
+	router.PATCH("/chronograf/v1/sources/:id/alerts/:aid", EnsureEditor(service.UpdateAlertEvent))
+	router.POST("/chronograf/v1/sources/:id/alerts/:aid/comments", EnsureEditor(service.NewAlertEventComment))
+
+	// Alert webhooks relay the alerts Kapacitor posts for this source
+	router.GET("/chronograf/v1/sources/:id/webhooks", EnsureViewer(service.AlertWebhooks))
+	router.POST("/chronograf/v1/sources/:id/webhooks", EnsureEditor(service.NewAlertWebhook))
+	router.GET("/chronograf/v1/sources/:id/webhooks/:wid", EnsureViewer(service.AlertWebhookID))
+	router.PATCH("/chronograf/v1/sources/:id/webhooks/:wid", EnsureEditor(service.UpdateAlertWebhook))
+	router.DELETE("/chronograf/v1/sources/:id/webhooks/:wid", EnsureEditor(service.RemoveAlertWebhook))
+	router.POST("/chronograf/v1/sources/:id/webhooks/:wid/test", EnsureEditor(service.TestAlertWebhook))
+
+	// Alert checks Chronograf evaluates against this source without Kapacitor
+	router.GET("/chronograf/v1/sources/:id/checks", EnsureViewer(service.AlertChecks))
+	router.POST("/chronograf/v1/sources/:id/checks", EnsureEditor(service.NewAlertCheck))
+	router.GET("/chronograf/v1/sources/:id/checks/:cid", EnsureViewer(service.AlertCheckID))
+	router.PATCH("/chronograf/v1/sources/:id/checks/:cid", EnsureEditor(service.UpdateAlertCheck))
+	router.DELETE("/chronograf/v1/sources/:id/checks/:cid", EnsureEditor(service.RemoveAlertCheck))
+	router.POST("/chronograf/v1/sources/:id/checks/:cid/run", EnsureEditor(service.RunAlertCheck))
+
+	// Alert policies throttle the notifications of the alert rules of this source
+	router.GET("/chronograf/v1/sources/:id/policies", EnsureViewer(service.AlertPolicies))
+	router.POST("/chronograf/v1/sources/:id/policies", EnsureEditor(service.NewAlertPolicy))
+	router.GET("/chronograf/v1/sources/:id/policies/:pid", EnsureViewer(service.AlertPolicyID))
+	router.PATCH("/chronograf/v1/sources/:id/policies/:pid", EnsureEditor(service.UpdateAlertPolicy))
+	router.DELETE("/chronograf/v1/sources/:id/policies/:pid", EnsureEditor(service.RemoveAlertPolicy))
+
 	// All possible permissions for users in this source
 	router.GET("/chronograf/v1/sources/:id/permissions", EnsureViewer(service.Permissions))
 
@@ -198,12 +371,40 @@ func NewMux(opts MuxOpts, service Service) http.Handler {
 	router.GET("/chronograf/v1/layouts", EnsureViewer(service.Layouts))
 	router.GET("/chronograf/v1/layouts/:id", EnsureViewer(service.LayoutsID))
 
+	// Telegraf configuration for collecting the measurements of layouts
+	router.POST("/chronograf/v1/telegraf-config", EnsureViewer(service.TelegrafConfig))
+
 	// Users associated with Chronograf
 	router.GET("/chronograf/v1/me", service.Me)
 
 	// Set current chronograf organization the user is logged into
 	router.PUT("/chronograf/v1/me", service.UpdateMe(opts.Auth))
 
+	// Notification inbox of the current user
+	router.GET("/chronograf/v1/me/notifications", EnsureMember(service.Notifications))
+	router.GET("/chronograf/v1/me/notifications/stream", EnsureMember(service.NotificationsStream))
+	router.POST("/chronograf/v1/me/notifications/read", EnsureMember(service.ReadAllNotifications))
+	router.PATCH("/chronograf/v1/me/notifications/:id", EnsureMember(service.UpdateNotification))
+	router.DELETE("/chronograf/v1/me/notifications/:id", EnsureMember(service.RemoveNotification))
+
+	// Pending invitations of the current user, who may not have been added yet
+	router.GET("/chronograf/v1/me/invitations", service.MeInvitations)
+	router.POST("/chronograf/v1/me/invitations/:id/accept", service.AcceptMeInvitation)
+	router.POST("/chronograf/v1/me/invitations/:id/decline", service.DeclineMeInvitation)
+
+	// Preferences of the current user that follow them across browsers
+	router.GET("/chronograf/v1/me/preferences", EnsureMember(service.MePreferences))
+	router.PATCH("/chronograf/v1/me/preferences", EnsureMember(service.UpdateMePreferences))
+
+	// Query history of the current user, recorded by the proxies
+	router.GET("/chronograf/v1/me/queries/history", EnsureViewer(service.MeQueryHistory))
+
+	// Dashboards starred and recently viewed by the current user
+	router.GET("/chronograf/v1/me/dashboards", EnsureViewer(service.MeDashboards))
+
+	// Alerts and broadcasts delivered to the inboxes of users
+	router.POST("/chronograf/v1/notifications", EnsureSuperAdmin(rawStoreAccess(service.NewNotification)))
+
 	// TODO(desa): what to do about admin's being able to set superadmin
 	router.GET("/chronograf/v1/organizations/:oid/users", EnsureAdmin(ensureOrgMatches(service.Users)))
 	router.POST("/chronograf/v1/organizations/:oid/users", EnsureAdmin(ensureOrgMatches(service.NewUser)))
@@ -214,19 +415,71 @@ func NewMux(opts MuxOpts, service Service) http.Handler {
 
 	router.GET("/chronograf/v1/users", EnsureSuperAdmin(rawStoreAccess(service.Users)))
 	router.POST("/chronograf/v1/users", EnsureSuperAdmin(rawStoreAccess(service.NewUser)))
+	router.PUT("/chronograf/v1/users", EnsureSuperAdmin(rawStoreAccess(service.UpsertUser)))
+	router.POST("/chronograf/v1/users/bulk", EnsureSuperAdmin(rawStoreAccess(service.NewUsers)))
 
 	router.GET("/chronograf/v1/users/:id", EnsureSuperAdmin(rawStoreAccess(service.UserID)))
 	router.DELETE("/chronograf/v1/users/:id", EnsureSuperAdmin(rawStoreAccess(service.RemoveUser)))
-	router.PATCH("/chronograf/v1/users/:id", EnsureSuperAdmin(rawStoreAccess(service.UpdateUser)))
+	// PATCH /chronograf/v1/users/roles changes the roles of many users at once
+	router.PATCH("/chronograf/v1/users/:id", EnsureSuperAdmin(rawStoreAccess(updateUserOrUsersRoles(service.UpdateUser, service.UpdateUsersRoles))))
+	routes.add("PATCH", "/chronograf/v1/users/roles")
+
+	router.PATCH("/chronograf/v1/users/:id/status", EnsureSuperAdmin(rawStoreAccess(service.UpdateUserStatus)))
+	router.PUT("/chronograf/v1/users/:id/roles/:oid", EnsureSuperAdmin(rawStoreAccess(service.SetUserRole)))
+	router.DELETE("/chronograf/v1/users/:id/roles/:oid", EnsureSuperAdmin(rawStoreAccess(service.RemoveUserRole)))
+
+	// Sessions of SuperAdmins impersonating users to reproduce their issues;
+	// a route under /chronograf/v1/users/:id would conflict with /users/bulk
+	if opts.UseAuth && opts.Impersonation > 0 {
+		router.POST("/chronograf/v1/impersonations/:id", EnsureSuperAdmin(rawStoreAccess(service.ImpersonateUser(opts.Auth, opts.Impersonation))))
+	}
+
+	// Passwords of users of the basic scheme
+	if opts.BasicAuth {
+		router.PUT("/chronograf/v1/me/password", EnsureMember(service.UpdateMePassword))
+		router.PUT("/chronograf/v1/users/:id/password", EnsureSuperAdmin(rawStoreAccess(service.ResetUserPassword)))
+
+		// Two-factor authentication by one-time passwords
+		router.GET("/chronograf/v1/me/mfa", EnsureMember(service.MeMFA))
+		router.POST("/chronograf/v1/me/mfa", EnsureMember(service.EnrollMeMFA))
+		router.POST("/chronograf/v1/me/mfa/verify", EnsureMember(service.VerifyMeMFA))
+		router.DELETE("/chronograf/v1/me/mfa", EnsureMember(service.DisableMeMFA))
+		router.DELETE("/chronograf/v1/users/:id/mfa", EnsureSuperAdmin(rawStoreAccess(service.ResetUserMFA)))
+	}
+
+	// SCIM 2.0 provisioning of users and of organizations as groups
+	if opts.SCIMToken != "" {
+		ensureSCIM := func(next http.HandlerFunc) http.HandlerFunc {
+			return SCIMAuthorized(opts.SCIMToken, opts.Logger, next)
+		}
+		router.GET(scimPath+"/Users", ensureSCIM(service.SCIMUsers))
+		router.POST(scimPath+"/Users", ensureSCIM(service.SCIMNewUser))
+		router.GET(scimPath+"/Users/:id", ensureSCIM(service.SCIMUserID))
+		router.PUT(scimPath+"/Users/:id", ensureSCIM(service.SCIMReplaceUser))
+		router.PATCH(scimPath+"/Users/:id", ensureSCIM(service.SCIMPatchUser))
+		router.DELETE(scimPath+"/Users/:id", ensureSCIM(service.SCIMRemoveUser))
+
+		router.GET(scimPath+"/Groups", ensureSCIM(service.SCIMGroups))
+		router.POST(scimPath+"/Groups", ensureSCIM(service.SCIMNewGroup))
+		router.GET(scimPath+"/Groups/:id", ensureSCIM(service.SCIMGroupID))
+		router.PUT(scimPath+"/Groups/:id", ensureSCIM(service.SCIMReplaceGroup))
+		router.PATCH(scimPath+"/Groups/:id", ensureSCIM(service.SCIMPatchGroup))
+		router.DELETE(scimPath+"/Groups/:id", ensureSCIM(service.SCIMRemoveGroup))
+	}
 
 	// Dashboards
+	// Search the names, cell titles and queries of dashboards
+	router.GET("/chronograf/v1/search", EnsureViewer(service.Search))
+
 	router.GET("/chronograf/v1/dashboards", EnsureViewer(service.Dashboards))
 	router.POST("/chronograf/v1/dashboards", EnsureEditor(service.NewDashboard))
+	router.PUT("/chronograf/v1/dashboards", EnsureEditor(service.UpsertDashboard))
 
 	router.GET("/chronograf/v1/dashboards/:id", EnsureViewer(service.DashboardID))
 	router.DELETE("/chronograf/v1/dashboards/:id", EnsureEditor(service.RemoveDashboard))
 	router.PUT("/chronograf/v1/dashboards/:id", EnsureEditor(service.ReplaceDashboard))
 	router.PATCH("/chronograf/v1/dashboards/:id", EnsureEditor(service.UpdateDashboard))
+	router.POST("/chronograf/v1/dashboards/:id/copy", EnsureEditor(service.CopyDashboard))
 	// Dashboard Cells
 	router.GET("/chronograf/v1/dashboards/:id/cells", EnsureViewer(service.DashboardCells))
 	router.POST("/chronograf/v1/dashboards/:id/cells", EnsureEditor(service.NewDashboardCell))
@@ -241,27 +494,121 @@ func NewMux(opts MuxOpts, service Service) http.Handler {
 	router.GET("/chronograf/v1/dashboards/:id/templates/:tid", EnsureViewer(service.TemplateID))
 	router.DELETE("/chronograf/v1/dashboards/:id/templates/:tid", EnsureEditor(service.RemoveTemplate))
 	router.PUT("/chronograf/v1/dashboards/:id/templates/:tid", EnsureEditor(service.ReplaceTemplate))
+	router.POST("/chronograf/v1/dashboards/:id/templates/:tid/values", EnsureViewer(service.TemplateValues))
+	// Dashboard Versions recorded on every write of a dashboard
+	router.GET("/chronograf/v1/dashboards/:id/versions", EnsureViewer(service.DashboardVersions))
+	router.GET("/chronograf/v1/dashboards/:id/versions/:rev", EnsureViewer(service.DashboardVersion))
+	router.GET("/chronograf/v1/dashboards/:id/versions/:rev/diff", EnsureViewer(service.DashboardVersionDiff))
+	router.POST("/chronograf/v1/dashboards/:id/versions/:rev/restore", EnsureEditor(service.RestoreDashboardVersion))
+
+	// Dashboard Snapshots freeze the results of the queries of a dashboard
+	router.GET("/chronograf/v1/dashboards/:id/snapshots", EnsureViewer(service.DashboardSnapshots))
+	router.POST("/chronograf/v1/dashboards/:id/snapshots", EnsureEditor(service.NewDashboardSnapshot))
+	router.GET("/chronograf/v1/dashboards/:id/snapshots/:sid", EnsureViewer(service.DashboardSnapshot))
+	router.DELETE("/chronograf/v1/dashboards/:id/snapshots/:sid", EnsureEditor(service.RemoveDashboardSnapshot))
+
+	// Dashboard Streams push the results of the queries of a dashboard to its viewers
+	router.GET("/chronograf/v1/streams/dashboards/:id", EnsureViewer(service.DashboardStream))
+
+	// Export dashboards as self-contained documents to import them into other
+	// instances. Imports are not below /dashboards as :id would conflict.
+	router.GET("/chronograf/v1/dashboards/:id/export", EnsureViewer(service.ExportDashboard))
+	router.POST("/chronograf/v1/dashboard-imports", EnsureEditor(service.ImportDashboard))
+
+	// Render dashboards server-side as PNG images or PDF documents
+	router.GET("/chronograf/v1/dashboards/:id/render", EnsureViewer(service.RenderDashboard))
+
+	// Favorites of the current user
+	router.PUT("/chronograf/v1/dashboards/:id/star", EnsureViewer(service.StarDashboard))
+	router.DELETE("/chronograf/v1/dashboards/:id/star", EnsureViewer(service.UnstarDashboard))
+
+	// Folders of dashboards
+	router.GET("/chronograf/v1/folders", EnsureViewer(service.Folders))
+	router.POST("/chronograf/v1/folders", EnsureEditor(service.NewFolder))
+
+	router.GET("/chronograf/v1/folders/:id", EnsureViewer(service.FolderID))
+	router.PATCH("/chronograf/v1/folders/:id", EnsureEditor(service.UpdateFolder))
+	router.DELETE("/chronograf/v1/folders/:id", EnsureEditor(service.RemoveFolder))
+	router.GET("/chronograf/v1/folders/:id/dashboards", EnsureViewer(service.FolderDashboards))
+
+	// Reports emailing summaries of dashboards on a schedule
+	router.GET("/chronograf/v1/reports", EnsureViewer(service.Reports))
+	router.POST("/chronograf/v1/reports", EnsureEditor(service.NewReport))
+
+	router.GET("/chronograf/v1/reports/:id", EnsureViewer(service.ReportID))
+	router.PATCH("/chronograf/v1/reports/:id", EnsureEditor(service.UpdateReport))
+	router.DELETE("/chronograf/v1/reports/:id", EnsureEditor(service.RemoveReport))
+	router.POST("/chronograf/v1/reports/:id/run", EnsureEditor(service.RunReport))
+
+	// Rule templates are alert checks admins parameterize for editors to
+	// instantiate per host or group
+	router.GET("/chronograf/v1/rule-templates", EnsureViewer(service.RuleTemplates))
+	router.POST("/chronograf/v1/rule-templates", EnsureAdmin(service.NewRuleTemplate))
+
+	router.GET("/chronograf/v1/rule-templates/:id", EnsureViewer(service.RuleTemplateID))
+	router.PATCH("/chronograf/v1/rule-templates/:id", EnsureAdmin(service.UpdateRuleTemplate))
+	router.DELETE("/chronograf/v1/rule-templates/:id", EnsureAdmin(service.RemoveRuleTemplate))
+	router.GET("/chronograf/v1/rule-templates/:id/checks", EnsureViewer(service.RuleTemplateChecks))
+
+	// Annotations of the organization shown across dashboards whatever their source
+	router.GET("/chronograf/v1/annotations", EnsureViewer(service.OrganizationAnnotations))
+	router.POST("/chronograf/v1/annotations", EnsureEditor(service.NewOrganizationAnnotation))
+
+	router.GET("/chronograf/v1/annotations/:id", EnsureViewer(service.OrganizationAnnotation))
+	router.PATCH("/chronograf/v1/annotations/:id", EnsureEditor(service.UpdateOrganizationAnnotation))
+	router.DELETE("/chronograf/v1/annotations/:id", EnsureEditor(service.RemoveOrganizationAnnotation))
+	router.GET("/chronograf/v1/annotation-streams", EnsureViewer(service.AnnotationStreams))
 
 	// Databases
 	router.GET("/chronograf/v1/sources/:id/dbs", EnsureViewer(service.GetDatabases))
 	router.POST("/chronograf/v1/sources/:id/dbs", EnsureEditor(service.NewDatabase))
 
+	router.GET("/chronograf/v1/sources/:id/dbs/:db", EnsureViewer(service.DatabaseID))
+	router.PATCH("/chronograf/v1/sources/:id/dbs/:db", EnsureEditor(service.UpdateDatabase))
 	router.DELETE("/chronograf/v1/sources/:id/dbs/:db", EnsureEditor(service.DropDatabase))
 
 	// Retention Policies
 	router.GET("/chronograf/v1/sources/:id/dbs/:db/rps", EnsureViewer(service.RetentionPolicies))
 	router.POST("/chronograf/v1/sources/:id/dbs/:db/rps", EnsureEditor(service.NewRetentionPolicy))
 
+	router.GET("/chronograf/v1/sources/:id/dbs/:db/rps/:rp", EnsureViewer(service.RetentionPolicyID))
 	router.PUT("/chronograf/v1/sources/:id/dbs/:db/rps/:rp", EnsureEditor(service.UpdateRetentionPolicy))
 	router.DELETE("/chronograf/v1/sources/:id/dbs/:db/rps/:rp", EnsureEditor(service.DropRetentionPolicy))
+	router.POST("/chronograf/v1/sources/:id/dbs/:db/rps/:rp/preview", EnsureViewer(service.PreviewRetentionPolicy))
+
+	// Continuous Queries
+	router.GET("/chronograf/v1/sources/:id/dbs/:db/cqs", EnsureViewer(service.ContinuousQueries))
+	router.POST("/chronograf/v1/sources/:id/dbs/:db/cqs", EnsureEditor(service.NewContinuousQuery))
+	router.POST("/chronograf/v1/sources/:id/dbs/:db/cqs/preview", EnsureViewer(service.PreviewContinuousQuery))
+
+	router.GET("/chronograf/v1/sources/:id/dbs/:db/cqs/:cq", EnsureViewer(service.ContinuousQueryID))
+	router.PUT("/chronograf/v1/sources/:id/dbs/:db/cqs/:cq", EnsureEditor(service.UpdateContinuousQuery))
+	router.DELETE("/chronograf/v1/sources/:id/dbs/:db/cqs/:cq", EnsureEditor(service.DropContinuousQuery))
 
 	// Measurements
 	router.GET("/chronograf/v1/sources/:id/dbs/:db/measurements", EnsureViewer(service.Measurements))
+	router.GET("/chronograf/v1/sources/:id/dbs/:db/measurements/:measurement/cardinality", EnsureViewer(service.MeasurementCardinality))
+	router.GET("/chronograf/v1/sources/:id/dbs/:db/cardinality", EnsureViewer(service.DatabaseCardinality))
+
+	// API tokens for machine access to the current organization
+	router.GET("/chronograf/v1/tokens", EnsureAdmin(service.Tokens))
+	router.POST("/chronograf/v1/tokens", EnsureAdmin(service.NewToken))
+
+	router.GET("/chronograf/v1/tokens/:id", EnsureAdmin(service.TokenID))
+	router.DELETE("/chronograf/v1/tokens/:id", EnsureAdmin(service.RemoveToken))
+
+	// Invitations of provider identities into the current organization
+	router.GET("/chronograf/v1/invitations", EnsureAdmin(service.Invitations))
+	router.POST("/chronograf/v1/invitations", EnsureAdmin(service.NewInvitation))
+
+	router.GET("/chronograf/v1/invitations/:id", EnsureAdmin(service.InvitationID))
+	router.DELETE("/chronograf/v1/invitations/:id", EnsureAdmin(service.RemoveInvitation))
 
 	// Global application config for Chronograf
 	router.GET("/chronograf/v1/config", EnsureSuperAdmin(service.Config))
 	router.GET("/chronograf/v1/config/auth", EnsureSuperAdmin(service.AuthConfig))
 	router.PUT("/chronograf/v1/config/auth", EnsureSuperAdmin(service.ReplaceAuthConfig))
+	router.GET("/chronograf/v1/config/server", EnsureSuperAdmin(service.ServerConfig))
 
 	// Organization config settings for Chronograf
 	router.GET("/chronograf/v1/org_config", EnsureViewer(service.OrganizationConfig))
@@ -270,6 +617,9 @@ func NewMux(opts MuxOpts, service Service) http.Handler {
 
 	router.GET("/chronograf/v1/env", EnsureViewer(service.Environment))
 
+	// Plugins extending chronograf with additional source and cell types
+	router.GET("/chronograf/v1/plugins", EnsureViewer(service.ListPlugins))
+
 	allRoutes := &AllRoutes{
 		Logger:      opts.Logger,
 		StatusFeed:  opts.StatusFeedURL,
@@ -290,14 +640,66 @@ func NewMux(opts MuxOpts, service Service) http.Handler {
 		// Encapsulate the router with OAuth2
 		var auth http.Handler
 		auth, allRoutes.AuthRoutes = AuthAPI(opts, router)
+		if opts.BasicAuth {
+			router.POST(basicLoginPath, service.BasicLogin(opts.Auth))
+			router.GET(basicLogoutPath, BasicLogout("/", opts.Basepath, opts.Auth))
+			allRoutes.AuthRoutes = append(allRoutes.AuthRoutes, AuthRoute{
+				Name:   BasicProvider,
+				Label:  "Username and password",
+				Login:  path.Join(opts.Basepath, basicLoginPath),
+				Logout: path.Join(opts.Basepath, basicLogoutPath),
+			})
+		}
+		if opts.LDAP != nil {
+			router.POST(ldapLoginPath, service.LDAPLogin(opts.LDAP, opts.Auth))
+			router.GET(ldapLogoutPath, BasicLogout("/", opts.Basepath, opts.Auth))
+			allRoutes.AuthRoutes = append(allRoutes.AuthRoutes, AuthRoute{
+				Name:   LDAPProvider,
+				Label:  "LDAP",
+				Login:  path.Join(opts.Basepath, ldapLoginPath),
+				Logout: path.Join(opts.Basepath, ldapLogoutPath),
+			})
+		}
+		if opts.SAML != nil {
+			router.GET(samlMetadataPath, service.SAMLMetadata(opts.SAML))
+			router.GET(samlLoginPath, service.SAMLLogin(opts.SAML))
+			router.POST(samlACSPath, service.SAMLACS(opts.SAML, opts.Auth, path.Join(opts.Basepath, "/"), path.Join(opts.Basepath, "/login")))
+			router.GET(samlLogoutPath, BasicLogout("/", opts.Basepath, opts.Auth))
+			allRoutes.AuthRoutes = append(allRoutes.AuthRoutes, AuthRoute{
+				Name:     SAMLProvider,
+				Label:    "SAML",
+				Login:    path.Join(opts.Basepath, samlLoginPath),
+				Logout:   path.Join(opts.Basepath, samlLogoutPath),
+				Callback: path.Join(opts.Basepath, samlACSPath),
+			})
+		}
+		// API tokens bypass the OAuth flow
+		auth = AuthorizedAPIToken(service.Store, opts.Logger, auth)
+		// So do verified TLS client certificates mapped to users
+		auth = AuthorizedClientCertificate(service.Store, opts.Logger, auth)
+		// And the users authenticated by a trusted reverse proxy
+		if opts.HeaderAuth != nil {
+			auth = service.AuthorizedProxyHeaders(opts.HeaderAuth, opts.Auth, auth)
+			logout := opts.HeaderAuth.LogoutURL
+			if logout == "" {
+				logout = path.Join(opts.Basepath, "/")
+			}
+			allRoutes.AuthRoutes = append(allRoutes.AuthRoutes, AuthRoute{
+				Name:   HeaderProvider,
+				Label:  "Single sign-on",
+				Login:  path.Join(opts.Basepath, "/"),
+				Logout: logout,
+			})
+		}
 		allRoutes.LogoutLink = path.Join(opts.Basepath, "/oauth/logout")
 
 		// Create middleware that redirects to the appropriate provider logout
 		router.GET("/oauth/logout", Logout("/", opts.Basepath, allRoutes.AuthRoutes))
-		out = Logger(opts.Logger, FlushingHandler(auth))
+		out = Logger(opts.Logger, FlushingHandler(Localize(auth)))
 	} else {
-		out = Logger(opts.Logger, FlushingHandler(router))
+		out = Logger(opts.Logger, FlushingHandler(Localize(router)))
 	}
+	out = RequestID(out)
 
 	return out
 }
@@ -333,9 +735,23 @@ func AuthAPI(opts MuxOpts, router chronograf.Router) (http.Handler, AuthRoutes)
 	rootPath := path.Join(opts.Basepath, "/chronograf/v1")
 	logoutPath := path.Join(opts.Basepath, "/oauth/logout")
 
-	tokenMiddleware := AuthorizedToken(opts.Auth, opts.Logger, router)
+	tokenMiddleware := AuthorizedToken(opts.Auth, opts.Logger, AuditImpersonation(opts.Logger, ActiveSessions(opts.Metrics, router)))
 	// Wrap the API with token validation middleware.
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Requests authenticated by an API token, a TLS client certificate or
+		// a trusted proxy have no OAuth token to validate
+		if _, ok := hasTokenContext(r.Context()); ok {
+			router.ServeHTTP(w, r)
+			return
+		}
+		if _, ok := hasCertificateContext(r.Context()); ok {
+			router.ServeHTTP(w, r)
+			return
+		}
+		if hasHeaderAuthContext(r.Context()) {
+			router.ServeHTTP(w, r)
+			return
+		}
 		cleanPath := path.Clean(r.URL.Path) // compare ignoring path garbage, trailing slashes, etc.
 		if (strings.HasPrefix(cleanPath, rootPath) && len(cleanPath) > len(rootPath)) || cleanPath == logoutPath {
 			tokenMiddleware.ServeHTTP(w, r)
@@ -353,29 +769,29 @@ func encodeJSON(w http.ResponseWriter, status int, v interface{}, logger chronog
 	}
 }
 
-// Error writes an JSON message
+// Error writes the problem details of an error as JSON
 func Error(w http.ResponseWriter, code int, msg string, logger chronograf.Logger) {
-	e := ErrorMessage{
-		Code:    code,
-		Message: msg,
-	}
+	e := newErrorMessage(code, translate(w, msg))
 	b, err := json.Marshal(e)
 	if err != nil {
 		code = http.StatusInternalServerError
-		b = []byte(`{"code": 500, "message":"server_error"}`)
+		b = []byte(`{"code":500,"message":"server_error","type":"urn:chronograf:problem:internal-error","title":"Internal Server Error","status":500,"detail":"server_error"}`)
 	}
 
 	logger.
 		WithField("component", "server").
 		WithField("http_status ", code).
 		Error("Error message ", msg)
-	w.Header().Set("Content-Type", JSONType)
+	w.Header().Set("Content-Type", ProblemJSONType)
+	if lang := language(w); lang != defaultLanguage {
+		w.Header().Set("Content-Language", lang)
+	}
 	w.WriteHeader(code)
 	_, _ = w.Write(b)
 }
 
 func invalidData(w http.ResponseWriter, err error, logger chronograf.Logger) {
-	Error(w, http.StatusUnprocessableEntity, fmt.Sprintf("%v", err), logger)
+	Error(w, http.StatusUnprocessableEntity, localize(w, err), logger)
 }
 
 func invalidJSON(w http.ResponseWriter, logger chronograf.Logger) {
@@ -387,7 +803,7 @@ func unknownErrorWithMessage(w http.ResponseWriter, err error, logger chronograf
 }
 
 func notFound(w http.ResponseWriter, id interface{}, logger chronograf.Logger) {
-	Error(w, http.StatusNotFound, fmt.Sprintf("ID %v not found", id), logger)
+	Error(w, http.StatusNotFound, localize(w, errorf("ID %v not found", id)), logger)
 }
 
 func paramID(key string, r *http.Request) (int, error) {
@@ -0,0 +1,62 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/metrics"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+	"github.com/influxdata/influxdb/chronograf/oauth2"
+)
+
+func TestNewMux(t *testing.T) {
+	tests := []struct {
+		name string
+		opts MuxOpts
+	}{
+		{
+			name: "Without auth",
+			opts: MuxOpts{},
+		},
+		{
+			name: "With auth and impersonation",
+			opts: MuxOpts{
+				UseAuth:       true,
+				Impersonation: 15 * time.Minute,
+			},
+		},
+		{
+			name: "With every optional route",
+			opts: MuxOpts{
+				UseAuth:       true,
+				Impersonation: 15 * time.Minute,
+				PprofEnabled:  true,
+				SCIMToken:     "secret",
+				BasicAuth:     true,
+				Metrics:       metrics.New(time.Minute),
+				Tracing:       true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.opts.Logger = &chronograf.NoopLogger{}
+			tt.opts.Auth = oauth2.NewCookieJWT("secret", time.Hour)
+			s := Service{
+				Store:  &mocks.Store{},
+				Logger: &chronograf.NoopLogger{},
+			}
+			// NewMux panics when two routes conflict
+			mux := NewMux(tt.opts, s)
+
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, httptest.NewRequest("GET", "http://any.url/chronograf/v1/", nil))
+			if w.Code != http.StatusOK {
+				t.Errorf("%q. GET /chronograf/v1/ = %d, want %d", tt.name, w.Code, http.StatusOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,368 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/bouk/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+type notificationLinks struct {
+	Self string `json:"self"` // Self link mapping to this resource
+}
+
+type notificationResponse struct {
+	Links notificationLinks `json:"links"`
+	chronograf.Notification
+}
+
+func newNotificationResponse(n chronograf.Notification) notificationResponse {
+	return notificationResponse{
+		Links: notificationLinks{
+			Self: fmt.Sprintf("/chronograf/v1/me/notifications/%s", n.ID),
+		},
+		Notification: n,
+	}
+}
+
+type notificationsLinks struct {
+	Self    string `json:"self"`    // Self link mapping to this resource
+	ReadAll string `json:"readAll"` // ReadAll marks every notification as read
+}
+
+type notificationsResponse struct {
+	Links         notificationsLinks     `json:"links"`
+	Unread        int                    `json:"unread"` // Unread is the number of unread notifications in the inbox
+	Notifications []notificationResponse `json:"notifications"`
+}
+
+func newNotificationsResponse(ns []chronograf.Notification, unreadOnly bool) *notificationsResponse {
+	res := &notificationsResponse{
+		Links: notificationsLinks{
+			Self:    "/chronograf/v1/me/notifications",
+			ReadAll: "/chronograf/v1/me/notifications/read",
+		},
+		Notifications: []notificationResponse{},
+	}
+	for _, n := range ns {
+		if !n.Read {
+			res.Unread++
+		} else if unreadOnly {
+			continue
+		}
+		res.Notifications = append(res.Notifications, newNotificationResponse(n))
+	}
+	return res
+}
+
+// Notifications returns the inbox of the current user. If the query parameter
+// unread=true is given, only unread notifications are returned.
+func (s *Service) Notifications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	unreadOnly := r.URL.Query().Get("unread") == "true"
+
+	u, ok := hasUserContext(ctx)
+	if !ok {
+		// Without authentication there are no users and therefore no inboxes
+		encodeJSON(w, http.StatusOK, newNotificationsResponse(nil, unreadOnly), s.Logger)
+		return
+	}
+
+	ns, err := s.Store.Notifications(ctx).All(ctx, u.ID)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	encodeJSON(w, http.StatusOK, newNotificationsResponse(ns, unreadOnly), s.Logger)
+}
+
+// notification retrieves the notification with the id of the route if it
+// belongs to the current user.
+func (s *Service) notification(ctx context.Context) (*chronograf.Notification, error) {
+	id := httprouter.GetParamFromContext(ctx, "id")
+	u, ok := hasUserContext(ctx)
+	if !ok {
+		return nil, chronograf.ErrNotificationNotFound
+	}
+	n, err := s.Store.Notifications(ctx).Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	// Notifications of other users are indistinguishable from missing ones
+	if n.UserID != u.ID {
+		return nil, chronograf.ErrNotificationNotFound
+	}
+	return n, nil
+}
+
+type notificationRequest struct {
+	Read *bool `json:"read"`
+}
+
+// UpdateNotification marks a notification of the current user as read or unread
+func (s *Service) UpdateNotification(w http.ResponseWriter, r *http.Request) {
+	var req notificationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	if req.Read == nil {
+		invalidData(w, errorf("no fields to update"), s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	n, err := s.notification(ctx)
+	if err != nil {
+		notFound(w, httprouter.GetParamFromContext(ctx, "id"), s.Logger)
+		return
+	}
+
+	n.Read = *req.Read
+	if err := s.Store.Notifications(ctx).Update(ctx, n); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	encodeJSON(w, http.StatusOK, newNotificationResponse(*n), s.Logger)
+}
+
+// RemoveNotification deletes a notification from the inbox of the current user
+func (s *Service) RemoveNotification(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	n, err := s.notification(ctx)
+	if err != nil {
+		notFound(w, httprouter.GetParamFromContext(ctx, "id"), s.Logger)
+		return
+	}
+
+	if err := s.Store.Notifications(ctx).Delete(ctx, n); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ReadAllNotifications marks every notification of the current user as read
+func (s *Service) ReadAllNotifications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	u, ok := hasUserContext(ctx)
+	if !ok {
+		encodeJSON(w, http.StatusOK, newNotificationsResponse(nil, false), s.Logger)
+		return
+	}
+
+	store := s.Store.Notifications(ctx)
+	ns, err := store.All(ctx, u.ID)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	for i := range ns {
+		if ns[i].Read {
+			continue
+		}
+		ns[i].Read = true
+		if err := store.Update(ctx, &ns[i]); err != nil {
+			unknownErrorWithMessage(w, err, s.Logger)
+			return
+		}
+	}
+	encodeJSON(w, http.StatusOK, newNotificationsResponse(ns, false), s.Logger)
+}
+
+type newNotificationRequest struct {
+	Type         string   `json:"type"`
+	Title        string   `json:"title"`
+	Message      string   `json:"message"`
+	Link         string   `json:"link"`
+	Organization string   `json:"organization"` // Organization restricts the recipients to the members of an organization
+	Users        []string `json:"users"`        // Users are the IDs of the recipients; if empty every user receives the notification
+}
+
+func (r *newNotificationRequest) ValidCreate() error {
+	if r.Type == "" {
+		r.Type = chronograf.BroadcastNotification
+	}
+	switch r.Type {
+	case chronograf.AlertNotification, chronograf.BroadcastNotification:
+	default:
+		return errorf("type must be alert or broadcast")
+	}
+	if r.Title == "" {
+		return errorf("title required on notification request body")
+	}
+	return nil
+}
+
+type notifiedResponse struct {
+	Notified int `json:"notified"` // Notified is the number of inboxes the notification was delivered to
+}
+
+// NewNotification delivers an alert or admin broadcast to the inboxes of users
+func (s *Service) NewNotification(w http.ResponseWriter, r *http.Request) {
+	var req newNotificationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	if err := req.ValidCreate(); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	users, err := s.Store.Users(ctx).All(ctx)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	ids := map[string]bool{}
+	for _, id := range req.Users {
+		ids[id] = true
+	}
+	recipients := []chronograf.User{}
+	for _, u := range users {
+		if len(ids) > 0 && !ids[fmt.Sprintf("%d", u.ID)] {
+			continue
+		}
+		if req.Organization != "" && !hasRoleInOrganization(u, req.Organization) && !u.SuperAdmin {
+			continue
+		}
+		recipients = append(recipients, u)
+	}
+
+	n := chronograf.Notification{
+		Organization: req.Organization,
+		Type:         req.Type,
+		Title:        req.Title,
+		Message:      req.Message,
+		Link:         req.Link,
+	}
+	if err := s.notify(ctx, recipients, n); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	encodeJSON(w, http.StatusCreated, notifiedResponse{Notified: len(recipients)}, s.Logger)
+}
+
+func hasRoleInOrganization(u chronograf.User, orgID string) bool {
+	for _, role := range u.Roles {
+		if role.Organization == orgID {
+			return true
+		}
+	}
+	return false
+}
+
+// notify delivers a copy of n to the inbox of each user and publishes it
+// to their open notification streams
+func (s *Service) notify(ctx context.Context, users []chronograf.User, n chronograf.Notification) error {
+	store := s.Store.Notifications(ctx)
+	for _, u := range users {
+		cp := n
+		cp.UserID = u.ID
+		if _, err := store.Add(ctx, &cp); err != nil {
+			return err
+		}
+		s.Notifier.Publish(cp)
+	}
+	return nil
+}
+
+// NotificationsStream streams new notifications of the current user as
+// server-sent events until the client disconnects.
+func (s *Service) NotificationsStream(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	u, ok := hasUserContext(ctx)
+	if !ok {
+		Error(w, http.StatusForbidden, "notification streams require authentication", s.Logger)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		Error(w, http.StatusInternalServerError, ErrNotFlusher, s.Logger)
+		return
+	}
+
+	ch, cancel := s.Notifier.Subscribe(u.ID)
+	defer cancel()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case n := <-ch:
+			b, err := json.Marshal(newNotificationResponse(n))
+			if err != nil {
+				s.Logger.Error("Unable to encode notification: ", err)
+				continue
+			}
+			fmt.Fprintf(w, "event: notification\nid: %s\ndata: %s\n\n", n.ID, b)
+			flusher.Flush()
+		}
+	}
+}
+
+// Notifier fans out new notifications to the open streams of their users
+type Notifier struct {
+	mu   sync.Mutex
+	subs map[uint64]map[chan chronograf.Notification]struct{}
+}
+
+// NewNotifier returns a Notifier without subscribers
+func NewNotifier() *Notifier {
+	return &Notifier{
+		subs: map[uint64]map[chan chronograf.Notification]struct{}{},
+	}
+}
+
+// Subscribe returns a channel receiving the new notifications of userID.
+// The returned function must be called to unsubscribe.
+func (n *Notifier) Subscribe(userID uint64) (<-chan chronograf.Notification, func()) {
+	ch := make(chan chronograf.Notification, 16)
+	if n == nil {
+		return ch, func() {}
+	}
+
+	n.mu.Lock()
+	if n.subs[userID] == nil {
+		n.subs[userID] = map[chan chronograf.Notification]struct{}{}
+	}
+	n.subs[userID][ch] = struct{}{}
+	n.mu.Unlock()
+
+	return ch, func() {
+		n.mu.Lock()
+		defer n.mu.Unlock()
+		delete(n.subs[userID], ch)
+		if len(n.subs[userID]) == 0 {
+			delete(n.subs, userID)
+		}
+	}
+}
+
+// Publish sends the notification to the streams of its user. Slow streams
+// drop notifications rather than block the publisher; they remain in the inbox.
+func (n *Notifier) Publish(notification chronograf.Notification) {
+	if n == nil {
+		return
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for ch := range n.subs[notification.UserID] {
+		select {
+		case ch <- notification:
+		default:
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bouk/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+func TestService_Notifications(t *testing.T) {
+	created := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := &mocks.NotificationsStore{
+		AllF: func(ctx context.Context, userID uint64) ([]chronograf.Notification, error) {
+			if userID != 1337 {
+				return nil, nil
+			}
+			return []chronograf.Notification{
+				{ID: "2", UserID: 1337, Type: "alert", Title: "cpu high", CreatedAt: created},
+				{ID: "1", UserID: 1337, Type: "broadcast", Title: "maintenance", Read: true, CreatedAt: created},
+			}, nil
+		},
+	}
+	tests := []struct {
+		name     string
+		url      string
+		user     *chronograf.User
+		wantBody string
+	}{
+		{
+			name:     "Inbox of the current user",
+			url:      "http://any.url/chronograf/v1/me/notifications",
+			user:     &chronograf.User{ID: 1337},
+			wantBody: `{"links":{"self":"/chronograf/v1/me/notifications","readAll":"/chronograf/v1/me/notifications/read"},"unread":1,"notifications":[{"links":{"self":"/chronograf/v1/me/notifications/2"},"id":"2","userID":"1337","type":"alert","title":"cpu high","message":"","read":false,"createdAt":"2018-01-01T00:00:00Z"},{"links":{"self":"/chronograf/v1/me/notifications/1"},"id":"1","userID":"1337","type":"broadcast","title":"maintenance","message":"","read":true,"createdAt":"2018-01-01T00:00:00Z"}]}`,
+		},
+		{
+			name:     "Only unread notifications",
+			url:      "http://any.url/chronograf/v1/me/notifications?unread=true",
+			user:     &chronograf.User{ID: 1337},
+			wantBody: `{"links":{"self":"/chronograf/v1/me/notifications","readAll":"/chronograf/v1/me/notifications/read"},"unread":1,"notifications":[{"links":{"self":"/chronograf/v1/me/notifications/2"},"id":"2","userID":"1337","type":"alert","title":"cpu high","message":"","read":false,"createdAt":"2018-01-01T00:00:00Z"}]}`,
+		},
+		{
+			name:     "Empty inbox without authentication",
+			url:      "http://any.url/chronograf/v1/me/notifications",
+			wantBody: `{"links":{"self":"/chronograf/v1/me/notifications","readAll":"/chronograf/v1/me/notifications/read"},"unread":0,"notifications":[]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{
+				Store:  &mocks.Store{NotificationsStore: store},
+				Logger: &chronograf.NoopLogger{},
+			}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("GET", tt.url, nil)
+			if tt.user != nil {
+				r = r.WithContext(context.WithValue(r.Context(), UserContextKey, tt.user))
+			}
+
+			s.Notifications(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("%q. Notifications() = %v, want %v", tt.name, resp.StatusCode, http.StatusOK)
+			}
+			if eq, _ := jsonEqual(string(body), tt.wantBody); !eq {
+				t.Errorf("%q. Notifications() = \n***%v***\n,\nwant\n***%v***", tt.name, string(body), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestService_UpdateNotification(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		body       string
+		wantStatus int
+		wantRead   bool
+	}{
+		{
+			name:       "Mark own notification read",
+			id:         "1",
+			body:       `{"read":true}`,
+			wantStatus: http.StatusOK,
+			wantRead:   true,
+		},
+		{
+			name:       "Notification of another user",
+			id:         "2",
+			body:       `{"read":true}`,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "Missing read field",
+			id:         "1",
+			body:       `{}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updated *chronograf.Notification
+			s := &Service{
+				Store: &mocks.Store{
+					NotificationsStore: &mocks.NotificationsStore{
+						GetF: func(ctx context.Context, id string) (*chronograf.Notification, error) {
+							switch id {
+							case "1":
+								return &chronograf.Notification{ID: "1", UserID: 1337}, nil
+							case "2":
+								return &chronograf.Notification{ID: "2", UserID: 1338}, nil
+							}
+							return nil, chronograf.ErrNotificationNotFound
+						},
+						UpdateF: func(ctx context.Context, n *chronograf.Notification) error {
+							updated = n
+							return nil
+						},
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("PATCH", "http://any.url", bytes.NewReader([]byte(tt.body)))
+			ctx := context.WithValue(r.Context(), UserContextKey, &chronograf.User{ID: 1337})
+			r = r.WithContext(httprouter.WithParams(ctx, httprouter.Params{
+				{
+					Key:   "id",
+					Value: tt.id,
+				},
+			}))
+
+			s.UpdateNotification(w, r)
+
+			if got := w.Result().StatusCode; got != tt.wantStatus {
+				t.Errorf("%q. UpdateNotification() = %v, want %v", tt.name, got, tt.wantStatus)
+			}
+			if tt.wantRead && (updated == nil || !updated.Read) {
+				t.Errorf("%q. UpdateNotification() did not mark the notification read", tt.name)
+			}
+			if !tt.wantRead && updated != nil {
+				t.Errorf("%q. UpdateNotification() updated %v", tt.name, updated)
+			}
+		})
+	}
+}
+
+func TestService_NewNotification(t *testing.T) {
+	var delivered []uint64
+	notifier := NewNotifier()
+	stream, cancel := notifier.Subscribe(1)
+	defer cancel()
+
+	s := &Service{
+		Store: &mocks.Store{
+			UsersStore: &mocks.UsersStore{
+				AllF: func(ctx context.Context) ([]chronograf.User, error) {
+					return []chronograf.User{
+						{ID: 1, Roles: []chronograf.Role{{Name: "viewer", Organization: "1"}}},
+						{ID: 2, Roles: []chronograf.Role{{Name: "viewer", Organization: "2"}}},
+						{ID: 3, SuperAdmin: true},
+					}, nil
+				},
+			},
+			NotificationsStore: &mocks.NotificationsStore{
+				AddF: func(ctx context.Context, n *chronograf.Notification) (*chronograf.Notification, error) {
+					delivered = append(delivered, n.UserID)
+					return n, nil
+				},
+			},
+		},
+		Logger:   &chronograf.NoopLogger{},
+		Notifier: notifier,
+	}
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("POST", "http://any.url", bytes.NewReader([]byte(`{"title":"maintenance","organization":"1"}`)))
+
+	s.NewNotification(w, r)
+
+	resp := w.Result()
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("NewNotification() = %v, want %v: %s", resp.StatusCode, http.StatusCreated, body)
+	}
+	if eq, _ := jsonEqual(string(body), `{"notified":2}`); !eq {
+		t.Errorf("NewNotification() = %s, want %s", body, `{"notified":2}`)
+	}
+	if len(delivered) != 2 || delivered[0] != 1 || delivered[1] != 3 {
+		t.Errorf("NewNotification() delivered to %v, want [1 3]", delivered)
+	}
+	select {
+	case n := <-stream:
+		if n.Type != chronograf.BroadcastNotification || n.Title != "maintenance" {
+			t.Errorf("NewNotification() published %v", n)
+		}
+	default:
+		t.Errorf("NewNotification() did not publish to the stream of user 1")
+	}
+}
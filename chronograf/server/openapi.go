@@ -0,0 +1,606 @@
+package server
+
+import (
+	"encoding"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// openAPIVersion is the version of the OpenAPI specification of the document
+// served at /chronograf/v1/swagger.json
+const openAPIVersion = "3.0.3"
+
+var _ chronograf.Router = &RouteTable{}
+
+// RouteTable is an implementation of a chronograf.Router which records the
+// routes added to its Delegate, so that the OpenAPI document of the API is
+// generated from the routes actually served
+type RouteTable struct {
+	Delegate chronograf.Router
+
+	mu     sync.Mutex
+	routes []route
+}
+
+// route is the method and the path pattern of a route
+type route struct {
+	Method string
+	Path   string
+}
+
+func (rt *RouteTable) add(method, path string) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	rt.routes = append(rt.routes, route{Method: method, Path: path})
+}
+
+// Routes returns the routes added so far
+func (rt *RouteTable) Routes() []route {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	return append([]route(nil), rt.routes...)
+}
+
+// DELETE defines a route responding to a DELETE request which is recorded
+func (rt *RouteTable) DELETE(path string, handler http.HandlerFunc) {
+	rt.add("DELETE", path)
+	rt.Delegate.DELETE(path, handler)
+}
+
+// GET defines a route responding to a GET request which is recorded
+func (rt *RouteTable) GET(path string, handler http.HandlerFunc) {
+	rt.add("GET", path)
+	rt.Delegate.GET(path, handler)
+}
+
+// POST defines a route responding to a POST request which is recorded
+func (rt *RouteTable) POST(path string, handler http.HandlerFunc) {
+	rt.add("POST", path)
+	rt.Delegate.POST(path, handler)
+}
+
+// PUT defines a route responding to a PUT request which is recorded
+func (rt *RouteTable) PUT(path string, handler http.HandlerFunc) {
+	rt.add("PUT", path)
+	rt.Delegate.PUT(path, handler)
+}
+
+// PATCH defines a route responding to a PATCH request which is recorded
+func (rt *RouteTable) PATCH(path string, handler http.HandlerFunc) {
+	rt.add("PATCH", path)
+	rt.Delegate.PATCH(path, handler)
+}
+
+// Handler defines a route responding to a request type specified in the
+// method parameter which is recorded
+func (rt *RouteTable) Handler(method string, path string, handler http.Handler) {
+	rt.add(method, path)
+	rt.Delegate.Handler(method, path, handler)
+}
+
+// ServeHTTP is an implementation of http.Handler which delegates to the
+// configured Delegate's implementation of http.Handler
+func (rt *RouteTable) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	rt.Delegate.ServeHTTP(rw, r)
+}
+
+// apiOperation documents an operation of the API by the structs of the
+// bodies of its requests and responses
+type apiOperation struct {
+	Summary  string
+	Status   int         // Status of successful responses; 200 OK when zero
+	Request  interface{} // Request is the body of requests; the operation has no body when nil
+	Response interface{} // Response is the body of successful responses; they have no body when nil
+}
+
+// apiOperations document the operations of the routes of the API by method
+// and path. The operations of other routes are listed without their bodies.
+var apiOperations = map[string]apiOperation{
+	"GET /chronograf/v1/organizations":         {Summary: "List the organizations", Response: organizationsResponse{}},
+	"POST /chronograf/v1/organizations":        {Summary: "Create an organization", Status: http.StatusCreated, Request: organizationRequest{}, Response: organizationResponse{}},
+	"PUT /chronograf/v1/organizations":         {Summary: "Create or replace the organization with the name of the name parameter", Request: organizationRequest{}, Response: organizationResponse{}},
+	"GET /chronograf/v1/organizations/:oid":    {Summary: "Get an organization", Response: organizationResponse{}},
+	"PATCH /chronograf/v1/organizations/:oid":  {Summary: "Update an organization", Request: organizationRequest{}, Response: organizationResponse{}},
+	"DELETE /chronograf/v1/organizations/:oid": {Summary: "Remove an organization", Status: http.StatusNoContent},
+
+	"GET /chronograf/v1/organizations/:oid/users":        {Summary: "List the users of an organization", Response: usersResponse{}},
+	"POST /chronograf/v1/organizations/:oid/users":       {Summary: "Add a user to an organization", Status: http.StatusCreated, Request: userRequest{}, Response: userResponse{}},
+	"GET /chronograf/v1/organizations/:oid/users/:id":    {Summary: "Get a user of an organization", Response: userResponse{}},
+	"PATCH /chronograf/v1/organizations/:oid/users/:id":  {Summary: "Update a user of an organization", Request: userRequest{}, Response: userResponse{}},
+	"DELETE /chronograf/v1/organizations/:oid/users/:id": {Summary: "Remove a user from an organization", Status: http.StatusNoContent},
+
+	"GET /chronograf/v1/users":         {Summary: "List all users", Response: usersResponse{}},
+	"POST /chronograf/v1/users":        {Summary: "Create a user", Status: http.StatusCreated, Request: userRequest{}, Response: userResponse{}},
+	"PUT /chronograf/v1/users":         {Summary: "Create or replace the user with the name and provider of the name and provider parameters", Request: userRequest{}, Response: userResponse{}},
+	"GET /chronograf/v1/users/:id":     {Summary: "Get a user", Response: userResponse{}},
+	"PATCH /chronograf/v1/users/:id":   {Summary: "Update a user", Request: userRequest{}, Response: userResponse{}},
+	"PATCH /chronograf/v1/users/roles": {Summary: "Add and remove the roles of many users at once", Request: usersRolesRequest{}, Response: usersResponse{}},
+	"DELETE /chronograf/v1/users/:id":  {Summary: "Remove a user", Status: http.StatusNoContent},
+
+	"GET /chronograf/v1/mappings":        {Summary: "List the mappings of provider identities to organizations", Response: mappingsResponse{}},
+	"POST /chronograf/v1/mappings":       {Summary: "Create a mapping", Status: http.StatusCreated, Request: mappingsRequest{}, Response: mappingResponse{}},
+	"PUT /chronograf/v1/mappings/:id":    {Summary: "Replace a mapping", Request: mappingsRequest{}, Response: mappingResponse{}},
+	"DELETE /chronograf/v1/mappings/:id": {Summary: "Remove a mapping", Status: http.StatusNoContent},
+
+	"GET /chronograf/v1/certificates":  {Summary: "List the mappings of TLS client certificates to users", Response: certificateMappingsResponse{}},
+	"POST /chronograf/v1/certificates": {Summary: "Map a TLS client certificate to a user", Status: http.StatusCreated, Request: certificateMappingRequest{}, Response: certificateMappingResponse{}},
+
+	"GET /chronograf/v1/groups":                   {Summary: "List the groups of users", Response: groupsResponse{}},
+	"POST /chronograf/v1/groups":                  {Summary: "Create a group of users granting its roles to its members", Status: http.StatusCreated, Request: groupRequest{}, Response: groupResponse{}},
+	"GET /chronograf/v1/groups/:id":               {Summary: "Get a group of users", Response: groupResponse{}},
+	"PUT /chronograf/v1/groups/:id":               {Summary: "Replace a group of users", Request: groupRequest{}, Response: groupResponse{}},
+	"DELETE /chronograf/v1/groups/:id":            {Summary: "Remove a group of users", Status: http.StatusNoContent},
+	"PUT /chronograf/v1/groups/:id/users/:uid":    {Summary: "Add a user to a group", Response: groupResponse{}},
+	"DELETE /chronograf/v1/groups/:id/users/:uid": {Summary: "Remove a user from a group", Response: groupResponse{}},
+
+	"GET /chronograf/v1/webhooks":                {Summary: "List the webhooks of events", Response: webhooksResponse{}},
+	"POST /chronograf/v1/webhooks":               {Summary: "Create a webhook", Status: http.StatusCreated, Request: webhookRequest{}, Response: webhookResponse{}},
+	"GET /chronograf/v1/webhooks/:id":            {Summary: "Get a webhook", Response: webhookResponse{}},
+	"PATCH /chronograf/v1/webhooks/:id":          {Summary: "Update a webhook", Request: webhookRequest{}, Response: webhookResponse{}},
+	"DELETE /chronograf/v1/webhooks/:id":         {Summary: "Remove a webhook", Status: http.StatusNoContent},
+	"GET /chronograf/v1/webhooks/:id/deliveries": {Summary: "List the deliveries of a webhook", Response: webhookDeliveriesResponse{}},
+
+	"GET /chronograf/v1/me": {Summary: "Get the current user", Response: meResponse{}},
+	"PUT /chronograf/v1/me": {Summary: "Switch the current organization of the current user", Request: meRequest{}, Response: meResponse{}},
+
+	"GET /chronograf/v1/dashboards":        {Summary: "List the dashboards", Response: getDashboardsResponse{}},
+	"POST /chronograf/v1/dashboards":       {Summary: "Create a dashboard", Status: http.StatusCreated, Request: chronograf.Dashboard{}, Response: dashboardResponse{}},
+	"PUT /chronograf/v1/dashboards":        {Summary: "Create or replace the dashboard with the slug of the slug parameter", Request: chronograf.Dashboard{}, Response: dashboardResponse{}},
+	"GET /chronograf/v1/dashboards/:id":    {Summary: "Get a dashboard", Response: dashboardResponse{}},
+	"PUT /chronograf/v1/dashboards/:id":    {Summary: "Replace a dashboard", Request: chronograf.Dashboard{}, Response: dashboardResponse{}},
+	"PATCH /chronograf/v1/dashboards/:id":  {Summary: "Update a dashboard", Response: dashboardResponse{}},
+	"DELETE /chronograf/v1/dashboards/:id": {Summary: "Remove a dashboard", Status: http.StatusNoContent},
+
+	"GET /chronograf/v1/sources":     {Summary: "List the sources", Response: sourcesResponse{}},
+	"PUT /chronograf/v1/sources":     {Summary: "Create or replace the source with the URL of the url parameter", Request: chronograf.Source{}, Response: sourceEventData{}},
+	"GET /chronograf/v1/sources/:id": {Summary: "Get a source", Response: sourceEventData{}},
+
+	"GET /chronograf/v1/folders":        {Summary: "List the folders of dashboards", Response: foldersResponse{}},
+	"POST /chronograf/v1/folders":       {Summary: "Create a folder", Status: http.StatusCreated, Request: folderRequest{}, Response: folderResponse{}},
+	"GET /chronograf/v1/folders/:id":    {Summary: "Get a folder", Response: folderResponse{}},
+	"PATCH /chronograf/v1/folders/:id":  {Summary: "Update a folder", Request: folderRequest{}, Response: folderResponse{}},
+	"DELETE /chronograf/v1/folders/:id": {Summary: "Remove a folder", Status: http.StatusNoContent},
+
+	"GET /chronograf/v1/layouts":     {Summary: "List the layouts", Response: getLayoutsResponse{}},
+	"GET /chronograf/v1/layouts/:id": {Summary: "Get a layout", Response: layoutResponse{}},
+
+	"GET /chronograf/v1/tokens":        {Summary: "List the API tokens of the current organization", Response: tokensResponse{}},
+	"POST /chronograf/v1/tokens":       {Summary: "Create an API token", Status: http.StatusCreated, Request: tokenRequest{}, Response: tokenResponse{}},
+	"GET /chronograf/v1/tokens/:id":    {Summary: "Get an API token", Response: tokenResponse{}},
+	"DELETE /chronograf/v1/tokens/:id": {Summary: "Revoke an API token", Status: http.StatusNoContent},
+
+	"GET /chronograf/v1/invitations":        {Summary: "List the invitations of the current organization", Response: invitationsResponse{}},
+	"POST /chronograf/v1/invitations":       {Summary: "Invite a provider identity", Status: http.StatusCreated, Request: invitationRequest{}, Response: invitationResponse{}},
+	"GET /chronograf/v1/invitations/:id":    {Summary: "Get an invitation", Response: invitationResponse{}},
+	"DELETE /chronograf/v1/invitations/:id": {Summary: "Revoke an invitation", Status: http.StatusNoContent},
+
+	"GET /chronograf/v1/config":        {Summary: "Get the global configuration", Response: configResponse{}},
+	"GET /chronograf/v1/config/server": {Summary: "Get the options of the server", Response: serverConfigResponse{}},
+	"GET /chronograf/v1/env":           {Summary: "Get the environment of the server", Response: envResponse{}},
+}
+
+type openAPI struct {
+	OpenAPI    string                                  `json:"openapi"`
+	Info       openAPIInfo                             `json:"info"`
+	Servers    []openAPIServer                         `json:"servers"`
+	Paths      map[string]map[string]*openAPIOperation `json:"paths"`
+	Components openAPIComponents                       `json:"components"`
+	Security   []map[string][]string                   `json:"security,omitempty"`
+}
+
+type openAPIInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type openAPIServer struct {
+	URL string `json:"url"`
+}
+
+type openAPIOperation struct {
+	OperationID string                      `json:"operationId"`
+	Summary     string                      `json:"summary,omitempty"`
+	Tags        []string                    `json:"tags"`
+	Parameters  []openAPIParameter          `json:"parameters,omitempty"`
+	RequestBody *openAPIBody                `json:"requestBody,omitempty"`
+	Responses   map[string]*openAPIResponse `json:"responses"`
+}
+
+type openAPIParameter struct {
+	Name     string         `json:"name"`
+	In       string         `json:"in"`
+	Required bool           `json:"required"`
+	Schema   *openAPISchema `json:"schema"`
+}
+
+type openAPIBody struct {
+	Required bool                        `json:"required"`
+	Content  map[string]openAPIMediaType `json:"content"`
+}
+
+type openAPIResponse struct {
+	Ref         string                      `json:"$ref,omitempty"`
+	Description string                      `json:"description,omitempty"`
+	Content     map[string]openAPIMediaType `json:"content,omitempty"`
+}
+
+type openAPIMediaType struct {
+	Schema *openAPISchema `json:"schema"`
+}
+
+type openAPISchema struct {
+	Ref                  string                    `json:"$ref,omitempty"`
+	Type                 string                    `json:"type,omitempty"`
+	Format               string                    `json:"format,omitempty"`
+	Enum                 []string                  `json:"enum,omitempty"`
+	Items                *openAPISchema            `json:"items,omitempty"`
+	Properties           map[string]*openAPISchema `json:"properties,omitempty"`
+	AdditionalProperties *openAPISchema            `json:"additionalProperties,omitempty"`
+}
+
+type openAPIComponents struct {
+	Schemas         map[string]*openAPISchema         `json:"schemas"`
+	Responses       map[string]*openAPIResponse       `json:"responses"`
+	SecuritySchemes map[string]*openAPISecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+type openAPISecurityScheme struct {
+	Type        string `json:"type"`
+	In          string `json:"in"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// OpenAPI serves the OpenAPI 3.0 document of the routes of rt, which is
+// generated on the first request once all routes are added
+func OpenAPI(rt *RouteTable, opts MuxOpts) http.HandlerFunc {
+	var (
+		once sync.Once
+		doc  []byte
+	)
+	return func(w http.ResponseWriter, r *http.Request) {
+		once.Do(func() {
+			doc, _ = json.Marshal(newOpenAPI(rt.Routes(), opts))
+		})
+		w.Header().Set("Content-Type", JSONType)
+		_, _ = w.Write(doc)
+	}
+}
+
+// newOpenAPI generates the OpenAPI document of routes
+func newOpenAPI(routes []route, opts MuxOpts) *openAPI {
+	server := opts.Basepath
+	if server == "" {
+		server = "/"
+	}
+	version := opts.Version
+	if version == "" {
+		version = "unknown"
+	}
+	doc := &openAPI{
+		OpenAPI: openAPIVersion,
+		Info: openAPIInfo{
+			Title:   "Chronograf",
+			Version: version,
+		},
+		Servers: []openAPIServer{{URL: server}},
+		Paths:   map[string]map[string]*openAPIOperation{},
+	}
+
+	schemas := newOpenAPISchemas()
+	ids := map[string]int{}
+	for _, rt := range routes {
+		p, params := openAPIPath(rt.Path)
+		if doc.Paths[p] == nil {
+			doc.Paths[p] = map[string]*openAPIOperation{}
+		}
+		op := schemas.operation(rt, params)
+		// Operations are unique by their IDs, such as those of the routes
+		// of /swagger.json and of /chronograf/v1/swagger.json
+		if ids[op.OperationID]++; ids[op.OperationID] > 1 {
+			op.OperationID += strconv.Itoa(ids[op.OperationID])
+		}
+		doc.Paths[p][strings.ToLower(rt.Method)] = op
+	}
+
+	// Every error is described by the problem details of ErrorMessage,
+	// whose type is one of the types of problems
+	problem := schemas.schema(reflect.TypeOf(ErrorMessage{}))
+	types := []string{"about:blank"}
+	for _, name := range problemTypes {
+		types = append(types, ProblemTypePrefix+name)
+	}
+	sort.Strings(types)
+	schemas.schemas[strings.TrimPrefix(problem.Ref, openAPISchemaRef)].Properties["type"].Enum = types
+
+	doc.Components = openAPIComponents{
+		Schemas: schemas.schemas,
+		Responses: map[string]*openAPIResponse{
+			"Error": {
+				Description: "Problem details of the error",
+				Content: map[string]openAPIMediaType{
+					ProblemJSONType: {Schema: problem},
+				},
+			},
+		},
+	}
+	if opts.UseAuth {
+		doc.Components.SecuritySchemes = map[string]*openAPISecurityScheme{
+			"session": {
+				Type:        "apiKey",
+				In:          "cookie",
+				Name:        "session",
+				Description: "Session of a user logged in by one of the authentication routes",
+			},
+			"token": {
+				Type:        "apiKey",
+				In:          "header",
+				Name:        "Authorization",
+				Description: `API token of the current organization, as in "Authorization: Token <secret>"`,
+			},
+		}
+		doc.Security = []map[string][]string{{"session": {}}, {"token": {}}}
+	}
+	return doc
+}
+
+// openAPIPath converts the path pattern of a route to an OpenAPI path,
+// returning the names of its parameters
+func openAPIPath(pattern string) (string, []string) {
+	var params []string
+	segments := strings.Split(pattern, "/")
+	for i, s := range segments {
+		if strings.HasPrefix(s, ":") || strings.HasPrefix(s, "*") {
+			params = append(params, s[1:])
+			segments[i] = "{" + s[1:] + "}"
+		}
+	}
+	return strings.Join(segments, "/"), params
+}
+
+// openAPIOperationID names the operation of a route, e.g.
+// getOrganizationsByOidUsers for GET /chronograf/v1/organizations/:oid/users
+func openAPIOperationID(rt route) string {
+	id := strings.ToLower(rt.Method)
+	for _, s := range strings.Split(strings.TrimPrefix(rt.Path, "/chronograf/v1"), "/") {
+		if strings.HasPrefix(s, ":") || strings.HasPrefix(s, "*") {
+			s = "by-" + s[1:]
+		}
+		for _, word := range strings.FieldsFunc(s, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		}) {
+			id += strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+	return id
+}
+
+// openAPITag groups the operations of a route by the resource of its path,
+// e.g. organizations for /chronograf/v1/organizations/:oid/users
+func openAPITag(path string) string {
+	for _, s := range strings.Split(strings.TrimPrefix(path, "/chronograf/v1"), "/") {
+		if s != "" {
+			return s
+		}
+	}
+	return "chronograf"
+}
+
+const openAPISchemaRef = "#/components/schemas/"
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	rawMessageType    = reflect.TypeOf(json.RawMessage{})
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// openAPISchemas generates the schemas of the components of the OpenAPI
+// document from the structs of the bodies of requests and responses
+type openAPISchemas struct {
+	schemas map[string]*openAPISchema
+	names   map[reflect.Type]string
+}
+
+func newOpenAPISchemas() *openAPISchemas {
+	return &openAPISchemas{
+		schemas: map[string]*openAPISchema{},
+		names:   map[reflect.Type]string{},
+	}
+}
+
+// operation documents the operation of a route with its path parameters
+func (g *openAPISchemas) operation(rt route, params []string) *openAPIOperation {
+	op := &openAPIOperation{
+		OperationID: openAPIOperationID(rt),
+		Tags:        []string{openAPITag(rt.Path)},
+		Responses: map[string]*openAPIResponse{
+			"4XX": {Ref: "#/components/responses/Error"},
+			"5XX": {Ref: "#/components/responses/Error"},
+		},
+	}
+	for _, name := range params {
+		op.Parameters = append(op.Parameters, openAPIParameter{
+			Name:     name,
+			In:       "path",
+			Required: true,
+			Schema:   &openAPISchema{Type: "string"},
+		})
+	}
+
+	doc, ok := apiOperations[rt.Method+" "+rt.Path]
+	if !ok {
+		op.Responses["2XX"] = &openAPIResponse{Description: "Success"}
+		return op
+	}
+	op.Summary = doc.Summary
+	if doc.Request != nil {
+		op.RequestBody = &openAPIBody{
+			Required: true,
+			Content: map[string]openAPIMediaType{
+				JSONType: {Schema: g.schema(reflect.TypeOf(doc.Request))},
+			},
+		}
+	}
+	status := doc.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	res := &openAPIResponse{Description: http.StatusText(status)}
+	if doc.Response != nil {
+		res.Content = map[string]openAPIMediaType{
+			JSONType: {Schema: g.schema(reflect.TypeOf(doc.Response))},
+		}
+	}
+	op.Responses[strconv.Itoa(status)] = res
+	return op
+}
+
+// schema returns the schema of the JSON encoding of values of t; named
+// structs refer to their schema in the components
+func (g *openAPISchemas) schema(t reflect.Type) *openAPISchema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch {
+	case t == timeType:
+		return &openAPISchema{Type: "string", Format: "date-time"}
+	case t == rawMessageType:
+		return &openAPISchema{}
+	case t.Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(jsonMarshalerType):
+		// Values encoding themselves may be any JSON
+		return &openAPISchema{}
+	case t.Implements(textMarshalerType) || reflect.PtrTo(t).Implements(textMarshalerType):
+		return &openAPISchema{Type: "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &openAPISchema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &openAPISchema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+		return &openAPISchema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &openAPISchema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &openAPISchema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &openAPISchema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			return &openAPISchema{Type: "string", Format: "byte"}
+		}
+		return &openAPISchema{Type: "array", Items: g.schema(t.Elem())}
+	case reflect.Map:
+		return &openAPISchema{Type: "object", AdditionalProperties: g.schema(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.object(t)
+		}
+		return g.ref(t)
+	}
+	return &openAPISchema{}
+}
+
+// ref adds the schema of the named struct t to the components, unless it
+// was added already, and refers to it
+func (g *openAPISchemas) ref(t reflect.Type) *openAPISchema {
+	name, ok := g.names[t]
+	if !ok {
+		name = g.name(t)
+		// The name is known before the fields of t, which may refer to t
+		g.names[t] = name
+		g.schemas[name] = &openAPISchema{}
+		g.schemas[name] = g.object(t)
+	}
+	return &openAPISchema{Ref: openAPISchemaRef + name}
+}
+
+// name names the schema of t by its exported name, prefixed by its package
+// when structs of several packages share their name
+func (g *openAPISchemas) name(t reflect.Type) string {
+	name := exported(t.Name())
+	if _, ok := g.schemas[name]; ok {
+		pkg := t.PkgPath()
+		name = exported(pkg[strings.LastIndex(pkg, "/")+1:]) + name
+	}
+	return name
+}
+
+func exported(name string) string {
+	if name == "" {
+		return name
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// object returns the schema of the JSON object of the struct t
+func (g *openAPISchemas) object(t reflect.Type) *openAPISchema {
+	s := &openAPISchema{
+		Type:       "object",
+		Properties: map[string]*openAPISchema{},
+	}
+	g.fields(t, s)
+	return s
+}
+
+// fields adds the properties of the fields of the struct t to s. As with
+// encoding/json, the fields of embedded structs are promoted unless a field
+// of t has their name.
+func (g *openAPISchemas) fields(t reflect.Type, s *openAPISchema) {
+	var embedded []reflect.Type
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		opts := strings.Split(tag, ",")
+		name := opts[0]
+
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				embedded = append(embedded, ft)
+				continue
+			}
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+
+		schema := g.schema(f.Type)
+		for _, opt := range opts[1:] {
+			if opt == "string" {
+				schema = &openAPISchema{Type: "string"}
+			}
+		}
+		s.Properties[name] = schema
+	}
+
+	for _, et := range embedded {
+		promoted := &openAPISchema{Properties: map[string]*openAPISchema{}}
+		g.fields(et, promoted)
+		for name, schema := range promoted.Properties {
+			if _, ok := s.Properties[name]; !ok {
+				s.Properties[name] = schema
+			}
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/bouk/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func TestOpenAPI(t *testing.T) {
+	routes := &RouteTable{
+		Delegate: httprouter.New(),
+	}
+	noop := func(w http.ResponseWriter, r *http.Request) {}
+	routes.GET("/chronograf/v1/organizations/:oid", noop)
+	routes.PATCH("/chronograf/v1/organizations/:oid", noop)
+	routes.GET("/chronograf/v1/organizations/:oid/usage", noop)
+	routes.GET("/swagger.json", noop)
+	routes.GET("/chronograf/v1/swagger.json", OpenAPI(routes, MuxOpts{
+		Basepath: "/chronograf",
+		UseAuth:  true,
+		Version:  "1.8.0",
+	}))
+
+	w := httptest.NewRecorder()
+	routes.ServeHTTP(w, httptest.NewRequest("GET", "http://any.url/chronograf/v1/swagger.json", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("OpenAPI() = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	var doc openAPI
+	if err := json.NewDecoder(w.Body).Decode(&doc); err != nil {
+		t.Fatal(err)
+	}
+	if doc.OpenAPI != openAPIVersion || doc.Info.Version != "1.8.0" || doc.Servers[0].URL != "/chronograf" {
+		t.Errorf("OpenAPI() = %s %+v %+v", doc.OpenAPI, doc.Info, doc.Servers)
+	}
+	if len(doc.Paths) != 4 {
+		t.Errorf("OpenAPI() paths = %v, want the 4 paths of the routes", doc.Paths)
+	}
+
+	get := doc.Paths["/chronograf/v1/organizations/{oid}"]["get"]
+	if get == nil {
+		t.Fatalf("OpenAPI() paths = %v, want GET /chronograf/v1/organizations/{oid}", doc.Paths)
+	}
+	if get.OperationID != "getOrganizationsByOid" || get.Tags[0] != "organizations" {
+		t.Errorf("OpenAPI() operation = %s %v", get.OperationID, get.Tags)
+	}
+	if len(get.Parameters) != 1 || get.Parameters[0].Name != "oid" || get.Parameters[0].In != "path" {
+		t.Errorf("OpenAPI() parameters = %+v", get.Parameters)
+	}
+	if got := get.Responses["200"].Content[JSONType].Schema.Ref; got != openAPISchemaRef+"OrganizationResponse" {
+		t.Errorf("OpenAPI() response = %q, want the schema of organizationResponse", got)
+	}
+	if got := get.Responses["4XX"].Ref; got != "#/components/responses/Error" {
+		t.Errorf("OpenAPI() error response = %q", got)
+	}
+
+	org := doc.Components.Schemas["OrganizationResponse"]
+	for _, name := range []string{"links", "id", "name", "defaultRole"} {
+		if org == nil || org.Properties[name] == nil {
+			t.Errorf("OpenAPI() OrganizationResponse = %+v, want property %s", org, name)
+		}
+	}
+	if got := doc.Paths["/chronograf/v1/organizations/{oid}"]["patch"].RequestBody.Content[JSONType].Schema.Ref; got != openAPISchemaRef+"OrganizationRequest" {
+		t.Errorf("OpenAPI() request = %q, want the schema of organizationRequest", got)
+	}
+
+	// Undocumented routes are listed without their bodies
+	if usage := doc.Paths["/chronograf/v1/organizations/{oid}/usage"]["get"]; usage.Responses["2XX"] == nil {
+		t.Errorf("OpenAPI() undocumented responses = %+v", usage.Responses)
+	}
+	if a, b := doc.Paths["/swagger.json"]["get"].OperationID, doc.Paths["/chronograf/v1/swagger.json"]["get"].OperationID; a == b {
+		t.Errorf("OpenAPI() operation IDs of both documents = %q", a)
+	}
+
+	problem := doc.Components.Schemas["ErrorMessage"]
+	if problem == nil || problem.Properties["detail"] == nil || len(problem.Properties["type"].Enum) != len(problemTypes)+1 {
+		t.Errorf("OpenAPI() ErrorMessage = %+v, want the problem details with their types", problem)
+	}
+	if _, ok := doc.Components.Responses["Error"].Content[ProblemJSONType]; !ok {
+		t.Errorf("OpenAPI() Error response = %+v, want %s", doc.Components.Responses["Error"], ProblemJSONType)
+	}
+	if len(doc.Security) != 2 || doc.Components.SecuritySchemes["token"] == nil {
+		t.Errorf("OpenAPI() security = %+v", doc.Security)
+	}
+}
+
+func TestOpenAPISchemas(t *testing.T) {
+	type node struct {
+		chronograf.Role
+		Name     string            `json:"name"` // Name shadows the name of the role
+		Children []*node           `json:"children,omitempty"`
+		Labels   map[string]string `json:"labels"`
+		Count    int64             `json:"count,string"`
+		Ignored  string            `json:"-"`
+		secret   string
+	}
+
+	g := newOpenAPISchemas()
+	ref := g.schema(reflect.TypeOf(&node{}))
+	if ref.Ref != openAPISchemaRef+"Node" {
+		t.Fatalf("schema() = %+v, want a reference to Node", ref)
+	}
+	s := g.schemas["Node"]
+	if got := s.Properties["children"]; got.Type != "array" || got.Items.Ref != ref.Ref {
+		t.Errorf("schema() children = %+v, want an array of Node", got)
+	}
+	if got := s.Properties["labels"]; got.Type != "object" || got.AdditionalProperties.Type != "string" {
+		t.Errorf("schema() labels = %+v, want a map of strings", got)
+	}
+	if got := s.Properties["count"]; got.Type != "string" {
+		t.Errorf("schema() count = %+v, want a string", got)
+	}
+	if got := s.Properties["organization"]; got == nil || got.Type != "string" {
+		t.Errorf("schema() organization = %+v, want the promoted field of the role", got)
+	}
+	for _, name := range []string{"Ignored", "secret"} {
+		if _, ok := s.Properties[name]; ok {
+			t.Errorf("schema() has property %s", name)
+		}
+	}
+}
@@ -0,0 +1,275 @@
+package server
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	flags "github.com/jessevdk/go-flags"
+	yaml "gopkg.in/yaml.v3"
+)
+
+const (
+	// configOption is the long name of the option of the config file
+	configOption = "config"
+	// redacted replaces the values of secret options
+	redacted = "xxxxx"
+)
+
+// ParseOptions parses the options of parser from args, the environment and
+// the config file of the --config option, which take precedence in that
+// order. The options of the config file are keyed by their long names.
+func ParseOptions(parser *flags.Parser, args []string) ([]string, error) {
+	rest, err := parser.ParseArgs(args)
+	if err != nil {
+		return rest, err
+	}
+	opt := parser.FindOptionByLongName(configOption)
+	if opt == nil {
+		return rest, nil
+	}
+	path, _ := opt.Value().(flags.Filename)
+	if path == "" {
+		return rest, nil
+	}
+
+	fileArgs, err := configFileArgs(parser, string(path))
+	if err != nil {
+		return rest, err
+	}
+	// Options of args are parsed last so that they override the config file
+	return parser.ParseArgs(append(fileArgs, args...))
+}
+
+// readConfigFile decodes a YAML or TOML config file by its extension
+func readConfigFile(path string) (map[string]interface{}, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	config := map[string]interface{}{}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yml", ".yaml":
+		err = yaml.NewDecoder(f).Decode(&config)
+	case ".toml":
+		_, err = toml.DecodeReader(f, &config)
+	default:
+		return nil, fmt.Errorf("config file %s must be a .yaml, .yml or .toml file", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid config file %s: %v", path, err)
+	}
+	return config, nil
+}
+
+// configFileArgs returns the options of a config file as the args setting
+// them. Options set by args or by the environment are skipped so that they
+// keep their precedence over the config file.
+func configFileArgs(parser *flags.Parser, path string) ([]string, error) {
+	config, err := readConfigFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(config))
+	for name := range config {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	args := []string{}
+	for _, name := range names {
+		opt := parser.FindOptionByLongName(name)
+		if opt == nil || name == configOption {
+			return nil, fmt.Errorf("unknown option %s in config file %s", name, path)
+		}
+		if opt.IsSet() && !opt.IsSetDefault() {
+			continue
+		}
+		if _, ok := os.LookupEnv(opt.EnvDefaultKey); ok && opt.EnvDefaultKey != "" {
+			continue
+		}
+		args = append(args, optionArgs(name, config[name])...)
+	}
+	return args, nil
+}
+
+// optionArgs returns the args setting the option name to a value of a config
+// file. Lists repeat the option for each of their values and tables set the
+// key:value pairs of map options.
+func optionArgs(name string, value interface{}) []string {
+	switch v := value.(type) {
+	case bool:
+		if v {
+			return []string{"--" + name}
+		}
+		return nil
+	case []interface{}:
+		args := []string{}
+		for _, e := range v {
+			args = append(args, optionArgs(name, e)...)
+		}
+		return args
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		args := []string{}
+		for _, k := range keys {
+			args = append(args, fmt.Sprintf("--%s=%s:%v", name, k, v[k]))
+		}
+		return args
+	default:
+		return []string{fmt.Sprintf("--%s=%v", name, v)}
+	}
+}
+
+// Validate checks the options of the Server which are invalid regardless of
+// its stores and auth providers
+func (s *Server) Validate() error {
+	if _, err := NewCustomLinks(s.CustomLinks); err != nil {
+		return err
+	}
+	if len(s.EtcdEndpoints) > 0 && s.PostgresURL != "" {
+		return fmt.Errorf("only one of etcd-endpoints and postgres-url can be set")
+	}
+	if !validBasepath(s.Basepath) {
+		return fmt.Errorf("invalid basepath, must follow format \"/mybasepath\"")
+	}
+	if s.UserRequestsBurst < 0 || s.IPRequestsBurst < 0 {
+		return fmt.Errorf("user-requests-burst and ip-requests-burst cannot be negative")
+	}
+	if s.ImpersonationDuration < 0 {
+		return fmt.Errorf("impersonation-duration cannot be negative")
+	}
+	if s.WebhookAttempts < 0 || s.WebhookRetryDelay < 0 {
+		return fmt.Errorf("webhook-attempts and webhook-retry-delay cannot be negative")
+	}
+	if s.JWKSCacheTTL < 0 || s.GroupCacheTTL < 0 || s.IdPCacheStale < 0 {
+		return fmt.Errorf("jwks-cache-ttl, group-cache-ttl and idp-cache-stale cannot be negative")
+	}
+	for _, srcs := range [][]string{s.CSPFrameSrc, s.CSPFrameAncestors} {
+		for _, src := range srcs {
+			if err := validCSPSource(src); err != nil {
+				return err
+			}
+		}
+	}
+	if s.TracingSampleRatio < 0 || s.TracingSampleRatio > 1 {
+		return fmt.Errorf("tracing-sample-ratio must be between 0 and 1")
+	}
+	if s.useTLS() {
+		key := s.Key
+		if key == "" {
+			key = s.Cert
+		}
+		if _, err := tls.LoadX509KeyPair(string(s.Cert), string(key)); err != nil {
+			return fmt.Errorf("invalid TLS certificate: %v", err)
+		}
+	}
+	if s.UseHeaderAuth() {
+		if len(s.HeaderAuthTrustedProxies) == 0 {
+			return fmt.Errorf("header-auth-user requires header-auth-trusted-proxy")
+		}
+		if _, err := parseTrustedProxies(s.HeaderAuthTrustedProxies); err != nil {
+			return err
+		}
+	}
+	if s.GRPCPort < 0 {
+		return fmt.Errorf("grpc-port cannot be negative")
+	}
+	if s.GRPCPort > 0 && s.TLSClientCA == "" {
+		return fmt.Errorf("grpc-port requires tls-client-ca")
+	}
+	if s.TLSClientCA != "" {
+		if !s.useTLS() {
+			return fmt.Errorf("tls-client-ca requires cert")
+		}
+		if _, err := s.clientCAs(); err != nil {
+			return fmt.Errorf("invalid TLS client certificate authorities: %v", err)
+		}
+	}
+	return nil
+}
+
+// secretOption is true for the options whose values are secrets. The new
+// sources are redacted as a whole as they embed the passwords of sources.
+func secretOption(name string) bool {
+	return strings.HasSuffix(name, "secret") ||
+		strings.HasSuffix(name, "-password") ||
+		strings.HasSuffix(name, "-token") ||
+		name == "encryption-key" ||
+		name == "new-sources"
+}
+
+// redactURL redacts the password of a URL
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return redacted
+	}
+	if _, ok := u.User.Password(); ok {
+		u.User = url.UserPassword(u.User.Username(), redacted)
+	}
+	return u.String()
+}
+
+// redactOption returns the value of an option as reported, secrets being
+// redacted
+func redactOption(name string, v reflect.Value) interface{} {
+	switch value := v.Interface().(type) {
+	case time.Duration:
+		return value.String()
+	case flags.Filename:
+		return string(value)
+	case string:
+		if value == "" {
+			return value
+		}
+		if name == "postgres-url" {
+			return redactURL(value)
+		}
+		if secretOption(name) {
+			return redacted
+		}
+		return value
+	case []string:
+		if !secretOption(name) {
+			return value
+		}
+		secrets := make([]string, len(value))
+		for i := range value {
+			secrets[i] = redacted
+		}
+		return secrets
+	default:
+		return value
+	}
+}
+
+// Options returns the effective options of the Server keyed by their long
+// names, the values of secret options being redacted
+func (s *Server) Options() map[string]interface{} {
+	options := map[string]interface{}{}
+	v := reflect.ValueOf(s).Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name := t.Field(i).Tag.Get("long")
+		if name == "" || name == "version" || name == "validate-config" {
+			continue
+		}
+		options[name] = redactOption(name, v.Field(i))
+	}
+	return options
+}
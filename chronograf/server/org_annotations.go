@@ -0,0 +1,321 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bouk/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// defaultAnnotationStream is the stream of annotations posted without one
+const defaultAnnotationStream = "default"
+
+type orgAnnotationResponse struct {
+	ID        string          `json:"id"`
+	StartTime time.Time       `json:"startTime"`
+	EndTime   time.Time       `json:"endTime"`
+	Region    bool            `json:"region"` // Region is true when the annotation spans a period of time
+	Text      string          `json:"text"`
+	Type      string          `json:"type"`
+	Stream    string          `json:"stream"`
+	Tags      []string        `json:"tags"`
+	Links     annotationLinks `json:"links"`
+}
+
+func newOrgAnnotationResponse(a chronograf.Annotation) *orgAnnotationResponse {
+	res := &orgAnnotationResponse{
+		ID:        a.ID,
+		StartTime: a.StartTime,
+		EndTime:   a.EndTime,
+		Region:    a.Region(),
+		Text:      a.Text,
+		Type:      a.Type,
+		Stream:    a.Stream,
+		Tags:      a.Tags,
+		Links: annotationLinks{
+			Self: fmt.Sprintf("/chronograf/v1/annotations/%s", a.ID),
+		},
+	}
+	if res.Tags == nil {
+		res.Tags = []string{}
+	}
+	return res
+}
+
+type orgAnnotationsResponse struct {
+	Links       selfLinks                `json:"links"`
+	Annotations []*orgAnnotationResponse `json:"annotations"`
+}
+
+func newOrgAnnotationsResponse(as []chronograf.Annotation) *orgAnnotationsResponse {
+	res := make([]*orgAnnotationResponse, len(as))
+	for i, a := range as {
+		res[i] = newOrgAnnotationResponse(a)
+	}
+	return &orgAnnotationsResponse{
+		Links: selfLinks{
+			Self: "/chronograf/v1/annotations",
+		},
+		Annotations: res,
+	}
+}
+
+type orgAnnotationRequest struct {
+	StartTime *time.Time `json:"startTime"` // StartTime defaults to now when the annotation is created
+	EndTime   *time.Time `json:"endTime"`   // EndTime defaults to StartTime, marking a point in time
+	Text      *string    `json:"text"`
+	Type      *string    `json:"type"`
+	Stream    *string    `json:"stream"` // Stream defaults to default
+	Tags      []string   `json:"tags"`
+}
+
+// apply copies the fields of the request that are set into a
+func (req *orgAnnotationRequest) apply(a *chronograf.Annotation) {
+	if req.StartTime != nil {
+		a.StartTime = req.StartTime.UTC()
+	}
+	if req.EndTime != nil {
+		a.EndTime = req.EndTime.UTC()
+	}
+	if req.Text != nil {
+		a.Text = *req.Text
+	}
+	if req.Type != nil {
+		a.Type = *req.Type
+	}
+	if req.Stream != nil {
+		a.Stream = *req.Stream
+	}
+	if req.Tags != nil {
+		a.Tags = req.Tags
+	}
+}
+
+// validOrgAnnotation checks the text, time span and tags of an annotation
+func validOrgAnnotation(a *chronograf.Annotation) error {
+	if a.Text == "" {
+		return errorf("text required on Chronograf Annotation request body")
+	}
+	if a.Stream == "" {
+		return errorf("stream must not be empty")
+	}
+	if a.EndTime.Before(a.StartTime) {
+		return errorf("endTime must not be before startTime")
+	}
+	for _, tag := range a.Tags {
+		if strings.TrimSpace(tag) == "" {
+			return errorf("tags must not be empty")
+		}
+	}
+	return nil
+}
+
+// annotationMatches is true when a belongs to one of streams, if any, and has
+// every tag of tags
+func annotationMatches(a chronograf.Annotation, streams, tags []string) bool {
+	if len(streams) > 0 {
+		found := false
+		for _, s := range streams {
+			found = found || s == a.Stream
+		}
+		if !found {
+			return false
+		}
+	}
+	for _, tag := range tags {
+		found := false
+		for _, t := range a.Tags {
+			found = found || t == tag
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// OrganizationAnnotations lists the annotations of the current organization
+// between since and until, the most recent first. They are not bound to a
+// source so that dashboards show them whatever they query. The stream and
+// tag parameters may be repeated; annotations belong to any of the streams
+// and have all of the tags.
+func (s *Service) OrganizationAnnotations(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	start, stop, err := validAnnotationQuery(query)
+	if err != nil {
+		Error(w, http.StatusUnprocessableEntity, err.Error(), s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	as, err := s.Store.Annotations(ctx).All(ctx, start, stop)
+	if err != nil {
+		unknownErrorWithMessage(w, fmt.Errorf("error loading annotations: %v", err), s.Logger)
+		return
+	}
+
+	streams, tags := query["stream"], query["tag"]
+	annotations := as[:0]
+	for _, a := range as {
+		if annotationMatches(a, streams, tags) {
+			annotations = append(annotations, a)
+		}
+	}
+
+	encodeJSON(w, http.StatusOK, newOrgAnnotationsResponse(annotations), s.Logger)
+}
+
+// OrganizationAnnotation returns a single annotation of the current organization
+func (s *Service) OrganizationAnnotation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := httprouter.GetParamFromContext(ctx, "id")
+	a, err := s.Store.Annotations(ctx).Get(ctx, id)
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+
+	encodeJSON(w, http.StatusOK, newOrgAnnotationResponse(*a), s.Logger)
+}
+
+// NewOrganizationAnnotation adds an annotation to the current organization.
+// Continuous integration marks deploys by posting here with an API token
+// scoped to annotations; the annotation starts now unless told otherwise.
+func (s *Service) NewOrganizationAnnotation(w http.ResponseWriter, r *http.Request) {
+	var req orgAnnotationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+
+	a := &chronograf.Annotation{
+		StartTime: time.Now().UTC(),
+		Stream:    defaultAnnotationStream,
+	}
+	req.apply(a)
+	if req.EndTime == nil {
+		a.EndTime = a.StartTime
+	}
+	if err := validOrgAnnotation(a); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	a, err := s.Store.Annotations(ctx).Add(ctx, a)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	res := newOrgAnnotationResponse(*a)
+	location(w, res.Links.Self)
+	encodeJSON(w, http.StatusCreated, res, s.Logger)
+}
+
+// UpdateOrganizationAnnotation changes the time span, text, stream or tags of
+// an annotation
+func (s *Service) UpdateOrganizationAnnotation(w http.ResponseWriter, r *http.Request) {
+	var req orgAnnotationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	id := httprouter.GetParamFromContext(ctx, "id")
+	a, err := s.Store.Annotations(ctx).Get(ctx, id)
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+
+	// Moving a point in time keeps it a point
+	point := !a.Region()
+	req.apply(a)
+	if point && req.StartTime != nil && req.EndTime == nil {
+		a.EndTime = a.StartTime
+	}
+	if err := validOrgAnnotation(a); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	if err := s.Store.Annotations(ctx).Update(ctx, a); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	encodeJSON(w, http.StatusOK, newOrgAnnotationResponse(*a), s.Logger)
+}
+
+// RemoveOrganizationAnnotation deletes an annotation
+func (s *Service) RemoveOrganizationAnnotation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := httprouter.GetParamFromContext(ctx, "id")
+	if _, err := s.Store.Annotations(ctx).Get(ctx, id); err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+
+	if err := s.Store.Annotations(ctx).Delete(ctx, id); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type annotationStream struct {
+	Name   string    `json:"name"`
+	Count  int       `json:"count"`  // Count is the number of annotations of the stream
+	Latest time.Time `json:"latest"` // Latest is the end of the most recent annotation of the stream
+}
+
+type annotationStreamsResponse struct {
+	Links   selfLinks          `json:"links"`
+	Streams []annotationStream `json:"streams"`
+}
+
+// AnnotationStreams lists the streams of the annotations of the current
+// organization by name so that dashboards can choose which ones to show
+func (s *Service) AnnotationStreams(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	as, err := s.Store.Annotations(ctx).All(ctx, time.Unix(0, math.MinInt64), time.Unix(0, math.MaxInt64))
+	if err != nil {
+		unknownErrorWithMessage(w, fmt.Errorf("error loading annotations: %v", err), s.Logger)
+		return
+	}
+
+	byName := map[string]*annotationStream{}
+	for _, a := range as {
+		stream, ok := byName[a.Stream]
+		if !ok {
+			stream = &annotationStream{Name: a.Stream}
+			byName[a.Stream] = stream
+		}
+		stream.Count++
+		if a.EndTime.After(stream.Latest) {
+			stream.Latest = a.EndTime
+		}
+	}
+
+	res := annotationStreamsResponse{
+		Links: selfLinks{
+			Self: "/chronograf/v1/annotation-streams",
+		},
+		Streams: make([]annotationStream, 0, len(byName)),
+	}
+	for _, stream := range byName {
+		res.Streams = append(res.Streams, *stream)
+	}
+	sort.Slice(res.Streams, func(i, j int) bool {
+		return res.Streams[i].Name < res.Streams[j].Name
+	})
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
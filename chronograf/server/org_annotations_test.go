@@ -0,0 +1,191 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+func newAnnotationsService(stored *[]chronograf.Annotation) *Service {
+	return &Service{
+		Store: &mocks.Store{
+			AnnotationsStore: &mocks.AnnotationsStore{
+				AllF: func(ctx context.Context, start, stop time.Time) ([]chronograf.Annotation, error) {
+					return append([]chronograf.Annotation{}, *stored...), nil
+				},
+				AddF: func(ctx context.Context, a *chronograf.Annotation) (*chronograf.Annotation, error) {
+					a.ID = "1"
+					*stored = append(*stored, *a)
+					return a, nil
+				},
+			},
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+}
+
+func TestService_NewOrganizationAnnotation(t *testing.T) {
+	deployed := time.Date(2018, 1, 1, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantErr    string
+		want       chronograf.Annotation
+	}{
+		{
+			name:       "Deploy marker",
+			body:       `{"text":"Deployed api v1.2.0","startTime":"2018-01-01T09:00:00Z","stream":"deploys","tags":["api","production"]}`,
+			wantStatus: http.StatusCreated,
+			want: chronograf.Annotation{
+				ID:        "1",
+				StartTime: deployed,
+				EndTime:   deployed,
+				Text:      "Deployed api v1.2.0",
+				Stream:    "deploys",
+				Tags:      []string{"api", "production"},
+			},
+		},
+		{
+			name:       "Region in the default stream",
+			body:       `{"text":"Maintenance","startTime":"2018-01-01T09:00:00Z","endTime":"2018-01-01T11:00:00Z"}`,
+			wantStatus: http.StatusCreated,
+			want: chronograf.Annotation{
+				ID:        "1",
+				StartTime: deployed,
+				EndTime:   deployed.Add(2 * time.Hour),
+				Text:      "Maintenance",
+				Stream:    defaultAnnotationStream,
+			},
+		},
+		{
+			name:       "Ends before it starts",
+			body:       `{"text":"Maintenance","startTime":"2018-01-01T09:00:00Z","endTime":"2018-01-01T08:00:00Z"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantErr:    "endTime must not be before startTime",
+		},
+		{
+			name:       "Without text",
+			body:       `{"stream":"deploys"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantErr:    "text required on Chronograf Annotation request body",
+		},
+		{
+			name:       "Empty tag",
+			body:       `{"text":"Deployed","tags":[" "]}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantErr:    "tags must not be empty",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stored := []chronograf.Annotation{}
+			s := newAnnotationsService(&stored)
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url/chronograf/v1/annotations", bytes.NewBufferString(tt.body))
+			s.NewOrganizationAnnotation(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("%q. NewOrganizationAnnotation() = %v, want %v: %s", tt.name, resp.StatusCode, tt.wantStatus, body)
+			}
+			if tt.wantErr != "" {
+				if !strings.Contains(string(body), tt.wantErr) {
+					t.Errorf("%q. NewOrganizationAnnotation() = %s, want error %q", tt.name, body, tt.wantErr)
+				}
+				return
+			}
+			if len(stored) != 1 {
+				t.Fatalf("%q. NewOrganizationAnnotation() stored %d annotations", tt.name, len(stored))
+			}
+			if diff := cmp.Diff(stored[0], tt.want); diff != "" {
+				t.Errorf("%q. NewOrganizationAnnotation() diff (-got +want):\n%s", tt.name, diff)
+			}
+			if loc := resp.Header.Get("Location"); loc != "/chronograf/v1/annotations/1" {
+				t.Errorf("%q. NewOrganizationAnnotation() Location = %s", tt.name, loc)
+			}
+		})
+	}
+}
+
+func TestService_OrganizationAnnotations(t *testing.T) {
+	stored := []chronograf.Annotation{
+		{ID: "1", Text: "Deployed api", Stream: "deploys", Tags: []string{"api", "production"}},
+		{ID: "2", Text: "Deployed web", Stream: "deploys", Tags: []string{"web", "production"}},
+		{ID: "3", Text: "Deployed api to staging", Stream: "deploys", Tags: []string{"api", "staging"}},
+		{ID: "4", Text: "Maintenance", Stream: "maintenance"},
+	}
+
+	tests := []struct {
+		name    string
+		query   string
+		wantIDs []string
+	}{
+		{
+			name:    "All streams",
+			query:   "",
+			wantIDs: []string{"1", "2", "3", "4"},
+		},
+		{
+			name:    "Stream",
+			query:   "&stream=maintenance",
+			wantIDs: []string{"4"},
+		},
+		{
+			name:    "Every tag",
+			query:   "&tag=api&tag=production",
+			wantIDs: []string{"1"},
+		},
+		{
+			name:    "Any stream with a tag",
+			query:   "&stream=deploys&stream=maintenance&tag=production",
+			wantIDs: []string{"1", "2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newAnnotationsService(&stored)
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("GET", "http://any.url/chronograf/v1/annotations?since=2018-01-01T00:00:00.000Z"+tt.query, nil)
+			s.OrganizationAnnotations(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("%q. OrganizationAnnotations() = %v: %s", tt.name, resp.StatusCode, body)
+			}
+			var got orgAnnotationsResponse
+			if err := json.Unmarshal(body, &got); err != nil {
+				t.Fatalf("%q. OrganizationAnnotations() = %s", tt.name, body)
+			}
+			ids := []string{}
+			for _, a := range got.Annotations {
+				ids = append(ids, a.ID)
+			}
+			if diff := cmp.Diff(ids, tt.wantIDs); diff != "" {
+				t.Errorf("%q. OrganizationAnnotations() diff (-got +want):\n%s", tt.name, diff)
+			}
+		})
+	}
+
+	// The start of the range is required
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "http://any.url/chronograf/v1/annotations", nil)
+	newAnnotationsService(&stored).OrganizationAnnotations(w, r)
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("OrganizationAnnotations() without since = %v, want %v", w.Code, http.StatusUnprocessableEntity)
+	}
+}
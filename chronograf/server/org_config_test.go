@@ -460,8 +460,8 @@ func TestReplaceLogViewerOrganizationConfig(t *testing.T) {
 			},
 			wants: wants{
 				statusCode:  400,
-				contentType: "application/json",
-				body:        `{"code":400,"message":"invalid log viewer config: must have at least 1 column"}`,
+				contentType: "application/problem+json",
+				body:        `{"code":400,"message":"invalid log viewer config: must have at least 1 column","type":"urn:chronograf:problem:invalid-request","title":"Bad Request","status":400,"detail":"invalid log viewer config: must have at least 1 column"}`,
 			},
 		},
 		{
@@ -525,8 +525,8 @@ func TestReplaceLogViewerOrganizationConfig(t *testing.T) {
 			},
 			wants: wants{
 				statusCode:  400,
-				contentType: "application/json",
-				body:        `{"code":400,"message":"invalid log viewer config: Duplicate column name procid"}`,
+				contentType: "application/problem+json",
+				body:        `{"code":400,"message":"invalid log viewer config: Duplicate column name procid","type":"urn:chronograf:problem:invalid-request","title":"Bad Request","status":400,"detail":"invalid log viewer config: Duplicate column name procid"}`,
 			},
 		},
 		{
@@ -590,8 +590,8 @@ func TestReplaceLogViewerOrganizationConfig(t *testing.T) {
 			},
 			wants: wants{
 				statusCode:  400,
-				contentType: "application/json",
-				body:        `{"code":400,"message":"invalid log viewer config: Multiple columns with same position value"}`,
+				contentType: "application/problem+json",
+				body:        `{"code":400,"message":"invalid log viewer config: Multiple columns with same position value","type":"urn:chronograf:problem:invalid-request","title":"Bad Request","status":400,"detail":"invalid log viewer config: Multiple columns with same position value"}`,
 			},
 		},
 		{
@@ -660,8 +660,8 @@ func TestReplaceLogViewerOrganizationConfig(t *testing.T) {
 			},
 			wants: wants{
 				statusCode:  400,
-				contentType: "application/json",
-				body:        `{"code":400,"message":"invalid log viewer config: missing visibility encoding in column severity"}`,
+				contentType: "application/problem+json",
+				body:        `{"code":400,"message":"invalid log viewer config: missing visibility encoding in column severity","type":"urn:chronograf:problem:invalid-request","title":"Bad Request","status":400,"detail":"invalid log viewer config: missing visibility encoding in column severity"}`,
 			},
 		},
 	}
@@ -13,21 +13,28 @@ import (
 )
 
 type organizationRequest struct {
-	Name        string `json:"name"`
-	DefaultRole string `json:"defaultRole"`
+	Name        string                         `json:"name"`
+	DefaultRole string                         `json:"defaultRole"`
+	Quotas      *chronograf.OrganizationQuotas `json:"quotas"`
 }
 
 func (r *organizationRequest) ValidCreate() error {
 	if r.Name == "" {
-		return fmt.Errorf("name required on Chronograf Organization request body")
+		return errorf("name required on Chronograf Organization request body")
+	}
+	if err := r.ValidQuotas(); err != nil {
+		return err
 	}
 
 	return r.ValidDefaultRole()
 }
 
 func (r *organizationRequest) ValidUpdate() error {
-	if r.Name == "" && r.DefaultRole == "" {
-		return fmt.Errorf("no fields to update")
+	if r.Name == "" && r.DefaultRole == "" && r.Quotas == nil {
+		return errorf("no fields to update")
+	}
+	if err := r.ValidQuotas(); err != nil {
+		return err
 	}
 
 	if r.DefaultRole != "" {
@@ -37,6 +44,16 @@ func (r *organizationRequest) ValidUpdate() error {
 	return nil
 }
 
+func (r *organizationRequest) ValidQuotas() error {
+	if r.Quotas == nil {
+		return nil
+	}
+	if r.Quotas.MaxDashboards < 0 || r.Quotas.MaxUsers < 0 || r.Quotas.MaxKapacitorRules < 0 {
+		return errorf("quotas must not be negative")
+	}
+	return nil
+}
+
 func (r *organizationRequest) ValidDefaultRole() error {
 	if r.DefaultRole == "" {
 		r.DefaultRole = roles.MemberRoleName
@@ -46,7 +63,7 @@ func (r *organizationRequest) ValidDefaultRole() error {
 	case roles.MemberRoleName, roles.ViewerRoleName, roles.EditorRoleName, roles.AdminRoleName:
 		return nil
 	default:
-		return fmt.Errorf("default role must be member, viewer, editor, or admin")
+		return errorf("default role must be member, viewer, editor, or admin")
 	}
 }
 
@@ -116,6 +133,7 @@ func (s *Service) NewOrganization(w http.ResponseWriter, r *http.Request) {
 	org := &chronograf.Organization{
 		Name:        req.Name,
 		DefaultRole: req.DefaultRole,
+		Quotas:      req.Quotas,
 	}
 
 	res, err := s.Store.Organizations(ctx).Add(ctx, org)
@@ -124,36 +142,41 @@ func (s *Service) NewOrganization(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Now that the organization was created, add the user
-	// making the request to the organization
+	if err := s.addOrganizationCreator(ctx, res); err != nil {
+		Error(w, http.StatusInternalServerError, err.Error(), s.Logger)
+		return
+	}
+
+	co := newOrganizationResponse(res)
+	location(w, co.Links.Self)
+	encodeJSON(w, http.StatusCreated, co, s.Logger)
+}
+
+// addOrganizationCreator adds the user making the request to the organization
+// they created as an admin. The organization is removed if that fails.
+func (s *Service) addOrganizationCreator(ctx context.Context, org *chronograf.Organization) error {
 	user, ok := hasUserContext(ctx)
 	if !ok {
 		// Best attempt at cleanup the organization if there were any errors
-		_ = s.Store.Organizations(ctx).Delete(ctx, res)
-		Error(w, http.StatusInternalServerError, "failed to retrieve user from context", s.Logger)
-		return
+		_ = s.Store.Organizations(ctx).Delete(ctx, org)
+		return fmt.Errorf("failed to retrieve user from context")
 	}
 
 	user.Roles = []chronograf.Role{
 		{
-			Organization: res.ID,
+			Organization: org.ID,
 			Name:         roles.AdminRoleName,
 		},
 	}
 
-	orgCtx := context.WithValue(ctx, organizations.ContextKey, res.ID)
-	_, err = s.Store.Users(orgCtx).Add(orgCtx, user)
-	if err != nil {
+	orgCtx := context.WithValue(ctx, organizations.ContextKey, org.ID)
+	if _, err := s.Store.Users(orgCtx).Add(orgCtx, user); err != nil {
 		// Best attempt at cleanup the organization if there were any errors adding user to org
-		_ = s.Store.Organizations(ctx).Delete(ctx, res)
+		_ = s.Store.Organizations(ctx).Delete(ctx, org)
 		s.Logger.Error("failed to add user to organization", err.Error())
-		Error(w, http.StatusInternalServerError, "failed to add user to organization", s.Logger)
-		return
+		return fmt.Errorf("failed to add user to organization")
 	}
-
-	co := newOrganizationResponse(res)
-	location(w, co.Links.Self)
-	encodeJSON(w, http.StatusCreated, co, s.Logger)
+	return nil
 }
 
 // OrganizationID retrieves a organization with ID from store
@@ -202,6 +225,10 @@ func (s *Service) UpdateOrganization(w http.ResponseWriter, r *http.Request) {
 		org.DefaultRole = req.DefaultRole
 	}
 
+	if req.Quotas != nil {
+		org.Quotas = req.Quotas
+	}
+
 	err = s.Store.Organizations(ctx).Update(ctx, org)
 	if err != nil {
 		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
@@ -293,8 +293,8 @@ func TestService_UpdateOrganization(t *testing.T) {
 			},
 			id:              "1337",
 			wantStatus:      http.StatusUnprocessableEntity,
-			wantContentType: "application/json",
-			wantBody:        `{"code":422,"message":"no fields to update"}`,
+			wantContentType: "application/problem+json",
+			wantBody:        `{"code":422,"message":"no fields to update","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"no fields to update"}`,
 		},
 		{
 			name: "Update Organization default role",
@@ -353,8 +353,8 @@ func TestService_UpdateOrganization(t *testing.T) {
 			},
 			id:              "1337",
 			wantStatus:      http.StatusUnprocessableEntity,
-			wantContentType: "application/json",
-			wantBody:        `{"code":422,"message":"no fields to update"}`,
+			wantContentType: "application/problem+json",
+			wantBody:        `{"code":422,"message":"no fields to update","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"no fields to update"}`,
 		},
 		{
 			name: "Update Organization - invalid role",
@@ -382,8 +382,8 @@ func TestService_UpdateOrganization(t *testing.T) {
 			},
 			id:              "1337",
 			wantStatus:      http.StatusUnprocessableEntity,
-			wantContentType: "application/json",
-			wantBody:        `{"code":422,"message":"default role must be member, viewer, editor, or admin"}`,
+			wantContentType: "application/problem+json",
+			wantBody:        `{"code":422,"message":"default role must be member, viewer, editor, or admin","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"default role must be member, viewer, editor, or admin"}`,
 		},
 	}
 
@@ -602,8 +602,8 @@ func TestService_NewOrganization(t *testing.T) {
 				},
 			},
 			wantStatus:      http.StatusUnprocessableEntity,
-			wantContentType: "application/json",
-			wantBody:        `{"code":422,"message":"name required on Chronograf Organization request body"}`,
+			wantContentType: "application/problem+json",
+			wantBody:        `{"code":422,"message":"name required on Chronograf Organization request body","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"name required on Chronograf Organization request body"}`,
 		},
 		{
 			name: "Create Organization - no user on context",
@@ -643,8 +643,8 @@ func TestService_NewOrganization(t *testing.T) {
 				},
 			},
 			wantStatus:      http.StatusInternalServerError,
-			wantContentType: "application/json",
-			wantBody:        `{"code":500,"message":"failed to retrieve user from context"}`,
+			wantContentType: "application/problem+json",
+			wantBody:        `{"code":500,"message":"failed to retrieve user from context","type":"urn:chronograf:problem:internal-error","title":"Internal Server Error","status":500,"detail":"failed to retrieve user from context"}`,
 		},
 		{
 			name: "Create Organization - failed to add user to organization",
@@ -685,8 +685,8 @@ func TestService_NewOrganization(t *testing.T) {
 				},
 			},
 			wantStatus:      http.StatusInternalServerError,
-			wantContentType: "application/json",
-			wantBody:        `{"code":500,"message":"failed to add user to organization"}`,
+			wantContentType: "application/problem+json",
+			wantBody:        `{"code":500,"message":"failed to add user to organization","type":"urn:chronograf:problem:internal-error","title":"Internal Server Error","status":500,"detail":"failed to add user to organization"}`,
 		},
 	}
 
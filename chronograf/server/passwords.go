@@ -0,0 +1,24 @@
+package server
+
+import "golang.org/x/crypto/bcrypt"
+
+// hashPassword returns the bcrypt hash of the password of a user of the
+// basic scheme
+func hashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// dummyPasswordHash is the bcrypt hash, at the default cost, of a random
+// password. Logins of unknown users are checked against it so that they take
+// as long as logins with a wrong password and do not reveal which usernames
+// exist.
+const dummyPasswordHash = "$2a$10$RXd1KcVOkkwG1v5H6c.QSOnoqvnheLygkfbSq9VlBLggj4a6J.mcW"
+
+// checkPassword returns an error unless password matches the bcrypt hash
+func checkPassword(hash, password string) error {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+}
@@ -0,0 +1,223 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// PluginManifest describes what a plugin adds to chronograf. Plugins are
+// sidecar HTTP services that serve their manifest at GET /manifest.
+type PluginManifest struct {
+	Version     string           `json:"version,omitempty"` // Version is the plugin's own version
+	SourceTypes []string         `json:"sourceTypes"`       // SourceTypes are the chronograf.Source types queries are proxied to the plugin for
+	CellTypes   []PluginCellType `json:"cellTypes"`         // CellTypes are the additional visualizations the plugin provides metadata for
+}
+
+// PluginCellType is the visualization metadata a plugin exposes to the client
+type PluginCellType struct {
+	Type        string          `json:"type"`                  // Type is the value of chronograf.DashboardCell.Type for this visualization
+	Name        string          `json:"name"`                  // Name is the user-facing name of the visualization
+	Description string          `json:"description,omitempty"` // Description is a user-facing explanation of the visualization
+	Options     json.RawMessage `json:"options,omitempty"`     // Options are opaque, plugin-specific rendering options for the client
+}
+
+// Plugin is a sidecar service registered with chronograf
+type Plugin struct {
+	Name     string
+	URL      string
+	Manifest PluginManifest
+
+	client *http.Client
+}
+
+type pluginQueryRequest struct {
+	Source chronograf.Source `json:"source"`
+	Query  chronograf.Query  `json:"query"`
+}
+
+// Query proxies a query against src to the plugin's POST /query endpoint.
+// The plugin responds with the same shape as the InfluxDB proxy; that is,
+// a JSON object with a results key.
+func (p *Plugin) Query(ctx context.Context, src chronograf.Source, q chronograf.Query) (interface{}, error) {
+	body, err := json.Marshal(pluginQueryRequest{
+		Source: src,
+		Query:  q,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", singleJoiningSlash(p.URL, "query"), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", JSONType)
+
+	resp, err := p.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var msg ErrorMessage
+		if err := json.NewDecoder(resp.Body).Decode(&msg); err != nil || msg.Message == "" {
+			return nil, fmt.Errorf("plugin %s responded with status %d", p.Name, resp.StatusCode)
+		}
+		return nil, fmt.Errorf("plugin %s: %s", p.Name, msg.Message)
+	}
+
+	var res postInfluxResponse
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, err
+	}
+	return res.Results, nil
+}
+
+// Plugins is the registry of sidecar plugins keyed by name
+type Plugins struct {
+	Timeout time.Duration // Timeout bounds every request made to a plugin
+
+	mu      sync.RWMutex
+	plugins map[string]*Plugin
+}
+
+// Register fetches the manifest of the plugin served at rawURL and adds it to
+// the registry. Registering a name twice replaces the previous plugin.
+func (ps *Plugins) Register(ctx context.Context, name, rawURL string) error {
+	if name == "" {
+		return fmt.Errorf("plugin name required")
+	}
+	if _, err := url.ParseRequestURI(rawURL); err != nil {
+		return fmt.Errorf("invalid url for plugin %s: %v", name, err)
+	}
+
+	timeout := ps.Timeout
+	if timeout == 0 {
+		timeout = 30 * time.Second
+	}
+	p := &Plugin{
+		Name:   name,
+		URL:    rawURL,
+		client: &http.Client{Timeout: timeout},
+	}
+
+	req, err := http.NewRequest("GET", singleJoiningSlash(rawURL, "manifest"), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := p.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("unable to retrieve manifest for plugin %s: %v", name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unable to retrieve manifest for plugin %s: status %d", name, resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&p.Manifest); err != nil {
+		return fmt.Errorf("invalid manifest for plugin %s: %v", name, err)
+	}
+	if p.Manifest.SourceTypes == nil {
+		p.Manifest.SourceTypes = []string{}
+	}
+	if p.Manifest.CellTypes == nil {
+		p.Manifest.CellTypes = []PluginCellType{}
+	}
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	for _, other := range ps.plugins {
+		if other.Name == name {
+			continue
+		}
+		for _, typ := range p.Manifest.SourceTypes {
+			if other.handles(typ) {
+				return fmt.Errorf("source type %s of plugin %s is already provided by plugin %s", typ, name, other.Name)
+			}
+		}
+	}
+	if ps.plugins == nil {
+		ps.plugins = map[string]*Plugin{}
+	}
+	ps.plugins[name] = p
+	return nil
+}
+
+func (p *Plugin) handles(sourceType string) bool {
+	for _, typ := range p.Manifest.SourceTypes {
+		if typ == sourceType {
+			return true
+		}
+	}
+	return false
+}
+
+// Lookup returns the plugin responsible for a source type, if any.
+func (ps *Plugins) Lookup(sourceType string) (*Plugin, bool) {
+	if ps == nil || sourceType == "" {
+		return nil, false
+	}
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+	for _, p := range ps.plugins {
+		if p.handles(sourceType) {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+// All returns all registered plugins sorted by name
+func (ps *Plugins) All() []*Plugin {
+	if ps == nil {
+		return nil
+	}
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+	all := make([]*Plugin, 0, len(ps.plugins))
+	for _, p := range ps.plugins {
+		all = append(all, p)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].Name < all[j].Name
+	})
+	return all
+}
+
+type pluginResponse struct {
+	Name string `json:"name"`
+	PluginManifest
+}
+
+type pluginsResponse struct {
+	Links   selfLinks        `json:"links"`
+	Plugins []pluginResponse `json:"plugins"`
+}
+
+// ListPlugins returns the manifests of all registered plugins so the client
+// can offer their source types and cell types.
+func (s *Service) ListPlugins(w http.ResponseWriter, r *http.Request) {
+	res := pluginsResponse{
+		Links: selfLinks{
+			Self: "/chronograf/v1/plugins",
+		},
+		Plugins: []pluginResponse{},
+	}
+	for _, p := range s.Plugins.All() {
+		res.Plugins = append(res.Plugins, pluginResponse{
+			Name:           p.Name,
+			PluginManifest: p.Manifest,
+		})
+	}
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
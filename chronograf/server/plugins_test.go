@@ -0,0 +1,122 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/influxdata/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+func newPluginServer(t *testing.T, manifest string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/manifest":
+			w.Write([]byte(manifest))
+		case "/query":
+			var req pluginQueryRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Errorf("plugin received invalid query: %v", err)
+			}
+			w.Write([]byte(`{"results":[{"db":"` + req.Query.DB + `","source":"` + req.Source.Name + `"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestPlugins_Register(t *testing.T) {
+	ts := newPluginServer(t, `{"version":"1.0","sourceTypes":["opentsdb"],"cellTypes":[{"type":"heatmap","name":"Heatmap"}]}`)
+	defer ts.Close()
+
+	ps := &Plugins{}
+	if err := ps.Register(context.Background(), "tsdb", ts.URL); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	if err := ps.Register(context.Background(), "other", ts.URL); err == nil {
+		t.Errorf("Register() expected error registering a duplicate source type")
+	}
+	if err := ps.Register(context.Background(), "bad", "not a url"); err == nil {
+		t.Errorf("Register() expected error registering an invalid url")
+	}
+
+	p, ok := ps.Lookup("opentsdb")
+	if !ok || p.Name != "tsdb" {
+		t.Fatalf("Lookup() = %v, %v, want plugin tsdb", p, ok)
+	}
+	if _, ok := ps.Lookup(chronograf.InfluxDB); ok {
+		t.Errorf("Lookup() found plugin for influx source type")
+	}
+	if got := len(ps.All()); got != 1 {
+		t.Errorf("All() returned %d plugins, want 1", got)
+	}
+}
+
+func TestService_ListPlugins(t *testing.T) {
+	ts := newPluginServer(t, `{"sourceTypes":["opentsdb"],"cellTypes":[{"type":"heatmap","name":"Heatmap"}]}`)
+	defer ts.Close()
+
+	s := &Service{
+		Plugins: &Plugins{},
+		Logger:  &chronograf.NoopLogger{},
+	}
+	if err := s.Plugins.Register(context.Background(), "tsdb", ts.URL); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	w := httptest.NewRecorder()
+	s.ListPlugins(w, httptest.NewRequest("GET", "http://any.url", nil))
+
+	resp := w.Result()
+	body, _ := ioutil.ReadAll(resp.Body)
+	want := `{"links":{"self":"/chronograf/v1/plugins"},"plugins":[{"name":"tsdb","sourceTypes":["opentsdb"],"cellTypes":[{"type":"heatmap","name":"Heatmap"}]}]}`
+	if eq, _ := jsonEqual(string(body), want); !eq {
+		t.Errorf("ListPlugins() = %s, want %s", string(body), want)
+	}
+}
+
+func TestService_InfluxPlugin(t *testing.T) {
+	ts := newPluginServer(t, `{"sourceTypes":["opentsdb"]}`)
+	defer ts.Close()
+
+	s := &Service{
+		Store: &mocks.Store{
+			SourcesStore: &mocks.SourcesStore{
+				GetF: func(ctx context.Context, ID int) (chronograf.Source, error) {
+					return chronograf.Source{ID: 1, Name: "tsdb-1", Type: "opentsdb"}, nil
+				},
+			},
+		},
+		Plugins: &Plugins{},
+		Logger:  &chronograf.NoopLogger{},
+	}
+	if err := s.Plugins.Register(context.Background(), "tsdb", ts.URL); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("POST", "http://any.url", bytes.NewBufferString(`{"query":"SELECT 1","db":"metrics"}`))
+	r = r.WithContext(context.WithValue(r.Context(), httprouter.ParamsKey, httprouter.Params{
+		{
+			Key:   "id",
+			Value: "1",
+		},
+	}))
+	s.Influx(w, r)
+
+	resp := w.Result()
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Influx() = %v, want %v: %s", resp.StatusCode, http.StatusOK, string(body))
+	}
+	want := `{"results":[{"db":"metrics","source":"tsdb-1"}]}`
+	if eq, _ := jsonEqual(string(body), want); !eq {
+		t.Errorf("Influx() = %s, want %s", string(body), want)
+	}
+}
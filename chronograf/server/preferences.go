@@ -0,0 +1,143 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// maxDisplayNameLength is the longest display name a user may choose
+const maxDisplayNameLength = 256
+
+type preferencesResponse struct {
+	Links selfLinks `json:"links"`
+	chronograf.Preferences
+}
+
+func newPreferencesResponse(p chronograf.Preferences) *preferencesResponse {
+	return &preferencesResponse{
+		Links: selfLinks{
+			Self: "/chronograf/v1/me/preferences",
+		},
+		Preferences: p,
+	}
+}
+
+// preferencesRequest is a partial update of the preferences of a user; only
+// the fields present in the request are changed.
+type preferencesRequest struct {
+	DisplayName      *string                 `json:"displayName"`
+	Timezone         *string                 `json:"timezone"`
+	DefaultDashboard *chronograf.DashboardID `json:"defaultDashboard"`
+	Theme            *string                 `json:"theme"`
+}
+
+// Valid checks the display name, timezone and theme of the request. The
+// default dashboard is checked against the current organization by the handler.
+func (r *preferencesRequest) Valid() error {
+	if r.DisplayName != nil {
+		name := strings.TrimSpace(*r.DisplayName)
+		if len(name) > maxDisplayNameLength {
+			return errorf("displayName must be at most %d characters long", maxDisplayNameLength)
+		}
+		r.DisplayName = &name
+	}
+	if r.Timezone != nil && *r.Timezone != "" {
+		// Local is the timezone of the server rather than of the user
+		if _, err := time.LoadLocation(*r.Timezone); err != nil || *r.Timezone == "Local" {
+			return errorf("unknown timezone %s", *r.Timezone)
+		}
+	}
+	if r.Theme != nil {
+		switch *r.Theme {
+		case "", chronograf.LightTheme, chronograf.DarkTheme:
+		default:
+			return errorf("unknown theme %s. Valid themes are '%s' and '%s'", *r.Theme, chronograf.LightTheme, chronograf.DarkTheme)
+		}
+	}
+	return nil
+}
+
+// preferences returns the stored preferences of the user or the defaults if
+// the user has never set any
+func (s *Service) preferences(ctx context.Context, u *chronograf.User) (*chronograf.Preferences, error) {
+	p, err := s.Store.Preferences(ctx).Get(ctx, u.ID)
+	if err == chronograf.ErrPreferencesNotFound {
+		return &chronograf.Preferences{UserID: u.ID}, nil
+	}
+	return p, err
+}
+
+// MePreferences returns the preferences of the current user
+func (s *Service) MePreferences(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	u, ok := hasUserContext(ctx)
+	if !ok {
+		// Without authentication there are no users and therefore only defaults
+		encodeJSON(w, http.StatusOK, newPreferencesResponse(chronograf.Preferences{}), s.Logger)
+		return
+	}
+
+	p, err := s.preferences(ctx, u)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	encodeJSON(w, http.StatusOK, newPreferencesResponse(*p), s.Logger)
+}
+
+// UpdateMePreferences changes the display name, timezone, default dashboard
+// or theme of the current user
+func (s *Service) UpdateMePreferences(w http.ResponseWriter, r *http.Request) {
+	var req preferencesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	if err := req.Valid(); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	u, ok := hasUserContext(ctx)
+	if !ok {
+		invalidData(w, errorf("preferences are only stored for authenticated users"), s.Logger)
+		return
+	}
+
+	if req.DefaultDashboard != nil && *req.DefaultDashboard != 0 {
+		if _, err := s.Store.Dashboards(ctx).Get(ctx, *req.DefaultDashboard); err != nil {
+			invalidData(w, errorf("dashboard %d not found", *req.DefaultDashboard), s.Logger)
+			return
+		}
+	}
+
+	p, err := s.preferences(ctx, u)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	if req.DisplayName != nil {
+		p.DisplayName = *req.DisplayName
+	}
+	if req.Timezone != nil {
+		p.Timezone = *req.Timezone
+	}
+	if req.DefaultDashboard != nil {
+		p.DefaultDashboard = *req.DefaultDashboard
+	}
+	if req.Theme != nil {
+		p.Theme = *req.Theme
+	}
+
+	if err := s.Store.Preferences(ctx).Put(ctx, p); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	encodeJSON(w, http.StatusOK, newPreferencesResponse(*p), s.Logger)
+}
@@ -0,0 +1,185 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+func TestService_MePreferences(t *testing.T) {
+	tests := []struct {
+		name     string
+		user     *chronograf.User
+		stored   *chronograf.Preferences
+		wantBody string
+	}{
+		{
+			name:     "Stored preferences",
+			user:     &chronograf.User{ID: 1, Name: "bob"},
+			stored:   &chronograf.Preferences{UserID: 1, DisplayName: "Bob", Timezone: "UTC", DefaultDashboard: 2, Theme: chronograf.DarkTheme},
+			wantBody: `{"links":{"self":"/chronograf/v1/me/preferences"},"userID":"1","displayName":"Bob","timezone":"UTC","defaultDashboard":2,"theme":"dark"}`,
+		},
+		{
+			name:     "Defaults of a user without preferences",
+			user:     &chronograf.User{ID: 1, Name: "bob"},
+			wantBody: `{"links":{"self":"/chronograf/v1/me/preferences"},"userID":"1","displayName":"","timezone":"","theme":""}`,
+		},
+		{
+			name:     "Defaults without authentication",
+			wantBody: `{"links":{"self":"/chronograf/v1/me/preferences"},"userID":"0","displayName":"","timezone":"","theme":""}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{
+				Store: &mocks.Store{
+					PreferencesStore: &mocks.PreferencesStore{
+						GetF: func(ctx context.Context, userID uint64) (*chronograf.Preferences, error) {
+							if tt.stored == nil || tt.stored.UserID != userID {
+								return nil, chronograf.ErrPreferencesNotFound
+							}
+							return tt.stored, nil
+						},
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("GET", "http://any.url/chronograf/v1/me/preferences", nil)
+			if tt.user != nil {
+				r = r.WithContext(context.WithValue(r.Context(), UserContextKey, tt.user))
+			}
+
+			s.MePreferences(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("%q. MePreferences() = %v, want %v", tt.name, resp.StatusCode, http.StatusOK)
+			}
+			if eq, _ := jsonEqual(string(body), tt.wantBody); !eq {
+				t.Errorf("%q. MePreferences() = \n***%v***\n,\nwant\n***%v***", tt.name, string(body), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestService_UpdateMePreferences(t *testing.T) {
+	tests := []struct {
+		name       string
+		stored     *chronograf.Preferences
+		body       string
+		wantStatus int
+		wantBody   string
+		wantPut    *chronograf.Preferences
+	}{
+		{
+			name:       "Create preferences",
+			body:       `{"displayName":"  Bob ","timezone":"America/New_York","defaultDashboard":2,"theme":"dark"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"links":{"self":"/chronograf/v1/me/preferences"},"userID":"1","displayName":"Bob","timezone":"America/New_York","defaultDashboard":2,"theme":"dark"}`,
+			wantPut:    &chronograf.Preferences{UserID: 1, DisplayName: "Bob", Timezone: "America/New_York", DefaultDashboard: 2, Theme: chronograf.DarkTheme},
+		},
+		{
+			name:       "Change only the theme",
+			stored:     &chronograf.Preferences{UserID: 1, DisplayName: "Bob", Timezone: "UTC", DefaultDashboard: 2, Theme: chronograf.DarkTheme},
+			body:       `{"theme":"light"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"links":{"self":"/chronograf/v1/me/preferences"},"userID":"1","displayName":"Bob","timezone":"UTC","defaultDashboard":2,"theme":"light"}`,
+			wantPut:    &chronograf.Preferences{UserID: 1, DisplayName: "Bob", Timezone: "UTC", DefaultDashboard: 2, Theme: chronograf.LightTheme},
+		},
+		{
+			name:       "Unset the default dashboard",
+			stored:     &chronograf.Preferences{UserID: 1, DefaultDashboard: 2},
+			body:       `{"defaultDashboard":0}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"links":{"self":"/chronograf/v1/me/preferences"},"userID":"1","displayName":"","timezone":"","theme":""}`,
+			wantPut:    &chronograf.Preferences{UserID: 1},
+		},
+		{
+			name:       "Unknown timezone",
+			body:       `{"timezone":"Mars/Olympus_Mons"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody:   `{"code":422,"message":"unknown timezone Mars/Olympus_Mons","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"unknown timezone Mars/Olympus_Mons"}`,
+		},
+		{
+			name:       "Timezone of the server",
+			body:       `{"timezone":"Local"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody:   `{"code":422,"message":"unknown timezone Local","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"unknown timezone Local"}`,
+		},
+		{
+			name:       "Unknown theme",
+			body:       `{"theme":"solarized"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody:   `{"code":422,"message":"unknown theme solarized. Valid themes are 'light' and 'dark'","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"unknown theme solarized. Valid themes are 'light' and 'dark'"}`,
+		},
+		{
+			name:       "Unknown default dashboard",
+			body:       `{"defaultDashboard":3}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody:   `{"code":422,"message":"dashboard 3 not found","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"dashboard 3 not found"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var put *chronograf.Preferences
+			s := &Service{
+				Store: &mocks.Store{
+					PreferencesStore: &mocks.PreferencesStore{
+						GetF: func(ctx context.Context, userID uint64) (*chronograf.Preferences, error) {
+							if tt.stored == nil {
+								return nil, chronograf.ErrPreferencesNotFound
+							}
+							p := *tt.stored
+							return &p, nil
+						},
+						PutF: func(ctx context.Context, p *chronograf.Preferences) error {
+							put = p
+							return nil
+						},
+					},
+					DashboardsStore: &mocks.DashboardsStore{
+						GetF: func(ctx context.Context, id chronograf.DashboardID) (chronograf.Dashboard, error) {
+							if id != 2 {
+								return chronograf.Dashboard{}, chronograf.ErrDashboardNotFound
+							}
+							return chronograf.Dashboard{ID: id}, nil
+						},
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("PATCH", "http://any.url/chronograf/v1/me/preferences", bytes.NewBufferString(tt.body))
+			r = r.WithContext(context.WithValue(r.Context(), UserContextKey, &chronograf.User{ID: 1, Name: "bob"}))
+
+			s.UpdateMePreferences(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("%q. UpdateMePreferences() = %v, want %v", tt.name, resp.StatusCode, tt.wantStatus)
+			}
+			if eq, _ := jsonEqual(string(body), tt.wantBody); !eq {
+				t.Errorf("%q. UpdateMePreferences() = \n***%v***\n,\nwant\n***%v***", tt.name, string(body), tt.wantBody)
+			}
+			if tt.wantPut == nil {
+				if put != nil {
+					t.Errorf("%q. UpdateMePreferences() stored %v for an invalid request", tt.name, put)
+				}
+				return
+			}
+			if put == nil || !reflect.DeepEqual(*put, *tt.wantPut) {
+				t.Errorf("%q. UpdateMePreferences() stored %v, want %v", tt.name, put, tt.wantPut)
+			}
+		})
+	}
+}
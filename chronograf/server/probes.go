@@ -0,0 +1,169 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+const (
+	// probeTimeout bounds the checks of a liveness or readiness probe
+	probeTimeout = 5 * time.Second
+	// probePass and probeFail are the statuses of probes and of their checks
+	probePass = "pass"
+	probeFail = "fail"
+)
+
+type probeCheck struct {
+	Name    string `json:"name"`    // Name of the dependency checked, such as bolt, source:1 or jwks
+	Status  string `json:"status"`  // Status is pass or fail
+	Latency int64  `json:"latency"` // Latency is the duration of the check in milliseconds
+	Error   string `json:"error,omitempty"`
+}
+
+type probeResponse struct {
+	Status string       `json:"status"` // Status is fail when any check failed
+	Checks []probeCheck `json:"checks"`
+}
+
+// runProbeCheck times a check of a dependency
+func runProbeCheck(ctx context.Context, name string, check func(context.Context) error) probeCheck {
+	start := time.Now()
+	err := check(ctx)
+	c := probeCheck{
+		Name:    name,
+		Status:  probePass,
+		Latency: int64(time.Since(start) / time.Millisecond),
+	}
+	if err != nil {
+		c.Status = probeFail
+		c.Error = err.Error()
+	}
+	return c
+}
+
+// probeChecks runs the checks concurrently, keeping their order
+func probeChecks(ctx context.Context, names []string, checks []func(context.Context) error) []probeCheck {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+
+	res := make([]probeCheck, len(checks))
+	var wg sync.WaitGroup
+	for i := range checks {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			res[i] = runProbeCheck(ctx, names[i], checks[i])
+		}(i)
+	}
+	wg.Wait()
+	return res
+}
+
+// respondProbe responds 200 OK when every check passed and 503 Service
+// Unavailable otherwise
+func (s *Service) respondProbe(w http.ResponseWriter, checks []probeCheck) {
+	res := probeResponse{
+		Status: probePass,
+		Checks: checks,
+	}
+	code := http.StatusOK
+	for _, c := range checks {
+		if c.Status == probeFail {
+			res.Status = probeFail
+			code = http.StatusServiceUnavailable
+		}
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	encodeJSON(w, code, res, s.Logger)
+}
+
+// checkStores checks that the stores are writable
+func (s *Service) checkStores(ctx context.Context) error {
+	if s.CheckStores == nil {
+		return nil
+	}
+	return s.CheckStores(ctx)
+}
+
+// checkSourceReachable checks that a source responds to its health check
+func (s *Service) checkSourceReachable(src chronograf.Source) func(context.Context) error {
+	return func(ctx context.Context) error {
+		h := s.checkSource(ctx, src)
+		if h.Status != chronograf.HealthDown {
+			return nil
+		}
+		if h.Error == "" {
+			return fmt.Errorf("source %s is unreachable", src.Name)
+		}
+		return fmt.Errorf("source %s is unreachable: %s", src.Name, h.Error)
+	}
+}
+
+// checkJWKS checks that the JSON Web Key Set of an auth provider is fetchable
+// and has keys
+func checkJWKS(u string) func(context.Context) error {
+	return func(ctx context.Context) error {
+		req, err := http.NewRequest("GET", u, nil)
+		if err != nil {
+			return err
+		}
+		res, err := http.DefaultClient.Do(req.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+		defer res.Body.Close()
+		if res.StatusCode != http.StatusOK {
+			return fmt.Errorf("JWKS %s responded %s", u, res.Status)
+		}
+		var jwks struct {
+			Keys []json.RawMessage `json:"keys"`
+		}
+		if err := json.NewDecoder(res.Body).Decode(&jwks); err != nil {
+			return fmt.Errorf("invalid JWKS %s: %v", u, err)
+		}
+		if len(jwks.Keys) == 0 {
+			return fmt.Errorf("JWKS %s has no keys", u)
+		}
+		return nil
+	}
+}
+
+// Healthz is the liveness probe of Chronograf. It only checks that the stores
+// are writable, so that unreachable sources or auth providers do not restart
+// Chronograf.
+func (s *Service) Healthz(w http.ResponseWriter, r *http.Request) {
+	checks := probeChecks(r.Context(), []string{"bolt"}, []func(context.Context) error{s.checkStores})
+	s.respondProbe(w, checks)
+}
+
+// Readyz is the readiness probe of Chronograf. It checks that the stores are
+// writable, that every source is reachable and that the JSON Web Key Sets of
+// the auth providers are fetchable.
+func (s *Service) Readyz(w http.ResponseWriter, r *http.Request) {
+	names := []string{"bolt"}
+	checks := []func(context.Context) error{s.checkStores}
+
+	ctx := serverContext(r.Context())
+	srcs, err := s.Store.Sources(ctx).All(ctx)
+	if err != nil {
+		names = append(names, "sources")
+		checks = append(checks, func(context.Context) error { return err })
+	}
+	for _, src := range srcs {
+		names = append(names, "source:"+strconv.Itoa(src.ID))
+		checks = append(checks, s.checkSourceReachable(src))
+	}
+	for _, u := range s.JWKSURLs {
+		names = append(names, "jwks")
+		checks = append(checks, checkJWKS(u))
+	}
+
+	s.respondProbe(w, probeChecks(ctx, names, checks))
+}
@@ -0,0 +1,138 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+func TestService_Probes(t *testing.T) {
+	influxdb := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Influxdb-Version", "1.7.8")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer influxdb.Close()
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte("booting"))
+	}))
+	defer down.Close()
+	jwks := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"keys":[{"kty":"RSA","kid":"1"}]}`))
+	}))
+	defer jwks.Close()
+	noKeys := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"keys":[]}`))
+	}))
+	defer noKeys.Close()
+
+	storeErr := errors.New("database not open")
+	tests := []struct {
+		name        string
+		handler     func(*Service) http.HandlerFunc
+		sources     []chronograf.Source
+		jwks        []string
+		checkStores func(context.Context) error
+		wantCode    int
+		want        []probeCheck
+	}{
+		{
+			name:     "Liveness ignores unreachable sources",
+			handler:  func(s *Service) http.HandlerFunc { return s.Healthz },
+			sources:  []chronograf.Source{{ID: 1, URL: down.URL}},
+			wantCode: http.StatusOK,
+			want: []probeCheck{
+				{Name: "bolt", Status: probePass},
+			},
+		},
+		{
+			name:        "Liveness fails when the store is not writable",
+			handler:     func(s *Service) http.HandlerFunc { return s.Healthz },
+			checkStores: func(context.Context) error { return storeErr },
+			wantCode:    http.StatusServiceUnavailable,
+			want: []probeCheck{
+				{Name: "bolt", Status: probeFail, Error: "database not open"},
+			},
+		},
+		{
+			name:     "Ready",
+			handler:  func(s *Service) http.HandlerFunc { return s.Readyz },
+			sources:  []chronograf.Source{{ID: 1, URL: influxdb.URL}},
+			jwks:     []string{jwks.URL},
+			wantCode: http.StatusOK,
+			want: []probeCheck{
+				{Name: "bolt", Status: probePass},
+				{Name: "source:1", Status: probePass},
+				{Name: "jwks", Status: probePass},
+			},
+		},
+		{
+			name:     "Not ready while a source is unreachable",
+			handler:  func(s *Service) http.HandlerFunc { return s.Readyz },
+			sources:  []chronograf.Source{{ID: 1, URL: influxdb.URL}, {ID: 2, Name: "down", URL: down.URL}},
+			wantCode: http.StatusServiceUnavailable,
+			want: []probeCheck{
+				{Name: "bolt", Status: probePass},
+				{Name: "source:1", Status: probePass},
+				{Name: "source:2", Status: probeFail, Error: "source down is unreachable: booting"},
+			},
+		},
+		{
+			name:     "Not ready while the JWKS has no keys",
+			handler:  func(s *Service) http.HandlerFunc { return s.Readyz },
+			jwks:     []string{noKeys.URL},
+			wantCode: http.StatusServiceUnavailable,
+			want: []probeCheck{
+				{Name: "bolt", Status: probePass},
+				{Name: "jwks", Status: probeFail, Error: "JWKS " + noKeys.URL + " has no keys"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{
+				Store: &mocks.Store{
+					SourcesStore: &mocks.SourcesStore{
+						AllF: func(ctx context.Context) ([]chronograf.Source, error) {
+							if !hasServerContext(ctx) {
+								t.Error("Sources are not listed for every organization")
+							}
+							return tt.sources, nil
+						},
+					},
+				},
+				TimeSeriesClient: &mocks.TimeSeries{},
+				Logger:           &chronograf.NoopLogger{},
+				CheckStores:      tt.checkStores,
+				JWKSURLs:         tt.jwks,
+			}
+
+			w := httptest.NewRecorder()
+			tt.handler(s)(w, httptest.NewRequest("GET", "http://any.url", nil))
+			if w.Code != tt.wantCode {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantCode)
+			}
+			var got probeResponse
+			if err := json.NewDecoder(w.Body).Decode(&got); err != nil {
+				t.Fatal(err)
+			}
+			for i := range got.Checks {
+				got.Checks[i].Latency = 0
+			}
+			wantStatus := probePass
+			if tt.wantCode != http.StatusOK {
+				wantStatus = probeFail
+			}
+			if diff := cmp.Diff(got, probeResponse{Status: wantStatus, Checks: tt.want}); diff != "" {
+				t.Errorf("probe diff (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
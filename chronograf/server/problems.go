@@ -0,0 +1,69 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+const (
+	// ProblemJSONType is the mimetype of the problem details of errors
+	ProblemJSONType = "application/problem+json"
+	// ProblemTypePrefix prefixes the types of the problems of errors
+	ProblemTypePrefix = "urn:chronograf:problem:"
+)
+
+// problemTypes name the kinds of problems of the status codes of errors;
+// the problems of other status codes have no type but their status
+var problemTypes = map[int]string{
+	http.StatusBadRequest:            "invalid-request",
+	http.StatusUnauthorized:          "unauthorized",
+	http.StatusForbidden:             "forbidden",
+	http.StatusNotFound:              "not-found",
+	http.StatusMethodNotAllowed:      "method-not-allowed",
+	http.StatusRequestTimeout:        "timeout",
+	http.StatusConflict:              "conflict",
+	http.StatusPreconditionFailed:    "precondition-failed",
+	http.StatusRequestEntityTooLarge: "too-large",
+	http.StatusUnsupportedMediaType:  "unsupported-media-type",
+	http.StatusUnprocessableEntity:   "invalid-data",
+	http.StatusTooManyRequests:       "too-many-requests",
+	http.StatusInternalServerError:   "internal-error",
+	http.StatusNotImplemented:        "not-implemented",
+	http.StatusBadGateway:            "bad-gateway",
+	http.StatusServiceUnavailable:    "unavailable",
+	http.StatusGatewayTimeout:        "gateway-timeout",
+}
+
+// problemType returns the type of the problem of an error with status code
+func problemType(code int) string {
+	if name, ok := problemTypes[code]; ok {
+		return ProblemTypePrefix + name
+	}
+	// about:blank is the problem type of RFC 7807 meaning that the problem
+	// is only described by its status
+	return "about:blank"
+}
+
+// newErrorMessage describes an error with status code by msg
+func newErrorMessage(code int, msg string) ErrorMessage {
+	return ErrorMessage{
+		Code:    code,
+		Message: msg,
+		Type:    problemType(code),
+		Title:   http.StatusText(code),
+		Status:  code,
+		Detail:  msg,
+	}
+}
+
+// encodeProblem writes the problem details v of an error, which extends
+// ErrorMessage with the members of its kind of problem
+func encodeProblem(w http.ResponseWriter, status int, v interface{}, logger chronograf.Logger) {
+	w.Header().Set("Content-Type", ProblemJSONType)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		unknownErrorWithMessage(w, err, logger)
+	}
+}
@@ -0,0 +1,38 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func TestError(t *testing.T) {
+	w := httptest.NewRecorder()
+	Error(w, http.StatusNotFound, "ID 1 not found", &chronograf.NoopLogger{})
+
+	if got := w.Header().Get("Content-Type"); got != ProblemJSONType {
+		t.Errorf("Error() Content-Type = %q, want %q", got, ProblemJSONType)
+	}
+	var got ErrorMessage
+	if err := json.NewDecoder(w.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	want := ErrorMessage{
+		Code:    http.StatusNotFound,
+		Message: "ID 1 not found",
+		Type:    "urn:chronograf:problem:not-found",
+		Title:   "Not Found",
+		Status:  http.StatusNotFound,
+		Detail:  "ID 1 not found",
+	}
+	if got != want {
+		t.Errorf("Error() = %+v, want %+v", got, want)
+	}
+
+	if got := problemType(http.StatusTeapot); got != "about:blank" {
+		t.Errorf("problemType() of an untyped status = %q, want about:blank", got)
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"net/url"
 	"strings"
 	"time"
+
+	"github.com/influxdata/influxdb/kit/tracing"
 )
 
 // Proxy proxies requests to services using the path query parameter.
@@ -48,10 +50,16 @@ func (s *Service) Proxy(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// The request to Kapacitor continues the trace of the request
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+	r = r.WithContext(ctx)
+
 	director := func(req *http.Request) {
 		// Set the Host header of the original Kapacitor URL
 		req.Host = u.Host
 		req.URL = u
+		tracing.InjectToHTTPRequest(span, req)
 
 		// Because we are acting as a proxy, kapacitor needs to have the basic auth information set as
 		// a header directly
@@ -88,8 +96,21 @@ func (s *Service) Proxy(w http.ResponseWriter, r *http.Request) {
 	proxy.ServeHTTP(w, r)
 }
 
-// ProxyPost proxies POST to service
+// ProxyPost proxies POST to service. Creating a Kapacitor task counts
+// against the quota of Kapacitor rules of the organization.
 func (s *Service) ProxyPost(w http.ResponseWriter, r *http.Request) {
+	if createsKapacitorRule(r) {
+		ctx := r.Context()
+		orgID, ok := hasOrganizationContext(ctx)
+		if !ok {
+			unknownErrorWithMessage(w, fmt.Errorf("expected organization to be set on context"), s.Logger)
+			return
+		}
+		if err := s.ensureQuota(ctx, orgID, quotaKapacitorRules, 1); err != nil {
+			quotaExceeded(w, err, s.Logger)
+			return
+		}
+	}
 	s.Proxy(w, r)
 }
 
@@ -33,14 +33,14 @@ func TestService_Queries(t *testing.T) {
 			ID:   "1",
 			w:    httptest.NewRecorder(),
 			r:    httptest.NewRequest("POST", "/queries", bytes.NewReader([]byte(`howdy`))),
-			want: `{"code":400,"message":"unparsable JSON"}`,
+			want: `{"code":400,"message":"unparsable JSON","type":"urn:chronograf:problem:invalid-request","title":"Bad Request","status":400,"detail":"unparsable JSON"}`,
 		},
 		{
 			name: "bad id",
 			ID:   "howdy",
 			w:    httptest.NewRecorder(),
 			r:    httptest.NewRequest("POST", "/queries", bytes.NewReader([]byte{})),
-			want: `{"code":422,"message":"error converting ID howdy"}`,
+			want: `{"code":422,"message":"error converting ID howdy","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"error converting ID howdy"}`,
 		},
 		{
 			name: "query with no template vars",
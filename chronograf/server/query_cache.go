@@ -0,0 +1,73 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+const (
+	// QueryCacheHeader tells whether the result of a proxied query was cached
+	QueryCacheHeader = "X-Chronograf-Cache"
+	queryCacheHit    = "hit"
+	queryCacheMiss   = "miss"
+)
+
+// queryCacheTTL is how long the result of a proxied query is cached. Queries
+// of a dashboard, named by the dashboard parameter of the request, use the
+// cache TTL of the dashboard if it has one and the server default otherwise.
+func (s *Service) queryCacheTTL(ctx context.Context, r *http.Request) time.Duration {
+	if s.QueryCache == nil {
+		return 0
+	}
+	param := r.URL.Query().Get("dashboard")
+	if param == "" {
+		return s.QueryCacheTTL
+	}
+	id, err := strconv.Atoi(param)
+	if err != nil {
+		return s.QueryCacheTTL
+	}
+	d, err := s.Store.Dashboards(ctx).Get(ctx, chronograf.DashboardID(id))
+	if err != nil || d.CacheTTL == "" {
+		return s.QueryCacheTTL
+	}
+	ttl, err := time.ParseDuration(d.CacheTTL)
+	if err != nil {
+		return s.QueryCacheTTL
+	}
+	return ttl
+}
+
+// bypassQueryCache is true when the client asks for a fresh result with
+// Cache-Control: no-cache, for instance when refreshing a dashboard by hand.
+// The fresh result replaces the cached one.
+func bypassQueryCache(r *http.Request) bool {
+	for _, directive := range strings.Split(r.Header.Get("Cache-Control"), ",") {
+		switch strings.ToLower(strings.TrimSpace(directive)) {
+		case "no-cache", "no-store":
+			return true
+		}
+	}
+	return false
+}
+
+// validCacheTTL checks that the cache TTL of a dashboard is a duration that
+// is not negative. An empty TTL uses the server default.
+func validCacheTTL(ttl string) error {
+	if ttl == "" {
+		return nil
+	}
+	d, err := time.ParseDuration(ttl)
+	if err != nil {
+		return errorf("invalid cacheTTL %q: %v", ttl, err)
+	}
+	if d < 0 {
+		return errorf("cacheTTL must not be negative")
+	}
+	return nil
+}
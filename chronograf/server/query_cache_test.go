@@ -0,0 +1,43 @@
+package server
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func Test_validCacheTTL(t *testing.T) {
+	tests := []struct {
+		ttl     string
+		wantErr bool
+	}{
+		{ttl: ""},
+		{ttl: "0s"},
+		{ttl: "30s"},
+		{ttl: "-1m", wantErr: true},
+		{ttl: "30", wantErr: true},
+	}
+	for _, tt := range tests {
+		if err := validCacheTTL(tt.ttl); (err != nil) != tt.wantErr {
+			t.Errorf("validCacheTTL(%q) error = %v, wantErr %v", tt.ttl, err, tt.wantErr)
+		}
+	}
+}
+
+func Test_bypassQueryCache(t *testing.T) {
+	tests := []struct {
+		cacheControl string
+		want         bool
+	}{
+		{cacheControl: "", want: false},
+		{cacheControl: "max-age=0", want: false},
+		{cacheControl: "no-cache", want: true},
+		{cacheControl: "max-age=0, No-Store", want: true},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest("POST", "http://any.url", nil)
+		r.Header.Set("Cache-Control", tt.cacheControl)
+		if got := bypassQueryCache(r); got != tt.want {
+			t.Errorf("bypassQueryCache(%q) = %v, want %v", tt.cacheControl, got, tt.want)
+		}
+	}
+}
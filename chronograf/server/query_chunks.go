@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// queryChunkBufferSize is the most bytes of a chunked response read from a
+// source before they are written to the client
+const queryChunkBufferSize = 32 * 1024
+
+// queryChunks returns whether the results of a proxied query are passed
+// through in chunks, as asked for by the chunked parameter, and the number of
+// points of every chunk of the chunk_size parameter. A size of 0 leaves it
+// to the source.
+func queryChunks(r *http.Request) (bool, int, error) {
+	params := r.URL.Query()
+	chunked, size := false, 0
+	if c := params.Get("chunked"); c != "" {
+		var err error
+		if chunked, err = strconv.ParseBool(c); err != nil {
+			return false, 0, errorf("invalid chunked %s", c)
+		}
+	}
+	if s := params.Get("chunk_size"); s != "" {
+		var err error
+		if size, err = strconv.Atoi(s); err != nil || size < 1 {
+			return false, 0, errorf("chunk_size must be a positive number of points")
+		}
+	}
+	return chunked, size, nil
+}
+
+// streamQuery passes the chunked response of q against src through to the
+// client as it is read. Chunks are read no faster than the client receives
+// them, so that only a buffer of the response is held in memory. Errors
+// before the response starts get an error response; later ones end it early.
+// It returns the error of the query, if any.
+func (s *Service) streamQuery(ctx context.Context, w http.ResponseWriter, src chronograf.Source, q chronograf.Query, size int) error {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		Error(w, http.StatusInternalServerError, ErrNotFlusher, s.Logger)
+		return errors.New(ErrNotFlusher)
+	}
+	chunked, err := s.chunkedQuerier(ctx, src)
+	if err != nil {
+		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+		return err
+	}
+	body, err := chunked.ChunkedResponse(ctx, q, size)
+	if err != nil {
+		if err == chronograf.ErrUpstreamTimeout {
+			Error(w, http.StatusRequestTimeout, "Timeout waiting for Influx response", s.Logger)
+			return err
+		}
+		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+		return err
+	}
+	defer body.Close()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	buf := make([]byte, queryChunkBufferSize)
+	for {
+		n, err := body.Read(buf)
+		if n > 0 {
+			if _, err := w.Write(buf[:n]); err != nil {
+				return err
+			}
+			flusher.Flush()
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			s.Logger.
+				WithField("component", "proxy").
+				WithField("source", src.ID).
+				Error("Error streaming chunked response: ", err)
+			return err
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/influxdata/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+func TestService_Influx_chunked(t *testing.T) {
+	chunks := `{"results":[{"statement_id":0,"series":[{"name":"cpu","columns":["time","usage"],"values":[[1000,0.5]]}],"partial":true}]}
+{"results":[{"statement_id":0,"series":[{"name":"cpu","columns":["time","usage"],"values":[[2000,0.7]]}]}]}
+`
+	tests := []struct {
+		name       string
+		params     string
+		db         string
+		wantStatus int
+		wantSize   int
+		wantBody   string
+	}{
+		{
+			name:       "Chunks of the source",
+			params:     "?chunked=true",
+			db:         "telegraf",
+			wantStatus: http.StatusOK,
+			wantBody:   chunks,
+		},
+		{
+			name:       "Chunks of a size",
+			params:     "?chunked=true&chunk_size=1",
+			db:         "telegraf",
+			wantStatus: http.StatusOK,
+			wantSize:   1,
+			wantBody:   chunks,
+		},
+		{
+			name:       "Query failing before its first chunk",
+			params:     "?chunked=true",
+			db:         "missing",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "Invalid chunk size",
+			params:     "?chunked=true&chunk_size=0",
+			db:         "telegraf",
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Chunks exported as CSV",
+			params:     "?chunked=true&format=csv",
+			db:         "telegraf",
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotSize int
+			s := &Service{
+				Store: &mocks.Store{
+					SourcesStore: &mocks.SourcesStore{
+						GetF: func(ctx context.Context, ID int) (chronograf.Source, error) {
+							return chronograf.Source{ID: ID, URL: "http://any.url"}, nil
+						},
+					},
+				},
+				TimeSeriesClient: &mocks.TimeSeries{
+					ConnectF: func(ctx context.Context, src *chronograf.Source) error {
+						return nil
+					},
+					ChunkedResponseF: func(ctx context.Context, q chronograf.Query, size int) (io.ReadCloser, error) {
+						if q.DB == "missing" {
+							return nil, fmt.Errorf("received status code 404 from server: err: database not found: missing")
+						}
+						gotSize = size
+						return ioutil.NopCloser(strings.NewReader(chunks)), nil
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+
+			body := fmt.Sprintf(`{"db":%q,"query":"SELECT usage FROM cpu"}`, tt.db)
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url/chronograf/v1/sources/1/proxy"+tt.params, bytes.NewBufferString(body))
+			r = r.WithContext(context.WithValue(r.Context(), httprouter.ParamsKey, httprouter.Params{{Key: "id", Value: "1"}}))
+			s.Influx(w, r)
+
+			resp := w.Result()
+			got, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("Influx() = %v, want %v: %s", resp.StatusCode, tt.wantStatus, got)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if gotSize != tt.wantSize {
+				t.Errorf("Influx() chunk size = %d, want %d", gotSize, tt.wantSize)
+			}
+			if string(got) != tt.wantBody {
+				t.Errorf("Influx() = %s, want %s", got, tt.wantBody)
+			}
+		})
+	}
+}
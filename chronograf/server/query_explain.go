@@ -0,0 +1,123 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxql"
+)
+
+type explainQueryResponse struct {
+	Query string `json:"query"` // Query is the statement as explained by the source
+	chronograf.QueryPlan
+}
+
+// explainStatement returns the single SELECT statement of an InfluxQL query,
+// the only statement EXPLAIN supports
+func explainStatement(command string) (*influxql.SelectStatement, error) {
+	q, err := influxql.ParseQuery(command)
+	if err != nil {
+		return nil, err
+	}
+	if len(q.Statements) != 1 {
+		return nil, errorf("only a single SELECT statement can be explained")
+	}
+	stmt, ok := q.Statements[0].(*influxql.SelectStatement)
+	if !ok {
+		return nil, errorf("only a single SELECT statement can be explained")
+	}
+	return stmt, nil
+}
+
+// queryExplainer connects to a source able to explain queries
+func (s *Service) queryExplainer(ctx context.Context, src chronograf.Source) (chronograf.QueryExplainer, error) {
+	if _, ok := s.Plugins.Lookup(src.Type); ok {
+		return nil, fmt.Errorf("source %d does not support explaining queries", src.ID)
+	}
+	ts, err := s.TimeSeries(src)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to source %d: %v", src.ID, err)
+	}
+	if err := ts.Connect(ctx, &src); err != nil {
+		return nil, fmt.Errorf("unable to connect to source %d: %v", src.ID, err)
+	}
+	explainer, ok := ts.(chronograf.QueryExplainer)
+	if !ok {
+		return nil, fmt.Errorf("source %d does not support explaining queries", src.ID)
+	}
+	return explainer, nil
+}
+
+// ExplainQuery returns the plan of a SELECT statement against a source
+// along with the statistics of running it, such as the series and shards
+// it reads and the cursors of its iterators, as shown by EXPLAIN and EXPLAIN
+// ANALYZE. As EXPLAIN ANALYZE runs the statement, it is subject to the same
+// limits as the queries of the proxy.
+func (s *Service) ExplainQuery(w http.ResponseWriter, r *http.Request) {
+	id, err := paramID("id", r)
+	if err != nil {
+		Error(w, http.StatusUnprocessableEntity, err.Error(), s.Logger)
+		return
+	}
+
+	var req chronograf.Query
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	if err = ValidInfluxRequest(req); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	stmt, err := explainStatement(req.Command)
+	if err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	req.Command = stmt.String()
+
+	ctx := r.Context()
+	src, err := s.Store.Sources(ctx).Get(ctx, id)
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+
+	now := time.Now()
+	if err := s.QueryLimiter.ValidRange(req.Command, now); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	user, _ := hasUserContext(ctx)
+	release, err := s.QueryLimiter.Acquire(id, user, now)
+	if err != nil {
+		queryLimited(w, err, s.Logger)
+		return
+	}
+	defer release()
+
+	explainer, err := s.queryExplainer(ctx, src)
+	if err != nil {
+		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+		return
+	}
+	plan, err := explainer.Explain(ctx, req)
+	if err != nil {
+		if err == chronograf.ErrUpstreamTimeout {
+			Error(w, http.StatusRequestTimeout, "Timeout waiting for Influx response", s.Logger)
+			return
+		}
+		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+		return
+	}
+
+	res := explainQueryResponse{
+		Query:     req.Command,
+		QueryPlan: plan,
+	}
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
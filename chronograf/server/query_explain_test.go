@@ -0,0 +1,105 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/influxdata/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+func TestService_ExplainQuery(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantQuery  string
+		want       string
+	}{
+		{
+			name:       "Plan of a SELECT statement",
+			body:       `{"query":"select max(usage_idle) from cpu","db":"telegraf"}`,
+			wantStatus: http.StatusOK,
+			wantQuery:  "SELECT max(usage_idle) FROM cpu",
+			want:       `{"query":"SELECT max(usage_idle) FROM cpu","series":10,"shards":1,"shardIDs":[608],"files":0,"blocks":0,"blocksSize":0,"cachedValues":0,"planningTime":"","executionTime":"","totalTime":"","cursors":{"cursors_ref":779},"plan":null,"tree":{"name":"select"}}`,
+		},
+		{
+			name:       "Not a SELECT statement",
+			body:       `{"query":"DROP DATABASE telegraf"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Several statements",
+			body:       `{"query":"SELECT * FROM cpu; SELECT * FROM mem"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Invalid query",
+			body:       `{"query":"SELECT FROM"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Error of the source",
+			body:       `{"query":"SELECT * FROM cpu","db":"missing"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotQuery string
+			s := &Service{
+				Store: &mocks.Store{
+					SourcesStore: &mocks.SourcesStore{
+						GetF: func(ctx context.Context, ID int) (chronograf.Source, error) {
+							return chronograf.Source{ID: ID, URL: "http://any.url"}, nil
+						},
+					},
+				},
+				TimeSeriesClient: &mocks.TimeSeries{
+					ConnectF: func(ctx context.Context, src *chronograf.Source) error {
+						return nil
+					},
+					ExplainF: func(ctx context.Context, q chronograf.Query) (chronograf.QueryPlan, error) {
+						if q.DB == "missing" {
+							return chronograf.QueryPlan{}, chronograf.Error("database not found: missing")
+						}
+						gotQuery = q.Command
+						return chronograf.QueryPlan{
+							Series:   10,
+							Shards:   1,
+							ShardIDs: []uint64{608},
+							Cursors:  map[string]int64{"cursors_ref": 779},
+							Tree:     chronograf.QueryPlanNode{Name: "select"},
+						}, nil
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url/chronograf/v1/sources/1/explain", bytes.NewBufferString(tt.body))
+			r = r.WithContext(context.WithValue(r.Context(), httprouter.ParamsKey, httprouter.Params{{Key: "id", Value: "1"}}))
+			s.ExplainQuery(w, r)
+
+			resp := w.Result()
+			got, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("ExplainQuery() = %v, want %v: %s", resp.StatusCode, tt.wantStatus, got)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if gotQuery != tt.wantQuery {
+				t.Errorf("ExplainQuery() explained %s, want %s", gotQuery, tt.wantQuery)
+			}
+			if eq, _ := jsonEqual(string(got), tt.want); !eq {
+				t.Errorf("ExplainQuery() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
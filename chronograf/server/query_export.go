@@ -0,0 +1,344 @@
+package server
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+const (
+	// csvExport streams the rows of query results as CSV
+	csvExport = "csv"
+	// jsonlExport streams the rows of query results as JSON lines
+	jsonlExport = "jsonl"
+)
+
+// queryExportFormat returns the format the results of a proxied query are
+// streamed in, chosen by the format parameter or else by the first media type
+// of the Accept header known to the proxy. Results are returned as a single
+// JSON document when it is empty.
+func queryExportFormat(r *http.Request) (string, error) {
+	switch format := r.URL.Query().Get("format"); format {
+	case csvExport, jsonlExport:
+		return format, nil
+	case "json":
+		return "", nil
+	case "":
+	default:
+		return "", errorf("unknown format %s. Valid formats are 'json', 'csv' and 'jsonl'", format)
+	}
+
+	for _, accept := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediatype, _, err := mime.ParseMediaType(strings.TrimSpace(accept))
+		if err != nil {
+			continue
+		}
+		switch mediatype {
+		case "text/csv":
+			return csvExport, nil
+		case "application/x-ndjson", "application/jsonl":
+			return jsonlExport, nil
+		case "application/json":
+			return "", nil
+		}
+	}
+	return "", nil
+}
+
+// queryExportOrigin identifies the query of the rows of a multi-source export
+type queryExportOrigin struct {
+	ID     string // ID is the ID of the query in the request, if any
+	Source string // Source is the link to the source of the query
+}
+
+type queryExportRow struct {
+	ID     string                 `json:"id,omitempty"`
+	Source string                 `json:"source,omitempty"`
+	Name   string                 `json:"name"`
+	Tags   map[string]string      `json:"tags,omitempty"`
+	Values map[string]interface{} `json:"values"`
+}
+
+type queryExportError struct {
+	ID     string `json:"id,omitempty"`
+	Source string `json:"source,omitempty"`
+	Error  string `json:"error"`
+}
+
+// queryExporter streams the rows of query results as CSV or JSON lines,
+// flushing every series as it is written. The status and headers of the
+// response are only written with the first row, so that queries failing
+// right away still get an error response.
+type queryExporter struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+	format  string
+	// multi adds the ID and source of their query to every row
+	multi   bool
+	csv     *csv.Writer
+	json    *json.Encoder
+	header  []string // header is the last CSV header written
+	started bool
+}
+
+func newQueryExporter(w http.ResponseWriter, format string, multi bool) (*queryExporter, error) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return nil, errors.New(ErrNotFlusher)
+	}
+	return &queryExporter{
+		w:       w,
+		flusher: flusher,
+		format:  format,
+		multi:   multi,
+		csv:     csv.NewWriter(w),
+		json:    json.NewEncoder(w),
+	}, nil
+}
+
+func (e *queryExporter) start() {
+	if e.started {
+		return
+	}
+	e.started = true
+	if e.format == csvExport {
+		e.w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	} else {
+		e.w.Header().Set("Content-Type", "application/x-ndjson")
+	}
+	e.w.WriteHeader(http.StatusOK)
+}
+
+// series writes a row for every value of s. CSV rows are preceded by a
+// header whenever their columns differ from those of the previous row.
+func (e *queryExporter) series(origin queryExportOrigin, s chronograf.ResultSeries) error {
+	e.start()
+	if e.format == jsonlExport {
+		for _, values := range s.Values {
+			row := queryExportRow{
+				Name:   s.Name,
+				Tags:   s.Tags,
+				Values: make(map[string]interface{}, len(s.Columns)),
+			}
+			if e.multi {
+				row.ID, row.Source = origin.ID, origin.Source
+			}
+			for i, c := range s.Columns {
+				if i < len(values) {
+					row.Values[c] = values[i]
+				}
+			}
+			if err := e.json.Encode(row); err != nil {
+				return err
+			}
+		}
+		e.flusher.Flush()
+		return nil
+	}
+
+	header := []string{"name", "tags"}
+	var prefix []string
+	if e.multi {
+		header = []string{"id", "source", "name", "tags"}
+		prefix = []string{origin.ID, origin.Source}
+	}
+	header = append(header, s.Columns...)
+	if !equalStrings(header, e.header) {
+		if err := e.csv.Write(header); err != nil {
+			return err
+		}
+		e.header = header
+	}
+	tags := csvTags(s.Tags)
+	for _, values := range s.Values {
+		row := append(append([]string{}, prefix...), s.Name, tags)
+		for _, v := range values {
+			row = append(row, csvValue(v))
+		}
+		if err := e.csv.Write(row); err != nil {
+			return err
+		}
+	}
+	e.csv.Flush()
+	if err := e.csv.Error(); err != nil {
+		return err
+	}
+	e.flusher.Flush()
+	return nil
+}
+
+// fail reports the error of a query whose rows are already being streamed.
+// JSON lines end with a line of the error; CSV has no room for errors, so
+// they are only logged.
+func (e *queryExporter) fail(origin queryExportOrigin, err error, logger chronograf.Logger) {
+	logger.
+		WithField("component", "proxy").
+		WithField("source", origin.Source).
+		Error("Error streaming query results: ", err)
+	e.start()
+	if e.format == jsonlExport {
+		e.json.Encode(queryExportError{
+			ID:     origin.ID,
+			Source: origin.Source,
+			Error:  err.Error(),
+		})
+	}
+	e.flusher.Flush()
+}
+
+// csvTags formats tags as comma separated key=value pairs sorted by key
+func csvTags(tags map[string]string) string {
+	pairs := make([]string, 0, len(tags))
+	for k, v := range tags {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// csvValue formats a value of a series as a CSV field; null values are empty
+func csvValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// chunkedQuerier connects to a source able to stream query results
+func (s *Service) chunkedQuerier(ctx context.Context, src chronograf.Source) (chronograf.ChunkedQuerier, error) {
+	if _, ok := s.Plugins.Lookup(src.Type); ok {
+		return nil, fmt.Errorf("source %d does not support streaming results", src.ID)
+	}
+	ts, err := s.TimeSeries(src)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to source %d: %v", src.ID, err)
+	}
+	if err := ts.Connect(ctx, &src); err != nil {
+		return nil, fmt.Errorf("unable to connect to source %d: %v", src.ID, err)
+	}
+	chunked, ok := ts.(chronograf.ChunkedQuerier)
+	if !ok {
+		return nil, fmt.Errorf("source %d does not support streaming results", src.ID)
+	}
+	return chunked, nil
+}
+
+// exportQuery streams the results of q against src in format. Errors before
+// the first row get an error response; later ones are reported by the
+// exporter. It returns the error of the query, if any.
+func (s *Service) exportQuery(ctx context.Context, w http.ResponseWriter, src chronograf.Source, q chronograf.Query, format string) error {
+	e, err := newQueryExporter(w, format, false)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, err.Error(), s.Logger)
+		return err
+	}
+	chunked, err := s.chunkedQuerier(ctx, src)
+	if err != nil {
+		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+		return err
+	}
+
+	origin := queryExportOrigin{Source: sourceLinkPrefix + strconv.Itoa(src.ID)}
+	err = chunked.ChunkedQuery(ctx, q, func(series chronograf.ResultSeries) error {
+		return e.series(origin, series)
+	})
+	switch {
+	case err == nil:
+		// Queries without results still answer with an empty export
+		e.start()
+	case e.started:
+		e.fail(origin, err, s.Logger)
+	case err == chronograf.ErrUpstreamTimeout:
+		Error(w, http.StatusRequestTimeout, "Timeout waiting for Influx response", s.Logger)
+	default:
+		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+	}
+	return err
+}
+
+// exportMultiSourceQueries streams the results of the queries of a
+// multi-source proxy request in format. Queries run one after the other so
+// that their rows are not interleaved; every row carries the ID and source
+// of its query, and queries that fail are reported by the exporter rather
+// than failing the request.
+func (s *Service) exportMultiSourceQueries(ctx context.Context, w http.ResponseWriter, req multiSourceProxyRequest, format string) {
+	e, err := newQueryExporter(w, format, true)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, err.Error(), s.Logger)
+		return
+	}
+	e.start()
+	e.flusher.Flush()
+
+	now := time.Now()
+	user, _ := hasUserContext(ctx)
+	for _, q := range req.Queries {
+		origin := queryExportOrigin{ID: q.ID, Source: q.Source}
+		if err := s.exportLinkedQuery(ctx, e, origin, q, user, now); err != nil {
+			e.fail(origin, err, s.Logger)
+		}
+	}
+}
+
+// exportLinkedQuery streams the results of a query of a multi-source proxy
+// request within the limits of its source and user
+func (s *Service) exportLinkedQuery(ctx context.Context, e *queryExporter, origin queryExportOrigin, q proxyQueryRequest, user *chronograf.User, now time.Time) error {
+	src, err := s.linkedSource(ctx, q.Source)
+	if err != nil {
+		return err
+	}
+	if err := s.QueryLimiter.ValidRange(q.Command, now); err != nil {
+		return err
+	}
+	release, err := s.QueryLimiter.Acquire(src.ID, user, now)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	query := chronograf.Query{
+		Command: q.Command,
+		DB:      q.DB,
+		RP:      q.RP,
+		Epoch:   q.Epoch,
+	}
+	start := time.Now()
+	chunked, err := s.chunkedQuerier(ctx, src)
+	if err == nil {
+		err = chunked.ChunkedQuery(ctx, query, func(series chronograf.ResultSeries) error {
+			return e.series(origin, series)
+		})
+	}
+	s.recordQuery(ctx, src, query, start, err)
+	return err
+}
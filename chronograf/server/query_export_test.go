@@ -0,0 +1,174 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/influxdata/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+// exportService is a Service of sources whose queries stream two chunks of
+// results, then fail for the database "broken" and before any result for
+// the database "missing"
+func exportService() *Service {
+	return &Service{
+		Store: &mocks.Store{
+			SourcesStore: &mocks.SourcesStore{
+				GetF: func(ctx context.Context, ID int) (chronograf.Source, error) {
+					return chronograf.Source{ID: ID, URL: "http://any.url"}, nil
+				},
+			},
+		},
+		TimeSeriesClient: &mocks.TimeSeries{
+			ConnectF: func(ctx context.Context, src *chronograf.Source) error {
+				return nil
+			},
+			ChunkedQueryF: func(ctx context.Context, q chronograf.Query, fn func(chronograf.ResultSeries) error) error {
+				if q.DB == "missing" {
+					return fmt.Errorf("database not found: missing")
+				}
+				chunks := []chronograf.ResultSeries{
+					{Name: "cpu", Tags: map[string]string{"host": "a", "cpu": "cpu0"}, Columns: []string{"time", "usage"}, Values: [][]interface{}{{json.Number("1000"), json.Number("0.5")}}},
+					{Name: "cpu", Tags: map[string]string{"host": "a", "cpu": "cpu0"}, Columns: []string{"time", "usage"}, Values: [][]interface{}{{json.Number("2000"), nil}}},
+				}
+				for _, s := range chunks {
+					if err := fn(s); err != nil {
+						return err
+					}
+				}
+				if q.DB == "broken" {
+					return fmt.Errorf("connection reset")
+				}
+				return fn(chronograf.ResultSeries{Name: "mem", Columns: []string{"time", "used", "host"}, Values: [][]interface{}{{json.Number("1000"), json.Number("42"), "a,b"}}})
+			},
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+}
+
+func TestService_Influx_export(t *testing.T) {
+	tests := []struct {
+		name        string
+		params      string
+		accept      string
+		db          string
+		wantStatus  int
+		wantType    string
+		wantBody    string
+		wantErrBody bool
+	}{
+		{
+			name:       "CSV of the Accept header",
+			accept:     "text/csv",
+			db:         "telegraf",
+			wantStatus: http.StatusOK,
+			wantType:   "text/csv; charset=utf-8",
+			wantBody: `name,tags,time,usage
+cpu,"cpu=cpu0,host=a",1000,0.5
+cpu,"cpu=cpu0,host=a",2000,
+name,tags,time,used,host
+mem,,1000,42,"a,b"
+`,
+		},
+		{
+			name:       "JSON lines of the format parameter",
+			params:     "?format=jsonl",
+			accept:     "application/json",
+			db:         "telegraf",
+			wantStatus: http.StatusOK,
+			wantType:   "application/x-ndjson",
+			wantBody: `{"name":"cpu","tags":{"cpu":"cpu0","host":"a"},"values":{"time":1000,"usage":0.5}}
+{"name":"cpu","tags":{"cpu":"cpu0","host":"a"},"values":{"time":2000,"usage":null}}
+{"name":"mem","values":{"host":"a,b","time":1000,"used":42}}
+`,
+		},
+		{
+			name:       "JSON lines ending with the error of the query",
+			params:     "?format=jsonl",
+			db:         "broken",
+			wantStatus: http.StatusOK,
+			wantType:   "application/x-ndjson",
+			wantBody: `{"name":"cpu","tags":{"cpu":"cpu0","host":"a"},"values":{"time":1000,"usage":0.5}}
+{"name":"cpu","tags":{"cpu":"cpu0","host":"a"},"values":{"time":2000,"usage":null}}
+{"source":"/chronograf/v1/sources/1","error":"connection reset"}
+`,
+		},
+		{
+			name:        "Query failing before its first row",
+			params:      "?format=csv",
+			db:          "missing",
+			wantStatus:  http.StatusBadRequest,
+			wantErrBody: true,
+		},
+		{
+			name:        "Unknown format",
+			params:      "?format=xml",
+			db:          "telegraf",
+			wantStatus:  http.StatusUnprocessableEntity,
+			wantErrBody: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := exportService()
+			body := fmt.Sprintf(`{"db":%q,"query":"SELECT * FROM cpu; SELECT * FROM mem"}`, tt.db)
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url/chronograf/v1/sources/1/proxy"+tt.params, bytes.NewBufferString(body))
+			if tt.accept != "" {
+				r.Header.Set("Accept", tt.accept)
+			}
+			r = r.WithContext(context.WithValue(r.Context(), httprouter.ParamsKey, httprouter.Params{{Key: "id", Value: "1"}}))
+			s.Influx(w, r)
+
+			resp := w.Result()
+			got, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("Influx() = %v, want %v: %s", resp.StatusCode, tt.wantStatus, got)
+			}
+			if tt.wantErrBody {
+				if !bytes.Contains(got, []byte(`"message"`)) {
+					t.Errorf("Influx() = %s, want an error", got)
+				}
+				return
+			}
+			if ct := resp.Header.Get("Content-Type"); ct != tt.wantType {
+				t.Errorf("Influx() Content-Type = %s, want %s", ct, tt.wantType)
+			}
+			if string(got) != tt.wantBody {
+				t.Errorf("Influx() = %s, want %s", got, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestService_MultiSourceProxy_export(t *testing.T) {
+	s := exportService()
+	body := `{"queries":[
+		{"id":"q1","source":"/chronograf/v1/sources/1","db":"missing","query":"SELECT * FROM cpu"},
+		{"id":"q2","source":"/chronograf/v1/sources/2","db":"broken","query":"SELECT * FROM cpu"}
+	]}`
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("POST", "http://any.url/chronograf/v1/proxy?format=csv", bytes.NewBufferString(body))
+	s.MultiSourceProxy(w, r)
+
+	resp := w.Result()
+	got, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("MultiSourceProxy() = %v: %s", resp.StatusCode, got)
+	}
+	want := `id,source,name,tags,time,usage
+q2,/chronograf/v1/sources/2,cpu,"cpu=cpu0,host=a",1000,0.5
+q2,/chronograf/v1/sources/2,cpu,"cpu=cpu0,host=a",2000,
+`
+	if string(got) != want {
+		t.Errorf("MultiSourceProxy() = %s, want %s", got, want)
+	}
+}
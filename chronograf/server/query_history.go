@@ -0,0 +1,161 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+const (
+	// defaultQueryHistoryLimit is the number of queries of a history request
+	// without a limit
+	defaultQueryHistoryLimit = 50
+	// maxQueryHistoryLimit is the largest number of queries of a history request
+	maxQueryHistoryLimit = 1000
+)
+
+type queryHistoryEntryLinks struct {
+	Source string `json:"source"` // Source is the link to the source queried
+}
+
+type queryHistoryEntryResponse struct {
+	chronograf.QueryHistoryEntry
+	Links queryHistoryEntryLinks `json:"links"`
+}
+
+type queryHistoryResponse struct {
+	Links   selfLinks                   `json:"links"`
+	Queries []queryHistoryEntryResponse `json:"queries"`
+}
+
+func newQueryHistoryResponse(queries []chronograf.QueryHistoryEntry) *queryHistoryResponse {
+	res := &queryHistoryResponse{
+		Links: selfLinks{
+			Self: "/chronograf/v1/me/queries/history",
+		},
+		Queries: make([]queryHistoryEntryResponse, 0, len(queries)),
+	}
+	for _, q := range queries {
+		res.Queries = append(res.Queries, queryHistoryEntryResponse{
+			QueryHistoryEntry: q,
+			Links: queryHistoryEntryLinks{
+				Source: sourceLinkPrefix + strconv.Itoa(q.SourceID),
+			},
+		})
+	}
+	return res
+}
+
+// recordQuery counts a query proxied to src and adds it to the query history
+// of the current user. Queries of requests without a user or made with an API
+// token, whose user is not stored, are not added to a history. Failing to
+// record a query does not fail it.
+func (s *Service) recordQuery(ctx context.Context, src chronograf.Source, q chronograf.Query, start time.Time, err error) {
+	s.Metrics.ProxyQuery(src.ID, err)
+
+	u, ok := hasUserContext(ctx)
+	if !ok {
+		return
+	}
+	if _, ok := hasTokenContext(ctx); ok {
+		return
+	}
+
+	entry := &chronograf.QueryHistoryEntry{
+		UserID:       u.ID,
+		SourceID:     src.ID,
+		Organization: src.Organization,
+		Query:        q.Command,
+		DB:           q.DB,
+		RP:           q.RP,
+		Duration:     time.Since(start),
+		Status:       chronograf.QuerySuccess,
+		RanAt:        start.UTC(),
+	}
+	if err != nil {
+		entry.Status = chronograf.QueryError
+		entry.Error = err.Error()
+	}
+	if err := s.Store.QueryHistory(ctx).Add(ctx, entry); err != nil {
+		s.Logger.
+			WithField("component", "server").
+			WithField("user", u.Name).
+			Error("Unable to record query: ", err)
+	}
+}
+
+// matchesQueryHistory is true when the text of q contains every word of
+// search regardless of case
+func matchesQueryHistory(q chronograf.QueryHistoryEntry, search []string) bool {
+	text := strings.ToLower(q.Query)
+	for _, word := range search {
+		if !strings.Contains(text, word) {
+			return false
+		}
+	}
+	return true
+}
+
+// MeQueryHistory returns the queries the current user ran against the
+// sources of the current organization, newest first. The q parameter only
+// returns queries containing each of its words, the source and status
+// parameters only those of a source or with a status, and the limit
+// parameter caps the number of queries.
+func (s *Service) MeQueryHistory(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	search := strings.Fields(strings.ToLower(params.Get("q")))
+	limit := defaultQueryHistoryLimit
+	if l := params.Get("limit"); l != "" {
+		var err error
+		if limit, err = strconv.Atoi(l); err != nil || limit < 1 || limit > maxQueryHistoryLimit {
+			invalidData(w, errorf("limit must be between 1 and %d", maxQueryHistoryLimit), s.Logger)
+			return
+		}
+	}
+	var srcID int
+	if src := params.Get("source"); src != "" {
+		var err error
+		if srcID, err = strconv.Atoi(src); err != nil {
+			invalidData(w, errorf("invalid source %s", src), s.Logger)
+			return
+		}
+	}
+	status := params.Get("status")
+	switch status {
+	case "", chronograf.QuerySuccess, chronograf.QueryError:
+	default:
+		invalidData(w, errorf("unknown status %s. Valid statuses are '%s' and '%s'", status, chronograf.QuerySuccess, chronograf.QueryError), s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	u, ok := hasUserContext(ctx)
+	if !ok {
+		// Without authentication there are no users and therefore no history
+		encodeJSON(w, http.StatusOK, newQueryHistoryResponse(nil), s.Logger)
+		return
+	}
+	org, _ := hasOrganizationContext(ctx)
+
+	all, err := s.Store.QueryHistory(ctx).All(ctx, u.ID)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	queries := []chronograf.QueryHistoryEntry{}
+	for i := len(all) - 1; i >= 0 && len(queries) < limit; i-- {
+		q := all[i]
+		if q.Organization != org ||
+			(srcID != 0 && q.SourceID != srcID) ||
+			(status != "" && q.Status != status) ||
+			!matchesQueryHistory(q, search) {
+			continue
+		}
+		queries = append(queries, q)
+	}
+	encodeJSON(w, http.StatusOK, newQueryHistoryResponse(queries), s.Logger)
+}
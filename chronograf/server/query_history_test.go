@@ -0,0 +1,211 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+	"github.com/influxdata/influxdb/chronograf/organizations"
+)
+
+func TestService_Influx_queryHistory(t *testing.T) {
+	var history []chronograf.QueryHistoryEntry
+	s := &Service{
+		Store: &mocks.Store{
+			SourcesStore: &mocks.SourcesStore{
+				GetF: func(ctx context.Context, ID int) (chronograf.Source, error) {
+					return chronograf.Source{ID: 1, URL: "http://any.url", Organization: "default"}, nil
+				},
+			},
+			QueryHistoryStore: &mocks.QueryHistoryStore{
+				AddF: func(ctx context.Context, q *chronograf.QueryHistoryEntry) error {
+					history = append(history, *q)
+					return nil
+				},
+			},
+		},
+		TimeSeriesClient: &mocks.TimeSeries{
+			ConnectF: func(ctx context.Context, src *chronograf.Source) error {
+				return nil
+			},
+			QueryF: func(ctx context.Context, query chronograf.Query) (chronograf.Response, error) {
+				if query.DB == "missing" {
+					return nil, fmt.Errorf("database not found: missing")
+				}
+				return mocks.NewResponse(`{"results":[{"statement_id":0}]}`, nil), nil
+			},
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+
+	query := func(ctx context.Context, body string) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "http://any.url/chronograf/v1/sources/1/proxy", bytes.NewBufferString(body))
+		ctx = context.WithValue(ctx, httprouter.ParamsKey, httprouter.Params{{Key: "id", Value: "1"}})
+		s.Influx(w, r.WithContext(ctx))
+	}
+
+	user := context.WithValue(context.Background(), UserContextKey, &chronograf.User{ID: 7, Name: "bob"})
+	query(user, `{"db":"telegraf","rp":"autogen","query":"SELECT mean(usage_user) FROM cpu"}`)
+	query(user, `{"db":"missing","query":"SHOW MEASUREMENTS"}`)
+	// Queries without a user or made with an API token are not recorded
+	query(context.Background(), `{"db":"telegraf","query":"SHOW DATABASES"}`)
+	token := context.WithValue(user, TokenContextKey, &chronograf.Token{ID: "1"})
+	query(token, `{"db":"telegraf","query":"SHOW DATABASES"}`)
+
+	want := []chronograf.QueryHistoryEntry{
+		{
+			UserID:       7,
+			SourceID:     1,
+			Organization: "default",
+			Query:        "SELECT mean(usage_user) FROM cpu",
+			DB:           "telegraf",
+			RP:           "autogen",
+			Status:       chronograf.QuerySuccess,
+		},
+		{
+			UserID:       7,
+			SourceID:     1,
+			Organization: "default",
+			Query:        "SHOW MEASUREMENTS",
+			DB:           "missing",
+			Status:       chronograf.QueryError,
+			Error:        "database not found: missing",
+		},
+	}
+	for i, q := range history {
+		if q.RanAt.IsZero() || q.Duration < 0 {
+			t.Errorf("Influx() recorded %s at %v for %v", q.Query, q.RanAt, q.Duration)
+		}
+		history[i].RanAt, history[i].Duration = time.Time{}, 0
+	}
+	if diff := cmp.Diff(history, want); diff != "" {
+		t.Errorf("Influx() query history diff (-got +want):\n%s", diff)
+	}
+}
+
+func TestService_MeQueryHistory(t *testing.T) {
+	ranAt := time.Date(2026, 10, 6, 12, 0, 0, 0, time.UTC)
+	s := &Service{
+		Store: &mocks.Store{
+			QueryHistoryStore: &mocks.QueryHistoryStore{
+				AllF: func(ctx context.Context, userID uint64) ([]chronograf.QueryHistoryEntry, error) {
+					if userID != 7 {
+						return []chronograf.QueryHistoryEntry{}, nil
+					}
+					return []chronograf.QueryHistoryEntry{
+						{UserID: 7, SourceID: 1, Organization: "default", Query: "SELECT mean(usage_user) FROM cpu GROUP BY host", Status: chronograf.QuerySuccess, Duration: time.Second, RanAt: ranAt},
+						{UserID: 7, SourceID: 2, Organization: "default", Query: "SELECT max(used) FROM mem", Status: chronograf.QueryError, Error: "timeout", RanAt: ranAt.Add(time.Minute)},
+						{UserID: 7, SourceID: 3, Organization: "other", Query: "SELECT mean(usage_user) FROM cpu", Status: chronograf.QuerySuccess, RanAt: ranAt.Add(2 * time.Minute)},
+						{UserID: 7, SourceID: 1, Organization: "default", Query: "SHOW MEASUREMENTS", Status: chronograf.QuerySuccess, RanAt: ranAt.Add(3 * time.Minute)},
+					}, nil
+				},
+			},
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+
+	tests := []struct {
+		name       string
+		params     string
+		user       *chronograf.User
+		wantStatus int
+		wantQuery  []string
+	}{
+		{
+			name:       "Queries of the organization, newest first",
+			user:       &chronograf.User{ID: 7},
+			wantStatus: http.StatusOK,
+			wantQuery:  []string{"SHOW MEASUREMENTS", "SELECT max(used) FROM mem", "SELECT mean(usage_user) FROM cpu GROUP BY host"},
+		},
+		{
+			name:       "Search for words of queries",
+			params:     "?q=" + "CPU%20host",
+			user:       &chronograf.User{ID: 7},
+			wantStatus: http.StatusOK,
+			wantQuery:  []string{"SELECT mean(usage_user) FROM cpu GROUP BY host"},
+		},
+		{
+			name:       "Queries of a source",
+			params:     "?source=1&limit=1",
+			user:       &chronograf.User{ID: 7},
+			wantStatus: http.StatusOK,
+			wantQuery:  []string{"SHOW MEASUREMENTS"},
+		},
+		{
+			name:       "Failed queries",
+			params:     "?status=error",
+			user:       &chronograf.User{ID: 7},
+			wantStatus: http.StatusOK,
+			wantQuery:  []string{"SELECT max(used) FROM mem"},
+		},
+		{
+			name:       "Another user",
+			user:       &chronograf.User{ID: 8},
+			wantStatus: http.StatusOK,
+			wantQuery:  []string{},
+		},
+		{
+			name:       "Without authentication",
+			wantStatus: http.StatusOK,
+			wantQuery:  []string{},
+		},
+		{
+			name:       "Unknown status",
+			params:     "?status=running",
+			user:       &chronograf.User{ID: 7},
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Invalid limit",
+			params:     "?limit=0",
+			user:       &chronograf.User{ID: 7},
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("GET", "http://any.url/chronograf/v1/me/queries/history"+tt.params, nil)
+			ctx := context.WithValue(r.Context(), organizations.ContextKey, "default")
+			if tt.user != nil {
+				ctx = context.WithValue(ctx, UserContextKey, tt.user)
+			}
+			s.MeQueryHistory(w, r.WithContext(ctx))
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("MeQueryHistory() = %v, want %v: %s", resp.StatusCode, tt.wantStatus, body)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			var res queryHistoryResponse
+			if err := json.Unmarshal(body, &res); err != nil {
+				t.Fatal(err)
+			}
+			got := []string{}
+			for _, q := range res.Queries {
+				got = append(got, q.Query)
+				if want := fmt.Sprintf("/chronograf/v1/sources/%d", q.SourceID); q.Links.Source != want {
+					t.Errorf("MeQueryHistory() source link = %s, want %s", q.Links.Source, want)
+				}
+			}
+			if diff := cmp.Diff(got, tt.wantQuery); diff != "" {
+				t.Errorf("MeQueryHistory() queries diff (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,388 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/influxdata/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	idgen "github.com/influxdata/influxdb/chronograf/id"
+	clog "github.com/influxdata/influxdb/chronograf/log"
+	"github.com/influxdata/influxdb/chronograf/organizations"
+)
+
+// maxQueryJobsPerOwner is the most jobs a user may have running or waiting
+// to be downloaded at once
+const maxQueryJobsPerOwner = 20
+
+// Statuses of query jobs. Finished jobs share the statuses of the query
+// history.
+const (
+	queryJobRunning = "running"
+	queryJobSuccess = chronograf.QuerySuccess
+	queryJobError   = chronograf.QueryError
+)
+
+// queryJob is a query running in the background for its owner, who polls
+// its status and downloads its results once it is done
+type queryJob struct {
+	ID           string
+	Owner        string // Owner identifies the user or API token who submitted the job
+	Organization string
+	SourceID     int
+	Query        chronograf.Query
+	Status       string
+	Error        string
+	SubmittedAt  time.Time
+	FinishedAt   time.Time
+	Results      interface{}
+
+	cancel context.CancelFunc
+}
+
+// QueryJobs runs queries too long for the proxy in the background and keeps
+// their results in memory until they expire
+type QueryJobs struct {
+	// TTL is how long the results of finished jobs are kept
+	TTL time.Duration
+	// Timeout is the longest a job runs before it is cancelled; 0 is unlimited
+	Timeout time.Duration
+
+	mu   sync.Mutex
+	jobs map[string]*queryJob
+}
+
+// NewQueryJobs returns QueryJobs without jobs
+func NewQueryJobs(ttl, timeout time.Duration) *QueryJobs {
+	return &QueryJobs{
+		TTL:     ttl,
+		Timeout: timeout,
+		jobs:    map[string]*queryJob{},
+	}
+}
+
+// purge removes the jobs whose results expired by now. It must be called
+// with the lock held.
+func (q *QueryJobs) purge(now time.Time) {
+	for id, job := range q.jobs {
+		if job.Status != queryJobRunning && now.Sub(job.FinishedAt) >= q.TTL {
+			delete(q.jobs, id)
+		}
+	}
+}
+
+// Start adds job and runs it in the background within ctx until run returns,
+// Timeout passes or the job is removed. Owners with too many jobs cannot
+// start more.
+func (q *QueryJobs) Start(ctx context.Context, job *queryJob, run func(context.Context) (interface{}, error)) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.purge(time.Now())
+	owned := 0
+	for _, j := range q.jobs {
+		if j.Owner == job.Owner {
+			owned++
+		}
+	}
+	if owned >= maxQueryJobsPerOwner {
+		return fmt.Errorf("at most %d query jobs may be kept at once; delete finished jobs or wait for them to expire", maxQueryJobsPerOwner)
+	}
+
+	if q.Timeout > 0 {
+		ctx, job.cancel = context.WithTimeout(ctx, q.Timeout)
+	} else {
+		ctx, job.cancel = context.WithCancel(ctx)
+	}
+	job.Status = queryJobRunning
+	q.jobs[job.ID] = job
+	go func() {
+		results, err := run(ctx)
+		job.cancel()
+
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		job.FinishedAt = time.Now().UTC()
+		job.Status = queryJobSuccess
+		if err != nil {
+			job.Status = queryJobError
+			job.Error = err.Error()
+			if err == chronograf.ErrUpstreamTimeout {
+				job.Error = fmt.Sprintf("query job did not finish within %s", q.Timeout)
+			}
+			return
+		}
+		job.Results = results
+	}()
+	return nil
+}
+
+// Get returns a copy of the job of id if owner submitted it in org
+func (q *QueryJobs) Get(id, owner, org string) (queryJob, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.purge(time.Now())
+	job, ok := q.jobs[id]
+	if !ok || job.Owner != owner || job.Organization != org {
+		return queryJob{}, false
+	}
+	return *job, true
+}
+
+// All returns copies of the jobs owner submitted in org, newest first
+func (q *QueryJobs) All(owner, org string) []queryJob {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.purge(time.Now())
+	jobs := []queryJob{}
+	for _, job := range q.jobs {
+		if job.Owner == owner && job.Organization == org {
+			jobs = append(jobs, *job)
+		}
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].SubmittedAt.After(jobs[j].SubmittedAt)
+	})
+	return jobs
+}
+
+// Remove cancels the job of id if it is still running and forgets it
+func (q *QueryJobs) Remove(id, owner, org string) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	job, ok := q.jobs[id]
+	if !ok || job.Owner != owner || job.Organization != org {
+		return false
+	}
+	job.cancel()
+	delete(q.jobs, id)
+	return true
+}
+
+// queryJobOwner identifies who submits the jobs of a request: an API token,
+// a user, or anyone when authentication is disabled
+func queryJobOwner(ctx context.Context) string {
+	if t, ok := hasTokenContext(ctx); ok {
+		return "token/" + t.ID
+	}
+	if u, ok := hasUserContext(ctx); ok {
+		return "user/" + strconv.FormatUint(u.ID, 10)
+	}
+	return ""
+}
+
+// queryJobContext returns a context outliving the request of ctx that keeps
+// its user, API token, organization and request ID, so that jobs are run,
+// limited, recorded and logged as if their query was proxied
+func queryJobContext(ctx context.Context) context.Context {
+	jobCtx := context.Background()
+	for _, key := range []interface{}{UserContextKey, TokenContextKey, organizations.ContextKey, clog.RequestIDKey} {
+		if v := ctx.Value(key); v != nil {
+			jobCtx = context.WithValue(jobCtx, key, v)
+		}
+	}
+	return jobCtx
+}
+
+type queryJobLinks struct {
+	Self    string `json:"self"`
+	Results string `json:"results"`
+	Source  string `json:"source"`
+}
+
+type queryJobResponse struct {
+	ID          string        `json:"id"`
+	Query       string        `json:"query"`
+	DB          string        `json:"db,omitempty"`
+	RP          string        `json:"rp,omitempty"`
+	Status      string        `json:"status"`
+	Error       string        `json:"error,omitempty"`
+	SubmittedAt time.Time     `json:"submittedAt"`
+	FinishedAt  *time.Time    `json:"finishedAt,omitempty"`
+	ExpiresAt   *time.Time    `json:"expiresAt,omitempty"` // ExpiresAt is when the results of a finished job are removed
+	Links       queryJobLinks `json:"links"`
+}
+
+func newQueryJobResponse(job queryJob, ttl time.Duration) *queryJobResponse {
+	self := "/chronograf/v1/jobs/" + job.ID
+	res := &queryJobResponse{
+		ID:          job.ID,
+		Query:       job.Query.Command,
+		DB:          job.Query.DB,
+		RP:          job.Query.RP,
+		Status:      job.Status,
+		Error:       job.Error,
+		SubmittedAt: job.SubmittedAt,
+		Links: queryJobLinks{
+			Self:    self,
+			Results: self + "/results",
+			Source:  sourceLinkPrefix + strconv.Itoa(job.SourceID),
+		},
+	}
+	if job.Status != queryJobRunning {
+		finished := job.FinishedAt
+		expires := finished.Add(ttl)
+		res.FinishedAt, res.ExpiresAt = &finished, &expires
+	}
+	return res
+}
+
+type queryJobsResponse struct {
+	Links selfLinks           `json:"links"`
+	Jobs  []*queryJobResponse `json:"jobs"`
+}
+
+// NewQueryJob submits a query to run against a source in the background,
+// for queries running longer than a browser waits for the proxy. The job
+// counts against the query limits of its source and user until it is done.
+func (s *Service) NewQueryJob(w http.ResponseWriter, r *http.Request) {
+	id, err := paramID("id", r)
+	if err != nil {
+		Error(w, http.StatusUnprocessableEntity, err.Error(), s.Logger)
+		return
+	}
+
+	var req chronograf.Query
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	if err = ValidInfluxRequest(req); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	src, err := s.Store.Sources(ctx).Get(ctx, id)
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+
+	now := time.Now()
+	if err := s.QueryLimiter.ValidRange(req.Command, now); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	user, _ := hasUserContext(ctx)
+	release, err := s.QueryLimiter.Acquire(id, user, now)
+	if err != nil {
+		queryLimited(w, err, s.Logger)
+		return
+	}
+
+	jobID, err := (&idgen.UUID{}).Generate()
+	if err != nil {
+		release()
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	org, _ := hasOrganizationContext(ctx)
+	job := &queryJob{
+		ID:           jobID,
+		Owner:        queryJobOwner(ctx),
+		Organization: org,
+		SourceID:     src.ID,
+		Query:        req,
+		SubmittedAt:  now.UTC(),
+	}
+	err = s.Jobs.Start(queryJobContext(ctx), job, func(ctx context.Context) (interface{}, error) {
+		defer release()
+		start := time.Now()
+		results, err := s.querySource(ctx, src, req)
+		s.recordQuery(ctx, src, req, start, err)
+		return results, err
+	})
+	if err != nil {
+		release()
+		Error(w, http.StatusTooManyRequests, err.Error(), s.Logger)
+		return
+	}
+
+	res := newQueryJobResponse(queryJob{
+		ID:          job.ID,
+		SourceID:    job.SourceID,
+		Query:       job.Query,
+		Status:      queryJobRunning,
+		SubmittedAt: job.SubmittedAt,
+	}, s.Jobs.TTL)
+	location(w, res.Links.Self)
+	encodeJSON(w, http.StatusAccepted, res, s.Logger)
+}
+
+// queryJob returns the job of the id parameter submitted by the owner of
+// the request in the current organization
+func (s *Service) queryJob(w http.ResponseWriter, r *http.Request) (queryJob, bool) {
+	ctx := r.Context()
+	id := httprouter.ParamsFromContext(ctx).ByName("id")
+	org, _ := hasOrganizationContext(ctx)
+	job, ok := s.Jobs.Get(id, queryJobOwner(ctx), org)
+	if !ok {
+		notFound(w, id, s.Logger)
+		return queryJob{}, false
+	}
+	return job, true
+}
+
+// QueryJobs lists the query jobs of the current user in the current
+// organization, newest first
+func (s *Service) QueryJobs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	org, _ := hasOrganizationContext(ctx)
+	jobs := s.Jobs.All(queryJobOwner(ctx), org)
+	res := queryJobsResponse{
+		Links: selfLinks{
+			Self: "/chronograf/v1/jobs",
+		},
+		Jobs: make([]*queryJobResponse, len(jobs)),
+	}
+	for i, job := range jobs {
+		res.Jobs[i] = newQueryJobResponse(job, s.Jobs.TTL)
+	}
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// QueryJob returns the status of a query job
+func (s *Service) QueryJob(w http.ResponseWriter, r *http.Request) {
+	job, ok := s.queryJob(w, r)
+	if !ok {
+		return
+	}
+	encodeJSON(w, http.StatusOK, newQueryJobResponse(job, s.Jobs.TTL), s.Logger)
+}
+
+// QueryJobResults returns the results of a query job once it succeeded, in
+// the same shape as the proxy
+func (s *Service) QueryJobResults(w http.ResponseWriter, r *http.Request) {
+	job, ok := s.queryJob(w, r)
+	if !ok {
+		return
+	}
+	switch job.Status {
+	case queryJobRunning:
+		Error(w, http.StatusConflict, fmt.Sprintf("query job %s is still running", job.ID), s.Logger)
+		return
+	case queryJobError:
+		Error(w, http.StatusBadRequest, job.Error, s.Logger)
+		return
+	}
+	encodeJSON(w, http.StatusOK, postInfluxResponse{Results: job.Results}, s.Logger)
+}
+
+// RemoveQueryJob cancels a running query job or deletes the results of a
+// finished one
+func (s *Service) RemoveQueryJob(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := httprouter.ParamsFromContext(ctx).ByName("id")
+	org, _ := hasOrganizationContext(ctx)
+	if !s.Jobs.Remove(id, queryJobOwner(ctx), org) {
+		notFound(w, id, s.Logger)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,185 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/influxdata/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+	"github.com/influxdata/influxdb/chronograf/organizations"
+)
+
+// queryJobsService is a Service whose queries of the database "slow" run
+// until they are cancelled
+func queryJobsService() *Service {
+	return &Service{
+		Store: &mocks.Store{
+			SourcesStore: &mocks.SourcesStore{
+				GetF: func(ctx context.Context, ID int) (chronograf.Source, error) {
+					return chronograf.Source{ID: ID, URL: "http://any.url", Organization: "default"}, nil
+				},
+			},
+			QueryHistoryStore: &mocks.QueryHistoryStore{
+				AddF: func(ctx context.Context, q *chronograf.QueryHistoryEntry) error {
+					return nil
+				},
+			},
+		},
+		TimeSeriesClient: &mocks.TimeSeries{
+			ConnectF: func(ctx context.Context, src *chronograf.Source) error {
+				return nil
+			},
+			QueryF: func(ctx context.Context, query chronograf.Query) (chronograf.Response, error) {
+				if query.DB == "slow" {
+					<-ctx.Done()
+					return nil, chronograf.ErrUpstreamTimeout
+				}
+				return mocks.NewResponse(`[{"statement_id":0,"series":[{"name":"cpu","columns":["time","mean"],"values":[[1000,0.5]]}]}]`, nil), nil
+			},
+		},
+		Jobs:   NewQueryJobs(time.Hour, time.Hour),
+		Logger: &chronograf.NoopLogger{},
+	}
+}
+
+func queryJobRequest(s *Service, handler http.HandlerFunc, method, path, body string, user *chronograf.User, params httprouter.Params) (int, []byte) {
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(method, "http://any.url"+path, bytes.NewBufferString(body))
+	ctx := context.WithValue(r.Context(), organizations.ContextKey, "default")
+	ctx = context.WithValue(ctx, UserContextKey, user)
+	ctx = context.WithValue(ctx, httprouter.ParamsKey, params)
+	handler(w, r.WithContext(ctx))
+	resp := w.Result()
+	b, _ := ioutil.ReadAll(resp.Body)
+	return resp.StatusCode, b
+}
+
+func TestService_QueryJobs(t *testing.T) {
+	s := queryJobsService()
+	bob := &chronograf.User{ID: 7, Name: "bob"}
+	alice := &chronograf.User{ID: 8, Name: "alice"}
+
+	status, body := queryJobRequest(s, s.NewQueryJob, "POST", "/chronograf/v1/sources/1/jobs", `{"db":"telegraf","query":"SELECT mean(usage_user) FROM cpu"}`, bob, httprouter.Params{{Key: "id", Value: "1"}})
+	if status != http.StatusAccepted {
+		t.Fatalf("NewQueryJob() = %v: %s", status, body)
+	}
+	var job queryJobResponse
+	if err := json.Unmarshal(body, &job); err != nil {
+		t.Fatal(err)
+	}
+	jobParams := httprouter.Params{{Key: "id", Value: job.ID}}
+
+	// Poll the job until its query is done
+	for i := 0; job.Status == queryJobRunning; i++ {
+		if i == 100 {
+			t.Fatalf("QueryJob() still running")
+		}
+		time.Sleep(10 * time.Millisecond)
+		status, body = queryJobRequest(s, s.QueryJob, "GET", job.Links.Self, "", bob, jobParams)
+		if status != http.StatusOK {
+			t.Fatalf("QueryJob() = %v: %s", status, body)
+		}
+		if err := json.Unmarshal(body, &job); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if job.Status != queryJobSuccess || job.FinishedAt == nil || job.ExpiresAt == nil {
+		t.Fatalf("QueryJob() = %s", body)
+	}
+
+	status, body = queryJobRequest(s, s.QueryJobResults, "GET", job.Links.Results, "", bob, jobParams)
+	want := `{"results":[{"statement_id":0,"series":[{"name":"cpu","columns":["time","mean"],"values":[[1000,0.5]]}]}]}`
+	if eq, _ := jsonEqual(string(body), want); status != http.StatusOK || !eq {
+		t.Errorf("QueryJobResults() = %v %s, want %s", status, body, want)
+	}
+
+	// Jobs are only visible to the user who submitted them
+	if status, _ := queryJobRequest(s, s.QueryJob, "GET", job.Links.Self, "", alice, jobParams); status != http.StatusNotFound {
+		t.Errorf("QueryJob() of another user = %v, want %v", status, http.StatusNotFound)
+	}
+	status, body = queryJobRequest(s, s.QueryJobs, "GET", "/chronograf/v1/jobs", "", alice, nil)
+	if eq, _ := jsonEqual(string(body), `{"links":{"self":"/chronograf/v1/jobs"},"jobs":[]}`); status != http.StatusOK || !eq {
+		t.Errorf("QueryJobs() of another user = %v %s", status, body)
+	}
+
+	if status, body := queryJobRequest(s, s.RemoveQueryJob, "DELETE", job.Links.Self, "", bob, jobParams); status != http.StatusNoContent {
+		t.Errorf("RemoveQueryJob() = %v: %s", status, body)
+	}
+	if status, _ := queryJobRequest(s, s.QueryJob, "GET", job.Links.Self, "", bob, jobParams); status != http.StatusNotFound {
+		t.Errorf("QueryJob() of a removed job = %v, want %v", status, http.StatusNotFound)
+	}
+}
+
+func TestService_QueryJobs_running(t *testing.T) {
+	s := queryJobsService()
+	bob := &chronograf.User{ID: 7, Name: "bob"}
+
+	status, body := queryJobRequest(s, s.NewQueryJob, "POST", "/chronograf/v1/sources/1/jobs", `{"db":"slow","query":"SELECT max(used) FROM mem"}`, bob, httprouter.Params{{Key: "id", Value: "1"}})
+	if status != http.StatusAccepted {
+		t.Fatalf("NewQueryJob() = %v: %s", status, body)
+	}
+	var job queryJobResponse
+	if err := json.Unmarshal(body, &job); err != nil {
+		t.Fatal(err)
+	}
+	jobParams := httprouter.Params{{Key: "id", Value: job.ID}}
+
+	if status, _ := queryJobRequest(s, s.QueryJobResults, "GET", job.Links.Results, "", bob, jobParams); status != http.StatusConflict {
+		t.Errorf("QueryJobResults() of a running job = %v, want %v", status, http.StatusConflict)
+	}
+	status, body = queryJobRequest(s, s.QueryJobs, "GET", "/chronograf/v1/jobs", "", bob, nil)
+	var jobs queryJobsResponse
+	if err := json.Unmarshal(body, &jobs); err != nil {
+		t.Fatal(err)
+	}
+	if status != http.StatusOK || len(jobs.Jobs) != 1 || jobs.Jobs[0].Status != queryJobRunning {
+		t.Errorf("QueryJobs() = %v %s", status, body)
+	}
+
+	// Removing a running job cancels its query
+	if status, body := queryJobRequest(s, s.RemoveQueryJob, "DELETE", job.Links.Self, "", bob, jobParams); status != http.StatusNoContent {
+		t.Errorf("RemoveQueryJob() = %v: %s", status, body)
+	}
+}
+
+func TestQueryJobs_Start(t *testing.T) {
+	jobs := NewQueryJobs(time.Hour, 10*time.Millisecond)
+	run := func(ctx context.Context) (interface{}, error) {
+		<-ctx.Done()
+		return nil, chronograf.ErrUpstreamTimeout
+	}
+	for i := 0; i < maxQueryJobsPerOwner; i++ {
+		job := &queryJob{ID: string(rune('a' + i)), Owner: "user/7"}
+		if err := jobs.Start(context.Background(), job, run); err != nil {
+			t.Fatalf("Start() of job %d error = %v", i, err)
+		}
+	}
+	if err := jobs.Start(context.Background(), &queryJob{ID: "extra", Owner: "user/7"}, run); err == nil {
+		t.Errorf("Start() of too many jobs of an owner succeeded")
+	}
+	if err := jobs.Start(context.Background(), &queryJob{ID: "other", Owner: "user/8"}, run); err != nil {
+		t.Errorf("Start() of a job of another owner error = %v", err)
+	}
+
+	// Jobs running longer than the timeout fail
+	for i := 0; ; i++ {
+		job, _ := jobs.Get("a", "user/7", "")
+		if job.Status == queryJobError {
+			if job.Error != "query job did not finish within 10ms" {
+				t.Errorf("Get() error = %s", job.Error)
+			}
+			break
+		}
+		if i == 100 {
+			t.Fatalf("Get() = %v, want a timed out job", job.Status)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,193 @@
+package server
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/influx"
+	"github.com/influxdata/influxql"
+	"golang.org/x/time/rate"
+)
+
+// QueryLimiter bounds the queries proxied to each source and those of each
+// user, protecting sources from runaway Data Explorer usage. Zero limits are
+// unlimited.
+type QueryLimiter struct {
+	SourceMaxConcurrent int           // SourceMaxConcurrent is the most queries running at once against a source
+	SourcePerSecond     float64       // SourcePerSecond is the most queries per second sent to a source
+	UserMaxConcurrent   int           // UserMaxConcurrent is the most queries of a user running at once
+	UserPerSecond       float64       // UserPerSecond is the most queries per second of a user
+	MaxRange            time.Duration // MaxRange is the longest time range queried by a SELECT statement
+
+	mu      sync.Mutex
+	sources map[int]*queryLimit
+	users   map[uint64]*queryLimit
+}
+
+// queryLimit tracks the queries of a source or of a user
+type queryLimit struct {
+	running int
+	limiter *rate.Limiter
+}
+
+// queryLimitError is returned when a query would exceed a limit; the query
+// may be retried after RetryAfter
+type queryLimitError struct {
+	msg        string
+	RetryAfter time.Duration
+}
+
+func (e *queryLimitError) Error() string {
+	return e.msg
+}
+
+// newQueryLimit allows perSecond queries per second, with bursts of as many
+// queries as are allowed within a second
+func newQueryLimit(perSecond float64) *queryLimit {
+	l := &queryLimit{}
+	if perSecond > 0 {
+		l.limiter = rate.NewLimiter(rate.Limit(perSecond), int(math.Ceil(perSecond)))
+	}
+	return l
+}
+
+// reserve reserves a query of l at now, returning how long to wait before
+// the query is allowed
+func (l *queryLimit) reserve(now time.Time) (*rate.Reservation, time.Duration) {
+	if l.limiter == nil {
+		return nil, 0
+	}
+	r := l.limiter.ReserveN(now, 1)
+	if delay := r.DelayFrom(now); delay > 0 {
+		r.CancelAt(now)
+		return nil, delay
+	}
+	return r, 0
+}
+
+// Acquire starts a query of a source by a user, who is nil when
+// authentication is disabled. It returns a queryLimitError when a limit of
+// the source or of the user is reached; otherwise release must be called
+// once the query is done.
+func (q *QueryLimiter) Acquire(srcID int, user *chronograf.User, now time.Time) (release func(), err error) {
+	if q == nil {
+		return func() {}, nil
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.sources == nil {
+		q.sources = map[int]*queryLimit{}
+		q.users = map[uint64]*queryLimit{}
+	}
+	src, ok := q.sources[srcID]
+	if !ok {
+		src = newQueryLimit(q.SourcePerSecond)
+		q.sources[srcID] = src
+	}
+	var usr *queryLimit
+	if user != nil {
+		if usr, ok = q.users[user.ID]; !ok {
+			usr = newQueryLimit(q.UserPerSecond)
+			q.users[user.ID] = usr
+		}
+	}
+
+	if q.SourceMaxConcurrent > 0 && src.running >= q.SourceMaxConcurrent {
+		return nil, &queryLimitError{
+			msg:        fmt.Sprintf("source %d is running its limit of %d concurrent queries", srcID, q.SourceMaxConcurrent),
+			RetryAfter: time.Second,
+		}
+	}
+	if usr != nil && q.UserMaxConcurrent > 0 && usr.running >= q.UserMaxConcurrent {
+		return nil, &queryLimitError{
+			msg:        fmt.Sprintf("user %s is running the limit of %d concurrent queries", user.Name, q.UserMaxConcurrent),
+			RetryAfter: time.Second,
+		}
+	}
+
+	srcReservation, delay := src.reserve(now)
+	if delay > 0 {
+		return nil, &queryLimitError{
+			msg:        fmt.Sprintf("source %d has reached its limit of %g queries per second", srcID, q.SourcePerSecond),
+			RetryAfter: delay,
+		}
+	}
+	if usr != nil {
+		if _, delay := usr.reserve(now); delay > 0 {
+			if srcReservation != nil {
+				srcReservation.CancelAt(now)
+			}
+			return nil, &queryLimitError{
+				msg:        fmt.Sprintf("user %s has reached the limit of %g queries per second", user.Name, q.UserPerSecond),
+				RetryAfter: delay,
+			}
+		}
+	}
+
+	src.running++
+	if usr != nil {
+		usr.running++
+	}
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			q.mu.Lock()
+			defer q.mu.Unlock()
+			src.running--
+			if usr != nil {
+				usr.running--
+			}
+		})
+	}, nil
+}
+
+// ValidRange checks that the SELECT statements of an InfluxQL query do not
+// query more than the maximum range before now. Statements without a time
+// range query all time. Queries that do not parse are left for the source to
+// reject.
+func (q *QueryLimiter) ValidRange(command string, now time.Time) error {
+	if q == nil || q.MaxRange <= 0 {
+		return nil
+	}
+	query, err := influxql.ParseQuery(command)
+	if err != nil {
+		return nil
+	}
+	for _, stmt := range query.Statements {
+		sel, ok := stmt.(*influxql.SelectStatement)
+		if !ok {
+			continue
+		}
+		cond := influxql.Reduce(sel.Condition, &influxql.NowValuer{Now: now})
+		min, max, err := influx.TimeRangeAsEpochNano(cond, now)
+		if err != nil {
+			return nil
+		}
+		if min == influxql.MinTime || time.Duration(max-min) > q.MaxRange {
+			return fmt.Errorf("queries may not select more than %s; restrict the time range of the query", q.MaxRange)
+		}
+	}
+	return nil
+}
+
+// queryLimited responds with http.StatusTooManyRequests and the Retry-After
+// header when a query limit is reached and with an unknown error otherwise
+func queryLimited(w http.ResponseWriter, err error, logger chronograf.Logger) {
+	limitErr, ok := err.(*queryLimitError)
+	if !ok {
+		unknownErrorWithMessage(w, err, logger)
+		return
+	}
+	retry := int(math.Ceil(limitErr.RetryAfter.Seconds()))
+	if retry < 1 {
+		retry = 1
+	}
+	w.Header().Set("Retry-After", strconv.Itoa(retry))
+	Error(w, http.StatusTooManyRequests, err.Error(), logger)
+}
@@ -0,0 +1,143 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+func TestQueryLimiter_Acquire(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	alice := &chronograf.User{ID: 1, Name: "alice"}
+	bob := &chronograf.User{ID: 2, Name: "bob"}
+
+	t.Run("Concurrent queries of a source", func(t *testing.T) {
+		q := &QueryLimiter{SourceMaxConcurrent: 2}
+		release1, err := q.Acquire(1, alice, now)
+		if err != nil {
+			t.Fatalf("Acquire() first query error = %v", err)
+		}
+		if _, err := q.Acquire(1, bob, now); err != nil {
+			t.Fatalf("Acquire() second query error = %v", err)
+		}
+		if _, err := q.Acquire(1, alice, now); err == nil {
+			t.Fatal("Acquire() third query of source 1 was allowed")
+		}
+		if _, err := q.Acquire(2, alice, now); err != nil {
+			t.Errorf("Acquire() query of source 2 error = %v", err)
+		}
+
+		release1()
+		release1()
+		if _, err := q.Acquire(1, alice, now); err != nil {
+			t.Errorf("Acquire() after release error = %v", err)
+		}
+		if _, err := q.Acquire(1, alice, now); err == nil {
+			t.Error("Acquire() released twice allowed another query")
+		}
+	})
+
+	t.Run("Concurrent queries of a user", func(t *testing.T) {
+		q := &QueryLimiter{UserMaxConcurrent: 1}
+		if _, err := q.Acquire(1, alice, now); err != nil {
+			t.Fatalf("Acquire() first query error = %v", err)
+		}
+		if _, err := q.Acquire(2, alice, now); err == nil {
+			t.Error("Acquire() second query of alice was allowed")
+		}
+		if _, err := q.Acquire(2, bob, now); err != nil {
+			t.Errorf("Acquire() query of bob error = %v", err)
+		}
+		if _, err := q.Acquire(2, nil, now); err != nil {
+			t.Errorf("Acquire() query without user error = %v", err)
+		}
+	})
+
+	t.Run("Queries per second of a source", func(t *testing.T) {
+		q := &QueryLimiter{SourcePerSecond: 2}
+		for i := 0; i < 2; i++ {
+			release, err := q.Acquire(1, alice, now)
+			if err != nil {
+				t.Fatalf("Acquire() query %d error = %v", i, err)
+			}
+			release()
+		}
+		_, err := q.Acquire(1, alice, now)
+		limitErr, ok := err.(*queryLimitError)
+		if !ok {
+			t.Fatalf("Acquire() over the rate error = %v, want queryLimitError", err)
+		}
+		if limitErr.RetryAfter != 500*time.Millisecond {
+			t.Errorf("Acquire() RetryAfter = %v, want %v", limitErr.RetryAfter, 500*time.Millisecond)
+		}
+		if _, err := q.Acquire(1, alice, now.Add(500*time.Millisecond)); err != nil {
+			t.Errorf("Acquire() after waiting error = %v", err)
+		}
+	})
+
+	t.Run("Queries per second of a user do not use up the rate of the source", func(t *testing.T) {
+		q := &QueryLimiter{SourcePerSecond: 1, UserPerSecond: 1}
+		if _, err := q.Acquire(1, alice, now); err != nil {
+			t.Fatalf("Acquire() first query error = %v", err)
+		}
+		if _, err := q.Acquire(2, alice, now); err == nil {
+			t.Fatal("Acquire() second query of alice was allowed")
+		}
+		if _, err := q.Acquire(2, bob, now); err != nil {
+			t.Errorf("Acquire() query of bob error = %v", err)
+		}
+	})
+
+	t.Run("No limits", func(t *testing.T) {
+		var q *QueryLimiter
+		release, err := q.Acquire(1, alice, now)
+		if err != nil {
+			t.Fatalf("Acquire() error = %v", err)
+		}
+		release()
+	})
+}
+
+func TestQueryLimiter_ValidRange(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	q := &QueryLimiter{MaxRange: 24 * time.Hour}
+	tests := []struct {
+		command string
+		wantErr bool
+	}{
+		{command: `SELECT mean(usage_user) FROM cpu WHERE time > now() - 1h GROUP BY time(1m)`},
+		{command: `SELECT mean(usage_user) FROM cpu WHERE time > now() - 24h`},
+		{command: `SELECT mean(usage_user) FROM cpu WHERE time > now() - 7d`, wantErr: true},
+		{command: `SELECT usage_user FROM cpu`, wantErr: true},
+		{command: `SELECT usage_user FROM cpu WHERE time > '2025-01-01T00:00:00Z' AND time < '2025-01-01T12:00:00Z'`},
+		{command: `SELECT usage_user FROM cpu WHERE time > now() - 1h; SELECT usage_user FROM mem`, wantErr: true},
+		{command: `SHOW DATABASES`},
+		{command: `SELEC nothing`},
+	}
+	for _, tt := range tests {
+		if err := q.ValidRange(tt.command, now); (err != nil) != tt.wantErr {
+			t.Errorf("ValidRange(%q) error = %v, wantErr %v", tt.command, err, tt.wantErr)
+		}
+	}
+}
+
+func Test_queryLimited(t *testing.T) {
+	w := httptest.NewRecorder()
+	queryLimited(w, &queryLimitError{msg: "too many queries", RetryAfter: 1500 * time.Millisecond}, &chronograf.NoopLogger{})
+	if w.Code != http.StatusTooManyRequests {
+		t.Errorf("queryLimited() status = %v, want %v", w.Code, http.StatusTooManyRequests)
+	}
+	if got := w.Header().Get("Retry-After"); got != "2" {
+		t.Errorf("queryLimited() Retry-After = %q, want %q", got, "2")
+	}
+
+	w = httptest.NewRecorder()
+	queryLimited(w, fmt.Errorf("unreachable"), &chronograf.NoopLogger{})
+	if w.Code != http.StatusInternalServerError || w.Header().Get("Retry-After") != "" {
+		t.Errorf("queryLimited() of another error = %v %q", w.Code, w.Header().Get("Retry-After"))
+	}
+}
@@ -0,0 +1,203 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxql"
+)
+
+// renderQueriesRequest holds InfluxQL queries and the values of the template
+// variables to replace within them
+type renderQueriesRequest struct {
+	Dashboard    chronograf.DashboardID   `json:"dashboard,omitempty"` // Dashboard is the ID of the dashboard whose templates give the values of variables of the request without one
+	Queries      []QueryRequest           `json:"queries"`
+	TemplateVars []chronograf.TemplateVar `json:"tempVars,omitempty"`
+}
+
+type renderQueryResponse struct {
+	ID             string `json:"id"`
+	Query          string `json:"query"`
+	QueryTemplated string `json:"queryTemplated"`
+}
+
+type renderQueriesResponse struct {
+	Queries []renderQueryResponse `json:"queries"`
+}
+
+// Quoting contexts of template variables within a query
+const (
+	renderBare   = iota // renderBare is outside of any quotes
+	renderString        // renderString is within a single quoted string
+	renderIdent         // renderIdent is within a double quoted identifier
+	renderRegex         // renderRegex is within a regular expression
+)
+
+var (
+	// stringEscaper escapes values within a single quoted string
+	stringEscaper = strings.NewReplacer("\n", `\n`, `\`, `\\`, `'`, `\'`)
+	// identEscaper escapes values within a double quoted identifier
+	identEscaper = strings.NewReplacer("\n", `\n`, `\`, `\\`, `"`, `\"`)
+)
+
+// quoteIdent quotes a template value as an InfluxQL identifier
+func quoteIdent(s string) string {
+	return `"` + identEscaper.Replace(s) + `"`
+}
+
+// rawTemplateValue checks that a template value substituted as is outside
+// of quotes cannot end its statement, start another one or comment out the
+// rest of the query
+func rawTemplateValue(v string) error {
+	s := influxql.NewScanner(strings.NewReader(v))
+	for {
+		tok, _, lit := s.Scan()
+		switch tok {
+		case influxql.EOF:
+			return nil
+		case influxql.SEMICOLON:
+			return fmt.Errorf("value must not end the statement")
+		case influxql.COMMENT:
+			return fmt.Errorf("value must not hold comments")
+		case influxql.ILLEGAL, influxql.BADSTRING, influxql.BADESCAPE, influxql.BADREGEX:
+			return fmt.Errorf("value holds invalid InfluxQL %q", lit)
+		}
+	}
+}
+
+// renderTemplateValue quotes and escapes a template value for the context
+// it is replaced in. Values within quotes or regular expressions are escaped
+// so that they cannot end them. Values outside of quotes are quoted as their
+// type requires; values of types substituted as is are checked instead.
+func renderTemplateValue(v chronograf.TemplateValue, context int) (string, error) {
+	switch context {
+	case renderString:
+		return stringEscaper.Replace(v.Value), nil
+	case renderIdent:
+		return identEscaper.Replace(v.Value), nil
+	case renderRegex:
+		return strings.Replace(regexp.QuoteMeta(v.Value), "/", `\/`, -1), nil
+	}
+
+	switch v.Type {
+	case "tagKey", "fieldKey", "measurement", "database":
+		return quoteIdent(v.Value), nil
+	case "tagValue":
+		return influxql.QuoteString(v.Value), nil
+	}
+	if err := rawTemplateValue(v.Value); err != nil {
+		return "", err
+	}
+	return v.Value, nil
+}
+
+// startsRegex is true when the slash at i of query opens a regular
+// expression, which InfluxQL only allows after =~ and !~
+func startsRegex(query string, i int) bool {
+	before := strings.TrimRight(query[:i], " \t\n")
+	return strings.HasSuffix(before, "=~") || strings.HasSuffix(before, "!~")
+}
+
+// renderTemplates replaces the template variables of an InfluxQL query by
+// their values, quoted and escaped for where they appear in the query.
+// Values that could change the meaning of the query beyond their own are
+// rejected.
+func renderTemplates(query string, values map[string]chronograf.TemplateValue) (string, error) {
+	vars := make([]string, 0, len(values))
+	for k := range values {
+		if k != "" {
+			vars = append(vars, k)
+		}
+	}
+	// Longer variables are matched first so that none is replaced within another
+	sort.Slice(vars, func(i, j int) bool {
+		return len(vars[i]) > len(vars[j])
+	})
+
+	var b strings.Builder
+	context := renderBare
+	for i := 0; i < len(query); i++ {
+		matched := ""
+		for _, k := range vars {
+			if strings.HasPrefix(query[i:], k) {
+				matched = k
+				break
+			}
+		}
+		if matched != "" {
+			value, err := renderTemplateValue(values[matched], context)
+			if err != nil {
+				return "", fmt.Errorf("invalid value of template variable %s: %v", matched, err)
+			}
+			b.WriteString(value)
+			i += len(matched) - 1
+			continue
+		}
+
+		c := query[i]
+		b.WriteByte(c)
+		switch {
+		case context != renderBare && c == '\\' && i+1 < len(query):
+			i++
+			b.WriteByte(query[i])
+		case context == renderBare && c == '\'':
+			context = renderString
+		case context == renderBare && c == '"':
+			context = renderIdent
+		case context == renderBare && c == '/' && startsRegex(query, i):
+			context = renderRegex
+		case context == renderString && c == '\'',
+			context == renderIdent && c == '"',
+			context == renderRegex && c == '/':
+			context = renderBare
+		}
+	}
+	return b.String(), nil
+}
+
+// RenderQueries replaces the template variables of InfluxQL queries by the
+// values of the request, or else by the selected values of the templates of
+// a dashboard, so that clients need not assemble queries themselves. Every
+// value is quoted and escaped for where it appears; values that would
+// inject InfluxQL into the query fail the request.
+func (s *Service) RenderQueries(w http.ResponseWriter, r *http.Request) {
+	var req renderQueriesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+
+	var templates []chronograf.Template
+	if req.Dashboard != 0 {
+		ctx := r.Context()
+		d, err := s.Store.Dashboards(ctx).Get(ctx, req.Dashboard)
+		if err != nil {
+			notFound(w, req.Dashboard, s.Logger)
+			return
+		}
+		templates = d.Templates
+	}
+	values := selectedTemplateValues(templates, req.TemplateVars)
+
+	res := renderQueriesResponse{
+		Queries: make([]renderQueryResponse, len(req.Queries)),
+	}
+	for i, q := range req.Queries {
+		rendered, err := renderTemplates(q.Query, values)
+		if err != nil {
+			invalidData(w, fmt.Errorf("query %d: %v", i, err), s.Logger)
+			return
+		}
+		res.Queries[i] = renderQueryResponse{
+			ID:             q.ID,
+			Query:          q.Query,
+			QueryTemplated: rendered,
+		}
+	}
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
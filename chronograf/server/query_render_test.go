@@ -0,0 +1,212 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+func Test_renderTemplates(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		values  map[string]chronograf.TemplateValue
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "Values quoted by type",
+			query: `SELECT :field: FROM :db:.autogen.:m: WHERE host = :host: AND time > now() - :range:`,
+			values: map[string]chronograf.TemplateValue{
+				":field:": {Value: "usage user", Type: "fieldKey"},
+				":db:":    {Value: "telegraf", Type: "database"},
+				":m:":     {Value: "cpu", Type: "measurement"},
+				":host:":  {Value: "server01", Type: "tagValue"},
+				":range:": {Value: "1h", Type: "constant"},
+			},
+			want: `SELECT "usage user" FROM "telegraf".autogen."cpu" WHERE host = 'server01' AND time > now() - 1h`,
+		},
+		{
+			name:  "Tag value ending its string",
+			query: `SELECT * FROM cpu WHERE host = :host:`,
+			values: map[string]chronograf.TemplateValue{
+				":host:": {Value: `x' OR 'a'='a`, Type: "tagValue"},
+			},
+			want: `SELECT * FROM cpu WHERE host = 'x\' OR \'a\'=\'a'`,
+		},
+		{
+			name:  "Identifier ending its quotes",
+			query: `SELECT :field: FROM cpu`,
+			values: map[string]chronograf.TemplateValue{
+				":field:": {Value: `usage" FROM secrets; DROP DATABASE "telegraf`, Type: "fieldKey"},
+			},
+			want: `SELECT "usage\" FROM secrets; DROP DATABASE \"telegraf" FROM cpu`,
+		},
+		{
+			name:  "Value within quotes of the query",
+			query: `SELECT * FROM cpu WHERE host = ':host:' AND "region" = 'us-:region:'`,
+			values: map[string]chronograf.TemplateValue{
+				":host:":   {Value: `a\'b`, Type: "tagValue"},
+				":region:": {Value: "west", Type: "tagValue"},
+			},
+			want: `SELECT * FROM cpu WHERE host = 'a\\\'b' AND "region" = 'us-west'`,
+		},
+		{
+			name:  "Value within a regular expression",
+			query: `SELECT * FROM cpu WHERE host =~ /^:host:$/`,
+			values: map[string]chronograf.TemplateValue{
+				":host:": {Value: "a.b/c", Type: "tagValue"},
+			},
+			want: `SELECT * FROM cpu WHERE host =~ /^a\.b\/c$/`,
+		},
+		{
+			name:  "Division is not a regular expression",
+			query: `SELECT usage / 2 FROM cpu WHERE host = :host:`,
+			values: map[string]chronograf.TemplateValue{
+				":host:": {Value: "b", Type: "tagValue"},
+			},
+			want: `SELECT usage / 2 FROM cpu WHERE host = 'b'`,
+		},
+		{
+			name:  "Longer variables first",
+			query: `SELECT * FROM cpu WHERE host = :host: OR host = :hostname:`,
+			values: map[string]chronograf.TemplateValue{
+				":host:":     {Value: "a", Type: "tagValue"},
+				":hostname:": {Value: "b", Type: "tagValue"},
+			},
+			want: `SELECT * FROM cpu WHERE host = 'a' OR host = 'b'`,
+		},
+		{
+			name:  "Raw value ending the statement",
+			query: `SELECT * FROM cpu WHERE time > now() - :range:`,
+			values: map[string]chronograf.TemplateValue{
+				":range:": {Value: "1h; DROP DATABASE telegraf", Type: "constant"},
+			},
+			wantErr: true,
+		},
+		{
+			name:  "Raw value commenting out the query",
+			query: `SELECT * FROM cpu WHERE time > now() - :range: AND host = 'a'`,
+			values: map[string]chronograf.TemplateValue{
+				":range:": {Value: "1h --", Type: "csv"},
+			},
+			wantErr: true,
+		},
+		{
+			name:  "Raw value with an unterminated string",
+			query: `SELECT * FROM cpu WHERE host IN (:hosts:)`,
+			values: map[string]chronograf.TemplateValue{
+				":hosts:": {Value: `'a', 'b`, Type: "csv"},
+			},
+			wantErr: true,
+		},
+		{
+			name:  "Raw list of values",
+			query: `SELECT * FROM cpu WHERE host = :hosts:`,
+			values: map[string]chronograf.TemplateValue{
+				":hosts:": {Value: `'a' OR host = 'b'`, Type: "csv"},
+			},
+			want: `SELECT * FROM cpu WHERE host = 'a' OR host = 'b'`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := renderTemplates(tt.query, tt.values)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("renderTemplates() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("renderTemplates() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_RenderQueries(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		want       string
+	}{
+		{
+			name:       "Values of the request",
+			body:       `{"queries":[{"id":"cpu","query":"SELECT * FROM cpu WHERE host = :host:"}],"tempVars":[{"tempVar":":host:","values":[{"value":"a","type":"tagValue"},{"value":"b'","type":"tagValue","selected":true}]}]}`,
+			wantStatus: http.StatusOK,
+			want:       `{"queries":[{"id":"cpu","query":"SELECT * FROM cpu WHERE host = :host:","queryTemplated":"SELECT * FROM cpu WHERE host = 'b\\''"}]}`,
+		},
+		{
+			name:       "Values of the dashboard",
+			body:       `{"dashboard":1,"queries":[{"id":"cpu","query":"SELECT * FROM cpu WHERE host = :host:"}]}`,
+			wantStatus: http.StatusOK,
+			want:       `{"queries":[{"id":"cpu","query":"SELECT * FROM cpu WHERE host = :host:","queryTemplated":"SELECT * FROM cpu WHERE host = 'marty'"}]}`,
+		},
+		{
+			name:       "Missing dashboard",
+			body:       `{"dashboard":2,"queries":[{"id":"cpu","query":"SELECT * FROM cpu WHERE host = :host:"}]}`,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "Injected statement",
+			body:       `{"queries":[{"id":"cpu","query":"SELECT * FROM cpu LIMIT :limit:"}],"tempVars":[{"tempVar":":limit:","values":[{"value":"1; DROP DATABASE telegraf","type":"constant"}]}]}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Invalid JSON",
+			body:       `{"queries":`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{
+				Store: &mocks.Store{
+					DashboardsStore: &mocks.DashboardsStore{
+						GetF: func(ctx context.Context, id chronograf.DashboardID) (chronograf.Dashboard, error) {
+							if id != 1 {
+								return chronograf.Dashboard{}, chronograf.ErrDashboardNotFound
+							}
+							return chronograf.Dashboard{
+								ID: id,
+								Templates: []chronograf.Template{
+									{
+										TemplateVar: chronograf.TemplateVar{
+											Var: ":host:",
+											Values: []chronograf.TemplateValue{
+												{Value: "doc", Type: "tagValue"},
+												{Value: "marty", Type: "tagValue", Selected: true},
+											},
+										},
+									},
+								},
+							}, nil
+						},
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url/chronograf/v1/queries/render", bytes.NewBufferString(tt.body))
+			s.RenderQueries(w, r)
+
+			resp := w.Result()
+			got, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("RenderQueries() = %v, want %v: %s", resp.StatusCode, tt.wantStatus, got)
+			}
+			if tt.want == "" {
+				return
+			}
+			if eq, _ := jsonEqual(string(got), tt.want); !eq {
+				t.Errorf("RenderQueries() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
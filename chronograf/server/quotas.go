@@ -0,0 +1,287 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/bouk/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/kit/tracing"
+)
+
+const (
+	quotaDashboards     = "dashboards"
+	quotaUsers          = "users"
+	quotaKapacitorRules = "kapacitor rules"
+
+	// kapacitorTasksPath is the Kapacitor API endpoint creating and listing
+	// the tasks of the rules of an organization
+	kapacitorTasksPath = "/kapacitor/v1/tasks"
+	// kapacitorTasksPage is the number of tasks listed per request
+	kapacitorTasksPage = 100
+)
+
+// quotaError is returned when a new resource would exceed the quota of an
+// organization
+type quotaError struct {
+	organization string
+	resource     string
+	max          int
+}
+
+func (e *quotaError) Error() string {
+	return fmt.Sprintf("organization %s has reached its quota of %d %s", e.organization, e.max, e.resource)
+}
+
+// quotaExceeded responds with http.StatusForbidden when the quota of an
+// organization is reached and with an unknown error otherwise
+func quotaExceeded(w http.ResponseWriter, err error, logger chronograf.Logger) {
+	if _, ok := err.(*quotaError); ok {
+		Error(w, http.StatusForbidden, err.Error(), logger)
+		return
+	}
+	unknownErrorWithMessage(w, err, logger)
+}
+
+// quotaMax returns the quota of a resource; zero is unlimited
+func quotaMax(q *chronograf.OrganizationQuotas, resource string) int {
+	if q == nil {
+		return 0
+	}
+	switch resource {
+	case quotaDashboards:
+		return q.MaxDashboards
+	case quotaUsers:
+		return q.MaxUsers
+	case quotaKapacitorRules:
+		return q.MaxKapacitorRules
+	}
+	return 0
+}
+
+// ensureQuota returns a quotaError if the organization cannot have n more
+// resources. Organizations that do not exist have no quotas.
+func (s *Service) ensureQuota(ctx context.Context, orgID, resource string, n int) error {
+	serverCtx := serverContext(ctx)
+	org, err := s.Store.Organizations(serverCtx).Get(serverCtx, chronograf.OrganizationQuery{ID: &orgID})
+	if err == chronograf.ErrOrganizationNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	max := quotaMax(org.Quotas, resource)
+	if max == 0 {
+		return nil
+	}
+	used, err := s.usage(ctx, orgID, resource, max)
+	if err != nil {
+		return err
+	}
+	if used+n > max {
+		return &quotaError{
+			organization: orgID,
+			resource:     resource,
+			max:          max,
+		}
+	}
+	return nil
+}
+
+// usage counts the resources of an organization. Kapacitor tasks are
+// counted up to limit, or all of them when limit is zero.
+func (s *Service) usage(ctx context.Context, orgID, resource string, limit int) (int, error) {
+	serverCtx := serverContext(ctx)
+	switch resource {
+	case quotaDashboards:
+		ds, err := s.Store.Dashboards(serverCtx).All(serverCtx)
+		if err != nil {
+			return 0, err
+		}
+		n := 0
+		for _, d := range ds {
+			if d.Organization == orgID {
+				n++
+			}
+		}
+		return n, nil
+	case quotaUsers:
+		us, err := s.Store.Users(serverCtx).All(serverCtx)
+		if err != nil {
+			return 0, err
+		}
+		n := 0
+		for _, u := range us {
+			for _, role := range u.Roles {
+				if role.Organization == orgID {
+					n++
+					break
+				}
+			}
+		}
+		return n, nil
+	case quotaKapacitorRules:
+		srvs, err := s.Store.Servers(serverCtx).All(serverCtx)
+		if err != nil {
+			return 0, err
+		}
+		n := 0
+		for _, srv := range srvs {
+			if srv.Organization != orgID || !isKapacitor(srv) {
+				continue
+			}
+			tasks, err := kapacitorTasks(ctx, srv, limit-n)
+			if err != nil {
+				return 0, err
+			}
+			n += tasks
+			if limit > 0 && n >= limit {
+				break
+			}
+		}
+		return n, nil
+	}
+	return 0, fmt.Errorf("unknown resource %s", resource)
+}
+
+// isKapacitor is true if the server is a Kapacitor rather than another
+// kind of service such as flux
+func isKapacitor(srv chronograf.Server) bool {
+	return srv.Type == "" || srv.Type == "kapacitor"
+}
+
+// kapacitorTasks counts the tasks of a Kapacitor up to limit, or all of them
+// when limit is not positive.
+func kapacitorTasks(ctx context.Context, srv chronograf.Server, limit int) (int, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	defer span.Finish()
+
+	client := &http.Client{
+		Timeout: 10 * time.Second,
+	}
+	if srv.InsecureSkipVerify {
+		client.Transport = &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		}
+	}
+
+	n := 0
+	for {
+		q := url.Values{}
+		q.Set("fields", "id")
+		q.Set("limit", fmt.Sprintf("%d", kapacitorTasksPage))
+		q.Set("offset", fmt.Sprintf("%d", n))
+		req, err := http.NewRequest("GET", singleJoiningSlash(srv.URL, kapacitorTasksPath)+"?"+q.Encode(), nil)
+		if err != nil {
+			return 0, err
+		}
+		tracing.InjectToHTTPRequest(span, req)
+		if srv.Username != "" && srv.Password != "" {
+			req.SetBasicAuth(srv.Username, srv.Password)
+		}
+
+		resp, err := client.Do(req.WithContext(ctx))
+		if err != nil {
+			return 0, fmt.Errorf("error listing tasks of kapacitor %s: %v", srv.Name, err)
+		}
+		var page struct {
+			Tasks []struct {
+				ID string `json:"id"`
+			} `json:"tasks"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return 0, fmt.Errorf("error listing tasks of kapacitor %s: %s", srv.Name, resp.Status)
+		}
+		if err != nil {
+			return 0, fmt.Errorf("error listing tasks of kapacitor %s: %v", srv.Name, err)
+		}
+
+		n += len(page.Tasks)
+		if len(page.Tasks) < kapacitorTasksPage || (limit > 0 && n >= limit) {
+			return n, nil
+		}
+	}
+}
+
+// resourceOrganization returns the organization a new resource is added to.
+// Within an organization the store assigns the organization on context; with
+// raw store access the resource keeps its own organization.
+func resourceOrganization(ctx context.Context, orgID string) string {
+	if hasServerContext(ctx) {
+		return orgID
+	}
+	if id, ok := hasOrganizationContext(ctx); ok {
+		return id
+	}
+	return orgID
+}
+
+// createsKapacitorRule is true if a request proxied to a service creates a
+// Kapacitor task
+func createsKapacitorRule(r *http.Request) bool {
+	if r.Method != "POST" {
+		return false
+	}
+	p := r.URL.Query().Get("path")
+	if i := strings.IndexByte(p, '?'); i >= 0 {
+		p = p[:i]
+	}
+	return strings.TrimSuffix(p, "/") == kapacitorTasksPath
+}
+
+type quotaUsage struct {
+	Used  int `json:"used"`
+	Quota int `json:"quota"` // Quota is the maximum of the resource; zero is unlimited
+}
+
+type organizationUsageResponse struct {
+	Links          selfLinks  `json:"links"`
+	Dashboards     quotaUsage `json:"dashboards"`
+	Users          quotaUsage `json:"users"`
+	KapacitorRules quotaUsage `json:"kapacitorRules"`
+}
+
+// OrganizationUsage reports the consumption of the resources of an
+// organization against its quotas
+func (s *Service) OrganizationUsage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := httprouter.GetParamFromContext(ctx, "oid")
+
+	serverCtx := serverContext(ctx)
+	org, err := s.Store.Organizations(serverCtx).Get(serverCtx, chronograf.OrganizationQuery{ID: &id})
+	if err != nil {
+		Error(w, http.StatusNotFound, err.Error(), s.Logger)
+		return
+	}
+
+	res := &organizationUsageResponse{
+		Links: selfLinks{
+			Self: fmt.Sprintf("/chronograf/v1/organizations/%s/usage", org.ID),
+		},
+	}
+	for resource, u := range map[string]*quotaUsage{
+		quotaDashboards:     &res.Dashboards,
+		quotaUsers:          &res.Users,
+		quotaKapacitorRules: &res.KapacitorRules,
+	} {
+		used, err := s.usage(ctx, org.ID, resource, 0)
+		if err != nil {
+			unknownErrorWithMessage(w, err, s.Logger)
+			return
+		}
+		u.Used = used
+		u.Quota = quotaMax(org.Quotas, resource)
+	}
+
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
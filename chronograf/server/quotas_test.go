@@ -0,0 +1,190 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bouk/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+	"github.com/influxdata/influxdb/chronograf/organizations"
+)
+
+func TestService_NewDashboard_quota(t *testing.T) {
+	tests := []struct {
+		name       string
+		quotas     *chronograf.OrganizationQuotas
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "No quotas",
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "Below the quota",
+			quotas:     &chronograf.OrganizationQuotas{MaxDashboards: 3},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "Quota reached",
+			quotas:     &chronograf.OrganizationQuotas{MaxDashboards: 2},
+			wantStatus: http.StatusForbidden,
+			wantBody:   `{"code":403,"message":"organization 1337 has reached its quota of 2 dashboards","type":"urn:chronograf:problem:forbidden","title":"Forbidden","status":403,"detail":"organization 1337 has reached its quota of 2 dashboards"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			added := false
+			s := &Service{
+				Store: &mocks.Store{
+					OrganizationsStore: &mocks.OrganizationsStore{
+						DefaultOrganizationF: func(ctx context.Context) (*chronograf.Organization, error) {
+							return &chronograf.Organization{ID: "0"}, nil
+						},
+						GetF: func(ctx context.Context, q chronograf.OrganizationQuery) (*chronograf.Organization, error) {
+							return &chronograf.Organization{ID: *q.ID, Quotas: tt.quotas}, nil
+						},
+					},
+					DashboardsStore: &mocks.DashboardsStore{
+						AllF: func(ctx context.Context) ([]chronograf.Dashboard, error) {
+							return []chronograf.Dashboard{
+								{ID: 1, Organization: "1337"},
+								{ID: 2, Organization: "1337"},
+								{ID: 3, Organization: "0"},
+							}, nil
+						},
+						AddF: func(ctx context.Context, d chronograf.Dashboard) (chronograf.Dashboard, error) {
+							added = true
+							d.ID = 4
+							return d, nil
+						},
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url/chronograf/v1/dashboards", bytes.NewBufferString(`{"name":"Weather"}`))
+			r = r.WithContext(context.WithValue(r.Context(), organizations.ContextKey, "1337"))
+
+			s.NewDashboard(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("%q. NewDashboard() = %v, want %v: %s", tt.name, resp.StatusCode, tt.wantStatus, body)
+			}
+			if added != (tt.wantStatus == http.StatusCreated) {
+				t.Errorf("%q. NewDashboard() added = %v", tt.name, added)
+			}
+			if tt.wantBody == "" {
+				return
+			}
+			if eq, _ := jsonEqual(string(body), tt.wantBody); !eq {
+				t.Errorf("%q. NewDashboard() = \n***%v***\n,\nwant\n***%v***", tt.name, string(body), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestService_OrganizationUsage(t *testing.T) {
+	kapa := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != kapacitorTasksPath {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"tasks": []map[string]string{{"id": "cpu"}, {"id": "mem"}},
+		})
+	}))
+	defer kapa.Close()
+
+	s := &Service{
+		Store: &mocks.Store{
+			OrganizationsStore: &mocks.OrganizationsStore{
+				GetF: func(ctx context.Context, q chronograf.OrganizationQuery) (*chronograf.Organization, error) {
+					return &chronograf.Organization{
+						ID:     *q.ID,
+						Quotas: &chronograf.OrganizationQuotas{MaxUsers: 10, MaxKapacitorRules: 5},
+					}, nil
+				},
+			},
+			DashboardsStore: &mocks.DashboardsStore{
+				AllF: func(ctx context.Context) ([]chronograf.Dashboard, error) {
+					return []chronograf.Dashboard{
+						{ID: 1, Organization: "1337"},
+						{ID: 2, Organization: "0"},
+					}, nil
+				},
+			},
+			UsersStore: &mocks.UsersStore{
+				AllF: func(ctx context.Context) ([]chronograf.User, error) {
+					return []chronograf.User{
+						{ID: 1, Roles: []chronograf.Role{{Name: "viewer", Organization: "1337"}}},
+						{ID: 2, Roles: []chronograf.Role{{Name: "admin", Organization: "0"}}},
+						{ID: 3, Roles: []chronograf.Role{{Name: "admin", Organization: "0"}, {Name: "editor", Organization: "1337"}}},
+					}, nil
+				},
+			},
+			ServersStore: &mocks.ServersStore{
+				AllF: func(ctx context.Context) ([]chronograf.Server, error) {
+					return []chronograf.Server{
+						{ID: 1, Organization: "1337", URL: kapa.URL},
+						{ID: 2, Organization: "1337", URL: "http://flux.invalid", Type: "flux"},
+						{ID: 3, Organization: "0", URL: "http://kapacitor.invalid"},
+					}, nil
+				},
+			},
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "http://any.url/chronograf/v1/organizations/1337/usage", nil)
+	r = r.WithContext(httprouter.WithParams(r.Context(), httprouter.Params{
+		{
+			Key:   "oid",
+			Value: "1337",
+		},
+	}))
+
+	s.OrganizationUsage(w, r)
+
+	resp := w.Result()
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("OrganizationUsage() = %v, want %v: %s", resp.StatusCode, http.StatusOK, body)
+	}
+	want := `{"links":{"self":"/chronograf/v1/organizations/1337/usage"},"dashboards":{"used":1,"quota":0},"users":{"used":2,"quota":10},"kapacitorRules":{"used":2,"quota":5}}`
+	if eq, _ := jsonEqual(string(body), want); !eq {
+		t.Errorf("OrganizationUsage() = \n***%v***\n,\nwant\n***%v***", string(body), want)
+	}
+}
+
+func Test_createsKapacitorRule(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		want   bool
+	}{
+		{method: "POST", path: "/kapacitor/v1/tasks", want: true},
+		{method: "POST", path: "/kapacitor/v1/tasks/", want: true},
+		{method: "POST", path: "/kapacitor/v1/tasks?skip-format=true", want: true},
+		{method: "GET", path: "/kapacitor/v1/tasks", want: false},
+		{method: "POST", path: "/kapacitor/v1/tasks/cpu", want: false},
+		{method: "POST", path: "/kapacitor/v1/templates", want: false},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest(tt.method, "http://any.url/chronograf/v1/sources/1/kapacitors/1/proxy", nil)
+		q := r.URL.Query()
+		q.Set("path", tt.path)
+		r.URL.RawQuery = q.Encode()
+		if got := createsKapacitorRule(r); got != tt.want {
+			t.Errorf("createsKapacitorRule(%s %s) = %v, want %v", tt.method, tt.path, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package server
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/metrics"
+	"golang.org/x/time/rate"
+)
+
+// Buckets of the rate limits
+const (
+	userBucket = "user"
+	ipBucket   = "ip"
+)
+
+// rateLimitSweep is how often the buckets of the clients that stopped making
+// requests are dropped
+const rateLimitSweep = time.Minute
+
+// RateLimiter limits the requests of each authenticated user and of each IP
+// of anonymous requests with token buckets, so that a single client cannot
+// starve the server. Zero rates are unlimited.
+type RateLimiter struct {
+	UserPerSecond float64          // UserPerSecond is the most requests per second of an authenticated user
+	UserBurst     int              // UserBurst is the most requests of a user at once; the requests allowed within a second when zero
+	IPPerSecond   float64          // IPPerSecond is the most requests per second of an IP of anonymous requests
+	IPBurst       int              // IPBurst is the most anonymous requests of an IP at once; the requests allowed within a second when zero
+	Metrics       *metrics.Metrics // Metrics counts the requests allowed and limited by bucket
+
+	mu      sync.Mutex
+	buckets map[string]*rateBucket
+	swept   time.Time
+}
+
+// rateBucket is the token bucket of a user or of an IP
+type rateBucket struct {
+	limiter *rate.Limiter
+	refill  time.Duration // refill is how long an empty bucket takes to fill up
+	seen    time.Time
+}
+
+func newRateBucket(perSecond float64, burst int) *rateBucket {
+	if burst <= 0 {
+		burst = int(math.Ceil(perSecond))
+	}
+	return &rateBucket{
+		limiter: rate.NewLimiter(rate.Limit(perSecond), burst),
+		refill:  time.Duration(float64(burst) / perSecond * float64(time.Second)),
+	}
+}
+
+// Allow takes a token of the bucket of a client, which is identified by key
+// within its bucket kind. It returns how long to wait before the request is
+// allowed, or 0 when the request is allowed.
+func (l *RateLimiter) Allow(bucket, key string, now time.Time) time.Duration {
+	perSecond, burst := l.UserPerSecond, l.UserBurst
+	if bucket == ipBucket {
+		perSecond, burst = l.IPPerSecond, l.IPBurst
+	}
+	if perSecond <= 0 {
+		return 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.buckets == nil {
+		l.buckets = map[string]*rateBucket{}
+		l.swept = now
+	}
+	if now.Sub(l.swept) >= rateLimitSweep {
+		l.sweep(now)
+	}
+
+	id := bucket + ":" + key
+	b, ok := l.buckets[id]
+	if !ok {
+		b = newRateBucket(perSecond, burst)
+		l.buckets[id] = b
+	}
+	b.seen = now
+
+	r := b.limiter.ReserveN(now, 1)
+	delay := r.DelayFrom(now)
+	if delay > 0 {
+		r.CancelAt(now)
+	}
+	l.Metrics.RateLimit(bucket, delay == 0)
+	return delay
+}
+
+// sweep drops the buckets that have filled up since their last request, as
+// they limit their clients the same as new buckets would
+func (l *RateLimiter) sweep(now time.Time) {
+	for id, b := range l.buckets {
+		if now.Sub(b.seen) > b.refill {
+			delete(l.buckets, id)
+		}
+	}
+	l.swept = now
+}
+
+// rateLimitKey returns the bucket and the key of the client of a request:
+// the principal or API token of authenticated requests and the IP of
+// anonymous ones
+func rateLimitKey(r *http.Request) (bucket, key string) {
+	ctx := r.Context()
+	if p, err := getValidPrincipal(ctx); err == nil {
+		return userBucket, p.Issuer + ":" + p.Subject
+	}
+	if t, ok := hasTokenContext(ctx); ok {
+		return userBucket, "token:" + t.ID
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return ipBucket, host
+}
+
+// RateLimited responds with http.StatusTooManyRequests and the Retry-After
+// header to the requests of the clients over their rate limit
+func RateLimited(l *RateLimiter, logger chronograf.Logger, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bucket, key := rateLimitKey(r)
+		if delay := l.Allow(bucket, key, time.Now()); delay > 0 {
+			logger.
+				WithField("component", "rate_limit").
+				WithField("remote_addr", r.RemoteAddr).
+				WithField("method", r.Method).
+				WithField("url", r.URL).
+				WithField(bucket, key).
+				Error("Rate limit reached")
+			queryLimited(w, &queryLimitError{
+				msg:        fmt.Sprintf("too many requests; retry in %s", delay.Round(time.Millisecond)),
+				RetryAfter: delay,
+			}, logger)
+			return
+		}
+		next(w, r)
+	}
+}
+
+var _ chronograf.Router = &RateLimitedRouter{}
+
+// RateLimitedRouter is an implementation of a chronograf.Router which limits
+// the rate of the requests of each client to the routes of a Delegated
+// chronograf.Router. Routes are limited once the principal of a request is
+// authenticated, so that users are limited by user rather than by IP.
+type RateLimitedRouter struct {
+	Limiter  *RateLimiter
+	Logger   chronograf.Logger
+	Delegate chronograf.Router
+}
+
+// DELETE defines a route responding to a DELETE request whose requests are
+// rate limited
+func (rr *RateLimitedRouter) DELETE(path string, handler http.HandlerFunc) {
+	rr.Delegate.DELETE(path, RateLimited(rr.Limiter, rr.Logger, handler))
+}
+
+// GET defines a route responding to a GET request whose requests are rate
+// limited
+func (rr *RateLimitedRouter) GET(path string, handler http.HandlerFunc) {
+	rr.Delegate.GET(path, RateLimited(rr.Limiter, rr.Logger, handler))
+}
+
+// POST defines a route responding to a POST request whose requests are rate
+// limited
+func (rr *RateLimitedRouter) POST(path string, handler http.HandlerFunc) {
+	rr.Delegate.POST(path, RateLimited(rr.Limiter, rr.Logger, handler))
+}
+
+// PUT defines a route responding to a PUT request whose requests are rate
+// limited
+func (rr *RateLimitedRouter) PUT(path string, handler http.HandlerFunc) {
+	rr.Delegate.PUT(path, RateLimited(rr.Limiter, rr.Logger, handler))
+}
+
+// PATCH defines a route responding to a PATCH request whose requests are
+// rate limited
+func (rr *RateLimitedRouter) PATCH(path string, handler http.HandlerFunc) {
+	rr.Delegate.PATCH(path, RateLimited(rr.Limiter, rr.Logger, handler))
+}
+
+// Handler defines a route responding to a request type specified in the
+// method parameter whose requests are rate limited
+func (rr *RateLimitedRouter) Handler(method string, path string, handler http.Handler) {
+	rr.Delegate.Handler(method, path, RateLimited(rr.Limiter, rr.Logger, handler.ServeHTTP))
+}
+
+// ServeHTTP is an implementation of http.Handler which delegates to the
+// configured Delegate's implementation of http.Handler
+func (rr *RateLimitedRouter) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	rr.Delegate.ServeHTTP(rw, r)
+}
@@ -0,0 +1,137 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bouk/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/oauth2"
+)
+
+func TestRateLimiter_Allow(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Requests per second of a user", func(t *testing.T) {
+		l := &RateLimiter{UserPerSecond: 2}
+		for i := 0; i < 2; i++ {
+			if delay := l.Allow(userBucket, "alice", now); delay != 0 {
+				t.Fatalf("Allow() request %d delay = %v, want 0", i, delay)
+			}
+		}
+		if delay := l.Allow(userBucket, "alice", now); delay != 500*time.Millisecond {
+			t.Errorf("Allow() over the rate delay = %v, want %v", delay, 500*time.Millisecond)
+		}
+		if delay := l.Allow(userBucket, "bob", now); delay != 0 {
+			t.Errorf("Allow() request of bob delay = %v, want 0", delay)
+		}
+		if delay := l.Allow(userBucket, "alice", now.Add(500*time.Millisecond)); delay != 0 {
+			t.Errorf("Allow() after waiting delay = %v, want 0", delay)
+		}
+	})
+
+	t.Run("Burst of an IP", func(t *testing.T) {
+		l := &RateLimiter{IPPerSecond: 1, IPBurst: 3}
+		for i := 0; i < 3; i++ {
+			if delay := l.Allow(ipBucket, "10.0.0.1", now); delay != 0 {
+				t.Fatalf("Allow() request %d delay = %v, want 0", i, delay)
+			}
+		}
+		if delay := l.Allow(ipBucket, "10.0.0.1", now); delay != time.Second {
+			t.Errorf("Allow() over the burst delay = %v, want %v", delay, time.Second)
+		}
+	})
+
+	t.Run("Unlimited buckets", func(t *testing.T) {
+		l := &RateLimiter{IPPerSecond: 1}
+		for i := 0; i < 10; i++ {
+			if delay := l.Allow(userBucket, "alice", now); delay != 0 {
+				t.Fatalf("Allow() unlimited request %d delay = %v, want 0", i, delay)
+			}
+		}
+	})
+
+	t.Run("Buckets of idle clients are dropped", func(t *testing.T) {
+		l := &RateLimiter{UserPerSecond: 1}
+		l.Allow(userBucket, "alice", now)
+		l.Allow(userBucket, "bob", now.Add(rateLimitSweep-time.Millisecond))
+		l.Allow(userBucket, "bob", now.Add(rateLimitSweep))
+		if _, ok := l.buckets["user:alice"]; ok {
+			t.Error("sweep kept the bucket of an idle client")
+		}
+		if _, ok := l.buckets["user:bob"]; !ok {
+			t.Error("sweep dropped the bucket of an active client")
+		}
+	})
+}
+
+func Test_rateLimitKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		ctx        func(context.Context) context.Context
+		wantBucket string
+		wantKey    string
+	}{
+		{
+			name:       "Anonymous request",
+			ctx:        func(ctx context.Context) context.Context { return ctx },
+			wantBucket: ipBucket,
+			wantKey:    "192.0.2.1",
+		},
+		{
+			name: "Principal",
+			ctx: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, oauth2.PrincipalKey, oauth2.Principal{Subject: "alice", Issuer: "github"})
+			},
+			wantBucket: userBucket,
+			wantKey:    "github:alice",
+		},
+		{
+			name: "API token",
+			ctx: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, TokenContextKey, &chronograf.Token{ID: "1"})
+			},
+			wantBucket: userBucket,
+			wantKey:    "token:1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "http://any.url", nil)
+			r = r.WithContext(tt.ctx(r.Context()))
+			bucket, key := rateLimitKey(r)
+			if bucket != tt.wantBucket || key != tt.wantKey {
+				t.Errorf("rateLimitKey() = %s, %s, want %s, %s", bucket, key, tt.wantBucket, tt.wantKey)
+			}
+		})
+	}
+}
+
+func TestRateLimitedRouter(t *testing.T) {
+	router := &RateLimitedRouter{
+		Limiter:  &RateLimiter{IPPerSecond: 1},
+		Logger:   &chronograf.NoopLogger{},
+		Delegate: httprouter.New(),
+	}
+	router.GET("/chronograf/v1/sources", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/chronograf/v1/sources", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("first request status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/chronograf/v1/sources", nil))
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("second request status = %d, want %d", w.Code, http.StatusTooManyRequests)
+	}
+	if got := w.Header().Get("Retry-After"); got != "1" {
+		t.Errorf("second request Retry-After = %q, want %q", got, "1")
+	}
+}
@@ -0,0 +1,385 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/influx"
+)
+
+// Actions reported for each resource within a reconcile response
+const (
+	reconcileCreate    = "create"
+	reconcileUpdate    = "update"
+	reconcileUnchanged = "unchanged"
+	reconcileFailed    = "failed"
+)
+
+// reconcileRequest is the declarative bundle of resources an operator would
+// like to exist. Each resource has the same JSON shape as the .org, .src, .kap,
+// and .dashboard files used for file provisioning in the resources path.
+type reconcileRequest struct {
+	Organizations []chronograf.Organization `json:"organizations"`
+	Sources       []chronograf.Source       `json:"sources"`
+	Kapacitors    []chronograf.Server       `json:"kapacitors"`
+	Dashboards    []chronograf.Dashboard    `json:"dashboards"`
+}
+
+type reconcileResult struct {
+	Kind   string `json:"kind"`            // Kind is the type of resource; e.g. organization, source, kapacitor or dashboard
+	Name   string `json:"name"`            // Name is the user-facing name of the resource in the bundle
+	ID     string `json:"id,omitempty"`    // ID is the ID of the resource in the store after reconciliation
+	Action string `json:"action"`          // Action is one of create, update, unchanged or failed
+	Error  string `json:"error,omitempty"` // Error describes why the resource could not be reconciled
+}
+
+type reconcileSummary struct {
+	Created   int `json:"created"`
+	Updated   int `json:"updated"`
+	Unchanged int `json:"unchanged"`
+	Failed    int `json:"failed"`
+}
+
+type reconcileResponse struct {
+	Links   selfLinks         `json:"links"`
+	DryRun  bool              `json:"dryRun"`
+	Summary reconcileSummary  `json:"summary"`
+	Results []reconcileResult `json:"results"`
+}
+
+func (r *reconcileResponse) add(res reconcileResult) {
+	switch res.Action {
+	case reconcileCreate:
+		r.Summary.Created++
+	case reconcileUpdate:
+		r.Summary.Updated++
+	case reconcileUnchanged:
+		r.Summary.Unchanged++
+	case reconcileFailed:
+		r.Summary.Failed++
+	}
+	r.Results = append(r.Results, res)
+}
+
+// reconciler applies a reconcileRequest against the stores of a Service. IDs
+// in the bundle are only used to tie resources together; organizations and
+// sources are matched against the stores by name and references to them are
+// remapped to the IDs that exist in the store.
+type reconciler struct {
+	store   DataStore
+	dryRun  bool
+	publish func(ctx context.Context, event string, data interface{}) // publish posts the events of the written sources and dashboards to webhooks
+
+	orgIDs map[string]string
+	srcIDs map[int]int
+}
+
+func (rc *reconciler) organization(ctx context.Context, o chronograf.Organization) reconcileResult {
+	res := reconcileResult{Kind: "organization", Name: o.Name}
+
+	req := organizationRequest{Name: o.Name, DefaultRole: o.DefaultRole}
+	if err := req.ValidCreate(); err != nil {
+		return failedResult(res, err)
+	}
+	o.DefaultRole = req.DefaultRole
+
+	orgs := rc.store.Organizations(ctx)
+	cur, err := orgs.Get(ctx, chronograf.OrganizationQuery{Name: &o.Name})
+	if err != nil && err != chronograf.ErrOrganizationNotFound {
+		return failedResult(res, err)
+	}
+
+	bundleID := o.ID
+	if cur == nil {
+		res.Action = reconcileCreate
+		if !rc.dryRun {
+			o.ID = ""
+			created, err := orgs.Add(ctx, &o)
+			if err != nil {
+				return failedResult(res, err)
+			}
+			res.ID = created.ID
+		}
+		rc.orgIDs[bundleID] = res.ID
+		return res
+	}
+
+	res.ID = cur.ID
+	rc.orgIDs[bundleID] = cur.ID
+	if cur.DefaultRole == o.DefaultRole {
+		res.Action = reconcileUnchanged
+		return res
+	}
+
+	res.Action = reconcileUpdate
+	if !rc.dryRun {
+		cur.DefaultRole = o.DefaultRole
+		if err := orgs.Update(ctx, cur); err != nil {
+			return failedResult(res, err)
+		}
+	}
+	return res
+}
+
+// organizationID maps an organization ID from the bundle to the ID of the
+// organization within the store. Unknown IDs are assumed to already refer to
+// the store.
+func (rc *reconciler) organizationID(ctx context.Context, id string) (string, error) {
+	if id == "" {
+		org, err := rc.store.Organizations(ctx).DefaultOrganization(ctx)
+		if err != nil {
+			return "", err
+		}
+		return org.ID, nil
+	}
+	if mapped, ok := rc.orgIDs[id]; ok {
+		return mapped, nil
+	}
+	return id, nil
+}
+
+func (rc *reconciler) source(ctx context.Context, src chronograf.Source) reconcileResult {
+	res := reconcileResult{Kind: "source", Name: src.Name}
+	if src.Name == "" || src.URL == "" {
+		return failedResult(res, fmt.Errorf("name and url required"))
+	}
+	if _, err := influx.TLSConfig(&src); err != nil {
+		return failedResult(res, err)
+	}
+
+	org, err := rc.organizationID(ctx, src.Organization)
+	if err != nil {
+		return failedResult(res, err)
+	}
+	src.Organization = org
+
+	srcs, err := rc.store.Sources(ctx).All(ctx)
+	if err != nil {
+		return failedResult(res, err)
+	}
+
+	bundleID := src.ID
+	for _, cur := range srcs {
+		if cur.Name != src.Name || cur.Organization != src.Organization {
+			continue
+		}
+		res.ID = strconv.Itoa(cur.ID)
+		rc.srcIDs[bundleID] = cur.ID
+		src.ID = cur.ID
+		if reflect.DeepEqual(cur, src) {
+			res.Action = reconcileUnchanged
+			return res
+		}
+		res.Action = reconcileUpdate
+		if !rc.dryRun {
+			if err := rc.store.Sources(ctx).Update(ctx, src); err != nil {
+				return failedResult(res, err)
+			}
+			rc.publish(ctx, EventSourceUpdated, newSourceEventData(src))
+		}
+		return res
+	}
+
+	res.Action = reconcileCreate
+	if !rc.dryRun {
+		src.ID = 0
+		created, err := rc.store.Sources(ctx).Add(ctx, src)
+		if err != nil {
+			return failedResult(res, err)
+		}
+		res.ID = strconv.Itoa(created.ID)
+		rc.srcIDs[bundleID] = created.ID
+		rc.publish(ctx, EventSourceCreated, newSourceEventData(created))
+	}
+	return res
+}
+
+func (rc *reconciler) kapacitor(ctx context.Context, srv chronograf.Server) reconcileResult {
+	res := reconcileResult{Kind: "kapacitor", Name: srv.Name}
+	if srv.Name == "" || srv.URL == "" {
+		return failedResult(res, fmt.Errorf("name and url required"))
+	}
+
+	org, err := rc.organizationID(ctx, srv.Organization)
+	if err != nil {
+		return failedResult(res, err)
+	}
+	srv.Organization = org
+	if id, ok := rc.srcIDs[srv.SrcID]; ok {
+		srv.SrcID = id
+	}
+
+	srvs, err := rc.store.Servers(ctx).All(ctx)
+	if err != nil {
+		return failedResult(res, err)
+	}
+
+	for _, cur := range srvs {
+		if cur.Name != srv.Name || cur.SrcID != srv.SrcID || cur.Organization != srv.Organization {
+			continue
+		}
+		res.ID = strconv.Itoa(cur.ID)
+		srv.ID = cur.ID
+		if reflect.DeepEqual(cur, srv) {
+			res.Action = reconcileUnchanged
+			return res
+		}
+		res.Action = reconcileUpdate
+		if !rc.dryRun {
+			if err := rc.store.Servers(ctx).Update(ctx, srv); err != nil {
+				return failedResult(res, err)
+			}
+		}
+		return res
+	}
+
+	res.Action = reconcileCreate
+	if !rc.dryRun {
+		srv.ID = 0
+		created, err := rc.store.Servers(ctx).Add(ctx, srv)
+		if err != nil {
+			return failedResult(res, err)
+		}
+		res.ID = strconv.Itoa(created.ID)
+	}
+	return res
+}
+
+func (rc *reconciler) dashboard(ctx context.Context, d chronograf.Dashboard) reconcileResult {
+	res := reconcileResult{Kind: "dashboard", Name: d.Name}
+	if d.Name == "" {
+		return failedResult(res, fmt.Errorf("name required"))
+	}
+
+	org, err := rc.organizationID(ctx, d.Organization)
+	if err != nil {
+		return failedResult(res, err)
+	}
+	if err := ValidDashboardRequest(&d, org); err != nil {
+		return failedResult(res, err)
+	}
+	if mapped, ok := rc.orgIDs[d.Organization]; ok {
+		d.Organization = mapped
+	}
+	for i := range d.Cells {
+		for j := range d.Cells[i].Queries {
+			d.Cells[i].Queries[j].Source = rc.sourceLink(d.Cells[i].Queries[j].Source)
+		}
+	}
+
+	dashes, err := rc.store.Dashboards(ctx).All(ctx)
+	if err != nil {
+		return failedResult(res, err)
+	}
+
+	for _, cur := range dashes {
+		// Dashboards of the bundle with a slug are matched by their slug
+		if cur.Organization != d.Organization || (d.Slug == "" && cur.Name != d.Name) || (d.Slug != "" && cur.Slug != d.Slug) {
+			continue
+		}
+		res.ID = strconv.Itoa(int(cur.ID))
+		d.ID = cur.ID
+		// Folders are not reconciled, so dashboards stay within their folder
+		d.Folder = cur.Folder
+		if d.Slug == "" {
+			d.Slug = cur.Slug
+		}
+		if reflect.DeepEqual(DashboardDefaults(cur), d) {
+			res.Action = reconcileUnchanged
+			return res
+		}
+		res.Action = reconcileUpdate
+		if !rc.dryRun {
+			if err := rc.store.Dashboards(ctx).Update(ctx, d); err != nil {
+				return failedResult(res, err)
+			}
+			rc.publish(ctx, EventDashboardUpdated, newDashboardResponse(d))
+		}
+		return res
+	}
+
+	res.Action = reconcileCreate
+	if !rc.dryRun {
+		d.ID = 0
+		created, err := rc.store.Dashboards(ctx).Add(ctx, d)
+		if err != nil {
+			return failedResult(res, err)
+		}
+		res.ID = strconv.Itoa(int(created.ID))
+		rc.publish(ctx, EventDashboardCreated, newDashboardResponse(created))
+	}
+	return res
+}
+
+func failedResult(res reconcileResult, err error) reconcileResult {
+	res.Action = reconcileFailed
+	res.Error = err.Error()
+	return res
+}
+
+// Reconcile applies a declarative bundle of organizations, sources, kapacitors
+// and dashboards to the stores and reports the difference between the bundle
+// and what was previously stored. Resources are never deleted. When the query
+// parameter dryRun=true is given, the report is produced without any writes.
+func (s *Service) Reconcile(w http.ResponseWriter, r *http.Request) {
+	var req reconcileRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+
+	dryRun, err := dryRunParam(r)
+	if err != nil {
+		Error(w, http.StatusUnprocessableEntity, err.Error(), s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	rc := &reconciler{
+		store:   s.Store,
+		dryRun:  dryRun,
+		publish: s.publishEvent,
+		orgIDs:  map[string]string{},
+		srcIDs:  map[int]int{},
+	}
+
+	res := &reconcileResponse{
+		Links: selfLinks{
+			Self: "/chronograf/v1/admin/reconcile",
+		},
+		DryRun:  dryRun,
+		Results: []reconcileResult{},
+	}
+	for _, o := range req.Organizations {
+		res.add(rc.organization(ctx, o))
+	}
+	for _, src := range req.Sources {
+		res.add(rc.source(ctx, src))
+	}
+	for _, srv := range req.Kapacitors {
+		res.add(rc.kapacitor(ctx, srv))
+	}
+	for _, d := range req.Dashboards {
+		res.add(rc.dashboard(ctx, d))
+	}
+
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// dryRunParam parses the optional dryRun query parameter of a request
+func dryRunParam(r *http.Request) (bool, error) {
+	v := r.URL.Query().Get("dryRun")
+	if v == "" {
+		return false, nil
+	}
+	dryRun, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("invalid dryRun parameter: %v", err)
+	}
+	return dryRun, nil
+}
@@ -0,0 +1,197 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+func TestService_Reconcile(t *testing.T) {
+	type fields struct {
+		OrganizationsStore chronograf.OrganizationsStore
+		SourcesStore       chronograf.SourcesStore
+		ServersStore       chronograf.ServersStore
+		DashboardsStore    chronograf.DashboardsStore
+	}
+	orgs := func(added *[]string) *mocks.OrganizationsStore {
+		return &mocks.OrganizationsStore{
+			DefaultOrganizationF: func(ctx context.Context) (*chronograf.Organization, error) {
+				return &chronograf.Organization{ID: "0", Name: "Default"}, nil
+			},
+			GetF: func(ctx context.Context, q chronograf.OrganizationQuery) (*chronograf.Organization, error) {
+				if *q.Name == "Existing" {
+					return &chronograf.Organization{ID: "7", Name: "Existing", DefaultRole: "viewer"}, nil
+				}
+				return nil, chronograf.ErrOrganizationNotFound
+			},
+			AddF: func(ctx context.Context, o *chronograf.Organization) (*chronograf.Organization, error) {
+				*added = append(*added, o.Name)
+				return &chronograf.Organization{ID: "42", Name: o.Name, DefaultRole: o.DefaultRole}, nil
+			},
+			UpdateF: func(ctx context.Context, o *chronograf.Organization) error {
+				return nil
+			},
+		}
+	}
+	tests := []struct {
+		name       string
+		fields     func(added *[]string) fields
+		query      string
+		body       string
+		wantStatus int
+		wantBody   string
+		wantAdded  []string
+	}{
+		{
+			name: "creates and remaps resources in the bundle",
+			fields: func(added *[]string) fields {
+				return fields{
+					OrganizationsStore: orgs(added),
+					SourcesStore: &mocks.SourcesStore{
+						AllF: func(ctx context.Context) ([]chronograf.Source, error) {
+							return []chronograf.Source{}, nil
+						},
+						AddF: func(ctx context.Context, src chronograf.Source) (chronograf.Source, error) {
+							if src.Organization != "42" {
+								t.Errorf("source organization = %s, want 42", src.Organization)
+							}
+							*added = append(*added, src.Name)
+							src.ID = 9
+							return src, nil
+						},
+					},
+					ServersStore: &mocks.ServersStore{
+						AllF: func(ctx context.Context) ([]chronograf.Server, error) {
+							return []chronograf.Server{}, nil
+						},
+						AddF: func(ctx context.Context, srv chronograf.Server) (chronograf.Server, error) {
+							if srv.SrcID != 9 {
+								t.Errorf("kapacitor srcID = %d, want 9", srv.SrcID)
+							}
+							*added = append(*added, srv.Name)
+							srv.ID = 3
+							return srv, nil
+						},
+					},
+					DashboardsStore: &mocks.DashboardsStore{
+						AllF: func(ctx context.Context) ([]chronograf.Dashboard, error) {
+							return []chronograf.Dashboard{}, nil
+						},
+						AddF: func(ctx context.Context, d chronograf.Dashboard) (chronograf.Dashboard, error) {
+							*added = append(*added, d.Name)
+							d.ID = 5
+							return d, nil
+						},
+					},
+				}
+			},
+			body: `{
+				"organizations": [{"id": "org", "name": "Bundle"}, {"id": "7", "name": "Existing", "defaultRole": "viewer"}],
+				"sources": [{"id": "1", "name": "influx", "url": "http://localhost:8086", "organization": "org"}],
+				"kapacitors": [{"id": "1", "srcId": "1", "name": "kapa", "url": "http://localhost:9092", "organization": "org"}],
+				"dashboards": [{"name": "dash", "organization": "org"}]
+			}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"links":{"self":"/chronograf/v1/admin/reconcile"},"dryRun":false,"summary":{"created":4,"updated":0,"unchanged":1,"failed":0},"results":[{"kind":"organization","name":"Bundle","id":"42","action":"create"},{"kind":"organization","name":"Existing","id":"7","action":"unchanged"},{"kind":"source","name":"influx","id":"9","action":"create"},{"kind":"kapacitor","name":"kapa","id":"3","action":"create"},{"kind":"dashboard","name":"dash","id":"5","action":"create"}]}`,
+			wantAdded:  []string{"Bundle", "influx", "kapa", "dash"},
+		},
+		{
+			name: "dry run reports changes without writing",
+			fields: func(added *[]string) fields {
+				return fields{
+					OrganizationsStore: orgs(added),
+					SourcesStore: &mocks.SourcesStore{
+						AllF: func(ctx context.Context) ([]chronograf.Source, error) {
+							return []chronograf.Source{
+								{ID: 2, Name: "influx", URL: "http://old:8086", Organization: "0"},
+							}, nil
+						},
+					},
+				}
+			},
+			query: "?dryRun=true",
+			body: `{
+				"organizations": [{"name": "Existing", "defaultRole": "admin"}],
+				"sources": [{"name": "influx", "url": "http://new:8086"}, {"name": "nourl"}]
+			}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"links":{"self":"/chronograf/v1/admin/reconcile"},"dryRun":true,"summary":{"created":0,"updated":2,"unchanged":0,"failed":1},"results":[{"kind":"organization","name":"Existing","id":"7","action":"update"},{"kind":"source","name":"influx","id":"2","action":"update"},{"kind":"source","name":"nourl","action":"failed","error":"name and url required"}]}`,
+		},
+		{
+			name: "matches dashboards with a slug by their slug",
+			fields: func(added *[]string) fields {
+				return fields{
+					OrganizationsStore: orgs(added),
+					DashboardsStore: &mocks.DashboardsStore{
+						AllF: func(ctx context.Context) ([]chronograf.Dashboard, error) {
+							return []chronograf.Dashboard{
+								{ID: 4, Name: "Hosts", Organization: "0"},
+								{ID: 5, Name: "Old hosts", Slug: "hosts", Organization: "0"},
+							}, nil
+						},
+					},
+				}
+			},
+			query:      "?dryRun=true",
+			body:       `{"dashboards": [{"name": "Hosts", "slug": "hosts"}]}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"links":{"self":"/chronograf/v1/admin/reconcile"},"dryRun":true,"summary":{"created":0,"updated":1,"unchanged":0,"failed":0},"results":[{"kind":"dashboard","name":"Hosts","id":"5","action":"update"}]}`,
+		},
+		{
+			name: "invalid dryRun parameter",
+			fields: func(added *[]string) fields {
+				return fields{}
+			},
+			query:      "?dryRun=maybe",
+			body:       `{}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "invalid JSON",
+			fields: func(added *[]string) fields {
+				return fields{}
+			},
+			body:       `{`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"code":400,"message":"unparsable JSON","type":"urn:chronograf:problem:invalid-request","title":"Bad Request","status":400,"detail":"unparsable JSON"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var added []string
+			f := tt.fields(&added)
+			s := &Service{
+				Store: &mocks.Store{
+					OrganizationsStore: f.OrganizationsStore,
+					SourcesStore:       f.SourcesStore,
+					ServersStore:       f.ServersStore,
+					DashboardsStore:    f.DashboardsStore,
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url"+tt.query, bytes.NewBufferString(tt.body))
+			s.Reconcile(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("%q. Reconcile() = %v, want %v", tt.name, resp.StatusCode, tt.wantStatus)
+			}
+			if eq, _ := jsonEqual(string(body), tt.wantBody); tt.wantBody != "" && !eq {
+				t.Errorf("%q. Reconcile() = \n***%v***\n,\nwant\n***%v***", tt.name, string(body), tt.wantBody)
+			}
+			if len(added) != len(tt.wantAdded) {
+				t.Errorf("%q. Reconcile() added %v, want %v", tt.name, added, tt.wantAdded)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+
+	clog "github.com/influxdata/influxdb/chronograf/log"
+	"github.com/influxdata/influxdb/chronograf/metrics"
+)
+
+// reloadableHandler is an http.Handler whose routes are replaced when the
+// options are reloaded, requests in flight finishing with the previous ones
+type reloadableHandler struct {
+	handler atomic.Value
+}
+
+// handlerBox keeps the concrete type stored in the atomic.Value the same
+type handlerBox struct {
+	http.Handler
+}
+
+func (h *reloadableHandler) store(next http.Handler) {
+	h.handler.Store(handlerBox{next})
+}
+
+func (h *reloadableHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.handler.Load().(handlerBox).ServeHTTP(w, r)
+}
+
+// keypair is the TLS certificate of the listener, which is loaded again
+// from its files when the options are reloaded so that renewed certificates
+// are served without a restart
+type keypair struct {
+	mu   sync.RWMutex
+	cert *tls.Certificate
+}
+
+func (k *keypair) load(certFile, keyFile string) error {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return err
+	}
+	k.set(&cert)
+	return nil
+}
+
+func (k *keypair) set(cert *tls.Certificate) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.cert = cert
+}
+
+// GetCertificate returns the last certificate loaded to the TLS handshakes
+func (k *keypair) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.cert, nil
+}
+
+// reload reads the options again and applies the TLS certificate, auth
+// providers and log level of the new options. Sessions are kept as the
+// token secret signing them is not reloaded. The options are kept unchanged
+// when any of the new ones is invalid.
+func (s *Server) reload(ctx context.Context, logger *clog.Logger, h *reloadableHandler, service Service, m *metrics.Metrics) error {
+	next := *s
+	if s.ReloadOptions != nil {
+		if err := s.ReloadOptions(&next); err != nil {
+			return err
+		}
+	}
+	if err := next.Validate(); err != nil {
+		return err
+	}
+	if next.TokenSecret != s.TokenSecret {
+		return fmt.Errorf("token-secret cannot be reloaded without dropping the sessions")
+	}
+
+	var cert tls.Certificate
+	if s.keypair != nil {
+		if next.Key == "" {
+			next.Key = next.Cert
+		}
+		var err error
+		if cert, err = tls.LoadX509KeyPair(string(next.Cert), string(next.Key)); err != nil {
+			return err
+		}
+	}
+	handler, err := next.newHandler(ctx, logger, service, m)
+	if err != nil {
+		return err
+	}
+
+	h.store(handler)
+	if s.keypair != nil {
+		s.keypair.set(&cert)
+	}
+	logger.SetLevel(clog.ParseLevel(next.LogLevel))
+	return nil
+}
@@ -0,0 +1,59 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/influxdata/influxdb/chronograf"
+	clog "github.com/influxdata/influxdb/chronograf/log"
+)
+
+func TestReloadableHandler(t *testing.T) {
+	h := &reloadableHandler{}
+	h.store(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	}))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "http://any.url", nil))
+	if w.Code != http.StatusTeapot {
+		t.Errorf("reloadableHandler status = %d, want %d", w.Code, http.StatusTeapot)
+	}
+
+	h.store(http.NotFoundHandler())
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "http://any.url", nil))
+	if w.Code != http.StatusNotFound {
+		t.Errorf("reloaded reloadableHandler status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestServer_reload(t *testing.T) {
+	var buf bytes.Buffer
+	logger := clog.New(&buf, clog.LogfmtFormat, clog.InfoLevel)
+	h := &reloadableHandler{}
+	h.store(http.NotFoundHandler())
+
+	s := &Server{
+		TokenSecret: "secret",
+		LogLevel:    "info",
+		ReloadOptions: func(s *Server) error {
+			s.TokenSecret = "rotated"
+			s.LogLevel = "debug"
+			return nil
+		},
+	}
+	err := s.reload(context.Background(), logger, h, Service{Logger: &chronograf.NoopLogger{}}, nil)
+	if err == nil || !strings.Contains(err.Error(), "token-secret") {
+		t.Fatalf("reload() of the token secret error = %v, want token-secret error", err)
+	}
+
+	// Options are kept when a reload fails
+	logger.Debug("still info")
+	if buf.Len() != 0 {
+		t.Errorf("failed reload() changed the log level")
+	}
+}
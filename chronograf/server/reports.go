@@ -0,0 +1,449 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"strconv"
+	"time"
+
+	"github.com/bouk/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/organizations"
+	"github.com/influxdata/influxdb/chronograf/reports"
+)
+
+// minReportInterval is the shortest time between the runs of a report. The
+// scheduler checks for due reports as often.
+const minReportInterval = time.Minute
+
+type reportLinks struct {
+	Self      string `json:"self"`      // Self link mapping to this resource
+	Run       string `json:"run"`       // Run link delivering the report immediately
+	Dashboard string `json:"dashboard"` // Dashboard link to the dashboard summarized by the report
+}
+
+type reportResponse struct {
+	ID           string                 `json:"id"`
+	Name         string                 `json:"name"`
+	DashboardID  chronograf.DashboardID `json:"dashboardID"`
+	Source       string                 `json:"source"`
+	Start        time.Time              `json:"start"`
+	Every        string                 `json:"every"`
+	Range        string                 `json:"range"`
+	Recipients   []string               `json:"recipients"`
+	Format       string                 `json:"format"`
+	Organization string                 `json:"organization"`
+	NextRun      *time.Time             `json:"nextRun,omitempty"` // NextRun is absent for reports that do not run again
+	LastRun      *time.Time             `json:"lastRun,omitempty"` // LastRun is absent for reports that have not run yet
+	LastError    string                 `json:"lastError,omitempty"`
+	Links        reportLinks            `json:"links"`
+}
+
+func newReportResponse(r chronograf.Report) *reportResponse {
+	res := &reportResponse{
+		ID:           r.ID,
+		Name:         r.Name,
+		DashboardID:  r.DashboardID,
+		Source:       r.Source,
+		Start:        r.Start,
+		Every:        r.Every.String(),
+		Range:        r.Range.String(),
+		Recipients:   r.Recipients,
+		Format:       r.Format,
+		Organization: r.Organization,
+		LastError:    r.LastError,
+		Links: reportLinks{
+			Self:      fmt.Sprintf("/chronograf/v1/reports/%s", r.ID),
+			Run:       fmt.Sprintf("/chronograf/v1/reports/%s/run", r.ID),
+			Dashboard: fmt.Sprintf("/chronograf/v1/dashboards/%d", r.DashboardID),
+		},
+	}
+	if res.Recipients == nil {
+		res.Recipients = []string{}
+	}
+	if !r.NextRun.IsZero() {
+		next := r.NextRun
+		res.NextRun = &next
+	}
+	if !r.LastRun.IsZero() {
+		last := r.LastRun
+		res.LastRun = &last
+	}
+	return res
+}
+
+type reportsResponse struct {
+	Links   selfLinks         `json:"links"`
+	Reports []*reportResponse `json:"reports"`
+}
+
+func newReportsResponse(rs []chronograf.Report) *reportsResponse {
+	res := make([]*reportResponse, len(rs))
+	for i, r := range rs {
+		res[i] = newReportResponse(r)
+	}
+	return &reportsResponse{
+		Links: selfLinks{
+			Self: "/chronograf/v1/reports",
+		},
+		Reports: res,
+	}
+}
+
+type reportRequest struct {
+	Name        *string                 `json:"name"`
+	DashboardID *chronograf.DashboardID `json:"dashboardID"`
+	Source      *string                 `json:"source"` // Source is the ID of the source of queries without one
+	Start       *time.Time              `json:"start"`  // Start is the time of the first run; it defaults to now
+	Every       *string                 `json:"every"`  // Every is a duration such as 168h
+	Range       *string                 `json:"range"`  // Range is the duration queried before each run; it defaults to Every
+	Recipients  []string                `json:"recipients"`
+	Format      *string                 `json:"format"` // Format is either html or png; it defaults to html
+}
+
+// apply copies the fields of the request that are set into r
+func (req *reportRequest) apply(r *chronograf.Report) error {
+	if req.Name != nil {
+		r.Name = *req.Name
+	}
+	if req.DashboardID != nil {
+		r.DashboardID = *req.DashboardID
+	}
+	if req.Source != nil {
+		r.Source = *req.Source
+	}
+	if req.Start != nil {
+		r.Start = req.Start.UTC()
+	}
+	if req.Every != nil {
+		d, err := time.ParseDuration(*req.Every)
+		if err != nil {
+			return errorf("invalid every %q: %v", *req.Every, err)
+		}
+		r.Every = d
+	}
+	if req.Range != nil {
+		d, err := time.ParseDuration(*req.Range)
+		if err != nil {
+			return errorf("invalid range %q: %v", *req.Range, err)
+		}
+		r.Range = d
+	}
+	if req.Recipients != nil {
+		r.Recipients = req.Recipients
+	}
+	if req.Format != nil {
+		r.Format = *req.Format
+	}
+	return nil
+}
+
+// validReport checks the schedule, recipients and format of a report and
+// that its dashboard and source exist within the current organization
+func (s *Service) validReport(ctx context.Context, r *chronograf.Report) error {
+	if r.Name == "" {
+		return errorf("name required on Chronograf Report request body")
+	}
+	if r.Every < minReportInterval {
+		return errorf("every must be at least %s", minReportInterval)
+	}
+	if r.Range <= 0 {
+		return errorf("range must be positive")
+	}
+	if len(r.Recipients) == 0 {
+		return errorf("at least one recipient is required")
+	}
+	for _, to := range r.Recipients {
+		if _, err := mail.ParseAddress(to); err != nil {
+			return errorf("invalid recipient %q", to)
+		}
+	}
+	switch r.Format {
+	case chronograf.ReportFormatHTML, chronograf.ReportFormatPNG:
+	default:
+		return errorf("unknown format %s. Valid formats are 'html' and 'png'", r.Format)
+	}
+	if _, err := s.Store.Dashboards(ctx).Get(ctx, r.DashboardID); err != nil {
+		return errorf("dashboard %d not found", r.DashboardID)
+	}
+	if r.Source != "" {
+		id, err := strconv.Atoi(r.Source)
+		if err != nil {
+			return errorf("invalid source ID %q", r.Source)
+		}
+		if _, err := s.Store.Sources(ctx).Get(ctx, id); err != nil {
+			return errorf("source %d not found", id)
+		}
+	}
+	return nil
+}
+
+// Reports lists the reports of the current organization
+func (s *Service) Reports(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	rs, err := s.Store.Reports(ctx).All(ctx)
+	if err != nil {
+		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+		return
+	}
+
+	encodeJSON(w, http.StatusOK, newReportsResponse(rs), s.Logger)
+}
+
+// ReportID returns a single report
+func (s *Service) ReportID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := httprouter.GetParamFromContext(ctx, "id")
+	report, err := s.Store.Reports(ctx).Get(ctx, id)
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+
+	encodeJSON(w, http.StatusOK, newReportResponse(*report), s.Logger)
+}
+
+// NewReport schedules a report of a dashboard within the current organization
+func (s *Service) NewReport(w http.ResponseWriter, r *http.Request) {
+	var req reportRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+
+	now := time.Now().UTC()
+	report := &chronograf.Report{
+		Start:  now.Truncate(time.Minute),
+		Format: chronograf.ReportFormatHTML,
+	}
+	if err := req.apply(report); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	if req.Range == nil {
+		report.Range = report.Every
+	}
+
+	ctx := r.Context()
+	if err := s.validReport(ctx, report); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	report.NextRun = reports.Next(report.Start, report.Every, now)
+
+	report, err := s.Store.Reports(ctx).Add(ctx, report)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	res := newReportResponse(*report)
+	location(w, res.Links.Self)
+	encodeJSON(w, http.StatusCreated, res, s.Logger)
+}
+
+// UpdateReport changes the dashboard, schedule, recipients or format of a
+// report. Changes of the schedule move the next run accordingly.
+func (s *Service) UpdateReport(w http.ResponseWriter, r *http.Request) {
+	var req reportRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	id := httprouter.GetParamFromContext(ctx, "id")
+	report, err := s.Store.Reports(ctx).Get(ctx, id)
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+
+	if err := req.apply(report); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	if err := s.validReport(ctx, report); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	if req.Start != nil || req.Every != nil {
+		report.NextRun = reports.Next(report.Start, report.Every, time.Now().UTC())
+	}
+
+	if err := s.Store.Reports(ctx).Update(ctx, report); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	encodeJSON(w, http.StatusOK, newReportResponse(*report), s.Logger)
+}
+
+// RemoveReport deletes a report
+func (s *Service) RemoveReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := httprouter.GetParamFromContext(ctx, "id")
+	report, err := s.Store.Reports(ctx).Get(ctx, id)
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+
+	if err := s.Store.Reports(ctx).Delete(ctx, report); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RunReport delivers a report immediately without changing its schedule
+func (s *Service) RunReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := httprouter.GetParamFromContext(ctx, "id")
+	report, err := s.Store.Reports(ctx).Get(ctx, id)
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+
+	if err := s.runReport(ctx, report, time.Now().UTC()); err != nil {
+		Error(w, http.StatusBadGateway, fmt.Sprintf("unable to deliver report %s: %v", report.ID, err), s.Logger)
+		return
+	}
+
+	encodeJSON(w, http.StatusOK, newReportResponse(*report), s.Logger)
+}
+
+// RunReports delivers due reports of every organization every interval until
+// ctx is done
+func (s *Service) RunReports(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.runDueReports(ctx, now.UTC())
+		}
+	}
+}
+
+// runDueReports delivers the reports whose next run is not after now and
+// schedules their next runs. Failures are recorded as the last error of
+// their reports.
+func (s *Service) runDueReports(ctx context.Context, now time.Time) {
+	serverCtx := serverContext(ctx)
+	all, err := s.Store.Reports(serverCtx).All(serverCtx)
+	if err != nil {
+		s.Logger.Error("Unable to list reports: ", err)
+		return
+	}
+	for i := range all {
+		report := &all[i]
+		if report.NextRun.IsZero() || report.NextRun.After(now) {
+			continue
+		}
+		report.NextRun = reports.Next(report.Start, report.Every, now)
+		orgCtx := context.WithValue(ctx, organizations.ContextKey, report.Organization)
+		if err := s.runReport(orgCtx, report, now); err != nil {
+			s.Logger.Error("Unable to deliver report ", report.ID, ": ", err)
+		}
+	}
+}
+
+// runReport delivers a report and records the run. The dashboard and sources
+// of the report are read within the organization of ctx.
+func (s *Service) runReport(ctx context.Context, report *chronograf.Report, now time.Time) error {
+	err := s.deliverReport(ctx, report, now)
+	report.LastRun = now
+	report.LastError = ""
+	if err != nil {
+		report.LastError = err.Error()
+	}
+	if uerr := s.Store.Reports(ctx).Update(ctx, report); uerr != nil {
+		s.Logger.Error("Unable to record run of report ", report.ID, ": ", uerr)
+	}
+	return err
+}
+
+// deliverReport queries the cells of the dashboard of a report over the range
+// of the report before now and mails the summary to its recipients. Queries
+// that fail are reported within the summary rather than failing the report.
+func (s *Service) deliverReport(ctx context.Context, report *chronograf.Report, now time.Time) error {
+	if s.Mailer == nil {
+		return fmt.Errorf("no SMTP server is configured")
+	}
+	d, err := s.Store.Dashboards(ctx).Get(ctx, report.DashboardID)
+	if err != nil {
+		return fmt.Errorf("dashboard %d not found", report.DashboardID)
+	}
+
+	upper := now.UTC()
+	lower := upper.Add(-report.Range)
+	defaultSource := ""
+	if report.Source != "" {
+		defaultSource = sourceLinkPrefix + report.Source
+	}
+
+	doc := reports.Document{
+		Title:  fmt.Sprintf("%s: %s", report.Name, d.Name),
+		Lower:  lower,
+		Upper:  upper,
+		Panels: []reports.Panel{},
+	}
+	values := snapshotTemplateValues(d.Templates, nil)
+	for _, c := range d.Cells {
+		title := c.Name
+		if title == "" {
+			title = "Cell " + c.ID
+		}
+		for _, q := range c.Queries {
+			panel := reports.Panel{
+				Title: title,
+			}
+			var err error
+			panel.Query, panel.Series, err = s.querySeries(ctx, q, lower, upper, values, defaultSource)
+			if err != nil {
+				panel.Error = err.Error()
+			}
+			doc.Panels = append(doc.Panels, panel)
+		}
+	}
+
+	html, images, err := reports.Render(doc, report.Format == chronograf.ReportFormatPNG)
+	if err != nil {
+		return err
+	}
+	return s.Mailer.Send(reports.Message{
+		To:      report.Recipients,
+		Subject: doc.Title,
+		HTML:    html,
+		Images:  images,
+	})
+}
+
+// querySeries runs a cell query over lower to upper against its source or,
+// without one, against defaultSource. It returns the query as run along with
+// the series of its result.
+func (s *Service) querySeries(ctx context.Context, q chronograf.DashboardQuery, lower, upper time.Time, values map[string]string, defaultSource string) (string, []reports.Series, error) {
+	command := renderSnapshotQuery(q.Command, lower, upper, values)
+	source := q.Source
+	if source == "" {
+		source = defaultSource
+	}
+	data, err := s.snapshotQuery(ctx, source, chronograf.Query{
+		Command: command,
+		DB:      q.QueryConfig.Database,
+		RP:      q.QueryConfig.RetentionPolicy,
+		Epoch:   "ms",
+	})
+	if err != nil {
+		return command, nil, err
+	}
+	series, err := reports.ParseResult(data)
+	return command, series, err
+}
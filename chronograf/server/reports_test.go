@@ -0,0 +1,220 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+	"github.com/influxdata/influxdb/chronograf/reports"
+)
+
+// mailer records the messages it is asked to send
+type mailer struct {
+	sent []reports.Message
+}
+
+func (m *mailer) Send(msg reports.Message) error {
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+func newReportsService(m reports.Mailer, stored *[]chronograf.Report) *Service {
+	return &Service{
+		Store: &mocks.Store{
+			DashboardsStore: &mocks.DashboardsStore{
+				GetF: func(ctx context.Context, id chronograf.DashboardID) (chronograf.Dashboard, error) {
+					if id != 1 {
+						return chronograf.Dashboard{}, chronograf.ErrDashboardNotFound
+					}
+					return chronograf.Dashboard{
+						ID:   1,
+						Name: "Capacity",
+						Cells: []chronograf.DashboardCell{
+							{
+								ID:   "a",
+								Name: "Disk",
+								Queries: []chronograf.DashboardQuery{
+									{
+										Command:     `SELECT max("used_percent") FROM disk WHERE time > :dashboardTime:`,
+										QueryConfig: chronograf.QueryConfig{Database: "telegraf"},
+									},
+								},
+							},
+						},
+					}, nil
+				},
+			},
+			SourcesStore: &mocks.SourcesStore{
+				GetF: func(ctx context.Context, id int) (chronograf.Source, error) {
+					if id != 1 {
+						return chronograf.Source{}, chronograf.ErrSourceNotFound
+					}
+					return chronograf.Source{ID: 1}, nil
+				},
+			},
+			ReportsStore: &mocks.ReportsStore{
+				AllF: func(ctx context.Context) ([]chronograf.Report, error) {
+					return append([]chronograf.Report{}, *stored...), nil
+				},
+				AddF: func(ctx context.Context, r *chronograf.Report) (*chronograf.Report, error) {
+					r.ID = "1"
+					*stored = append(*stored, *r)
+					return r, nil
+				},
+				UpdateF: func(ctx context.Context, r *chronograf.Report) error {
+					for i := range *stored {
+						if (*stored)[i].ID == r.ID {
+							(*stored)[i] = *r
+						}
+					}
+					return nil
+				},
+			},
+		},
+		TimeSeriesClient: &mocks.TimeSeries{
+			ConnectF: func(context.Context, *chronograf.Source) error {
+				return nil
+			},
+			QueryF: func(ctx context.Context, q chronograf.Query) (chronograf.Response, error) {
+				return mocks.NewResponse(`{"results":[{"statement_id":0,"series":[{"name":"disk","columns":["time","max"],"values":[[1514797200000,61.5]]}]}]}`, nil), nil
+			},
+		},
+		Mailer: m,
+		Logger: &chronograf.NoopLogger{},
+	}
+}
+
+func TestService_NewReport(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantErr    string
+	}{
+		{
+			name:       "Weekly report",
+			body:       `{"name":"Weekly capacity","dashboardID":1,"source":"1","start":"2018-01-01T09:00:00Z","every":"168h","recipients":["ops@example.com"]}`,
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "Too frequent",
+			body:       `{"name":"Capacity","dashboardID":1,"every":"10s","recipients":["ops@example.com"]}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantErr:    "every must be at least 1m0s",
+		},
+		{
+			name:       "Invalid recipient",
+			body:       `{"name":"Capacity","dashboardID":1,"every":"24h","recipients":["ops"]}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantErr:    `invalid recipient \"ops\"`,
+		},
+		{
+			name:       "Unknown format",
+			body:       `{"name":"Capacity","dashboardID":1,"every":"24h","recipients":["ops@example.com"],"format":"pdf"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantErr:    "unknown format pdf",
+		},
+		{
+			name:       "Unknown dashboard",
+			body:       `{"name":"Capacity","dashboardID":2,"every":"24h","recipients":["ops@example.com"]}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantErr:    "dashboard 2 not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stored := []chronograf.Report{}
+			s := newReportsService(&mailer{}, &stored)
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url/chronograf/v1/reports", bytes.NewBufferString(tt.body))
+
+			s.NewReport(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("%q. NewReport() = %v, want %v: %s", tt.name, resp.StatusCode, tt.wantStatus, body)
+			}
+			if tt.wantErr != "" {
+				if !strings.Contains(string(body), tt.wantErr) {
+					t.Errorf("%q. NewReport() = %s, want error %s", tt.name, body, tt.wantErr)
+				}
+				return
+			}
+			if len(stored) != 1 {
+				t.Fatalf("%q. NewReport() stored %d reports, want 1", tt.name, len(stored))
+			}
+			got := stored[0]
+			if got.Range != 168*time.Hour || got.Format != chronograf.ReportFormatHTML {
+				t.Errorf("%q. NewReport() did not default the range and format: %#v", tt.name, got)
+			}
+			if got.NextRun.Before(time.Now()) || got.NextRun.Sub(got.Start)%got.Every != 0 {
+				t.Errorf("%q. NewReport() next run %v is not a future run of the schedule", tt.name, got.NextRun)
+			}
+			if !strings.Contains(string(body), `"every":"168h0m0s"`) {
+				t.Errorf("%q. NewReport() = %s", tt.name, body)
+			}
+		})
+	}
+}
+
+func TestService_runDueReports(t *testing.T) {
+	now := time.Date(2018, 1, 8, 9, 0, 30, 0, time.UTC)
+	start := time.Date(2018, 1, 1, 9, 0, 0, 0, time.UTC)
+	stored := []chronograf.Report{
+		{
+			ID:           "1",
+			Name:         "Weekly capacity",
+			DashboardID:  1,
+			Source:       "1",
+			Start:        start,
+			Every:        7 * 24 * time.Hour,
+			Range:        7 * 24 * time.Hour,
+			Recipients:   []string{"ops@example.com"},
+			Format:       chronograf.ReportFormatPNG,
+			Organization: "default",
+			NextRun:      start.Add(7 * 24 * time.Hour),
+		},
+		{
+			ID:           "2",
+			Name:         "Not due",
+			DashboardID:  1,
+			Start:        start,
+			Every:        7 * 24 * time.Hour,
+			Range:        time.Hour,
+			Recipients:   []string{"dev@example.com"},
+			Format:       chronograf.ReportFormatHTML,
+			Organization: "default",
+			NextRun:      now.Add(time.Minute),
+		},
+	}
+	m := &mailer{}
+	s := newReportsService(m, &stored)
+
+	s.runDueReports(context.Background(), now)
+
+	if len(m.sent) != 1 {
+		t.Fatalf("runDueReports() sent %d messages, want 1", len(m.sent))
+	}
+	msg := m.sent[0]
+	if msg.Subject != "Weekly capacity: Capacity" || len(msg.To) != 1 || msg.To[0] != "ops@example.com" {
+		t.Errorf("runDueReports() sent %q to %v", msg.Subject, msg.To)
+	}
+	if !bytes.Contains(msg.HTML, []byte("<td>disk max</td><td>61.5</td>")) || len(msg.Images) != 1 {
+		t.Errorf("runDueReports() sent %s with %d images", msg.HTML, len(msg.Images))
+	}
+	if got := stored[0]; !got.LastRun.Equal(now) || !got.NextRun.Equal(start.Add(14*24*time.Hour)) || got.LastError != "" {
+		t.Errorf("runDueReports() recorded the run as %#v", got)
+	}
+	if got := stored[1]; !got.LastRun.IsZero() {
+		t.Errorf("runDueReports() ran a report that is not due")
+	}
+}
@@ -92,8 +92,9 @@ func (a *AllRoutes) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		DashboardsV2:  "/chronograf/v2/dashboards",
 		Cells:         "/chronograf/v2/cells",
 		Config: getConfigLinksResponse{
-			Self: "/chronograf/v1/config",
-			Auth: "/chronograf/v1/config/auth",
+			Self:   "/chronograf/v1/config",
+			Auth:   "/chronograf/v1/config/auth",
+			Server: "/chronograf/v1/config/server",
 		},
 		OrganizationConfig: getOrganizationConfigLinksResponse{
 			Self:      "/chronograf/v1/org_config",
@@ -0,0 +1,348 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/bouk/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/checks"
+)
+
+// ruleTemplatesPath is the path of the rule templates of the current
+// organization
+const ruleTemplatesPath = "/chronograf/v1/rule-templates"
+
+type ruleTemplateLinks struct {
+	Self   string `json:"self"`   // Self link mapping to this resource
+	Checks string `json:"checks"` // Checks link to the checks instantiated from the template
+}
+
+type ruleTemplateResponse struct {
+	ID           string                             `json:"id"`
+	Name         string                             `json:"name"`
+	Description  string                             `json:"description"`
+	Params       []chronograf.RuleTemplateParam     `json:"params"`
+	CheckName    string                             `json:"checkName"`
+	Query        string                             `json:"query"`
+	DB           string                             `json:"db"`
+	RP           string                             `json:"rp"`
+	Every        string                             `json:"every"`
+	Operator     string                             `json:"operator"`
+	Thresholds   []chronograf.RuleTemplateThreshold `json:"thresholds"`
+	Message      string                             `json:"message"`
+	Organization string                             `json:"organization"`
+	Links        ruleTemplateLinks                  `json:"links"`
+}
+
+func newRuleTemplateResponse(t chronograf.RuleTemplate) *ruleTemplateResponse {
+	self := fmt.Sprintf("%s/%s", ruleTemplatesPath, t.ID)
+	res := &ruleTemplateResponse{
+		ID:           t.ID,
+		Name:         t.Name,
+		Description:  t.Description,
+		Params:       t.Params,
+		CheckName:    t.CheckName,
+		Query:        t.Query,
+		DB:           t.DB,
+		RP:           t.RP,
+		Every:        t.Every.String(),
+		Operator:     t.Operator,
+		Thresholds:   t.Thresholds,
+		Message:      t.Message,
+		Organization: t.Organization,
+		Links: ruleTemplateLinks{
+			Self:   self,
+			Checks: self + "/checks",
+		},
+	}
+	if res.Params == nil {
+		res.Params = []chronograf.RuleTemplateParam{}
+	}
+	if res.Thresholds == nil {
+		res.Thresholds = []chronograf.RuleTemplateThreshold{}
+	}
+	return res
+}
+
+type ruleTemplatesResponse struct {
+	Links     selfLinks               `json:"links"`
+	Templates []*ruleTemplateResponse `json:"templates"`
+}
+
+// ruleTemplateRequest creates or updates a rule template. Fields left out of
+// an update keep their value.
+type ruleTemplateRequest struct {
+	Name        *string                             `json:"name"`
+	Description *string                             `json:"description"`
+	Params      *[]chronograf.RuleTemplateParam     `json:"params"`
+	CheckName   *string                             `json:"checkName"` // CheckName is the name of the instances, such as high_cpu_:host:
+	Query       *string                             `json:"query"`
+	DB          *string                             `json:"db"`
+	RP          *string                             `json:"rp"`
+	Every       *string                             `json:"every"`    // Every is a duration such as 1m
+	Operator    *string                             `json:"operator"` // Operator is one of >, >=, <, <=, == or !=
+	Thresholds  *[]chronograf.RuleTemplateThreshold `json:"thresholds"`
+	Message     *string                             `json:"message"`
+}
+
+// apply sets the fields of the request on a rule template
+func (req *ruleTemplateRequest) apply(t *chronograf.RuleTemplate) error {
+	if req.Name != nil {
+		t.Name = *req.Name
+	}
+	if req.Description != nil {
+		t.Description = *req.Description
+	}
+	if req.Params != nil {
+		t.Params = *req.Params
+	}
+	if req.CheckName != nil {
+		t.CheckName = *req.CheckName
+	}
+	if req.Query != nil {
+		t.Query = *req.Query
+	}
+	if req.DB != nil {
+		t.DB = *req.DB
+	}
+	if req.RP != nil {
+		t.RP = *req.RP
+	}
+	if req.Every != nil {
+		d, err := time.ParseDuration(*req.Every)
+		if err != nil {
+			return errorf("invalid every %q: %v", *req.Every, err)
+		}
+		t.Every = d
+	}
+	if req.Operator != nil {
+		t.Operator = *req.Operator
+	}
+	if req.Thresholds != nil {
+		t.Thresholds = *req.Thresholds
+	}
+	if req.Message != nil {
+		t.Message = *req.Message
+	}
+	return nil
+}
+
+// applyRuleTemplate sets the fields of an instance of a rule template from
+// the template. Renamed instances forget the levels of their series, whose
+// alert IDs are prefixed by the name.
+func applyRuleTemplate(t *chronograf.RuleTemplate, c *chronograf.AlertCheck) error {
+	name := c.Name
+	if err := checks.Instantiate(t, c.Params, c); err != nil {
+		return errorf("%v", err)
+	}
+	if c.Name != name {
+		c.States = map[string]string{}
+	}
+	return nil
+}
+
+// ruleTemplateInstances lists the checks instantiated from a rule template
+// among the sources of the current organization
+func (s *Service) ruleTemplateInstances(ctx context.Context, id string) ([]chronograf.AlertCheck, error) {
+	srcs, err := s.Store.Sources(ctx).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	instances := []chronograf.AlertCheck{}
+	for _, src := range srcs {
+		all, err := s.Store.AlertChecks(ctx).All(ctx, src.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range all {
+			if c.Template == id {
+				instances = append(instances, c)
+			}
+		}
+	}
+	return instances, nil
+}
+
+// RuleTemplates lists the rule templates of the current organization
+func (s *Service) RuleTemplates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	ts, err := s.Store.RuleTemplates(ctx).All(ctx)
+	if err != nil {
+		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+		return
+	}
+
+	res := &ruleTemplatesResponse{
+		Links: selfLinks{
+			Self: ruleTemplatesPath,
+		},
+		Templates: []*ruleTemplateResponse{},
+	}
+	for _, t := range ts {
+		res.Templates = append(res.Templates, newRuleTemplateResponse(t))
+	}
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// RuleTemplateID returns a single rule template
+func (s *Service) RuleTemplateID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := httprouter.GetParamFromContext(ctx, "id")
+	t, err := s.Store.RuleTemplates(ctx).Get(ctx, id)
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+
+	encodeJSON(w, http.StatusOK, newRuleTemplateResponse(*t), s.Logger)
+}
+
+// NewRuleTemplate creates a rule template within the current organization
+func (s *Service) NewRuleTemplate(w http.ResponseWriter, r *http.Request) {
+	var req ruleTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	t := &chronograf.RuleTemplate{}
+	if err := req.apply(t); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	if err := checks.ValidateTemplate(t); err != nil {
+		invalidData(w, errorf("%v", err), s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	t, err := s.Store.RuleTemplates(ctx).Add(ctx, t)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	res := newRuleTemplateResponse(*t)
+	location(w, res.Links.Self)
+	encodeJSON(w, http.StatusCreated, res, s.Logger)
+}
+
+// UpdateRuleTemplate changes the fields of a rule template given in the
+// request and updates the checks instantiated from it. The template is left
+// unchanged when any of its instances could not be instantiated anymore, such
+// as when a parameter without a default is added.
+func (s *Service) UpdateRuleTemplate(w http.ResponseWriter, r *http.Request) {
+	var req ruleTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	id := httprouter.GetParamFromContext(ctx, "id")
+	t, err := s.Store.RuleTemplates(ctx).Get(ctx, id)
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+	if err := req.apply(t); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	if err := checks.ValidateTemplate(t); err != nil {
+		invalidData(w, errorf("%v", err), s.Logger)
+		return
+	}
+
+	instances, err := s.ruleTemplateInstances(ctx, t.ID)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	for i := range instances {
+		c := &instances[i]
+		if err := applyRuleTemplate(t, c); err != nil {
+			invalidData(w, errorf("check %d of source %d: %v", c.ID, c.SourceID, err), s.Logger)
+			return
+		}
+	}
+
+	if err := s.Store.RuleTemplates(ctx).Update(ctx, t); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	now := time.Now().UTC()
+	for i := range instances {
+		c := &instances[i]
+		scheduleAlertCheck(c, now)
+		if err := s.Store.AlertChecks(ctx).Update(ctx, c); err != nil {
+			unknownErrorWithMessage(w, err, s.Logger)
+			return
+		}
+	}
+
+	encodeJSON(w, http.StatusOK, newRuleTemplateResponse(*t), s.Logger)
+}
+
+// RemoveRuleTemplate deletes a rule template. The checks instantiated from it
+// are kept as checks of their own.
+func (s *Service) RemoveRuleTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := httprouter.GetParamFromContext(ctx, "id")
+	t, err := s.Store.RuleTemplates(ctx).Get(ctx, id)
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+
+	instances, err := s.ruleTemplateInstances(ctx, t.ID)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	for i := range instances {
+		c := &instances[i]
+		c.Template = ""
+		c.Params = nil
+		if err := s.Store.AlertChecks(ctx).Update(ctx, c); err != nil {
+			unknownErrorWithMessage(w, err, s.Logger)
+			return
+		}
+	}
+	if err := s.Store.RuleTemplates(ctx).Delete(ctx, t); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RuleTemplateChecks lists the checks instantiated from a rule template
+// among the sources of the current organization
+func (s *Service) RuleTemplateChecks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := httprouter.GetParamFromContext(ctx, "id")
+	t, err := s.Store.RuleTemplates(ctx).Get(ctx, id)
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+	instances, err := s.ruleTemplateInstances(ctx, t.ID)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	res := alertChecksResponse{
+		Links: selfLinks{
+			Self: fmt.Sprintf("%s/%s/checks", ruleTemplatesPath, t.ID),
+		},
+		Checks: []alertCheckResponse{},
+	}
+	for _, c := range instances {
+		res.Checks = append(res.Checks, newAlertCheckResponse(c))
+	}
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
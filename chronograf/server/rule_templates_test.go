@@ -0,0 +1,170 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bouk/httprouter"
+	jhttprouter "github.com/influxdata/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+// ruleTemplatesStore keeps the rule templates of the tests in memory
+func ruleTemplatesStore(all *[]chronograf.RuleTemplate) *mocks.RuleTemplatesStore {
+	find := func(id string) int {
+		for i, t := range *all {
+			if t.ID == id {
+				return i
+			}
+		}
+		return -1
+	}
+	return &mocks.RuleTemplatesStore{
+		AllF: func(ctx context.Context) ([]chronograf.RuleTemplate, error) {
+			return append([]chronograf.RuleTemplate{}, *all...), nil
+		},
+		AddF: func(ctx context.Context, t *chronograf.RuleTemplate) (*chronograf.RuleTemplate, error) {
+			t.ID = "1"
+			*all = append(*all, *t)
+			return t, nil
+		},
+		GetF: func(ctx context.Context, id string) (*chronograf.RuleTemplate, error) {
+			i := find(id)
+			if i < 0 {
+				return nil, chronograf.ErrRuleTemplateNotFound
+			}
+			t := (*all)[i]
+			return &t, nil
+		},
+		UpdateF: func(ctx context.Context, t *chronograf.RuleTemplate) error {
+			i := find(t.ID)
+			if i < 0 {
+				return chronograf.ErrRuleTemplateNotFound
+			}
+			(*all)[i] = *t
+			return nil
+		},
+		DeleteF: func(ctx context.Context, t *chronograf.RuleTemplate) error {
+			i := find(t.ID)
+			if i < 0 {
+				return chronograf.ErrRuleTemplateNotFound
+			}
+			*all = append((*all)[:i], (*all)[i+1:]...)
+			return nil
+		},
+	}
+}
+
+func TestService_RuleTemplateWorkflow(t *testing.T) {
+	templates := []chronograf.RuleTemplate{}
+	checks := []chronograf.AlertCheck{}
+	s := &Service{
+		Store: &mocks.Store{
+			SourcesStore: &mocks.SourcesStore{
+				AllF: func(ctx context.Context) ([]chronograf.Source, error) {
+					return []chronograf.Source{{ID: 1}}, nil
+				},
+				GetF: func(ctx context.Context, ID int) (chronograf.Source, error) {
+					return chronograf.Source{ID: ID}, nil
+				},
+			},
+			RuleTemplatesStore: ruleTemplatesStore(&templates),
+			AlertChecksStore:   alertChecksStore(&checks),
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+	serveTemplate := func(h func(http.ResponseWriter, *http.Request), id, body string) (int, string) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "http://any.url", bytes.NewBufferString(body))
+		h(w, r.WithContext(httprouter.WithParams(r.Context(), httprouter.Params{{Key: "id", Value: id}})))
+		res, _ := ioutil.ReadAll(w.Result().Body)
+		return w.Code, string(res)
+	}
+	serveCheck := func(h func(http.ResponseWriter, *http.Request), cid, body string) (int, string) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "http://any.url", bytes.NewBufferString(body))
+		ctx := context.WithValue(r.Context(), jhttprouter.ParamsKey, jhttprouter.Params{
+			{Key: "id", Value: "1"},
+			{Key: "cid", Value: cid},
+		})
+		h(w, r.WithContext(ctx))
+		res, _ := ioutil.ReadAll(w.Result().Body)
+		return w.Code, string(res)
+	}
+
+	code, body := serveTemplate(s.NewRuleTemplate, "", `{"name":"High CPU","params":[{"name":"host"},{"name":"threshold","default":"90"}],"checkName":"high_cpu_:host:","query":"SELECT mean(\"usage_user\") FROM \"cpu\" WHERE \"host\" = ':host:' AND time > now() - 5m","db":"telegraf","every":"1m","operator":">","thresholds":[{"level":"CRITICAL","value":":threshold:"}]}`)
+	if code != http.StatusCreated {
+		t.Fatalf("NewRuleTemplate() = %v, want %v: %s", code, http.StatusCreated, body)
+	}
+	if code, body := serveTemplate(s.NewRuleTemplate, "", `{"name":"Bad","params":[{"name":"host name"}],"checkName":"bad","query":"SELECT 1","every":"1m","operator":">","thresholds":[{"level":"CRITICAL","value":"1"}]}`); code != http.StatusUnprocessableEntity {
+		t.Errorf("NewRuleTemplate() with invalid parameter = %v: %s", code, body)
+	}
+
+	invalid := []struct {
+		name string
+		body string
+	}{
+		{"unknown template", `{"template":"2","params":{"host":"a"}}`},
+		{"missing parameter", `{"template":"1"}`},
+		{"field set by template", `{"template":"1","params":{"host":"a"},"query":"SELECT 1"}`},
+	}
+	for _, tt := range invalid {
+		if code, body := serveCheck(s.NewAlertCheck, "", tt.body); code != http.StatusUnprocessableEntity {
+			t.Errorf("NewAlertCheck() %s = %v, want %v: %s", tt.name, code, http.StatusUnprocessableEntity, body)
+		}
+	}
+	code, body = serveCheck(s.NewAlertCheck, "", `{"template":"1","params":{"host":"a"},"enabled":true}`)
+	if code != http.StatusCreated {
+		t.Fatalf("NewAlertCheck() of template = %v: %s", code, body)
+	}
+	if c := checks[0]; c.Name != "high_cpu_a" || c.Thresholds[0].Value != 90 || c.Template != "1" {
+		t.Errorf("NewAlertCheck() of template created %+v", c)
+	}
+
+	// Updates of the template propagate to its instances
+	checks[0].States = map[string]string{"high_cpu_a": "CRITICAL"}
+	if code, body := serveTemplate(s.UpdateRuleTemplate, "1", `{"params":[{"name":"host"},{"name":"threshold","default":"95"}]}`); code != http.StatusOK {
+		t.Fatalf("UpdateRuleTemplate() = %v: %s", code, body)
+	}
+	if c := checks[0]; c.Thresholds[0].Value != 95 || len(c.States) != 1 {
+		t.Errorf("UpdateRuleTemplate() updated instance to %+v", c)
+	}
+	if code, body := serveTemplate(s.UpdateRuleTemplate, "1", `{"checkName":"cpu_:host:"}`); code != http.StatusOK {
+		t.Fatalf("UpdateRuleTemplate() = %v: %s", code, body)
+	}
+	if c := checks[0]; c.Name != "cpu_a" || len(c.States) != 0 {
+		t.Errorf("UpdateRuleTemplate() renamed instance to %+v", c)
+	}
+	if code, body := serveTemplate(s.UpdateRuleTemplate, "1", `{"params":[{"name":"host"},{"name":"threshold","default":"95"},{"name":"region"}]}`); code != http.StatusUnprocessableEntity {
+		t.Errorf("UpdateRuleTemplate() adding a parameter the instances lack = %v: %s", code, body)
+	}
+	if len(templates[0].Params) != 2 {
+		t.Errorf("UpdateRuleTemplate() stored a template its instances cannot follow: %+v", templates[0])
+	}
+
+	// Instances may change their parameters but not the fields of the template
+	if code, body := serveCheck(s.UpdateAlertCheck, "1", `{"params":{"host":"b","threshold":"80"}}`); code != http.StatusOK {
+		t.Fatalf("UpdateAlertCheck() of parameters = %v: %s", code, body)
+	}
+	if c := checks[0]; c.Name != "cpu_b" || c.Thresholds[0].Value != 80 {
+		t.Errorf("UpdateAlertCheck() of parameters updated instance to %+v", c)
+	}
+	if code, body := serveCheck(s.UpdateAlertCheck, "1", `{"every":"5m"}`); code != http.StatusUnprocessableEntity {
+		t.Errorf("UpdateAlertCheck() of field set by template = %v: %s", code, body)
+	}
+
+	if code, body := serveTemplate(s.RemoveRuleTemplate, "1", ""); code != http.StatusNoContent {
+		t.Fatalf("RemoveRuleTemplate() = %v: %s", code, body)
+	}
+	if c := checks[0]; c.Template != "" || c.Params != nil || c.Name != "cpu_b" {
+		t.Errorf("RemoveRuleTemplate() left instance as %+v", c)
+	}
+	if code, body := serveTemplate(s.RuleTemplateID, "1", ""); code != http.StatusNotFound {
+		t.Errorf("RuleTemplateID() after RemoveRuleTemplate() = %v: %s", code, body)
+	}
+}
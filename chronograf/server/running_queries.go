@@ -0,0 +1,103 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/influxdata/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+type runningQueryResponse struct {
+	chronograf.RunningQuery
+	Links selfLinks `json:"links"`
+}
+
+func newRunningQueryResponse(srcID int, q chronograf.RunningQuery) runningQueryResponse {
+	self := fmt.Sprintf("/chronograf/v1/sources/%d/queries/%d", srcID, q.ID)
+	// Query IDs are only unique within a data node of InfluxDB Enterprise
+	if q.Host != "" {
+		self += "?host=" + url.QueryEscape(q.Host)
+	}
+	return runningQueryResponse{
+		RunningQuery: q,
+		Links:        selfLinks{Self: self},
+	}
+}
+
+type runningQueriesResponse struct {
+	Queries []runningQueryResponse `json:"queries"`
+}
+
+// RunningQueries lists the queries running in a source, as shown by SHOW
+// QUERIES
+func (s *Service) RunningQueries(w http.ResponseWriter, r *http.Request) {
+	src, dbsvc, ok := s.sourceDatabases(w, r)
+	if !ok {
+		return
+	}
+
+	ctx := r.Context()
+	queries, err := dbsvc.AllQueries(ctx)
+	if err != nil {
+		msg := fmt.Sprintf("unable to get running queries %d: %v", src.ID, err)
+		Error(w, http.StatusBadRequest, msg, s.Logger)
+		return
+	}
+
+	res := runningQueriesResponse{
+		Queries: make([]runningQueryResponse, len(queries)),
+	}
+	for i, q := range queries {
+		res.Queries[i] = newRunningQueryResponse(src.ID, q)
+	}
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// KillRunningQuery stops a query running in a source with KILL QUERY. Queries
+// of InfluxDB Enterprise are killed on the data node of the host parameter,
+// which may be left out when a single data node runs a query of that ID.
+func (s *Service) KillRunningQuery(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	param := httprouter.ParamsFromContext(ctx).ByName("qid")
+	qid, err := strconv.ParseUint(param, 10, 64)
+	if err != nil {
+		Error(w, http.StatusUnprocessableEntity, fmt.Sprintf("error converting query ID %s", param), s.Logger)
+		return
+	}
+	host := r.URL.Query().Get("host")
+
+	src, dbsvc, ok := s.sourceDatabases(w, r)
+	if !ok {
+		return
+	}
+
+	queries, err := dbsvc.AllQueries(ctx)
+	if err != nil {
+		msg := fmt.Sprintf("unable to get running queries %d: %v", src.ID, err)
+		Error(w, http.StatusBadRequest, msg, s.Logger)
+		return
+	}
+	var matches []chronograf.RunningQuery
+	for _, q := range queries {
+		if q.ID == qid && (host == "" || q.Host == host) {
+			matches = append(matches, q)
+		}
+	}
+	switch {
+	case len(matches) == 0:
+		notFound(w, qid, s.Logger)
+		return
+	case len(matches) > 1:
+		invalidData(w, fmt.Errorf("query %d runs on several data nodes; choose one with the host parameter", qid), s.Logger)
+		return
+	}
+
+	if err := dbsvc.KillQuery(ctx, &matches[0]); err != nil {
+		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
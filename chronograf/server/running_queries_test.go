@@ -0,0 +1,140 @@
+package server
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/influxdata/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+// runningQueriesService is a Service of a source running the same query on
+// two data nodes and another query on one of them
+func runningQueriesService(killed *[]chronograf.RunningQuery) *Service {
+	return &Service{
+		Store: &mocks.Store{
+			SourcesStore: &mocks.SourcesStore{
+				GetF: func(ctx context.Context, id int) (chronograf.Source, error) {
+					return chronograf.Source{ID: id}, nil
+				},
+			},
+		},
+		Databases: &mocks.Databases{
+			ConnectF: func(context.Context, *chronograf.Source) error {
+				return nil
+			},
+			AllQueriesF: func(context.Context) ([]chronograf.RunningQuery, error) {
+				return []chronograf.RunningQuery{
+					{ID: 7, Query: "SELECT * FROM cpu", Database: "telegraf", Duration: "2m", Status: "running", Host: "data-1:8088"},
+					{ID: 7, Query: "SELECT * FROM mem", Database: "telegraf", Duration: "1s", Status: "running", Host: "data-2:8088"},
+					{ID: 8, Query: "SHOW QUERIES", Duration: "10µs", Status: "running", Host: "data-2:8088"},
+				}, nil
+			},
+			KillQueryF: func(ctx context.Context, q *chronograf.RunningQuery) error {
+				*killed = append(*killed, *q)
+				return nil
+			},
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+}
+
+func TestService_RunningQueries(t *testing.T) {
+	var killed []chronograf.RunningQuery
+	s := runningQueriesService(&killed)
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "http://any.url/chronograf/v1/sources/1/queries", nil)
+	r = r.WithContext(context.WithValue(r.Context(), httprouter.ParamsKey, httprouter.Params{
+		{Key: "id", Value: "1"},
+	}))
+	s.RunningQueries(w, r)
+
+	resp := w.Result()
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("RunningQueries() = %v: %s", resp.StatusCode, body)
+	}
+	want := `{"queries":[
+{"id":"7","query":"SELECT * FROM cpu","database":"telegraf","duration":"2m","status":"running","host":"data-1:8088","links":{"self":"/chronograf/v1/sources/1/queries/7?host=data-1%3A8088"}},
+{"id":"7","query":"SELECT * FROM mem","database":"telegraf","duration":"1s","status":"running","host":"data-2:8088","links":{"self":"/chronograf/v1/sources/1/queries/7?host=data-2%3A8088"}},
+{"id":"8","query":"SHOW QUERIES","duration":"10µs","status":"running","host":"data-2:8088","links":{"self":"/chronograf/v1/sources/1/queries/8?host=data-2%3A8088"}}]}`
+	if eq, _ := jsonEqual(string(body), want); !eq {
+		t.Errorf("RunningQueries() = %s, want %s", body, want)
+	}
+}
+
+func TestService_KillRunningQuery(t *testing.T) {
+	tests := []struct {
+		name       string
+		qid        string
+		host       string
+		wantStatus int
+		wantKilled string
+	}{
+		{
+			name:       "Query running on one data node",
+			qid:        "8",
+			wantStatus: http.StatusNoContent,
+			wantKilled: "data-2:8088",
+		},
+		{
+			name:       "Query of a data node",
+			qid:        "7",
+			host:       "data-1:8088",
+			wantStatus: http.StatusNoContent,
+			wantKilled: "data-1:8088",
+		},
+		{
+			name:       "Query ID of several data nodes",
+			qid:        "7",
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Query that is not running",
+			qid:        "9",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "Invalid query ID",
+			qid:        "runaway",
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var killed []chronograf.RunningQuery
+			s := runningQueriesService(&killed)
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("DELETE", "http://any.url/chronograf/v1/sources/1/queries/"+tt.qid, nil)
+			if tt.host != "" {
+				r.URL.RawQuery = "host=" + tt.host
+			}
+			r = r.WithContext(context.WithValue(r.Context(), httprouter.ParamsKey, httprouter.Params{
+				{Key: "id", Value: "1"},
+				{Key: "qid", Value: tt.qid},
+			}))
+			s.KillRunningQuery(w, r)
+
+			resp := w.Result()
+			if resp.StatusCode != tt.wantStatus {
+				body, _ := ioutil.ReadAll(resp.Body)
+				t.Fatalf("KillRunningQuery() = %v, want %v: %s", resp.StatusCode, tt.wantStatus, body)
+			}
+			if tt.wantKilled == "" {
+				if len(killed) != 0 {
+					t.Errorf("KillRunningQuery() killed %v", killed)
+				}
+				return
+			}
+			if len(killed) != 1 || killed[0].Host != tt.wantKilled {
+				t.Errorf("KillRunningQuery() killed %v, want the query of %s", killed, tt.wantKilled)
+			}
+		})
+	}
+}
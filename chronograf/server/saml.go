@@ -0,0 +1,116 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf/oauth2"
+	"github.com/influxdata/influxdb/chronograf/saml"
+)
+
+const (
+	// SAMLScheme is the scheme of users authenticated by a SAML identity provider
+	SAMLScheme = "saml"
+	// SAMLProvider is the provider of users of the saml scheme and the
+	// issuer of their principals
+	SAMLProvider = "saml"
+
+	samlMetadataPath = "/saml/metadata"
+	samlLoginPath    = "/saml/login"
+	samlACSPath      = "/saml/acs"
+	samlLogoutPath   = "/saml/logout"
+)
+
+// SAMLAuth authenticates users with a SAML identity provider
+type SAMLAuth struct {
+	SP     *saml.ServiceProvider
+	Tokens oauth2.Tokenizer // Tokens create and validate the RelayState of authentication requests
+}
+
+// SAMLMetadata serves the metadata of the service provider to configure
+// identity providers
+func (s *Service) SAMLMetadata(a *SAMLAuth) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		md, err := a.SP.Metadata()
+		if err != nil {
+			unknownErrorWithMessage(w, err, s.Logger)
+			return
+		}
+		w.Header().Set("Content-Type", "application/samlmetadata+xml")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(md)
+	}
+}
+
+// SAMLLogin redirects to the identity provider with an authentication
+// request. As the state of the OAuth2 logins, the RelayState is a token that
+// any chronograf server can validate; its subject is the ID of the request
+// that the response must be in response to.
+func (s *Service) SAMLLogin(a *SAMLAuth) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := saml.NewRequestID()
+		if err != nil {
+			unknownErrorWithMessage(w, err, s.Logger)
+			return
+		}
+		now := time.Now()
+		state, err := a.Tokens.Create(r.Context(), oauth2.Principal{
+			Subject:   id,
+			IssuedAt:  now,
+			ExpiresAt: now.Add(oauth2.TenMinutes),
+		})
+		if err != nil {
+			unknownErrorWithMessage(w, err, s.Logger)
+			return
+		}
+		u, err := a.SP.AuthnRequestURL(id, string(state))
+		if err != nil {
+			unknownErrorWithMessage(w, err, s.Logger)
+			return
+		}
+		http.Redirect(w, r, u, http.StatusTemporaryRedirect)
+	}
+}
+
+// SAMLACS is the assertion consumer service receiving the responses of the
+// identity provider. Valid assertions set the session cookie in the same way
+// as the OAuth2 callbacks and redirect to successURL; all others redirect to
+// failureURL.
+func (s *Service) SAMLACS(a *SAMLAuth, auth oauth2.Authenticator, successURL, failureURL string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := s.Logger.
+			WithField("component", "auth").
+			WithField("remote_addr", r.RemoteAddr).
+			WithField("method", r.Method).
+			WithField("url", r.URL)
+
+		ctx := r.Context()
+		state, err := a.Tokens.ValidPrincipal(ctx, oauth2.Token(r.PostFormValue("RelayState")), oauth2.TenMinutes)
+		if err != nil {
+			log.Error("Invalid SAML RelayState received: ", err.Error())
+			http.Redirect(w, r, failureURL, http.StatusSeeOther)
+			return
+		}
+
+		assertion, err := a.SP.ParseResponse(r.PostFormValue("SAMLResponse"), state.Subject)
+		if err != nil {
+			log.Error("Invalid SAML response received: ", err.Error())
+			http.Redirect(w, r, failureURL, http.StatusSeeOther)
+			return
+		}
+
+		p := oauth2.Principal{
+			Subject: assertion.NameID,
+			Issuer:  SAMLProvider,
+			Group:   strings.Join(assertion.Groups, ","),
+		}
+		if err := auth.Authorize(ctx, w, p); err != nil {
+			log.Error("Unable to add session to response ", err.Error())
+			http.Redirect(w, r, failureURL, http.StatusSeeOther)
+			return
+		}
+		log.Info("User ", assertion.NameID, " is authenticated")
+		http.Redirect(w, r, successURL, http.StatusSeeOther)
+	}
+}
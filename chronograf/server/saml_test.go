@@ -0,0 +1,125 @@
+package server
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/oauth2"
+	"github.com/influxdata/influxdb/chronograf/saml"
+)
+
+func testSAMLAuth() *SAMLAuth {
+	return &SAMLAuth{
+		SP: &saml.ServiceProvider{
+			EntityID: "https://chronograf.example.com/saml/metadata",
+			ACSURL:   "https://chronograf.example.com/saml/acs",
+			IDP: saml.IdentityProvider{
+				EntityID: "https://idp.example.com",
+				SSOURL:   "https://idp.example.com/sso",
+			},
+		},
+		Tokens: oauth2.NewJWT("secret", ""),
+	}
+}
+
+func TestService_SAMLMetadata(t *testing.T) {
+	s := &Service{Logger: &chronograf.NoopLogger{}}
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "http://any.url/saml/metadata", nil)
+	s.SAMLMetadata(testSAMLAuth())(w, r)
+
+	resp := w.Result()
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("SAMLMetadata() = %v, want %v", resp.StatusCode, http.StatusOK)
+	}
+	if got := resp.Header.Get("Content-Type"); got != "application/samlmetadata+xml" {
+		t.Errorf("SAMLMetadata() Content-Type = %s", got)
+	}
+	if !strings.Contains(string(body), `entityID="https://chronograf.example.com/saml/metadata"`) ||
+		!strings.Contains(string(body), `Location="https://chronograf.example.com/saml/acs"`) {
+		t.Errorf("SAMLMetadata() = %s", body)
+	}
+}
+
+func TestService_SAMLLogin(t *testing.T) {
+	a := testSAMLAuth()
+	s := &Service{Logger: &chronograf.NoopLogger{}}
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "http://any.url/saml/login", nil)
+	s.SAMLLogin(a)(w, r)
+
+	resp := w.Result()
+	if resp.StatusCode != http.StatusTemporaryRedirect {
+		t.Fatalf("SAMLLogin() = %v, want %v", resp.StatusCode, http.StatusTemporaryRedirect)
+	}
+	loc, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loc.Host != "idp.example.com" || loc.Query().Get("SAMLRequest") == "" {
+		t.Errorf("SAMLLogin() redirected to %s", loc)
+	}
+	p, err := a.Tokens.ValidPrincipal(context.Background(), oauth2.Token(loc.Query().Get("RelayState")), oauth2.TenMinutes)
+	if err != nil {
+		t.Fatalf("SAMLLogin() RelayState is invalid: %v", err)
+	}
+	if !strings.HasPrefix(p.Subject, "id-") {
+		t.Errorf("SAMLLogin() RelayState subject = %s, want a request ID", p.Subject)
+	}
+}
+
+func TestService_SAMLACS(t *testing.T) {
+	a := testSAMLAuth()
+	now := oauth2.DefaultNowTime()
+	state, err := a.Tokens.Create(context.Background(), oauth2.Principal{
+		Subject:   "id-1",
+		IssuedAt:  now,
+		ExpiresAt: now.Add(oauth2.TenMinutes),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name       string
+		relayState string
+		response   string
+	}{
+		{
+			name:       "Forged RelayState",
+			relayState: "forged",
+			response:   "PHNhbWxwOlJlc3BvbnNlLz4=",
+		},
+		{
+			name:       "Invalid response",
+			relayState: string(state),
+			response:   "PHNhbWxwOlJlc3BvbnNlLz4=",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{Logger: &chronograf.NoopLogger{}}
+			form := url.Values{"RelayState": {tt.relayState}, "SAMLResponse": {tt.response}}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url/saml/acs", strings.NewReader(form.Encode()))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			auth := &basicTestAuthenticator{}
+			s.SAMLACS(a, auth, "/", "/login")(w, r)
+
+			resp := w.Result()
+			if resp.StatusCode != http.StatusSeeOther || resp.Header.Get("Location") != "/login" {
+				t.Errorf("%q. SAMLACS() = %v to %s, want %v to /login", tt.name, resp.StatusCode, resp.Header.Get("Location"), http.StatusSeeOther)
+			}
+			if auth.authorized != nil {
+				t.Errorf("%q. SAMLACS() authorized %v", tt.name, auth.authorized)
+			}
+		})
+	}
+}
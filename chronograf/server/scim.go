@@ -0,0 +1,845 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bouk/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/oauth2"
+	"github.com/influxdata/influxdb/chronograf/roles"
+	"github.com/influxdata/influxdb/chronograf/scim"
+)
+
+// scimPath is the path of the SCIM 2.0 provisioning API
+const scimPath = "/scim/v2"
+
+// SCIMAuthorized ensures the request carries the bearer token of the SCIM
+// clients. Authorized requests have access to all users and organizations.
+func SCIMAuthorized(token string, logger chronograf.Logger, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		bearer := strings.TrimPrefix(auth, "Bearer ")
+		if bearer == auth || subtle.ConstantTimeCompare([]byte(bearer), []byte(token)) != 1 {
+			logger.
+				WithField("component", "scim").
+				WithField("remote_addr", r.RemoteAddr).
+				WithField("method", r.Method).
+				WithField("url", r.URL).
+				Error("Invalid SCIM bearer token")
+			scimError(w, scim.NewError(http.StatusUnauthorized, "", "invalid bearer token"), logger)
+			return
+		}
+
+		next(w, r.WithContext(serverContext(r.Context())))
+	}
+}
+
+func encodeSCIM(w http.ResponseWriter, status int, v interface{}, logger chronograf.Logger) {
+	w.Header().Set("Content-Type", scim.ContentType)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		unknownErrorWithMessage(w, err, logger)
+	}
+}
+
+// scimError responds with err as a SCIM error. Errors that are not SCIM
+// errors are internal server errors.
+func scimError(w http.ResponseWriter, err error, logger chronograf.Logger) {
+	e, ok := err.(*scim.Error)
+	if !ok {
+		e = scim.NewError(http.StatusInternalServerError, "", "%s", err.Error())
+	}
+	logger.
+		WithField("component", "scim").
+		WithField("http_status ", e.Code()).
+		Error("Error message ", e.Detail)
+	encodeSCIM(w, e.Code(), e, logger)
+}
+
+// scimList returns the page of resources selected by the startIndex
+// and count query parameters
+func scimList(query url.Values, resources []interface{}) (*scim.ListResponse, error) {
+	start := 1
+	if s := query.Get("startIndex"); s != "" {
+		i, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, scim.NewError(http.StatusBadRequest, scim.InvalidValue, "invalid startIndex %s", s)
+		}
+		if i > 1 {
+			start = i
+		}
+	}
+	count := len(resources)
+	if s := query.Get("count"); s != "" {
+		i, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, scim.NewError(http.StatusBadRequest, scim.InvalidValue, "invalid count %s", s)
+		}
+		if i < 0 {
+			i = 0
+		}
+		count = i
+	}
+
+	first := start - 1
+	if first > len(resources) {
+		first = len(resources)
+	}
+	last := first + count
+	if last > len(resources) {
+		last = len(resources)
+	}
+	return &scim.ListResponse{
+		Schemas:      []string{scim.ListResponseSchema},
+		TotalResults: len(resources),
+		StartIndex:   start,
+		ItemsPerPage: last - first,
+		Resources:    resources[first:last],
+	}, nil
+}
+
+func newSCIMUser(u *chronograf.User, orgs map[string]string) scim.User {
+	id := strconv.FormatUint(u.ID, 10)
+	active := !u.Suspended()
+	res := scim.User{
+		Schemas:  []string{scim.UserSchema, scim.ChronografSchema},
+		ID:       id,
+		UserName: u.Name,
+		Active:   &active,
+		Chronograf: &scim.UserExtension{
+			Provider:   u.Provider,
+			Scheme:     u.Scheme,
+			SuperAdmin: u.SuperAdmin,
+		},
+		Meta: &scim.Meta{
+			ResourceType: "User",
+			Location:     scimPath + "/Users/" + id,
+		},
+	}
+	seen := map[string]bool{}
+	for _, role := range u.Roles {
+		if seen[role.Organization] {
+			continue
+		}
+		seen[role.Organization] = true
+		res.Groups = append(res.Groups, scim.Member{
+			Value:   role.Organization,
+			Display: orgs[role.Organization],
+			Ref:     scimPath + "/Groups/" + role.Organization,
+		})
+	}
+	return res
+}
+
+func scimUserAttributes(u *scim.User) scim.Attributes {
+	ext := strings.ToLower(scim.ChronografSchema)
+	attrs := scim.Attributes{
+		"id":                {u.ID},
+		"username":          {u.UserName},
+		"active":            {strconv.FormatBool(*u.Active)},
+		"meta.resourcetype": {u.Meta.ResourceType},
+		ext + ":provider":   {u.Chronograf.Provider},
+		ext + ":scheme":     {u.Chronograf.Scheme},
+		ext + ":superadmin": {strconv.FormatBool(u.Chronograf.SuperAdmin)},
+	}
+	for _, g := range u.Groups {
+		attrs["groups"] = append(attrs["groups"], g.Value)
+		attrs["groups.value"] = append(attrs["groups.value"], g.Value)
+		attrs["groups.display"] = append(attrs["groups.display"], g.Display)
+	}
+	return attrs
+}
+
+func newSCIMGroup(o *chronograf.Organization, users []chronograf.User) scim.Group {
+	res := scim.Group{
+		Schemas:     []string{scim.GroupSchema},
+		ID:          o.ID,
+		DisplayName: o.Name,
+		Members:     []scim.Member{},
+		Meta: &scim.Meta{
+			ResourceType: "Group",
+			Location:     scimPath + "/Groups/" + o.ID,
+		},
+	}
+	for _, u := range users {
+		if !hasRoleInOrganization(u, o.ID) {
+			continue
+		}
+		id := strconv.FormatUint(u.ID, 10)
+		res.Members = append(res.Members, scim.Member{
+			Value:   id,
+			Display: u.Name,
+			Ref:     scimPath + "/Users/" + id,
+		})
+	}
+	return res
+}
+
+func scimGroupAttributes(g *scim.Group) scim.Attributes {
+	attrs := scim.Attributes{
+		"id":                {g.ID},
+		"displayname":       {g.DisplayName},
+		"meta.resourcetype": {g.Meta.ResourceType},
+	}
+	for _, m := range g.Members {
+		attrs["members"] = append(attrs["members"], m.Value)
+		attrs["members.value"] = append(attrs["members.value"], m.Value)
+		attrs["members.display"] = append(attrs["members.display"], m.Display)
+	}
+	return attrs
+}
+
+// scimOrganizationNames returns the names of all organizations by ID
+func (s *Service) scimOrganizationNames(ctx context.Context) (map[string]string, error) {
+	orgs, err := s.Store.Organizations(ctx).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]string, len(orgs))
+	for _, o := range orgs {
+		names[o.ID] = o.Name
+	}
+	return names, nil
+}
+
+func (s *Service) scimUser(ctx context.Context) (*chronograf.User, error) {
+	idStr := httprouter.GetParamFromContext(ctx, "id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return nil, scim.NewError(http.StatusNotFound, "", "user %s not found", idStr)
+	}
+	u, err := s.Store.Users(ctx).Get(ctx, chronograf.UserQuery{ID: &id})
+	if err == chronograf.ErrUserNotFound {
+		return nil, scim.NewError(http.StatusNotFound, "", "user %s not found", idStr)
+	}
+	return u, err
+}
+
+func (s *Service) scimOrganization(ctx context.Context) (*chronograf.Organization, error) {
+	id := httprouter.GetParamFromContext(ctx, "id")
+	o, err := s.Store.Organizations(ctx).Get(ctx, chronograf.OrganizationQuery{ID: &id})
+	if err == chronograf.ErrOrganizationNotFound {
+		return nil, scim.NewError(http.StatusNotFound, "", "group %s not found", id)
+	}
+	return o, err
+}
+
+// respondSCIMUser responds with the SCIM representation of u
+func (s *Service) respondSCIMUser(w http.ResponseWriter, ctx context.Context, status int, u *chronograf.User) {
+	orgs, err := s.scimOrganizationNames(ctx)
+	if err != nil {
+		scimError(w, err, s.Logger)
+		return
+	}
+	res := newSCIMUser(u, orgs)
+	location(w, res.Meta.Location)
+	encodeSCIM(w, status, res, s.Logger)
+}
+
+// SCIMUsers lists the users matching the SCIM filter query parameter
+func (s *Service) SCIMUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+	filter, err := scim.ParseFilter(query.Get("filter"))
+	if err != nil {
+		scimError(w, scim.NewError(http.StatusBadRequest, scim.InvalidFilter, "%s", err.Error()), s.Logger)
+		return
+	}
+
+	users, err := s.Store.Users(ctx).All(ctx)
+	if err != nil {
+		scimError(w, err, s.Logger)
+		return
+	}
+	orgs, err := s.scimOrganizationNames(ctx)
+	if err != nil {
+		scimError(w, err, s.Logger)
+		return
+	}
+
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].ID < users[j].ID
+	})
+	resources := []interface{}{}
+	for i := range users {
+		u := newSCIMUser(&users[i], orgs)
+		if filter.Match(scimUserAttributes(&u)) {
+			resources = append(resources, u)
+		}
+	}
+
+	res, err := scimList(query, resources)
+	if err != nil {
+		scimError(w, err, s.Logger)
+		return
+	}
+	encodeSCIM(w, http.StatusOK, res, s.Logger)
+}
+
+// SCIMUserID returns a single user
+func (s *Service) SCIMUserID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	u, err := s.scimUser(ctx)
+	if err != nil {
+		scimError(w, err, s.Logger)
+		return
+	}
+	s.respondSCIMUser(w, ctx, http.StatusOK, u)
+}
+
+// SCIMNewUser provisions a user. Users without the Chronograf extension
+// authenticate with the OAuth2 provider configured for SCIM.
+func (s *Service) SCIMNewUser(w http.ResponseWriter, r *http.Request) {
+	var req scim.User
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		scimError(w, scim.NewError(http.StatusBadRequest, scim.InvalidSyntax, "invalid SCIM user"), s.Logger)
+		return
+	}
+	if req.UserName == "" {
+		scimError(w, scim.NewError(http.StatusBadRequest, scim.InvalidValue, "userName is required"), s.Logger)
+		return
+	}
+	user := &chronograf.User{
+		Name:     req.UserName,
+		Provider: s.SCIMProvider,
+		Scheme:   schemeOf(oauth2.Principal{Issuer: s.SCIMProvider}),
+		Roles:    []chronograf.Role{},
+	}
+	if ext := req.Chronograf; ext != nil {
+		if ext.Provider != "" {
+			user.Provider = ext.Provider
+			user.Scheme = schemeOf(oauth2.Principal{Issuer: ext.Provider})
+		}
+		if ext.Scheme != "" {
+			user.Scheme = ext.Scheme
+		}
+		user.SuperAdmin = ext.SuperAdmin
+	}
+	if req.Active != nil && !*req.Active {
+		user.Status = chronograf.UserStatusSuspended
+	}
+
+	ctx := r.Context()
+	store := s.Store.Users(ctx)
+	if err := userNotExists(ctx, store, user); err == chronograf.ErrUserAlreadyExists {
+		scimError(w, scim.NewError(http.StatusConflict, scim.Uniqueness, "user %s already exists", user.Name), s.Logger)
+		return
+	} else if err != nil {
+		scimError(w, err, s.Logger)
+		return
+	}
+
+	res, err := store.Add(ctx, user)
+	if err != nil {
+		scimError(w, err, s.Logger)
+		return
+	}
+	s.respondSCIMUser(w, ctx, http.StatusCreated, res)
+}
+
+// SCIMReplaceUser replaces the attributes of a user. Deactivated users are
+// suspended rather than removed so that they keep their roles.
+func (s *Service) SCIMReplaceUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	u, err := s.scimUser(ctx)
+	if err != nil {
+		scimError(w, err, s.Logger)
+		return
+	}
+
+	var req scim.User
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		scimError(w, scim.NewError(http.StatusBadRequest, scim.InvalidSyntax, "invalid SCIM user"), s.Logger)
+		return
+	}
+	s.updateSCIMUser(w, ctx, u, req)
+}
+
+// SCIMPatchUser modifies the attributes of a user by a sequence of SCIM
+// patch operations. Attributes that Chronograf does not store are ignored.
+func (s *Service) SCIMPatchUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	u, err := s.scimUser(ctx)
+	if err != nil {
+		scimError(w, err, s.Logger)
+		return
+	}
+
+	var req scim.PatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		scimError(w, scim.NewError(http.StatusBadRequest, scim.InvalidSyntax, "invalid SCIM patch"), s.Logger)
+		return
+	}
+
+	patched := newSCIMUser(u, nil)
+	for _, op := range req.Operations {
+		if err := patchSCIMUser(&patched, op); err != nil {
+			scimError(w, err, s.Logger)
+			return
+		}
+	}
+	s.updateSCIMUser(w, ctx, u, patched)
+}
+
+// updateSCIMUser sets the attributes of req on u
+func (s *Service) updateSCIMUser(w http.ResponseWriter, ctx context.Context, u *chronograf.User, req scim.User) {
+	store := s.Store.Users(ctx)
+	if req.UserName == "" {
+		scimError(w, scim.NewError(http.StatusBadRequest, scim.InvalidValue, "userName is required"), s.Logger)
+		return
+	}
+
+	updated := *u
+	updated.Name = req.UserName
+	if ext := req.Chronograf; ext != nil {
+		if ext.Provider != "" {
+			updated.Provider = ext.Provider
+		}
+		if ext.Scheme != "" {
+			updated.Scheme = ext.Scheme
+		}
+		updated.SuperAdmin = ext.SuperAdmin
+	}
+	if req.Active != nil {
+		updated.Status = chronograf.UserStatusActive
+		if !*req.Active {
+			updated.Status = chronograf.UserStatusSuspended
+		}
+	}
+
+	if updated.Name != u.Name || updated.Provider != u.Provider || updated.Scheme != u.Scheme {
+		if err := userNotExists(ctx, store, &updated); err == chronograf.ErrUserAlreadyExists {
+			scimError(w, scim.NewError(http.StatusConflict, scim.Uniqueness, "user %s already exists", updated.Name), s.Logger)
+			return
+		} else if err != nil {
+			scimError(w, err, s.Logger)
+			return
+		}
+	}
+
+	if !updated.SuperAdmin || updated.Suspended() {
+		if last, err := s.isLastSuperAdmin(ctx, u); err != nil {
+			scimError(w, err, s.Logger)
+			return
+		} else if last {
+			scimError(w, scim.NewError(http.StatusConflict, scim.Mutability, "cannot demote or deactivate the last SuperAdmin"), s.Logger)
+			return
+		}
+	}
+
+	if err := store.Update(ctx, &updated); err != nil {
+		scimError(w, err, s.Logger)
+		return
+	}
+	s.respondSCIMUser(w, ctx, http.StatusOK, &updated)
+}
+
+func patchSCIMUser(u *scim.User, op scim.PatchOperation) error {
+	name := strings.ToLower(op.Op)
+	switch name {
+	case scim.OpAdd, scim.OpReplace, scim.OpRemove:
+	default:
+		return scim.NewError(http.StatusBadRequest, scim.InvalidSyntax, "unknown operation %s", op.Op)
+	}
+
+	if op.Path == "" {
+		attrs, ok := op.Value.(map[string]interface{})
+		if name == scim.OpRemove || !ok {
+			return scim.NewError(http.StatusBadRequest, scim.NoTarget, "operation %s requires a path", op.Op)
+		}
+		for k, v := range attrs {
+			if err := setSCIMUserAttribute(u, strings.ToLower(k), v, false); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	attr, _, err := scim.ParsePath(op.Path)
+	if err != nil {
+		return scim.NewError(http.StatusBadRequest, scim.InvalidPath, "%s", err.Error())
+	}
+	return setSCIMUserAttribute(u, attr, op.Value, name == scim.OpRemove)
+}
+
+func setSCIMUserAttribute(u *scim.User, attr string, v interface{}, remove bool) error {
+	ext := strings.ToLower(scim.ChronografSchema)
+	switch attr {
+	case "active", ext + ":superadmin":
+		var b bool
+		if !remove {
+			var err error
+			if b, err = scim.Bool(v); err != nil {
+				return scim.NewError(http.StatusBadRequest, scim.InvalidValue, "%s: %s", attr, err.Error())
+			}
+		}
+		if attr == "active" {
+			u.Active = &b
+		} else {
+			u.Chronograf.SuperAdmin = b
+		}
+	case "username", ext + ":provider", ext + ":scheme":
+		s, ok := v.(string)
+		if remove || !ok {
+			return scim.NewError(http.StatusBadRequest, scim.InvalidValue, "%s must be a string", attr)
+		}
+		switch attr {
+		case "username":
+			u.UserName = s
+		case ext + ":provider":
+			u.Chronograf.Provider = s
+		default:
+			u.Chronograf.Scheme = s
+		}
+	case ext:
+		attrs, ok := v.(map[string]interface{})
+		if !ok {
+			return scim.NewError(http.StatusBadRequest, scim.InvalidValue, "%s must be an object", scim.ChronografSchema)
+		}
+		for k, v := range attrs {
+			if err := setSCIMUserAttribute(u, ext+":"+strings.ToLower(k), v, remove); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// SCIMRemoveUser deprovisions a user
+func (s *Service) SCIMRemoveUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	u, err := s.scimUser(ctx)
+	if err != nil {
+		scimError(w, err, s.Logger)
+		return
+	}
+	if last, err := s.isLastSuperAdmin(ctx, u); err != nil {
+		scimError(w, err, s.Logger)
+		return
+	} else if last {
+		scimError(w, scim.NewError(http.StatusConflict, scim.Mutability, "cannot delete the last SuperAdmin"), s.Logger)
+		return
+	}
+	if err := s.Store.Users(ctx).Delete(ctx, u); err != nil {
+		scimError(w, err, s.Logger)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SCIMGroups lists the organizations matching the SCIM filter query
+// parameter as groups. The members of a group are the users having a role
+// in the organization.
+func (s *Service) SCIMGroups(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+	filter, err := scim.ParseFilter(query.Get("filter"))
+	if err != nil {
+		scimError(w, scim.NewError(http.StatusBadRequest, scim.InvalidFilter, "%s", err.Error()), s.Logger)
+		return
+	}
+
+	orgs, err := s.Store.Organizations(ctx).All(ctx)
+	if err != nil {
+		scimError(w, err, s.Logger)
+		return
+	}
+	users, err := s.Store.Users(ctx).All(ctx)
+	if err != nil {
+		scimError(w, err, s.Logger)
+		return
+	}
+
+	resources := []interface{}{}
+	for i := range orgs {
+		g := newSCIMGroup(&orgs[i], users)
+		if filter.Match(scimGroupAttributes(&g)) {
+			resources = append(resources, g)
+		}
+	}
+
+	res, err := scimList(query, resources)
+	if err != nil {
+		scimError(w, err, s.Logger)
+		return
+	}
+	encodeSCIM(w, http.StatusOK, res, s.Logger)
+}
+
+// SCIMGroupID returns a single organization as a group
+func (s *Service) SCIMGroupID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	o, err := s.scimOrganization(ctx)
+	if err != nil {
+		scimError(w, err, s.Logger)
+		return
+	}
+	s.respondSCIMGroup(w, ctx, http.StatusOK, o)
+}
+
+// respondSCIMGroup responds with the SCIM representation of o
+func (s *Service) respondSCIMGroup(w http.ResponseWriter, ctx context.Context, status int, o *chronograf.Organization) {
+	users, err := s.Store.Users(ctx).All(ctx)
+	if err != nil {
+		scimError(w, err, s.Logger)
+		return
+	}
+	res := newSCIMGroup(o, users)
+	location(w, res.Meta.Location)
+	encodeSCIM(w, status, res, s.Logger)
+}
+
+// SCIMNewGroup creates an organization from a group. Its members are granted
+// the default role of the organization.
+func (s *Service) SCIMNewGroup(w http.ResponseWriter, r *http.Request) {
+	var req scim.Group
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		scimError(w, scim.NewError(http.StatusBadRequest, scim.InvalidSyntax, "invalid SCIM group"), s.Logger)
+		return
+	}
+	if req.DisplayName == "" {
+		scimError(w, scim.NewError(http.StatusBadRequest, scim.InvalidValue, "displayName is required"), s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	org, err := s.Store.Organizations(ctx).Add(ctx, &chronograf.Organization{
+		Name:        req.DisplayName,
+		DefaultRole: roles.MemberRoleName,
+	})
+	if err == chronograf.ErrOrganizationAlreadyExists {
+		scimError(w, scim.NewError(http.StatusConflict, scim.Uniqueness, "group %s already exists", req.DisplayName), s.Logger)
+		return
+	} else if err != nil {
+		scimError(w, err, s.Logger)
+		return
+	}
+
+	if err := s.setSCIMMembers(ctx, org, req.Members); err != nil {
+		// Best attempt at cleanup the organization if there were any errors
+		_ = s.Store.Organizations(ctx).Delete(ctx, org)
+		scimError(w, err, s.Logger)
+		return
+	}
+	s.respondSCIMGroup(w, ctx, http.StatusCreated, org)
+}
+
+// SCIMReplaceGroup renames an organization and replaces its members
+func (s *Service) SCIMReplaceGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	org, err := s.scimOrganization(ctx)
+	if err != nil {
+		scimError(w, err, s.Logger)
+		return
+	}
+
+	var req scim.Group
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		scimError(w, scim.NewError(http.StatusBadRequest, scim.InvalidSyntax, "invalid SCIM group"), s.Logger)
+		return
+	}
+	s.updateSCIMGroup(w, ctx, org, req)
+}
+
+// SCIMPatchGroup modifies the name and members of an organization by a
+// sequence of SCIM patch operations
+func (s *Service) SCIMPatchGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	org, err := s.scimOrganization(ctx)
+	if err != nil {
+		scimError(w, err, s.Logger)
+		return
+	}
+
+	var req scim.PatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		scimError(w, scim.NewError(http.StatusBadRequest, scim.InvalidSyntax, "invalid SCIM patch"), s.Logger)
+		return
+	}
+
+	users, err := s.Store.Users(ctx).All(ctx)
+	if err != nil {
+		scimError(w, err, s.Logger)
+		return
+	}
+	patched := newSCIMGroup(org, users)
+	for _, op := range req.Operations {
+		if err := patchSCIMGroup(&patched, op); err != nil {
+			scimError(w, err, s.Logger)
+			return
+		}
+	}
+	s.updateSCIMGroup(w, ctx, org, patched)
+}
+
+// updateSCIMGroup sets the name and members of req on org
+func (s *Service) updateSCIMGroup(w http.ResponseWriter, ctx context.Context, org *chronograf.Organization, req scim.Group) {
+	if req.DisplayName == "" {
+		scimError(w, scim.NewError(http.StatusBadRequest, scim.InvalidValue, "displayName is required"), s.Logger)
+		return
+	}
+
+	if req.DisplayName != org.Name {
+		org.Name = req.DisplayName
+		err := s.Store.Organizations(ctx).Update(ctx, org)
+		if err == chronograf.ErrOrganizationAlreadyExists {
+			scimError(w, scim.NewError(http.StatusConflict, scim.Uniqueness, "group %s already exists", org.Name), s.Logger)
+			return
+		} else if err != nil {
+			scimError(w, err, s.Logger)
+			return
+		}
+	}
+
+	if err := s.setSCIMMembers(ctx, org, req.Members); err != nil {
+		scimError(w, err, s.Logger)
+		return
+	}
+	s.respondSCIMGroup(w, ctx, http.StatusOK, org)
+}
+
+// setSCIMMembers grants the default role of org to the members that have no
+// role in org and removes the users that are not members from org
+func (s *Service) setSCIMMembers(ctx context.Context, org *chronograf.Organization, members []scim.Member) error {
+	store := s.Store.Users(ctx)
+	users, err := store.All(ctx)
+	if err != nil {
+		return err
+	}
+
+	known := map[string]bool{}
+	for _, u := range users {
+		known[strconv.FormatUint(u.ID, 10)] = true
+	}
+	want := map[string]bool{}
+	for _, m := range members {
+		if !known[m.Value] {
+			return scim.NewError(http.StatusBadRequest, scim.InvalidValue, "unknown user %s", m.Value)
+		}
+		want[m.Value] = true
+	}
+
+	for _, u := range users {
+		member := want[strconv.FormatUint(u.ID, 10)]
+		if member == hasRoleInOrganization(u, org.ID) {
+			continue
+		}
+		if member {
+			u.Roles = append(u.Roles, chronograf.Role{
+				Name:         org.DefaultRole,
+				Organization: org.ID,
+			})
+		} else {
+			rs := []chronograf.Role{}
+			for _, role := range u.Roles {
+				if role.Organization != org.ID {
+					rs = append(rs, role)
+				}
+			}
+			u.Roles = rs
+		}
+		if err := store.Update(ctx, &u); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func patchSCIMGroup(g *scim.Group, op scim.PatchOperation) error {
+	name := strings.ToLower(op.Op)
+	switch name {
+	case scim.OpAdd, scim.OpReplace, scim.OpRemove:
+	default:
+		return scim.NewError(http.StatusBadRequest, scim.InvalidSyntax, "unknown operation %s", op.Op)
+	}
+
+	if op.Path == "" {
+		attrs, ok := op.Value.(map[string]interface{})
+		if name == scim.OpRemove || !ok {
+			return scim.NewError(http.StatusBadRequest, scim.NoTarget, "operation %s requires a path", op.Op)
+		}
+		for k, v := range attrs {
+			if err := setSCIMGroupAttribute(g, name, strings.ToLower(k), nil, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	attr, filter, err := scim.ParsePath(op.Path)
+	if err != nil {
+		return scim.NewError(http.StatusBadRequest, scim.InvalidPath, "%s", err.Error())
+	}
+	return setSCIMGroupAttribute(g, name, attr, filter, op.Value)
+}
+
+func setSCIMGroupAttribute(g *scim.Group, op, attr string, filter scim.Filter, v interface{}) error {
+	switch attr {
+	case "displayname":
+		s, ok := v.(string)
+		if op == scim.OpRemove || !ok {
+			return scim.NewError(http.StatusBadRequest, scim.InvalidValue, "displayName must be a string")
+		}
+		g.DisplayName = s
+	case "members":
+		values, err := scim.MemberValues(v)
+		if err != nil {
+			return scim.NewError(http.StatusBadRequest, scim.InvalidValue, "%s", err.Error())
+		}
+		listed := map[string]bool{}
+		for _, value := range values {
+			listed[value] = true
+		}
+
+		members := []scim.Member{}
+		switch op {
+		case scim.OpAdd:
+			members = g.Members
+			for _, m := range g.Members {
+				delete(listed, m.Value)
+			}
+			for _, value := range values {
+				if listed[value] {
+					members = append(members, scim.Member{Value: value})
+					delete(listed, value)
+				}
+			}
+		case scim.OpReplace:
+			for _, value := range values {
+				members = append(members, scim.Member{Value: value})
+			}
+		case scim.OpRemove:
+			// Without a filter or values, all members are removed
+			for _, m := range g.Members {
+				selected := filter != nil && filter.Match(scim.Attributes{"value": {m.Value}})
+				if (filter != nil || len(values) > 0) && !selected && !listed[m.Value] {
+					members = append(members, m)
+				}
+			}
+		}
+		g.Members = members
+	}
+	return nil
+}
+
+// SCIMRemoveGroup removes an organization
+func (s *Service) SCIMRemoveGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	org, err := s.scimOrganization(ctx)
+	if err != nil {
+		scimError(w, err, s.Logger)
+		return
+	}
+	if err := s.Store.Organizations(ctx).Delete(ctx, org); err != nil {
+		scimError(w, scim.NewError(http.StatusBadRequest, "", "%s", err.Error()), s.Logger)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,332 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/bouk/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+	"github.com/influxdata/influxdb/chronograf/scim"
+)
+
+// scimTestService serves a SCIM test with the users and organizations of
+// mock stores that keep the changes made by the handlers
+func scimTestService(users map[uint64]*chronograf.User) *Service {
+	orgs := []chronograf.Organization{
+		{ID: "1", Name: "Default", DefaultRole: "viewer"},
+		{ID: "2", Name: "Engineering", DefaultRole: "member"},
+	}
+	all := func(ctx context.Context) ([]chronograf.User, error) {
+		res := []chronograf.User{}
+		for _, u := range users {
+			res = append(res, *u)
+		}
+		sort.Slice(res, func(i, j int) bool {
+			return res[i].ID < res[j].ID
+		})
+		return res, nil
+	}
+	return &Service{
+		Store: &mocks.Store{
+			OrganizationsStore: &mocks.OrganizationsStore{
+				AllF: func(ctx context.Context) ([]chronograf.Organization, error) {
+					return orgs, nil
+				},
+				GetF: func(ctx context.Context, q chronograf.OrganizationQuery) (*chronograf.Organization, error) {
+					for i := range orgs {
+						if orgs[i].ID == *q.ID {
+							o := orgs[i]
+							return &o, nil
+						}
+					}
+					return nil, chronograf.ErrOrganizationNotFound
+				},
+			},
+			UsersStore: &mocks.UsersStore{
+				AllF: all,
+				GetF: func(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+					for _, u := range users {
+						if (q.ID != nil && u.ID == *q.ID) || (q.Name != nil && u.Name == *q.Name && u.Provider == *q.Provider) {
+							res := *u
+							return &res, nil
+						}
+					}
+					return nil, chronograf.ErrUserNotFound
+				},
+				AddF: func(ctx context.Context, u *chronograf.User) (*chronograf.User, error) {
+					u.ID = uint64(len(users) + 1)
+					users[u.ID] = u
+					return u, nil
+				},
+				UpdateF: func(ctx context.Context, u *chronograf.User) error {
+					res := *u
+					users[u.ID] = &res
+					return nil
+				},
+				DeleteF: func(ctx context.Context, u *chronograf.User) error {
+					delete(users, u.ID)
+					return nil
+				},
+			},
+		},
+		Logger:       &chronograf.NoopLogger{},
+		SCIMProvider: "generic",
+	}
+}
+
+func TestSCIMAuthorized(t *testing.T) {
+	next := func(w http.ResponseWriter, r *http.Request) {
+		if !hasServerContext(r.Context()) {
+			t.Errorf("SCIMAuthorized() did not grant access to all users")
+		}
+	}
+	tests := []struct {
+		name       string
+		header     string
+		wantStatus int
+	}{
+		{
+			name:       "Bearer token",
+			header:     "Bearer secret",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "Wrong token",
+			header:     "Bearer guess",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "Token without bearer scheme",
+			header:     "secret",
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("GET", "http://any.url/scim/v2/Users", nil)
+			r.Header.Set("Authorization", tt.header)
+
+			SCIMAuthorized("secret", &chronograf.NoopLogger{}, next)(w, r)
+
+			if got := w.Result().StatusCode; got != tt.wantStatus {
+				t.Errorf("SCIMAuthorized() = %v, want %v", got, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestService_SCIMUsers(t *testing.T) {
+	tests := []struct {
+		name       string
+		url        string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "Filter by userName",
+			url:        `http://any.url/scim/v2/Users?filter=userName+eq+%22Bob%22`,
+			wantStatus: http.StatusOK,
+			wantBody: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:ListResponse"],"totalResults":1,"startIndex":1,"itemsPerPage":1,"Resources":[
+				{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User","urn:ietf:params:scim:schemas:extension:chronograf:2.0:User"],"id":"1","userName":"bob","active":true,
+				 "groups":[{"value":"2","display":"Engineering","$ref":"/scim/v2/Groups/2"}],
+				 "urn:ietf:params:scim:schemas:extension:chronograf:2.0:User":{"provider":"generic","scheme":"oauth2","superAdmin":false},
+				 "meta":{"resourceType":"User","location":"/scim/v2/Users/1"}}]}`,
+		},
+		{
+			name:       "Page of group members",
+			url:        `http://any.url/scim/v2/Users?filter=groups.value+eq+%222%22&startIndex=2&count=1`,
+			wantStatus: http.StatusOK,
+			wantBody: `{"schemas":["urn:ietf:params:scim:api:messages:2.0:ListResponse"],"totalResults":2,"startIndex":2,"itemsPerPage":1,"Resources":[
+				{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User","urn:ietf:params:scim:schemas:extension:chronograf:2.0:User"],"id":"2","userName":"alice","active":true,
+				 "groups":[{"value":"2","display":"Engineering","$ref":"/scim/v2/Groups/2"}],
+				 "urn:ietf:params:scim:schemas:extension:chronograf:2.0:User":{"provider":"generic","scheme":"oauth2","superAdmin":true},
+				 "meta":{"resourceType":"User","location":"/scim/v2/Users/2"}}]}`,
+		},
+		{
+			name:       "Invalid filter",
+			url:        `http://any.url/scim/v2/Users?filter=userName+eq`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"status":"400","scimType":"invalidFilter","detail":"expected value after userName eq"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := scimTestService(map[uint64]*chronograf.User{
+				1: {ID: 1, Name: "bob", Provider: "generic", Scheme: "oauth2", Roles: []chronograf.Role{{Name: "member", Organization: "2"}}},
+				2: {ID: 2, Name: "alice", Provider: "generic", Scheme: "oauth2", SuperAdmin: true, Roles: []chronograf.Role{{Name: "admin", Organization: "2"}}},
+			})
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("GET", tt.url, nil)
+
+			s.SCIMUsers(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("%q. SCIMUsers() = %v, want %v", tt.name, resp.StatusCode, tt.wantStatus)
+			}
+			if content := resp.Header.Get("Content-Type"); content != scim.ContentType {
+				t.Errorf("%q. SCIMUsers() Content-Type = %v, want %v", tt.name, content, scim.ContentType)
+			}
+			if eq, _ := jsonEqual(string(body), tt.wantBody); !eq {
+				t.Errorf("%q. SCIMUsers() = \n***%v***\n,\nwant\n***%v***", tt.name, string(body), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestService_SCIMNewUser(t *testing.T) {
+	users := map[uint64]*chronograf.User{
+		1: {ID: 1, Name: "bob", Provider: "generic", Scheme: "oauth2"},
+	}
+	s := scimTestService(users)
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("POST", "http://any.url/scim/v2/Users", bytes.NewBufferString(`{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],"userName":"alice","emails":[{"value":"alice@example.com"}]}`))
+	s.SCIMNewUser(w, r)
+	if got := w.Result().StatusCode; got != http.StatusCreated {
+		t.Fatalf("SCIMNewUser() = %v, want %v", got, http.StatusCreated)
+	}
+	if got := w.Result().Header.Get("Location"); got != "/scim/v2/Users/2" {
+		t.Errorf("SCIMNewUser() Location = %v, want /scim/v2/Users/2", got)
+	}
+	if u := users[2]; u == nil || u.Name != "alice" || u.Provider != "generic" || u.Scheme != "oauth2" {
+		t.Errorf("SCIMNewUser() created %v", u)
+	}
+
+	w = httptest.NewRecorder()
+	r = httptest.NewRequest("POST", "http://any.url/scim/v2/Users", bytes.NewBufferString(`{"userName":"bob"}`))
+	s.SCIMNewUser(w, r)
+	body, _ := ioutil.ReadAll(w.Result().Body)
+	want := `{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"status":"409","scimType":"uniqueness","detail":"user bob already exists"}`
+	if eq, _ := jsonEqual(string(body), want); !eq {
+		t.Errorf("SCIMNewUser() = %s, want %s", body, want)
+	}
+}
+
+func TestService_SCIMPatchUser(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantUser   *chronograf.User
+	}{
+		{
+			name:       "Rename and promote",
+			body:       `{"Operations":[{"op":"Replace","value":{"userName":"robert","urn:ietf:params:scim:schemas:extension:chronograf:2.0:User":{"superAdmin":true}}},{"op":"add","path":"name.givenName","value":"Robert"}]}`,
+			wantStatus: http.StatusOK,
+			wantUser:   &chronograf.User{ID: 1, Name: "robert", Provider: "generic", Scheme: "oauth2", SuperAdmin: true},
+		},
+		{
+			name:       "Deactivate",
+			body:       `{"Operations":[{"op":"replace","path":"active","value":"False"}]}`,
+			wantStatus: http.StatusOK,
+			wantUser:   &chronograf.User{ID: 1, Name: "bob", Provider: "generic", Scheme: "oauth2", Status: chronograf.UserStatusSuspended},
+		},
+		{
+			name:       "Unknown operation",
+			body:       `{"Operations":[{"op":"move","path":"active","value":false}]}`,
+			wantStatus: http.StatusBadRequest,
+			wantUser:   &chronograf.User{ID: 1, Name: "bob", Provider: "generic", Scheme: "oauth2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := map[uint64]*chronograf.User{
+				1: {ID: 1, Name: "bob", Provider: "generic", Scheme: "oauth2"},
+			}
+			s := scimTestService(users)
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("PATCH", "http://any.url/scim/v2/Users/1", bytes.NewBufferString(tt.body))
+			r = r.WithContext(httprouter.WithParams(r.Context(), httprouter.Params{{Key: "id", Value: "1"}}))
+
+			s.SCIMPatchUser(w, r)
+
+			if got := w.Result().StatusCode; got != tt.wantStatus {
+				t.Errorf("%q. SCIMPatchUser() = %v, want %v", tt.name, got, tt.wantStatus)
+			}
+			got := users[1]
+			if got.Name != tt.wantUser.Name || got.SuperAdmin != tt.wantUser.SuperAdmin || got.Suspended() != tt.wantUser.Suspended() {
+				t.Errorf("%q. SCIMPatchUser() user = %v, want %v", tt.name, got, tt.wantUser)
+			}
+		})
+	}
+}
+
+func TestService_SCIMPatchGroup(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantRoles  map[uint64]string // wantRoles are the roles of the members
+	}{
+		{
+			name:       "Add member",
+			body:       `{"Operations":[{"op":"add","path":"members","value":[{"value":"3"}]}]}`,
+			wantStatus: http.StatusOK,
+			wantRoles:  map[uint64]string{1: "admin", 2: "member", 3: "member"},
+		},
+		{
+			name:       "Remove member by filter",
+			body:       `{"Operations":[{"op":"remove","path":"members[value eq \"1\"]"}]}`,
+			wantStatus: http.StatusOK,
+			wantRoles:  map[uint64]string{2: "member"},
+		},
+		{
+			name:       "Remove member by value",
+			body:       `{"Operations":[{"op":"remove","path":"members","value":[{"value":"2"}]}]}`,
+			wantStatus: http.StatusOK,
+			wantRoles:  map[uint64]string{1: "admin"},
+		},
+		{
+			name:       "Replace members",
+			body:       `{"Operations":[{"op":"replace","path":"members","value":[{"value":"3"}]}]}`,
+			wantStatus: http.StatusOK,
+			wantRoles:  map[uint64]string{3: "member"},
+		},
+		{
+			name:       "Unknown member",
+			body:       `{"Operations":[{"op":"add","path":"members","value":[{"value":"9"}]}]}`,
+			wantStatus: http.StatusBadRequest,
+			wantRoles:  map[uint64]string{1: "admin", 2: "member"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := map[uint64]*chronograf.User{
+				1: {ID: 1, Name: "bob", Roles: []chronograf.Role{{Name: "admin", Organization: "2"}}},
+				2: {ID: 2, Name: "alice", Roles: []chronograf.Role{{Name: "member", Organization: "2"}}},
+				3: {ID: 3, Name: "carol", Roles: []chronograf.Role{{Name: "viewer", Organization: "1"}}},
+			}
+			s := scimTestService(users)
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("PATCH", "http://any.url/scim/v2/Groups/2", bytes.NewBufferString(tt.body))
+			r = r.WithContext(httprouter.WithParams(r.Context(), httprouter.Params{{Key: "id", Value: "2"}}))
+
+			s.SCIMPatchGroup(w, r)
+
+			if got := w.Result().StatusCode; got != tt.wantStatus {
+				t.Errorf("%q. SCIMPatchGroup() = %v, want %v", tt.name, got, tt.wantStatus)
+			}
+			got := map[uint64]string{}
+			for id, u := range users {
+				for _, role := range u.Roles {
+					if role.Organization == "2" {
+						got[id] = role.Name
+					}
+				}
+			}
+			if !reflect.DeepEqual(got, tt.wantRoles) {
+				t.Errorf("%q. SCIMPatchGroup() roles = %v, want %v", tt.name, got, tt.wantRoles)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/search"
+)
+
+const (
+	// defaultSearchLimit is the number of hits of searches without a limit
+	defaultSearchLimit = 50
+	// maxSearchLimit is the largest number of hits of a search
+	maxSearchLimit = 1000
+)
+
+type searchHitLinks struct {
+	Self      string `json:"self"`      // Self is the link to the dashboard or cell of the hit
+	Dashboard string `json:"dashboard"` // Dashboard is the link to the dashboard of the hit
+}
+
+type searchHitResponse struct {
+	Type        string                 `json:"type"`
+	DashboardID chronograf.DashboardID `json:"dashboardID"`
+	Dashboard   string                 `json:"dashboard"`
+	CellID      string                 `json:"cellID,omitempty"`
+	Cell        string                 `json:"cell,omitempty"`
+	Text        string                 `json:"text"`
+	Score       int                    `json:"score"`
+	Links       searchHitLinks         `json:"links"`
+}
+
+type searchResponse struct {
+	Query string              `json:"query"`
+	Hits  []searchHitResponse `json:"hits"`
+}
+
+func newSearchResponse(query string, hits []search.Hit) *searchResponse {
+	res := &searchResponse{
+		Query: query,
+		Hits:  make([]searchHitResponse, 0, len(hits)),
+	}
+	for _, h := range hits {
+		dashboard := fmt.Sprintf("/chronograf/v1/dashboards/%d", h.DashboardID)
+		self := dashboard
+		if h.CellID != "" {
+			self = fmt.Sprintf("%s/cells/%s", dashboard, h.CellID)
+		}
+		res.Hits = append(res.Hits, searchHitResponse{
+			Type:        h.Type,
+			DashboardID: h.DashboardID,
+			Dashboard:   h.Dashboard,
+			CellID:      h.CellID,
+			Cell:        h.Cell,
+			Text:        h.Text,
+			Score:       h.Score,
+			Links: searchHitLinks{
+				Self:      self,
+				Dashboard: dashboard,
+			},
+		})
+	}
+	return res
+}
+
+// Search returns the dashboard names, cell titles and query text of the
+// current organization matching the words of the q parameter, best matches
+// first. The limit parameter caps the number of hits.
+func (s *Service) Search(w http.ResponseWriter, r *http.Request) {
+	query := strings.TrimSpace(r.URL.Query().Get("q"))
+	if query == "" {
+		invalidData(w, errorf("q is required"), s.Logger)
+		return
+	}
+	limit := defaultSearchLimit
+	if l := r.URL.Query().Get("limit"); l != "" {
+		var err error
+		if limit, err = strconv.Atoi(l); err != nil || limit < 1 || limit > maxSearchLimit {
+			invalidData(w, errorf("limit must be between 1 and %d", maxSearchLimit), s.Logger)
+			return
+		}
+	}
+
+	ctx := r.Context()
+	org, _ := hasOrganizationContext(ctx)
+
+	var hits []search.Hit
+	if s.DashboardSearch != nil {
+		var err error
+		if hits, err = s.DashboardSearch.Search(ctx, org, query, limit); err != nil {
+			unknownErrorWithMessage(w, err, s.Logger)
+			return
+		}
+	} else {
+		// Without an index maintained on writes, the dashboards of the
+		// organization are indexed for this search only
+		dashboards, err := s.Store.Dashboards(ctx).All(ctx)
+		if err != nil {
+			Error(w, http.StatusInternalServerError, "Error loading dashboards", s.Logger)
+			return
+		}
+		idx := search.NewIndex()
+		for _, d := range dashboards {
+			idx.Add(d)
+		}
+		hits = idx.Search("", query, limit)
+	}
+
+	encodeJSON(w, http.StatusOK, newSearchResponse(query, hits), s.Logger)
+}
@@ -0,0 +1,152 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+	"github.com/influxdata/influxdb/chronograf/organizations"
+	"github.com/influxdata/influxdb/chronograf/search"
+)
+
+func TestService_Search(t *testing.T) {
+	dashboards := []chronograf.Dashboard{
+		{
+			ID:           1,
+			Name:         "Production hosts",
+			Organization: "default",
+			Cells: []chronograf.DashboardCell{
+				{
+					ID:   "a",
+					Name: "CPU usage",
+					Queries: []chronograf.DashboardQuery{
+						{Command: `SELECT mean("usage_user") FROM "cpu"`},
+					},
+				},
+			},
+		},
+		{
+			ID:           2,
+			Name:         "Production cpu",
+			Organization: "other",
+		},
+	}
+	store := &mocks.DashboardsStore{
+		AllF: func(ctx context.Context) ([]chronograf.Dashboard, error) {
+			return dashboards, nil
+		},
+	}
+
+	tests := []struct {
+		name       string
+		search     *search.DashboardsStore
+		url        string
+		wantStatus int
+		want       []searchHitResponse
+	}{
+		{
+			name:       "Indexed on writes",
+			search:     search.NewDashboardsStore(store),
+			url:        "http://any.url/chronograf/v1/search?q=cpu",
+			wantStatus: http.StatusOK,
+			want: []searchHitResponse{
+				{
+					Type:        search.HitCell,
+					DashboardID: 1,
+					Dashboard:   "Production hosts",
+					CellID:      "a",
+					Cell:        "CPU usage",
+					Text:        "CPU usage",
+					Score:       1,
+					Links: searchHitLinks{
+						Self:      "/chronograf/v1/dashboards/1/cells/a",
+						Dashboard: "/chronograf/v1/dashboards/1",
+					},
+				},
+				{
+					Type:        search.HitQuery,
+					DashboardID: 1,
+					Dashboard:   "Production hosts",
+					CellID:      "a",
+					Cell:        "CPU usage",
+					Text:        `SELECT mean("usage_user") FROM "cpu"`,
+					Score:       1,
+					Links: searchHitLinks{
+						Self:      "/chronograf/v1/dashboards/1/cells/a",
+						Dashboard: "/chronograf/v1/dashboards/1",
+					},
+				},
+			},
+		},
+		{
+			name:       "Without index",
+			url:        "http://any.url/chronograf/v1/search?q=production+hosts&limit=1",
+			wantStatus: http.StatusOK,
+			want: []searchHitResponse{
+				{
+					Type:        search.HitDashboard,
+					DashboardID: 1,
+					Dashboard:   "Production hosts",
+					Text:        "Production hosts",
+					Score:       2,
+					Links: searchHitLinks{
+						Self:      "/chronograf/v1/dashboards/1",
+						Dashboard: "/chronograf/v1/dashboards/1",
+					},
+				},
+			},
+		},
+		{
+			name:       "Without query",
+			url:        "http://any.url/chronograf/v1/search?q=+",
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Invalid limit",
+			url:        "http://any.url/chronograf/v1/search?q=cpu&limit=0",
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{
+				Store: &mocks.Store{
+					DashboardsStore: &mocks.DashboardsStore{
+						AllF: func(ctx context.Context) ([]chronograf.Dashboard, error) {
+							return dashboards[:1], nil
+						},
+					},
+				},
+				Logger:          &chronograf.NoopLogger{},
+				DashboardSearch: tt.search,
+			}
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("GET", tt.url, nil)
+			r = r.WithContext(context.WithValue(r.Context(), organizations.ContextKey, "default"))
+			s.Search(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("Search() = %v, want %v: %s", resp.StatusCode, tt.wantStatus, body)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var got searchResponse
+			if err := json.Unmarshal(body, &got); err != nil {
+				t.Fatalf("Search() = %s", body)
+			}
+			if diff := cmp.Diff(got.Hits, tt.want); diff != "" {
+				t.Errorf("Search() diff (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// redocScript is the origin of the script of the API documentation at /docs
+const redocScript = "https://rebilly.github.io"
+
+// SecurityHeaders are the headers protecting the browsers of the users
+// against protocol downgrades, clickjacking, MIME sniffing and injected
+// content
+type SecurityHeaders struct {
+	HSTSMaxAge            time.Duration // HSTSMaxAge is how long browsers only use https; HSTS is not sent when 0
+	ContentSecurityPolicy string        // ContentSecurityPolicy restricts the content of the pages; no policy is sent when empty
+	FrameAncestors        []string      // FrameAncestors are the origins allowed to embed Chronograf in iframes; framing is denied when empty
+}
+
+// Secure adds the security headers to all the responses
+func Secure(h SecurityHeaders, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := w.Header()
+		if h.HSTSMaxAge > 0 {
+			header.Set("Strict-Transport-Security", fmt.Sprintf("max-age=%d; includeSubDomains", int64(h.HSTSMaxAge.Seconds())))
+		}
+		// Browsers honoring the frame-ancestors of the policy ignore the
+		// header, which cannot list the allowed origins
+		if len(h.FrameAncestors) == 0 {
+			header.Set("X-Frame-Options", "DENY")
+		}
+		header.Set("X-Content-Type-Options", "nosniff")
+		if h.ContentSecurityPolicy != "" {
+			header.Set("Content-Security-Policy", h.ContentSecurityPolicy)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// origin returns the scheme and host of a URL, or "" when it has none
+func origin(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return ""
+	}
+	return u.Scheme + "://" + u.Host
+}
+
+// validCSPSource checks that a source of the options is a single source of
+// a directive of the Content-Security-Policy
+func validCSPSource(src string) error {
+	if src == "" || strings.ContainsAny(src, " \t;,") {
+		return fmt.Errorf("invalid Content-Security-Policy source %q", src)
+	}
+	return nil
+}
+
+// contentSecurityPolicy is the policy of the --content-security-policy
+// option, or the policy allowing the content of Chronograf itself: its
+// assets, the status feed, the API documentation and the iframes of the
+// --csp-frame-src option
+func (s *Server) contentSecurityPolicy() string {
+	if s.ContentSecurityPolicy != "" {
+		return s.ContentSecurityPolicy
+	}
+	frameAncestors := "'none'"
+	if len(s.CSPFrameAncestors) > 0 {
+		frameAncestors = strings.Join(s.CSPFrameAncestors, " ")
+	}
+	directives := []string{
+		"default-src 'self'",
+		"script-src 'self' " + redocScript,
+		"style-src 'self' 'unsafe-inline'",
+		"img-src 'self' data: https:",
+		"font-src 'self' data:",
+		strings.TrimSpace("connect-src 'self' " + origin(s.StatusFeedURL)),
+		strings.Join(append([]string{"frame-src 'self'"}, s.CSPFrameSrc...), " "),
+		"frame-ancestors " + frameAncestors,
+		"object-src 'none'",
+		"base-uri 'self'",
+		"form-action 'self'",
+	}
+	return strings.Join(directives, "; ")
+}
+
+// securityHeaders are the security headers of the options. HSTS is only sent
+// when Chronograf is served over https, by itself or behind a proxy
+// terminating TLS at its public URL.
+func (s *Server) securityHeaders() SecurityHeaders {
+	h := SecurityHeaders{
+		ContentSecurityPolicy: s.contentSecurityPolicy(),
+		FrameAncestors:        s.CSPFrameAncestors,
+	}
+	if s.useTLS() || strings.HasPrefix(s.PublicURL, "https://") {
+		h.HSTSMaxAge = s.HSTSMaxAge
+	}
+	return h
+}
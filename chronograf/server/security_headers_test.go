@@ -0,0 +1,104 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSecure(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers SecurityHeaders
+		want    map[string]string
+	}{
+		{
+			name: "Served over https",
+			headers: SecurityHeaders{
+				HSTSMaxAge:            17520 * time.Hour,
+				ContentSecurityPolicy: "default-src 'self'",
+			},
+			want: map[string]string{
+				"Strict-Transport-Security": "max-age=63072000; includeSubDomains",
+				"X-Frame-Options":           "DENY",
+				"X-Content-Type-Options":    "nosniff",
+				"Content-Security-Policy":   "default-src 'self'",
+			},
+		},
+		{
+			name: "Embedded in iframes over http",
+			headers: SecurityHeaders{
+				FrameAncestors: []string{"https://portal.example.com"},
+			},
+			want: map[string]string{
+				"Strict-Transport-Security": "",
+				"X-Frame-Options":           "",
+				"X-Content-Type-Options":    "nosniff",
+				"Content-Security-Policy":   "",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+			w := httptest.NewRecorder()
+			Secure(tt.headers, next).ServeHTTP(w, httptest.NewRequest("GET", "http://any.url", nil))
+			for name, want := range tt.want {
+				if got := w.Header().Get(name); got != want {
+					t.Errorf("Secure() %s = %q, want %q", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestServer_securityHeaders(t *testing.T) {
+	tests := []struct {
+		name     string
+		server   Server
+		wantHSTS time.Duration
+		wantCSP  string
+	}{
+		{
+			name: "Default policy",
+			server: Server{
+				HSTSMaxAge:    time.Hour,
+				StatusFeedURL: "https://www.influxdata.com/feed/json",
+			},
+			wantCSP: "default-src 'self'; script-src 'self' https://rebilly.github.io; style-src 'self' 'unsafe-inline'; img-src 'self' data: https:; font-src 'self' data:; connect-src 'self' https://www.influxdata.com; frame-src 'self'; frame-ancestors 'none'; object-src 'none'; base-uri 'self'; form-action 'self'",
+		},
+		{
+			name: "Allowed iframes behind a proxy terminating TLS",
+			server: Server{
+				HSTSMaxAge:        time.Hour,
+				PublicURL:         "https://chronograf.example.com",
+				CSPFrameSrc:       []string{"https://grafana.example.com"},
+				CSPFrameAncestors: []string{"'self'", "https://portal.example.com"},
+			},
+			wantHSTS: time.Hour,
+			wantCSP:  "default-src 'self'; script-src 'self' https://rebilly.github.io; style-src 'self' 'unsafe-inline'; img-src 'self' data: https:; font-src 'self' data:; connect-src 'self'; frame-src 'self' https://grafana.example.com; frame-ancestors 'self' https://portal.example.com; object-src 'none'; base-uri 'self'; form-action 'self'",
+		},
+		{
+			name: "Configured policy",
+			server: Server{
+				HSTSMaxAge:            time.Hour,
+				Cert:                  "chronograf.pem",
+				ContentSecurityPolicy: "default-src 'self'",
+			},
+			wantHSTS: time.Hour,
+			wantCSP:  "default-src 'self'",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.server.securityHeaders()
+			if got.HSTSMaxAge != tt.wantHSTS {
+				t.Errorf("securityHeaders() HSTSMaxAge = %v, want %v", got.HSTSMaxAge, tt.wantHSTS)
+			}
+			if got.ContentSecurityPolicy != tt.wantCSP {
+				t.Errorf("securityHeaders() ContentSecurityPolicy = %q, want %q", got.ContentSecurityPolicy, tt.wantCSP)
+			}
+		})
+	}
+}
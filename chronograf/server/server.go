@@ -10,18 +10,31 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"os/signal"
 	"path"
 	"regexp"
 	"runtime"
 	"strconv"
+	"strings"
+	"syscall"
 	"time"
 
 	bbolt "github.com/coreos/bbolt"
 	"github.com/influxdata/influxdb/chronograf"
 	"github.com/influxdata/influxdb/chronograf/bolt"
+	"github.com/influxdata/influxdb/chronograf/cache"
+	"github.com/influxdata/influxdb/chronograf/encryption"
+	"github.com/influxdata/influxdb/chronograf/etcd"
 	idgen "github.com/influxdata/influxdb/chronograf/id"
 	"github.com/influxdata/influxdb/chronograf/influx"
+	"github.com/influxdata/influxdb/chronograf/ldap"
+	clog "github.com/influxdata/influxdb/chronograf/log"
+	"github.com/influxdata/influxdb/chronograf/metrics"
 	"github.com/influxdata/influxdb/chronograf/oauth2"
+	"github.com/influxdata/influxdb/chronograf/postgres"
+	"github.com/influxdata/influxdb/chronograf/reports"
+	"github.com/influxdata/influxdb/chronograf/saml"
+	"github.com/influxdata/influxdb/chronograf/search"
 	client "github.com/influxdata/usage-client/v1"
 	flags "github.com/jessevdk/go-flags"
 	"github.com/tylerb/graceful"
@@ -40,11 +53,31 @@ type Server struct {
 	Host string `long:"host" description:"The IP to listen on" default:"0.0.0.0" env:"HOST"`
 	Port int    `long:"port" description:"The port to listen on for insecure connections, defaults to a random value" default:"8888" env:"PORT"`
 
-	PprofEnabled bool `long:"pprof-enabled" description:"Enable the /debug/pprof/* HTTP routes" env:"PPROF_ENABLED"`
+	ConfigFile     flags.Filename `long:"config" description:"Path to a YAML (.yaml, .yml) or TOML (.toml) file of the options keyed by their long names. Options of the command line and the environment take precedence. The file is read again on SIGHUP." env:"CONFIG_FILE"`
+	ValidateConfig bool           `long:"validate-config" description:"Validate the options and exit"`
+
+	ShutdownTimeout time.Duration `long:"shutdown-timeout" description:"Duration the in-flight requests are drained for on SIGTERM or SIGINT before they are closed. They are drained without a limit when 0." default:"30s" env:"SHUTDOWN_TIMEOUT"`
+
+	PprofEnabled bool   `long:"pprof-enabled" description:"Enable the /debug/pprof/* HTTP routes" env:"PPROF_ENABLED"`
+	MetricsToken string `long:"metrics-token" description:"Bearer token Prometheus scrapes the internal metrics of Chronograf at /metrics with. /metrics is public when empty." env:"METRICS_TOKEN"`
+
+	TracingEndpoint    string  `long:"tracing-endpoint" description:"URL of the collector the traces of the requests, store operations and proxied InfluxDB and Kapacitor requests are exported to with the Jaeger Thrift HTTP protocol, such as http://localhost:14268/api/traces of a Jaeger or OpenTelemetry collector. Tracing is disabled when empty." env:"TRACING_ENDPOINT"`
+	TracingServiceName string  `long:"tracing-service-name" description:"Service name of the exported traces" default:"chronograf" env:"TRACING_SERVICE_NAME"`
+	TracingSampleRatio float64 `long:"tracing-sample-ratio" description:"Ratio of the traces exported, between 0 and 1" default:"1" env:"TRACING_SAMPLE_RATIO"`
+
+	HSTSMaxAge            time.Duration `long:"hsts-max-age" default:"17520h" description:"Duration browsers only connect to Chronograf over https once they were served by it over https, either with --cert or behind a proxy terminating TLS at an https --public-url. HSTS is disabled when 0." env:"HSTS_MAX_AGE"`
+	ContentSecurityPolicy string        `long:"content-security-policy" description:"Content-Security-Policy header of the responses, replacing the default policy allowing the content of Chronograf, its status feed and the iframes of --csp-frame-src" env:"CONTENT_SECURITY_POLICY"`
+	CSPFrameSrc           []string      `long:"csp-frame-src" description:"Origin of the iframes embedded in dashboards allowed by the default Content-Security-Policy (e.g. https://grafana.example.com). Multiple origins can be added by using multiple of the same flag or as an environment variable with comma-separated values." env:"CSP_FRAME_SRC" env-delim:","`
+	CSPFrameAncestors     []string      `long:"csp-frame-ancestors" description:"Origin of the pages allowed to embed Chronograf in an iframe (e.g. https://portal.example.com). Pages of other origins cannot embed Chronograf. Multiple origins can be added by using multiple of the same flag or as an environment variable with comma-separated values." env:"CSP_FRAME_ANCESTORS" env-delim:","`
+
+	TemplateURLHosts []string `long:"template-url-host" description:"Host the lists of choices of templates of type url may be read from at a loopback, link-local or private address (e.g. inventory.internal). Other hosts must resolve to public addresses. Multiple hosts can be added by using multiple of the same flag or as an environment variable with comma-separated values." env:"TEMPLATE_URL_HOSTS" env-delim:","`
 
 	Cert flags.Filename `long:"cert" description:"Path to PEM encoded public key certificate. " env:"TLS_CERTIFICATE"`
 	Key  flags.Filename `long:"key" description:"Path to private key associated with given certificate. " env:"TLS_PRIVATE_KEY"`
 
+	TLSClientCA flags.Filename `long:"tls-client-ca" description:"Path to the PEM encoded certificate authorities of the TLS client certificates. API requests presenting a client certificate they verify, whose subject is mapped to a user at /chronograf/v1/certificates, are authenticated as that user. Requires --cert." env:"TLS_CLIENT_CA"`
+	GRPCPort    int            `long:"grpc-port" description:"Port of the gRPC administrative API of users, organizations, sources and dashboards. Its clients must present a client certificate verified by --tls-client-ca and mapped to a SuperAdmin. Disabled when 0." env:"GRPC_PORT"`
+
 	InfluxDBURL      string `long:"influxdb-url" description:"Location of your InfluxDB instance" env:"INFLUXDB_URL"`
 	InfluxDBUsername string `long:"influxdb-username" description:"Username for your InfluxDB instance" env:"INFLUXDB_USERNAME"`
 	InfluxDBPassword string `long:"influxdb-password" description:"Password for your InfluxDB instance" env:"INFLUXDB_PASSWORD"`
@@ -55,14 +88,53 @@ type Server struct {
 
 	NewSources string `long:"new-sources" description:"Config for adding a new InfluxDB source and Kapacitor server, in JSON as an array of objects, and surrounded by single quotes. E.g. --new-sources='[{\"influxdb\":{\"name\":\"Influx 1\",\"username\":\"user1\",\"password\":\"pass1\",\"url\":\"http://localhost:8086\",\"metaUrl\":\"http://metaurl.com\",\"type\":\"influx-enterprise\",\"insecureSkipVerify\":false,\"default\":true,\"telegraf\":\"telegraf\",\"sharedSecret\":\"cubeapples\"},\"kapacitor\":{\"name\":\"Kapa 1\",\"url\":\"http://localhost:9092\",\"active\":true}}]'" env:"NEW_SOURCES" hidden:"true"`
 
-	Develop       bool          `short:"d" long:"develop" description:"Run server in develop mode."`
-	BoltPath      string        `short:"b" long:"bolt-path" description:"Full path to boltDB file (e.g. './chronograf-v1.db')" env:"BOLT_PATH" default:"chronograf-v1.db"`
-	CannedPath    string        `short:"c" long:"canned-path" description:"Path to directory of pre-canned application layouts (/usr/share/chronograf/canned)" env:"CANNED_PATH" default:"canned"`
-	ResourcesPath string        `long:"resources-path" description:"Path to directory of pre-canned dashboards, sources, kapacitors, and organizations (/usr/share/chronograf/resources)" env:"RESOURCES_PATH" default:"canned"`
-	TokenSecret   string        `short:"t" long:"token-secret" description:"Secret to sign tokens" env:"TOKEN_SECRET"`
-	JwksURL       string        `long:"jwks-url" description:"URL that returns OpenID Key Discovery JWKS document." env:"JWKS_URL"`
-	UseIDToken    bool          `long:"use-id-token" description:"Enable id_token processing." env:"USE_ID_TOKEN"`
-	AuthDuration  time.Duration `long:"auth-duration" default:"720h" description:"Total duration of cookie life for authentication (in hours). 0 means authentication expires on browser close." env:"AUTH_DURATION"`
+	Develop         bool          `short:"d" long:"develop" description:"Run server in develop mode."`
+	BoltPath        string        `short:"b" long:"bolt-path" description:"Full path to boltDB file (e.g. './chronograf-v1.db')" env:"BOLT_PATH" default:"chronograf-v1.db"`
+	EtcdEndpoints   []string      `long:"etcd-endpoints" description:"Client URLs of an etcd cluster storing users, dashboards, sources, servers and layouts instead of the boltDB file, so that Chronograf replicas behind a load balancer share them (e.g. http://localhost:2379). Multiple endpoints can be added by using multiple of the same flag or as an environment variable with comma-separated values." env:"ETCD_ENDPOINTS" env-delim:","`
+	EtcdPrefix      string        `long:"etcd-prefix" description:"Prefix of the keys of Chronograf in etcd" env:"ETCD_PREFIX" default:"/chronograf/"`
+	EtcdUsername    string        `long:"etcd-username" description:"Username authenticating with etcd" env:"ETCD_USERNAME"`
+	EtcdPassword    string        `long:"etcd-password" description:"Password authenticating with etcd" env:"ETCD_PASSWORD"`
+	PostgresURL     string        `long:"postgres-url" description:"URL of a PostgreSQL database storing users, dashboards, sources, servers and layouts instead of the boltDB file (e.g. postgres://chronograf@localhost/chronograf?sslmode=disable)" env:"POSTGRES_URL"`
+	PostgresMaxConn int           `long:"postgres-max-open-conns" description:"Maximum number of open connections to PostgreSQL; zero is unlimited" env:"POSTGRES_MAX_OPEN_CONNS" default:"0"`
+	EncryptionKey   string        `long:"encryption-key" description:"Base64 encoded 32 byte master key encrypting the passwords of sources and kapacitors in the stores" env:"ENCRYPTION_KEY"`
+	VaultAddr       string        `long:"encryption-vault-addr" description:"URL of HashiCorp Vault whose transit secrets engine holds the master key encrypting the passwords of sources and kapacitors (e.g. https://vault:8200)" env:"ENCRYPTION_VAULT_ADDR"`
+	VaultToken      string        `long:"encryption-vault-token" description:"Token authenticating with Vault" env:"ENCRYPTION_VAULT_TOKEN"`
+	VaultMount      string        `long:"encryption-vault-mount" description:"Path of the transit secrets engine in Vault" env:"ENCRYPTION_VAULT_MOUNT" default:"transit"`
+	VaultKey        string        `long:"encryption-vault-key" description:"Name of the transit key in Vault" env:"ENCRYPTION_VAULT_KEY" default:"chronograf"`
+	StoreCacheTTL   time.Duration `long:"store-cache-ttl" default:"0s" description:"Duration users, sources and dashboards read from the stores are cached in memory. Writes of other replicas sharing the stores are seen once cached values expire. 0 disables the cache." env:"STORE_CACHE_TTL"`
+	QueryCacheTTL   time.Duration `long:"query-cache-ttl" default:"0s" description:"Duration results of queries proxied to sources are cached in memory, unless a dashboard sets a cache TTL of its own. 0 only caches the queries of dashboards with a cache TTL." env:"QUERY_CACHE_TTL"`
+	QueryCacheSize  int           `long:"query-cache-size" default:"1000" description:"Maximum number of query results cached in memory. 0 disables the query cache and the cardinality cache." env:"QUERY_CACHE_SIZE"`
+	CardinalityTTL  time.Duration `long:"cardinality-cache-ttl" default:"5m" description:"Duration the series cardinality of databases and measurements is cached in memory, as counting series is expensive. 0 disables the cache." env:"CARDINALITY_CACHE_TTL"`
+
+	SourceMaxConcurrentQueries int           `long:"source-max-concurrent-queries" default:"0" description:"Maximum number of queries proxied to a source at once. 0 is unlimited." env:"SOURCE_MAX_CONCURRENT_QUERIES"`
+	SourceQueriesPerSecond     float64       `long:"source-queries-per-second" default:"0" description:"Maximum number of queries per second proxied to a source. 0 is unlimited." env:"SOURCE_QUERIES_PER_SECOND"`
+	UserMaxConcurrentQueries   int           `long:"user-max-concurrent-queries" default:"0" description:"Maximum number of queries of a user proxied at once. 0 is unlimited." env:"USER_MAX_CONCURRENT_QUERIES"`
+	UserQueriesPerSecond       float64       `long:"user-queries-per-second" default:"0" description:"Maximum number of queries per second of a user. 0 is unlimited." env:"USER_QUERIES_PER_SECOND"`
+	MaxQueryRange              time.Duration `long:"max-query-range" default:"0s" description:"Longest time range of SELECT statements proxied to sources. 0 is unlimited." env:"MAX_QUERY_RANGE"`
+	QueryJobTTL                time.Duration `long:"query-job-ttl" default:"1h" description:"Duration the results of finished query jobs are kept in memory for download." env:"QUERY_JOB_TTL"`
+	QueryJobTimeout            time.Duration `long:"query-job-timeout" default:"1h" description:"Longest duration a query job runs before it is cancelled. 0 is unlimited." env:"QUERY_JOB_TIMEOUT"`
+
+	WebhookAttempts   int           `long:"webhook-attempts" default:"5" description:"Number of attempts to deliver an event to a webhook before the delivery fails. Deliveries are retried on network errors, 429 and 5xx responses." env:"WEBHOOK_ATTEMPTS"`
+	WebhookRetryDelay time.Duration `long:"webhook-retry-delay" default:"1s" description:"Delay before retrying the delivery of an event to a webhook, doubled on every further attempt." env:"WEBHOOK_RETRY_DELAY"`
+
+	UserRequestsPerSecond float64 `long:"user-requests-per-second" default:"0" description:"Maximum number of requests per second of an authenticated user or API token. Requests over the limit are answered with 429 Too Many Requests. 0 is unlimited." env:"USER_REQUESTS_PER_SECOND"`
+	UserRequestsBurst     int     `long:"user-requests-burst" default:"0" description:"Maximum number of requests of an authenticated user at once. 0 allows the requests of a second at once." env:"USER_REQUESTS_BURST"`
+	IPRequestsPerSecond   float64 `long:"ip-requests-per-second" default:"0" description:"Maximum number of unauthenticated requests per second of an IP. Requests over the limit are answered with 429 Too Many Requests. 0 is unlimited." env:"IP_REQUESTS_PER_SECOND"`
+	IPRequestsBurst       int     `long:"ip-requests-burst" default:"0" description:"Maximum number of unauthenticated requests of an IP at once. 0 allows the requests of a second at once." env:"IP_REQUESTS_BURST"`
+
+	CannedPath      string        `short:"c" long:"canned-path" description:"Path to directory of pre-canned application layouts (/usr/share/chronograf/canned)" env:"CANNED_PATH" default:"canned"`
+	ResourcesPath   string        `long:"resources-path" description:"Path to directory of pre-canned dashboards, sources, kapacitors, and organizations (/usr/share/chronograf/resources)" env:"RESOURCES_PATH" default:"canned"`
+	TokenSecret     string        `short:"t" long:"token-secret" description:"Secret to sign tokens" env:"TOKEN_SECRET"`
+	PreviousSecrets []string      `long:"previous-token-secret" description:"Previous secret that still validates tokens signed before --token-secret was rotated. Multiple secrets can be added by using multiple of the same flag or as an environment variable with comma-separated values." env:"PREVIOUS_TOKEN_SECRETS" env-delim:","`
+	JwksURL         string        `long:"jwks-url" description:"URL that returns OpenID Key Discovery JWKS document." env:"JWKS_URL"`
+	JWKSCacheTTL    time.Duration `long:"jwks-cache-ttl" default:"1h" description:"Duration for which the JWKS document of --jwks-url is cached before it is fetched again. The document is fetched again early when a token is signed by an unknown key. 0 fetches it on every token validation." env:"JWKS_CACHE_TTL"`
+	GroupCacheTTL   time.Duration `long:"group-cache-ttl" default:"5m" description:"Duration for which the groups of a user looked up from an OAuth 2 provider are cached. 0 looks them up on every login." env:"GROUP_CACHE_TTL"`
+	IdPCacheStale   time.Duration `long:"idp-cache-stale" default:"10m" description:"Duration after --jwks-cache-ttl and --group-cache-ttl for which cached keys and groups are still used while they are refreshed in the background, so that logins keep working while the identity provider is briefly unreachable." env:"IDP_CACHE_STALE"`
+	UseIDToken      bool          `long:"use-id-token" description:"Enable id_token processing." env:"USE_ID_TOKEN"`
+	AuthDuration    time.Duration `long:"auth-duration" default:"720h" description:"Total duration of cookie life for authentication (in hours), which is the maximum lifetime of a session. 0 means authentication expires on browser close." env:"AUTH_DURATION"`
+	IdleTimeout     time.Duration `long:"idle-timeout" default:"5m" description:"Duration without requests after which a session expires. Every request extends the session until --auth-duration after login." env:"IDLE_TIMEOUT"`
+
+	ImpersonationDuration time.Duration `long:"impersonation-duration" default:"15m" description:"Lifespan of the sessions of SuperAdmins impersonating users to reproduce their issues. 0 disables impersonation." env:"IMPERSONATION_DURATION"`
 
 	GithubClientID     string   `short:"i" long:"github-client-id" description:"Github Client ID for OAuth 2 support" env:"GH_CLIENT_ID"`
 	GithubClientSecret string   `short:"s" long:"github-client-secret" description:"Github Client Secret for OAuth 2 support" env:"GH_CLIENT_SECRET"`
@@ -86,6 +158,16 @@ type Server struct {
 	GenericTokenURL     string   `long:"generic-token-url" description:"OAuth 2.0 provider's token endpoint URL" env:"GENERIC_TOKEN_URL"`
 	GenericAPIURL       string   `long:"generic-api-url" description:"URL that returns OpenID UserInfo compatible information." env:"GENERIC_API_URL"`
 	GenericAPIKey       string   `long:"generic-api-key" description:"JSON lookup key into OpenID UserInfo. (Azure should be userPrincipalName)" default:"email" env:"GENERIC_API_KEY"`
+	GenericGroupsKey    string   `long:"generic-groups-key" description:"JSON lookup key into OpenID UserInfo or id_token for the groups of the user, which mappings match instead of the email domain (e.g. groups)" env:"GENERIC_GROUPS_KEY"`
+
+	OIDCName          string   `long:"oidc-name" description:"Name of the OpenID Connect provider presented on the login page" default:"oidc" env:"OIDC_NAME"`
+	OIDCIssuerURL     string   `long:"oidc-issuer-url" description:"Issuer URL of an OpenID Connect provider whose endpoints and keys are discovered from /.well-known/openid-configuration. Requires --token-secret and --public-url." env:"OIDC_ISSUER_URL"`
+	OIDCClientID      string   `long:"oidc-client-id" description:"OpenID Connect Client ID" env:"OIDC_CLIENT_ID"`
+	OIDCClientSecret  string   `long:"oidc-client-secret" description:"OpenID Connect Client Secret" env:"OIDC_CLIENT_SECRET"`
+	OIDCScopes        []string `long:"oidc-scopes" description:"Scopes requested of the OpenID Connect provider" default:"openid" default:"profile" default:"email" env:"OIDC_SCOPES" env-delim:","` //lint:ignore SA5008 duplicate tag default is expected with go-flags.
+	OIDCDomains       []string `long:"oidc-domains" description:"Email domain users' email address to have (example.com)" env:"OIDC_DOMAINS" env-delim:","`
+	OIDCUsernameClaim string   `long:"oidc-username-claim" description:"Claim of the id_token naming users" default:"email" env:"OIDC_USERNAME_CLAIM"`
+	OIDCGroupsClaim   string   `long:"oidc-groups-claim" description:"Claim of the id_token listing the groups of users, which mappings match instead of the email domain" default:"groups" env:"OIDC_GROUPS_CLAIM"`
 
 	Auth0Domain        string   `long:"auth0-domain" description:"Subdomain of auth0.com used for Auth0 OAuth2 authentication" env:"AUTH0_DOMAIN"`
 	Auth0ClientID      string   `long:"auth0-client-id" description:"Auth0 Client ID for OAuth2 support" env:"AUTH0_CLIENT_ID"`
@@ -93,17 +175,61 @@ type Server struct {
 	Auth0Organizations []string `long:"auth0-organizations" description:"Auth0 organizations permitted to access Chronograf (comma separated)" env:"AUTH0_ORGS" env-delim:","`
 	Auth0SuperAdminOrg string   `long:"auth0-superadmin-org" description:"Auth0 organization from which users are automatically granted SuperAdmin status" env:"AUTH0_SUPERADMIN_ORG"`
 
-	StatusFeedURL          string            `long:"status-feed-url" description:"URL of a JSON Feed to display as a News Feed on the client Status page." default:"https://www.influxdata.com/feed/json" env:"STATUS_FEED_URL"`
-	CustomLinks            map[string]string `long:"custom-link" description:"Custom link to be added to the client User menu. Multiple links can be added by using multiple of the same flag with different 'name:url' values, or as an environment variable with comma-separated 'name:url' values. E.g. via flags: '--custom-link=InfluxData:https://www.influxdata.com --custom-link=Chronograf:https://github.com/influxdata/influxdb/chronograf'. E.g. via environment variable: 'export CUSTOM_LINKS=InfluxData:https://www.influxdata.com,Chronograf:https://github.com/influxdata/influxdb/chronograf'" env:"CUSTOM_LINKS" env-delim:","`
-	TelegrafSystemInterval time.Duration     `long:"telegraf-system-interval" default:"1m" description:"Duration used in the GROUP BY time interval for the hosts list" env:"TELEGRAF_SYSTEM_INTERVAL"`
+	SCIMToken    string `long:"scim-token" description:"Bearer token of SCIM 2.0 clients provisioning users and groups at /scim/v2. SCIM is disabled when empty." env:"SCIM_TOKEN"`
+	SCIMProvider string `long:"scim-provider" description:"OAuth2 provider of the users provisioned by SCIM" default:"generic" env:"SCIM_PROVIDER"`
+
+	AutoProvisionUsers   bool   `long:"auto-provision-users" description:"Create unknown users who authenticate successfully even if no mapping grants them a role. Replaces the auto-provisioning policy of /chronograf/v1/config/auth on startup." env:"AUTO_PROVISION_USERS"`
+	NewUsersOrganization string `long:"new-users-organization" description:"ID of the organization auto-provisioned users are added to. Defaults to the default organization." env:"NEW_USERS_ORGANIZATION"`
+	NewUsersRole         string `long:"new-users-role" description:"Role of auto-provisioned users within their organization. Defaults to the default role of the organization." env:"NEW_USERS_ROLE"`
+
+	BasicAuth bool `long:"basic-auth" description:"Enable login by username and password of users of the basic scheme for installations without an OAuth2 provider. Requires --token-secret." env:"BASIC_AUTH"`
+
+	LDAPURL                string        `long:"ldap-url" description:"ldap:// or ldaps:// URL of an LDAP or Active Directory server authenticating users. Requires --token-secret and --ldap-user-base-dn." env:"LDAP_URL"`
+	LDAPInsecureSkipVerify bool          `long:"ldap-insecure-skip-verify" description:"Skip the verification of the certificate of an ldaps:// server" env:"LDAP_INSECURE_SKIP_VERIFY"`
+	LDAPTimeout            time.Duration `long:"ldap-timeout" default:"10s" description:"Timeout of each operation on the LDAP server" env:"LDAP_TIMEOUT"`
+	LDAPBindDN             string        `long:"ldap-bind-dn" description:"DN of the service account searching users and groups. Searches are anonymous when empty." env:"LDAP_BIND_DN"`
+	LDAPBindPassword       string        `long:"ldap-bind-password" description:"Password of the LDAP service account" env:"LDAP_BIND_PASSWORD"`
+	LDAPUserBaseDN         string        `long:"ldap-user-base-dn" description:"DN of the subtree searched for users, e.g. ou=people,dc=example,dc=com" env:"LDAP_USER_BASE_DN"`
+	LDAPUserFilter         string        `long:"ldap-user-filter" default:"(uid=%s)" description:"Filter finding a user by username, which replaces %s. Use (sAMAccountName=%s) for Active Directory." env:"LDAP_USER_FILTER"`
+	LDAPGroupBaseDN        string        `long:"ldap-group-base-dn" description:"DN of the subtree searched for the groups of users. The memberOf attribute of users names their groups when empty." env:"LDAP_GROUP_BASE_DN"`
+	LDAPGroupFilter        string        `long:"ldap-group-filter" default:"(member=%s)" description:"Filter finding the groups of a user, whose DN replaces %s" env:"LDAP_GROUP_FILTER"`
+	LDAPGroupAttribute     string        `long:"ldap-group-attribute" default:"cn" description:"Attribute of groups naming them in organization mappings" env:"LDAP_GROUP_ATTRIBUTE"`
+
+	SAMLIDPMetadata     string `long:"saml-idp-metadata" description:"URL or file of the SAML metadata of an identity provider such as ADFS or Shibboleth authenticating users. Requires --token-secret and --public-url." env:"SAML_IDP_METADATA"`
+	SAMLEntityID        string `long:"saml-entity-id" description:"Entity ID of Chronograf as a SAML service provider. Defaults to the URL of its metadata." env:"SAML_ENTITY_ID"`
+	SAMLGroupsAttribute string `long:"saml-groups-attribute" default:"groups" description:"Name of the attribute of SAML assertions listing the groups of users" env:"SAML_GROUPS_ATTRIBUTE"`
+
+	HeaderAuthUser           string   `long:"header-auth-user" description:"Header naming the user authenticated by a reverse proxy such as oauth2-proxy (X-Auth-Request-User) or Pomerium (X-Pomerium-Claim-Email) in front of Chronograf. Users are created on their first request and switch organizations with a session cookie signed by --token-secret. Requires --header-auth-trusted-proxy." env:"HEADER_AUTH_USER"`
+	HeaderAuthGroups         string   `long:"header-auth-groups" description:"Header listing the comma-separated groups of the user authenticated by the reverse proxy, e.g. X-Auth-Request-Groups, which are mapped to roles by the organization mappings" env:"HEADER_AUTH_GROUPS"`
+	HeaderAuthTrustedProxies []string `long:"header-auth-trusted-proxy" description:"CIDR or IP of the reverse proxies trusted to set the header of --header-auth-user. Requests of other clients setting the header are rejected. Multiple proxies can be added by using multiple of the same flag or as an environment variable with comma-separated values." env:"HEADER_AUTH_TRUSTED_PROXIES" env-delim:","`
+	HeaderAuthLogoutURL      string   `long:"header-auth-logout-url" description:"URL ending the session of the reverse proxy, e.g. /oauth2/sign_out of oauth2-proxy" env:"HEADER_AUTH_LOGOUT_URL"`
+
+	StatusFeedURL           string            `long:"status-feed-url" description:"URL of a JSON Feed to display as a News Feed on the client Status page." default:"https://www.influxdata.com/feed/json" env:"STATUS_FEED_URL"`
+	CustomLinks             map[string]string `long:"custom-link" description:"Custom link to be added to the client User menu. Multiple links can be added by using multiple of the same flag with different 'name:url' values, or as an environment variable with comma-separated 'name:url' values. E.g. via flags: '--custom-link=InfluxData:https://www.influxdata.com --custom-link=Chronograf:https://github.com/influxdata/influxdb/chronograf'. E.g. via environment variable: 'export CUSTOM_LINKS=InfluxData:https://www.influxdata.com,Chronograf:https://github.com/influxdata/influxdb/chronograf'" env:"CUSTOM_LINKS" env-delim:","`
+	Plugins                 map[string]string `long:"plugin" description:"Sidecar plugin providing additional source types and cell types. Multiple plugins can be added by using multiple of the same flag with different 'name:url' values, or as an environment variable with comma-separated 'name:url' values. E.g. via flags: '--plugin=opentsdb:http://localhost:9200'" env:"PLUGINS" env-delim:","`
+	TelegrafSystemInterval  time.Duration     `long:"telegraf-system-interval" default:"1m" description:"Duration used in the GROUP BY time interval for the hosts list" env:"TELEGRAF_SYSTEM_INTERVAL"`
+	HealthCheckInterval     time.Duration     `long:"health-check-interval" default:"1m" description:"Interval between the health checks of sources and Kapacitors. 0 disables health checks." env:"HEALTH_CHECK_INTERVAL"`
+	SourceDiscoveryInterval time.Duration     `long:"source-discovery-interval" default:"5m" description:"Interval between syncs of the sources discovered from InfluxDB Enterprise meta nodes with their clusters. 0 disables syncs." env:"SOURCE_DISCOVERY_INTERVAL"`
+	AlertCheckInterval      time.Duration     `long:"alert-check-interval" default:"10s" description:"Interval between the evaluations of due alert checks, which Chronograf runs without Kapacitor. 0 disables alert checks." env:"ALERT_CHECK_INTERVAL"`
+
+	SMTPHost     string `long:"smtp-host" description:"Host of the SMTP server delivering scheduled dashboard reports. Reports are not delivered when empty." env:"SMTP_HOST"`
+	SMTPPort     int    `long:"smtp-port" description:"Port of the SMTP server" default:"25" env:"SMTP_PORT"`
+	SMTPUsername string `long:"smtp-username" description:"Username authenticating with the SMTP server" env:"SMTP_USERNAME"`
+	SMTPPassword string `long:"smtp-password" description:"Password authenticating with the SMTP server" env:"SMTP_PASSWORD"`
+	SMTPFrom     string `long:"smtp-from" description:"Sender address of scheduled dashboard reports" default:"chronograf@localhost" env:"SMTP_FROM"`
 
 	ReportingDisabled bool   `short:"r" long:"reporting-disabled" description:"Disable reporting of usage stats (os,arch,version,cluster_id,uptime) once every 24hr" env:"REPORTING_DISABLED"`
 	LogLevel          string `short:"l" long:"log-level" value-name:"choice" choice:"debug" choice:"info" choice:"error" default:"info" description:"Set the logging level" env:"LOG_LEVEL"` //lint:ignore SA5008 duplicate tag choice is expected with go-flags.
+	LogFormat         string `long:"log-format" value-name:"choice" choice:"logfmt" choice:"json" default:"logfmt" description:"Set the format of the log entries" env:"LOG_FORMAT"`         //lint:ignore SA5008 duplicate tag choice is expected with go-flags.
 	Basepath          string `short:"p" long:"basepath" description:"A URL path prefix under which all chronograf routes will be mounted. (Note: PREFIX_ROUTES has been deprecated. Now, if basepath is set, all routes will be prefixed with it.)" env:"BASE_PATH"`
 	ShowVersion       bool   `short:"v" long:"version" description:"Show Chronograf version info"`
 	BuildInfo         chronograf.BuildInfo
+	ReloadOptions     func(*Server) error // ReloadOptions reads the options again on SIGHUP; the options are kept when nil
 	Listener          net.Listener
 	handler           http.Handler
+	keypair           *keypair
+	keysCache         *oauth2.Cache // keysCache caches the JWKS document of JwksURL
+	groupsCache       *oauth2.Cache // groupsCache caches the groups of users looked up from OAuth 2 providers
 }
 
 func provide(p oauth2.Provider, m oauth2.Mux, ok func() bool) func(func(oauth2.Provider, oauth2.Mux)) {
@@ -114,6 +240,30 @@ func provide(p oauth2.Provider, m oauth2.Mux, ok func() bool) func(func(oauth2.P
 	}
 }
 
+// uniqueProviders checks that the names of the enabled OAuth2 providers are
+// unique, as they name the routes of the providers and the namespaces of their
+// users. The names of the basic, ldap, saml and proxy providers are reserved,
+// as schemeOf tells their users apart by them.
+func uniqueProviders(providerFuncs []func(func(oauth2.Provider, oauth2.Mux))) error {
+	names := map[string]bool{
+		BasicProvider:  true,
+		LDAPProvider:   true,
+		SAMLProvider:   true,
+		HeaderProvider: true,
+	}
+	var err error
+	for _, pf := range providerFuncs {
+		pf(func(p oauth2.Provider, _ oauth2.Mux) {
+			name := strings.ToLower(p.Name())
+			if names[name] && err == nil {
+				err = fmt.Errorf("more than one authentication provider is named %s", p.Name())
+			}
+			names[name] = true
+		})
+	}
+	return err
+}
+
 // UseGithub validates the CLI parameters to enable github oauth support
 func (s *Server) UseGithub() bool {
 	return s.TokenSecret != "" && s.GithubClientID != "" && s.GithubClientSecret != ""
@@ -141,6 +291,27 @@ func (s *Server) UseGenericOAuth2() bool {
 		s.GenericTokenURL != ""
 }
 
+// UseOIDC validates the CLI parameters to enable OpenID Connect support
+func (s *Server) UseOIDC() bool {
+	return s.TokenSecret != "" && s.OIDCIssuerURL != "" &&
+		s.OIDCClientID != "" && s.OIDCClientSecret != "" &&
+		s.PublicURL != ""
+}
+
+// jwt signs and validates tokens, caching the keys of JwksURL
+func (s *Server) jwt() *oauth2.JWT {
+	jwt := oauth2.NewJWT(s.TokenSecret, s.JwksURL, s.PreviousSecrets...)
+	jwt.Keys = s.keysCache
+	return jwt
+}
+
+// authMux logs users in with the OAuth 2 provider p, caching their groups
+func (s *Server) authMux(p oauth2.Provider, auth oauth2.Authenticator, logger chronograf.Logger) *oauth2.AuthMux {
+	mux := oauth2.NewAuthMux(p, auth, s.jwt(), s.Basepath, logger, s.UseIDToken)
+	mux.Groups = s.groupsCache
+	return mux
+}
+
 func (s *Server) githubOAuth(logger chronograf.Logger, auth oauth2.Authenticator) (oauth2.Provider, oauth2.Mux, func() bool) {
 	gh := oauth2.Github{
 		ClientID:     s.GithubClientID,
@@ -148,8 +319,7 @@ func (s *Server) githubOAuth(logger chronograf.Logger, auth oauth2.Authenticator
 		Orgs:         s.GithubOrgs,
 		Logger:       logger,
 	}
-	jwt := oauth2.NewJWT(s.TokenSecret, s.JwksURL)
-	ghMux := oauth2.NewAuthMux(&gh, auth, jwt, s.Basepath, logger, s.UseIDToken)
+	ghMux := s.authMux(&gh, auth, logger)
 	return &gh, ghMux, s.UseGithub
 }
 
@@ -162,8 +332,7 @@ func (s *Server) googleOAuth(logger chronograf.Logger, auth oauth2.Authenticator
 		RedirectURL:  redirectURL,
 		Logger:       logger,
 	}
-	jwt := oauth2.NewJWT(s.TokenSecret, s.JwksURL)
-	goMux := oauth2.NewAuthMux(&google, auth, jwt, s.Basepath, logger, s.UseIDToken)
+	goMux := s.authMux(&google, auth, logger)
 	return &google, goMux, s.UseGoogle
 }
 
@@ -174,8 +343,7 @@ func (s *Server) herokuOAuth(logger chronograf.Logger, auth oauth2.Authenticator
 		Organizations: s.HerokuOrganizations,
 		Logger:        logger,
 	}
-	jwt := oauth2.NewJWT(s.TokenSecret, s.JwksURL)
-	hMux := oauth2.NewAuthMux(&heroku, auth, jwt, s.Basepath, logger, s.UseIDToken)
+	hMux := s.authMux(&heroku, auth, logger)
 	return &heroku, hMux, s.UseHeroku
 }
 
@@ -191,13 +359,30 @@ func (s *Server) genericOAuth(logger chronograf.Logger, auth oauth2.Authenticato
 		TokenURL:       s.GenericTokenURL,
 		APIURL:         s.GenericAPIURL,
 		APIKey:         s.GenericAPIKey,
+		GroupsKey:      s.GenericGroupsKey,
 		Logger:         logger,
 	}
-	jwt := oauth2.NewJWT(s.TokenSecret, s.JwksURL)
-	genMux := oauth2.NewAuthMux(&gen, auth, jwt, s.Basepath, logger, s.UseIDToken)
+	genMux := s.authMux(&gen, auth, logger)
 	return &gen, genMux, s.UseGenericOAuth2
 }
 
+func (s *Server) oidcOAuth(logger chronograf.Logger, auth oauth2.Authenticator) (*oauth2.OIDC, oauth2.Mux, func() bool) {
+	oidc := oauth2.OIDC{
+		PageName:       s.OIDCName,
+		ClientID:       s.OIDCClientID,
+		ClientSecret:   s.OIDCClientSecret,
+		RequiredScopes: s.OIDCScopes,
+		Domains:        s.OIDCDomains,
+		IssuerURL:      s.OIDCIssuerURL,
+		UsernameClaim:  s.OIDCUsernameClaim,
+		GroupsClaim:    s.OIDCGroupsClaim,
+		Logger:         logger,
+	}
+	oidc.RedirectURL = s.PublicURL + s.Basepath + "/oauth/" + oidc.Name() + "/callback"
+	oidcMux := s.authMux(&oidc, auth, logger)
+	return &oidc, oidcMux, s.UseOIDC
+}
+
 func (s *Server) auth0OAuth(logger chronograf.Logger, auth oauth2.Authenticator) (oauth2.Provider, oauth2.Mux, func() bool) {
 	redirectPath := path.Join(s.Basepath, "oauth", "auth0", "callback")
 	redirectURL, err := url.Parse(s.PublicURL)
@@ -209,8 +394,7 @@ func (s *Server) auth0OAuth(logger chronograf.Logger, auth oauth2.Authenticator)
 
 	auth0, err := oauth2.NewAuth0(s.Auth0Domain, s.Auth0ClientID, s.Auth0ClientSecret, redirectURL.String(), s.Auth0Organizations, logger)
 
-	jwt := oauth2.NewJWT(s.TokenSecret, s.JwksURL)
-	genMux := oauth2.NewAuthMux(&auth0, auth, jwt, s.Basepath, logger, s.UseIDToken)
+	genMux := s.authMux(&auth0, auth, logger)
 
 	if err != nil {
 		logger.Error("Error parsing Auth0 domain: err:", err)
@@ -238,8 +422,112 @@ func (s *Server) genericRedirectURL() string {
 	return publicURL.String()
 }
 
+// UseBasicAuth validates the CLI parameters to enable username and password login
+func (s *Server) UseBasicAuth() bool {
+	return s.TokenSecret != "" && s.BasicAuth
+}
+
+// UseLDAP validates the CLI parameters to enable LDAP login
+func (s *Server) UseLDAP() bool {
+	return s.TokenSecret != "" && s.LDAPURL != "" && s.LDAPUserBaseDN != ""
+}
+
+// ldapDirectory returns the directory authenticating users of the ldap
+// scheme or nil if LDAP login is disabled
+func (s *Server) ldapDirectory() LDAPDirectory {
+	if !s.UseLDAP() {
+		return nil
+	}
+	return &ldap.Directory{
+		URL:                s.LDAPURL,
+		InsecureSkipVerify: s.LDAPInsecureSkipVerify,
+		Timeout:            s.LDAPTimeout,
+		BindDN:             s.LDAPBindDN,
+		BindPassword:       s.LDAPBindPassword,
+		UserBaseDN:         s.LDAPUserBaseDN,
+		UserFilter:         s.LDAPUserFilter,
+		GroupBaseDN:        s.LDAPGroupBaseDN,
+		GroupFilter:        s.LDAPGroupFilter,
+		GroupAttribute:     s.LDAPGroupAttribute,
+	}
+}
+
+// UseSAML validates the CLI parameters to enable SAML login
+func (s *Server) UseSAML() bool {
+	return s.TokenSecret != "" && s.SAMLIDPMetadata != "" && s.PublicURL != ""
+}
+
+// samlAuth loads the metadata of the identity provider and returns the
+// service provider authenticating users of the saml scheme or nil if SAML
+// login is disabled
+func (s *Server) samlAuth() (*SAMLAuth, error) {
+	if !s.UseSAML() {
+		return nil, nil
+	}
+	idp, err := saml.LoadMetadata(s.SAMLIDPMetadata, 30*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	base := strings.TrimSuffix(s.PublicURL, "/") + s.Basepath
+	entityID := s.SAMLEntityID
+	if entityID == "" {
+		entityID = base + samlMetadataPath
+	}
+	return &SAMLAuth{
+		SP: &saml.ServiceProvider{
+			EntityID:        entityID,
+			ACSURL:          base + samlACSPath,
+			IDP:             *idp,
+			GroupsAttribute: s.SAMLGroupsAttribute,
+			ClockSkew:       saml.DefaultClockSkew,
+		},
+		Tokens: s.jwt(),
+	}, nil
+}
+
+// UseHeaderAuth validates the CLI parameters to enable the authentication
+// of users by the headers of a reverse proxy
+func (s *Server) UseHeaderAuth() bool {
+	return s.HeaderAuthUser != ""
+}
+
+// headerAuth is the authentication of users of the header scheme or nil if
+// it is disabled
+func (s *Server) headerAuth() (*HeaderAuth, error) {
+	if !s.UseHeaderAuth() {
+		return nil, nil
+	}
+	proxies, err := parseTrustedProxies(s.HeaderAuthTrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+	return &HeaderAuth{
+		UserHeader:     s.HeaderAuthUser,
+		GroupsHeader:   s.HeaderAuthGroups,
+		TrustedProxies: proxies,
+		LogoutURL:      s.HeaderAuthLogoutURL,
+	}, nil
+}
+
 func (s *Server) useAuth() bool {
-	return s.UseGithub() || s.UseGoogle() || s.UseHeroku() || s.UseGenericOAuth2() || s.UseAuth0()
+	return s.UseGithub() || s.UseGoogle() || s.UseHeroku() || s.UseGenericOAuth2() || s.UseAuth0() || s.UseOIDC() || s.UseBasicAuth() || s.UseLDAP() || s.UseSAML() || s.UseHeaderAuth()
+}
+
+// autoProvisionUsers stores the auto-provisioning policy of the command line
+// in the auth config, where it is otherwise managed by the API
+func (s *Server) autoProvisionUsers(ctx context.Context, service Service) error {
+	serverCtx := serverContext(ctx)
+	cfg, err := service.Store.Config(serverCtx).Get(serverCtx)
+	if err != nil {
+		return err
+	}
+	cfg.Auth.AutoProvisionNewUsers = true
+	cfg.Auth.NewUsersOrganization = s.NewUsersOrganization
+	cfg.Auth.NewUsersRole = s.NewUsersRole
+	if err := service.validAuthConfig(serverCtx, &cfg.Auth); err != nil {
+		return err
+	}
+	return service.Store.Config(serverCtx).Update(serverCtx, cfg)
 }
 
 func (s *Server) useTLS() bool {
@@ -262,15 +550,26 @@ func (s *Server) NewListener() (net.Listener, error) {
 		s.Key = s.Cert
 	}
 
-	cert, err := tls.LoadX509KeyPair(string(s.Cert), string(s.Key))
-	if err != nil {
+	// The certificate is loaded again when the options are reloaded
+	s.keypair = &keypair{}
+	if err := s.keypair.load(string(s.Cert), string(s.Key)); err != nil {
 		return nil, err
 	}
 
-	listener, err := tls.Listen("tcp", addr, &tls.Config{
-		Certificates: []tls.Certificate{cert},
-	})
+	config := &tls.Config{
+		GetCertificate: s.keypair.GetCertificate,
+	}
+	// Client certificates are optional as browsers log in without one
+	if s.TLSClientCA != "" {
+		cas, err := s.clientCAs()
+		if err != nil {
+			return nil, err
+		}
+		config.ClientCAs = cas
+		config.ClientAuth = tls.VerifyClientCertIfGiven
+	}
 
+	listener, err := tls.Listen("tcp", addr, config)
 	if err != nil {
 		return nil, err
 	}
@@ -320,44 +619,67 @@ func (s *Server) newBuilders(logger chronograf.Logger) builders {
 	}
 }
 
-// Serve starts and runs the chronograf server
-func (s *Server) Serve(ctx context.Context) error {
-	logger := &chronograf.NoopLogger{}
-	_, err := NewCustomLinks(s.CustomLinks)
+// newHandler routes the requests to the service, authenticating them with
+// the auth providers of the options. It is called again when the options are
+// reloaded.
+func (s *Server) newHandler(ctx context.Context, logger chronograf.Logger, service Service, m *metrics.Metrics) (http.Handler, error) {
+	service.ServerOptions = s.Options()
+	s.keysCache = oauth2.NewCache(s.JWKSCacheTTL, s.IdPCacheStale)
+	s.groupsCache = oauth2.NewCache(s.GroupCacheTTL, s.IdPCacheStale)
+	samlAuth, err := s.samlAuth()
 	if err != nil {
 		logger.
 			WithField("component", "server").
-			WithField("CustomLink", "invalid").
+			WithField("saml", "metadata").
 			Error(err)
-		return err
-	}
-	service := openService(ctx, s.BuildInfo, s.BoltPath, s.newBuilders(logger), logger, s.useAuth())
-	service.SuperAdminProviderGroups = superAdminProviderGroups{
-		auth0: s.Auth0SuperAdminOrg,
-	}
-	service.Env = chronograf.Environment{
-		TelegrafSystemInterval: s.TelegrafSystemInterval,
+		return nil, err
 	}
 
-	if !validBasepath(s.Basepath) {
-		err := fmt.Errorf("invalid basepath, must follow format \"/mybasepath\"")
+	headerAuth, err := s.headerAuth()
+	if err != nil {
 		logger.
 			WithField("component", "server").
-			WithField("basepath", "invalid").
+			WithField("header_auth", "trusted_proxies").
 			Error(err)
-		return err
+		return nil, err
 	}
 
 	providerFuncs := []func(func(oauth2.Provider, oauth2.Mux)){}
 
-	auth := oauth2.NewCookieJWT(s.TokenSecret, s.AuthDuration)
+	auth := oauth2.NewSlidingCookieJWT(s.TokenSecret, s.AuthDuration, s.IdleTimeout, s.PreviousSecrets...)
 	providerFuncs = append(providerFuncs, provide(s.githubOAuth(logger, auth)))
 	providerFuncs = append(providerFuncs, provide(s.googleOAuth(logger, auth)))
 	providerFuncs = append(providerFuncs, provide(s.herokuOAuth(logger, auth)))
 	providerFuncs = append(providerFuncs, provide(s.genericOAuth(logger, auth)))
 	providerFuncs = append(providerFuncs, provide(s.auth0OAuth(logger, auth)))
 
-	s.handler = NewMux(MuxOpts{
+	oidc, oidcMux, useOIDC := s.oidcOAuth(logger, auth)
+	if useOIDC() {
+		if err := oidc.Discover(ctx); err != nil {
+			logger.
+				WithField("component", "server").
+				WithField("oidc", "discovery").
+				Error(err)
+			return nil, err
+		}
+	}
+	providerFuncs = append(providerFuncs, provide(oidc, oidcMux, useOIDC))
+	// Readiness probes check that the signing keys of id_tokens are fetchable
+	if s.UseIDToken && s.JwksURL != "" {
+		service.JWKSURLs = append(service.JWKSURLs, s.JwksURL)
+	}
+	if useOIDC() {
+		service.JWKSURLs = append(service.JWKSURLs, oidc.JWKSURL())
+	}
+	if err := uniqueProviders(providerFuncs); err != nil {
+		logger.
+			WithField("component", "server").
+			WithField("auth", "providers").
+			Error(err)
+		return nil, err
+	}
+
+	handler := NewMux(MuxOpts{
 		Develop:       s.Develop,
 		Auth:          auth,
 		Logger:        logger,
@@ -366,16 +688,152 @@ func (s *Server) Serve(ctx context.Context) error {
 		Basepath:      s.Basepath,
 		StatusFeedURL: s.StatusFeedURL,
 		CustomLinks:   s.CustomLinks,
+		SCIMToken:     s.SCIMToken,
+		BasicAuth:     s.UseBasicAuth(),
+		LDAP:          s.ldapDirectory(),
+		SAML:          samlAuth,
+		HeaderAuth:    headerAuth,
+		Metrics:       m,
+		MetricsToken:  s.MetricsToken,
+		Tracing:       s.useTracing(),
+		RateLimiter:   s.rateLimiter(m),
+		Impersonation: s.ImpersonationDuration,
+		Version:       s.BuildInfo.Version,
 	}, service)
 
 	// Add chronograf's version header to all requests
-	s.handler = Version(s.BuildInfo.Version, s.handler)
+	handler = Version(s.BuildInfo.Version, handler)
 
-	if s.useTLS() {
-		// Add HSTS to instruct all browsers to change from http to https
-		s.handler = HSTS(s.handler)
+	// Add HSTS, the Content-Security-Policy and the headers denying framing
+	// and MIME sniffing to all requests
+	handler = Secure(s.securityHeaders(), handler)
+	return handler, nil
+}
+
+// rateLimiter limits the requests of users and IPs; requests are not
+// limited when no rate is set
+func (s *Server) rateLimiter(m *metrics.Metrics) *RateLimiter {
+	if s.UserRequestsPerSecond <= 0 && s.IPRequestsPerSecond <= 0 {
+		return nil
+	}
+	return &RateLimiter{
+		UserPerSecond: s.UserRequestsPerSecond,
+		UserBurst:     s.UserRequestsBurst,
+		IPPerSecond:   s.IPRequestsPerSecond,
+		IPBurst:       s.IPRequestsBurst,
+		Metrics:       m,
+	}
+}
+
+// Serve starts and runs the chronograf server
+func (s *Server) Serve(ctx context.Context) error {
+	logger := clog.New(os.Stderr, clog.ParseFormat(s.LogFormat), clog.ParseLevel(s.LogLevel))
+	if err := s.Validate(); err != nil {
+		logger.
+			WithField("component", "server").
+			WithField("options", "invalid").
+			Error(err)
+		return err
+	}
+	cipher, err := s.cipher(ctx)
+	if err != nil {
+		logger.
+			WithField("component", "server").
+			WithField("encryption", "invalid").
+			Error(err)
+		return err
+	}
+	if s.useTracing() {
+		closer, err := s.tracer()
+		if err != nil {
+			logger.
+				WithField("component", "server").
+				WithField("tracing", "invalid").
+				Error(err)
+			return err
+		}
+		defer closer.Close()
+	}
+	// Sessions are active while their users make requests within the idle
+	// timeout that would otherwise expire them
+	idleTimeout := s.IdleTimeout
+	if idleTimeout <= 0 {
+		idleTimeout = oauth2.DefaultInactivityDuration
+	}
+	m := metrics.New(idleTimeout)
+	service := openService(ctx, s.BuildInfo, s.BoltPath, s.etcdClient(), s.postgresClient(), cipher, s.StoreCacheTTL, m, s.newBuilders(logger), logger, s.useAuth())
+	service.Metrics = m
+	service.SuperAdminProviderGroups = superAdminProviderGroups{
+		auth0: s.Auth0SuperAdminOrg,
+	}
+	service.SCIMProvider = s.SCIMProvider
+	if s.QueryCacheSize > 0 {
+		service.QueryCache = cache.NewQueryCache(s.QueryCacheSize)
+		service.QueryCacheTTL = s.QueryCacheTTL
+		service.CardinalityCache = cache.NewQueryCache(s.QueryCacheSize)
+		service.CardinalityCacheTTL = s.CardinalityTTL
+	}
+	service.QueryLimiter = &QueryLimiter{
+		SourceMaxConcurrent: s.SourceMaxConcurrentQueries,
+		SourcePerSecond:     s.SourceQueriesPerSecond,
+		UserMaxConcurrent:   s.UserMaxConcurrentQueries,
+		UserPerSecond:       s.UserQueriesPerSecond,
+		MaxRange:            s.MaxQueryRange,
+	}
+	service.Jobs = NewQueryJobs(s.QueryJobTTL, s.QueryJobTimeout)
+	service.AlertThrottler = NewAlertThrottler()
+	service.EventWebhooks = NewEventWebhooks(s.WebhookAttempts, s.WebhookRetryDelay)
+	if s.AutoProvisionUsers {
+		if err := s.autoProvisionUsers(ctx, service); err != nil {
+			logger.
+				WithField("component", "server").
+				WithField("auth", "auto-provisioning").
+				Error(err)
+			return err
+		}
+	}
+	service.TemplateURLHosts = s.TemplateURLHosts
+	service.Env = chronograf.Environment{
+		TelegrafSystemInterval: s.TelegrafSystemInterval,
+	}
+	service.Plugins = &Plugins{}
+	for name, u := range s.Plugins {
+		if err := service.Plugins.Register(ctx, name, u); err != nil {
+			logger.
+				WithField("component", "server").
+				WithField("plugin", name).
+				Error(err)
+		}
 	}
 
+	if s.SMTPHost != "" {
+		service.Mailer = &reports.SMTP{
+			Host:     s.SMTPHost,
+			Port:     s.SMTPPort,
+			Username: s.SMTPUsername,
+			Password: s.SMTPPassword,
+			From:     s.SMTPFrom,
+		}
+		go service.RunReports(ctx, minReportInterval)
+	}
+	if s.HealthCheckInterval > 0 {
+		go service.RunHealthChecks(ctx, s.HealthCheckInterval)
+	}
+	if s.SourceDiscoveryInterval > 0 {
+		go service.RunSourceDiscovery(ctx, s.SourceDiscoveryInterval)
+	}
+	if s.AlertCheckInterval > 0 {
+		go service.RunAlertChecks(ctx, s.AlertCheckInterval)
+	}
+
+	handler, err := s.newHandler(ctx, logger, service, m)
+	if err != nil {
+		return err
+	}
+	reloadable := &reloadableHandler{}
+	reloadable.store(handler)
+	s.handler = reloadable
+
 	listener, err := s.NewListener()
 	if err != nil {
 		logger.
@@ -385,12 +843,25 @@ func (s *Server) Serve(ctx context.Context) error {
 	}
 	s.Listener = listener
 
+	if s.GRPCPort > 0 {
+		stopAdmin, err := s.serveAdmin(&service, logger)
+		if err != nil {
+			logger.
+				WithField("component", "server").
+				Error(err)
+			return err
+		}
+		defer stopAdmin()
+	}
+
 	// Using a log writer for http server logging
 	w := logger.Writer()
 	defer w.Close()
 	stdLog := log.New(w, "", 0)
 
 	// TODO: Remove graceful when changing to go 1.8
+	// SIGTERM and SIGINT stop accepting connections and drain the in-flight
+	// requests for up to the shutdown timeout
 	httpServer := &graceful.Server{
 		Server: &http.Server{
 			ErrorLog: stdLog,
@@ -398,9 +869,37 @@ func (s *Server) Serve(ctx context.Context) error {
 		},
 		Logger:       stdLog,
 		TCPKeepAlive: 5 * time.Second,
+		Timeout:      s.ShutdownTimeout,
+		ShutdownInitiated: func() {
+			logger.
+				WithField("component", "server").
+				Info("Draining in-flight requests for up to ", s.ShutdownTimeout)
+		},
 	}
 	httpServer.SetKeepAlivesEnabled(true)
 
+	// SIGHUP reloads the options without dropping sessions or requests
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	defer func() {
+		signal.Stop(hangup)
+		close(hangup)
+	}()
+	go func() {
+		for range hangup {
+			if err := s.reload(ctx, logger, reloadable, service, m); err != nil {
+				logger.
+					WithField("component", "server").
+					WithField("reload", "invalid").
+					Error(err)
+				continue
+			}
+			logger.
+				WithField("component", "server").
+				Info("Reloaded options")
+		}
+	}()
+
 	if !s.ReportingDisabled {
 		go reportUsageStats(s.BuildInfo, logger)
 	}
@@ -435,12 +934,25 @@ func NewServiceV2(ctx context.Context, d *bbolt.DB) (*Service, error) {
 	}
 
 	logger := &chronograf.NoopLogger{}
+	dashboardSearch := search.NewDashboardsStore(db.DashboardsStore)
 
 	return &Service{
 		TimeSeriesClient: &InfluxClient{},
 		Store: &DirectStore{
 			LayoutsStore:            db.LayoutsStore,
-			DashboardsStore:         db.DashboardsStore,
+			DashboardsStore:         dashboardSearch,
+			DashboardVersionsStore:  db.DashboardVersionsStore,
+			DashboardSnapshotsStore: db.DashboardSnapshotsStore,
+			FoldersStore:            db.FoldersStore,
+			ReportsStore:            db.ReportsStore,
+			AnnotationsStore:        db.AnnotationsStore,
+			SourceHealthStore:       db.SourceHealthStore,
+			QueryHistoryStore:       db.QueryHistoryStore,
+			AlertEventsStore:        db.AlertEventsStore,
+			AlertWebhooksStore:      db.AlertWebhooksStore,
+			AlertChecksStore:        db.AlertChecksStore,
+			AlertPoliciesStore:      db.AlertPoliciesStore,
+			RuleTemplatesStore:      db.RuleTemplatesStore,
 			SourcesStore:            db.SourcesStore,
 			ServersStore:            db.ServersStore,
 			OrganizationsStore:      db.OrganizationsStore,
@@ -448,16 +960,80 @@ func NewServiceV2(ctx context.Context, d *bbolt.DB) (*Service, error) {
 			ConfigStore:             db.ConfigStore,
 			MappingsStore:           db.MappingsStore,
 			OrganizationConfigStore: db.OrganizationConfigStore,
+			NotificationsStore:      db.NotificationsStore,
+			TokensStore:             db.TokensStore,
+			InvitationsStore:        db.InvitationsStore,
+			PreferencesStore:        db.PreferencesStore,
+			CertificatesStore:       db.CertificatesStore,
+			GroupsStore:             db.GroupsStore,
+			WebhooksStore:           db.WebhooksStore,
+			WebhookDeliveriesStore:  db.WebhookDeliveriesStore,
 		},
 		// TODO(desa): what to do about logger
 		Logger: logger,
 		Databases: &influx.Client{
 			Logger: logger,
 		},
+		Notifier:         NewNotifier(),
+		DashboardStreams: NewDashboardStreams(),
+		DashboardSearch:  dashboardSearch,
 	}, nil
 }
 
-func openService(ctx context.Context, buildInfo chronograf.BuildInfo, boltPath string, builder builders, logger chronograf.Logger, useAuth bool) Service {
+// etcdClient returns the client of the etcd cluster or nil if the stores
+// are kept in the boltDB file only
+func (s *Server) etcdClient() *etcd.Client {
+	if len(s.EtcdEndpoints) == 0 {
+		return nil
+	}
+	c := etcd.NewClient()
+	c.Endpoints = s.EtcdEndpoints
+	c.Prefix = s.EtcdPrefix
+	c.Username = s.EtcdUsername
+	c.Password = s.EtcdPassword
+	return c
+}
+
+// postgresClient returns the client of the PostgreSQL database or nil if the
+// stores are kept in the boltDB file only
+func (s *Server) postgresClient() *postgres.Client {
+	if s.PostgresURL == "" {
+		return nil
+	}
+	c := postgres.NewClient()
+	c.URL = s.PostgresURL
+	c.MaxOpenConns = s.PostgresMaxConn
+	return c
+}
+
+// cipher returns the cipher encrypting the secrets in the stores or nil if
+// they are stored in CLEARTEXT
+func (s *Server) cipher(ctx context.Context) (*encryption.Cipher, error) {
+	var wrapper encryption.KeyWrapper
+	switch {
+	case s.EncryptionKey != "" && s.VaultAddr != "":
+		return nil, fmt.Errorf("only one of encryption-key and encryption-vault-addr can be set")
+	case s.EncryptionKey != "":
+		k, err := encryption.NewLocalKey(s.EncryptionKey)
+		if err != nil {
+			return nil, err
+		}
+		wrapper = k
+	case s.VaultAddr != "":
+		wrapper = &encryption.VaultTransit{
+			Addr:    s.VaultAddr,
+			Token:   s.VaultToken,
+			Mount:   s.VaultMount,
+			Key:     s.VaultKey,
+			Timeout: 10 * time.Second,
+		}
+	default:
+		return nil, nil
+	}
+	return encryption.NewCipher(ctx, wrapper)
+}
+
+func openService(ctx context.Context, buildInfo chronograf.BuildInfo, boltPath string, etcdDB *etcd.Client, pgDB *postgres.Client, cipher *encryption.Cipher, cacheTTL time.Duration, m *metrics.Metrics, builder builders, logger chronograf.Logger, useAuth bool) Service {
 	db := bolt.NewClient()
 	db.Path = boltPath
 
@@ -468,7 +1044,77 @@ func openService(ctx context.Context, buildInfo chronograf.BuildInfo, boltPath s
 		os.Exit(1)
 	}
 
-	layouts, err := builder.Layouts.Build(db.LayoutsStore)
+	var (
+		layoutsStore    chronograf.LayoutsStore    = db.LayoutsStore
+		dashboardsStore chronograf.DashboardsStore = db.DashboardsStore
+		sourcesStore    chronograf.SourcesStore    = db.SourcesStore
+		serversStore    chronograf.ServersStore    = db.ServersStore
+		usersStore      chronograf.UsersStore      = db.UsersStore
+	)
+	// Replicas share the stores kept in etcd
+	if etcdDB != nil {
+		if err := etcdDB.Open(ctx, logger); err != nil {
+			logger.
+				WithField("component", "etcdstore").
+				Error(err)
+			os.Exit(1)
+		}
+		layoutsStore = etcdDB.LayoutsStore
+		dashboardsStore = etcdDB.DashboardsStore
+		sourcesStore = etcdDB.SourcesStore
+		serversStore = etcdDB.ServersStore
+		usersStore = etcdDB.UsersStore
+
+		db.OrganizationsStore.SourcesStore = etcdDB.SourcesStore
+		db.OrganizationsStore.ServersStore = etcdDB.ServersStore
+		db.OrganizationsStore.DashboardsStore = etcdDB.DashboardsStore
+		db.OrganizationsStore.UsersStore = etcdDB.UsersStore
+	}
+	// Replicas share the stores kept in PostgreSQL
+	if pgDB != nil {
+		if err := pgDB.Open(ctx, logger); err != nil {
+			logger.
+				WithField("component", "postgresstore").
+				Error(err)
+			os.Exit(1)
+		}
+		layoutsStore = pgDB.LayoutsStore
+		dashboardsStore = pgDB.DashboardsStore
+		sourcesStore = pgDB.SourcesStore
+		serversStore = pgDB.ServersStore
+		usersStore = pgDB.UsersStore
+
+		db.OrganizationsStore.SourcesStore = pgDB.SourcesStore
+		db.OrganizationsStore.ServersStore = pgDB.ServersStore
+		db.OrganizationsStore.DashboardsStore = pgDB.DashboardsStore
+		db.OrganizationsStore.UsersStore = pgDB.UsersStore
+	}
+	// Operations of the stores, which may be remote, are timed
+	if m != nil {
+		layoutsStore = metrics.NewLayoutsStore(layoutsStore, m)
+		dashboardsStore = metrics.NewDashboardsStore(dashboardsStore, m)
+		sourcesStore = metrics.NewSourcesStore(sourcesStore, m)
+		serversStore = metrics.NewServersStore(serversStore, m)
+		usersStore = metrics.NewUsersStore(usersStore, m)
+	}
+	// Passwords of sources and kapacitors are encrypted at rest
+	if cipher != nil {
+		sourcesStore = encryption.NewSourcesStore(sourcesStore, cipher)
+		serversStore = encryption.NewServersStore(serversStore, cipher)
+	}
+	// Frequent reads of users, sources and dashboards are cached
+	if cacheTTL > 0 {
+		sourcesStore = cache.NewSourcesStore(sourcesStore, cacheTTL)
+		dashboardsStore = cache.NewDashboardsStore(dashboardsStore, cacheTTL)
+		usersStore = cache.NewUsersStore(usersStore, cacheTTL)
+
+		// Deleting an organization flushes the caches of its resources
+		db.OrganizationsStore.SourcesStore = sourcesStore
+		db.OrganizationsStore.DashboardsStore = dashboardsStore
+		db.OrganizationsStore.UsersStore = usersStore
+	}
+
+	layouts, err := builder.Layouts.Build(layoutsStore)
 	if err != nil {
 		logger.
 			WithField("component", "LayoutsStore").
@@ -476,14 +1122,14 @@ func openService(ctx context.Context, buildInfo chronograf.BuildInfo, boltPath s
 		os.Exit(1)
 	}
 
-	dashboards, err := builder.Dashboards.Build(db.DashboardsStore)
+	dashboards, err := builder.Dashboards.Build(dashboardsStore)
 	if err != nil {
 		logger.
 			WithField("component", "DashboardsStore").
 			Error("Unable to construct a MultiDashboardsStore", err)
 		os.Exit(1)
 	}
-	sources, err := builder.Sources.Build(db.SourcesStore)
+	sources, err := builder.Sources.Build(sourcesStore)
 	if err != nil {
 		logger.
 			WithField("component", "SourcesStore").
@@ -491,7 +1137,7 @@ func openService(ctx context.Context, buildInfo chronograf.BuildInfo, boltPath s
 		os.Exit(1)
 	}
 
-	kapacitors, err := builder.Kapacitors.Build(db.ServersStore)
+	kapacitors, err := builder.Kapacitors.Build(serversStore)
 	if err != nil {
 		logger.
 			WithField("component", "KapacitorStore").
@@ -507,22 +1153,49 @@ func openService(ctx context.Context, buildInfo chronograf.BuildInfo, boltPath s
 		os.Exit(1)
 	}
 
+	// Dashboards written through the store are indexed for search
+	dashboardSearch := search.NewDashboardsStore(dashboards)
+
 	return Service{
 		TimeSeriesClient: &InfluxClient{},
 		Store: &Store{
 			LayoutsStore:            layouts,
-			DashboardsStore:         dashboards,
+			DashboardsStore:         dashboardSearch,
+			DashboardVersionsStore:  db.DashboardVersionsStore,
+			DashboardSnapshotsStore: db.DashboardSnapshotsStore,
+			FoldersStore:            db.FoldersStore,
+			ReportsStore:            db.ReportsStore,
+			AnnotationsStore:        db.AnnotationsStore,
+			SourceHealthStore:       db.SourceHealthStore,
+			QueryHistoryStore:       db.QueryHistoryStore,
+			AlertEventsStore:        db.AlertEventsStore,
+			AlertWebhooksStore:      db.AlertWebhooksStore,
+			AlertChecksStore:        db.AlertChecksStore,
+			AlertPoliciesStore:      db.AlertPoliciesStore,
+			RuleTemplatesStore:      db.RuleTemplatesStore,
 			SourcesStore:            sources,
 			ServersStore:            kapacitors,
 			OrganizationsStore:      organizations,
-			UsersStore:              db.UsersStore,
+			UsersStore:              usersStore,
 			ConfigStore:             db.ConfigStore,
 			MappingsStore:           db.MappingsStore,
 			OrganizationConfigStore: db.OrganizationConfigStore,
+			NotificationsStore:      db.NotificationsStore,
+			TokensStore:             db.TokensStore,
+			InvitationsStore:        db.InvitationsStore,
+			PreferencesStore:        db.PreferencesStore,
+			CertificatesStore:       db.CertificatesStore,
+			GroupsStore:             db.GroupsStore,
+			WebhooksStore:           db.WebhooksStore,
+			WebhookDeliveriesStore:  db.WebhookDeliveriesStore,
 		},
-		Logger:    logger,
-		UseAuth:   useAuth,
-		Databases: &influx.Client{Logger: logger},
+		Logger:           logger,
+		UseAuth:          useAuth,
+		Databases:        &influx.Client{Logger: logger},
+		Notifier:         NewNotifier(),
+		DashboardStreams: NewDashboardStreams(),
+		DashboardSearch:  dashboardSearch,
+		CheckStores:      db.CheckWritable,
 	}
 }
 
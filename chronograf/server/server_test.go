@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/bouk/httprouter"
+	"github.com/influxdata/influxdb/chronograf/oauth2"
 )
 
 // WithContext is a helper function to cut down on boilerplate in server test files
@@ -73,3 +74,43 @@ func Test_validBasepath(t *testing.T) {
 		})
 	}
 }
+
+func Test_uniqueProviders(t *testing.T) {
+	providers := func(names ...string) []func(func(oauth2.Provider, oauth2.Mux)) {
+		pfs := []func(func(oauth2.Provider, oauth2.Mux)){}
+		for _, name := range names {
+			p := &oauth2.Generic{PageName: name}
+			pfs = append(pfs, provide(p, &oauth2.AuthMux{}, func() bool { return true }))
+		}
+		// Disabled providers never conflict
+		pfs = append(pfs, provide(&oauth2.Generic{PageName: "github"}, &oauth2.AuthMux{}, func() bool { return false }))
+		return pfs
+	}
+	tests := []struct {
+		name    string
+		names   []string
+		wantErr bool
+	}{
+		{
+			name:  "Several providers",
+			names: []string{"github", "google", "oidc"},
+		},
+		{
+			name:    "Providers of the same name",
+			names:   []string{"github", "oidc", "GitHub"},
+			wantErr: true,
+		},
+		{
+			name:    "Reserved name",
+			names:   []string{"github", LDAPProvider},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := uniqueProviders(providers(tt.names...)); (err != nil) != tt.wantErr {
+				t.Errorf("uniqueProviders() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
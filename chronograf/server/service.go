@@ -3,10 +3,15 @@ package server
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/cache"
 	"github.com/influxdata/influxdb/chronograf/enterprise"
 	"github.com/influxdata/influxdb/chronograf/influx"
+	"github.com/influxdata/influxdb/chronograf/metrics"
+	"github.com/influxdata/influxdb/chronograf/reports"
+	"github.com/influxdata/influxdb/chronograf/search"
 )
 
 // Service handles REST calls to the persistence
@@ -18,6 +23,25 @@ type Service struct {
 	SuperAdminProviderGroups superAdminProviderGroups
 	Env                      chronograf.Environment
 	Databases                chronograf.Databases
+	Plugins                  *Plugins
+	Notifier                 *Notifier
+	DashboardStreams         *DashboardStreams
+	DashboardSearch          *search.DashboardsStore
+	Mailer                   reports.Mailer
+	SCIMProvider             string
+	QueryCache               *cache.QueryCache
+	QueryCacheTTL            time.Duration
+	CardinalityCache         *cache.QueryCache
+	CardinalityCacheTTL      time.Duration
+	QueryLimiter             *QueryLimiter
+	Jobs                     *QueryJobs
+	AlertThrottler           *AlertThrottler
+	EventWebhooks            *EventWebhooks
+	Metrics                  *metrics.Metrics
+	CheckStores              func(context.Context) error // CheckStores checks that the stores are writable for the probes of Chronograf
+	JWKSURLs                 []string                    // JWKSURLs are the JSON Web Key Sets of the auth providers checked by readiness probes
+	ServerOptions            map[string]interface{}      // ServerOptions are the effective options of the server, secrets being redacted
+	TemplateURLHosts         []string                    // TemplateURLHosts are the hosts the URLs of templates may reach at non-public addresses
 }
 
 type superAdminProviderGroups struct {
@@ -29,10 +53,16 @@ type TimeSeriesClient interface {
 	New(chronograf.Source, chronograf.Logger) (chronograf.TimeSeries, error)
 }
 
-// ErrorMessage is the error response format for all service errors
+// ErrorMessage is the error response format for all service errors. It is
+// a problem details object of RFC 7807 served as application/problem+json;
+// Code and Message repeat Status and Detail for the clients reading them.
 type ErrorMessage struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
+	Type    string `json:"type"`   // Type identifies the kind of problem, e.g. urn:chronograf:problem:not-found
+	Title   string `json:"title"`  // Title is the HTTP status text of the kind of problem
+	Status  int    `json:"status"` // Status is the HTTP status code of the response
+	Detail  string `json:"detail"` // Detail explains this occurrence of the problem
 }
 
 // TimeSeries returns a new client connected to a time series database
@@ -54,6 +84,9 @@ func (c *InfluxClient) New(src chronograf.Source, logger chronograf.Logger) (chr
 	if src.Type == chronograf.InfluxEnterprise && src.MetaURL != "" {
 		tls := strings.Contains(src.MetaURL, "https")
 		insecure := src.InsecureSkipVerify
+		if client.TLSTransport != nil {
+			return enterprise.NewClientWithTransport(logger, src.MetaURL, influx.DefaultAuthorization(&src), tls, client.TLSTransport, client)
+		}
 		return enterprise.NewClientWithTimeSeries(logger, src.MetaURL, influx.DefaultAuthorization(&src), tls, insecure, client)
 	}
 	return client, nil
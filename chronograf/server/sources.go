@@ -0,0 +1,62 @@
+package server
+
+import (
+	"net/http"
+	"sort"
+)
+
+type sourcesResponse struct {
+	Links   selfLinks         `json:"links"`
+	Sources []sourceEventData `json:"sources"`
+}
+
+// Sources lists the sources of the current organization without their
+// secrets, with only the fields of the fields parameter if any
+func (s *Service) Sources(w http.ResponseWriter, r *http.Request) {
+	fields, err := validFieldset(r.URL.Query(), sourceEventData{})
+	if err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	srcs, err := s.Store.Sources(ctx).All(ctx)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "Error loading sources", s.Logger)
+		return
+	}
+	sort.Slice(srcs, func(i, j int) bool { return srcs[i].ID < srcs[j].ID })
+
+	res := sourcesResponse{
+		Links:   selfLinks{Self: "/chronograf/v1/sources"},
+		Sources: make([]sourceEventData, len(srcs)),
+	}
+	for i, src := range srcs {
+		res.Sources[i] = newSourceEventData(src)
+	}
+	sparse, err := fields.list(res, "sources")
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	encodeJSON(w, http.StatusOK, sparse, s.Logger)
+}
+
+// SourceID returns a source of the current organization without its secrets
+func (s *Service) SourceID(w http.ResponseWriter, r *http.Request) {
+	id, err := paramID("id", r)
+	if err != nil {
+		Error(w, http.StatusUnprocessableEntity, err.Error(), s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	src, err := s.Store.Sources(ctx).Get(ctx, id)
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+	res := newSourceEventData(src)
+	setETag(w, res)
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
@@ -90,8 +90,28 @@ type DataStore interface {
 	Organizations(ctx context.Context) chronograf.OrganizationsStore
 	Mappings(ctx context.Context) chronograf.MappingsStore
 	Dashboards(ctx context.Context) chronograf.DashboardsStore
+	DashboardVersions(ctx context.Context) chronograf.DashboardVersionsStore
+	DashboardSnapshots(ctx context.Context) chronograf.DashboardSnapshotsStore
+	Folders(ctx context.Context) chronograf.FoldersStore
+	Reports(ctx context.Context) chronograf.ReportsStore
+	Annotations(ctx context.Context) chronograf.AnnotationStore
+	SourceHealth(ctx context.Context) chronograf.SourceHealthStore
+	QueryHistory(ctx context.Context) chronograf.QueryHistoryStore
+	AlertEvents(ctx context.Context) chronograf.AlertEventsStore
+	AlertWebhooks(ctx context.Context) chronograf.AlertWebhooksStore
+	AlertChecks(ctx context.Context) chronograf.AlertChecksStore
+	AlertPolicies(ctx context.Context) chronograf.AlertPoliciesStore
+	RuleTemplates(ctx context.Context) chronograf.RuleTemplatesStore
 	Config(ctx context.Context) chronograf.ConfigStore
 	OrganizationConfig(ctx context.Context) chronograf.OrganizationConfigStore
+	Notifications(ctx context.Context) chronograf.NotificationsStore
+	Tokens(ctx context.Context) chronograf.TokensStore
+	Invitations(ctx context.Context) chronograf.InvitationsStore
+	Preferences(ctx context.Context) chronograf.PreferencesStore
+	Certificates(ctx context.Context) chronograf.CertificatesStore
+	Groups(ctx context.Context) chronograf.GroupsStore
+	Webhooks(ctx context.Context) chronograf.WebhooksStore
+	WebhookDeliveries(ctx context.Context) chronograf.WebhookDeliveriesStore
 }
 
 // ensure that Store implements a DataStore
@@ -104,10 +124,30 @@ type Store struct {
 	LayoutsStore            chronograf.LayoutsStore
 	UsersStore              chronograf.UsersStore
 	DashboardsStore         chronograf.DashboardsStore
+	DashboardVersionsStore  chronograf.DashboardVersionsStore
+	DashboardSnapshotsStore chronograf.DashboardSnapshotsStore
+	FoldersStore            chronograf.FoldersStore
+	ReportsStore            chronograf.ReportsStore
+	AnnotationsStore        chronograf.AnnotationStore
+	SourceHealthStore       chronograf.SourceHealthStore
+	QueryHistoryStore       chronograf.QueryHistoryStore
+	AlertEventsStore        chronograf.AlertEventsStore
+	AlertWebhooksStore      chronograf.AlertWebhooksStore
+	AlertChecksStore        chronograf.AlertChecksStore
+	AlertPoliciesStore      chronograf.AlertPoliciesStore
+	RuleTemplatesStore      chronograf.RuleTemplatesStore
 	MappingsStore           chronograf.MappingsStore
 	OrganizationsStore      chronograf.OrganizationsStore
 	ConfigStore             chronograf.ConfigStore
 	OrganizationConfigStore chronograf.OrganizationConfigStore
+	NotificationsStore      chronograf.NotificationsStore
+	TokensStore             chronograf.TokensStore
+	InvitationsStore        chronograf.InvitationsStore
+	PreferencesStore        chronograf.PreferencesStore
+	CertificatesStore       chronograf.CertificatesStore
+	GroupsStore             chronograf.GroupsStore
+	WebhooksStore           chronograf.WebhooksStore
+	WebhookDeliveriesStore  chronograf.WebhookDeliveriesStore
 }
 
 // Sources returns a noop.SourcesStore if the context has no organization specified
@@ -159,18 +199,133 @@ func (s *Store) Users(ctx context.Context) chronograf.UsersStore {
 }
 
 // Dashboards returns a noop.DashboardsStore if the context has no organization specified
-// and an organization.DashboardsStore otherwise.
+// and an organization.DashboardsStore otherwise. When a role is specified as well,
+// dashboards in folders the role may not see are filtered by a roles.DashboardsStore.
 func (s *Store) Dashboards(ctx context.Context) chronograf.DashboardsStore {
 	if isServer := hasServerContext(ctx); isServer {
 		return s.DashboardsStore
 	}
 	if org, ok := hasOrganizationContext(ctx); ok {
-		return organizations.NewDashboardsStore(s.DashboardsStore, org)
+		store := organizations.NewDashboardsStore(s.DashboardsStore, org)
+		if role, ok := hasRoleContext(ctx); ok && s.FoldersStore != nil {
+			return roles.NewDashboardsStore(store, organizations.NewFoldersStore(s.FoldersStore, org), role)
+		}
+		return store
 	}
 
 	return &noop.DashboardsStore{}
 }
 
+// DashboardVersions returns the underlying DashboardVersionsStore. Revisions
+// belong to dashboards, so access is restricted by the handlers to revisions
+// of dashboards within the current organization.
+func (s *Store) DashboardVersions(ctx context.Context) chronograf.DashboardVersionsStore {
+	return s.DashboardVersionsStore
+}
+
+// DashboardSnapshots returns the underlying DashboardSnapshotsStore. Like
+// revisions, snapshots belong to dashboards, so access is restricted by the
+// handlers to snapshots of dashboards within the current organization.
+func (s *Store) DashboardSnapshots(ctx context.Context) chronograf.DashboardSnapshotsStore {
+	return s.DashboardSnapshotsStore
+}
+
+// SourceHealth returns the underlying SourceHealthStore. Health checks belong
+// to sources, so access is restricted by the handlers to checks of sources
+// within the current organization.
+func (s *Store) SourceHealth(ctx context.Context) chronograf.SourceHealthStore {
+	return s.SourceHealthStore
+}
+
+// QueryHistory returns the underlying QueryHistoryStore. Like preferences,
+// the query history belongs to users, so access is restricted by the handlers
+// to the queries of the current user.
+func (s *Store) QueryHistory(ctx context.Context) chronograf.QueryHistoryStore {
+	return s.QueryHistoryStore
+}
+
+// AlertEvents returns the underlying AlertEventsStore. Alert events belong to
+// sources, so access is restricted by the handlers to events of sources
+// within the current organization.
+func (s *Store) AlertEvents(ctx context.Context) chronograf.AlertEventsStore {
+	return s.AlertEventsStore
+}
+
+// AlertWebhooks returns the underlying AlertWebhooksStore. Like alert events,
+// webhooks belong to sources and access is restricted by the handlers.
+func (s *Store) AlertWebhooks(ctx context.Context) chronograf.AlertWebhooksStore {
+	return s.AlertWebhooksStore
+}
+
+// AlertChecks returns the underlying AlertChecksStore. Checks belong to
+// sources and access is restricted by the handlers.
+func (s *Store) AlertChecks(ctx context.Context) chronograf.AlertChecksStore {
+	return s.AlertChecksStore
+}
+
+// AlertPolicies returns the underlying AlertPoliciesStore. Policies belong
+// to sources and access is restricted by the handlers.
+func (s *Store) AlertPolicies(ctx context.Context) chronograf.AlertPoliciesStore {
+	return s.AlertPoliciesStore
+}
+
+// Folders returns a noop.FoldersStore if the context has no organization specified
+// and an organization.FoldersStore otherwise. When a role is specified as well,
+// folders the role may not see are filtered by a roles.FoldersStore.
+func (s *Store) Folders(ctx context.Context) chronograf.FoldersStore {
+	if isServer := hasServerContext(ctx); isServer {
+		return s.FoldersStore
+	}
+	if org, ok := hasOrganizationContext(ctx); ok {
+		store := organizations.NewFoldersStore(s.FoldersStore, org)
+		if role, ok := hasRoleContext(ctx); ok {
+			return roles.NewFoldersStore(store, role)
+		}
+		return store
+	}
+
+	return &noop.FoldersStore{}
+}
+
+// Reports returns a noop.ReportsStore if the context has no organization specified
+// and an organization.ReportsStore otherwise.
+func (s *Store) Reports(ctx context.Context) chronograf.ReportsStore {
+	if isServer := hasServerContext(ctx); isServer {
+		return s.ReportsStore
+	}
+	if org, ok := hasOrganizationContext(ctx); ok {
+		return organizations.NewReportsStore(s.ReportsStore, org)
+	}
+
+	return &noop.ReportsStore{}
+}
+
+// RuleTemplates returns a noop.RuleTemplatesStore if the context has no
+// organization specified and an organization.RuleTemplatesStore otherwise.
+func (s *Store) RuleTemplates(ctx context.Context) chronograf.RuleTemplatesStore {
+	if isServer := hasServerContext(ctx); isServer {
+		return s.RuleTemplatesStore
+	}
+	if org, ok := hasOrganizationContext(ctx); ok {
+		return organizations.NewRuleTemplatesStore(s.RuleTemplatesStore, org)
+	}
+
+	return &noop.RuleTemplatesStore{}
+}
+
+// Annotations returns a noop.AnnotationsStore if the context has no organization
+// specified and an organization.AnnotationsStore otherwise.
+func (s *Store) Annotations(ctx context.Context) chronograf.AnnotationStore {
+	if isServer := hasServerContext(ctx); isServer {
+		return s.AnnotationsStore
+	}
+	if org, ok := hasOrganizationContext(ctx); ok {
+		return organizations.NewAnnotationsStore(s.AnnotationsStore, org)
+	}
+
+	return &noop.AnnotationsStore{}
+}
+
 // OrganizationConfig returns a noop.OrganizationConfigStore if the context has no organization specified
 // and an organization.OrganizationConfigStore otherwise.
 func (s *Store) OrganizationConfig(ctx context.Context) chronograf.OrganizationConfigStore {
@@ -218,6 +373,94 @@ func (s *Store) Mappings(ctx context.Context) chronograf.MappingsStore {
 	return &noop.MappingsStore{}
 }
 
+// Certificates returns the underlying CertificatesStore to servers and
+// SuperAdmins and a noop.CertificatesStore otherwise.
+func (s *Store) Certificates(ctx context.Context) chronograf.CertificatesStore {
+	if isServer := hasServerContext(ctx); isServer {
+		return s.CertificatesStore
+	}
+	if isSuperAdmin := hasSuperAdminContext(ctx); isSuperAdmin {
+		return s.CertificatesStore
+	}
+	return &noop.CertificatesStore{}
+}
+
+// Groups returns the underlying GroupsStore to servers and SuperAdmins and
+// a noop.GroupsStore otherwise.
+func (s *Store) Groups(ctx context.Context) chronograf.GroupsStore {
+	if isServer := hasServerContext(ctx); isServer {
+		return s.GroupsStore
+	}
+	if isSuperAdmin := hasSuperAdminContext(ctx); isSuperAdmin {
+		return s.GroupsStore
+	}
+	return &noop.GroupsStore{}
+}
+
+// Webhooks returns the underlying WebhooksStore to servers and SuperAdmins
+// and a noop.WebhooksStore otherwise.
+func (s *Store) Webhooks(ctx context.Context) chronograf.WebhooksStore {
+	if isServer := hasServerContext(ctx); isServer {
+		return s.WebhooksStore
+	}
+	if isSuperAdmin := hasSuperAdminContext(ctx); isSuperAdmin {
+		return s.WebhooksStore
+	}
+	return &noop.WebhooksStore{}
+}
+
+// WebhookDeliveries returns the underlying WebhookDeliveriesStore to servers
+// and SuperAdmins and a noop.WebhookDeliveriesStore otherwise.
+func (s *Store) WebhookDeliveries(ctx context.Context) chronograf.WebhookDeliveriesStore {
+	if isServer := hasServerContext(ctx); isServer {
+		return s.WebhookDeliveriesStore
+	}
+	if isSuperAdmin := hasSuperAdminContext(ctx); isSuperAdmin {
+		return s.WebhookDeliveriesStore
+	}
+	return &noop.WebhookDeliveriesStore{}
+}
+
+// Notifications returns the underlying NotificationsStore. Notifications
+// belong to users rather than organizations, so access is restricted by the
+// handlers to the inbox of the current user.
+func (s *Store) Notifications(ctx context.Context) chronograf.NotificationsStore {
+	return s.NotificationsStore
+}
+
+// Tokens returns a noop.TokensStore if the context has no organization specified
+// and an organization.TokensStore otherwise.
+func (s *Store) Tokens(ctx context.Context) chronograf.TokensStore {
+	if isServer := hasServerContext(ctx); isServer {
+		return s.TokensStore
+	}
+	if org, ok := hasOrganizationContext(ctx); ok {
+		return organizations.NewTokensStore(s.TokensStore, org)
+	}
+
+	return &noop.TokensStore{}
+}
+
+// Invitations returns a noop.InvitationsStore if the context has no organization
+// specified and an organization.InvitationsStore otherwise.
+func (s *Store) Invitations(ctx context.Context) chronograf.InvitationsStore {
+	if isServer := hasServerContext(ctx); isServer {
+		return s.InvitationsStore
+	}
+	if org, ok := hasOrganizationContext(ctx); ok {
+		return organizations.NewInvitationsStore(s.InvitationsStore, org)
+	}
+
+	return &noop.InvitationsStore{}
+}
+
+// Preferences returns the underlying PreferencesStore. Preferences belong to
+// users rather than organizations, so access is restricted by the handlers to
+// the preferences of the current user.
+func (s *Store) Preferences(ctx context.Context) chronograf.PreferencesStore {
+	return s.PreferencesStore
+}
+
 // ensure that DirectStore implements a DataStore
 var _ DataStore = &DirectStore{}
 
@@ -228,10 +471,30 @@ type DirectStore struct {
 	LayoutsStore            chronograf.LayoutsStore
 	UsersStore              chronograf.UsersStore
 	DashboardsStore         chronograf.DashboardsStore
+	DashboardVersionsStore  chronograf.DashboardVersionsStore
+	DashboardSnapshotsStore chronograf.DashboardSnapshotsStore
+	FoldersStore            chronograf.FoldersStore
+	ReportsStore            chronograf.ReportsStore
+	AnnotationsStore        chronograf.AnnotationStore
+	SourceHealthStore       chronograf.SourceHealthStore
+	QueryHistoryStore       chronograf.QueryHistoryStore
+	AlertEventsStore        chronograf.AlertEventsStore
+	AlertWebhooksStore      chronograf.AlertWebhooksStore
+	AlertChecksStore        chronograf.AlertChecksStore
+	AlertPoliciesStore      chronograf.AlertPoliciesStore
+	RuleTemplatesStore      chronograf.RuleTemplatesStore
 	MappingsStore           chronograf.MappingsStore
 	OrganizationsStore      chronograf.OrganizationsStore
 	ConfigStore             chronograf.ConfigStore
 	OrganizationConfigStore chronograf.OrganizationConfigStore
+	NotificationsStore      chronograf.NotificationsStore
+	TokensStore             chronograf.TokensStore
+	InvitationsStore        chronograf.InvitationsStore
+	PreferencesStore        chronograf.PreferencesStore
+	CertificatesStore       chronograf.CertificatesStore
+	GroupsStore             chronograf.GroupsStore
+	WebhooksStore           chronograf.WebhooksStore
+	WebhookDeliveriesStore  chronograf.WebhookDeliveriesStore
 }
 
 // Sources returns a noop.SourcesStore if the context has no organization specified
@@ -267,6 +530,66 @@ func (s *DirectStore) Dashboards(ctx context.Context) chronograf.DashboardsStore
 	return s.DashboardsStore
 }
 
+// DashboardVersions returns the underlying DashboardVersionsStore.
+func (s *DirectStore) DashboardVersions(ctx context.Context) chronograf.DashboardVersionsStore {
+	return s.DashboardVersionsStore
+}
+
+// DashboardSnapshots returns the underlying DashboardSnapshotsStore.
+func (s *DirectStore) DashboardSnapshots(ctx context.Context) chronograf.DashboardSnapshotsStore {
+	return s.DashboardSnapshotsStore
+}
+
+// Folders returns the underlying FoldersStore.
+func (s *DirectStore) Folders(ctx context.Context) chronograf.FoldersStore {
+	return s.FoldersStore
+}
+
+// Reports returns the underlying ReportsStore.
+func (s *DirectStore) Reports(ctx context.Context) chronograf.ReportsStore {
+	return s.ReportsStore
+}
+
+// Annotations returns the underlying AnnotationsStore.
+func (s *DirectStore) Annotations(ctx context.Context) chronograf.AnnotationStore {
+	return s.AnnotationsStore
+}
+
+// SourceHealth returns the underlying SourceHealthStore.
+func (s *DirectStore) SourceHealth(ctx context.Context) chronograf.SourceHealthStore {
+	return s.SourceHealthStore
+}
+
+// QueryHistory returns the underlying QueryHistoryStore.
+func (s *DirectStore) QueryHistory(ctx context.Context) chronograf.QueryHistoryStore {
+	return s.QueryHistoryStore
+}
+
+// AlertEvents returns the underlying AlertEventsStore.
+func (s *DirectStore) AlertEvents(ctx context.Context) chronograf.AlertEventsStore {
+	return s.AlertEventsStore
+}
+
+// AlertWebhooks returns the underlying AlertWebhooksStore.
+func (s *DirectStore) AlertWebhooks(ctx context.Context) chronograf.AlertWebhooksStore {
+	return s.AlertWebhooksStore
+}
+
+// AlertChecks returns the underlying AlertChecksStore.
+func (s *DirectStore) AlertChecks(ctx context.Context) chronograf.AlertChecksStore {
+	return s.AlertChecksStore
+}
+
+// AlertPolicies returns the underlying AlertPoliciesStore.
+func (s *DirectStore) AlertPolicies(ctx context.Context) chronograf.AlertPoliciesStore {
+	return s.AlertPoliciesStore
+}
+
+// RuleTemplates returns the underlying RuleTemplatesStore.
+func (s *DirectStore) RuleTemplates(ctx context.Context) chronograf.RuleTemplatesStore {
+	return s.RuleTemplatesStore
+}
+
 // OrganizationConfig returns a noop.OrganizationConfigStore if the context has no organization specified
 // and an organization.OrganizationConfigStore otherwise.
 func (s *DirectStore) OrganizationConfig(ctx context.Context) chronograf.OrganizationConfigStore {
@@ -287,3 +610,43 @@ func (s *DirectStore) Config(ctx context.Context) chronograf.ConfigStore {
 func (s *DirectStore) Mappings(ctx context.Context) chronograf.MappingsStore {
 	return s.MappingsStore
 }
+
+// Certificates returns the underlying CertificatesStore.
+func (s *DirectStore) Certificates(ctx context.Context) chronograf.CertificatesStore {
+	return s.CertificatesStore
+}
+
+// Groups returns the underlying GroupsStore.
+func (s *DirectStore) Groups(ctx context.Context) chronograf.GroupsStore {
+	return s.GroupsStore
+}
+
+// Webhooks returns the underlying WebhooksStore.
+func (s *DirectStore) Webhooks(ctx context.Context) chronograf.WebhooksStore {
+	return s.WebhooksStore
+}
+
+// WebhookDeliveries returns the underlying WebhookDeliveriesStore.
+func (s *DirectStore) WebhookDeliveries(ctx context.Context) chronograf.WebhookDeliveriesStore {
+	return s.WebhookDeliveriesStore
+}
+
+// Notifications returns the underlying NotificationsStore.
+func (s *DirectStore) Notifications(ctx context.Context) chronograf.NotificationsStore {
+	return s.NotificationsStore
+}
+
+// Tokens returns the underlying TokensStore.
+func (s *DirectStore) Tokens(ctx context.Context) chronograf.TokensStore {
+	return s.TokensStore
+}
+
+// Invitations returns the underlying InvitationsStore.
+func (s *DirectStore) Invitations(ctx context.Context) chronograf.InvitationsStore {
+	return s.InvitationsStore
+}
+
+// Preferences returns the underlying PreferencesStore.
+func (s *DirectStore) Preferences(ctx context.Context) chronograf.PreferencesStore {
+	return s.PreferencesStore
+}
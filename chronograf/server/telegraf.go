@@ -0,0 +1,248 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// telegrafInputs are the telegraf input plugins chronograf can configure
+// along with the options written for each of them. The options are the
+// defaults from the telegraf sample configuration and are expected to be
+// edited by the operator.
+var telegrafInputs = map[string][]string{
+	"apache":            {`urls = ["http://localhost/server-status?auto"]`},
+	"consul":            {`address = "localhost:8500"`},
+	"cpu":               {`percpu = true`, `totalcpu = true`},
+	"disk":              {`ignore_fs = ["tmpfs", "devtmpfs", "devfs"]`},
+	"diskio":            nil,
+	"docker":            {`endpoint = "unix:///var/run/docker.sock"`},
+	"elasticsearch":     {`servers = ["http://localhost:9200"]`},
+	"haproxy":           {`servers = ["http://localhost:1936/haproxy?stats"]`},
+	"influxdb":          {`urls = ["http://localhost:8086/debug/vars"]`},
+	"kubernetes":        {`url = "http://localhost:10255"`},
+	"mem":               nil,
+	"memcached":         {`servers = ["localhost:11211"]`},
+	"mesos":             {`masters = ["http://localhost:5050"]`},
+	"mongodb":           {`servers = ["mongodb://127.0.0.1:27017"]`},
+	"mysql":             {`servers = ["root@tcp(127.0.0.1:3306)/"]`},
+	"net":               nil,
+	"netstat":           nil,
+	"nginx":             {`urls = ["http://localhost/server_status"]`},
+	"nsq":               {`endpoints = ["http://localhost:4151"]`},
+	"phpfpm":            {`urls = ["http://localhost:80/status"]`},
+	"ping":              {`urls = ["localhost"]`},
+	"postgresql":        {`address = "host=localhost user=postgres sslmode=disable"`},
+	"processes":         nil,
+	"procstat":          {`pattern = "influxd"`},
+	"rabbitmq":          {`url = "http://localhost:15672"`},
+	"redis":             {`servers = ["tcp://localhost:6379"]`},
+	"riak":              {`servers = ["http://localhost:8098"]`},
+	"statsd":            {`service_address = ":8125"`},
+	"system":            nil,
+	"varnish":           nil,
+	"win_perf_counters": nil,
+}
+
+// layoutInputs maps the measurements of the canned layouts to the telegraf
+// input plugin writing them.
+var layoutInputs = map[string]string{
+	"apache":                     "apache",
+	"consul_health_checks":       "consul",
+	"consul_consul_fsm_register": "statsd",
+	"consul_consul_http_GET_v1_health_state__": "statsd",
+	"consul_memberlist_msg_alive":              "statsd",
+	"consul_raft_state_candidate":              "statsd",
+	"consul_raft_state_leader":                 "statsd",
+	"consul_serf_events":                       "statsd",
+	"cpu":                                      "cpu",
+	"disk":                                     "disk",
+	"diskio":                                   "diskio",
+	"docker":                                   "docker",
+	"docker_container_blkio":                   "docker",
+	"docker_container_net":                     "docker",
+	"elasticsearch_indices":                    "elasticsearch",
+	"haproxy":                                  "haproxy",
+	"influxdb_database":                        "influxdb",
+	"influxdb_httpd":                           "influxdb",
+	"influxdb_queryExecutor":                   "influxdb",
+	"influxdb_write":                           "influxdb",
+	"kubernetes_node":                          "kubernetes",
+	"kubernetes_pod_container":                 "kubernetes",
+	"kubernetes_pod_network":                   "kubernetes",
+	"kubernetes_system_container":              "kubernetes",
+	"mem":                                      "mem",
+	"memcached":                                "memcached",
+	"mesos":                                    "mesos",
+	"mongodb":                                  "mongodb",
+	"mysql":                                    "mysql",
+	"net":                                      "net",
+	"netstat":                                  "netstat",
+	"nginx":                                    "nginx",
+	"nsq_channel":                              "nsq",
+	"nsq_server":                               "nsq",
+	"nsq_topic":                                "nsq",
+	"phpfpm":                                   "phpfpm",
+	"ping":                                     "ping",
+	"postgresql":                               "postgresql",
+	"processes":                                "processes",
+	"procstat":                                 "procstat",
+	"rabbitmq_node":                            "rabbitmq",
+	"redis":                                    "redis",
+	"riak":                                     "riak",
+	"system":                                   "system",
+	"varnish":                                  "varnish",
+	"win_cpu":                                  "win_perf_counters",
+	"win_mem":                                  "win_perf_counters",
+	"win_net":                                  "win_perf_counters",
+	"win_system":                               "win_perf_counters",
+	"win_websvc":                               "win_perf_counters",
+}
+
+type telegrafConfigRequest struct {
+	Source   string   `json:"source"`   // Source is the ID of the source telegraf writes to
+	Layouts  []string `json:"layouts"`  // Layouts are the IDs of the layouts whose measurements are collected
+	Apps     []string `json:"apps"`     // Apps selects every layout of the named applications
+	Plugins  []string `json:"plugins"`  // Plugins are additional telegraf input plugins
+	Interval string   `json:"interval"` // Interval is the collection interval; defaults to 10s
+}
+
+func (r *telegrafConfigRequest) Valid() error {
+	if r.Source == "" {
+		return errorf("source required on telegraf config request body")
+	}
+	if _, err := strconv.Atoi(r.Source); err != nil {
+		return errorf("invalid source ID %s", r.Source)
+	}
+	if r.Interval == "" {
+		r.Interval = "10s"
+	}
+	if d, err := time.ParseDuration(r.Interval); err != nil || d <= 0 {
+		return errorf("invalid interval %s", r.Interval)
+	}
+	for _, p := range r.Plugins {
+		if _, ok := telegrafInputs[p]; !ok {
+			return errorf("unknown telegraf plugin %s", p)
+		}
+	}
+	return nil
+}
+
+// TelegrafConfig generates a telegraf.conf writing to a source and collecting
+// the measurements needed by the selected layouts and plugins.
+func (s *Service) TelegrafConfig(w http.ResponseWriter, r *http.Request) {
+	var req telegrafConfigRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	if err := req.Valid(); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	id, _ := strconv.Atoi(req.Source)
+	src, err := s.Store.Sources(ctx).Get(ctx, id)
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+
+	plugins := map[string]bool{}
+	for _, p := range req.Plugins {
+		plugins[p] = true
+	}
+
+	if len(req.Layouts) > 0 || len(req.Apps) > 0 {
+		layouts, err := s.Store.Layouts(ctx).All(ctx)
+		if err != nil {
+			Error(w, http.StatusInternalServerError, "Error loading layouts", s.Logger)
+			return
+		}
+		selected := map[string]bool{}
+		for _, l := range req.Layouts {
+			selected[l] = true
+		}
+		for _, a := range req.Apps {
+			selected[a] = true
+		}
+		found := map[string]bool{}
+		for _, l := range layouts {
+			if !selected[l.ID] && !selected[l.Application] {
+				continue
+			}
+			found[l.ID], found[l.Application] = true, true
+			if p, ok := layoutInputs[l.Measurement]; ok {
+				plugins[p] = true
+			}
+		}
+		for id := range selected {
+			if !found[id] {
+				invalidData(w, errorf("unknown layout %s", id), s.Logger)
+				return
+			}
+		}
+	}
+
+	if len(plugins) == 0 {
+		invalidData(w, errorf("no layouts or plugins selected"), s.Logger)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="telegraf.conf"`)
+	w.WriteHeader(http.StatusOK)
+	w.Write(telegrafConfig(src, req.Interval, plugins))
+}
+
+// telegrafConfig renders the agent, an influxdb output pointed at src and
+// an input for each plugin.
+func telegrafConfig(src chronograf.Source, interval string, plugins map[string]bool) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "# Generated by Chronograf for source %q\n\n", src.Name)
+
+	b.WriteString("[agent]\n")
+	fmt.Fprintf(&b, "  interval = %q\n", interval)
+	fmt.Fprintf(&b, "  flush_interval = %q\n", interval)
+	b.WriteString("\n")
+
+	db := src.Telegraf
+	if db == "" {
+		db = "telegraf"
+	}
+	b.WriteString("[[outputs.influxdb]]\n")
+	fmt.Fprintf(&b, "  urls = [%q]\n", src.URL)
+	fmt.Fprintf(&b, "  database = %q\n", db)
+	if src.DefaultRP != "" {
+		fmt.Fprintf(&b, "  retention_policy = %q\n", src.DefaultRP)
+	}
+	if src.Username != "" {
+		fmt.Fprintf(&b, "  username = %q\n", src.Username)
+		// The password of the source is never written into the config;
+		// telegraf reads it from the environment instead.
+		b.WriteString("  password = \"${INFLUX_PASSWORD}\"\n")
+	}
+	if src.InsecureSkipVerify {
+		b.WriteString("  insecure_skip_verify = true\n")
+	}
+
+	names := make([]string, 0, len(plugins))
+	for p := range plugins {
+		names = append(names, p)
+	}
+	sort.Strings(names)
+	for _, p := range names {
+		fmt.Fprintf(&b, "\n[[inputs.%s]]\n", p)
+		for _, opt := range telegrafInputs[p] {
+			fmt.Fprintf(&b, "  %s\n", opt)
+		}
+	}
+	return b.Bytes()
+}
@@ -0,0 +1,129 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+func TestService_TelegrafConfig(t *testing.T) {
+	layouts := &mocks.LayoutsStore{
+		AllF: func(ctx context.Context) ([]chronograf.Layout, error) {
+			return []chronograf.Layout{
+				{ID: "cpu", Application: "system", Measurement: "cpu"},
+				{ID: "mem", Application: "system", Measurement: "mem"},
+				{ID: "docker", Application: "docker", Measurement: "docker"},
+				{ID: "docker-net", Application: "docker", Measurement: "docker_container_net"},
+			}, nil
+		},
+	}
+	sources := &mocks.SourcesStore{
+		GetF: func(ctx context.Context, ID int) (chronograf.Source, error) {
+			if ID != 1 {
+				return chronograf.Source{}, chronograf.ErrSourceNotFound
+			}
+			return chronograf.Source{
+				ID:       1,
+				Name:     "influx",
+				URL:      "http://localhost:8086",
+				Username: "admin",
+				Password: "secret",
+				Telegraf: "metrics",
+			}, nil
+		},
+	}
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "Layouts by ID and application",
+			body:       `{"source":"1","layouts":["cpu"],"apps":["docker"],"plugins":["ping"],"interval":"1m"}`,
+			wantStatus: http.StatusOK,
+			wantBody: `# Generated by Chronograf for source "influx"
+
+[agent]
+  interval = "1m"
+  flush_interval = "1m"
+
+[[outputs.influxdb]]
+  urls = ["http://localhost:8086"]
+  database = "metrics"
+  username = "admin"
+  password = "${INFLUX_PASSWORD}"
+
+[[inputs.cpu]]
+  percpu = true
+  totalcpu = true
+
+[[inputs.docker]]
+  endpoint = "unix:///var/run/docker.sock"
+
+[[inputs.ping]]
+  urls = ["localhost"]
+`,
+		},
+		{
+			name:       "Unknown layout",
+			body:       `{"source":"1","layouts":["nope"]}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody:   `{"code":422,"message":"unknown layout nope","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"unknown layout nope"}`,
+		},
+		{
+			name:       "Unknown plugin",
+			body:       `{"source":"1","plugins":["nope"]}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody:   `{"code":422,"message":"unknown telegraf plugin nope","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"unknown telegraf plugin nope"}`,
+		},
+		{
+			name:       "Nothing selected",
+			body:       `{"source":"1"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody:   `{"code":422,"message":"no layouts or plugins selected","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"no layouts or plugins selected"}`,
+		},
+		{
+			name:       "Unknown source",
+			body:       `{"source":"2","plugins":["cpu"]}`,
+			wantStatus: http.StatusNotFound,
+			wantBody:   `{"code":404,"message":"ID 2 not found","type":"urn:chronograf:problem:not-found","title":"Not Found","status":404,"detail":"ID 2 not found"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{
+				Store: &mocks.Store{
+					LayoutsStore: layouts,
+					SourcesStore: sources,
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url/chronograf/v1/telegraf-config", bytes.NewBufferString(tt.body))
+
+			s.TelegrafConfig(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("%q. TelegrafConfig() = %v, want %v", tt.name, resp.StatusCode, tt.wantStatus)
+			}
+			if resp.StatusCode == http.StatusOK {
+				if string(body) != tt.wantBody {
+					t.Errorf("%q. TelegrafConfig() = \n%s\nwant\n%s", tt.name, body, tt.wantBody)
+				}
+				return
+			}
+			if eq, _ := jsonEqual(string(body), tt.wantBody); !eq {
+				t.Errorf("%q. TelegrafConfig() = %s, want %s", tt.name, body, tt.wantBody)
+			}
+		})
+	}
+}
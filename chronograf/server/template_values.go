@@ -0,0 +1,502 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bouk/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Formats of the lists of choices of templates of type url
+const (
+	templateFormatCSV  = "csv"
+	templateFormatJSON = "json"
+)
+
+// fluxValueColumn is the column holding the choices of templates of type
+// flux without a column
+const fluxValueColumn = "_value"
+
+// maxTemplateListSize is the largest list of choices read from the URL of a
+// template in bytes
+const maxTemplateListSize = 1 << 20
+
+// templateURLTimeout is the longest a template waits for its URL to respond
+const templateURLTimeout = 10 * time.Second
+
+// privateNetworks are the IPv4 and IPv6 ranges of private networks that the
+// URLs of templates may only reach when their hosts are allowed
+var privateNetworks = mustParseCIDRs(
+	"10.0.0.0/8",
+	"172.16.0.0/12",
+	"192.168.0.0/16",
+	"100.64.0.0/10",
+	"fc00::/7",
+)
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	nets := make([]*net.IPNet, len(cidrs))
+	for i, c := range cidrs {
+		_, n, err := net.ParseCIDR(c)
+		if err != nil {
+			panic(err)
+		}
+		nets[i] = n
+	}
+	return nets
+}
+
+// publicIP returns true if ip is neither a loopback, link-local, multicast,
+// unspecified nor private address
+func publicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return false
+	}
+	for _, n := range privateNetworks {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// newTemplateURLClient returns the client reading the choices of templates
+// from their URLs. Hosts other than the allowed ones are only dialed at the
+// public addresses they resolve to, so that editors of dashboards cannot
+// make Chronograf request its own network. Every dial is checked, including
+// the ones of redirects.
+func newTemplateURLClient(allowed []string) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   templateURLTimeout,
+		KeepAlive: 30 * time.Second,
+	}
+	dial := func(ctx context.Context, network, addr string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, err
+		}
+		for _, h := range allowed {
+			if strings.EqualFold(h, host) {
+				return dialer.DialContext(ctx, network, addr)
+			}
+		}
+		ips, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+		if len(ips) == 0 {
+			return nil, fmt.Errorf("no address found for %s", host)
+		}
+		for _, ip := range ips {
+			if !publicIP(ip.IP) {
+				return nil, fmt.Errorf("%s resolves to the non-public address %s; allow it with --template-url-host", host, ip.IP)
+			}
+		}
+		// The resolved address is dialed so that the host cannot resolve
+		// to another address between the check and the dial
+		return dialer.DialContext(ctx, network, net.JoinHostPort(ips[0].IP.String(), port))
+	}
+	return &http.Client{
+		Timeout: templateURLTimeout,
+		Transport: &http.Transport{
+			DialContext:           dial,
+			DisableKeepAlives:     true,
+			TLSHandshakeTimeout:   templateURLTimeout,
+			ResponseHeaderTimeout: templateURLTimeout,
+		},
+	}
+}
+
+// templateValuesRequest holds the selections of the other template variables
+// of a dashboard that the choices of a template depend on, and the source of
+// Flux scripts.
+type templateValuesRequest struct {
+	Source       string                   `json:"source"`
+	TemplateVars []chronograf.TemplateVar `json:"tempVars"`
+}
+
+type templateValuesResponse struct {
+	Values []chronograf.TemplateValue `json:"values"`
+}
+
+// TemplateValues lists the choices of a template of type flux or url, which
+// the server resolves on behalf of the client. Variables the Flux script or
+// URL of the template refers to are replaced with their selection in the
+// request, falling back to the selection saved with the dashboard, so that
+// chained templates are listed for the choices made before them.
+func (s *Service) TemplateValues(w http.ResponseWriter, r *http.Request) {
+	id, err := paramID("id", r)
+	if err != nil {
+		Error(w, http.StatusUnprocessableEntity, err.Error(), s.Logger)
+		return
+	}
+
+	var req templateValuesRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			invalidJSON(w, s.Logger)
+			return
+		}
+	}
+
+	ctx := r.Context()
+	dash, err := s.Store.Dashboards(ctx).Get(ctx, chronograf.DashboardID(id))
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+
+	tid := httprouter.GetParamFromContext(ctx, "tid")
+	pos := -1
+	for i, t := range dash.Templates {
+		if t.ID == chronograf.TemplateID(tid) {
+			pos = i
+			break
+		}
+	}
+	if pos == -1 {
+		notFound(w, id, s.Logger)
+		return
+	}
+	t := dash.Templates[pos]
+
+	selected := selectedTemplateValues(dash.Templates, req.TemplateVars)
+	vars := map[string]string{}
+	var choices []string
+	switch t.Type {
+	case "flux":
+		if req.Source == "" {
+			invalidData(w, errorf("source is required to run the flux script of the template"), s.Logger)
+			return
+		}
+		srcID, err := strconv.Atoi(req.Source)
+		if err != nil {
+			invalidData(w, fmt.Errorf("invalid source ID %q", req.Source), s.Logger)
+			return
+		}
+		for k, v := range selected {
+			vars[k] = v.Value
+		}
+		script := replaceTemplateVars(t.Query.Flux, vars)
+		if choices, err = s.fluxTemplateChoices(ctx, srcID, script, t.Query.Column); err != nil {
+			Error(w, http.StatusBadGateway, err.Error(), s.Logger)
+			return
+		}
+	case "url":
+		for k, v := range selected {
+			vars[k] = url.QueryEscape(v.Value)
+		}
+		u := replaceTemplateVars(t.Query.URL, vars)
+		if choices, err = fetchTemplateChoices(ctx, newTemplateURLClient(s.TemplateURLHosts), u, t.Query.Format, t.Query.Column); err != nil {
+			Error(w, http.StatusBadGateway, err.Error(), s.Logger)
+			return
+		}
+	default:
+		invalidData(w, errorf("choices of templates of type %s are listed by the client", t.Type), s.Logger)
+		return
+	}
+
+	// The selection is kept while it remains one of the choices
+	current, ok := selected[t.Var]
+	res := templateValuesResponse{
+		Values: make([]chronograf.TemplateValue, len(choices)),
+	}
+	found := false
+	for i, c := range choices {
+		res.Values[i] = chronograf.TemplateValue{
+			Value:    c,
+			Type:     t.Type,
+			Selected: ok && c == current.Value,
+		}
+		found = found || res.Values[i].Selected
+	}
+	if !found && len(res.Values) > 0 {
+		res.Values[0].Selected = true
+	}
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// fluxTemplateChoices runs a Flux script against a source of the current
+// organization and returns the distinct values of column
+func (s *Service) fluxTemplateChoices(ctx context.Context, srcID int, script, column string) ([]string, error) {
+	src, err := s.Store.Sources(ctx).Get(ctx, srcID)
+	if err != nil {
+		return nil, fmt.Errorf("source %d not found", srcID)
+	}
+	flux, err := s.fluxQuerier(ctx, src)
+	if err != nil {
+		return nil, err
+	}
+	data, err := flux.FluxQuery(ctx, script)
+	if err != nil {
+		return nil, err
+	}
+	if column == "" {
+		column = fluxValueColumn
+	}
+	return parseFluxChoices(data, column)
+}
+
+// parseFluxChoices returns the distinct values of column within the tables
+// of an annotated CSV result of Flux. Every table starts with annotation
+// rows followed by a header row.
+func parseFluxChoices(data []byte, column string) ([]string, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+
+	var choices []string
+	seen := map[string]bool{}
+	header := true
+	col, errCol := -1, -1
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if strings.HasPrefix(record[0], "#") {
+			header = true
+			continue
+		}
+		if header {
+			col, errCol = -1, -1
+			for i, name := range record {
+				switch name {
+				case column:
+					col = i
+				case "error":
+					errCol = i
+				}
+			}
+			header = false
+			continue
+		}
+		if errCol >= 0 && errCol < len(record) && record[errCol] != "" {
+			return nil, fmt.Errorf("flux error: %s", record[errCol])
+		}
+		if col >= 0 && col < len(record) {
+			choices = appendChoice(choices, seen, record[col])
+		}
+	}
+	return choices, nil
+}
+
+// fetchTemplateChoices reads the list of choices of a template from a URL
+// responding with CSV or JSON
+func fetchTemplateChoices(ctx context.Context, client *http.Client, rawURL, format, column string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, templateURLTimeout)
+	defer cancel()
+
+	req, err := http.NewRequest("GET", rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("received status code %d from %s", resp.StatusCode, req.URL.Host)
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxTemplateListSize))
+	if err != nil {
+		return nil, err
+	}
+
+	if format == templateFormatJSON {
+		return parseJSONChoices(data, column)
+	}
+	return parseCSVChoices(data, column)
+}
+
+// parseCSVChoices returns the distinct values of the first field of every
+// record, or of column if the first record is a header naming it
+func parseCSVChoices(data []byte, column string) ([]string, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	col := 0
+	if column != "" {
+		if len(records) == 0 {
+			return nil, fmt.Errorf("column %s not found", column)
+		}
+		col = -1
+		for i, name := range records[0] {
+			if name == column {
+				col = i
+			}
+		}
+		if col == -1 {
+			return nil, fmt.Errorf("column %s not found", column)
+		}
+		records = records[1:]
+	}
+
+	var choices []string
+	seen := map[string]bool{}
+	for _, record := range records {
+		if col < len(record) {
+			choices = appendChoice(choices, seen, record[col])
+		}
+	}
+	return choices, nil
+}
+
+// parseJSONChoices returns the distinct values of an array of strings, numbers
+// and booleans, or of the column key of an array of objects
+func parseJSONChoices(data []byte, column string) ([]string, error) {
+	var items []interface{}
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, fmt.Errorf("expected a JSON array of choices: %v", err)
+	}
+
+	var choices []string
+	seen := map[string]bool{}
+	for _, item := range items {
+		if obj, ok := item.(map[string]interface{}); ok {
+			if column == "" {
+				return nil, fmt.Errorf("column is required to list choices from JSON objects")
+			}
+			item = obj[column]
+		}
+		switch v := item.(type) {
+		case string:
+			choices = appendChoice(choices, seen, v)
+		case float64:
+			choices = appendChoice(choices, seen, strconv.FormatFloat(v, 'f', -1, 64))
+		case bool:
+			choices = appendChoice(choices, seen, strconv.FormatBool(v))
+		}
+	}
+	return choices, nil
+}
+
+// appendChoice appends c to choices unless it is empty or was seen before
+func appendChoice(choices []string, seen map[string]bool, c string) []string {
+	c = strings.TrimSpace(c)
+	if c == "" || seen[c] {
+		return choices
+	}
+	seen[c] = true
+	return append(choices, c)
+}
+
+// selectedTemplateValues returns the selected value of each template
+// variable, or its first value if none is selected. Variables of vars take
+// precedence over the templates of the dashboard.
+func selectedTemplateValues(templates []chronograf.Template, vars []chronograf.TemplateVar) map[string]chronograf.TemplateValue {
+	all := make([]chronograf.TemplateVar, 0, len(templates)+len(vars))
+	for _, t := range templates {
+		all = append(all, t.TemplateVar)
+	}
+	all = append(all, vars...)
+
+	values := map[string]chronograf.TemplateValue{}
+	for _, v := range all {
+		if len(v.Values) == 0 {
+			continue
+		}
+		value := v.Values[0]
+		for _, tv := range v.Values {
+			if tv.Selected {
+				value = tv
+				break
+			}
+		}
+		values[v.Var] = value
+	}
+	return values
+}
+
+// replaceTemplateVars replaces every variable of vars within s. Longer
+// variables are replaced first so that none is replaced within another.
+func replaceTemplateVars(s string, vars map[string]string) string {
+	keys := make([]string, 0, len(vars))
+	for k := range vars {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return len(keys[i]) > len(keys[j])
+	})
+	for _, k := range keys {
+		s = strings.Replace(s, k, vars[k], -1)
+	}
+	return s
+}
+
+// templateDependencies returns the variables of templates that the query,
+// Flux script or URL of t refers to
+func templateDependencies(t chronograf.Template, templates []chronograf.Template) []string {
+	if t.Query == nil {
+		return nil
+	}
+	text := strings.Join([]string{t.Query.Command, t.Query.Flux, t.Query.URL}, "\n")
+	var deps []string
+	for _, other := range templates {
+		if other.Var != "" && strings.Contains(text, other.Var) {
+			deps = append(deps, other.Var)
+		}
+	}
+	return deps
+}
+
+// validTemplateDependencies checks that no template depends on itself
+// through the variables its choices are listed with
+func validTemplateDependencies(templates []chronograf.Template) error {
+	deps := map[string][]string{}
+	for _, t := range templates {
+		deps[t.Var] = append(deps[t.Var], templateDependencies(t, templates)...)
+	}
+
+	const (
+		visiting = iota + 1
+		visited
+	)
+	state := map[string]int{}
+	var visit func(v string, path []string) error
+	visit = func(v string, path []string) error {
+		path = append(path, v)
+		switch state[v] {
+		case visiting:
+			return errorf("template variables depend on each other: %s", strings.Join(path, " -> "))
+		case visited:
+			return nil
+		}
+		state[v] = visiting
+		for _, d := range deps[v] {
+			if err := visit(d, path); err != nil {
+				return err
+			}
+		}
+		state[v] = visited
+		return nil
+	}
+	for _, t := range templates {
+		if err := visit(t.Var, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
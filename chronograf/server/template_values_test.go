@@ -0,0 +1,331 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bouk/httprouter"
+	"github.com/google/go-cmp/cmp"
+	jhttprouter "github.com/influxdata/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+func TestService_TemplateValues(t *testing.T) {
+	inventory := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("dc") {
+		case "west":
+			fmt.Fprint(w, `[{"name":"w1"},{"name":"w2"}]`)
+		default:
+			fmt.Fprint(w, `[{"name":"e1"}]`)
+		}
+	}))
+	defer inventory.Close()
+
+	var scripts []string
+	s := &Service{
+		Store: &mocks.Store{
+			DashboardsStore: &mocks.DashboardsStore{
+				GetF: func(ctx context.Context, id chronograf.DashboardID) (chronograf.Dashboard, error) {
+					return chronograf.Dashboard{
+						ID: 1,
+						Templates: []chronograf.Template{
+							{
+								ID:          "dc",
+								Type:        "csv",
+								TemplateVar: chronograf.TemplateVar{Var: ":dc:", Values: []chronograf.TemplateValue{{Value: "east", Type: "csv", Selected: true}, {Value: "west", Type: "csv"}}},
+							},
+							{
+								ID:          "host",
+								Type:        "url",
+								TemplateVar: chronograf.TemplateVar{Var: ":host:", Values: []chronograf.TemplateValue{{Value: "w2", Type: "url", Selected: true}}},
+								Query:       &chronograf.TemplateQuery{URL: inventory.URL + "/hosts?dc=:dc:", Format: "json", Column: "name"},
+							},
+							{
+								ID:          "instance",
+								Type:        "url",
+								TemplateVar: chronograf.TemplateVar{Var: ":instance:"},
+								Query:       &chronograf.TemplateQuery{URL: "http://169.254.169.254/latest/meta-data/instance-id"},
+							},
+							{
+								ID:          "cpu",
+								Type:        "flux",
+								TemplateVar: chronograf.TemplateVar{Var: ":cpu:"},
+								Query:       &chronograf.TemplateQuery{Flux: `from(bucket: "telegraf") |> filter(fn: (r) => r.host == ":host:")`, Column: "cpu"},
+							},
+						},
+					}, nil
+				},
+			},
+			SourcesStore: &mocks.SourcesStore{
+				GetF: func(ctx context.Context, id int) (chronograf.Source, error) {
+					if id != 1 {
+						return chronograf.Source{}, chronograf.ErrSourceNotFound
+					}
+					return chronograf.Source{ID: 1}, nil
+				},
+			},
+		},
+		TimeSeriesClient: &mocks.TimeSeries{
+			ConnectF: func(context.Context, *chronograf.Source) error {
+				return nil
+			},
+			FluxQueryF: func(ctx context.Context, script string) ([]byte, error) {
+				scripts = append(scripts, script)
+				return []byte("#datatype,string,long,string\n,result,table,cpu\n,_result,0,cpu0\n,_result,0,cpu1\n\n#datatype,string,long,string\n,result,table,cpu\n,_result,1,cpu0\n"), nil
+			},
+		},
+		Logger:           &chronograf.NoopLogger{},
+		TemplateURLHosts: []string{"127.0.0.1"},
+	}
+
+	tests := []struct {
+		name       string
+		tid        string
+		body       string
+		wantStatus int
+		wantValues []chronograf.TemplateValue
+		wantScript string
+	}{
+		{
+			name:       "URL chained to the saved selection",
+			tid:        "host",
+			wantStatus: http.StatusOK,
+			wantValues: []chronograf.TemplateValue{{Value: "e1", Type: "url", Selected: true}},
+		},
+		{
+			name:       "URL chained to the selection of the request",
+			tid:        "host",
+			body:       `{"tempVars":[{"tempVar":":dc:","values":[{"value":"west","type":"csv","selected":true}]}]}`,
+			wantStatus: http.StatusOK,
+			wantValues: []chronograf.TemplateValue{{Value: "w1", Type: "url"}, {Value: "w2", Type: "url", Selected: true}},
+		},
+		{
+			name:       "URL at a link-local address",
+			tid:        "instance",
+			wantStatus: http.StatusBadGateway,
+		},
+		{
+			name:       "Flux",
+			tid:        "cpu",
+			body:       `{"source":"1","tempVars":[{"tempVar":":host:","values":[{"value":"w1","type":"url","selected":true}]}]}`,
+			wantStatus: http.StatusOK,
+			wantValues: []chronograf.TemplateValue{{Value: "cpu0", Type: "flux", Selected: true}, {Value: "cpu1", Type: "flux"}},
+			wantScript: `from(bucket: "telegraf") |> filter(fn: (r) => r.host == "w1")`,
+		},
+		{
+			name:       "Flux without source",
+			tid:        "cpu",
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Flux with unknown source",
+			tid:        "cpu",
+			body:       `{"source":"2"}`,
+			wantStatus: http.StatusBadGateway,
+		},
+		{
+			name:       "Listed by the client",
+			tid:        "dc",
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Unknown template",
+			tid:        "mem",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scripts = nil
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url/chronograf/v1/dashboards/1/templates/"+tt.tid+"/values", bytes.NewBufferString(tt.body))
+			r = r.WithContext(httprouter.WithParams(
+				context.WithValue(r.Context(), jhttprouter.ParamsKey, jhttprouter.Params{{Key: "id", Value: "1"}, {Key: "tid", Value: tt.tid}}),
+				httprouter.Params{{Key: "id", Value: "1"}, {Key: "tid", Value: tt.tid}},
+			))
+
+			s.TemplateValues(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("%q. TemplateValues() = %v, want %v: %s", tt.name, resp.StatusCode, tt.wantStatus, body)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var got templateValuesResponse
+			if err := json.Unmarshal(body, &got); err != nil {
+				t.Fatalf("%q. TemplateValues() = %s", tt.name, body)
+			}
+			if diff := cmp.Diff(got.Values, tt.wantValues); diff != "" {
+				t.Errorf("%q. TemplateValues() diff (-got +want):\n%s", tt.name, diff)
+			}
+			if tt.wantScript != "" && (len(scripts) != 1 || scripts[0] != tt.wantScript) {
+				t.Errorf("%q. TemplateValues() ran %q, want %q", tt.name, scripts, tt.wantScript)
+			}
+		})
+	}
+}
+
+func Test_publicIP(t *testing.T) {
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{ip: "93.184.216.34", want: true},
+		{ip: "2606:2800:220:1:248:1893:25c8:1946", want: true},
+		{ip: "127.0.0.1"},
+		{ip: "::1"},
+		{ip: "0.0.0.0"},
+		{ip: "169.254.169.254"},
+		{ip: "fe80::1"},
+		{ip: "10.1.2.3"},
+		{ip: "172.16.0.1"},
+		{ip: "192.168.1.1"},
+		{ip: "100.64.0.1"},
+		{ip: "fd00::1"},
+		{ip: "::ffff:127.0.0.1"},
+		{ip: "224.0.0.1"},
+	}
+	for _, tt := range tests {
+		if got := publicIP(net.ParseIP(tt.ip)); got != tt.want {
+			t.Errorf("publicIP(%s) = %v, want %v", tt.ip, got, tt.want)
+		}
+	}
+}
+
+func Test_newTemplateURLClient(t *testing.T) {
+	inventory := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "e1\n")
+	}))
+	defer inventory.Close()
+
+	if _, err := fetchTemplateChoices(context.Background(), newTemplateURLClient(nil), inventory.URL, "", ""); err == nil {
+		t.Errorf("fetchTemplateChoices() of a loopback URL without allowed hosts succeeded")
+	}
+	got, err := fetchTemplateChoices(context.Background(), newTemplateURLClient([]string{"127.0.0.1"}), inventory.URL, "", "")
+	if err != nil {
+		t.Fatalf("fetchTemplateChoices() of an allowed host error = %v", err)
+	}
+	if len(got) != 1 || got[0] != "e1" {
+		t.Errorf("fetchTemplateChoices() of an allowed host = %v, want [e1]", got)
+	}
+}
+
+func Test_parseCSVChoices(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		column  string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "First field",
+			data: "a,1\nb,2\na,3\n",
+			want: []string{"a", "b"},
+		},
+		{
+			name:   "Column of a header",
+			data:   "id, name\n1, a\n2, b\n",
+			column: "name",
+			want:   []string{"a", "b"},
+		},
+		{
+			name:    "Unknown column",
+			data:    "id,name\n1,a\n",
+			column:  "host",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseCSVChoices([]byte(tt.data), tt.column)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseCSVChoices() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("parseCSVChoices() diff (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_parseJSONChoices(t *testing.T) {
+	got, err := parseJSONChoices([]byte(`["a", 1.5, true, "a", null]`), "")
+	if err != nil {
+		t.Fatalf("parseJSONChoices() error = %v", err)
+	}
+	if diff := cmp.Diff(got, []string{"a", "1.5", "true"}); diff != "" {
+		t.Errorf("parseJSONChoices() diff (-got +want):\n%s", diff)
+	}
+
+	if _, err := parseJSONChoices([]byte(`[{"name":"a"}]`), ""); err == nil {
+		t.Errorf("parseJSONChoices() of objects without a column succeeded")
+	}
+	if _, err := parseJSONChoices([]byte(`{"name":"a"}`), "name"); err == nil {
+		t.Errorf("parseJSONChoices() of an object succeeded")
+	}
+}
+
+func Test_parseFluxChoices(t *testing.T) {
+	data := "#datatype,string,string\n,error,reference\n,\"bucket \"\"telegraf\"\" not found\",\n"
+	if _, err := parseFluxChoices([]byte(data), "_value"); err == nil {
+		t.Errorf("parseFluxChoices() of an error table succeeded")
+	}
+}
+
+func Test_validTemplateDependencies(t *testing.T) {
+	template := func(v, influxql string) chronograf.Template {
+		return chronograf.Template{
+			TemplateVar: chronograf.TemplateVar{Var: v},
+			Type:        "influxql",
+			Query:       &chronograf.TemplateQuery{Command: influxql},
+		}
+	}
+	tests := []struct {
+		name      string
+		templates []chronograf.Template
+		wantErr   bool
+	}{
+		{
+			name: "Chain",
+			templates: []chronograf.Template{
+				template(":db:", "SHOW DATABASES"),
+				template(":measurement:", "SHOW MEASUREMENTS ON :db:"),
+				template(":host:", "SHOW TAG VALUES ON :db: FROM :measurement: WITH KEY = host"),
+			},
+		},
+		{
+			name: "Cycle",
+			templates: []chronograf.Template{
+				template(":a:", "SHOW TAG VALUES WITH KEY = a WHERE b = :b:"),
+				template(":b:", "SHOW TAG VALUES WITH KEY = b WHERE a = :a:"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Itself",
+			templates: []chronograf.Template{
+				template(":a:", "SHOW TAG VALUES WITH KEY = a WHERE a = :a:"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validTemplateDependencies(tt.templates); (err != nil) != tt.wantErr {
+				t.Errorf("validTemplateDependencies() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/bouk/httprouter"
 	"github.com/influxdata/influxdb/chronograf"
@@ -15,14 +16,14 @@ func ValidTemplateRequest(template *chronograf.Template) error {
 	switch template.Type {
 	default:
 		return fmt.Errorf("unknown template type %s", template.Type)
-	case "constant", "csv", "fieldKeys", "tagKeys", "tagValues", "measurements", "databases", "map", "influxql", "text":
+	case "constant", "csv", "fieldKeys", "tagKeys", "tagValues", "measurements", "databases", "map", "influxql", "text", "flux", "url":
 	}
 
 	for _, v := range template.Values {
 		switch v.Type {
 		default:
 			return fmt.Errorf("unknown template variable type %s", v.Type)
-		case "csv", "map", "fieldKey", "tagKey", "tagValue", "measurement", "database", "constant", "influxql":
+		case "csv", "map", "fieldKey", "tagKey", "tagValue", "measurement", "database", "constant", "influxql", "flux", "url":
 		}
 
 		if template.Type == "map" && v.Key == "" {
@@ -34,6 +35,24 @@ func ValidTemplateRequest(template *chronograf.Template) error {
 		return fmt.Errorf("no query set for template of type 'influxql'")
 	}
 
+	if template.Type == "flux" && (template.Query == nil || template.Query.Flux == "") {
+		return fmt.Errorf("no flux script set for template of type 'flux'")
+	}
+
+	if template.Type == "url" {
+		if template.Query == nil || template.Query.URL == "" {
+			return fmt.Errorf("no url set for template of type 'url'")
+		}
+		if !strings.HasPrefix(template.Query.URL, "http://") && !strings.HasPrefix(template.Query.URL, "https://") {
+			return fmt.Errorf("url of template must use http or https")
+		}
+		switch template.Query.Format {
+		case "", templateFormatCSV, templateFormatJSON:
+		default:
+			return fmt.Errorf("unknown format %s. Valid formats are '%s' and '%s'", template.Query.Format, templateFormatCSV, templateFormatJSON)
+		}
+	}
+
 	return nil
 }
 
@@ -98,6 +117,7 @@ func (s *Service) NewTemplate(w http.ResponseWriter, r *http.Request) {
 	}
 
 	ctx := r.Context()
+	defer lockResource("dashboards", id)()
 	dash, err := s.Store.Dashboards(ctx).Get(ctx, chronograf.DashboardID(id))
 	if err != nil {
 		notFound(w, id, s.Logger)
@@ -125,11 +145,17 @@ func (s *Service) NewTemplate(w http.ResponseWriter, r *http.Request) {
 	template.ID = chronograf.TemplateID(tid)
 
 	dash.Templates = append(dash.Templates, template)
+	if err := validTemplateDependencies(dash.Templates); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
 	if err := s.Store.Dashboards(ctx).Update(ctx, dash); err != nil {
 		msg := fmt.Sprintf("Error adding template %s to dashboard %d: %v", tid, id, err)
 		Error(w, http.StatusInternalServerError, msg, s.Logger)
 		return
 	}
+	s.recordDashboardVersion(ctx, dash.ID)
+	s.publishDashboardEvent(ctx, EventDashboardUpdated, dash)
 
 	res := newTemplateResponse(dash.ID, template)
 	encodeJSON(w, http.StatusOK, res, s.Logger)
@@ -171,6 +197,7 @@ func (s *Service) RemoveTemplate(w http.ResponseWriter, r *http.Request) {
 	}
 
 	ctx := r.Context()
+	defer lockResource("dashboards", id)()
 	dash, err := s.Store.Dashboards(ctx).Get(ctx, chronograf.DashboardID(id))
 	if err != nil {
 		notFound(w, id, s.Logger)
@@ -196,6 +223,8 @@ func (s *Service) RemoveTemplate(w http.ResponseWriter, r *http.Request) {
 		Error(w, http.StatusInternalServerError, msg, s.Logger)
 		return
 	}
+	s.recordDashboardVersion(ctx, dash.ID)
+	s.publishDashboardEvent(ctx, EventDashboardUpdated, dash)
 
 	w.WriteHeader(http.StatusNoContent)
 }
@@ -209,6 +238,7 @@ func (s *Service) ReplaceTemplate(w http.ResponseWriter, r *http.Request) {
 	}
 
 	ctx := r.Context()
+	defer lockResource("dashboards", id)()
 	dash, err := s.Store.Dashboards(ctx).Get(ctx, chronograf.DashboardID(id))
 	if err != nil {
 		notFound(w, id, s.Logger)
@@ -241,11 +271,17 @@ func (s *Service) ReplaceTemplate(w http.ResponseWriter, r *http.Request) {
 	template.ID = chronograf.TemplateID(tid)
 
 	dash.Templates[pos] = template
+	if err := validTemplateDependencies(dash.Templates); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
 	if err := s.Store.Dashboards(ctx).Update(ctx, dash); err != nil {
 		msg := fmt.Sprintf("Error updating template %s in dashboard %d: %v", tid, id, err)
 		Error(w, http.StatusInternalServerError, msg, s.Logger)
 		return
 	}
+	s.recordDashboardVersion(ctx, dash.ID)
+	s.publishDashboardEvent(ctx, EventDashboardUpdated, dash)
 
 	res := newTemplateResponse(chronograf.DashboardID(id), template)
 	encodeJSON(w, http.StatusOK, res, s.Logger)
@@ -90,6 +90,44 @@ func TestValidTemplateRequest(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Valid Flux type",
+			template: &chronograf.Template{
+				Type:  "flux",
+				Query: &chronograf.TemplateQuery{Flux: `from(bucket: "telegraf") |> range(start: -1h)`},
+			},
+		},
+		{
+			name:    "Flux without script",
+			wantErr: true,
+			template: &chronograf.Template{
+				Type:  "flux",
+				Query: &chronograf.TemplateQuery{Command: "SHOW DATABASES"},
+			},
+		},
+		{
+			name: "Valid URL type",
+			template: &chronograf.Template{
+				Type:  "url",
+				Query: &chronograf.TemplateQuery{URL: "https://inventory/hosts?dc=:dc:", Format: "json", Column: "name"},
+			},
+		},
+		{
+			name:    "URL without http",
+			wantErr: true,
+			template: &chronograf.Template{
+				Type:  "url",
+				Query: &chronograf.TemplateQuery{URL: "file:///etc/hosts"},
+			},
+		},
+		{
+			name:    "URL of unknown format",
+			wantErr: true,
+			template: &chronograf.Template{
+				Type:  "url",
+				Query: &chronograf.TemplateQuery{URL: "https://inventory/hosts", Format: "xml"},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
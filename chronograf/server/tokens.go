@@ -0,0 +1,365 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bouk/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/roles"
+)
+
+const (
+	// tokenAuthScheme is the scheme of the Authorization header carrying
+	// the secret of an API token, e.g. "Authorization: Token chronograf_..."
+	tokenAuthScheme = "Token"
+	// tokenPrefix is prepended to the secrets of API tokens to make them
+	// recognizable, for instance by secret scanners
+	tokenPrefix = "chronograf_"
+	// tokenResourceTokens is the API resource of tokens themselves
+	tokenResourceTokens = "tokens"
+	// tokenResourceMe is the API resource of the current user, which a token
+	// does not have as it is not stored
+	tokenResourceMe = "me"
+)
+
+// tokenScopes are the API resources under /chronograf/v1 a token can be
+// restricted to.
+var tokenScopes = map[string]bool{
+	"dashboards":         true,
+	"sources":            true,
+	"layouts":            true,
+	"organizations":      true,
+	"users":              true,
+	"mappings":           true,
+	"config":             true,
+	"org_config":         true,
+	"env":                true,
+	"plugins":            true,
+	"telegraf-config":    true,
+	"invitations":        true,
+	"dashboard-imports":  true,
+	"reports":            true,
+	"annotations":        true,
+	"annotation-streams": true,
+	"streams":            true,
+	"search":             true,
+	"flux":               true,
+	"health":             true,
+	"proxy":              true,
+	"discovery":          true,
+	"queries":            true,
+	"rule-templates":     true,
+}
+
+// tokenResourceScopes are the scopes of the API resources that belong to the
+// resource of another scope, such as the folders of dashboards or the query
+// jobs run against sources
+var tokenResourceScopes = map[string]string{
+	"folders": "dashboards",
+	"jobs":    "sources",
+}
+
+type tokenContextKey string
+
+// TokenContextKey is the context key for retrieving the API token
+// authenticating the request
+const TokenContextKey = tokenContextKey("token")
+
+// hasTokenContext retrieves the API token authenticating the request
+func hasTokenContext(ctx context.Context) (*chronograf.Token, bool) {
+	// prevents panic in case of nil context
+	if ctx == nil {
+		return nil, false
+	}
+	t, ok := ctx.Value(TokenContextKey).(*chronograf.Token)
+	// should never happen
+	if !ok {
+		return nil, false
+	}
+	if t == nil {
+		return nil, false
+	}
+	return t, true
+}
+
+// hashToken returns the hex encoded SHA-256 of the secret of a token
+func hashToken(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// newTokenSecret generates a random secret for a token
+func newTokenSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return tokenPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// tokenSecret returns the secret of the API token of the Authorization header
+func tokenSecret(r *http.Request) (string, bool) {
+	parts := strings.SplitN(r.Header.Get("Authorization"), " ", 2)
+	if len(parts) != 2 || !strings.EqualFold(parts[0], tokenAuthScheme) {
+		return "", false
+	}
+	secret := strings.TrimSpace(parts[1])
+	return secret, secret != ""
+}
+
+// tokenResource returns the API resource of a request path, such as
+// "dashboards" for /chronograf/v1/dashboards/1/cells
+func tokenResource(p string) string {
+	const root = "/chronograf/v1/"
+	i := strings.Index(p, root)
+	if i < 0 {
+		return ""
+	}
+	resource := p[i+len(root):]
+	if j := strings.IndexByte(resource, '/'); j >= 0 {
+		resource = resource[:j]
+	}
+	return resource
+}
+
+// tokenAllows is true if the token may access the resource of the request
+// path, or the resource it belongs to. Tokens cannot be used to manage tokens nor to access the inbox or
+// preferences of a user.
+func tokenAllows(t *chronograf.Token, p string) bool {
+	resource := tokenResource(p)
+	if resource == tokenResourceTokens || resource == tokenResourceMe {
+		return false
+	}
+	if scope, ok := tokenResourceScopes[resource]; ok {
+		resource = scope
+	}
+	return t.Allows(resource)
+}
+
+// tokenUser is the user a token acts as. It has no ID as it is not stored
+// and its only role is the role of the token.
+func tokenUser(t *chronograf.Token) *chronograf.User {
+	return &chronograf.User{
+		Name:     t.Name,
+		Provider: "chronograf",
+		Scheme:   "token",
+		Roles: []chronograf.Role{
+			{
+				Name:         t.Role,
+				Organization: t.Organization,
+			},
+		},
+	}
+}
+
+// AuthorizedAPIToken validates the API token of the Authorization header of
+// a request. A valid token is sent to the next handler via the request's
+// context; AuthorizedUser then authorizes the token instead of the principal
+// of the OAuth flow. Requests without a token are passed on unchanged.
+// On an unknown or expired token, will return http.StatusForbidden.
+func AuthorizedAPIToken(store DataStore, logger chronograf.Logger, next http.Handler) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		secret, ok := tokenSecret(r)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		log := logger.
+			WithField("component", "api_token_auth").
+			WithField("remote_addr", r.RemoteAddr).
+			WithField("method", r.Method).
+			WithField("url", r.URL)
+
+		ctx := r.Context()
+		serverCtx := serverContext(ctx)
+		hash := hashToken(secret)
+		t, err := store.Tokens(serverCtx).Get(serverCtx, chronograf.TokenQuery{Hash: &hash})
+		if err != nil {
+			log.Error("Invalid API token")
+			Error(w, http.StatusForbidden, "Token is not authorized", logger)
+			return
+		}
+		if t.Expired(time.Now()) {
+			log.Error(fmt.Sprintf("API token %s expired", t.ID))
+			Error(w, http.StatusForbidden, "Token is expired", logger)
+			return
+		}
+
+		ctx = context.WithValue(ctx, TokenContextKey, t)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+type tokenLinks struct {
+	Self string `json:"self"` // Self link mapping to this resource
+}
+
+type tokenResponse struct {
+	Links tokenLinks `json:"links"`
+	chronograf.Token
+	Secret string `json:"token,omitempty"` // Secret is only returned when the token is created
+}
+
+func newTokenResponse(t chronograf.Token) *tokenResponse {
+	if t.Scopes == nil {
+		t.Scopes = []string{}
+	}
+	return &tokenResponse{
+		Links: tokenLinks{
+			Self: fmt.Sprintf("/chronograf/v1/tokens/%s", t.ID),
+		},
+		Token: t,
+	}
+}
+
+type tokensResponse struct {
+	Links  selfLinks        `json:"links"`
+	Tokens []*tokenResponse `json:"tokens"`
+}
+
+func newTokensResponse(ts []chronograf.Token) *tokensResponse {
+	tokens := make([]*tokenResponse, len(ts))
+	for i, t := range ts {
+		tokens[i] = newTokenResponse(t)
+	}
+	return &tokensResponse{
+		Links: selfLinks{
+			Self: "/chronograf/v1/tokens",
+		},
+		Tokens: tokens,
+	}
+}
+
+type tokenRequest struct {
+	Name      string     `json:"name"`
+	Role      string     `json:"role"`
+	Scopes    []string   `json:"scopes"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+// ValidCreate checks that the token request is valid and defaults the role
+// of the token to viewer, which grants read-only access.
+func (r *tokenRequest) ValidCreate(now time.Time) error {
+	if r.Name == "" {
+		return errorf("name required on Chronograf Token request body")
+	}
+	switch r.Role {
+	case "":
+		r.Role = roles.ViewerRoleName
+	case roles.ViewerRoleName, roles.EditorRoleName, roles.AdminRoleName:
+	default:
+		return errorf("unknown role %s. Valid roles are 'viewer', 'editor', and 'admin'", r.Role)
+	}
+	for _, scope := range r.Scopes {
+		if !tokenScopes[scope] {
+			return errorf("unknown scope %s", scope)
+		}
+	}
+	if r.ExpiresAt != nil && !r.ExpiresAt.After(now) {
+		return errorf("expiresAt must be in the future")
+	}
+	return nil
+}
+
+// Tokens lists the API tokens of the current organization
+func (s *Service) Tokens(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	ts, err := s.Store.Tokens(ctx).All(ctx)
+	if err != nil {
+		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+		return
+	}
+
+	encodeJSON(w, http.StatusOK, newTokensResponse(ts), s.Logger)
+}
+
+// TokenID returns a single API token of the current organization
+func (s *Service) TokenID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := httprouter.GetParamFromContext(ctx, "id")
+	t, err := s.Store.Tokens(ctx).Get(ctx, chronograf.TokenQuery{ID: &id})
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+
+	encodeJSON(w, http.StatusOK, newTokenResponse(*t), s.Logger)
+}
+
+// NewToken mints an API token within the current organization. The secret
+// of the token is only part of this response.
+func (s *Service) NewToken(w http.ResponseWriter, r *http.Request) {
+	var req tokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	if err := req.ValidCreate(time.Now()); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	orgID, ok := hasOrganizationContext(ctx)
+	if !ok {
+		unknownErrorWithMessage(w, fmt.Errorf("expected organization to be set on context"), s.Logger)
+		return
+	}
+
+	secret, err := newTokenSecret()
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	t := &chronograf.Token{
+		Name:         req.Name,
+		Organization: orgID,
+		Role:         req.Role,
+		Scopes:       req.Scopes,
+		Hash:         hashToken(secret),
+		ExpiresAt:    req.ExpiresAt,
+	}
+	if u, ok := hasUserContext(ctx); ok {
+		t.CreatedBy = u.ID
+	}
+
+	t, err = s.Store.Tokens(ctx).Add(ctx, t)
+	if err != nil {
+		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+		return
+	}
+
+	res := newTokenResponse(*t)
+	res.Secret = secret
+	location(w, res.Links.Self)
+	encodeJSON(w, http.StatusCreated, res, s.Logger)
+}
+
+// RemoveToken revokes an API token of the current organization
+func (s *Service) RemoveToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := httprouter.GetParamFromContext(ctx, "id")
+	t, err := s.Store.Tokens(ctx).Get(ctx, chronograf.TokenQuery{ID: &id})
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+
+	if err := s.Store.Tokens(ctx).Delete(ctx, t); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
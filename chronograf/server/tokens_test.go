@@ -0,0 +1,436 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bouk/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+	"github.com/influxdata/influxdb/chronograf/organizations"
+	"github.com/influxdata/influxdb/chronograf/roles"
+)
+
+func TestService_NewToken(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantBody   string
+		want       *chronograf.Token
+	}{
+		{
+			name:       "Create read-only dashboards token",
+			body:       `{"name":"grafana","scopes":["dashboards"]}`,
+			wantStatus: http.StatusCreated,
+			want: &chronograf.Token{
+				ID:           "1",
+				Name:         "grafana",
+				Organization: "1337",
+				Role:         roles.ViewerRoleName,
+				Scopes:       []string{"dashboards"},
+				CreatedBy:    42,
+			},
+		},
+		{
+			name:       "Create token rendering queries",
+			body:       `{"name":"grafana","scopes":["queries"]}`,
+			wantStatus: http.StatusCreated,
+			want: &chronograf.Token{
+				ID:           "1",
+				Name:         "grafana",
+				Organization: "1337",
+				Role:         roles.ViewerRoleName,
+				Scopes:       []string{"queries"},
+				CreatedBy:    42,
+			},
+		},
+		{
+			name:       "Create rule templates token",
+			body:       `{"name":"terraform","role":"admin","scopes":["rule-templates"]}`,
+			wantStatus: http.StatusCreated,
+			want: &chronograf.Token{
+				ID:           "1",
+				Name:         "terraform",
+				Organization: "1337",
+				Role:         roles.AdminRoleName,
+				Scopes:       []string{"rule-templates"},
+				CreatedBy:    42,
+			},
+		},
+		{
+			name:       "Unknown role",
+			body:       `{"name":"grafana","role":"member"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody:   `{"code":422,"message":"unknown role member. Valid roles are 'viewer', 'editor', and 'admin'","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"unknown role member. Valid roles are 'viewer', 'editor', and 'admin'"}`,
+		},
+		{
+			name:       "Unknown scope",
+			body:       `{"name":"grafana","scopes":["tokens"]}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody:   `{"code":422,"message":"unknown scope tokens","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"unknown scope tokens"}`,
+		},
+		{
+			name:       "Expired",
+			body:       `{"name":"grafana","expiresAt":"2001-01-01T00:00:00Z"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody:   `{"code":422,"message":"expiresAt must be in the future","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"expiresAt must be in the future"}`,
+		},
+		{
+			name:       "No name",
+			body:       `{"role":"viewer"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody:   `{"code":422,"message":"name required on Chronograf Token request body","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"name required on Chronograf Token request body"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var added *chronograf.Token
+			s := &Service{
+				Store: &mocks.Store{
+					TokensStore: &mocks.TokensStore{
+						AddF: func(ctx context.Context, tok *chronograf.Token) (*chronograf.Token, error) {
+							tok.ID = "1"
+							added = tok
+							return tok, nil
+						},
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url/chronograf/v1/tokens", bytes.NewBufferString(tt.body))
+			ctx := context.WithValue(r.Context(), organizations.ContextKey, "1337")
+			ctx = context.WithValue(ctx, UserContextKey, &chronograf.User{ID: 42})
+			r = r.WithContext(ctx)
+
+			s.NewToken(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("%q. NewToken() = %v, want %v: %s", tt.name, resp.StatusCode, tt.wantStatus, body)
+			}
+			if tt.want == nil {
+				if eq, _ := jsonEqual(string(body), tt.wantBody); !eq {
+					t.Errorf("%q. NewToken() = \n***%v***\n,\nwant\n***%v***", tt.name, string(body), tt.wantBody)
+				}
+				return
+			}
+
+			var res struct {
+				Secret string `json:"token"`
+			}
+			if err := json.Unmarshal(body, &res); err != nil {
+				t.Fatal(err)
+			}
+			if !strings.HasPrefix(res.Secret, tokenPrefix) {
+				t.Errorf("%q. NewToken() secret = %s, want prefix %s", tt.name, res.Secret, tokenPrefix)
+			}
+			if added.Hash != hashToken(res.Secret) {
+				t.Errorf("%q. NewToken() stored hash does not match the secret", tt.name)
+			}
+			tt.want.Hash = added.Hash
+			if !reflect.DeepEqual(added, tt.want) {
+				t.Errorf("%q. NewToken() added %#v, want %#v", tt.name, added, tt.want)
+			}
+			if loc := resp.Header.Get("Location"); loc != "/chronograf/v1/tokens/1" {
+				t.Errorf("%q. NewToken() Location = %s", tt.name, loc)
+			}
+		})
+	}
+}
+
+func TestService_Tokens(t *testing.T) {
+	s := &Service{
+		Store: &mocks.Store{
+			TokensStore: &mocks.TokensStore{
+				AllF: func(ctx context.Context) ([]chronograf.Token, error) {
+					return []chronograf.Token{
+						{
+							ID:           "1",
+							Name:         "grafana",
+							Organization: "1337",
+							Role:         roles.ViewerRoleName,
+							Hash:         "secret hash",
+							CreatedBy:    42,
+							CreatedAt:    time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC),
+						},
+					}, nil
+				},
+			},
+		},
+		Logger: &chronograf.NoopLogger{},
+	}
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "http://any.url/chronograf/v1/tokens", nil)
+
+	s.Tokens(w, r)
+
+	resp := w.Result()
+	body, _ := ioutil.ReadAll(resp.Body)
+	want := `{"links":{"self":"/chronograf/v1/tokens"},"tokens":[{"links":{"self":"/chronograf/v1/tokens/1"},"id":"1","name":"grafana","organization":"1337","role":"viewer","scopes":[],"createdBy":"42","createdAt":"2018-01-01T00:00:00Z"}]}`
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Tokens() = %v, want %v", resp.StatusCode, http.StatusOK)
+	}
+	if eq, _ := jsonEqual(string(body), want); !eq {
+		t.Errorf("Tokens() = \n***%v***\n,\nwant\n***%v***", string(body), want)
+	}
+}
+
+func TestService_RemoveToken(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		wantStatus int
+	}{
+		{
+			name:       "Revoke token",
+			id:         "1",
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "Unknown token",
+			id:         "2",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var deleted bool
+			s := &Service{
+				Store: &mocks.Store{
+					TokensStore: &mocks.TokensStore{
+						GetF: func(ctx context.Context, q chronograf.TokenQuery) (*chronograf.Token, error) {
+							if *q.ID != "1" {
+								return nil, chronograf.ErrTokenNotFound
+							}
+							return &chronograf.Token{ID: "1"}, nil
+						},
+						DeleteF: func(ctx context.Context, tok *chronograf.Token) error {
+							deleted = true
+							return nil
+						},
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("DELETE", "http://any.url", nil)
+			r = r.WithContext(httprouter.WithParams(r.Context(), httprouter.Params{{Key: "id", Value: tt.id}}))
+
+			s.RemoveToken(w, r)
+
+			if got := w.Result().StatusCode; got != tt.wantStatus {
+				t.Errorf("%q. RemoveToken() = %v, want %v", tt.name, got, tt.wantStatus)
+			}
+			if deleted != (tt.wantStatus == http.StatusNoContent) {
+				t.Errorf("%q. RemoveToken() deleted = %v", tt.name, deleted)
+			}
+		})
+	}
+}
+
+func TestAuthorizedAPIToken(t *testing.T) {
+	expired := time.Now().Add(-time.Hour)
+	secret := "chronograf_secret"
+	tests := []struct {
+		name          string
+		authorization string
+		token         *chronograf.Token
+		wantStatus    int
+		wantToken     bool
+	}{
+		{
+			name:          "Valid token",
+			authorization: "Token " + secret,
+			token:         &chronograf.Token{ID: "1", Hash: hashToken(secret)},
+			wantStatus:    http.StatusOK,
+			wantToken:     true,
+		},
+		{
+			name:       "No token",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:          "Unknown token",
+			authorization: "Token chronograf_unknown",
+			token:         &chronograf.Token{ID: "1", Hash: hashToken(secret)},
+			wantStatus:    http.StatusForbidden,
+		},
+		{
+			name:          "Expired token",
+			authorization: "Token " + secret,
+			token:         &chronograf.Token{ID: "1", Hash: hashToken(secret), ExpiresAt: &expired},
+			wantStatus:    http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &mocks.Store{
+				TokensStore: &mocks.TokensStore{
+					GetF: func(ctx context.Context, q chronograf.TokenQuery) (*chronograf.Token, error) {
+						if tt.token == nil || *q.Hash != tt.token.Hash {
+							return nil, chronograf.ErrTokenNotFound
+						}
+						return tt.token, nil
+					},
+				},
+			}
+			var hasToken bool
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, hasToken = hasTokenContext(r.Context())
+			})
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("GET", "http://any.url/chronograf/v1/dashboards", nil)
+			if tt.authorization != "" {
+				r.Header.Set("Authorization", tt.authorization)
+			}
+
+			AuthorizedAPIToken(store, &chronograf.NoopLogger{}, next)(w, r)
+
+			if got := w.Result().StatusCode; got != tt.wantStatus {
+				t.Errorf("%q. AuthorizedAPIToken() = %v, want %v", tt.name, got, tt.wantStatus)
+			}
+			if hasToken != tt.wantToken {
+				t.Errorf("%q. AuthorizedAPIToken() token on context = %v, want %v", tt.name, hasToken, tt.wantToken)
+			}
+		})
+	}
+}
+
+func TestAuthorizedUser_Token(t *testing.T) {
+	tests := []struct {
+		name       string
+		token      *chronograf.Token
+		role       string
+		path       string
+		authorized bool
+	}{
+		{
+			name:       "Viewer token reads dashboards",
+			token:      &chronograf.Token{ID: "1", Organization: "1337", Role: roles.ViewerRoleName, Scopes: []string{"dashboards"}},
+			role:       roles.ViewerRoleName,
+			path:       "/chronograf/v1/dashboards/1",
+			authorized: true,
+		},
+		{
+			name:       "Viewer token cannot edit",
+			token:      &chronograf.Token{ID: "1", Organization: "1337", Role: roles.ViewerRoleName},
+			role:       roles.EditorRoleName,
+			path:       "/chronograf/v1/dashboards/1",
+			authorized: false,
+		},
+		{
+			name:       "Dashboards token cannot access sources",
+			token:      &chronograf.Token{ID: "1", Organization: "1337", Role: roles.AdminRoleName, Scopes: []string{"dashboards"}},
+			role:       roles.ViewerRoleName,
+			path:       "/chronograf/v1/sources/1",
+			authorized: false,
+		},
+		{
+			name:       "Dashboards token reads folders",
+			token:      &chronograf.Token{ID: "1", Organization: "1337", Role: roles.ViewerRoleName, Scopes: []string{"dashboards"}},
+			role:       roles.ViewerRoleName,
+			path:       "/chronograf/v1/folders/1/dashboards",
+			authorized: true,
+		},
+		{
+			name:       "Sources token cannot access folders",
+			token:      &chronograf.Token{ID: "1", Organization: "1337", Role: roles.AdminRoleName, Scopes: []string{"sources"}},
+			role:       roles.ViewerRoleName,
+			path:       "/chronograf/v1/folders",
+			authorized: false,
+		},
+		{
+			name:       "Sources token polls the results of its query jobs",
+			token:      &chronograf.Token{ID: "1", Organization: "1337", Role: roles.ViewerRoleName, Scopes: []string{"sources"}},
+			role:       roles.ViewerRoleName,
+			path:       "/chronograf/v1/jobs/1/results",
+			authorized: true,
+		},
+		{
+			name:       "Dashboards token cannot access query jobs",
+			token:      &chronograf.Token{ID: "1", Organization: "1337", Role: roles.AdminRoleName, Scopes: []string{"dashboards"}},
+			role:       roles.ViewerRoleName,
+			path:       "/chronograf/v1/jobs/1",
+			authorized: false,
+		},
+		{
+			name:       "Queries token renders queries",
+			token:      &chronograf.Token{ID: "1", Organization: "1337", Role: roles.ViewerRoleName, Scopes: []string{"queries"}},
+			role:       roles.ViewerRoleName,
+			path:       "/chronograf/v1/queries/render",
+			authorized: true,
+		},
+		{
+			name:       "Rule templates token lists the checks of a template",
+			token:      &chronograf.Token{ID: "1", Organization: "1337", Role: roles.ViewerRoleName, Scopes: []string{"rule-templates"}},
+			role:       roles.ViewerRoleName,
+			path:       "/chronograf/v1/rule-templates/1/checks",
+			authorized: true,
+		},
+		{
+			name:       "Admin token cannot manage tokens",
+			token:      &chronograf.Token{ID: "1", Organization: "1337", Role: roles.AdminRoleName},
+			role:       roles.AdminRoleName,
+			path:       "/chronograf/v1/tokens",
+			authorized: false,
+		},
+		{
+			name:       "Tokens have no preferences",
+			token:      &chronograf.Token{ID: "1", Organization: "1337", Role: roles.AdminRoleName},
+			role:       roles.MemberRoleName,
+			path:       "/chronograf/v1/me/preferences",
+			authorized: false,
+		},
+		{
+			name:       "Tokens are never super admins",
+			token:      &chronograf.Token{ID: "1", Organization: "1337", Role: roles.AdminRoleName},
+			role:       roles.SuperAdminStatus,
+			path:       "/chronograf/v1/users",
+			authorized: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &mocks.Store{
+				OrganizationsStore: &mocks.OrganizationsStore{
+					DefaultOrganizationF: func(ctx context.Context) (*chronograf.Organization, error) {
+						return &chronograf.Organization{ID: "0"}, nil
+					},
+					GetF: func(ctx context.Context, q chronograf.OrganizationQuery) (*chronograf.Organization, error) {
+						return &chronograf.Organization{ID: *q.ID}, nil
+					},
+				},
+			}
+			var authorized bool
+			var org, role string
+			next := func(w http.ResponseWriter, r *http.Request) {
+				authorized = true
+				org, _ = hasOrganizationContext(r.Context())
+				role, _ = hasRoleContext(r.Context())
+			}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("GET", "http://any.url"+tt.path, nil)
+			r = r.WithContext(context.WithValue(r.Context(), TokenContextKey, tt.token))
+
+			AuthorizedUser(store, true, tt.role, &chronograf.NoopLogger{}, next)(w, r)
+
+			if authorized != tt.authorized {
+				t.Errorf("%q. AuthorizedUser() authorized = %v, want %v", tt.name, authorized, tt.authorized)
+			}
+			if authorized && (org != tt.token.Organization || role != tt.token.Role) {
+				t.Errorf("%q. AuthorizedUser() context organization %s and role %s, want %s and %s", tt.name, org, role, tt.token.Organization, tt.token.Role)
+			}
+		})
+	}
+}
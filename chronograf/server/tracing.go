@@ -0,0 +1,123 @@
+package server
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/influxdata/influxdb/chronograf"
+	clog "github.com/influxdata/influxdb/chronograf/log"
+	"github.com/influxdata/influxdb/kit/tracing"
+	"github.com/opentracing/opentracing-go"
+	jaeger "github.com/uber/jaeger-client-go"
+	jaegerconfig "github.com/uber/jaeger-client-go/config"
+)
+
+var _ chronograf.Router = &TracedRouter{}
+
+// TracedRouter is an implementation of a chronograf.Router which starts a
+// span for the requests to each route of a Delegated chronograf.Router. The
+// spans of the stores and of the requests proxied to InfluxDB and Kapacitor
+// are children of the span of their request.
+type TracedRouter struct {
+	Delegate chronograf.Router
+}
+
+// trace starts a span named after the route of method and path for its
+// requests, continuing the trace of the tracing headers of a request
+func (tr *TracedRouter) trace(method, path string, next http.Handler) http.HandlerFunc {
+	name := method + " " + path
+	return func(w http.ResponseWriter, r *http.Request) {
+		span, r := tracing.ExtractFromHTTPRequest(r, name)
+		defer span.Finish()
+		span.SetOperationName(name)
+		span.SetTag("http.method", method)
+		span.SetTag("http.url", r.URL.String())
+		if id, ok := clog.RequestID(r.Context()); ok {
+			span.SetTag("request_id", id)
+		}
+
+		sw := &statusWriter{
+			ResponseWriter: w,
+		}
+		if f, ok := w.(http.Flusher); ok {
+			sw.Flusher = f
+		}
+		next.ServeHTTP(sw, r)
+
+		// Handlers writing the body without a header respond with 200 OK
+		status := sw.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+		span.SetTag("http.status_code", status)
+		if status >= http.StatusInternalServerError {
+			span.SetTag("error", true)
+		}
+	}
+}
+
+// DELETE defines a route responding to a DELETE request whose requests are
+// traced
+func (tr *TracedRouter) DELETE(path string, handler http.HandlerFunc) {
+	tr.Delegate.DELETE(path, tr.trace("DELETE", path, handler))
+}
+
+// GET defines a route responding to a GET request whose requests are traced
+func (tr *TracedRouter) GET(path string, handler http.HandlerFunc) {
+	tr.Delegate.GET(path, tr.trace("GET", path, handler))
+}
+
+// POST defines a route responding to a POST request whose requests are
+// traced
+func (tr *TracedRouter) POST(path string, handler http.HandlerFunc) {
+	tr.Delegate.POST(path, tr.trace("POST", path, handler))
+}
+
+// PUT defines a route responding to a PUT request whose requests are traced
+func (tr *TracedRouter) PUT(path string, handler http.HandlerFunc) {
+	tr.Delegate.PUT(path, tr.trace("PUT", path, handler))
+}
+
+// PATCH defines a route responding to a PATCH request whose requests are
+// traced
+func (tr *TracedRouter) PATCH(path string, handler http.HandlerFunc) {
+	tr.Delegate.PATCH(path, tr.trace("PATCH", path, handler))
+}
+
+// Handler defines a route responding to a request type specified in the
+// method parameter whose requests are traced
+func (tr *TracedRouter) Handler(method string, path string, handler http.Handler) {
+	tr.Delegate.Handler(method, path, tr.trace(method, path, handler))
+}
+
+// ServeHTTP is an implementation of http.Handler which delegates to the
+// configured Delegate's implementation of http.Handler
+func (tr *TracedRouter) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	tr.Delegate.ServeHTTP(rw, r)
+}
+
+// useTracing is true when the spans of Chronograf are exported
+func (s *Server) useTracing() bool {
+	return s.TracingEndpoint != ""
+}
+
+// tracer sets the global tracer to one exporting the sampled traces to the
+// tracing endpoint. The returned closer flushes the spans not yet exported.
+func (s *Server) tracer() (io.Closer, error) {
+	cfg := jaegerconfig.Configuration{
+		ServiceName: s.TracingServiceName,
+		Sampler: &jaegerconfig.SamplerConfig{
+			Type:  jaeger.SamplerTypeProbabilistic,
+			Param: s.TracingSampleRatio,
+		},
+		Reporter: &jaegerconfig.ReporterConfig{
+			CollectorEndpoint: s.TracingEndpoint,
+		},
+	}
+	tracer, closer, err := cfg.NewTracer()
+	if err != nil {
+		return nil, err
+	}
+	opentracing.SetGlobalTracer(tracer)
+	return closer, nil
+}
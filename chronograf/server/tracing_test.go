@@ -0,0 +1,102 @@
+package server
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bouk/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/kit/tracing"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/mocktracer"
+)
+
+func TestTracedRouter(t *testing.T) {
+	tracer := mocktracer.New()
+	oldTracer := opentracing.GlobalTracer()
+	opentracing.SetGlobalTracer(tracer)
+	defer opentracing.SetGlobalTracer(oldTracer)
+
+	router := &TracedRouter{
+		Delegate: &MountableRouter{
+			Prefix:   "/chronograf",
+			Delegate: httprouter.New(),
+		},
+	}
+	router.GET("/chronograf/v1/sources/:id", func(w http.ResponseWriter, r *http.Request) {
+		// Stores and proxied requests start children of the span of the request
+		span, _ := tracing.StartSpanFromContext(r.Context())
+		span.Finish()
+		if httprouter.GetParamFromContext(r.Context(), "id") == "2" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+
+	tests := []struct {
+		name      string
+		url       string
+		wantCode  int
+		wantError bool
+	}{
+		{
+			name:     "Traces a request and its store operations",
+			url:      "http://any.url/chronograf/chronograf/v1/sources/1",
+			wantCode: http.StatusOK,
+		},
+		{
+			name:      "Marks the spans of failed requests",
+			url:       "http://any.url/chronograf/chronograf/v1/sources/2",
+			wantCode:  http.StatusInternalServerError,
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracer.Reset()
+			RequestID(router).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", tt.url, nil))
+
+			spans := tracer.FinishedSpans()
+			if len(spans) != 2 {
+				t.Fatalf("TracedRouter finished %d spans, want 2", len(spans))
+			}
+			child, parent := spans[0], spans[1]
+			if want := "GET /chronograf/v1/sources/:id"; parent.OperationName != want {
+				t.Errorf("TracedRouter span operation = %q, want %q", parent.OperationName, want)
+			}
+			if child.ParentID != parent.SpanContext.SpanID {
+				t.Errorf("TracedRouter span is not the parent of the spans of its handler")
+			}
+			if got := parent.Tag("http.status_code"); got != tt.wantCode {
+				t.Errorf("TracedRouter span status = %v, want %d", got, tt.wantCode)
+			}
+			if got := parent.Tag("error") != nil; got != tt.wantError {
+				t.Errorf("TracedRouter span error = %t, want %t", got, tt.wantError)
+			}
+			if parent.Tag("request_id") == nil {
+				t.Errorf("TracedRouter span has no request_id")
+			}
+		})
+	}
+}
+
+func TestTracedRouter_Localize(t *testing.T) {
+	router := &TracedRouter{
+		Delegate: httprouter.New(),
+	}
+	router.POST("/chronograf/v1/sources", func(w http.ResponseWriter, r *http.Request) {
+		invalidJSON(w, &chronograf.NoopLogger{})
+	})
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("POST", "http://any.url/chronograf/v1/sources", nil)
+	r.Header.Set("Accept-Language", "de")
+	Localize(router).ServeHTTP(w, r)
+
+	body, _ := ioutil.ReadAll(w.Result().Body)
+	if !strings.Contains(string(body), `"message":"JSON konnte nicht gelesen werden"`) || w.Header().Get("Content-Language") != "de" {
+		t.Errorf("Localize() of a traced route = %s, Content-Language %q", body, w.Header().Get("Content-Language"))
+	}
+}
@@ -0,0 +1,402 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/influx"
+	"github.com/influxdata/influxdb/chronograf/oauth2"
+)
+
+// The upserts below create or replace a resource identified by a natural key
+// given in a query parameter rather than by its ID, so that tools that
+// provision Chronograf declaratively can apply the same request again and
+// again. They respond with 201 when the resource is created and 200 when it
+// is replaced.
+
+// upsertKey returns the natural key of the query parameter param. A key of
+// the request body, if any, must be the same.
+func upsertKey(r *http.Request, param, body string) (string, error) {
+	key := r.URL.Query().Get(param)
+	if key == "" {
+		return "", errorf("query parameter %s required", param)
+	}
+	if body != "" && body != key {
+		return "", errorf("%s %s of the request body does not match the query parameter %s", param, body, key)
+	}
+	return key, nil
+}
+
+// UpsertOrganization creates or replaces the organization named by the name
+// query parameter. A created organization gets the user making the request
+// as an admin, as with NewOrganization.
+func (s *Service) UpsertOrganization(w http.ResponseWriter, r *http.Request) {
+	var req organizationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	name, err := upsertKey(r, "name", req.Name)
+	if err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	req.Name = name
+	if err := req.ValidCreate(); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	org, err := s.Store.Organizations(ctx).Get(ctx, chronograf.OrganizationQuery{Name: &name})
+	if err == chronograf.ErrOrganizationNotFound {
+		org = &chronograf.Organization{
+			Name:        req.Name,
+			DefaultRole: req.DefaultRole,
+			Quotas:      req.Quotas,
+		}
+		if org, err = s.Store.Organizations(ctx).Add(ctx, org); err != nil {
+			Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+			return
+		}
+		if err := s.addOrganizationCreator(ctx, org); err != nil {
+			Error(w, http.StatusInternalServerError, err.Error(), s.Logger)
+			return
+		}
+
+		res := newOrganizationResponse(org)
+		location(w, res.Links.Self)
+		encodeJSON(w, http.StatusCreated, res, s.Logger)
+		return
+	} else if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	org.DefaultRole = req.DefaultRole
+	org.Quotas = req.Quotas
+	if err := s.Store.Organizations(ctx).Update(ctx, org); err != nil {
+		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+		return
+	}
+
+	res := newOrganizationResponse(org)
+	location(w, res.Links.Self)
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// UpsertSource creates or replaces the source of the organization with the
+// URL of the url query parameter. Replaced sources keep their ID, and their
+// password, shared secret and TLS key when the request has none. As the
+// secrets of sources are not returned, they do not contribute to their ETag.
+func (s *Service) UpsertSource(w http.ResponseWriter, r *http.Request) {
+	var req chronograf.Source
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	url, err := upsertKey(r, "url", req.URL)
+	if err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	req.URL = url
+	if req.Name == "" {
+		invalidData(w, fmt.Errorf("name required"), s.Logger)
+		return
+	}
+	if _, err := influx.TLSConfig(&req); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	req.Organization, _ = hasOrganizationContext(ctx)
+	srcs, err := s.Store.Sources(ctx).All(ctx)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	var matches []chronograf.Source
+	for _, src := range srcs {
+		if src.URL == url && src.Organization == req.Organization {
+			matches = append(matches, src)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		req.ID = 0
+		if req, err = s.Store.Sources(ctx).Add(ctx, req); err != nil {
+			unknownErrorWithMessage(w, err, s.Logger)
+			return
+		}
+		s.publishEvent(ctx, EventSourceCreated, newSourceEventData(req))
+		s.setSourceETag(ctx, w, req.ID)
+		encodeJSON(w, http.StatusCreated, newSourceEventData(req), s.Logger)
+	case 1:
+		// The source is read again once locked, as it may have changed since
+		// it was found
+		defer lockResource("sources", matches[0].ID)()
+		cur, err := s.Store.Sources(ctx).Get(ctx, matches[0].ID)
+		if err != nil {
+			unknownErrorWithMessage(w, err, s.Logger)
+			return
+		}
+		if !checkIfMatch(w, r, newSourceEventData(cur), s.Logger) {
+			return
+		}
+		req.ID = cur.ID
+		req.Discovered = cur.Discovered
+		if req.Password == "" {
+			req.Password = cur.Password
+		}
+		if req.SharedSecret == "" {
+			req.SharedSecret = cur.SharedSecret
+		}
+		if req.TLSKey == "" {
+			req.TLSKey = cur.TLSKey
+		}
+		if err := s.Store.Sources(ctx).Update(ctx, req); err != nil {
+			unknownErrorWithMessage(w, err, s.Logger)
+			return
+		}
+		s.publishEvent(ctx, EventSourceUpdated, newSourceEventData(req))
+		s.setSourceETag(ctx, w, req.ID)
+		encodeJSON(w, http.StatusOK, newSourceEventData(req), s.Logger)
+	default:
+		Error(w, http.StatusConflict, fmt.Sprintf("%d sources have the url %s", len(matches), url), s.Logger)
+	}
+}
+
+// UpsertDashboard creates or replaces the dashboard of the organization with
+// the slug of the slug query parameter. Replaced dashboards keep their ID,
+// and their folder and cache TTL when the request has none.
+func (s *Service) UpsertDashboard(w http.ResponseWriter, r *http.Request) {
+	var req chronograf.Dashboard
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	slug, err := upsertKey(r, "slug", req.Slug)
+	if err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	req.Slug = slug
+
+	ctx := r.Context()
+	defaultOrg, err := s.Store.Organizations(ctx).DefaultOrganization(ctx)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	if err := ValidDashboardRequest(&req, defaultOrg.ID); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	orig, err := s.dashboardBySlug(ctx, req)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	if orig == nil {
+		if err := s.validDashboardFolder(ctx, req.Folder); err != nil {
+			invalidData(w, err, s.Logger)
+			return
+		}
+		if err := s.ensureQuota(ctx, resourceOrganization(ctx, req.Organization), quotaDashboards, 1); err != nil {
+			quotaExceeded(w, err, s.Logger)
+			return
+		}
+		req.ID = 0
+		if req, err = s.Store.Dashboards(ctx).Add(ctx, req); err != nil {
+			unknownErrorWithMessage(w, fmt.Errorf("error storing dashboard %s: %v", slug, err), s.Logger)
+			return
+		}
+		s.recordDashboardVersion(ctx, req.ID)
+		s.publishDashboardEvent(ctx, EventDashboardCreated, req)
+
+		res := newDashboardResponse(req)
+		location(w, res.Links.Self)
+		encodeJSON(w, http.StatusCreated, res, s.Logger)
+		return
+	}
+	// The dashboard is read again once locked, as it may have changed since
+	// it was found
+	defer lockResource("dashboards", orig.ID)()
+	cur, err := s.Store.Dashboards(ctx).Get(ctx, orig.ID)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	orig = &cur
+	if !checkIfMatch(w, r, *orig, s.Logger) {
+		return
+	}
+
+	req.ID = orig.ID
+	if req.Folder == "" {
+		req.Folder = orig.Folder
+	} else if err := s.validDashboardFolder(ctx, req.Folder); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	if req.CacheTTL == "" {
+		req.CacheTTL = orig.CacheTTL
+	}
+	if err := s.Store.Dashboards(ctx).Update(ctx, req); err != nil {
+		unknownErrorWithMessage(w, fmt.Errorf("error updating dashboard %s: %v", slug, err), s.Logger)
+		return
+	}
+	s.recordDashboardVersion(ctx, req.ID)
+	s.publishDashboardEvent(ctx, EventDashboardUpdated, req)
+
+	res := newDashboardResponse(req)
+	s.setDashboardETag(ctx, w, req.ID)
+	location(w, res.Links.Self)
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// UpsertUser creates or replaces the roles and SuperAdmin status of the user
+// named by the name and provider query parameters.
+func (s *Service) UpsertUser(w http.ResponseWriter, r *http.Request) {
+	var req userRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	name, err := upsertKey(r, "name", req.Name)
+	if err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	provider, err := upsertKey(r, "provider", req.Provider)
+	if err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	req.Name, req.Provider = name, provider
+	req.Scheme = schemeOf(oauth2.Principal{Issuer: provider})
+	if err := req.ValidCreate(); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	serverCtx := serverContext(ctx)
+	if err := s.validRoles(serverCtx, req.Roles); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	u, err := s.Store.Users(ctx).Get(ctx, chronograf.UserQuery{
+		Name:     &req.Name,
+		Provider: &req.Provider,
+		Scheme:   &req.Scheme,
+	})
+	if err == chronograf.ErrUserNotFound {
+		cfg, err := s.Store.Config(serverCtx).Get(serverCtx)
+		if err != nil {
+			Error(w, http.StatusInternalServerError, err.Error(), s.Logger)
+			return
+		}
+		for _, role := range req.Roles {
+			if err := s.ensureQuota(ctx, role.Organization, quotaUsers, 1); err != nil {
+				quotaExceeded(w, err, s.Logger)
+				return
+			}
+		}
+
+		u = &chronograf.User{
+			Name:     req.Name,
+			Provider: req.Provider,
+			Scheme:   req.Scheme,
+			Roles:    req.Roles,
+		}
+		if cfg.Auth.SuperAdminNewUsers {
+			req.SuperAdmin = true
+		}
+		if err := setSuperAdmin(ctx, req, u); err != nil {
+			Error(w, http.StatusUnauthorized, err.Error(), s.Logger)
+			return
+		}
+		if u, err = s.Store.Users(ctx).Add(ctx, u); err != nil {
+			Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+			return
+		}
+		s.publishUserEvent(ctx, EventUserCreated, u.ID)
+
+		res := newUserResponse(u, "")
+		location(w, res.Links.Self)
+		encodeJSON(w, http.StatusCreated, res, s.Logger)
+		return
+	} else if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	// The user is read again once locked, as it may have changed since it
+	// was found
+	defer lockResource("users", u.ID)()
+	if u, err = s.Store.Users(ctx).Get(ctx, chronograf.UserQuery{ID: &u.ID}); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	if !checkIfMatch(w, r, u, s.Logger) {
+		return
+	}
+
+	// As with UpdateUser, SuperAdmins may not revoke their own SuperAdmin
+	// status, nor that of the last SuperAdmin
+	ctxUser, ok := hasUserContext(ctx)
+	if !ok {
+		Error(w, http.StatusInternalServerError, "failed to retrieve user from context", s.Logger)
+		return
+	}
+	if ctxUser.ID == u.ID && u.SuperAdmin && !req.SuperAdmin {
+		Error(w, http.StatusUnauthorized, "user cannot modify their own SuperAdmin status", s.Logger)
+		return
+	}
+	if u.SuperAdmin && !req.SuperAdmin {
+		if last, err := s.isLastSuperAdmin(ctx, u); err != nil {
+			unknownErrorWithMessage(w, err, s.Logger)
+			return
+		} else if last {
+			Error(w, http.StatusConflict, "cannot revoke the SuperAdmin status of the last SuperAdmin", s.Logger)
+			return
+		}
+	}
+
+	joined := map[string]bool{}
+	for _, role := range u.Roles {
+		joined[role.Organization] = true
+	}
+	for _, role := range req.Roles {
+		if joined[role.Organization] {
+			continue
+		}
+		if err := s.ensureQuota(ctx, role.Organization, quotaUsers, 1); err != nil {
+			quotaExceeded(w, err, s.Logger)
+			return
+		}
+	}
+
+	u.Roles = req.Roles
+	if err := setSuperAdmin(ctx, req, u); err != nil {
+		Error(w, http.StatusUnauthorized, err.Error(), s.Logger)
+		return
+	}
+	if err := s.Store.Users(ctx).Update(ctx, u); err != nil {
+		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+		return
+	}
+	s.publishUserEvent(ctx, EventUserUpdated, u.ID)
+
+	res := newUserResponse(u, "")
+	s.setUserETag(ctx, w, u.ID)
+	location(w, res.Links.Self)
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
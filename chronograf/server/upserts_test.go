@@ -0,0 +1,394 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+	"github.com/influxdata/influxdb/chronograf/organizations"
+)
+
+func TestService_UpsertDashboard(t *testing.T) {
+	existing := chronograf.Dashboard{
+		ID:           5,
+		Name:         "Hosts",
+		Organization: "default",
+		CacheTTL:     "30s",
+		Slug:         "hosts",
+	}
+	tests := []struct {
+		name       string
+		query      string
+		body       string
+		wantStatus int
+		wantID     chronograf.DashboardID
+		wantTTL    string
+	}{
+		{
+			name:       "Create the dashboard of a new slug",
+			query:      "?slug=cpu",
+			body:       `{"name":"CPU"}`,
+			wantStatus: http.StatusCreated,
+			wantID:     6,
+		},
+		{
+			name:       "Replace the dashboard of a slug",
+			query:      "?slug=hosts",
+			body:       `{"name":"All hosts"}`,
+			wantStatus: http.StatusOK,
+			wantID:     5,
+			wantTTL:    "30s",
+		},
+		{
+			name:       "Slug required",
+			body:       `{"name":"CPU"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Slug of the body differs",
+			query:      "?slug=hosts",
+			body:       `{"name":"CPU","slug":"cpu"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Invalid slug",
+			query:      "?slug=Hosts%20Overview",
+			body:       `{"name":"CPU"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updated chronograf.Dashboard
+			s := &Service{
+				Store: &mocks.Store{
+					DashboardsStore: &mocks.DashboardsStore{
+						AllF: func(ctx context.Context) ([]chronograf.Dashboard, error) {
+							return []chronograf.Dashboard{existing}, nil
+						},
+						GetF: func(ctx context.Context, id chronograf.DashboardID) (chronograf.Dashboard, error) {
+							if updated.ID == id {
+								return updated, nil
+							}
+							return existing, nil
+						},
+						AddF: func(ctx context.Context, d chronograf.Dashboard) (chronograf.Dashboard, error) {
+							d.ID = 6
+							return d, nil
+						},
+						UpdateF: func(ctx context.Context, d chronograf.Dashboard) error {
+							updated = d
+							return nil
+						},
+					},
+					OrganizationsStore: &mocks.OrganizationsStore{
+						DefaultOrganizationF: func(ctx context.Context) (*chronograf.Organization, error) {
+							return &chronograf.Organization{ID: "default"}, nil
+						},
+						GetF: func(ctx context.Context, q chronograf.OrganizationQuery) (*chronograf.Organization, error) {
+							return &chronograf.Organization{ID: *q.ID}, nil
+						},
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("PUT", "http://any.url/chronograf/v1/dashboards"+tt.query, bytes.NewBufferString(tt.body))
+
+			s.UpsertDashboard(w, r)
+
+			resp := w.Result()
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("UpsertDashboard() = %v, want %v", resp.StatusCode, tt.wantStatus)
+			}
+			if tt.wantID == 0 {
+				return
+			}
+			var res dashboardResponse
+			if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+				t.Fatal(err)
+			}
+			if res.ID != tt.wantID || res.CacheTTL != tt.wantTTL {
+				t.Errorf("UpsertDashboard() = dashboard %d with cache TTL %q, want %d with %q", res.ID, res.CacheTTL, tt.wantID, tt.wantTTL)
+			}
+		})
+	}
+}
+
+func TestService_UpsertSource(t *testing.T) {
+	tests := []struct {
+		name         string
+		query        string
+		body         string
+		sources      []chronograf.Source
+		wantStatus   int
+		wantAdded    bool
+		wantPassword string
+	}{
+		{
+			name:       "Create the source of a new URL",
+			query:      "?url=http%3A%2F%2Finflux%3A8086",
+			body:       `{"name":"Influx","password":"secret"}`,
+			wantStatus: http.StatusCreated,
+			wantAdded:  true,
+		},
+		{
+			name:  "Replace the source of a URL keeping its password",
+			query: "?url=http%3A%2F%2Finflux%3A8086",
+			body:  `{"name":"Influx 2","url":"http://influx:8086"}`,
+			sources: []chronograf.Source{
+				{ID: 1, Name: "Influx", URL: "http://influx:8086", Password: "secret", Organization: "1"},
+				{ID: 2, Name: "Influx", URL: "http://influx:8086", Organization: "2"},
+			},
+			wantStatus:   http.StatusOK,
+			wantPassword: "secret",
+		},
+		{
+			name:  "Several sources of a URL",
+			query: "?url=http%3A%2F%2Finflux%3A8086",
+			body:  `{"name":"Influx"}`,
+			sources: []chronograf.Source{
+				{ID: 1, Name: "Influx", URL: "http://influx:8086", Organization: "1"},
+				{ID: 2, Name: "Influx copy", URL: "http://influx:8086", Organization: "1"},
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "URL of the body differs",
+			query:      "?url=http%3A%2F%2Finflux%3A8086",
+			body:       `{"name":"Influx","url":"http://other:8086"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Name required",
+			query:      "?url=http%3A%2F%2Finflux%3A8086",
+			body:       `{}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var added bool
+			var updated chronograf.Source
+			s := &Service{
+				Store: &mocks.Store{
+					SourcesStore: &mocks.SourcesStore{
+						AllF: func(ctx context.Context) ([]chronograf.Source, error) {
+							return tt.sources, nil
+						},
+						GetF: func(ctx context.Context, id int) (chronograf.Source, error) {
+							for _, src := range tt.sources {
+								if src.ID == id {
+									return src, nil
+								}
+							}
+							return chronograf.Source{}, chronograf.ErrSourceNotFound
+						},
+						AddF: func(ctx context.Context, src chronograf.Source) (chronograf.Source, error) {
+							added = true
+							src.ID = 3
+							return src, nil
+						},
+						UpdateF: func(ctx context.Context, src chronograf.Source) error {
+							updated = src
+							return nil
+						},
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("PUT", "http://any.url/chronograf/v1/sources"+tt.query, bytes.NewBufferString(tt.body))
+			r = r.WithContext(context.WithValue(r.Context(), organizations.ContextKey, "1"))
+
+			s.UpsertSource(w, r)
+
+			if got := w.Result().StatusCode; got != tt.wantStatus {
+				t.Fatalf("UpsertSource() = %v, want %v", got, tt.wantStatus)
+			}
+			if added != tt.wantAdded {
+				t.Errorf("UpsertSource() added a source = %v, want %v", added, tt.wantAdded)
+			}
+			if tt.wantStatus == http.StatusOK && (updated.ID != 1 || updated.Password != tt.wantPassword) {
+				t.Errorf("UpsertSource() updated source %d with password %q, want 1 with %q", updated.ID, updated.Password, tt.wantPassword)
+			}
+		})
+	}
+}
+
+func TestService_UpsertOrganization(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		body       string
+		wantStatus int
+		wantRole   string
+	}{
+		{
+			name:       "Create the organization of a new name",
+			query:      "?name=Ops",
+			body:       `{"defaultRole":"viewer"}`,
+			wantStatus: http.StatusCreated,
+			wantRole:   "viewer",
+		},
+		{
+			name:       "Replace the organization of a name",
+			query:      "?name=Dev",
+			body:       `{"name":"Dev","defaultRole":"editor"}`,
+			wantStatus: http.StatusOK,
+			wantRole:   "editor",
+		},
+		{
+			name:       "Name required",
+			body:       `{"defaultRole":"editor"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{
+				Store: &mocks.Store{
+					OrganizationsStore: &mocks.OrganizationsStore{
+						GetF: func(ctx context.Context, q chronograf.OrganizationQuery) (*chronograf.Organization, error) {
+							if *q.Name != "Dev" {
+								return nil, chronograf.ErrOrganizationNotFound
+							}
+							return &chronograf.Organization{ID: "2", Name: "Dev", DefaultRole: "member"}, nil
+						},
+						AddF: func(ctx context.Context, o *chronograf.Organization) (*chronograf.Organization, error) {
+							o.ID = "3"
+							return o, nil
+						},
+						UpdateF: func(ctx context.Context, o *chronograf.Organization) error {
+							return nil
+						},
+					},
+					UsersStore: &mocks.UsersStore{
+						AddF: func(ctx context.Context, u *chronograf.User) (*chronograf.User, error) {
+							return u, nil
+						},
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("PUT", "http://any.url/chronograf/v1/organizations"+tt.query, bytes.NewBufferString(tt.body))
+			r = r.WithContext(context.WithValue(r.Context(), UserContextKey, &chronograf.User{ID: 1, Name: "bob"}))
+
+			s.UpsertOrganization(w, r)
+
+			resp := w.Result()
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("UpsertOrganization() = %v, want %v", resp.StatusCode, tt.wantStatus)
+			}
+			if tt.wantRole == "" {
+				return
+			}
+			var res organizationResponse
+			if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+				t.Fatal(err)
+			}
+			if res.DefaultRole != tt.wantRole {
+				t.Errorf("UpsertOrganization() default role = %q, want %q", res.DefaultRole, tt.wantRole)
+			}
+		})
+	}
+}
+
+func TestService_UpsertUser(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		body       string
+		wantStatus int
+		wantID     uint64
+		wantRoles  int
+	}{
+		{
+			name:       "Create the user of a new name",
+			query:      "?name=alice&provider=github",
+			body:       `{"roles":[{"name":"viewer","organization":"1"}]}`,
+			wantStatus: http.StatusCreated,
+			wantID:     2,
+			wantRoles:  1,
+		},
+		{
+			name:       "Replace the roles of a user",
+			query:      "?name=bob&provider=github",
+			body:       `{"name":"bob","roles":[{"name":"viewer","organization":"1"},{"name":"editor","organization":"2"}]}`,
+			wantStatus: http.StatusOK,
+			wantID:     1,
+			wantRoles:  2,
+		},
+		{
+			name:       "Provider required",
+			query:      "?name=bob",
+			body:       `{"roles":[]}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bob := &chronograf.User{
+				ID:       1,
+				Name:     "bob",
+				Provider: "github",
+				Scheme:   "oauth2",
+				Roles:    []chronograf.Role{{Name: "admin", Organization: "1"}},
+			}
+			s := &Service{
+				Store: &mocks.Store{
+					UsersStore: &mocks.UsersStore{
+						GetF: func(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+							if (q.Name != nil && *q.Name != "bob") || (q.ID != nil && *q.ID != 1) {
+								return nil, chronograf.ErrUserNotFound
+							}
+							return bob, nil
+						},
+						AddF: func(ctx context.Context, u *chronograf.User) (*chronograf.User, error) {
+							u.ID = 2
+							return u, nil
+						},
+						UpdateF: func(ctx context.Context, u *chronograf.User) error {
+							return nil
+						},
+					},
+					OrganizationsStore: &mocks.OrganizationsStore{
+						GetF: func(ctx context.Context, q chronograf.OrganizationQuery) (*chronograf.Organization, error) {
+							return &chronograf.Organization{ID: *q.ID, DefaultRole: "member"}, nil
+						},
+					},
+					ConfigStore: &mocks.ConfigStore{
+						Config: &chronograf.Config{},
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("PUT", "http://any.url/chronograf/v1/users"+tt.query, bytes.NewBufferString(tt.body))
+			r = r.WithContext(context.WithValue(r.Context(), UserContextKey, &chronograf.User{ID: 7, Name: "root", SuperAdmin: true}))
+
+			s.UpsertUser(w, r)
+
+			resp := w.Result()
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("UpsertUser() = %v, want %v", resp.StatusCode, tt.wantStatus)
+			}
+			if tt.wantID == 0 {
+				return
+			}
+			var res userResponse
+			if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+				t.Fatal(err)
+			}
+			if res.ID != tt.wantID || len(res.Roles) != tt.wantRoles {
+				t.Errorf("UpsertUser() = user %d with %d roles, want %d with %d", res.ID, len(res.Roles), tt.wantID, tt.wantRoles)
+			}
+		})
+	}
+}
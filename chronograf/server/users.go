@@ -5,11 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"sort"
 	"strconv"
 
 	"github.com/bouk/httprouter"
 	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/oauth2"
 	"github.com/influxdata/influxdb/chronograf/roles"
 )
 
@@ -24,25 +26,24 @@ type userRequest struct {
 
 func (r *userRequest) ValidCreate() error {
 	if r.Name == "" {
-		return fmt.Errorf("name required on Chronograf User request body")
+		return errorf("name required on Chronograf User request body")
 	}
 	if r.Provider == "" {
-		return fmt.Errorf("provider required on Chronograf User request body")
+		return errorf("provider required on Chronograf User request body")
 	}
 	if r.Scheme == "" {
-		return fmt.Errorf("scheme required on Chronograf User request body")
+		return errorf("scheme required on Chronograf User request body")
 	}
 
-	// TODO: This Scheme value is hard-coded temporarily since we only currently
-	// support OAuth2. This hard-coding should be removed whenever we add
-	// support for other authentication schemes.
-	r.Scheme = "oauth2"
+	// Each provider authenticates users of a single scheme, so that the same
+	// name at two providers names two different users
+	r.Scheme = schemeOf(oauth2.Principal{Issuer: r.Provider})
 	return r.ValidRoles()
 }
 
 func (r *userRequest) ValidUpdate() error {
 	if r.Roles == nil {
-		return fmt.Errorf("no Roles to update")
+		return errorf("no Roles to update")
 	}
 	return r.ValidRoles()
 }
@@ -52,17 +53,17 @@ func (r *userRequest) ValidRoles() error {
 		orgs := map[string]bool{}
 		for _, r := range r.Roles {
 			if r.Organization == "" {
-				return fmt.Errorf("no organization was provided")
+				return errorf("no organization was provided")
 			}
 			if _, ok := orgs[r.Organization]; ok {
-				return fmt.Errorf("duplicate organization %q in roles", r.Organization)
+				return errorf("duplicate organization %q in roles", r.Organization)
 			}
 			orgs[r.Organization] = true
 			switch r.Name {
 			case roles.MemberRoleName, roles.ViewerRoleName, roles.EditorRoleName, roles.AdminRoleName, roles.WildcardRoleName:
 				continue
 			default:
-				return fmt.Errorf("unknown role %s. Valid roles are 'member', 'viewer', 'editor', 'admin', and '*'", r.Name)
+				return errorf("unknown role %s. Valid roles are 'member', 'viewer', 'editor', 'admin', and '*'", r.Name)
 			}
 		}
 	}
@@ -77,6 +78,7 @@ type userResponse struct {
 	Scheme     string            `json:"scheme"`
 	SuperAdmin bool              `json:"superAdmin"`
 	Roles      []chronograf.Role `json:"roles"`
+	Status     string            `json:"status"`
 }
 
 func newUserResponse(u *chronograf.User, org string) *userResponse {
@@ -92,6 +94,10 @@ func newUserResponse(u *chronograf.User, org string) *userResponse {
 	} else {
 		selfLink = fmt.Sprintf("/chronograf/v1/users/%d", u.ID)
 	}
+	status := chronograf.UserStatusActive
+	if u.Suspended() {
+		status = chronograf.UserStatusSuspended
+	}
 	return &userResponse{
 		ID:         u.ID,
 		Name:       u.Name,
@@ -99,14 +105,23 @@ func newUserResponse(u *chronograf.User, org string) *userResponse {
 		Scheme:     u.Scheme,
 		Roles:      u.Roles,
 		SuperAdmin: u.SuperAdmin,
+		Status:     status,
 		Links: selfLinks{
 			Self: selfLink,
 		},
 	}
 }
 
+type usersLinks struct {
+	Self  string `json:"self"`            // Self link mapping to this resource
+	First string `json:"first,omitempty"` // First is the first page of users
+	Next  string `json:"next,omitempty"`  // Next is the following page of users, if any
+	Prev  string `json:"prev,omitempty"`  // Prev is the preceding page of users, if any
+}
+
 type usersResponse struct {
-	Links selfLinks       `json:"links"`
+	Links usersLinks      `json:"links"`
+	Total int             `json:"total"` // Total is the number of users across all pages
 	Users []*userResponse `json:"users"`
 }
 
@@ -127,12 +142,94 @@ func newUsersResponse(users []chronograf.User, org string) *usersResponse {
 	}
 	return &usersResponse{
 		Users: usersResp,
-		Links: selfLinks{
+		Total: len(usersResp),
+		Links: usersLinks{
 			Self: selfLink,
 		},
 	}
 }
 
+// paginate restricts the response to limit users starting at offset and
+// links to the neighbouring pages. The remaining parameters of query are
+// kept in the links. A limit of zero returns every user.
+func (r *usersResponse) paginate(query url.Values, limit, offset int) {
+	if limit == 0 && offset == 0 {
+		return
+	}
+
+	base := r.Links.Self
+	page := func(offset int) string {
+		q := url.Values{}
+		for k, v := range query {
+			q[k] = v
+		}
+		if limit > 0 {
+			q.Set(limitQuery, strconv.Itoa(limit))
+		}
+		q.Set(offsetQuery, strconv.Itoa(offset))
+		return base + "?" + q.Encode()
+	}
+
+	r.Links.Self = page(offset)
+	r.Links.First = page(0)
+
+	if offset > len(r.Users) {
+		offset = len(r.Users)
+	}
+	end := len(r.Users)
+	if limit > 0 && offset+limit < end {
+		end = offset + limit
+		r.Links.Next = page(end)
+	}
+	if offset > 0 {
+		prev := offset - limit
+		if limit == 0 || prev < 0 {
+			prev = 0
+		}
+		r.Links.Prev = page(prev)
+	}
+	r.Users = r.Users[offset:end]
+}
+
+// validUsersQuery parses the optional limit and offset parameters of a users
+// listing. Without a limit every user is returned.
+func validUsersQuery(query url.Values) (limit, offset int, err error) {
+	if v := query.Get(limitQuery); v != "" {
+		if limit, err = strconv.Atoi(v); err != nil || limit < 0 {
+			return 0, 0, errorf("invalid limit %s", v)
+		}
+	}
+	if v := query.Get(offsetQuery); v != "" {
+		if offset, err = strconv.Atoi(v); err != nil || offset < 0 {
+			return 0, 0, errorf("invalid offset %s", v)
+		}
+	}
+	return limit, offset, nil
+}
+
+// validUsersFilter parses the optional name, provider, scheme and role
+// parameters of a users listing. It returns false if no filter was given.
+func validUsersFilter(query url.Values) (chronograf.UserFilter, bool, error) {
+	f := chronograf.UserFilter{
+		Name:     query.Get("name"),
+		Provider: query.Get("provider"),
+		Scheme:   query.Get("scheme"),
+		Role:     query.Get("role"),
+		Status:   query.Get("status"),
+	}
+	switch f.Role {
+	case "", roles.MemberRoleName, roles.ViewerRoleName, roles.EditorRoleName, roles.AdminRoleName, roles.WildcardRoleName:
+	default:
+		return f, false, errorf("unknown role %s. Valid roles are 'member', 'viewer', 'editor', 'admin', and '*'", f.Role)
+	}
+	switch f.Status {
+	case "", chronograf.UserStatusActive, chronograf.UserStatusSuspended:
+	default:
+		return f, false, errorf("unknown status %s. Valid statuses are 'active' and 'suspended'", f.Status)
+	}
+	return f, f != chronograf.UserFilter{}, nil
+}
+
 // UserID retrieves a Chronograf user with ID from store
 func (s *Service) UserID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -151,6 +248,7 @@ func (s *Service) UserID(w http.ResponseWriter, r *http.Request) {
 
 	orgID := httprouter.GetParamFromContext(ctx, "oid")
 	res := newUserResponse(user, orgID)
+	setETag(w, user)
 	location(w, res.Links.Self)
 	encodeJSON(w, http.StatusOK, res, s.Logger)
 }
@@ -182,6 +280,13 @@ func (s *Service) NewUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	for _, role := range req.Roles {
+		if err := s.ensureQuota(ctx, role.Organization, quotaUsers, 1); err != nil {
+			quotaExceeded(w, err, s.Logger)
+			return
+		}
+	}
+
 	user := &chronograf.User{
 		Name:     req.Name,
 		Provider: req.Provider,
@@ -203,6 +308,7 @@ func (s *Service) NewUser(w http.ResponseWriter, r *http.Request) {
 		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
 		return
 	}
+	s.publishUserEvent(ctx, EventUserCreated, res.ID)
 
 	orgID := httprouter.GetParamFromContext(ctx, "oid")
 	cu := newUserResponse(res, orgID)
@@ -220,15 +326,35 @@ func (s *Service) RemoveUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	defer lockResource("users", id)()
 	u, err := s.Store.Users(ctx).Get(ctx, chronograf.UserQuery{ID: &id})
 	if err != nil {
 		Error(w, http.StatusNotFound, err.Error(), s.Logger)
 		return
 	}
+	if !checkIfMatch(w, r, u, s.Logger) {
+		return
+	}
+	// Within an organization only the roles of the user in the organization
+	// are removed; with raw store access the user is deleted.
+	if hasServerContext(ctx) {
+		if last, err := s.isLastSuperAdmin(ctx, u); err != nil {
+			unknownErrorWithMessage(w, err, s.Logger)
+			return
+		} else if last {
+			Error(w, http.StatusConflict, "cannot delete the last SuperAdmin", s.Logger)
+			return
+		}
+	}
 	if err := s.Store.Users(ctx).Delete(ctx, u); err != nil {
 		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
 		return
 	}
+	if hasServerContext(ctx) {
+		s.publishEvent(ctx, EventUserDeleted, newUserResponse(u, ""))
+	} else {
+		s.publishUserEvent(ctx, EventUserUpdated, u.ID)
+	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
@@ -254,11 +380,15 @@ func (s *Service) UpdateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	defer lockResource("users", id)()
 	u, err := s.Store.Users(ctx).Get(ctx, chronograf.UserQuery{ID: &id})
 	if err != nil {
 		Error(w, http.StatusNotFound, err.Error(), s.Logger)
 		return
 	}
+	if !checkIfMatch(w, r, u, s.Logger) {
+		return
+	}
 
 	serverCtx := serverContext(ctx)
 	if err := s.validRoles(serverCtx, req.Roles); err != nil {
@@ -276,17 +406,17 @@ func (s *Service) UpdateUser(w http.ResponseWriter, r *http.Request) {
 	// But currently, it is not possible to change name, provider, or
 	// scheme via the API.
 	if req.Name != "" && req.Name != u.Name {
-		err := fmt.Errorf("cannot update Name")
+		err := errorf("cannot update Name")
 		invalidData(w, err, s.Logger)
 		return
 	}
 	if req.Provider != "" && req.Provider != u.Provider {
-		err := fmt.Errorf("cannot update Provider")
+		err := errorf("cannot update Provider")
 		invalidData(w, err, s.Logger)
 		return
 	}
 	if req.Scheme != "" && req.Scheme != u.Scheme {
-		err := fmt.Errorf("cannot update Scheme")
+		err := errorf("cannot update Scheme")
 		invalidData(w, err, s.Logger)
 		return
 	}
@@ -306,6 +436,18 @@ func (s *Service) UpdateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Only a SuperAdmin may revoke SuperAdmin status, which setSuperAdmin
+	// enforces, and never the status of the last SuperAdmin
+	if u.SuperAdmin && !req.SuperAdmin && hasSuperAdminContext(ctx) {
+		if last, err := s.isLastSuperAdmin(ctx, u); err != nil {
+			unknownErrorWithMessage(w, err, s.Logger)
+			return
+		} else if last {
+			Error(w, http.StatusConflict, "cannot revoke the SuperAdmin status of the last SuperAdmin", s.Logger)
+			return
+		}
+	}
+
 	if err := setSuperAdmin(ctx, req, u); err != nil {
 		Error(w, http.StatusUnauthorized, err.Error(), s.Logger)
 		return
@@ -316,18 +458,249 @@ func (s *Service) UpdateUser(w http.ResponseWriter, r *http.Request) {
 		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
 		return
 	}
+	s.publishUserEvent(ctx, EventUserUpdated, u.ID)
 
 	orgID := httprouter.GetParamFromContext(ctx, "oid")
 	cu := newUserResponse(u, orgID)
+	s.setUserETag(ctx, w, u.ID)
 	location(w, cu.Links.Self)
 	encodeJSON(w, http.StatusOK, cu, s.Logger)
 }
 
-// Users retrieves all Chronograf users from store
+type userStatusRequest struct {
+	Status string `json:"status"`
+}
+
+// UpdateUserStatus suspends or reactivates a user. Suspended users keep their
+// roles but are not authorized to access Chronograf until reactivated.
+func (s *Service) UpdateUserStatus(w http.ResponseWriter, r *http.Request) {
+	var req userStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	switch req.Status {
+	case chronograf.UserStatusActive, chronograf.UserStatusSuspended:
+	default:
+		invalidData(w, errorf("unknown status %s. Valid statuses are 'active' and 'suspended'", req.Status), s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	id, err := userIDParam(ctx)
+	if err != nil {
+		Error(w, http.StatusNotFound, err.Error(), s.Logger)
+		return
+	}
+	defer lockResource("users", id)()
+	u, err := s.Store.Users(ctx).Get(ctx, chronograf.UserQuery{ID: &id})
+	if err != nil {
+		Error(w, http.StatusNotFound, err.Error(), s.Logger)
+		return
+	}
+	if !checkIfMatch(w, r, u, s.Logger) {
+		return
+	}
+
+	// Suspending oneself could result in an application without any super admins
+	ctxUser, ok := hasUserContext(ctx)
+	if !ok {
+		Error(w, http.StatusInternalServerError, "failed to retrieve user from context", s.Logger)
+		return
+	}
+	if ctxUser.ID == u.ID && req.Status == chronograf.UserStatusSuspended {
+		Error(w, http.StatusUnauthorized, "user cannot suspend themselves", s.Logger)
+		return
+	}
+
+	if req.Status == chronograf.UserStatusSuspended {
+		if last, err := s.isLastSuperAdmin(ctx, u); err != nil {
+			unknownErrorWithMessage(w, err, s.Logger)
+			return
+		} else if last {
+			Error(w, http.StatusConflict, "cannot suspend the last SuperAdmin", s.Logger)
+			return
+		}
+	}
+
+	u.Status = req.Status
+	if err := s.Store.Users(ctx).Update(ctx, u); err != nil {
+		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+		return
+	}
+	s.publishUserEvent(ctx, EventUserUpdated, u.ID)
+
+	res := newUserResponse(u, "")
+	s.setUserETag(ctx, w, u.ID)
+	location(w, res.Links.Self)
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+type userRoleRequest struct {
+	Name string `json:"name"`
+}
+
+// SetUserRole adds or replaces the role of a user within a single organization
+// leaving the roles of the user in other organizations as they are.
+func (s *Service) SetUserRole(w http.ResponseWriter, r *http.Request) {
+	var req userRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	id, err := userIDParam(ctx)
+	if err != nil {
+		Error(w, http.StatusNotFound, err.Error(), s.Logger)
+		return
+	}
+	defer lockResource("users", id)()
+	u, err := s.Store.Users(ctx).Get(ctx, chronograf.UserQuery{ID: &id})
+	if err != nil {
+		Error(w, http.StatusNotFound, err.Error(), s.Logger)
+		return
+	}
+	if !checkIfMatch(w, r, u, s.Logger) {
+		return
+	}
+
+	role := chronograf.Role{
+		Name:         req.Name,
+		Organization: httprouter.GetParamFromContext(ctx, "oid"),
+	}
+	valid := userRequest{Roles: []chronograf.Role{role}}
+	if err := valid.ValidRoles(); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	if err := s.validRoles(serverContext(ctx), valid.Roles); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	role = valid.Roles[0]
+
+	status := http.StatusCreated
+	for i := range u.Roles {
+		if u.Roles[i].Organization == role.Organization {
+			u.Roles = append(u.Roles[:i], u.Roles[i+1:]...)
+			status = http.StatusOK
+			break
+		}
+	}
+	if status == http.StatusCreated {
+		if err := s.ensureQuota(ctx, role.Organization, quotaUsers, 1); err != nil {
+			quotaExceeded(w, err, s.Logger)
+			return
+		}
+	}
+	u.Roles = append(u.Roles, role)
+
+	if err := s.Store.Users(ctx).Update(ctx, u); err != nil {
+		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+		return
+	}
+	s.publishUserEvent(ctx, EventUserUpdated, u.ID)
+
+	res := newUserResponse(u, "")
+	s.setUserETag(ctx, w, u.ID)
+	location(w, res.Links.Self)
+	encodeJSON(w, status, res, s.Logger)
+}
+
+// RemoveUserRole removes the role of a user within a single organization
+func (s *Service) RemoveUserRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := userIDParam(ctx)
+	if err != nil {
+		Error(w, http.StatusNotFound, err.Error(), s.Logger)
+		return
+	}
+	defer lockResource("users", id)()
+	u, err := s.Store.Users(ctx).Get(ctx, chronograf.UserQuery{ID: &id})
+	if err != nil {
+		Error(w, http.StatusNotFound, err.Error(), s.Logger)
+		return
+	}
+	if !checkIfMatch(w, r, u, s.Logger) {
+		return
+	}
+
+	orgID := httprouter.GetParamFromContext(ctx, "oid")
+	rs := []chronograf.Role{}
+	for _, role := range u.Roles {
+		if role.Organization != orgID {
+			rs = append(rs, role)
+		}
+	}
+	if len(rs) == len(u.Roles) {
+		Error(w, http.StatusNotFound, fmt.Sprintf("user has no role in organization %s", orgID), s.Logger)
+		return
+	}
+	u.Roles = rs
+
+	if err := s.Store.Users(ctx).Update(ctx, u); err != nil {
+		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+		return
+	}
+	s.publishUserEvent(ctx, EventUserUpdated, u.ID)
+
+	res := newUserResponse(u, "")
+	s.setUserETag(ctx, w, u.ID)
+	location(w, res.Links.Self)
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// userRoleTarget retrieves the user whose roles or status are changed
+func (s *Service) userRoleTarget(ctx context.Context) (*chronograf.User, error) {
+	id, err := userIDParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.Store.Users(ctx).Get(ctx, chronograf.UserQuery{ID: &id})
+}
+
+// userIDParam returns the user ID of the id route parameter
+func userIDParam(ctx context.Context) (uint64, error) {
+	idStr := httprouter.GetParamFromContext(ctx, "id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid user id: %s", err.Error())
+	}
+	return id, nil
+}
+
+// Users retrieves all Chronograf users from store. The optional name,
+// provider, scheme and role query parameters filter the users, the
+// optional limit and offset query parameters return a single page of users,
+// and the optional fields query parameter selects the fields of the users.
 func (s *Service) Users(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	users, err := s.Store.Users(ctx).All(ctx)
+	query := r.URL.Query()
+	limit, offset, err := validUsersQuery(query)
+	if err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	filter, filtered, err := validUsersFilter(query)
+	if err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	fields, err := validFieldset(query, userResponse{})
+	if err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	var users []chronograf.User
+	if filtered {
+		users, err = s.Store.Users(ctx).Filter(ctx, filter)
+	} else {
+		users, err = s.Store.Users(ctx).All(ctx)
+	}
 	if err != nil {
 		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
 		return
@@ -335,7 +708,13 @@ func (s *Service) Users(w http.ResponseWriter, r *http.Request) {
 
 	orgID := httprouter.GetParamFromContext(ctx, "oid")
 	res := newUsersResponse(users, orgID)
-	encodeJSON(w, http.StatusOK, res, s.Logger)
+	res.paginate(query, limit, offset)
+	sparse, err := fields.list(res, "users")
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	encodeJSON(w, http.StatusOK, sparse, s.Logger)
 }
 
 func setSuperAdmin(ctx context.Context, req userRequest, user *chronograf.User) error {
@@ -362,6 +741,26 @@ func setSuperAdmin(ctx context.Context, req userRequest, user *chronograf.User)
 	return nil
 }
 
+// isLastSuperAdmin is true if u is the only active SuperAdmin. Deleting,
+// demoting, or suspending that user would leave nobody able to administer
+// Chronograf.
+func (s *Service) isLastSuperAdmin(ctx context.Context, u *chronograf.User) (bool, error) {
+	if !u.SuperAdmin || u.Suspended() {
+		return false, nil
+	}
+	serverCtx := serverContext(ctx)
+	users, err := s.Store.Users(serverCtx).All(serverCtx)
+	if err != nil {
+		return false, err
+	}
+	for _, other := range users {
+		if other.ID != u.ID && other.SuperAdmin && !other.Suspended() {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 func (s *Service) validRoles(ctx context.Context, rs []chronograf.Role) error {
 	for i, role := range rs {
 		// verify that the organization exists
@@ -0,0 +1,159 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/bouk/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Statuses of the users of a bulk request
+const (
+	bulkUserCreated = "created"
+	bulkUserFailed  = "failed"
+	bulkUserSkipped = "skipped"
+)
+
+type bulkUserResult struct {
+	Index  int           `json:"index"`
+	Status string        `json:"status"`
+	User   *userResponse `json:"user,omitempty"`
+	Error  string        `json:"error,omitempty"`
+}
+
+type bulkUsersResponse struct {
+	Results []bulkUserResult `json:"results"`
+}
+
+// NewUsers creates all users of the request body or none of them. Every user is
+// validated before any is created; if one of them is invalid the response lists
+// the reason for each failed user and the users skipped because of them.
+func (s *Service) NewUsers(w http.ResponseWriter, r *http.Request) {
+	var reqs []userRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqs); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+
+	if len(reqs) == 0 {
+		invalidData(w, errorf("no users provided"), s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+
+	serverCtx := serverContext(ctx)
+	cfg, err := s.Store.Config(serverCtx).Get(serverCtx)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, err.Error(), s.Logger)
+		return
+	}
+
+	store := s.Store.Users(ctx)
+	users := make([]*chronograf.User, len(reqs))
+	results := make([]bulkUserResult, len(reqs))
+	seen := map[string]int{}
+	failed := false
+	for i := range reqs {
+		results[i] = bulkUserResult{
+			Index:  i,
+			Status: bulkUserSkipped,
+		}
+		user, err := s.bulkUser(ctx, cfg, &reqs[i])
+		if err == nil {
+			key := fmt.Sprintf("%s:%s:%s", user.Provider, user.Scheme, user.Name)
+			if j, ok := seen[key]; ok {
+				err = errorf("user is a duplicate of user %d", j)
+			} else {
+				seen[key] = i
+				err = userNotExists(ctx, store, user)
+			}
+		}
+		if err != nil {
+			failed = true
+			results[i].Status = bulkUserFailed
+			results[i].Error = err.Error()
+			continue
+		}
+		users[i] = user
+	}
+
+	if failed {
+		encodeJSON(w, http.StatusUnprocessableEntity, bulkUsersResponse{Results: results}, s.Logger)
+		return
+	}
+
+	perOrg := map[string]int{}
+	for _, u := range users {
+		for _, role := range u.Roles {
+			perOrg[role.Organization]++
+		}
+	}
+	for orgID, n := range perOrg {
+		if err := s.ensureQuota(ctx, orgID, quotaUsers, n); err != nil {
+			quotaExceeded(w, err, s.Logger)
+			return
+		}
+	}
+
+	added, err := store.AddMany(ctx, users)
+	if err != nil {
+		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+		return
+	}
+
+	orgID := httprouter.GetParamFromContext(ctx, "oid")
+	for i, u := range added {
+		results[i].Status = bulkUserCreated
+		results[i].User = newUserResponse(u, orgID)
+		s.publishUserEvent(ctx, EventUserCreated, u.ID)
+	}
+	encodeJSON(w, http.StatusCreated, bulkUsersResponse{Results: results}, s.Logger)
+}
+
+// bulkUser validates req as NewUser does and returns the user to create
+func (s *Service) bulkUser(ctx context.Context, cfg *chronograf.Config, req *userRequest) (*chronograf.User, error) {
+	if err := req.ValidCreate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.validRoles(serverContext(ctx), req.Roles); err != nil {
+		return nil, err
+	}
+
+	user := &chronograf.User{
+		Name:     req.Name,
+		Provider: req.Provider,
+		Scheme:   req.Scheme,
+		Roles:    req.Roles,
+	}
+
+	if cfg.Auth.SuperAdminNewUsers {
+		req.SuperAdmin = true
+	}
+
+	if err := setSuperAdmin(ctx, *req, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// userNotExists returns chronograf.ErrUserAlreadyExists if user is in store
+func userNotExists(ctx context.Context, store chronograf.UsersStore, user *chronograf.User) error {
+	_, err := store.Get(ctx, chronograf.UserQuery{
+		Name:     &user.Name,
+		Provider: &user.Provider,
+		Scheme:   &user.Scheme,
+	})
+	switch err {
+	case nil:
+		return chronograf.ErrUserAlreadyExists
+	case chronograf.ErrUserNotFound:
+		return nil
+	default:
+		return err
+	}
+}
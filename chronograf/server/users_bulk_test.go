@@ -0,0 +1,112 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+	"github.com/influxdata/influxdb/chronograf/roles"
+)
+
+func TestService_NewUsers(t *testing.T) {
+	existing := &chronograf.User{
+		ID:       1,
+		Name:     "billietta",
+		Provider: "github",
+		Scheme:   "oauth2",
+	}
+	tests := []struct {
+		name       string
+		body       string
+		addMany    bool
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "Create users",
+			body:       `[{"name":"bob","provider":"github","scheme":"oauth2","roles":[{"name":"viewer","organization":"1"}]},{"name":"alice","provider":"google","scheme":"oauth2"}]`,
+			addMany:    true,
+			wantStatus: http.StatusCreated,
+			wantBody: `{"results":[
+				{"index":0,"status":"created","user":{"id":"100","superAdmin":false,"status":"active","name":"bob","provider":"github","scheme":"oauth2","roles":[{"name":"viewer","organization":"1"}],"links":{"self":"/chronograf/v1/users/100"}}},
+				{"index":1,"status":"created","user":{"id":"101","superAdmin":false,"status":"active","name":"alice","provider":"google","scheme":"oauth2","roles":[],"links":{"self":"/chronograf/v1/users/101"}}}
+			]}`,
+		},
+		{
+			name:       "Invalid users create nothing",
+			body:       `[{"name":"bob","provider":"github","scheme":"oauth2"},{"name":"billietta","provider":"github","scheme":"oauth2"},{"name":"bob","provider":"github","scheme":"oauth2"},{"provider":"github","scheme":"oauth2"},{"name":"carol","provider":"github","scheme":"oauth2","roles":[{"name":"chef","organization":"1"}]}]`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody: `{"results":[
+				{"index":0,"status":"skipped"},
+				{"index":1,"status":"failed","error":"user already exists"},
+				{"index":2,"status":"failed","error":"user is a duplicate of user 0"},
+				{"index":3,"status":"failed","error":"name required on Chronograf User request body"},
+				{"index":4,"status":"failed","error":"unknown role chef. Valid roles are 'member', 'viewer', 'editor', 'admin', and '*'"}
+			]}`,
+		},
+		{
+			name:       "No users",
+			body:       `[]`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody:   `{"code":422,"message":"no users provided","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"no users provided"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{
+				Store: &mocks.Store{
+					ConfigStore: &mocks.ConfigStore{
+						Config: &chronograf.Config{},
+					},
+					OrganizationsStore: &mocks.OrganizationsStore{
+						GetF: func(ctx context.Context, q chronograf.OrganizationQuery) (*chronograf.Organization, error) {
+							return &chronograf.Organization{
+								ID:          "1",
+								DefaultRole: roles.ViewerRoleName,
+							}, nil
+						},
+					},
+					UsersStore: &mocks.UsersStore{
+						GetF: func(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+							if *q.Name == existing.Name && *q.Provider == existing.Provider {
+								return existing, nil
+							}
+							return nil, chronograf.ErrUserNotFound
+						},
+						AddManyF: func(ctx context.Context, us []*chronograf.User) ([]*chronograf.User, error) {
+							if !tt.addMany {
+								t.Fatalf("AddMany() called for invalid users")
+							}
+							for i, u := range us {
+								u.ID = uint64(100 + i)
+								if u.Roles == nil {
+									u.Roles = []chronograf.Role{}
+								}
+							}
+							return us, nil
+						},
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url/chronograf/v1/users/bulk", bytes.NewBufferString(tt.body))
+
+			s.NewUsers(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("%q. NewUsers() = %v, want %v", tt.name, resp.StatusCode, tt.wantStatus)
+			}
+			if eq, _ := jsonEqual(string(body), tt.wantBody); !eq {
+				t.Errorf("%q. NewUsers() = \n***%v***\n,\nwant\n***%v***", tt.name, string(body), tt.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/bouk/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+type usersRolesRequest struct {
+	Users  []string          `json:"users"`  // Users are the IDs of the users whose roles change
+	Add    []chronograf.Role `json:"add"`    // Add are the roles added to, or replacing the roles of, each user in their organizations
+	Remove []string          `json:"remove"` // Remove are the IDs of the organizations in which each user loses their role
+}
+
+// Valid checks the roles of the request and returns the IDs of its users
+func (r *usersRolesRequest) Valid() ([]uint64, error) {
+	if len(r.Users) == 0 {
+		return nil, errorf("no users provided")
+	}
+	if len(r.Add) == 0 && len(r.Remove) == 0 {
+		return nil, errorf("no roles to add or remove")
+	}
+
+	added := userRequest{Roles: r.Add}
+	if err := added.ValidRoles(); err != nil {
+		return nil, err
+	}
+	orgs := map[string]bool{}
+	for _, role := range r.Add {
+		orgs[role.Organization] = true
+	}
+	for _, orgID := range r.Remove {
+		if orgs[orgID] {
+			return nil, errorf("organization %q is both added and removed", orgID)
+		}
+	}
+
+	ids := make([]uint64, len(r.Users))
+	seen := map[uint64]bool{}
+	for i, idStr := range r.Users {
+		id, err := strconv.ParseUint(idStr, 10, 64)
+		if err != nil {
+			return nil, errorf("invalid user id %s", idStr)
+		}
+		if seen[id] {
+			return nil, errorf("duplicate user %d", id)
+		}
+		seen[id] = true
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// UpdateUsersRoles adds and removes the roles of many users at once, either
+// for all of the users or for none of them, such as to grant the editor role
+// to every user on call for a week. The roles of the users in the other
+// organizations are left as they are.
+func (s *Service) UpdateUsersRoles(w http.ResponseWriter, r *http.Request) {
+	var req usersRolesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+
+	ids, err := req.Valid()
+	if err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	if err := s.validRoles(serverContext(ctx), req.Add); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	store := s.Store.Users(ctx)
+	users := make([]*chronograf.User, len(ids))
+	for i := range ids {
+		u, err := store.Get(ctx, chronograf.UserQuery{ID: &ids[i]})
+		if err != nil {
+			Error(w, http.StatusNotFound, fmt.Sprintf("user %d: %v", ids[i], err), s.Logger)
+			return
+		}
+		users[i] = u
+	}
+
+	removed := map[string]bool{}
+	for _, orgID := range req.Remove {
+		removed[orgID] = true
+	}
+	joined := map[string]int{}
+	for _, u := range users {
+		current := map[string]bool{}
+		rs := []chronograf.Role{}
+		for _, role := range u.Roles {
+			current[role.Organization] = true
+			if !removed[role.Organization] && !hasRoleIn(req.Add, role.Organization) {
+				rs = append(rs, role)
+			}
+		}
+		for _, role := range req.Add {
+			if !current[role.Organization] {
+				joined[role.Organization]++
+			}
+			rs = append(rs, role)
+		}
+		u.Roles = rs
+	}
+	for orgID, n := range joined {
+		if err := s.ensureQuota(ctx, orgID, quotaUsers, n); err != nil {
+			quotaExceeded(w, err, s.Logger)
+			return
+		}
+	}
+
+	if err := store.UpdateMany(ctx, users); err != nil {
+		Error(w, http.StatusBadRequest, err.Error(), s.Logger)
+		return
+	}
+
+	res := make([]chronograf.User, len(users))
+	for i, u := range users {
+		s.publishUserEvent(ctx, EventUserUpdated, u.ID)
+		res[i] = *u
+	}
+	encodeJSON(w, http.StatusOK, newUsersResponse(res, ""), s.Logger)
+}
+
+// hasRoleIn is true if rs has a role in the organization orgID
+func hasRoleIn(rs []chronograf.Role, orgID string) bool {
+	for _, role := range rs {
+		if role.Organization == orgID {
+			return true
+		}
+	}
+	return false
+}
+
+// updateUserOrUsersRoles serves PATCH /users/roles with roles, and every other
+// PATCH /users/:id with update. The router does not allow the static roles
+// segment beside the :id wildcard of the same method.
+func updateUserOrUsersRoles(update, roles http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if httprouter.GetParamFromContext(r.Context(), "id") == "roles" {
+			roles(w, r)
+			return
+		}
+		update(w, r)
+	}
+}
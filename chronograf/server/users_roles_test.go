@@ -0,0 +1,152 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+func TestService_UpdateUsersRoles(t *testing.T) {
+	users := func() map[uint64]*chronograf.User {
+		return map[uint64]*chronograf.User{
+			1: {
+				ID:       1,
+				Name:     "alice",
+				Provider: "github",
+				Scheme:   "oauth2",
+				Roles: []chronograf.Role{
+					{Name: "viewer", Organization: "1"},
+					{Name: "viewer", Organization: "2"},
+				},
+			},
+			2: {
+				ID:       2,
+				Name:     "bob",
+				Provider: "github",
+				Scheme:   "oauth2",
+				Roles: []chronograf.Role{
+					{Name: "admin", Organization: "3"},
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name       string
+		body       string
+		updateErr  error
+		wantStatus int
+		wantBody   string
+		wantUpdate bool
+	}{
+		{
+			name:       "Grants a role to every user and removes another",
+			body:       `{"users":["1","2"],"add":[{"name":"editor","organization":"1"}],"remove":["2"]}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"links":{"self":"/chronograf/v1/users"},"total":2,"users":[{"links":{"self":"/chronograf/v1/users/1"},"id":"1","name":"alice","provider":"github","scheme":"oauth2","superAdmin":false,"roles":[{"name":"editor","organization":"1"}],"status":"active"},{"links":{"self":"/chronograf/v1/users/2"},"id":"2","name":"bob","provider":"github","scheme":"oauth2","superAdmin":false,"roles":[{"name":"admin","organization":"3"},{"name":"editor","organization":"1"}],"status":"active"}]}`,
+			wantUpdate: true,
+		},
+		{
+			name:       "Wildcard role is the default role of the organization",
+			body:       `{"users":["2"],"add":[{"name":"*","organization":"1"}]}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"links":{"self":"/chronograf/v1/users"},"total":1,"users":[{"links":{"self":"/chronograf/v1/users/2"},"id":"2","name":"bob","provider":"github","scheme":"oauth2","superAdmin":false,"roles":[{"name":"admin","organization":"3"},{"name":"viewer","organization":"1"}],"status":"active"}]}`,
+			wantUpdate: true,
+		},
+		{
+			name:       "Unknown user updates none of the users",
+			body:       `{"users":["1","3"],"add":[{"name":"editor","organization":"1"}]}`,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "Failed update of the store",
+			body:       `{"users":["1","2"],"remove":["1"]}`,
+			updateErr:  chronograf.ErrUserNotFound,
+			wantStatus: http.StatusBadRequest,
+			wantUpdate: true,
+		},
+		{
+			name:       "Organization both added and removed",
+			body:       `{"users":["1"],"add":[{"name":"editor","organization":"1"}],"remove":["1"]}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Unknown role",
+			body:       `{"users":["1"],"add":[{"name":"owner","organization":"1"}]}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Duplicate user",
+			body:       `{"users":["1","1"],"remove":["1"]}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "No roles",
+			body:       `{"users":["1"]}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Invalid JSON",
+			body:       `{"users":`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stored := users()
+			updated := false
+			s := &Service{
+				Store: &mocks.Store{
+					UsersStore: &mocks.UsersStore{
+						GetF: func(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+							u, ok := stored[*q.ID]
+							if !ok {
+								return nil, chronograf.ErrUserNotFound
+							}
+							return u, nil
+						},
+						UpdateManyF: func(ctx context.Context, us []*chronograf.User) error {
+							updated = true
+							return tt.updateErr
+						},
+					},
+					OrganizationsStore: &mocks.OrganizationsStore{
+						GetF: func(ctx context.Context, q chronograf.OrganizationQuery) (*chronograf.Organization, error) {
+							switch *q.ID {
+							case "1", "2", "3":
+								return &chronograf.Organization{ID: *q.ID, DefaultRole: "viewer"}, nil
+							}
+							return nil, fmt.Errorf("organization not found")
+						},
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("PATCH", "http://any.url/chronograf/v1/users/roles", strings.NewReader(tt.body))
+			s.UpdateUsersRoles(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("UpdateUsersRoles() = %v, want %v: %s", resp.StatusCode, tt.wantStatus, body)
+			}
+			if updated != tt.wantUpdate {
+				t.Errorf("UpdateUsersRoles() updated the users = %v, want %v", updated, tt.wantUpdate)
+			}
+			if tt.wantBody == "" {
+				return
+			}
+			if eq, _ := jsonEqual(string(body), tt.wantBody); !eq {
+				t.Errorf("UpdateUsersRoles() = %s, want %s", body, tt.wantBody)
+			}
+		})
+	}
+}
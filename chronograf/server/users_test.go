@@ -68,7 +68,7 @@ func TestService_UserID(t *testing.T) {
 			id:              "1337",
 			wantStatus:      http.StatusOK,
 			wantContentType: "application/json",
-			wantBody:        `{"id":"1337","superAdmin":false,"name":"billysteve","provider":"google","scheme":"oauth2","links":{"self":"/chronograf/v1/users/1337"},"roles":[{"name":"viewer"}]}`,
+			wantBody:        `{"id":"1337","superAdmin":false,"status":"active","name":"billysteve","provider":"google","scheme":"oauth2","links":{"self":"/chronograf/v1/users/1337"},"roles":[{"name":"viewer"}]}`,
 		},
 	}
 
@@ -168,7 +168,7 @@ func TestService_NewUser(t *testing.T) {
 			},
 			wantStatus:      http.StatusCreated,
 			wantContentType: "application/json",
-			wantBody:        `{"id":"1338","superAdmin":false,"name":"bob","provider":"github","scheme":"oauth2","roles":[],"links":{"self":"/chronograf/v1/users/1338"}}`,
+			wantBody:        `{"id":"1338","superAdmin":false,"status":"active","name":"bob","provider":"github","scheme":"oauth2","roles":[],"links":{"self":"/chronograf/v1/users/1338"}}`,
 		},
 		{
 			name: "Create a new Chronograf User with multiple roles",
@@ -246,7 +246,7 @@ func TestService_NewUser(t *testing.T) {
 			},
 			wantStatus:      http.StatusCreated,
 			wantContentType: "application/json",
-			wantBody:        `{"id":"1338","superAdmin":false,"name":"bob","provider":"github","scheme":"oauth2","roles":[{"name":"admin","organization":"1"},{"name":"viewer","organization":"2"}],"links":{"self":"/chronograf/v1/users/1338"}}`,
+			wantBody:        `{"id":"1338","superAdmin":false,"status":"active","name":"bob","provider":"github","scheme":"oauth2","roles":[{"name":"admin","organization":"1"},{"name":"viewer","organization":"2"}],"links":{"self":"/chronograf/v1/users/1338"}}`,
 		},
 		{
 			name: "Create a new Chronograf User with multiple roles same org",
@@ -304,8 +304,8 @@ func TestService_NewUser(t *testing.T) {
 				},
 			},
 			wantStatus:      http.StatusUnprocessableEntity,
-			wantContentType: "application/json",
-			wantBody:        `{"code":422,"message":"duplicate organization \"1\" in roles"}`,
+			wantContentType: "application/problem+json",
+			wantBody:        `{"code":422,"message":"duplicate organization \"1\" in roles","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"duplicate organization \"1\" in roles"}`,
 		},
 		{
 			name: "Create a new SuperAdmin User - Not as superadmin",
@@ -352,8 +352,8 @@ func TestService_NewUser(t *testing.T) {
 				},
 			},
 			wantStatus:      http.StatusUnauthorized,
-			wantContentType: "application/json",
-			wantBody:        `{"code":401,"message":"user does not have authorization required to set SuperAdmin status. See https://github.com/influxdata/influxdb/chronograf/issues/2601 for more information"}`,
+			wantContentType: "application/problem+json",
+			wantBody:        `{"code":401,"message":"user does not have authorization required to set SuperAdmin status. See https://github.com/influxdata/influxdb/chronograf/issues/2601 for more information","type":"urn:chronograf:problem:unauthorized","title":"Unauthorized","status":401,"detail":"user does not have authorization required to set SuperAdmin status. See https://github.com/influxdata/influxdb/chronograf/issues/2601 for more information"}`,
 		},
 		{
 			name: "Create a new SuperAdmin User - as superadmin",
@@ -402,7 +402,7 @@ func TestService_NewUser(t *testing.T) {
 			},
 			wantStatus:      http.StatusCreated,
 			wantContentType: "application/json",
-			wantBody:        `{"id":"1338","superAdmin":true,"name":"bob","provider":"github","scheme":"oauth2","roles":[],"links":{"self":"/chronograf/v1/users/1338"}}`,
+			wantBody:        `{"id":"1338","superAdmin":true,"status":"active","name":"bob","provider":"github","scheme":"oauth2","roles":[],"links":{"self":"/chronograf/v1/users/1338"}}`,
 		},
 		{
 			name: "Create a new User with SuperAdminNewUsers: true in ConfigStore",
@@ -444,7 +444,7 @@ func TestService_NewUser(t *testing.T) {
 			},
 			wantStatus:      http.StatusCreated,
 			wantContentType: "application/json",
-			wantBody:        `{"id":"1338","superAdmin":true,"name":"bob","provider":"github","scheme":"oauth2","roles":[],"links":{"self":"/chronograf/v1/users/1338"}}`,
+			wantBody:        `{"id":"1338","superAdmin":true,"status":"active","name":"bob","provider":"github","scheme":"oauth2","roles":[],"links":{"self":"/chronograf/v1/users/1338"}}`,
 		},
 		{
 			name: "Create a new Chronograf User with multiple roles with wildcard default role",
@@ -522,7 +522,7 @@ func TestService_NewUser(t *testing.T) {
 			},
 			wantStatus:      http.StatusCreated,
 			wantContentType: "application/json",
-			wantBody:        `{"id":"1338","superAdmin":false,"name":"bob","provider":"github","scheme":"oauth2","roles":[{"name":"admin","organization":"1"},{"name":"member","organization":"2"}],"links":{"self":"/chronograf/v1/users/1338"}}`,
+			wantBody:        `{"id":"1338","superAdmin":false,"status":"active","name":"bob","provider":"github","scheme":"oauth2","roles":[{"name":"admin","organization":"1"},{"name":"member","organization":"2"}],"links":{"self":"/chronograf/v1/users/1338"}}`,
 		},
 	}
 
@@ -781,7 +781,7 @@ func TestService_UpdateUser(t *testing.T) {
 			id:              "1336",
 			wantStatus:      http.StatusOK,
 			wantContentType: "application/json",
-			wantBody:        `{"id":"1336","superAdmin":false,"name":"bobbetta","provider":"github","scheme":"oauth2","links":{"self":"/chronograf/v1/users/1336"},"roles":[]}`,
+			wantBody:        `{"id":"1336","superAdmin":false,"status":"active","name":"bobbetta","provider":"github","scheme":"oauth2","links":{"self":"/chronograf/v1/users/1336"},"roles":[]}`,
 		},
 		{
 			name: "Update a Chronograf user",
@@ -852,7 +852,7 @@ func TestService_UpdateUser(t *testing.T) {
 			id:              "1336",
 			wantStatus:      http.StatusOK,
 			wantContentType: "application/json",
-			wantBody:        `{"id":"1336","superAdmin":false,"name":"bobbetta","provider":"github","scheme":"oauth2","links":{"self":"/chronograf/v1/users/1336"},"roles":[{"name":"admin","organization":"1"}]}`,
+			wantBody:        `{"id":"1336","superAdmin":false,"status":"active","name":"bobbetta","provider":"github","scheme":"oauth2","links":{"self":"/chronograf/v1/users/1336"},"roles":[{"name":"admin","organization":"1"}]}`,
 		},
 		{
 			name: "Update a Chronograf user roles different orgs",
@@ -930,7 +930,7 @@ func TestService_UpdateUser(t *testing.T) {
 			id:              "1336",
 			wantStatus:      http.StatusOK,
 			wantContentType: "application/json",
-			wantBody:        `{"id":"1336","superAdmin":false,"name":"bobbetta","provider":"github","scheme":"oauth2","links":{"self":"/chronograf/v1/users/1336"},"roles":[{"name":"admin","organization":"1"},{"name":"viewer","organization":"2"}]}`,
+			wantBody:        `{"id":"1336","superAdmin":false,"status":"active","name":"bobbetta","provider":"github","scheme":"oauth2","links":{"self":"/chronograf/v1/users/1336"},"roles":[{"name":"admin","organization":"1"},{"name":"viewer","organization":"2"}]}`,
 		},
 		{
 			name: "Update a Chronograf user roles same org",
@@ -981,8 +981,8 @@ func TestService_UpdateUser(t *testing.T) {
 			},
 			id:              "1336",
 			wantStatus:      http.StatusUnprocessableEntity,
-			wantContentType: "application/json",
-			wantBody:        `{"code":422,"message":"duplicate organization \"1\" in roles"}`,
+			wantContentType: "application/problem+json",
+			wantBody:        `{"code":422,"message":"duplicate organization \"1\" in roles","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"duplicate organization \"1\" in roles"}`,
 		},
 		{
 			name: "SuperAdmin modifying their own SuperAdmin Status - user missing from context",
@@ -1047,8 +1047,8 @@ func TestService_UpdateUser(t *testing.T) {
 			},
 			id:              "1336",
 			wantStatus:      http.StatusInternalServerError,
-			wantContentType: "application/json",
-			wantBody:        `{"code":500,"message":"failed to retrieve user from context"}`,
+			wantContentType: "application/problem+json",
+			wantBody:        `{"code":500,"message":"failed to retrieve user from context","type":"urn:chronograf:problem:internal-error","title":"Internal Server Error","status":500,"detail":"failed to retrieve user from context"}`,
 		},
 		{
 			name: "SuperAdmin modifying their own SuperAdmin Status",
@@ -1120,8 +1120,8 @@ func TestService_UpdateUser(t *testing.T) {
 			},
 			id:              "1336",
 			wantStatus:      http.StatusUnauthorized,
-			wantContentType: "application/json",
-			wantBody:        `{"code":401,"message":"user cannot modify their own SuperAdmin status"}`,
+			wantContentType: "application/problem+json",
+			wantBody:        `{"code":401,"message":"user cannot modify their own SuperAdmin status","type":"urn:chronograf:problem:unauthorized","title":"Unauthorized","status":401,"detail":"user cannot modify their own SuperAdmin status"}`,
 		},
 		{
 			name: "Update a SuperAdmin's Roles - without super admin context",
@@ -1194,7 +1194,7 @@ func TestService_UpdateUser(t *testing.T) {
 			id:              "1336",
 			wantStatus:      http.StatusOK,
 			wantContentType: "application/json",
-			wantBody:        `{"links":{"self":"/chronograf/v1/users/1336"},"id":"1336","name":"bobbetta","provider":"github","scheme":"oauth2","superAdmin":true,"roles":[{"name":"admin","organization":"1"}]}`,
+			wantBody:        `{"links":{"self":"/chronograf/v1/users/1336"},"id":"1336","name":"bobbetta","provider":"github","scheme":"oauth2","superAdmin":true,"status":"active","roles":[{"name":"admin","organization":"1"}]}`,
 		},
 		{
 			name: "Update a Chronograf user to super admin - without super admin context",
@@ -1262,8 +1262,8 @@ func TestService_UpdateUser(t *testing.T) {
 			},
 			id:              "1336",
 			wantStatus:      http.StatusUnauthorized,
-			wantContentType: "application/json",
-			wantBody:        `{"code":401,"message":"user does not have authorization required to set SuperAdmin status. See https://github.com/influxdata/influxdb/chronograf/issues/2601 for more information"}`,
+			wantContentType: "application/problem+json",
+			wantBody:        `{"code":401,"message":"user does not have authorization required to set SuperAdmin status. See https://github.com/influxdata/influxdb/chronograf/issues/2601 for more information","type":"urn:chronograf:problem:unauthorized","title":"Unauthorized","status":401,"detail":"user does not have authorization required to set SuperAdmin status. See https://github.com/influxdata/influxdb/chronograf/issues/2601 for more information"}`,
 		},
 		{
 			name: "Update a Chronograf user to super admin - with super admin context",
@@ -1332,7 +1332,7 @@ func TestService_UpdateUser(t *testing.T) {
 			id:              "1336",
 			wantStatus:      http.StatusOK,
 			wantContentType: "application/json",
-			wantBody:        `{"id":"1336","superAdmin":true,"name":"bobbetta","provider":"github","scheme":"oauth2","links":{"self":"/chronograf/v1/users/1336"},"roles":[{"name":"admin","organization":"1"}]}`,
+			wantBody:        `{"id":"1336","superAdmin":true,"status":"active","name":"bobbetta","provider":"github","scheme":"oauth2","links":{"self":"/chronograf/v1/users/1336"},"roles":[{"name":"admin","organization":"1"}]}`,
 		},
 	}
 	for _, tt := range tests {
@@ -1434,7 +1434,7 @@ func TestService_Users(t *testing.T) {
 			},
 			wantStatus:      http.StatusOK,
 			wantContentType: "application/json",
-			wantBody:        `{"users":[{"id":"1337","superAdmin":false,"name":"billysteve","provider":"google","scheme":"oauth2","roles":[{"name":"editor"}],"links":{"self":"/chronograf/v1/users/1337"}},{"id":"1338","superAdmin":false,"name":"bobbettastuhvetta","provider":"auth0","scheme":"oauth2","roles":[],"links":{"self":"/chronograf/v1/users/1338"}}],"links":{"self":"/chronograf/v1/users"}}`,
+			wantBody:        `{"users":[{"id":"1337","superAdmin":false,"status":"active","name":"billysteve","provider":"google","scheme":"oauth2","roles":[{"name":"editor"}],"links":{"self":"/chronograf/v1/users/1337"}},{"id":"1338","superAdmin":false,"status":"active","name":"bobbettastuhvetta","provider":"auth0","scheme":"oauth2","roles":[],"links":{"self":"/chronograf/v1/users/1338"}}],"total":2,"links":{"self":"/chronograf/v1/users"}}`,
 		},
 		{
 			name: "Get all Chronograf users, ensuring order of users in response",
@@ -1472,7 +1472,126 @@ func TestService_Users(t *testing.T) {
 			},
 			wantStatus:      http.StatusOK,
 			wantContentType: "application/json",
-			wantBody:        `{"users":[{"id":"1337","superAdmin":false,"name":"billysteve","provider":"google","scheme":"oauth2","roles":[{"name":"editor"}],"links":{"self":"/chronograf/v1/users/1337"}},{"id":"1338","superAdmin":false,"name":"bobbettastuhvetta","provider":"auth0","scheme":"oauth2","roles":[],"links":{"self":"/chronograf/v1/users/1338"}}],"links":{"self":"/chronograf/v1/users"}}`,
+			wantBody:        `{"users":[{"id":"1337","superAdmin":false,"status":"active","name":"billysteve","provider":"google","scheme":"oauth2","roles":[{"name":"editor"}],"links":{"self":"/chronograf/v1/users/1337"}},{"id":"1338","superAdmin":false,"status":"active","name":"bobbettastuhvetta","provider":"auth0","scheme":"oauth2","roles":[],"links":{"self":"/chronograf/v1/users/1338"}}],"total":2,"links":{"self":"/chronograf/v1/users"}}`,
+		},
+		{
+			name: "Get the first page of Chronograf users",
+			fields: fields{
+				Logger: &chronograf.NoopLogger{},
+				UsersStore: &mocks.UsersStore{
+					AllF: func(ctx context.Context) ([]chronograf.User, error) {
+						return []chronograf.User{
+							{ID: 1337, Name: "billysteve", Provider: "google", Scheme: "oauth2"},
+							{ID: 1338, Name: "bobbettastuhvetta", Provider: "auth0", Scheme: "oauth2"},
+							{ID: 1339, Name: "helena", Provider: "heroku", Scheme: "oauth2"},
+						}, nil
+					},
+				},
+			},
+			args: args{
+				w: httptest.NewRecorder(),
+				r: httptest.NewRequest(
+					"GET",
+					"http://any.url/chronograf/v1/users?limit=2",
+					nil,
+				),
+			},
+			wantStatus:      http.StatusOK,
+			wantContentType: "application/json",
+			wantBody:        `{"users":[{"id":"1337","superAdmin":false,"status":"active","name":"billysteve","provider":"google","scheme":"oauth2","roles":[],"links":{"self":"/chronograf/v1/users/1337"}},{"id":"1338","superAdmin":false,"status":"active","name":"bobbettastuhvetta","provider":"auth0","scheme":"oauth2","roles":[],"links":{"self":"/chronograf/v1/users/1338"}}],"total":3,"links":{"self":"/chronograf/v1/users?limit=2&offset=0","first":"/chronograf/v1/users?limit=2&offset=0","next":"/chronograf/v1/users?limit=2&offset=2"}}`,
+		},
+		{
+			name: "Get the last page of Chronograf users",
+			fields: fields{
+				Logger: &chronograf.NoopLogger{},
+				UsersStore: &mocks.UsersStore{
+					AllF: func(ctx context.Context) ([]chronograf.User, error) {
+						return []chronograf.User{
+							{ID: 1337, Name: "billysteve", Provider: "google", Scheme: "oauth2"},
+							{ID: 1338, Name: "bobbettastuhvetta", Provider: "auth0", Scheme: "oauth2"},
+							{ID: 1339, Name: "helena", Provider: "heroku", Scheme: "oauth2"},
+						}, nil
+					},
+				},
+			},
+			args: args{
+				w: httptest.NewRecorder(),
+				r: httptest.NewRequest(
+					"GET",
+					"http://any.url/chronograf/v1/users?limit=2&offset=2",
+					nil,
+				),
+			},
+			wantStatus:      http.StatusOK,
+			wantContentType: "application/json",
+			wantBody:        `{"users":[{"id":"1339","superAdmin":false,"status":"active","name":"helena","provider":"heroku","scheme":"oauth2","roles":[],"links":{"self":"/chronograf/v1/users/1339"}}],"total":3,"links":{"self":"/chronograf/v1/users?limit=2&offset=2","first":"/chronograf/v1/users?limit=2&offset=0","prev":"/chronograf/v1/users?limit=2&offset=0"}}`,
+		},
+		{
+			name: "Filter Chronograf users by provider and role",
+			fields: fields{
+				Logger: &chronograf.NoopLogger{},
+				UsersStore: &mocks.UsersStore{
+					FilterF: func(ctx context.Context, f chronograf.UserFilter) ([]chronograf.User, error) {
+						if f != (chronograf.UserFilter{Provider: "google", Role: "editor"}) {
+							t.Errorf("Users() filter = %v", f)
+						}
+						return []chronograf.User{
+							{
+								ID:       1337,
+								Name:     "billysteve",
+								Provider: "google",
+								Scheme:   "oauth2",
+								Roles: []chronograf.Role{
+									roles.EditorRole,
+								},
+							},
+						}, nil
+					},
+				},
+			},
+			args: args{
+				w: httptest.NewRecorder(),
+				r: httptest.NewRequest(
+					"GET",
+					"http://any.url/chronograf/v1/users?provider=google&role=editor&limit=1",
+					nil,
+				),
+			},
+			wantStatus:      http.StatusOK,
+			wantContentType: "application/json",
+			wantBody:        `{"users":[{"id":"1337","superAdmin":false,"status":"active","name":"billysteve","provider":"google","scheme":"oauth2","roles":[{"name":"editor"}],"links":{"self":"/chronograf/v1/users/1337"}}],"total":1,"links":{"self":"/chronograf/v1/users?limit=1&offset=0&provider=google&role=editor","first":"/chronograf/v1/users?limit=1&offset=0&provider=google&role=editor"}}`,
+		},
+		{
+			name: "Filter by unknown role",
+			fields: fields{
+				Logger: &chronograf.NoopLogger{},
+			},
+			args: args{
+				w: httptest.NewRecorder(),
+				r: httptest.NewRequest(
+					"GET",
+					"http://any.url/chronograf/v1/users?role=owner",
+					nil,
+				),
+			},
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody:   `{"code":422,"message":"unknown role owner. Valid roles are 'member', 'viewer', 'editor', 'admin', and '*'","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"unknown role owner. Valid roles are 'member', 'viewer', 'editor', 'admin', and '*'"}`,
+		},
+		{
+			name: "Invalid limit",
+			fields: fields{
+				Logger: &chronograf.NoopLogger{},
+			},
+			args: args{
+				w: httptest.NewRecorder(),
+				r: httptest.NewRequest(
+					"GET",
+					"http://any.url/chronograf/v1/users?limit=-1",
+					nil,
+				),
+			},
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody:   `{"code":422,"message":"invalid limit -1","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"invalid limit -1"}`,
 		},
 	}
 
@@ -1643,6 +1762,34 @@ func TestUserRequest_ValidCreate(t *testing.T) {
 	}
 }
 
+func TestUserRequest_ValidCreate_scheme(t *testing.T) {
+	tests := []struct {
+		provider string
+		want     string
+	}{
+		{provider: "github", want: "oauth2"},
+		{provider: BasicProvider, want: BasicScheme},
+		{provider: LDAPProvider, want: LDAPScheme},
+		{provider: SAMLProvider, want: SAMLScheme},
+	}
+	for _, tt := range tests {
+		t.Run(tt.provider, func(t *testing.T) {
+			u := &userRequest{
+				Name:     "billietta",
+				Provider: tt.provider,
+				Scheme:   "oauth2",
+				Roles:    []chronograf.Role{},
+			}
+			if err := u.ValidCreate(); err != nil {
+				t.Fatal(err)
+			}
+			if u.Scheme != tt.want {
+				t.Errorf("ValidCreate() scheme = %q, want %q", u.Scheme, tt.want)
+			}
+		})
+	}
+}
+
 func TestUserRequest_ValidUpdate(t *testing.T) {
 	type args struct {
 		u *userRequest
@@ -1769,3 +1916,316 @@ func TestUserRequest_ValidUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestService_SetUserRole(t *testing.T) {
+	tests := []struct {
+		name       string
+		oid        string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "Add a role in another organization",
+			oid:        "2",
+			body:       `{"name":"editor"}`,
+			wantStatus: http.StatusCreated,
+			wantBody:   `{"id":"1","superAdmin":false,"status":"active","name":"bob","provider":"github","scheme":"oauth2","roles":[{"name":"admin","organization":"1"},{"name":"editor","organization":"2"}],"links":{"self":"/chronograf/v1/users/1"}}`,
+		},
+		{
+			name:       "Replace the role in an organization",
+			oid:        "1",
+			body:       `{"name":"viewer"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"id":"1","superAdmin":false,"status":"active","name":"bob","provider":"github","scheme":"oauth2","roles":[{"name":"viewer","organization":"1"}],"links":{"self":"/chronograf/v1/users/1"}}`,
+		},
+		{
+			name:       "Wildcard role is the default role of the organization",
+			oid:        "2",
+			body:       `{"name":"*"}`,
+			wantStatus: http.StatusCreated,
+			wantBody:   `{"id":"1","superAdmin":false,"status":"active","name":"bob","provider":"github","scheme":"oauth2","roles":[{"name":"admin","organization":"1"},{"name":"member","organization":"2"}],"links":{"self":"/chronograf/v1/users/1"}}`,
+		},
+		{
+			name:       "Unknown role",
+			oid:        "2",
+			body:       `{"name":"chef"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody:   `{"code":422,"message":"unknown role chef. Valid roles are 'member', 'viewer', 'editor', 'admin', and '*'","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"unknown role chef. Valid roles are 'member', 'viewer', 'editor', 'admin', and '*'"}`,
+		},
+		{
+			name:       "Unknown organization",
+			oid:        "3",
+			body:       `{"name":"viewer"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody:   `{"code":422,"message":"organization not found","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"organization not found"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{
+				Store: &mocks.Store{
+					UsersStore: &mocks.UsersStore{
+						GetF: func(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+							return &chronograf.User{
+								ID:       1,
+								Name:     "bob",
+								Provider: "github",
+								Scheme:   "oauth2",
+								Roles:    []chronograf.Role{{Name: roles.AdminRoleName, Organization: "1"}},
+							}, nil
+						},
+						UpdateF: func(ctx context.Context, u *chronograf.User) error {
+							return nil
+						},
+					},
+					OrganizationsStore: &mocks.OrganizationsStore{
+						GetF: func(ctx context.Context, q chronograf.OrganizationQuery) (*chronograf.Organization, error) {
+							if *q.ID == "3" {
+								return nil, chronograf.ErrOrganizationNotFound
+							}
+							return &chronograf.Organization{ID: *q.ID, DefaultRole: roles.MemberRoleName}, nil
+						},
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("PUT", "http://any.url", bytes.NewBufferString(tt.body))
+			r = r.WithContext(httprouter.WithParams(r.Context(), httprouter.Params{
+				{Key: "id", Value: "1"},
+				{Key: "oid", Value: tt.oid},
+			}))
+
+			s.SetUserRole(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("%q. SetUserRole() = %v, want %v", tt.name, resp.StatusCode, tt.wantStatus)
+			}
+			if eq, _ := jsonEqual(string(body), tt.wantBody); !eq {
+				t.Errorf("%q. SetUserRole() = \n***%v***\n,\nwant\n***%v***", tt.name, string(body), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestService_RemoveUserRole(t *testing.T) {
+	tests := []struct {
+		name       string
+		oid        string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "Remove the role in an organization",
+			oid:        "2",
+			wantStatus: http.StatusOK,
+			wantBody:   `{"id":"1","superAdmin":false,"status":"active","name":"bob","provider":"github","scheme":"oauth2","roles":[{"name":"admin","organization":"1"}],"links":{"self":"/chronograf/v1/users/1"}}`,
+		},
+		{
+			name:       "No role in the organization",
+			oid:        "3",
+			wantStatus: http.StatusNotFound,
+			wantBody:   `{"code":404,"message":"user has no role in organization 3","type":"urn:chronograf:problem:not-found","title":"Not Found","status":404,"detail":"user has no role in organization 3"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{
+				Store: &mocks.Store{
+					UsersStore: &mocks.UsersStore{
+						GetF: func(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+							return &chronograf.User{
+								ID:       1,
+								Name:     "bob",
+								Provider: "github",
+								Scheme:   "oauth2",
+								Roles: []chronograf.Role{
+									{Name: roles.AdminRoleName, Organization: "1"},
+									{Name: roles.ViewerRoleName, Organization: "2"},
+								},
+							}, nil
+						},
+						UpdateF: func(ctx context.Context, u *chronograf.User) error {
+							return nil
+						},
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("DELETE", "http://any.url", nil)
+			r = r.WithContext(httprouter.WithParams(r.Context(), httprouter.Params{
+				{Key: "id", Value: "1"},
+				{Key: "oid", Value: tt.oid},
+			}))
+
+			s.RemoveUserRole(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("%q. RemoveUserRole() = %v, want %v", tt.name, resp.StatusCode, tt.wantStatus)
+			}
+			if eq, _ := jsonEqual(string(body), tt.wantBody); !eq {
+				t.Errorf("%q. RemoveUserRole() = \n***%v***\n,\nwant\n***%v***", tt.name, string(body), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestService_UpdateUserStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "Suspend a user",
+			id:         "1",
+			body:       `{"status":"suspended"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"id":"1","superAdmin":false,"status":"suspended","name":"bob","provider":"github","scheme":"oauth2","roles":[{"name":"admin","organization":"1"}],"links":{"self":"/chronograf/v1/users/1"}}`,
+		},
+		{
+			name:       "Unknown status",
+			id:         "1",
+			body:       `{"status":"banned"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody:   `{"code":422,"message":"unknown status banned. Valid statuses are 'active' and 'suspended'","type":"urn:chronograf:problem:invalid-data","title":"Unprocessable Entity","status":422,"detail":"unknown status banned. Valid statuses are 'active' and 'suspended'"}`,
+		},
+		{
+			name:       "Suspend oneself",
+			id:         "2",
+			body:       `{"status":"suspended"}`,
+			wantStatus: http.StatusUnauthorized,
+			wantBody:   `{"code":401,"message":"user cannot suspend themselves","type":"urn:chronograf:problem:unauthorized","title":"Unauthorized","status":401,"detail":"user cannot suspend themselves"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{
+				Store: &mocks.Store{
+					UsersStore: &mocks.UsersStore{
+						GetF: func(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+							return &chronograf.User{
+								ID:       *q.ID,
+								Name:     "bob",
+								Provider: "github",
+								Scheme:   "oauth2",
+								Roles:    []chronograf.Role{{Name: roles.AdminRoleName, Organization: "1"}},
+							}, nil
+						},
+						UpdateF: func(ctx context.Context, u *chronograf.User) error {
+							return nil
+						},
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("PATCH", "http://any.url", bytes.NewBufferString(tt.body))
+			ctx := context.WithValue(r.Context(), UserContextKey, &chronograf.User{ID: 2, SuperAdmin: true})
+			r = r.WithContext(httprouter.WithParams(ctx, httprouter.Params{{Key: "id", Value: tt.id}}))
+
+			s.UpdateUserStatus(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("%q. UpdateUserStatus() = %v, want %v", tt.name, resp.StatusCode, tt.wantStatus)
+			}
+			if eq, _ := jsonEqual(string(body), tt.wantBody); !eq {
+				t.Errorf("%q. UpdateUserStatus() = \n***%v***\n,\nwant\n***%v***", tt.name, string(body), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestService_LastSuperAdmin(t *testing.T) {
+	tests := []struct {
+		name       string
+		others     []chronograf.User
+		handler    func(s *Service) http.HandlerFunc
+		body       string
+		wantStatus int
+	}{
+		{
+			name:       "Delete the last SuperAdmin",
+			handler:    func(s *Service) http.HandlerFunc { return s.RemoveUser },
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "Delete a SuperAdmin while another is active",
+			others:     []chronograf.User{{ID: 3, SuperAdmin: true}},
+			handler:    func(s *Service) http.HandlerFunc { return s.RemoveUser },
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "Suspended SuperAdmins do not count",
+			others:     []chronograf.User{{ID: 3, SuperAdmin: true, Status: chronograf.UserStatusSuspended}},
+			handler:    func(s *Service) http.HandlerFunc { return s.RemoveUser },
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "Demote the last SuperAdmin",
+			handler:    func(s *Service) http.HandlerFunc { return s.UpdateUser },
+			body:       `{"superAdmin":false,"roles":[]}`,
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "Demote a SuperAdmin while another is active",
+			others:     []chronograf.User{{ID: 3, SuperAdmin: true}},
+			handler:    func(s *Service) http.HandlerFunc { return s.UpdateUser },
+			body:       `{"superAdmin":false,"roles":[]}`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "Suspend the last SuperAdmin",
+			handler:    func(s *Service) http.HandlerFunc { return s.UpdateUserStatus },
+			body:       `{"status":"suspended"}`,
+			wantStatus: http.StatusConflict,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := chronograf.User{ID: 1, Name: "bob", Provider: "github", Scheme: "oauth2", SuperAdmin: true, Roles: []chronograf.Role{}}
+			requester := &chronograf.User{ID: 2, SuperAdmin: true}
+			s := &Service{
+				Store: &mocks.Store{
+					UsersStore: &mocks.UsersStore{
+						AllF: func(ctx context.Context) ([]chronograf.User, error) {
+							return append([]chronograf.User{target}, tt.others...), nil
+						},
+						GetF: func(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+							u := target
+							return &u, nil
+						},
+						UpdateF: func(ctx context.Context, u *chronograf.User) error {
+							return nil
+						},
+						DeleteF: func(ctx context.Context, u *chronograf.User) error {
+							return nil
+						},
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("PATCH", "http://any.url", bytes.NewBufferString(tt.body))
+			ctx := serverContext(context.WithValue(r.Context(), UserContextKey, requester))
+			r = r.WithContext(httprouter.WithParams(ctx, httprouter.Params{{Key: "id", Value: "1"}}))
+
+			tt.handler(s)(w, r)
+
+			if got := w.Result().StatusCode; got != tt.wantStatus {
+				body, _ := ioutil.ReadAll(w.Result().Body)
+				t.Errorf("%q. status = %v, want %v: %s", tt.name, got, tt.wantStatus, body)
+			}
+		})
+	}
+}
@@ -0,0 +1,484 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+	idgen "github.com/influxdata/influxdb/chronograf/id"
+)
+
+// Types of the events posted to webhooks
+const (
+	EventUserCreated      = "user.created"
+	EventUserUpdated      = "user.updated"
+	EventUserDeleted      = "user.deleted"
+	EventDashboardCreated = "dashboard.created"
+	EventDashboardUpdated = "dashboard.updated"
+	EventDashboardDeleted = "dashboard.deleted"
+	EventSourceCreated    = "source.created"
+	EventSourceUpdated    = "source.updated"
+	EventSourceDeleted    = "source.deleted"
+)
+
+// webhookEvents are the types of events webhooks subscribe to
+var webhookEvents = map[string]bool{
+	EventUserCreated:      true,
+	EventUserUpdated:      true,
+	EventUserDeleted:      true,
+	EventDashboardCreated: true,
+	EventDashboardUpdated: true,
+	EventDashboardDeleted: true,
+	EventSourceCreated:    true,
+	EventSourceUpdated:    true,
+	EventSourceDeleted:    true,
+}
+
+const (
+	// WebhookEventHeader carries the type of the event of a payload
+	WebhookEventHeader = "X-Chronograf-Event"
+	// WebhookDeliveryHeader carries the ID of the event of a payload, which
+	// is the same for all attempts to deliver the event
+	WebhookDeliveryHeader = "X-Chronograf-Delivery"
+)
+
+// webhookEvent is the payload posted to webhooks
+type webhookEvent struct {
+	ID    string      `json:"id"`
+	Type  string      `json:"type"`
+	Time  time.Time   `json:"time"`
+	Actor string      `json:"actor,omitempty"` // Actor is the name of the user causing the event; empty for events of Chronograf itself
+	Data  interface{} `json:"data"`            // Data is the user, dashboard or source of the event
+}
+
+// sourceEventData is a source within an event, without its credentials
+type sourceEventData struct {
+	ID           int    `json:"id,string"`
+	Name         string `json:"name"`
+	Type         string `json:"type,omitempty"`
+	URL          string `json:"url"`
+	MetaURL      string `json:"metaUrl,omitempty"`
+	Discovered   bool   `json:"discovered,omitempty"`
+	Default      bool   `json:"default"`
+	Organization string `json:"organization"`
+}
+
+func newSourceEventData(src chronograf.Source) sourceEventData {
+	return sourceEventData{
+		ID:           src.ID,
+		Name:         src.Name,
+		Type:         src.Type,
+		URL:          src.URL,
+		MetaURL:      src.MetaURL,
+		Discovered:   src.Discovered,
+		Default:      src.Default,
+		Organization: src.Organization,
+	}
+}
+
+// EventWebhooks delivers the events of users, dashboards and sources to the
+// webhooks registered by SuperAdmins. Deliveries failing with a network
+// error, 429 or a 5xx response are retried with an exponential backoff.
+type EventWebhooks struct {
+	Attempts   int           // Attempts is the number of attempts to deliver an event to a webhook
+	RetryDelay time.Duration // RetryDelay is the delay before the first retry, doubled on every further retry
+	Client     *http.Client
+}
+
+// NewEventWebhooks delivers each event in at most attempts attempts, or in
+// a single attempt when attempts is not positive
+func NewEventWebhooks(attempts int, retryDelay time.Duration) *EventWebhooks {
+	if attempts < 1 {
+		attempts = 1
+	}
+	return &EventWebhooks{
+		Attempts:   attempts,
+		RetryDelay: retryDelay,
+		Client:     &http.Client{Timeout: alertWebhookTimeout},
+	}
+}
+
+// webhookSubscribes reports whether a webhook is posted events of a type.
+// Webhooks without events are posted all events.
+func webhookSubscribes(h chronograf.Webhook, event string) bool {
+	if len(h.Events) == 0 {
+		return true
+	}
+	for _, e := range h.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// send posts an event to a webhook once and reports whether the delivery
+// should be retried
+func (e *EventWebhooks) send(ctx context.Context, h chronograf.Webhook, ev webhookEvent, payload []byte) (chronograf.WebhookDelivery, bool) {
+	d := chronograf.WebhookDelivery{
+		WebhookID:   h.ID,
+		EventID:     ev.ID,
+		Event:       ev.Type,
+		Status:      chronograf.WebhookDeliveryError,
+		DeliveredAt: time.Now().UTC(),
+	}
+	req, err := http.NewRequest("POST", h.URL, bytes.NewReader(payload))
+	if err != nil {
+		d.Error = err.Error()
+		return d, false
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventHeader, ev.Type)
+	req.Header.Set(WebhookDeliveryHeader, ev.ID)
+	if h.Secret != "" {
+		req.Header.Set(AlertWebhookSignatureHeader, signAlertWebhook(h.Secret, payload))
+	}
+
+	resp, err := e.Client.Do(req.WithContext(ctx))
+	d.Duration = time.Since(d.DeliveredAt)
+	if err != nil {
+		d.Error = err.Error()
+		return d, true
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+
+	d.StatusCode = resp.StatusCode
+	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+		d.Status = chronograf.WebhookDeliverySuccess
+		return d, false
+	}
+	d.Error = fmt.Sprintf("received status code %d from webhook", resp.StatusCode)
+	return d, resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+}
+
+// deliverEvent posts an event to a webhook until it succeeds, fails for
+// good or runs out of attempts, recording each attempt in the delivery log
+func (s *Service) deliverEvent(ctx context.Context, h chronograf.Webhook, ev webhookEvent, payload []byte) {
+	delay := s.EventWebhooks.RetryDelay
+	for attempt := 1; attempt <= s.EventWebhooks.Attempts; attempt++ {
+		d, retry := s.EventWebhooks.send(ctx, h, ev, payload)
+		d.Attempt = attempt
+		if err := s.Store.WebhookDeliveries(ctx).Add(ctx, &d); err != nil {
+			s.Logger.Error("Unable to record the delivery of event ", ev.ID, " to webhook ", h.Name, ": ", err)
+		}
+		if d.Status == chronograf.WebhookDeliverySuccess {
+			return
+		}
+		if !retry || attempt == s.EventWebhooks.Attempts {
+			s.Logger.Error("Unable to deliver event ", ev.ID, " to webhook ", h.Name, ": ", d.Error)
+			return
+		}
+		time.Sleep(delay)
+		delay *= 2
+	}
+}
+
+// publishEvent posts an event of the request of ctx to the webhooks
+// subscribing to its type in the background. The data of the event must not
+// hold secrets, as it is sent as is.
+func (s *Service) publishEvent(ctx context.Context, event string, data interface{}) {
+	s.publishEventFunc(ctx, event, func() interface{} { return data })
+}
+
+// publishEventFunc is publishEvent with the data of the event built by data,
+// which is only called when a webhook subscribes to the event
+func (s *Service) publishEventFunc(ctx context.Context, event string, data func() interface{}) {
+	if s.EventWebhooks == nil {
+		return
+	}
+	serverCtx := serverContext(context.Background())
+	hooks, err := s.Store.Webhooks(serverCtx).All(serverCtx)
+	if err != nil {
+		s.Logger.Error("Unable to list webhooks: ", err)
+		return
+	}
+	subscribed := []chronograf.Webhook{}
+	for _, h := range hooks {
+		if webhookSubscribes(h, event) {
+			subscribed = append(subscribed, h)
+		}
+	}
+	if len(subscribed) == 0 {
+		return
+	}
+
+	id, err := (&idgen.UUID{}).Generate()
+	if err != nil {
+		s.Logger.Error("Unable to generate the ID of event ", event, ": ", err)
+		return
+	}
+	ev := webhookEvent{
+		ID:   id,
+		Type: event,
+		Time: time.Now().UTC(),
+		Data: data(),
+	}
+	if u, ok := hasUserContext(ctx); ok {
+		ev.Actor = u.Name
+	}
+	payload, err := json.Marshal(ev)
+	if err != nil {
+		s.Logger.Error("Unable to encode event ", event, ": ", err)
+		return
+	}
+	for _, h := range subscribed {
+		go s.deliverEvent(serverCtx, h, ev, payload)
+	}
+}
+
+// publishUserEvent publishes an event of the user of id with all of its
+// roles, as the users store of an organization only returns the roles within
+// the organization
+func (s *Service) publishUserEvent(ctx context.Context, event string, id uint64) {
+	if s.EventWebhooks == nil {
+		return
+	}
+	serverCtx := serverContext(ctx)
+	u, err := s.Store.Users(serverCtx).Get(serverCtx, chronograf.UserQuery{ID: &id})
+	if err != nil {
+		s.Logger.Error("Unable to retrieve user ", id, " of event ", event, ": ", err)
+		return
+	}
+	s.publishEvent(ctx, event, newUserResponse(u, ""))
+}
+
+// publishDashboardEvent publishes an event of dashboard d. Its data is built
+// from a copy of the queries of the cells of d, as newDashboardResponse
+// rewrites them in place.
+func (s *Service) publishDashboardEvent(ctx context.Context, event string, d chronograf.Dashboard) {
+	s.publishEventFunc(ctx, event, func() interface{} {
+		cells := make([]chronograf.DashboardCell, len(d.Cells))
+		for i, c := range d.Cells {
+			c.Queries = append([]chronograf.DashboardQuery(nil), c.Queries...)
+			cells[i] = c
+		}
+		d.Cells = cells
+		return newDashboardResponse(d)
+	})
+}
+
+type webhookLinks struct {
+	Self       string `json:"self"`       // Self link mapping to this resource
+	Deliveries string `json:"deliveries"` // Deliveries link to the delivery log of the webhook
+}
+
+type webhookResponse struct {
+	ID        string       `json:"id"`
+	Name      string       `json:"name"`
+	URL       string       `json:"url"`
+	Events    []string     `json:"events"`
+	HasSecret bool         `json:"hasSecret"`
+	Links     webhookLinks `json:"links"`
+}
+
+func newWebhookResponse(h chronograf.Webhook) webhookResponse {
+	if h.Events == nil {
+		h.Events = []string{}
+	}
+	self := fmt.Sprintf("/chronograf/v1/webhooks/%s", h.ID)
+	return webhookResponse{
+		ID:        h.ID,
+		Name:      h.Name,
+		URL:       h.URL,
+		Events:    h.Events,
+		HasSecret: h.Secret != "",
+		Links: webhookLinks{
+			Self:       self,
+			Deliveries: self + "/deliveries",
+		},
+	}
+}
+
+type webhooksResponse struct {
+	Links    selfLinks         `json:"links"`
+	Webhooks []webhookResponse `json:"webhooks"`
+}
+
+type webhookDeliveriesResponse struct {
+	Links      selfLinks                    `json:"links"`
+	Deliveries []chronograf.WebhookDelivery `json:"deliveries"`
+}
+
+// webhookRequest creates or updates a webhook. Fields left out of an update
+// keep their value; an empty secret stops signing the payloads.
+type webhookRequest struct {
+	Name   *string   `json:"name"`
+	URL    *string   `json:"url"`
+	Events *[]string `json:"events"`
+	Secret *string   `json:"secret"`
+}
+
+// apply sets the fields of the request on a webhook
+func (req *webhookRequest) apply(h *chronograf.Webhook) {
+	if req.Name != nil {
+		h.Name = *req.Name
+	}
+	if req.URL != nil {
+		h.URL = *req.URL
+	}
+	if req.Events != nil {
+		h.Events = *req.Events
+	}
+	if req.Secret != nil {
+		h.Secret = *req.Secret
+	}
+}
+
+// validWebhook checks a webhook before it is stored
+func validWebhook(h *chronograf.Webhook) error {
+	if h.Name == "" {
+		return errorf("name required on webhook request body")
+	}
+	u, err := url.Parse(h.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errorf("url must be an http or https URL")
+	}
+	for _, e := range h.Events {
+		if !webhookEvents[e] {
+			return errorf("unknown event %q", e)
+		}
+	}
+	return nil
+}
+
+// Webhooks returns the webhooks of events
+func (s *Service) Webhooks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	hooks, err := s.Store.Webhooks(ctx).All(ctx)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	res := webhooksResponse{
+		Links: selfLinks{
+			Self: "/chronograf/v1/webhooks",
+		},
+		Webhooks: []webhookResponse{},
+	}
+	for _, h := range hooks {
+		res.Webhooks = append(res.Webhooks, newWebhookResponse(h))
+	}
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
+
+// NewWebhook registers a webhook posted the events of its types
+func (s *Service) NewWebhook(w http.ResponseWriter, r *http.Request) {
+	var req webhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+	h := &chronograf.Webhook{}
+	req.apply(h)
+	if err := validWebhook(h); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	h, err := s.Store.Webhooks(ctx).Add(ctx, h)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	res := newWebhookResponse(*h)
+	location(w, res.Links.Self)
+	encodeJSON(w, http.StatusCreated, res, s.Logger)
+}
+
+// WebhookID returns a webhook of events
+func (s *Service) WebhookID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, _ := paramStr("id", r)
+	h, err := s.Store.Webhooks(ctx).Get(ctx, id)
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+	encodeJSON(w, http.StatusOK, newWebhookResponse(*h), s.Logger)
+}
+
+// UpdateWebhook changes the fields of a webhook given by the request
+func (s *Service) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
+	var req webhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		invalidJSON(w, s.Logger)
+		return
+	}
+
+	ctx := r.Context()
+	id, _ := paramStr("id", r)
+	h, err := s.Store.Webhooks(ctx).Get(ctx, id)
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+	req.apply(h)
+	if err := validWebhook(h); err != nil {
+		invalidData(w, err, s.Logger)
+		return
+	}
+	if err := s.Store.Webhooks(ctx).Update(ctx, h); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	encodeJSON(w, http.StatusOK, newWebhookResponse(*h), s.Logger)
+}
+
+// RemoveWebhook deletes a webhook and its delivery log
+func (s *Service) RemoveWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, _ := paramStr("id", r)
+	h, err := s.Store.Webhooks(ctx).Get(ctx, id)
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+	if err := s.Store.Webhooks(ctx).Delete(ctx, h); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	if err := s.Store.WebhookDeliveries(ctx).Delete(ctx, h.ID); err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// WebhookDeliveries returns the log of the deliveries of events to a
+// webhook, latest first
+func (s *Service) WebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, _ := paramStr("id", r)
+	h, err := s.Store.Webhooks(ctx).Get(ctx, id)
+	if err != nil {
+		notFound(w, id, s.Logger)
+		return
+	}
+	deliveries, err := s.Store.WebhookDeliveries(ctx).All(ctx, h.ID)
+	if err != nil {
+		unknownErrorWithMessage(w, err, s.Logger)
+		return
+	}
+
+	res := webhookDeliveriesResponse{
+		Links: selfLinks{
+			Self: fmt.Sprintf("/chronograf/v1/webhooks/%s/deliveries", h.ID),
+		},
+		Deliveries: make([]chronograf.WebhookDelivery, 0, len(deliveries)),
+	}
+	for i := len(deliveries) - 1; i >= 0; i-- {
+		res.Deliveries = append(res.Deliveries, deliveries[i])
+	}
+	encodeJSON(w, http.StatusOK, res, s.Logger)
+}
@@ -0,0 +1,272 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/influxdata/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+func TestService_publishEvent(t *testing.T) {
+	type received struct {
+		header  http.Header
+		payload []byte
+	}
+	requests := make(chan received, 10)
+	failures := 1
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload, _ := ioutil.ReadAll(r.Body)
+		requests <- received{header: r.Header, payload: payload}
+		// The first delivery fails, so that it is retried
+		if failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	deliveries := make(chan chronograf.WebhookDelivery, 10)
+	s := &Service{
+		Logger:        &chronograf.NoopLogger{},
+		EventWebhooks: NewEventWebhooks(3, time.Millisecond),
+		Store: &mocks.Store{
+			WebhooksStore: &mocks.WebhooksStore{
+				AllF: func(ctx context.Context) ([]chronograf.Webhook, error) {
+					return []chronograf.Webhook{
+						{ID: "1", Name: "catalog", URL: ts.URL, Events: []string{EventDashboardUpdated}, Secret: "hunter2"},
+						{ID: "2", Name: "users", URL: ts.URL, Events: []string{EventUserCreated}},
+					}, nil
+				},
+			},
+			WebhookDeliveriesStore: &mocks.WebhookDeliveriesStore{
+				AddF: func(ctx context.Context, d *chronograf.WebhookDelivery) error {
+					deliveries <- *d
+					return nil
+				},
+			},
+		},
+	}
+
+	ctx := context.WithValue(context.Background(), UserContextKey, &chronograf.User{ID: 1, Name: "marty"})
+	s.publishEvent(ctx, EventDashboardUpdated, map[string]string{"name": "cpu"})
+
+	for attempt := 1; attempt <= 2; attempt++ {
+		var req received
+		select {
+		case req = <-requests:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("publishEvent() attempt %d was not delivered", attempt)
+		}
+		if got := req.header.Get(WebhookEventHeader); got != EventDashboardUpdated {
+			t.Errorf("publishEvent() event header = %q, want %q", got, EventDashboardUpdated)
+		}
+		if got, want := req.header.Get(AlertWebhookSignatureHeader), signAlertWebhook("hunter2", req.payload); got != want {
+			t.Errorf("publishEvent() signature = %q, want %q", got, want)
+		}
+		var ev struct {
+			ID    string            `json:"id"`
+			Type  string            `json:"type"`
+			Actor string            `json:"actor"`
+			Data  map[string]string `json:"data"`
+		}
+		if err := json.Unmarshal(req.payload, &ev); err != nil {
+			t.Fatalf("publishEvent() payload is not JSON: %v", err)
+		}
+		if ev.ID != req.header.Get(WebhookDeliveryHeader) || ev.Type != EventDashboardUpdated || ev.Actor != "marty" || ev.Data["name"] != "cpu" {
+			t.Errorf("publishEvent() payload = %s", req.payload)
+		}
+
+		var d chronograf.WebhookDelivery
+		select {
+		case d = <-deliveries:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("publishEvent() attempt %d was not recorded", attempt)
+		}
+		want := chronograf.WebhookDeliveryError
+		if attempt == 2 {
+			want = chronograf.WebhookDeliverySuccess
+		}
+		if d.WebhookID != "1" || d.EventID != ev.ID || d.Attempt != attempt || d.Status != want {
+			t.Errorf("publishEvent() delivery = %+v", d)
+		}
+	}
+
+	select {
+	case req := <-requests:
+		t.Errorf("publishEvent() posted an unsubscribed event: %s", req.payload)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestService_publishDashboardEvent(t *testing.T) {
+	requests := make(chan []byte, 10)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload, _ := ioutil.ReadAll(r.Body)
+		requests <- payload
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	s := &Service{
+		Logger:        &chronograf.NoopLogger{},
+		EventWebhooks: NewEventWebhooks(1, time.Millisecond),
+		Store: &mocks.Store{
+			WebhooksStore: &mocks.WebhooksStore{
+				AllF: func(ctx context.Context) ([]chronograf.Webhook, error) {
+					return []chronograf.Webhook{
+						{ID: "1", Name: "catalog", URL: ts.URL, Events: []string{EventDashboardUpdated}},
+					}, nil
+				},
+			},
+			WebhookDeliveriesStore: &mocks.WebhookDeliveriesStore{
+				AddF: func(ctx context.Context, d *chronograf.WebhookDelivery) error {
+					return nil
+				},
+			},
+		},
+	}
+
+	built := false
+	s.publishEventFunc(context.Background(), EventDashboardDeleted, func() interface{} {
+		built = true
+		return nil
+	})
+	if built {
+		t.Errorf("publishEventFunc() built the data of an event no webhook subscribes to")
+	}
+
+	qc := chronograf.QueryConfig{ID: "1", Database: "telegraf", Measurement: "cpu"}
+	d := chronograf.Dashboard{
+		ID: 1,
+		Cells: []chronograf.DashboardCell{
+			{
+				ID:      "a",
+				Queries: []chronograf.DashboardQuery{{Command: "SELECT mean(usage_user) FROM cpu", QueryConfig: qc}},
+			},
+		},
+	}
+	s.publishDashboardEvent(context.Background(), EventDashboardUpdated, d)
+
+	select {
+	case payload := <-requests:
+		if !bytes.Contains(payload, []byte(`"cells"`)) {
+			t.Errorf("publishDashboardEvent() payload = %s", payload)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("publishDashboardEvent() was not delivered")
+	}
+	if got := d.Cells[0].Queries[0].QueryConfig; got.ID != qc.ID || got.Database != qc.Database || got.Measurement != qc.Measurement {
+		t.Errorf("publishDashboardEvent() changed the query config of the dashboard to %+v", got)
+	}
+}
+
+func TestService_NewWebhook(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+	}{
+		{
+			name:       "Webhook of dashboard events",
+			body:       `{"name":"catalog","url":"https://catalog.example.com/hooks","events":["dashboard.created","dashboard.deleted"],"secret":"hunter2"}`,
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "Unknown event",
+			body:       `{"name":"catalog","url":"https://catalog.example.com/hooks","events":["dashboard.renamed"]}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "Not an HTTP URL",
+			body:       `{"name":"catalog","url":"ftp://catalog.example.com/hooks"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{
+				Logger: &chronograf.NoopLogger{},
+				Store: &mocks.Store{
+					WebhooksStore: &mocks.WebhooksStore{
+						AddF: func(ctx context.Context, h *chronograf.Webhook) (*chronograf.Webhook, error) {
+							h.ID = "1"
+							return h, nil
+						},
+					},
+				},
+			}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url/chronograf/v1/webhooks", bytes.NewBufferString(tt.body))
+			s.NewWebhook(w, r)
+
+			resp := w.Result()
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("%q. NewWebhook() = %v, want %v", tt.name, resp.StatusCode, tt.wantStatus)
+			}
+			if tt.wantStatus != http.StatusCreated {
+				return
+			}
+			body, _ := ioutil.ReadAll(resp.Body)
+			var res map[string]interface{}
+			if err := json.Unmarshal(body, &res); err != nil {
+				t.Fatal(err)
+			}
+			if _, ok := res["secret"]; ok || res["hasSecret"] != true {
+				t.Errorf("%q. NewWebhook() = %s", tt.name, body)
+			}
+			if got := resp.Header.Get("Location"); got != "/chronograf/v1/webhooks/1" {
+				t.Errorf("%q. NewWebhook() location = %q", tt.name, got)
+			}
+		})
+	}
+}
+
+func TestService_WebhookDeliveries(t *testing.T) {
+	s := &Service{
+		Logger: &chronograf.NoopLogger{},
+		Store: &mocks.Store{
+			WebhooksStore: &mocks.WebhooksStore{
+				GetF: func(ctx context.Context, id string) (*chronograf.Webhook, error) {
+					if id != "1" {
+						return nil, chronograf.ErrWebhookNotFound
+					}
+					return &chronograf.Webhook{ID: "1", Name: "catalog"}, nil
+				},
+			},
+			WebhookDeliveriesStore: &mocks.WebhookDeliveriesStore{
+				AllF: func(ctx context.Context, webhookID string) ([]chronograf.WebhookDelivery, error) {
+					return []chronograf.WebhookDelivery{
+						{WebhookID: webhookID, EventID: "a", Attempt: 1, Status: chronograf.WebhookDeliveryError},
+						{WebhookID: webhookID, EventID: "a", Attempt: 2, Status: chronograf.WebhookDeliverySuccess},
+					}, nil
+				},
+			},
+		},
+	}
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "http://any.url/chronograf/v1/webhooks/1/deliveries", nil)
+	r = r.WithContext(context.WithValue(r.Context(), httprouter.ParamsKey, httprouter.Params{{Key: "id", Value: "1"}}))
+	s.WebhookDeliveries(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("WebhookDeliveries() = %v, want %v", w.Code, http.StatusOK)
+	}
+	var res webhookDeliveriesResponse
+	if err := json.NewDecoder(w.Body).Decode(&res); err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Deliveries) != 2 || res.Deliveries[0].Attempt != 2 {
+		t.Errorf("WebhookDeliveries() = %+v, want the latest delivery first", res.Deliveries)
+	}
+}
@@ -0,0 +1,139 @@
+package server
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/influx"
+	"github.com/influxdata/influxdb/models"
+)
+
+// maxWriteSize is the largest body of a write in bytes, once decompressed,
+// matching the default max-body-size of InfluxDB
+const maxWriteSize = 25000000
+
+// maxWriteErrors is the most invalid lines reported for a write
+const maxWriteErrors = 100
+
+// writePoint is a point of a write sent as JSON
+type writePoint struct {
+	Measurement string                 `json:"measurement"`
+	Tags        map[string]string      `json:"tags,omitempty"`
+	Fields      map[string]interface{} `json:"fields"`
+	Time        int64                  `json:"time,omitempty"` // Time is in the precision of the write; it defaults to the time of the write
+}
+
+type writeRequest struct {
+	Points []writePoint `json:"points"`
+}
+
+// lineProtocolError is an invalid line of a write
+type lineProtocolError struct {
+	Line  int    `json:"line"`  // Line is the number of the line, starting at 1
+	Text  string `json:"text"`  // Text is the content of the line
+	Error string `json:"error"` // Error is why the line could not be parsed
+}
+
+type invalidWriteResponse struct {
+	ErrorMessage
+	Errors []lineProtocolError `json:"errors"`
+}
+
+// writeBody reads the body of a write as line protocol. Bodies may be
+// gzipped and points may be sent as JSON, which is converted to line
+// protocol.
+func writeBody(r *http.Request) ([]byte, error) {
+	var body io.Reader = r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(r.Body)
+		if err != nil {
+			return nil, errorf("invalid gzip body: %v", err)
+		}
+		defer gz.Close()
+		body = gz
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(body, maxWriteSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxWriteSize {
+		return nil, errorf("writes are limited to %d bytes", maxWriteSize)
+	}
+
+	if ct, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); ct != "application/json" {
+		return data, nil
+	}
+	var req writeRequest
+	if err := json.Unmarshal(data, &req); err != nil {
+		return nil, errorf("unparsable JSON")
+	}
+	points := make([]chronograf.Point, len(req.Points))
+	for i, p := range req.Points {
+		points[i] = chronograf.Point{
+			Measurement: p.Measurement,
+			Time:        p.Time,
+			Tags:        p.Tags,
+			Fields:      p.Fields,
+		}
+	}
+	lp, err := influx.LineProtocol(points)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(lp), nil
+}
+
+// invalidLines parses every line of a write in precision and returns the
+// lines InfluxDB would reject, up to maxWriteErrors of them. Empty lines and
+// comments are skipped as InfluxDB does.
+func invalidLines(data []byte, precision string) []lineProtocolError {
+	// InfluxDB 1.x names microseconds u; timestamps in minutes and hours
+	// are only checked as nanoseconds
+	if precision == "u" {
+		precision = "us"
+	}
+
+	errs := []lineProtocolError{}
+	now := time.Now().UTC()
+	for i, line := range bytes.Split(data, []byte("\n")) {
+		if _, err := models.ParsePointsWithPrecisionV1(line, nil, now, precision); err != nil {
+			text := string(line)
+			errs = append(errs, lineProtocolError{
+				Line:  i + 1,
+				Text:  text,
+				Error: lineError(text, err),
+			})
+			if len(errs) == maxWriteErrors {
+				break
+			}
+		}
+	}
+	return errs
+}
+
+// lineError returns the reason line is invalid without the line itself,
+// which the parse error repeats
+func lineError(line string, err error) string {
+	return strings.TrimPrefix(err.Error(), "unable to parse '"+strings.TrimLeft(line, " \t")+"': ")
+}
+
+// invalidWrite responds with the invalid lines of a write
+func invalidWrite(w http.ResponseWriter, errs []lineProtocolError, logger chronograf.Logger) {
+	msg := fmt.Sprintf("invalid line protocol on line %d: %s", errs[0].Line, errs[0].Error)
+	if len(errs) > 1 {
+		msg = fmt.Sprintf("%s (and %d more invalid lines)", msg, len(errs)-1)
+	}
+	encodeProblem(w, http.StatusUnprocessableEntity, invalidWriteResponse{
+		ErrorMessage: newErrorMessage(http.StatusUnprocessableEntity, msg),
+		Errors:       errs,
+	}, logger)
+}
@@ -0,0 +1,140 @@
+package server
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/influxdata/httprouter"
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+)
+
+func TestService_Write(t *testing.T) {
+	gzipped := func(s string) []byte {
+		var b bytes.Buffer
+		gz := gzip.NewWriter(&b)
+		gz.Write([]byte(s))
+		gz.Close()
+		return b.Bytes()
+	}
+	tests := []struct {
+		name        string
+		body        []byte
+		contentType string
+		encoding    string
+		params      string
+		wantStatus  int
+		wantBody    string
+		wantErrors  []lineProtocolError
+	}{
+		{
+			name:       "Line protocol",
+			body:       []byte("cpu,host=a usage=0.5 1000\n# comment\n\ncpu,host=b usage=0.7 2000\n"),
+			params:     "?db=telegraf&precision=s",
+			wantStatus: http.StatusNoContent,
+			wantBody:   "cpu,host=a usage=0.5 1000\n# comment\n\ncpu,host=b usage=0.7 2000\n",
+		},
+		{
+			name:        "Points as JSON",
+			body:        []byte(`{"points":[{"measurement":"cpu","tags":{"host":"a"},"fields":{"usage":0.5,"state":"ok"},"time":1000}]}`),
+			contentType: "application/json",
+			params:      "?db=telegraf",
+			wantStatus:  http.StatusNoContent,
+			wantBody:    `cpu,host=a state="ok",usage=0.500000 1000`,
+		},
+		{
+			name:       "Gzipped line protocol",
+			body:       gzipped("cpu usage=1i"),
+			encoding:   "gzip",
+			params:     "?db=telegraf",
+			wantStatus: http.StatusNoContent,
+			wantBody:   "cpu usage=1i",
+		},
+		{
+			name:       "Invalid lines",
+			body:       []byte("cpu usage=0.5\ncpu\ncpu usage=0.5 1000\ncpu,host usage=1\n"),
+			params:     "?db=telegraf",
+			wantStatus: http.StatusUnprocessableEntity,
+			wantErrors: []lineProtocolError{
+				{Line: 2, Text: "cpu", Error: "missing fields"},
+				{Line: 4, Text: "cpu,host usage=1", Error: "missing tag value"},
+			},
+		},
+		{
+			name:        "Points without fields",
+			body:        []byte(`{"points":[{"measurement":"cpu"}]}`),
+			contentType: "application/json",
+			params:      "?db=telegraf",
+			wantStatus:  http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotBody, gotEncoding string
+			ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				b, _ := ioutil.ReadAll(r.Body)
+				gotBody = string(b)
+				gotEncoding = r.Header.Get("Content-Encoding")
+				rw.WriteHeader(http.StatusNoContent)
+			}))
+			defer ts.Close()
+
+			s := &Service{
+				Store: &mocks.Store{
+					SourcesStore: &mocks.SourcesStore{
+						GetF: func(ctx context.Context, ID int) (chronograf.Source, error) {
+							return chronograf.Source{ID: ID, URL: ts.URL}, nil
+						},
+					},
+				},
+				Logger: &chronograf.NoopLogger{},
+			}
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "http://any.url/chronograf/v1/sources/1/write"+tt.params, bytes.NewReader(tt.body))
+			if tt.contentType != "" {
+				r.Header.Set("Content-Type", tt.contentType)
+			}
+			if tt.encoding != "" {
+				r.Header.Set("Content-Encoding", tt.encoding)
+			}
+			r = r.WithContext(context.WithValue(r.Context(), httprouter.ParamsKey, httprouter.Params{{Key: "id", Value: "1"}}))
+			s.Write(w, r)
+
+			resp := w.Result()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("Write() = %v, want %v: %s", resp.StatusCode, tt.wantStatus, body)
+			}
+			if tt.wantStatus == http.StatusNoContent {
+				if gotBody != tt.wantBody {
+					t.Errorf("Write() forwarded %q, want %q", gotBody, tt.wantBody)
+				}
+				if gotEncoding != "" {
+					t.Errorf("Write() forwarded Content-Encoding %s", gotEncoding)
+				}
+				return
+			}
+			if gotBody != "" {
+				t.Errorf("Write() forwarded the invalid write %q", gotBody)
+			}
+			if tt.wantErrors == nil {
+				return
+			}
+			var res invalidWriteResponse
+			if err := json.Unmarshal(body, &res); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(res.Errors, tt.wantErrors) {
+				t.Errorf("Write() errors = %+v, want %+v", res.Errors, tt.wantErrors)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package shadow
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Ensure AlertEventsStore implements chronograf.AlertEventsStore.
+var _ chronograf.AlertEventsStore = &AlertEventsStore{}
+
+// AlertEventsStore writes alert events to both Primary and Shadow and reads from Primary
+type AlertEventsStore struct {
+	Primary chronograf.AlertEventsStore
+	Shadow  chronograf.AlertEventsStore
+	Logger  chronograf.Logger
+}
+
+func (s *AlertEventsStore) log() logger {
+	return newLogger(s.Logger, "alertevents")
+}
+
+// All returns the alert events of a source from the Primary store
+func (s *AlertEventsStore) All(ctx context.Context, sourceID int) ([]chronograf.AlertEvent, error) {
+	all, err := s.Primary.All(ctx, sourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	shadow, err := s.Shadow.All(ctx, sourceID)
+	if err != nil {
+		s.log().failed("All", err)
+		return all, nil
+	}
+
+	p, sh := map[string]interface{}{}, map[string]interface{}{}
+	for _, e := range all {
+		p[strconv.FormatUint(e.ID, 10)] = e
+	}
+	for _, e := range shadow {
+		sh[strconv.FormatUint(e.ID, 10)] = e
+	}
+	s.log().compareAll(p, sh)
+	return all, nil
+}
+
+// Add records e in the Primary store and then in the Shadow store. Each
+// store assigns the ID of the event, so both stores must start out empty for
+// their IDs to match.
+func (s *AlertEventsStore) Add(ctx context.Context, e *chronograf.AlertEvent) error {
+	if err := s.Primary.Add(ctx, e); err != nil {
+		return err
+	}
+	event := *e
+	if err := s.Shadow.Add(ctx, &event); err != nil {
+		s.log().failed("Add", err)
+	}
+	return nil
+}
+
+// Get returns the alert event id of a source from the Primary store
+func (s *AlertEventsStore) Get(ctx context.Context, sourceID int, id uint64) (*chronograf.AlertEvent, error) {
+	e, err := s.Primary.Get(ctx, sourceID, id)
+	if err != nil {
+		return e, err
+	}
+	shadow, err := s.Shadow.Get(ctx, sourceID, id)
+	if err != nil {
+		s.log().failed("Get", err)
+		return e, nil
+	}
+	s.log().compare("Get", e.ID, e, shadow)
+	return e, nil
+}
+
+// Update replaces e in both stores
+func (s *AlertEventsStore) Update(ctx context.Context, e *chronograf.AlertEvent) error {
+	if err := s.Primary.Update(ctx, e); err != nil {
+		return err
+	}
+	if err := s.Shadow.Update(ctx, e); err != nil {
+		s.log().failed("Update", err)
+	}
+	return nil
+}
+
+// Delete removes the alert events of a source from both stores
+func (s *AlertEventsStore) Delete(ctx context.Context, sourceID int) error {
+	if err := s.Primary.Delete(ctx, sourceID); err != nil {
+		return err
+	}
+	if err := s.Shadow.Delete(ctx, sourceID); err != nil {
+		s.log().failed("Delete", err)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package shadow
+
+import (
+	"context"
+	"time"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Ensure AnnotationsStore implements chronograf.AnnotationStore.
+var _ chronograf.AnnotationStore = &AnnotationsStore{}
+
+// AnnotationsStore writes annotations to both Primary and Shadow and reads
+// from Primary
+type AnnotationsStore struct {
+	Primary chronograf.AnnotationStore
+	Shadow  chronograf.AnnotationStore
+	Logger  chronograf.Logger
+}
+
+func (s *AnnotationsStore) log() logger {
+	return newLogger(s.Logger, "annotations")
+}
+
+// All returns the annotations between start and stop from the Primary store
+func (s *AnnotationsStore) All(ctx context.Context, start, stop time.Time) ([]chronograf.Annotation, error) {
+	all, err := s.Primary.All(ctx, start, stop)
+	if err != nil {
+		return nil, err
+	}
+
+	shadow, err := s.Shadow.All(ctx, start, stop)
+	if err != nil {
+		s.log().failed("All", err)
+		return all, nil
+	}
+
+	p, sh := map[string]interface{}{}, map[string]interface{}{}
+	for _, a := range all {
+		p[a.ID] = a
+	}
+	for _, a := range shadow {
+		sh[a.ID] = a
+	}
+	s.log().compareAll(p, sh)
+	return all, nil
+}
+
+// Add creates a in the Primary store and then in the Shadow store using the
+// ID assigned by the Primary store
+func (s *AnnotationsStore) Add(ctx context.Context, a *chronograf.Annotation) (*chronograf.Annotation, error) {
+	added, err := s.Primary.Add(ctx, a)
+	if err != nil {
+		return added, err
+	}
+	annotation := *added
+	if _, err := s.Shadow.Add(ctx, &annotation); err != nil {
+		s.log().failed("Add", err)
+	}
+	return added, nil
+}
+
+// Delete removes the annotation with id from both stores
+func (s *AnnotationsStore) Delete(ctx context.Context, id string) error {
+	if err := s.Primary.Delete(ctx, id); err != nil {
+		return err
+	}
+	if err := s.Shadow.Delete(ctx, id); err != nil {
+		s.log().failed("Delete", err)
+	}
+	return nil
+}
+
+// Get returns the annotation with id from the Primary store
+func (s *AnnotationsStore) Get(ctx context.Context, id string) (*chronograf.Annotation, error) {
+	a, err := s.Primary.Get(ctx, id)
+	if err != nil {
+		return a, err
+	}
+	shadow, err := s.Shadow.Get(ctx, id)
+	if err != nil {
+		s.log().failed("Get", err)
+		return a, nil
+	}
+	s.log().compare("Get", a.ID, a, shadow)
+	return a, nil
+}
+
+// Update replaces a in both stores
+func (s *AnnotationsStore) Update(ctx context.Context, a *chronograf.Annotation) error {
+	if err := s.Primary.Update(ctx, a); err != nil {
+		return err
+	}
+	if err := s.Shadow.Update(ctx, a); err != nil {
+		s.log().failed("Update", err)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package shadow
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Ensure ConfigStore implements chronograf.ConfigStore.
+var _ chronograf.ConfigStore = &ConfigStore{}
+
+// ConfigStore writes the application config to both Primary and Shadow and reads from Primary
+type ConfigStore struct {
+	Primary chronograf.ConfigStore
+	Shadow  chronograf.ConfigStore
+	Logger  chronograf.Logger
+}
+
+func (s *ConfigStore) log() logger {
+	return newLogger(s.Logger, "config")
+}
+
+// Initialize initializes both stores
+func (s *ConfigStore) Initialize(ctx context.Context) error {
+	if err := s.Primary.Initialize(ctx); err != nil {
+		return err
+	}
+	if err := s.Shadow.Initialize(ctx); err != nil {
+		s.log().failed("Initialize", err)
+	}
+	return nil
+}
+
+// Get returns the config of the Primary store
+func (s *ConfigStore) Get(ctx context.Context) (*chronograf.Config, error) {
+	cfg, err := s.Primary.Get(ctx)
+	if err != nil {
+		return cfg, err
+	}
+	shadow, err := s.Shadow.Get(ctx)
+	if err != nil {
+		s.log().failed("Get", err)
+		return cfg, nil
+	}
+	s.log().compare("Get", "", cfg, shadow)
+	return cfg, nil
+}
+
+// Update replaces the config in both stores
+func (s *ConfigStore) Update(ctx context.Context, cfg *chronograf.Config) error {
+	if err := s.Primary.Update(ctx, cfg); err != nil {
+		return err
+	}
+	if err := s.Shadow.Update(ctx, cfg); err != nil {
+		s.log().failed("Update", err)
+	}
+	return nil
+}
+
+// Ensure OrganizationConfigStore implements chronograf.OrganizationConfigStore.
+var _ chronograf.OrganizationConfigStore = &OrganizationConfigStore{}
+
+// OrganizationConfigStore writes organization configs to both Primary and Shadow and reads from Primary
+type OrganizationConfigStore struct {
+	Primary chronograf.OrganizationConfigStore
+	Shadow  chronograf.OrganizationConfigStore
+	Logger  chronograf.Logger
+}
+
+func (s *OrganizationConfigStore) log() logger {
+	return newLogger(s.Logger, "organizationconfig")
+}
+
+// FindOrCreate returns the config of orgID from the Primary store, creating
+// it in both stores if needed
+func (s *OrganizationConfigStore) FindOrCreate(ctx context.Context, orgID string) (*chronograf.OrganizationConfig, error) {
+	cfg, err := s.Primary.FindOrCreate(ctx, orgID)
+	if err != nil {
+		return cfg, err
+	}
+	shadow, err := s.Shadow.FindOrCreate(ctx, orgID)
+	if err != nil {
+		s.log().failed("FindOrCreate", err)
+		return cfg, nil
+	}
+	s.log().compare("FindOrCreate", orgID, cfg, shadow)
+	return cfg, nil
+}
+
+// Put replaces the organization config in both stores
+func (s *OrganizationConfigStore) Put(ctx context.Context, cfg *chronograf.OrganizationConfig) error {
+	if err := s.Primary.Put(ctx, cfg); err != nil {
+		return err
+	}
+	if err := s.Shadow.Put(ctx, cfg); err != nil {
+		s.log().failed("Put", err)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package shadow
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Ensure DashboardSnapshotsStore implements chronograf.DashboardSnapshotsStore.
+var _ chronograf.DashboardSnapshotsStore = &DashboardSnapshotsStore{}
+
+// DashboardSnapshotsStore writes dashboard snapshots to both Primary and Shadow and reads from Primary
+type DashboardSnapshotsStore struct {
+	Primary chronograf.DashboardSnapshotsStore
+	Shadow  chronograf.DashboardSnapshotsStore
+	Logger  chronograf.Logger
+}
+
+func (s *DashboardSnapshotsStore) log() logger {
+	return newLogger(s.Logger, "dashboardsnapshots")
+}
+
+// All returns the snapshots of a dashboard from the Primary store
+func (s *DashboardSnapshotsStore) All(ctx context.Context, id chronograf.DashboardID) ([]chronograf.DashboardSnapshot, error) {
+	all, err := s.Primary.All(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	shadow, err := s.Shadow.All(ctx, id)
+	if err != nil {
+		s.log().failed("All", err)
+		return all, nil
+	}
+
+	p, sh := map[string]interface{}{}, map[string]interface{}{}
+	for _, snap := range all {
+		p[strconv.Itoa(snap.ID)] = snap
+	}
+	for _, snap := range shadow {
+		sh[strconv.Itoa(snap.ID)] = snap
+	}
+	s.log().compareAll(p, sh)
+	return all, nil
+}
+
+// Add stores snap in the Primary store and then in the Shadow store. The
+// Shadow store numbers its snapshots itself, so they match the Primary store
+// as long as both stored the same snapshots.
+func (s *DashboardSnapshotsStore) Add(ctx context.Context, snap *chronograf.DashboardSnapshot) (*chronograf.DashboardSnapshot, error) {
+	added, err := s.Primary.Add(ctx, snap)
+	if err != nil {
+		return added, err
+	}
+	snapshot := *added
+	if _, err := s.Shadow.Add(ctx, &snapshot); err != nil {
+		s.log().failed("Add", err)
+	}
+	return added, nil
+}
+
+// Delete removes a snapshot of a dashboard from both stores
+func (s *DashboardSnapshotsStore) Delete(ctx context.Context, snap *chronograf.DashboardSnapshot) error {
+	if err := s.Primary.Delete(ctx, snap); err != nil {
+		return err
+	}
+	if err := s.Shadow.Delete(ctx, snap); err != nil {
+		s.log().failed("Delete", err)
+	}
+	return nil
+}
+
+// DeleteAll removes the snapshots of a dashboard from both stores
+func (s *DashboardSnapshotsStore) DeleteAll(ctx context.Context, id chronograf.DashboardID) error {
+	if err := s.Primary.DeleteAll(ctx, id); err != nil {
+		return err
+	}
+	if err := s.Shadow.DeleteAll(ctx, id); err != nil {
+		s.log().failed("DeleteAll", err)
+	}
+	return nil
+}
+
+// Get returns a snapshot of a dashboard from the Primary store
+func (s *DashboardSnapshotsStore) Get(ctx context.Context, id chronograf.DashboardID, snapshot int) (*chronograf.DashboardSnapshot, error) {
+	snap, err := s.Primary.Get(ctx, id, snapshot)
+	if err != nil {
+		return snap, err
+	}
+	shadow, err := s.Shadow.Get(ctx, id, snapshot)
+	if err != nil {
+		s.log().failed("Get", err)
+		return snap, nil
+	}
+	s.log().compare("Get", snap.ID, snap, shadow)
+	return snap, nil
+}
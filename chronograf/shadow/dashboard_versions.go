@@ -0,0 +1,87 @@
+package shadow
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Ensure DashboardVersionsStore implements chronograf.DashboardVersionsStore.
+var _ chronograf.DashboardVersionsStore = &DashboardVersionsStore{}
+
+// DashboardVersionsStore writes dashboard revisions to both Primary and Shadow and reads from Primary
+type DashboardVersionsStore struct {
+	Primary chronograf.DashboardVersionsStore
+	Shadow  chronograf.DashboardVersionsStore
+	Logger  chronograf.Logger
+}
+
+func (s *DashboardVersionsStore) log() logger {
+	return newLogger(s.Logger, "dashboardversions")
+}
+
+// All returns the revisions of a dashboard from the Primary store
+func (s *DashboardVersionsStore) All(ctx context.Context, id chronograf.DashboardID) ([]chronograf.DashboardVersion, error) {
+	all, err := s.Primary.All(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	shadow, err := s.Shadow.All(ctx, id)
+	if err != nil {
+		s.log().failed("All", err)
+		return all, nil
+	}
+
+	p, sh := map[string]interface{}{}, map[string]interface{}{}
+	for _, v := range all {
+		p[strconv.Itoa(v.Revision)] = v
+	}
+	for _, v := range shadow {
+		sh[strconv.Itoa(v.Revision)] = v
+	}
+	s.log().compareAll(p, sh)
+	return all, nil
+}
+
+// Add records v in the Primary store and then in the Shadow store. The
+// Shadow store numbers its revisions itself, so they match the Primary store
+// as long as both recorded the same revisions.
+func (s *DashboardVersionsStore) Add(ctx context.Context, v *chronograf.DashboardVersion) (*chronograf.DashboardVersion, error) {
+	added, err := s.Primary.Add(ctx, v)
+	if err != nil {
+		return added, err
+	}
+	version := *added
+	if _, err := s.Shadow.Add(ctx, &version); err != nil {
+		s.log().failed("Add", err)
+	}
+	return added, nil
+}
+
+// Delete removes the revisions of a dashboard from both stores
+func (s *DashboardVersionsStore) Delete(ctx context.Context, id chronograf.DashboardID) error {
+	if err := s.Primary.Delete(ctx, id); err != nil {
+		return err
+	}
+	if err := s.Shadow.Delete(ctx, id); err != nil {
+		s.log().failed("Delete", err)
+	}
+	return nil
+}
+
+// Get returns a revision of a dashboard from the Primary store
+func (s *DashboardVersionsStore) Get(ctx context.Context, id chronograf.DashboardID, revision int) (*chronograf.DashboardVersion, error) {
+	v, err := s.Primary.Get(ctx, id, revision)
+	if err != nil {
+		return v, err
+	}
+	shadow, err := s.Shadow.Get(ctx, id, revision)
+	if err != nil {
+		s.log().failed("Get", err)
+		return v, nil
+	}
+	s.log().compare("Get", v.Revision, v, shadow)
+	return v, nil
+}
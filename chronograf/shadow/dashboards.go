@@ -0,0 +1,96 @@
+package shadow
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Ensure DashboardsStore implements chronograf.DashboardsStore.
+var _ chronograf.DashboardsStore = &DashboardsStore{}
+
+// DashboardsStore writes dashboards to both Primary and Shadow and reads from Primary
+type DashboardsStore struct {
+	Primary chronograf.DashboardsStore
+	Shadow  chronograf.DashboardsStore
+	Logger  chronograf.Logger
+}
+
+func (s *DashboardsStore) log() logger {
+	return newLogger(s.Logger, "dashboards")
+}
+
+// All returns all dashboards of the Primary store
+func (s *DashboardsStore) All(ctx context.Context) ([]chronograf.Dashboard, error) {
+	all, err := s.Primary.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	shadow, err := s.Shadow.All(ctx)
+	if err != nil {
+		s.log().failed("All", err)
+		return all, nil
+	}
+
+	p, sh := map[string]interface{}{}, map[string]interface{}{}
+	for _, d := range all {
+		p[strconv.Itoa(int(d.ID))] = d
+	}
+	for _, d := range shadow {
+		sh[strconv.Itoa(int(d.ID))] = d
+	}
+	s.log().compareAll(p, sh)
+	return all, nil
+}
+
+// Add creates d in the Primary store and then in the Shadow store using the
+// ID assigned by the Primary store
+func (s *DashboardsStore) Add(ctx context.Context, d chronograf.Dashboard) (chronograf.Dashboard, error) {
+	added, err := s.Primary.Add(ctx, d)
+	if err != nil {
+		return added, err
+	}
+	if _, err := s.Shadow.Add(ctx, added); err != nil {
+		s.log().failed("Add", err)
+	}
+	return added, nil
+}
+
+// Delete removes d from both stores
+func (s *DashboardsStore) Delete(ctx context.Context, d chronograf.Dashboard) error {
+	if err := s.Primary.Delete(ctx, d); err != nil {
+		return err
+	}
+	if err := s.Shadow.Delete(ctx, d); err != nil {
+		s.log().failed("Delete", err)
+	}
+	return nil
+}
+
+// Get returns the dashboard with id from the Primary store
+func (s *DashboardsStore) Get(ctx context.Context, id chronograf.DashboardID) (chronograf.Dashboard, error) {
+	d, err := s.Primary.Get(ctx, id)
+	if err != nil {
+		return d, err
+	}
+	shadow, err := s.Shadow.Get(ctx, id)
+	if err != nil {
+		s.log().failed("Get", err)
+		return d, nil
+	}
+	s.log().compare("Get", id, d, shadow)
+	return d, nil
+}
+
+// Update replaces d in both stores
+func (s *DashboardsStore) Update(ctx context.Context, d chronograf.Dashboard) error {
+	if err := s.Primary.Update(ctx, d); err != nil {
+		return err
+	}
+	if err := s.Shadow.Update(ctx, d); err != nil {
+		s.log().failed("Update", err)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package shadow
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Ensure FoldersStore implements chronograf.FoldersStore.
+var _ chronograf.FoldersStore = &FoldersStore{}
+
+// FoldersStore writes folders to both Primary and Shadow and reads from Primary
+type FoldersStore struct {
+	Primary chronograf.FoldersStore
+	Shadow  chronograf.FoldersStore
+	Logger  chronograf.Logger
+}
+
+func (s *FoldersStore) log() logger {
+	return newLogger(s.Logger, "folders")
+}
+
+// All returns the folders from the Primary store
+func (s *FoldersStore) All(ctx context.Context) ([]chronograf.Folder, error) {
+	all, err := s.Primary.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	shadow, err := s.Shadow.All(ctx)
+	if err != nil {
+		s.log().failed("All", err)
+		return all, nil
+	}
+
+	p, sh := map[string]interface{}{}, map[string]interface{}{}
+	for _, f := range all {
+		p[f.ID] = f
+	}
+	for _, f := range shadow {
+		sh[f.ID] = f
+	}
+	s.log().compareAll(p, sh)
+	return all, nil
+}
+
+// Add creates f in the Primary store and then in the Shadow store using the
+// ID assigned by the Primary store
+func (s *FoldersStore) Add(ctx context.Context, f *chronograf.Folder) (*chronograf.Folder, error) {
+	added, err := s.Primary.Add(ctx, f)
+	if err != nil {
+		return added, err
+	}
+	folder := *added
+	if _, err := s.Shadow.Add(ctx, &folder); err != nil {
+		s.log().failed("Add", err)
+	}
+	return added, nil
+}
+
+// Delete removes f from both stores
+func (s *FoldersStore) Delete(ctx context.Context, f *chronograf.Folder) error {
+	if err := s.Primary.Delete(ctx, f); err != nil {
+		return err
+	}
+	if err := s.Shadow.Delete(ctx, f); err != nil {
+		s.log().failed("Delete", err)
+	}
+	return nil
+}
+
+// Get returns the folder with id from the Primary store
+func (s *FoldersStore) Get(ctx context.Context, id string) (*chronograf.Folder, error) {
+	f, err := s.Primary.Get(ctx, id)
+	if err != nil {
+		return f, err
+	}
+	shadow, err := s.Shadow.Get(ctx, id)
+	if err != nil {
+		s.log().failed("Get", err)
+		return f, nil
+	}
+	s.log().compare("Get", f.ID, f, shadow)
+	return f, nil
+}
+
+// Update replaces f in both stores
+func (s *FoldersStore) Update(ctx context.Context, f *chronograf.Folder) error {
+	if err := s.Primary.Update(ctx, f); err != nil {
+		return err
+	}
+	if err := s.Shadow.Update(ctx, f); err != nil {
+		s.log().failed("Update", err)
+	}
+	return nil
+}
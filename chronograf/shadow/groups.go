@@ -0,0 +1,96 @@
+package shadow
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Ensure GroupsStore implements chronograf.GroupsStore.
+var _ chronograf.GroupsStore = &GroupsStore{}
+
+// GroupsStore writes groups to both Primary and Shadow and reads from Primary
+type GroupsStore struct {
+	Primary chronograf.GroupsStore
+	Shadow  chronograf.GroupsStore
+	Logger  chronograf.Logger
+}
+
+func (s *GroupsStore) log() logger {
+	return newLogger(s.Logger, "groups")
+}
+
+// All returns all groups of the Primary store
+func (s *GroupsStore) All(ctx context.Context) ([]chronograf.Group, error) {
+	all, err := s.Primary.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	shadow, err := s.Shadow.All(ctx)
+	if err != nil {
+		s.log().failed("All", err)
+		return all, nil
+	}
+
+	p, sh := map[string]interface{}{}, map[string]interface{}{}
+	for _, g := range all {
+		p[g.ID] = g
+	}
+	for _, g := range shadow {
+		sh[g.ID] = g
+	}
+	s.log().compareAll(p, sh)
+	return all, nil
+}
+
+// Add creates g in the Primary store and then in the Shadow store using the
+// ID assigned by the Primary store
+func (s *GroupsStore) Add(ctx context.Context, g *chronograf.Group) (*chronograf.Group, error) {
+	added, err := s.Primary.Add(ctx, g)
+	if err != nil {
+		return added, err
+	}
+	group := *added
+	if _, err := s.Shadow.Add(ctx, &group); err != nil {
+		s.log().failed("Add", err)
+	}
+	return added, nil
+}
+
+// Delete removes g from both stores
+func (s *GroupsStore) Delete(ctx context.Context, g *chronograf.Group) error {
+	if err := s.Primary.Delete(ctx, g); err != nil {
+		return err
+	}
+	if err := s.Shadow.Delete(ctx, g); err != nil {
+		s.log().failed("Delete", err)
+	}
+	return nil
+}
+
+// Get returns the group with id from the Primary store
+func (s *GroupsStore) Get(ctx context.Context, id string) (*chronograf.Group, error) {
+	g, err := s.Primary.Get(ctx, id)
+	if err != nil {
+		return g, err
+	}
+	shadow, err := s.Shadow.Get(ctx, id)
+	if err != nil {
+		s.log().failed("Get", err)
+		return g, nil
+	}
+	s.log().compare("Get", g.ID, g, shadow)
+	return g, nil
+}
+
+// Update replaces g in both stores
+func (s *GroupsStore) Update(ctx context.Context, g *chronograf.Group) error {
+	if err := s.Primary.Update(ctx, g); err != nil {
+		return err
+	}
+	if err := s.Shadow.Update(ctx, g); err != nil {
+		s.log().failed("Update", err)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package shadow
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Ensure InvitationsStore implements chronograf.InvitationsStore.
+var _ chronograf.InvitationsStore = &InvitationsStore{}
+
+// InvitationsStore writes invitations to both Primary and Shadow and reads from Primary
+type InvitationsStore struct {
+	Primary chronograf.InvitationsStore
+	Shadow  chronograf.InvitationsStore
+	Logger  chronograf.Logger
+}
+
+func (s *InvitationsStore) log() logger {
+	return newLogger(s.Logger, "invitations")
+}
+
+// All returns the invitations from the Primary store
+func (s *InvitationsStore) All(ctx context.Context) ([]chronograf.Invitation, error) {
+	all, err := s.Primary.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	shadow, err := s.Shadow.All(ctx)
+	if err != nil {
+		s.log().failed("All", err)
+		return all, nil
+	}
+
+	p, sh := map[string]interface{}{}, map[string]interface{}{}
+	for _, i := range all {
+		p[i.ID] = i
+	}
+	for _, i := range shadow {
+		sh[i.ID] = i
+	}
+	s.log().compareAll(p, sh)
+	return all, nil
+}
+
+// Add creates i in the Primary store and then in the Shadow store using the
+// ID assigned by the Primary store
+func (s *InvitationsStore) Add(ctx context.Context, i *chronograf.Invitation) (*chronograf.Invitation, error) {
+	added, err := s.Primary.Add(ctx, i)
+	if err != nil {
+		return added, err
+	}
+	invitation := *added
+	if _, err := s.Shadow.Add(ctx, &invitation); err != nil {
+		s.log().failed("Add", err)
+	}
+	return added, nil
+}
+
+// Delete removes i from both stores
+func (s *InvitationsStore) Delete(ctx context.Context, i *chronograf.Invitation) error {
+	if err := s.Primary.Delete(ctx, i); err != nil {
+		return err
+	}
+	if err := s.Shadow.Delete(ctx, i); err != nil {
+		s.log().failed("Delete", err)
+	}
+	return nil
+}
+
+// Get returns the invitation with id from the Primary store
+func (s *InvitationsStore) Get(ctx context.Context, id string) (*chronograf.Invitation, error) {
+	i, err := s.Primary.Get(ctx, id)
+	if err != nil {
+		return i, err
+	}
+	shadow, err := s.Shadow.Get(ctx, id)
+	if err != nil {
+		s.log().failed("Get", err)
+		return i, nil
+	}
+	s.log().compare("Get", i.ID, i, shadow)
+	return i, nil
+}
@@ -0,0 +1,96 @@
+package shadow
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Ensure MappingsStore implements chronograf.MappingsStore.
+var _ chronograf.MappingsStore = &MappingsStore{}
+
+// MappingsStore writes mappings to both Primary and Shadow and reads from Primary
+type MappingsStore struct {
+	Primary chronograf.MappingsStore
+	Shadow  chronograf.MappingsStore
+	Logger  chronograf.Logger
+}
+
+func (s *MappingsStore) log() logger {
+	return newLogger(s.Logger, "mappings")
+}
+
+// All returns all mappings of the Primary store
+func (s *MappingsStore) All(ctx context.Context) ([]chronograf.Mapping, error) {
+	all, err := s.Primary.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	shadow, err := s.Shadow.All(ctx)
+	if err != nil {
+		s.log().failed("All", err)
+		return all, nil
+	}
+
+	p, sh := map[string]interface{}{}, map[string]interface{}{}
+	for _, m := range all {
+		p[m.ID] = m
+	}
+	for _, m := range shadow {
+		sh[m.ID] = m
+	}
+	s.log().compareAll(p, sh)
+	return all, nil
+}
+
+// Add creates m in the Primary store and then in the Shadow store using the
+// ID assigned by the Primary store
+func (s *MappingsStore) Add(ctx context.Context, m *chronograf.Mapping) (*chronograf.Mapping, error) {
+	added, err := s.Primary.Add(ctx, m)
+	if err != nil {
+		return added, err
+	}
+	mapping := *added
+	if _, err := s.Shadow.Add(ctx, &mapping); err != nil {
+		s.log().failed("Add", err)
+	}
+	return added, nil
+}
+
+// Delete removes m from both stores
+func (s *MappingsStore) Delete(ctx context.Context, m *chronograf.Mapping) error {
+	if err := s.Primary.Delete(ctx, m); err != nil {
+		return err
+	}
+	if err := s.Shadow.Delete(ctx, m); err != nil {
+		s.log().failed("Delete", err)
+	}
+	return nil
+}
+
+// Get returns the mapping with id from the Primary store
+func (s *MappingsStore) Get(ctx context.Context, id string) (*chronograf.Mapping, error) {
+	m, err := s.Primary.Get(ctx, id)
+	if err != nil {
+		return m, err
+	}
+	shadow, err := s.Shadow.Get(ctx, id)
+	if err != nil {
+		s.log().failed("Get", err)
+		return m, nil
+	}
+	s.log().compare("Get", m.ID, m, shadow)
+	return m, nil
+}
+
+// Update replaces m in both stores
+func (s *MappingsStore) Update(ctx context.Context, m *chronograf.Mapping) error {
+	if err := s.Primary.Update(ctx, m); err != nil {
+		return err
+	}
+	if err := s.Shadow.Update(ctx, m); err != nil {
+		s.log().failed("Update", err)
+	}
+	return nil
+}
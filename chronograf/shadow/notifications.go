@@ -0,0 +1,96 @@
+package shadow
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Ensure NotificationsStore implements chronograf.NotificationsStore.
+var _ chronograf.NotificationsStore = &NotificationsStore{}
+
+// NotificationsStore writes notifications to both Primary and Shadow and reads from Primary
+type NotificationsStore struct {
+	Primary chronograf.NotificationsStore
+	Shadow  chronograf.NotificationsStore
+	Logger  chronograf.Logger
+}
+
+func (s *NotificationsStore) log() logger {
+	return newLogger(s.Logger, "notifications")
+}
+
+// All returns the notifications of userID from the Primary store
+func (s *NotificationsStore) All(ctx context.Context, userID uint64) ([]chronograf.Notification, error) {
+	all, err := s.Primary.All(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	shadow, err := s.Shadow.All(ctx, userID)
+	if err != nil {
+		s.log().failed("All", err)
+		return all, nil
+	}
+
+	p, sh := map[string]interface{}{}, map[string]interface{}{}
+	for _, n := range all {
+		p[n.ID] = n
+	}
+	for _, n := range shadow {
+		sh[n.ID] = n
+	}
+	s.log().compareAll(p, sh)
+	return all, nil
+}
+
+// Add creates n in the Primary store and then in the Shadow store using the
+// ID assigned by the Primary store
+func (s *NotificationsStore) Add(ctx context.Context, n *chronograf.Notification) (*chronograf.Notification, error) {
+	added, err := s.Primary.Add(ctx, n)
+	if err != nil {
+		return added, err
+	}
+	notification := *added
+	if _, err := s.Shadow.Add(ctx, &notification); err != nil {
+		s.log().failed("Add", err)
+	}
+	return added, nil
+}
+
+// Delete removes n from both stores
+func (s *NotificationsStore) Delete(ctx context.Context, n *chronograf.Notification) error {
+	if err := s.Primary.Delete(ctx, n); err != nil {
+		return err
+	}
+	if err := s.Shadow.Delete(ctx, n); err != nil {
+		s.log().failed("Delete", err)
+	}
+	return nil
+}
+
+// Get returns the notification with id from the Primary store
+func (s *NotificationsStore) Get(ctx context.Context, id string) (*chronograf.Notification, error) {
+	n, err := s.Primary.Get(ctx, id)
+	if err != nil {
+		return n, err
+	}
+	shadow, err := s.Shadow.Get(ctx, id)
+	if err != nil {
+		s.log().failed("Get", err)
+		return n, nil
+	}
+	s.log().compare("Get", n.ID, n, shadow)
+	return n, nil
+}
+
+// Update replaces n in both stores
+func (s *NotificationsStore) Update(ctx context.Context, n *chronograf.Notification) error {
+	if err := s.Primary.Update(ctx, n); err != nil {
+		return err
+	}
+	if err := s.Shadow.Update(ctx, n); err != nil {
+		s.log().failed("Update", err)
+	}
+	return nil
+}
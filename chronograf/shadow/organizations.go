@@ -0,0 +1,122 @@
+package shadow
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Ensure OrganizationsStore implements chronograf.OrganizationsStore.
+var _ chronograf.OrganizationsStore = &OrganizationsStore{}
+
+// OrganizationsStore writes organizations to both Primary and Shadow and reads from Primary
+type OrganizationsStore struct {
+	Primary chronograf.OrganizationsStore
+	Shadow  chronograf.OrganizationsStore
+	Logger  chronograf.Logger
+}
+
+func (s *OrganizationsStore) log() logger {
+	return newLogger(s.Logger, "organizations")
+}
+
+// All returns all organizations of the Primary store
+func (s *OrganizationsStore) All(ctx context.Context) ([]chronograf.Organization, error) {
+	all, err := s.Primary.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	shadow, err := s.Shadow.All(ctx)
+	if err != nil {
+		s.log().failed("All", err)
+		return all, nil
+	}
+
+	p, sh := map[string]interface{}{}, map[string]interface{}{}
+	for _, o := range all {
+		p[o.ID] = o
+	}
+	for _, o := range shadow {
+		sh[o.ID] = o
+	}
+	s.log().compareAll(p, sh)
+	return all, nil
+}
+
+// Add creates o in the Primary store and then in the Shadow store using the
+// ID assigned by the Primary store
+func (s *OrganizationsStore) Add(ctx context.Context, o *chronograf.Organization) (*chronograf.Organization, error) {
+	added, err := s.Primary.Add(ctx, o)
+	if err != nil {
+		return added, err
+	}
+	org := *added
+	if _, err := s.Shadow.Add(ctx, &org); err != nil {
+		s.log().failed("Add", err)
+	}
+	return added, nil
+}
+
+// Delete removes o from both stores
+func (s *OrganizationsStore) Delete(ctx context.Context, o *chronograf.Organization) error {
+	if err := s.Primary.Delete(ctx, o); err != nil {
+		return err
+	}
+	if err := s.Shadow.Delete(ctx, o); err != nil {
+		s.log().failed("Delete", err)
+	}
+	return nil
+}
+
+// Get returns the organization matching q from the Primary store
+func (s *OrganizationsStore) Get(ctx context.Context, q chronograf.OrganizationQuery) (*chronograf.Organization, error) {
+	o, err := s.Primary.Get(ctx, q)
+	if err != nil {
+		return o, err
+	}
+	shadow, err := s.Shadow.Get(ctx, q)
+	if err != nil {
+		s.log().failed("Get", err)
+		return o, nil
+	}
+	s.log().compare("Get", o.ID, o, shadow)
+	return o, nil
+}
+
+// Update replaces o in both stores
+func (s *OrganizationsStore) Update(ctx context.Context, o *chronograf.Organization) error {
+	if err := s.Primary.Update(ctx, o); err != nil {
+		return err
+	}
+	if err := s.Shadow.Update(ctx, o); err != nil {
+		s.log().failed("Update", err)
+	}
+	return nil
+}
+
+// CreateDefault creates the default organization in both stores
+func (s *OrganizationsStore) CreateDefault(ctx context.Context) error {
+	if err := s.Primary.CreateDefault(ctx); err != nil {
+		return err
+	}
+	if err := s.Shadow.CreateDefault(ctx); err != nil {
+		s.log().failed("CreateDefault", err)
+	}
+	return nil
+}
+
+// DefaultOrganization returns the default organization of the Primary store
+func (s *OrganizationsStore) DefaultOrganization(ctx context.Context) (*chronograf.Organization, error) {
+	o, err := s.Primary.DefaultOrganization(ctx)
+	if err != nil {
+		return o, err
+	}
+	shadow, err := s.Shadow.DefaultOrganization(ctx)
+	if err != nil {
+		s.log().failed("DefaultOrganization", err)
+		return o, nil
+	}
+	s.log().compare("DefaultOrganization", o.ID, o, shadow)
+	return o, nil
+}
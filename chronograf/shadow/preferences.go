@@ -0,0 +1,47 @@
+package shadow
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Ensure PreferencesStore implements chronograf.PreferencesStore.
+var _ chronograf.PreferencesStore = &PreferencesStore{}
+
+// PreferencesStore writes the preferences of users to both Primary and Shadow and reads from Primary
+type PreferencesStore struct {
+	Primary chronograf.PreferencesStore
+	Shadow  chronograf.PreferencesStore
+	Logger  chronograf.Logger
+}
+
+func (s *PreferencesStore) log() logger {
+	return newLogger(s.Logger, "preferences")
+}
+
+// Get returns the preferences of userID from the Primary store
+func (s *PreferencesStore) Get(ctx context.Context, userID uint64) (*chronograf.Preferences, error) {
+	p, err := s.Primary.Get(ctx, userID)
+	if err != nil {
+		return p, err
+	}
+	shadow, err := s.Shadow.Get(ctx, userID)
+	if err != nil {
+		s.log().failed("Get", err)
+		return p, nil
+	}
+	s.log().compare("Get", userID, p, shadow)
+	return p, nil
+}
+
+// Put replaces the preferences in both stores
+func (s *PreferencesStore) Put(ctx context.Context, p *chronograf.Preferences) error {
+	if err := s.Primary.Put(ctx, p); err != nil {
+		return err
+	}
+	if err := s.Shadow.Put(ctx, p); err != nil {
+		s.log().failed("Put", err)
+	}
+	return nil
+}
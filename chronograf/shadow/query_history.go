@@ -0,0 +1,70 @@
+package shadow
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Ensure QueryHistoryStore implements chronograf.QueryHistoryStore.
+var _ chronograf.QueryHistoryStore = &QueryHistoryStore{}
+
+// QueryHistoryStore writes queries to both Primary and Shadow and reads from Primary
+type QueryHistoryStore struct {
+	Primary chronograf.QueryHistoryStore
+	Shadow  chronograf.QueryHistoryStore
+	Logger  chronograf.Logger
+}
+
+func (s *QueryHistoryStore) log() logger {
+	return newLogger(s.Logger, "queryhistory")
+}
+
+// All returns the queries of a user from the Primary store. Queries have no
+// ID of their own, so they are compared by position.
+func (s *QueryHistoryStore) All(ctx context.Context, userID uint64) ([]chronograf.QueryHistoryEntry, error) {
+	all, err := s.Primary.All(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	shadow, err := s.Shadow.All(ctx, userID)
+	if err != nil {
+		s.log().failed("All", err)
+		return all, nil
+	}
+
+	p, sh := map[string]interface{}{}, map[string]interface{}{}
+	for i, q := range all {
+		p[strconv.Itoa(i)] = q
+	}
+	for i, q := range shadow {
+		sh[strconv.Itoa(i)] = q
+	}
+	s.log().compareAll(p, sh)
+	return all, nil
+}
+
+// Add records q in the Primary store and then in the Shadow store
+func (s *QueryHistoryStore) Add(ctx context.Context, q *chronograf.QueryHistoryEntry) error {
+	if err := s.Primary.Add(ctx, q); err != nil {
+		return err
+	}
+	entry := *q
+	if err := s.Shadow.Add(ctx, &entry); err != nil {
+		s.log().failed("Add", err)
+	}
+	return nil
+}
+
+// Delete removes the queries of a user from both stores
+func (s *QueryHistoryStore) Delete(ctx context.Context, userID uint64) error {
+	if err := s.Primary.Delete(ctx, userID); err != nil {
+		return err
+	}
+	if err := s.Shadow.Delete(ctx, userID); err != nil {
+		s.log().failed("Delete", err)
+	}
+	return nil
+}
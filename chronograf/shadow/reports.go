@@ -0,0 +1,96 @@
+package shadow
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Ensure ReportsStore implements chronograf.ReportsStore.
+var _ chronograf.ReportsStore = &ReportsStore{}
+
+// ReportsStore writes reports to both Primary and Shadow and reads from Primary
+type ReportsStore struct {
+	Primary chronograf.ReportsStore
+	Shadow  chronograf.ReportsStore
+	Logger  chronograf.Logger
+}
+
+func (s *ReportsStore) log() logger {
+	return newLogger(s.Logger, "reports")
+}
+
+// All returns the reports from the Primary store
+func (s *ReportsStore) All(ctx context.Context) ([]chronograf.Report, error) {
+	all, err := s.Primary.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	shadow, err := s.Shadow.All(ctx)
+	if err != nil {
+		s.log().failed("All", err)
+		return all, nil
+	}
+
+	p, sh := map[string]interface{}{}, map[string]interface{}{}
+	for _, r := range all {
+		p[r.ID] = r
+	}
+	for _, r := range shadow {
+		sh[r.ID] = r
+	}
+	s.log().compareAll(p, sh)
+	return all, nil
+}
+
+// Add creates r in the Primary store and then in the Shadow store using the
+// ID assigned by the Primary store
+func (s *ReportsStore) Add(ctx context.Context, r *chronograf.Report) (*chronograf.Report, error) {
+	added, err := s.Primary.Add(ctx, r)
+	if err != nil {
+		return added, err
+	}
+	report := *added
+	if _, err := s.Shadow.Add(ctx, &report); err != nil {
+		s.log().failed("Add", err)
+	}
+	return added, nil
+}
+
+// Delete removes r from both stores
+func (s *ReportsStore) Delete(ctx context.Context, r *chronograf.Report) error {
+	if err := s.Primary.Delete(ctx, r); err != nil {
+		return err
+	}
+	if err := s.Shadow.Delete(ctx, r); err != nil {
+		s.log().failed("Delete", err)
+	}
+	return nil
+}
+
+// Get returns the report with id from the Primary store
+func (s *ReportsStore) Get(ctx context.Context, id string) (*chronograf.Report, error) {
+	r, err := s.Primary.Get(ctx, id)
+	if err != nil {
+		return r, err
+	}
+	shadow, err := s.Shadow.Get(ctx, id)
+	if err != nil {
+		s.log().failed("Get", err)
+		return r, nil
+	}
+	s.log().compare("Get", r.ID, r, shadow)
+	return r, nil
+}
+
+// Update replaces r in both stores
+func (s *ReportsStore) Update(ctx context.Context, r *chronograf.Report) error {
+	if err := s.Primary.Update(ctx, r); err != nil {
+		return err
+	}
+	if err := s.Shadow.Update(ctx, r); err != nil {
+		s.log().failed("Update", err)
+	}
+	return nil
+}
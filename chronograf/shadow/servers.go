@@ -0,0 +1,96 @@
+package shadow
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Ensure ServersStore implements chronograf.ServersStore.
+var _ chronograf.ServersStore = &ServersStore{}
+
+// ServersStore writes servers to both Primary and Shadow and reads from Primary
+type ServersStore struct {
+	Primary chronograf.ServersStore
+	Shadow  chronograf.ServersStore
+	Logger  chronograf.Logger
+}
+
+func (s *ServersStore) log() logger {
+	return newLogger(s.Logger, "servers")
+}
+
+// All returns all servers of the Primary store
+func (s *ServersStore) All(ctx context.Context) ([]chronograf.Server, error) {
+	all, err := s.Primary.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	shadow, err := s.Shadow.All(ctx)
+	if err != nil {
+		s.log().failed("All", err)
+		return all, nil
+	}
+
+	p, sh := map[string]interface{}{}, map[string]interface{}{}
+	for _, srv := range all {
+		p[strconv.Itoa(srv.ID)] = srv
+	}
+	for _, srv := range shadow {
+		sh[strconv.Itoa(srv.ID)] = srv
+	}
+	s.log().compareAll(p, sh)
+	return all, nil
+}
+
+// Add creates srv in the Primary store and then in the Shadow store using the
+// ID assigned by the Primary store
+func (s *ServersStore) Add(ctx context.Context, srv chronograf.Server) (chronograf.Server, error) {
+	added, err := s.Primary.Add(ctx, srv)
+	if err != nil {
+		return added, err
+	}
+	if _, err := s.Shadow.Add(ctx, added); err != nil {
+		s.log().failed("Add", err)
+	}
+	return added, nil
+}
+
+// Delete removes srv from both stores
+func (s *ServersStore) Delete(ctx context.Context, srv chronograf.Server) error {
+	if err := s.Primary.Delete(ctx, srv); err != nil {
+		return err
+	}
+	if err := s.Shadow.Delete(ctx, srv); err != nil {
+		s.log().failed("Delete", err)
+	}
+	return nil
+}
+
+// Get returns the server with ID from the Primary store
+func (s *ServersStore) Get(ctx context.Context, ID int) (chronograf.Server, error) {
+	srv, err := s.Primary.Get(ctx, ID)
+	if err != nil {
+		return srv, err
+	}
+	shadow, err := s.Shadow.Get(ctx, ID)
+	if err != nil {
+		s.log().failed("Get", err)
+		return srv, nil
+	}
+	s.log().compare("Get", ID, srv, shadow)
+	return srv, nil
+}
+
+// Update replaces srv in both stores
+func (s *ServersStore) Update(ctx context.Context, srv chronograf.Server) error {
+	if err := s.Primary.Update(ctx, srv); err != nil {
+		return err
+	}
+	if err := s.Shadow.Update(ctx, srv); err != nil {
+		s.log().failed("Update", err)
+	}
+	return nil
+}
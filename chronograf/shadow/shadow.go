@@ -0,0 +1,86 @@
+// Package shadow provides stores used to validate a switch between store
+// backends. Every write is applied to both the primary and the shadow store
+// while reads are served by the primary. Each read is repeated against the
+// shadow store and any divergence between the two is logged.
+//
+// Resources are added to the shadow store with the ID assigned by the
+// primary store, so shadow stores must retain the IDs of added resources.
+// Failures of the shadow store are logged and never returned to callers.
+package shadow
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+type logger struct {
+	chronograf.Logger
+	store string
+}
+
+func newLogger(l chronograf.Logger, store string) logger {
+	if l == nil {
+		l = &chronograf.NoopLogger{}
+	}
+	return logger{
+		Logger: l,
+		store:  store,
+	}
+}
+
+func (l logger) with(op string) chronograf.Logger {
+	return l.
+		WithField("component", "shadow").
+		WithField("store", l.store).
+		WithField("op", op)
+}
+
+// failed logs an error of the shadow store
+func (l logger) failed(op string, err error) {
+	l.with(op).Error("Shadow store failed: ", err)
+}
+
+// compare logs a divergence of a single resource. Only the ID of the resource
+// is logged as resources may contain credentials.
+func (l logger) compare(op string, id interface{}, primary, shadow interface{}) {
+	if !reflect.DeepEqual(primary, shadow) {
+		l.with(op).
+			WithField("id", id).
+			Error("Shadow store diverged from primary store")
+	}
+}
+
+// compareAll logs the IDs of resources missing from, unexpected in, or
+// different within the shadow store.
+func (l logger) compareAll(primary, shadow map[string]interface{}) {
+	var missing, extra, different []string
+	for id, p := range primary {
+		s, ok := shadow[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		if !reflect.DeepEqual(p, s) {
+			different = append(different, id)
+		}
+	}
+	for id := range shadow {
+		if _, ok := primary[id]; !ok {
+			extra = append(extra, id)
+		}
+	}
+	if len(missing)+len(extra)+len(different) == 0 {
+		return
+	}
+
+	sort.Strings(missing)
+	sort.Strings(extra)
+	sort.Strings(different)
+	l.with("All").
+		WithField("missing", missing).
+		WithField("extra", extra).
+		WithField("different", different).
+		Error("Shadow store diverged from primary store")
+}
@@ -0,0 +1,70 @@
+package shadow
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Ensure SourceHealthStore implements chronograf.SourceHealthStore.
+var _ chronograf.SourceHealthStore = &SourceHealthStore{}
+
+// SourceHealthStore writes health checks to both Primary and Shadow and reads from Primary
+type SourceHealthStore struct {
+	Primary chronograf.SourceHealthStore
+	Shadow  chronograf.SourceHealthStore
+	Logger  chronograf.Logger
+}
+
+func (s *SourceHealthStore) log() logger {
+	return newLogger(s.Logger, "sourcehealth")
+}
+
+// All returns the health checks of a source from the Primary store. Checks
+// have no ID of their own, so they are compared by position.
+func (s *SourceHealthStore) All(ctx context.Context, sourceID int) ([]chronograf.SourceHealth, error) {
+	all, err := s.Primary.All(ctx, sourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	shadow, err := s.Shadow.All(ctx, sourceID)
+	if err != nil {
+		s.log().failed("All", err)
+		return all, nil
+	}
+
+	p, sh := map[string]interface{}{}, map[string]interface{}{}
+	for i, h := range all {
+		p[strconv.Itoa(i)] = h
+	}
+	for i, h := range shadow {
+		sh[strconv.Itoa(i)] = h
+	}
+	s.log().compareAll(p, sh)
+	return all, nil
+}
+
+// Add records h in the Primary store and then in the Shadow store
+func (s *SourceHealthStore) Add(ctx context.Context, h *chronograf.SourceHealth) error {
+	if err := s.Primary.Add(ctx, h); err != nil {
+		return err
+	}
+	check := *h
+	if err := s.Shadow.Add(ctx, &check); err != nil {
+		s.log().failed("Add", err)
+	}
+	return nil
+}
+
+// Delete removes the health checks of a source from both stores
+func (s *SourceHealthStore) Delete(ctx context.Context, sourceID int) error {
+	if err := s.Primary.Delete(ctx, sourceID); err != nil {
+		return err
+	}
+	if err := s.Shadow.Delete(ctx, sourceID); err != nil {
+		s.log().failed("Delete", err)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package shadow
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Ensure SourcesStore implements chronograf.SourcesStore.
+var _ chronograf.SourcesStore = &SourcesStore{}
+
+// SourcesStore writes sources to both Primary and Shadow and reads from Primary
+type SourcesStore struct {
+	Primary chronograf.SourcesStore
+	Shadow  chronograf.SourcesStore
+	Logger  chronograf.Logger
+}
+
+func (s *SourcesStore) log() logger {
+	return newLogger(s.Logger, "sources")
+}
+
+// All returns all sources of the Primary store
+func (s *SourcesStore) All(ctx context.Context) ([]chronograf.Source, error) {
+	all, err := s.Primary.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	shadow, err := s.Shadow.All(ctx)
+	if err != nil {
+		s.log().failed("All", err)
+		return all, nil
+	}
+
+	p, sh := map[string]interface{}{}, map[string]interface{}{}
+	for _, src := range all {
+		p[strconv.Itoa(src.ID)] = src
+	}
+	for _, src := range shadow {
+		sh[strconv.Itoa(src.ID)] = src
+	}
+	s.log().compareAll(p, sh)
+	return all, nil
+}
+
+// Add creates src in the Primary store and then in the Shadow store using the
+// ID assigned by the Primary store
+func (s *SourcesStore) Add(ctx context.Context, src chronograf.Source) (chronograf.Source, error) {
+	added, err := s.Primary.Add(ctx, src)
+	if err != nil {
+		return added, err
+	}
+	if _, err := s.Shadow.Add(ctx, added); err != nil {
+		s.log().failed("Add", err)
+	}
+	return added, nil
+}
+
+// Delete removes src from both stores
+func (s *SourcesStore) Delete(ctx context.Context, src chronograf.Source) error {
+	if err := s.Primary.Delete(ctx, src); err != nil {
+		return err
+	}
+	if err := s.Shadow.Delete(ctx, src); err != nil {
+		s.log().failed("Delete", err)
+	}
+	return nil
+}
+
+// Get returns the source with ID from the Primary store
+func (s *SourcesStore) Get(ctx context.Context, ID int) (chronograf.Source, error) {
+	src, err := s.Primary.Get(ctx, ID)
+	if err != nil {
+		return src, err
+	}
+	shadow, err := s.Shadow.Get(ctx, ID)
+	if err != nil {
+		s.log().failed("Get", err)
+		return src, nil
+	}
+	s.log().compare("Get", ID, src, shadow)
+	return src, nil
+}
+
+// Update replaces src in both stores
+func (s *SourcesStore) Update(ctx context.Context, src chronograf.Source) error {
+	if err := s.Primary.Update(ctx, src); err != nil {
+		return err
+	}
+	if err := s.Shadow.Update(ctx, src); err != nil {
+		s.log().failed("Update", err)
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package shadow_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/influxdata/influxdb/chronograf"
+	"github.com/influxdata/influxdb/chronograf/mocks"
+	"github.com/influxdata/influxdb/chronograf/shadow"
+)
+
+func TestSourcesStore_Get(t *testing.T) {
+	tests := []struct {
+		name       string
+		primary    chronograf.Source
+		shadow     chronograf.Source
+		shadowErr  error
+		wantErrors int
+	}{
+		{
+			name:    "Stores agree",
+			primary: chronograf.Source{ID: 1, Name: "influx"},
+			shadow:  chronograf.Source{ID: 1, Name: "influx"},
+		},
+		{
+			name:       "Stores diverge",
+			primary:    chronograf.Source{ID: 1, Name: "influx"},
+			shadow:     chronograf.Source{ID: 1, Name: "stale"},
+			wantErrors: 1,
+		},
+		{
+			name:       "Shadow store fails",
+			primary:    chronograf.Source{ID: 1, Name: "influx"},
+			shadowErr:  errors.New("boom"),
+			wantErrors: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := &mocks.TestLogger{}
+			s := &shadow.SourcesStore{
+				Primary: &mocks.SourcesStore{
+					GetF: func(ctx context.Context, ID int) (chronograf.Source, error) {
+						return tt.primary, nil
+					},
+				},
+				Shadow: &mocks.SourcesStore{
+					GetF: func(ctx context.Context, ID int) (chronograf.Source, error) {
+						return tt.shadow, tt.shadowErr
+					},
+				},
+				Logger: logger,
+			}
+
+			got, err := s.Get(context.Background(), 1)
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			if got != tt.primary {
+				t.Errorf("Get() = %v, want %v", got, tt.primary)
+			}
+			if len(logger.Messages) != tt.wantErrors {
+				t.Errorf("Get() logged %v, want %d errors", logger.Messages, tt.wantErrors)
+			}
+		})
+	}
+}
+
+func TestSourcesStore_All(t *testing.T) {
+	logger := &mocks.TestLogger{}
+	s := &shadow.SourcesStore{
+		Primary: &mocks.SourcesStore{
+			AllF: func(ctx context.Context) ([]chronograf.Source, error) {
+				return []chronograf.Source{{ID: 1}, {ID: 2}}, nil
+			},
+		},
+		Shadow: &mocks.SourcesStore{
+			AllF: func(ctx context.Context) ([]chronograf.Source, error) {
+				// Order does not matter; only the missing source is a divergence
+				return []chronograf.Source{{ID: 2}}, nil
+			},
+		},
+		Logger: logger,
+	}
+
+	got, err := s.All(context.Background())
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	if len(got) != 2 {
+		t.Errorf("All() returned %d sources, want 2", len(got))
+	}
+	if len(logger.Messages) != 1 {
+		t.Errorf("All() logged %v, want 1 divergence", logger.Messages)
+	}
+}
+
+func TestSourcesStore_Add(t *testing.T) {
+	var shadowed chronograf.Source
+	logger := &mocks.TestLogger{}
+	s := &shadow.SourcesStore{
+		Primary: &mocks.SourcesStore{
+			AddF: func(ctx context.Context, src chronograf.Source) (chronograf.Source, error) {
+				src.ID = 42
+				return src, nil
+			},
+		},
+		Shadow: &mocks.SourcesStore{
+			AddF: func(ctx context.Context, src chronograf.Source) (chronograf.Source, error) {
+				shadowed = src
+				return src, errors.New("shadow unavailable")
+			},
+		},
+		Logger: logger,
+	}
+
+	got, err := s.Add(context.Background(), chronograf.Source{Name: "influx"})
+	if err != nil {
+		t.Fatalf("Add() error = %v, shadow errors must not be returned", err)
+	}
+	if got.ID != 42 || shadowed.ID != 42 {
+		t.Errorf("Add() = %d, shadow = %d, want both to use the primary ID 42", got.ID, shadowed.ID)
+	}
+	if len(logger.Messages) != 1 {
+		t.Errorf("Add() logged %v, want 1 error", logger.Messages)
+	}
+}
@@ -0,0 +1,96 @@
+package shadow
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Ensure TokensStore implements chronograf.TokensStore.
+var _ chronograf.TokensStore = &TokensStore{}
+
+// TokensStore writes tokens to both Primary and Shadow and reads from Primary
+type TokensStore struct {
+	Primary chronograf.TokensStore
+	Shadow  chronograf.TokensStore
+	Logger  chronograf.Logger
+}
+
+func (s *TokensStore) log() logger {
+	return newLogger(s.Logger, "tokens")
+}
+
+// All returns the tokens from the Primary store
+func (s *TokensStore) All(ctx context.Context) ([]chronograf.Token, error) {
+	all, err := s.Primary.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	shadow, err := s.Shadow.All(ctx)
+	if err != nil {
+		s.log().failed("All", err)
+		return all, nil
+	}
+
+	p, sh := map[string]interface{}{}, map[string]interface{}{}
+	for _, t := range all {
+		p[t.ID] = t
+	}
+	for _, t := range shadow {
+		sh[t.ID] = t
+	}
+	s.log().compareAll(p, sh)
+	return all, nil
+}
+
+// Add creates t in the Primary store and then in the Shadow store using the
+// ID assigned by the Primary store
+func (s *TokensStore) Add(ctx context.Context, t *chronograf.Token) (*chronograf.Token, error) {
+	added, err := s.Primary.Add(ctx, t)
+	if err != nil {
+		return added, err
+	}
+	token := *added
+	if _, err := s.Shadow.Add(ctx, &token); err != nil {
+		s.log().failed("Add", err)
+	}
+	return added, nil
+}
+
+// Delete removes t from both stores
+func (s *TokensStore) Delete(ctx context.Context, t *chronograf.Token) error {
+	if err := s.Primary.Delete(ctx, t); err != nil {
+		return err
+	}
+	if err := s.Shadow.Delete(ctx, t); err != nil {
+		s.log().failed("Delete", err)
+	}
+	return nil
+}
+
+// Get returns the token matching q from the Primary store
+func (s *TokensStore) Get(ctx context.Context, q chronograf.TokenQuery) (*chronograf.Token, error) {
+	t, err := s.Primary.Get(ctx, q)
+	if err != nil {
+		return t, err
+	}
+	shadow, err := s.Shadow.Get(ctx, q)
+	if err != nil {
+		s.log().failed("Get", err)
+		return t, nil
+	}
+	s.log().compare("Get", t.ID, t, shadow)
+	return t, nil
+}
+
+// Update replaces t in both stores
+func (s *TokensStore) Update(ctx context.Context, t *chronograf.Token) error {
+	if err := s.Primary.Update(ctx, t); err != nil {
+		return err
+	}
+	if err := s.Shadow.Update(ctx, t); err != nil {
+		s.log().failed("Update", err)
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package shadow
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Ensure UsersStore implements chronograf.UsersStore.
+var _ chronograf.UsersStore = &UsersStore{}
+
+// UsersStore writes users to both Primary and Shadow and reads from Primary
+type UsersStore struct {
+	Primary chronograf.UsersStore
+	Shadow  chronograf.UsersStore
+	Logger  chronograf.Logger
+}
+
+func (s *UsersStore) log() logger {
+	return newLogger(s.Logger, "users")
+}
+
+// All returns all users of the Primary store
+func (s *UsersStore) All(ctx context.Context) ([]chronograf.User, error) {
+	all, err := s.Primary.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	shadow, err := s.Shadow.All(ctx)
+	if err != nil {
+		s.log().failed("All", err)
+		return all, nil
+	}
+
+	p, sh := map[string]interface{}{}, map[string]interface{}{}
+	for _, u := range all {
+		p[strconv.FormatUint(u.ID, 10)] = u
+	}
+	for _, u := range shadow {
+		sh[strconv.FormatUint(u.ID, 10)] = u
+	}
+	s.log().compareAll(p, sh)
+	return all, nil
+}
+
+// Add creates u in the Primary store and then in the Shadow store using the
+// ID assigned by the Primary store
+func (s *UsersStore) Add(ctx context.Context, u *chronograf.User) (*chronograf.User, error) {
+	added, err := s.Primary.Add(ctx, u)
+	if err != nil {
+		return added, err
+	}
+	user := *added
+	if _, err := s.Shadow.Add(ctx, &user); err != nil {
+		s.log().failed("Add", err)
+	}
+	return added, nil
+}
+
+// AddMany creates us in the Primary store and then in the Shadow store using
+// the IDs assigned by the Primary store
+func (s *UsersStore) AddMany(ctx context.Context, us []*chronograf.User) ([]*chronograf.User, error) {
+	added, err := s.Primary.AddMany(ctx, us)
+	if err != nil {
+		return added, err
+	}
+	users := make([]*chronograf.User, len(added))
+	for i := range added {
+		user := *added[i]
+		users[i] = &user
+	}
+	if _, err := s.Shadow.AddMany(ctx, users); err != nil {
+		s.log().failed("AddMany", err)
+	}
+	return added, nil
+}
+
+// Delete removes u from both stores
+func (s *UsersStore) Delete(ctx context.Context, u *chronograf.User) error {
+	if err := s.Primary.Delete(ctx, u); err != nil {
+		return err
+	}
+	if err := s.Shadow.Delete(ctx, u); err != nil {
+		s.log().failed("Delete", err)
+	}
+	return nil
+}
+
+// Get returns the user matching q from the Primary store
+func (s *UsersStore) Get(ctx context.Context, q chronograf.UserQuery) (*chronograf.User, error) {
+	u, err := s.Primary.Get(ctx, q)
+	if err != nil {
+		return u, err
+	}
+	shadow, err := s.Shadow.Get(ctx, q)
+	if err != nil {
+		s.log().failed("Get", err)
+		return u, nil
+	}
+	s.log().compare("Get", u.ID, u, shadow)
+	return u, nil
+}
+
+// Update replaces u in both stores
+func (s *UsersStore) Update(ctx context.Context, u *chronograf.User) error {
+	if err := s.Primary.Update(ctx, u); err != nil {
+		return err
+	}
+	if err := s.Shadow.Update(ctx, u); err != nil {
+		s.log().failed("Update", err)
+	}
+	return nil
+}
+
+// UpdateMany replaces us in both stores
+func (s *UsersStore) UpdateMany(ctx context.Context, us []*chronograf.User) error {
+	if err := s.Primary.UpdateMany(ctx, us); err != nil {
+		return err
+	}
+	if err := s.Shadow.UpdateMany(ctx, us); err != nil {
+		s.log().failed("UpdateMany", err)
+	}
+	return nil
+}
+
+// Num returns the number of users in the Primary store
+func (s *UsersStore) Num(ctx context.Context) (int, error) {
+	n, err := s.Primary.Num(ctx)
+	if err != nil {
+		return n, err
+	}
+	shadow, err := s.Shadow.Num(ctx)
+	if err != nil {
+		s.log().failed("Num", err)
+		return n, nil
+	}
+	s.log().compare("Num", "", n, shadow)
+	return n, nil
+}
+
+// Filter returns the users of the Primary store matching f
+func (s *UsersStore) Filter(ctx context.Context, f chronograf.UserFilter) ([]chronograf.User, error) {
+	all, err := s.Primary.Filter(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	shadow, err := s.Shadow.Filter(ctx, f)
+	if err != nil {
+		s.log().failed("Filter", err)
+		return all, nil
+	}
+
+	p, sh := map[string]interface{}{}, map[string]interface{}{}
+	for _, u := range all {
+		p[strconv.FormatUint(u.ID, 10)] = u
+	}
+	for _, u := range shadow {
+		sh[strconv.FormatUint(u.ID, 10)] = u
+	}
+	s.log().compareAll(p, sh)
+	return all, nil
+}
@@ -0,0 +1,159 @@
+package shadow
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/influxdata/influxdb/chronograf"
+)
+
+// Ensure WebhooksStore implements chronograf.WebhooksStore.
+var _ chronograf.WebhooksStore = &WebhooksStore{}
+
+// WebhooksStore writes webhooks to both Primary and Shadow and reads from Primary
+type WebhooksStore struct {
+	Primary chronograf.WebhooksStore
+	Shadow  chronograf.WebhooksStore
+	Logger  chronograf.Logger
+}
+
+func (s *WebhooksStore) log() logger {
+	return newLogger(s.Logger, "webhooks")
+}
+
+// All returns all webhooks of the Primary store
+func (s *WebhooksStore) All(ctx context.Context) ([]chronograf.Webhook, error) {
+	all, err := s.Primary.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	shadow, err := s.Shadow.All(ctx)
+	if err != nil {
+		s.log().failed("All", err)
+		return all, nil
+	}
+
+	p, sh := map[string]interface{}{}, map[string]interface{}{}
+	for _, h := range all {
+		p[h.ID] = h
+	}
+	for _, h := range shadow {
+		sh[h.ID] = h
+	}
+	s.log().compareAll(p, sh)
+	return all, nil
+}
+
+// Add creates h in the Primary store and then in the Shadow store using the
+// ID assigned by the Primary store
+func (s *WebhooksStore) Add(ctx context.Context, h *chronograf.Webhook) (*chronograf.Webhook, error) {
+	added, err := s.Primary.Add(ctx, h)
+	if err != nil {
+		return added, err
+	}
+	hook := *added
+	if _, err := s.Shadow.Add(ctx, &hook); err != nil {
+		s.log().failed("Add", err)
+	}
+	return added, nil
+}
+
+// Delete removes h from both stores
+func (s *WebhooksStore) Delete(ctx context.Context, h *chronograf.Webhook) error {
+	if err := s.Primary.Delete(ctx, h); err != nil {
+		return err
+	}
+	if err := s.Shadow.Delete(ctx, h); err != nil {
+		s.log().failed("Delete", err)
+	}
+	return nil
+}
+
+// Get returns the webhook with id from the Primary store
+func (s *WebhooksStore) Get(ctx context.Context, id string) (*chronograf.Webhook, error) {
+	h, err := s.Primary.Get(ctx, id)
+	if err != nil {
+		return h, err
+	}
+	shadow, err := s.Shadow.Get(ctx, id)
+	if err != nil {
+		s.log().failed("Get", err)
+		return h, nil
+	}
+	s.log().compare("Get", h.ID, h, shadow)
+	return h, nil
+}
+
+// Update replaces h in both stores
+func (s *WebhooksStore) Update(ctx context.Context, h *chronograf.Webhook) error {
+	if err := s.Primary.Update(ctx, h); err != nil {
+		return err
+	}
+	if err := s.Shadow.Update(ctx, h); err != nil {
+		s.log().failed("Update", err)
+	}
+	return nil
+}
+
+// Ensure WebhookDeliveriesStore implements chronograf.WebhookDeliveriesStore.
+var _ chronograf.WebhookDeliveriesStore = &WebhookDeliveriesStore{}
+
+// WebhookDeliveriesStore writes webhook deliveries to both Primary and Shadow and reads from Primary
+type WebhookDeliveriesStore struct {
+	Primary chronograf.WebhookDeliveriesStore
+	Shadow  chronograf.WebhookDeliveriesStore
+	Logger  chronograf.Logger
+}
+
+func (s *WebhookDeliveriesStore) log() logger {
+	return newLogger(s.Logger, "webhookdeliveries")
+}
+
+// All returns the deliveries of a webhook from the Primary store. Deliveries
+// have no ID of their own, so they are compared by position.
+func (s *WebhookDeliveriesStore) All(ctx context.Context, webhookID string) ([]chronograf.WebhookDelivery, error) {
+	all, err := s.Primary.All(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	shadow, err := s.Shadow.All(ctx, webhookID)
+	if err != nil {
+		s.log().failed("All", err)
+		return all, nil
+	}
+
+	p, sh := map[string]interface{}{}, map[string]interface{}{}
+	for i, d := range all {
+		p[strconv.Itoa(i)] = d
+	}
+	for i, d := range shadow {
+		sh[strconv.Itoa(i)] = d
+	}
+	s.log().compareAll(p, sh)
+	return all, nil
+}
+
+// Add records d in the Primary store and then in the Shadow store
+func (s *WebhookDeliveriesStore) Add(ctx context.Context, d *chronograf.WebhookDelivery) error {
+	if err := s.Primary.Add(ctx, d); err != nil {
+		return err
+	}
+	delivery := *d
+	if err := s.Shadow.Add(ctx, &delivery); err != nil {
+		s.log().failed("Add", err)
+	}
+	return nil
+}
+
+// Delete removes the deliveries of a webhook from both stores
+func (s *WebhookDeliveriesStore) Delete(ctx context.Context, webhookID string) error {
+	if err := s.Primary.Delete(ctx, webhookID); err != nil {
+		return err
+	}
+	if err := s.Shadow.Delete(ctx, webhookID); err != nil {
+		s.log().failed("Delete", err)
+	}
+	return nil
+}
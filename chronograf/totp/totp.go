@@ -0,0 +1,106 @@
+// Package totp implements the time-based one-time passwords of RFC 6238 as
+// generated by authenticator apps: six digits of HMAC-SHA1 over 30 second
+// time steps.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// Period is the duration of a time step
+	Period = 30 * time.Second
+	// Digits is the length of a one-time password
+	Digits = 6
+	// Skew is the number of time steps before and after the current one whose
+	// passwords are accepted for clocks that are out of sync
+	Skew = 1
+
+	secretSize = 20 // 160 bits as recommended by RFC 4226
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// NewSecret returns a random base32 encoded secret
+func NewSecret() (string, error) {
+	b := make([]byte, secretSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return encoding.EncodeToString(b), nil
+}
+
+// Counter returns the time step of t
+func Counter(t time.Time) uint64 {
+	return uint64(t.Unix()) / uint64(Period/time.Second)
+}
+
+// Code returns the one-time password of secret at the time step counter
+func Code(secret string, counter uint64) (string, error) {
+	key, err := encoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return "", fmt.Errorf("invalid secret: %v", err)
+	}
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	// dynamic truncation of RFC 4226 section 5.3
+	offset := sum[len(sum)-1] & 0xf
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", Digits, value%1000000), nil
+}
+
+// Validate returns the time step of the password code of secret at t. Codes
+// of the time steps up to last are rejected, so that a password cannot be
+// used twice.
+func Validate(secret, code string, t time.Time, last uint64) (uint64, bool) {
+	code = strings.Replace(code, " ", "", -1)
+	if len(code) != Digits {
+		return 0, false
+	}
+	now := Counter(t)
+	for i := -Skew; i <= Skew; i++ {
+		counter := now + uint64(i)
+		if counter <= last {
+			continue
+		}
+		want, err := Code(secret, counter)
+		if err != nil {
+			return 0, false
+		}
+		if subtle.ConstantTimeCompare([]byte(want), []byte(code)) == 1 {
+			return counter, true
+		}
+	}
+	return 0, false
+}
+
+// URL returns the otpauth URI that authenticator apps enroll secret of
+// account at issuer by, usually from a QR code
+func URL(issuer, account, secret string) string {
+	u := url.URL{
+		Scheme: "otpauth",
+		Host:   "totp",
+		Path:   "/" + issuer + ":" + account,
+	}
+	q := url.Values{}
+	q.Set("secret", secret)
+	q.Set("issuer", issuer)
+	q.Set("algorithm", "SHA1")
+	q.Set("digits", fmt.Sprint(Digits))
+	q.Set("period", fmt.Sprint(int(Period/time.Second)))
+	u.RawQuery = q.Encode()
+	return u.String()
+}
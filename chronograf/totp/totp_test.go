@@ -0,0 +1,112 @@
+package totp
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+// secret is the key "12345678901234567890" of the RFC 6238 test vectors
+const secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCode(t *testing.T) {
+	// The SHA1 test vectors of RFC 6238 Appendix B truncated to six digits
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+	for _, tt := range tests {
+		got, err := Code(secret, Counter(time.Unix(tt.unix, 0)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("Code() at %d = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	counter := Counter(now)
+	tests := []struct {
+		name        string
+		code        string
+		last        uint64
+		wantCounter uint64
+		wantOK      bool
+	}{
+		{
+			name:        "Current time step",
+			code:        "050471",
+			wantCounter: counter,
+			wantOK:      true,
+		},
+		{
+			name:        "Previous time step of a slow clock",
+			code:        "081804",
+			wantCounter: counter - 1,
+			wantOK:      true,
+		},
+		{
+			name:        "Spaces are ignored",
+			code:        "050 471",
+			wantCounter: counter,
+			wantOK:      true,
+		},
+		{
+			name: "Used code",
+			code: "050471",
+			last: counter,
+		},
+		{
+			name: "Wrong code",
+			code: "123456",
+		},
+		{
+			name: "Too short",
+			code: "05047",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Validate(secret, tt.code, now, tt.last)
+			if ok != tt.wantOK || got != tt.wantCounter {
+				t.Errorf("Validate() = %d, %v, want %d, %v", got, ok, tt.wantCounter, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestNewSecret(t *testing.T) {
+	s, err := NewSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s) != 32 {
+		t.Errorf("NewSecret() = %q is not 160 bits", s)
+	}
+	if _, err := Code(s, 0); err != nil {
+		t.Errorf("NewSecret() = %q is not base32: %v", s, err)
+	}
+}
+
+func TestURL(t *testing.T) {
+	u, err := url.Parse(URL("Chronograf", "marty@pinheads.rok", secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Scheme != "otpauth" || u.Host != "totp" || u.Path != "/Chronograf:marty@pinheads.rok" {
+		t.Errorf("URL() = %s", u)
+	}
+	if q := u.Query(); q.Get("secret") != secret || q.Get("issuer") != "Chronograf" {
+		t.Errorf("URL() query = %s", u.RawQuery)
+	}
+}